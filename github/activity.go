@@ -53,6 +53,9 @@ type Feeds struct {
 //
 // Note: Private feeds are only returned when authenticating via Basic Auth
 // since current feed URIs use the older, non revocable auth tokens.
+//
+// The feeds themselves can be fetched with GetFeed, or with the
+// Get*Feed methods, which also expand the URI templates.
 func (s *ActivityService) ListFeeds(ctx context.Context) (*Feeds, *Response, error) {
 	req, err := s.client.NewRequest("GET", "feeds", nil)
 	if err != nil {
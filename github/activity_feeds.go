@@ -0,0 +1,238 @@
+// Copyright 2021 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"bytes"
+	"context"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+)
+
+const mediaTypeAtom = "application/atom+xml"
+
+// AtomFeed represents a timeline in Atom format, as returned by the
+// ActivityService feed methods.
+type AtomFeed struct {
+	ID      *string      `xml:"id"`
+	Title   *string      `xml:"title"`
+	Updated *time.Time   `xml:"updated"`
+	Links   []*AtomLink  `xml:"link"`
+	Entries []*AtomEntry `xml:"entry"`
+}
+
+func (f AtomFeed) String() string {
+	return Stringify(f)
+}
+
+// AtomEntry represents an entry of an AtomFeed, which is a single event of
+// the timeline.
+type AtomEntry struct {
+	ID        *string        `xml:"id"`
+	Title     *string        `xml:"title"`
+	Published *time.Time     `xml:"published"`
+	Updated   *time.Time     `xml:"updated"`
+	Links     []*AtomLink    `xml:"link"`
+	Author    *AtomAuthor    `xml:"author"`
+	Thumbnail *AtomThumbnail `xml:"http://search.yahoo.com/mrss/ thumbnail"`
+	Content   *AtomContent   `xml:"content"`
+}
+
+// EventType returns the type of the event of the entry, such as
+// "PushEvent" or "WatchEvent", taken from its ID. It returns the empty
+// string if the ID does not hold an event type.
+func (e *AtomEntry) EventType() string {
+	id := e.GetID()
+	// IDs look like "tag:github.com,2008:PushEvent/1234".
+	if i := strings.LastIndex(id, ":"); i >= 0 {
+		id = id[i+1:]
+	}
+	if i := strings.Index(id, "/"); i > 0 {
+		return id[:i]
+	}
+	return ""
+}
+
+// AtomLink represents a link of an AtomFeed or AtomEntry.
+type AtomLink struct {
+	HRef *string `xml:"href,attr"`
+	Rel  *string `xml:"rel,attr"`
+	Type *string `xml:"type,attr"`
+}
+
+// AtomAuthor represents the author of an AtomEntry.
+type AtomAuthor struct {
+	Name  *string `xml:"name"`
+	URI   *string `xml:"uri"`
+	Email *string `xml:"email"`
+}
+
+// AtomThumbnail represents the thumbnail of an AtomEntry, which is the
+// avatar of its author.
+type AtomThumbnail struct {
+	URL    *string `xml:"url,attr"`
+	Height *int    `xml:"height,attr"`
+	Width  *int    `xml:"width,attr"`
+}
+
+// AtomContent represents the content of an AtomEntry. Type is usually
+// "html".
+type AtomContent struct {
+	Type *string `xml:"type,attr"`
+	Body *string `xml:",chardata"`
+}
+
+// ExpandFeedURL expands a feed URI template, such as Feeds.UserURL, with
+// params. Only simple string expansions, like "{user}", are supported, which
+// is all that GitHub uses in feed URLs.
+func ExpandFeedURL(template string, params map[string]string) (string, error) {
+	var b strings.Builder
+	for {
+		i := strings.Index(template, "{")
+		if i < 0 {
+			b.WriteString(template)
+			return b.String(), nil
+		}
+		j := strings.Index(template[i:], "}")
+		if j < 0 {
+			return "", fmt.Errorf("unterminated expression in URI template %q", template)
+		}
+		name := template[i+1 : i+j]
+		if name == "" || strings.ContainsAny(name, "+#./;?&,*:") {
+			return "", fmt.Errorf("unsupported expression %q in URI template %q", name, template)
+		}
+		value, ok := params[name]
+		if !ok {
+			return "", fmt.Errorf("missing value for %q in URI template %q", name, template)
+		}
+
+		b.WriteString(template[:i])
+		b.WriteString(escapeURITemplateValue(value))
+		template = template[i+j+1:]
+	}
+}
+
+// escapeURITemplateValue percent-encodes all but the unreserved characters
+// of s, as a simple string expansion of RFC 6570 does.
+func escapeURITemplateValue(s string) string {
+	const hex = "0123456789ABCDEF"
+	var b strings.Builder
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		switch {
+		case 'a' <= c && c <= 'z', 'A' <= c && c <= 'Z', '0' <= c && c <= '9',
+			c == '-', c == '.', c == '_', c == '~':
+			b.WriteByte(c)
+		default:
+			b.WriteByte('%')
+			b.WriteByte(hex[c>>4])
+			b.WriteByte(hex[c&15])
+		}
+	}
+	return b.String()
+}
+
+// GetFeed fetches and parses the Atom feed at feedURL, which is one of the
+// URLs returned by ListFeeds, with its URI template expanded if needed.
+func (s *ActivityService) GetFeed(ctx context.Context, feedURL string) (*AtomFeed, *Response, error) {
+	req, err := s.client.NewRequest("GET", feedURL, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	req.Header.Set("Accept", mediaTypeAtom)
+
+	var buf bytes.Buffer
+	resp, err := s.client.Do(ctx, req, &buf)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	feed := new(AtomFeed)
+	if err := xml.Unmarshal(buf.Bytes(), feed); err != nil {
+		return nil, resp, err
+	}
+
+	return feed, resp, nil
+}
+
+// getFeed fetches the feed at feedURL, or returns an error naming the feed
+// if it is not available to the authenticated user.
+func (s *ActivityService) getFeed(ctx context.Context, name string, feedURL *string) (*AtomFeed, *Response, error) {
+	if feedURL == nil || *feedURL == "" {
+		return nil, nil, fmt.Errorf("%v feed is not available", name)
+	}
+	return s.GetFeed(ctx, *feedURL)
+}
+
+// GetTimelineFeed fetches the GitHub global public timeline.
+func (s *ActivityService) GetTimelineFeed(ctx context.Context, feeds *Feeds) (*AtomFeed, *Response, error) {
+	if feeds == nil {
+		return nil, nil, errors.New("feeds must be provided")
+	}
+	return s.getFeed(ctx, "timeline", feeds.TimelineURL)
+}
+
+// GetUserFeed fetches the public timeline of user, by expanding the
+// Feeds.UserURL template.
+func (s *ActivityService) GetUserFeed(ctx context.Context, feeds *Feeds, user string) (*AtomFeed, *Response, error) {
+	if feeds == nil {
+		return nil, nil, errors.New("feeds must be provided")
+	}
+	if feeds.UserURL == nil {
+		return nil, nil, errors.New("user feed is not available")
+	}
+	u, err := ExpandFeedURL(*feeds.UserURL, map[string]string{"user": user})
+	if err != nil {
+		return nil, nil, err
+	}
+	return s.GetFeed(ctx, u)
+}
+
+// GetCurrentUserPublicFeed fetches the public timeline of the authenticated
+// user.
+func (s *ActivityService) GetCurrentUserPublicFeed(ctx context.Context, feeds *Feeds) (*AtomFeed, *Response, error) {
+	if feeds == nil {
+		return nil, nil, errors.New("feeds must be provided")
+	}
+	return s.getFeed(ctx, "current user public", feeds.CurrentUserPublicURL)
+}
+
+// GetCurrentUserFeed fetches the private timeline of the authenticated user.
+func (s *ActivityService) GetCurrentUserFeed(ctx context.Context, feeds *Feeds) (*AtomFeed, *Response, error) {
+	if feeds == nil {
+		return nil, nil, errors.New("feeds must be provided")
+	}
+	return s.getFeed(ctx, "current user", feeds.CurrentUserURL)
+}
+
+// GetCurrentUserActorFeed fetches the private timeline of the activity
+// created by the authenticated user.
+func (s *ActivityService) GetCurrentUserActorFeed(ctx context.Context, feeds *Feeds) (*AtomFeed, *Response, error) {
+	if feeds == nil {
+		return nil, nil, errors.New("feeds must be provided")
+	}
+	return s.getFeed(ctx, "current user actor", feeds.CurrentUserActorURL)
+}
+
+// GetCurrentUserOrganizationFeed fetches the private timeline of org, an
+// organization the authenticated user is a member of, by expanding the
+// Feeds.CurrentUserOrganizationURL template.
+func (s *ActivityService) GetCurrentUserOrganizationFeed(ctx context.Context, feeds *Feeds, org string) (*AtomFeed, *Response, error) {
+	if feeds == nil {
+		return nil, nil, errors.New("feeds must be provided")
+	}
+	if feeds.CurrentUserOrganizationURL == nil {
+		return nil, nil, errors.New("current user organization feed is not available")
+	}
+	u, err := ExpandFeedURL(*feeds.CurrentUserOrganizationURL, map[string]string{"org": org})
+	if err != nil {
+		return nil, nil, err
+	}
+	return s.GetFeed(ctx, u)
+}
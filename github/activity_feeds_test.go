@@ -0,0 +1,213 @@
+// Copyright 2021 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+	"time"
+)
+
+const atomFeedXML = `<?xml version="1.0" encoding="UTF-8"?>
+<feed xmlns="http://www.w3.org/2005/Atom" xmlns:media="http://search.yahoo.com/mrss/" xml:lang="en-US">
+  <id>tag:github.com,2008:/octocat</id>
+  <link type="text/html" rel="alternate" href="https://github.com/octocat"/>
+  <title>octocat's Activity</title>
+  <updated>2021-01-02T15:04:05Z</updated>
+  <entry>
+    <id>tag:github.com,2008:PushEvent/1234</id>
+    <published>2021-01-02T15:04:05Z</published>
+    <updated>2021-01-02T15:04:05Z</updated>
+    <link type="text/html" rel="alternate" href="https://github.com/octocat/Hello-World/compare/a...b"/>
+    <title type="html">octocat pushed to main in octocat/Hello-World</title>
+    <author>
+      <name>octocat</name>
+      <uri>https://github.com/octocat</uri>
+    </author>
+    <media:thumbnail height="30" width="30" url="https://avatars.githubusercontent.com/u/583231?s=30"/>
+    <content type="html">&lt;p&gt;pushed&lt;/p&gt;</content>
+  </entry>
+</feed>`
+
+func TestActivityService_GetFeed(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/octocat.atom", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testHeader(t, r, "Accept", mediaTypeAtom)
+		fmt.Fprint(w, atomFeedXML)
+	})
+
+	ctx := context.Background()
+	got, _, err := client.Activity.GetFeed(ctx, "octocat.atom")
+	if err != nil {
+		t.Errorf("Activity.GetFeed returned error: %v", err)
+	}
+
+	ts := time.Date(2021, time.January, 2, 15, 4, 5, 0, time.UTC)
+	want := &AtomFeed{
+		ID:      String("tag:github.com,2008:/octocat"),
+		Title:   String("octocat's Activity"),
+		Updated: &ts,
+		Links: []*AtomLink{
+			{HRef: String("https://github.com/octocat"), Rel: String("alternate"), Type: String("text/html")},
+		},
+		Entries: []*AtomEntry{
+			{
+				ID:        String("tag:github.com,2008:PushEvent/1234"),
+				Title:     String("octocat pushed to main in octocat/Hello-World"),
+				Published: &ts,
+				Updated:   &ts,
+				Links: []*AtomLink{
+					{HRef: String("https://github.com/octocat/Hello-World/compare/a...b"), Rel: String("alternate"), Type: String("text/html")},
+				},
+				Author: &AtomAuthor{Name: String("octocat"), URI: String("https://github.com/octocat")},
+				Thumbnail: &AtomThumbnail{
+					URL:    String("https://avatars.githubusercontent.com/u/583231?s=30"),
+					Height: Int(30),
+					Width:  Int(30),
+				},
+				Content: &AtomContent{Type: String("html"), Body: String("<p>pushed</p>")},
+			},
+		},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Activity.GetFeed returned %+v, want %+v", got, want)
+	}
+	if got, want := got.Entries[0].EventType(), "PushEvent"; got != want {
+		t.Errorf("AtomEntry.EventType = %v, want %v", got, want)
+	}
+
+	const methodName = "GetFeed"
+	testBadOptions(t, methodName, func() (err error) {
+		_, _, err = client.Activity.GetFeed(ctx, "\n")
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.Activity.GetFeed(ctx, "octocat.atom")
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
+
+func TestActivityService_GetFeed_invalidXML(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/octocat.atom", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `<feed>`)
+	})
+
+	ctx := context.Background()
+	if _, _, err := client.Activity.GetFeed(ctx, "octocat.atom"); err == nil {
+		t.Error("Activity.GetFeed returned nil error, want error")
+	}
+}
+
+func TestActivityService_GetFeeds(t *testing.T) {
+	client, mux, serverURL, teardown := setup()
+	defer teardown()
+
+	for _, path := range []string{"/timeline", "/hubot", "/octocat", "/octocat.private", "/octocat.private.actor", "/organizations/github/octocat.private.atom"} {
+		path := path
+		mux.HandleFunc(path, func(w http.ResponseWriter, r *http.Request) {
+			testMethod(t, r, "GET")
+			if path == "/organizations/github/octocat.private.atom" {
+				testFormValues(t, r, values{"token": "abc"})
+			}
+			fmt.Fprintf(w, `<feed xmlns="http://www.w3.org/2005/Atom"><id>%v</id></feed>`, path)
+		})
+	}
+
+	base := serverURL + baseURLPath
+	feeds := &Feeds{
+		TimelineURL:                String(base + "/timeline"),
+		UserURL:                    String(base + "/{user}"),
+		CurrentUserPublicURL:       String(base + "/octocat"),
+		CurrentUserURL:             String(base + "/octocat.private"),
+		CurrentUserActorURL:        String(base + "/octocat.private.actor"),
+		CurrentUserOrganizationURL: String(base + "/organizations/{org}/octocat.private.atom?token=abc"),
+	}
+
+	ctx := context.Background()
+	tests := []struct {
+		name  string
+		fetch func() (*AtomFeed, *Response, error)
+		want  string
+	}{
+		{"GetTimelineFeed", func() (*AtomFeed, *Response, error) { return client.Activity.GetTimelineFeed(ctx, feeds) }, "/timeline"},
+		{"GetUserFeed", func() (*AtomFeed, *Response, error) { return client.Activity.GetUserFeed(ctx, feeds, "hubot") }, "/hubot"},
+		{"GetCurrentUserPublicFeed", func() (*AtomFeed, *Response, error) { return client.Activity.GetCurrentUserPublicFeed(ctx, feeds) }, "/octocat"},
+		{"GetCurrentUserFeed", func() (*AtomFeed, *Response, error) { return client.Activity.GetCurrentUserFeed(ctx, feeds) }, "/octocat.private"},
+		{"GetCurrentUserActorFeed", func() (*AtomFeed, *Response, error) { return client.Activity.GetCurrentUserActorFeed(ctx, feeds) }, "/octocat.private.actor"},
+		{"GetCurrentUserOrganizationFeed", func() (*AtomFeed, *Response, error) {
+			return client.Activity.GetCurrentUserOrganizationFeed(ctx, feeds, "github")
+		}, "/organizations/github/octocat.private.atom"},
+	}
+	for _, tt := range tests {
+		feed, _, err := tt.fetch()
+		if err != nil {
+			t.Errorf("Activity.%v returned error: %v", tt.name, err)
+			continue
+		}
+		if got := feed.GetID(); got != tt.want {
+			t.Errorf("Activity.%v fetched %v, want %v", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestActivityService_GetFeeds_unavailable(t *testing.T) {
+	client, _, _, teardown := setup()
+	defer teardown()
+
+	ctx := context.Background()
+	feeds := &Feeds{}
+	if _, _, err := client.Activity.GetTimelineFeed(ctx, nil); err == nil {
+		t.Error("Activity.GetTimelineFeed with nil feeds returned nil error")
+	}
+	if _, _, err := client.Activity.GetCurrentUserFeed(ctx, feeds); err == nil {
+		t.Error("Activity.GetCurrentUserFeed returned nil error")
+	}
+	if _, _, err := client.Activity.GetUserFeed(ctx, feeds, "u"); err == nil {
+		t.Error("Activity.GetUserFeed returned nil error")
+	}
+	if _, _, err := client.Activity.GetCurrentUserOrganizationFeed(ctx, feeds, "o"); err == nil {
+		t.Error("Activity.GetCurrentUserOrganizationFeed returned nil error")
+	}
+}
+
+func TestExpandFeedURL(t *testing.T) {
+	tests := []struct {
+		template string
+		params   map[string]string
+		want     string
+		wantErr  bool
+	}{
+		{template: "https://github.com/timeline", want: "https://github.com/timeline"},
+		{template: "https://github.com/{user}", params: map[string]string{"user": "octocat"}, want: "https://github.com/octocat"},
+		{template: "https://github.com/{user}", params: map[string]string{"user": "a b/c~"}, want: "https://github.com/a%20b%2Fc~"},
+		{template: "https://github.com/organizations/{org}/{user}.private.atom?token=t", params: map[string]string{"org": "o", "user": "u"}, want: "https://github.com/organizations/o/u.private.atom?token=t"},
+		{template: "https://github.com/{user}", wantErr: true},
+		{template: "https://github.com/{user", params: map[string]string{"user": "u"}, wantErr: true},
+		{template: "https://github.com/{?user}", params: map[string]string{"?user": "u"}, wantErr: true},
+	}
+	for _, tt := range tests {
+		got, err := ExpandFeedURL(tt.template, tt.params)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("ExpandFeedURL(%q) returned error %v, wantErr %v", tt.template, err, tt.wantErr)
+		}
+		if got != tt.want {
+			t.Errorf("ExpandFeedURL(%q) = %q, want %q", tt.template, got, tt.want)
+		}
+	}
+}
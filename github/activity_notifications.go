@@ -8,6 +8,8 @@ package github
 import (
 	"context"
 	"fmt"
+	"net/http"
+	"strconv"
 	"time"
 )
 
@@ -221,3 +223,152 @@ func (s *ActivityService) DeleteThreadSubscription(ctx context.Context, id strin
 
 	return s.client.Do(ctx, req, nil)
 }
+
+// defaultNotificationPollInterval is used by PollNotifications when GitHub
+// does not send an X-Poll-Interval header, and is the minimum interval
+// between polls.
+const defaultNotificationPollInterval = 60 * time.Second
+
+// NotificationHandler is called by PollNotifications for each notification
+// thread that is new, or has been updated, since it was last handled.
+// Returning an error stops the polling.
+type NotificationHandler func(*Notification) error
+
+// PollNotificationsOptions specifies the optional parameters to the
+// ActivityService.PollNotifications method.
+type PollNotificationsOptions struct {
+	// NotificationListOptions filters the polled notifications. Its Since
+	// time is the cursor of the polling, which is advanced to the latest
+	// UpdatedAt of the handled notifications.
+	NotificationListOptions
+
+	// Wait waits for the duration d between two polls, or until ctx is done,
+	// in which case it returns the error of ctx. It defaults to sleeping for
+	// d.
+	Wait func(ctx context.Context, d time.Duration) error
+}
+
+// PollNotifications polls the notifications of the authenticated user, and
+// calls handler for each new or updated notification thread, until ctx is
+// done or handler returns an error.
+//
+// Polling follows GitHub's recommendations: requests are spaced by the
+// interval from the X-Poll-Interval response header, which is at least 60
+// seconds, and are made conditional with If-Modified-Since using the
+// Last-Modified response header of the previous poll, so that polls which
+// return "304 Not Modified" do not count against the rate limit. Rate limit
+// errors are waited out.
+//
+// PollNotifications returns ctx.Err() when ctx is done, or the first other
+// error encountered.
+//
+// GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/activity/#list-notifications-for-the-authenticated-user
+func (s *ActivityService) PollNotifications(ctx context.Context, opts *PollNotificationsOptions, handler NotificationHandler) error {
+	var o PollNotificationsOptions
+	if opts != nil {
+		o = *opts
+	}
+	if o.Wait == nil {
+		o.Wait = func(ctx context.Context, d time.Duration) error {
+			return sleepUntil(ctx, time.Now().Add(d))
+		}
+	}
+
+	// seen maps a thread ID to the UpdatedAt of its last handled version,
+	// for the threads updated at or after the Since cursor, which may be
+	// listed again.
+	seen := make(map[string]time.Time)
+	var lastModified string
+	for {
+		interval, modified, notifications, err := s.pollNotifications(ctx, o.NotificationListOptions, lastModified)
+		if err != nil {
+			return err
+		}
+		if modified != "" {
+			lastModified = modified
+		}
+
+		for _, n := range notifications {
+			id := n.GetID()
+			updatedAt := n.GetUpdatedAt()
+			if last, ok := seen[id]; ok && !updatedAt.After(last) {
+				continue
+			}
+			if err := handler(n); err != nil {
+				return err
+			}
+			seen[id] = updatedAt
+			if updatedAt.After(o.Since) {
+				o.Since = updatedAt
+			}
+		}
+		for id, updatedAt := range seen {
+			if updatedAt.Before(o.Since) {
+				delete(seen, id)
+			}
+		}
+
+		if err := o.Wait(ctx, interval); err != nil {
+			return err
+		}
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+	}
+}
+
+// pollNotifications fetches all pages of notifications once, waiting out
+// rate limit errors. It returns the poll interval requested by GitHub, which
+// is at least defaultNotificationPollInterval, the Last-Modified header of
+// the first page, and the notifications, which are empty if they were not
+// modified since lastModified.
+func (s *ActivityService) pollNotifications(ctx context.Context, opts NotificationListOptions, lastModified string) (time.Duration, string, []*Notification, error) {
+	interval := defaultNotificationPollInterval
+	var modified string
+	var all []*Notification
+	first := true
+	for {
+		u, err := addOptions("notifications", &opts)
+		if err != nil {
+			return 0, "", nil, err
+		}
+
+		req, err := s.client.NewRequest("GET", u, nil)
+		if err != nil {
+			return 0, "", nil, err
+		}
+		// Only the first page is conditional, as the following ones must be
+		// fetched for the response to be complete.
+		if first && lastModified != "" {
+			req.Header.Set("If-Modified-Since", lastModified)
+		}
+
+		var notifications []*Notification
+		resp, err := s.client.Do(ctx, req, &notifications)
+		if resp != nil {
+			if v, perr := strconv.Atoi(resp.Header.Get("X-Poll-Interval")); perr == nil && time.Duration(v)*time.Second > interval {
+				interval = time.Duration(v) * time.Second
+			}
+		}
+
+		if err != nil {
+			if e, ok := err.(*ErrorResponse); ok && e.Response != nil && e.Response.StatusCode == http.StatusNotModified {
+				return interval, "", nil, nil
+			}
+			if retry, err := waitForRateLimit(ctx, err); !retry {
+				return 0, "", nil, err
+			}
+			continue
+		}
+
+		if first {
+			modified = resp.Header.Get("Last-Modified")
+		}
+		all = append(all, notifications...)
+		if resp.NextPage == 0 {
+			return interval, modified, all, nil
+		}
+		opts.Page = resp.NextPage
+		first = false
+	}
+}
@@ -8,6 +8,7 @@ package github
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"net/http"
 	"reflect"
@@ -311,3 +312,101 @@ func TestActivityService_DeleteThreadSubscription(t *testing.T) {
 		return client.Activity.DeleteThreadSubscription(ctx, "1")
 	})
 }
+
+func TestActivityService_PollNotifications(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	const lastModified = "Thu, 05 Jul 2012 15:31:30 GMT"
+	poll := 0
+	mux.HandleFunc("/notifications", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		poll++
+		w.Header().Set("X-Poll-Interval", "0")
+		switch poll {
+		case 1:
+			testFormValues(t, r, values{"participating": "true"})
+			testHeader(t, r, "If-Modified-Since", "")
+			w.Header().Set("Last-Modified", lastModified)
+			fmt.Fprint(w, `[{"id":"1","updated_at":"2006-01-02T15:04:05Z"},{"id":"2","updated_at":"2006-01-02T15:04:05Z"}]`)
+		case 2:
+			testFormValues(t, r, values{"participating": "true", "since": "2006-01-02T15:04:05Z"})
+			testHeader(t, r, "If-Modified-Since", lastModified)
+			w.WriteHeader(http.StatusNotModified)
+		default:
+			testFormValues(t, r, values{"participating": "true", "since": "2006-01-02T15:04:05Z"})
+			testHeader(t, r, "If-Modified-Since", lastModified)
+			fmt.Fprint(w, `[{"id":"1","updated_at":"2006-01-02T15:04:05Z"},{"id":"2","updated_at":"2006-01-03T15:04:05Z"}]`)
+		}
+	})
+
+	errStop := errors.New("stop")
+	var got []string
+	var waits []time.Duration
+	opts := &PollNotificationsOptions{
+		NotificationListOptions: NotificationListOptions{Participating: true},
+		Wait: func(ctx context.Context, d time.Duration) error {
+			waits = append(waits, d)
+			return nil
+		},
+	}
+	ctx := context.Background()
+	err := client.Activity.PollNotifications(ctx, opts, func(n *Notification) error {
+		got = append(got, n.GetID()+"@"+n.GetUpdatedAt().Format("2006-01-02"))
+		if len(got) == 3 {
+			return errStop
+		}
+		return nil
+	})
+	if err != errStop {
+		t.Errorf("Activity.PollNotifications returned error %v, want %v", err, errStop)
+	}
+
+	want := []string{"1@2006-01-02", "2@2006-01-02", "2@2006-01-03"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Activity.PollNotifications handled %v, want %v", got, want)
+	}
+	if poll != 3 {
+		t.Errorf("Activity.PollNotifications polled %v times, want 3", poll)
+	}
+	// The interval of 0s is raised to the minimum of 60s.
+	wantWaits := []time.Duration{60 * time.Second, 60 * time.Second}
+	if !reflect.DeepEqual(waits, wantWaits) {
+		t.Errorf("Activity.PollNotifications waited %v, want %v", waits, wantWaits)
+	}
+}
+
+func TestActivityService_PollNotifications_error(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/notifications", func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, `{"message":"Bad credentials"}`, http.StatusUnauthorized)
+	})
+
+	ctx := context.Background()
+	err := client.Activity.PollNotifications(ctx, nil, func(n *Notification) error {
+		t.Errorf("Activity.PollNotifications called handler with %v", n)
+		return nil
+	})
+	if _, ok := err.(*ErrorResponse); !ok {
+		t.Errorf("Activity.PollNotifications returned error %v, want *ErrorResponse", err)
+	}
+}
+
+func TestActivityService_PollNotifications_canceled(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/notifications", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("X-Poll-Interval", "60")
+		fmt.Fprint(w, `[]`)
+	})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	err := client.Activity.PollNotifications(ctx, nil, func(*Notification) error { return nil })
+	if err == nil {
+		t.Error("Activity.PollNotifications returned nil error, want context error")
+	}
+}
@@ -25,8 +25,34 @@ type Stargazer struct {
 
 // ListStargazers lists people who have starred the specified repo.
 //
+// The star+json media type is requested, so the StarredAt time of each
+// Stargazer is populated.
+//
 // GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/activity/#list-stargazers
 func (s *ActivityService) ListStargazers(ctx context.Context, owner, repo string, opts *ListOptions) ([]*Stargazer, *Response, error) {
+	var o *ActivityListStargazersOptions
+	if opts != nil {
+		o = &ActivityListStargazersOptions{ListOptions: *opts}
+	}
+	return s.ListStargazersWithOptions(ctx, owner, repo, o)
+}
+
+// ActivityListStargazersOptions specifies the optional parameters to the
+// ActivityService.ListStargazersWithOptions method.
+type ActivityListStargazersOptions struct {
+	// StarredAt specifies whether the star+json media type is requested, so
+	// that the StarredAt time of each Stargazer is populated. Otherwise, only
+	// the User of each Stargazer is. Default: true.
+	StarredAt *bool `url:"-"`
+
+	ListOptions
+}
+
+// ListStargazersWithOptions lists people who have starred the specified
+// repo, with or without the time they starred it, as set in opts.
+//
+// GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/activity/#list-stargazers
+func (s *ActivityService) ListStargazersWithOptions(ctx context.Context, owner, repo string, opts *ActivityListStargazersOptions) ([]*Stargazer, *Response, error) {
 	u := fmt.Sprintf("repos/%s/%s/stargazers", owner, repo)
 	u, err := addOptions(u, opts)
 	if err != nil {
@@ -38,6 +64,20 @@ func (s *ActivityService) ListStargazers(ctx context.Context, owner, repo string
 		return nil, nil, err
 	}
 
+	if opts != nil && opts.StarredAt != nil && !*opts.StarredAt {
+		var users []*User
+		resp, err := s.client.Do(ctx, req, &users)
+		if err != nil {
+			return nil, resp, err
+		}
+
+		stargazers := make([]*Stargazer, len(users))
+		for i, user := range users {
+			stargazers[i] = &Stargazer{User: user}
+		}
+		return stargazers, resp, nil
+	}
+
 	// TODO: remove custom Accept header when this API fully launches
 	req.Header.Set("Accept", mediaTypeStarringPreview)
 
@@ -61,12 +101,21 @@ type ActivityListStarredOptions struct {
 	// Default is "asc" when sort is "full_name", otherwise default is "desc".
 	Direction string `url:"direction,omitempty"`
 
+	// StarredAt specifies whether the star+json media type is requested, so
+	// that the StarredAt time of each StarredRepository is populated.
+	// Otherwise, only the Repository of each StarredRepository is.
+	// Default: true.
+	StarredAt *bool `url:"-"`
+
 	ListOptions
 }
 
 // ListStarred lists all the repos starred by a user. Passing the empty string
 // will list the starred repositories for the authenticated user.
 //
+// The star+json media type is requested, so the StarredAt time of each
+// StarredRepository is populated, unless opts.StarredAt is false.
+//
 // GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/activity/#list-repositories-starred-by-the-authenticated-user
 // GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/activity/#list-repositories-starred-by-a-user
 func (s *ActivityService) ListStarred(ctx context.Context, user string, opts *ActivityListStarredOptions) ([]*StarredRepository, *Response, error) {
@@ -86,6 +135,23 @@ func (s *ActivityService) ListStarred(ctx context.Context, user string, opts *Ac
 		return nil, nil, err
 	}
 
+	if opts != nil && opts.StarredAt != nil && !*opts.StarredAt {
+		// TODO: remove custom Accept header when this API fully launches
+		req.Header.Set("Accept", mediaTypeTopicsPreview)
+
+		var repos []*Repository
+		resp, err := s.client.Do(ctx, req, &repos)
+		if err != nil {
+			return nil, resp, err
+		}
+
+		starred := make([]*StarredRepository, len(repos))
+		for i, repo := range repos {
+			starred[i] = &StarredRepository{Repository: repo}
+		}
+		return starred, resp, nil
+	}
+
 	// TODO: remove custom Accept header when APIs fully launch
 	acceptHeaders := []string{mediaTypeStarringPreview, mediaTypeTopicsPreview}
 	req.Header.Set("Accept", strings.Join(acceptHeaders, ", "))
@@ -136,3 +202,108 @@ func (s *ActivityService) Unstar(ctx context.Context, owner, repo string) (*Resp
 	}
 	return s.client.Do(ctx, req, nil)
 }
+
+// starPageSize is the default page size of the star iterators, which is the
+// largest page size allowed by GitHub, to make as few requests as possible
+// for very popular repositories.
+const starPageSize = 100
+
+// StargazersIterator iterates over the stargazers of a repository, fetching
+// the pages of ActivityService.ListStargazersWithOptions as needed.
+type StargazersIterator struct {
+	pageIterator
+
+	stargazers []*Stargazer // remaining stargazers of the current page
+	stargazer  *Stargazer
+}
+
+// AllStargazers returns an iterator over the stargazers of the specified
+// repo. opts may be used to request the stargazers without their StarredAt
+// time, and to set the page size, which defaults to 100, and the page to
+// start from. No request is made until the first call to Next.
+//
+// GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/activity/#list-stargazers
+func (s *ActivityService) AllStargazers(ctx context.Context, owner, repo string, opts *ActivityListStargazersOptions) *StargazersIterator {
+	var o ActivityListStargazersOptions
+	if opts != nil {
+		o = *opts
+	}
+	if o.PerPage == 0 {
+		o.PerPage = starPageSize
+	}
+	it := &StargazersIterator{}
+	it.fetch = listPages(&o.ListOptions, func() (n int, resp *Response, err error) {
+		it.stargazers, resp, err = s.ListStargazersWithOptions(ctx, owner, repo, &o)
+		return len(it.stargazers), resp, err
+	})
+	return it
+}
+
+// Next advances the iterator to the next stargazer, which is then available
+// through Stargazer. It returns false when there are no more stargazers or
+// an error occurred, in which case it is returned by Err.
+func (it *StargazersIterator) Next() bool {
+	if !it.next() {
+		it.stargazer = nil
+		return false
+	}
+	it.stargazer, it.stargazers = it.stargazers[0], it.stargazers[1:]
+	return true
+}
+
+// Stargazer returns the current stargazer, or nil if Next has not been
+// called or returned false.
+func (it *StargazersIterator) Stargazer() *Stargazer {
+	return it.stargazer
+}
+
+// StarredRepositoriesIterator iterates over the repositories starred by a
+// user, fetching the pages of ActivityService.ListStarred as needed.
+type StarredRepositoriesIterator struct {
+	pageIterator
+
+	repos []*StarredRepository // remaining repositories of the current page
+	repo  *StarredRepository
+}
+
+// AllStarred returns an iterator over the repositories starred by a user.
+// Passing the empty string will iterate over the starred repositories of the
+// authenticated user. opts may be used as with ListStarred, and to set the
+// page size, which defaults to 100, and the page to start from. No request is
+// made until the first call to Next.
+//
+// GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/activity/#list-repositories-starred-by-the-authenticated-user
+// GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/activity/#list-repositories-starred-by-a-user
+func (s *ActivityService) AllStarred(ctx context.Context, user string, opts *ActivityListStarredOptions) *StarredRepositoriesIterator {
+	var o ActivityListStarredOptions
+	if opts != nil {
+		o = *opts
+	}
+	if o.PerPage == 0 {
+		o.PerPage = starPageSize
+	}
+	it := &StarredRepositoriesIterator{}
+	it.fetch = listPages(&o.ListOptions, func() (n int, resp *Response, err error) {
+		it.repos, resp, err = s.ListStarred(ctx, user, &o)
+		return len(it.repos), resp, err
+	})
+	return it
+}
+
+// Next advances the iterator to the next starred repository, which is then
+// available through Repository. It returns false when there are no more
+// repositories or an error occurred, in which case it is returned by Err.
+func (it *StarredRepositoriesIterator) Next() bool {
+	if !it.next() {
+		it.repo = nil
+		return false
+	}
+	it.repo, it.repos = it.repos[0], it.repos[1:]
+	return true
+}
+
+// Repository returns the current starred repository, or nil if Next has not
+// been called or returned false.
+func (it *StarredRepositoriesIterator) Repository() *StarredRepository {
+	return it.repo
+}
@@ -106,7 +106,7 @@ func TestActivityService_ListStarred_specifiedUser(t *testing.T) {
 		fmt.Fprint(w, `[{"starred_at":"2002-02-10T15:30:00Z","repo":{"id":2}}]`)
 	})
 
-	opt := &ActivityListStarredOptions{"created", "asc", ListOptions{Page: 2}}
+	opt := &ActivityListStarredOptions{"created", "asc", nil, ListOptions{Page: 2}}
 	ctx := context.Background()
 	repos, _, err := client.Activity.ListStarred(ctx, "u", opt)
 	if err != nil {
@@ -284,3 +284,168 @@ func TestActivityService_Unstar_invalidID(t *testing.T) {
 	_, err := client.Activity.Unstar(ctx, "%", "%")
 	testURLParseError(t, err)
 }
+
+func TestActivityService_AllStargazers(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/stargazers", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testHeader(t, r, "Accept", mediaTypeStarringPreview)
+		switch r.FormValue("page") {
+		case "":
+			testFormValues(t, r, values{"per_page": "100"})
+			w.Header().Set("Link", `<https://api.github.com/repos/o/r/stargazers?page=2>; rel="next"`)
+			fmt.Fprint(w, `[{"starred_at":"2002-02-10T15:30:00Z","user":{"id":1}},{"starred_at":"2002-02-11T15:30:00Z","user":{"id":2}}]`)
+		case "2":
+			testFormValues(t, r, values{"per_page": "100", "page": "2"})
+			fmt.Fprint(w, `[{"starred_at":"2002-02-12T15:30:00Z","user":{"id":3}}]`)
+		default:
+			t.Errorf("unexpected page %q", r.FormValue("page"))
+		}
+	})
+
+	ctx := context.Background()
+	it := client.Activity.AllStargazers(ctx, "o", "r", nil)
+	if got := it.Stargazer(); got != nil {
+		t.Errorf("StargazersIterator.Stargazer before Next = %v, want nil", got)
+	}
+
+	var got []*Stargazer
+	for it.Next() {
+		got = append(got, it.Stargazer())
+	}
+	if err := it.Err(); err != nil {
+		t.Errorf("StargazersIterator.Err returned %v", err)
+	}
+
+	want := []*Stargazer{
+		{StarredAt: &Timestamp{time.Date(2002, time.February, 10, 15, 30, 0, 0, time.UTC)}, User: &User{ID: Int64(1)}},
+		{StarredAt: &Timestamp{time.Date(2002, time.February, 11, 15, 30, 0, 0, time.UTC)}, User: &User{ID: Int64(2)}},
+		{StarredAt: &Timestamp{time.Date(2002, time.February, 12, 15, 30, 0, 0, time.UTC)}, User: &User{ID: Int64(3)}},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("StargazersIterator returned %+v, want %+v", got, want)
+	}
+	if it.Stargazer() != nil {
+		t.Errorf("StargazersIterator.Stargazer after end = %v, want nil", it.Stargazer())
+	}
+	if it.Response() == nil {
+		t.Error("StargazersIterator.Response returned nil")
+	}
+	if it.Next() {
+		t.Error("StargazersIterator.Next after end returned true")
+	}
+}
+
+func TestActivityService_AllStargazers_error(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/stargazers", func(w http.ResponseWriter, r *http.Request) {
+		testFormValues(t, r, values{"per_page": "10", "page": "3"})
+		http.Error(w, `{"message":"Not Found"}`, http.StatusNotFound)
+	})
+
+	ctx := context.Background()
+	it := client.Activity.AllStargazers(ctx, "o", "r", &ActivityListStargazersOptions{ListOptions: ListOptions{Page: 3, PerPage: 10}})
+	if it.Next() {
+		t.Error("StargazersIterator.Next returned true, want false")
+	}
+	if _, ok := it.Err().(*ErrorResponse); !ok {
+		t.Errorf("StargazersIterator.Err returned %v, want *ErrorResponse", it.Err())
+	}
+	if it.Next() {
+		t.Error("StargazersIterator.Next after error returned true")
+	}
+}
+
+func TestActivityService_AllStarred(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/user/starred", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testHeader(t, r, "Accept", strings.Join([]string{mediaTypeStarringPreview, mediaTypeTopicsPreview}, ", "))
+		switch r.FormValue("page") {
+		case "":
+			testFormValues(t, r, values{"sort": "created", "per_page": "100"})
+			w.Header().Set("Link", `<https://api.github.com/user/starred?page=2>; rel="next"`)
+			fmt.Fprint(w, `[{"starred_at":"2002-02-10T15:30:00Z","repo":{"id":1}}]`)
+		case "2":
+			testFormValues(t, r, values{"sort": "created", "per_page": "100", "page": "2"})
+			fmt.Fprint(w, `[{"starred_at":"2002-02-11T15:30:00Z","repo":{"id":2}}]`)
+		default:
+			t.Errorf("unexpected page %q", r.FormValue("page"))
+		}
+	})
+
+	ctx := context.Background()
+	it := client.Activity.AllStarred(ctx, "", &ActivityListStarredOptions{Sort: "created"})
+	var got []*StarredRepository
+	for it.Next() {
+		got = append(got, it.Repository())
+	}
+	if err := it.Err(); err != nil {
+		t.Errorf("StarredRepositoriesIterator.Err returned %v", err)
+	}
+
+	want := []*StarredRepository{
+		{StarredAt: &Timestamp{time.Date(2002, time.February, 10, 15, 30, 0, 0, time.UTC)}, Repository: &Repository{ID: Int64(1)}},
+		{StarredAt: &Timestamp{time.Date(2002, time.February, 11, 15, 30, 0, 0, time.UTC)}, Repository: &Repository{ID: Int64(2)}},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("StarredRepositoriesIterator returned %+v, want %+v", got, want)
+	}
+	if it.Response() == nil {
+		t.Error("StarredRepositoriesIterator.Response returned nil")
+	}
+}
+
+func TestActivityService_ListStargazersWithOptions_noStarredAt(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/stargazers", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testHeader(t, r, "Accept", mediaTypeV3)
+		testFormValues(t, r, values{"page": "2"})
+		fmt.Fprint(w, `[{"id":1}]`)
+	})
+
+	ctx := context.Background()
+	opts := &ActivityListStargazersOptions{StarredAt: Bool(false), ListOptions: ListOptions{Page: 2}}
+	stargazers, _, err := client.Activity.ListStargazersWithOptions(ctx, "o", "r", opts)
+	if err != nil {
+		t.Errorf("Activity.ListStargazersWithOptions returned error: %v", err)
+	}
+
+	want := []*Stargazer{{User: &User{ID: Int64(1)}}}
+	if !reflect.DeepEqual(stargazers, want) {
+		t.Errorf("Activity.ListStargazersWithOptions returned %+v, want %+v", stargazers, want)
+	}
+}
+
+func TestActivityService_ListStarred_noStarredAt(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/user/starred", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testHeader(t, r, "Accept", mediaTypeTopicsPreview)
+		testFormValues(t, r, values{"sort": "created"})
+		fmt.Fprint(w, `[{"id":1}]`)
+	})
+
+	ctx := context.Background()
+	opts := &ActivityListStarredOptions{Sort: "created", StarredAt: Bool(false)}
+	repos, _, err := client.Activity.ListStarred(ctx, "", opts)
+	if err != nil {
+		t.Errorf("Activity.ListStarred returned error: %v", err)
+	}
+
+	want := []*StarredRepository{{Repository: &Repository{ID: Int64(1)}}}
+	if !reflect.DeepEqual(repos, want) {
+		t.Errorf("Activity.ListStarred returned %+v, want %+v", repos, want)
+	}
+}
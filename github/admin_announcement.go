@@ -0,0 +1,59 @@
+// Copyright 2021 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import "context"
+
+// GetAnnouncementBanner gets the global announcement banner of a GitHub
+// Enterprise Server instance.
+//
+// GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/enterprise-admin/#get-the-global-announcement-banner
+func (s *AdminService) GetAnnouncementBanner(ctx context.Context) (*AnnouncementBanner, *Response, error) {
+	req, err := s.client.NewRequest("GET", "enterprise/announcement", nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	banner := new(AnnouncementBanner)
+	resp, err := s.client.Do(ctx, req, banner)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return banner, resp, nil
+}
+
+// SetAnnouncementBanner sets the global announcement banner of a GitHub
+// Enterprise Server instance.
+//
+// GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/enterprise-admin/#set-the-global-announcement-banner
+func (s *AdminService) SetAnnouncementBanner(ctx context.Context, banner *AnnouncementBanner) (*AnnouncementBanner, *Response, error) {
+	req, err := s.client.NewRequest("PATCH", "enterprise/announcement", banner)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	b := new(AnnouncementBanner)
+	resp, err := s.client.Do(ctx, req, b)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return b, resp, nil
+}
+
+// RemoveAnnouncementBanner removes the global announcement banner of a
+// GitHub Enterprise Server instance.
+//
+// GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/enterprise-admin/#remove-the-global-announcement-banner
+func (s *AdminService) RemoveAnnouncementBanner(ctx context.Context) (*Response, error) {
+	req, err := s.client.NewRequest("DELETE", "enterprise/announcement", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(ctx, req, nil)
+}
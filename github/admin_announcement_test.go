@@ -0,0 +1,103 @@
+// Copyright 2021 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+func TestAdminService_GetAnnouncementBanner(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/enterprise/announcement", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"announcement": "Upgrade on Saturday", "expires_at": `+referenceTimeStr+`}`)
+	})
+
+	ctx := context.Background()
+	banner, _, err := client.Admin.GetAnnouncementBanner(ctx)
+	if err != nil {
+		t.Errorf("Admin.GetAnnouncementBanner returned error: %v", err)
+	}
+
+	want := &AnnouncementBanner{
+		Announcement: String("Upgrade on Saturday"),
+		ExpiresAt:    &Timestamp{referenceTime},
+	}
+	if !reflect.DeepEqual(banner, want) {
+		t.Errorf("Admin.GetAnnouncementBanner returned %+v, want %+v", banner, want)
+	}
+
+	const methodName = "GetAnnouncementBanner"
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.Admin.GetAnnouncementBanner(ctx)
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
+
+func TestAdminService_SetAnnouncementBanner(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	input := &AnnouncementBanner{
+		Announcement:    String("Upgrade on Saturday"),
+		UserDismissible: Bool(true),
+	}
+
+	mux.HandleFunc("/enterprise/announcement", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PATCH")
+		testBody(t, r, `{"announcement":"Upgrade on Saturday","user_dismissible":true}`+"\n")
+		fmt.Fprint(w, `{"announcement": "Upgrade on Saturday", "user_dismissible": true}`)
+	})
+
+	ctx := context.Background()
+	banner, _, err := client.Admin.SetAnnouncementBanner(ctx, input)
+	if err != nil {
+		t.Errorf("Admin.SetAnnouncementBanner returned error: %v", err)
+	}
+
+	if !reflect.DeepEqual(banner, input) {
+		t.Errorf("Admin.SetAnnouncementBanner returned %+v, want %+v", banner, input)
+	}
+
+	const methodName = "SetAnnouncementBanner"
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.Admin.SetAnnouncementBanner(ctx, input)
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
+
+func TestAdminService_RemoveAnnouncementBanner(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/enterprise/announcement", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	ctx := context.Background()
+	_, err := client.Admin.RemoveAnnouncementBanner(ctx)
+	if err != nil {
+		t.Errorf("Admin.RemoveAnnouncementBanner returned error: %v", err)
+	}
+
+	const methodName = "RemoveAnnouncementBanner"
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		return client.Admin.RemoveAnnouncementBanner(ctx)
+	})
+}
@@ -41,50 +41,117 @@ type InstallationToken struct {
 	Repositories []*Repository            `json:"repositories,omitempty"`
 }
 
+// Expired reports whether the installation token has expired. It reports
+// false if the expiry of the token is unknown.
+func (t *InstallationToken) Expired() bool {
+	return t.ExpiresWithin(0)
+}
+
+// ExpiresWithin reports whether the installation token expires within d,
+// so that it should be renewed before it is used. It reports false if the
+// expiry of the token is unknown.
+func (t *InstallationToken) ExpiresWithin(d time.Duration) bool {
+	if t == nil || t.ExpiresAt == nil {
+		return false
+	}
+	return !time.Now().Add(d).Before(*t.ExpiresAt)
+}
+
+// ExpiresIn returns the time left until the installation token expires, which
+// is negative if it has already expired, or 0 if its expiry is unknown.
+func (t *InstallationToken) ExpiresIn() time.Duration {
+	if t == nil || t.ExpiresAt == nil {
+		return 0
+	}
+	return time.Until(*t.ExpiresAt)
+}
+
 // InstallationTokenOptions allow restricting a token's access to specific repositories.
 type InstallationTokenOptions struct {
 	// The IDs of the repositories that the installation token can access.
 	// Providing repository IDs restricts the access of an installation token to specific repositories.
 	RepositoryIDs []int64 `json:"repository_ids,omitempty"`
 
+	// The names of the repositories, without their owner, that the installation
+	// token can access. Providing repository names restricts the access of an
+	// installation token to specific repositories.
+	Repositories []string `json:"repositories,omitempty"`
+
 	// The permissions granted to the access token.
-	// The permissions object includes the permission names and their access type.
+	// The permissions object includes the permission names and their access type,
+	// one of PermissionRead, PermissionWrite or PermissionAdmin.
 	Permissions *InstallationPermissions `json:"permissions,omitempty"`
 }
 
+// PermissionLevel is the access type of a permission of an installation, as
+// set in the fields of InstallationPermissions.
+type PermissionLevel string
+
+// The access types of the permissions of an installation.
+const (
+	PermissionRead  PermissionLevel = "read"
+	PermissionWrite PermissionLevel = "write"
+	PermissionAdmin PermissionLevel = "admin"
+)
+
 // InstallationPermissions lists the repository and organization permissions for an installation.
 //
 // Permission names taken from:
 //   https://docs.github.com/en/free-pro-team@latest/rest/reference/apps/permissions/
 //   https://developer.github.com/enterprise/v3/apps/permissions/
 type InstallationPermissions struct {
-	Administration              *string `json:"administration,omitempty"`
-	Blocking                    *string `json:"blocking,omitempty"`
-	Checks                      *string `json:"checks,omitempty"`
-	Contents                    *string `json:"contents,omitempty"`
-	ContentReferences           *string `json:"content_references,omitempty"`
-	Deployments                 *string `json:"deployments,omitempty"`
-	Emails                      *string `json:"emails,omitempty"`
-	Followers                   *string `json:"followers,omitempty"`
-	Issues                      *string `json:"issues,omitempty"`
-	Metadata                    *string `json:"metadata,omitempty"`
-	Members                     *string `json:"members,omitempty"`
-	OrganizationAdministration  *string `json:"organization_administration,omitempty"`
-	OrganizationHooks           *string `json:"organization_hooks,omitempty"`
-	OrganizationPlan            *string `json:"organization_plan,omitempty"`
-	OrganizationPreReceiveHooks *string `json:"organization_pre_receive_hooks,omitempty"`
-	OrganizationProjects        *string `json:"organization_projects,omitempty"`
-	OrganizationUserBlocking    *string `json:"organization_user_blocking,omitempty"`
-	Packages                    *string `json:"packages,omitempty"`
-	Pages                       *string `json:"pages,omitempty"`
-	PullRequests                *string `json:"pull_requests,omitempty"`
-	RepositoryHooks             *string `json:"repository_hooks,omitempty"`
-	RepositoryProjects          *string `json:"repository_projects,omitempty"`
-	RepositoryPreReceiveHooks   *string `json:"repository_pre_receive_hooks,omitempty"`
-	SingleFile                  *string `json:"single_file,omitempty"`
-	Statuses                    *string `json:"statuses,omitempty"`
-	TeamDiscussions             *string `json:"team_discussions,omitempty"`
-	VulnerabilityAlerts         *string `json:"vulnerability_alerts,omitempty"`
+	Actions                                 *string `json:"actions,omitempty"`
+	Administration                          *string `json:"administration,omitempty"`
+	Blocking                                *string `json:"blocking,omitempty"`
+	Checks                                  *string `json:"checks,omitempty"`
+	Codespaces                              *string `json:"codespaces,omitempty"`
+	ContentReferences                       *string `json:"content_references,omitempty"`
+	Contents                                *string `json:"contents,omitempty"`
+	DependabotSecrets                       *string `json:"dependabot_secrets,omitempty"`
+	Deployments                             *string `json:"deployments,omitempty"`
+	EmailAddresses                          *string `json:"email_addresses,omitempty"`
+	Emails                                  *string `json:"emails,omitempty"`
+	Environments                            *string `json:"environments,omitempty"`
+	Followers                               *string `json:"followers,omitempty"`
+	GPGKeys                                 *string `json:"gpg_keys,omitempty"`
+	GitSSHKeys                              *string `json:"git_ssh_keys,omitempty"`
+	InteractionLimits                       *string `json:"interaction_limits,omitempty"`
+	Issues                                  *string `json:"issues,omitempty"`
+	Members                                 *string `json:"members,omitempty"`
+	MergeQueues                             *string `json:"merge_queues,omitempty"`
+	Metadata                                *string `json:"metadata,omitempty"`
+	OrganizationAdministration              *string `json:"organization_administration,omitempty"`
+	OrganizationAnnouncementBanners         *string `json:"organization_announcement_banners,omitempty"`
+	OrganizationCustomProperties            *string `json:"organization_custom_properties,omitempty"`
+	OrganizationCustomRoles                 *string `json:"organization_custom_roles,omitempty"`
+	OrganizationHooks                       *string `json:"organization_hooks,omitempty"`
+	OrganizationPackages                    *string `json:"organization_packages,omitempty"`
+	OrganizationPersonalAccessTokenRequests *string `json:"organization_personal_access_token_requests,omitempty"`
+	OrganizationPersonalAccessTokens        *string `json:"organization_personal_access_tokens,omitempty"`
+	OrganizationPlan                        *string `json:"organization_plan,omitempty"`
+	OrganizationPreReceiveHooks             *string `json:"organization_pre_receive_hooks,omitempty"`
+	OrganizationProjects                    *string `json:"organization_projects,omitempty"`
+	OrganizationSecrets                     *string `json:"organization_secrets,omitempty"`
+	OrganizationSelfHostedRunners           *string `json:"organization_self_hosted_runners,omitempty"`
+	OrganizationUserBlocking                *string `json:"organization_user_blocking,omitempty"`
+	Packages                                *string `json:"packages,omitempty"`
+	Pages                                   *string `json:"pages,omitempty"`
+	Profile                                 *string `json:"profile,omitempty"`
+	PullRequests                            *string `json:"pull_requests,omitempty"`
+	RepositoryAdvisories                    *string `json:"repository_advisories,omitempty"`
+	RepositoryCustomProperties              *string `json:"repository_custom_properties,omitempty"`
+	RepositoryHooks                         *string `json:"repository_hooks,omitempty"`
+	RepositoryPreReceiveHooks               *string `json:"repository_pre_receive_hooks,omitempty"`
+	RepositoryProjects                      *string `json:"repository_projects,omitempty"`
+	SecretScanningAlerts                    *string `json:"secret_scanning_alerts,omitempty"`
+	Secrets                                 *string `json:"secrets,omitempty"`
+	SecurityEvents                          *string `json:"security_events,omitempty"`
+	SingleFile                              *string `json:"single_file,omitempty"`
+	Starring                                *string `json:"starring,omitempty"`
+	Statuses                                *string `json:"statuses,omitempty"`
+	TeamDiscussions                         *string `json:"team_discussions,omitempty"`
+	VulnerabilityAlerts                     *string `json:"vulnerability_alerts,omitempty"`
+	Workflows                               *string `json:"workflows,omitempty"`
 }
 
 // Installation represents a GitHub Apps installation.
@@ -0,0 +1,43 @@
+// Copyright 2021 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"fmt"
+)
+
+// ListHookDeliveries lists deliveries of the webhook of the authenticated App.
+// The deliveries are paginated with cursors: set opts.Cursor to Response.Cursor
+// to get the next page.
+//
+// You must use a JWT to access this endpoint.
+//
+// GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/apps/#list-deliveries-for-an-app-webhook
+func (s *AppsService) ListHookDeliveries(ctx context.Context, opts *ListCursorOptions) ([]*HookDelivery, *Response, error) {
+	return listHookDeliveries(ctx, s.client, "app/hook/deliveries", opts)
+}
+
+// GetHookDelivery returns a delivery of the webhook of the authenticated App,
+// including its request and response.
+//
+// You must use a JWT to access this endpoint.
+//
+// GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/apps/#get-a-delivery-for-an-app-webhook
+func (s *AppsService) GetHookDelivery(ctx context.Context, deliveryID int64) (*HookDelivery, *Response, error) {
+	u := fmt.Sprintf("app/hook/deliveries/%v", deliveryID)
+	return getHookDelivery(ctx, s.client, u)
+}
+
+// RedeliverHookDelivery redelivers a delivery of the webhook of the authenticated App.
+//
+// You must use a JWT to access this endpoint.
+//
+// GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/apps/#redeliver-a-delivery-for-an-app-webhook
+func (s *AppsService) RedeliverHookDelivery(ctx context.Context, deliveryID int64) (*Response, error) {
+	u := fmt.Sprintf("app/hook/deliveries/%v/attempts", deliveryID)
+	return redeliverHookDelivery(ctx, s.client, u)
+}
@@ -0,0 +1,98 @@
+// Copyright 2021 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+func TestAppsService_ListHookDeliveries(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/app/hook/deliveries", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testFormValues(t, r, values{"cursor": "v1_12077215967"})
+		fmt.Fprint(w, `[{"id":1,"installation_id":3}, {"id":2}]`)
+	})
+
+	opts := &ListCursorOptions{Cursor: "v1_12077215967"}
+
+	ctx := context.Background()
+	deliveries, _, err := client.Apps.ListHookDeliveries(ctx, opts)
+	if err != nil {
+		t.Errorf("Apps.ListHookDeliveries returned error: %v", err)
+	}
+
+	want := []*HookDelivery{{ID: Int64(1), InstallationID: Int64(3)}, {ID: Int64(2)}}
+	if !reflect.DeepEqual(deliveries, want) {
+		t.Errorf("Apps.ListHookDeliveries returned %+v, want %+v", deliveries, want)
+	}
+
+	const methodName = "ListHookDeliveries"
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.Apps.ListHookDeliveries(ctx, opts)
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
+
+func TestAppsService_GetHookDelivery(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/app/hook/deliveries/2", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"id":2,"redelivery":true}`)
+	})
+
+	ctx := context.Background()
+	delivery, _, err := client.Apps.GetHookDelivery(ctx, 2)
+	if err != nil {
+		t.Errorf("Apps.GetHookDelivery returned error: %v", err)
+	}
+
+	want := &HookDelivery{ID: Int64(2), Redelivery: Bool(true)}
+	if !reflect.DeepEqual(delivery, want) {
+		t.Errorf("Apps.GetHookDelivery returned %+v, want %+v", delivery, want)
+	}
+
+	const methodName = "GetHookDelivery"
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.Apps.GetHookDelivery(ctx, 2)
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
+
+func TestAppsService_RedeliverHookDelivery(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/app/hook/deliveries/2/attempts", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		w.WriteHeader(http.StatusAccepted)
+	})
+
+	ctx := context.Background()
+	_, err := client.Apps.RedeliverHookDelivery(ctx, 2)
+	if err != nil {
+		t.Errorf("Apps.RedeliverHookDelivery returned error: %v", err)
+	}
+
+	const methodName = "RedeliverHookDelivery"
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		return client.Apps.RedeliverHookDelivery(ctx, 2)
+	})
+}
@@ -94,7 +94,9 @@ func (s *AppsService) RemoveRepository(ctx context.Context, instID, repoID int64
 	return s.client.Do(ctx, req, nil)
 }
 
-// RevokeInstallationToken revokes an installation token.
+// RevokeInstallationToken revokes an installation token. The client must be
+// authenticated with the installation token to revoke. Use
+// CredentialsService.Revoke to revoke leaked tokens of any kind.
 //
 // GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/apps/#revoke-an-installation-access-token
 func (s *AppsService) RevokeInstallationToken(ctx context.Context) (*Response, error) {
@@ -0,0 +1,159 @@
+// Copyright 2021 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"sort"
+	"strings"
+	"sync"
+)
+
+// InstallationCache caches the repositories of the installations of a
+// GitHub App, for apps installed by many accounts. It is kept up to date
+// with the installation and installation_repositories webhook events by
+// Update, which a WebHookDispatcher calls for its Installations.
+//
+// Repositories are identified by their full name, such as "o/r", which is
+// matched case-insensitively. The zero value is an empty cache ready to use.
+type InstallationCache struct {
+	mu sync.RWMutex
+	// repos are the full names of the repositories of each installation ID.
+	repos map[int64]map[string]bool
+	// installations are the installation IDs of the lower-cased full names
+	// of the repositories.
+	installations map[string]int64
+}
+
+// SetRepositories sets the repositories of an installation, e.g. as listed
+// by AppsService.ListRepos when the app starts.
+func (c *InstallationCache) SetRepositories(installationID int64, fullNames []string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.deleteInstallation(installationID)
+	c.addRepositories(installationID, fullNames)
+}
+
+// AddRepositories adds repositories to an installation.
+func (c *InstallationCache) AddRepositories(installationID int64, fullNames ...string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.addRepositories(installationID, fullNames)
+}
+
+// RemoveRepositories removes repositories from an installation.
+func (c *InstallationCache) RemoveRepositories(installationID int64, fullNames ...string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for _, name := range fullNames {
+		c.removeRepository(installationID, name)
+	}
+}
+
+// DeleteInstallation removes an installation and its repositories.
+func (c *InstallationCache) DeleteInstallation(installationID int64) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.deleteInstallation(installationID)
+}
+
+// Repositories returns the sorted full names of the repositories of an
+// installation, and whether the installation is in the cache.
+func (c *InstallationCache) Repositories(installationID int64) ([]string, bool) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	repos, ok := c.repos[installationID]
+	if !ok {
+		return nil, false
+	}
+	names := make([]string, 0, len(repos))
+	for name := range repos {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names, true
+}
+
+// Installation returns the ID of the installation of the repository of
+// the given full name, and whether the repository is in the cache.
+func (c *InstallationCache) Installation(fullName string) (int64, bool) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	id, ok := c.installations[strings.ToLower(fullName)]
+	return id, ok
+}
+
+// Update updates the cache with a webhook event, as returned by
+// ParseWebHook. The repositories of an installation are set when an
+// *InstallationEvent is "created", and the installation is removed when it
+// is "deleted". Repositories are added and removed by an
+// *InstallationRepositoriesEvent. Other events are ignored.
+func (c *InstallationCache) Update(event interface{}) {
+	switch e := event.(type) {
+	case *InstallationEvent:
+		id := e.GetInstallation().GetID()
+		switch e.GetAction() {
+		case "created":
+			c.SetRepositories(id, repositoryFullNames(e.Repositories))
+		case "deleted":
+			c.DeleteInstallation(id)
+		}
+	case *InstallationRepositoriesEvent:
+		id := e.GetInstallation().GetID()
+		c.AddRepositories(id, repositoryFullNames(e.RepositoriesAdded)...)
+		c.RemoveRepositories(id, repositoryFullNames(e.RepositoriesRemoved)...)
+	}
+}
+
+func (c *InstallationCache) addRepositories(installationID int64, fullNames []string) {
+	if c.repos == nil {
+		c.repos = map[int64]map[string]bool{}
+		c.installations = map[string]int64{}
+	}
+	repos, ok := c.repos[installationID]
+	if !ok {
+		repos = map[string]bool{}
+		c.repos[installationID] = repos
+	}
+	for _, name := range fullNames {
+		// A repository belongs to a single installation of an app, and
+		// may have been renamed with a different case.
+		if id, ok := c.installations[strings.ToLower(name)]; ok {
+			c.removeRepository(id, name)
+		}
+		repos[name] = true
+		c.installations[strings.ToLower(name)] = installationID
+	}
+}
+
+func (c *InstallationCache) removeRepository(installationID int64, fullName string) {
+	key := strings.ToLower(fullName)
+	for name := range c.repos[installationID] {
+		if strings.ToLower(name) == key {
+			delete(c.repos[installationID], name)
+		}
+	}
+	if id, ok := c.installations[key]; ok && id == installationID {
+		delete(c.installations, key)
+	}
+}
+
+func (c *InstallationCache) deleteInstallation(installationID int64) {
+	for name := range c.repos[installationID] {
+		c.removeRepository(installationID, name)
+	}
+	delete(c.repos, installationID)
+}
+
+// repositoryFullNames returns the full names of repos.
+func repositoryFullNames(repos []*Repository) []string {
+	names := make([]string, 0, len(repos))
+	for _, r := range repos {
+		if name := r.GetFullName(); name != "" {
+			names = append(names, name)
+		}
+	}
+	return names
+}
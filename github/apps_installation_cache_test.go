@@ -0,0 +1,75 @@
+// Copyright 2021 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestInstallationCache(t *testing.T) {
+	c := &InstallationCache{}
+	if _, ok := c.Repositories(1); ok {
+		t.Errorf("Repositories of empty cache returned ok")
+	}
+	if _, ok := c.Installation("o/r"); ok {
+		t.Errorf("Installation of empty cache returned ok")
+	}
+
+	c.SetRepositories(1, []string{"o/b", "o/a"})
+	c.AddRepositories(2, "p/c")
+	c.AddRepositories(2, "o/B") // Moved to installation 2, with a new case.
+	c.RemoveRepositories(2, "p/c")
+
+	if got, ok := c.Repositories(1); !ok || !reflect.DeepEqual(got, []string{"o/a"}) {
+		t.Errorf("Repositories(1) returned %v, %v, want [o/a], true", got, ok)
+	}
+	if got, ok := c.Repositories(2); !ok || !reflect.DeepEqual(got, []string{"o/B"}) {
+		t.Errorf("Repositories(2) returned %v, %v, want [o/B], true", got, ok)
+	}
+	if id, ok := c.Installation("O/b"); !ok || id != 2 {
+		t.Errorf("Installation(O/b) returned %v, %v, want 2, true", id, ok)
+	}
+
+	c.DeleteInstallation(2)
+	if _, ok := c.Repositories(2); ok {
+		t.Errorf("Repositories of deleted installation returned ok")
+	}
+	if _, ok := c.Installation("o/b"); ok {
+		t.Errorf("Installation of repository of deleted installation returned ok")
+	}
+}
+
+func TestInstallationCache_Update(t *testing.T) {
+	c := &InstallationCache{}
+	installation := &Installation{ID: Int64(1)}
+
+	c.Update(&InstallationEvent{
+		Action:       String("created"),
+		Installation: installation,
+		Repositories: []*Repository{{FullName: String("o/a")}, {FullName: String("o/b")}},
+	})
+	c.Update(&InstallationRepositoriesEvent{
+		Action:              String("added"),
+		Installation:        installation,
+		RepositoriesAdded:   []*Repository{{FullName: String("o/c")}},
+		RepositoriesRemoved: []*Repository{{FullName: String("o/a")}},
+	})
+	c.Update(&PushEvent{})
+
+	if got, ok := c.Repositories(1); !ok || !reflect.DeepEqual(got, []string{"o/b", "o/c"}) {
+		t.Errorf("Repositories(1) returned %v, %v, want [o/b o/c], true", got, ok)
+	}
+
+	c.Update(&InstallationEvent{Action: String("suspend"), Installation: installation})
+	if _, ok := c.Repositories(1); !ok {
+		t.Errorf("Repositories of suspended installation returned not ok")
+	}
+	c.Update(&InstallationEvent{Action: String("deleted"), Installation: installation})
+	if _, ok := c.Repositories(1); ok {
+		t.Errorf("Repositories of deleted installation returned ok")
+	}
+}
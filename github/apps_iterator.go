@@ -0,0 +1,327 @@
+// Copyright 2021 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sync"
+)
+
+// InstallationsIterator iterates over the installations of the
+// authenticated GitHub App, fetching the pages of
+// AppsService.ListInstallations as needed.
+type InstallationsIterator struct {
+	pageIterator
+
+	installations []*Installation // remaining installations of the current page
+	installation  *Installation
+}
+
+// AllInstallations returns an iterator over all the installations of the
+// authenticated GitHub App. No request is made until the first call to Next.
+//
+// GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/apps/#list-installations-for-the-authenticated-app
+func (s *AppsService) AllInstallations(ctx context.Context, opts *ListOptions) *InstallationsIterator {
+	var o ListOptions
+	if opts != nil {
+		o = *opts
+	}
+	if o.PerPage == 0 {
+		o.PerPage = 100
+	}
+	it := &InstallationsIterator{}
+	it.fetch = listPages(&o, func() (n int, resp *Response, err error) {
+		it.installations, resp, err = s.ListInstallations(ctx, &o)
+		return len(it.installations), resp, err
+	})
+	return it
+}
+
+// Next advances the iterator to the next installation, which is then
+// available through Installation. It returns false when there are no more
+// installations or an error occurred, in which case it is returned by Err.
+func (it *InstallationsIterator) Next() bool {
+	if !it.next() {
+		it.installation = nil
+		return false
+	}
+	it.installation, it.installations = it.installations[0], it.installations[1:]
+	return true
+}
+
+// Installation returns the current installation, or nil if Next has not been
+// called or returned false.
+func (it *InstallationsIterator) Installation() *Installation {
+	return it.installation
+}
+
+// InstallationReposOptions specifies the optional parameters to the
+// AppsService.AllInstallationRepos method.
+type InstallationReposOptions struct {
+	// ListOptions is used to paginate the installations and the
+	// repositories of each installation.
+	ListOptions
+
+	// Concurrency is the maximum number of installations whose repositories
+	// are listed concurrently. Default: 1.
+	Concurrency int
+
+	// InstallationClient returns the client used to list the repositories
+	// accessible to an installation, which must be authenticated as the
+	// installation. By default, an installation token is created with
+	// AppsService.CreateInstallationToken for each installation, and used
+	// by a client configured like the App's client, with the same
+	// http.Client and middleware. The App's client must then authenticate
+	// with a TokenSourceTransport, a BasicAuthTransport or a transport with a
+	// BaseTransport method such as ghauth.AppTransport, which is bypassed by
+	// the installation client. Otherwise, the iteration stops with an error,
+	// and InstallationClient must be set.
+	InstallationClient func(ctx context.Context, installation *Installation) (*Client, error)
+}
+
+// installationReposPage is a page of the repositories of an installation,
+// or the error which stopped the iteration.
+type installationReposPage struct {
+	installation *Installation
+	repos        []*Repository
+	resp         *Response
+	err          error
+}
+
+// InstallationReposIterator iterates over the repositories accessible to all
+// the installations of the authenticated GitHub App. It is used like the
+// other iterators, and must be closed with Close if the iteration is stopped
+// before Next returns false.
+//
+// The repositories of an installation are returned in order, but the
+// repositories of different installations are interleaved when listed
+// concurrently.
+type InstallationReposIterator struct {
+	start  func() // starts listing the repositories, on the first call to Next
+	pages  chan *installationReposPage
+	cancel context.CancelFunc
+
+	page         *installationReposPage // current page
+	repos        []*Repository          // remaining repositories of the current page
+	installation *Installation
+	repo         *Repository
+	resp         *Response
+	err          error
+}
+
+// AllInstallationRepos returns an iterator over the repositories accessible
+// to all the installations of the authenticated GitHub App, which lists the
+// installations with AllInstallations and the repositories of each
+// installation with AppsService.ListRepos, with up to opts.Concurrency
+// installations at a time. The iteration stops at the first error. No
+// request is made until the first call to Next.
+//
+// GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/apps/#list-repositories-accessible-to-the-app-installation
+func (s *AppsService) AllInstallationRepos(ctx context.Context, opts *InstallationReposOptions) *InstallationReposIterator {
+	var o InstallationReposOptions
+	if opts != nil {
+		o = *opts
+	}
+	if o.Concurrency < 1 {
+		o.Concurrency = 1
+	}
+	if o.InstallationClient == nil {
+		o.InstallationClient = s.installationClient
+	}
+
+	it := &InstallationReposIterator{}
+	it.start = func() {
+		ctx, cancel := context.WithCancel(ctx)
+		it.pages, it.cancel = make(chan *installationReposPage), cancel
+		go s.listInstallationRepos(ctx, &o, it.pages)
+	}
+	return it
+}
+
+// listInstallationRepos sends the pages of the repositories of all the
+// installations to pages, which it closes when done.
+func (s *AppsService) listInstallationRepos(ctx context.Context, opts *InstallationReposOptions, pages chan<- *installationReposPage) {
+	defer close(pages)
+	send := func(page *installationReposPage) bool {
+		select {
+		case pages <- page:
+			return true
+		case <-ctx.Done():
+			return false
+		}
+	}
+
+	var wg sync.WaitGroup
+	defer wg.Wait()
+	sem := make(chan struct{}, opts.Concurrency)
+	installations := s.AllInstallations(ctx, &opts.ListOptions)
+	for installations.Next() {
+		select {
+		case sem <- struct{}{}:
+		case <-ctx.Done():
+			return
+		}
+		wg.Add(1)
+		go func(installation *Installation) {
+			defer func() {
+				<-sem
+				wg.Done()
+			}()
+			client, err := opts.InstallationClient(ctx, installation)
+			if err != nil {
+				send(&installationReposPage{installation: installation, err: err})
+				return
+			}
+			listOpts := ListOptions{PerPage: opts.PerPage}
+			if listOpts.PerPage == 0 {
+				listOpts.PerPage = 100
+			}
+			for {
+				repos, resp, err := client.Apps.ListRepos(ctx, &listOpts)
+				if !send(&installationReposPage{installation: installation, repos: repos, resp: resp, err: err}) {
+					return
+				}
+				if err != nil || resp.NextPage == 0 || len(repos) == 0 {
+					return
+				}
+				listOpts.Page = resp.NextPage
+			}
+		}(installations.Installation())
+	}
+	if err := installations.Err(); err != nil {
+		send(&installationReposPage{resp: installations.Response(), err: err})
+	}
+}
+
+// installationClient returns a client authenticated with a new installation
+// token of installation, which is otherwise configured like the client of s:
+// it has the same URLs, user agent, rate limit behavior, decoding options and
+// middleware, such as the cache and retry middleware, and sends its requests
+// with the same http.Client, over the transport of the client of s without
+// its authentication.
+func (s *AppsService) installationClient(ctx context.Context, installation *Installation) (*Client, error) {
+	httpClient := *s.client.client
+	transport, err := unauthenticatedTransport(httpClient.Transport)
+	if err != nil {
+		return nil, err
+	}
+	httpClient.Transport = transport
+	token, _, err := s.CreateInstallationToken(ctx, installation.GetID(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	client := NewClient(&httpClient)
+	client.BaseURL = s.client.BaseURL
+	client.UploadURL = s.client.UploadURL
+	client.UserAgent = s.client.UserAgent
+	client.rateLimitBehavior = s.client.rateLimitBehavior
+	client.rawBodyRetention = s.client.rawBodyRetention
+	client.strictDecoding = s.client.strictDecoding
+	client.schemaDriftHandler = s.client.schemaDriftHandler
+
+	// The token is set first, so that the cache middleware keys the
+	// responses by installation.
+	client.Use(tokenSourceMiddleware(StaticTokenSource(&OAuthToken{AccessToken: token.GetToken()})))
+	s.client.middlewareMu.Lock()
+	client.Use(s.client.middleware...)
+	s.client.middlewareMu.Unlock()
+	return client, nil
+}
+
+// unauthenticatedTransport returns the transport under t if t authenticates
+// the requests, which is the case of TokenSourceTransport, BasicAuthTransport
+// and of the transports with a BaseTransport method such as
+// ghauth.AppTransport, t itself if it is an *http.Transport, or
+// http.DefaultTransport if t is nil. It returns an error for the other
+// transports, which may authenticate the requests as the App.
+func unauthenticatedTransport(t http.RoundTripper) (http.RoundTripper, error) {
+	switch t := t.(type) {
+	case nil:
+		return http.DefaultTransport, nil
+	case *http.Transport:
+		return t, nil
+	case *TokenSourceTransport:
+		return t.transport(), nil
+	case *BasicAuthTransport:
+		return t.transport(), nil
+	case interface{ BaseTransport() http.RoundTripper }:
+		return t.BaseTransport(), nil
+	}
+	return nil, fmt.Errorf("cannot create an installation client over transport %T: set InstallationReposOptions.InstallationClient", t)
+}
+
+// Next advances the iterator to the next repository, which is then
+// available through Repository, along with its installation through
+// Installation. It returns false when there are no more repositories or an
+// error occurred, in which case it is returned by Err.
+func (it *InstallationReposIterator) Next() bool {
+	if it.pages == nil {
+		it.start()
+	}
+	for len(it.repos) == 0 {
+		if it.err != nil {
+			it.installation, it.repo = nil, nil
+			return false
+		}
+		page, ok := <-it.pages
+		if !ok {
+			it.installation, it.repo = nil, nil
+			it.cancel()
+			return false
+		}
+		it.resp = page.resp
+		if page.err != nil {
+			it.err = page.err
+			it.cancel()
+			continue
+		}
+		it.page, it.repos = page, page.repos
+	}
+
+	it.installation = it.page.installation
+	it.repo, it.repos = it.repos[0], it.repos[1:]
+	return true
+}
+
+// Repository returns the current repository, or nil if Next has not been
+// called or returned false.
+func (it *InstallationReposIterator) Repository() *Repository {
+	return it.repo
+}
+
+// Installation returns the installation of the current repository, or nil if
+// Next has not been called or returned false.
+func (it *InstallationReposIterator) Installation() *Installation {
+	return it.installation
+}
+
+// Err returns the error, if any, that stopped the iteration.
+func (it *InstallationReposIterator) Err() error {
+	return it.err
+}
+
+// Response returns the response of the last page fetched by the iterator.
+func (it *InstallationReposIterator) Response() *Response {
+	return it.resp
+}
+
+// Close stops the iteration, and the requests in progress. It must be called
+// if the iteration is stopped before Next returns false.
+func (it *InstallationReposIterator) Close() {
+	if it.pages == nil {
+		// The iteration has not started: it ends without any request.
+		it.pages = make(chan *installationReposPage)
+		close(it.pages)
+		it.cancel = func() {}
+		return
+	}
+	it.cancel()
+	for range it.pages {
+	}
+}
@@ -0,0 +1,317 @@
+// Copyright 2021 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"reflect"
+	"sort"
+	"testing"
+)
+
+func TestAppsService_AllInstallations(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/app/installations", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		switch r.FormValue("page") {
+		case "":
+			testFormValues(t, r, values{"per_page": "2"})
+			w.Header().Set("Link", `<https://api.github.com/app/installations?page=2&per_page=2>; rel="next"`)
+			fmt.Fprint(w, `[{"id":1},{"id":2}]`)
+		case "2":
+			testFormValues(t, r, values{"page": "2", "per_page": "2"})
+			fmt.Fprint(w, `[{"id":3}]`)
+		default:
+			t.Errorf("Unexpected page %q", r.FormValue("page"))
+		}
+	})
+
+	ctx := context.Background()
+	it := client.Apps.AllInstallations(ctx, &ListOptions{PerPage: 2})
+	var got []int64
+	for it.Next() {
+		got = append(got, it.Installation().GetID())
+	}
+	if err := it.Err(); err != nil {
+		t.Errorf("Apps.AllInstallations returned error: %v", err)
+	}
+	if want := []int64{1, 2, 3}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Apps.AllInstallations returned %v, want %v", got, want)
+	}
+	if it.Installation() != nil || it.Next() || it.Response() == nil {
+		t.Errorf("Apps.AllInstallations iterator continues after end")
+	}
+}
+
+func TestAppsService_AllInstallations_error(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/app/installations", func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, "Bad Request", http.StatusBadRequest)
+	})
+
+	it := client.Apps.AllInstallations(context.Background(), nil)
+	if it.Next() || it.Err() == nil {
+		t.Errorf("Apps.AllInstallations returned no error")
+	}
+}
+
+func TestAppsService_AllInstallationRepos(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/app/installations", func(w http.ResponseWriter, r *http.Request) {
+		testFormValues(t, r, values{"per_page": "2"})
+		fmt.Fprint(w, `[{"id":1},{"id":2}]`)
+	})
+	for _, id := range []int{1, 2} {
+		id := id
+		mux.HandleFunc(fmt.Sprintf("/app/installations/%v/access_tokens", id), func(w http.ResponseWriter, r *http.Request) {
+			testMethod(t, r, "POST")
+			fmt.Fprintf(w, `{"token":"t%v"}`, id)
+		})
+	}
+	mux.HandleFunc("/installation/repositories", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		auth := r.Header.Get("Authorization")
+		switch {
+		case auth == "token t1" && r.FormValue("page") == "":
+			w.Header().Set("Link", `<https://api.github.com/installation/repositories?page=2&per_page=2>; rel="next"`)
+			fmt.Fprint(w, `{"repositories":[{"full_name":"o1/a"},{"full_name":"o1/b"}]}`)
+		case auth == "token t1" && r.FormValue("page") == "2":
+			fmt.Fprint(w, `{"repositories":[{"full_name":"o1/c"}]}`)
+		case auth == "token t2":
+			fmt.Fprint(w, `{"repositories":[{"full_name":"o2/a"}]}`)
+		default:
+			t.Errorf("Unexpected request with Authorization %q and page %q", auth, r.FormValue("page"))
+		}
+	})
+
+	ctx := context.Background()
+	it := client.Apps.AllInstallationRepos(ctx, &InstallationReposOptions{ListOptions: ListOptions{PerPage: 2}, Concurrency: 2})
+	defer it.Close()
+	var got []string
+	for it.Next() {
+		got = append(got, fmt.Sprintf("%v:%v", it.Installation().GetID(), it.Repository().GetFullName()))
+	}
+	if err := it.Err(); err != nil {
+		t.Errorf("Apps.AllInstallationRepos returned error: %v", err)
+	}
+	sort.Strings(got)
+	if want := []string{"1:o1/a", "1:o1/b", "1:o1/c", "2:o2/a"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Apps.AllInstallationRepos returned %v, want %v", got, want)
+	}
+	if it.Repository() != nil || it.Installation() != nil || it.Next() {
+		t.Errorf("Apps.AllInstallationRepos iterator continues after end")
+	}
+}
+
+// testAppTransport authenticates the requests as a GitHub App over base,
+// like ghauth.AppTransport.
+type testAppTransport struct {
+	base     http.RoundTripper
+	requests int // requests sent over base, authenticated or not
+}
+
+func (t *testAppTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	req2 := req.Clone(req.Context())
+	req2.Header.Set("Authorization", "Bearer jwt")
+	return t.BaseTransport().RoundTrip(req2)
+}
+
+func (t *testAppTransport) BaseTransport() http.RoundTripper {
+	return roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+		t.requests++
+		return t.base.RoundTrip(req)
+	})
+}
+
+type roundTripperFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripperFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
+func TestAppsService_AllInstallationRepos_defaultInstallationClient(t *testing.T) {
+	tr := &testAppTransport{base: http.DefaultTransport}
+	client, mux, teardown := setupWithOptions(t,
+		WithHTTPClient(&http.Client{Transport: tr}),
+		WithUserAgent("ua"),
+		WithMiddleware(func(next RequestHandler) RequestHandler {
+			return func(req *http.Request) (*http.Response, error) {
+				req.Header.Set("X-Middleware", "1")
+				return next(req)
+			}
+		}),
+	)
+	defer teardown()
+
+	mux.HandleFunc("/app/installations", func(w http.ResponseWriter, r *http.Request) {
+		testHeader(t, r, "Authorization", "Bearer jwt")
+		fmt.Fprint(w, `[{"id":1}]`)
+	})
+	mux.HandleFunc("/app/installations/1/access_tokens", func(w http.ResponseWriter, r *http.Request) {
+		testHeader(t, r, "Authorization", "Bearer jwt")
+		fmt.Fprint(w, `{"token":"t1"}`)
+	})
+	mux.HandleFunc("/installation/repositories", func(w http.ResponseWriter, r *http.Request) {
+		testHeader(t, r, "Authorization", "token t1")
+		testHeader(t, r, "User-Agent", "ua")
+		testHeader(t, r, "X-Middleware", "1")
+		fmt.Fprint(w, `{"repositories":[{"name":"r"}]}`)
+	})
+
+	it := client.Apps.AllInstallationRepos(context.Background(), nil)
+	defer it.Close()
+	var got []string
+	for it.Next() {
+		got = append(got, it.Repository().GetName())
+	}
+	if err := it.Err(); err != nil {
+		t.Errorf("Apps.AllInstallationRepos returned error: %v", err)
+	}
+	if want := []string{"r"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Apps.AllInstallationRepos returned %v, want %v", got, want)
+	}
+	if tr.requests != 3 {
+		t.Errorf("Made %v requests over the App's transport, want 3", tr.requests)
+	}
+}
+
+func TestUnauthenticatedTransport(t *testing.T) {
+	base := &http.Transport{}
+	tests := []struct {
+		in, want http.RoundTripper
+	}{
+		{nil, http.DefaultTransport},
+		{base, base},
+		{&TokenSourceTransport{Transport: base}, base},
+		{&BasicAuthTransport{Transport: base}, base},
+		{&TokenSourceTransport{}, http.DefaultTransport},
+	}
+	for _, tt := range tests {
+		if got, err := unauthenticatedTransport(tt.in); err != nil || got != tt.want {
+			t.Errorf("unauthenticatedTransport(%T) returned %T, %v, want %T", tt.in, got, err, tt.want)
+		}
+	}
+
+	// The other transports may authenticate the requests as the App.
+	unknown := roundTripperFunc(http.DefaultTransport.RoundTrip)
+	if _, err := unauthenticatedTransport(unknown); err == nil {
+		t.Errorf("unauthenticatedTransport(%T) returned no error", unknown)
+	}
+}
+
+func TestAppsService_AllInstallationRepos_unknownTransport(t *testing.T) {
+	client, mux, teardown := setupWithOptions(t, WithHTTPClient(&http.Client{Transport: roundTripperFunc(http.DefaultTransport.RoundTrip)}))
+	defer teardown()
+
+	mux.HandleFunc("/app/installations", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `[{"id":1}]`)
+	})
+	mux.HandleFunc("/app/installations/1/access_tokens", func(w http.ResponseWriter, r *http.Request) {
+		t.Error("Unexpected installation token request.")
+	})
+
+	it := client.Apps.AllInstallationRepos(context.Background(), nil)
+	defer it.Close()
+	if it.Next() || it.Err() == nil {
+		t.Errorf("Apps.AllInstallationRepos over an unknown transport returned no error")
+	}
+}
+
+func TestAppsService_AllInstallationRepos_lazy(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/app/installations", func(w http.ResponseWriter, r *http.Request) {
+		t.Error("Unexpected request.")
+	})
+
+	it := client.Apps.AllInstallationRepos(context.Background(), nil)
+	it.Close()
+	if it.Next() {
+		t.Errorf("Apps.AllInstallationRepos iterator Next returned true after Close")
+	}
+}
+
+func TestAppsService_AllInstallationRepos_installationClient(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/app/installations", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `[{"id":1},{"id":2},{"id":3}]`)
+	})
+	mux.HandleFunc("/installation/repositories", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"repositories":[{"name":"r"}]}`)
+	})
+
+	opts := &InstallationReposOptions{
+		InstallationClient: func(ctx context.Context, installation *Installation) (*Client, error) {
+			if installation.GetID() == 2 {
+				return nil, fmt.Errorf("no client")
+			}
+			return client, nil
+		},
+	}
+
+	it := client.Apps.AllInstallationRepos(context.Background(), opts)
+	defer it.Close()
+	var got []int64
+	for it.Next() {
+		got = append(got, it.Installation().GetID())
+	}
+	if err := it.Err(); err == nil || err.Error() != "no client" {
+		t.Errorf("Apps.AllInstallationRepos returned error %v, want no client", err)
+	}
+	if want := []int64{1}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Apps.AllInstallationRepos returned repositories of %v, want %v", got, want)
+	}
+}
+
+func TestAppsService_AllInstallationRepos_errors(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/app/installations", func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, "Bad Request", http.StatusBadRequest)
+	})
+
+	it := client.Apps.AllInstallationRepos(context.Background(), nil)
+	defer it.Close()
+	if it.Next() || it.Err() == nil || it.Response() == nil {
+		t.Errorf("Apps.AllInstallationRepos returned no error and response")
+	}
+}
+
+func TestInstallationReposIterator_Close(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/app/installations", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `[{"id":1},{"id":2},{"id":3}]`)
+	})
+	mux.HandleFunc("/installation/repositories", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"repositories":[{"name":"r"}]}`)
+	})
+
+	opts := &InstallationReposOptions{
+		Concurrency: 3,
+		InstallationClient: func(ctx context.Context, installation *Installation) (*Client, error) {
+			return client, nil
+		},
+	}
+	it := client.Apps.AllInstallationRepos(context.Background(), opts)
+	if !it.Next() {
+		t.Fatalf("Apps.AllInstallationRepos returned no repository: %v", it.Err())
+	}
+	it.Close()
+}
@@ -14,25 +14,69 @@ const (
 	mediaTypeAppManifestPreview = "application/vnd.github.fury-preview+json"
 )
 
+// AppManifest represents a GitHub App manifest, which is posted, as the
+// "manifest" form field, to https://github.com/settings/apps/new (or to
+// https://github.com/organizations/{org}/settings/apps/new) to start the
+// App manifest flow.
+//
+// GitHub docs: https://docs.github.com/en/free-pro-team@latest/developers/apps/creating-a-github-app-from-a-manifest
+type AppManifest struct {
+	// The name of the GitHub App.
+	Name *string `json:"name,omitempty"`
+	// Required. The homepage of the GitHub App.
+	URL *string `json:"url,omitempty"`
+	// The configuration of the GitHub App's webhook.
+	HookAttributes *AppManifestHookAttributes `json:"hook_attributes,omitempty"`
+	// The full URL to redirect to after the person installs the GitHub App.
+	RedirectURL *string `json:"redirect_url,omitempty"`
+	// A description of the GitHub App.
+	Description *string `json:"description,omitempty"`
+	// Set to true when the GitHub App is available to the public or false when
+	// it is only accessible to the owner of the app.
+	Public *bool `json:"public,omitempty"`
+	// The list of events the GitHub App subscribes to.
+	DefaultEvents []string `json:"default_events,omitempty"`
+	// The set of permissions needed by the GitHub App.
+	DefaultPermissions *InstallationPermissions `json:"default_permissions,omitempty"`
+}
+
+// AppManifestHookAttributes represents the configuration of the webhook of a
+// GitHub App in its manifest.
+type AppManifestHookAttributes struct {
+	// Required. The URL of the server that will receive the webhook POST
+	// requests.
+	URL *string `json:"url,omitempty"`
+	// Deliver event details when this hook is triggered. Default: true.
+	Active *bool `json:"active,omitempty"`
+}
+
 // AppConfig describes the configuration of a GitHub App.
 type AppConfig struct {
-	ID            *int64     `json:"id,omitempty"`
-	NodeID        *string    `json:"node_id,omitempty"`
-	Owner         *User      `json:"owner,omitempty"`
-	Name          *string    `json:"name,omitempty"`
-	Description   *string    `json:"description,omitempty"`
-	ExternalURL   *string    `json:"external_url,omitempty"`
-	HTMLURL       *string    `json:"html_url,omitempty"`
-	CreatedAt     *Timestamp `json:"created_at,omitempty"`
-	UpdatedAt     *Timestamp `json:"updated_at,omitempty"`
-	ClientID      *string    `json:"client_id,omitempty"`
-	ClientSecret  *string    `json:"client_secret,omitempty"`
-	WebhookSecret *string    `json:"webhook_secret,omitempty"`
-	PEM           *string    `json:"pem,omitempty"`
+	ID            *int64                   `json:"id,omitempty"`
+	Slug          *string                  `json:"slug,omitempty"`
+	NodeID        *string                  `json:"node_id,omitempty"`
+	Owner         *User                    `json:"owner,omitempty"`
+	Name          *string                  `json:"name,omitempty"`
+	Description   *string                  `json:"description,omitempty"`
+	ExternalURL   *string                  `json:"external_url,omitempty"`
+	HTMLURL       *string                  `json:"html_url,omitempty"`
+	CreatedAt     *Timestamp               `json:"created_at,omitempty"`
+	UpdatedAt     *Timestamp               `json:"updated_at,omitempty"`
+	Permissions   *InstallationPermissions `json:"permissions,omitempty"`
+	Events        []string                 `json:"events,omitempty"`
+	ClientID      *string                  `json:"client_id,omitempty"`
+	ClientSecret  *string                  `json:"client_secret,omitempty"`
+	WebhookSecret *string                  `json:"webhook_secret,omitempty"`
+	PEM           *string                  `json:"pem,omitempty"`
 }
 
-// CompleteAppManifest completes the App manifest handshake flow for the given
-// code.
+// CompleteAppManifest completes the App manifest handshake flow by exchanging
+// the temporary code, which GitHub passes to the redirect URL of the manifest,
+// for the configuration of the newly created GitHub App. The returned
+// AppConfig includes the ClientSecret, the WebhookSecret and the private key
+// of the App, as PEM, which are only available from this response.
+//
+// The code must be exchanged within one hour of the App creation.
 //
 // GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/apps/#create-a-github-app-from-a-manifest
 func (s *AppsService) CompleteAppManifest(ctx context.Context, code string) (*AppConfig, *Response, error) {
@@ -66,3 +66,87 @@ func TestGetConfig(t *testing.T) {
 		return resp, err
 	})
 }
+
+func TestAppsService_CompleteAppManifest(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/app-manifests/code/conversions", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testHeader(t, r, "Accept", mediaTypeAppManifestPreview)
+		fmt.Fprint(w, `{
+			"id": 1,
+			"slug": "s",
+			"name": "n",
+			"permissions": {"contents": "read"},
+			"events": ["push"],
+			"client_id": "a",
+			"client_secret": "b",
+			"webhook_secret": "c",
+			"pem": "key"
+		}`)
+	})
+
+	ctx := context.Background()
+	cfg, _, err := client.Apps.CompleteAppManifest(ctx, "code")
+	if err != nil {
+		t.Errorf("Apps.CompleteAppManifest returned error: %v", err)
+	}
+
+	want := &AppConfig{
+		ID:            Int64(1),
+		Slug:          String("s"),
+		Name:          String("n"),
+		Permissions:   &InstallationPermissions{Contents: String("read")},
+		Events:        []string{"push"},
+		ClientID:      String("a"),
+		ClientSecret:  String("b"),
+		WebhookSecret: String("c"),
+		PEM:           String("key"),
+	}
+	if !reflect.DeepEqual(cfg, want) {
+		t.Errorf("Apps.CompleteAppManifest returned %+v, want %+v", cfg, want)
+	}
+
+	const methodName = "CompleteAppManifest"
+	testBadOptions(t, methodName, func() (err error) {
+		_, _, err = client.Apps.CompleteAppManifest(ctx, "\n")
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.Apps.CompleteAppManifest(ctx, "code")
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
+
+func TestAppManifest_Marshal(t *testing.T) {
+	testJSONMarshal(t, &AppManifest{}, "{}")
+
+	u := &AppManifest{
+		Name:               String("n"),
+		URL:                String("https://example.com"),
+		HookAttributes:     &AppManifestHookAttributes{URL: String("https://example.com/hook"), Active: Bool(true)},
+		RedirectURL:        String("https://example.com/redirect"),
+		Description:        String("d"),
+		Public:             Bool(true),
+		DefaultEvents:      []string{"push"},
+		DefaultPermissions: &InstallationPermissions{Contents: String("read")},
+	}
+
+	want := `{
+		"name": "n",
+		"url": "https://example.com",
+		"hook_attributes": {"url": "https://example.com/hook", "active": true},
+		"redirect_url": "https://example.com/redirect",
+		"description": "d",
+		"public": true,
+		"default_events": ["push"],
+		"default_permissions": {"contents": "read"}
+	}`
+
+	testJSONMarshal(t, u, want)
+}
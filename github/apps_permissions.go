@@ -0,0 +1,149 @@
+// Copyright 2021 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+// permissionRanks orders the access types of the permissions, so that a
+// higher access type satisfies the lower ones.
+var permissionRanks = map[PermissionLevel]int{
+	PermissionRead:  1,
+	PermissionWrite: 2,
+	PermissionAdmin: 3,
+}
+
+// MissingPermission is a permission which is required but not granted, or
+// granted with a lower access type.
+type MissingPermission struct {
+	// Name is the name of the permission, such as "contents".
+	Name string
+	// Required is the required access type, and Granted the granted access
+	// type, which is empty if the permission is not granted.
+	Required PermissionLevel
+	Granted  PermissionLevel
+	// Pending is true if the App requests the required access type, so that
+	// the permission is granted once the owner of the installation accepts
+	// the updated permissions of the App.
+	Pending bool
+}
+
+func (p *MissingPermission) String() string {
+	if p.Granted == "" {
+		return fmt.Sprintf("%v:%v", p.Name, p.Required)
+	}
+	return fmt.Sprintf("%v:%v (granted %v)", p.Name, p.Required, p.Granted)
+}
+
+// MissingPermissionsError occurs when an installation lacks some permissions
+// required by a GitHub App, as returned by CheckInstallationPermissions.
+type MissingPermissionsError struct {
+	InstallationID int64
+	Missing        []*MissingPermission
+
+	// UpdatePending is true if all the missing permissions are Pending, so
+	// that the owner of the installation only needs to accept the updated
+	// permissions of the App.
+	UpdatePending bool
+}
+
+func (e *MissingPermissionsError) Error() string {
+	missing := make([]string, len(e.Missing))
+	for i, p := range e.Missing {
+		missing[i] = p.String()
+	}
+	msg := fmt.Sprintf("installation %v is missing permissions %v", e.InstallationID, strings.Join(missing, ", "))
+	if e.UpdatePending {
+		msg += "; the installation owner must accept the updated permissions of the app"
+	}
+	return msg
+}
+
+// MissingPermissions returns the permissions of required which are not
+// granted by granted, with a sufficient access type. An access type
+// satisfies itself and the lower access types, in the order PermissionRead,
+// PermissionWrite and PermissionAdmin. Other access types only satisfy
+// themselves.
+func MissingPermissions(granted, required *InstallationPermissions) []*MissingPermission {
+	if required == nil {
+		return nil
+	}
+	if granted == nil {
+		granted = &InstallationPermissions{}
+	}
+
+	var missing []*MissingPermission
+	g, r := reflect.ValueOf(granted).Elem(), reflect.ValueOf(required).Elem()
+	for i := 0; i < r.NumField(); i++ {
+		req, ok := r.Field(i).Interface().(*string)
+		if !ok || req == nil || *req == "" {
+			continue
+		}
+		grant := g.Field(i).Interface().(*string)
+		if grant != nil && permissionSatisfies(PermissionLevel(*grant), PermissionLevel(*req)) {
+			continue
+		}
+		p := &MissingPermission{
+			Name:     strings.Split(r.Type().Field(i).Tag.Get("json"), ",")[0],
+			Required: PermissionLevel(*req),
+		}
+		if grant != nil {
+			p.Granted = PermissionLevel(*grant)
+		}
+		missing = append(missing, p)
+	}
+	return missing
+}
+
+// CheckInstallationPermissions checks that installation grants the required
+// permissions. If some are missing, it returns a *MissingPermissionsError,
+// so that the App can give an actionable error instead of failing with 403
+// Forbidden responses.
+//
+// If app is not nil, it is the App of the installation, as returned by
+// AppsService.Get, and the missing permissions requested by the App are
+// reported as Pending.
+func CheckInstallationPermissions(installation *Installation, app *App, required *InstallationPermissions) error {
+	missing := MissingPermissions(installation.GetPermissions(), required)
+	if len(missing) == 0 {
+		return nil
+	}
+
+	updatePending := app != nil
+	if app != nil {
+		notRequested := MissingPermissions(app.GetPermissions(), required)
+		for _, p := range missing {
+			p.Pending = true
+			for _, n := range notRequested {
+				if n.Name == p.Name {
+					p.Pending = false
+					updatePending = false
+					break
+				}
+			}
+		}
+	}
+
+	return &MissingPermissionsError{
+		InstallationID: installation.GetID(),
+		Missing:        missing,
+		UpdatePending:  updatePending,
+	}
+}
+
+// permissionSatisfies reports whether the granted access type satisfies the
+// required one.
+func permissionSatisfies(granted, required PermissionLevel) bool {
+	if granted == required {
+		return true
+	}
+	g, gok := permissionRanks[granted]
+	r, rok := permissionRanks[required]
+	return gok && rok && g >= r
+}
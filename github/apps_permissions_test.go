@@ -0,0 +1,113 @@
+// Copyright 2021 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestMissingPermissions(t *testing.T) {
+	granted := &InstallationPermissions{
+		Contents:       String("read"),
+		Issues:         String("write"),
+		Administration: String("admin"),
+		SingleFile:     String("custom"),
+	}
+	required := &InstallationPermissions{
+		Contents:       String("write"),
+		Issues:         String("read"),
+		Administration: String("write"),
+		Workflows:      String("write"),
+		SingleFile:     String("read"),
+		Metadata:       String(""),
+	}
+
+	got := MissingPermissions(granted, required)
+	want := []*MissingPermission{
+		{Name: "contents", Required: PermissionWrite, Granted: PermissionRead},
+		{Name: "single_file", Required: PermissionRead, Granted: "custom"},
+		{Name: "workflows", Required: PermissionWrite},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("MissingPermissions returned %v, want %v", got, want)
+	}
+
+	if got := MissingPermissions(granted, nil); got != nil {
+		t.Errorf("MissingPermissions with nil required returned %v, want nil", got)
+	}
+	if got := MissingPermissions(nil, &InstallationPermissions{Issues: String("read")}); len(got) != 1 {
+		t.Errorf("MissingPermissions with nil granted returned %v, want issues", got)
+	}
+}
+
+func TestCheckInstallationPermissions(t *testing.T) {
+	installation := &Installation{
+		ID:          Int64(1),
+		Permissions: &InstallationPermissions{Contents: String("read")},
+	}
+	required := &InstallationPermissions{
+		Contents: String("write"),
+		Issues:   String("read"),
+	}
+
+	if err := CheckInstallationPermissions(installation, nil, &InstallationPermissions{Contents: String("read")}); err != nil {
+		t.Errorf("CheckInstallationPermissions returned error: %v", err)
+	}
+
+	for _, tt := range []struct {
+		name string
+		app  *App
+		want *MissingPermissionsError
+		msg  string
+	}{
+		{
+			name: "unknown app",
+			want: &MissingPermissionsError{
+				InstallationID: 1,
+				Missing: []*MissingPermission{
+					{Name: "contents", Required: PermissionWrite, Granted: PermissionRead},
+					{Name: "issues", Required: PermissionRead},
+				},
+			},
+			msg: "installation 1 is missing permissions contents:write (granted read), issues:read",
+		},
+		{
+			name: "app requests some permissions",
+			app:  &App{Permissions: &InstallationPermissions{Contents: String("write")}},
+			want: &MissingPermissionsError{
+				InstallationID: 1,
+				Missing: []*MissingPermission{
+					{Name: "contents", Required: PermissionWrite, Granted: PermissionRead, Pending: true},
+					{Name: "issues", Required: PermissionRead},
+				},
+			},
+			msg: "installation 1 is missing permissions contents:write (granted read), issues:read",
+		},
+		{
+			name: "app requests all permissions",
+			app:  &App{Permissions: &InstallationPermissions{Contents: String("write"), Issues: String("write")}},
+			want: &MissingPermissionsError{
+				InstallationID: 1,
+				Missing: []*MissingPermission{
+					{Name: "contents", Required: PermissionWrite, Granted: PermissionRead, Pending: true},
+					{Name: "issues", Required: PermissionRead, Pending: true},
+				},
+				UpdatePending: true,
+			},
+			msg: "installation 1 is missing permissions contents:write (granted read), issues:read; the installation owner must accept the updated permissions of the app",
+		},
+	} {
+		err := CheckInstallationPermissions(installation, tt.app, required)
+		if !reflect.DeepEqual(err, tt.want) {
+			t.Errorf("%v: CheckInstallationPermissions returned %#v, want %#v", tt.name, err, tt.want)
+			continue
+		}
+		if got := err.Error(); got != tt.msg {
+			t.Errorf("%v: Error returned %q, want %q", tt.name, got, tt.msg)
+		}
+	}
+}
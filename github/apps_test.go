@@ -414,6 +414,100 @@ func TestAppsService_CreateInstallationTokenWithOptions(t *testing.T) {
 	}
 }
 
+func TestAppsService_CreateInstallationTokenWithOptions_repositories(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	opts := &InstallationTokenOptions{
+		Repositories: []string{"r"},
+		Permissions: &InstallationPermissions{
+			Contents:  String(string(PermissionWrite)),
+			Workflows: String(string(PermissionWrite)),
+		},
+	}
+
+	mux.HandleFunc("/app/installations/1/access_tokens", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testBody(t, r, `{"repositories":["r"],"permissions":{"contents":"write","workflows":"write"}}`+"\n")
+		fmt.Fprint(w, `{"token":"t","repositories":[{"id":1,"name":"r"}]}`)
+	})
+
+	ctx := context.Background()
+	token, _, err := client.Apps.CreateInstallationToken(ctx, 1, opts)
+	if err != nil {
+		t.Errorf("Apps.CreateInstallationToken returned error: %v", err)
+	}
+
+	want := &InstallationToken{Token: String("t"), Repositories: []*Repository{{ID: Int64(1), Name: String("r")}}}
+	if !reflect.DeepEqual(token, want) {
+		t.Errorf("Apps.CreateInstallationToken returned %+v, want %+v", token, want)
+	}
+}
+
+func TestInstallationToken_expiry(t *testing.T) {
+	past := time.Now().Add(-time.Minute)
+	future := time.Now().Add(time.Hour)
+
+	for _, tt := range []struct {
+		name        string
+		token       *InstallationToken
+		wantExpired bool
+		wantWithin  bool
+	}{
+		{"nil token", nil, false, false},
+		{"unknown expiry", &InstallationToken{}, false, false},
+		{"expired", &InstallationToken{ExpiresAt: &past}, true, true},
+		{"expires in an hour", &InstallationToken{ExpiresAt: &future}, false, false},
+	} {
+		if got := tt.token.Expired(); got != tt.wantExpired {
+			t.Errorf("%v: Expired returned %v, want %v", tt.name, got, tt.wantExpired)
+		}
+		if got := tt.token.ExpiresWithin(5 * time.Minute); got != tt.wantWithin {
+			t.Errorf("%v: ExpiresWithin(5m) returned %v, want %v", tt.name, got, tt.wantWithin)
+		}
+	}
+
+	token := &InstallationToken{ExpiresAt: &future}
+	if !token.ExpiresWithin(2 * time.Hour) {
+		t.Errorf("ExpiresWithin(2h) returned false, want true")
+	}
+	if d := token.ExpiresIn(); d <= 59*time.Minute || d > time.Hour {
+		t.Errorf("ExpiresIn returned %v, want about 1h", d)
+	}
+	if d := (&InstallationToken{ExpiresAt: &past}).ExpiresIn(); d >= 0 {
+		t.Errorf("ExpiresIn returned %v for expired token, want negative", d)
+	}
+	if d := (&InstallationToken{}).ExpiresIn(); d != 0 {
+		t.Errorf("ExpiresIn returned %v for unknown expiry, want 0", d)
+	}
+}
+
+func TestInstallationTokenOptions_Marshal(t *testing.T) {
+	testJSONMarshal(t, &InstallationTokenOptions{}, "{}")
+
+	u := &InstallationTokenOptions{
+		RepositoryIDs: []int64{1},
+		Repositories:  []string{"r"},
+		Permissions: &InstallationPermissions{
+			Actions:        String("read"),
+			Administration: String("admin"),
+			Contents:       String("write"),
+		},
+	}
+
+	want := `{
+		"repository_ids": [1],
+		"repositories": ["r"],
+		"permissions": {
+			"actions": "read",
+			"administration": "admin",
+			"contents": "write"
+		}
+	}`
+
+	testJSONMarshal(t, u, want)
+}
+
 func TestAppsService_CreateAttachement(t *testing.T) {
 	client, mux, _, teardown := setup()
 	defer teardown()
@@ -0,0 +1,293 @@
+// Copyright 2021 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// mediaTypeInToto is the payload type of the DSSE envelopes holding in-toto
+// statements.
+const mediaTypeInToto = "application/vnd.in-toto+json"
+
+// Attestation represents an artifact attestation, such as a build
+// provenance, associated with a repository.
+type Attestation struct {
+	// Bundle is the Sigstore bundle of the attestation, which holds a signed
+	// in-toto statement about the artifact. Verify can be used to check it.
+	Bundle       json.RawMessage `json:"bundle,omitempty"`
+	RepositoryID *int64          `json:"repository_id,omitempty"`
+}
+
+// AttestationsResponse represents a list of attestations.
+type AttestationsResponse struct {
+	Attestations []*Attestation `json:"attestations,omitempty"`
+}
+
+// listAttestations lists the attestations at u, which is the attestations
+// endpoint of a repository or an organization.
+func listAttestations(ctx context.Context, client *Client, u string, opts *ListOptions) (*AttestationsResponse, *Response, error) {
+	u, err := addOptions(u, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	attestations := new(AttestationsResponse)
+	resp, err := client.Do(ctx, req, attestations)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return attestations, resp, nil
+}
+
+// InTotoStatement represents an in-toto statement, which is the content of
+// an attestation.
+type InTotoStatement struct {
+	Type          *string          `json:"_type,omitempty"`
+	Subject       []*InTotoSubject `json:"subject,omitempty"`
+	PredicateType *string          `json:"predicateType,omitempty"`
+	// Predicate depends on PredicateType, such as a SLSA provenance.
+	Predicate json.RawMessage `json:"predicate,omitempty"`
+}
+
+// InTotoSubject represents an artifact an in-toto statement is about.
+type InTotoSubject struct {
+	Name *string `json:"name,omitempty"`
+	// Digest maps digest algorithms, such as "sha256", to hex-encoded
+	// digests of the artifact.
+	Digest map[string]string `json:"digest,omitempty"`
+}
+
+// AttestationVerifyOptions specifies the parameters to the
+// Attestation.Verify method.
+type AttestationVerifyOptions struct {
+	// Roots are the trusted root certificates, such as the Fulcio roots of
+	// the Sigstore public good instance or of GitHub. They are required: the
+	// signing certificate of the bundle is never trusted on its own.
+	Roots *x509.CertPool
+
+	// Intermediates are the intermediate certificates, in addition to those
+	// of the bundle.
+	Intermediates *x509.CertPool
+
+	// CurrentTime is the time at which the signing certificate must be
+	// valid when the bundle has no transparency log entry. By default, the
+	// integration time of the transparency log entry is used, as signing
+	// certificates are only valid for a few minutes, or else the current
+	// time.
+	CurrentTime time.Time
+}
+
+// sigstoreBundle is the part of a Sigstore bundle used to verify an
+// attestation.
+type sigstoreBundle struct {
+	VerificationMaterial struct {
+		Certificate *struct {
+			RawBytes string `json:"rawBytes"`
+		} `json:"certificate"`
+		X509CertificateChain *struct {
+			Certificates []struct {
+				RawBytes string `json:"rawBytes"`
+			} `json:"certificates"`
+		} `json:"x509CertificateChain"`
+		TlogEntries []struct {
+			IntegratedTime string `json:"integratedTime"`
+		} `json:"tlogEntries"`
+	} `json:"verificationMaterial"`
+	DSSEEnvelope *struct {
+		Payload     string `json:"payload"`
+		PayloadType string `json:"payloadType"`
+		Signatures  []struct {
+			Sig string `json:"sig"`
+		} `json:"signatures"`
+	} `json:"dsseEnvelope"`
+}
+
+// Verify checks that the attestation is signed by the certificate of its
+// bundle, that this certificate chains up to opts.Roots, and
+// that the attestation is about the artifact with the given digest, such as
+// "sha256:e3b0c4...". It returns the verified in-toto statement.
+//
+// Verify does not check the inclusion of the attestation in the
+// transparency log, nor the identity in the signing certificate, such as
+// the workflow which built the artifact: callers must check the latter
+// against their own policy. Full Sigstore verification requires a Sigstore
+// library, such as github.com/sigstore/sigstore-go, which can be given the
+// raw Bundle.
+func (a *Attestation) Verify(subjectDigest string, opts *AttestationVerifyOptions) (*InTotoStatement, error) {
+	if opts == nil || opts.Roots == nil {
+		return nil, errors.New("attestation verification requires trusted Roots")
+	}
+
+	var bundle sigstoreBundle
+	if err := json.Unmarshal(a.Bundle, &bundle); err != nil {
+		return nil, fmt.Errorf("invalid attestation bundle: %v", err)
+	}
+	envelope := bundle.DSSEEnvelope
+	if envelope == nil || len(envelope.Signatures) == 0 {
+		return nil, errors.New("attestation bundle has no signed DSSE envelope")
+	}
+	if envelope.PayloadType != mediaTypeInToto {
+		return nil, fmt.Errorf("unsupported attestation payload type %q", envelope.PayloadType)
+	}
+	payload, err := base64.StdEncoding.DecodeString(envelope.Payload)
+	if err != nil {
+		return nil, fmt.Errorf("invalid attestation payload: %v", err)
+	}
+
+	certs, err := bundle.certificates()
+	if err != nil {
+		return nil, err
+	}
+	leaf := certs[0]
+
+	verifyOpts := x509.VerifyOptions{
+		Roots:         opts.Roots,
+		Intermediates: x509.NewCertPool(),
+		CurrentTime:   opts.CurrentTime,
+		KeyUsages:     []x509.ExtKeyUsage{x509.ExtKeyUsageCodeSigning},
+	}
+	if opts.Intermediates != nil {
+		verifyOpts.Intermediates = opts.Intermediates.Clone()
+	}
+	for _, cert := range certs[1:] {
+		verifyOpts.Intermediates.AddCert(cert)
+	}
+	if entries := bundle.VerificationMaterial.TlogEntries; verifyOpts.CurrentTime.IsZero() && len(entries) > 0 {
+		sec, err := strconv.ParseInt(entries[0].IntegratedTime, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid transparency log integration time: %v", err)
+		}
+		verifyOpts.CurrentTime = time.Unix(sec, 0)
+	}
+	if _, err := leaf.Verify(verifyOpts); err != nil {
+		return nil, fmt.Errorf("untrusted attestation signing certificate: %v", err)
+	}
+
+	algorithm, err := signatureAlgorithm(leaf)
+	if err != nil {
+		return nil, err
+	}
+	signed := dssePAE(envelope.PayloadType, payload)
+	verified := false
+	for _, s := range envelope.Signatures {
+		sig, err := base64.StdEncoding.DecodeString(s.Sig)
+		if err == nil && leaf.CheckSignature(algorithm, signed, sig) == nil {
+			verified = true
+			break
+		}
+	}
+	if !verified {
+		return nil, errors.New("attestation signature does not match its signing certificate")
+	}
+
+	statement := new(InTotoStatement)
+	if err := json.Unmarshal(payload, statement); err != nil {
+		return nil, fmt.Errorf("invalid in-toto statement: %v", err)
+	}
+	if !statement.hasSubject(subjectDigest) {
+		return nil, fmt.Errorf("attestation is not about artifact %v", subjectDigest)
+	}
+	return statement, nil
+}
+
+// certificates returns the signing certificate of the bundle, followed by
+// the rest of its chain if any.
+func (b *sigstoreBundle) certificates() ([]*x509.Certificate, error) {
+	var raw []string
+	material := b.VerificationMaterial
+	switch {
+	case material.Certificate != nil:
+		raw = append(raw, material.Certificate.RawBytes)
+	case material.X509CertificateChain != nil:
+		for _, c := range material.X509CertificateChain.Certificates {
+			raw = append(raw, c.RawBytes)
+		}
+	}
+	if len(raw) == 0 {
+		return nil, errors.New("attestation bundle has no signing certificate")
+	}
+
+	var certs []*x509.Certificate
+	for _, r := range raw {
+		der, err := base64.StdEncoding.DecodeString(r)
+		if err != nil {
+			return nil, fmt.Errorf("invalid attestation certificate: %v", err)
+		}
+		cert, err := x509.ParseCertificate(der)
+		if err != nil {
+			return nil, fmt.Errorf("invalid attestation certificate: %v", err)
+		}
+		certs = append(certs, cert)
+	}
+	return certs, nil
+}
+
+// signatureAlgorithm returns the algorithm of the signatures made with the
+// key of cert, as done by Sigstore.
+func signatureAlgorithm(cert *x509.Certificate) (x509.SignatureAlgorithm, error) {
+	switch key := cert.PublicKey.(type) {
+	case *ecdsa.PublicKey:
+		switch key.Curve {
+		case elliptic.P256():
+			return x509.ECDSAWithSHA256, nil
+		case elliptic.P384():
+			return x509.ECDSAWithSHA384, nil
+		case elliptic.P521():
+			return x509.ECDSAWithSHA512, nil
+		}
+	case *rsa.PublicKey:
+		return x509.SHA256WithRSA, nil
+	case ed25519.PublicKey:
+		return x509.PureEd25519, nil
+	}
+	return x509.UnknownSignatureAlgorithm, fmt.Errorf("unsupported attestation signing key type %T", cert.PublicKey)
+}
+
+// dssePAE returns the pre-authentication encoding of a DSSE payload, which
+// is what its signatures are computed over.
+func dssePAE(payloadType string, payload []byte) []byte {
+	return []byte(fmt.Sprintf("DSSEv1 %d %s %d %s", len(payloadType), payloadType, len(payload), payload))
+}
+
+// hasSubject reports whether the statement is about the artifact with the
+// given digest, in the "algorithm:hex" form.
+func (s *InTotoStatement) hasSubject(digest string) bool {
+	i := strings.Index(digest, ":")
+	if i < 0 {
+		return false
+	}
+	algorithm, value := strings.ToLower(digest[:i]), digest[i+1:]
+	want, err := hex.DecodeString(value)
+	if err != nil {
+		return false
+	}
+	for _, subject := range s.Subject {
+		if got, err := hex.DecodeString(subject.Digest[algorithm]); err == nil && len(got) > 0 && string(got) == string(want) {
+			return true
+		}
+	}
+	return false
+}
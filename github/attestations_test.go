@@ -0,0 +1,160 @@
+// Copyright 2021 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/base64"
+	"encoding/json"
+	"math/big"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+)
+
+// newTestAttestation returns an attestation about the artifact with the
+// given digest, signed by a certificate issued at signedAt by a new root,
+// or by itself if selfSigned, and a pool holding this root.
+func newTestAttestation(t *testing.T, digest string, signedAt time.Time, selfSigned bool) (*Attestation, *x509.CertPool) {
+	t.Helper()
+
+	newCert := func(template, parent *x509.Certificate, parentKey crypto.Signer) (*x509.Certificate, *ecdsa.PrivateKey) {
+		key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if parent == nil {
+			parent, parentKey = template, key
+		}
+		der, err := x509.CreateCertificate(rand.Reader, template, parent, &key.PublicKey, parentKey)
+		if err != nil {
+			t.Fatal(err)
+		}
+		cert, err := x509.ParseCertificate(der)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return cert, key
+	}
+
+	root, rootKey := newCert(&x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "root"},
+		NotBefore:             signedAt.Add(-time.Hour),
+		NotAfter:              signedAt.Add(time.Hour),
+		IsCA:                  true,
+		BasicConstraintsValid: true,
+		KeyUsage:              x509.KeyUsageCertSign,
+	}, nil, nil)
+	leafTemplate := &x509.Certificate{
+		SerialNumber: big.NewInt(2),
+		NotBefore:    signedAt,
+		NotAfter:     signedAt.Add(10 * time.Minute),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageCodeSigning},
+	}
+	leaf, leafKey := newCert(leafTemplate, root, rootKey)
+	if selfSigned {
+		leaf, leafKey = newCert(leafTemplate, nil, nil)
+	}
+
+	parts := strings.SplitN(digest, ":", 2)
+	statement, _ := json.Marshal(&InTotoStatement{
+		Type:          String("https://in-toto.io/Statement/v1"),
+		Subject:       []*InTotoSubject{{Name: String("artifact"), Digest: map[string]string{parts[0]: parts[1]}}},
+		PredicateType: String("https://slsa.dev/provenance/v1"),
+		Predicate:     json.RawMessage(`{"buildDefinition":{}}`),
+	})
+	hash := sha256.Sum256(dssePAE(mediaTypeInToto, statement))
+	sig, err := ecdsa.SignASN1(rand.Reader, leafKey, hash[:])
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	bundle := map[string]interface{}{
+		"mediaType": "application/vnd.dev.sigstore.bundle.v0.3+json",
+		"verificationMaterial": map[string]interface{}{
+			"certificate": map[string]string{"rawBytes": base64.StdEncoding.EncodeToString(leaf.Raw)},
+			"tlogEntries": []map[string]string{{"integratedTime": strconv.FormatInt(signedAt.Add(time.Minute).Unix(), 10)}},
+		},
+		"dsseEnvelope": map[string]interface{}{
+			"payload":     base64.StdEncoding.EncodeToString(statement),
+			"payloadType": mediaTypeInToto,
+			"signatures":  []map[string]string{{"sig": base64.StdEncoding.EncodeToString(sig)}},
+		},
+	}
+	raw, _ := json.Marshal(bundle)
+
+	roots := x509.NewCertPool()
+	roots.AddCert(root)
+	return &Attestation{Bundle: raw, RepositoryID: Int64(1)}, roots
+}
+
+func TestAttestation_Verify(t *testing.T) {
+	const digest = "sha256:e3b0c44298fc1c149afbf4c8996fb92427ae41e4649b934ca495991b7852b855"
+	signedAt := time.Now().Add(-24 * time.Hour).Truncate(time.Second)
+	a, roots := newTestAttestation(t, digest, signedAt, false)
+
+	statement, err := a.Verify(digest, &AttestationVerifyOptions{Roots: roots})
+	if err != nil {
+		t.Fatalf("Attestation.Verify returned error: %v", err)
+	}
+	if got, want := statement.GetPredicateType(), "https://slsa.dev/provenance/v1"; got != want {
+		t.Errorf("Attestation.Verify returned predicate type %q, want %q", got, want)
+	}
+
+	if _, err := a.Verify(strings.ToUpper(digest), &AttestationVerifyOptions{Roots: roots}); err != nil {
+		t.Errorf("Attestation.Verify with upper case digest returned error: %v", err)
+	}
+	if _, err := a.Verify(digest, nil); err == nil {
+		t.Error("Attestation.Verify without roots returned nil error")
+	}
+
+	if _, err := a.Verify("sha256:00", &AttestationVerifyOptions{Roots: roots}); err == nil {
+		t.Error("Attestation.Verify with another digest returned nil error")
+	}
+	if _, err := a.Verify(digest, &AttestationVerifyOptions{Roots: x509.NewCertPool()}); err == nil {
+		t.Error("Attestation.Verify with untrusted roots returned nil error")
+	}
+	if _, err := a.Verify(digest, &AttestationVerifyOptions{Roots: roots, CurrentTime: signedAt.Add(time.Hour)}); err == nil {
+		t.Error("Attestation.Verify with expired certificate returned nil error")
+	}
+
+	var bundle map[string]interface{}
+	json.Unmarshal(a.Bundle, &bundle)
+	envelope := bundle["dsseEnvelope"].(map[string]interface{})
+	envelope["payload"] = base64.StdEncoding.EncodeToString([]byte(`{"subject":[{"digest":{"sha256":"00"}}]}`))
+	tampered, _ := json.Marshal(bundle)
+	if _, err := (&Attestation{Bundle: tampered}).Verify("sha256:00", &AttestationVerifyOptions{Roots: roots}); err == nil {
+		t.Error("Attestation.Verify with tampered payload returned nil error")
+	}
+
+	for _, b := range []string{``, `{}`, `{"dsseEnvelope":{"payloadType":"x","signatures":[{}]}}`} {
+		if _, err := (&Attestation{Bundle: json.RawMessage(b)}).Verify(digest, &AttestationVerifyOptions{Roots: roots}); err == nil {
+			t.Errorf("Attestation.Verify(%q) returned nil error", b)
+		}
+	}
+}
+
+func TestAttestation_Verify_selfSigned(t *testing.T) {
+	const digest = "sha256:e3b0c44298fc1c149afbf4c8996fb92427ae41e4649b934ca495991b7852b855"
+	a, roots := newTestAttestation(t, digest, time.Now().Add(-time.Hour).Truncate(time.Second), true)
+
+	// The bundle is validly signed by its own certificate, which must not
+	// be trusted.
+	for _, opts := range []*AttestationVerifyOptions{nil, {}, {Roots: roots}} {
+		if _, err := a.Verify(digest, opts); err == nil {
+			t.Errorf("Attestation.Verify of self-signed bundle with %+v returned nil error", opts)
+		}
+	}
+}
@@ -67,9 +67,13 @@ type Authorization struct {
 	UpdatedAt      *Timestamp        `json:"updated_at,omitempty"`
 	CreatedAt      *Timestamp        `json:"created_at,omitempty"`
 	Fingerprint    *string           `json:"fingerprint,omitempty"`
+	ExpiresAt      *Timestamp        `json:"expires_at,omitempty"`
 
-	// User is only populated by the Check and Reset methods.
-	User *User `json:"user,omitempty"`
+	// User and Installation are only populated by the Check and Reset
+	// methods. Installation is only populated for the user-to-server tokens
+	// of GitHub Apps.
+	User         *User         `json:"user,omitempty"`
+	Installation *Installation `json:"installation,omitempty"`
 }
 
 func (a Authorization) String() string {
@@ -166,6 +170,20 @@ func (s *AuthorizationsService) Check(ctx context.Context, clientID, accessToken
 	return a, resp, nil
 }
 
+// IsValid reports whether an OAuth token is valid for a specific app, using
+// Check, without the details of the token.
+//
+// Note that this operation requires the use of BasicAuth, but where the
+// username is the OAuth application clientID, and the password is its
+// clientSecret.
+//
+// GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/apps/#check-a-token
+func (s *AuthorizationsService) IsValid(ctx context.Context, clientID, accessToken string) (bool, *Response, error) {
+	_, resp, err := s.Check(ctx, clientID, accessToken)
+	valid, err := parseBoolResponse(err)
+	return valid, resp, err
+}
+
 // Reset is used to reset a valid OAuth token without end user involvement.
 // Applications must save the "token" property in the response, because changes
 // take effect immediately.
@@ -8,8 +8,10 @@ package github
 import (
 	"context"
 	"fmt"
+	"io/ioutil"
 	"net/http"
 	"reflect"
+	"strings"
 	"testing"
 )
 
@@ -50,6 +52,76 @@ func TestAuthorizationsService_Check(t *testing.T) {
 	})
 }
 
+func TestAuthorizationsService_Check_userToServerToken(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/applications/id/token", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		fmt.Fprint(w, `{
+			"id": 1,
+			"token_last_eight": "12345678",
+			"expires_at": `+referenceTimeStr+`,
+			"user": {"login": "u"},
+			"installation": {"repository_selection": "selected", "permissions": {"contents": "read"}}
+		}`)
+	})
+
+	ctx := context.Background()
+	got, _, err := client.Authorizations.Check(ctx, "id", "a")
+	if err != nil {
+		t.Errorf("Authorizations.Check returned error: %v", err)
+	}
+
+	want := &Authorization{
+		ID:             Int64(1),
+		TokenLastEight: String("12345678"),
+		ExpiresAt:      &Timestamp{referenceTime},
+		User:           &User{Login: String("u")},
+		Installation: &Installation{
+			RepositorySelection: String("selected"),
+			Permissions:         &InstallationPermissions{Contents: String("read")},
+		},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Authorizations.Check returned auth %+v, want %+v", got, want)
+	}
+}
+
+func TestAuthorizationsService_IsValid(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/applications/id/token", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		body, _ := ioutil.ReadAll(r.Body)
+		switch strings.TrimSpace(string(body)) {
+		case `{"access_token":"valid"}`:
+			fmt.Fprint(w, `{"id":1}`)
+		case `{"access_token":"revoked"}`:
+			http.Error(w, `{"message":"Not Found"}`, http.StatusNotFound)
+		default:
+			http.Error(w, `{"message":"Bad Request"}`, http.StatusBadRequest)
+		}
+	})
+
+	ctx := context.Background()
+	for _, tt := range []struct {
+		token     string
+		wantValid bool
+		wantErr   bool
+	}{
+		{"valid", true, false},
+		{"revoked", false, false},
+		{"bad", false, true},
+	} {
+		valid, _, err := client.Authorizations.IsValid(ctx, "id", tt.token)
+		if valid != tt.wantValid || (err != nil) != tt.wantErr {
+			t.Errorf("Authorizations.IsValid(%q) returned %v, %v, want %v and error %v", tt.token, valid, err, tt.wantValid, tt.wantErr)
+		}
+	}
+}
+
 func TestAuthorizationsService_Reset(t *testing.T) {
 	client, mux, _, teardown := setup()
 	defer teardown()
@@ -0,0 +1,596 @@
+// Copyright 2021 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/http/httputil"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	headerFromCache = "X-From-Cache"
+
+	defaultRetryMinBackoff = time.Second
+	defaultRetryMaxBackoff = 30 * time.Second
+)
+
+// RateLimitBehavior is the behavior of a Client when the rate limit of a
+// request is known to be exceeded, as set with WithRateLimitBehavior.
+type RateLimitBehavior int
+
+const (
+	// RateLimitFailFast returns a *RateLimitError without making the request
+	// if the rate limit is known to be exceeded, as does NewClient.
+	RateLimitFailFast RateLimitBehavior = iota
+
+	// RateLimitWait waits until the rate limit is reset, or until the
+	// context of the request is done, and then makes the request, once. It
+	// also waits and retries the requests which GitHub rejects with a
+	// *RateLimitError.
+	RateLimitWait
+
+	// RateLimitAlwaysSend always makes the request, leaving it to GitHub to
+	// reject it if the rate limit is exceeded.
+	RateLimitAlwaysSend
+)
+
+// ClientOption configures a Client created by NewClientWithOptions.
+type ClientOption func(*clientOptions) error
+
+type clientOptions struct {
+	httpClient        *http.Client
+	baseURL           *url.URL
+	uploadURL         *url.URL
+	userAgent         string
+	tokenSource       TokenSource
+	rateLimitBehavior RateLimitBehavior
+	rawBodyRetention  bool
+	strictDecoding    bool
+	schemaDrift       func(*SchemaDrift)
+	retry             *RetryOptions
+	cache             Cache
+	middleware        []RequestMiddleware
+}
+
+// NewClientWithOptions returns a new GitHub API client configured by opts.
+// Without options, it is equivalent to NewClient(nil).
+//
+// For example, a client for GitHub Enterprise Server authenticated with a
+// personal access token, which retries the failed requests:
+//
+//	client, err := github.NewClientWithOptions(
+//		github.WithEnterpriseURLs("https://github.example.com/", "https://github.example.com/"),
+//		github.WithAuthToken("... your access token ..."),
+//		github.WithRetry(github.RetryOptions{MaxRetries: 3}),
+//	)
+//
+// The requests are sent through the middleware of the options in a fixed
+// order, regardless of the order of opts: they are authenticated, then
+// looked up in the cache, then retried, and then sent through the
+// middleware added with WithMiddleware.
+func NewClientWithOptions(opts ...ClientOption) (*Client, error) {
+	o := &clientOptions{}
+	for _, opt := range opts {
+		if err := opt(o); err != nil {
+			return nil, err
+		}
+	}
+
+	c := NewClient(o.httpClient)
+	if o.baseURL != nil {
+		c.BaseURL = o.baseURL
+	}
+	if o.uploadURL != nil {
+		c.UploadURL = o.uploadURL
+	}
+	if o.userAgent != "" {
+		c.UserAgent = o.userAgent
+	}
+	c.rateLimitBehavior = o.rateLimitBehavior
+	c.rawBodyRetention = o.rawBodyRetention
+	c.strictDecoding = o.strictDecoding
+	c.schemaDriftHandler = o.schemaDrift
+	if o.tokenSource != nil {
+		c.Use(tokenSourceMiddleware(o.tokenSource))
+	}
+	if o.cache != nil {
+		c.Use(CacheMiddleware(o.cache))
+	}
+	if o.retry != nil {
+		c.Use(RetryMiddleware(*o.retry))
+	}
+	c.Use(o.middleware...)
+	return c, nil
+}
+
+// WithHTTPClient sets the http.Client used to send the requests. It defaults
+// to a new http.Client.
+func WithHTTPClient(httpClient *http.Client) ClientOption {
+	return func(o *clientOptions) error {
+		o.httpClient = httpClient
+		return nil
+	}
+}
+
+// WithBaseURL sets the base URL of the API requests, to which a trailing
+// slash is added if needed. It defaults to https://api.github.com/.
+func WithBaseURL(baseURL string) ClientOption {
+	return func(o *clientOptions) error {
+		u, err := parseClientURL(baseURL)
+		if err != nil {
+			return err
+		}
+		o.baseURL = u
+		return nil
+	}
+}
+
+// WithUploadURL sets the base URL of the upload requests, to which a
+// trailing slash is added if needed. It defaults to
+// https://uploads.github.com/.
+func WithUploadURL(uploadURL string) ClientOption {
+	return func(o *clientOptions) error {
+		u, err := parseClientURL(uploadURL)
+		if err != nil {
+			return err
+		}
+		o.uploadURL = u
+		return nil
+	}
+}
+
+// WithEnterpriseURLs sets the base URL and the upload URL of a GitHub
+// Enterprise Server, adding the "/api/v3/" and "/api/uploads/" suffixes if
+// needed, as does NewEnterpriseClient.
+func WithEnterpriseURLs(baseURL, uploadURL string) ClientOption {
+	return func(o *clientOptions) error {
+		baseEndpoint, err := enterpriseURL(baseURL, "api/v3/")
+		if err != nil {
+			return err
+		}
+		uploadEndpoint, err := enterpriseURL(uploadURL, "api/uploads/")
+		if err != nil {
+			return err
+		}
+		o.baseURL, o.uploadURL = baseEndpoint, uploadEndpoint
+		return nil
+	}
+}
+
+// WithUserAgent sets the User-Agent header of the requests.
+func WithUserAgent(userAgent string) ClientOption {
+	return func(o *clientOptions) error {
+		o.userAgent = userAgent
+		return nil
+	}
+}
+
+// WithAuthToken authenticates the requests with an OAuth token, such as a
+// personal access token or an installation token.
+func WithAuthToken(token string) ClientOption {
+	return func(o *clientOptions) error {
+		if token == "" {
+			return errors.New("token must be non-empty")
+		}
+		o.tokenSource = StaticTokenSource(&OAuthToken{AccessToken: token})
+		return nil
+	}
+}
+
+// WithTokenSource authenticates the requests with the OAuth tokens returned
+// by source, such as a RefreshTokenSource.
+func WithTokenSource(source TokenSource) ClientOption {
+	return func(o *clientOptions) error {
+		if source == nil {
+			return errors.New("source must be non-nil")
+		}
+		o.tokenSource = source
+		return nil
+	}
+}
+
+// WithRateLimitBehavior sets the behavior of the client when the rate limit
+// of a request is known to be exceeded. It defaults to RateLimitFailFast.
+func WithRateLimitBehavior(behavior RateLimitBehavior) ClientOption {
+	return func(o *clientOptions) error {
+		switch behavior {
+		case RateLimitFailFast, RateLimitWait, RateLimitAlwaysSend:
+		default:
+			return fmt.Errorf("invalid rate limit behavior %v", behavior)
+		}
+		o.rateLimitBehavior = behavior
+		return nil
+	}
+}
+
+// WithRawBodyRetention keeps the raw JSON body of the responses decoded by
+// Client.Do, and so by the service methods, in Response.RawBody. See also the
+// WithRawBody request option, to keep the raw bodies of some requests only.
+func WithRawBodyRetention() ClientOption {
+	return func(o *clientOptions) error {
+		o.rawBodyRetention = true
+		return nil
+	}
+}
+
+// WithStrictDecoding makes Client.Do, and so the service methods, return an
+// error when the JSON body of a response has a field which is not modeled by
+// the decoded type, as does json.Decoder.DisallowUnknownFields. It is meant
+// for tests and for the maintainers of the library, to detect the new or
+// renamed fields of the API; see WithSchemaDriftHandler to detect them
+// without failing the requests.
+func WithStrictDecoding() ClientOption {
+	return func(o *clientOptions) error {
+		o.strictDecoding = true
+		return nil
+	}
+}
+
+// WithSchemaDriftHandler makes Client.Do, and so the service methods, call
+// handler with the fields of the JSON body of a response which are not
+// modeled by the decoded type, if any, once the response is decoded. The
+// handler is called synchronously, and must be safe for concurrent use.
+func WithSchemaDriftHandler(handler func(*SchemaDrift)) ClientOption {
+	return func(o *clientOptions) error {
+		if handler == nil {
+			return errors.New("handler must be non-nil")
+		}
+		o.schemaDrift = handler
+		return nil
+	}
+}
+
+// WithRetry retries the requests which fail, as does RetryMiddleware.
+func WithRetry(opts RetryOptions) ClientOption {
+	return func(o *clientOptions) error {
+		if opts.MaxRetries < 0 || opts.MinBackoff < 0 || opts.MaxBackoff < 0 {
+			return errors.New("retry options must not be negative")
+		}
+		o.retry = &opts
+		return nil
+	}
+}
+
+// WithCache makes conditional requests with the responses stored in cache,
+// as does CacheMiddleware.
+func WithCache(cache Cache) ClientOption {
+	return func(o *clientOptions) error {
+		if cache == nil {
+			return errors.New("cache must be non-nil")
+		}
+		o.cache = cache
+		return nil
+	}
+}
+
+// WithMiddleware adds middleware to the client, as does Client.Use.
+func WithMiddleware(middleware ...RequestMiddleware) ClientOption {
+	return func(o *clientOptions) error {
+		o.middleware = append(o.middleware, middleware...)
+		return nil
+	}
+}
+
+// parseClientURL parses rawURL, adding a trailing slash to its path if
+// needed.
+func parseClientURL(rawURL string) (*url.URL, error) {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return nil, err
+	}
+	if !strings.HasSuffix(u.Path, "/") {
+		u.Path += "/"
+	}
+	return u, nil
+}
+
+// enterpriseURL parses rawURL, a URL of a GitHub Enterprise Server, adding a
+// trailing slash and suffix to its path if needed.
+func enterpriseURL(rawURL, suffix string) (*url.URL, error) {
+	u, err := parseClientURL(rawURL)
+	if err != nil {
+		return nil, err
+	}
+	if !strings.HasSuffix(u.Path, "/"+suffix) &&
+		!strings.HasPrefix(u.Host, "api.") &&
+		!strings.Contains(u.Host, ".api.") {
+		u.Path += suffix
+	}
+	return u, nil
+}
+
+// tokenSourceMiddleware authenticates the requests with the OAuth tokens
+// returned by source.
+func tokenSourceMiddleware(source TokenSource) RequestMiddleware {
+	return func(next RequestHandler) RequestHandler {
+		return func(req *http.Request) (*http.Response, error) {
+			token, err := source.Token(req.Context())
+			if err != nil {
+				return nil, err
+			}
+			req2 := req.Clone(req.Context())
+			req2.Header.Set("Authorization", "token "+token.AccessToken)
+			return next(req2)
+		}
+	}
+}
+
+// rewindRequest returns a copy of req whose body can be sent again, or an
+// error if the body of req cannot be read again.
+func rewindRequest(req *http.Request) (*http.Request, error) {
+	req2 := req.Clone(req.Context())
+	if req.Body == nil || req.Body == http.NoBody {
+		return req2, nil
+	}
+	if req.GetBody == nil {
+		return nil, errors.New("request body cannot be sent again")
+	}
+	body, err := req.GetBody()
+	if err != nil {
+		return nil, err
+	}
+	req2.Body = body
+	return req2, nil
+}
+
+// RetryOptions specifies the parameters to RetryMiddleware.
+type RetryOptions struct {
+	// MaxRetries is the maximum number of retries of a request.
+	MaxRetries int
+
+	// MinBackoff is the delay before the first retry, which doubles with
+	// each retry up to MaxBackoff, unless the response has a Retry-After
+	// header. Default: 1s and 30s.
+	MinBackoff time.Duration
+	MaxBackoff time.Duration
+}
+
+// RetryMiddleware returns a middleware which retries the requests which fail
+// with a 5xx server error, or a network error, up to opts.MaxRetries times,
+// with an exponential backoff, as well as the requests rejected with a 429
+// status code or an abuse rate limit error, after the delay of their
+// Retry-After header. Server and network errors are only retried for the
+// idempotent methods, and requests with a body are only retried if it can be
+// read again, as with the requests created by Client.NewRequest.
+//
+// The primary rate limit errors are not retried, see WithRateLimitBehavior.
+func RetryMiddleware(opts RetryOptions) RequestMiddleware {
+	if opts.MinBackoff == 0 {
+		opts.MinBackoff = defaultRetryMinBackoff
+	}
+	if opts.MaxBackoff == 0 {
+		opts.MaxBackoff = defaultRetryMaxBackoff
+	}
+	return func(next RequestHandler) RequestHandler {
+		return func(req *http.Request) (*http.Response, error) {
+			backoff := opts.MinBackoff
+			for retry := 0; ; retry++ {
+				attempt := req
+				if retry < opts.MaxRetries {
+					var err error
+					if attempt, err = rewindRequest(req); err != nil {
+						// The request can only be sent once.
+						return next(req)
+					}
+				}
+
+				resp, err := next(attempt)
+				if retry >= opts.MaxRetries {
+					return resp, err
+				}
+				delay, ok := retryDelay(req, resp, err)
+				if !ok {
+					return resp, err
+				}
+				if delay == 0 {
+					delay = backoff
+					if backoff *= 2; backoff > opts.MaxBackoff {
+						backoff = opts.MaxBackoff
+					}
+				}
+				if resp != nil {
+					resp.Body.Close()
+				}
+				if err := sleepUntil(req.Context(), time.Now().Add(delay)); err != nil {
+					return nil, err
+				}
+			}
+		}
+	}
+}
+
+// retryDelay reports whether the request req, which returned resp and err,
+// should be retried, along with the delay of the Retry-After header of resp,
+// if any.
+func retryDelay(req *http.Request, resp *http.Response, err error) (time.Duration, bool) {
+	if err != nil {
+		return 0, req.Context().Err() == nil && isIdempotent(req.Method)
+	}
+
+	var retryAfter time.Duration
+	if v := resp.Header.Get("Retry-After"); v != "" {
+		seconds, _ := strconv.ParseInt(v, 10, 64)
+		retryAfter = time.Duration(seconds) * time.Second
+	}
+	switch {
+	case resp.StatusCode == http.StatusTooManyRequests:
+		return retryAfter, true
+	case resp.StatusCode == http.StatusForbidden:
+		// The abuse rate limit errors have a Retry-After header, unlike
+		// the other 403 Forbidden errors.
+		return retryAfter, resp.Header.Get("Retry-After") != "" && resp.Header.Get(headerRateRemaining) != "0"
+	case resp.StatusCode >= 500:
+		return retryAfter, isIdempotent(req.Method)
+	}
+	return 0, false
+}
+
+func isIdempotent(method string) bool {
+	switch method {
+	case "GET", "HEAD", "OPTIONS", "PUT", "DELETE":
+		return true
+	}
+	return false
+}
+
+// Cache stores the HTTP responses of CacheMiddleware. Implementations must be
+// safe for concurrent use.
+type Cache interface {
+	// Get returns the response stored with key, if any.
+	Get(key string) (resp []byte, ok bool)
+	// Set stores resp with key.
+	Set(key string, resp []byte)
+	// Delete removes the response stored with key, if any.
+	Delete(key string)
+}
+
+// MemoryCache is a Cache which stores the responses in memory, without
+// limit.
+type MemoryCache struct {
+	mu    sync.Mutex
+	items map[string][]byte
+}
+
+// NewMemoryCache returns a new, empty MemoryCache.
+func NewMemoryCache() *MemoryCache {
+	return &MemoryCache{items: make(map[string][]byte)}
+}
+
+// Get implements the Cache interface.
+func (c *MemoryCache) Get(key string) ([]byte, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	resp, ok := c.items[key]
+	return resp, ok
+}
+
+// Set implements the Cache interface.
+func (c *MemoryCache) Set(key string, resp []byte) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.items[key] = resp
+}
+
+// Delete implements the Cache interface.
+func (c *MemoryCache) Delete(key string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	delete(c.items, key)
+}
+
+// CacheMiddleware returns a middleware which stores the successful responses
+// of the GET requests with an ETag or Last-Modified header in cache, and
+// uses them to make conditional requests. If GitHub answers that the
+// resource is not modified, which does not count against the rate limit,
+// the stored response is returned, with the headers of the new response and
+// an "X-From-Cache: 1" header.
+//
+// The responses are stored by URL, Accept header, Authorization header and
+// rate limit key (see WithRateLimitKey), so a cache can be shared by clients
+// which authenticate their requests with a token source or a middleware.
+// Clients whose http.Client authenticates the requests with different
+// credentials should not share a cache.
+//
+// The requests which already have an If-None-Match or If-Modified-Since
+// header are not cached.
+func CacheMiddleware(cache Cache) RequestMiddleware {
+	return func(next RequestHandler) RequestHandler {
+		return func(req *http.Request) (*http.Response, error) {
+			if req.Method != "GET" || req.Header.Get("If-None-Match") != "" || req.Header.Get("If-Modified-Since") != "" {
+				return next(req)
+			}
+
+			key := cacheKey(req)
+			var cached *http.Response
+			if b, ok := cache.Get(key); ok {
+				if resp, err := http.ReadResponse(bufio.NewReader(bytes.NewReader(b)), req); err == nil {
+					cached = resp
+				} else {
+					cache.Delete(key)
+				}
+			}
+			if cached != nil {
+				req2 := req.Clone(req.Context())
+				if etag := cached.Header.Get("ETag"); etag != "" {
+					req2.Header.Set("If-None-Match", etag)
+				}
+				if lastModified := cached.Header.Get("Last-Modified"); lastModified != "" {
+					req2.Header.Set("If-Modified-Since", lastModified)
+				}
+				req = req2
+			}
+
+			resp, err := next(req)
+			if err != nil {
+				return nil, err
+			}
+			if resp.StatusCode == http.StatusNotModified && cached != nil {
+				io.Copy(ioutil.Discard, resp.Body)
+				resp.Body.Close()
+				for k, v := range resp.Header {
+					if k != "Content-Length" && k != "Transfer-Encoding" {
+						cached.Header[k] = v
+					}
+				}
+				cached.Header.Set(headerFromCache, "1")
+				return cached, nil
+			}
+			if resp.StatusCode == http.StatusOK && (resp.Header.Get("ETag") != "" || resp.Header.Get("Last-Modified") != "") {
+				// DumpResponse restores the body it reads.
+				if b, err := httputil.DumpResponse(resp, true); err == nil {
+					cache.Set(key, b)
+				}
+			} else if cached != nil {
+				cache.Delete(key)
+			}
+			return resp, nil
+		}
+	}
+}
+
+// cacheKey returns the key of the response of req in a Cache.
+func cacheKey(req *http.Request) string {
+	key := req.URL.String() + "\n" + req.Header.Get("Accept")
+	if auth := req.Header.Get("Authorization"); auth != "" {
+		sum := sha256.Sum256([]byte(auth))
+		key += "\n" + hex.EncodeToString(sum[:])
+	}
+	if rateLimitKey, _ := req.Context().Value(rateLimitKey{}).(string); rateLimitKey != "" {
+		key += "\n" + rateLimitKey
+	}
+	return key
+}
+
+// waitRateLimit waits until the rate limit of err is reset, or until ctx is
+// done, and returns a copy of req to be sent again, if c waits for the rate
+// limits to reset.
+func (c *Client) waitRateLimit(ctx context.Context, req *http.Request, err error) (*http.Request, bool) {
+	if _, ok := err.(*RateLimitError); !ok || c.rateLimitBehavior != RateLimitWait {
+		return nil, false
+	}
+	req2, rewindErr := rewindRequest(req)
+	if rewindErr != nil {
+		return nil, false
+	}
+	if retry, _ := waitForRateLimit(ctx, err); !retry {
+		return nil, false
+	}
+	return req2, true
+}
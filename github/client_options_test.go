@@ -0,0 +1,268 @@
+// Copyright 2021 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+)
+
+// setupWithOptions sets up a test HTTP server like setup, along with a Client
+// created by NewClientWithOptions with opts.
+func setupWithOptions(t *testing.T, opts ...ClientOption) (client *Client, mux *http.ServeMux, teardown func()) {
+	_, mux, serverURL, teardown := setup()
+	opts = append([]ClientOption{WithBaseURL(serverURL + baseURLPath)}, opts...)
+	client, err := NewClientWithOptions(opts...)
+	if err != nil {
+		teardown()
+		t.Fatalf("NewClientWithOptions returned error: %v", err)
+	}
+	return client, mux, teardown
+}
+
+func TestNewClientWithOptions(t *testing.T) {
+	c, err := NewClientWithOptions()
+	if err != nil {
+		t.Fatalf("NewClientWithOptions returned error: %v", err)
+	}
+	if got, want := c.BaseURL.String(), defaultBaseURL; got != want {
+		t.Errorf("NewClientWithOptions BaseURL is %v, want %v", got, want)
+	}
+	if got, want := c.UploadURL.String(), uploadBaseURL; got != want {
+		t.Errorf("NewClientWithOptions UploadURL is %v, want %v", got, want)
+	}
+	if got, want := c.UserAgent, userAgent; got != want {
+		t.Errorf("NewClientWithOptions UserAgent is %v, want %v", got, want)
+	}
+
+	httpClient := &http.Client{}
+	c, err = NewClientWithOptions(
+		WithHTTPClient(httpClient),
+		WithBaseURL("https://custom-url/api"),
+		WithUploadURL("https://custom-upload-url/"),
+		WithUserAgent("custom-agent"),
+	)
+	if err != nil {
+		t.Fatalf("NewClientWithOptions returned error: %v", err)
+	}
+	if c.client != httpClient {
+		t.Errorf("NewClientWithOptions did not use the http.Client of WithHTTPClient")
+	}
+	if got, want := c.BaseURL.String(), "https://custom-url/api/"; got != want {
+		t.Errorf("NewClientWithOptions BaseURL is %v, want %v", got, want)
+	}
+	if got, want := c.UploadURL.String(), "https://custom-upload-url/"; got != want {
+		t.Errorf("NewClientWithOptions UploadURL is %v, want %v", got, want)
+	}
+	if got, want := c.UserAgent, "custom-agent"; got != want {
+		t.Errorf("NewClientWithOptions UserAgent is %v, want %v", got, want)
+	}
+}
+
+func TestWithEnterpriseURLs(t *testing.T) {
+	c, err := NewClientWithOptions(WithEnterpriseURLs("https://custom-url", "https://custom-upload-url/api/uploads"))
+	if err != nil {
+		t.Fatalf("NewClientWithOptions returned error: %v", err)
+	}
+	if got, want := c.BaseURL.String(), "https://custom-url/api/v3/"; got != want {
+		t.Errorf("NewClientWithOptions BaseURL is %v, want %v", got, want)
+	}
+	if got, want := c.UploadURL.String(), "https://custom-upload-url/api/uploads/"; got != want {
+		t.Errorf("NewClientWithOptions UploadURL is %v, want %v", got, want)
+	}
+}
+
+func TestNewClientWithOptions_invalidOptions(t *testing.T) {
+	tests := map[string]ClientOption{
+		"base URL":            WithBaseURL(":"),
+		"upload URL":          WithUploadURL(":"),
+		"enterprise URL":      WithEnterpriseURLs("https://custom-url", ":"),
+		"auth token":          WithAuthToken(""),
+		"token source":        WithTokenSource(nil),
+		"rate limit behavior": WithRateLimitBehavior(RateLimitBehavior(42)),
+		"retry":               WithRetry(RetryOptions{MaxRetries: -1}),
+		"cache":               WithCache(nil),
+		"schema drift":        WithSchemaDriftHandler(nil),
+	}
+	for name, opt := range tests {
+		if _, err := NewClientWithOptions(opt); err == nil {
+			t.Errorf("NewClientWithOptions with invalid %v returned no error", name)
+		}
+	}
+}
+
+func TestWithAuthToken(t *testing.T) {
+	client, mux, teardown := setupWithOptions(t, WithAuthToken("t"))
+	defer teardown()
+
+	mux.HandleFunc("/user", func(w http.ResponseWriter, r *http.Request) {
+		testHeader(t, r, "Authorization", "token t")
+		fmt.Fprint(w, `{"id":1}`)
+	})
+
+	if _, _, err := client.Users.Get(context.Background(), ""); err != nil {
+		t.Errorf("Users.Get returned error: %v", err)
+	}
+}
+
+func TestWithRetry(t *testing.T) {
+	client, mux, teardown := setupWithOptions(t, WithRetry(RetryOptions{MaxRetries: 2, MinBackoff: time.Millisecond}))
+	defer teardown()
+
+	var getRequests, postRequests int
+	mux.HandleFunc("/repos/o/r", func(w http.ResponseWriter, r *http.Request) {
+		getRequests++
+		if getRequests < 3 {
+			w.WriteHeader(http.StatusBadGateway)
+			return
+		}
+		fmt.Fprint(w, `{"id":1}`)
+	})
+	mux.HandleFunc("/repos/o/r/issues", func(w http.ResponseWriter, r *http.Request) {
+		postRequests++
+		testBody(t, r, `{"title":"t"}`+"\n")
+		if postRequests == 1 {
+			w.Header().Set("Retry-After", "0")
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+		w.WriteHeader(http.StatusInternalServerError)
+	})
+
+	ctx := context.Background()
+	repo, _, err := client.Repositories.Get(ctx, "o", "r")
+	if err != nil {
+		t.Fatalf("Repositories.Get returned error: %v", err)
+	}
+	if repo.GetID() != 1 || getRequests != 3 {
+		t.Errorf("Repositories.Get returned %+v after %v requests, want ID 1 after 3 requests", repo, getRequests)
+	}
+
+	// The POST request is retried after the 429, but not after the 500.
+	_, resp, err := client.Issues.Create(ctx, "o", "r", &IssueRequest{Title: String("t")})
+	if err == nil || resp.StatusCode != http.StatusInternalServerError {
+		t.Errorf("Issues.Create returned %v, want 500 error", err)
+	}
+	if postRequests != 2 {
+		t.Errorf("Issues.Create made %v requests, want 2", postRequests)
+	}
+}
+
+func TestWithCache(t *testing.T) {
+	cache := NewMemoryCache()
+	client, mux, teardown := setupWithOptions(t, WithCache(cache), WithAuthToken("t"))
+	defer teardown()
+
+	var requests int
+	mux.HandleFunc("/repos/o/r", func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		w.Header().Set(headerRateRemaining, fmt.Sprint(5000-requests))
+		if r.Header.Get("If-None-Match") == `"abc"` {
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+		w.Header().Set("ETag", `"abc"`)
+		fmt.Fprint(w, `{"id":1}`)
+	})
+
+	ctx := context.Background()
+	for i := 0; i < 2; i++ {
+		repo, resp, err := client.Repositories.Get(ctx, "o", "r")
+		if err != nil {
+			t.Fatalf("Repositories.Get returned error: %v", err)
+		}
+		if repo.GetID() != 1 {
+			t.Errorf("Repositories.Get returned %+v, want ID 1", repo)
+		}
+		if got, want := resp.Header.Get(headerFromCache) == "1", i == 1; got != want {
+			t.Errorf("Response %v from cache is %v, want %v", i, got, want)
+		}
+		if got, want := resp.Rate.Remaining, 4999-i; got != want {
+			t.Errorf("Response %v rate remaining is %v, want %v", i, got, want)
+		}
+	}
+	if requests != 2 {
+		t.Errorf("Made %v requests, want 2", requests)
+	}
+
+	// The responses are cached per credentials.
+	other, _ := NewClientWithOptions(WithBaseURL(client.BaseURL.String()), WithCache(cache), WithAuthToken("other"))
+	_, resp, err := other.Repositories.Get(ctx, "o", "r")
+	if err != nil {
+		t.Fatalf("Repositories.Get returned error: %v", err)
+	}
+	if resp.Header.Get(headerFromCache) != "" {
+		t.Errorf("Response for other credentials is from cache")
+	}
+}
+
+func TestWithRateLimitBehavior(t *testing.T) {
+	for _, behavior := range []RateLimitBehavior{RateLimitWait, RateLimitAlwaysSend} {
+		client, mux, teardown := setupWithOptions(t, WithRateLimitBehavior(behavior))
+
+		var requests int
+		mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+			requests++
+		})
+		client.rateLimits[coreCategory] = Rate{
+			Limit:     5000,
+			Remaining: 0,
+			Reset:     Timestamp{time.Now().Add(50 * time.Millisecond)},
+		}
+
+		req, _ := client.NewRequest("GET", ".", nil)
+		if _, err := client.Do(context.Background(), req, nil); err != nil {
+			t.Errorf("Do with behavior %v returned error: %v", behavior, err)
+		}
+		if requests != 1 {
+			t.Errorf("Do with behavior %v made %v requests, want 1", behavior, requests)
+		}
+		teardown()
+	}
+}
+
+func TestWithRateLimitBehavior_waitCanceled(t *testing.T) {
+	client, mux, teardown := setupWithOptions(t, WithRateLimitBehavior(RateLimitWait))
+	defer teardown()
+
+	mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		t.Error("Unexpected request.")
+	})
+	client.rateLimits[coreCategory] = Rate{
+		Limit:     5000,
+		Remaining: 0,
+		Reset:     Timestamp{time.Now().Add(time.Minute)},
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	req, _ := client.NewRequest("GET", ".", nil)
+	if _, err := client.Do(ctx, req, nil); err == nil {
+		t.Error("Expected error to be returned.")
+	} else if _, ok := err.(*RateLimitError); !ok {
+		t.Errorf("Expected a *RateLimitError error; got %#v.", err)
+	}
+}
+
+func TestWithRawBodyRetention(t *testing.T) {
+	client, mux, teardown := setupWithOptions(t, WithRawBodyRetention())
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"id":1}`)
+	})
+
+	_, resp, err := client.Repositories.Get(context.Background(), "o", "r")
+	if err != nil {
+		t.Fatalf("Repositories.Get returned error: %v", err)
+	}
+	if got, want := string(resp.RawBody), `{"id":1}`; got != want {
+		t.Errorf("Response.RawBody is %v, want %v", got, want)
+	}
+}
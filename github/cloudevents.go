@@ -0,0 +1,164 @@
+// Copyright 2021 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strings"
+	"time"
+)
+
+const (
+	// CloudEventSpecVersion is the version of the CloudEvents specification
+	// implemented by CloudEvent.
+	CloudEventSpecVersion = "1.0"
+
+	// CloudEventTypePrefix is the prefix of the Type of the CloudEvents
+	// converted from webhook deliveries, which is followed by the webhook
+	// event name and, if any, by the action of the event, such as
+	// "com.github.pull_request.opened".
+	CloudEventTypePrefix = "com.github."
+
+	defaultCloudEventSource = "https://github.com"
+)
+
+// CloudEvent is a CloudEvent in the JSON format, converted from a GitHub
+// webhook delivery by NewCloudEvent.
+//
+// The delivery GUID is the ID of the CloudEvent, and the webhook event name
+// and the installation ID are kept in the GitHubEvent and the
+// GitHubInstallationID extension attributes.
+//
+// CloudEvents specification: https://github.com/cloudevents/spec/blob/v1.0/spec.md
+type CloudEvent struct {
+	SpecVersion string `json:"specversion"`
+	ID          string `json:"id"`
+	// Source is the HTML URL of the repository, or the URL of the
+	// organization, of the webhook event, or "https://github.com".
+	Source string `json:"source"`
+	Type   string `json:"type"`
+	// Subject is the full name of the repository of the webhook event, if any.
+	Subject         string     `json:"subject,omitempty"`
+	Time            *time.Time `json:"time,omitempty"`
+	DataContentType string     `json:"datacontenttype,omitempty"`
+	// Data is the JSON webhook payload. DataBase64 is only used for CloudEvents
+	// with a binary payload, which are not created by NewCloudEvent.
+	Data       json.RawMessage `json:"data,omitempty"`
+	DataBase64 []byte          `json:"data_base64,omitempty"`
+
+	// Extension attributes.
+	GitHubEvent          string `json:"githubevent,omitempty"`
+	GitHubInstallationID int64  `json:"githubinstallationid,omitempty"`
+}
+
+// cloudEventPayload is the subset of a webhook payload which is used to
+// fill in the attributes of a CloudEvent.
+type cloudEventPayload struct {
+	Action       string `json:"action"`
+	Installation *struct {
+		ID int64 `json:"id"`
+	} `json:"installation"`
+	Repository *struct {
+		FullName string `json:"full_name"`
+		HTMLURL  string `json:"html_url"`
+	} `json:"repository"`
+	Organization *struct {
+		URL string `json:"url"`
+	} `json:"organization"`
+}
+
+// NewCloudEvent converts a webhook delivery to a CloudEvent. r is the
+// webhook request, whose headers give the delivery GUID and the event name,
+// and payload is its JSON payload, as returned by ValidatePayload.
+//
+// Example usage:
+//
+//     func (s *GitHubEventMonitor) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+//       payload, err := github.ValidatePayload(r, s.webhookSecretKey)
+//       if err != nil { ... }
+//       event, err := github.NewCloudEvent(r, payload)
+//       if err != nil { ... }
+//       data, err := json.Marshal(event)
+//       // Publish data with the application/cloudevents+json content type...
+//     }
+//
+func NewCloudEvent(r *http.Request, payload []byte) (*CloudEvent, error) {
+	messageType := WebHookType(r)
+	if messageType == "" {
+		return nil, errors.New("missing X-Github-Event header")
+	}
+	deliveryID := DeliveryID(r)
+	if deliveryID == "" {
+		return nil, errors.New("missing X-Github-Delivery header")
+	}
+
+	var p cloudEventPayload
+	if err := json.Unmarshal(payload, &p); err != nil {
+		return nil, err
+	}
+
+	e := &CloudEvent{
+		SpecVersion:     CloudEventSpecVersion,
+		ID:              deliveryID,
+		Source:          defaultCloudEventSource,
+		Type:            CloudEventTypePrefix + messageType,
+		DataContentType: "application/json",
+		Data:            json.RawMessage(payload),
+		GitHubEvent:     messageType,
+	}
+	if p.Action != "" {
+		e.Type += "." + p.Action
+	}
+	if p.Installation != nil {
+		e.GitHubInstallationID = p.Installation.ID
+	}
+	switch {
+	case p.Repository != nil && p.Repository.HTMLURL != "":
+		e.Source = p.Repository.HTMLURL
+	case p.Organization != nil && p.Organization.URL != "":
+		e.Source = p.Organization.URL
+	}
+	if p.Repository != nil {
+		e.Subject = p.Repository.FullName
+	}
+	return e, nil
+}
+
+// WebHook converts e back to a webhook delivery. It returns the event name,
+// as in the X-Github-Event header, the delivery GUID, as in the
+// X-Github-Delivery header, and the JSON payload of the delivery.
+//
+// The event name is the GitHubEvent extension attribute or, if it is not
+// set, the event name in the Type of e.
+func (e *CloudEvent) WebHook() (messageType, deliveryID string, payload []byte, err error) {
+	messageType = e.GitHubEvent
+	if messageType == "" {
+		if !strings.HasPrefix(e.Type, CloudEventTypePrefix) {
+			return "", "", nil, errors.New("CloudEvent is not a GitHub webhook event")
+		}
+		messageType = strings.SplitN(strings.TrimPrefix(e.Type, CloudEventTypePrefix), ".", 2)[0]
+	}
+
+	payload = e.Data
+	if len(payload) == 0 {
+		payload = e.DataBase64
+	}
+	if len(payload) == 0 {
+		return "", "", nil, errors.New("CloudEvent has no data")
+	}
+	return messageType, e.ID, payload, nil
+}
+
+// ParseWebHook parses the webhook payload of e, like ParseWebHook.
+func (e *CloudEvent) ParseWebHook() (interface{}, error) {
+	messageType, _, payload, err := e.WebHook()
+	if err != nil {
+		return nil, err
+	}
+	return ParseWebHook(messageType, payload)
+}
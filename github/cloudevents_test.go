@@ -0,0 +1,140 @@
+// Copyright 2021 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"encoding/json"
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+func TestNewCloudEvent(t *testing.T) {
+	payload := []byte(`{"action":"opened","issue":{"number":1},"installation":{"id":3},"repository":{"full_name":"o/r","html_url":"https://github.com/o/r"}}`)
+	req, err := http.NewRequest("POST", "http://localhost/event", nil)
+	if err != nil {
+		t.Fatalf("NewRequest: %v", err)
+	}
+	req.Header.Set(eventTypeHeader, "issues")
+	req.Header.Set(deliveryIDHeader, "72d3162e-cc78-11e3-81ab-4c9367dc0958")
+
+	e, err := NewCloudEvent(req, payload)
+	if err != nil {
+		t.Fatalf("NewCloudEvent returned error: %v", err)
+	}
+
+	want := &CloudEvent{
+		SpecVersion:          "1.0",
+		ID:                   "72d3162e-cc78-11e3-81ab-4c9367dc0958",
+		Source:               "https://github.com/o/r",
+		Type:                 "com.github.issues.opened",
+		Subject:              "o/r",
+		DataContentType:      "application/json",
+		Data:                 json.RawMessage(payload),
+		GitHubEvent:          "issues",
+		GitHubInstallationID: 3,
+	}
+	if !reflect.DeepEqual(e, want) {
+		t.Errorf("NewCloudEvent returned %+v, want %+v", e, want)
+	}
+
+	testJSONMarshal(t, e, `{
+		"specversion": "1.0",
+		"id": "72d3162e-cc78-11e3-81ab-4c9367dc0958",
+		"source": "https://github.com/o/r",
+		"type": "com.github.issues.opened",
+		"subject": "o/r",
+		"datacontenttype": "application/json",
+		"data": {
+			"action": "opened",
+			"issue": {"number": 1},
+			"installation": {"id": 3},
+			"repository": {"full_name": "o/r", "html_url": "https://github.com/o/r"}
+		},
+		"githubevent": "issues",
+		"githubinstallationid": 3
+	}`)
+
+	messageType, deliveryID, gotPayload, err := e.WebHook()
+	if err != nil {
+		t.Fatalf("CloudEvent.WebHook returned error: %v", err)
+	}
+	if messageType != "issues" || deliveryID != want.ID || string(gotPayload) != string(payload) {
+		t.Errorf("CloudEvent.WebHook returned %q, %q, %s", messageType, deliveryID, gotPayload)
+	}
+
+	event, err := e.ParseWebHook()
+	if err != nil {
+		t.Fatalf("CloudEvent.ParseWebHook returned error: %v", err)
+	}
+	if got := event.(*IssuesEvent).GetIssue().GetNumber(); got != 1 {
+		t.Errorf("CloudEvent.ParseWebHook returned issue %v, want 1", got)
+	}
+}
+
+func TestNewCloudEvent_source(t *testing.T) {
+	req, err := http.NewRequest("POST", "http://localhost/event", nil)
+	if err != nil {
+		t.Fatalf("NewRequest: %v", err)
+	}
+	req.Header.Set(eventTypeHeader, "ping")
+	req.Header.Set(deliveryIDHeader, "d")
+
+	for payload, want := range map[string]string{
+		`{"zen":"z"}`: "https://github.com",
+		`{"organization":{"url":"https://api.github.com/orgs/o"}}`: "https://api.github.com/orgs/o",
+	} {
+		e, err := NewCloudEvent(req, []byte(payload))
+		if err != nil {
+			t.Fatalf("NewCloudEvent returned error: %v", err)
+		}
+		if e.Source != want || e.Type != "com.github.ping" || e.Subject != "" {
+			t.Errorf("NewCloudEvent(%v) returned source %q, type %q and subject %q, want %q, com.github.ping and none", payload, e.Source, e.Type, e.Subject, want)
+		}
+	}
+}
+
+func TestNewCloudEvent_errors(t *testing.T) {
+	for name, headers := range map[string]map[string]string{
+		"no event":    {deliveryIDHeader: "d"},
+		"no delivery": {eventTypeHeader: "ping"},
+		"bad payload": {eventTypeHeader: "ping", deliveryIDHeader: "d"},
+	} {
+		req, err := http.NewRequest("POST", "http://localhost/event", nil)
+		if err != nil {
+			t.Fatalf("NewRequest: %v", err)
+		}
+		for k, v := range headers {
+			req.Header.Set(k, v)
+		}
+		if _, err := NewCloudEvent(req, []byte("[")); err == nil {
+			t.Errorf("NewCloudEvent with %v returned no error", name)
+		}
+	}
+}
+
+func TestCloudEvent_WebHook(t *testing.T) {
+	e := &CloudEvent{ID: "d", Type: "com.github.push", DataBase64: []byte(`{"ref":"r"}`)}
+	messageType, deliveryID, payload, err := e.WebHook()
+	if err != nil {
+		t.Fatalf("CloudEvent.WebHook returned error: %v", err)
+	}
+	if messageType != "push" || deliveryID != "d" || string(payload) != `{"ref":"r"}` {
+		t.Errorf("CloudEvent.WebHook returned %q, %q, %s", messageType, deliveryID, payload)
+	}
+
+	for name, e := range map[string]*CloudEvent{
+		"other type": {Type: "com.example.push", Data: json.RawMessage(`{}`)},
+		"no data":    {Type: "com.github.push"},
+	} {
+		if _, _, _, err := e.WebHook(); err == nil {
+			t.Errorf("CloudEvent.WebHook with %v returned no error", name)
+		}
+		if _, err := e.ParseWebHook(); err == nil {
+			t.Errorf("CloudEvent.ParseWebHook with %v returned no error", name)
+		}
+	}
+}
@@ -6,10 +6,17 @@
 package github
 
 import (
+	"bytes"
+	"compress/gzip"
 	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
 	"fmt"
+	"io"
 	"strconv"
 	"strings"
+	"time"
 )
 
 // CodeScanningService handles communication with the code scanning related
@@ -115,3 +122,385 @@ func (s *CodeScanningService) GetAlert(ctx context.Context, owner, repo string,
 
 	return a, resp, nil
 }
+
+// Tool represents the tool used to generate a code scanning analysis.
+type Tool struct {
+	Name    *string `json:"name,omitempty"`
+	GUID    *string `json:"guid,omitempty"`
+	Version *string `json:"version,omitempty"`
+}
+
+// ScanningAnalysis represents an individual GitHub code scanning analysis.
+type ScanningAnalysis struct {
+	ID           *int64     `json:"id,omitempty"`
+	Ref          *string    `json:"ref,omitempty"`
+	CommitSHA    *string    `json:"commit_sha,omitempty"`
+	AnalysisKey  *string    `json:"analysis_key,omitempty"`
+	Environment  *string    `json:"environment,omitempty"`
+	Error        *string    `json:"error,omitempty"`
+	Category     *string    `json:"category,omitempty"`
+	CreatedAt    *Timestamp `json:"created_at,omitempty"`
+	ResultsCount *int       `json:"results_count,omitempty"`
+	RulesCount   *int       `json:"rules_count,omitempty"`
+	URL          *string    `json:"url,omitempty"`
+	SARIFID      *string    `json:"sarif_id,omitempty"`
+	Tool         *Tool      `json:"tool,omitempty"`
+	Deletable    *bool      `json:"deletable,omitempty"`
+	Warning      *string    `json:"warning,omitempty"`
+}
+
+// AnalysesListOptions specifies optional parameters to the CodeScanningService.ListAnalysesForRepo method.
+type AnalysesListOptions struct {
+	// Return code scanning analyses belonging to the same SARIF upload.
+	SARIFID string `url:"sarif_id,omitempty"`
+
+	// Return code scanning analyses for a specific branch reference. The ref can be formatted as refs/heads/<branch name> or simply <branch name>.
+	Ref string `url:"ref,omitempty"`
+
+	ListOptions
+}
+
+// ListAnalysesForRepo lists code scanning analyses for a repository.
+//
+// You must use an access token with the security_events scope to use this endpoint.
+// GitHub Apps must have the security_events read permission to use this endpoint.
+//
+// GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/code-scanning/#list-code-scanning-analyses-for-a-repository
+func (s *CodeScanningService) ListAnalysesForRepo(ctx context.Context, owner, repo string, opts *AnalysesListOptions) ([]*ScanningAnalysis, *Response, error) {
+	u := fmt.Sprintf("repos/%v/%v/code-scanning/analyses", owner, repo)
+	u, err := addOptions(u, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var analyses []*ScanningAnalysis
+	resp, err := s.client.Do(ctx, req, &analyses)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return analyses, resp, nil
+}
+
+// SARIFUploadOptions specifies the parameters to the CodeScanningService.UploadSARIF method.
+type SARIFUploadOptions struct {
+	// CommitSHA is the SHA of the commit to which the analysis you are uploading relates. (Required.)
+	CommitSHA string
+	// Ref is the full Git reference, formatted as refs/heads/<branch name> or refs/pull/<number>/head. (Required.)
+	Ref string
+	// CheckoutURI is the base directory used in the analysis, as it appears in the SARIF file. (Optional.)
+	CheckoutURI string
+	// StartedAt is the time that the analysis run began. (Optional.)
+	StartedAt *Timestamp
+	// ToolName is the name of the tool used to generate the analysis. (Optional.)
+	ToolName string
+}
+
+// sarifUploadRequest represents the body of a SARIF upload request.
+type sarifUploadRequest struct {
+	CommitSHA   string     `json:"commit_sha"`
+	Ref         string     `json:"ref"`
+	Sarif       string     `json:"sarif"`
+	CheckoutURI string     `json:"checkout_uri,omitempty"`
+	StartedAt   *Timestamp `json:"started_at,omitempty"`
+	ToolName    string     `json:"tool_name,omitempty"`
+}
+
+// SARIFID identifies a SARIF upload.
+type SARIFID struct {
+	ID  *string `json:"id,omitempty"`
+	URL *string `json:"url,omitempty"`
+}
+
+// Processing statuses of a SARIF upload, as reported by SARIFUpload.ProcessingStatus.
+const (
+	SARIFProcessingPending  = "pending"
+	SARIFProcessingComplete = "complete"
+	SARIFProcessingFailed   = "failed"
+)
+
+// SARIFUpload represents the processing status of a SARIF upload.
+type SARIFUpload struct {
+	// ProcessingStatus is one of the SARIFProcessing constants.
+	ProcessingStatus *string `json:"processing_status,omitempty"`
+	// AnalysesURL is the REST API URL for getting the analyses associated with the upload.
+	AnalysesURL *string `json:"analyses_url,omitempty"`
+	// Errors holds any errors that occurred during processing of the upload.
+	Errors []string `json:"errors,omitempty"`
+}
+
+// UploadSARIF uploads the results of a code scanning analysis, read from
+// sarif in the SARIF format. The results are gzip compressed and base64
+// encoded, as expected by GitHub, before being uploaded.
+//
+// The upload is processed asynchronously; use WaitForSARIFProcessing with
+// the returned ID to wait for the resulting analyses.
+//
+// You must use an access token with the security_events scope to use this endpoint.
+// GitHub Apps must have the security_events write permission to use this endpoint.
+//
+// GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/code-scanning/#upload-an-analysis-as-sarif-data
+func (s *CodeScanningService) UploadSARIF(ctx context.Context, owner, repo string, sarif io.Reader, opts *SARIFUploadOptions) (*SARIFID, *Response, error) {
+	if opts == nil {
+		return nil, nil, errors.New("opts must be provided")
+	}
+
+	var buf bytes.Buffer
+	enc := base64.NewEncoder(base64.StdEncoding, &buf)
+	zw := gzip.NewWriter(enc)
+	if _, err := io.Copy(zw, sarif); err != nil {
+		return nil, nil, err
+	}
+	if err := zw.Close(); err != nil {
+		return nil, nil, err
+	}
+	if err := enc.Close(); err != nil {
+		return nil, nil, err
+	}
+
+	u := fmt.Sprintf("repos/%v/%v/code-scanning/sarifs", owner, repo)
+	body := &sarifUploadRequest{
+		CommitSHA:   opts.CommitSHA,
+		Ref:         opts.Ref,
+		Sarif:       buf.String(),
+		CheckoutURI: opts.CheckoutURI,
+		StartedAt:   opts.StartedAt,
+		ToolName:    opts.ToolName,
+	}
+	req, err := s.client.NewRequest("POST", u, body)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// GitHub responds with 202 Accepted, reported as an *AcceptedError
+	// holding the body.
+	sarifID := new(SARIFID)
+	resp, err := s.client.Do(ctx, req, sarifID)
+	if aerr, ok := err.(*AcceptedError); ok {
+		if err := json.Unmarshal(aerr.Raw, sarifID); err != nil {
+			return nil, resp, err
+		}
+		return sarifID, resp, nil
+	}
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return sarifID, resp, nil
+}
+
+// GetSARIF gets the processing status of a SARIF upload.
+//
+// GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/code-scanning/#get-information-about-a-sarif-upload
+func (s *CodeScanningService) GetSARIF(ctx context.Context, owner, repo, sarifID string) (*SARIFUpload, *Response, error) {
+	u := fmt.Sprintf("repos/%v/%v/code-scanning/sarifs/%v", owner, repo, sarifID)
+
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	sarifUpload := new(SARIFUpload)
+	resp, err := s.client.Do(ctx, req, sarifUpload)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return sarifUpload, resp, nil
+}
+
+// SARIFProcessingError occurs when GitHub fails to process a SARIF upload.
+type SARIFProcessingError struct {
+	SARIFID string
+	Errors  []string // errors reported by GitHub
+}
+
+func (e *SARIFProcessingError) Error() string {
+	return fmt.Sprintf("processing of SARIF upload %v failed: %v", e.SARIFID, strings.Join(e.Errors, "; "))
+}
+
+// SARIFProcessingOptions specifies the optional parameters to the
+// CodeScanningService.WaitForSARIFProcessing method.
+type SARIFProcessingOptions struct {
+	// InitialInterval is the delay before polling again the first time
+	// the upload is still pending. It doubles after each poll, up to
+	// MaxInterval. Default: 1 second.
+	InitialInterval time.Duration
+
+	// MaxInterval is the maximum delay between two polls. Default: 16 seconds.
+	MaxInterval time.Duration
+}
+
+// WaitForSARIFProcessing polls the processing status of a SARIF upload
+// until it is processed, and returns the IDs of the resulting analyses.
+// If processing failed, a *SARIFProcessingError is returned. The delay
+// between polls increases exponentially; use ctx to bound the total wait.
+//
+// GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/code-scanning/#get-information-about-a-sarif-upload
+func (s *CodeScanningService) WaitForSARIFProcessing(ctx context.Context, owner, repo, sarifID string, opts *SARIFProcessingOptions) ([]int64, *Response, error) {
+	interval, maxInterval := time.Second, 16*time.Second
+	if opts != nil {
+		if opts.InitialInterval > 0 {
+			interval = opts.InitialInterval
+		}
+		if opts.MaxInterval > 0 {
+			maxInterval = opts.MaxInterval
+		}
+	}
+
+	for {
+		upload, resp, err := s.GetSARIF(ctx, owner, repo, sarifID)
+		if err != nil {
+			return nil, resp, err
+		}
+
+		switch upload.GetProcessingStatus() {
+		case SARIFProcessingComplete:
+			return s.listSARIFAnalysisIDs(ctx, owner, repo, sarifID)
+		case SARIFProcessingFailed:
+			return nil, resp, &SARIFProcessingError{SARIFID: sarifID, Errors: upload.Errors}
+		}
+
+		if err := sleepUntil(ctx, time.Now().Add(interval)); err != nil {
+			return nil, resp, err
+		}
+
+		if interval *= 2; interval > maxInterval {
+			interval = maxInterval
+		}
+	}
+}
+
+// listSARIFAnalysisIDs returns the IDs of all the analyses of a SARIF upload.
+func (s *CodeScanningService) listSARIFAnalysisIDs(ctx context.Context, owner, repo, sarifID string) ([]int64, *Response, error) {
+	opts := &AnalysesListOptions{SARIFID: sarifID, ListOptions: ListOptions{PerPage: 100}}
+	var ids []int64
+	for {
+		analyses, resp, err := s.ListAnalysesForRepo(ctx, owner, repo, opts)
+		if err != nil {
+			return nil, resp, err
+		}
+		for _, a := range analyses {
+			ids = append(ids, a.GetID())
+		}
+		if resp.NextPage == 0 {
+			return ids, resp, nil
+		}
+		opts.Page = resp.NextPage
+	}
+}
+
+// Languages that can be analyzed by the CodeQL default setup.
+const (
+	CodeQLLanguageCCPP                 = "c-cpp"
+	CodeQLLanguageCSharp               = "csharp"
+	CodeQLLanguageGo                   = "go"
+	CodeQLLanguageJavaKotlin           = "java-kotlin"
+	CodeQLLanguageJavaScriptTypeScript = "javascript-typescript"
+	CodeQLLanguagePython               = "python"
+	CodeQLLanguageRuby                 = "ruby"
+	CodeQLLanguageSwift                = "swift"
+)
+
+// Query suites that can be run by the CodeQL default setup.
+const (
+	CodeQLQuerySuiteDefault  = "default"
+	CodeQLQuerySuiteExtended = "extended"
+)
+
+// DefaultSetupConfiguration represents the CodeQL default setup
+// configuration of a repository.
+type DefaultSetupConfiguration struct {
+	// Possible values for State are: "configured" and "not-configured".
+	State *string `json:"state,omitempty"`
+	// Languages are CodeQLLanguage constants, e.g. CodeQLLanguageGo.
+	Languages []string `json:"languages,omitempty"`
+	// QuerySuite is one of CodeQLQuerySuiteDefault or CodeQLQuerySuiteExtended.
+	QuerySuite *string `json:"query_suite,omitempty"`
+	// Possible values for Schedule are: "weekly".
+	Schedule  *string    `json:"schedule,omitempty"`
+	UpdatedAt *Timestamp `json:"updated_at,omitempty"`
+}
+
+// UpdateDefaultSetupConfigurationOptions specifies the parameters to the
+// CodeScanningService.UpdateDefaultSetupConfiguration method.
+type UpdateDefaultSetupConfigurationOptions struct {
+	// State is the state to set the default setup to. Possible values are:
+	// "configured" and "not-configured".
+	State string `json:"state"`
+	// QuerySuite is one of CodeQLQuerySuiteDefault or CodeQLQuerySuiteExtended.
+	// (Optional.)
+	QuerySuite *string `json:"query_suite,omitempty"`
+	// Languages are the CodeQLLanguage constants of the languages to
+	// analyze. If empty, all the supported languages found in the repository
+	// are analyzed. (Optional.)
+	Languages []string `json:"languages,omitempty"`
+}
+
+// UpdateDefaultSetupConfigurationResponse represents the workflow run
+// triggered by an update of the CodeQL default setup configuration.
+type UpdateDefaultSetupConfigurationResponse struct {
+	RunID  *int64  `json:"run_id,omitempty"`
+	RunURL *string `json:"run_url,omitempty"`
+}
+
+// GetDefaultSetupConfiguration gets the CodeQL default setup configuration
+// of a repository.
+//
+// GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/code-scanning/#get-a-code-scanning-default-setup-configuration
+func (s *CodeScanningService) GetDefaultSetupConfiguration(ctx context.Context, owner, repo string) (*DefaultSetupConfiguration, *Response, error) {
+	u := fmt.Sprintf("repos/%v/%v/code-scanning/default-setup", owner, repo)
+
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	cfg := new(DefaultSetupConfiguration)
+	resp, err := s.client.Do(ctx, req, cfg)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return cfg, resp, nil
+}
+
+// UpdateDefaultSetupConfiguration updates the CodeQL default setup
+// configuration of a repository.
+//
+// This method might return an *AcceptedError and a status code of
+// 202. This is because this is the status that GitHub returns to signify that
+// it has now scheduled the update in a background task. In this event, the
+// UpdateDefaultSetupConfigurationResponse value will be returned, which
+// includes the details about the workflow run.
+//
+// GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/code-scanning/#update-a-code-scanning-default-setup-configuration
+func (s *CodeScanningService) UpdateDefaultSetupConfiguration(ctx context.Context, owner, repo string, opts *UpdateDefaultSetupConfigurationOptions) (*UpdateDefaultSetupConfigurationResponse, *Response, error) {
+	u := fmt.Sprintf("repos/%v/%v/code-scanning/default-setup", owner, repo)
+
+	req, err := s.client.NewRequest("PATCH", u, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	run := new(UpdateDefaultSetupConfigurationResponse)
+	resp, err := s.client.Do(ctx, req, run)
+	if err != nil {
+		// Persist AcceptedError's metadata to the UpdateDefaultSetupConfigurationResponse object.
+		if aerr, ok := err.(*AcceptedError); ok {
+			if err := json.Unmarshal(aerr.Raw, run); err != nil {
+				return run, resp, err
+			}
+
+			return run, resp, err
+		}
+		return nil, resp, err
+	}
+
+	return run, resp, nil
+}
@@ -6,10 +6,16 @@
 package github
 
 import (
+	"bytes"
+	"compress/gzip"
 	"context"
+	"encoding/base64"
+	"encoding/json"
 	"fmt"
+	"io/ioutil"
 	"net/http"
 	"reflect"
+	"strings"
 	"testing"
 	"time"
 )
@@ -193,3 +199,327 @@ func TestActionsService_GetAlert(t *testing.T) {
 		return resp, err
 	})
 }
+
+func TestCodeScanningService_ListAnalysesForRepo(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/code-scanning/analyses", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testFormValues(t, r, values{"sarif_id": "8981cd8e", "ref": "heads/main"})
+		fmt.Fprint(w, `[{
+			"id": 201,
+			"ref": "refs/heads/main",
+			"commit_sha": "d99612c3e1f2970085cfbaeadf8f010ef69bad83",
+			"analysis_key": ".github/workflows/codeql-analysis.yml:analyze",
+			"environment": "{\"language\":\"python\"}",
+			"error": "",
+			"category": "language:python",
+			"created_at": "2020-08-27T15:05:21Z",
+			"results_count": 17,
+			"rules_count": 49,
+			"url": "https://api.github.com/repos/o/r/code-scanning/analyses/201",
+			"sarif_id": "8981cd8e",
+			"tool": {"name": "CodeQL", "guid": null, "version": "2.4.0"},
+			"deletable": true,
+			"warning": ""
+		}]`)
+	})
+
+	opts := &AnalysesListOptions{SARIFID: "8981cd8e", Ref: "heads/main"}
+	ctx := context.Background()
+	analyses, _, err := client.CodeScanning.ListAnalysesForRepo(ctx, "o", "r", opts)
+	if err != nil {
+		t.Errorf("CodeScanning.ListAnalysesForRepo returned error: %v", err)
+	}
+
+	want := []*ScanningAnalysis{{
+		ID:           Int64(201),
+		Ref:          String("refs/heads/main"),
+		CommitSHA:    String("d99612c3e1f2970085cfbaeadf8f010ef69bad83"),
+		AnalysisKey:  String(".github/workflows/codeql-analysis.yml:analyze"),
+		Environment:  String(`{"language":"python"}`),
+		Error:        String(""),
+		Category:     String("language:python"),
+		CreatedAt:    &Timestamp{time.Date(2020, time.August, 27, 15, 5, 21, 0, time.UTC)},
+		ResultsCount: Int(17),
+		RulesCount:   Int(49),
+		URL:          String("https://api.github.com/repos/o/r/code-scanning/analyses/201"),
+		SARIFID:      String("8981cd8e"),
+		Tool:         &Tool{Name: String("CodeQL"), Version: String("2.4.0")},
+		Deletable:    Bool(true),
+		Warning:      String(""),
+	}}
+	if !reflect.DeepEqual(analyses, want) {
+		t.Errorf("CodeScanning.ListAnalysesForRepo returned %+v, want %+v", analyses, want)
+	}
+
+	const methodName = "ListAnalysesForRepo"
+	testBadOptions(t, methodName, func() (err error) {
+		_, _, err = client.CodeScanning.ListAnalysesForRepo(ctx, "\n", "\n", opts)
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.CodeScanning.ListAnalysesForRepo(ctx, "o", "r", opts)
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
+
+func TestCodeScanningService_UploadSARIF(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	const sarif = `{"version":"2.1.0","runs":[]}`
+
+	mux.HandleFunc("/repos/o/r/code-scanning/sarifs", func(w http.ResponseWriter, r *http.Request) {
+		v := new(sarifUploadRequest)
+		json.NewDecoder(r.Body).Decode(v)
+
+		testMethod(t, r, "POST")
+		want := &sarifUploadRequest{CommitSHA: "abc", Ref: "refs/heads/main", ToolName: "scanner", Sarif: v.Sarif}
+		if !reflect.DeepEqual(v, want) {
+			t.Errorf("Request body = %+v, want %+v", v, want)
+		}
+
+		gz, err := base64.StdEncoding.DecodeString(v.Sarif)
+		if err != nil {
+			t.Fatalf("Request sarif is not base64 encoded: %v", err)
+		}
+		zr, err := gzip.NewReader(bytes.NewReader(gz))
+		if err != nil {
+			t.Fatalf("Request sarif is not gzip compressed: %v", err)
+		}
+		got, _ := ioutil.ReadAll(zr)
+		if string(got) != sarif {
+			t.Errorf("Request sarif = %q, want %q", got, sarif)
+		}
+
+		w.WriteHeader(http.StatusAccepted)
+		fmt.Fprint(w, `{"id":"47177e22","url":"https://api.github.com/repos/o/r/code-scanning/sarifs/47177e22"}`)
+	})
+
+	opts := &SARIFUploadOptions{CommitSHA: "abc", Ref: "refs/heads/main", ToolName: "scanner"}
+	ctx := context.Background()
+	sarifID, _, err := client.CodeScanning.UploadSARIF(ctx, "o", "r", strings.NewReader(sarif), opts)
+	if err != nil {
+		t.Errorf("CodeScanning.UploadSARIF returned error: %v", err)
+	}
+
+	want := &SARIFID{ID: String("47177e22"), URL: String("https://api.github.com/repos/o/r/code-scanning/sarifs/47177e22")}
+	if !reflect.DeepEqual(sarifID, want) {
+		t.Errorf("CodeScanning.UploadSARIF returned %+v, want %+v", sarifID, want)
+	}
+
+	if _, _, err := client.CodeScanning.UploadSARIF(ctx, "o", "r", strings.NewReader(sarif), nil); err == nil {
+		t.Errorf("CodeScanning.UploadSARIF with nil opts returned no error")
+	}
+
+	const methodName = "UploadSARIF"
+	testBadOptions(t, methodName, func() (err error) {
+		_, _, err = client.CodeScanning.UploadSARIF(ctx, "\n", "\n", strings.NewReader(sarif), opts)
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.CodeScanning.UploadSARIF(ctx, "o", "r", strings.NewReader(sarif), opts)
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
+
+func TestCodeScanningService_GetSARIF(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/code-scanning/sarifs/47177e22", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"processing_status":"failed","analyses_url":"u","errors":["e1","e2"]}`)
+	})
+
+	ctx := context.Background()
+	upload, _, err := client.CodeScanning.GetSARIF(ctx, "o", "r", "47177e22")
+	if err != nil {
+		t.Errorf("CodeScanning.GetSARIF returned error: %v", err)
+	}
+
+	want := &SARIFUpload{ProcessingStatus: String("failed"), AnalysesURL: String("u"), Errors: []string{"e1", "e2"}}
+	if !reflect.DeepEqual(upload, want) {
+		t.Errorf("CodeScanning.GetSARIF returned %+v, want %+v", upload, want)
+	}
+
+	const methodName = "GetSARIF"
+	testBadOptions(t, methodName, func() (err error) {
+		_, _, err = client.CodeScanning.GetSARIF(ctx, "\n", "\n", "\n")
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.CodeScanning.GetSARIF(ctx, "o", "r", "47177e22")
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
+
+func TestCodeScanningService_WaitForSARIFProcessing(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	polls := 0
+	mux.HandleFunc("/repos/o/r/code-scanning/sarifs/47177e22", func(w http.ResponseWriter, r *http.Request) {
+		polls++
+		if polls < 3 {
+			fmt.Fprint(w, `{"processing_status":"pending"}`)
+			return
+		}
+		fmt.Fprint(w, `{"processing_status":"complete"}`)
+	})
+	mux.HandleFunc("/repos/o/r/code-scanning/analyses", func(w http.ResponseWriter, r *http.Request) {
+		switch r.FormValue("page") {
+		case "":
+			testFormValues(t, r, values{"sarif_id": "47177e22", "per_page": "100"})
+			w.Header().Set("Link", `<https://api.github.com/repos/o/r/code-scanning/analyses?sarif_id=47177e22&per_page=100&page=2>; rel="next"`)
+			fmt.Fprint(w, `[{"id":1},{"id":2}]`)
+		default:
+			fmt.Fprint(w, `[{"id":3}]`)
+		}
+	})
+
+	ctx := context.Background()
+	opts := &SARIFProcessingOptions{InitialInterval: time.Millisecond, MaxInterval: time.Millisecond}
+	ids, _, err := client.CodeScanning.WaitForSARIFProcessing(ctx, "o", "r", "47177e22", opts)
+	if err != nil {
+		t.Fatalf("CodeScanning.WaitForSARIFProcessing returned error: %v", err)
+	}
+	if want := []int64{1, 2, 3}; !reflect.DeepEqual(ids, want) {
+		t.Errorf("CodeScanning.WaitForSARIFProcessing returned %v, want %v", ids, want)
+	}
+	if polls != 3 {
+		t.Errorf("CodeScanning.WaitForSARIFProcessing polled %v times, want 3", polls)
+	}
+
+	const methodName = "WaitForSARIFProcessing"
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.CodeScanning.WaitForSARIFProcessing(ctx, "o", "r", "47177e22", opts)
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
+
+func TestCodeScanningService_WaitForSARIFProcessing_failed(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/code-scanning/sarifs/47177e22", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"processing_status":"failed","errors":["invalid SARIF"]}`)
+	})
+
+	ctx := context.Background()
+	_, _, err := client.CodeScanning.WaitForSARIFProcessing(ctx, "o", "r", "47177e22", nil)
+	perr, ok := err.(*SARIFProcessingError)
+	if !ok {
+		t.Fatalf("CodeScanning.WaitForSARIFProcessing returned error %#v, want *SARIFProcessingError", err)
+	}
+	want := &SARIFProcessingError{SARIFID: "47177e22", Errors: []string{"invalid SARIF"}}
+	if !reflect.DeepEqual(perr, want) {
+		t.Errorf("CodeScanning.WaitForSARIFProcessing returned error %+v, want %+v", perr, want)
+	}
+}
+
+func TestCodeScanningService_GetDefaultSetupConfiguration(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/code-scanning/default-setup", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{
+			"state": "configured",
+			"languages": ["go", "javascript-typescript"],
+			"query_suite": "extended",
+			"schedule": "weekly",
+			"updated_at": `+referenceTimeStr+`
+		}`)
+	})
+
+	ctx := context.Background()
+	cfg, _, err := client.CodeScanning.GetDefaultSetupConfiguration(ctx, "o", "r")
+	if err != nil {
+		t.Errorf("CodeScanning.GetDefaultSetupConfiguration returned error: %v", err)
+	}
+
+	want := &DefaultSetupConfiguration{
+		State:      String("configured"),
+		Languages:  []string{CodeQLLanguageGo, CodeQLLanguageJavaScriptTypeScript},
+		QuerySuite: String(CodeQLQuerySuiteExtended),
+		Schedule:   String("weekly"),
+		UpdatedAt:  &Timestamp{referenceTime},
+	}
+	if !reflect.DeepEqual(cfg, want) {
+		t.Errorf("CodeScanning.GetDefaultSetupConfiguration returned %+v, want %+v", cfg, want)
+	}
+
+	const methodName = "GetDefaultSetupConfiguration"
+	testBadOptions(t, methodName, func() (err error) {
+		_, _, err = client.CodeScanning.GetDefaultSetupConfiguration(ctx, "\n", "\n")
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.CodeScanning.GetDefaultSetupConfiguration(ctx, "o", "r")
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
+
+func TestCodeScanningService_UpdateDefaultSetupConfiguration(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/code-scanning/default-setup", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PATCH")
+		testBody(t, r, `{"state":"configured","query_suite":"default","languages":["go"]}`+"\n")
+		w.WriteHeader(http.StatusAccepted)
+		fmt.Fprint(w, `{"run_id":42,"run_url":"https://api.github.com/repos/o/r/actions/runs/42"}`)
+	})
+
+	opts := &UpdateDefaultSetupConfigurationOptions{
+		State:      "configured",
+		QuerySuite: String(CodeQLQuerySuiteDefault),
+		Languages:  []string{CodeQLLanguageGo},
+	}
+	ctx := context.Background()
+	run, _, err := client.CodeScanning.UpdateDefaultSetupConfiguration(ctx, "o", "r", opts)
+	if _, ok := err.(*AcceptedError); !ok {
+		t.Errorf("CodeScanning.UpdateDefaultSetupConfiguration returned error: %v (want AcceptedError)", err)
+	}
+
+	want := &UpdateDefaultSetupConfigurationResponse{RunID: Int64(42), RunURL: String("https://api.github.com/repos/o/r/actions/runs/42")}
+	if !reflect.DeepEqual(run, want) {
+		t.Errorf("CodeScanning.UpdateDefaultSetupConfiguration returned %+v, want %+v", run, want)
+	}
+
+	const methodName = "UpdateDefaultSetupConfiguration"
+	testBadOptions(t, methodName, func() (err error) {
+		_, _, err = client.CodeScanning.UpdateDefaultSetupConfiguration(ctx, "\n", "\n", opts)
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.CodeScanning.UpdateDefaultSetupConfiguration(ctx, "o", "r", opts)
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
@@ -0,0 +1,304 @@
+// Copyright 2021 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+)
+
+// CopilotService handles communication with the Copilot related
+// methods of the GitHub API.
+//
+// GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/copilot/
+type CopilotService service
+
+// CopilotSeatBreakdown represents the breakdown of the Copilot seats of an
+// organization for the current billing cycle.
+type CopilotSeatBreakdown struct {
+	Total               *int64 `json:"total,omitempty"`
+	AddedThisCycle      *int64 `json:"added_this_cycle,omitempty"`
+	PendingCancellation *int64 `json:"pending_cancellation,omitempty"`
+	PendingInvitation   *int64 `json:"pending_invitation,omitempty"`
+	ActiveThisCycle     *int64 `json:"active_this_cycle,omitempty"`
+	InactiveThisCycle   *int64 `json:"inactive_this_cycle,omitempty"`
+}
+
+// CopilotOrganizationDetails represents the Copilot billing details and
+// settings of an organization.
+type CopilotOrganizationDetails struct {
+	SeatBreakdown *CopilotSeatBreakdown `json:"seat_breakdown,omitempty"`
+	// Possible values for PublicCodeSuggestions are: allow, block, unconfigured.
+	PublicCodeSuggestions *string `json:"public_code_suggestions,omitempty"`
+	// IDEChat, PlatformChat and CLI are the policies for Copilot Chat in the
+	// IDEs, Copilot Chat on GitHub.com and Copilot in the CLI.
+	// Possible values are: enabled, disabled, unconfigured.
+	IDEChat      *string `json:"ide_chat,omitempty"`
+	PlatformChat *string `json:"platform_chat,omitempty"`
+	CLI          *string `json:"cli,omitempty"`
+	// Possible values for SeatManagementSetting are: assign_all,
+	// assign_selected, disabled, unconfigured.
+	SeatManagementSetting *string `json:"seat_management_setting,omitempty"`
+	// Possible values for PlanType are: business, enterprise.
+	PlanType *string `json:"plan_type,omitempty"`
+}
+
+// CopilotSeatDetails represents the details of a Copilot seat.
+type CopilotSeatDetails struct {
+	// Assignee is the assignee of the seat: a *User, a *Team or an
+	// *Organization. Use GetUser, GetTeam and GetOrganization to access it.
+	Assignee      interface{} `json:"assignee"`
+	AssigningTeam *Team       `json:"assigning_team,omitempty"`
+	// PendingCancellationDate is the date, formatted as YYYY-MM-DD, on which
+	// the seat is cancelled, if its cancellation is pending.
+	PendingCancellationDate *string    `json:"pending_cancellation_date,omitempty"`
+	LastActivityAt          *Timestamp `json:"last_activity_at,omitempty"`
+	LastActivityEditor      *string    `json:"last_activity_editor,omitempty"`
+	CreatedAt               *Timestamp `json:"created_at,omitempty"`
+	UpdatedAt               *Timestamp `json:"updated_at,omitempty"`
+	// Possible values for PlanType are: business, enterprise, unknown.
+	PlanType *string `json:"plan_type,omitempty"`
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface. It decodes the
+// assignee of the seat according to its type.
+func (cp *CopilotSeatDetails) UnmarshalJSON(data []byte) error {
+	type seatDetails CopilotSeatDetails
+	var seat struct {
+		*seatDetails
+		Assignee json.RawMessage `json:"assignee"`
+	}
+	seat.seatDetails = (*seatDetails)(cp)
+	if err := json.Unmarshal(data, &seat); err != nil {
+		return err
+	}
+
+	cp.Assignee = nil
+	if len(seat.Assignee) == 0 || string(seat.Assignee) == "null" {
+		return nil
+	}
+
+	var probe struct {
+		Type *string `json:"type"`
+		Slug *string `json:"slug"`
+	}
+	if err := json.Unmarshal(seat.Assignee, &probe); err != nil {
+		return err
+	}
+
+	switch {
+	case probe.Type != nil && *probe.Type == "User":
+		cp.Assignee = new(User)
+	case probe.Type != nil && *probe.Type == "Organization":
+		cp.Assignee = new(Organization)
+	case probe.Slug != nil:
+		cp.Assignee = new(Team)
+	default:
+		return errors.New("unsupported assignee type")
+	}
+	return json.Unmarshal(seat.Assignee, cp.Assignee)
+}
+
+// GetUser returns the assignee of the seat if it is a user.
+func (cp *CopilotSeatDetails) GetUser() (*User, bool) {
+	u, ok := cp.Assignee.(*User)
+	return u, ok
+}
+
+// GetTeam returns the assignee of the seat if it is a team.
+func (cp *CopilotSeatDetails) GetTeam() (*Team, bool) {
+	t, ok := cp.Assignee.(*Team)
+	return t, ok
+}
+
+// GetOrganization returns the assignee of the seat if it is an organization.
+func (cp *CopilotSeatDetails) GetOrganization() (*Organization, bool) {
+	o, ok := cp.Assignee.(*Organization)
+	return o, ok
+}
+
+// ListCopilotSeatsResponse represents the Copilot seats of an organization.
+type ListCopilotSeatsResponse struct {
+	TotalSeats *int64                `json:"total_seats,omitempty"`
+	Seats      []*CopilotSeatDetails `json:"seats,omitempty"`
+}
+
+// SeatAssignments represents the number of Copilot seats created by an
+// assignment.
+type SeatAssignments struct {
+	SeatsCreated *int `json:"seats_created,omitempty"`
+}
+
+// SeatCancellations represents the number of Copilot seats set to be
+// cancelled by a removal.
+type SeatCancellations struct {
+	SeatsCancelled *int `json:"seats_cancelled,omitempty"`
+}
+
+// GetCopilotBilling gets the Copilot billing details and settings of an
+// organization.
+//
+// GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/copilot/#get-copilot-seat-information-and-settings-for-an-organization
+func (s *CopilotService) GetCopilotBilling(ctx context.Context, org string) (*CopilotOrganizationDetails, *Response, error) {
+	u := fmt.Sprintf("orgs/%v/copilot/billing", org)
+
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	details := new(CopilotOrganizationDetails)
+	resp, err := s.client.Do(ctx, req, details)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return details, resp, nil
+}
+
+// ListCopilotSeats lists the Copilot seats of an organization, including
+// the seats pending cancellation.
+//
+// GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/copilot/#list-all-copilot-seat-assignments-for-an-organization
+func (s *CopilotService) ListCopilotSeats(ctx context.Context, org string, opts *ListOptions) (*ListCopilotSeatsResponse, *Response, error) {
+	u := fmt.Sprintf("orgs/%v/copilot/billing/seats", org)
+	u, err := addOptions(u, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	seats := new(ListCopilotSeatsResponse)
+	resp, err := s.client.Do(ctx, req, seats)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return seats, resp, nil
+}
+
+// AddCopilotTeams adds Copilot seats for all the members of the given teams
+// of an organization.
+//
+// GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/copilot/#add-teams-to-the-copilot-subscription-for-an-organization
+func (s *CopilotService) AddCopilotTeams(ctx context.Context, org string, teamNames []string) (*SeatAssignments, *Response, error) {
+	u := fmt.Sprintf("orgs/%v/copilot/billing/selected_teams", org)
+
+	body := struct {
+		SelectedTeams []string `json:"selected_teams"`
+	}{teamNames}
+	req, err := s.client.NewRequest("POST", u, body)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	seatAssignments := new(SeatAssignments)
+	resp, err := s.client.Do(ctx, req, seatAssignments)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return seatAssignments, resp, nil
+}
+
+// RemoveCopilotTeams sets the Copilot seats of all the members of the given
+// teams of an organization to be cancelled at the end of the billing cycle.
+//
+// GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/copilot/#remove-teams-from-the-copilot-subscription-for-an-organization
+func (s *CopilotService) RemoveCopilotTeams(ctx context.Context, org string, teamNames []string) (*SeatCancellations, *Response, error) {
+	u := fmt.Sprintf("orgs/%v/copilot/billing/selected_teams", org)
+
+	body := struct {
+		SelectedTeams []string `json:"selected_teams"`
+	}{teamNames}
+	req, err := s.client.NewRequest("DELETE", u, body)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	seatCancellations := new(SeatCancellations)
+	resp, err := s.client.Do(ctx, req, seatCancellations)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return seatCancellations, resp, nil
+}
+
+// AddCopilotUsers adds Copilot seats for the given members of an
+// organization.
+//
+// GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/copilot/#add-users-to-the-copilot-subscription-for-an-organization
+func (s *CopilotService) AddCopilotUsers(ctx context.Context, org string, users []string) (*SeatAssignments, *Response, error) {
+	u := fmt.Sprintf("orgs/%v/copilot/billing/selected_users", org)
+
+	body := struct {
+		SelectedUsers []string `json:"selected_usernames"`
+	}{users}
+	req, err := s.client.NewRequest("POST", u, body)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	seatAssignments := new(SeatAssignments)
+	resp, err := s.client.Do(ctx, req, seatAssignments)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return seatAssignments, resp, nil
+}
+
+// RemoveCopilotUsers sets the Copilot seats of the given members of an
+// organization to be cancelled at the end of the billing cycle.
+//
+// GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/copilot/#remove-users-from-the-copilot-subscription-for-an-organization
+func (s *CopilotService) RemoveCopilotUsers(ctx context.Context, org string, users []string) (*SeatCancellations, *Response, error) {
+	u := fmt.Sprintf("orgs/%v/copilot/billing/selected_users", org)
+
+	body := struct {
+		SelectedUsers []string `json:"selected_usernames"`
+	}{users}
+	req, err := s.client.NewRequest("DELETE", u, body)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	seatCancellations := new(SeatCancellations)
+	resp, err := s.client.Do(ctx, req, seatCancellations)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return seatCancellations, resp, nil
+}
+
+// GetSeatDetails gets the details of the Copilot seat of a member of an
+// organization.
+//
+// GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/copilot/#get-copilot-seat-assignment-details-for-a-user
+func (s *CopilotService) GetSeatDetails(ctx context.Context, org, user string) (*CopilotSeatDetails, *Response, error) {
+	u := fmt.Sprintf("orgs/%v/members/%v/copilot", org, user)
+
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	seatDetails := new(CopilotSeatDetails)
+	resp, err := s.client.Do(ctx, req, seatDetails)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return seatDetails, resp, nil
+}
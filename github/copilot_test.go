@@ -0,0 +1,418 @@
+// Copyright 2021 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+func TestCopilotSeatDetails_UnmarshalJSON(t *testing.T) {
+	tests := map[string]struct {
+		data    string
+		want    *CopilotSeatDetails
+		wantErr bool
+	}{
+		"user": {
+			data: `{"assignee": {"type": "User", "login": "octocat", "id": 1}, "pending_cancellation_date": "2021-02-01"}`,
+			want: &CopilotSeatDetails{
+				Assignee:                &User{Type: String("User"), Login: String("octocat"), ID: Int64(1)},
+				PendingCancellationDate: String("2021-02-01"),
+			},
+		},
+		"team": {
+			data: `{"assignee": {"id": 2, "slug": "justice-league"}}`,
+			want: &CopilotSeatDetails{
+				Assignee: &Team{ID: Int64(2), Slug: String("justice-league")},
+			},
+		},
+		"organization": {
+			data: `{"assignee": {"type": "Organization", "login": "github"}}`,
+			want: &CopilotSeatDetails{
+				Assignee: &Organization{Type: String("Organization"), Login: String("github")},
+			},
+		},
+		"no assignee": {
+			data: `{"assignee": null, "plan_type": "business"}`,
+			want: &CopilotSeatDetails{PlanType: String("business")},
+		},
+		"unsupported assignee": {
+			data:    `{"assignee": {"name": "n"}}`,
+			wantErr: true,
+		},
+		"invalid assignee": {
+			data:    `{"assignee": "octocat"}`,
+			wantErr: true,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			got := new(CopilotSeatDetails)
+			err := json.Unmarshal([]byte(tc.data), got)
+			if tc.wantErr {
+				if err == nil {
+					t.Errorf("CopilotSeatDetails.UnmarshalJSON returned no error, want an error")
+				}
+				return
+			}
+			if err != nil {
+				t.Errorf("CopilotSeatDetails.UnmarshalJSON returned error: %v", err)
+			}
+			if !reflect.DeepEqual(got, tc.want) {
+				t.Errorf("CopilotSeatDetails.UnmarshalJSON returned %+v, want %+v", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestCopilotSeatDetails_GetAssignee(t *testing.T) {
+	seat := &CopilotSeatDetails{Assignee: &Team{ID: Int64(1)}}
+
+	if team, ok := seat.GetTeam(); !ok || team.GetID() != 1 {
+		t.Errorf("CopilotSeatDetails.GetTeam returned %+v, %v, want the team", team, ok)
+	}
+	if user, ok := seat.GetUser(); ok || user != nil {
+		t.Errorf("CopilotSeatDetails.GetUser returned %+v, %v, want nil, false", user, ok)
+	}
+	if org, ok := seat.GetOrganization(); ok || org != nil {
+		t.Errorf("CopilotSeatDetails.GetOrganization returned %+v, %v, want nil, false", org, ok)
+	}
+}
+
+func TestCopilotService_GetCopilotBilling(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/orgs/o/copilot/billing", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{
+			"seat_breakdown": {
+				"total": 12,
+				"added_this_cycle": 9,
+				"pending_invitation": 0,
+				"pending_cancellation": 1,
+				"active_this_cycle": 12,
+				"inactive_this_cycle": 11
+			},
+			"seat_management_setting": "assign_selected",
+			"ide_chat": "enabled",
+			"platform_chat": "disabled",
+			"cli": "unconfigured",
+			"public_code_suggestions": "block",
+			"plan_type": "business"
+		}`)
+	})
+
+	ctx := context.Background()
+	got, _, err := client.Copilot.GetCopilotBilling(ctx, "o")
+	if err != nil {
+		t.Errorf("Copilot.GetCopilotBilling returned error: %v", err)
+	}
+
+	want := &CopilotOrganizationDetails{
+		SeatBreakdown: &CopilotSeatBreakdown{
+			Total:               Int64(12),
+			AddedThisCycle:      Int64(9),
+			PendingInvitation:   Int64(0),
+			PendingCancellation: Int64(1),
+			ActiveThisCycle:     Int64(12),
+			InactiveThisCycle:   Int64(11),
+		},
+		SeatManagementSetting: String("assign_selected"),
+		IDEChat:               String("enabled"),
+		PlatformChat:          String("disabled"),
+		CLI:                   String("unconfigured"),
+		PublicCodeSuggestions: String("block"),
+		PlanType:              String("business"),
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Copilot.GetCopilotBilling returned %+v, want %+v", got, want)
+	}
+
+	const methodName = "GetCopilotBilling"
+	testBadOptions(t, methodName, func() (err error) {
+		_, _, err = client.Copilot.GetCopilotBilling(ctx, "\n")
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.Copilot.GetCopilotBilling(ctx, "o")
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
+
+func TestCopilotService_ListCopilotSeats(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/orgs/o/copilot/billing/seats", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testFormValues(t, r, values{"per_page": "2"})
+		fmt.Fprint(w, `{
+			"total_seats": 2,
+			"seats": [
+				{
+					"created_at": `+referenceTimeStr+`,
+					"updated_at": `+referenceTimeStr+`,
+					"pending_cancellation_date": null,
+					"last_activity_at": `+referenceTimeStr+`,
+					"last_activity_editor": "vscode/1.77.3/copilot/1.86.82",
+					"plan_type": "business",
+					"assignee": {"login": "octocat", "id": 1, "type": "User"},
+					"assigning_team": {"id": 1, "slug": "justice-league"}
+				},
+				{
+					"created_at": `+referenceTimeStr+`,
+					"pending_cancellation_date": "2021-02-01",
+					"assignee": {"login": "octokitten", "id": 2, "type": "User"}
+				}
+			]
+		}`)
+	})
+
+	opts := &ListOptions{PerPage: 2}
+	ctx := context.Background()
+	got, _, err := client.Copilot.ListCopilotSeats(ctx, "o", opts)
+	if err != nil {
+		t.Errorf("Copilot.ListCopilotSeats returned error: %v", err)
+	}
+
+	want := &ListCopilotSeatsResponse{
+		TotalSeats: Int64(2),
+		Seats: []*CopilotSeatDetails{
+			{
+				Assignee:           &User{Login: String("octocat"), ID: Int64(1), Type: String("User")},
+				AssigningTeam:      &Team{ID: Int64(1), Slug: String("justice-league")},
+				LastActivityAt:     &Timestamp{referenceTime},
+				LastActivityEditor: String("vscode/1.77.3/copilot/1.86.82"),
+				CreatedAt:          &Timestamp{referenceTime},
+				UpdatedAt:          &Timestamp{referenceTime},
+				PlanType:           String("business"),
+			},
+			{
+				Assignee:                &User{Login: String("octokitten"), ID: Int64(2), Type: String("User")},
+				PendingCancellationDate: String("2021-02-01"),
+				CreatedAt:               &Timestamp{referenceTime},
+			},
+		},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Copilot.ListCopilotSeats returned %+v, want %+v", got, want)
+	}
+
+	const methodName = "ListCopilotSeats"
+	testBadOptions(t, methodName, func() (err error) {
+		_, _, err = client.Copilot.ListCopilotSeats(ctx, "\n", opts)
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.Copilot.ListCopilotSeats(ctx, "o", opts)
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
+
+func TestCopilotService_AddCopilotTeams(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/orgs/o/copilot/billing/selected_teams", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testBody(t, r, `{"selected_teams":["team1","team2"]}`+"\n")
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprint(w, `{"seats_created": 2}`)
+	})
+
+	ctx := context.Background()
+	got, _, err := client.Copilot.AddCopilotTeams(ctx, "o", []string{"team1", "team2"})
+	if err != nil {
+		t.Errorf("Copilot.AddCopilotTeams returned error: %v", err)
+	}
+
+	want := &SeatAssignments{SeatsCreated: Int(2)}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Copilot.AddCopilotTeams returned %+v, want %+v", got, want)
+	}
+
+	const methodName = "AddCopilotTeams"
+	testBadOptions(t, methodName, func() (err error) {
+		_, _, err = client.Copilot.AddCopilotTeams(ctx, "\n", []string{"team1"})
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.Copilot.AddCopilotTeams(ctx, "o", []string{"team1"})
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
+
+func TestCopilotService_RemoveCopilotTeams(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/orgs/o/copilot/billing/selected_teams", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+		testBody(t, r, `{"selected_teams":["team1"]}`+"\n")
+		fmt.Fprint(w, `{"seats_cancelled": 4}`)
+	})
+
+	ctx := context.Background()
+	got, _, err := client.Copilot.RemoveCopilotTeams(ctx, "o", []string{"team1"})
+	if err != nil {
+		t.Errorf("Copilot.RemoveCopilotTeams returned error: %v", err)
+	}
+
+	want := &SeatCancellations{SeatsCancelled: Int(4)}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Copilot.RemoveCopilotTeams returned %+v, want %+v", got, want)
+	}
+
+	const methodName = "RemoveCopilotTeams"
+	testBadOptions(t, methodName, func() (err error) {
+		_, _, err = client.Copilot.RemoveCopilotTeams(ctx, "\n", []string{"team1"})
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.Copilot.RemoveCopilotTeams(ctx, "o", []string{"team1"})
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
+
+func TestCopilotService_AddCopilotUsers(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/orgs/o/copilot/billing/selected_users", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testBody(t, r, `{"selected_usernames":["user1","user2"]}`+"\n")
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprint(w, `{"seats_created": 2}`)
+	})
+
+	ctx := context.Background()
+	got, _, err := client.Copilot.AddCopilotUsers(ctx, "o", []string{"user1", "user2"})
+	if err != nil {
+		t.Errorf("Copilot.AddCopilotUsers returned error: %v", err)
+	}
+
+	want := &SeatAssignments{SeatsCreated: Int(2)}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Copilot.AddCopilotUsers returned %+v, want %+v", got, want)
+	}
+
+	const methodName = "AddCopilotUsers"
+	testBadOptions(t, methodName, func() (err error) {
+		_, _, err = client.Copilot.AddCopilotUsers(ctx, "\n", []string{"user1"})
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.Copilot.AddCopilotUsers(ctx, "o", []string{"user1"})
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
+
+func TestCopilotService_RemoveCopilotUsers(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/orgs/o/copilot/billing/selected_users", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+		testBody(t, r, `{"selected_usernames":["user1"]}`+"\n")
+		fmt.Fprint(w, `{"seats_cancelled": 1}`)
+	})
+
+	ctx := context.Background()
+	got, _, err := client.Copilot.RemoveCopilotUsers(ctx, "o", []string{"user1"})
+	if err != nil {
+		t.Errorf("Copilot.RemoveCopilotUsers returned error: %v", err)
+	}
+
+	want := &SeatCancellations{SeatsCancelled: Int(1)}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Copilot.RemoveCopilotUsers returned %+v, want %+v", got, want)
+	}
+
+	const methodName = "RemoveCopilotUsers"
+	testBadOptions(t, methodName, func() (err error) {
+		_, _, err = client.Copilot.RemoveCopilotUsers(ctx, "\n", []string{"user1"})
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.Copilot.RemoveCopilotUsers(ctx, "o", []string{"user1"})
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
+
+func TestCopilotService_GetSeatDetails(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/orgs/o/members/u/copilot", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{
+			"created_at": `+referenceTimeStr+`,
+			"pending_cancellation_date": "2021-02-01",
+			"assignee": {"login": "u", "id": 1, "type": "User"},
+			"assigning_team": {"id": 1, "slug": "justice-league"}
+		}`)
+	})
+
+	ctx := context.Background()
+	got, _, err := client.Copilot.GetSeatDetails(ctx, "o", "u")
+	if err != nil {
+		t.Errorf("Copilot.GetSeatDetails returned error: %v", err)
+	}
+
+	want := &CopilotSeatDetails{
+		Assignee:                &User{Login: String("u"), ID: Int64(1), Type: String("User")},
+		AssigningTeam:           &Team{ID: Int64(1), Slug: String("justice-league")},
+		PendingCancellationDate: String("2021-02-01"),
+		CreatedAt:               &Timestamp{referenceTime},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Copilot.GetSeatDetails returned %+v, want %+v", got, want)
+	}
+
+	const methodName = "GetSeatDetails"
+	testBadOptions(t, methodName, func() (err error) {
+		_, _, err = client.Copilot.GetSeatDetails(ctx, "\n", "u")
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.Copilot.GetSeatDetails(ctx, "o", "u")
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
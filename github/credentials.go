@@ -0,0 +1,63 @@
+// Copyright 2021 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+)
+
+// CredentialsService handles communication with the credential related
+// methods of the GitHub API.
+//
+// GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/credentials/
+type CredentialsService service
+
+// MaxRevokedCredentials is the maximum number of credentials which can be
+// revoked by a single request. CredentialsService.Revoke splits the larger
+// lists into requests of up to MaxRevokedCredentials credentials.
+const MaxRevokedCredentials = 1000
+
+// revokeCredentialsRequest represents the body of a credential revocation
+// request.
+type revokeCredentialsRequest struct {
+	Credentials []string `json:"credentials"`
+}
+
+// Revoke submits the given credentials, such as leaked personal access
+// tokens or OAuth tokens, for revocation. GitHub revokes them asynchronously,
+// and notifies their owners. The credentials are sent in batches of up to
+// MaxRevokedCredentials credentials, and Revoke stops at the first batch
+// which fails, returning its Response. It does not require authentication.
+//
+// GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/credentials/#revoke-a-list-of-credentials
+func (s *CredentialsService) Revoke(ctx context.Context, credentials []string) (*Response, error) {
+	for {
+		batch := credentials
+		if len(batch) > MaxRevokedCredentials {
+			batch = batch[:MaxRevokedCredentials]
+		}
+		credentials = credentials[len(batch):]
+
+		resp, err := s.revoke(ctx, batch)
+		if err != nil || len(credentials) == 0 {
+			return resp, err
+		}
+	}
+}
+
+// revoke submits a single batch of credentials for revocation.
+func (s *CredentialsService) revoke(ctx context.Context, credentials []string) (*Response, error) {
+	req, err := s.client.NewRequest("POST", "credentials/revoke", &revokeCredentialsRequest{Credentials: credentials})
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.client.Do(ctx, req, nil)
+	if _, ok := err.(*AcceptedError); ok {
+		return resp, nil
+	}
+	return resp, err
+}
@@ -0,0 +1,83 @@
+// Copyright 2021 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+func TestCredentialsService_Revoke(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/credentials/revoke", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testBody(t, r, `{"credentials":["ghp_1","ghp_2"]}`+"\n")
+		w.WriteHeader(http.StatusAccepted)
+		fmt.Fprint(w, `{}`)
+	})
+
+	ctx := context.Background()
+	resp, err := client.Credentials.Revoke(ctx, []string{"ghp_1", "ghp_2"})
+	if err != nil {
+		t.Errorf("Credentials.Revoke returned error: %v", err)
+	}
+	if resp.StatusCode != http.StatusAccepted {
+		t.Errorf("Credentials.Revoke returned status %v, want %v", resp.StatusCode, http.StatusAccepted)
+	}
+
+	const methodName = "Revoke"
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		return client.Credentials.Revoke(ctx, []string{"ghp_1"})
+	})
+}
+
+func TestCredentialsService_Revoke_batches(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	var sizes []int
+	mux.HandleFunc("/credentials/revoke", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		v := new(revokeCredentialsRequest)
+		json.NewDecoder(r.Body).Decode(v)
+		sizes = append(sizes, len(v.Credentials))
+		if len(sizes) == 3 {
+			http.Error(w, "Unprocessable", http.StatusUnprocessableEntity)
+			return
+		}
+		w.WriteHeader(http.StatusAccepted)
+	})
+
+	credentials := make([]string, 2*MaxRevokedCredentials+1)
+	for i := range credentials {
+		credentials[i] = fmt.Sprintf("ghp_%v", i)
+	}
+	ctx := context.Background()
+	if _, err := client.Credentials.Revoke(ctx, credentials[:2*MaxRevokedCredentials]); err != nil {
+		t.Errorf("Credentials.Revoke returned error: %v", err)
+	}
+	if want := []int{MaxRevokedCredentials, MaxRevokedCredentials}; !reflect.DeepEqual(sizes, want) {
+		t.Errorf("Credentials.Revoke sent batches of %v credentials, want %v", sizes, want)
+	}
+
+	sizes = nil
+	resp, err := client.Credentials.Revoke(ctx, credentials)
+	if err == nil {
+		t.Error("Credentials.Revoke returned nil error, want the error of the failed batch")
+	}
+	if resp == nil || resp.StatusCode != http.StatusUnprocessableEntity {
+		t.Errorf("Credentials.Revoke returned response %v, want the response of the failed batch", resp)
+	}
+	if want := []int{MaxRevokedCredentials, MaxRevokedCredentials, 1}; !reflect.DeepEqual(sizes, want) {
+		t.Errorf("Credentials.Revoke sent batches of %v credentials, want %v", sizes, want)
+	}
+}
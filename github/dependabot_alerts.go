@@ -0,0 +1,336 @@
+// Copyright 2021 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"fmt"
+)
+
+// Dependency represents the vulnerable dependency of a Dependabot alert.
+type Dependency struct {
+	Package      *VulnerabilityPackage `json:"package,omitempty"`
+	ManifestPath *string               `json:"manifest_path,omitempty"`
+	// Possible values for Scope are: "development" and "runtime".
+	Scope *string `json:"scope,omitempty"`
+}
+
+// VulnerabilityPackage represents the package affected by a vulnerability.
+type VulnerabilityPackage struct {
+	Ecosystem *string `json:"ecosystem,omitempty"`
+	Name      *string `json:"name,omitempty"`
+}
+
+// FirstPatchedVersion represents the first version of a package that is not
+// affected by a vulnerability.
+type FirstPatchedVersion struct {
+	Identifier *string `json:"identifier,omitempty"`
+}
+
+// AdvisoryVulnerability represents a vulnerability of a security advisory.
+type AdvisoryVulnerability struct {
+	Package                *VulnerabilityPackage `json:"package,omitempty"`
+	Severity               *string               `json:"severity,omitempty"`
+	VulnerableVersionRange *string               `json:"vulnerable_version_range,omitempty"`
+	FirstPatchedVersion    *FirstPatchedVersion  `json:"first_patched_version,omitempty"`
+
+	// PatchedVersions and VulnerableFunctions are only used by repository
+	// security advisories.
+	PatchedVersions     *string  `json:"patched_versions,omitempty"`
+	VulnerableFunctions []string `json:"vulnerable_functions,omitempty"`
+}
+
+// AdvisoryIdentifier represents an identifier, such as a GHSA or CVE ID, of
+// a security advisory.
+type AdvisoryIdentifier struct {
+	Value *string `json:"value,omitempty"`
+	Type  *string `json:"type,omitempty"`
+}
+
+// AdvisoryReference represents a reference URL of a security advisory.
+type AdvisoryReference struct {
+	URL *string `json:"url,omitempty"`
+}
+
+// AdvisoryCVSS represents the CVSS score of a security advisory.
+type AdvisoryCVSS struct {
+	Score        *float64 `json:"score,omitempty"`
+	VectorString *string  `json:"vector_string,omitempty"`
+}
+
+// AdvisoryCWE represents a common weakness enumeration of a security advisory.
+type AdvisoryCWE struct {
+	CWEID *string `json:"cwe_id,omitempty"`
+	Name  *string `json:"name,omitempty"`
+}
+
+// AdvisoryEPSS represents the exploit prediction scoring system score of a
+// security advisory.
+type AdvisoryEPSS struct {
+	// Percentage is the probability, between 0 and 1, that the
+	// vulnerability will be exploited in the next 30 days.
+	Percentage *float64 `json:"percentage,omitempty"`
+	// Percentile is the share of vulnerabilities with a lower or equal
+	// Percentage.
+	Percentile *float64 `json:"percentile,omitempty"`
+}
+
+// DependabotSecurityAdvisory represents the security advisory of a Dependabot alert.
+type DependabotSecurityAdvisory struct {
+	GHSAID          *string                  `json:"ghsa_id,omitempty"`
+	CVEID           *string                  `json:"cve_id,omitempty"`
+	Summary         *string                  `json:"summary,omitempty"`
+	Description     *string                  `json:"description,omitempty"`
+	Vulnerabilities []*AdvisoryVulnerability `json:"vulnerabilities,omitempty"`
+	Severity        *string                  `json:"severity,omitempty"`
+	CVSS            *AdvisoryCVSS            `json:"cvss,omitempty"`
+	CWEs            []*AdvisoryCWE           `json:"cwes,omitempty"`
+	EPSS            *AdvisoryEPSS            `json:"epss,omitempty"`
+	Identifiers     []*AdvisoryIdentifier    `json:"identifiers,omitempty"`
+	References      []*AdvisoryReference     `json:"references,omitempty"`
+	PublishedAt     *Timestamp               `json:"published_at,omitempty"`
+	UpdatedAt       *Timestamp               `json:"updated_at,omitempty"`
+	WithdrawnAt     *Timestamp               `json:"withdrawn_at,omitempty"`
+}
+
+// DependabotAlert represents a Dependabot alert.
+type DependabotAlert struct {
+	Number *int `json:"number,omitempty"`
+	// Possible values for State are: "auto_dismissed", "dismissed", "fixed" and "open".
+	State                 *string                     `json:"state,omitempty"`
+	Dependency            *Dependency                 `json:"dependency,omitempty"`
+	SecurityAdvisory      *DependabotSecurityAdvisory `json:"security_advisory,omitempty"`
+	SecurityVulnerability *AdvisoryVulnerability      `json:"security_vulnerability,omitempty"`
+	URL                   *string                     `json:"url,omitempty"`
+	HTMLURL               *string                     `json:"html_url,omitempty"`
+	CreatedAt             *Timestamp                  `json:"created_at,omitempty"`
+	UpdatedAt             *Timestamp                  `json:"updated_at,omitempty"`
+	DismissedAt           *Timestamp                  `json:"dismissed_at,omitempty"`
+	DismissedBy           *User                       `json:"dismissed_by,omitempty"`
+	DismissedReason       *string                     `json:"dismissed_reason,omitempty"`
+	DismissedComment      *string                     `json:"dismissed_comment,omitempty"`
+	FixedAt               *Timestamp                  `json:"fixed_at,omitempty"`
+	AutoDismissedAt       *Timestamp                  `json:"auto_dismissed_at,omitempty"`
+	// The repository is only populated by the organization and enterprise
+	// level list methods.
+	Repository *Repository `json:"repository,omitempty"`
+}
+
+// DependabotAlertState represents the state of a Dependabot alert to update.
+type DependabotAlertState struct {
+	// State is the state to set the alert to. Possible values are:
+	// "dismissed" and "open".
+	State string `json:"state"`
+	// DismissedReason is required when State is "dismissed". Possible values
+	// are: "fix_started", "inaccurate", "no_bandwidth", "not_used" and
+	// "tolerable_risk".
+	DismissedReason *string `json:"dismissed_reason,omitempty"`
+	// DismissedComment is an optional comment associated with the dismissal.
+	DismissedComment *string `json:"dismissed_comment,omitempty"`
+}
+
+// ListAlertsOptions specifies the optional parameters to the
+// DependabotService alert listing methods. Filters that accept several
+// values take them as a comma-separated list.
+type ListAlertsOptions struct {
+	// Possible values for State are: "auto_dismissed", "dismissed", "fixed" and "open".
+	State string `url:"state,omitempty"`
+	// Possible values for Severity are: "low", "medium", "high" and "critical".
+	Severity  string `url:"severity,omitempty"`
+	Ecosystem string `url:"ecosystem,omitempty"`
+	Package   string `url:"package,omitempty"`
+	// Manifest is only supported by DependabotService.ListRepoAlerts.
+	Manifest string `url:"manifest,omitempty"`
+	// Possible values for Scope are: "development" and "runtime".
+	Scope string `url:"scope,omitempty"`
+	// EPSSPercentage filters by the EPSS percentage of the advisory, e.g.
+	// ">=0.1" or "0.2..0.5".
+	EPSSPercentage string `url:"epss_percentage,omitempty"`
+
+	// Sort specifies how to sort the alerts. Possible values are: "created",
+	// "updated" and "epss_percentage". Default: "created".
+	Sort string `url:"sort,omitempty"`
+	// Direction in which to sort the alerts. Possible values are: "asc" and
+	// "desc". Default: "desc".
+	Direction string `url:"direction,omitempty"`
+
+	// Before and After are cursors for paginating through the results.
+	// Set them from Response.Before and Response.After respectively.
+	Before string `url:"before,omitempty"`
+	After  string `url:"after,omitempty"`
+
+	// For paginated result sets, the number of results to include per page.
+	PerPage int `url:"per_page,omitempty"`
+}
+
+func (s *DependabotService) listAlerts(ctx context.Context, u string, opts *ListAlertsOptions) ([]*DependabotAlert, *Response, error) {
+	u, err := addOptions(u, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var alerts []*DependabotAlert
+	resp, err := s.client.Do(ctx, req, &alerts)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return alerts, resp, nil
+}
+
+// ListRepoAlerts lists the Dependabot alerts of a repository.
+//
+// GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/dependabot/#list-dependabot-alerts-for-a-repository
+func (s *DependabotService) ListRepoAlerts(ctx context.Context, owner, repo string, opts *ListAlertsOptions) ([]*DependabotAlert, *Response, error) {
+	u := fmt.Sprintf("repos/%v/%v/dependabot/alerts", owner, repo)
+	return s.listAlerts(ctx, u, opts)
+}
+
+// ListOrgAlerts lists the Dependabot alerts of the repositories of an
+// organization.
+//
+// GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/dependabot/#list-dependabot-alerts-for-an-organization
+func (s *DependabotService) ListOrgAlerts(ctx context.Context, org string, opts *ListAlertsOptions) ([]*DependabotAlert, *Response, error) {
+	u := fmt.Sprintf("orgs/%v/dependabot/alerts", org)
+	return s.listAlerts(ctx, u, opts)
+}
+
+// ListEnterpriseAlerts lists the Dependabot alerts of the repositories owned
+// by the organizations of an enterprise.
+//
+// GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/dependabot/#list-dependabot-alerts-for-an-enterprise
+func (s *DependabotService) ListEnterpriseAlerts(ctx context.Context, enterprise string, opts *ListAlertsOptions) ([]*DependabotAlert, *Response, error) {
+	u := fmt.Sprintf("enterprises/%v/dependabot/alerts", enterprise)
+	return s.listAlerts(ctx, u, opts)
+}
+
+// GetRepoAlert gets a Dependabot alert of a repository.
+//
+// GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/dependabot/#get-a-dependabot-alert
+func (s *DependabotService) GetRepoAlert(ctx context.Context, owner, repo string, number int) (*DependabotAlert, *Response, error) {
+	u := fmt.Sprintf("repos/%v/%v/dependabot/alerts/%v", owner, repo, number)
+
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	alert := new(DependabotAlert)
+	resp, err := s.client.Do(ctx, req, alert)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return alert, resp, nil
+}
+
+// UpdateAlert updates the state of a Dependabot alert of a repository.
+//
+// GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/dependabot/#update-a-dependabot-alert
+func (s *DependabotService) UpdateAlert(ctx context.Context, owner, repo string, number int, state *DependabotAlertState) (*DependabotAlert, *Response, error) {
+	u := fmt.Sprintf("repos/%v/%v/dependabot/alerts/%v", owner, repo, number)
+
+	req, err := s.client.NewRequest("PATCH", u, state)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	alert := new(DependabotAlert)
+	resp, err := s.client.Do(ctx, req, alert)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return alert, resp, nil
+}
+
+// DismissAlertsOptions specifies the optional parameters to the
+// DependabotService.DismissAlerts method.
+type DismissAlertsOptions struct {
+	// BatchSize is the number of alerts to dismiss between rate limit
+	// checks. If fewer requests than BatchSize remain in the rate limit
+	// after a batch, DismissAlerts waits for the rate limit to reset.
+	// Default: 10.
+	BatchSize int
+
+	// DismissedComment is an optional comment associated with the dismissals.
+	DismissedComment string
+}
+
+// DismissAlerts dismisses, with the given reason, the open alerts among
+// alerts for which match returns true. A nil match dismisses all the open
+// alerts. The alerts must have their Repository set, as the alerts returned
+// by ListOrgAlerts and ListEnterpriseAlerts do.
+//
+// DismissAlerts keeps within the rate limit: it waits for the rate limit to
+// reset when it runs low, and retries the dismissals that fail with a
+// *RateLimitError or an *AbuseRateLimitError. It returns the dismissed
+// alerts, and the Response of the last request made.
+//
+// GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/dependabot/#update-a-dependabot-alert
+func (s *DependabotService) DismissAlerts(ctx context.Context, alerts []*DependabotAlert, match func(*DependabotAlert) bool, reason string, opts *DismissAlertsOptions) ([]*DependabotAlert, *Response, error) {
+	batchSize := 10
+	state := &DependabotAlertState{State: "dismissed", DismissedReason: String(reason)}
+	if opts != nil {
+		if opts.BatchSize > 0 {
+			batchSize = opts.BatchSize
+		}
+		if opts.DismissedComment != "" {
+			state.DismissedComment = String(opts.DismissedComment)
+		}
+	}
+
+	var dismissed []*DependabotAlert
+	var resp *Response
+	inBatch := 0
+	for _, alert := range alerts {
+		if alert.State != nil && *alert.State != "open" {
+			continue
+		}
+		if match != nil && !match(alert) {
+			continue
+		}
+
+		owner, repo := alert.GetRepository().GetOwner().GetLogin(), alert.GetRepository().GetName()
+		if owner == "" || repo == "" {
+			return dismissed, resp, fmt.Errorf("alert %v has no repository", alert.GetNumber())
+		}
+
+		for {
+			var updated *DependabotAlert
+			var err error
+			updated, resp, err = s.UpdateAlert(ctx, owner, repo, alert.GetNumber(), state)
+			if err == nil {
+				if updated.Repository == nil {
+					updated.Repository = alert.Repository
+				}
+				dismissed = append(dismissed, updated)
+				break
+			}
+
+			if retry, err := waitForRateLimit(ctx, err); !retry {
+				return dismissed, resp, err
+			}
+		}
+
+		inBatch++
+		if inBatch == batchSize {
+			inBatch = 0
+			if resp.Rate.Remaining < batchSize {
+				if err := sleepUntil(ctx, resp.Rate.Reset.Time); err != nil {
+					return dismissed, resp, err
+				}
+			}
+		}
+	}
+
+	return dismissed, resp, nil
+}
@@ -0,0 +1,396 @@
+// Copyright 2021 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestDependabotService_ListRepoAlerts(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/dependabot/alerts", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testFormValues(t, r, values{"state": "open", "manifest": "go.mod", "after": "a1"})
+		w.Header().Set("Link", `<https://api.github.com/repos/o/r/dependabot/alerts?after=a2>; rel="next"`)
+		fmt.Fprint(w, `[{
+			"number": 1,
+			"state": "open",
+			"dependency": {
+				"package": {"ecosystem": "go", "name": "golang.org/x/net"},
+				"manifest_path": "go.mod",
+				"scope": "runtime"
+			},
+			"security_advisory": {
+				"ghsa_id": "GHSA-1",
+				"cve_id": "CVE-1",
+				"severity": "high",
+				"cvss": {"score": 7.5, "vector_string": "CVSS:3.1/AV:N"},
+				"cwes": [{"cwe_id": "CWE-400", "name": "Uncontrolled Resource Consumption"}],
+				"epss": {"percentage": 0.01, "percentile": 0.5},
+				"identifiers": [{"value": "GHSA-1", "type": "GHSA"}],
+				"references": [{"url": "https://example.com"}],
+				"published_at": `+referenceTimeStr+`
+			},
+			"security_vulnerability": {
+				"package": {"ecosystem": "go", "name": "golang.org/x/net"},
+				"severity": "high",
+				"vulnerable_version_range": "< 0.7.0",
+				"first_patched_version": {"identifier": "0.7.0"}
+			},
+			"created_at": `+referenceTimeStr+`
+		}]`)
+	})
+
+	opts := &ListAlertsOptions{State: "open", Manifest: "go.mod", After: "a1"}
+	ctx := context.Background()
+	alerts, resp, err := client.Dependabot.ListRepoAlerts(ctx, "o", "r", opts)
+	if err != nil {
+		t.Errorf("Dependabot.ListRepoAlerts returned error: %v", err)
+	}
+
+	pkg := &VulnerabilityPackage{Ecosystem: String("go"), Name: String("golang.org/x/net")}
+	want := []*DependabotAlert{{
+		Number: Int(1),
+		State:  String("open"),
+		Dependency: &Dependency{
+			Package:      pkg,
+			ManifestPath: String("go.mod"),
+			Scope:        String("runtime"),
+		},
+		SecurityAdvisory: &DependabotSecurityAdvisory{
+			GHSAID:      String("GHSA-1"),
+			CVEID:       String("CVE-1"),
+			Severity:    String("high"),
+			CVSS:        &AdvisoryCVSS{Score: Float64(7.5), VectorString: String("CVSS:3.1/AV:N")},
+			CWEs:        []*AdvisoryCWE{{CWEID: String("CWE-400"), Name: String("Uncontrolled Resource Consumption")}},
+			EPSS:        &AdvisoryEPSS{Percentage: Float64(0.01), Percentile: Float64(0.5)},
+			Identifiers: []*AdvisoryIdentifier{{Value: String("GHSA-1"), Type: String("GHSA")}},
+			References:  []*AdvisoryReference{{URL: String("https://example.com")}},
+			PublishedAt: &Timestamp{referenceTime},
+		},
+		SecurityVulnerability: &AdvisoryVulnerability{
+			Package:                pkg,
+			Severity:               String("high"),
+			VulnerableVersionRange: String("< 0.7.0"),
+			FirstPatchedVersion:    &FirstPatchedVersion{Identifier: String("0.7.0")},
+		},
+		CreatedAt: &Timestamp{referenceTime},
+	}}
+	if !reflect.DeepEqual(alerts, want) {
+		t.Errorf("Dependabot.ListRepoAlerts returned %+v, want %+v", alerts, want)
+	}
+	if got, want := resp.After, "a2"; got != want {
+		t.Errorf("Dependabot.ListRepoAlerts returned After %v, want %v", got, want)
+	}
+
+	const methodName = "ListRepoAlerts"
+	testBadOptions(t, methodName, func() (err error) {
+		_, _, err = client.Dependabot.ListRepoAlerts(ctx, "\n", "\n", opts)
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.Dependabot.ListRepoAlerts(ctx, "o", "r", opts)
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
+
+func TestDependabotService_ListOrgAlerts(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/orgs/o/dependabot/alerts", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testFormValues(t, r, values{
+			"severity":        "high,critical",
+			"scope":           "runtime",
+			"epss_percentage": ">=0.1",
+			"sort":            "epss_percentage",
+			"per_page":        "50",
+		})
+		fmt.Fprint(w, `[{"number":1,"repository":{"name":"r","owner":{"login":"o"}}}]`)
+	})
+
+	opts := &ListAlertsOptions{Severity: "high,critical", Scope: "runtime", EPSSPercentage: ">=0.1", Sort: "epss_percentage", PerPage: 50}
+	ctx := context.Background()
+	alerts, _, err := client.Dependabot.ListOrgAlerts(ctx, "o", opts)
+	if err != nil {
+		t.Errorf("Dependabot.ListOrgAlerts returned error: %v", err)
+	}
+
+	want := []*DependabotAlert{{Number: Int(1), Repository: &Repository{Name: String("r"), Owner: &User{Login: String("o")}}}}
+	if !reflect.DeepEqual(alerts, want) {
+		t.Errorf("Dependabot.ListOrgAlerts returned %+v, want %+v", alerts, want)
+	}
+
+	const methodName = "ListOrgAlerts"
+	testBadOptions(t, methodName, func() (err error) {
+		_, _, err = client.Dependabot.ListOrgAlerts(ctx, "\n", opts)
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.Dependabot.ListOrgAlerts(ctx, "o", opts)
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
+
+func TestDependabotService_ListEnterpriseAlerts(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/enterprises/e/dependabot/alerts", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testFormValues(t, r, values{"ecosystem": "npm"})
+		fmt.Fprint(w, `[{"number":1}]`)
+	})
+
+	opts := &ListAlertsOptions{Ecosystem: "npm"}
+	ctx := context.Background()
+	alerts, _, err := client.Dependabot.ListEnterpriseAlerts(ctx, "e", opts)
+	if err != nil {
+		t.Errorf("Dependabot.ListEnterpriseAlerts returned error: %v", err)
+	}
+
+	want := []*DependabotAlert{{Number: Int(1)}}
+	if !reflect.DeepEqual(alerts, want) {
+		t.Errorf("Dependabot.ListEnterpriseAlerts returned %+v, want %+v", alerts, want)
+	}
+
+	const methodName = "ListEnterpriseAlerts"
+	testBadOptions(t, methodName, func() (err error) {
+		_, _, err = client.Dependabot.ListEnterpriseAlerts(ctx, "\n", opts)
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.Dependabot.ListEnterpriseAlerts(ctx, "e", opts)
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
+
+func TestDependabotService_GetRepoAlert(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/dependabot/alerts/1", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"number":1,"state":"fixed","fixed_at":`+referenceTimeStr+`}`)
+	})
+
+	ctx := context.Background()
+	alert, _, err := client.Dependabot.GetRepoAlert(ctx, "o", "r", 1)
+	if err != nil {
+		t.Errorf("Dependabot.GetRepoAlert returned error: %v", err)
+	}
+
+	want := &DependabotAlert{Number: Int(1), State: String("fixed"), FixedAt: &Timestamp{referenceTime}}
+	if !reflect.DeepEqual(alert, want) {
+		t.Errorf("Dependabot.GetRepoAlert returned %+v, want %+v", alert, want)
+	}
+
+	const methodName = "GetRepoAlert"
+	testBadOptions(t, methodName, func() (err error) {
+		_, _, err = client.Dependabot.GetRepoAlert(ctx, "\n", "\n", -1)
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.Dependabot.GetRepoAlert(ctx, "o", "r", 1)
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
+
+func TestDependabotService_UpdateAlert(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	input := &DependabotAlertState{State: "dismissed", DismissedReason: String("tolerable_risk"), DismissedComment: String("c")}
+
+	mux.HandleFunc("/repos/o/r/dependabot/alerts/1", func(w http.ResponseWriter, r *http.Request) {
+		v := new(DependabotAlertState)
+		json.NewDecoder(r.Body).Decode(v)
+
+		testMethod(t, r, "PATCH")
+		if !reflect.DeepEqual(v, input) {
+			t.Errorf("Request body = %+v, want %+v", v, input)
+		}
+
+		fmt.Fprint(w, `{"number":1,"state":"dismissed","dismissed_reason":"tolerable_risk"}`)
+	})
+
+	ctx := context.Background()
+	alert, _, err := client.Dependabot.UpdateAlert(ctx, "o", "r", 1, input)
+	if err != nil {
+		t.Errorf("Dependabot.UpdateAlert returned error: %v", err)
+	}
+
+	want := &DependabotAlert{Number: Int(1), State: String("dismissed"), DismissedReason: String("tolerable_risk")}
+	if !reflect.DeepEqual(alert, want) {
+		t.Errorf("Dependabot.UpdateAlert returned %+v, want %+v", alert, want)
+	}
+
+	const methodName = "UpdateAlert"
+	testBadOptions(t, methodName, func() (err error) {
+		_, _, err = client.Dependabot.UpdateAlert(ctx, "\n", "\n", -1, input)
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.Dependabot.UpdateAlert(ctx, "o", "r", 1, input)
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
+
+func TestDependabotService_DismissAlerts(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	var dismissed []string
+	abused := false
+	mux.HandleFunc("/repos/", func(w http.ResponseWriter, r *http.Request) {
+		v := new(DependabotAlertState)
+		json.NewDecoder(r.Body).Decode(v)
+
+		testMethod(t, r, "PATCH")
+		want := &DependabotAlertState{State: "dismissed", DismissedReason: String("not_used"), DismissedComment: String("c")}
+		if !reflect.DeepEqual(v, want) {
+			t.Errorf("Request body = %+v, want %+v", v, want)
+		}
+
+		// The first dismissal hits the abuse rate limit and is retried.
+		if !abused {
+			abused = true
+			w.Header().Set("Retry-After", "0")
+			w.WriteHeader(http.StatusForbidden)
+			fmt.Fprint(w, `{"message":"abuse","documentation_url":"https://docs.github.com/rest/overview/resources-in-the-rest-api#abuse-rate-limits"}`)
+			return
+		}
+
+		// An exhausted rate limit that has already been reset.
+		w.Header().Set(headerRateLimit, "60")
+		w.Header().Set(headerRateRemaining, "0")
+		w.Header().Set(headerRateReset, fmt.Sprint(time.Now().Add(-time.Minute).Unix()))
+
+		dismissed = append(dismissed, r.URL.Path)
+		number := r.URL.Path[strings.LastIndex(r.URL.Path, "/")+1:]
+		fmt.Fprintf(w, `{"number":%v,"state":"dismissed"}`, number)
+	})
+
+	repo := func(owner, name string) *Repository {
+		return &Repository{Name: String(name), Owner: &User{Login: String(owner)}}
+	}
+	alerts := []*DependabotAlert{
+		{Number: Int(1), State: String("open"), Dependency: &Dependency{Scope: String("development")}, Repository: repo("o", "r1")},
+		{Number: Int(2), State: String("open"), Dependency: &Dependency{Scope: String("runtime")}, Repository: repo("o", "r1")},
+		{Number: Int(3), State: String("fixed"), Dependency: &Dependency{Scope: String("development")}, Repository: repo("o", "r2")},
+		{Number: Int(4), State: String("open"), Dependency: &Dependency{Scope: String("development")}, Repository: repo("o", "r2")},
+	}
+	isDevelopment := func(a *DependabotAlert) bool {
+		return a.GetDependency().GetScope() == "development"
+	}
+
+	ctx := context.Background()
+	opts := &DismissAlertsOptions{BatchSize: 1, DismissedComment: "c"}
+	got, _, err := client.Dependabot.DismissAlerts(ctx, alerts, isDevelopment, "not_used", opts)
+	if err != nil {
+		t.Fatalf("Dependabot.DismissAlerts returned error: %v", err)
+	}
+
+	want := []*DependabotAlert{
+		{Number: Int(1), State: String("dismissed"), Repository: repo("o", "r1")},
+		{Number: Int(4), State: String("dismissed"), Repository: repo("o", "r2")},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Dependabot.DismissAlerts returned %+v, want %+v", got, want)
+	}
+
+	wantPaths := []string{"/repos/o/r1/dependabot/alerts/1", "/repos/o/r2/dependabot/alerts/4"}
+	if !reflect.DeepEqual(dismissed, wantPaths) {
+		t.Errorf("Dependabot.DismissAlerts dismissed %v, want %v", dismissed, wantPaths)
+	}
+}
+
+func TestDependabotService_DismissAlerts_noRepository(t *testing.T) {
+	client, _, _, teardown := setup()
+	defer teardown()
+
+	alerts := []*DependabotAlert{{Number: Int(1)}}
+	ctx := context.Background()
+	if _, _, err := client.Dependabot.DismissAlerts(ctx, alerts, nil, "not_used", nil); err == nil {
+		t.Errorf("Dependabot.DismissAlerts returned no error for an alert without repository")
+	}
+}
+
+func TestDependabotService_DismissAlerts_canceled(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/dependabot/alerts/1", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Retry-After", "60")
+		w.WriteHeader(http.StatusForbidden)
+		fmt.Fprint(w, `{"message":"abuse","documentation_url":"https://docs.github.com/rest/overview/resources-in-the-rest-api#abuse-rate-limits"}`)
+	})
+
+	alerts := []*DependabotAlert{{Number: Int(1), Repository: &Repository{Name: String("r"), Owner: &User{Login: String("o")}}}}
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	if _, _, err := client.Dependabot.DismissAlerts(ctx, alerts, nil, "not_used", nil); err != context.DeadlineExceeded {
+		t.Errorf("Dependabot.DismissAlerts returned error %v, want %v", err, context.DeadlineExceeded)
+	}
+}
+
+func TestDependabotAlert_Marshal(t *testing.T) {
+	testJSONMarshal(t, &DependabotAlert{}, "{}")
+
+	u := &DependabotAlert{
+		Number: Int(1),
+		State:  String("open"),
+		Dependency: &Dependency{
+			Package:      &VulnerabilityPackage{Ecosystem: String("go"), Name: String("n")},
+			ManifestPath: String("go.mod"),
+		},
+		SecurityAdvisory: &DependabotSecurityAdvisory{GHSAID: String("GHSA-1")},
+		CreatedAt:        &Timestamp{referenceTime},
+	}
+
+	want := `{
+		"number": 1,
+		"state": "open",
+		"dependency": {
+			"package": {"ecosystem": "go", "name": "n"},
+			"manifest_path": "go.mod"
+		},
+		"security_advisory": {"ghsa_id": "GHSA-1"},
+		"created_at": ` + referenceTimeStr + `
+	}`
+
+	testJSONMarshal(t, u, want)
+}
@@ -0,0 +1,197 @@
+// Copyright 2021 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"fmt"
+)
+
+// DependencyGraphService handles communication with the dependency graph
+// related methods of the GitHub API.
+//
+// GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/dependency-graph/
+type DependencyGraphService service
+
+// SBOM represents a software bill of materials of a repository.
+type SBOM struct {
+	SBOM *SBOMInfo `json:"sbom,omitempty"`
+}
+
+// SBOMInfo represents a software bill of materials in the SPDX JSON format.
+type SBOMInfo struct {
+	SPDXID            *string       `json:"SPDXID,omitempty"`
+	SPDXVersion       *string       `json:"spdxVersion,omitempty"`
+	CreationInfo      *CreationInfo `json:"creationInfo,omitempty"`
+	Name              *string       `json:"name,omitempty"`
+	DataLicense       *string       `json:"dataLicense,omitempty"`
+	DocumentDescribes []string      `json:"documentDescribes,omitempty"`
+	DocumentNamespace *string       `json:"documentNamespace,omitempty"`
+
+	// List of packages dependencies.
+	Packages []*RepoDependencies `json:"packages,omitempty"`
+
+	// List of relationships between packages.
+	Relationships []*SBOMRelationship `json:"relationships,omitempty"`
+}
+
+// CreationInfo represents when and by whom an SBOM was created.
+type CreationInfo struct {
+	Created  *Timestamp `json:"created,omitempty"`
+	Creators []string   `json:"creators,omitempty"`
+}
+
+// RepoDependencies represents a package of an SBOM.
+type RepoDependencies struct {
+	SPDXID           *string `json:"SPDXID,omitempty"`
+	Name             *string `json:"name,omitempty"`
+	VersionInfo      *string `json:"versionInfo,omitempty"`
+	DownloadLocation *string `json:"downloadLocation,omitempty"`
+	FilesAnalyzed    *bool   `json:"filesAnalyzed,omitempty"`
+	LicenseConcluded *string `json:"licenseConcluded,omitempty"`
+	LicenseDeclared  *string `json:"licenseDeclared,omitempty"`
+	CopyrightText    *string `json:"copyrightText,omitempty"`
+
+	ExternalRefs []*PackageExternalRef `json:"externalRefs,omitempty"`
+}
+
+// PackageExternalRef represents an external reference, such as a package
+// URL, of an SBOM package.
+type PackageExternalRef struct {
+	// Possible values for ReferenceCategory are: "SECURITY",
+	// "PACKAGE-MANAGER", "PERSISTENT-ID" and "OTHER".
+	ReferenceCategory *string `json:"referenceCategory,omitempty"`
+	ReferenceLocator  *string `json:"referenceLocator,omitempty"`
+	ReferenceType     *string `json:"referenceType,omitempty"`
+}
+
+// SBOMRelationship represents a relationship between two elements of an SBOM.
+type SBOMRelationship struct {
+	SPDXElementID      *string `json:"spdxElementId,omitempty"`
+	RelatedSPDXElement *string `json:"relatedSpdxElement,omitempty"`
+	// RelationshipType is for example "DEPENDS_ON" or "DESCRIBES".
+	RelationshipType *string `json:"relationshipType,omitempty"`
+}
+
+// GetSBOM gets the software bill of materials of a repository, in the SPDX
+// JSON format.
+//
+// GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/dependency-graph/#export-a-software-bill-of-materials-sbom-for-a-repository
+func (s *DependencyGraphService) GetSBOM(ctx context.Context, owner, repo string) (*SBOM, *Response, error) {
+	u := fmt.Sprintf("repos/%v/%v/dependency-graph/sbom", owner, repo)
+
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	sbom := new(SBOM)
+	resp, err := s.client.Do(ctx, req, sbom)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return sbom, resp, nil
+}
+
+// DependencyGraphSnapshotJob represents the build job that produced a
+// dependency snapshot.
+type DependencyGraphSnapshotJob struct {
+	// Correlator identifies the snapshots of a job across runs: a new
+	// snapshot replaces the previous one with the same Correlator.
+	Correlator *string `json:"correlator,omitempty"`
+	ID         *string `json:"id,omitempty"`
+	HTMLURL    *string `json:"html_url,omitempty"`
+}
+
+// DependencyGraphSnapshotDetector represents the tool that detected the
+// dependencies of a snapshot.
+type DependencyGraphSnapshotDetector struct {
+	Name    *string `json:"name,omitempty"`
+	Version *string `json:"version,omitempty"`
+	URL     *string `json:"url,omitempty"`
+}
+
+// DependencyGraphSnapshotResolvedDependency represents a dependency of a
+// manifest of a snapshot.
+type DependencyGraphSnapshotResolvedDependency struct {
+	// PackageURL is the package URL of the dependency, e.g.
+	// "pkg:golang/golang.org/x/net@v0.7.0".
+	PackageURL *string                `json:"package_url,omitempty"`
+	Metadata   map[string]interface{} `json:"metadata,omitempty"`
+	// Possible values for Relationship are: "direct" and "indirect".
+	Relationship *string `json:"relationship,omitempty"`
+	// Possible values for Scope are: "runtime" and "development".
+	Scope *string `json:"scope,omitempty"`
+	// Dependencies are the package URLs of the dependencies of this
+	// dependency.
+	Dependencies []string `json:"dependencies,omitempty"`
+}
+
+// DependencyGraphSnapshotManifestFile represents the file of a manifest of a
+// snapshot.
+type DependencyGraphSnapshotManifestFile struct {
+	// SourceLocation is the path of the file relative to the root of the
+	// repository.
+	SourceLocation *string `json:"source_location,omitempty"`
+}
+
+// DependencyGraphSnapshotManifest represents a manifest of a snapshot, such
+// as a lock file or a build target.
+type DependencyGraphSnapshotManifest struct {
+	Name     *string                              `json:"name,omitempty"`
+	File     *DependencyGraphSnapshotManifestFile `json:"file,omitempty"`
+	Metadata map[string]interface{}               `json:"metadata,omitempty"`
+	// Resolved maps the package URLs of the dependencies of the manifest
+	// to their details.
+	Resolved map[string]*DependencyGraphSnapshotResolvedDependency `json:"resolved,omitempty"`
+}
+
+// DependencyGraphSnapshot represents a snapshot of the dependencies of a
+// repository at a commit, as detected by a build system.
+type DependencyGraphSnapshot struct {
+	Version  int                              `json:"version"`
+	SHA      *string                          `json:"sha,omitempty"`
+	Ref      *string                          `json:"ref,omitempty"`
+	Job      *DependencyGraphSnapshotJob      `json:"job,omitempty"`
+	Detector *DependencyGraphSnapshotDetector `json:"detector,omitempty"`
+	Scanned  *Timestamp                       `json:"scanned,omitempty"`
+	Metadata map[string]interface{}           `json:"metadata,omitempty"`
+	// Manifests maps the names of the manifests of the snapshot to their
+	// details.
+	Manifests map[string]*DependencyGraphSnapshotManifest `json:"manifests,omitempty"`
+}
+
+// DependencyGraphSnapshotCreationData represents the result of the
+// creation of a dependency snapshot.
+type DependencyGraphSnapshotCreationData struct {
+	ID        *int64     `json:"id,omitempty"`
+	CreatedAt *Timestamp `json:"created_at,omitempty"`
+	// Possible values for Result are: "SUCCESS", "ACCEPTED" and "INVALID".
+	Result  *string `json:"result,omitempty"`
+	Message *string `json:"message,omitempty"`
+}
+
+// CreateSnapshot submits a snapshot of the dependencies of a repository,
+// such as those resolved by a custom build system, to the dependency graph.
+//
+// GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/dependency-graph/#create-a-snapshot-of-dependencies-for-a-repository
+func (s *DependencyGraphService) CreateSnapshot(ctx context.Context, owner, repo string, snapshot *DependencyGraphSnapshot) (*DependencyGraphSnapshotCreationData, *Response, error) {
+	u := fmt.Sprintf("repos/%v/%v/dependency-graph/snapshots", owner, repo)
+
+	req, err := s.client.NewRequest("POST", u, snapshot)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	data := new(DependencyGraphSnapshotCreationData)
+	resp, err := s.client.Do(ctx, req, data)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return data, resp, nil
+}
@@ -0,0 +1,81 @@
+// Copyright 2021 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+)
+
+// Possible values for the ChangeType of a DependencyChange.
+const (
+	DependencyChangeAdded   = "added"
+	DependencyChangeRemoved = "removed"
+)
+
+// DependencyChangeVulnerability represents a known vulnerability of a
+// changed dependency.
+type DependencyChangeVulnerability struct {
+	// Possible values for Severity are: "low", "moderate", "high" and "critical".
+	Severity        *string `json:"severity,omitempty"`
+	AdvisoryGHSAID  *string `json:"advisory_ghsa_id,omitempty"`
+	AdvisorySummary *string `json:"advisory_summary,omitempty"`
+	AdvisoryURL     *string `json:"advisory_url,omitempty"`
+}
+
+// DependencyChange represents a dependency added or removed between two
+// revisions of a repository.
+type DependencyChange struct {
+	// ChangeType is one of DependencyChangeAdded or DependencyChangeRemoved.
+	ChangeType *string `json:"change_type,omitempty"`
+	// Manifest is the path of the manifest declaring the dependency.
+	Manifest            *string `json:"manifest,omitempty"`
+	Ecosystem           *string `json:"ecosystem,omitempty"`
+	Name                *string `json:"name,omitempty"`
+	Version             *string `json:"version,omitempty"`
+	PackageURL          *string `json:"package_url,omitempty"`
+	License             *string `json:"license,omitempty"`
+	SourceRepositoryURL *string `json:"source_repository_url,omitempty"`
+	// Possible values for Scope are: "unknown", "runtime" and "development".
+	Scope           *string                          `json:"scope,omitempty"`
+	Vulnerabilities []*DependencyChangeVulnerability `json:"vulnerabilities,omitempty"`
+}
+
+// DependencyCompareOptions specifies the optional parameters to the
+// DependencyGraphService.Compare method.
+type DependencyCompareOptions struct {
+	// Name restricts the comparison to the manifest with the given path.
+	Name string `url:"name,omitempty"`
+}
+
+// Compare gets the dependencies added and removed between two revisions of
+// a repository, along with their licenses and known vulnerabilities.
+//
+// GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/dependency-graph/#get-a-diff-of-the-dependencies-between-commits
+func (s *DependencyGraphService) Compare(ctx context.Context, owner, repo, base, head string, opts *DependencyCompareOptions) ([]*DependencyChange, *Response, error) {
+	escapedBase := url.QueryEscape(base)
+	escapedHead := url.QueryEscape(head)
+
+	u := fmt.Sprintf("repos/%v/%v/dependency-graph/compare/%v...%v", owner, repo, escapedBase, escapedHead)
+	u, err := addOptions(u, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var changes []*DependencyChange
+	resp, err := s.client.Do(ctx, req, &changes)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return changes, resp, nil
+}
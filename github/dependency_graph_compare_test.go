@@ -0,0 +1,126 @@
+// Copyright 2021 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+func TestDependencyGraphService_Compare(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/dependency-graph/compare/main...feature", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testFormValues(t, r, values{"name": "go.mod"})
+		fmt.Fprint(w, `[{
+			"change_type": "added",
+			"manifest": "go.mod",
+			"ecosystem": "gomod",
+			"name": "golang.org/x/net",
+			"version": "0.6.0",
+			"package_url": "pkg:golang/golang.org/x/net@0.6.0",
+			"license": "BSD-3-Clause",
+			"source_repository_url": "https://github.com/golang/net",
+			"scope": "runtime",
+			"vulnerabilities": [{
+				"severity": "moderate",
+				"advisory_ghsa_id": "GHSA-1",
+				"advisory_summary": "s",
+				"advisory_url": "https://github.com/advisories/GHSA-1"
+			}]
+		}, {
+			"change_type": "removed",
+			"name": "golang.org/x/text",
+			"vulnerabilities": []
+		}]`)
+	})
+
+	opts := &DependencyCompareOptions{Name: "go.mod"}
+	ctx := context.Background()
+	changes, _, err := client.DependencyGraph.Compare(ctx, "o", "r", "main", "feature", opts)
+	if err != nil {
+		t.Errorf("DependencyGraph.Compare returned error: %v", err)
+	}
+
+	want := []*DependencyChange{{
+		ChangeType:          String(DependencyChangeAdded),
+		Manifest:            String("go.mod"),
+		Ecosystem:           String("gomod"),
+		Name:                String("golang.org/x/net"),
+		Version:             String("0.6.0"),
+		PackageURL:          String("pkg:golang/golang.org/x/net@0.6.0"),
+		License:             String("BSD-3-Clause"),
+		SourceRepositoryURL: String("https://github.com/golang/net"),
+		Scope:               String("runtime"),
+		Vulnerabilities: []*DependencyChangeVulnerability{{
+			Severity:        String("moderate"),
+			AdvisoryGHSAID:  String("GHSA-1"),
+			AdvisorySummary: String("s"),
+			AdvisoryURL:     String("https://github.com/advisories/GHSA-1"),
+		}},
+	}, {
+		ChangeType:      String(DependencyChangeRemoved),
+		Name:            String("golang.org/x/text"),
+		Vulnerabilities: []*DependencyChangeVulnerability{},
+	}}
+	if !reflect.DeepEqual(changes, want) {
+		t.Errorf("DependencyGraph.Compare returned %+v, want %+v", changes, want)
+	}
+
+	const methodName = "Compare"
+	testBadOptions(t, methodName, func() (err error) {
+		_, _, err = client.DependencyGraph.Compare(ctx, "\n", "\n", "\n", "\n", opts)
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.DependencyGraph.Compare(ctx, "o", "r", "main", "feature", opts)
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
+
+func TestDependencyGraphService_Compare_escaping(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/dependency-graph/compare/u:b...refs/heads/f", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `[]`)
+	})
+
+	ctx := context.Background()
+	if _, _, err := client.DependencyGraph.Compare(ctx, "o", "r", "u:b", "refs/heads/f", nil); err != nil {
+		t.Errorf("DependencyGraph.Compare returned error: %v", err)
+	}
+}
+
+func TestDependencyChange_Marshal(t *testing.T) {
+	testJSONMarshal(t, &DependencyChange{}, "{}")
+
+	u := &DependencyChange{
+		ChangeType:      String("added"),
+		Name:            String("n"),
+		Version:         String("1.0.0"),
+		Vulnerabilities: []*DependencyChangeVulnerability{{Severity: String("high")}},
+	}
+
+	want := `{
+		"change_type": "added",
+		"name": "n",
+		"version": "1.0.0",
+		"vulnerabilities": [{"severity": "high"}]
+	}`
+
+	testJSONMarshal(t, u, want)
+}
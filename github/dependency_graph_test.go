@@ -0,0 +1,214 @@
+// Copyright 2021 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+func TestDependencyGraphService_GetSBOM(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/dependency-graph/sbom", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{
+			"sbom": {
+				"SPDXID": "SPDXRef-DOCUMENT",
+				"spdxVersion": "SPDX-2.3",
+				"creationInfo": {
+					"created": `+referenceTimeStr+`,
+					"creators": ["Tool: GitHub.com-Dependency-Graph"]
+				},
+				"name": "github/o/r",
+				"dataLicense": "CC0-1.0",
+				"documentDescribes": ["SPDXRef-o-r"],
+				"documentNamespace": "https://github.com/o/r/dependency_graph/sbom-1",
+				"packages": [{
+					"SPDXID": "SPDXRef-go-golang.org-x-net-0.7.0",
+					"name": "go:golang.org/x/net",
+					"versionInfo": "0.7.0",
+					"downloadLocation": "NOASSERTION",
+					"filesAnalyzed": false,
+					"licenseConcluded": "BSD-3-Clause",
+					"licenseDeclared": "BSD-3-Clause",
+					"copyrightText": "NOASSERTION",
+					"externalRefs": [{
+						"referenceCategory": "PACKAGE-MANAGER",
+						"referenceLocator": "pkg:golang/golang.org/x/net@0.7.0",
+						"referenceType": "purl"
+					}]
+				}],
+				"relationships": [{
+					"spdxElementId": "SPDXRef-o-r",
+					"relatedSpdxElement": "SPDXRef-go-golang.org-x-net-0.7.0",
+					"relationshipType": "DEPENDS_ON"
+				}]
+			}
+		}`)
+	})
+
+	ctx := context.Background()
+	sbom, _, err := client.DependencyGraph.GetSBOM(ctx, "o", "r")
+	if err != nil {
+		t.Errorf("DependencyGraph.GetSBOM returned error: %v", err)
+	}
+
+	want := &SBOM{
+		SBOM: &SBOMInfo{
+			SPDXID:      String("SPDXRef-DOCUMENT"),
+			SPDXVersion: String("SPDX-2.3"),
+			CreationInfo: &CreationInfo{
+				Created:  &Timestamp{referenceTime},
+				Creators: []string{"Tool: GitHub.com-Dependency-Graph"},
+			},
+			Name:              String("github/o/r"),
+			DataLicense:       String("CC0-1.0"),
+			DocumentDescribes: []string{"SPDXRef-o-r"},
+			DocumentNamespace: String("https://github.com/o/r/dependency_graph/sbom-1"),
+			Packages: []*RepoDependencies{{
+				SPDXID:           String("SPDXRef-go-golang.org-x-net-0.7.0"),
+				Name:             String("go:golang.org/x/net"),
+				VersionInfo:      String("0.7.0"),
+				DownloadLocation: String("NOASSERTION"),
+				FilesAnalyzed:    Bool(false),
+				LicenseConcluded: String("BSD-3-Clause"),
+				LicenseDeclared:  String("BSD-3-Clause"),
+				CopyrightText:    String("NOASSERTION"),
+				ExternalRefs: []*PackageExternalRef{{
+					ReferenceCategory: String("PACKAGE-MANAGER"),
+					ReferenceLocator:  String("pkg:golang/golang.org/x/net@0.7.0"),
+					ReferenceType:     String("purl"),
+				}},
+			}},
+			Relationships: []*SBOMRelationship{{
+				SPDXElementID:      String("SPDXRef-o-r"),
+				RelatedSPDXElement: String("SPDXRef-go-golang.org-x-net-0.7.0"),
+				RelationshipType:   String("DEPENDS_ON"),
+			}},
+		},
+	}
+	if !reflect.DeepEqual(sbom, want) {
+		t.Errorf("DependencyGraph.GetSBOM returned %+v, want %+v", sbom, want)
+	}
+
+	const methodName = "GetSBOM"
+	testBadOptions(t, methodName, func() (err error) {
+		_, _, err = client.DependencyGraph.GetSBOM(ctx, "\n", "\n")
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.DependencyGraph.GetSBOM(ctx, "o", "r")
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
+
+func TestDependencyGraphService_CreateSnapshot(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	input := &DependencyGraphSnapshot{
+		Version: 0,
+		SHA:     String("ce587453ced02b1526dfb4cb910479d431683101"),
+		Ref:     String("refs/heads/main"),
+		Job: &DependencyGraphSnapshotJob{
+			Correlator: String("build-main"),
+			ID:         String("42"),
+		},
+		Detector: &DependencyGraphSnapshotDetector{Name: String("bazel-detector"), Version: String("1.0.0")},
+		Scanned:  &Timestamp{referenceTime},
+		Manifests: map[string]*DependencyGraphSnapshotManifest{
+			"BUILD": {
+				Name: String("BUILD"),
+				File: &DependencyGraphSnapshotManifestFile{SourceLocation: String("BUILD")},
+				Resolved: map[string]*DependencyGraphSnapshotResolvedDependency{
+					"pkg:golang/golang.org/x/net@v0.7.0": {
+						PackageURL:   String("pkg:golang/golang.org/x/net@v0.7.0"),
+						Relationship: String("direct"),
+						Scope:        String("runtime"),
+						Dependencies: []string{"pkg:golang/golang.org/x/text@v0.7.0"},
+					},
+				},
+			},
+		},
+	}
+
+	mux.HandleFunc("/repos/o/r/dependency-graph/snapshots", func(w http.ResponseWriter, r *http.Request) {
+		v := new(DependencyGraphSnapshot)
+		json.NewDecoder(r.Body).Decode(v)
+
+		testMethod(t, r, "POST")
+		if !reflect.DeepEqual(v, input) {
+			t.Errorf("Request body = %+v, want %+v", v, input)
+		}
+
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprint(w, `{"id":12345,"created_at":`+referenceTimeStr+`,"result":"SUCCESS","message":"Dependency results for the repo have been successfully updated."}`)
+	})
+
+	ctx := context.Background()
+	data, _, err := client.DependencyGraph.CreateSnapshot(ctx, "o", "r", input)
+	if err != nil {
+		t.Errorf("DependencyGraph.CreateSnapshot returned error: %v", err)
+	}
+
+	want := &DependencyGraphSnapshotCreationData{
+		ID:        Int64(12345),
+		CreatedAt: &Timestamp{referenceTime},
+		Result:    String("SUCCESS"),
+		Message:   String("Dependency results for the repo have been successfully updated."),
+	}
+	if !reflect.DeepEqual(data, want) {
+		t.Errorf("DependencyGraph.CreateSnapshot returned %+v, want %+v", data, want)
+	}
+
+	const methodName = "CreateSnapshot"
+	testBadOptions(t, methodName, func() (err error) {
+		_, _, err = client.DependencyGraph.CreateSnapshot(ctx, "\n", "\n", input)
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.DependencyGraph.CreateSnapshot(ctx, "o", "r", input)
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
+
+func TestDependencyGraphSnapshot_Marshal(t *testing.T) {
+	testJSONMarshal(t, &DependencyGraphSnapshot{}, `{"version": 0}`)
+
+	u := &DependencyGraphSnapshot{
+		Version: 0,
+		SHA:     String("s"),
+		Ref:     String("refs/heads/main"),
+		Job:     &DependencyGraphSnapshotJob{Correlator: String("c"), ID: String("1")},
+		Manifests: map[string]*DependencyGraphSnapshotManifest{
+			"m": {Name: String("m")},
+		},
+	}
+
+	want := `{
+		"version": 0,
+		"sha": "s",
+		"ref": "refs/heads/main",
+		"job": {"correlator": "c", "id": "1"},
+		"manifests": {"m": {"name": "m"}}
+	}`
+
+	testJSONMarshal(t, u, want)
+}
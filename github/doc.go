@@ -184,5 +184,19 @@ github.Response struct.
 		opt.Page = resp.NextPage
 	}
 
+Some lists can also be iterated over with an iterator, such as the one
+returned by PullRequestsService.AllFiles, which fetches the pages as needed.
+The iterators are used like a bufio.Scanner, and their Response method
+returns the response of the last page fetched:
+
+	it := client.PullRequests.AllFiles(ctx, "google", "go-github", 1, nil)
+	for it.Next() {
+		file := it.File()
+		// ...
+	}
+	if err := it.Err(); err != nil {
+		return err
+	}
+
 */
 package github
@@ -0,0 +1,78 @@
+// Copyright 2021 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"fmt"
+)
+
+// AnnouncementBanner represents an announcement banner, displayed to the
+// users of an enterprise or of a GitHub Enterprise Server instance.
+type AnnouncementBanner struct {
+	// Announcement is the text of the banner, in GitHub Flavored Markdown.
+	Announcement *string `json:"announcement,omitempty"`
+	// ExpiresAt is when the banner stops being displayed. If it is not set,
+	// the banner is displayed until it is removed.
+	ExpiresAt *Timestamp `json:"expires_at,omitempty"`
+	// UserDismissible specifies whether users can dismiss the banner.
+	UserDismissible *bool `json:"user_dismissible,omitempty"`
+}
+
+func (a AnnouncementBanner) String() string {
+	return Stringify(a)
+}
+
+// GetAnnouncementBanner gets the announcement banner of an enterprise.
+//
+// GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/enterprise-admin/#get-announcement-banner-for-enterprise
+func (s *EnterpriseService) GetAnnouncementBanner(ctx context.Context, enterprise string) (*AnnouncementBanner, *Response, error) {
+	u := fmt.Sprintf("enterprises/%v/announcement", enterprise)
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	banner := new(AnnouncementBanner)
+	resp, err := s.client.Do(ctx, req, banner)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return banner, resp, nil
+}
+
+// SetAnnouncementBanner sets the announcement banner of an enterprise.
+//
+// GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/enterprise-admin/#set-announcement-banner-for-enterprise
+func (s *EnterpriseService) SetAnnouncementBanner(ctx context.Context, enterprise string, banner *AnnouncementBanner) (*AnnouncementBanner, *Response, error) {
+	u := fmt.Sprintf("enterprises/%v/announcement", enterprise)
+	req, err := s.client.NewRequest("PATCH", u, banner)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	b := new(AnnouncementBanner)
+	resp, err := s.client.Do(ctx, req, b)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return b, resp, nil
+}
+
+// RemoveAnnouncementBanner removes the announcement banner of an enterprise.
+//
+// GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/enterprise-admin/#remove-announcement-banner-from-enterprise
+func (s *EnterpriseService) RemoveAnnouncementBanner(ctx context.Context, enterprise string) (*Response, error) {
+	u := fmt.Sprintf("enterprises/%v/announcement", enterprise)
+	req, err := s.client.NewRequest("DELETE", u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(ctx, req, nil)
+}
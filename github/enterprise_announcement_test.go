@@ -0,0 +1,138 @@
+// Copyright 2021 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+func TestEnterpriseService_GetAnnouncementBanner(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/enterprises/e/announcement", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"announcement": "Very **important** announcement", "expires_at": `+referenceTimeStr+`, "user_dismissible": true}`)
+	})
+
+	ctx := context.Background()
+	banner, _, err := client.Enterprise.GetAnnouncementBanner(ctx, "e")
+	if err != nil {
+		t.Errorf("Enterprise.GetAnnouncementBanner returned error: %v", err)
+	}
+
+	want := &AnnouncementBanner{
+		Announcement:    String("Very **important** announcement"),
+		ExpiresAt:       &Timestamp{referenceTime},
+		UserDismissible: Bool(true),
+	}
+	if !reflect.DeepEqual(banner, want) {
+		t.Errorf("Enterprise.GetAnnouncementBanner returned %+v, want %+v", banner, want)
+	}
+
+	const methodName = "GetAnnouncementBanner"
+	testBadOptions(t, methodName, func() (err error) {
+		_, _, err = client.Enterprise.GetAnnouncementBanner(ctx, "\n")
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.Enterprise.GetAnnouncementBanner(ctx, "e")
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
+
+func TestEnterpriseService_SetAnnouncementBanner(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	input := &AnnouncementBanner{
+		Announcement:    String("Maintenance tonight"),
+		ExpiresAt:       &Timestamp{referenceTime},
+		UserDismissible: Bool(false),
+	}
+
+	mux.HandleFunc("/enterprises/e/announcement", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PATCH")
+		testBody(t, r, `{"announcement":"Maintenance tonight","expires_at":`+referenceTimeStr+`,"user_dismissible":false}`+"\n")
+		fmt.Fprint(w, `{"announcement": "Maintenance tonight", "expires_at": `+referenceTimeStr+`, "user_dismissible": false}`)
+	})
+
+	ctx := context.Background()
+	banner, _, err := client.Enterprise.SetAnnouncementBanner(ctx, "e", input)
+	if err != nil {
+		t.Errorf("Enterprise.SetAnnouncementBanner returned error: %v", err)
+	}
+
+	if !reflect.DeepEqual(banner, input) {
+		t.Errorf("Enterprise.SetAnnouncementBanner returned %+v, want %+v", banner, input)
+	}
+
+	const methodName = "SetAnnouncementBanner"
+	testBadOptions(t, methodName, func() (err error) {
+		_, _, err = client.Enterprise.SetAnnouncementBanner(ctx, "\n", input)
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.Enterprise.SetAnnouncementBanner(ctx, "e", input)
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
+
+func TestEnterpriseService_RemoveAnnouncementBanner(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/enterprises/e/announcement", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	ctx := context.Background()
+	_, err := client.Enterprise.RemoveAnnouncementBanner(ctx, "e")
+	if err != nil {
+		t.Errorf("Enterprise.RemoveAnnouncementBanner returned error: %v", err)
+	}
+
+	const methodName = "RemoveAnnouncementBanner"
+	testBadOptions(t, methodName, func() (err error) {
+		_, err = client.Enterprise.RemoveAnnouncementBanner(ctx, "\n")
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		return client.Enterprise.RemoveAnnouncementBanner(ctx, "e")
+	})
+}
+
+func TestAnnouncementBanner_Marshal(t *testing.T) {
+	testJSONMarshal(t, &AnnouncementBanner{}, "{}")
+
+	u := &AnnouncementBanner{
+		Announcement:    String("a"),
+		ExpiresAt:       &Timestamp{referenceTime},
+		UserDismissible: Bool(true),
+	}
+
+	want := `{
+		"announcement": "a",
+		"expires_at": ` + referenceTimeStr + `,
+		"user_dismissible": true
+	}`
+
+	testJSONMarshal(t, u, want)
+}
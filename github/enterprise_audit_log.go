@@ -0,0 +1,47 @@
+// Copyright 2021 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"fmt"
+)
+
+// GetAuditLog gets the audit log events of an enterprise. If there are more
+// results, Response.After is set to the cursor to pass as
+// GetAuditLogOptions.After.
+//
+// GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/enterprise-admin/#get-the-audit-log-for-an-enterprise
+func (s *EnterpriseService) GetAuditLog(ctx context.Context, enterprise string, opts *GetAuditLogOptions) ([]*AuditEntry, *Response, error) {
+	u := fmt.Sprintf("enterprises/%v/audit-log", enterprise)
+	u, err := addOptions(u, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var auditEntries []*AuditEntry
+	resp, err := s.client.Do(ctx, req, &auditEntries)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return auditEntries, resp, nil
+}
+
+// AuditLogStream returns a stream of the audit log events of an enterprise.
+// See OrganizationsService.AuditLogStream for details.
+//
+// GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/enterprise-admin/#get-the-audit-log-for-an-enterprise
+func (s *EnterpriseService) AuditLogStream(ctx context.Context, enterprise string, opts *GetAuditLogOptions) *AuditLogStream {
+	return newAuditLogStream(opts, func(opts *GetAuditLogOptions) ([]*AuditEntry, *Response, error) {
+		return s.GetAuditLog(ctx, enterprise, opts)
+	})
+}
@@ -0,0 +1,122 @@
+// Copyright 2021 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestEnterpriseService_GetAuditLog(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/enterprises/e/audit-log", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testFormValues(t, r, values{
+			"phrase":   "action:org.add_member",
+			"include":  "web",
+			"before":   "c1",
+			"per_page": "2",
+		})
+		w.Header().Set("Link", `<https://api.github.com/enterprises/e/audit-log?before=c0>; rel="prev"`)
+		fmt.Fprint(w, `[
+		  {
+			"@timestamp": 1611689203000,
+			"_document_id": "d1",
+			"action": "org.add_member",
+			"actor": "octocat",
+			"business": "e",
+			"business_id": 1,
+			"created_at": 1611689203000,
+			"org": "o",
+			"permission": "read",
+			"user": "hubot"
+		  }
+		]`)
+	})
+
+	opts := &GetAuditLogOptions{
+		Phrase:  "action:org.add_member",
+		Include: "web",
+		Before:  "c1",
+		PerPage: 2,
+	}
+	ctx := context.Background()
+	auditEntries, resp, err := client.Enterprise.GetAuditLog(ctx, "e", opts)
+	if err != nil {
+		t.Errorf("Enterprise.GetAuditLog returned error: %v", err)
+	}
+
+	timestamp := &Timestamp{time.Date(2021, time.January, 26, 19, 26, 43, 0, time.UTC)}
+	want := []*AuditEntry{
+		{
+			Action:           String("org.add_member"),
+			Actor:            String("octocat"),
+			Business:         String("e"),
+			BusinessID:       Int64(1),
+			CreatedAt:        timestamp,
+			DocumentID:       String("d1"),
+			Org:              String("o"),
+			Timestamp:        timestamp,
+			User:             String("hubot"),
+			AdditionalFields: map[string]interface{}{"permission": "read"},
+		},
+	}
+	if !reflect.DeepEqual(auditEntries, want) {
+		t.Errorf("Enterprise.GetAuditLog returned %+v, want %+v", auditEntries, want)
+	}
+	if got, want := resp.Before, "c0"; got != want {
+		t.Errorf("Enterprise.GetAuditLog returned Before %v, want %v", got, want)
+	}
+
+	const methodName = "GetAuditLog"
+	testBadOptions(t, methodName, func() (err error) {
+		_, _, err = client.Enterprise.GetAuditLog(ctx, "\n", opts)
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.Enterprise.GetAuditLog(ctx, "e", opts)
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
+
+func TestEnterpriseService_AuditLogStream(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/enterprises/e/audit-log", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		if r.FormValue("after") == "" {
+			w.Header().Set("Link", `<https://api.github.com/enterprises/e/audit-log?after=c1>; rel="next"`)
+			fmt.Fprint(w, `[{"_document_id": "d1"}]`)
+			return
+		}
+		testFormValues(t, r, values{"after": "c1"})
+		fmt.Fprint(w, `[{"_document_id": "d2"}]`)
+	})
+
+	ctx := context.Background()
+	stream := client.Enterprise.AuditLogStream(ctx, "e", nil)
+	var ids []string
+	for stream.Next() {
+		ids = append(ids, stream.Entry().GetDocumentID())
+	}
+	if err := stream.Err(); err != nil {
+		t.Errorf("AuditLogStream returned error: %v", err)
+	}
+	if want := []string{"d1", "d2"}; !reflect.DeepEqual(ids, want) {
+		t.Errorf("AuditLogStream returned %v, want %v", ids, want)
+	}
+}
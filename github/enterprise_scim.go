@@ -0,0 +1,327 @@
+// Copyright 2021 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"fmt"
+)
+
+// SCIM schema URIs used in the SCIM requests and responses.
+const (
+	SCIMSchemasURINamespacesUser         = "urn:ietf:params:scim:schemas:core:2.0:User"
+	SCIMSchemasURINamespacesGroups       = "urn:ietf:params:scim:schemas:core:2.0:Group"
+	SCIMSchemasURINamespacesListResponse = "urn:ietf:params:scim:api:messages:2.0:ListResponse"
+	SCIMSchemasURINamespacesPatchOp      = "urn:ietf:params:scim:api:messages:2.0:PatchOp"
+)
+
+// SCIM patch operations used in SCIMEnterpriseAttributeOperation.Op.
+const (
+	SCIMPatchOpAdd     = "add"
+	SCIMPatchOpRemove  = "remove"
+	SCIMPatchOpReplace = "replace"
+)
+
+// SCIMMeta represents the metadata of a SCIM resource.
+type SCIMMeta struct {
+	// Possible values for ResourceType are: "User" and "Group".
+	ResourceType *string    `json:"resourceType,omitempty"`
+	Created      *Timestamp `json:"created,omitempty"`
+	LastModified *Timestamp `json:"lastModified,omitempty"`
+	Location     *string    `json:"location,omitempty"`
+}
+
+// SCIMEnterpriseDisplayReference represents a reference to a SCIM user from a
+// group, or to a SCIM group from a user.
+type SCIMEnterpriseDisplayReference struct {
+	// Value is the ID of the referenced resource.
+	Value   *string `json:"value,omitempty"`
+	Ref     *string `json:"$ref,omitempty"`
+	Display *string `json:"display,omitempty"`
+}
+
+// SCIMEnterpriseGroupAttributes represents a SCIM group of an enterprise.
+type SCIMEnterpriseGroupAttributes struct {
+	Schemas     []string                          `json:"schemas,omitempty"`
+	ID          *string                           `json:"id,omitempty"`
+	ExternalID  *string                           `json:"externalId,omitempty"`
+	DisplayName *string                           `json:"displayName,omitempty"`
+	Members     []*SCIMEnterpriseDisplayReference `json:"members,omitempty"`
+	Meta        *SCIMMeta                         `json:"meta,omitempty"`
+}
+
+// SCIMEnterpriseGroups represents a list of SCIM groups of an enterprise.
+type SCIMEnterpriseGroups struct {
+	Schemas      []string                         `json:"schemas,omitempty"`
+	TotalResults *int                             `json:"totalResults,omitempty"`
+	ItemsPerPage *int                             `json:"itemsPerPage,omitempty"`
+	StartIndex   *int                             `json:"startIndex,omitempty"`
+	Resources    []*SCIMEnterpriseGroupAttributes `json:"Resources,omitempty"`
+}
+
+// SCIMEnterpriseUserName represents the name of a SCIM user.
+type SCIMEnterpriseUserName struct {
+	GivenName  *string `json:"givenName,omitempty"`
+	FamilyName *string `json:"familyName,omitempty"`
+	Formatted  *string `json:"formatted,omitempty"`
+	MiddleName *string `json:"middleName,omitempty"`
+}
+
+// SCIMEnterpriseUserEmail represents an email address of a SCIM user.
+type SCIMEnterpriseUserEmail struct {
+	Value   *string `json:"value,omitempty"`
+	Type    *string `json:"type,omitempty"`
+	Primary *bool   `json:"primary,omitempty"`
+}
+
+// SCIMEnterpriseUserRole represents a role of a SCIM user, such as
+// "User", "Enterprise Owner" or "Guest Collaborator".
+type SCIMEnterpriseUserRole struct {
+	Value   *string `json:"value,omitempty"`
+	Display *string `json:"display,omitempty"`
+	Type    *string `json:"type,omitempty"`
+	Primary *bool   `json:"primary,omitempty"`
+}
+
+// SCIMEnterpriseUserAttributes represents a SCIM user of an enterprise.
+type SCIMEnterpriseUserAttributes struct {
+	Schemas     []string                          `json:"schemas,omitempty"`
+	ID          *string                           `json:"id,omitempty"`
+	ExternalID  *string                           `json:"externalId,omitempty"`
+	UserName    *string                           `json:"userName,omitempty"`
+	DisplayName *string                           `json:"displayName,omitempty"`
+	Name        *SCIMEnterpriseUserName           `json:"name,omitempty"`
+	Emails      []*SCIMEnterpriseUserEmail        `json:"emails,omitempty"`
+	Roles       []*SCIMEnterpriseUserRole         `json:"roles,omitempty"`
+	Active      *bool                             `json:"active,omitempty"`
+	Groups      []*SCIMEnterpriseDisplayReference `json:"groups,omitempty"`
+	Meta        *SCIMMeta                         `json:"meta,omitempty"`
+}
+
+// SCIMEnterpriseUsers represents a list of SCIM users of an enterprise.
+type SCIMEnterpriseUsers struct {
+	Schemas      []string                        `json:"schemas,omitempty"`
+	TotalResults *int                            `json:"totalResults,omitempty"`
+	ItemsPerPage *int                            `json:"itemsPerPage,omitempty"`
+	StartIndex   *int                            `json:"startIndex,omitempty"`
+	Resources    []*SCIMEnterpriseUserAttributes `json:"Resources,omitempty"`
+}
+
+// ListProvisionedSCIMEnterpriseOptions specifies the optional parameters to
+// the EnterpriseService.ListProvisionedSCIMGroups and
+// EnterpriseService.ListProvisionedSCIMUsers methods.
+type ListProvisionedSCIMEnterpriseOptions struct {
+	// Filter filters the results, e.g. `userName eq "octocat"` or
+	// `externalId eq "8aa1a0c0"`.
+	Filter string `url:"filter,omitempty"`
+	// ExcludedAttributes excludes the given attributes from the results,
+	// e.g. "members".
+	ExcludedAttributes string `url:"excludedAttributes,omitempty"`
+	// StartIndex is the 1-based index of the first result to return.
+	StartIndex int `url:"startIndex,omitempty"`
+	// Count is the number of results to return per page.
+	Count int `url:"count,omitempty"`
+}
+
+// SCIMEnterpriseAttributeOperation represents an operation of a SCIM patch
+// request.
+type SCIMEnterpriseAttributeOperation struct {
+	// Possible values for Op are: "add", "remove" and "replace".
+	Op    string      `json:"op"`
+	Path  *string     `json:"path,omitempty"`
+	Value interface{} `json:"value,omitempty"`
+}
+
+// SCIMEnterpriseAttributes represents a SCIM patch request. Schemas should
+// contain SCIMSchemasURINamespacesPatchOp.
+type SCIMEnterpriseAttributes struct {
+	Schemas    []string                            `json:"schemas,omitempty"`
+	Operations []*SCIMEnterpriseAttributeOperation `json:"Operations"`
+}
+
+// ListProvisionedSCIMGroups lists the SCIM groups provisioned for an enterprise.
+//
+// GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/enterprise-admin/#list-provisioned-scim-groups-for-an-enterprise
+func (s *EnterpriseService) ListProvisionedSCIMGroups(ctx context.Context, enterprise string, opts *ListProvisionedSCIMEnterpriseOptions) (*SCIMEnterpriseGroups, *Response, error) {
+	u := fmt.Sprintf("scim/v2/enterprises/%v/Groups", enterprise)
+	u, err := addOptions(u, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	req.Header.Set("Accept", mediaTypeSCIM)
+
+	groups := new(SCIMEnterpriseGroups)
+	resp, err := s.client.Do(ctx, req, groups)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return groups, resp, nil
+}
+
+// ProvisionSCIMGroup provisions a SCIM group for an enterprise.
+//
+// GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/enterprise-admin/#provision-a-scim-enterprise-group
+func (s *EnterpriseService) ProvisionSCIMGroup(ctx context.Context, enterprise string, group *SCIMEnterpriseGroupAttributes) (*SCIMEnterpriseGroupAttributes, *Response, error) {
+	u := fmt.Sprintf("scim/v2/enterprises/%v/Groups", enterprise)
+	return s.sendSCIMGroup(ctx, "POST", u, group)
+}
+
+// GetProvisionedSCIMGroup gets a SCIM group provisioned for an enterprise.
+//
+// GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/enterprise-admin/#get-scim-provisioning-information-for-an-enterprise-group
+func (s *EnterpriseService) GetProvisionedSCIMGroup(ctx context.Context, enterprise, scimGroupID string) (*SCIMEnterpriseGroupAttributes, *Response, error) {
+	u := fmt.Sprintf("scim/v2/enterprises/%v/Groups/%v", enterprise, scimGroupID)
+	return s.sendSCIMGroup(ctx, "GET", u, nil)
+}
+
+// SetProvisionedSCIMGroup replaces all the attributes of a SCIM group
+// provisioned for an enterprise.
+//
+// GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/enterprise-admin/#set-scim-information-for-a-provisioned-enterprise-group
+func (s *EnterpriseService) SetProvisionedSCIMGroup(ctx context.Context, enterprise, scimGroupID string, group *SCIMEnterpriseGroupAttributes) (*SCIMEnterpriseGroupAttributes, *Response, error) {
+	u := fmt.Sprintf("scim/v2/enterprises/%v/Groups/%v", enterprise, scimGroupID)
+	return s.sendSCIMGroup(ctx, "PUT", u, group)
+}
+
+// UpdateSCIMGroupAttribute updates some of the attributes of a SCIM group
+// provisioned for an enterprise, such as its members.
+//
+// GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/enterprise-admin/#update-an-attribute-for-a-scim-enterprise-group
+func (s *EnterpriseService) UpdateSCIMGroupAttribute(ctx context.Context, enterprise, scimGroupID string, attributes *SCIMEnterpriseAttributes) (*SCIMEnterpriseGroupAttributes, *Response, error) {
+	u := fmt.Sprintf("scim/v2/enterprises/%v/Groups/%v", enterprise, scimGroupID)
+	return s.sendSCIMGroup(ctx, "PATCH", u, attributes)
+}
+
+// DeleteSCIMGroup deletes a SCIM group provisioned for an enterprise.
+//
+// GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/enterprise-admin/#delete-a-scim-group-from-an-enterprise
+func (s *EnterpriseService) DeleteSCIMGroup(ctx context.Context, enterprise, scimGroupID string) (*Response, error) {
+	u := fmt.Sprintf("scim/v2/enterprises/%v/Groups/%v", enterprise, scimGroupID)
+	req, err := s.client.NewRequest("DELETE", u, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Accept", mediaTypeSCIM)
+
+	return s.client.Do(ctx, req, nil)
+}
+
+// sendSCIMGroup sends a SCIM group request and decodes the SCIM group in
+// its response.
+func (s *EnterpriseService) sendSCIMGroup(ctx context.Context, method, u string, body interface{}) (*SCIMEnterpriseGroupAttributes, *Response, error) {
+	req, err := s.client.NewRequest(method, u, body)
+	if err != nil {
+		return nil, nil, err
+	}
+	req.Header.Set("Accept", mediaTypeSCIM)
+
+	group := new(SCIMEnterpriseGroupAttributes)
+	resp, err := s.client.Do(ctx, req, group)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return group, resp, nil
+}
+
+// ListProvisionedSCIMUsers lists the SCIM users provisioned for an enterprise.
+//
+// GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/enterprise-admin/#list-scim-provisioned-identities-for-an-enterprise
+func (s *EnterpriseService) ListProvisionedSCIMUsers(ctx context.Context, enterprise string, opts *ListProvisionedSCIMEnterpriseOptions) (*SCIMEnterpriseUsers, *Response, error) {
+	u := fmt.Sprintf("scim/v2/enterprises/%v/Users", enterprise)
+	u, err := addOptions(u, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	req.Header.Set("Accept", mediaTypeSCIM)
+
+	users := new(SCIMEnterpriseUsers)
+	resp, err := s.client.Do(ctx, req, users)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return users, resp, nil
+}
+
+// ProvisionSCIMUser provisions a SCIM user for an enterprise.
+//
+// GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/enterprise-admin/#provision-a-scim-enterprise-user
+func (s *EnterpriseService) ProvisionSCIMUser(ctx context.Context, enterprise string, user *SCIMEnterpriseUserAttributes) (*SCIMEnterpriseUserAttributes, *Response, error) {
+	u := fmt.Sprintf("scim/v2/enterprises/%v/Users", enterprise)
+	return s.sendSCIMUser(ctx, "POST", u, user)
+}
+
+// GetProvisionedSCIMUser gets a SCIM user provisioned for an enterprise.
+//
+// GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/enterprise-admin/#get-scim-provisioning-information-for-an-enterprise-user
+func (s *EnterpriseService) GetProvisionedSCIMUser(ctx context.Context, enterprise, scimUserID string) (*SCIMEnterpriseUserAttributes, *Response, error) {
+	u := fmt.Sprintf("scim/v2/enterprises/%v/Users/%v", enterprise, scimUserID)
+	return s.sendSCIMUser(ctx, "GET", u, nil)
+}
+
+// SetProvisionedSCIMUser replaces all the attributes of a SCIM user
+// provisioned for an enterprise.
+//
+// GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/enterprise-admin/#set-scim-information-for-a-provisioned-enterprise-user
+func (s *EnterpriseService) SetProvisionedSCIMUser(ctx context.Context, enterprise, scimUserID string, user *SCIMEnterpriseUserAttributes) (*SCIMEnterpriseUserAttributes, *Response, error) {
+	u := fmt.Sprintf("scim/v2/enterprises/%v/Users/%v", enterprise, scimUserID)
+	return s.sendSCIMUser(ctx, "PUT", u, user)
+}
+
+// UpdateSCIMUserAttribute updates some of the attributes of a SCIM user
+// provisioned for an enterprise. It can be used to suspend a user, by
+// replacing its "active" attribute with false.
+//
+// GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/enterprise-admin/#update-an-attribute-for-a-scim-enterprise-user
+func (s *EnterpriseService) UpdateSCIMUserAttribute(ctx context.Context, enterprise, scimUserID string, attributes *SCIMEnterpriseAttributes) (*SCIMEnterpriseUserAttributes, *Response, error) {
+	u := fmt.Sprintf("scim/v2/enterprises/%v/Users/%v", enterprise, scimUserID)
+	return s.sendSCIMUser(ctx, "PATCH", u, attributes)
+}
+
+// DeleteSCIMUser deletes a SCIM user provisioned for an enterprise, and
+// suspends the corresponding GitHub user.
+//
+// GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/enterprise-admin/#delete-a-scim-user-from-an-enterprise
+func (s *EnterpriseService) DeleteSCIMUser(ctx context.Context, enterprise, scimUserID string) (*Response, error) {
+	u := fmt.Sprintf("scim/v2/enterprises/%v/Users/%v", enterprise, scimUserID)
+	req, err := s.client.NewRequest("DELETE", u, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Accept", mediaTypeSCIM)
+
+	return s.client.Do(ctx, req, nil)
+}
+
+// sendSCIMUser sends a SCIM user request and decodes the SCIM user in its
+// response.
+func (s *EnterpriseService) sendSCIMUser(ctx context.Context, method, u string, body interface{}) (*SCIMEnterpriseUserAttributes, *Response, error) {
+	req, err := s.client.NewRequest(method, u, body)
+	if err != nil {
+		return nil, nil, err
+	}
+	req.Header.Set("Accept", mediaTypeSCIM)
+
+	user := new(SCIMEnterpriseUserAttributes)
+	resp, err := s.client.Do(ctx, req, user)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return user, resp, nil
+}
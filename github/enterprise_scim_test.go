@@ -0,0 +1,603 @@
+// Copyright 2021 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+func TestEnterpriseService_ListProvisionedSCIMGroups(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/scim/v2/enterprises/e/Groups", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testHeader(t, r, "Accept", mediaTypeSCIM)
+		testFormValues(t, r, values{
+			"filter":             `externalId eq "8aa1a0c0"`,
+			"excludedAttributes": "members",
+			"startIndex":         "1",
+			"count":              "2",
+		})
+		fmt.Fprint(w, `{
+			"schemas": ["urn:ietf:params:scim:api:messages:2.0:ListResponse"],
+			"totalResults": 1,
+			"itemsPerPage": 1,
+			"startIndex": 1,
+			"Resources": [
+				{
+					"schemas": ["urn:ietf:params:scim:schemas:core:2.0:Group"],
+					"id": "abcd27f8",
+					"externalId": "8aa1a0c0",
+					"displayName": "Engineering",
+					"meta": {
+						"resourceType": "Group",
+						"created": `+referenceTimeStr+`,
+						"lastModified": `+referenceTimeStr+`,
+						"location": "https://api.github.com/scim/v2/enterprises/e/Groups/abcd27f8"
+					}
+				}
+			]
+		}`)
+	})
+
+	opts := &ListProvisionedSCIMEnterpriseOptions{
+		Filter:             `externalId eq "8aa1a0c0"`,
+		ExcludedAttributes: "members",
+		StartIndex:         1,
+		Count:              2,
+	}
+	ctx := context.Background()
+	groups, _, err := client.Enterprise.ListProvisionedSCIMGroups(ctx, "e", opts)
+	if err != nil {
+		t.Errorf("Enterprise.ListProvisionedSCIMGroups returned error: %v", err)
+	}
+
+	want := &SCIMEnterpriseGroups{
+		Schemas:      []string{SCIMSchemasURINamespacesListResponse},
+		TotalResults: Int(1),
+		ItemsPerPage: Int(1),
+		StartIndex:   Int(1),
+		Resources: []*SCIMEnterpriseGroupAttributes{
+			{
+				Schemas:     []string{SCIMSchemasURINamespacesGroups},
+				ID:          String("abcd27f8"),
+				ExternalID:  String("8aa1a0c0"),
+				DisplayName: String("Engineering"),
+				Meta: &SCIMMeta{
+					ResourceType: String("Group"),
+					Created:      &Timestamp{referenceTime},
+					LastModified: &Timestamp{referenceTime},
+					Location:     String("https://api.github.com/scim/v2/enterprises/e/Groups/abcd27f8"),
+				},
+			},
+		},
+	}
+	if !reflect.DeepEqual(groups, want) {
+		t.Errorf("Enterprise.ListProvisionedSCIMGroups returned %+v, want %+v", groups, want)
+	}
+
+	const methodName = "ListProvisionedSCIMGroups"
+	testBadOptions(t, methodName, func() (err error) {
+		_, _, err = client.Enterprise.ListProvisionedSCIMGroups(ctx, "\n", opts)
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.Enterprise.ListProvisionedSCIMGroups(ctx, "e", opts)
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
+
+func TestEnterpriseService_ProvisionSCIMGroup(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/scim/v2/enterprises/e/Groups", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testHeader(t, r, "Accept", mediaTypeSCIM)
+		testBody(t, r, `{"schemas":["urn:ietf:params:scim:schemas:core:2.0:Group"],"externalId":"8aa1a0c0","displayName":"Engineering","members":[{"value":"7fce0092"}]}`+"\n")
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprint(w, `{"id": "abcd27f8", "displayName": "Engineering", "members": [{"value": "7fce0092", "display": "octocat"}]}`)
+	})
+
+	group := &SCIMEnterpriseGroupAttributes{
+		Schemas:     []string{SCIMSchemasURINamespacesGroups},
+		ExternalID:  String("8aa1a0c0"),
+		DisplayName: String("Engineering"),
+		Members:     []*SCIMEnterpriseDisplayReference{{Value: String("7fce0092")}},
+	}
+	ctx := context.Background()
+	got, _, err := client.Enterprise.ProvisionSCIMGroup(ctx, "e", group)
+	if err != nil {
+		t.Errorf("Enterprise.ProvisionSCIMGroup returned error: %v", err)
+	}
+
+	want := &SCIMEnterpriseGroupAttributes{
+		ID:          String("abcd27f8"),
+		DisplayName: String("Engineering"),
+		Members:     []*SCIMEnterpriseDisplayReference{{Value: String("7fce0092"), Display: String("octocat")}},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Enterprise.ProvisionSCIMGroup returned %+v, want %+v", got, want)
+	}
+
+	const methodName = "ProvisionSCIMGroup"
+	testBadOptions(t, methodName, func() (err error) {
+		_, _, err = client.Enterprise.ProvisionSCIMGroup(ctx, "\n", group)
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.Enterprise.ProvisionSCIMGroup(ctx, "e", group)
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
+
+func TestEnterpriseService_GetProvisionedSCIMGroup(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/scim/v2/enterprises/e/Groups/abcd27f8", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testHeader(t, r, "Accept", mediaTypeSCIM)
+		fmt.Fprint(w, `{"id": "abcd27f8", "displayName": "Engineering"}`)
+	})
+
+	ctx := context.Background()
+	got, _, err := client.Enterprise.GetProvisionedSCIMGroup(ctx, "e", "abcd27f8")
+	if err != nil {
+		t.Errorf("Enterprise.GetProvisionedSCIMGroup returned error: %v", err)
+	}
+
+	want := &SCIMEnterpriseGroupAttributes{ID: String("abcd27f8"), DisplayName: String("Engineering")}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Enterprise.GetProvisionedSCIMGroup returned %+v, want %+v", got, want)
+	}
+
+	const methodName = "GetProvisionedSCIMGroup"
+	testBadOptions(t, methodName, func() (err error) {
+		_, _, err = client.Enterprise.GetProvisionedSCIMGroup(ctx, "\n", "\n")
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.Enterprise.GetProvisionedSCIMGroup(ctx, "e", "abcd27f8")
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
+
+func TestEnterpriseService_SetProvisionedSCIMGroup(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/scim/v2/enterprises/e/Groups/abcd27f8", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PUT")
+		testBody(t, r, `{"displayName":"Platform"}`+"\n")
+		fmt.Fprint(w, `{"id": "abcd27f8", "displayName": "Platform"}`)
+	})
+
+	group := &SCIMEnterpriseGroupAttributes{DisplayName: String("Platform")}
+	ctx := context.Background()
+	got, _, err := client.Enterprise.SetProvisionedSCIMGroup(ctx, "e", "abcd27f8", group)
+	if err != nil {
+		t.Errorf("Enterprise.SetProvisionedSCIMGroup returned error: %v", err)
+	}
+
+	want := &SCIMEnterpriseGroupAttributes{ID: String("abcd27f8"), DisplayName: String("Platform")}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Enterprise.SetProvisionedSCIMGroup returned %+v, want %+v", got, want)
+	}
+
+	const methodName = "SetProvisionedSCIMGroup"
+	testBadOptions(t, methodName, func() (err error) {
+		_, _, err = client.Enterprise.SetProvisionedSCIMGroup(ctx, "\n", "\n", group)
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.Enterprise.SetProvisionedSCIMGroup(ctx, "e", "abcd27f8", group)
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
+
+func TestEnterpriseService_UpdateSCIMGroupAttribute(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/scim/v2/enterprises/e/Groups/abcd27f8", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PATCH")
+		testBody(t, r, `{"schemas":["urn:ietf:params:scim:api:messages:2.0:PatchOp"],"Operations":[{"op":"add","path":"members","value":[{"value":"7fce0092"}]}]}`+"\n")
+		fmt.Fprint(w, `{"id": "abcd27f8", "members": [{"value": "7fce0092"}]}`)
+	})
+
+	attributes := &SCIMEnterpriseAttributes{
+		Schemas: []string{SCIMSchemasURINamespacesPatchOp},
+		Operations: []*SCIMEnterpriseAttributeOperation{
+			{
+				Op:    SCIMPatchOpAdd,
+				Path:  String("members"),
+				Value: []*SCIMEnterpriseDisplayReference{{Value: String("7fce0092")}},
+			},
+		},
+	}
+	ctx := context.Background()
+	got, _, err := client.Enterprise.UpdateSCIMGroupAttribute(ctx, "e", "abcd27f8", attributes)
+	if err != nil {
+		t.Errorf("Enterprise.UpdateSCIMGroupAttribute returned error: %v", err)
+	}
+
+	want := &SCIMEnterpriseGroupAttributes{
+		ID:      String("abcd27f8"),
+		Members: []*SCIMEnterpriseDisplayReference{{Value: String("7fce0092")}},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Enterprise.UpdateSCIMGroupAttribute returned %+v, want %+v", got, want)
+	}
+
+	const methodName = "UpdateSCIMGroupAttribute"
+	testBadOptions(t, methodName, func() (err error) {
+		_, _, err = client.Enterprise.UpdateSCIMGroupAttribute(ctx, "\n", "\n", attributes)
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.Enterprise.UpdateSCIMGroupAttribute(ctx, "e", "abcd27f8", attributes)
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
+
+func TestEnterpriseService_DeleteSCIMGroup(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/scim/v2/enterprises/e/Groups/abcd27f8", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+		testHeader(t, r, "Accept", mediaTypeSCIM)
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	ctx := context.Background()
+	_, err := client.Enterprise.DeleteSCIMGroup(ctx, "e", "abcd27f8")
+	if err != nil {
+		t.Errorf("Enterprise.DeleteSCIMGroup returned error: %v", err)
+	}
+
+	const methodName = "DeleteSCIMGroup"
+	testBadOptions(t, methodName, func() (err error) {
+		_, err = client.Enterprise.DeleteSCIMGroup(ctx, "\n", "\n")
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		return client.Enterprise.DeleteSCIMGroup(ctx, "e", "abcd27f8")
+	})
+}
+
+func TestEnterpriseService_ListProvisionedSCIMUsers(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/scim/v2/enterprises/e/Users", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testHeader(t, r, "Accept", mediaTypeSCIM)
+		testFormValues(t, r, values{"filter": `userName eq "octocat"`})
+		fmt.Fprint(w, `{
+			"schemas": ["urn:ietf:params:scim:api:messages:2.0:ListResponse"],
+			"totalResults": 1,
+			"Resources": [
+				{
+					"schemas": ["urn:ietf:params:scim:schemas:core:2.0:User"],
+					"id": "7fce0092",
+					"externalId": "e7b32a4c",
+					"userName": "octocat@example.com",
+					"displayName": "Mona Octocat",
+					"name": {"givenName": "Mona", "familyName": "Octocat"},
+					"emails": [{"value": "octocat@example.com", "type": "work", "primary": true}],
+					"roles": [{"value": "User", "primary": true}],
+					"active": true,
+					"groups": [{"value": "abcd27f8", "display": "Engineering"}]
+				}
+			]
+		}`)
+	})
+
+	opts := &ListProvisionedSCIMEnterpriseOptions{Filter: `userName eq "octocat"`}
+	ctx := context.Background()
+	users, _, err := client.Enterprise.ListProvisionedSCIMUsers(ctx, "e", opts)
+	if err != nil {
+		t.Errorf("Enterprise.ListProvisionedSCIMUsers returned error: %v", err)
+	}
+
+	want := &SCIMEnterpriseUsers{
+		Schemas:      []string{SCIMSchemasURINamespacesListResponse},
+		TotalResults: Int(1),
+		Resources: []*SCIMEnterpriseUserAttributes{
+			{
+				Schemas:     []string{SCIMSchemasURINamespacesUser},
+				ID:          String("7fce0092"),
+				ExternalID:  String("e7b32a4c"),
+				UserName:    String("octocat@example.com"),
+				DisplayName: String("Mona Octocat"),
+				Name:        &SCIMEnterpriseUserName{GivenName: String("Mona"), FamilyName: String("Octocat")},
+				Emails:      []*SCIMEnterpriseUserEmail{{Value: String("octocat@example.com"), Type: String("work"), Primary: Bool(true)}},
+				Roles:       []*SCIMEnterpriseUserRole{{Value: String("User"), Primary: Bool(true)}},
+				Active:      Bool(true),
+				Groups:      []*SCIMEnterpriseDisplayReference{{Value: String("abcd27f8"), Display: String("Engineering")}},
+			},
+		},
+	}
+	if !reflect.DeepEqual(users, want) {
+		t.Errorf("Enterprise.ListProvisionedSCIMUsers returned %+v, want %+v", users, want)
+	}
+
+	const methodName = "ListProvisionedSCIMUsers"
+	testBadOptions(t, methodName, func() (err error) {
+		_, _, err = client.Enterprise.ListProvisionedSCIMUsers(ctx, "\n", opts)
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.Enterprise.ListProvisionedSCIMUsers(ctx, "e", opts)
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
+
+func TestEnterpriseService_ProvisionSCIMUser(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/scim/v2/enterprises/e/Users", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testHeader(t, r, "Accept", mediaTypeSCIM)
+		testBody(t, r, `{"schemas":["urn:ietf:params:scim:schemas:core:2.0:User"],"externalId":"e7b32a4c","userName":"octocat@example.com","active":true}`+"\n")
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprint(w, `{"id": "7fce0092", "userName": "octocat@example.com"}`)
+	})
+
+	user := &SCIMEnterpriseUserAttributes{
+		Schemas:    []string{SCIMSchemasURINamespacesUser},
+		ExternalID: String("e7b32a4c"),
+		UserName:   String("octocat@example.com"),
+		Active:     Bool(true),
+	}
+	ctx := context.Background()
+	got, _, err := client.Enterprise.ProvisionSCIMUser(ctx, "e", user)
+	if err != nil {
+		t.Errorf("Enterprise.ProvisionSCIMUser returned error: %v", err)
+	}
+
+	want := &SCIMEnterpriseUserAttributes{ID: String("7fce0092"), UserName: String("octocat@example.com")}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Enterprise.ProvisionSCIMUser returned %+v, want %+v", got, want)
+	}
+
+	const methodName = "ProvisionSCIMUser"
+	testBadOptions(t, methodName, func() (err error) {
+		_, _, err = client.Enterprise.ProvisionSCIMUser(ctx, "\n", user)
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.Enterprise.ProvisionSCIMUser(ctx, "e", user)
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
+
+func TestEnterpriseService_GetProvisionedSCIMUser(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/scim/v2/enterprises/e/Users/7fce0092", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testHeader(t, r, "Accept", mediaTypeSCIM)
+		fmt.Fprint(w, `{"id": "7fce0092", "active": false}`)
+	})
+
+	ctx := context.Background()
+	got, _, err := client.Enterprise.GetProvisionedSCIMUser(ctx, "e", "7fce0092")
+	if err != nil {
+		t.Errorf("Enterprise.GetProvisionedSCIMUser returned error: %v", err)
+	}
+
+	want := &SCIMEnterpriseUserAttributes{ID: String("7fce0092"), Active: Bool(false)}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Enterprise.GetProvisionedSCIMUser returned %+v, want %+v", got, want)
+	}
+
+	const methodName = "GetProvisionedSCIMUser"
+	testBadOptions(t, methodName, func() (err error) {
+		_, _, err = client.Enterprise.GetProvisionedSCIMUser(ctx, "\n", "\n")
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.Enterprise.GetProvisionedSCIMUser(ctx, "e", "7fce0092")
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
+
+func TestEnterpriseService_SetProvisionedSCIMUser(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/scim/v2/enterprises/e/Users/7fce0092", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PUT")
+		testBody(t, r, `{"userName":"mona@example.com","displayName":"Mona"}`+"\n")
+		fmt.Fprint(w, `{"id": "7fce0092", "userName": "mona@example.com", "displayName": "Mona"}`)
+	})
+
+	user := &SCIMEnterpriseUserAttributes{UserName: String("mona@example.com"), DisplayName: String("Mona")}
+	ctx := context.Background()
+	got, _, err := client.Enterprise.SetProvisionedSCIMUser(ctx, "e", "7fce0092", user)
+	if err != nil {
+		t.Errorf("Enterprise.SetProvisionedSCIMUser returned error: %v", err)
+	}
+
+	want := &SCIMEnterpriseUserAttributes{ID: String("7fce0092"), UserName: String("mona@example.com"), DisplayName: String("Mona")}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Enterprise.SetProvisionedSCIMUser returned %+v, want %+v", got, want)
+	}
+
+	const methodName = "SetProvisionedSCIMUser"
+	testBadOptions(t, methodName, func() (err error) {
+		_, _, err = client.Enterprise.SetProvisionedSCIMUser(ctx, "\n", "\n", user)
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.Enterprise.SetProvisionedSCIMUser(ctx, "e", "7fce0092", user)
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
+
+func TestEnterpriseService_UpdateSCIMUserAttribute(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/scim/v2/enterprises/e/Users/7fce0092", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PATCH")
+		testBody(t, r, `{"schemas":["urn:ietf:params:scim:api:messages:2.0:PatchOp"],"Operations":[{"op":"replace","path":"active","value":false}]}`+"\n")
+		fmt.Fprint(w, `{"id": "7fce0092", "active": false}`)
+	})
+
+	attributes := &SCIMEnterpriseAttributes{
+		Schemas: []string{SCIMSchemasURINamespacesPatchOp},
+		Operations: []*SCIMEnterpriseAttributeOperation{
+			{Op: SCIMPatchOpReplace, Path: String("active"), Value: false},
+		},
+	}
+	ctx := context.Background()
+	got, _, err := client.Enterprise.UpdateSCIMUserAttribute(ctx, "e", "7fce0092", attributes)
+	if err != nil {
+		t.Errorf("Enterprise.UpdateSCIMUserAttribute returned error: %v", err)
+	}
+
+	want := &SCIMEnterpriseUserAttributes{ID: String("7fce0092"), Active: Bool(false)}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Enterprise.UpdateSCIMUserAttribute returned %+v, want %+v", got, want)
+	}
+
+	const methodName = "UpdateSCIMUserAttribute"
+	testBadOptions(t, methodName, func() (err error) {
+		_, _, err = client.Enterprise.UpdateSCIMUserAttribute(ctx, "\n", "\n", attributes)
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.Enterprise.UpdateSCIMUserAttribute(ctx, "e", "7fce0092", attributes)
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
+
+func TestEnterpriseService_DeleteSCIMUser(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/scim/v2/enterprises/e/Users/7fce0092", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+		testHeader(t, r, "Accept", mediaTypeSCIM)
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	ctx := context.Background()
+	_, err := client.Enterprise.DeleteSCIMUser(ctx, "e", "7fce0092")
+	if err != nil {
+		t.Errorf("Enterprise.DeleteSCIMUser returned error: %v", err)
+	}
+
+	const methodName = "DeleteSCIMUser"
+	testBadOptions(t, methodName, func() (err error) {
+		_, err = client.Enterprise.DeleteSCIMUser(ctx, "\n", "\n")
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		return client.Enterprise.DeleteSCIMUser(ctx, "e", "7fce0092")
+	})
+}
+
+func TestSCIMEnterpriseUserAttributes_Marshal(t *testing.T) {
+	testJSONMarshal(t, &SCIMEnterpriseUserAttributes{}, "{}")
+
+	u := &SCIMEnterpriseUserAttributes{
+		Schemas:     []string{SCIMSchemasURINamespacesUser},
+		ID:          String("i"),
+		ExternalID:  String("e"),
+		UserName:    String("u"),
+		DisplayName: String("d"),
+		Name:        &SCIMEnterpriseUserName{GivenName: String("g"), FamilyName: String("f")},
+		Emails:      []*SCIMEnterpriseUserEmail{{Value: String("v"), Type: String("work"), Primary: Bool(true)}},
+		Roles:       []*SCIMEnterpriseUserRole{{Value: String("User")}},
+		Active:      Bool(true),
+		Meta:        &SCIMMeta{ResourceType: String("User"), Created: &Timestamp{referenceTime}},
+	}
+
+	want := `{
+		"schemas": ["urn:ietf:params:scim:schemas:core:2.0:User"],
+		"id": "i",
+		"externalId": "e",
+		"userName": "u",
+		"displayName": "d",
+		"name": {
+			"givenName": "g",
+			"familyName": "f"
+		},
+		"emails": [
+			{
+				"value": "v",
+				"type": "work",
+				"primary": true
+			}
+		],
+		"roles": [
+			{
+				"value": "User"
+			}
+		],
+		"active": true,
+		"meta": {
+			"resourceType": "User",
+			"created": ` + referenceTimeStr + `
+		}
+	}`
+
+	testJSONMarshal(t, u, want)
+}
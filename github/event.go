@@ -7,6 +7,9 @@ package github
 
 import (
 	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
 	"time"
 )
 
@@ -26,107 +29,210 @@ func (e Event) String() string {
 	return Stringify(e)
 }
 
+// EventType is the type of an event, as in Event.Type, which is the name of
+// the struct type of its payload, such as "PushEvent".
+type EventType string
+
+// Types of the events whose payload is parsed by Event.ParsePayload, as in
+// Event.Type.
+const (
+	EventTypeCheckRun                     EventType = "CheckRunEvent"
+	EventTypeCheckSuite                   EventType = "CheckSuiteEvent"
+	EventTypeCommitComment                EventType = "CommitCommentEvent"
+	EventTypeContentReference             EventType = "ContentReferenceEvent"
+	EventTypeCreate                       EventType = "CreateEvent"
+	EventTypeCustomProperty               EventType = "CustomPropertyEvent"
+	EventTypeCustomPropertyValues         EventType = "CustomPropertyValuesEvent"
+	EventTypeDelete                       EventType = "DeleteEvent"
+	EventTypeDeployKey                    EventType = "DeployKeyEvent"
+	EventTypeDeployment                   EventType = "DeploymentEvent"
+	EventTypeDeploymentStatus             EventType = "DeploymentStatusEvent"
+	EventTypeFork                         EventType = "ForkEvent"
+	EventTypeGitHubAppAuthorization       EventType = "GitHubAppAuthorizationEvent"
+	EventTypeGollum                       EventType = "GollumEvent"
+	EventTypeInstallation                 EventType = "InstallationEvent"
+	EventTypeInstallationRepositories     EventType = "InstallationRepositoriesEvent"
+	EventTypeIssueComment                 EventType = "IssueCommentEvent"
+	EventTypeIssueDependencies            EventType = "IssueDependenciesEvent"
+	EventTypeIssues                       EventType = "IssuesEvent"
+	EventTypeLabel                        EventType = "LabelEvent"
+	EventTypeMarketplacePurchase          EventType = "MarketplacePurchaseEvent"
+	EventTypeMember                       EventType = "MemberEvent"
+	EventTypeMembership                   EventType = "MembershipEvent"
+	EventTypeMergeGroup                   EventType = "MergeGroupEvent"
+	EventTypeMeta                         EventType = "MetaEvent"
+	EventTypeMilestone                    EventType = "MilestoneEvent"
+	EventTypeOrganization                 EventType = "OrganizationEvent"
+	EventTypeOrgBlock                     EventType = "OrgBlockEvent"
+	EventTypePackage                      EventType = "PackageEvent"
+	EventTypePageBuild                    EventType = "PageBuildEvent"
+	EventTypePing                         EventType = "PingEvent"
+	EventTypeProject                      EventType = "ProjectEvent"
+	EventTypeProjectCard                  EventType = "ProjectCardEvent"
+	EventTypeProjectColumn                EventType = "ProjectColumnEvent"
+	EventTypeProjectV2StatusUpdate        EventType = "ProjectV2StatusUpdateEvent"
+	EventTypePublic                       EventType = "PublicEvent"
+	EventTypePullRequest                  EventType = "PullRequestEvent"
+	EventTypePullRequestReview            EventType = "PullRequestReviewEvent"
+	EventTypePullRequestReviewComment     EventType = "PullRequestReviewCommentEvent"
+	EventTypePush                         EventType = "PushEvent"
+	EventTypeRelease                      EventType = "ReleaseEvent"
+	EventTypeRepository                   EventType = "RepositoryEvent"
+	EventTypeRepositoryDispatch           EventType = "RepositoryDispatchEvent"
+	EventTypeRepositoryVulnerabilityAlert EventType = "RepositoryVulnerabilityAlertEvent"
+	EventTypeSecretScanningAlertLocation  EventType = "SecretScanningAlertLocationEvent"
+	EventTypeStar                         EventType = "StarEvent"
+	EventTypeStatus                       EventType = "StatusEvent"
+	EventTypeSubIssues                    EventType = "SubIssuesEvent"
+	EventTypeTeam                         EventType = "TeamEvent"
+	EventTypeTeamAdd                      EventType = "TeamAddEvent"
+	EventTypeUser                         EventType = "UserEvent"
+	EventTypeWatch                        EventType = "WatchEvent"
+	EventTypeWorkflowDispatch             EventType = "WorkflowDispatchEvent"
+	EventTypeWorkflowRun                  EventType = "WorkflowRunEvent"
+)
+
 // ParsePayload parses the event payload. For recognized event types,
 // a value of the corresponding struct type will be returned.
 func (e *Event) ParsePayload() (payload interface{}, err error) {
-	switch *e.Type {
-	case "CheckRunEvent":
+	payload = newEventPayload(EventType(*e.Type))
+	err = json.Unmarshal(*e.RawPayload, &payload)
+	return payload, err
+}
+
+// newEventPayload returns a new value of the struct type of the payload of
+// the events of type eventType, or nil if eventType is not recognized.
+func newEventPayload(eventType EventType) (payload interface{}) {
+	switch eventType {
+	case EventTypeCheckRun:
 		payload = &CheckRunEvent{}
-	case "CheckSuiteEvent":
+	case EventTypeCheckSuite:
 		payload = &CheckSuiteEvent{}
-	case "CommitCommentEvent":
+	case EventTypeCommitComment:
 		payload = &CommitCommentEvent{}
-	case "ContentReferenceEvent":
+	case EventTypeContentReference:
 		payload = &ContentReferenceEvent{}
-	case "CreateEvent":
+	case EventTypeCreate:
 		payload = &CreateEvent{}
-	case "DeleteEvent":
+	case EventTypeCustomProperty:
+		payload = &CustomPropertyEvent{}
+	case EventTypeCustomPropertyValues:
+		payload = &CustomPropertyValuesEvent{}
+	case EventTypeDelete:
 		payload = &DeleteEvent{}
-	case "DeployKeyEvent":
+	case EventTypeDeployKey:
 		payload = &DeployKeyEvent{}
-	case "DeploymentEvent":
+	case EventTypeDeployment:
 		payload = &DeploymentEvent{}
-	case "DeploymentStatusEvent":
+	case EventTypeDeploymentStatus:
 		payload = &DeploymentStatusEvent{}
-	case "ForkEvent":
+	case EventTypeFork:
 		payload = &ForkEvent{}
-	case "GitHubAppAuthorizationEvent":
+	case EventTypeGitHubAppAuthorization:
 		payload = &GitHubAppAuthorizationEvent{}
-	case "GollumEvent":
+	case EventTypeGollum:
 		payload = &GollumEvent{}
-	case "InstallationEvent":
+	case EventTypeInstallation:
 		payload = &InstallationEvent{}
-	case "InstallationRepositoriesEvent":
+	case EventTypeInstallationRepositories:
 		payload = &InstallationRepositoriesEvent{}
-	case "IssueCommentEvent":
+	case EventTypeIssueComment:
 		payload = &IssueCommentEvent{}
-	case "IssuesEvent":
+	case EventTypeIssueDependencies:
+		payload = &IssueDependenciesEvent{}
+	case EventTypeIssues:
 		payload = &IssuesEvent{}
-	case "LabelEvent":
+	case EventTypeLabel:
 		payload = &LabelEvent{}
-	case "MarketplacePurchaseEvent":
+	case EventTypeMarketplacePurchase:
 		payload = &MarketplacePurchaseEvent{}
-	case "MemberEvent":
+	case EventTypeMember:
 		payload = &MemberEvent{}
-	case "MembershipEvent":
+	case EventTypeMembership:
 		payload = &MembershipEvent{}
-	case "MetaEvent":
+	case EventTypeMergeGroup:
+		payload = &MergeGroupEvent{}
+	case EventTypeMeta:
 		payload = &MetaEvent{}
-	case "MilestoneEvent":
+	case EventTypeMilestone:
 		payload = &MilestoneEvent{}
-	case "OrganizationEvent":
+	case EventTypeOrganization:
 		payload = &OrganizationEvent{}
-	case "OrgBlockEvent":
+	case EventTypeOrgBlock:
 		payload = &OrgBlockEvent{}
-	case "PackageEvent":
+	case EventTypePackage:
 		payload = &PackageEvent{}
-	case "PageBuildEvent":
+	case EventTypePageBuild:
 		payload = &PageBuildEvent{}
-	case "PingEvent":
+	case EventTypePing:
 		payload = &PingEvent{}
-	case "ProjectEvent":
+	case EventTypeProject:
 		payload = &ProjectEvent{}
-	case "ProjectCardEvent":
+	case EventTypeProjectCard:
 		payload = &ProjectCardEvent{}
-	case "ProjectColumnEvent":
+	case EventTypeProjectColumn:
 		payload = &ProjectColumnEvent{}
-	case "PublicEvent":
+	case EventTypeProjectV2StatusUpdate:
+		payload = &ProjectV2StatusUpdateEvent{}
+	case EventTypePublic:
 		payload = &PublicEvent{}
-	case "PullRequestEvent":
+	case EventTypePullRequest:
 		payload = &PullRequestEvent{}
-	case "PullRequestReviewEvent":
+	case EventTypePullRequestReview:
 		payload = &PullRequestReviewEvent{}
-	case "PullRequestReviewCommentEvent":
+	case EventTypePullRequestReviewComment:
 		payload = &PullRequestReviewCommentEvent{}
-	case "PushEvent":
+	case EventTypePush:
 		payload = &PushEvent{}
-	case "ReleaseEvent":
+	case EventTypeRelease:
 		payload = &ReleaseEvent{}
-	case "RepositoryEvent":
+	case EventTypeRepository:
 		payload = &RepositoryEvent{}
-	case "RepositoryDispatchEvent":
+	case EventTypeRepositoryDispatch:
 		payload = &RepositoryDispatchEvent{}
-	case "RepositoryVulnerabilityAlertEvent":
+	case EventTypeRepositoryVulnerabilityAlert:
 		payload = &RepositoryVulnerabilityAlertEvent{}
-	case "StarEvent":
+	case EventTypeSecretScanningAlertLocation:
+		payload = &SecretScanningAlertLocationEvent{}
+	case EventTypeStar:
 		payload = &StarEvent{}
-	case "StatusEvent":
+	case EventTypeStatus:
 		payload = &StatusEvent{}
-	case "TeamEvent":
+	case EventTypeSubIssues:
+		payload = &SubIssuesEvent{}
+	case EventTypeTeam:
 		payload = &TeamEvent{}
-	case "TeamAddEvent":
+	case EventTypeTeamAdd:
 		payload = &TeamAddEvent{}
-	case "UserEvent":
+	case EventTypeUser:
 		payload = &UserEvent{}
-	case "WatchEvent":
+	case EventTypeWatch:
 		payload = &WatchEvent{}
-	case "WorkflowDispatchEvent":
+	case EventTypeWorkflowDispatch:
 		payload = &WorkflowDispatchEvent{}
-	case "WorkflowRunEvent":
+	case EventTypeWorkflowRun:
 		payload = &WorkflowRunEvent{}
 	}
-	err = json.Unmarshal(*e.RawPayload, &payload)
-	return payload, err
+	return payload
+}
+
+// DecodePayload parses the event payload into v, which must be a pointer to
+// the struct type of the payload, such as *PushEvent for the events of type
+// EventTypePush. It returns an error if the type of e doesn't match v.
+//
+// With Go 1.21 or later, EventPayload is a generic alternative.
+func (e *Event) DecodePayload(v interface{}) error {
+	t := reflect.TypeOf(v)
+	if t == nil || t.Kind() != reflect.Ptr {
+		return fmt.Errorf("DecodePayload requires a non-nil pointer, got %T", v)
+	}
+	if name := t.Elem().Name(); name != e.GetType() {
+		return fmt.Errorf("event of type %q can't be decoded into a %v", e.GetType(), name)
+	}
+	if e.RawPayload == nil {
+		return errors.New("event has no payload")
+	}
+	return json.Unmarshal(*e.RawPayload, v)
 }
 
 // Payload returns the parsed event payload. For recognized event types,
@@ -0,0 +1,27 @@
+// Copyright 2021 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:build go1.21
+// +build go1.21
+
+package github
+
+// EventPayload parses the payload of e as a T, the struct type of the
+// payload, such as PushEvent for the events of type EventTypePush. It
+// returns an error if the type of e doesn't match T.
+//
+// Example usage:
+//
+//	push, err := github.EventPayload[github.PushEvent](event)
+//
+// EventPayload requires Go 1.21 or later, as this module supports earlier
+// Go versions; use Event.DecodePayload with those.
+func EventPayload[T any](e *Event) (*T, error) {
+	payload := new(T)
+	if err := e.DecodePayload(payload); err != nil {
+		return nil, err
+	}
+	return payload, nil
+}
@@ -0,0 +1,33 @@
+// Copyright 2021 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:build go1.21
+// +build go1.21
+
+package github
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+)
+
+func TestEventPayload(t *testing.T) {
+	body := json.RawMessage(`{"ref":"refs/heads/main","size":1}`)
+	e := &Event{Type: String(string(EventTypePush)), RawPayload: &body}
+
+	got, err := EventPayload[PushEvent](e)
+	if err != nil {
+		t.Fatalf("EventPayload returned error: %v", err)
+	}
+	want := &PushEvent{Ref: String("refs/heads/main"), Size: Int(1)}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("EventPayload returned %+v, want %+v", got, want)
+	}
+
+	if got, err := EventPayload[IssuesEvent](e); got != nil || err == nil {
+		t.Errorf("EventPayload with mismatched type returned %+v, %v, want nil and error", got, err)
+	}
+}
@@ -7,6 +7,7 @@ package github
 
 import (
 	"encoding/json"
+	"reflect"
 	"testing"
 )
 
@@ -29,3 +30,45 @@ func TestPayload_NoPanic(t *testing.T) {
 	e := &Event{Type: &name, RawPayload: &body}
 	e.Payload()
 }
+
+func TestEvent_DecodePayload(t *testing.T) {
+	body := json.RawMessage(`{"action":"opened","issue":{"number":1}}`)
+	e := &Event{Type: String(string(EventTypeIssues)), RawPayload: &body}
+
+	var got IssuesEvent
+	if err := e.DecodePayload(&got); err != nil {
+		t.Fatalf("DecodePayload returned error: %v", err)
+	}
+	want := IssuesEvent{Action: String("opened"), Issue: &Issue{Number: Int(1)}}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("DecodePayload returned %+v, want %+v", got, want)
+	}
+
+	for name, tt := range map[string]struct {
+		e *Event
+		v interface{}
+	}{
+		"mismatched type": {e, &PushEvent{}},
+		"non-pointer":     {e, IssuesEvent{}},
+		"nil":             {e, nil},
+		"no payload":      {&Event{Type: String(string(EventTypeIssues))}, &IssuesEvent{}},
+	} {
+		if err := tt.e.DecodePayload(tt.v); err == nil {
+			t.Errorf("DecodePayload with %v returned no error", name)
+		}
+	}
+}
+
+func TestEvent_ParsePayload_eventTypes(t *testing.T) {
+	body := json.RawMessage(`{}`)
+	for _, eventType := range eventTypeMapping {
+		e := &Event{Type: String(eventType), RawPayload: &body}
+		payload, err := e.ParsePayload()
+		if err != nil {
+			t.Fatalf("ParsePayload(%v) returned error: %v", eventType, err)
+		}
+		if got := reflect.TypeOf(payload).Elem().Name(); got != eventType {
+			t.Errorf("ParsePayload(%v) returned a %v", eventType, got)
+		}
+	}
+}
@@ -100,6 +100,40 @@ type CreateEvent struct {
 	Installation *Installation `json:"installation,omitempty"`
 }
 
+// CustomPropertyEvent is triggered when a custom property is created, updated
+// or deleted in an organization.
+// The Webhook event name is "custom_property".
+//
+// GitHub API docs: https://docs.github.com/en/webhooks/webhook-events-and-payloads#custom_property
+type CustomPropertyEvent struct {
+	// Action is the action that was performed. Possible values are: "created", "deleted", "updated".
+	Action     *string         `json:"action,omitempty"`
+	Definition *CustomProperty `json:"definition,omitempty"`
+
+	// The following fields are only populated by Webhook events.
+	Org          *Organization `json:"organization,omitempty"`
+	Installation *Installation `json:"installation,omitempty"`
+	Sender       *User         `json:"sender,omitempty"`
+}
+
+// CustomPropertyValuesEvent is triggered when the custom property values of a
+// repository are updated.
+// The Webhook event name is "custom_property_values".
+//
+// GitHub API docs: https://docs.github.com/en/webhooks/webhook-events-and-payloads#custom_property_values
+type CustomPropertyValuesEvent struct {
+	// Action is the action that was performed. Possible value is: "updated".
+	Action            *string                `json:"action,omitempty"`
+	NewPropertyValues []*CustomPropertyValue `json:"new_property_values,omitempty"`
+	OldPropertyValues []*CustomPropertyValue `json:"old_property_values,omitempty"`
+
+	// The following fields are only populated by Webhook events.
+	Repo         *Repository   `json:"repository,omitempty"`
+	Org          *Organization `json:"organization,omitempty"`
+	Installation *Installation `json:"installation,omitempty"`
+	Sender       *User         `json:"sender,omitempty"`
+}
+
 // DeleteEvent represents a deleted branch or tag.
 // The Webhook event name is "delete".
 //
@@ -322,6 +356,28 @@ type IssueCommentEvent struct {
 	Installation *Installation `json:"installation,omitempty"`
 }
 
+// IssueDependenciesEvent is triggered when an issue is marked as blocked by,
+// or as blocking, another issue, or when such a dependency is removed.
+// The Webhook event name is "issue_dependencies".
+//
+// GitHub API docs: https://docs.github.com/en/webhooks/webhook-events-and-payloads#issue_dependencies
+type IssueDependenciesEvent struct {
+	// Action is the action that was performed. Possible values are:
+	// "blocked_by_added", "blocked_by_removed", "blocking_added", "blocking_removed".
+	Action *string `json:"action,omitempty"`
+	// BlockedIssue is the issue that is blocked by BlockingIssue.
+	BlockedIssue      *Issue      `json:"blocked_issue,omitempty"`
+	BlockedIssueRepo  *Repository `json:"blocked_issue_repo,omitempty"`
+	BlockingIssue     *Issue      `json:"blocking_issue,omitempty"`
+	BlockingIssueRepo *Repository `json:"blocking_issue_repo,omitempty"`
+
+	// The following fields are only populated by Webhook events.
+	Repo         *Repository   `json:"repository,omitempty"`
+	Org          *Organization `json:"organization,omitempty"`
+	Installation *Installation `json:"installation,omitempty"`
+	Sender       *User         `json:"sender,omitempty"`
+}
+
 // IssuesEvent is triggered when an issue is opened, edited, deleted, transferred,
 // pinned, unpinned, closed, reopened, assigned, unassigned, labeled, unlabeled,
 // locked, unlocked, milestoned, or demilestoned.
@@ -416,6 +472,40 @@ type MembershipEvent struct {
 	Installation *Installation `json:"installation,omitempty"`
 }
 
+// MergeGroup represents a group of pull requests that are tested and merged
+// together by a merge queue.
+type MergeGroup struct {
+	// The SHA of the merge group.
+	HeadSHA *string `json:"head_sha,omitempty"`
+	// The full ref of the merge group.
+	HeadRef *string `json:"head_ref,omitempty"`
+	// The SHA of the merge group's parent commit.
+	BaseSHA *string `json:"base_sha,omitempty"`
+	// The full ref of the branch the merge group will be merged into.
+	BaseRef *string `json:"base_ref,omitempty"`
+	// An expanded representation of the HeadSHA commit.
+	HeadCommit *HeadCommit `json:"head_commit,omitempty"`
+}
+
+// MergeGroupEvent represents activity related to merge groups in a merge queue.
+// The Webhook event name is "merge_group".
+//
+// GitHub API docs: https://docs.github.com/en/webhooks/webhook-events-and-payloads#merge_group
+type MergeGroupEvent struct {
+	// Action is the action that was performed. Possible values are: "checks_requested", "destroyed".
+	Action *string `json:"action,omitempty"`
+	// Reason is populated when Action is "destroyed".
+	// Possible values are: "merged", "invalidated", "dequeued".
+	Reason     *string     `json:"reason,omitempty"`
+	MergeGroup *MergeGroup `json:"merge_group,omitempty"`
+
+	// The following fields are only populated by Webhook events.
+	Repo         *Repository   `json:"repository,omitempty"`
+	Org          *Organization `json:"organization,omitempty"`
+	Installation *Installation `json:"installation,omitempty"`
+	Sender       *User         `json:"sender,omitempty"`
+}
+
 // MetaEvent is triggered when the webhook that this event is configured on is deleted.
 // This event will only listen for changes to the particular hook the event is installed on.
 // Therefore, it must be selected for each hook that you'd like to receive meta events for.
@@ -589,6 +679,55 @@ type ProjectColumnEvent struct {
 	Installation *Installation `json:"installation,omitempty"`
 }
 
+// ProjectV2StatusUpdate represents a status update of a project.
+type ProjectV2StatusUpdate struct {
+	ID            *int64     `json:"id,omitempty"`
+	NodeID        *string    `json:"node_id,omitempty"`
+	ProjectNodeID *string    `json:"project_node_id,omitempty"`
+	Creator       *User      `json:"creator,omitempty"`
+	CreatedAt     *Timestamp `json:"created_at,omitempty"`
+	UpdatedAt     *Timestamp `json:"updated_at,omitempty"`
+	// Status is one of "INACTIVE", "ON_TRACK", "AT_RISK", "OFF_TRACK" or "COMPLETE".
+	Status *string `json:"status,omitempty"`
+	// StartDate and TargetDate are dates formatted as "2006-01-02".
+	StartDate  *string `json:"start_date,omitempty"`
+	TargetDate *string `json:"target_date,omitempty"`
+	Body       *string `json:"body,omitempty"`
+}
+
+// ProjectV2StatusUpdateChange represents the changes when a project status
+// update has been edited.
+type ProjectV2StatusUpdateChange struct {
+	Body       *ProjectV2StatusUpdateFieldChange `json:"body,omitempty"`
+	Status     *ProjectV2StatusUpdateFieldChange `json:"status,omitempty"`
+	StartDate  *ProjectV2StatusUpdateFieldChange `json:"start_date,omitempty"`
+	TargetDate *ProjectV2StatusUpdateFieldChange `json:"target_date,omitempty"`
+}
+
+// ProjectV2StatusUpdateFieldChange represents the previous and the new value
+// of an edited field of a ProjectV2StatusUpdate.
+type ProjectV2StatusUpdateFieldChange struct {
+	From *string `json:"from,omitempty"`
+	To   *string `json:"to,omitempty"`
+}
+
+// ProjectV2StatusUpdateEvent is triggered when a status update is created,
+// edited or deleted in an organization project.
+// The Webhook event name is "projects_v2_status_update".
+//
+// GitHub API docs: https://docs.github.com/en/webhooks/webhook-events-and-payloads#projects_v2_status_update
+type ProjectV2StatusUpdateEvent struct {
+	// Action is the action that was performed. Possible values are: "created", "deleted", "edited".
+	Action                *string                      `json:"action,omitempty"`
+	ProjectV2StatusUpdate *ProjectV2StatusUpdate       `json:"projects_v2_status_update,omitempty"`
+	Changes               *ProjectV2StatusUpdateChange `json:"changes,omitempty"`
+
+	// The following fields are only populated by Webhook events.
+	Org          *Organization `json:"organization,omitempty"`
+	Installation *Installation `json:"installation,omitempty"`
+	Sender       *User         `json:"sender,omitempty"`
+}
+
 // PublicEvent is triggered when a private repository is open sourced.
 // According to GitHub: "Without a doubt: the best GitHub event."
 // The Webhook event name is "public".
@@ -862,6 +1001,25 @@ type RepositoryVulnerabilityAlertEvent struct {
 	Repository *Repository `json:"repository,omitempty"`
 }
 
+// SecretScanningAlertLocationEvent is triggered when a new instance of a
+// previously detected secret is found, which adds a location to an existing
+// secret scanning alert.
+// The Webhook event name is "secret_scanning_alert_location".
+//
+// GitHub API docs: https://docs.github.com/en/webhooks/webhook-events-and-payloads#secret_scanning_alert_location
+type SecretScanningAlertLocationEvent struct {
+	// Action is the action that was performed. Possible value is: "created".
+	Action   *string                      `json:"action,omitempty"`
+	Alert    *SecretScanningAlert         `json:"alert,omitempty"`
+	Location *SecretScanningAlertLocation `json:"location,omitempty"`
+
+	// The following fields are only populated by Webhook events.
+	Repo         *Repository   `json:"repository,omitempty"`
+	Org          *Organization `json:"organization,omitempty"`
+	Installation *Installation `json:"installation,omitempty"`
+	Sender       *User         `json:"sender,omitempty"`
+}
+
 // StarEvent is triggered when a star is added or removed from a repository.
 // The Webhook event name is "star".
 //
@@ -901,6 +1059,29 @@ type StatusEvent struct {
 	Installation *Installation     `json:"installation,omitempty"`
 }
 
+// SubIssuesEvent is triggered when a sub-issue is added to, or removed from,
+// an issue.
+// The Webhook event name is "sub_issues".
+//
+// GitHub API docs: https://docs.github.com/en/webhooks/webhook-events-and-payloads#sub_issues
+type SubIssuesEvent struct {
+	// Action is the action that was performed. Possible values are:
+	// "sub_issue_added", "sub_issue_removed", "parent_issue_added", "parent_issue_removed".
+	Action          *string     `json:"action,omitempty"`
+	ParentIssueID   *int64      `json:"parent_issue_id,omitempty"`
+	ParentIssue     *Issue      `json:"parent_issue,omitempty"`
+	ParentIssueRepo *Repository `json:"parent_issue_repo,omitempty"`
+	SubIssueID      *int64      `json:"sub_issue_id,omitempty"`
+	SubIssue        *Issue      `json:"sub_issue,omitempty"`
+	SubIssueRepo    *Repository `json:"sub_issue_repo,omitempty"`
+
+	// The following fields are only populated by Webhook events.
+	Repo         *Repository   `json:"repository,omitempty"`
+	Org          *Organization `json:"organization,omitempty"`
+	Installation *Installation `json:"installation,omitempty"`
+	Sender       *User         `json:"sender,omitempty"`
+}
+
 // TeamEvent is triggered when an organization's team is created, modified or deleted.
 // The Webhook event name is "team".
 //
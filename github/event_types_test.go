@@ -103,3 +103,114 @@ func TestEditChange_Marshal_BaseChange(t *testing.T) {
 
 	testJSONMarshal(t, u, want)
 }
+
+func TestMergeGroupEvent_Marshal(t *testing.T) {
+	testJSONMarshal(t, &MergeGroupEvent{}, "{}")
+
+	u := &MergeGroupEvent{
+		Action: String("destroyed"),
+		Reason: String("merged"),
+		MergeGroup: &MergeGroup{
+			HeadSHA:    String("hs"),
+			HeadRef:    String("refs/heads/gh-readonly-queue/main/pr-1-bs"),
+			BaseSHA:    String("bs"),
+			BaseRef:    String("refs/heads/main"),
+			HeadCommit: &HeadCommit{ID: String("hs"), Message: String("m")},
+		},
+		Repo:   &Repository{ID: Int64(1)},
+		Org:    &Organization{Login: String("o")},
+		Sender: &User{Login: String("l")},
+	}
+
+	want := `{
+		"action": "destroyed",
+		"reason": "merged",
+		"merge_group": {
+			"head_sha": "hs",
+			"head_ref": "refs/heads/gh-readonly-queue/main/pr-1-bs",
+			"base_sha": "bs",
+			"base_ref": "refs/heads/main",
+			"head_commit": {
+				"id": "hs",
+				"message": "m"
+			}
+		},
+		"repository": {"id": 1},
+		"organization": {"login": "o"},
+		"sender": {"login": "l"}
+	}`
+
+	testJSONMarshal(t, u, want)
+}
+
+func TestProjectV2StatusUpdateEvent_Marshal(t *testing.T) {
+	testJSONMarshal(t, &ProjectV2StatusUpdateEvent{}, "{}")
+
+	u := &ProjectV2StatusUpdateEvent{
+		Action: String("edited"),
+		ProjectV2StatusUpdate: &ProjectV2StatusUpdate{
+			ID:            Int64(1),
+			ProjectNodeID: String("p"),
+			Creator:       &User{Login: String("l")},
+			CreatedAt:     &Timestamp{referenceTime},
+			Status:        String("AT_RISK"),
+			TargetDate:    String("2021-06-30"),
+			Body:          String("b"),
+		},
+		Changes: &ProjectV2StatusUpdateChange{
+			Status: &ProjectV2StatusUpdateFieldChange{From: String("ON_TRACK"), To: String("AT_RISK")},
+		},
+		Org:    &Organization{Login: String("o")},
+		Sender: &User{Login: String("l")},
+	}
+
+	want := `{
+		"action": "edited",
+		"projects_v2_status_update": {
+			"id": 1,
+			"project_node_id": "p",
+			"creator": {"login": "l"},
+			"created_at": ` + referenceTimeStr + `,
+			"status": "AT_RISK",
+			"target_date": "2021-06-30",
+			"body": "b"
+		},
+		"changes": {
+			"status": {"from": "ON_TRACK", "to": "AT_RISK"}
+		},
+		"organization": {"login": "o"},
+		"sender": {"login": "l"}
+	}`
+
+	testJSONMarshal(t, u, want)
+}
+
+func TestSubIssuesEvent_Marshal(t *testing.T) {
+	testJSONMarshal(t, &SubIssuesEvent{}, "{}")
+
+	u := &SubIssuesEvent{
+		Action:          String("sub_issue_added"),
+		ParentIssueID:   Int64(1),
+		ParentIssue:     &Issue{ID: Int64(1), Number: Int(10)},
+		ParentIssueRepo: &Repository{ID: Int64(3)},
+		SubIssueID:      Int64(2),
+		SubIssue:        &Issue{ID: Int64(2), Number: Int(20)},
+		SubIssueRepo:    &Repository{ID: Int64(3)},
+		Repo:            &Repository{ID: Int64(3)},
+		Sender:          &User{Login: String("l")},
+	}
+
+	want := `{
+		"action": "sub_issue_added",
+		"parent_issue_id": 1,
+		"parent_issue": {"id": 1, "number": 10},
+		"parent_issue_repo": {"id": 3},
+		"sub_issue_id": 2,
+		"sub_issue": {"id": 2, "number": 20},
+		"sub_issue_repo": {"id": 3},
+		"repository": {"id": 3},
+		"sender": {"login": "l"}
+	}`
+
+	testJSONMarshal(t, u, want)
+}
@@ -151,7 +151,8 @@ func (t *templateData) processAST(f *ast.File) error {
 }
 
 func sourceFilter(fi os.FileInfo) bool {
-	return !strings.HasSuffix(fi.Name(), "_test.go") && !strings.HasSuffix(fi.Name(), fileSuffix)
+	// The value-typed mirrors generated by gen-values have no accessors.
+	return !strings.HasSuffix(fi.Name(), "_test.go") && !strings.HasSuffix(fi.Name(), fileSuffix) && !strings.HasSuffix(fi.Name(), "-values.go")
 }
 
 func (t *templateData) dump() error {
@@ -3,6 +3,7 @@
 // Use of this source code is governed by a BSD-style
 // license that can be found in the LICENSE file.
 
+//go:build ignore
 // +build ignore
 
 // gen-stringify-test generates test methods to test the String methods.
@@ -53,29 +54,33 @@ var (
 			}
 			return ""
 		},
-		"processZeroValue": func(v string) string {
-			switch v {
-			case "Bool(false)":
-				return "false"
-			case "Float64(0.0)":
-				return "0"
-			case "0", "Int(0)", "Int64(0)":
-				return "0"
-			case `""`, `String("")`:
-				return `""`
-			case "Timestamp{}", "&Timestamp{}":
-				return "github.Timestamp{0001-01-01 00:00:00 +0000 UTC}"
-			case "nil":
-				return "map[]"
-			}
-			log.Fatalf("Unhandled zero value: %q", v)
-			return ""
-		},
+		"processZeroValue": processZeroValue,
 	}
 
 	sourceTmpl = template.Must(template.New("source").Funcs(funcMap).Parse(source))
 )
 
+// processZeroValue returns the string of the zero value v of a field, as
+// printed by Stringify.
+func processZeroValue(v string) string {
+	switch v {
+	case "false", "Bool(false)":
+		return "false"
+	case "0.0", "Float64(0.0)":
+		return "0"
+	case "0", "Int(0)", "Int64(0)":
+		return "0"
+	case `""`, `String("")`:
+		return `""`
+	case "Timestamp{}", "&Timestamp{}":
+		return "github.Timestamp{0001-01-01 00:00:00 +0000 UTC}"
+	case "nil":
+		return "map[]"
+	}
+	log.Fatalf("Unhandled zero value: %q", v)
+	return ""
+}
+
 func main() {
 	flag.Parse()
 	fset := token.NewFileSet()
@@ -131,6 +136,11 @@ type structField struct {
 	FieldType    string
 	ZeroValue    string
 	NamedStruct  bool // Getter for named struct.
+	// NamedStructValue is the string of the zero value of the named struct,
+	// with the fields which Stringify prints even when they are not set.
+	NamedStructValue string
+
+	isPtr bool // Whether the field is a pointer, which Stringify skips if nil.
 }
 
 func (t *templateData) processAST(f *ast.File) error {
@@ -225,7 +235,7 @@ func (t *templateData) processAST(f *ast.File) error {
 }
 
 func (t *templateData) addMapType(receiverType, fieldName string) {
-	t.StructFields[receiverType] = append(t.StructFields[receiverType], newStructField(receiverType, fieldName, "map[]", "nil", false))
+	t.StructFields[receiverType] = append(t.StructFields[receiverType], newStructField(receiverType, fieldName, "map[]", "nil", false, false))
 }
 
 func (t *templateData) addIdent(x *ast.Ident, receiverType, fieldName string) {
@@ -249,7 +259,7 @@ func (t *templateData) addIdent(x *ast.Ident, receiverType, fieldName string) {
 		namedStruct = true
 	}
 
-	t.StructFields[receiverType] = append(t.StructFields[receiverType], newStructField(receiverType, fieldName, x.String(), zeroValue, namedStruct))
+	t.StructFields[receiverType] = append(t.StructFields[receiverType], newStructField(receiverType, fieldName, x.String(), zeroValue, namedStruct, false))
 }
 
 func (t *templateData) addIdentPtr(x *ast.Ident, receiverType, fieldName string) {
@@ -273,7 +283,25 @@ func (t *templateData) addIdentPtr(x *ast.Ident, receiverType, fieldName string)
 		namedStruct = true
 	}
 
-	t.StructFields[receiverType] = append(t.StructFields[receiverType], newStructField(receiverType, fieldName, x.String(), zeroValue, namedStruct))
+	t.StructFields[receiverType] = append(t.StructFields[receiverType], newStructField(receiverType, fieldName, x.String(), zeroValue, namedStruct, true))
+}
+
+// zeroStructValue returns the string of the zero value of the struct type
+// typeName as printed by Stringify, which prints the fields which are not
+// pointers, such as maps, even when they are not set.
+func (t *templateData) zeroStructValue(typeName string) string {
+	var fields []string
+	for _, f := range t.StructFields[typeName] {
+		if f.isPtr {
+			continue
+		}
+		if f.NamedStruct {
+			fields = append(fields, fmt.Sprintf("%v:%v.%v%v", f.FieldName, t.Package, f.FieldType, t.zeroStructValue(f.FieldType)))
+			continue
+		}
+		fields = append(fields, f.FieldName+":"+processZeroValue(f.ZeroValue))
+	}
+	return "{" + strings.Join(fields, ", ") + "}"
 }
 
 func (t *templateData) dump() error {
@@ -290,6 +318,13 @@ func (t *templateData) dump() error {
 			continue
 		}
 	}
+	for _, fields := range t.StructFields {
+		for _, f := range fields {
+			if f.NamedStruct {
+				f.NamedStructValue = t.zeroStructValue(f.FieldType)
+			}
+		}
+	}
 	for _, k := range toDelete {
 		delete(t.StructFields, k)
 	}
@@ -308,7 +343,7 @@ func (t *templateData) dump() error {
 	return ioutil.WriteFile(t.filename, clean, 0644)
 }
 
-func newStructField(receiverType, fieldName, fieldType, zeroValue string, namedStruct bool) *structField {
+func newStructField(receiverType, fieldName, fieldType, zeroValue string, namedStruct, isPtr bool) *structField {
 	return &structField{
 		sortVal:      strings.ToLower(receiverType) + "." + strings.ToLower(fieldName),
 		ReceiverVar:  strings.ToLower(receiverType[:1]),
@@ -317,6 +352,7 @@ func newStructField(receiverType, fieldName, fieldType, zeroValue string, namedS
 		FieldType:    fieldType,
 		ZeroValue:    zeroValue,
 		NamedStruct:  namedStruct,
+		isPtr:        isPtr,
 	}
 }
 
@@ -349,7 +385,7 @@ func Test{{ $key }}_String(t *testing.T) {
     {{ .FieldName }}: {{.ZeroValue}},{{end}}{{end}}
   }
  	want := ` + "`" + `{{ $package }}.{{ $key }}{{ $slice := . }}{
-{{- range $ind, $val := .}}{{if .NamedStruct}}{{ .FieldName }}:{{ $package }}.{{ .FieldType }}{}{{else}}{{ .FieldName }}:{{ processZeroValue .ZeroValue }}{{end}}{{ isNotLast $ind $slice }}{{end}}}` + "`" + `
+{{- range $ind, $val := .}}{{if .NamedStruct}}{{ .FieldName }}:{{ $package }}.{{ .FieldType }}{{ .NamedStructValue }}{{else}}{{ .FieldName }}:{{ processZeroValue .ZeroValue }}{{end}}{{ isNotLast $ind $slice }}{{end}}}` + "`" + `
 	if got := v.String(); got != want {
 		t.Errorf("{{ $key }}.String = %v, want %v", got, want)
 	}
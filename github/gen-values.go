@@ -0,0 +1,421 @@
+// Copyright 2021 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// +build ignore
+
+// gen-values generates value-typed mirrors of the structs listed in
+// valueTypes, such as IssueValue for Issue, along with the ToValue methods
+// which convert the structs to their mirrors.
+//
+// It is meant to be used by go-github contributors in conjunction with the
+// go generate tool before sending a PR to GitHub.
+// Please see the CONTRIBUTING.md file for more information.
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"io/ioutil"
+	"log"
+	"os"
+	"sort"
+	"strings"
+	"text/template"
+)
+
+const (
+	fileSuffix = "-values.go"
+)
+
+var (
+	verbose = flag.Bool("v", false, "Print verbose log messages")
+
+	sourceTmpl = template.Must(template.New("source").Parse(source))
+
+	// valueTypes lists the structs to mirror. Their pointers to each other
+	// are mirrored as values, except for the pointers of a struct to
+	// itself.
+	valueTypes = []string{
+		"Issue",
+		"IssueComment",
+		"Label",
+		"License",
+		"Milestone",
+		"Organization",
+		"Plan",
+		"PullRequest",
+		"PullRequestBranch",
+		"Reactions",
+		"Repository",
+		"Team",
+		"User",
+	}
+)
+
+func logf(fmt string, args ...interface{}) {
+	if *verbose {
+		log.Printf(fmt, args...)
+	}
+}
+
+func main() {
+	flag.Parse()
+	fset := token.NewFileSet()
+
+	pkgs, err := parser.ParseDir(fset, ".", sourceFilter, 0)
+	if err != nil {
+		log.Fatal(err)
+		return
+	}
+
+	for pkgName, pkg := range pkgs {
+		t := &templateData{
+			filename: pkgName + fileSuffix,
+			Year:     2021,
+			Package:  pkgName,
+			Imports:  map[string]string{},
+			fset:     fset,
+			structs:  map[string]*ast.StructType{},
+			types:    map[string]bool{},
+			mirrored: map[string]bool{},
+		}
+		for _, name := range valueTypes {
+			t.mirrored[name] = true
+		}
+		for filename, f := range pkg.Files {
+			logf("Processing %v...", filename)
+			t.collectTypes(f)
+		}
+		for _, name := range valueTypes {
+			if err := t.addType(name); err != nil {
+				log.Fatal(err)
+			}
+		}
+		if err := t.dump(); err != nil {
+			log.Fatal(err)
+		}
+	}
+	logf("Done.")
+}
+
+func sourceFilter(fi os.FileInfo) bool {
+	return !strings.HasSuffix(fi.Name(), "_test.go") && !strings.HasSuffix(fi.Name(), fileSuffix) && !strings.HasPrefix(fi.Name(), "gen-")
+}
+
+// collectTypes records the types declared in f.
+func (t *templateData) collectTypes(f *ast.File) {
+	for _, decl := range f.Decls {
+		gd, ok := decl.(*ast.GenDecl)
+		if !ok {
+			continue
+		}
+		for _, spec := range gd.Specs {
+			ts, ok := spec.(*ast.TypeSpec)
+			if !ok {
+				continue
+			}
+			t.types[ts.Name.Name] = true
+			if st, ok := ts.Type.(*ast.StructType); ok {
+				t.structs[ts.Name.Name] = st
+			}
+		}
+	}
+}
+
+func (t *templateData) addType(name string) error {
+	st, ok := t.structs[name]
+	if !ok {
+		return fmt.Errorf("struct %v not found", name)
+	}
+	if t.types[name+"Value"] {
+		return fmt.Errorf("type %vValue already exists", name)
+	}
+
+	vt := &valueType{
+		TypeName:    name,
+		ReceiverVar: strings.ToLower(name[:1]),
+	}
+	for _, field := range st.Fields.List {
+		if len(field.Names) == 0 {
+			logf("Embedded field %v.%v; skipping.", name, t.expr(field.Type))
+			continue
+		}
+		for _, fieldName := range field.Names {
+			// Skip unexported identifiers.
+			if !fieldName.IsExported() {
+				logf("Field %v.%v is unexported; skipping.", name, fieldName)
+				continue
+			}
+			f, err := t.newField(name, fieldName.Name, field)
+			if err != nil {
+				return err
+			}
+			vt.Fields = append(vt.Fields, f)
+		}
+	}
+	vt.Doc = pointerDoc(vt)
+	t.ValueTypes = append(t.ValueTypes, vt)
+	return nil
+}
+
+// pointerDoc returns the lines of the doc comment of the mirror of vt which
+// document its fields kept as pointers, if any.
+func pointerDoc(vt *valueType) []string {
+	var kept, self []string
+	for _, f := range vt.Fields {
+		switch {
+		case f.Kind == kindValuePtr:
+			self = append(self, f.Name)
+		case strings.HasPrefix(f.Type, "*"):
+			kept = append(kept, f.Name)
+		}
+	}
+
+	var doc string
+	if len(kept) > 0 {
+		doc += fmt.Sprintf("%v %v shared with %v.ToValue's receiver, as %v no mirror. ",
+			list(kept), plural(kept, "is a pointer", "are pointers"), vt.TypeName, plural(kept, "its type has", "their types have"))
+	}
+	if len(self) > 0 {
+		doc += fmt.Sprintf("%v %v to a %vValue, to end the recursion. ",
+			list(self), plural(self, "is a pointer", "are pointers"), vt.TypeName)
+	}
+	if doc == "" {
+		return nil
+	}
+	return wrap(doc+plural(append(kept, self...), "It is", "They are")+" nil if unset.", 76)
+}
+
+// list returns the names joined as in "A, B and C".
+func list(names []string) string {
+	if len(names) == 1 {
+		return names[0]
+	}
+	return strings.Join(names[:len(names)-1], ", ") + " and " + names[len(names)-1]
+}
+
+func plural(names []string, one, many string) string {
+	if len(names) == 1 {
+		return one
+	}
+	return many
+}
+
+// wrap splits text into lines of at most width characters, if its words
+// allow.
+func wrap(text string, width int) []string {
+	var lines []string
+	var line string
+	for _, word := range strings.Fields(text) {
+		if line != "" && len(line)+1+len(word) > width {
+			lines = append(lines, line)
+			line = ""
+		}
+		if line != "" {
+			line += " "
+		}
+		line += word
+	}
+	return append(lines, line)
+}
+
+// newField returns the mirror of the field fieldName of the struct typeName.
+func (t *templateData) newField(typeName, fieldName string, field *ast.Field) (*valueField, error) {
+	f := &valueField{Name: fieldName, Kind: kindCopy}
+	if field.Tag != nil {
+		f.Tag = field.Tag.Value
+	}
+
+	switch x := field.Type.(type) {
+	case *ast.StarExpr:
+		ident, isIdent := x.X.(*ast.Ident)
+		switch {
+		case isIdent && t.mirrored[ident.Name] && ident.Name == typeName:
+			f.Type, f.Kind = "*"+ident.Name+"Value", kindValuePtr
+		case isIdent && t.mirrored[ident.Name]:
+			f.Type, f.Kind = ident.Name+"Value", kindValue
+		case isIdent && t.structs[ident.Name] != nil && ident.Name != "Timestamp":
+			// Pointers to the structs without mirror are kept.
+			f.Type = "*" + ident.Name
+		default:
+			f.Type, f.Kind = t.expr(x.X), kindDeref
+		}
+	case *ast.ArrayType:
+		if se, ok := x.Elt.(*ast.StarExpr); ok && x.Len == nil {
+			if ident, ok := se.X.(*ast.Ident); ok && t.mirrored[ident.Name] {
+				f.Type, f.Kind, f.ElemType = "[]"+ident.Name+"Value", kindSlice, ident.Name+"Value"
+			}
+		}
+	}
+	if f.Type == "" {
+		f.Type = t.expr(field.Type)
+	}
+	if f.Kind == kindValue || t.structs[f.Type] != nil || f.Type == "time.Time" {
+		// omitempty has no effect on the struct values.
+		f.Tag = strings.Replace(f.Tag, ",omitempty", "", 1)
+	}
+	if err := t.addImports(field.Type); err != nil {
+		return nil, fmt.Errorf("%v.%v: %v", typeName, fieldName, err)
+	}
+	return f, nil
+}
+
+// addImports adds the imports of the packages used by x.
+func (t *templateData) addImports(x ast.Expr) error {
+	var err error
+	ast.Inspect(x, func(n ast.Node) bool {
+		se, ok := n.(*ast.SelectorExpr)
+		if !ok {
+			return true
+		}
+		switch pkg := t.expr(se.X); pkg {
+		case "json":
+			t.Imports["encoding/json"] = "encoding/json"
+		case "time":
+			t.Imports["time"] = "time"
+		default:
+			err = fmt.Errorf("unknown package %q", pkg)
+		}
+		return false
+	})
+	return err
+}
+
+func (t *templateData) expr(x ast.Expr) string {
+	var buf bytes.Buffer
+	if err := format.Node(&buf, t.fset, x); err != nil {
+		log.Fatal(err)
+	}
+	return buf.String()
+}
+
+func (t *templateData) dump() error {
+	if len(t.ValueTypes) == 0 {
+		logf("No value types for %v; skipping.", t.filename)
+		return nil
+	}
+	sort.Slice(t.ValueTypes, func(i, j int) bool { return t.ValueTypes[i].TypeName < t.ValueTypes[j].TypeName })
+
+	var buf bytes.Buffer
+	if err := sourceTmpl.Execute(&buf, t); err != nil {
+		return err
+	}
+	clean, err := format.Source(buf.Bytes())
+	if err != nil {
+		return fmt.Errorf("format.Source:\n%v\n%v", buf.String(), err)
+	}
+
+	logf("Writing %v...", t.filename)
+	return ioutil.WriteFile(t.filename, clean, 0644)
+}
+
+type templateData struct {
+	filename   string
+	Year       int
+	Package    string
+	Imports    map[string]string
+	ValueTypes []*valueType
+
+	fset     *token.FileSet
+	structs  map[string]*ast.StructType // Structs of the package, by name.
+	types    map[string]bool            // Types of the package, by name.
+	mirrored map[string]bool            // Structs listed in valueTypes.
+}
+
+type valueType struct {
+	TypeName    string
+	ReceiverVar string // The one-letter variable name to match the TypeName.
+	Fields      []*valueField
+	Doc         []string // Lines documenting the fields kept as pointers.
+}
+
+// The kinds of conversion of the fields.
+const (
+	kindCopy     = "copy"     // Copied as is.
+	kindDeref    = "deref"    // Dereferenced, if not nil.
+	kindValue    = "value"    // Converted with ToValue.
+	kindValuePtr = "valuePtr" // Converted with ToValue, if not nil, and kept as a pointer.
+	kindSlice    = "slice"    // Converted element by element with ToValue.
+)
+
+type valueField struct {
+	Name     string
+	Type     string
+	ElemType string // Element type of the kindSlice fields.
+	Tag      string
+	Kind     string
+}
+
+const source = `// Copyright {{.Year}} The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Code generated by gen-values; DO NOT EDIT.
+
+package {{.Package}}
+{{with .Imports}}
+import (
+  {{- range . -}}
+  "{{.}}"
+  {{end -}}
+)
+{{end}}
+{{range .ValueTypes}}
+// {{.TypeName}}Value is a value-typed mirror of {{.TypeName}}, as returned by
+// {{.TypeName}}.ToValue, whose nil pointers are zero values.
+{{- with .Doc}}
+//
+{{- range .}}
+// {{.}}
+{{- end}}
+{{- end}}
+type {{.TypeName}}Value struct {
+  {{- range .Fields}}
+  {{.Name}} {{.Type}} {{.Tag}}
+  {{- end}}
+}
+
+// ToValue returns a copy of {{.ReceiverVar}} with values instead of pointers, or the zero
+// {{.TypeName}}Value if {{.ReceiverVar}} is nil. The copy is shallow: the maps, and the
+// slices and pointers of the types without mirror, are shared with {{.ReceiverVar}}.
+func ({{.ReceiverVar}} *{{.TypeName}}) ToValue() {{.TypeName}}Value {
+  var value {{.TypeName}}Value
+  if {{.ReceiverVar}} == nil {
+    return value
+  }
+  {{- $recv := .ReceiverVar}}
+  {{- range .Fields}}
+  {{- if eq .Kind "copy"}}
+  value.{{.Name}} = {{$recv}}.{{.Name}}
+  {{- else if eq .Kind "deref"}}
+  if {{$recv}}.{{.Name}} != nil {
+    value.{{.Name}} = *{{$recv}}.{{.Name}}
+  }
+  {{- else if eq .Kind "value"}}
+  value.{{.Name}} = {{$recv}}.{{.Name}}.ToValue()
+  {{- else if eq .Kind "valuePtr"}}
+  if {{$recv}}.{{.Name}} != nil {
+    v := {{$recv}}.{{.Name}}.ToValue()
+    value.{{.Name}} = &v
+  }
+  {{- else if eq .Kind "slice"}}
+  if {{$recv}}.{{.Name}} != nil {
+    value.{{.Name}} = make([]{{.ElemType}}, len({{$recv}}.{{.Name}}))
+    for n, e := range {{$recv}}.{{.Name}} {
+      value.{{.Name}}[n] = e.ToValue()
+    }
+  }
+  {{- end}}
+  {{- end}}
+  return value
+}
+{{end}}
+`
@@ -0,0 +1,319 @@
+// Copyright 2021 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// +build ignore
+
+// gen-webhook-events compares the webhook events supported by ParseWebHook
+// with the webhook events of the official GitHub REST API description.
+//
+// It reports the events that are missing from eventTypeMapping in
+// messages.go, along with a suggested mapping for each of them, the events
+// of eventTypeMapping that are no longer described, and the events mapped to
+// types which are not declared in the package. It exits with a non-zero
+// status if any event is missing or mapped to an undeclared type.
+//
+// It is meant to be used by go-github contributors before sending a PR to
+// GitHub, through go generate or directly:
+//
+//	go run gen-webhook-events.go
+//	go run gen-webhook-events.go -schema path/to/api.github.com.json
+//
+// Please see the CONTRIBUTING.md file for more information.
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"io"
+	"io/ioutil"
+	"log"
+	"net/http"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+const (
+	defaultSchema = "https://raw.githubusercontent.com/github/rest-api-description/main/descriptions/api.github.com/api.github.com.json"
+	messagesFile  = "messages.go"
+	mappingName   = "eventTypeMapping"
+)
+
+var (
+	schema  = flag.String("schema", defaultSchema, "URL or path of the GitHub REST API description")
+	verbose = flag.Bool("v", false, "Print verbose log messages")
+)
+
+func main() {
+	flag.Parse()
+
+	mapping, err := parseMapping(messagesFile)
+	if err != nil {
+		log.Fatal(err)
+	}
+	logf("Found %v events in %v.", len(mapping), mappingName)
+
+	declared, err := eventTypes(".")
+	if err != nil {
+		log.Fatal(err)
+	}
+	logf("Found %v event types in the package.", len(declared))
+
+	events, err := schemaEvents(*schema)
+	if err != nil {
+		log.Fatal(err)
+	}
+	logf("Found %v events in %v.", len(events), *schema)
+
+	var missing, unknown, undeclared []string
+	for event, name := range mapping {
+		if !declared[name] {
+			undeclared = append(undeclared, event)
+		}
+	}
+	for event := range events {
+		if _, ok := mapping[event]; !ok {
+			missing = append(missing, event)
+		}
+	}
+	for event := range mapping {
+		if !events[event] {
+			unknown = append(unknown, event)
+		}
+	}
+	sort.Strings(missing)
+	sort.Strings(unknown)
+	sort.Strings(undeclared)
+
+	if len(missing) > 0 {
+		fmt.Printf("Events missing from %v:\n", mappingName)
+		for _, event := range missing {
+			fmt.Printf("\t%q: %q,\n", event, typeName(event, declared))
+		}
+	}
+	if len(unknown) > 0 {
+		fmt.Printf("Events of %v that are not in the schema:\n", mappingName)
+		for _, event := range unknown {
+			fmt.Printf("\t%v\n", event)
+		}
+	}
+	if len(undeclared) > 0 {
+		fmt.Printf("Events of %v mapped to undeclared types:\n", mappingName)
+		for _, event := range undeclared {
+			fmt.Printf("\t%q: %q,\n", event, mapping[event])
+		}
+	}
+	if len(missing) > 0 || len(undeclared) > 0 {
+		os.Exit(1)
+	}
+	logf("Done.")
+}
+
+// parseMapping returns the eventTypeMapping map defined in filename.
+func parseMapping(filename string) (map[string]string, error) {
+	fset := token.NewFileSet()
+	f, err := parser.ParseFile(fset, filename, nil, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, decl := range f.Decls {
+		gd, ok := decl.(*ast.GenDecl)
+		if !ok || gd.Tok != token.VAR {
+			continue
+		}
+		for _, spec := range gd.Specs {
+			vs, ok := spec.(*ast.ValueSpec)
+			if !ok {
+				continue
+			}
+			for i, name := range vs.Names {
+				if name.Name != mappingName || i >= len(vs.Values) {
+					continue
+				}
+				lit, ok := vs.Values[i].(*ast.CompositeLit)
+				if !ok {
+					return nil, fmt.Errorf("%v is not a composite literal", mappingName)
+				}
+				return mappingEntries(lit)
+			}
+		}
+	}
+	return nil, fmt.Errorf("%v not found in %v", mappingName, filename)
+}
+
+func mappingEntries(lit *ast.CompositeLit) (map[string]string, error) {
+	mapping := map[string]string{}
+	for _, elt := range lit.Elts {
+		kv, ok := elt.(*ast.KeyValueExpr)
+		if !ok {
+			return nil, fmt.Errorf("unexpected element %T in %v", elt, mappingName)
+		}
+		key, err := unquote(kv.Key)
+		if err != nil {
+			return nil, err
+		}
+		value, err := unquote(kv.Value)
+		if err != nil {
+			return nil, err
+		}
+		mapping[key] = value
+	}
+	return mapping, nil
+}
+
+func unquote(expr ast.Expr) (string, error) {
+	lit, ok := expr.(*ast.BasicLit)
+	if !ok || lit.Kind != token.STRING {
+		return "", fmt.Errorf("unexpected expression %T in %v", expr, mappingName)
+	}
+	return strconv.Unquote(lit.Value)
+}
+
+// schemaEvents returns the names of the webhook events described by the
+// GitHub REST API description at location, a URL or a file path. Each
+// webhook of the description is an action of an event, whose name is the
+// subcategory of the webhook.
+func schemaEvents(location string) (map[string]bool, error) {
+	var r io.Reader
+	if strings.HasPrefix(location, "https://") || strings.HasPrefix(location, "http://") {
+		resp, err := http.Get(location)
+		if err != nil {
+			return nil, err
+		}
+		defer resp.Body.Close()
+		if resp.StatusCode != http.StatusOK {
+			return nil, fmt.Errorf("GET %v: %v", location, resp.Status)
+		}
+		r = resp.Body
+	} else {
+		f, err := os.Open(location)
+		if err != nil {
+			return nil, err
+		}
+		defer f.Close()
+		r = f
+	}
+
+	body, err := ioutil.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+	var description struct {
+		Webhooks map[string]struct {
+			Post struct {
+				XGitHub struct {
+					Subcategory string `json:"subcategory"`
+				} `json:"x-github"`
+			} `json:"post"`
+		} `json:"webhooks"`
+	}
+	if err := json.Unmarshal(body, &description); err != nil {
+		return nil, err
+	}
+
+	events := map[string]bool{}
+	for name, webhook := range description.Webhooks {
+		event := webhook.Post.XGitHub.Subcategory
+		if event == "" {
+			logf("Webhook %v has no subcategory; skipping.", name)
+			continue
+		}
+		events[event] = true
+	}
+	if len(events) == 0 {
+		return nil, fmt.Errorf("no webhooks found in %v", location)
+	}
+	return events, nil
+}
+
+// eventTypes returns the names of the types ending in "Event" declared in
+// the Go files of the package in dir.
+func eventTypes(dir string) (map[string]bool, error) {
+	fset := token.NewFileSet()
+	pkgs, err := parser.ParseDir(fset, dir, func(fi os.FileInfo) bool {
+		return !strings.HasSuffix(fi.Name(), "_test.go")
+	}, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	types := map[string]bool{}
+	for name, pkg := range pkgs {
+		if name == "main" {
+			// The generators.
+			continue
+		}
+		for _, f := range pkg.Files {
+			for _, decl := range f.Decls {
+				gd, ok := decl.(*ast.GenDecl)
+				if !ok || gd.Tok != token.TYPE {
+					continue
+				}
+				for _, spec := range gd.Specs {
+					ts := spec.(*ast.TypeSpec)
+					if strings.HasSuffix(ts.Name.Name, "Event") {
+						types[ts.Name.Name] = true
+					}
+				}
+			}
+		}
+	}
+	return types, nil
+}
+
+// typeName returns the suggested name of the struct type of event, e.g.
+// "PullRequestReviewEvent" for "pull_request_review". If a type of declared
+// matches the words of event, regardless of their case and plural, such as
+// "GitHubAppAuthorizationEvent" for "github_app_authorization" or
+// "ProjectV2StatusUpdateEvent" for "projects_v2_status_update", its name is
+// returned instead.
+func typeName(event string, declared map[string]bool) string {
+	var words []string
+	for _, word := range strings.Split(event, "_") {
+		if word != "" {
+			words = append(words, word)
+		}
+	}
+
+	var b strings.Builder
+	for _, word := range words {
+		b.WriteString(strings.ToUpper(word[:1]) + word[1:])
+	}
+	b.WriteString("Event")
+	name := b.String()
+
+	for d := range declared {
+		if strings.EqualFold(d, name) || matchesWords(strings.ToLower(strings.TrimSuffix(d, "Event")), words) {
+			return d
+		}
+	}
+	return name
+}
+
+// matchesWords reports whether the lower case type name s is made of words,
+// where each word may also be in the singular.
+func matchesWords(s string, words []string) bool {
+	if len(words) == 0 {
+		return s == ""
+	}
+	word := words[0]
+	if strings.HasPrefix(s, word) && matchesWords(s[len(word):], words[1:]) {
+		return true
+	}
+	singular := strings.TrimSuffix(word, "s")
+	return singular != word && strings.HasPrefix(s, singular) && matchesWords(s[len(singular):], words[1:])
+}
+
+func logf(fmt string, args ...interface{}) {
+	if *verbose {
+		log.Printf(fmt, args...)
+	}
+}
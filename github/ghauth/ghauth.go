@@ -0,0 +1,259 @@
+// Copyright 2021 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+/*
+Package ghauth creates the JSON Web Tokens (JWTs) which authenticate a
+GitHub App, without the need for an external JWT library.
+
+The JWTs are signed with RS256 using the private key of the App, which can be
+read from a PEM file or PEM bytes, or provided as any crypto.Signer with an
+RSA public key, such as a key kept in a cloud KMS:
+
+	signer, err := ghauth.NewAppSignerFromFile(appID, "app.private-key.pem")
+	if err != nil {
+		// ...
+	}
+	tr := &ghauth.AppTransport{Signer: signer}
+	client := github.NewClient(&http.Client{Transport: tr})
+
+	// List the installations of the App.
+	installations, _, err := client.Apps.ListInstallations(ctx, nil)
+
+The issued-at time of the JWTs is backdated by IssuedAtSkew, so that they are
+accepted by GitHub even if the local clock is slightly ahead of GitHub's. Their
+expiry is capped at MaxExpiration after the backdated issued-at time, as GitHub
+rejects the JWTs whose lifetime is longer.
+
+GitHub API docs: https://docs.github.com/en/free-pro-team@latest/developers/apps/authenticating-with-github-apps#authenticating-as-a-github-app
+*/
+package ghauth
+
+import (
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+)
+
+const (
+	// DefaultIssuedAtSkew is the default duration by which the issued-at
+	// time of the JWTs is backdated, as recommended by GitHub.
+	DefaultIssuedAtSkew = 60 * time.Second
+
+	// MaxExpiration is the maximum lifetime of the JWTs accepted by GitHub,
+	// from their issued-at time.
+	MaxExpiration = 10 * time.Minute
+
+	// refreshMargin is the time before their expiry when the JWTs cached
+	// by AppTransport are renewed.
+	refreshMargin = time.Minute
+)
+
+// jwtHeader is the encoded header of the RS256 JWTs.
+var jwtHeader = base64.RawURLEncoding.EncodeToString([]byte(`{"alg":"RS256","typ":"JWT"}`))
+
+// AppSigner creates the JWTs which authenticate a GitHub App.
+type AppSigner struct {
+	// Issuer is the issuer claim of the JWTs, which is the ID of the App
+	// or its client ID.
+	Issuer string
+
+	// Key is the private key of the App. Its public key must be an
+	// *rsa.PublicKey.
+	Key crypto.Signer
+
+	// IssuedAtSkew is the duration by which the issued-at time of the JWTs
+	// is backdated to tolerate clock skew.
+	IssuedAtSkew time.Duration
+
+	// Expiration is the lifetime of the JWTs, from the current time.
+	// It must not be greater than MaxExpiration. The expiry is capped at
+	// MaxExpiration after the backdated issued-at time, so the lifetime of
+	// the JWTs is at most MaxExpiration - IssuedAtSkew from the current time.
+	Expiration time.Duration
+
+	now func() time.Time // for testing; defaults to time.Now
+}
+
+// NewAppSigner returns an AppSigner for the App with the given ID, which
+// signs the JWTs with key, using the default IssuedAtSkew and Expiration.
+func NewAppSigner(appID int64, key crypto.Signer) (*AppSigner, error) {
+	if _, ok := key.Public().(*rsa.PublicKey); !ok {
+		return nil, fmt.Errorf("ghauth: unsupported public key type %T, want *rsa.PublicKey", key.Public())
+	}
+	return &AppSigner{
+		Issuer:       strconv.FormatInt(appID, 10),
+		Key:          key,
+		IssuedAtSkew: DefaultIssuedAtSkew,
+		Expiration:   MaxExpiration,
+	}, nil
+}
+
+// NewAppSignerFromPEM returns an AppSigner for the App with the given ID,
+// which signs the JWTs with the private key encoded in pemBytes.
+func NewAppSignerFromPEM(appID int64, pemBytes []byte) (*AppSigner, error) {
+	key, err := ParsePrivateKey(pemBytes)
+	if err != nil {
+		return nil, err
+	}
+	return NewAppSigner(appID, key)
+}
+
+// NewAppSignerFromFile returns an AppSigner for the App with the given ID,
+// which signs the JWTs with the private key read from the PEM file at path.
+func NewAppSignerFromFile(appID int64, path string) (*AppSigner, error) {
+	pemBytes, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	return NewAppSignerFromPEM(appID, pemBytes)
+}
+
+// ParsePrivateKey parses the RSA private key encoded in pemBytes, in the
+// PKCS #1 format, as downloaded from GitHub, or in the PKCS #8 format.
+func ParsePrivateKey(pemBytes []byte) (*rsa.PrivateKey, error) {
+	block, _ := pem.Decode(pemBytes)
+	if block == nil {
+		return nil, errors.New("ghauth: no PEM data found")
+	}
+	if key, err := x509.ParsePKCS1PrivateKey(block.Bytes); err == nil {
+		return key, nil
+	}
+	key, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+	if err != nil {
+		return nil, fmt.Errorf("ghauth: parsing private key: %v", err)
+	}
+	rsaKey, ok := key.(*rsa.PrivateKey)
+	if !ok {
+		return nil, fmt.Errorf("ghauth: unsupported private key type %T, want *rsa.PrivateKey", key)
+	}
+	return rsaKey, nil
+}
+
+// JWT returns a new JWT which authenticates the App.
+func (s *AppSigner) JWT() (string, error) {
+	token, _, err := s.jwt()
+	return token, err
+}
+
+// jwt returns a new JWT and its expiry.
+func (s *AppSigner) jwt() (string, time.Time, error) {
+	if s.Key == nil {
+		return "", time.Time{}, errors.New("ghauth: AppSigner has no Key")
+	}
+	if s.Expiration > MaxExpiration {
+		return "", time.Time{}, fmt.Errorf("ghauth: Expiration %v is greater than %v", s.Expiration, MaxExpiration)
+	}
+	expiration := s.Expiration
+	if expiration <= 0 {
+		expiration = MaxExpiration
+	}
+
+	now := time.Now
+	if s.now != nil {
+		now = s.now
+	}
+	t := now()
+	iat := t.Add(-s.IssuedAtSkew)
+	exp := t.Add(expiration)
+	if max := iat.Add(MaxExpiration); exp.After(max) {
+		exp = max
+	}
+	claims, err := json.Marshal(struct {
+		IssuedAt  int64  `json:"iat"`
+		ExpiresAt int64  `json:"exp"`
+		Issuer    string `json:"iss"`
+	}{
+		IssuedAt:  iat.Unix(),
+		ExpiresAt: exp.Unix(),
+		Issuer:    s.Issuer,
+	})
+	if err != nil {
+		return "", time.Time{}, err
+	}
+
+	signingInput := jwtHeader + "." + base64.RawURLEncoding.EncodeToString(claims)
+	digest := sha256.Sum256([]byte(signingInput))
+	sig, err := s.Key.Sign(rand.Reader, digest[:], crypto.SHA256)
+	if err != nil {
+		return "", time.Time{}, fmt.Errorf("ghauth: signing JWT: %v", err)
+	}
+	return signingInput + "." + base64.RawURLEncoding.EncodeToString(sig), exp, nil
+}
+
+// AppTransport is an http.RoundTripper which authenticates the requests as
+// a GitHub App, with a JWT created by Signer. The JWT is reused until shortly
+// before it expires.
+type AppTransport struct {
+	Signer *AppSigner
+
+	// Base is the underlying transport. If nil, http.DefaultTransport is used.
+	Base http.RoundTripper
+
+	mu     sync.Mutex
+	token  string
+	expiry time.Time
+}
+
+// Token returns the current JWT, creating a new one if needed.
+func (t *AppTransport) Token() (string, error) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	now := time.Now
+	if t.Signer != nil && t.Signer.now != nil {
+		now = t.Signer.now
+	}
+	if t.token != "" && now().Add(refreshMargin).Before(t.expiry) {
+		return t.token, nil
+	}
+	if t.Signer == nil {
+		return "", errors.New("ghauth: AppTransport has no Signer")
+	}
+	token, expiry, err := t.Signer.jwt()
+	if err != nil {
+		return "", err
+	}
+	t.token, t.expiry = token, expiry
+	return token, nil
+}
+
+// RoundTrip implements the RoundTripper interface.
+func (t *AppTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	token, err := t.Token()
+	if err != nil {
+		return nil, err
+	}
+
+	// To set the Authorization header, we must make a copy of the Request
+	// so that we don't modify the Request we were given. This is required by the
+	// specification of http.RoundTripper.
+	req2 := req.Clone(req.Context())
+	req2.Header.Set("Authorization", "Bearer "+token)
+
+	return t.BaseTransport().RoundTrip(req2)
+}
+
+// BaseTransport returns the transport through which t sends the requests
+// once authenticated, which is Base or http.DefaultTransport. It lets
+// github.AppsService.AllInstallationRepos authenticate the requests of the
+// installations with their own tokens over the same transport.
+func (t *AppTransport) BaseTransport() http.RoundTripper {
+	if t.Base == nil {
+		return http.DefaultTransport
+	}
+	return t.Base
+}
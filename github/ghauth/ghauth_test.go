@@ -0,0 +1,297 @@
+// Copyright 2021 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package ghauth
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+var testKey *rsa.PrivateKey
+
+func init() {
+	var err error
+	testKey, err = rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		panic(err)
+	}
+}
+
+// parseJWT verifies the signature of token and returns its claims.
+func parseJWT(t *testing.T, token string) map[string]interface{} {
+	t.Helper()
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		t.Fatalf("JWT %q has %v parts, want 3", token, len(parts))
+	}
+
+	header, err := base64.RawURLEncoding.DecodeString(parts[0])
+	if err != nil {
+		t.Fatalf("Decoding JWT header returned error: %v", err)
+	}
+	if want := `{"alg":"RS256","typ":"JWT"}`; string(header) != want {
+		t.Errorf("JWT header is %s, want %s", header, want)
+	}
+
+	sig, err := base64.RawURLEncoding.DecodeString(parts[2])
+	if err != nil {
+		t.Fatalf("Decoding JWT signature returned error: %v", err)
+	}
+	digest := sha256.Sum256([]byte(parts[0] + "." + parts[1]))
+	if err := rsa.VerifyPKCS1v15(&testKey.PublicKey, crypto.SHA256, digest[:], sig); err != nil {
+		t.Errorf("JWT signature is invalid: %v", err)
+	}
+
+	payload, err := base64.RawURLEncoding.DecodeString(parts[1])
+	if err != nil {
+		t.Fatalf("Decoding JWT claims returned error: %v", err)
+	}
+	var claims map[string]interface{}
+	if err := json.Unmarshal(payload, &claims); err != nil {
+		t.Fatalf("Unmarshaling JWT claims returned error: %v", err)
+	}
+	return claims
+}
+
+func TestAppSigner_JWT(t *testing.T) {
+	s, err := NewAppSigner(42, testKey)
+	if err != nil {
+		t.Fatalf("NewAppSigner returned error: %v", err)
+	}
+	now := time.Unix(1600000000, 0)
+	s.now = func() time.Time { return now }
+
+	token, err := s.JWT()
+	if err != nil {
+		t.Fatalf("JWT returned error: %v", err)
+	}
+	claims := parseJWT(t, token)
+	want := map[string]interface{}{
+		"iss": "42",
+		"iat": float64(1600000000 - 60),
+		"exp": float64(1600000000 - 60 + 600),
+	}
+	for k, v := range want {
+		if claims[k] != v {
+			t.Errorf("JWT claim %v is %v, want %v", k, claims[k], v)
+		}
+	}
+
+	s.IssuedAtSkew = 0
+	s.Expiration = 5 * time.Minute
+	token, err = s.JWT()
+	if err != nil {
+		t.Fatalf("JWT returned error: %v", err)
+	}
+	claims = parseJWT(t, token)
+	if claims["iat"] != float64(1600000000) || claims["exp"] != float64(1600000000+300) {
+		t.Errorf("JWT claims are %v, want iat 1600000000 and exp 1600000300", claims)
+	}
+}
+
+func TestAppSigner_JWT_skewedClock(t *testing.T) {
+	s, err := NewAppSigner(42, testKey)
+	if err != nil {
+		t.Fatalf("NewAppSigner returned error: %v", err)
+	}
+	githubNow := time.Unix(1600000000, 0)
+
+	// The JWTs must be accepted by GitHub whether the local clock is ahead
+	// of or behind GitHub's, by less than IssuedAtSkew.
+	for _, skew := range []time.Duration{-30 * time.Second, 0, 30 * time.Second} {
+		now := githubNow.Add(skew)
+		s.now = func() time.Time { return now }
+		token, err := s.JWT()
+		if err != nil {
+			t.Fatalf("JWT returned error: %v", err)
+		}
+		claims := parseJWT(t, token)
+		iat := time.Unix(int64(claims["iat"].(float64)), 0)
+		exp := time.Unix(int64(claims["exp"].(float64)), 0)
+		if iat.After(githubNow) {
+			t.Errorf("With clock skew %v, JWT iat %v is after GitHub's time %v", skew, iat, githubNow)
+		}
+		if !exp.After(githubNow) {
+			t.Errorf("With clock skew %v, JWT exp %v is not after GitHub's time %v", skew, exp, githubNow)
+		}
+		if lifetime := exp.Sub(iat); lifetime > MaxExpiration {
+			t.Errorf("With clock skew %v, JWT lifetime is %v, want at most %v", skew, lifetime, MaxExpiration)
+		}
+	}
+}
+
+func TestAppSigner_JWT_errors(t *testing.T) {
+	if _, err := (&AppSigner{}).JWT(); err == nil {
+		t.Errorf("JWT without Key returned no error")
+	}
+	s := &AppSigner{Key: testKey, Expiration: 11 * time.Minute}
+	if _, err := s.JWT(); err == nil {
+		t.Errorf("JWT with Expiration greater than MaxExpiration returned no error")
+	}
+}
+
+func TestNewAppSigner_unsupportedKey(t *testing.T) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := NewAppSigner(1, key); err == nil {
+		t.Errorf("NewAppSigner with ECDSA key returned no error")
+	}
+}
+
+func TestParsePrivateKey(t *testing.T) {
+	pkcs8, err := x509.MarshalPKCS8PrivateKey(testKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for name, block := range map[string]*pem.Block{
+		"PKCS #1": {Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(testKey)},
+		"PKCS #8": {Type: "PRIVATE KEY", Bytes: pkcs8},
+	} {
+		key, err := ParsePrivateKey(pem.EncodeToMemory(block))
+		if err != nil {
+			t.Errorf("ParsePrivateKey(%v) returned error: %v", name, err)
+			continue
+		}
+		if key.N.Cmp(testKey.N) != 0 || key.D.Cmp(testKey.D) != 0 {
+			t.Errorf("ParsePrivateKey(%v) returned a different key", name)
+		}
+	}
+
+	ecKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ecPKCS8, err := x509.MarshalPKCS8PrivateKey(ecKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for name, data := range map[string][]byte{
+		"no PEM":      []byte("key"),
+		"invalid key": pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: []byte("key")}),
+		"ECDSA key":   pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: ecPKCS8}),
+	} {
+		if _, err := ParsePrivateKey(data); err == nil {
+			t.Errorf("ParsePrivateKey(%v) returned no error", name)
+		}
+	}
+}
+
+func TestNewAppSignerFromFile(t *testing.T) {
+	dir, err := ioutil.TempDir("", "ghauth")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	path := filepath.Join(dir, "key.pem")
+	data := pem.EncodeToMemory(&pem.Block{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(testKey)})
+	if err := ioutil.WriteFile(path, data, 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	s, err := NewAppSignerFromFile(7, path)
+	if err != nil {
+		t.Fatalf("NewAppSignerFromFile returned error: %v", err)
+	}
+	token, err := s.JWT()
+	if err != nil {
+		t.Fatalf("JWT returned error: %v", err)
+	}
+	if claims := parseJWT(t, token); claims["iss"] != "7" {
+		t.Errorf("JWT issuer is %v, want 7", claims["iss"])
+	}
+
+	if _, err := NewAppSignerFromFile(7, filepath.Join(dir, "missing.pem")); err == nil {
+		t.Errorf("NewAppSignerFromFile with missing file returned no error")
+	}
+}
+
+func TestAppTransport(t *testing.T) {
+	var auths []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		auths = append(auths, r.Header.Get("Authorization"))
+	}))
+	defer server.Close()
+
+	s, err := NewAppSigner(1, testKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	now := time.Unix(1600000000, 0)
+	s.now = func() time.Time { return now }
+	client := &http.Client{Transport: &AppTransport{Signer: s}}
+
+	get := func() {
+		req, err := http.NewRequest("GET", server.URL, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		resp, err := client.Do(req)
+		if err != nil {
+			t.Fatalf("Do returned error: %v", err)
+		}
+		resp.Body.Close()
+		if req.Header.Get("Authorization") != "" {
+			t.Errorf("AppTransport modified the original request")
+		}
+	}
+
+	get()
+	now = now.Add(5 * time.Minute)
+	get()
+	now = now.Add(5 * time.Minute)
+	get()
+
+	if len(auths) != 3 {
+		t.Fatalf("Server received %v requests, want 3", len(auths))
+	}
+	for _, auth := range auths {
+		if !strings.HasPrefix(auth, "Bearer ") {
+			t.Fatalf("Authorization header is %q, want a Bearer token", auth)
+		}
+		parseJWT(t, strings.TrimPrefix(auth, "Bearer "))
+	}
+	if auths[0] != auths[1] {
+		t.Errorf("AppTransport did not reuse the JWT before its expiry")
+	}
+	if auths[1] == auths[2] {
+		t.Errorf("AppTransport reused the JWT after its expiry")
+	}
+}
+
+func TestAppTransport_noSigner(t *testing.T) {
+	if _, err := (&AppTransport{}).Token(); err == nil {
+		t.Errorf("Token without Signer returned no error")
+	}
+}
+
+func TestAppTransport_BaseTransport(t *testing.T) {
+	if got := (&AppTransport{}).BaseTransport(); got != http.DefaultTransport {
+		t.Errorf("BaseTransport without Base returned %v, want http.DefaultTransport", got)
+	}
+	base := &http.Transport{}
+	if got := (&AppTransport{Base: base}).BaseTransport(); got != base {
+		t.Errorf("BaseTransport returned %v, want Base", got)
+	}
+}
@@ -8,7 +8,10 @@ package github
 import (
 	"bytes"
 	"context"
+	"encoding/base64"
 	"fmt"
+	"io"
+	"io/ioutil"
 )
 
 // Blob represents a blob object.
@@ -53,6 +56,29 @@ func (s *GitService) GetBlobRaw(ctx context.Context, owner, repo, sha string) ([
 	return buf.Bytes(), resp, err
 }
 
+// DownloadBlob writes a blob's raw contents to w as they are received, so
+// that large blobs are not held in memory, and returns the number of bytes
+// written.
+//
+// GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/git/#get-a-blob
+func (s *GitService) DownloadBlob(ctx context.Context, owner, repo, sha string, w io.Writer) (int64, *Response, error) {
+	u := fmt.Sprintf("repos/%v/%v/git/blobs/%v", owner, repo, sha)
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return 0, nil, err
+	}
+	req.Header.Set("Accept", mediaTypeV3Raw)
+
+	resp, err := s.client.BareDo(ctx, req)
+	if err != nil {
+		return 0, resp, err
+	}
+	defer resp.Body.Close()
+
+	n, err := io.Copy(w, resp.Body)
+	return n, resp, err
+}
+
 // CreateBlob creates a blob object.
 //
 // GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/git/#create-a-blob
@@ -67,3 +93,73 @@ func (s *GitService) CreateBlob(ctx context.Context, owner string, repo string,
 	resp, err := s.client.Do(ctx, req, t)
 	return t, resp, err
 }
+
+// CreateBlobFromReader creates a blob object with the content read from r.
+// The content is base64-encoded as it is sent, so that large content is not
+// held in memory. size is the length of the content, or -1 if it is unknown,
+// in which case the request is sent with chunked encoding.
+//
+// GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/git/#create-a-blob
+func (s *GitService) CreateBlobFromReader(ctx context.Context, owner, repo string, r io.Reader, size int64) (*Blob, *Response, error) {
+	u := fmt.Sprintf("repos/%v/%v/git/blobs", owner, repo)
+	req, err := s.client.NewRequest("POST", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	const prefix, suffix = `{"encoding":"base64","content":"`, `"}`
+	req.Body = ioutil.NopCloser(io.MultiReader(
+		bytes.NewReader([]byte(prefix)),
+		&base64Reader{r: r},
+		bytes.NewReader([]byte(suffix)),
+	))
+	req.ContentLength = -1
+	if size >= 0 {
+		// The padded base64 encoding takes 4 bytes for every 3 bytes.
+		req.ContentLength = int64(len(prefix)+len(suffix)) + (size+2)/3*4
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	t := new(Blob)
+	resp, err := s.client.Do(ctx, req, t)
+	if err != nil {
+		return nil, resp, err
+	}
+	return t, resp, nil
+}
+
+// base64Reader reads the standard base64 encoding of the content of r.
+type base64Reader struct {
+	r       io.Reader
+	chunk   []byte
+	encoded []byte
+	err     error
+}
+
+func (b *base64Reader) Read(p []byte) (int, error) {
+	for len(b.encoded) == 0 {
+		if b.err != nil {
+			return 0, b.err
+		}
+		if b.chunk == nil {
+			// Chunks are a multiple of 3 bytes, so that they are encoded
+			// without padding, except for the last one.
+			b.chunk = make([]byte, 3*1024)
+		}
+
+		n, err := io.ReadFull(b.r, b.chunk)
+		switch err {
+		case nil:
+		case io.EOF, io.ErrUnexpectedEOF:
+			b.err = io.EOF
+		default:
+			return 0, err
+		}
+		b.encoded = make([]byte, base64.StdEncoding.EncodedLen(n))
+		base64.StdEncoding.Encode(b.encoded, b.chunk[:n])
+	}
+
+	n := copy(p, b.encoded)
+	b.encoded = b.encoded[n:]
+	return n, nil
+}
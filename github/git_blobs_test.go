@@ -8,8 +8,10 @@ package github
 import (
 	"bytes"
 	"context"
+	"encoding/base64"
 	"encoding/json"
 	"fmt"
+	"io/ioutil"
 	"net/http"
 	"reflect"
 	"testing"
@@ -153,3 +155,103 @@ func TestGitService_CreateBlob_invalidOwner(t *testing.T) {
 	_, _, err := client.Git.CreateBlob(ctx, "%", "%", &Blob{})
 	testURLParseError(t, err)
 }
+
+func TestGitService_DownloadBlob(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/git/blobs/s", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testHeader(t, r, "Accept", "application/vnd.github.v3.raw")
+
+		fmt.Fprint(w, `raw contents here`)
+	})
+
+	ctx := context.Background()
+	var buf bytes.Buffer
+	n, _, err := client.Git.DownloadBlob(ctx, "o", "r", "s", &buf)
+	if err != nil {
+		t.Errorf("Git.DownloadBlob returned error: %v", err)
+	}
+	if want := "raw contents here"; buf.String() != want || n != int64(len(want)) {
+		t.Errorf("Git.DownloadBlob wrote %q (%v bytes), want %q", buf.String(), n, want)
+	}
+
+	const methodName = "DownloadBlob"
+	testBadOptions(t, methodName, func() (err error) {
+		_, _, err = client.Git.DownloadBlob(ctx, "\n", "\n", "\n", &buf)
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		_, resp, err := client.Git.DownloadBlob(ctx, "o", "r", "s", &buf)
+		return resp, err
+	})
+}
+
+func TestGitService_CreateBlobFromReader(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	// Content spanning several chunks, with a length which is not a
+	// multiple of 3.
+	content := bytes.Repeat([]byte("blob\x00content\xff"), 1000)[:10000]
+
+	mux.HandleFunc("/repos/o/r/git/blobs", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testHeader(t, r, "Content-Type", "application/json")
+
+		v := new(Blob)
+		body, _ := ioutil.ReadAll(r.Body)
+		if err := json.Unmarshal(body, v); err != nil {
+			t.Errorf("Request body %q is not JSON: %v", body, err)
+		}
+		if r.ContentLength != -1 && r.ContentLength != int64(len(body)) {
+			t.Errorf("Request Content-Length = %v, want %v", r.ContentLength, len(body))
+		}
+		want := &Blob{Content: String(base64.StdEncoding.EncodeToString(content)), Encoding: String("base64")}
+		if !reflect.DeepEqual(v, want) {
+			t.Errorf("Request body = %+v, want %+v", v, want)
+		}
+
+		fmt.Fprint(w, `{"sha": "s"}`)
+	})
+
+	ctx := context.Background()
+	for _, size := range []int64{int64(len(content)), -1} {
+		blob, _, err := client.Git.CreateBlobFromReader(ctx, "o", "r", bytes.NewReader(content), size)
+		if err != nil {
+			t.Errorf("Git.CreateBlobFromReader returned error: %v", err)
+		}
+		if want := (&Blob{SHA: String("s")}); !reflect.DeepEqual(blob, want) {
+			t.Errorf("Git.CreateBlobFromReader returned %+v, want %+v", blob, want)
+		}
+	}
+
+	const methodName = "CreateBlobFromReader"
+	testBadOptions(t, methodName, func() (err error) {
+		_, _, err = client.Git.CreateBlobFromReader(ctx, "\n", "\n", bytes.NewReader(content), -1)
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.Git.CreateBlobFromReader(ctx, "o", "r", bytes.NewReader(content), -1)
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
+
+func TestBase64Reader(t *testing.T) {
+	for _, n := range []int{0, 1, 2, 3, 3072, 3073, 10000} {
+		content := bytes.Repeat([]byte{0xfb}, n)
+		got, err := ioutil.ReadAll(&base64Reader{r: bytes.NewReader(content)})
+		if err != nil {
+			t.Errorf("base64Reader(%v bytes) returned error: %v", n, err)
+		}
+		if want := base64.StdEncoding.EncodeToString(content); string(got) != want {
+			t.Errorf("base64Reader(%v bytes) = %q, want %q", n, got, want)
+		}
+	}
+}
@@ -8,20 +8,64 @@ package github
 import (
 	"bytes"
 	"context"
+	"crypto/rand"
+	"crypto/sha512"
+	"encoding/base64"
+	"encoding/binary"
 	"errors"
 	"fmt"
+	"io"
+	"io/ioutil"
 	"strings"
 	"time"
 
 	"golang.org/x/crypto/openpgp"
+	"golang.org/x/crypto/ssh"
 )
 
 // SignatureVerification represents GPG signature verification.
 type SignatureVerification struct {
-	Verified  *bool   `json:"verified,omitempty"`
+	Verified *bool `json:"verified,omitempty"`
+	// Reason is one of the SignatureVerificationReason values, which
+	// VerificationReason returns typed.
 	Reason    *string `json:"reason,omitempty"`
 	Signature *string `json:"signature,omitempty"`
 	Payload   *string `json:"payload,omitempty"`
+	// VerifiedAt is the time at which the signature was verified.
+	VerifiedAt *Timestamp `json:"verified_at,omitempty"`
+}
+
+// SignatureVerificationReason is the reason a signature is verified or not.
+type SignatureVerificationReason string
+
+// The reasons reported by GitHub for SignatureVerification.Reason.
+const (
+	// SignatureValid is the reason of verified signatures.
+	SignatureValid SignatureVerificationReason = "valid"
+	// SignatureUnsigned is the reason of unsigned commits and tags.
+	SignatureUnsigned SignatureVerificationReason = "unsigned"
+
+	SignatureExpiredKey           SignatureVerificationReason = "expired_key"
+	SignatureNotSigningKey        SignatureVerificationReason = "not_signing_key"
+	SignatureGPGVerifyError       SignatureVerificationReason = "gpgverify_error"
+	SignatureGPGVerifyUnavailable SignatureVerificationReason = "gpgverify_unavailable"
+	SignatureUnknownSignatureType SignatureVerificationReason = "unknown_signature_type"
+	SignatureNoUser               SignatureVerificationReason = "no_user"
+	SignatureUnverifiedEmail      SignatureVerificationReason = "unverified_email"
+	SignatureBadEmail             SignatureVerificationReason = "bad_email"
+	SignatureUnknownKey           SignatureVerificationReason = "unknown_key"
+	SignatureMalformedSignature   SignatureVerificationReason = "malformed_signature"
+	SignatureInvalid              SignatureVerificationReason = "invalid"
+	SignatureBadCert              SignatureVerificationReason = "bad_cert"
+	SignatureOCSPPending          SignatureVerificationReason = "ocsp_pending"
+	SignatureOCSPError            SignatureVerificationReason = "ocsp_error"
+	SignatureOCSPRevoked          SignatureVerificationReason = "ocsp_revoked"
+)
+
+// VerificationReason returns the typed reason the signature is verified or
+// not.
+func (s *SignatureVerification) VerificationReason() SignatureVerificationReason {
+	return SignatureVerificationReason(s.GetReason())
 }
 
 // Commit represents a GitHub commit.
@@ -97,6 +141,16 @@ type createCommit struct {
 	Signature *string       `json:"signature,omitempty"`
 }
 
+// CreateCommitOptions specifies the optional parameters to the
+// GitService.CreateCommitWithOptions method.
+type CreateCommitOptions struct {
+	// Signer signs the commit. If not nil, it is used instead of
+	// Commit.SigningKey, such as to sign the commit with an SSH key or with a
+	// key held by an external service. Ignored if Commit.Verification.Signature
+	// is defined.
+	Signer MessageSigner
+}
+
 // CreateCommit creates a new commit in a repository.
 // commit must not be nil.
 //
@@ -106,6 +160,14 @@ type createCommit struct {
 //
 // GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/git/#create-a-commit
 func (s *GitService) CreateCommit(ctx context.Context, owner string, repo string, commit *Commit) (*Commit, *Response, error) {
+	return s.CreateCommitWithOptions(ctx, owner, repo, commit, nil)
+}
+
+// CreateCommitWithOptions creates a new commit in a repository like
+// CreateCommit, signing it with opts.Signer if set.
+//
+// GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/git/#create-a-commit
+func (s *GitService) CreateCommitWithOptions(ctx context.Context, owner string, repo string, commit *Commit, opts *CreateCommitOptions) (*Commit, *Response, error) {
 	if commit == nil {
 		return nil, nil, fmt.Errorf("commit must be provided")
 	}
@@ -126,7 +188,14 @@ func (s *GitService) CreateCommit(ctx context.Context, owner string, repo string
 	if commit.Tree != nil {
 		body.Tree = commit.Tree.SHA
 	}
-	if commit.SigningKey != nil {
+	switch {
+	case opts != nil && opts.Signer != nil:
+		signature, err := signCommit(opts.Signer, body)
+		if err != nil {
+			return nil, nil, err
+		}
+		body.Signature = &signature
+	case commit.SigningKey != nil:
 		signature, err := createSignature(commit.SigningKey, body)
 		if err != nil {
 			return nil, nil, err
@@ -151,8 +220,106 @@ func (s *GitService) CreateCommit(ctx context.Context, owner string, repo string
 	return c, resp, nil
 }
 
+// MessageSigner signs commit and tag payloads, as formatted by git, for
+// CreateCommitOptions.Signer and Tag.Signer.
+type MessageSigner interface {
+	// Sign reads the payload from r and writes its armored detached
+	// signature to w.
+	Sign(w io.Writer, r io.Reader) error
+}
+
+// MessageSignerFunc is a function implementing MessageSigner.
+type MessageSignerFunc func(w io.Writer, r io.Reader) error
+
+// Sign calls f(w, r).
+func (f MessageSignerFunc) Sign(w io.Writer, r io.Reader) error {
+	return f(w, r)
+}
+
+// OpenPGPSigner returns a MessageSigner signing with an OpenPGP key, whose
+// private key must be present and already decrypted.
+func OpenPGPSigner(signingKey *openpgp.Entity) MessageSigner {
+	return MessageSignerFunc(func(w io.Writer, r io.Reader) error {
+		return openpgp.ArmoredDetachSign(w, signingKey, r, nil)
+	})
+}
+
+// SSHSigner returns a MessageSigner signing with an SSH key, in the format
+// used by git with gpg.format set to "ssh".
+func SSHSigner(signer ssh.Signer) MessageSigner {
+	return MessageSignerFunc(func(w io.Writer, r io.Reader) error {
+		message, err := ioutil.ReadAll(r)
+		if err != nil {
+			return err
+		}
+		signature, err := sshSign(signer, "git", message)
+		if err != nil {
+			return err
+		}
+		_, err = io.WriteString(w, signature)
+		return err
+	})
+}
+
+// sshSign returns the armored SSH signature of message, as defined by
+// https://github.com/openssh/openssh-portable/blob/master/PROTOCOL.sshsig.
+func sshSign(signer ssh.Signer, namespace string, message []byte) (string, error) {
+	const magic, hashAlgorithm = "SSHSIG", "sha512"
+	hash := sha512.Sum512(message)
+
+	var signed bytes.Buffer
+	signed.WriteString(magic)
+	for _, field := range [][]byte{[]byte(namespace), nil, []byte(hashAlgorithm), hash[:]} {
+		writeSSHString(&signed, field)
+	}
+
+	var sig *ssh.Signature
+	var err error
+	if algorithmSigner, ok := signer.(ssh.AlgorithmSigner); ok && signer.PublicKey().Type() == ssh.KeyAlgoRSA {
+		// SHA-1 RSA signatures are rejected.
+		sig, err = algorithmSigner.SignWithAlgorithm(rand.Reader, signed.Bytes(), ssh.SigAlgoRSASHA2512)
+	} else {
+		sig, err = signer.Sign(rand.Reader, signed.Bytes())
+	}
+	if err != nil {
+		return "", err
+	}
+
+	var blob bytes.Buffer
+	blob.WriteString(magic)
+	binary.Write(&blob, binary.BigEndian, uint32(1))
+	for _, field := range [][]byte{signer.PublicKey().Marshal(), []byte(namespace), nil, []byte(hashAlgorithm), ssh.Marshal(sig)} {
+		writeSSHString(&blob, field)
+	}
+
+	encoded := base64.StdEncoding.EncodeToString(blob.Bytes())
+	var armored strings.Builder
+	armored.WriteString("-----BEGIN SSH SIGNATURE-----\n")
+	for len(encoded) > 70 {
+		armored.WriteString(encoded[:70] + "\n")
+		encoded = encoded[70:]
+	}
+	armored.WriteString(encoded + "\n-----END SSH SIGNATURE-----\n")
+	return armored.String(), nil
+}
+
+// writeSSHString writes s to b as an SSH wire format string, prefixed by
+// its length.
+func writeSSHString(b *bytes.Buffer, s []byte) {
+	binary.Write(b, binary.BigEndian, uint32(len(s)))
+	b.Write(s)
+}
+
 func createSignature(signingKey *openpgp.Entity, commit *createCommit) (string, error) {
-	if signingKey == nil || commit == nil {
+	if signingKey == nil {
+		return "", errors.New("createSignature: invalid parameters")
+	}
+	return signCommit(OpenPGPSigner(signingKey), commit)
+}
+
+// signCommit returns the signature of commit by signer.
+func signCommit(signer MessageSigner, commit *createCommit) (string, error) {
+	if signer == nil || commit == nil {
 		return "", errors.New("createSignature: invalid parameters")
 	}
 
@@ -163,7 +330,7 @@ func createSignature(signingKey *openpgp.Entity, commit *createCommit) (string,
 
 	writer := new(bytes.Buffer)
 	reader := bytes.NewReader([]byte(message))
-	if err := openpgp.ArmoredDetachSign(writer, signingKey, reader, nil); err != nil {
+	if err := signer.Sign(writer, reader); err != nil {
 		return "", err
 	}
 
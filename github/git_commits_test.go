@@ -6,9 +6,19 @@
 package github
 
 import (
+	"bytes"
 	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha512"
+	"encoding/base64"
+	"encoding/binary"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"io"
+	"io/ioutil"
 	"net/http"
 	"reflect"
 	"strings"
@@ -16,6 +26,7 @@ import (
 	"time"
 
 	"golang.org/x/crypto/openpgp"
+	"golang.org/x/crypto/ssh"
 )
 
 func TestCommit_Marshal(t *testing.T) {
@@ -58,10 +69,11 @@ func TestCommit_Marshal(t *testing.T) {
 		HTMLURL: String("h"),
 		URL:     String("u"),
 		Verification: &SignatureVerification{
-			Verified:  Bool(false),
-			Reason:    String("r"),
-			Signature: String("s"),
-			Payload:   String("p"),
+			Verified:   Bool(false),
+			Reason:     String("r"),
+			Signature:  String("s"),
+			Payload:    String("p"),
+			VerifiedAt: &Timestamp{referenceTime},
 		},
 		NodeID:       String("n"),
 		CommentCount: Int(1),
@@ -109,7 +121,8 @@ func TestCommit_Marshal(t *testing.T) {
 			"verified": false,
 			"reason": "r",
 			"signature": "s",
-			"payload": "p"
+			"payload": "p",
+			"verified_at": ` + referenceTimeStr + `
 		},
 		"node_id": "n",
 		"comment_count": 1
@@ -118,6 +131,18 @@ func TestCommit_Marshal(t *testing.T) {
 	testJSONMarshal(t, u, want)
 }
 
+func TestSignatureVerification_VerificationReason(t *testing.T) {
+	v := &SignatureVerification{Reason: String("expired_key")}
+	if got := v.VerificationReason(); got != SignatureExpiredKey {
+		t.Errorf("VerificationReason = %q, want %q", got, SignatureExpiredKey)
+	}
+
+	v = nil
+	if got := v.VerificationReason(); got != "" {
+		t.Errorf("VerificationReason of nil verification = %q, want empty", got)
+	}
+}
+
 func TestGitService_GetCommit(t *testing.T) {
 	client, mux, _, teardown := setup()
 	defer teardown()
@@ -360,6 +385,140 @@ Commit Message.`)
 	}
 }
 
+func TestGitService_CreateSignedCommitWithSigner(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	date, _ := time.Parse("Mon Jan 02 15:04:05 2006 -0700", "Thu May 04 00:03:43 2017 +0200")
+	author := &CommitAuthor{
+		Name:  String("go-github"),
+		Email: String("go-github@github.com"),
+		Date:  &date,
+	}
+	var payload string
+	input := &Commit{
+		Message: String("Commit Message."),
+		Tree:    &Tree{SHA: String("t")},
+		Parents: []*Commit{{SHA: String("p")}},
+		Author:  author,
+		// SigningKey is ignored when Signer is set.
+		SigningKey: &openpgp.Entity{},
+	}
+	opts := &CreateCommitOptions{
+		Signer: MessageSignerFunc(func(w io.Writer, r io.Reader) error {
+			b, err := ioutil.ReadAll(r)
+			payload = string(b)
+			fmt.Fprint(w, "signature")
+			return err
+		}),
+	}
+
+	mux.HandleFunc("/repos/o/r/git/commits", func(w http.ResponseWriter, r *http.Request) {
+		v := new(createCommit)
+		json.NewDecoder(r.Body).Decode(v)
+
+		testMethod(t, r, "POST")
+		want := &createCommit{
+			Message:   input.Message,
+			Tree:      String("t"),
+			Parents:   []string{"p"},
+			Author:    author,
+			Signature: String("signature"),
+		}
+		if !reflect.DeepEqual(v, want) {
+			t.Errorf("Request body = %+v, want %+v", v, want)
+		}
+		fmt.Fprint(w, `{"sha":"commitSha"}`)
+	})
+
+	ctx := context.Background()
+	if _, _, err := client.Git.CreateCommitWithOptions(ctx, "o", "r", input, opts); err != nil {
+		t.Errorf("Git.CreateCommitWithOptions returned error: %v", err)
+	}
+
+	wantPayload := `tree t
+parent p
+author go-github <go-github@github.com> 1493849023 +0200
+committer go-github <go-github@github.com> 1493849023 +0200
+
+Commit Message.`
+	if payload != wantPayload {
+		t.Errorf("Signed payload = %q, want %q", payload, wantPayload)
+	}
+
+	opts.Signer = MessageSignerFunc(func(w io.Writer, r io.Reader) error {
+		return errors.New("signing failed")
+	})
+	if _, _, err := client.Git.CreateCommitWithOptions(ctx, "o", "r", input, opts); err == nil {
+		t.Error("Git.CreateCommitWithOptions with failing signer returned nil error")
+	}
+}
+
+func TestSSHSigner(t *testing.T) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	signer, err := ssh.NewSignerFromKey(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var armored bytes.Buffer
+	message := "tree t\n\nCommit Message."
+	if err := SSHSigner(signer).Sign(&armored, strings.NewReader(message)); err != nil {
+		t.Fatalf("SSHSigner.Sign returned error: %v", err)
+	}
+
+	lines := strings.Split(strings.TrimSuffix(armored.String(), "\n"), "\n")
+	if first, last := lines[0], lines[len(lines)-1]; first != "-----BEGIN SSH SIGNATURE-----" || last != "-----END SSH SIGNATURE-----" {
+		t.Fatalf("SSHSigner.Sign returned unarmored signature %q", armored.String())
+	}
+	for _, line := range lines[1 : len(lines)-1] {
+		if len(line) > 70 {
+			t.Errorf("Armored signature line %q is longer than 70 characters", line)
+		}
+	}
+	blob, err := base64.StdEncoding.DecodeString(strings.Join(lines[1:len(lines)-1], ""))
+	if err != nil {
+		t.Fatalf("Armored signature is not base64: %v", err)
+	}
+
+	if !bytes.HasPrefix(blob, []byte("SSHSIG\x00\x00\x00\x01")) {
+		t.Fatalf("Signature blob has wrong preamble: %q", blob[:10])
+	}
+	blob = blob[10:]
+	var fields [][]byte
+	for len(blob) >= 4 {
+		n := binary.BigEndian.Uint32(blob)
+		fields = append(fields, blob[4:4+n])
+		blob = blob[4+n:]
+	}
+	if len(fields) != 5 {
+		t.Fatalf("Signature blob has %v fields, want 5", len(fields))
+	}
+	if !bytes.Equal(fields[0], signer.PublicKey().Marshal()) {
+		t.Error("Signature blob has wrong public key")
+	}
+	if string(fields[1]) != "git" || string(fields[3]) != "sha512" {
+		t.Errorf("Signature blob has namespace %q and hash %q, want git and sha512", fields[1], fields[3])
+	}
+
+	sig := new(ssh.Signature)
+	if err := ssh.Unmarshal(fields[4], sig); err != nil {
+		t.Fatalf("Signature is invalid: %v", err)
+	}
+	var signed bytes.Buffer
+	hash := sha512.Sum512([]byte(message))
+	signed.WriteString("SSHSIG")
+	for _, field := range [][]byte{[]byte("git"), nil, []byte("sha512"), hash[:]} {
+		writeSSHString(&signed, field)
+	}
+	if err := signer.PublicKey().Verify(signed.Bytes(), sig); err != nil {
+		t.Errorf("Signature does not verify: %v", err)
+	}
+}
+
 func TestGitService_createSignature_nilSigningKey(t *testing.T) {
 	a := &createCommit{
 		Message: String("Commit Message."),
@@ -8,6 +8,7 @@ package github
 import (
 	"context"
 	"fmt"
+	"net/http"
 	"net/url"
 	"strings"
 )
@@ -173,3 +174,107 @@ func (s *GitService) DeleteRef(ctx context.Context, owner string, repo string, r
 
 	return s.client.Do(ctx, req, nil)
 }
+
+// RefUpdate describes the update of a ref by UpdateRefs.
+type RefUpdate struct {
+	// Ref is the fully qualified name of the ref, such as "refs/heads/main"
+	// or "refs/tags/v1.0.0". The "refs/" prefix is optional.
+	Ref string
+
+	// OldSHA is the SHA the ref is expected to point to. If empty, the ref
+	// is expected not to exist, and is created.
+	OldSHA string
+
+	// NewSHA is the SHA the ref is updated to.
+	NewSHA string
+
+	// Force allows updates which are not fast-forwards. By default, GitHub
+	// only allows fast-forward updates.
+	Force bool
+}
+
+// RefUpdateResult is the result of a RefUpdate.
+type RefUpdateResult struct {
+	Update *RefUpdate
+	// Ref is the updated ref, if the update succeeded.
+	Ref *Reference
+	// Err is the reason the update failed, if it did. It is a
+	// *RefConflictError if the ref did not point to the expected SHA.
+	Err error
+}
+
+// RefConflictError is returned when a ref does not point to the SHA a
+// RefUpdate expects.
+type RefConflictError struct {
+	Ref string
+	// Expected is the expected SHA, or the empty string if the ref was
+	// expected not to exist.
+	Expected string
+	// Actual is the SHA the ref points to, or the empty string if it does
+	// not exist.
+	Actual string
+}
+
+func (e *RefConflictError) Error() string {
+	describe := func(sha string) string {
+		if sha == "" {
+			return "no ref"
+		}
+		return sha
+	}
+	return fmt.Sprintf("ref %v points to %v, expected %v", e.Ref, describe(e.Actual), describe(e.Expected))
+}
+
+// UpdateRefs updates several refs in a repository, checking that each of
+// them points to the expected SHA before updating it. Each update is
+// attempted, whether the previous ones succeeded or not, and its result is
+// reported at the same index of the returned results. The returned error
+// is non-nil if any update failed.
+//
+// GitHub does not update refs atomically nor conditionally: a ref updated
+// by someone else between its check and its update is not detected, and
+// the updates which succeeded are not rolled back when another one fails.
+//
+// GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/git/#update-a-reference
+func (s *GitService) UpdateRefs(ctx context.Context, owner, repo string, updates []*RefUpdate) ([]*RefUpdateResult, error) {
+	results := make([]*RefUpdateResult, len(updates))
+	failed := 0
+	for i, update := range updates {
+		ref, err := s.updateRef(ctx, owner, repo, update)
+		if err != nil {
+			failed++
+		}
+		results[i] = &RefUpdateResult{Update: update, Ref: ref, Err: err}
+	}
+
+	if failed > 0 {
+		return results, fmt.Errorf("%v of %v ref updates failed", failed, len(updates))
+	}
+	return results, nil
+}
+
+// updateRef applies a single RefUpdate.
+func (s *GitService) updateRef(ctx context.Context, owner, repo string, update *RefUpdate) (*Reference, error) {
+	name := "refs/" + strings.TrimPrefix(update.Ref, "refs/")
+
+	var actual string
+	current, _, err := s.GetRef(ctx, owner, repo, name)
+	if err != nil {
+		if e, ok := err.(*ErrorResponse); !ok || e.Response.StatusCode != http.StatusNotFound {
+			return nil, err
+		}
+	} else {
+		actual = current.GetObject().GetSHA()
+	}
+	if actual != update.OldSHA {
+		return nil, &RefConflictError{Ref: name, Expected: update.OldSHA, Actual: actual}
+	}
+
+	ref := &Reference{Ref: String(name), Object: &GitObject{SHA: String(update.NewSHA)}}
+	if update.OldSHA == "" {
+		ref, _, err = s.CreateRef(ctx, owner, repo, ref)
+	} else {
+		ref, _, err = s.UpdateRef(ctx, owner, repo, ref, update.Force)
+	}
+	return ref, err
+}
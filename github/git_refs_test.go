@@ -622,3 +622,82 @@ func TestGitService_GetRef_pathEscape(t *testing.T) {
 		return resp, err
 	})
 }
+
+func TestGitService_UpdateRefs(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	refs := map[string]string{"heads/main": "a", "heads/dev": "c", "heads/old": "d"}
+	mux.HandleFunc("/repos/o/r/git/ref/", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		name := strings.TrimPrefix(r.URL.Path, "/repos/o/r/git/ref/")
+		sha, ok := refs[name]
+		if !ok {
+			http.Error(w, `{"message":"Not Found"}`, http.StatusNotFound)
+			return
+		}
+		fmt.Fprintf(w, `{"ref":"refs/%v","object":{"type":"commit","sha":%q}}`, name, sha)
+	})
+	mux.HandleFunc("/repos/o/r/git/refs/heads/main", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PATCH")
+		testBody(t, r, `{"sha":"b","force":false}`+"\n")
+		fmt.Fprint(w, `{"ref":"refs/heads/main","object":{"sha":"b"}}`)
+	})
+	mux.HandleFunc("/repos/o/r/git/refs/heads/old", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PATCH")
+		testBody(t, r, `{"sha":"e","force":true}`+"\n")
+		w.WriteHeader(http.StatusUnprocessableEntity)
+		fmt.Fprint(w, `{"message":"Reference cannot be updated"}`)
+	})
+	mux.HandleFunc("/repos/o/r/git/refs", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testBody(t, r, `{"ref":"refs/tags/v1","sha":"b"}`+"\n")
+		fmt.Fprint(w, `{"ref":"refs/tags/v1","object":{"sha":"b"}}`)
+	})
+
+	updates := []*RefUpdate{
+		{Ref: "refs/heads/main", OldSHA: "a", NewSHA: "b"},
+		{Ref: "tags/v1", NewSHA: "b"},
+		{Ref: "heads/dev", OldSHA: "a", NewSHA: "b"},
+		{Ref: "heads/old", OldSHA: "d", NewSHA: "e", Force: true},
+		{Ref: "heads/missing", OldSHA: "a", NewSHA: "b"},
+	}
+	ctx := context.Background()
+	results, err := client.Git.UpdateRefs(ctx, "o", "r", updates)
+	if err == nil || err.Error() != "3 of 5 ref updates failed" {
+		t.Errorf("Git.UpdateRefs returned error %v, want 3 of 5 ref updates failed", err)
+	}
+	if len(results) != len(updates) {
+		t.Fatalf("Git.UpdateRefs returned %v results, want %v", len(results), len(updates))
+	}
+	for i, result := range results {
+		if result.Update != updates[i] {
+			t.Errorf("Result %v is for update %+v, want %+v", i, result.Update, updates[i])
+		}
+	}
+
+	if results[0].Err != nil || results[0].Ref.GetObject().GetSHA() != "b" {
+		t.Errorf("Update of heads/main returned %+v, %v", results[0].Ref, results[0].Err)
+	}
+	if results[1].Err != nil || results[1].Ref.GetRef() != "refs/tags/v1" {
+		t.Errorf("Creation of tags/v1 returned %+v, %v", results[1].Ref, results[1].Err)
+	}
+	wantConflict := &RefConflictError{Ref: "refs/heads/dev", Expected: "a", Actual: "c"}
+	if !reflect.DeepEqual(results[2].Err, wantConflict) {
+		t.Errorf("Update of heads/dev returned error %v, want %v", results[2].Err, wantConflict)
+	}
+	if _, ok := results[3].Err.(*ErrorResponse); !ok {
+		t.Errorf("Update of heads/old returned error %v, want *ErrorResponse", results[3].Err)
+	}
+	if got, want := results[4].Err.Error(), "ref refs/heads/missing points to no ref, expected a"; got != want {
+		t.Errorf("Update of heads/missing returned error %q, want %q", got, want)
+	}
+
+	results, err = client.Git.UpdateRefs(ctx, "o", "r", updates[:2])
+	if err != nil {
+		t.Errorf("Git.UpdateRefs returned error: %v", err)
+	}
+	if len(results) != 2 {
+		t.Errorf("Git.UpdateRefs returned %v results, want 2", len(results))
+	}
+}
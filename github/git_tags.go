@@ -7,7 +7,9 @@ package github
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"strings"
 )
 
 // Tag represents a tag object.
@@ -20,6 +22,11 @@ type Tag struct {
 	Object       *GitObject             `json:"object,omitempty"`
 	Verification *SignatureVerification `json:"verification,omitempty"`
 	NodeID       *string                `json:"node_id,omitempty"`
+
+	// Signer signs the tag created by CreateTag, which requires Tagger with
+	// its Date to be set. The signature is appended to the message, as git
+	// does.
+	Signer MessageSigner `json:"-"`
 }
 
 // createTagRequest represents the body of a CreateTag request. This is mostly
@@ -64,6 +71,13 @@ func (s *GitService) CreateTag(ctx context.Context, owner string, repo string, t
 		tagRequest.Object = tag.Object.SHA
 		tagRequest.Type = tag.Object.Type
 	}
+	if tag.Signer != nil {
+		message, err := signTag(tag.Signer, tagRequest)
+		if err != nil {
+			return nil, nil, err
+		}
+		tagRequest.Message = &message
+	}
 
 	req, err := s.client.NewRequest("POST", u, tagRequest)
 	if err != nil {
@@ -74,3 +88,31 @@ func (s *GitService) CreateTag(ctx context.Context, owner string, repo string, t
 	resp, err := s.client.Do(ctx, req, t)
 	return t, resp, err
 }
+
+// signTag returns the message of tag followed by its signature by signer.
+func signTag(signer MessageSigner, tag *createTagRequest) (string, error) {
+	if tag.Object == nil || tag.Type == nil || tag.Tag == nil || tag.Tagger == nil || tag.Tagger.Date == nil {
+		return "", errors.New("signTag: object, type, tag and tagger with date must be provided")
+	}
+
+	// git terminates tag messages with a newline.
+	var message string
+	if tag.Message != nil {
+		message = *tag.Message
+	}
+	if !strings.HasSuffix(message, "\n") {
+		message += "\n"
+	}
+
+	tagger := tag.Tagger
+	payload := fmt.Sprintf("object %s\ntype %s\ntag %s\ntagger %s <%s> %d %s\n\n%s",
+		*tag.Object, *tag.Type, *tag.Tag,
+		tagger.GetName(), tagger.GetEmail(), tagger.GetDate().Unix(), tagger.GetDate().Format("-0700"),
+		message)
+
+	var signature strings.Builder
+	if err := signer.Sign(&signature, strings.NewReader(payload)); err != nil {
+		return "", err
+	}
+	return message + signature.String(), nil
+}
@@ -11,7 +11,11 @@ import (
 	"fmt"
 	"net/http"
 	"reflect"
+	"strings"
 	"testing"
+	"time"
+
+	"golang.org/x/crypto/openpgp"
 )
 
 func TestGitService_GetTag(t *testing.T) {
@@ -82,3 +86,72 @@ func TestGitService_CreateTag(t *testing.T) {
 		return err
 	})
 }
+
+func TestGitService_CreateSignedTag(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	keyring, err := openpgp.ReadArmoredKeyRing(strings.NewReader(testGPGKey))
+	if err != nil {
+		t.Fatalf("Error reading keyring: %+v", err)
+	}
+
+	date, _ := time.Parse("Mon Jan 02 15:04:05 2006 -0700", "Thu May 04 00:03:43 2017 +0200")
+	tagger := &CommitAuthor{
+		Name:  String("go-github"),
+		Email: String("go-github@github.com"),
+		Date:  &date,
+	}
+	input := &Tag{
+		Tag:     String("v1.0.0"),
+		Message: String("Release v1.0.0"),
+		Tagger:  tagger,
+		Object:  &GitObject{SHA: String("s"), Type: String("commit")},
+		Signer:  OpenPGPSigner(keyring[0]),
+	}
+
+	mux.HandleFunc("/repos/o/r/git/tags", func(w http.ResponseWriter, r *http.Request) {
+		v := new(createTagRequest)
+		json.NewDecoder(r.Body).Decode(v)
+
+		testMethod(t, r, "POST")
+		message := v.Message
+		if message == nil || !strings.HasPrefix(*message, "Release v1.0.0\n-----BEGIN PGP SIGNATURE-----") {
+			t.Fatalf("Request message = %v, want signed message", message)
+		}
+
+		payload := strings.NewReader(`object s
+type commit
+tag v1.0.0
+tagger go-github <go-github@github.com> 1493849023 +0200
+
+Release v1.0.0
+`)
+		signature := strings.NewReader(strings.TrimPrefix(*message, "Release v1.0.0\n"))
+		if _, err := openpgp.CheckArmoredDetachedSignature(keyring, payload, signature); err != nil {
+			t.Errorf("Error verifying signature: %+v", err)
+		}
+
+		v.Message = nil
+		want := &createTagRequest{Tag: String("v1.0.0"), Object: String("s"), Type: String("commit"), Tagger: tagger}
+		if !reflect.DeepEqual(v, want) {
+			t.Errorf("Request body = %+v, want %+v", v, want)
+		}
+
+		fmt.Fprint(w, `{"tag":"v1.0.0","verification":{"verified":true,"reason":"valid"}}`)
+	})
+
+	ctx := context.Background()
+	tag, _, err := client.Git.CreateTag(ctx, "o", "r", input)
+	if err != nil {
+		t.Errorf("Git.CreateTag returned error: %v", err)
+	}
+	if got := tag.GetVerification().VerificationReason(); got != SignatureValid {
+		t.Errorf("Git.CreateTag returned verification reason %q, want %q", got, SignatureValid)
+	}
+
+	input.Tagger = &CommitAuthor{Name: String("go-github")}
+	if _, _, err := client.Git.CreateTag(ctx, "o", "r", input); err == nil {
+		t.Error("Git.CreateTag without tagger date returned nil error")
+	}
+}
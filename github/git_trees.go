@@ -114,6 +114,71 @@ func (s *GitService) GetTree(ctx context.Context, owner string, repo string, sha
 	return t, resp, nil
 }
 
+// GetFullTree fetches the complete recursive listing of the Tree object for a
+// given sha hash from a repository.
+//
+// GitHub limits the number of entries returned by a recursive GetTree request
+// and sets Tree.Truncated when that limit is exceeded. When that happens,
+// GetFullTree lists the tree non-recursively and walks each of its subtrees in
+// turn, so that the returned Tree contains every entry. Paths of the returned
+// entries are relative to the root tree, as they are for a recursive GetTree.
+// The returned Response is the one for the last request made.
+//
+// GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/git/#get-a-tree
+func (s *GitService) GetFullTree(ctx context.Context, owner string, repo string, sha string) (*Tree, *Response, error) {
+	t := &Tree{SHA: String(sha), Truncated: Bool(false)}
+	resp, err := s.walkTree(ctx, owner, repo, sha, "", t)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return t, resp, nil
+}
+
+// walkTree appends all entries of the tree identified by sha to t, prefixing
+// their paths with prefix.
+func (s *GitService) walkTree(ctx context.Context, owner, repo, sha, prefix string, t *Tree) (*Response, error) {
+	tree, resp, err := s.GetTree(ctx, owner, repo, sha, true)
+	if err != nil {
+		return resp, err
+	}
+	if !tree.GetTruncated() {
+		t.Entries = append(t.Entries, prefixTreeEntries(prefix, tree.Entries)...)
+		return resp, nil
+	}
+
+	tree, resp, err = s.GetTree(ctx, owner, repo, sha, false)
+	if err != nil {
+		return resp, err
+	}
+	if tree.GetTruncated() {
+		return resp, fmt.Errorf("tree %v has too many entries to be listed", sha)
+	}
+
+	for _, entry := range prefixTreeEntries(prefix, tree.Entries) {
+		t.Entries = append(t.Entries, entry)
+		if entry.GetType() != "tree" {
+			continue
+		}
+		if resp, err = s.walkTree(ctx, owner, repo, entry.GetSHA(), entry.GetPath()+"/", t); err != nil {
+			return resp, err
+		}
+	}
+
+	return resp, nil
+}
+
+// prefixTreeEntries prepends prefix to the path of each entry.
+func prefixTreeEntries(prefix string, entries []*TreeEntry) []*TreeEntry {
+	if prefix == "" {
+		return entries
+	}
+	for _, entry := range entries {
+		entry.Path = String(prefix + entry.GetPath())
+	}
+	return entries
+}
+
 // createTree represents the body of a CreateTree request.
 type createTree struct {
 	BaseTree string        `json:"base_tree,omitempty"`
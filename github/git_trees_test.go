@@ -91,6 +91,121 @@ func TestGitService_GetTree_invalidOwner(t *testing.T) {
 	testURLParseError(t, err)
 }
 
+func TestGitService_GetFullTree(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/git/trees/s", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		if r.URL.Query().Get("recursive") != "" {
+			fmt.Fprint(w, `{"sha": "s", "tree": [ { "path": "a", "type": "blob" } ], "truncated": true}`)
+			return
+		}
+		fmt.Fprint(w, `{
+			  "sha": "s",
+			  "tree": [ { "path": "a", "type": "blob", "sha": "a" }, { "path": "d", "type": "tree", "sha": "d" } ],
+			  "truncated": false
+			}`)
+	})
+	mux.HandleFunc("/repos/o/r/git/trees/d", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testFormValues(t, r, values{"recursive": "1"})
+		fmt.Fprint(w, `{
+			  "sha": "d",
+			  "tree": [ { "path": "x", "type": "blob", "sha": "x" }, { "path": "e", "type": "tree", "sha": "e" }, { "path": "e/y", "type": "blob", "sha": "y" } ],
+			  "truncated": false
+			}`)
+	})
+
+	ctx := context.Background()
+	tree, _, err := client.Git.GetFullTree(ctx, "o", "r", "s")
+	if err != nil {
+		t.Errorf("Git.GetFullTree returned error: %v", err)
+	}
+
+	want := &Tree{
+		SHA: String("s"),
+		Entries: []*TreeEntry{
+			{Path: String("a"), Type: String("blob"), SHA: String("a")},
+			{Path: String("d"), Type: String("tree"), SHA: String("d")},
+			{Path: String("d/x"), Type: String("blob"), SHA: String("x")},
+			{Path: String("d/e"), Type: String("tree"), SHA: String("e")},
+			{Path: String("d/e/y"), Type: String("blob"), SHA: String("y")},
+		},
+		Truncated: Bool(false),
+	}
+	if !reflect.DeepEqual(tree, want) {
+		t.Errorf("Git.GetFullTree returned %+v, want %+v", tree, want)
+	}
+
+	const methodName = "GetFullTree"
+	testBadOptions(t, methodName, func() (err error) {
+		_, _, err = client.Git.GetFullTree(ctx, "\n", "\n", "\n")
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.Git.GetFullTree(ctx, "o", "r", "s")
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
+
+func TestGitService_GetFullTree_notTruncated(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	var requests int
+	mux.HandleFunc("/repos/o/r/git/trees/s", func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		testMethod(t, r, "GET")
+		testFormValues(t, r, values{"recursive": "1"})
+		fmt.Fprint(w, `{"sha": "s", "tree": [ { "path": "d/a", "type": "blob" } ], "truncated": false}`)
+	})
+
+	ctx := context.Background()
+	tree, _, err := client.Git.GetFullTree(ctx, "o", "r", "s")
+	if err != nil {
+		t.Errorf("Git.GetFullTree returned error: %v", err)
+	}
+
+	want := &Tree{
+		SHA:       String("s"),
+		Entries:   []*TreeEntry{{Path: String("d/a"), Type: String("blob")}},
+		Truncated: Bool(false),
+	}
+	if !reflect.DeepEqual(tree, want) {
+		t.Errorf("Git.GetFullTree returned %+v, want %+v", tree, want)
+	}
+	if requests != 1 {
+		t.Errorf("Git.GetFullTree made %v requests, want 1", requests)
+	}
+}
+
+func TestGitService_GetFullTree_tooManyEntries(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/git/trees/s", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"sha": "s", "tree": [ { "path": "a", "type": "blob" } ], "truncated": true}`)
+	})
+
+	ctx := context.Background()
+	tree, resp, err := client.Git.GetFullTree(ctx, "o", "r", "s")
+	if err == nil {
+		t.Error("Git.GetFullTree returned nil error, want error")
+	}
+	if tree != nil {
+		t.Errorf("Git.GetFullTree returned %+v, want nil", tree)
+	}
+	if resp == nil {
+		t.Error("Git.GetFullTree returned nil response, want non-nil")
+	}
+}
+
 func TestGitService_CreateTree(t *testing.T) {
 	client, mux, _, teardown := setup()
 	defer teardown()
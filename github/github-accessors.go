@@ -20,6 +20,30 @@ func (a *AbuseRateLimitError) GetRetryAfter() time.Duration {
 	return *a.RetryAfter
 }
 
+// GetStarredAt returns the StarredAt field if it's non-nil, zero value otherwise.
+func (a *ActivityListStargazersOptions) GetStarredAt() bool {
+	if a == nil || a.StarredAt == nil {
+		return false
+	}
+	return *a.StarredAt
+}
+
+// GetStarredAt returns the StarredAt field if it's non-nil, zero value otherwise.
+func (a *ActivityListStarredOptions) GetStarredAt() bool {
+	if a == nil || a.StarredAt == nil {
+		return false
+	}
+	return *a.StarredAt
+}
+
+// GetCountryCode returns the CountryCode field if it's non-nil, zero value otherwise.
+func (a *ActorLocation) GetCountryCode() string {
+	if a == nil || a.CountryCode == nil {
+		return ""
+	}
+	return *a.CountryCode
+}
+
 // GetURL returns the URL field if it's non-nil, zero value otherwise.
 func (a *AdminEnforcement) GetURL() string {
 	if a == nil || a.URL == nil {
@@ -108,6 +132,118 @@ func (a *AdminStats) GetUsers() *UserStats {
 	return a.Users
 }
 
+// GetScore returns the Score field.
+func (a *AdvisoryCVSS) GetScore() *float64 {
+	if a == nil {
+		return nil
+	}
+	return a.Score
+}
+
+// GetVectorString returns the VectorString field if it's non-nil, zero value otherwise.
+func (a *AdvisoryCVSS) GetVectorString() string {
+	if a == nil || a.VectorString == nil {
+		return ""
+	}
+	return *a.VectorString
+}
+
+// GetCWEID returns the CWEID field if it's non-nil, zero value otherwise.
+func (a *AdvisoryCWE) GetCWEID() string {
+	if a == nil || a.CWEID == nil {
+		return ""
+	}
+	return *a.CWEID
+}
+
+// GetName returns the Name field if it's non-nil, zero value otherwise.
+func (a *AdvisoryCWE) GetName() string {
+	if a == nil || a.Name == nil {
+		return ""
+	}
+	return *a.Name
+}
+
+// GetPercentage returns the Percentage field.
+func (a *AdvisoryEPSS) GetPercentage() *float64 {
+	if a == nil {
+		return nil
+	}
+	return a.Percentage
+}
+
+// GetPercentile returns the Percentile field.
+func (a *AdvisoryEPSS) GetPercentile() *float64 {
+	if a == nil {
+		return nil
+	}
+	return a.Percentile
+}
+
+// GetType returns the Type field if it's non-nil, zero value otherwise.
+func (a *AdvisoryIdentifier) GetType() string {
+	if a == nil || a.Type == nil {
+		return ""
+	}
+	return *a.Type
+}
+
+// GetValue returns the Value field if it's non-nil, zero value otherwise.
+func (a *AdvisoryIdentifier) GetValue() string {
+	if a == nil || a.Value == nil {
+		return ""
+	}
+	return *a.Value
+}
+
+// GetURL returns the URL field if it's non-nil, zero value otherwise.
+func (a *AdvisoryReference) GetURL() string {
+	if a == nil || a.URL == nil {
+		return ""
+	}
+	return *a.URL
+}
+
+// GetFirstPatchedVersion returns the FirstPatchedVersion field.
+func (a *AdvisoryVulnerability) GetFirstPatchedVersion() *FirstPatchedVersion {
+	if a == nil {
+		return nil
+	}
+	return a.FirstPatchedVersion
+}
+
+// GetPackage returns the Package field.
+func (a *AdvisoryVulnerability) GetPackage() *VulnerabilityPackage {
+	if a == nil {
+		return nil
+	}
+	return a.Package
+}
+
+// GetPatchedVersions returns the PatchedVersions field if it's non-nil, zero value otherwise.
+func (a *AdvisoryVulnerability) GetPatchedVersions() string {
+	if a == nil || a.PatchedVersions == nil {
+		return ""
+	}
+	return *a.PatchedVersions
+}
+
+// GetSeverity returns the Severity field if it's non-nil, zero value otherwise.
+func (a *AdvisoryVulnerability) GetSeverity() string {
+	if a == nil || a.Severity == nil {
+		return ""
+	}
+	return *a.Severity
+}
+
+// GetVulnerableVersionRange returns the VulnerableVersionRange field if it's non-nil, zero value otherwise.
+func (a *AdvisoryVulnerability) GetVulnerableVersionRange() string {
+	if a == nil || a.VulnerableVersionRange == nil {
+		return ""
+	}
+	return *a.VulnerableVersionRange
+}
+
 // GetClosedAt returns the ClosedAt field if it's non-nil, zero value otherwise.
 func (a *Alert) GetClosedAt() Timestamp {
 	if a == nil || a.ClosedAt == nil {
@@ -188,6 +324,38 @@ func (a *Alert) GetURL() string {
 	return *a.URL
 }
 
+// GetAnnouncement returns the Announcement field if it's non-nil, zero value otherwise.
+func (a *AnnouncementBanner) GetAnnouncement() string {
+	if a == nil || a.Announcement == nil {
+		return ""
+	}
+	return *a.Announcement
+}
+
+// GetExpiresAt returns the ExpiresAt field if it's non-nil, zero value otherwise.
+func (a *AnnouncementBanner) GetExpiresAt() Timestamp {
+	if a == nil || a.ExpiresAt == nil {
+		return Timestamp{}
+	}
+	return *a.ExpiresAt
+}
+
+// GetUserDismissible returns the UserDismissible field if it's non-nil, zero value otherwise.
+func (a *AnnouncementBanner) GetUserDismissible() bool {
+	if a == nil || a.UserDismissible == nil {
+		return false
+	}
+	return *a.UserDismissible
+}
+
+// GetInstalledVersion returns the InstalledVersion field if it's non-nil, zero value otherwise.
+func (a *APIMeta) GetInstalledVersion() string {
+	if a == nil || a.InstalledVersion == nil {
+		return ""
+	}
+	return *a.InstalledVersion
+}
+
 // GetVerifiablePasswordAuthentication returns the VerifiablePasswordAuthentication field if it's non-nil, zero value otherwise.
 func (a *APIMeta) GetVerifiablePasswordAuthentication() bool {
 	if a == nil || a.VerifiablePasswordAuthentication == nil {
@@ -372,6 +540,22 @@ func (a *AppConfig) GetPEM() string {
 	return *a.PEM
 }
 
+// GetPermissions returns the Permissions field.
+func (a *AppConfig) GetPermissions() *InstallationPermissions {
+	if a == nil {
+		return nil
+	}
+	return a.Permissions
+}
+
+// GetSlug returns the Slug field if it's non-nil, zero value otherwise.
+func (a *AppConfig) GetSlug() string {
+	if a == nil || a.Slug == nil {
+		return ""
+	}
+	return *a.Slug
+}
+
 // GetUpdatedAt returns the UpdatedAt field if it's non-nil, zero value otherwise.
 func (a *AppConfig) GetUpdatedAt() Timestamp {
 	if a == nil || a.UpdatedAt == nil {
@@ -388,6 +572,78 @@ func (a *AppConfig) GetWebhookSecret() string {
 	return *a.WebhookSecret
 }
 
+// GetDefaultPermissions returns the DefaultPermissions field.
+func (a *AppManifest) GetDefaultPermissions() *InstallationPermissions {
+	if a == nil {
+		return nil
+	}
+	return a.DefaultPermissions
+}
+
+// GetDescription returns the Description field if it's non-nil, zero value otherwise.
+func (a *AppManifest) GetDescription() string {
+	if a == nil || a.Description == nil {
+		return ""
+	}
+	return *a.Description
+}
+
+// GetHookAttributes returns the HookAttributes field.
+func (a *AppManifest) GetHookAttributes() *AppManifestHookAttributes {
+	if a == nil {
+		return nil
+	}
+	return a.HookAttributes
+}
+
+// GetName returns the Name field if it's non-nil, zero value otherwise.
+func (a *AppManifest) GetName() string {
+	if a == nil || a.Name == nil {
+		return ""
+	}
+	return *a.Name
+}
+
+// GetPublic returns the Public field if it's non-nil, zero value otherwise.
+func (a *AppManifest) GetPublic() bool {
+	if a == nil || a.Public == nil {
+		return false
+	}
+	return *a.Public
+}
+
+// GetRedirectURL returns the RedirectURL field if it's non-nil, zero value otherwise.
+func (a *AppManifest) GetRedirectURL() string {
+	if a == nil || a.RedirectURL == nil {
+		return ""
+	}
+	return *a.RedirectURL
+}
+
+// GetURL returns the URL field if it's non-nil, zero value otherwise.
+func (a *AppManifest) GetURL() string {
+	if a == nil || a.URL == nil {
+		return ""
+	}
+	return *a.URL
+}
+
+// GetActive returns the Active field if it's non-nil, zero value otherwise.
+func (a *AppManifestHookAttributes) GetActive() bool {
+	if a == nil || a.Active == nil {
+		return false
+	}
+	return *a.Active
+}
+
+// GetURL returns the URL field if it's non-nil, zero value otherwise.
+func (a *AppManifestHookAttributes) GetURL() string {
+	if a == nil || a.URL == nil {
+		return ""
+	}
+	return *a.URL
+}
+
 // GetArchiveDownloadURL returns the ArchiveDownloadURL field if it's non-nil, zero value otherwise.
 func (a *Artifact) GetArchiveDownloadURL() string {
 	if a == nil || a.ArchiveDownloadURL == nil {
@@ -460,1696 +716,1640 @@ func (a *ArtifactList) GetTotalCount() int64 {
 	return *a.TotalCount
 }
 
-// GetBody returns the Body field if it's non-nil, zero value otherwise.
-func (a *Attachment) GetBody() string {
-	if a == nil || a.Body == nil {
+// GetEmail returns the Email field if it's non-nil, zero value otherwise.
+func (a *AtomAuthor) GetEmail() string {
+	if a == nil || a.Email == nil {
 		return ""
 	}
-	return *a.Body
+	return *a.Email
 }
 
-// GetID returns the ID field if it's non-nil, zero value otherwise.
-func (a *Attachment) GetID() int64 {
-	if a == nil || a.ID == nil {
-		return 0
+// GetName returns the Name field if it's non-nil, zero value otherwise.
+func (a *AtomAuthor) GetName() string {
+	if a == nil || a.Name == nil {
+		return ""
 	}
-	return *a.ID
+	return *a.Name
 }
 
-// GetTitle returns the Title field if it's non-nil, zero value otherwise.
-func (a *Attachment) GetTitle() string {
-	if a == nil || a.Title == nil {
+// GetURI returns the URI field if it's non-nil, zero value otherwise.
+func (a *AtomAuthor) GetURI() string {
+	if a == nil || a.URI == nil {
 		return ""
 	}
-	return *a.Title
+	return *a.URI
 }
 
-// GetApp returns the App field.
-func (a *Authorization) GetApp() *AuthorizationApp {
-	if a == nil {
-		return nil
+// GetBody returns the Body field if it's non-nil, zero value otherwise.
+func (a *AtomContent) GetBody() string {
+	if a == nil || a.Body == nil {
+		return ""
 	}
-	return a.App
+	return *a.Body
 }
 
-// GetCreatedAt returns the CreatedAt field if it's non-nil, zero value otherwise.
-func (a *Authorization) GetCreatedAt() Timestamp {
-	if a == nil || a.CreatedAt == nil {
-		return Timestamp{}
+// GetType returns the Type field if it's non-nil, zero value otherwise.
+func (a *AtomContent) GetType() string {
+	if a == nil || a.Type == nil {
+		return ""
 	}
-	return *a.CreatedAt
+	return *a.Type
 }
 
-// GetFingerprint returns the Fingerprint field if it's non-nil, zero value otherwise.
-func (a *Authorization) GetFingerprint() string {
-	if a == nil || a.Fingerprint == nil {
-		return ""
+// GetAuthor returns the Author field.
+func (a *AtomEntry) GetAuthor() *AtomAuthor {
+	if a == nil {
+		return nil
 	}
-	return *a.Fingerprint
+	return a.Author
 }
 
-// GetHashedToken returns the HashedToken field if it's non-nil, zero value otherwise.
-func (a *Authorization) GetHashedToken() string {
-	if a == nil || a.HashedToken == nil {
-		return ""
+// GetContent returns the Content field.
+func (a *AtomEntry) GetContent() *AtomContent {
+	if a == nil {
+		return nil
 	}
-	return *a.HashedToken
+	return a.Content
 }
 
 // GetID returns the ID field if it's non-nil, zero value otherwise.
-func (a *Authorization) GetID() int64 {
+func (a *AtomEntry) GetID() string {
 	if a == nil || a.ID == nil {
-		return 0
+		return ""
 	}
 	return *a.ID
 }
 
-// GetNote returns the Note field if it's non-nil, zero value otherwise.
-func (a *Authorization) GetNote() string {
-	if a == nil || a.Note == nil {
-		return ""
+// GetPublished returns the Published field if it's non-nil, zero value otherwise.
+func (a *AtomEntry) GetPublished() time.Time {
+	if a == nil || a.Published == nil {
+		return time.Time{}
 	}
-	return *a.Note
+	return *a.Published
 }
 
-// GetNoteURL returns the NoteURL field if it's non-nil, zero value otherwise.
-func (a *Authorization) GetNoteURL() string {
-	if a == nil || a.NoteURL == nil {
-		return ""
+// GetThumbnail returns the Thumbnail field.
+func (a *AtomEntry) GetThumbnail() *AtomThumbnail {
+	if a == nil {
+		return nil
 	}
-	return *a.NoteURL
+	return a.Thumbnail
 }
 
-// GetToken returns the Token field if it's non-nil, zero value otherwise.
-func (a *Authorization) GetToken() string {
-	if a == nil || a.Token == nil {
+// GetTitle returns the Title field if it's non-nil, zero value otherwise.
+func (a *AtomEntry) GetTitle() string {
+	if a == nil || a.Title == nil {
 		return ""
 	}
-	return *a.Token
+	return *a.Title
 }
 
-// GetTokenLastEight returns the TokenLastEight field if it's non-nil, zero value otherwise.
-func (a *Authorization) GetTokenLastEight() string {
-	if a == nil || a.TokenLastEight == nil {
-		return ""
+// GetUpdated returns the Updated field if it's non-nil, zero value otherwise.
+func (a *AtomEntry) GetUpdated() time.Time {
+	if a == nil || a.Updated == nil {
+		return time.Time{}
 	}
-	return *a.TokenLastEight
+	return *a.Updated
 }
 
-// GetUpdatedAt returns the UpdatedAt field if it's non-nil, zero value otherwise.
-func (a *Authorization) GetUpdatedAt() Timestamp {
-	if a == nil || a.UpdatedAt == nil {
-		return Timestamp{}
+// GetID returns the ID field if it's non-nil, zero value otherwise.
+func (a *AtomFeed) GetID() string {
+	if a == nil || a.ID == nil {
+		return ""
 	}
-	return *a.UpdatedAt
+	return *a.ID
 }
 
-// GetURL returns the URL field if it's non-nil, zero value otherwise.
-func (a *Authorization) GetURL() string {
-	if a == nil || a.URL == nil {
+// GetTitle returns the Title field if it's non-nil, zero value otherwise.
+func (a *AtomFeed) GetTitle() string {
+	if a == nil || a.Title == nil {
 		return ""
 	}
-	return *a.URL
+	return *a.Title
 }
 
-// GetUser returns the User field.
-func (a *Authorization) GetUser() *User {
-	if a == nil {
-		return nil
+// GetUpdated returns the Updated field if it's non-nil, zero value otherwise.
+func (a *AtomFeed) GetUpdated() time.Time {
+	if a == nil || a.Updated == nil {
+		return time.Time{}
 	}
-	return a.User
+	return *a.Updated
 }
 
-// GetClientID returns the ClientID field if it's non-nil, zero value otherwise.
-func (a *AuthorizationApp) GetClientID() string {
-	if a == nil || a.ClientID == nil {
+// GetHRef returns the HRef field if it's non-nil, zero value otherwise.
+func (a *AtomLink) GetHRef() string {
+	if a == nil || a.HRef == nil {
 		return ""
 	}
-	return *a.ClientID
+	return *a.HRef
 }
 
-// GetName returns the Name field if it's non-nil, zero value otherwise.
-func (a *AuthorizationApp) GetName() string {
-	if a == nil || a.Name == nil {
+// GetRel returns the Rel field if it's non-nil, zero value otherwise.
+func (a *AtomLink) GetRel() string {
+	if a == nil || a.Rel == nil {
 		return ""
 	}
-	return *a.Name
+	return *a.Rel
 }
 
-// GetURL returns the URL field if it's non-nil, zero value otherwise.
-func (a *AuthorizationApp) GetURL() string {
-	if a == nil || a.URL == nil {
+// GetType returns the Type field if it's non-nil, zero value otherwise.
+func (a *AtomLink) GetType() string {
+	if a == nil || a.Type == nil {
 		return ""
 	}
-	return *a.URL
+	return *a.Type
 }
 
-// GetClientID returns the ClientID field if it's non-nil, zero value otherwise.
-func (a *AuthorizationRequest) GetClientID() string {
-	if a == nil || a.ClientID == nil {
-		return ""
+// GetHeight returns the Height field if it's non-nil, zero value otherwise.
+func (a *AtomThumbnail) GetHeight() int {
+	if a == nil || a.Height == nil {
+		return 0
 	}
-	return *a.ClientID
+	return *a.Height
 }
 
-// GetClientSecret returns the ClientSecret field if it's non-nil, zero value otherwise.
-func (a *AuthorizationRequest) GetClientSecret() string {
-	if a == nil || a.ClientSecret == nil {
+// GetURL returns the URL field if it's non-nil, zero value otherwise.
+func (a *AtomThumbnail) GetURL() string {
+	if a == nil || a.URL == nil {
 		return ""
 	}
-	return *a.ClientSecret
+	return *a.URL
 }
 
-// GetFingerprint returns the Fingerprint field if it's non-nil, zero value otherwise.
-func (a *AuthorizationRequest) GetFingerprint() string {
-	if a == nil || a.Fingerprint == nil {
-		return ""
+// GetWidth returns the Width field if it's non-nil, zero value otherwise.
+func (a *AtomThumbnail) GetWidth() int {
+	if a == nil || a.Width == nil {
+		return 0
 	}
-	return *a.Fingerprint
+	return *a.Width
 }
 
-// GetNote returns the Note field if it's non-nil, zero value otherwise.
-func (a *AuthorizationRequest) GetNote() string {
-	if a == nil || a.Note == nil {
+// GetBody returns the Body field if it's non-nil, zero value otherwise.
+func (a *Attachment) GetBody() string {
+	if a == nil || a.Body == nil {
 		return ""
 	}
-	return *a.Note
+	return *a.Body
 }
 
-// GetNoteURL returns the NoteURL field if it's non-nil, zero value otherwise.
-func (a *AuthorizationRequest) GetNoteURL() string {
-	if a == nil || a.NoteURL == nil {
-		return ""
+// GetID returns the ID field if it's non-nil, zero value otherwise.
+func (a *Attachment) GetID() int64 {
+	if a == nil || a.ID == nil {
+		return 0
 	}
-	return *a.NoteURL
+	return *a.ID
 }
 
-// GetFingerprint returns the Fingerprint field if it's non-nil, zero value otherwise.
-func (a *AuthorizationUpdateRequest) GetFingerprint() string {
-	if a == nil || a.Fingerprint == nil {
+// GetTitle returns the Title field if it's non-nil, zero value otherwise.
+func (a *Attachment) GetTitle() string {
+	if a == nil || a.Title == nil {
 		return ""
 	}
-	return *a.Fingerprint
+	return *a.Title
 }
 
-// GetNote returns the Note field if it's non-nil, zero value otherwise.
-func (a *AuthorizationUpdateRequest) GetNote() string {
-	if a == nil || a.Note == nil {
+// GetRepositoryID returns the RepositoryID field if it's non-nil, zero value otherwise.
+func (a *Attestation) GetRepositoryID() int64 {
+	if a == nil || a.RepositoryID == nil {
+		return 0
+	}
+	return *a.RepositoryID
+}
+
+// GetAction returns the Action field if it's non-nil, zero value otherwise.
+func (a *AuditEntry) GetAction() string {
+	if a == nil || a.Action == nil {
 		return ""
 	}
-	return *a.Note
+	return *a.Action
 }
 
-// GetNoteURL returns the NoteURL field if it's non-nil, zero value otherwise.
-func (a *AuthorizationUpdateRequest) GetNoteURL() string {
-	if a == nil || a.NoteURL == nil {
+// GetActor returns the Actor field if it's non-nil, zero value otherwise.
+func (a *AuditEntry) GetActor() string {
+	if a == nil || a.Actor == nil {
 		return ""
 	}
-	return *a.NoteURL
+	return *a.Actor
 }
 
-// GetAppID returns the AppID field if it's non-nil, zero value otherwise.
-func (a *AutoTriggerCheck) GetAppID() int64 {
-	if a == nil || a.AppID == nil {
+// GetActorID returns the ActorID field if it's non-nil, zero value otherwise.
+func (a *AuditEntry) GetActorID() int64 {
+	if a == nil || a.ActorID == nil {
 		return 0
 	}
-	return *a.AppID
+	return *a.ActorID
 }
 
-// GetSetting returns the Setting field if it's non-nil, zero value otherwise.
-func (a *AutoTriggerCheck) GetSetting() bool {
-	if a == nil || a.Setting == nil {
-		return false
+// GetActorLocation returns the ActorLocation field.
+func (a *AuditEntry) GetActorLocation() *ActorLocation {
+	if a == nil {
+		return nil
 	}
-	return *a.Setting
+	return a.ActorLocation
 }
 
-// GetContent returns the Content field if it's non-nil, zero value otherwise.
-func (b *Blob) GetContent() string {
-	if b == nil || b.Content == nil {
+// GetBusiness returns the Business field if it's non-nil, zero value otherwise.
+func (a *AuditEntry) GetBusiness() string {
+	if a == nil || a.Business == nil {
 		return ""
 	}
-	return *b.Content
+	return *a.Business
 }
 
-// GetEncoding returns the Encoding field if it's non-nil, zero value otherwise.
-func (b *Blob) GetEncoding() string {
-	if b == nil || b.Encoding == nil {
-		return ""
+// GetBusinessID returns the BusinessID field if it's non-nil, zero value otherwise.
+func (a *AuditEntry) GetBusinessID() int64 {
+	if a == nil || a.BusinessID == nil {
+		return 0
 	}
-	return *b.Encoding
+	return *a.BusinessID
 }
 
-// GetNodeID returns the NodeID field if it's non-nil, zero value otherwise.
-func (b *Blob) GetNodeID() string {
-	if b == nil || b.NodeID == nil {
-		return ""
+// GetCreatedAt returns the CreatedAt field if it's non-nil, zero value otherwise.
+func (a *AuditEntry) GetCreatedAt() Timestamp {
+	if a == nil || a.CreatedAt == nil {
+		return Timestamp{}
 	}
-	return *b.NodeID
+	return *a.CreatedAt
 }
 
-// GetSHA returns the SHA field if it's non-nil, zero value otherwise.
-func (b *Blob) GetSHA() string {
-	if b == nil || b.SHA == nil {
+// GetDocumentID returns the DocumentID field if it's non-nil, zero value otherwise.
+func (a *AuditEntry) GetDocumentID() string {
+	if a == nil || a.DocumentID == nil {
 		return ""
 	}
-	return *b.SHA
+	return *a.DocumentID
 }
 
-// GetSize returns the Size field if it's non-nil, zero value otherwise.
-func (b *Blob) GetSize() int {
-	if b == nil || b.Size == nil {
-		return 0
+// GetExternalIdentityNameID returns the ExternalIdentityNameID field if it's non-nil, zero value otherwise.
+func (a *AuditEntry) GetExternalIdentityNameID() string {
+	if a == nil || a.ExternalIdentityNameID == nil {
+		return ""
 	}
-	return *b.Size
+	return *a.ExternalIdentityNameID
 }
 
-// GetURL returns the URL field if it's non-nil, zero value otherwise.
-func (b *Blob) GetURL() string {
-	if b == nil || b.URL == nil {
+// GetExternalIdentityUsername returns the ExternalIdentityUsername field if it's non-nil, zero value otherwise.
+func (a *AuditEntry) GetExternalIdentityUsername() string {
+	if a == nil || a.ExternalIdentityUsername == nil {
 		return ""
 	}
-	return *b.URL
+	return *a.ExternalIdentityUsername
 }
 
-// GetCommit returns the Commit field.
-func (b *Branch) GetCommit() *RepositoryCommit {
-	if b == nil {
-		return nil
+// GetHashedToken returns the HashedToken field if it's non-nil, zero value otherwise.
+func (a *AuditEntry) GetHashedToken() string {
+	if a == nil || a.HashedToken == nil {
+		return ""
 	}
-	return b.Commit
+	return *a.HashedToken
 }
 
-// GetName returns the Name field if it's non-nil, zero value otherwise.
-func (b *Branch) GetName() string {
-	if b == nil || b.Name == nil {
+// GetOrg returns the Org field if it's non-nil, zero value otherwise.
+func (a *AuditEntry) GetOrg() string {
+	if a == nil || a.Org == nil {
 		return ""
 	}
-	return *b.Name
+	return *a.Org
 }
 
-// GetProtected returns the Protected field if it's non-nil, zero value otherwise.
-func (b *Branch) GetProtected() bool {
-	if b == nil || b.Protected == nil {
-		return false
+// GetOrgID returns the OrgID field if it's non-nil, zero value otherwise.
+func (a *AuditEntry) GetOrgID() int64 {
+	if a == nil || a.OrgID == nil {
+		return 0
 	}
-	return *b.Protected
+	return *a.OrgID
 }
 
-// GetCommit returns the Commit field.
-func (b *BranchCommit) GetCommit() *Commit {
-	if b == nil {
-		return nil
+// GetRepo returns the Repo field if it's non-nil, zero value otherwise.
+func (a *AuditEntry) GetRepo() string {
+	if a == nil || a.Repo == nil {
+		return ""
 	}
-	return b.Commit
+	return *a.Repo
 }
 
-// GetName returns the Name field if it's non-nil, zero value otherwise.
-func (b *BranchCommit) GetName() string {
-	if b == nil || b.Name == nil {
+// GetTeam returns the Team field if it's non-nil, zero value otherwise.
+func (a *AuditEntry) GetTeam() string {
+	if a == nil || a.Team == nil {
 		return ""
 	}
-	return *b.Name
+	return *a.Team
 }
 
-// GetProtected returns the Protected field if it's non-nil, zero value otherwise.
-func (b *BranchCommit) GetProtected() bool {
-	if b == nil || b.Protected == nil {
-		return false
+// GetTimestamp returns the Timestamp field if it's non-nil, zero value otherwise.
+func (a *AuditEntry) GetTimestamp() Timestamp {
+	if a == nil || a.Timestamp == nil {
+		return Timestamp{}
 	}
-	return *b.Protected
+	return *a.Timestamp
 }
 
-// GetProtected returns the Protected field if it's non-nil, zero value otherwise.
-func (b *BranchListOptions) GetProtected() bool {
-	if b == nil || b.Protected == nil {
-		return false
+// GetTokenID returns the TokenID field if it's non-nil, zero value otherwise.
+func (a *AuditEntry) GetTokenID() int64 {
+	if a == nil || a.TokenID == nil {
+		return 0
 	}
-	return *b.Protected
+	return *a.TokenID
 }
 
-// GetApp returns the App field.
-func (c *CheckRun) GetApp() *App {
-	if c == nil {
-		return nil
+// GetTokenScopes returns the TokenScopes field if it's non-nil, zero value otherwise.
+func (a *AuditEntry) GetTokenScopes() string {
+	if a == nil || a.TokenScopes == nil {
+		return ""
 	}
-	return c.App
+	return *a.TokenScopes
 }
 
-// GetCheckSuite returns the CheckSuite field.
-func (c *CheckRun) GetCheckSuite() *CheckSuite {
-	if c == nil {
-		return nil
+// GetUser returns the User field if it's non-nil, zero value otherwise.
+func (a *AuditEntry) GetUser() string {
+	if a == nil || a.User == nil {
+		return ""
 	}
-	return c.CheckSuite
+	return *a.User
 }
 
-// GetCompletedAt returns the CompletedAt field if it's non-nil, zero value otherwise.
-func (c *CheckRun) GetCompletedAt() Timestamp {
-	if c == nil || c.CompletedAt == nil {
-		return Timestamp{}
+// GetUserID returns the UserID field if it's non-nil, zero value otherwise.
+func (a *AuditEntry) GetUserID() int64 {
+	if a == nil || a.UserID == nil {
+		return 0
 	}
-	return *c.CompletedAt
+	return *a.UserID
 }
 
-// GetConclusion returns the Conclusion field if it's non-nil, zero value otherwise.
-func (c *CheckRun) GetConclusion() string {
-	if c == nil || c.Conclusion == nil {
+// GetRepository returns the Repository field if it's non-nil, zero value otherwise.
+func (a *AuditEntryGitPayload) GetRepository() string {
+	if a == nil || a.Repository == nil {
 		return ""
 	}
-	return *c.Conclusion
+	return *a.Repository
 }
 
-// GetDetailsURL returns the DetailsURL field if it's non-nil, zero value otherwise.
-func (c *CheckRun) GetDetailsURL() string {
-	if c == nil || c.DetailsURL == nil {
-		return ""
+// GetRepositoryPublic returns the RepositoryPublic field if it's non-nil, zero value otherwise.
+func (a *AuditEntryGitPayload) GetRepositoryPublic() bool {
+	if a == nil || a.RepositoryPublic == nil {
+		return false
 	}
-	return *c.DetailsURL
+	return *a.RepositoryPublic
 }
 
-// GetExternalID returns the ExternalID field if it's non-nil, zero value otherwise.
-func (c *CheckRun) GetExternalID() string {
-	if c == nil || c.ExternalID == nil {
-		return ""
+// GetTransportProtocol returns the TransportProtocol field if it's non-nil, zero value otherwise.
+func (a *AuditEntryGitPayload) GetTransportProtocol() int {
+	if a == nil || a.TransportProtocol == nil {
+		return 0
 	}
-	return *c.ExternalID
+	return *a.TransportProtocol
 }
 
-// GetHeadSHA returns the HeadSHA field if it's non-nil, zero value otherwise.
-func (c *CheckRun) GetHeadSHA() string {
-	if c == nil || c.HeadSHA == nil {
+// GetTransportProtocolName returns the TransportProtocolName field if it's non-nil, zero value otherwise.
+func (a *AuditEntryGitPayload) GetTransportProtocolName() string {
+	if a == nil || a.TransportProtocolName == nil {
 		return ""
 	}
-	return *c.HeadSHA
+	return *a.TransportProtocolName
 }
 
-// GetHTMLURL returns the HTMLURL field if it's non-nil, zero value otherwise.
-func (c *CheckRun) GetHTMLURL() string {
-	if c == nil || c.HTMLURL == nil {
+// GetOldPermission returns the OldPermission field if it's non-nil, zero value otherwise.
+func (a *AuditEntryMemberPayload) GetOldPermission() string {
+	if a == nil || a.OldPermission == nil {
 		return ""
 	}
-	return *c.HTMLURL
+	return *a.OldPermission
 }
 
-// GetID returns the ID field if it's non-nil, zero value otherwise.
-func (c *CheckRun) GetID() int64 {
-	if c == nil || c.ID == nil {
-		return 0
+// GetPermission returns the Permission field if it's non-nil, zero value otherwise.
+func (a *AuditEntryMemberPayload) GetPermission() string {
+	if a == nil || a.Permission == nil {
+		return ""
 	}
-	return *c.ID
+	return *a.Permission
 }
 
-// GetName returns the Name field if it's non-nil, zero value otherwise.
-func (c *CheckRun) GetName() string {
-	if c == nil || c.Name == nil {
+// GetPreviousVisibility returns the PreviousVisibility field if it's non-nil, zero value otherwise.
+func (a *AuditEntryRepoPayload) GetPreviousVisibility() string {
+	if a == nil || a.PreviousVisibility == nil {
 		return ""
 	}
-	return *c.Name
+	return *a.PreviousVisibility
 }
 
-// GetNodeID returns the NodeID field if it's non-nil, zero value otherwise.
-func (c *CheckRun) GetNodeID() string {
-	if c == nil || c.NodeID == nil {
+// GetPublicRepo returns the PublicRepo field if it's non-nil, zero value otherwise.
+func (a *AuditEntryRepoPayload) GetPublicRepo() bool {
+	if a == nil || a.PublicRepo == nil {
+		return false
+	}
+	return *a.PublicRepo
+}
+
+// GetVisibility returns the Visibility field if it's non-nil, zero value otherwise.
+func (a *AuditEntryRepoPayload) GetVisibility() string {
+	if a == nil || a.Visibility == nil {
 		return ""
 	}
-	return *c.NodeID
+	return *a.Visibility
 }
 
-// GetOutput returns the Output field.
-func (c *CheckRun) GetOutput() *CheckRunOutput {
-	if c == nil {
+// GetApp returns the App field.
+func (a *Authorization) GetApp() *AuthorizationApp {
+	if a == nil {
 		return nil
 	}
-	return c.Output
+	return a.App
 }
 
-// GetStartedAt returns the StartedAt field if it's non-nil, zero value otherwise.
-func (c *CheckRun) GetStartedAt() Timestamp {
-	if c == nil || c.StartedAt == nil {
+// GetCreatedAt returns the CreatedAt field if it's non-nil, zero value otherwise.
+func (a *Authorization) GetCreatedAt() Timestamp {
+	if a == nil || a.CreatedAt == nil {
 		return Timestamp{}
 	}
-	return *c.StartedAt
+	return *a.CreatedAt
 }
 
-// GetStatus returns the Status field if it's non-nil, zero value otherwise.
-func (c *CheckRun) GetStatus() string {
-	if c == nil || c.Status == nil {
-		return ""
-	}
-	return *c.Status
+// GetExpiresAt returns the ExpiresAt field if it's non-nil, zero value otherwise.
+func (a *Authorization) GetExpiresAt() Timestamp {
+	if a == nil || a.ExpiresAt == nil {
+		return Timestamp{}
+	}
+	return *a.ExpiresAt
 }
 
-// GetURL returns the URL field if it's non-nil, zero value otherwise.
-func (c *CheckRun) GetURL() string {
-	if c == nil || c.URL == nil {
+// GetFingerprint returns the Fingerprint field if it's non-nil, zero value otherwise.
+func (a *Authorization) GetFingerprint() string {
+	if a == nil || a.Fingerprint == nil {
 		return ""
 	}
-	return *c.URL
+	return *a.Fingerprint
 }
 
-// GetAnnotationLevel returns the AnnotationLevel field if it's non-nil, zero value otherwise.
-func (c *CheckRunAnnotation) GetAnnotationLevel() string {
-	if c == nil || c.AnnotationLevel == nil {
+// GetHashedToken returns the HashedToken field if it's non-nil, zero value otherwise.
+func (a *Authorization) GetHashedToken() string {
+	if a == nil || a.HashedToken == nil {
 		return ""
 	}
-	return *c.AnnotationLevel
+	return *a.HashedToken
 }
 
-// GetEndColumn returns the EndColumn field if it's non-nil, zero value otherwise.
-func (c *CheckRunAnnotation) GetEndColumn() int {
-	if c == nil || c.EndColumn == nil {
+// GetID returns the ID field if it's non-nil, zero value otherwise.
+func (a *Authorization) GetID() int64 {
+	if a == nil || a.ID == nil {
 		return 0
 	}
-	return *c.EndColumn
+	return *a.ID
 }
 
-// GetEndLine returns the EndLine field if it's non-nil, zero value otherwise.
-func (c *CheckRunAnnotation) GetEndLine() int {
-	if c == nil || c.EndLine == nil {
-		return 0
+// GetInstallation returns the Installation field.
+func (a *Authorization) GetInstallation() *Installation {
+	if a == nil {
+		return nil
 	}
-	return *c.EndLine
+	return a.Installation
 }
 
-// GetMessage returns the Message field if it's non-nil, zero value otherwise.
-func (c *CheckRunAnnotation) GetMessage() string {
-	if c == nil || c.Message == nil {
+// GetNote returns the Note field if it's non-nil, zero value otherwise.
+func (a *Authorization) GetNote() string {
+	if a == nil || a.Note == nil {
 		return ""
 	}
-	return *c.Message
+	return *a.Note
 }
 
-// GetPath returns the Path field if it's non-nil, zero value otherwise.
-func (c *CheckRunAnnotation) GetPath() string {
-	if c == nil || c.Path == nil {
+// GetNoteURL returns the NoteURL field if it's non-nil, zero value otherwise.
+func (a *Authorization) GetNoteURL() string {
+	if a == nil || a.NoteURL == nil {
 		return ""
 	}
-	return *c.Path
+	return *a.NoteURL
 }
 
-// GetRawDetails returns the RawDetails field if it's non-nil, zero value otherwise.
-func (c *CheckRunAnnotation) GetRawDetails() string {
-	if c == nil || c.RawDetails == nil {
+// GetToken returns the Token field if it's non-nil, zero value otherwise.
+func (a *Authorization) GetToken() string {
+	if a == nil || a.Token == nil {
 		return ""
 	}
-	return *c.RawDetails
-}
-
-// GetStartColumn returns the StartColumn field if it's non-nil, zero value otherwise.
-func (c *CheckRunAnnotation) GetStartColumn() int {
-	if c == nil || c.StartColumn == nil {
-		return 0
-	}
-	return *c.StartColumn
+	return *a.Token
 }
 
-// GetStartLine returns the StartLine field if it's non-nil, zero value otherwise.
-func (c *CheckRunAnnotation) GetStartLine() int {
-	if c == nil || c.StartLine == nil {
-		return 0
+// GetTokenLastEight returns the TokenLastEight field if it's non-nil, zero value otherwise.
+func (a *Authorization) GetTokenLastEight() string {
+	if a == nil || a.TokenLastEight == nil {
+		return ""
 	}
-	return *c.StartLine
+	return *a.TokenLastEight
 }
 
-// GetTitle returns the Title field if it's non-nil, zero value otherwise.
-func (c *CheckRunAnnotation) GetTitle() string {
-	if c == nil || c.Title == nil {
-		return ""
+// GetUpdatedAt returns the UpdatedAt field if it's non-nil, zero value otherwise.
+func (a *Authorization) GetUpdatedAt() Timestamp {
+	if a == nil || a.UpdatedAt == nil {
+		return Timestamp{}
 	}
-	return *c.Title
+	return *a.UpdatedAt
 }
 
-// GetAction returns the Action field if it's non-nil, zero value otherwise.
-func (c *CheckRunEvent) GetAction() string {
-	if c == nil || c.Action == nil {
+// GetURL returns the URL field if it's non-nil, zero value otherwise.
+func (a *Authorization) GetURL() string {
+	if a == nil || a.URL == nil {
 		return ""
 	}
-	return *c.Action
+	return *a.URL
 }
 
-// GetCheckRun returns the CheckRun field.
-func (c *CheckRunEvent) GetCheckRun() *CheckRun {
-	if c == nil {
+// GetUser returns the User field.
+func (a *Authorization) GetUser() *User {
+	if a == nil {
 		return nil
 	}
-	return c.CheckRun
+	return a.User
 }
 
-// GetInstallation returns the Installation field.
-func (c *CheckRunEvent) GetInstallation() *Installation {
-	if c == nil {
-		return nil
+// GetClientID returns the ClientID field if it's non-nil, zero value otherwise.
+func (a *AuthorizationApp) GetClientID() string {
+	if a == nil || a.ClientID == nil {
+		return ""
 	}
-	return c.Installation
+	return *a.ClientID
 }
 
-// GetOrg returns the Org field.
-func (c *CheckRunEvent) GetOrg() *Organization {
-	if c == nil {
-		return nil
+// GetName returns the Name field if it's non-nil, zero value otherwise.
+func (a *AuthorizationApp) GetName() string {
+	if a == nil || a.Name == nil {
+		return ""
 	}
-	return c.Org
+	return *a.Name
 }
 
-// GetRepo returns the Repo field.
-func (c *CheckRunEvent) GetRepo() *Repository {
-	if c == nil {
-		return nil
+// GetURL returns the URL field if it's non-nil, zero value otherwise.
+func (a *AuthorizationApp) GetURL() string {
+	if a == nil || a.URL == nil {
+		return ""
 	}
-	return c.Repo
+	return *a.URL
 }
 
-// GetRequestedAction returns the RequestedAction field.
-func (c *CheckRunEvent) GetRequestedAction() *RequestedAction {
-	if c == nil {
-		return nil
+// GetClientID returns the ClientID field if it's non-nil, zero value otherwise.
+func (a *AuthorizationRequest) GetClientID() string {
+	if a == nil || a.ClientID == nil {
+		return ""
 	}
-	return c.RequestedAction
+	return *a.ClientID
 }
 
-// GetSender returns the Sender field.
-func (c *CheckRunEvent) GetSender() *User {
-	if c == nil {
-		return nil
+// GetClientSecret returns the ClientSecret field if it's non-nil, zero value otherwise.
+func (a *AuthorizationRequest) GetClientSecret() string {
+	if a == nil || a.ClientSecret == nil {
+		return ""
 	}
-	return c.Sender
+	return *a.ClientSecret
 }
 
-// GetAlt returns the Alt field if it's non-nil, zero value otherwise.
-func (c *CheckRunImage) GetAlt() string {
-	if c == nil || c.Alt == nil {
+// GetFingerprint returns the Fingerprint field if it's non-nil, zero value otherwise.
+func (a *AuthorizationRequest) GetFingerprint() string {
+	if a == nil || a.Fingerprint == nil {
 		return ""
 	}
-	return *c.Alt
+	return *a.Fingerprint
 }
 
-// GetCaption returns the Caption field if it's non-nil, zero value otherwise.
-func (c *CheckRunImage) GetCaption() string {
-	if c == nil || c.Caption == nil {
+// GetNote returns the Note field if it's non-nil, zero value otherwise.
+func (a *AuthorizationRequest) GetNote() string {
+	if a == nil || a.Note == nil {
 		return ""
 	}
-	return *c.Caption
+	return *a.Note
 }
 
-// GetImageURL returns the ImageURL field if it's non-nil, zero value otherwise.
-func (c *CheckRunImage) GetImageURL() string {
-	if c == nil || c.ImageURL == nil {
+// GetNoteURL returns the NoteURL field if it's non-nil, zero value otherwise.
+func (a *AuthorizationRequest) GetNoteURL() string {
+	if a == nil || a.NoteURL == nil {
 		return ""
 	}
-	return *c.ImageURL
+	return *a.NoteURL
 }
 
-// GetAnnotationsCount returns the AnnotationsCount field if it's non-nil, zero value otherwise.
-func (c *CheckRunOutput) GetAnnotationsCount() int {
-	if c == nil || c.AnnotationsCount == nil {
-		return 0
+// GetFingerprint returns the Fingerprint field if it's non-nil, zero value otherwise.
+func (a *AuthorizationUpdateRequest) GetFingerprint() string {
+	if a == nil || a.Fingerprint == nil {
+		return ""
 	}
-	return *c.AnnotationsCount
+	return *a.Fingerprint
 }
 
-// GetAnnotationsURL returns the AnnotationsURL field if it's non-nil, zero value otherwise.
-func (c *CheckRunOutput) GetAnnotationsURL() string {
-	if c == nil || c.AnnotationsURL == nil {
+// GetNote returns the Note field if it's non-nil, zero value otherwise.
+func (a *AuthorizationUpdateRequest) GetNote() string {
+	if a == nil || a.Note == nil {
 		return ""
 	}
-	return *c.AnnotationsURL
+	return *a.Note
 }
 
-// GetSummary returns the Summary field if it's non-nil, zero value otherwise.
-func (c *CheckRunOutput) GetSummary() string {
-	if c == nil || c.Summary == nil {
+// GetNoteURL returns the NoteURL field if it's non-nil, zero value otherwise.
+func (a *AuthorizationUpdateRequest) GetNoteURL() string {
+	if a == nil || a.NoteURL == nil {
 		return ""
 	}
-	return *c.Summary
+	return *a.NoteURL
 }
 
-// GetText returns the Text field if it's non-nil, zero value otherwise.
-func (c *CheckRunOutput) GetText() string {
-	if c == nil || c.Text == nil {
-		return ""
+// GetID returns the ID field if it's non-nil, zero value otherwise.
+func (a *Autolink) GetID() int64 {
+	if a == nil || a.ID == nil {
+		return 0
 	}
-	return *c.Text
+	return *a.ID
 }
 
-// GetTitle returns the Title field if it's non-nil, zero value otherwise.
-func (c *CheckRunOutput) GetTitle() string {
-	if c == nil || c.Title == nil {
-		return ""
+// GetIsAlphanumeric returns the IsAlphanumeric field if it's non-nil, zero value otherwise.
+func (a *Autolink) GetIsAlphanumeric() bool {
+	if a == nil || a.IsAlphanumeric == nil {
+		return false
 	}
-	return *c.Title
+	return *a.IsAlphanumeric
 }
 
-// GetAfterSHA returns the AfterSHA field if it's non-nil, zero value otherwise.
-func (c *CheckSuite) GetAfterSHA() string {
-	if c == nil || c.AfterSHA == nil {
+// GetKeyPrefix returns the KeyPrefix field if it's non-nil, zero value otherwise.
+func (a *Autolink) GetKeyPrefix() string {
+	if a == nil || a.KeyPrefix == nil {
 		return ""
 	}
-	return *c.AfterSHA
+	return *a.KeyPrefix
 }
 
-// GetApp returns the App field.
-func (c *CheckSuite) GetApp() *App {
-	if c == nil {
-		return nil
+// GetURLTemplate returns the URLTemplate field if it's non-nil, zero value otherwise.
+func (a *Autolink) GetURLTemplate() string {
+	if a == nil || a.URLTemplate == nil {
+		return ""
 	}
-	return c.App
+	return *a.URLTemplate
 }
 
-// GetBeforeSHA returns the BeforeSHA field if it's non-nil, zero value otherwise.
-func (c *CheckSuite) GetBeforeSHA() string {
-	if c == nil || c.BeforeSHA == nil {
-		return ""
+// GetIsAlphanumeric returns the IsAlphanumeric field if it's non-nil, zero value otherwise.
+func (a *AutolinkOptions) GetIsAlphanumeric() bool {
+	if a == nil || a.IsAlphanumeric == nil {
+		return false
 	}
-	return *c.BeforeSHA
+	return *a.IsAlphanumeric
 }
 
-// GetConclusion returns the Conclusion field if it's non-nil, zero value otherwise.
-func (c *CheckSuite) GetConclusion() string {
-	if c == nil || c.Conclusion == nil {
+// GetKeyPrefix returns the KeyPrefix field if it's non-nil, zero value otherwise.
+func (a *AutolinkOptions) GetKeyPrefix() string {
+	if a == nil || a.KeyPrefix == nil {
 		return ""
 	}
-	return *c.Conclusion
+	return *a.KeyPrefix
 }
 
-// GetHeadBranch returns the HeadBranch field if it's non-nil, zero value otherwise.
-func (c *CheckSuite) GetHeadBranch() string {
-	if c == nil || c.HeadBranch == nil {
+// GetURLTemplate returns the URLTemplate field if it's non-nil, zero value otherwise.
+func (a *AutolinkOptions) GetURLTemplate() string {
+	if a == nil || a.URLTemplate == nil {
 		return ""
 	}
-	return *c.HeadBranch
+	return *a.URLTemplate
 }
 
-// GetHeadCommit returns the HeadCommit field.
-func (c *CheckSuite) GetHeadCommit() *Commit {
-	if c == nil {
-		return nil
+// GetAppID returns the AppID field if it's non-nil, zero value otherwise.
+func (a *AutoTriggerCheck) GetAppID() int64 {
+	if a == nil || a.AppID == nil {
+		return 0
 	}
-	return c.HeadCommit
+	return *a.AppID
 }
 
-// GetHeadSHA returns the HeadSHA field if it's non-nil, zero value otherwise.
-func (c *CheckSuite) GetHeadSHA() string {
-	if c == nil || c.HeadSHA == nil {
+// GetSetting returns the Setting field if it's non-nil, zero value otherwise.
+func (a *AutoTriggerCheck) GetSetting() bool {
+	if a == nil || a.Setting == nil {
+		return false
+	}
+	return *a.Setting
+}
+
+// GetContent returns the Content field if it's non-nil, zero value otherwise.
+func (b *Blob) GetContent() string {
+	if b == nil || b.Content == nil {
 		return ""
 	}
-	return *c.HeadSHA
+	return *b.Content
 }
 
-// GetID returns the ID field if it's non-nil, zero value otherwise.
-func (c *CheckSuite) GetID() int64 {
-	if c == nil || c.ID == nil {
-		return 0
+// GetEncoding returns the Encoding field if it's non-nil, zero value otherwise.
+func (b *Blob) GetEncoding() string {
+	if b == nil || b.Encoding == nil {
+		return ""
 	}
-	return *c.ID
+	return *b.Encoding
 }
 
 // GetNodeID returns the NodeID field if it's non-nil, zero value otherwise.
-func (c *CheckSuite) GetNodeID() string {
-	if c == nil || c.NodeID == nil {
+func (b *Blob) GetNodeID() string {
+	if b == nil || b.NodeID == nil {
 		return ""
 	}
-	return *c.NodeID
+	return *b.NodeID
 }
 
-// GetRepository returns the Repository field.
-func (c *CheckSuite) GetRepository() *Repository {
-	if c == nil {
-		return nil
+// GetSHA returns the SHA field if it's non-nil, zero value otherwise.
+func (b *Blob) GetSHA() string {
+	if b == nil || b.SHA == nil {
+		return ""
 	}
-	return c.Repository
+	return *b.SHA
 }
 
-// GetStatus returns the Status field if it's non-nil, zero value otherwise.
-func (c *CheckSuite) GetStatus() string {
-	if c == nil || c.Status == nil {
-		return ""
+// GetSize returns the Size field if it's non-nil, zero value otherwise.
+func (b *Blob) GetSize() int {
+	if b == nil || b.Size == nil {
+		return 0
 	}
-	return *c.Status
+	return *b.Size
 }
 
 // GetURL returns the URL field if it's non-nil, zero value otherwise.
-func (c *CheckSuite) GetURL() string {
-	if c == nil || c.URL == nil {
+func (b *Blob) GetURL() string {
+	if b == nil || b.URL == nil {
 		return ""
 	}
-	return *c.URL
+	return *b.URL
 }
 
-// GetAction returns the Action field if it's non-nil, zero value otherwise.
-func (c *CheckSuiteEvent) GetAction() string {
-	if c == nil || c.Action == nil {
-		return ""
+// GetCommit returns the Commit field.
+func (b *Branch) GetCommit() *RepositoryCommit {
+	if b == nil {
+		return nil
 	}
-	return *c.Action
+	return b.Commit
 }
 
-// GetCheckSuite returns the CheckSuite field.
-func (c *CheckSuiteEvent) GetCheckSuite() *CheckSuite {
-	if c == nil {
-		return nil
+// GetName returns the Name field if it's non-nil, zero value otherwise.
+func (b *Branch) GetName() string {
+	if b == nil || b.Name == nil {
+		return ""
 	}
-	return c.CheckSuite
+	return *b.Name
 }
 
-// GetInstallation returns the Installation field.
-func (c *CheckSuiteEvent) GetInstallation() *Installation {
-	if c == nil {
-		return nil
+// GetProtected returns the Protected field if it's non-nil, zero value otherwise.
+func (b *Branch) GetProtected() bool {
+	if b == nil || b.Protected == nil {
+		return false
 	}
-	return c.Installation
+	return *b.Protected
 }
 
-// GetOrg returns the Org field.
-func (c *CheckSuiteEvent) GetOrg() *Organization {
-	if c == nil {
+// GetCommit returns the Commit field.
+func (b *BranchCommit) GetCommit() *Commit {
+	if b == nil {
 		return nil
 	}
-	return c.Org
+	return b.Commit
 }
 
-// GetRepo returns the Repo field.
-func (c *CheckSuiteEvent) GetRepo() *Repository {
-	if c == nil {
-		return nil
+// GetName returns the Name field if it's non-nil, zero value otherwise.
+func (b *BranchCommit) GetName() string {
+	if b == nil || b.Name == nil {
+		return ""
 	}
-	return c.Repo
+	return *b.Name
 }
 
-// GetSender returns the Sender field.
-func (c *CheckSuiteEvent) GetSender() *User {
-	if c == nil {
-		return nil
+// GetProtected returns the Protected field if it's non-nil, zero value otherwise.
+func (b *BranchCommit) GetProtected() bool {
+	if b == nil || b.Protected == nil {
+		return false
 	}
-	return c.Sender
+	return *b.Protected
 }
 
-// GetPreferences returns the Preferences field.
-func (c *CheckSuitePreferenceResults) GetPreferences() *PreferenceList {
-	if c == nil {
-		return nil
+// GetProtected returns the Protected field if it's non-nil, zero value otherwise.
+func (b *BranchListOptions) GetProtected() bool {
+	if b == nil || b.Protected == nil {
+		return false
 	}
-	return c.Preferences
+	return *b.Protected
 }
 
-// GetRepository returns the Repository field.
-func (c *CheckSuitePreferenceResults) GetRepository() *Repository {
-	if c == nil {
-		return nil
+// GetActorID returns the ActorID field if it's non-nil, zero value otherwise.
+func (b *BypassActor) GetActorID() int64 {
+	if b == nil || b.ActorID == nil {
+		return 0
 	}
-	return c.Repository
+	return *b.ActorID
 }
 
-// GetBody returns the Body field if it's non-nil, zero value otherwise.
-func (c *CodeOfConduct) GetBody() string {
-	if c == nil || c.Body == nil {
+// GetActorType returns the ActorType field if it's non-nil, zero value otherwise.
+func (b *BypassActor) GetActorType() string {
+	if b == nil || b.ActorType == nil {
 		return ""
 	}
-	return *c.Body
+	return *b.ActorType
 }
 
-// GetKey returns the Key field if it's non-nil, zero value otherwise.
-func (c *CodeOfConduct) GetKey() string {
-	if c == nil || c.Key == nil {
+// GetBypassMode returns the BypassMode field if it's non-nil, zero value otherwise.
+func (b *BypassActor) GetBypassMode() string {
+	if b == nil || b.BypassMode == nil {
 		return ""
 	}
-	return *c.Key
+	return *b.BypassMode
 }
 
-// GetName returns the Name field if it's non-nil, zero value otherwise.
-func (c *CodeOfConduct) GetName() string {
-	if c == nil || c.Name == nil {
-		return ""
+// GetActorID returns the ActorID field if it's non-nil, zero value otherwise.
+func (b *BypassRequestActor) GetActorID() int64 {
+	if b == nil || b.ActorID == nil {
+		return 0
 	}
-	return *c.Name
+	return *b.ActorID
 }
 
-// GetURL returns the URL field if it's non-nil, zero value otherwise.
-func (c *CodeOfConduct) GetURL() string {
-	if c == nil || c.URL == nil {
+// GetActorName returns the ActorName field if it's non-nil, zero value otherwise.
+func (b *BypassRequestActor) GetActorName() string {
+	if b == nil || b.ActorName == nil {
 		return ""
 	}
-	return *c.URL
+	return *b.ActorName
 }
 
-// GetHTMLURL returns the HTMLURL field if it's non-nil, zero value otherwise.
-func (c *CodeResult) GetHTMLURL() string {
-	if c == nil || c.HTMLURL == nil {
+// GetBypassReason returns the BypassReason field if it's non-nil, zero value otherwise.
+func (b *BypassRequestData) GetBypassReason() string {
+	if b == nil || b.BypassReason == nil {
 		return ""
 	}
-	return *c.HTMLURL
+	return *b.BypassReason
 }
 
-// GetName returns the Name field if it's non-nil, zero value otherwise.
-func (c *CodeResult) GetName() string {
-	if c == nil || c.Name == nil {
-		return ""
+// GetLocation returns the Location field.
+func (b *BypassRequestData) GetLocation() *BypassRequestLocation {
+	if b == nil {
+		return nil
 	}
-	return *c.Name
+	return b.Location
 }
 
-// GetPath returns the Path field if it's non-nil, zero value otherwise.
-func (c *CodeResult) GetPath() string {
-	if c == nil || c.Path == nil {
+// GetSecretType returns the SecretType field if it's non-nil, zero value otherwise.
+func (b *BypassRequestData) GetSecretType() string {
+	if b == nil || b.SecretType == nil {
 		return ""
 	}
-	return *c.Path
+	return *b.SecretType
 }
 
-// GetRepository returns the Repository field.
-func (c *CodeResult) GetRepository() *Repository {
-	if c == nil {
-		return nil
+// GetCommitSHA returns the CommitSHA field if it's non-nil, zero value otherwise.
+func (b *BypassRequestLocation) GetCommitSHA() string {
+	if b == nil || b.CommitSHA == nil {
+		return ""
 	}
-	return c.Repository
+	return *b.CommitSHA
 }
 
-// GetSHA returns the SHA field if it's non-nil, zero value otherwise.
-func (c *CodeResult) GetSHA() string {
-	if c == nil || c.SHA == nil {
-		return ""
+// GetEndLine returns the EndLine field if it's non-nil, zero value otherwise.
+func (b *BypassRequestLocation) GetEndLine() int {
+	if b == nil || b.EndLine == nil {
+		return 0
 	}
-	return *c.SHA
+	return *b.EndLine
 }
 
-// GetIncompleteResults returns the IncompleteResults field if it's non-nil, zero value otherwise.
-func (c *CodeSearchResult) GetIncompleteResults() bool {
-	if c == nil || c.IncompleteResults == nil {
-		return false
+// GetPath returns the Path field if it's non-nil, zero value otherwise.
+func (b *BypassRequestLocation) GetPath() string {
+	if b == nil || b.Path == nil {
+		return ""
 	}
-	return *c.IncompleteResults
+	return *b.Path
 }
 
-// GetTotal returns the Total field if it's non-nil, zero value otherwise.
-func (c *CodeSearchResult) GetTotal() int {
-	if c == nil || c.Total == nil {
+// GetStartLine returns the StartLine field if it's non-nil, zero value otherwise.
+func (b *BypassRequestLocation) GetStartLine() int {
+	if b == nil || b.StartLine == nil {
 		return 0
 	}
-	return *c.Total
+	return *b.StartLine
 }
 
 // GetCreatedAt returns the CreatedAt field if it's non-nil, zero value otherwise.
-func (c *CollaboratorInvitation) GetCreatedAt() Timestamp {
-	if c == nil || c.CreatedAt == nil {
+func (b *BypassResponse) GetCreatedAt() Timestamp {
+	if b == nil || b.CreatedAt == nil {
 		return Timestamp{}
 	}
-	return *c.CreatedAt
-}
-
-// GetHTMLURL returns the HTMLURL field if it's non-nil, zero value otherwise.
-func (c *CollaboratorInvitation) GetHTMLURL() string {
-	if c == nil || c.HTMLURL == nil {
-		return ""
-	}
-	return *c.HTMLURL
+	return *b.CreatedAt
 }
 
 // GetID returns the ID field if it's non-nil, zero value otherwise.
-func (c *CollaboratorInvitation) GetID() int64 {
-	if c == nil || c.ID == nil {
+func (b *BypassResponse) GetID() int64 {
+	if b == nil || b.ID == nil {
 		return 0
 	}
-	return *c.ID
+	return *b.ID
 }
 
-// GetInvitee returns the Invitee field.
-func (c *CollaboratorInvitation) GetInvitee() *User {
-	if c == nil {
+// GetReviewer returns the Reviewer field.
+func (b *BypassResponse) GetReviewer() *BypassRequestActor {
+	if b == nil {
 		return nil
 	}
-	return c.Invitee
+	return b.Reviewer
 }
 
-// GetInviter returns the Inviter field.
-func (c *CollaboratorInvitation) GetInviter() *User {
-	if c == nil {
-		return nil
+// GetStatus returns the Status field if it's non-nil, zero value otherwise.
+func (b *BypassResponse) GetStatus() string {
+	if b == nil || b.Status == nil {
+		return ""
 	}
-	return c.Inviter
+	return *b.Status
 }
 
-// GetPermissions returns the Permissions field if it's non-nil, zero value otherwise.
-func (c *CollaboratorInvitation) GetPermissions() string {
-	if c == nil || c.Permissions == nil {
-		return ""
+// GetApp returns the App field.
+func (c *CheckRun) GetApp() *App {
+	if c == nil {
+		return nil
 	}
-	return *c.Permissions
+	return c.App
 }
 
-// GetRepo returns the Repo field.
-func (c *CollaboratorInvitation) GetRepo() *Repository {
+// GetCheckSuite returns the CheckSuite field.
+func (c *CheckRun) GetCheckSuite() *CheckSuite {
 	if c == nil {
 		return nil
 	}
-	return c.Repo
+	return c.CheckSuite
 }
 
-// GetURL returns the URL field if it's non-nil, zero value otherwise.
-func (c *CollaboratorInvitation) GetURL() string {
-	if c == nil || c.URL == nil {
-		return ""
+// GetCompletedAt returns the CompletedAt field if it's non-nil, zero value otherwise.
+func (c *CheckRun) GetCompletedAt() Timestamp {
+	if c == nil || c.CompletedAt == nil {
+		return Timestamp{}
 	}
-	return *c.URL
+	return *c.CompletedAt
 }
 
-// GetCommitURL returns the CommitURL field if it's non-nil, zero value otherwise.
-func (c *CombinedStatus) GetCommitURL() string {
-	if c == nil || c.CommitURL == nil {
+// GetConclusion returns the Conclusion field if it's non-nil, zero value otherwise.
+func (c *CheckRun) GetConclusion() string {
+	if c == nil || c.Conclusion == nil {
 		return ""
 	}
-	return *c.CommitURL
+	return *c.Conclusion
 }
 
-// GetName returns the Name field if it's non-nil, zero value otherwise.
-func (c *CombinedStatus) GetName() string {
-	if c == nil || c.Name == nil {
+// GetDetailsURL returns the DetailsURL field if it's non-nil, zero value otherwise.
+func (c *CheckRun) GetDetailsURL() string {
+	if c == nil || c.DetailsURL == nil {
 		return ""
 	}
-	return *c.Name
+	return *c.DetailsURL
 }
 
-// GetRepositoryURL returns the RepositoryURL field if it's non-nil, zero value otherwise.
-func (c *CombinedStatus) GetRepositoryURL() string {
-	if c == nil || c.RepositoryURL == nil {
+// GetExternalID returns the ExternalID field if it's non-nil, zero value otherwise.
+func (c *CheckRun) GetExternalID() string {
+	if c == nil || c.ExternalID == nil {
 		return ""
 	}
-	return *c.RepositoryURL
+	return *c.ExternalID
 }
 
-// GetSHA returns the SHA field if it's non-nil, zero value otherwise.
-func (c *CombinedStatus) GetSHA() string {
-	if c == nil || c.SHA == nil {
+// GetHeadSHA returns the HeadSHA field if it's non-nil, zero value otherwise.
+func (c *CheckRun) GetHeadSHA() string {
+	if c == nil || c.HeadSHA == nil {
 		return ""
 	}
-	return *c.SHA
+	return *c.HeadSHA
 }
 
-// GetState returns the State field if it's non-nil, zero value otherwise.
-func (c *CombinedStatus) GetState() string {
-	if c == nil || c.State == nil {
+// GetHTMLURL returns the HTMLURL field if it's non-nil, zero value otherwise.
+func (c *CheckRun) GetHTMLURL() string {
+	if c == nil || c.HTMLURL == nil {
 		return ""
 	}
-	return *c.State
-}
-
-// GetTotalCount returns the TotalCount field if it's non-nil, zero value otherwise.
-func (c *CombinedStatus) GetTotalCount() int {
-	if c == nil || c.TotalCount == nil {
-		return 0
-	}
-	return *c.TotalCount
-}
-
-// GetCreatedAt returns the CreatedAt field if it's non-nil, zero value otherwise.
-func (c *Comment) GetCreatedAt() time.Time {
-	if c == nil || c.CreatedAt == nil {
-		return time.Time{}
-	}
-	return *c.CreatedAt
-}
-
-// GetTotalCommitComments returns the TotalCommitComments field if it's non-nil, zero value otherwise.
-func (c *CommentStats) GetTotalCommitComments() int {
-	if c == nil || c.TotalCommitComments == nil {
-		return 0
-	}
-	return *c.TotalCommitComments
-}
-
-// GetTotalGistComments returns the TotalGistComments field if it's non-nil, zero value otherwise.
-func (c *CommentStats) GetTotalGistComments() int {
-	if c == nil || c.TotalGistComments == nil {
-		return 0
-	}
-	return *c.TotalGistComments
-}
-
-// GetTotalIssueComments returns the TotalIssueComments field if it's non-nil, zero value otherwise.
-func (c *CommentStats) GetTotalIssueComments() int {
-	if c == nil || c.TotalIssueComments == nil {
-		return 0
-	}
-	return *c.TotalIssueComments
+	return *c.HTMLURL
 }
 
-// GetTotalPullRequestComments returns the TotalPullRequestComments field if it's non-nil, zero value otherwise.
-func (c *CommentStats) GetTotalPullRequestComments() int {
-	if c == nil || c.TotalPullRequestComments == nil {
+// GetID returns the ID field if it's non-nil, zero value otherwise.
+func (c *CheckRun) GetID() int64 {
+	if c == nil || c.ID == nil {
 		return 0
 	}
-	return *c.TotalPullRequestComments
+	return *c.ID
 }
 
-// GetAuthor returns the Author field.
-func (c *Commit) GetAuthor() *CommitAuthor {
-	if c == nil {
-		return nil
+// GetName returns the Name field if it's non-nil, zero value otherwise.
+func (c *CheckRun) GetName() string {
+	if c == nil || c.Name == nil {
+		return ""
 	}
-	return c.Author
+	return *c.Name
 }
 
-// GetCommentCount returns the CommentCount field if it's non-nil, zero value otherwise.
-func (c *Commit) GetCommentCount() int {
-	if c == nil || c.CommentCount == nil {
-		return 0
+// GetNodeID returns the NodeID field if it's non-nil, zero value otherwise.
+func (c *CheckRun) GetNodeID() string {
+	if c == nil || c.NodeID == nil {
+		return ""
 	}
-	return *c.CommentCount
+	return *c.NodeID
 }
 
-// GetCommitter returns the Committer field.
-func (c *Commit) GetCommitter() *CommitAuthor {
+// GetOutput returns the Output field.
+func (c *CheckRun) GetOutput() *CheckRunOutput {
 	if c == nil {
 		return nil
 	}
-	return c.Committer
+	return c.Output
 }
 
-// GetHTMLURL returns the HTMLURL field if it's non-nil, zero value otherwise.
-func (c *Commit) GetHTMLURL() string {
-	if c == nil || c.HTMLURL == nil {
-		return ""
+// GetStartedAt returns the StartedAt field if it's non-nil, zero value otherwise.
+func (c *CheckRun) GetStartedAt() Timestamp {
+	if c == nil || c.StartedAt == nil {
+		return Timestamp{}
 	}
-	return *c.HTMLURL
+	return *c.StartedAt
 }
 
-// GetMessage returns the Message field if it's non-nil, zero value otherwise.
-func (c *Commit) GetMessage() string {
-	if c == nil || c.Message == nil {
+// GetStatus returns the Status field if it's non-nil, zero value otherwise.
+func (c *CheckRun) GetStatus() string {
+	if c == nil || c.Status == nil {
 		return ""
 	}
-	return *c.Message
+	return *c.Status
 }
 
-// GetNodeID returns the NodeID field if it's non-nil, zero value otherwise.
-func (c *Commit) GetNodeID() string {
-	if c == nil || c.NodeID == nil {
+// GetURL returns the URL field if it's non-nil, zero value otherwise.
+func (c *CheckRun) GetURL() string {
+	if c == nil || c.URL == nil {
 		return ""
 	}
-	return *c.NodeID
+	return *c.URL
 }
 
-// GetSHA returns the SHA field if it's non-nil, zero value otherwise.
-func (c *Commit) GetSHA() string {
-	if c == nil || c.SHA == nil {
+// GetAnnotationLevel returns the AnnotationLevel field if it's non-nil, zero value otherwise.
+func (c *CheckRunAnnotation) GetAnnotationLevel() string {
+	if c == nil || c.AnnotationLevel == nil {
 		return ""
 	}
-	return *c.SHA
+	return *c.AnnotationLevel
 }
 
-// GetStats returns the Stats field.
-func (c *Commit) GetStats() *CommitStats {
-	if c == nil {
-		return nil
+// GetEndColumn returns the EndColumn field if it's non-nil, zero value otherwise.
+func (c *CheckRunAnnotation) GetEndColumn() int {
+	if c == nil || c.EndColumn == nil {
+		return 0
 	}
-	return c.Stats
+	return *c.EndColumn
 }
 
-// GetTree returns the Tree field.
-func (c *Commit) GetTree() *Tree {
-	if c == nil {
-		return nil
+// GetEndLine returns the EndLine field if it's non-nil, zero value otherwise.
+func (c *CheckRunAnnotation) GetEndLine() int {
+	if c == nil || c.EndLine == nil {
+		return 0
 	}
-	return c.Tree
+	return *c.EndLine
 }
 
-// GetURL returns the URL field if it's non-nil, zero value otherwise.
-func (c *Commit) GetURL() string {
-	if c == nil || c.URL == nil {
+// GetMessage returns the Message field if it's non-nil, zero value otherwise.
+func (c *CheckRunAnnotation) GetMessage() string {
+	if c == nil || c.Message == nil {
 		return ""
 	}
-	return *c.URL
+	return *c.Message
 }
 
-// GetVerification returns the Verification field.
-func (c *Commit) GetVerification() *SignatureVerification {
-	if c == nil {
-		return nil
+// GetPath returns the Path field if it's non-nil, zero value otherwise.
+func (c *CheckRunAnnotation) GetPath() string {
+	if c == nil || c.Path == nil {
+		return ""
 	}
-	return c.Verification
+	return *c.Path
 }
 
-// GetDate returns the Date field if it's non-nil, zero value otherwise.
-func (c *CommitAuthor) GetDate() time.Time {
-	if c == nil || c.Date == nil {
-		return time.Time{}
+// GetRawDetails returns the RawDetails field if it's non-nil, zero value otherwise.
+func (c *CheckRunAnnotation) GetRawDetails() string {
+	if c == nil || c.RawDetails == nil {
+		return ""
 	}
-	return *c.Date
+	return *c.RawDetails
 }
 
-// GetEmail returns the Email field if it's non-nil, zero value otherwise.
-func (c *CommitAuthor) GetEmail() string {
-	if c == nil || c.Email == nil {
-		return ""
+// GetStartColumn returns the StartColumn field if it's non-nil, zero value otherwise.
+func (c *CheckRunAnnotation) GetStartColumn() int {
+	if c == nil || c.StartColumn == nil {
+		return 0
 	}
-	return *c.Email
+	return *c.StartColumn
 }
 
-// GetLogin returns the Login field if it's non-nil, zero value otherwise.
-func (c *CommitAuthor) GetLogin() string {
-	if c == nil || c.Login == nil {
-		return ""
+// GetStartLine returns the StartLine field if it's non-nil, zero value otherwise.
+func (c *CheckRunAnnotation) GetStartLine() int {
+	if c == nil || c.StartLine == nil {
+		return 0
 	}
-	return *c.Login
+	return *c.StartLine
 }
 
-// GetName returns the Name field if it's non-nil, zero value otherwise.
-func (c *CommitAuthor) GetName() string {
-	if c == nil || c.Name == nil {
+// GetTitle returns the Title field if it's non-nil, zero value otherwise.
+func (c *CheckRunAnnotation) GetTitle() string {
+	if c == nil || c.Title == nil {
 		return ""
 	}
-	return *c.Name
+	return *c.Title
 }
 
 // GetAction returns the Action field if it's non-nil, zero value otherwise.
-func (c *CommitCommentEvent) GetAction() string {
+func (c *CheckRunEvent) GetAction() string {
 	if c == nil || c.Action == nil {
 		return ""
 	}
 	return *c.Action
 }
 
-// GetComment returns the Comment field.
-func (c *CommitCommentEvent) GetComment() *RepositoryComment {
+// GetCheckRun returns the CheckRun field.
+func (c *CheckRunEvent) GetCheckRun() *CheckRun {
 	if c == nil {
 		return nil
 	}
-	return c.Comment
+	return c.CheckRun
 }
 
 // GetInstallation returns the Installation field.
-func (c *CommitCommentEvent) GetInstallation() *Installation {
+func (c *CheckRunEvent) GetInstallation() *Installation {
 	if c == nil {
 		return nil
 	}
 	return c.Installation
 }
 
+// GetOrg returns the Org field.
+func (c *CheckRunEvent) GetOrg() *Organization {
+	if c == nil {
+		return nil
+	}
+	return c.Org
+}
+
 // GetRepo returns the Repo field.
-func (c *CommitCommentEvent) GetRepo() *Repository {
+func (c *CheckRunEvent) GetRepo() *Repository {
 	if c == nil {
 		return nil
 	}
 	return c.Repo
 }
 
-// GetSender returns the Sender field.
-func (c *CommitCommentEvent) GetSender() *User {
+// GetRequestedAction returns the RequestedAction field.
+func (c *CheckRunEvent) GetRequestedAction() *RequestedAction {
 	if c == nil {
 		return nil
 	}
-	return c.Sender
+	return c.RequestedAction
 }
 
-// GetAdditions returns the Additions field if it's non-nil, zero value otherwise.
-func (c *CommitFile) GetAdditions() int {
-	if c == nil || c.Additions == nil {
-		return 0
+// GetSender returns the Sender field.
+func (c *CheckRunEvent) GetSender() *User {
+	if c == nil {
+		return nil
 	}
-	return *c.Additions
+	return c.Sender
 }
 
-// GetBlobURL returns the BlobURL field if it's non-nil, zero value otherwise.
-func (c *CommitFile) GetBlobURL() string {
-	if c == nil || c.BlobURL == nil {
+// GetAlt returns the Alt field if it's non-nil, zero value otherwise.
+func (c *CheckRunImage) GetAlt() string {
+	if c == nil || c.Alt == nil {
 		return ""
 	}
-	return *c.BlobURL
+	return *c.Alt
 }
 
-// GetChanges returns the Changes field if it's non-nil, zero value otherwise.
-func (c *CommitFile) GetChanges() int {
-	if c == nil || c.Changes == nil {
-		return 0
+// GetCaption returns the Caption field if it's non-nil, zero value otherwise.
+func (c *CheckRunImage) GetCaption() string {
+	if c == nil || c.Caption == nil {
+		return ""
 	}
-	return *c.Changes
+	return *c.Caption
 }
 
-// GetContentsURL returns the ContentsURL field if it's non-nil, zero value otherwise.
-func (c *CommitFile) GetContentsURL() string {
-	if c == nil || c.ContentsURL == nil {
+// GetImageURL returns the ImageURL field if it's non-nil, zero value otherwise.
+func (c *CheckRunImage) GetImageURL() string {
+	if c == nil || c.ImageURL == nil {
 		return ""
 	}
-	return *c.ContentsURL
+	return *c.ImageURL
 }
 
-// GetDeletions returns the Deletions field if it's non-nil, zero value otherwise.
-func (c *CommitFile) GetDeletions() int {
-	if c == nil || c.Deletions == nil {
+// GetAnnotationsCount returns the AnnotationsCount field if it's non-nil, zero value otherwise.
+func (c *CheckRunOutput) GetAnnotationsCount() int {
+	if c == nil || c.AnnotationsCount == nil {
 		return 0
 	}
-	return *c.Deletions
+	return *c.AnnotationsCount
 }
 
-// GetFilename returns the Filename field if it's non-nil, zero value otherwise.
-func (c *CommitFile) GetFilename() string {
-	if c == nil || c.Filename == nil {
+// GetAnnotationsURL returns the AnnotationsURL field if it's non-nil, zero value otherwise.
+func (c *CheckRunOutput) GetAnnotationsURL() string {
+	if c == nil || c.AnnotationsURL == nil {
 		return ""
 	}
-	return *c.Filename
+	return *c.AnnotationsURL
 }
 
-// GetPatch returns the Patch field if it's non-nil, zero value otherwise.
-func (c *CommitFile) GetPatch() string {
-	if c == nil || c.Patch == nil {
+// GetSummary returns the Summary field if it's non-nil, zero value otherwise.
+func (c *CheckRunOutput) GetSummary() string {
+	if c == nil || c.Summary == nil {
 		return ""
 	}
-	return *c.Patch
+	return *c.Summary
 }
 
-// GetPreviousFilename returns the PreviousFilename field if it's non-nil, zero value otherwise.
-func (c *CommitFile) GetPreviousFilename() string {
-	if c == nil || c.PreviousFilename == nil {
+// GetText returns the Text field if it's non-nil, zero value otherwise.
+func (c *CheckRunOutput) GetText() string {
+	if c == nil || c.Text == nil {
 		return ""
 	}
-	return *c.PreviousFilename
+	return *c.Text
 }
 
-// GetRawURL returns the RawURL field if it's non-nil, zero value otherwise.
-func (c *CommitFile) GetRawURL() string {
-	if c == nil || c.RawURL == nil {
+// GetTitle returns the Title field if it's non-nil, zero value otherwise.
+func (c *CheckRunOutput) GetTitle() string {
+	if c == nil || c.Title == nil {
 		return ""
 	}
-	return *c.RawURL
+	return *c.Title
 }
 
-// GetSHA returns the SHA field if it's non-nil, zero value otherwise.
-func (c *CommitFile) GetSHA() string {
-	if c == nil || c.SHA == nil {
-		return ""
+// GetCheckRun returns the CheckRun field.
+func (c *ChecksStatusContext) GetCheckRun() *CheckRun {
+	if c == nil {
+		return nil
 	}
-	return *c.SHA
+	return c.CheckRun
 }
 
-// GetStatus returns the Status field if it's non-nil, zero value otherwise.
-func (c *CommitFile) GetStatus() string {
-	if c == nil || c.Status == nil {
+// GetStatus returns the Status field.
+func (c *ChecksStatusContext) GetStatus() *RepoStatus {
+	if c == nil {
+		return nil
+	}
+	return c.Status
+}
+
+// GetAfterSHA returns the AfterSHA field if it's non-nil, zero value otherwise.
+func (c *CheckSuite) GetAfterSHA() string {
+	if c == nil || c.AfterSHA == nil {
 		return ""
 	}
-	return *c.Status
+	return *c.AfterSHA
 }
 
-// GetAuthor returns the Author field.
-func (c *CommitResult) GetAuthor() *User {
+// GetApp returns the App field.
+func (c *CheckSuite) GetApp() *App {
 	if c == nil {
 		return nil
 	}
-	return c.Author
+	return c.App
 }
 
-// GetCommentsURL returns the CommentsURL field if it's non-nil, zero value otherwise.
-func (c *CommitResult) GetCommentsURL() string {
-	if c == nil || c.CommentsURL == nil {
+// GetBeforeSHA returns the BeforeSHA field if it's non-nil, zero value otherwise.
+func (c *CheckSuite) GetBeforeSHA() string {
+	if c == nil || c.BeforeSHA == nil {
 		return ""
 	}
-	return *c.CommentsURL
+	return *c.BeforeSHA
 }
 
-// GetCommit returns the Commit field.
-func (c *CommitResult) GetCommit() *Commit {
-	if c == nil {
-		return nil
+// GetConclusion returns the Conclusion field if it's non-nil, zero value otherwise.
+func (c *CheckSuite) GetConclusion() string {
+	if c == nil || c.Conclusion == nil {
+		return ""
 	}
-	return c.Commit
+	return *c.Conclusion
 }
 
-// GetCommitter returns the Committer field.
-func (c *CommitResult) GetCommitter() *User {
+// GetHeadBranch returns the HeadBranch field if it's non-nil, zero value otherwise.
+func (c *CheckSuite) GetHeadBranch() string {
+	if c == nil || c.HeadBranch == nil {
+		return ""
+	}
+	return *c.HeadBranch
+}
+
+// GetHeadCommit returns the HeadCommit field.
+func (c *CheckSuite) GetHeadCommit() *Commit {
 	if c == nil {
 		return nil
 	}
-	return c.Committer
+	return c.HeadCommit
 }
 
-// GetHTMLURL returns the HTMLURL field if it's non-nil, zero value otherwise.
-func (c *CommitResult) GetHTMLURL() string {
-	if c == nil || c.HTMLURL == nil {
+// GetHeadSHA returns the HeadSHA field if it's non-nil, zero value otherwise.
+func (c *CheckSuite) GetHeadSHA() string {
+	if c == nil || c.HeadSHA == nil {
 		return ""
 	}
-	return *c.HTMLURL
+	return *c.HeadSHA
 }
 
-// GetRepository returns the Repository field.
-func (c *CommitResult) GetRepository() *Repository {
-	if c == nil {
-		return nil
+// GetID returns the ID field if it's non-nil, zero value otherwise.
+func (c *CheckSuite) GetID() int64 {
+	if c == nil || c.ID == nil {
+		return 0
 	}
-	return c.Repository
+	return *c.ID
 }
 
-// GetScore returns the Score field.
-func (c *CommitResult) GetScore() *float64 {
+// GetNodeID returns the NodeID field if it's non-nil, zero value otherwise.
+func (c *CheckSuite) GetNodeID() string {
+	if c == nil || c.NodeID == nil {
+		return ""
+	}
+	return *c.NodeID
+}
+
+// GetRepository returns the Repository field.
+func (c *CheckSuite) GetRepository() *Repository {
 	if c == nil {
 		return nil
 	}
-	return c.Score
+	return c.Repository
 }
 
-// GetSHA returns the SHA field if it's non-nil, zero value otherwise.
-func (c *CommitResult) GetSHA() string {
-	if c == nil || c.SHA == nil {
+// GetStatus returns the Status field if it's non-nil, zero value otherwise.
+func (c *CheckSuite) GetStatus() string {
+	if c == nil || c.Status == nil {
 		return ""
 	}
-	return *c.SHA
+	return *c.Status
 }
 
 // GetURL returns the URL field if it's non-nil, zero value otherwise.
-func (c *CommitResult) GetURL() string {
+func (c *CheckSuite) GetURL() string {
 	if c == nil || c.URL == nil {
 		return ""
 	}
 	return *c.URL
 }
 
-// GetAheadBy returns the AheadBy field if it's non-nil, zero value otherwise.
-func (c *CommitsComparison) GetAheadBy() int {
-	if c == nil || c.AheadBy == nil {
-		return 0
+// GetAction returns the Action field if it's non-nil, zero value otherwise.
+func (c *CheckSuiteEvent) GetAction() string {
+	if c == nil || c.Action == nil {
+		return ""
 	}
-	return *c.AheadBy
+	return *c.Action
 }
 
-// GetBaseCommit returns the BaseCommit field.
-func (c *CommitsComparison) GetBaseCommit() *RepositoryCommit {
+// GetCheckSuite returns the CheckSuite field.
+func (c *CheckSuiteEvent) GetCheckSuite() *CheckSuite {
 	if c == nil {
 		return nil
 	}
-	return c.BaseCommit
+	return c.CheckSuite
 }
 
-// GetBehindBy returns the BehindBy field if it's non-nil, zero value otherwise.
-func (c *CommitsComparison) GetBehindBy() int {
-	if c == nil || c.BehindBy == nil {
-		return 0
+// GetInstallation returns the Installation field.
+func (c *CheckSuiteEvent) GetInstallation() *Installation {
+	if c == nil {
+		return nil
 	}
-	return *c.BehindBy
+	return c.Installation
 }
 
-// GetDiffURL returns the DiffURL field if it's non-nil, zero value otherwise.
-func (c *CommitsComparison) GetDiffURL() string {
-	if c == nil || c.DiffURL == nil {
-		return ""
+// GetOrg returns the Org field.
+func (c *CheckSuiteEvent) GetOrg() *Organization {
+	if c == nil {
+		return nil
 	}
-	return *c.DiffURL
+	return c.Org
 }
 
-// GetHTMLURL returns the HTMLURL field if it's non-nil, zero value otherwise.
-func (c *CommitsComparison) GetHTMLURL() string {
-	if c == nil || c.HTMLURL == nil {
-		return ""
+// GetRepo returns the Repo field.
+func (c *CheckSuiteEvent) GetRepo() *Repository {
+	if c == nil {
+		return nil
 	}
-	return *c.HTMLURL
+	return c.Repo
 }
 
-// GetMergeBaseCommit returns the MergeBaseCommit field.
-func (c *CommitsComparison) GetMergeBaseCommit() *RepositoryCommit {
+// GetSender returns the Sender field.
+func (c *CheckSuiteEvent) GetSender() *User {
 	if c == nil {
 		return nil
 	}
-	return c.MergeBaseCommit
+	return c.Sender
 }
 
-// GetPatchURL returns the PatchURL field if it's non-nil, zero value otherwise.
-func (c *CommitsComparison) GetPatchURL() string {
-	if c == nil || c.PatchURL == nil {
-		return ""
+// GetPreferences returns the Preferences field.
+func (c *CheckSuitePreferenceResults) GetPreferences() *PreferenceList {
+	if c == nil {
+		return nil
 	}
-	return *c.PatchURL
+	return c.Preferences
 }
 
-// GetPermalinkURL returns the PermalinkURL field if it's non-nil, zero value otherwise.
-func (c *CommitsComparison) GetPermalinkURL() string {
-	if c == nil || c.PermalinkURL == nil {
-		return ""
+// GetRepository returns the Repository field.
+func (c *CheckSuitePreferenceResults) GetRepository() *Repository {
+	if c == nil {
+		return nil
 	}
-	return *c.PermalinkURL
+	return c.Repository
 }
 
-// GetStatus returns the Status field if it's non-nil, zero value otherwise.
-func (c *CommitsComparison) GetStatus() string {
-	if c == nil || c.Status == nil {
-		return ""
+// GetTime returns the Time field if it's non-nil, zero value otherwise.
+func (c *CloudEvent) GetTime() time.Time {
+	if c == nil || c.Time == nil {
+		return time.Time{}
 	}
-	return *c.Status
+	return *c.Time
 }
 
-// GetTotalCommits returns the TotalCommits field if it's non-nil, zero value otherwise.
-func (c *CommitsComparison) GetTotalCommits() int {
-	if c == nil || c.TotalCommits == nil {
-		return 0
+// GetBody returns the Body field if it's non-nil, zero value otherwise.
+func (c *CodeOfConduct) GetBody() string {
+	if c == nil || c.Body == nil {
+		return ""
 	}
-	return *c.TotalCommits
+	return *c.Body
 }
 
-// GetURL returns the URL field if it's non-nil, zero value otherwise.
-func (c *CommitsComparison) GetURL() string {
-	if c == nil || c.URL == nil {
+// GetKey returns the Key field if it's non-nil, zero value otherwise.
+func (c *CodeOfConduct) GetKey() string {
+	if c == nil || c.Key == nil {
 		return ""
 	}
-	return *c.URL
+	return *c.Key
 }
 
-// GetIncompleteResults returns the IncompleteResults field if it's non-nil, zero value otherwise.
-func (c *CommitsSearchResult) GetIncompleteResults() bool {
-	if c == nil || c.IncompleteResults == nil {
-		return false
+// GetName returns the Name field if it's non-nil, zero value otherwise.
+func (c *CodeOfConduct) GetName() string {
+	if c == nil || c.Name == nil {
+		return ""
 	}
-	return *c.IncompleteResults
+	return *c.Name
 }
 
-// GetTotal returns the Total field if it's non-nil, zero value otherwise.
-func (c *CommitsSearchResult) GetTotal() int {
-	if c == nil || c.Total == nil {
-		return 0
+// GetURL returns the URL field if it's non-nil, zero value otherwise.
+func (c *CodeOfConduct) GetURL() string {
+	if c == nil || c.URL == nil {
+		return ""
 	}
-	return *c.Total
+	return *c.URL
 }
 
-// GetAdditions returns the Additions field if it's non-nil, zero value otherwise.
-func (c *CommitStats) GetAdditions() int {
-	if c == nil || c.Additions == nil {
+// GetColumn returns the Column field if it's non-nil, zero value otherwise.
+func (c *CodeownersError) GetColumn() int {
+	if c == nil || c.Column == nil {
 		return 0
 	}
-	return *c.Additions
+	return *c.Column
 }
 
-// GetDeletions returns the Deletions field if it's non-nil, zero value otherwise.
-func (c *CommitStats) GetDeletions() int {
-	if c == nil || c.Deletions == nil {
-		return 0
+// GetKind returns the Kind field if it's non-nil, zero value otherwise.
+func (c *CodeownersError) GetKind() string {
+	if c == nil || c.Kind == nil {
+		return ""
 	}
-	return *c.Deletions
+	return *c.Kind
 }
 
-// GetTotal returns the Total field if it's non-nil, zero value otherwise.
-func (c *CommitStats) GetTotal() int {
-	if c == nil || c.Total == nil {
+// GetLine returns the Line field if it's non-nil, zero value otherwise.
+func (c *CodeownersError) GetLine() int {
+	if c == nil || c.Line == nil {
 		return 0
 	}
-	return *c.Total
-}
-
-// GetCodeOfConduct returns the CodeOfConduct field.
-func (c *CommunityHealthFiles) GetCodeOfConduct() *Metric {
-	if c == nil {
-		return nil
-	}
-	return c.CodeOfConduct
-}
-
-// GetContributing returns the Contributing field.
-func (c *CommunityHealthFiles) GetContributing() *Metric {
-	if c == nil {
-		return nil
-	}
-	return c.Contributing
-}
-
-// GetIssueTemplate returns the IssueTemplate field.
-func (c *CommunityHealthFiles) GetIssueTemplate() *Metric {
-	if c == nil {
-		return nil
-	}
-	return c.IssueTemplate
+	return *c.Line
 }
 
-// GetLicense returns the License field.
-func (c *CommunityHealthFiles) GetLicense() *Metric {
-	if c == nil {
-		return nil
+// GetMessage returns the Message field if it's non-nil, zero value otherwise.
+func (c *CodeownersError) GetMessage() string {
+	if c == nil || c.Message == nil {
+		return ""
 	}
-	return c.License
+	return *c.Message
 }
 
-// GetPullRequestTemplate returns the PullRequestTemplate field.
-func (c *CommunityHealthFiles) GetPullRequestTemplate() *Metric {
-	if c == nil {
-		return nil
+// GetPath returns the Path field if it's non-nil, zero value otherwise.
+func (c *CodeownersError) GetPath() string {
+	if c == nil || c.Path == nil {
+		return ""
 	}
-	return c.PullRequestTemplate
+	return *c.Path
 }
 
-// GetReadme returns the Readme field.
-func (c *CommunityHealthFiles) GetReadme() *Metric {
-	if c == nil {
-		return nil
+// GetSource returns the Source field if it's non-nil, zero value otherwise.
+func (c *CodeownersError) GetSource() string {
+	if c == nil || c.Source == nil {
+		return ""
 	}
-	return c.Readme
+	return *c.Source
 }
 
-// GetFiles returns the Files field.
-func (c *CommunityHealthMetrics) GetFiles() *CommunityHealthFiles {
-	if c == nil {
-		return nil
+// GetSuggestion returns the Suggestion field if it's non-nil, zero value otherwise.
+func (c *CodeownersError) GetSuggestion() string {
+	if c == nil || c.Suggestion == nil {
+		return ""
 	}
-	return c.Files
+	return *c.Suggestion
 }
 
-// GetHealthPercentage returns the HealthPercentage field if it's non-nil, zero value otherwise.
-func (c *CommunityHealthMetrics) GetHealthPercentage() int {
-	if c == nil || c.HealthPercentage == nil {
+// GetFileSize returns the FileSize field if it's non-nil, zero value otherwise.
+func (c *CodeResult) GetFileSize() int {
+	if c == nil || c.FileSize == nil {
 		return 0
 	}
-	return *c.HealthPercentage
-}
-
-// GetUpdatedAt returns the UpdatedAt field if it's non-nil, zero value otherwise.
-func (c *CommunityHealthMetrics) GetUpdatedAt() time.Time {
-	if c == nil || c.UpdatedAt == nil {
-		return time.Time{}
-	}
-	return *c.UpdatedAt
+	return *c.FileSize
 }
 
-// GetID returns the ID field if it's non-nil, zero value otherwise.
-func (c *ContentReference) GetID() int64 {
-	if c == nil || c.ID == nil {
-		return 0
+// GetGitURL returns the GitURL field if it's non-nil, zero value otherwise.
+func (c *CodeResult) GetGitURL() string {
+	if c == nil || c.GitURL == nil {
+		return ""
 	}
-	return *c.ID
+	return *c.GitURL
 }
 
-// GetNodeID returns the NodeID field if it's non-nil, zero value otherwise.
-func (c *ContentReference) GetNodeID() string {
-	if c == nil || c.NodeID == nil {
+// GetHTMLURL returns the HTMLURL field if it's non-nil, zero value otherwise.
+func (c *CodeResult) GetHTMLURL() string {
+	if c == nil || c.HTMLURL == nil {
 		return ""
 	}
-	return *c.NodeID
+	return *c.HTMLURL
 }
 
-// GetReference returns the Reference field if it's non-nil, zero value otherwise.
-func (c *ContentReference) GetReference() string {
-	if c == nil || c.Reference == nil {
+// GetLanguage returns the Language field if it's non-nil, zero value otherwise.
+func (c *CodeResult) GetLanguage() string {
+	if c == nil || c.Language == nil {
 		return ""
 	}
-	return *c.Reference
+	return *c.Language
 }
 
-// GetAction returns the Action field if it's non-nil, zero value otherwise.
-func (c *ContentReferenceEvent) GetAction() string {
-	if c == nil || c.Action == nil {
-		return ""
+// GetLastModifiedAt returns the LastModifiedAt field if it's non-nil, zero value otherwise.
+func (c *CodeResult) GetLastModifiedAt() Timestamp {
+	if c == nil || c.LastModifiedAt == nil {
+		return Timestamp{}
 	}
-	return *c.Action
+	return *c.LastModifiedAt
 }
 
-// GetContentReference returns the ContentReference field.
-func (c *ContentReferenceEvent) GetContentReference() *ContentReference {
-	if c == nil {
-		return nil
+// GetName returns the Name field if it's non-nil, zero value otherwise.
+func (c *CodeResult) GetName() string {
+	if c == nil || c.Name == nil {
+		return ""
 	}
-	return c.ContentReference
+	return *c.Name
 }
 
-// GetInstallation returns the Installation field.
-func (c *ContentReferenceEvent) GetInstallation() *Installation {
-	if c == nil {
-		return nil
+// GetPath returns the Path field if it's non-nil, zero value otherwise.
+func (c *CodeResult) GetPath() string {
+	if c == nil || c.Path == nil {
+		return ""
 	}
-	return c.Installation
+	return *c.Path
 }
 
-// GetRepo returns the Repo field.
-func (c *ContentReferenceEvent) GetRepo() *Repository {
+// GetRepository returns the Repository field.
+func (c *CodeResult) GetRepository() *Repository {
 	if c == nil {
 		return nil
 	}
-	return c.Repo
+	return c.Repository
 }
 
-// GetSender returns the Sender field.
-func (c *ContentReferenceEvent) GetSender() *User {
+// GetScore returns the Score field.
+func (c *CodeResult) GetScore() *float64 {
 	if c == nil {
 		return nil
 	}
-	return c.Sender
-}
-
-// GetAvatarURL returns the AvatarURL field if it's non-nil, zero value otherwise.
-func (c *Contributor) GetAvatarURL() string {
-	if c == nil || c.AvatarURL == nil {
-		return ""
-	}
-	return *c.AvatarURL
-}
-
-// GetContributions returns the Contributions field if it's non-nil, zero value otherwise.
-func (c *Contributor) GetContributions() int {
-	if c == nil || c.Contributions == nil {
-		return 0
-	}
-	return *c.Contributions
-}
-
-// GetEmail returns the Email field if it's non-nil, zero value otherwise.
-func (c *Contributor) GetEmail() string {
-	if c == nil || c.Email == nil {
-		return ""
-	}
-	return *c.Email
+	return c.Score
 }
 
-// GetEventsURL returns the EventsURL field if it's non-nil, zero value otherwise.
-func (c *Contributor) GetEventsURL() string {
-	if c == nil || c.EventsURL == nil {
+// GetSHA returns the SHA field if it's non-nil, zero value otherwise.
+func (c *CodeResult) GetSHA() string {
+	if c == nil || c.SHA == nil {
 		return ""
 	}
-	return *c.EventsURL
+	return *c.SHA
 }
 
-// GetFollowersURL returns the FollowersURL field if it's non-nil, zero value otherwise.
-func (c *Contributor) GetFollowersURL() string {
-	if c == nil || c.FollowersURL == nil {
+// GetURL returns the URL field if it's non-nil, zero value otherwise.
+func (c *CodeResult) GetURL() string {
+	if c == nil || c.URL == nil {
 		return ""
 	}
-	return *c.FollowersURL
+	return *c.URL
 }
 
-// GetFollowingURL returns the FollowingURL field if it's non-nil, zero value otherwise.
-func (c *Contributor) GetFollowingURL() string {
-	if c == nil || c.FollowingURL == nil {
-		return ""
+// GetIncompleteResults returns the IncompleteResults field if it's non-nil, zero value otherwise.
+func (c *CodeSearchResult) GetIncompleteResults() bool {
+	if c == nil || c.IncompleteResults == nil {
+		return false
 	}
-	return *c.FollowingURL
+	return *c.IncompleteResults
 }
 
-// GetGistsURL returns the GistsURL field if it's non-nil, zero value otherwise.
-func (c *Contributor) GetGistsURL() string {
-	if c == nil || c.GistsURL == nil {
-		return ""
+// GetTotal returns the Total field if it's non-nil, zero value otherwise.
+func (c *CodeSearchResult) GetTotal() int {
+	if c == nil || c.Total == nil {
+		return 0
 	}
-	return *c.GistsURL
+	return *c.Total
 }
 
-// GetGravatarID returns the GravatarID field if it's non-nil, zero value otherwise.
-func (c *Contributor) GetGravatarID() string {
-	if c == nil || c.GravatarID == nil {
-		return ""
+// GetCreatedAt returns the CreatedAt field if it's non-nil, zero value otherwise.
+func (c *CollaboratorInvitation) GetCreatedAt() Timestamp {
+	if c == nil || c.CreatedAt == nil {
+		return Timestamp{}
 	}
-	return *c.GravatarID
+	return *c.CreatedAt
 }
 
 // GetHTMLURL returns the HTMLURL field if it's non-nil, zero value otherwise.
-func (c *Contributor) GetHTMLURL() string {
+func (c *CollaboratorInvitation) GetHTMLURL() string {
 	if c == nil || c.HTMLURL == nil {
 		return ""
 	}
@@ -2157,5823 +2357,11263 @@ func (c *Contributor) GetHTMLURL() string {
 }
 
 // GetID returns the ID field if it's non-nil, zero value otherwise.
-func (c *Contributor) GetID() int64 {
+func (c *CollaboratorInvitation) GetID() int64 {
 	if c == nil || c.ID == nil {
 		return 0
 	}
 	return *c.ID
 }
 
-// GetLogin returns the Login field if it's non-nil, zero value otherwise.
-func (c *Contributor) GetLogin() string {
-	if c == nil || c.Login == nil {
-		return ""
+// GetInvitee returns the Invitee field.
+func (c *CollaboratorInvitation) GetInvitee() *User {
+	if c == nil {
+		return nil
 	}
-	return *c.Login
+	return c.Invitee
 }
 
-// GetName returns the Name field if it's non-nil, zero value otherwise.
-func (c *Contributor) GetName() string {
-	if c == nil || c.Name == nil {
-		return ""
+// GetInviter returns the Inviter field.
+func (c *CollaboratorInvitation) GetInviter() *User {
+	if c == nil {
+		return nil
 	}
-	return *c.Name
+	return c.Inviter
 }
 
-// GetNodeID returns the NodeID field if it's non-nil, zero value otherwise.
-func (c *Contributor) GetNodeID() string {
-	if c == nil || c.NodeID == nil {
+// GetPermissions returns the Permissions field if it's non-nil, zero value otherwise.
+func (c *CollaboratorInvitation) GetPermissions() string {
+	if c == nil || c.Permissions == nil {
 		return ""
 	}
-	return *c.NodeID
+	return *c.Permissions
 }
 
-// GetOrganizationsURL returns the OrganizationsURL field if it's non-nil, zero value otherwise.
-func (c *Contributor) GetOrganizationsURL() string {
-	if c == nil || c.OrganizationsURL == nil {
-		return ""
+// GetRepo returns the Repo field.
+func (c *CollaboratorInvitation) GetRepo() *Repository {
+	if c == nil {
+		return nil
 	}
-	return *c.OrganizationsURL
+	return c.Repo
 }
 
-// GetReceivedEventsURL returns the ReceivedEventsURL field if it's non-nil, zero value otherwise.
-func (c *Contributor) GetReceivedEventsURL() string {
-	if c == nil || c.ReceivedEventsURL == nil {
+// GetURL returns the URL field if it's non-nil, zero value otherwise.
+func (c *CollaboratorInvitation) GetURL() string {
+	if c == nil || c.URL == nil {
 		return ""
 	}
-	return *c.ReceivedEventsURL
+	return *c.URL
 }
 
-// GetReposURL returns the ReposURL field if it's non-nil, zero value otherwise.
-func (c *Contributor) GetReposURL() string {
-	if c == nil || c.ReposURL == nil {
+// GetCommitURL returns the CommitURL field if it's non-nil, zero value otherwise.
+func (c *CombinedStatus) GetCommitURL() string {
+	if c == nil || c.CommitURL == nil {
 		return ""
 	}
-	return *c.ReposURL
-}
-
-// GetSiteAdmin returns the SiteAdmin field if it's non-nil, zero value otherwise.
-func (c *Contributor) GetSiteAdmin() bool {
-	if c == nil || c.SiteAdmin == nil {
-		return false
-	}
-	return *c.SiteAdmin
+	return *c.CommitURL
 }
 
-// GetStarredURL returns the StarredURL field if it's non-nil, zero value otherwise.
-func (c *Contributor) GetStarredURL() string {
-	if c == nil || c.StarredURL == nil {
+// GetName returns the Name field if it's non-nil, zero value otherwise.
+func (c *CombinedStatus) GetName() string {
+	if c == nil || c.Name == nil {
 		return ""
 	}
-	return *c.StarredURL
+	return *c.Name
 }
 
-// GetSubscriptionsURL returns the SubscriptionsURL field if it's non-nil, zero value otherwise.
-func (c *Contributor) GetSubscriptionsURL() string {
-	if c == nil || c.SubscriptionsURL == nil {
+// GetRepositoryURL returns the RepositoryURL field if it's non-nil, zero value otherwise.
+func (c *CombinedStatus) GetRepositoryURL() string {
+	if c == nil || c.RepositoryURL == nil {
 		return ""
 	}
-	return *c.SubscriptionsURL
+	return *c.RepositoryURL
 }
 
-// GetType returns the Type field if it's non-nil, zero value otherwise.
-func (c *Contributor) GetType() string {
-	if c == nil || c.Type == nil {
+// GetSHA returns the SHA field if it's non-nil, zero value otherwise.
+func (c *CombinedStatus) GetSHA() string {
+	if c == nil || c.SHA == nil {
 		return ""
 	}
-	return *c.Type
+	return *c.SHA
 }
 
-// GetURL returns the URL field if it's non-nil, zero value otherwise.
-func (c *Contributor) GetURL() string {
-	if c == nil || c.URL == nil {
+// GetState returns the State field if it's non-nil, zero value otherwise.
+func (c *CombinedStatus) GetState() string {
+	if c == nil || c.State == nil {
 		return ""
 	}
-	return *c.URL
+	return *c.State
 }
 
-// GetAuthor returns the Author field.
-func (c *ContributorStats) GetAuthor() *Contributor {
-	if c == nil {
-		return nil
+// GetTotalCount returns the TotalCount field if it's non-nil, zero value otherwise.
+func (c *CombinedStatus) GetTotalCount() int {
+	if c == nil || c.TotalCount == nil {
+		return 0
 	}
-	return c.Author
+	return *c.TotalCount
 }
 
-// GetTotal returns the Total field if it's non-nil, zero value otherwise.
-func (c *ContributorStats) GetTotal() int {
-	if c == nil || c.Total == nil {
-		return 0
+// GetCreatedAt returns the CreatedAt field if it's non-nil, zero value otherwise.
+func (c *Comment) GetCreatedAt() time.Time {
+	if c == nil || c.CreatedAt == nil {
+		return time.Time{}
 	}
-	return *c.Total
+	return *c.CreatedAt
 }
 
-// GetCompletedAt returns the CompletedAt field if it's non-nil, zero value otherwise.
-func (c *CreateCheckRunOptions) GetCompletedAt() Timestamp {
-	if c == nil || c.CompletedAt == nil {
-		return Timestamp{}
+// GetTotalCommitComments returns the TotalCommitComments field if it's non-nil, zero value otherwise.
+func (c *CommentStats) GetTotalCommitComments() int {
+	if c == nil || c.TotalCommitComments == nil {
+		return 0
 	}
-	return *c.CompletedAt
+	return *c.TotalCommitComments
 }
 
-// GetConclusion returns the Conclusion field if it's non-nil, zero value otherwise.
-func (c *CreateCheckRunOptions) GetConclusion() string {
-	if c == nil || c.Conclusion == nil {
-		return ""
+// GetTotalGistComments returns the TotalGistComments field if it's non-nil, zero value otherwise.
+func (c *CommentStats) GetTotalGistComments() int {
+	if c == nil || c.TotalGistComments == nil {
+		return 0
 	}
-	return *c.Conclusion
+	return *c.TotalGistComments
 }
 
-// GetDetailsURL returns the DetailsURL field if it's non-nil, zero value otherwise.
-func (c *CreateCheckRunOptions) GetDetailsURL() string {
-	if c == nil || c.DetailsURL == nil {
-		return ""
+// GetTotalIssueComments returns the TotalIssueComments field if it's non-nil, zero value otherwise.
+func (c *CommentStats) GetTotalIssueComments() int {
+	if c == nil || c.TotalIssueComments == nil {
+		return 0
 	}
-	return *c.DetailsURL
+	return *c.TotalIssueComments
 }
 
-// GetExternalID returns the ExternalID field if it's non-nil, zero value otherwise.
-func (c *CreateCheckRunOptions) GetExternalID() string {
-	if c == nil || c.ExternalID == nil {
-		return ""
+// GetTotalPullRequestComments returns the TotalPullRequestComments field if it's non-nil, zero value otherwise.
+func (c *CommentStats) GetTotalPullRequestComments() int {
+	if c == nil || c.TotalPullRequestComments == nil {
+		return 0
 	}
-	return *c.ExternalID
+	return *c.TotalPullRequestComments
 }
 
-// GetOutput returns the Output field.
-func (c *CreateCheckRunOptions) GetOutput() *CheckRunOutput {
+// GetAuthor returns the Author field.
+func (c *Commit) GetAuthor() *CommitAuthor {
 	if c == nil {
 		return nil
 	}
-	return c.Output
+	return c.Author
 }
 
-// GetStartedAt returns the StartedAt field if it's non-nil, zero value otherwise.
-func (c *CreateCheckRunOptions) GetStartedAt() Timestamp {
-	if c == nil || c.StartedAt == nil {
-		return Timestamp{}
+// GetCommentCount returns the CommentCount field if it's non-nil, zero value otherwise.
+func (c *Commit) GetCommentCount() int {
+	if c == nil || c.CommentCount == nil {
+		return 0
 	}
-	return *c.StartedAt
+	return *c.CommentCount
 }
 
-// GetStatus returns the Status field if it's non-nil, zero value otherwise.
-func (c *CreateCheckRunOptions) GetStatus() string {
-	if c == nil || c.Status == nil {
-		return ""
+// GetCommitter returns the Committer field.
+func (c *Commit) GetCommitter() *CommitAuthor {
+	if c == nil {
+		return nil
 	}
-	return *c.Status
+	return c.Committer
 }
 
-// GetHeadBranch returns the HeadBranch field if it's non-nil, zero value otherwise.
-func (c *CreateCheckSuiteOptions) GetHeadBranch() string {
-	if c == nil || c.HeadBranch == nil {
+// GetHTMLURL returns the HTMLURL field if it's non-nil, zero value otherwise.
+func (c *Commit) GetHTMLURL() string {
+	if c == nil || c.HTMLURL == nil {
 		return ""
 	}
-	return *c.HeadBranch
+	return *c.HTMLURL
 }
 
-// GetDescription returns the Description field if it's non-nil, zero value otherwise.
-func (c *CreateEvent) GetDescription() string {
-	if c == nil || c.Description == nil {
+// GetMessage returns the Message field if it's non-nil, zero value otherwise.
+func (c *Commit) GetMessage() string {
+	if c == nil || c.Message == nil {
 		return ""
 	}
-	return *c.Description
+	return *c.Message
 }
 
-// GetInstallation returns the Installation field.
-func (c *CreateEvent) GetInstallation() *Installation {
-	if c == nil {
-		return nil
+// GetNodeID returns the NodeID field if it's non-nil, zero value otherwise.
+func (c *Commit) GetNodeID() string {
+	if c == nil || c.NodeID == nil {
+		return ""
 	}
-	return c.Installation
+	return *c.NodeID
 }
 
-// GetMasterBranch returns the MasterBranch field if it's non-nil, zero value otherwise.
-func (c *CreateEvent) GetMasterBranch() string {
-	if c == nil || c.MasterBranch == nil {
+// GetSHA returns the SHA field if it's non-nil, zero value otherwise.
+func (c *Commit) GetSHA() string {
+	if c == nil || c.SHA == nil {
 		return ""
 	}
-	return *c.MasterBranch
+	return *c.SHA
 }
 
-// GetPusherType returns the PusherType field if it's non-nil, zero value otherwise.
-func (c *CreateEvent) GetPusherType() string {
-	if c == nil || c.PusherType == nil {
-		return ""
+// GetStats returns the Stats field.
+func (c *Commit) GetStats() *CommitStats {
+	if c == nil {
+		return nil
 	}
-	return *c.PusherType
+	return c.Stats
 }
 
-// GetRef returns the Ref field if it's non-nil, zero value otherwise.
-func (c *CreateEvent) GetRef() string {
-	if c == nil || c.Ref == nil {
-		return ""
+// GetTree returns the Tree field.
+func (c *Commit) GetTree() *Tree {
+	if c == nil {
+		return nil
 	}
-	return *c.Ref
+	return c.Tree
 }
 
-// GetRefType returns the RefType field if it's non-nil, zero value otherwise.
-func (c *CreateEvent) GetRefType() string {
-	if c == nil || c.RefType == nil {
+// GetURL returns the URL field if it's non-nil, zero value otherwise.
+func (c *Commit) GetURL() string {
+	if c == nil || c.URL == nil {
 		return ""
 	}
-	return *c.RefType
+	return *c.URL
 }
 
-// GetRepo returns the Repo field.
-func (c *CreateEvent) GetRepo() *Repository {
+// GetVerification returns the Verification field.
+func (c *Commit) GetVerification() *SignatureVerification {
 	if c == nil {
 		return nil
 	}
-	return c.Repo
+	return c.Verification
 }
 
-// GetSender returns the Sender field.
-func (c *CreateEvent) GetSender() *User {
-	if c == nil {
-		return nil
+// GetDate returns the Date field if it's non-nil, zero value otherwise.
+func (c *CommitAuthor) GetDate() time.Time {
+	if c == nil || c.Date == nil {
+		return time.Time{}
 	}
-	return c.Sender
+	return *c.Date
 }
 
 // GetEmail returns the Email field if it's non-nil, zero value otherwise.
-func (c *CreateOrgInvitationOptions) GetEmail() string {
+func (c *CommitAuthor) GetEmail() string {
 	if c == nil || c.Email == nil {
 		return ""
 	}
 	return *c.Email
 }
 
-// GetInviteeID returns the InviteeID field if it's non-nil, zero value otherwise.
-func (c *CreateOrgInvitationOptions) GetInviteeID() int64 {
-	if c == nil || c.InviteeID == nil {
-		return 0
+// GetLogin returns the Login field if it's non-nil, zero value otherwise.
+func (c *CommitAuthor) GetLogin() string {
+	if c == nil || c.Login == nil {
+		return ""
 	}
-	return *c.InviteeID
+	return *c.Login
 }
 
-// GetRole returns the Role field if it's non-nil, zero value otherwise.
-func (c *CreateOrgInvitationOptions) GetRole() string {
-	if c == nil || c.Role == nil {
+// GetName returns the Name field if it's non-nil, zero value otherwise.
+func (c *CommitAuthor) GetName() string {
+	if c == nil || c.Name == nil {
 		return ""
 	}
-	return *c.Role
+	return *c.Name
 }
 
-// GetBody returns the Body field if it's non-nil, zero value otherwise.
-func (c *CreateUserProjectOptions) GetBody() string {
-	if c == nil || c.Body == nil {
-		return ""
-	}
-	return *c.Body
-}
-
-// GetInstallation returns the Installation field.
-func (d *DeleteEvent) GetInstallation() *Installation {
-	if d == nil {
-		return nil
-	}
-	return d.Installation
-}
-
-// GetPusherType returns the PusherType field if it's non-nil, zero value otherwise.
-func (d *DeleteEvent) GetPusherType() string {
-	if d == nil || d.PusherType == nil {
+// GetAction returns the Action field if it's non-nil, zero value otherwise.
+func (c *CommitCommentEvent) GetAction() string {
+	if c == nil || c.Action == nil {
 		return ""
 	}
-	return *d.PusherType
+	return *c.Action
 }
 
-// GetRef returns the Ref field if it's non-nil, zero value otherwise.
-func (d *DeleteEvent) GetRef() string {
-	if d == nil || d.Ref == nil {
-		return ""
+// GetComment returns the Comment field.
+func (c *CommitCommentEvent) GetComment() *RepositoryComment {
+	if c == nil {
+		return nil
 	}
-	return *d.Ref
+	return c.Comment
 }
 
-// GetRefType returns the RefType field if it's non-nil, zero value otherwise.
-func (d *DeleteEvent) GetRefType() string {
-	if d == nil || d.RefType == nil {
-		return ""
+// GetInstallation returns the Installation field.
+func (c *CommitCommentEvent) GetInstallation() *Installation {
+	if c == nil {
+		return nil
 	}
-	return *d.RefType
+	return c.Installation
 }
 
 // GetRepo returns the Repo field.
-func (d *DeleteEvent) GetRepo() *Repository {
-	if d == nil {
+func (c *CommitCommentEvent) GetRepo() *Repository {
+	if c == nil {
 		return nil
 	}
-	return d.Repo
+	return c.Repo
 }
 
 // GetSender returns the Sender field.
-func (d *DeleteEvent) GetSender() *User {
-	if d == nil {
-		return nil
-	}
-	return d.Sender
-}
-
-// GetAction returns the Action field if it's non-nil, zero value otherwise.
-func (d *DeployKeyEvent) GetAction() string {
-	if d == nil || d.Action == nil {
-		return ""
-	}
-	return *d.Action
-}
-
-// GetKey returns the Key field.
-func (d *DeployKeyEvent) GetKey() *Key {
-	if d == nil {
+func (c *CommitCommentEvent) GetSender() *User {
+	if c == nil {
 		return nil
 	}
-	return d.Key
+	return c.Sender
 }
 
-// GetCreatedAt returns the CreatedAt field if it's non-nil, zero value otherwise.
-func (d *Deployment) GetCreatedAt() Timestamp {
-	if d == nil || d.CreatedAt == nil {
-		return Timestamp{}
+// GetAdditions returns the Additions field if it's non-nil, zero value otherwise.
+func (c *CommitFile) GetAdditions() int {
+	if c == nil || c.Additions == nil {
+		return 0
 	}
-	return *d.CreatedAt
+	return *c.Additions
 }
 
-// GetCreator returns the Creator field.
-func (d *Deployment) GetCreator() *User {
-	if d == nil {
-		return nil
+// GetBlobURL returns the BlobURL field if it's non-nil, zero value otherwise.
+func (c *CommitFile) GetBlobURL() string {
+	if c == nil || c.BlobURL == nil {
+		return ""
 	}
-	return d.Creator
+	return *c.BlobURL
 }
 
-// GetDescription returns the Description field if it's non-nil, zero value otherwise.
-func (d *Deployment) GetDescription() string {
-	if d == nil || d.Description == nil {
-		return ""
+// GetChanges returns the Changes field if it's non-nil, zero value otherwise.
+func (c *CommitFile) GetChanges() int {
+	if c == nil || c.Changes == nil {
+		return 0
 	}
-	return *d.Description
+	return *c.Changes
 }
 
-// GetEnvironment returns the Environment field if it's non-nil, zero value otherwise.
-func (d *Deployment) GetEnvironment() string {
-	if d == nil || d.Environment == nil {
+// GetContentsURL returns the ContentsURL field if it's non-nil, zero value otherwise.
+func (c *CommitFile) GetContentsURL() string {
+	if c == nil || c.ContentsURL == nil {
 		return ""
 	}
-	return *d.Environment
+	return *c.ContentsURL
 }
 
-// GetID returns the ID field if it's non-nil, zero value otherwise.
-func (d *Deployment) GetID() int64 {
-	if d == nil || d.ID == nil {
+// GetDeletions returns the Deletions field if it's non-nil, zero value otherwise.
+func (c *CommitFile) GetDeletions() int {
+	if c == nil || c.Deletions == nil {
 		return 0
 	}
-	return *d.ID
+	return *c.Deletions
 }
 
-// GetNodeID returns the NodeID field if it's non-nil, zero value otherwise.
-func (d *Deployment) GetNodeID() string {
-	if d == nil || d.NodeID == nil {
+// GetFilename returns the Filename field if it's non-nil, zero value otherwise.
+func (c *CommitFile) GetFilename() string {
+	if c == nil || c.Filename == nil {
 		return ""
 	}
-	return *d.NodeID
+	return *c.Filename
 }
 
-// GetRef returns the Ref field if it's non-nil, zero value otherwise.
-func (d *Deployment) GetRef() string {
-	if d == nil || d.Ref == nil {
+// GetPatch returns the Patch field if it's non-nil, zero value otherwise.
+func (c *CommitFile) GetPatch() string {
+	if c == nil || c.Patch == nil {
 		return ""
 	}
-	return *d.Ref
+	return *c.Patch
 }
 
-// GetRepositoryURL returns the RepositoryURL field if it's non-nil, zero value otherwise.
-func (d *Deployment) GetRepositoryURL() string {
-	if d == nil || d.RepositoryURL == nil {
+// GetPreviousFilename returns the PreviousFilename field if it's non-nil, zero value otherwise.
+func (c *CommitFile) GetPreviousFilename() string {
+	if c == nil || c.PreviousFilename == nil {
 		return ""
 	}
-	return *d.RepositoryURL
+	return *c.PreviousFilename
 }
 
-// GetSHA returns the SHA field if it's non-nil, zero value otherwise.
-func (d *Deployment) GetSHA() string {
-	if d == nil || d.SHA == nil {
+// GetRawURL returns the RawURL field if it's non-nil, zero value otherwise.
+func (c *CommitFile) GetRawURL() string {
+	if c == nil || c.RawURL == nil {
 		return ""
 	}
-	return *d.SHA
+	return *c.RawURL
 }
 
-// GetStatusesURL returns the StatusesURL field if it's non-nil, zero value otherwise.
-func (d *Deployment) GetStatusesURL() string {
-	if d == nil || d.StatusesURL == nil {
+// GetSHA returns the SHA field if it's non-nil, zero value otherwise.
+func (c *CommitFile) GetSHA() string {
+	if c == nil || c.SHA == nil {
 		return ""
 	}
-	return *d.StatusesURL
+	return *c.SHA
 }
 
-// GetTask returns the Task field if it's non-nil, zero value otherwise.
-func (d *Deployment) GetTask() string {
-	if d == nil || d.Task == nil {
+// GetStatus returns the Status field if it's non-nil, zero value otherwise.
+func (c *CommitFile) GetStatus() string {
+	if c == nil || c.Status == nil {
 		return ""
 	}
-	return *d.Task
+	return *c.Status
 }
 
-// GetUpdatedAt returns the UpdatedAt field if it's non-nil, zero value otherwise.
-func (d *Deployment) GetUpdatedAt() Timestamp {
-	if d == nil || d.UpdatedAt == nil {
-		return Timestamp{}
+// GetAuthor returns the Author field.
+func (c *CommitResult) GetAuthor() *User {
+	if c == nil {
+		return nil
 	}
-	return *d.UpdatedAt
+	return c.Author
 }
 
-// GetURL returns the URL field if it's non-nil, zero value otherwise.
-func (d *Deployment) GetURL() string {
-	if d == nil || d.URL == nil {
+// GetCommentsURL returns the CommentsURL field if it's non-nil, zero value otherwise.
+func (c *CommitResult) GetCommentsURL() string {
+	if c == nil || c.CommentsURL == nil {
 		return ""
 	}
-	return *d.URL
+	return *c.CommentsURL
 }
 
-// GetDeployment returns the Deployment field.
-func (d *DeploymentEvent) GetDeployment() *Deployment {
-	if d == nil {
+// GetCommit returns the Commit field.
+func (c *CommitResult) GetCommit() *Commit {
+	if c == nil {
 		return nil
 	}
-	return d.Deployment
+	return c.Commit
 }
 
-// GetInstallation returns the Installation field.
-func (d *DeploymentEvent) GetInstallation() *Installation {
-	if d == nil {
+// GetCommitter returns the Committer field.
+func (c *CommitResult) GetCommitter() *User {
+	if c == nil {
 		return nil
 	}
-	return d.Installation
+	return c.Committer
 }
 
-// GetRepo returns the Repo field.
-func (d *DeploymentEvent) GetRepo() *Repository {
-	if d == nil {
-		return nil
+// GetHTMLURL returns the HTMLURL field if it's non-nil, zero value otherwise.
+func (c *CommitResult) GetHTMLURL() string {
+	if c == nil || c.HTMLURL == nil {
+		return ""
 	}
-	return d.Repo
+	return *c.HTMLURL
 }
 
-// GetSender returns the Sender field.
-func (d *DeploymentEvent) GetSender() *User {
-	if d == nil {
+// GetRepository returns the Repository field.
+func (c *CommitResult) GetRepository() *Repository {
+	if c == nil {
 		return nil
 	}
-	return d.Sender
+	return c.Repository
 }
 
-// GetAutoMerge returns the AutoMerge field if it's non-nil, zero value otherwise.
-func (d *DeploymentRequest) GetAutoMerge() bool {
-	if d == nil || d.AutoMerge == nil {
-		return false
+// GetScore returns the Score field.
+func (c *CommitResult) GetScore() *float64 {
+	if c == nil {
+		return nil
 	}
-	return *d.AutoMerge
+	return c.Score
 }
 
-// GetDescription returns the Description field if it's non-nil, zero value otherwise.
-func (d *DeploymentRequest) GetDescription() string {
-	if d == nil || d.Description == nil {
+// GetSHA returns the SHA field if it's non-nil, zero value otherwise.
+func (c *CommitResult) GetSHA() string {
+	if c == nil || c.SHA == nil {
 		return ""
 	}
-	return *d.Description
+	return *c.SHA
 }
 
-// GetEnvironment returns the Environment field if it's non-nil, zero value otherwise.
-func (d *DeploymentRequest) GetEnvironment() string {
-	if d == nil || d.Environment == nil {
+// GetURL returns the URL field if it's non-nil, zero value otherwise.
+func (c *CommitResult) GetURL() string {
+	if c == nil || c.URL == nil {
 		return ""
 	}
-	return *d.Environment
+	return *c.URL
 }
 
-// GetProductionEnvironment returns the ProductionEnvironment field if it's non-nil, zero value otherwise.
-func (d *DeploymentRequest) GetProductionEnvironment() bool {
-	if d == nil || d.ProductionEnvironment == nil {
-		return false
+// GetAheadBy returns the AheadBy field if it's non-nil, zero value otherwise.
+func (c *CommitsComparison) GetAheadBy() int {
+	if c == nil || c.AheadBy == nil {
+		return 0
 	}
-	return *d.ProductionEnvironment
+	return *c.AheadBy
 }
 
-// GetRef returns the Ref field if it's non-nil, zero value otherwise.
-func (d *DeploymentRequest) GetRef() string {
-	if d == nil || d.Ref == nil {
-		return ""
-	}
-	return *d.Ref
-}
-
-// GetRequiredContexts returns the RequiredContexts field if it's non-nil, zero value otherwise.
-func (d *DeploymentRequest) GetRequiredContexts() []string {
-	if d == nil || d.RequiredContexts == nil {
+// GetBaseCommit returns the BaseCommit field.
+func (c *CommitsComparison) GetBaseCommit() *RepositoryCommit {
+	if c == nil {
 		return nil
 	}
-	return *d.RequiredContexts
+	return c.BaseCommit
 }
 
-// GetTask returns the Task field if it's non-nil, zero value otherwise.
-func (d *DeploymentRequest) GetTask() string {
-	if d == nil || d.Task == nil {
-		return ""
+// GetBehindBy returns the BehindBy field if it's non-nil, zero value otherwise.
+func (c *CommitsComparison) GetBehindBy() int {
+	if c == nil || c.BehindBy == nil {
+		return 0
 	}
-	return *d.Task
+	return *c.BehindBy
 }
 
-// GetTransientEnvironment returns the TransientEnvironment field if it's non-nil, zero value otherwise.
-func (d *DeploymentRequest) GetTransientEnvironment() bool {
-	if d == nil || d.TransientEnvironment == nil {
-		return false
+// GetDiffURL returns the DiffURL field if it's non-nil, zero value otherwise.
+func (c *CommitsComparison) GetDiffURL() string {
+	if c == nil || c.DiffURL == nil {
+		return ""
 	}
-	return *d.TransientEnvironment
+	return *c.DiffURL
 }
 
-// GetCreatedAt returns the CreatedAt field if it's non-nil, zero value otherwise.
-func (d *DeploymentStatus) GetCreatedAt() Timestamp {
-	if d == nil || d.CreatedAt == nil {
-		return Timestamp{}
+// GetHTMLURL returns the HTMLURL field if it's non-nil, zero value otherwise.
+func (c *CommitsComparison) GetHTMLURL() string {
+	if c == nil || c.HTMLURL == nil {
+		return ""
 	}
-	return *d.CreatedAt
+	return *c.HTMLURL
 }
 
-// GetCreator returns the Creator field.
-func (d *DeploymentStatus) GetCreator() *User {
-	if d == nil {
+// GetMergeBaseCommit returns the MergeBaseCommit field.
+func (c *CommitsComparison) GetMergeBaseCommit() *RepositoryCommit {
+	if c == nil {
 		return nil
 	}
-	return d.Creator
+	return c.MergeBaseCommit
 }
 
-// GetDeploymentURL returns the DeploymentURL field if it's non-nil, zero value otherwise.
-func (d *DeploymentStatus) GetDeploymentURL() string {
-	if d == nil || d.DeploymentURL == nil {
+// GetPatchURL returns the PatchURL field if it's non-nil, zero value otherwise.
+func (c *CommitsComparison) GetPatchURL() string {
+	if c == nil || c.PatchURL == nil {
 		return ""
 	}
-	return *d.DeploymentURL
+	return *c.PatchURL
 }
 
-// GetDescription returns the Description field if it's non-nil, zero value otherwise.
-func (d *DeploymentStatus) GetDescription() string {
-	if d == nil || d.Description == nil {
+// GetPermalinkURL returns the PermalinkURL field if it's non-nil, zero value otherwise.
+func (c *CommitsComparison) GetPermalinkURL() string {
+	if c == nil || c.PermalinkURL == nil {
 		return ""
 	}
-	return *d.Description
+	return *c.PermalinkURL
 }
 
-// GetEnvironment returns the Environment field if it's non-nil, zero value otherwise.
-func (d *DeploymentStatus) GetEnvironment() string {
-	if d == nil || d.Environment == nil {
+// GetStatus returns the Status field if it's non-nil, zero value otherwise.
+func (c *CommitsComparison) GetStatus() string {
+	if c == nil || c.Status == nil {
 		return ""
 	}
-	return *d.Environment
+	return *c.Status
 }
 
-// GetEnvironmentURL returns the EnvironmentURL field if it's non-nil, zero value otherwise.
-func (d *DeploymentStatus) GetEnvironmentURL() string {
-	if d == nil || d.EnvironmentURL == nil {
-		return ""
+// GetTotalCommits returns the TotalCommits field if it's non-nil, zero value otherwise.
+func (c *CommitsComparison) GetTotalCommits() int {
+	if c == nil || c.TotalCommits == nil {
+		return 0
 	}
-	return *d.EnvironmentURL
+	return *c.TotalCommits
 }
 
-// GetID returns the ID field if it's non-nil, zero value otherwise.
-func (d *DeploymentStatus) GetID() int64 {
-	if d == nil || d.ID == nil {
-		return 0
+// GetURL returns the URL field if it's non-nil, zero value otherwise.
+func (c *CommitsComparison) GetURL() string {
+	if c == nil || c.URL == nil {
+		return ""
 	}
-	return *d.ID
+	return *c.URL
 }
 
-// GetLogURL returns the LogURL field if it's non-nil, zero value otherwise.
-func (d *DeploymentStatus) GetLogURL() string {
-	if d == nil || d.LogURL == nil {
-		return ""
+// GetIncompleteResults returns the IncompleteResults field if it's non-nil, zero value otherwise.
+func (c *CommitsSearchResult) GetIncompleteResults() bool {
+	if c == nil || c.IncompleteResults == nil {
+		return false
 	}
-	return *d.LogURL
+	return *c.IncompleteResults
 }
 
-// GetNodeID returns the NodeID field if it's non-nil, zero value otherwise.
-func (d *DeploymentStatus) GetNodeID() string {
-	if d == nil || d.NodeID == nil {
-		return ""
+// GetTotal returns the Total field if it's non-nil, zero value otherwise.
+func (c *CommitsSearchResult) GetTotal() int {
+	if c == nil || c.Total == nil {
+		return 0
 	}
-	return *d.NodeID
+	return *c.Total
 }
 
-// GetRepositoryURL returns the RepositoryURL field if it's non-nil, zero value otherwise.
-func (d *DeploymentStatus) GetRepositoryURL() string {
-	if d == nil || d.RepositoryURL == nil {
-		return ""
+// GetAdditions returns the Additions field if it's non-nil, zero value otherwise.
+func (c *CommitStats) GetAdditions() int {
+	if c == nil || c.Additions == nil {
+		return 0
 	}
-	return *d.RepositoryURL
+	return *c.Additions
 }
 
-// GetState returns the State field if it's non-nil, zero value otherwise.
-func (d *DeploymentStatus) GetState() string {
-	if d == nil || d.State == nil {
-		return ""
+// GetDeletions returns the Deletions field if it's non-nil, zero value otherwise.
+func (c *CommitStats) GetDeletions() int {
+	if c == nil || c.Deletions == nil {
+		return 0
 	}
-	return *d.State
+	return *c.Deletions
 }
 
-// GetTargetURL returns the TargetURL field if it's non-nil, zero value otherwise.
-func (d *DeploymentStatus) GetTargetURL() string {
-	if d == nil || d.TargetURL == nil {
-		return ""
+// GetTotal returns the Total field if it's non-nil, zero value otherwise.
+func (c *CommitStats) GetTotal() int {
+	if c == nil || c.Total == nil {
+		return 0
 	}
-	return *d.TargetURL
+	return *c.Total
 }
 
-// GetUpdatedAt returns the UpdatedAt field if it's non-nil, zero value otherwise.
-func (d *DeploymentStatus) GetUpdatedAt() Timestamp {
-	if d == nil || d.UpdatedAt == nil {
-		return Timestamp{}
+// GetCodeOfConduct returns the CodeOfConduct field.
+func (c *CommunityHealthFiles) GetCodeOfConduct() *Metric {
+	if c == nil {
+		return nil
 	}
-	return *d.UpdatedAt
+	return c.CodeOfConduct
 }
 
-// GetURL returns the URL field if it's non-nil, zero value otherwise.
-func (d *DeploymentStatus) GetURL() string {
-	if d == nil || d.URL == nil {
-		return ""
+// GetContributing returns the Contributing field.
+func (c *CommunityHealthFiles) GetContributing() *Metric {
+	if c == nil {
+		return nil
 	}
-	return *d.URL
+	return c.Contributing
 }
 
-// GetDeployment returns the Deployment field.
-func (d *DeploymentStatusEvent) GetDeployment() *Deployment {
-	if d == nil {
+// GetIssueTemplate returns the IssueTemplate field.
+func (c *CommunityHealthFiles) GetIssueTemplate() *Metric {
+	if c == nil {
 		return nil
 	}
-	return d.Deployment
+	return c.IssueTemplate
 }
 
-// GetDeploymentStatus returns the DeploymentStatus field.
-func (d *DeploymentStatusEvent) GetDeploymentStatus() *DeploymentStatus {
-	if d == nil {
+// GetLicense returns the License field.
+func (c *CommunityHealthFiles) GetLicense() *Metric {
+	if c == nil {
 		return nil
 	}
-	return d.DeploymentStatus
+	return c.License
 }
 
-// GetInstallation returns the Installation field.
-func (d *DeploymentStatusEvent) GetInstallation() *Installation {
-	if d == nil {
+// GetPullRequestTemplate returns the PullRequestTemplate field.
+func (c *CommunityHealthFiles) GetPullRequestTemplate() *Metric {
+	if c == nil {
 		return nil
 	}
-	return d.Installation
+	return c.PullRequestTemplate
 }
 
-// GetRepo returns the Repo field.
-func (d *DeploymentStatusEvent) GetRepo() *Repository {
-	if d == nil {
+// GetReadme returns the Readme field.
+func (c *CommunityHealthFiles) GetReadme() *Metric {
+	if c == nil {
 		return nil
 	}
-	return d.Repo
+	return c.Readme
 }
 
-// GetSender returns the Sender field.
-func (d *DeploymentStatusEvent) GetSender() *User {
-	if d == nil {
+// GetFiles returns the Files field.
+func (c *CommunityHealthMetrics) GetFiles() *CommunityHealthFiles {
+	if c == nil {
 		return nil
 	}
-	return d.Sender
+	return c.Files
 }
 
-// GetAutoInactive returns the AutoInactive field if it's non-nil, zero value otherwise.
-func (d *DeploymentStatusRequest) GetAutoInactive() bool {
-	if d == nil || d.AutoInactive == nil {
-		return false
+// GetHealthPercentage returns the HealthPercentage field if it's non-nil, zero value otherwise.
+func (c *CommunityHealthMetrics) GetHealthPercentage() int {
+	if c == nil || c.HealthPercentage == nil {
+		return 0
 	}
-	return *d.AutoInactive
+	return *c.HealthPercentage
 }
 
-// GetDescription returns the Description field if it's non-nil, zero value otherwise.
-func (d *DeploymentStatusRequest) GetDescription() string {
-	if d == nil || d.Description == nil {
-		return ""
+// GetUpdatedAt returns the UpdatedAt field if it's non-nil, zero value otherwise.
+func (c *CommunityHealthMetrics) GetUpdatedAt() time.Time {
+	if c == nil || c.UpdatedAt == nil {
+		return time.Time{}
 	}
-	return *d.Description
+	return *c.UpdatedAt
 }
 
-// GetEnvironment returns the Environment field if it's non-nil, zero value otherwise.
-func (d *DeploymentStatusRequest) GetEnvironment() string {
-	if d == nil || d.Environment == nil {
-		return ""
+// GetID returns the ID field if it's non-nil, zero value otherwise.
+func (c *ContentReference) GetID() int64 {
+	if c == nil || c.ID == nil {
+		return 0
 	}
-	return *d.Environment
+	return *c.ID
 }
 
-// GetEnvironmentURL returns the EnvironmentURL field if it's non-nil, zero value otherwise.
-func (d *DeploymentStatusRequest) GetEnvironmentURL() string {
-	if d == nil || d.EnvironmentURL == nil {
+// GetNodeID returns the NodeID field if it's non-nil, zero value otherwise.
+func (c *ContentReference) GetNodeID() string {
+	if c == nil || c.NodeID == nil {
 		return ""
 	}
-	return *d.EnvironmentURL
+	return *c.NodeID
 }
 
-// GetLogURL returns the LogURL field if it's non-nil, zero value otherwise.
-func (d *DeploymentStatusRequest) GetLogURL() string {
-	if d == nil || d.LogURL == nil {
+// GetReference returns the Reference field if it's non-nil, zero value otherwise.
+func (c *ContentReference) GetReference() string {
+	if c == nil || c.Reference == nil {
 		return ""
 	}
-	return *d.LogURL
+	return *c.Reference
 }
 
-// GetState returns the State field if it's non-nil, zero value otherwise.
-func (d *DeploymentStatusRequest) GetState() string {
-	if d == nil || d.State == nil {
+// GetAction returns the Action field if it's non-nil, zero value otherwise.
+func (c *ContentReferenceEvent) GetAction() string {
+	if c == nil || c.Action == nil {
 		return ""
 	}
-	return *d.State
+	return *c.Action
 }
 
-// GetAuthor returns the Author field.
-func (d *DiscussionComment) GetAuthor() *User {
-	if d == nil {
+// GetContentReference returns the ContentReference field.
+func (c *ContentReferenceEvent) GetContentReference() *ContentReference {
+	if c == nil {
 		return nil
 	}
-	return d.Author
+	return c.ContentReference
 }
 
-// GetBody returns the Body field if it's non-nil, zero value otherwise.
-func (d *DiscussionComment) GetBody() string {
-	if d == nil || d.Body == nil {
-		return ""
+// GetInstallation returns the Installation field.
+func (c *ContentReferenceEvent) GetInstallation() *Installation {
+	if c == nil {
+		return nil
 	}
-	return *d.Body
+	return c.Installation
 }
 
-// GetBodyHTML returns the BodyHTML field if it's non-nil, zero value otherwise.
-func (d *DiscussionComment) GetBodyHTML() string {
-	if d == nil || d.BodyHTML == nil {
-		return ""
+// GetRepo returns the Repo field.
+func (c *ContentReferenceEvent) GetRepo() *Repository {
+	if c == nil {
+		return nil
 	}
-	return *d.BodyHTML
+	return c.Repo
 }
 
-// GetBodyVersion returns the BodyVersion field if it's non-nil, zero value otherwise.
-func (d *DiscussionComment) GetBodyVersion() string {
-	if d == nil || d.BodyVersion == nil {
+// GetSender returns the Sender field.
+func (c *ContentReferenceEvent) GetSender() *User {
+	if c == nil {
+		return nil
+	}
+	return c.Sender
+}
+
+// GetAvatarURL returns the AvatarURL field if it's non-nil, zero value otherwise.
+func (c *Contributor) GetAvatarURL() string {
+	if c == nil || c.AvatarURL == nil {
 		return ""
 	}
-	return *d.BodyVersion
+	return *c.AvatarURL
 }
 
-// GetCreatedAt returns the CreatedAt field if it's non-nil, zero value otherwise.
-func (d *DiscussionComment) GetCreatedAt() Timestamp {
-	if d == nil || d.CreatedAt == nil {
-		return Timestamp{}
+// GetContributions returns the Contributions field if it's non-nil, zero value otherwise.
+func (c *Contributor) GetContributions() int {
+	if c == nil || c.Contributions == nil {
+		return 0
 	}
-	return *d.CreatedAt
+	return *c.Contributions
 }
 
-// GetDiscussionURL returns the DiscussionURL field if it's non-nil, zero value otherwise.
-func (d *DiscussionComment) GetDiscussionURL() string {
-	if d == nil || d.DiscussionURL == nil {
+// GetEmail returns the Email field if it's non-nil, zero value otherwise.
+func (c *Contributor) GetEmail() string {
+	if c == nil || c.Email == nil {
 		return ""
 	}
-	return *d.DiscussionURL
+	return *c.Email
 }
 
-// GetHTMLURL returns the HTMLURL field if it's non-nil, zero value otherwise.
-func (d *DiscussionComment) GetHTMLURL() string {
-	if d == nil || d.HTMLURL == nil {
+// GetEventsURL returns the EventsURL field if it's non-nil, zero value otherwise.
+func (c *Contributor) GetEventsURL() string {
+	if c == nil || c.EventsURL == nil {
 		return ""
 	}
-	return *d.HTMLURL
+	return *c.EventsURL
 }
 
-// GetLastEditedAt returns the LastEditedAt field if it's non-nil, zero value otherwise.
-func (d *DiscussionComment) GetLastEditedAt() Timestamp {
-	if d == nil || d.LastEditedAt == nil {
-		return Timestamp{}
+// GetFollowersURL returns the FollowersURL field if it's non-nil, zero value otherwise.
+func (c *Contributor) GetFollowersURL() string {
+	if c == nil || c.FollowersURL == nil {
+		return ""
 	}
-	return *d.LastEditedAt
+	return *c.FollowersURL
 }
 
-// GetNodeID returns the NodeID field if it's non-nil, zero value otherwise.
-func (d *DiscussionComment) GetNodeID() string {
-	if d == nil || d.NodeID == nil {
+// GetFollowingURL returns the FollowingURL field if it's non-nil, zero value otherwise.
+func (c *Contributor) GetFollowingURL() string {
+	if c == nil || c.FollowingURL == nil {
 		return ""
 	}
-	return *d.NodeID
+	return *c.FollowingURL
 }
 
-// GetNumber returns the Number field if it's non-nil, zero value otherwise.
-func (d *DiscussionComment) GetNumber() int {
-	if d == nil || d.Number == nil {
-		return 0
+// GetGistsURL returns the GistsURL field if it's non-nil, zero value otherwise.
+func (c *Contributor) GetGistsURL() string {
+	if c == nil || c.GistsURL == nil {
+		return ""
 	}
-	return *d.Number
+	return *c.GistsURL
 }
 
-// GetReactions returns the Reactions field.
-func (d *DiscussionComment) GetReactions() *Reactions {
-	if d == nil {
-		return nil
+// GetGravatarID returns the GravatarID field if it's non-nil, zero value otherwise.
+func (c *Contributor) GetGravatarID() string {
+	if c == nil || c.GravatarID == nil {
+		return ""
 	}
-	return d.Reactions
+	return *c.GravatarID
 }
 
-// GetUpdatedAt returns the UpdatedAt field if it's non-nil, zero value otherwise.
-func (d *DiscussionComment) GetUpdatedAt() Timestamp {
-	if d == nil || d.UpdatedAt == nil {
-		return Timestamp{}
+// GetHTMLURL returns the HTMLURL field if it's non-nil, zero value otherwise.
+func (c *Contributor) GetHTMLURL() string {
+	if c == nil || c.HTMLURL == nil {
+		return ""
 	}
-	return *d.UpdatedAt
+	return *c.HTMLURL
 }
 
-// GetURL returns the URL field if it's non-nil, zero value otherwise.
-func (d *DiscussionComment) GetURL() string {
-	if d == nil || d.URL == nil {
-		return ""
+// GetID returns the ID field if it's non-nil, zero value otherwise.
+func (c *Contributor) GetID() int64 {
+	if c == nil || c.ID == nil {
+		return 0
 	}
-	return *d.URL
+	return *c.ID
 }
 
-// GetTeams returns the Teams field if it's non-nil, zero value otherwise.
-func (d *DismissalRestrictionsRequest) GetTeams() []string {
-	if d == nil || d.Teams == nil {
-		return nil
+// GetLogin returns the Login field if it's non-nil, zero value otherwise.
+func (c *Contributor) GetLogin() string {
+	if c == nil || c.Login == nil {
+		return ""
 	}
-	return *d.Teams
+	return *c.Login
 }
 
-// GetUsers returns the Users field if it's non-nil, zero value otherwise.
-func (d *DismissalRestrictionsRequest) GetUsers() []string {
-	if d == nil || d.Users == nil {
-		return nil
+// GetName returns the Name field if it's non-nil, zero value otherwise.
+func (c *Contributor) GetName() string {
+	if c == nil || c.Name == nil {
+		return ""
 	}
-	return *d.Users
+	return *c.Name
 }
 
-// GetDismissalCommitID returns the DismissalCommitID field if it's non-nil, zero value otherwise.
-func (d *DismissedReview) GetDismissalCommitID() string {
-	if d == nil || d.DismissalCommitID == nil {
+// GetNodeID returns the NodeID field if it's non-nil, zero value otherwise.
+func (c *Contributor) GetNodeID() string {
+	if c == nil || c.NodeID == nil {
 		return ""
 	}
-	return *d.DismissalCommitID
+	return *c.NodeID
 }
 
-// GetDismissalMessage returns the DismissalMessage field if it's non-nil, zero value otherwise.
-func (d *DismissedReview) GetDismissalMessage() string {
-	if d == nil || d.DismissalMessage == nil {
+// GetOrganizationsURL returns the OrganizationsURL field if it's non-nil, zero value otherwise.
+func (c *Contributor) GetOrganizationsURL() string {
+	if c == nil || c.OrganizationsURL == nil {
 		return ""
 	}
-	return *d.DismissalMessage
+	return *c.OrganizationsURL
 }
 
-// GetReviewID returns the ReviewID field if it's non-nil, zero value otherwise.
-func (d *DismissedReview) GetReviewID() int64 {
-	if d == nil || d.ReviewID == nil {
-		return 0
+// GetReceivedEventsURL returns the ReceivedEventsURL field if it's non-nil, zero value otherwise.
+func (c *Contributor) GetReceivedEventsURL() string {
+	if c == nil || c.ReceivedEventsURL == nil {
+		return ""
 	}
-	return *d.ReviewID
+	return *c.ReceivedEventsURL
 }
 
-// GetState returns the State field if it's non-nil, zero value otherwise.
-func (d *DismissedReview) GetState() string {
-	if d == nil || d.State == nil {
+// GetReposURL returns the ReposURL field if it's non-nil, zero value otherwise.
+func (c *Contributor) GetReposURL() string {
+	if c == nil || c.ReposURL == nil {
 		return ""
 	}
-	return *d.State
+	return *c.ReposURL
 }
 
-// GetClientPayload returns the ClientPayload field if it's non-nil, zero value otherwise.
-func (d *DispatchRequestOptions) GetClientPayload() json.RawMessage {
-	if d == nil || d.ClientPayload == nil {
-		return json.RawMessage{}
+// GetSiteAdmin returns the SiteAdmin field if it's non-nil, zero value otherwise.
+func (c *Contributor) GetSiteAdmin() bool {
+	if c == nil || c.SiteAdmin == nil {
+		return false
 	}
-	return *d.ClientPayload
+	return *c.SiteAdmin
 }
 
-// GetBody returns the Body field if it's non-nil, zero value otherwise.
-func (d *DraftReviewComment) GetBody() string {
-	if d == nil || d.Body == nil {
+// GetStarredURL returns the StarredURL field if it's non-nil, zero value otherwise.
+func (c *Contributor) GetStarredURL() string {
+	if c == nil || c.StarredURL == nil {
 		return ""
 	}
-	return *d.Body
+	return *c.StarredURL
 }
 
-// GetLine returns the Line field if it's non-nil, zero value otherwise.
-func (d *DraftReviewComment) GetLine() int {
-	if d == nil || d.Line == nil {
-		return 0
+// GetSubscriptionsURL returns the SubscriptionsURL field if it's non-nil, zero value otherwise.
+func (c *Contributor) GetSubscriptionsURL() string {
+	if c == nil || c.SubscriptionsURL == nil {
+		return ""
 	}
-	return *d.Line
+	return *c.SubscriptionsURL
 }
 
-// GetPath returns the Path field if it's non-nil, zero value otherwise.
-func (d *DraftReviewComment) GetPath() string {
-	if d == nil || d.Path == nil {
+// GetType returns the Type field if it's non-nil, zero value otherwise.
+func (c *Contributor) GetType() string {
+	if c == nil || c.Type == nil {
 		return ""
 	}
-	return *d.Path
+	return *c.Type
 }
 
-// GetPosition returns the Position field if it's non-nil, zero value otherwise.
-func (d *DraftReviewComment) GetPosition() int {
-	if d == nil || d.Position == nil {
-		return 0
+// GetURL returns the URL field if it's non-nil, zero value otherwise.
+func (c *Contributor) GetURL() string {
+	if c == nil || c.URL == nil {
+		return ""
 	}
-	return *d.Position
+	return *c.URL
 }
 
-// GetSide returns the Side field if it's non-nil, zero value otherwise.
-func (d *DraftReviewComment) GetSide() string {
-	if d == nil || d.Side == nil {
-		return ""
+// GetAuthor returns the Author field.
+func (c *ContributorStats) GetAuthor() *Contributor {
+	if c == nil {
+		return nil
 	}
-	return *d.Side
+	return c.Author
 }
 
-// GetStartLine returns the StartLine field if it's non-nil, zero value otherwise.
-func (d *DraftReviewComment) GetStartLine() int {
-	if d == nil || d.StartLine == nil {
+// GetTotal returns the Total field if it's non-nil, zero value otherwise.
+func (c *ContributorStats) GetTotal() int {
+	if c == nil || c.Total == nil {
 		return 0
 	}
-	return *d.StartLine
+	return *c.Total
 }
 
-// GetStartSide returns the StartSide field if it's non-nil, zero value otherwise.
-func (d *DraftReviewComment) GetStartSide() string {
-	if d == nil || d.StartSide == nil {
+// GetCLI returns the CLI field if it's non-nil, zero value otherwise.
+func (c *CopilotOrganizationDetails) GetCLI() string {
+	if c == nil || c.CLI == nil {
 		return ""
 	}
-	return *d.StartSide
+	return *c.CLI
 }
 
-// GetAvatarURL returns the AvatarURL field if it's non-nil, zero value otherwise.
-func (e *Enterprise) GetAvatarURL() string {
-	if e == nil || e.AvatarURL == nil {
+// GetIDEChat returns the IDEChat field if it's non-nil, zero value otherwise.
+func (c *CopilotOrganizationDetails) GetIDEChat() string {
+	if c == nil || c.IDEChat == nil {
 		return ""
 	}
-	return *e.AvatarURL
+	return *c.IDEChat
 }
 
-// GetCreatedAt returns the CreatedAt field if it's non-nil, zero value otherwise.
-func (e *Enterprise) GetCreatedAt() Timestamp {
-	if e == nil || e.CreatedAt == nil {
-		return Timestamp{}
+// GetPlanType returns the PlanType field if it's non-nil, zero value otherwise.
+func (c *CopilotOrganizationDetails) GetPlanType() string {
+	if c == nil || c.PlanType == nil {
+		return ""
 	}
-	return *e.CreatedAt
+	return *c.PlanType
 }
 
-// GetDescription returns the Description field if it's non-nil, zero value otherwise.
-func (e *Enterprise) GetDescription() string {
-	if e == nil || e.Description == nil {
+// GetPlatformChat returns the PlatformChat field if it's non-nil, zero value otherwise.
+func (c *CopilotOrganizationDetails) GetPlatformChat() string {
+	if c == nil || c.PlatformChat == nil {
 		return ""
 	}
-	return *e.Description
+	return *c.PlatformChat
 }
 
-// GetHTMLURL returns the HTMLURL field if it's non-nil, zero value otherwise.
-func (e *Enterprise) GetHTMLURL() string {
-	if e == nil || e.HTMLURL == nil {
+// GetPublicCodeSuggestions returns the PublicCodeSuggestions field if it's non-nil, zero value otherwise.
+func (c *CopilotOrganizationDetails) GetPublicCodeSuggestions() string {
+	if c == nil || c.PublicCodeSuggestions == nil {
 		return ""
 	}
-	return *e.HTMLURL
+	return *c.PublicCodeSuggestions
 }
 
-// GetID returns the ID field if it's non-nil, zero value otherwise.
-func (e *Enterprise) GetID() int {
-	if e == nil || e.ID == nil {
-		return 0
+// GetSeatBreakdown returns the SeatBreakdown field.
+func (c *CopilotOrganizationDetails) GetSeatBreakdown() *CopilotSeatBreakdown {
+	if c == nil {
+		return nil
 	}
-	return *e.ID
+	return c.SeatBreakdown
 }
 
-// GetName returns the Name field if it's non-nil, zero value otherwise.
-func (e *Enterprise) GetName() string {
-	if e == nil || e.Name == nil {
+// GetSeatManagementSetting returns the SeatManagementSetting field if it's non-nil, zero value otherwise.
+func (c *CopilotOrganizationDetails) GetSeatManagementSetting() string {
+	if c == nil || c.SeatManagementSetting == nil {
 		return ""
 	}
-	return *e.Name
+	return *c.SeatManagementSetting
 }
 
-// GetNodeID returns the NodeID field if it's non-nil, zero value otherwise.
-func (e *Enterprise) GetNodeID() string {
-	if e == nil || e.NodeID == nil {
-		return ""
+// GetActiveThisCycle returns the ActiveThisCycle field if it's non-nil, zero value otherwise.
+func (c *CopilotSeatBreakdown) GetActiveThisCycle() int64 {
+	if c == nil || c.ActiveThisCycle == nil {
+		return 0
 	}
-	return *e.NodeID
+	return *c.ActiveThisCycle
 }
 
-// GetSlug returns the Slug field if it's non-nil, zero value otherwise.
-func (e *Enterprise) GetSlug() string {
-	if e == nil || e.Slug == nil {
-		return ""
+// GetAddedThisCycle returns the AddedThisCycle field if it's non-nil, zero value otherwise.
+func (c *CopilotSeatBreakdown) GetAddedThisCycle() int64 {
+	if c == nil || c.AddedThisCycle == nil {
+		return 0
 	}
-	return *e.Slug
+	return *c.AddedThisCycle
 }
 
-// GetUpdatedAt returns the UpdatedAt field if it's non-nil, zero value otherwise.
-func (e *Enterprise) GetUpdatedAt() Timestamp {
-	if e == nil || e.UpdatedAt == nil {
-		return Timestamp{}
+// GetInactiveThisCycle returns the InactiveThisCycle field if it's non-nil, zero value otherwise.
+func (c *CopilotSeatBreakdown) GetInactiveThisCycle() int64 {
+	if c == nil || c.InactiveThisCycle == nil {
+		return 0
 	}
-	return *e.UpdatedAt
+	return *c.InactiveThisCycle
 }
 
-// GetWebsiteURL returns the WebsiteURL field if it's non-nil, zero value otherwise.
-func (e *Enterprise) GetWebsiteURL() string {
-	if e == nil || e.WebsiteURL == nil {
-		return ""
+// GetPendingCancellation returns the PendingCancellation field if it's non-nil, zero value otherwise.
+func (c *CopilotSeatBreakdown) GetPendingCancellation() int64 {
+	if c == nil || c.PendingCancellation == nil {
+		return 0
 	}
-	return *e.WebsiteURL
+	return *c.PendingCancellation
 }
 
-// GetActor returns the Actor field.
-func (e *Event) GetActor() *User {
-	if e == nil {
+// GetPendingInvitation returns the PendingInvitation field if it's non-nil, zero value otherwise.
+func (c *CopilotSeatBreakdown) GetPendingInvitation() int64 {
+	if c == nil || c.PendingInvitation == nil {
+		return 0
+	}
+	return *c.PendingInvitation
+}
+
+// GetTotal returns the Total field if it's non-nil, zero value otherwise.
+func (c *CopilotSeatBreakdown) GetTotal() int64 {
+	if c == nil || c.Total == nil {
+		return 0
+	}
+	return *c.Total
+}
+
+// GetAssigningTeam returns the AssigningTeam field.
+func (c *CopilotSeatDetails) GetAssigningTeam() *Team {
+	if c == nil {
 		return nil
 	}
-	return e.Actor
+	return c.AssigningTeam
 }
 
 // GetCreatedAt returns the CreatedAt field if it's non-nil, zero value otherwise.
-func (e *Event) GetCreatedAt() time.Time {
-	if e == nil || e.CreatedAt == nil {
-		return time.Time{}
+func (c *CopilotSeatDetails) GetCreatedAt() Timestamp {
+	if c == nil || c.CreatedAt == nil {
+		return Timestamp{}
 	}
-	return *e.CreatedAt
+	return *c.CreatedAt
 }
 
-// GetID returns the ID field if it's non-nil, zero value otherwise.
-func (e *Event) GetID() string {
-	if e == nil || e.ID == nil {
+// GetLastActivityAt returns the LastActivityAt field if it's non-nil, zero value otherwise.
+func (c *CopilotSeatDetails) GetLastActivityAt() Timestamp {
+	if c == nil || c.LastActivityAt == nil {
+		return Timestamp{}
+	}
+	return *c.LastActivityAt
+}
+
+// GetLastActivityEditor returns the LastActivityEditor field if it's non-nil, zero value otherwise.
+func (c *CopilotSeatDetails) GetLastActivityEditor() string {
+	if c == nil || c.LastActivityEditor == nil {
 		return ""
 	}
-	return *e.ID
+	return *c.LastActivityEditor
 }
 
-// GetOrg returns the Org field.
-func (e *Event) GetOrg() *Organization {
-	if e == nil {
-		return nil
+// GetPendingCancellationDate returns the PendingCancellationDate field if it's non-nil, zero value otherwise.
+func (c *CopilotSeatDetails) GetPendingCancellationDate() string {
+	if c == nil || c.PendingCancellationDate == nil {
+		return ""
 	}
-	return e.Org
+	return *c.PendingCancellationDate
 }
 
-// GetPublic returns the Public field if it's non-nil, zero value otherwise.
-func (e *Event) GetPublic() bool {
-	if e == nil || e.Public == nil {
-		return false
+// GetPlanType returns the PlanType field if it's non-nil, zero value otherwise.
+func (c *CopilotSeatDetails) GetPlanType() string {
+	if c == nil || c.PlanType == nil {
+		return ""
 	}
-	return *e.Public
+	return *c.PlanType
 }
 
-// GetRawPayload returns the RawPayload field if it's non-nil, zero value otherwise.
-func (e *Event) GetRawPayload() json.RawMessage {
-	if e == nil || e.RawPayload == nil {
-		return json.RawMessage{}
+// GetUpdatedAt returns the UpdatedAt field if it's non-nil, zero value otherwise.
+func (c *CopilotSeatDetails) GetUpdatedAt() Timestamp {
+	if c == nil || c.UpdatedAt == nil {
+		return Timestamp{}
 	}
-	return *e.RawPayload
+	return *c.UpdatedAt
 }
 
-// GetRepo returns the Repo field.
-func (e *Event) GetRepo() *Repository {
-	if e == nil {
-		return nil
+// GetCompletedAt returns the CompletedAt field if it's non-nil, zero value otherwise.
+func (c *CreateCheckRunOptions) GetCompletedAt() Timestamp {
+	if c == nil || c.CompletedAt == nil {
+		return Timestamp{}
 	}
-	return e.Repo
+	return *c.CompletedAt
 }
 
-// GetType returns the Type field if it's non-nil, zero value otherwise.
-func (e *Event) GetType() string {
-	if e == nil || e.Type == nil {
+// GetConclusion returns the Conclusion field if it's non-nil, zero value otherwise.
+func (c *CreateCheckRunOptions) GetConclusion() string {
+	if c == nil || c.Conclusion == nil {
 		return ""
 	}
-	return *e.Type
+	return *c.Conclusion
 }
 
-// GetHRef returns the HRef field if it's non-nil, zero value otherwise.
-func (f *FeedLink) GetHRef() string {
-	if f == nil || f.HRef == nil {
+// GetDetailsURL returns the DetailsURL field if it's non-nil, zero value otherwise.
+func (c *CreateCheckRunOptions) GetDetailsURL() string {
+	if c == nil || c.DetailsURL == nil {
 		return ""
 	}
-	return *f.HRef
+	return *c.DetailsURL
 }
 
-// GetType returns the Type field if it's non-nil, zero value otherwise.
-func (f *FeedLink) GetType() string {
-	if f == nil || f.Type == nil {
+// GetExternalID returns the ExternalID field if it's non-nil, zero value otherwise.
+func (c *CreateCheckRunOptions) GetExternalID() string {
+	if c == nil || c.ExternalID == nil {
 		return ""
 	}
-	return *f.Type
+	return *c.ExternalID
 }
 
-// GetCurrentUserActorURL returns the CurrentUserActorURL field if it's non-nil, zero value otherwise.
-func (f *Feeds) GetCurrentUserActorURL() string {
-	if f == nil || f.CurrentUserActorURL == nil {
-		return ""
+// GetOutput returns the Output field.
+func (c *CreateCheckRunOptions) GetOutput() *CheckRunOutput {
+	if c == nil {
+		return nil
 	}
-	return *f.CurrentUserActorURL
+	return c.Output
 }
 
-// GetCurrentUserOrganizationURL returns the CurrentUserOrganizationURL field if it's non-nil, zero value otherwise.
-func (f *Feeds) GetCurrentUserOrganizationURL() string {
-	if f == nil || f.CurrentUserOrganizationURL == nil {
+// GetStartedAt returns the StartedAt field if it's non-nil, zero value otherwise.
+func (c *CreateCheckRunOptions) GetStartedAt() Timestamp {
+	if c == nil || c.StartedAt == nil {
+		return Timestamp{}
+	}
+	return *c.StartedAt
+}
+
+// GetStatus returns the Status field if it's non-nil, zero value otherwise.
+func (c *CreateCheckRunOptions) GetStatus() string {
+	if c == nil || c.Status == nil {
 		return ""
 	}
-	return *f.CurrentUserOrganizationURL
+	return *c.Status
 }
 
-// GetCurrentUserPublicURL returns the CurrentUserPublicURL field if it's non-nil, zero value otherwise.
-func (f *Feeds) GetCurrentUserPublicURL() string {
-	if f == nil || f.CurrentUserPublicURL == nil {
+// GetHeadBranch returns the HeadBranch field if it's non-nil, zero value otherwise.
+func (c *CreateCheckSuiteOptions) GetHeadBranch() string {
+	if c == nil || c.HeadBranch == nil {
 		return ""
 	}
-	return *f.CurrentUserPublicURL
+	return *c.HeadBranch
 }
 
-// GetCurrentUserURL returns the CurrentUserURL field if it's non-nil, zero value otherwise.
-func (f *Feeds) GetCurrentUserURL() string {
-	if f == nil || f.CurrentUserURL == nil {
+// GetDescription returns the Description field if it's non-nil, zero value otherwise.
+func (c *CreateEvent) GetDescription() string {
+	if c == nil || c.Description == nil {
 		return ""
 	}
-	return *f.CurrentUserURL
+	return *c.Description
 }
 
-// GetTimelineURL returns the TimelineURL field if it's non-nil, zero value otherwise.
-func (f *Feeds) GetTimelineURL() string {
-	if f == nil || f.TimelineURL == nil {
+// GetInstallation returns the Installation field.
+func (c *CreateEvent) GetInstallation() *Installation {
+	if c == nil {
+		return nil
+	}
+	return c.Installation
+}
+
+// GetMasterBranch returns the MasterBranch field if it's non-nil, zero value otherwise.
+func (c *CreateEvent) GetMasterBranch() string {
+	if c == nil || c.MasterBranch == nil {
 		return ""
 	}
-	return *f.TimelineURL
+	return *c.MasterBranch
 }
 
-// GetUserURL returns the UserURL field if it's non-nil, zero value otherwise.
-func (f *Feeds) GetUserURL() string {
-	if f == nil || f.UserURL == nil {
+// GetPusherType returns the PusherType field if it's non-nil, zero value otherwise.
+func (c *CreateEvent) GetPusherType() string {
+	if c == nil || c.PusherType == nil {
 		return ""
 	}
-	return *f.UserURL
+	return *c.PusherType
 }
 
-// GetForkee returns the Forkee field.
-func (f *ForkEvent) GetForkee() *Repository {
-	if f == nil {
-		return nil
+// GetRef returns the Ref field if it's non-nil, zero value otherwise.
+func (c *CreateEvent) GetRef() string {
+	if c == nil || c.Ref == nil {
+		return ""
 	}
-	return f.Forkee
+	return *c.Ref
 }
 
-// GetInstallation returns the Installation field.
-func (f *ForkEvent) GetInstallation() *Installation {
-	if f == nil {
-		return nil
+// GetRefType returns the RefType field if it's non-nil, zero value otherwise.
+func (c *CreateEvent) GetRefType() string {
+	if c == nil || c.RefType == nil {
+		return ""
 	}
-	return f.Installation
+	return *c.RefType
 }
 
 // GetRepo returns the Repo field.
-func (f *ForkEvent) GetRepo() *Repository {
-	if f == nil {
+func (c *CreateEvent) GetRepo() *Repository {
+	if c == nil {
 		return nil
 	}
-	return f.Repo
+	return c.Repo
 }
 
 // GetSender returns the Sender field.
-func (f *ForkEvent) GetSender() *User {
-	if f == nil {
+func (c *CreateEvent) GetSender() *User {
+	if c == nil {
 		return nil
 	}
-	return f.Sender
+	return c.Sender
 }
 
-// GetComments returns the Comments field if it's non-nil, zero value otherwise.
-func (g *Gist) GetComments() int {
-	if g == nil || g.Comments == nil {
-		return 0
+// GetEmail returns the Email field if it's non-nil, zero value otherwise.
+func (c *CreateOrgInvitationOptions) GetEmail() string {
+	if c == nil || c.Email == nil {
+		return ""
 	}
-	return *g.Comments
+	return *c.Email
 }
 
-// GetCreatedAt returns the CreatedAt field if it's non-nil, zero value otherwise.
-func (g *Gist) GetCreatedAt() time.Time {
-	if g == nil || g.CreatedAt == nil {
-		return time.Time{}
+// GetInviteeID returns the InviteeID field if it's non-nil, zero value otherwise.
+func (c *CreateOrgInvitationOptions) GetInviteeID() int64 {
+	if c == nil || c.InviteeID == nil {
+		return 0
 	}
-	return *g.CreatedAt
+	return *c.InviteeID
 }
 
-// GetDescription returns the Description field if it's non-nil, zero value otherwise.
-func (g *Gist) GetDescription() string {
-	if g == nil || g.Description == nil {
+// GetRole returns the Role field if it's non-nil, zero value otherwise.
+func (c *CreateOrgInvitationOptions) GetRole() string {
+	if c == nil || c.Role == nil {
 		return ""
 	}
-	return *g.Description
+	return *c.Role
 }
 
-// GetGitPullURL returns the GitPullURL field if it's non-nil, zero value otherwise.
-func (g *Gist) GetGitPullURL() string {
-	if g == nil || g.GitPullURL == nil {
+// GetBaseRole returns the BaseRole field if it's non-nil, zero value otherwise.
+func (c *CreateOrUpdateCustomRepoRoleOptions) GetBaseRole() string {
+	if c == nil || c.BaseRole == nil {
 		return ""
 	}
-	return *g.GitPullURL
+	return *c.BaseRole
 }
 
-// GetGitPushURL returns the GitPushURL field if it's non-nil, zero value otherwise.
-func (g *Gist) GetGitPushURL() string {
-	if g == nil || g.GitPushURL == nil {
+// GetDescription returns the Description field if it's non-nil, zero value otherwise.
+func (c *CreateOrUpdateCustomRepoRoleOptions) GetDescription() string {
+	if c == nil || c.Description == nil {
 		return ""
 	}
-	return *g.GitPushURL
+	return *c.Description
 }
 
-// GetHTMLURL returns the HTMLURL field if it's non-nil, zero value otherwise.
-func (g *Gist) GetHTMLURL() string {
-	if g == nil || g.HTMLURL == nil {
+// GetName returns the Name field if it's non-nil, zero value otherwise.
+func (c *CreateOrUpdateCustomRepoRoleOptions) GetName() string {
+	if c == nil || c.Name == nil {
 		return ""
 	}
-	return *g.HTMLURL
+	return *c.Name
 }
 
-// GetID returns the ID field if it's non-nil, zero value otherwise.
-func (g *Gist) GetID() string {
-	if g == nil || g.ID == nil {
+// GetBaseRole returns the BaseRole field if it's non-nil, zero value otherwise.
+func (c *CreateOrUpdateOrgRoleOptions) GetBaseRole() string {
+	if c == nil || c.BaseRole == nil {
 		return ""
 	}
-	return *g.ID
+	return *c.BaseRole
 }
 
-// GetNodeID returns the NodeID field if it's non-nil, zero value otherwise.
-func (g *Gist) GetNodeID() string {
-	if g == nil || g.NodeID == nil {
+// GetDescription returns the Description field if it's non-nil, zero value otherwise.
+func (c *CreateOrUpdateOrgRoleOptions) GetDescription() string {
+	if c == nil || c.Description == nil {
 		return ""
 	}
-	return *g.NodeID
-}
-
-// GetOwner returns the Owner field.
-func (g *Gist) GetOwner() *User {
-	if g == nil {
-		return nil
-	}
-	return g.Owner
-}
-
-// GetPublic returns the Public field if it's non-nil, zero value otherwise.
-func (g *Gist) GetPublic() bool {
-	if g == nil || g.Public == nil {
-		return false
-	}
-	return *g.Public
+	return *c.Description
 }
 
-// GetUpdatedAt returns the UpdatedAt field if it's non-nil, zero value otherwise.
-func (g *Gist) GetUpdatedAt() time.Time {
-	if g == nil || g.UpdatedAt == nil {
-		return time.Time{}
+// GetName returns the Name field if it's non-nil, zero value otherwise.
+func (c *CreateOrUpdateOrgRoleOptions) GetName() string {
+	if c == nil || c.Name == nil {
+		return ""
 	}
-	return *g.UpdatedAt
+	return *c.Name
 }
 
 // GetBody returns the Body field if it's non-nil, zero value otherwise.
-func (g *GistComment) GetBody() string {
-	if g == nil || g.Body == nil {
+func (c *CreateUserProjectOptions) GetBody() string {
+	if c == nil || c.Body == nil {
 		return ""
 	}
-	return *g.Body
-}
-
-// GetCreatedAt returns the CreatedAt field if it's non-nil, zero value otherwise.
-func (g *GistComment) GetCreatedAt() time.Time {
-	if g == nil || g.CreatedAt == nil {
-		return time.Time{}
-	}
-	return *g.CreatedAt
+	return *c.Body
 }
 
-// GetID returns the ID field if it's non-nil, zero value otherwise.
-func (g *GistComment) GetID() int64 {
-	if g == nil || g.ID == nil {
-		return 0
+// GetCreated returns the Created field if it's non-nil, zero value otherwise.
+func (c *CreationInfo) GetCreated() Timestamp {
+	if c == nil || c.Created == nil {
+		return Timestamp{}
 	}
-	return *g.ID
+	return *c.Created
 }
 
-// GetURL returns the URL field if it's non-nil, zero value otherwise.
-func (g *GistComment) GetURL() string {
-	if g == nil || g.URL == nil {
+// GetType returns the Type field if it's non-nil, zero value otherwise.
+func (c *Credit) GetType() string {
+	if c == nil || c.Type == nil {
 		return ""
 	}
-	return *g.URL
+	return *c.Type
 }
 
 // GetUser returns the User field.
-func (g *GistComment) GetUser() *User {
-	if g == nil {
+func (c *Credit) GetUser() *User {
+	if c == nil {
 		return nil
 	}
-	return g.User
+	return c.User
 }
 
-// GetChangeStatus returns the ChangeStatus field.
-func (g *GistCommit) GetChangeStatus() *CommitStats {
-	if g == nil {
+// GetApp returns the App field.
+func (c *CustomDeploymentProtectionRule) GetApp() *CustomDeploymentProtectionRuleApp {
+	if c == nil {
 		return nil
 	}
-	return g.ChangeStatus
+	return c.App
 }
 
-// GetCommittedAt returns the CommittedAt field if it's non-nil, zero value otherwise.
-func (g *GistCommit) GetCommittedAt() Timestamp {
-	if g == nil || g.CommittedAt == nil {
-		return Timestamp{}
+// GetEnabled returns the Enabled field if it's non-nil, zero value otherwise.
+func (c *CustomDeploymentProtectionRule) GetEnabled() bool {
+	if c == nil || c.Enabled == nil {
+		return false
 	}
-	return *g.CommittedAt
+	return *c.Enabled
 }
 
-// GetNodeID returns the NodeID field if it's non-nil, zero value otherwise.
-func (g *GistCommit) GetNodeID() string {
-	if g == nil || g.NodeID == nil {
-		return ""
+// GetID returns the ID field if it's non-nil, zero value otherwise.
+func (c *CustomDeploymentProtectionRule) GetID() int64 {
+	if c == nil || c.ID == nil {
+		return 0
 	}
-	return *g.NodeID
+	return *c.ID
 }
 
-// GetURL returns the URL field if it's non-nil, zero value otherwise.
-func (g *GistCommit) GetURL() string {
-	if g == nil || g.URL == nil {
+// GetNodeID returns the NodeID field if it's non-nil, zero value otherwise.
+func (c *CustomDeploymentProtectionRule) GetNodeID() string {
+	if c == nil || c.NodeID == nil {
 		return ""
 	}
-	return *g.URL
+	return *c.NodeID
 }
 
-// GetUser returns the User field.
-func (g *GistCommit) GetUser() *User {
-	if g == nil {
-		return nil
+// GetID returns the ID field if it's non-nil, zero value otherwise.
+func (c *CustomDeploymentProtectionRuleApp) GetID() int64 {
+	if c == nil || c.ID == nil {
+		return 0
 	}
-	return g.User
+	return *c.ID
 }
 
-// GetVersion returns the Version field if it's non-nil, zero value otherwise.
-func (g *GistCommit) GetVersion() string {
-	if g == nil || g.Version == nil {
+// GetIntegrationURL returns the IntegrationURL field if it's non-nil, zero value otherwise.
+func (c *CustomDeploymentProtectionRuleApp) GetIntegrationURL() string {
+	if c == nil || c.IntegrationURL == nil {
 		return ""
 	}
-	return *g.Version
+	return *c.IntegrationURL
 }
 
-// GetContent returns the Content field if it's non-nil, zero value otherwise.
-func (g *GistFile) GetContent() string {
-	if g == nil || g.Content == nil {
+// GetNodeID returns the NodeID field if it's non-nil, zero value otherwise.
+func (c *CustomDeploymentProtectionRuleApp) GetNodeID() string {
+	if c == nil || c.NodeID == nil {
 		return ""
 	}
-	return *g.Content
+	return *c.NodeID
 }
 
-// GetFilename returns the Filename field if it's non-nil, zero value otherwise.
-func (g *GistFile) GetFilename() string {
-	if g == nil || g.Filename == nil {
+// GetSlug returns the Slug field if it's non-nil, zero value otherwise.
+func (c *CustomDeploymentProtectionRuleApp) GetSlug() string {
+	if c == nil || c.Slug == nil {
 		return ""
 	}
-	return *g.Filename
+	return *c.Slug
 }
 
-// GetLanguage returns the Language field if it's non-nil, zero value otherwise.
-func (g *GistFile) GetLanguage() string {
-	if g == nil || g.Language == nil {
-		return ""
+// GetIntegrationID returns the IntegrationID field if it's non-nil, zero value otherwise.
+func (c *CustomDeploymentProtectionRuleRequest) GetIntegrationID() int64 {
+	if c == nil || c.IntegrationID == nil {
+		return 0
 	}
-	return *g.Language
+	return *c.IntegrationID
 }
 
-// GetRawURL returns the RawURL field if it's non-nil, zero value otherwise.
-func (g *GistFile) GetRawURL() string {
-	if g == nil || g.RawURL == nil {
+// GetBaseRole returns the BaseRole field if it's non-nil, zero value otherwise.
+func (c *CustomOrgRoles) GetBaseRole() string {
+	if c == nil || c.BaseRole == nil {
 		return ""
 	}
-	return *g.RawURL
+	return *c.BaseRole
 }
 
-// GetSize returns the Size field if it's non-nil, zero value otherwise.
-func (g *GistFile) GetSize() int {
-	if g == nil || g.Size == nil {
-		return 0
+// GetCreatedAt returns the CreatedAt field if it's non-nil, zero value otherwise.
+func (c *CustomOrgRoles) GetCreatedAt() Timestamp {
+	if c == nil || c.CreatedAt == nil {
+		return Timestamp{}
 	}
-	return *g.Size
+	return *c.CreatedAt
 }
 
-// GetType returns the Type field if it's non-nil, zero value otherwise.
-func (g *GistFile) GetType() string {
-	if g == nil || g.Type == nil {
+// GetDescription returns the Description field if it's non-nil, zero value otherwise.
+func (c *CustomOrgRoles) GetDescription() string {
+	if c == nil || c.Description == nil {
 		return ""
 	}
-	return *g.Type
+	return *c.Description
 }
 
-// GetCreatedAt returns the CreatedAt field if it's non-nil, zero value otherwise.
-func (g *GistFork) GetCreatedAt() Timestamp {
-	if g == nil || g.CreatedAt == nil {
-		return Timestamp{}
+// GetID returns the ID field if it's non-nil, zero value otherwise.
+func (c *CustomOrgRoles) GetID() int64 {
+	if c == nil || c.ID == nil {
+		return 0
 	}
-	return *g.CreatedAt
+	return *c.ID
 }
 
-// GetID returns the ID field if it's non-nil, zero value otherwise.
-func (g *GistFork) GetID() string {
-	if g == nil || g.ID == nil {
+// GetName returns the Name field if it's non-nil, zero value otherwise.
+func (c *CustomOrgRoles) GetName() string {
+	if c == nil || c.Name == nil {
 		return ""
 	}
-	return *g.ID
+	return *c.Name
 }
 
-// GetNodeID returns the NodeID field if it's non-nil, zero value otherwise.
-func (g *GistFork) GetNodeID() string {
-	if g == nil || g.NodeID == nil {
+// GetOrg returns the Org field.
+func (c *CustomOrgRoles) GetOrg() *Organization {
+	if c == nil {
+		return nil
+	}
+	return c.Org
+}
+
+// GetSource returns the Source field if it's non-nil, zero value otherwise.
+func (c *CustomOrgRoles) GetSource() string {
+	if c == nil || c.Source == nil {
 		return ""
 	}
-	return *g.NodeID
+	return *c.Source
 }
 
 // GetUpdatedAt returns the UpdatedAt field if it's non-nil, zero value otherwise.
-func (g *GistFork) GetUpdatedAt() Timestamp {
-	if g == nil || g.UpdatedAt == nil {
+func (c *CustomOrgRoles) GetUpdatedAt() Timestamp {
+	if c == nil || c.UpdatedAt == nil {
 		return Timestamp{}
 	}
-	return *g.UpdatedAt
+	return *c.UpdatedAt
 }
 
-// GetURL returns the URL field if it's non-nil, zero value otherwise.
-func (g *GistFork) GetURL() string {
-	if g == nil || g.URL == nil {
+// GetDefaultValue returns the DefaultValue field if it's non-nil, zero value otherwise.
+func (c *CustomProperty) GetDefaultValue() string {
+	if c == nil || c.DefaultValue == nil {
 		return ""
 	}
-	return *g.URL
+	return *c.DefaultValue
 }
 
-// GetUser returns the User field.
-func (g *GistFork) GetUser() *User {
-	if g == nil {
-		return nil
+// GetDescription returns the Description field if it's non-nil, zero value otherwise.
+func (c *CustomProperty) GetDescription() string {
+	if c == nil || c.Description == nil {
+		return ""
 	}
-	return g.User
+	return *c.Description
 }
 
-// GetPrivateGists returns the PrivateGists field if it's non-nil, zero value otherwise.
-func (g *GistStats) GetPrivateGists() int {
-	if g == nil || g.PrivateGists == nil {
-		return 0
+// GetPropertyName returns the PropertyName field if it's non-nil, zero value otherwise.
+func (c *CustomProperty) GetPropertyName() string {
+	if c == nil || c.PropertyName == nil {
+		return ""
 	}
-	return *g.PrivateGists
+	return *c.PropertyName
 }
 
-// GetPublicGists returns the PublicGists field if it's non-nil, zero value otherwise.
-func (g *GistStats) GetPublicGists() int {
-	if g == nil || g.PublicGists == nil {
-		return 0
+// GetRequired returns the Required field if it's non-nil, zero value otherwise.
+func (c *CustomProperty) GetRequired() bool {
+	if c == nil || c.Required == nil {
+		return false
 	}
-	return *g.PublicGists
+	return *c.Required
 }
 
-// GetTotalGists returns the TotalGists field if it's non-nil, zero value otherwise.
-func (g *GistStats) GetTotalGists() int {
-	if g == nil || g.TotalGists == nil {
-		return 0
+// GetValueType returns the ValueType field if it's non-nil, zero value otherwise.
+func (c *CustomProperty) GetValueType() string {
+	if c == nil || c.ValueType == nil {
+		return ""
 	}
-	return *g.TotalGists
+	return *c.ValueType
 }
 
 // GetAction returns the Action field if it's non-nil, zero value otherwise.
-func (g *GitHubAppAuthorizationEvent) GetAction() string {
-	if g == nil || g.Action == nil {
+func (c *CustomPropertyEvent) GetAction() string {
+	if c == nil || c.Action == nil {
 		return ""
 	}
-	return *g.Action
+	return *c.Action
 }
 
-// GetSender returns the Sender field.
-func (g *GitHubAppAuthorizationEvent) GetSender() *User {
-	if g == nil {
+// GetDefinition returns the Definition field.
+func (c *CustomPropertyEvent) GetDefinition() *CustomProperty {
+	if c == nil {
 		return nil
 	}
-	return g.Sender
+	return c.Definition
 }
 
-// GetName returns the Name field if it's non-nil, zero value otherwise.
-func (g *Gitignore) GetName() string {
-	if g == nil || g.Name == nil {
-		return ""
+// GetInstallation returns the Installation field.
+func (c *CustomPropertyEvent) GetInstallation() *Installation {
+	if c == nil {
+		return nil
 	}
-	return *g.Name
+	return c.Installation
 }
 
-// GetSource returns the Source field if it's non-nil, zero value otherwise.
-func (g *Gitignore) GetSource() string {
-	if g == nil || g.Source == nil {
-		return ""
+// GetOrg returns the Org field.
+func (c *CustomPropertyEvent) GetOrg() *Organization {
+	if c == nil {
+		return nil
 	}
-	return *g.Source
+	return c.Org
 }
 
-// GetSHA returns the SHA field if it's non-nil, zero value otherwise.
-func (g *GitObject) GetSHA() string {
-	if g == nil || g.SHA == nil {
-		return ""
+// GetSender returns the Sender field.
+func (c *CustomPropertyEvent) GetSender() *User {
+	if c == nil {
+		return nil
 	}
-	return *g.SHA
+	return c.Sender
 }
 
-// GetType returns the Type field if it's non-nil, zero value otherwise.
-func (g *GitObject) GetType() string {
-	if g == nil || g.Type == nil {
+// GetValue returns the Value field if it's non-nil, zero value otherwise.
+func (c *CustomPropertyValue) GetValue() string {
+	if c == nil || c.Value == nil {
 		return ""
 	}
-	return *g.Type
+	return *c.Value
 }
 
-// GetURL returns the URL field if it's non-nil, zero value otherwise.
-func (g *GitObject) GetURL() string {
-	if g == nil || g.URL == nil {
+// GetAction returns the Action field if it's non-nil, zero value otherwise.
+func (c *CustomPropertyValuesEvent) GetAction() string {
+	if c == nil || c.Action == nil {
 		return ""
 	}
-	return *g.URL
+	return *c.Action
 }
 
 // GetInstallation returns the Installation field.
-func (g *GollumEvent) GetInstallation() *Installation {
-	if g == nil {
+func (c *CustomPropertyValuesEvent) GetInstallation() *Installation {
+	if c == nil {
 		return nil
 	}
-	return g.Installation
+	return c.Installation
+}
+
+// GetOrg returns the Org field.
+func (c *CustomPropertyValuesEvent) GetOrg() *Organization {
+	if c == nil {
+		return nil
+	}
+	return c.Org
 }
 
 // GetRepo returns the Repo field.
-func (g *GollumEvent) GetRepo() *Repository {
-	if g == nil {
+func (c *CustomPropertyValuesEvent) GetRepo() *Repository {
+	if c == nil {
 		return nil
 	}
-	return g.Repo
+	return c.Repo
 }
 
 // GetSender returns the Sender field.
-func (g *GollumEvent) GetSender() *User {
-	if g == nil {
+func (c *CustomPropertyValuesEvent) GetSender() *User {
+	if c == nil {
 		return nil
 	}
-	return g.Sender
+	return c.Sender
 }
 
-// GetEmail returns the Email field if it's non-nil, zero value otherwise.
-func (g *GPGEmail) GetEmail() string {
-	if g == nil || g.Email == nil {
+// GetBaseRole returns the BaseRole field if it's non-nil, zero value otherwise.
+func (c *CustomRepoRoles) GetBaseRole() string {
+	if c == nil || c.BaseRole == nil {
 		return ""
 	}
-	return *g.Email
+	return *c.BaseRole
 }
 
-// GetVerified returns the Verified field if it's non-nil, zero value otherwise.
-func (g *GPGEmail) GetVerified() bool {
-	if g == nil || g.Verified == nil {
-		return false
+// GetCreatedAt returns the CreatedAt field if it's non-nil, zero value otherwise.
+func (c *CustomRepoRoles) GetCreatedAt() Timestamp {
+	if c == nil || c.CreatedAt == nil {
+		return Timestamp{}
 	}
-	return *g.Verified
+	return *c.CreatedAt
 }
 
-// GetCanCertify returns the CanCertify field if it's non-nil, zero value otherwise.
-func (g *GPGKey) GetCanCertify() bool {
-	if g == nil || g.CanCertify == nil {
-		return false
+// GetDescription returns the Description field if it's non-nil, zero value otherwise.
+func (c *CustomRepoRoles) GetDescription() string {
+	if c == nil || c.Description == nil {
+		return ""
 	}
-	return *g.CanCertify
+	return *c.Description
 }
 
-// GetCanEncryptComms returns the CanEncryptComms field if it's non-nil, zero value otherwise.
-func (g *GPGKey) GetCanEncryptComms() bool {
-	if g == nil || g.CanEncryptComms == nil {
-		return false
+// GetID returns the ID field if it's non-nil, zero value otherwise.
+func (c *CustomRepoRoles) GetID() int64 {
+	if c == nil || c.ID == nil {
+		return 0
 	}
-	return *g.CanEncryptComms
+	return *c.ID
 }
 
-// GetCanEncryptStorage returns the CanEncryptStorage field if it's non-nil, zero value otherwise.
-func (g *GPGKey) GetCanEncryptStorage() bool {
-	if g == nil || g.CanEncryptStorage == nil {
-		return false
+// GetName returns the Name field if it's non-nil, zero value otherwise.
+func (c *CustomRepoRoles) GetName() string {
+	if c == nil || c.Name == nil {
+		return ""
 	}
-	return *g.CanEncryptStorage
+	return *c.Name
 }
 
-// GetCanSign returns the CanSign field if it's non-nil, zero value otherwise.
-func (g *GPGKey) GetCanSign() bool {
-	if g == nil || g.CanSign == nil {
-		return false
+// GetOrg returns the Org field.
+func (c *CustomRepoRoles) GetOrg() *Organization {
+	if c == nil {
+		return nil
 	}
-	return *g.CanSign
+	return c.Org
 }
 
-// GetCreatedAt returns the CreatedAt field if it's non-nil, zero value otherwise.
-func (g *GPGKey) GetCreatedAt() time.Time {
-	if g == nil || g.CreatedAt == nil {
-		return time.Time{}
+// GetUpdatedAt returns the UpdatedAt field if it's non-nil, zero value otherwise.
+func (c *CustomRepoRoles) GetUpdatedAt() Timestamp {
+	if c == nil || c.UpdatedAt == nil {
+		return Timestamp{}
 	}
-	return *g.CreatedAt
+	return *c.UpdatedAt
 }
 
-// GetExpiresAt returns the ExpiresAt field if it's non-nil, zero value otherwise.
-func (g *GPGKey) GetExpiresAt() time.Time {
-	if g == nil || g.ExpiresAt == nil {
-		return time.Time{}
+// GetQuerySuite returns the QuerySuite field if it's non-nil, zero value otherwise.
+func (d *DefaultSetupConfiguration) GetQuerySuite() string {
+	if d == nil || d.QuerySuite == nil {
+		return ""
 	}
-	return *g.ExpiresAt
+	return *d.QuerySuite
 }
 
-// GetID returns the ID field if it's non-nil, zero value otherwise.
-func (g *GPGKey) GetID() int64 {
-	if g == nil || g.ID == nil {
-		return 0
+// GetSchedule returns the Schedule field if it's non-nil, zero value otherwise.
+func (d *DefaultSetupConfiguration) GetSchedule() string {
+	if d == nil || d.Schedule == nil {
+		return ""
 	}
-	return *g.ID
+	return *d.Schedule
 }
 
-// GetKeyID returns the KeyID field if it's non-nil, zero value otherwise.
-func (g *GPGKey) GetKeyID() string {
-	if g == nil || g.KeyID == nil {
+// GetState returns the State field if it's non-nil, zero value otherwise.
+func (d *DefaultSetupConfiguration) GetState() string {
+	if d == nil || d.State == nil {
 		return ""
 	}
-	return *g.KeyID
+	return *d.State
 }
 
-// GetPrimaryKeyID returns the PrimaryKeyID field if it's non-nil, zero value otherwise.
-func (g *GPGKey) GetPrimaryKeyID() int64 {
-	if g == nil || g.PrimaryKeyID == nil {
-		return 0
+// GetUpdatedAt returns the UpdatedAt field if it's non-nil, zero value otherwise.
+func (d *DefaultSetupConfiguration) GetUpdatedAt() Timestamp {
+	if d == nil || d.UpdatedAt == nil {
+		return Timestamp{}
 	}
-	return *g.PrimaryKeyID
+	return *d.UpdatedAt
 }
 
-// GetPublicKey returns the PublicKey field if it's non-nil, zero value otherwise.
-func (g *GPGKey) GetPublicKey() string {
-	if g == nil || g.PublicKey == nil {
+// GetInstallation returns the Installation field.
+func (d *DeleteEvent) GetInstallation() *Installation {
+	if d == nil {
+		return nil
+	}
+	return d.Installation
+}
+
+// GetPusherType returns the PusherType field if it's non-nil, zero value otherwise.
+func (d *DeleteEvent) GetPusherType() string {
+	if d == nil || d.PusherType == nil {
 		return ""
 	}
-	return *g.PublicKey
+	return *d.PusherType
 }
 
-// GetApp returns the App field.
-func (g *Grant) GetApp() *AuthorizationApp {
-	if g == nil {
-		return nil
+// GetRef returns the Ref field if it's non-nil, zero value otherwise.
+func (d *DeleteEvent) GetRef() string {
+	if d == nil || d.Ref == nil {
+		return ""
 	}
-	return g.App
+	return *d.Ref
 }
 
-// GetCreatedAt returns the CreatedAt field if it's non-nil, zero value otherwise.
-func (g *Grant) GetCreatedAt() Timestamp {
-	if g == nil || g.CreatedAt == nil {
-		return Timestamp{}
+// GetRefType returns the RefType field if it's non-nil, zero value otherwise.
+func (d *DeleteEvent) GetRefType() string {
+	if d == nil || d.RefType == nil {
+		return ""
 	}
-	return *g.CreatedAt
+	return *d.RefType
 }
 
-// GetID returns the ID field if it's non-nil, zero value otherwise.
-func (g *Grant) GetID() int64 {
-	if g == nil || g.ID == nil {
-		return 0
+// GetRepo returns the Repo field.
+func (d *DeleteEvent) GetRepo() *Repository {
+	if d == nil {
+		return nil
 	}
-	return *g.ID
+	return d.Repo
 }
 
-// GetUpdatedAt returns the UpdatedAt field if it's non-nil, zero value otherwise.
-func (g *Grant) GetUpdatedAt() Timestamp {
-	if g == nil || g.UpdatedAt == nil {
-		return Timestamp{}
+// GetSender returns the Sender field.
+func (d *DeleteEvent) GetSender() *User {
+	if d == nil {
+		return nil
 	}
-	return *g.UpdatedAt
+	return d.Sender
 }
 
-// GetURL returns the URL field if it's non-nil, zero value otherwise.
-func (g *Grant) GetURL() string {
-	if g == nil || g.URL == nil {
-		return ""
+// GetAutoDismissedAt returns the AutoDismissedAt field if it's non-nil, zero value otherwise.
+func (d *DependabotAlert) GetAutoDismissedAt() Timestamp {
+	if d == nil || d.AutoDismissedAt == nil {
+		return Timestamp{}
 	}
-	return *g.URL
+	return *d.AutoDismissedAt
 }
 
-// GetAuthor returns the Author field.
-func (h *HeadCommit) GetAuthor() *CommitAuthor {
-	if h == nil {
-		return nil
+// GetCreatedAt returns the CreatedAt field if it's non-nil, zero value otherwise.
+func (d *DependabotAlert) GetCreatedAt() Timestamp {
+	if d == nil || d.CreatedAt == nil {
+		return Timestamp{}
 	}
-	return h.Author
+	return *d.CreatedAt
 }
 
-// GetCommitter returns the Committer field.
-func (h *HeadCommit) GetCommitter() *CommitAuthor {
-	if h == nil {
+// GetDependency returns the Dependency field.
+func (d *DependabotAlert) GetDependency() *Dependency {
+	if d == nil {
 		return nil
 	}
-	return h.Committer
+	return d.Dependency
 }
 
-// GetDistinct returns the Distinct field if it's non-nil, zero value otherwise.
-func (h *HeadCommit) GetDistinct() bool {
-	if h == nil || h.Distinct == nil {
-		return false
+// GetDismissedAt returns the DismissedAt field if it's non-nil, zero value otherwise.
+func (d *DependabotAlert) GetDismissedAt() Timestamp {
+	if d == nil || d.DismissedAt == nil {
+		return Timestamp{}
 	}
-	return *h.Distinct
+	return *d.DismissedAt
 }
 
-// GetID returns the ID field if it's non-nil, zero value otherwise.
-func (h *HeadCommit) GetID() string {
-	if h == nil || h.ID == nil {
-		return ""
+// GetDismissedBy returns the DismissedBy field.
+func (d *DependabotAlert) GetDismissedBy() *User {
+	if d == nil {
+		return nil
 	}
-	return *h.ID
+	return d.DismissedBy
 }
 
-// GetMessage returns the Message field if it's non-nil, zero value otherwise.
-func (h *HeadCommit) GetMessage() string {
-	if h == nil || h.Message == nil {
+// GetDismissedComment returns the DismissedComment field if it's non-nil, zero value otherwise.
+func (d *DependabotAlert) GetDismissedComment() string {
+	if d == nil || d.DismissedComment == nil {
 		return ""
 	}
-	return *h.Message
+	return *d.DismissedComment
 }
 
-// GetSHA returns the SHA field if it's non-nil, zero value otherwise.
-func (h *HeadCommit) GetSHA() string {
-	if h == nil || h.SHA == nil {
+// GetDismissedReason returns the DismissedReason field if it's non-nil, zero value otherwise.
+func (d *DependabotAlert) GetDismissedReason() string {
+	if d == nil || d.DismissedReason == nil {
 		return ""
 	}
-	return *h.SHA
+	return *d.DismissedReason
 }
 
-// GetTimestamp returns the Timestamp field if it's non-nil, zero value otherwise.
-func (h *HeadCommit) GetTimestamp() Timestamp {
-	if h == nil || h.Timestamp == nil {
+// GetFixedAt returns the FixedAt field if it's non-nil, zero value otherwise.
+func (d *DependabotAlert) GetFixedAt() Timestamp {
+	if d == nil || d.FixedAt == nil {
 		return Timestamp{}
 	}
-	return *h.Timestamp
+	return *d.FixedAt
 }
 
-// GetTreeID returns the TreeID field if it's non-nil, zero value otherwise.
-func (h *HeadCommit) GetTreeID() string {
-	if h == nil || h.TreeID == nil {
+// GetHTMLURL returns the HTMLURL field if it's non-nil, zero value otherwise.
+func (d *DependabotAlert) GetHTMLURL() string {
+	if d == nil || d.HTMLURL == nil {
 		return ""
 	}
-	return *h.TreeID
+	return *d.HTMLURL
 }
 
-// GetURL returns the URL field if it's non-nil, zero value otherwise.
-func (h *HeadCommit) GetURL() string {
-	if h == nil || h.URL == nil {
-		return ""
+// GetNumber returns the Number field if it's non-nil, zero value otherwise.
+func (d *DependabotAlert) GetNumber() int {
+	if d == nil || d.Number == nil {
+		return 0
 	}
-	return *h.URL
+	return *d.Number
 }
 
-// GetActive returns the Active field if it's non-nil, zero value otherwise.
-func (h *Hook) GetActive() bool {
-	if h == nil || h.Active == nil {
-		return false
-	}
-	return *h.Active
+// GetRepository returns the Repository field.
+func (d *DependabotAlert) GetRepository() *Repository {
+	if d == nil {
+		return nil
+	}
+	return d.Repository
 }
 
-// GetCreatedAt returns the CreatedAt field if it's non-nil, zero value otherwise.
-func (h *Hook) GetCreatedAt() time.Time {
-	if h == nil || h.CreatedAt == nil {
-		return time.Time{}
+// GetSecurityAdvisory returns the SecurityAdvisory field.
+func (d *DependabotAlert) GetSecurityAdvisory() *DependabotSecurityAdvisory {
+	if d == nil {
+		return nil
 	}
-	return *h.CreatedAt
+	return d.SecurityAdvisory
 }
 
-// GetID returns the ID field if it's non-nil, zero value otherwise.
-func (h *Hook) GetID() int64 {
-	if h == nil || h.ID == nil {
-		return 0
+// GetSecurityVulnerability returns the SecurityVulnerability field.
+func (d *DependabotAlert) GetSecurityVulnerability() *AdvisoryVulnerability {
+	if d == nil {
+		return nil
 	}
-	return *h.ID
+	return d.SecurityVulnerability
+}
+
+// GetState returns the State field if it's non-nil, zero value otherwise.
+func (d *DependabotAlert) GetState() string {
+	if d == nil || d.State == nil {
+		return ""
+	}
+	return *d.State
 }
 
 // GetUpdatedAt returns the UpdatedAt field if it's non-nil, zero value otherwise.
-func (h *Hook) GetUpdatedAt() time.Time {
-	if h == nil || h.UpdatedAt == nil {
-		return time.Time{}
+func (d *DependabotAlert) GetUpdatedAt() Timestamp {
+	if d == nil || d.UpdatedAt == nil {
+		return Timestamp{}
 	}
-	return *h.UpdatedAt
+	return *d.UpdatedAt
 }
 
 // GetURL returns the URL field if it's non-nil, zero value otherwise.
-func (h *Hook) GetURL() string {
-	if h == nil || h.URL == nil {
+func (d *DependabotAlert) GetURL() string {
+	if d == nil || d.URL == nil {
 		return ""
 	}
-	return *h.URL
+	return *d.URL
 }
 
-// GetActiveHooks returns the ActiveHooks field if it's non-nil, zero value otherwise.
-func (h *HookStats) GetActiveHooks() int {
-	if h == nil || h.ActiveHooks == nil {
-		return 0
+// GetDismissedComment returns the DismissedComment field if it's non-nil, zero value otherwise.
+func (d *DependabotAlertState) GetDismissedComment() string {
+	if d == nil || d.DismissedComment == nil {
+		return ""
 	}
-	return *h.ActiveHooks
+	return *d.DismissedComment
 }
 
-// GetInactiveHooks returns the InactiveHooks field if it's non-nil, zero value otherwise.
-func (h *HookStats) GetInactiveHooks() int {
-	if h == nil || h.InactiveHooks == nil {
-		return 0
+// GetDismissedReason returns the DismissedReason field if it's non-nil, zero value otherwise.
+func (d *DependabotAlertState) GetDismissedReason() string {
+	if d == nil || d.DismissedReason == nil {
+		return ""
 	}
-	return *h.InactiveHooks
+	return *d.DismissedReason
 }
 
-// GetTotalHooks returns the TotalHooks field if it's non-nil, zero value otherwise.
-func (h *HookStats) GetTotalHooks() int {
-	if h == nil || h.TotalHooks == nil {
-		return 0
+// GetCVEID returns the CVEID field if it's non-nil, zero value otherwise.
+func (d *DependabotSecurityAdvisory) GetCVEID() string {
+	if d == nil || d.CVEID == nil {
+		return ""
 	}
-	return *h.TotalHooks
+	return *d.CVEID
 }
 
-// GetGroupDescription returns the GroupDescription field if it's non-nil, zero value otherwise.
-func (i *IDPGroup) GetGroupDescription() string {
-	if i == nil || i.GroupDescription == nil {
-		return ""
+// GetCVSS returns the CVSS field.
+func (d *DependabotSecurityAdvisory) GetCVSS() *AdvisoryCVSS {
+	if d == nil {
+		return nil
 	}
-	return *i.GroupDescription
+	return d.CVSS
 }
 
-// GetGroupID returns the GroupID field if it's non-nil, zero value otherwise.
-func (i *IDPGroup) GetGroupID() string {
-	if i == nil || i.GroupID == nil {
+// GetDescription returns the Description field if it's non-nil, zero value otherwise.
+func (d *DependabotSecurityAdvisory) GetDescription() string {
+	if d == nil || d.Description == nil {
 		return ""
 	}
-	return *i.GroupID
+	return *d.Description
 }
 
-// GetGroupName returns the GroupName field if it's non-nil, zero value otherwise.
-func (i *IDPGroup) GetGroupName() string {
-	if i == nil || i.GroupName == nil {
-		return ""
+// GetEPSS returns the EPSS field.
+func (d *DependabotSecurityAdvisory) GetEPSS() *AdvisoryEPSS {
+	if d == nil {
+		return nil
 	}
-	return *i.GroupName
+	return d.EPSS
 }
 
-// GetAuthorsCount returns the AuthorsCount field if it's non-nil, zero value otherwise.
-func (i *Import) GetAuthorsCount() int {
-	if i == nil || i.AuthorsCount == nil {
-		return 0
+// GetGHSAID returns the GHSAID field if it's non-nil, zero value otherwise.
+func (d *DependabotSecurityAdvisory) GetGHSAID() string {
+	if d == nil || d.GHSAID == nil {
+		return ""
 	}
-	return *i.AuthorsCount
+	return *d.GHSAID
 }
 
-// GetAuthorsURL returns the AuthorsURL field if it's non-nil, zero value otherwise.
-func (i *Import) GetAuthorsURL() string {
-	if i == nil || i.AuthorsURL == nil {
-		return ""
+// GetPublishedAt returns the PublishedAt field if it's non-nil, zero value otherwise.
+func (d *DependabotSecurityAdvisory) GetPublishedAt() Timestamp {
+	if d == nil || d.PublishedAt == nil {
+		return Timestamp{}
 	}
-	return *i.AuthorsURL
+	return *d.PublishedAt
 }
 
-// GetCommitCount returns the CommitCount field if it's non-nil, zero value otherwise.
-func (i *Import) GetCommitCount() int {
-	if i == nil || i.CommitCount == nil {
-		return 0
+// GetSeverity returns the Severity field if it's non-nil, zero value otherwise.
+func (d *DependabotSecurityAdvisory) GetSeverity() string {
+	if d == nil || d.Severity == nil {
+		return ""
 	}
-	return *i.CommitCount
+	return *d.Severity
 }
 
-// GetFailedStep returns the FailedStep field if it's non-nil, zero value otherwise.
-func (i *Import) GetFailedStep() string {
-	if i == nil || i.FailedStep == nil {
+// GetSummary returns the Summary field if it's non-nil, zero value otherwise.
+func (d *DependabotSecurityAdvisory) GetSummary() string {
+	if d == nil || d.Summary == nil {
 		return ""
 	}
-	return *i.FailedStep
+	return *d.Summary
 }
 
-// GetHasLargeFiles returns the HasLargeFiles field if it's non-nil, zero value otherwise.
-func (i *Import) GetHasLargeFiles() bool {
-	if i == nil || i.HasLargeFiles == nil {
-		return false
+// GetUpdatedAt returns the UpdatedAt field if it's non-nil, zero value otherwise.
+func (d *DependabotSecurityAdvisory) GetUpdatedAt() Timestamp {
+	if d == nil || d.UpdatedAt == nil {
+		return Timestamp{}
 	}
-	return *i.HasLargeFiles
+	return *d.UpdatedAt
 }
 
-// GetHTMLURL returns the HTMLURL field if it's non-nil, zero value otherwise.
-func (i *Import) GetHTMLURL() string {
-	if i == nil || i.HTMLURL == nil {
-		return ""
+// GetWithdrawnAt returns the WithdrawnAt field if it's non-nil, zero value otherwise.
+func (d *DependabotSecurityAdvisory) GetWithdrawnAt() Timestamp {
+	if d == nil || d.WithdrawnAt == nil {
+		return Timestamp{}
 	}
-	return *i.HTMLURL
+	return *d.WithdrawnAt
 }
 
-// GetHumanName returns the HumanName field if it's non-nil, zero value otherwise.
-func (i *Import) GetHumanName() string {
-	if i == nil || i.HumanName == nil {
+// GetManifestPath returns the ManifestPath field if it's non-nil, zero value otherwise.
+func (d *Dependency) GetManifestPath() string {
+	if d == nil || d.ManifestPath == nil {
 		return ""
 	}
-	return *i.HumanName
+	return *d.ManifestPath
 }
 
-// GetLargeFilesCount returns the LargeFilesCount field if it's non-nil, zero value otherwise.
-func (i *Import) GetLargeFilesCount() int {
-	if i == nil || i.LargeFilesCount == nil {
-		return 0
+// GetPackage returns the Package field.
+func (d *Dependency) GetPackage() *VulnerabilityPackage {
+	if d == nil {
+		return nil
 	}
-	return *i.LargeFilesCount
+	return d.Package
 }
 
-// GetLargeFilesSize returns the LargeFilesSize field if it's non-nil, zero value otherwise.
-func (i *Import) GetLargeFilesSize() int {
-	if i == nil || i.LargeFilesSize == nil {
-		return 0
+// GetScope returns the Scope field if it's non-nil, zero value otherwise.
+func (d *Dependency) GetScope() string {
+	if d == nil || d.Scope == nil {
+		return ""
 	}
-	return *i.LargeFilesSize
+	return *d.Scope
 }
 
-// GetMessage returns the Message field if it's non-nil, zero value otherwise.
-func (i *Import) GetMessage() string {
-	if i == nil || i.Message == nil {
+// GetChangeType returns the ChangeType field if it's non-nil, zero value otherwise.
+func (d *DependencyChange) GetChangeType() string {
+	if d == nil || d.ChangeType == nil {
 		return ""
 	}
-	return *i.Message
+	return *d.ChangeType
 }
 
-// GetPercent returns the Percent field if it's non-nil, zero value otherwise.
-func (i *Import) GetPercent() int {
-	if i == nil || i.Percent == nil {
-		return 0
+// GetEcosystem returns the Ecosystem field if it's non-nil, zero value otherwise.
+func (d *DependencyChange) GetEcosystem() string {
+	if d == nil || d.Ecosystem == nil {
+		return ""
 	}
-	return *i.Percent
+	return *d.Ecosystem
 }
 
-// GetPushPercent returns the PushPercent field if it's non-nil, zero value otherwise.
-func (i *Import) GetPushPercent() int {
-	if i == nil || i.PushPercent == nil {
-		return 0
+// GetLicense returns the License field if it's non-nil, zero value otherwise.
+func (d *DependencyChange) GetLicense() string {
+	if d == nil || d.License == nil {
+		return ""
 	}
-	return *i.PushPercent
+	return *d.License
 }
 
-// GetRepositoryURL returns the RepositoryURL field if it's non-nil, zero value otherwise.
-func (i *Import) GetRepositoryURL() string {
-	if i == nil || i.RepositoryURL == nil {
+// GetManifest returns the Manifest field if it's non-nil, zero value otherwise.
+func (d *DependencyChange) GetManifest() string {
+	if d == nil || d.Manifest == nil {
 		return ""
 	}
-	return *i.RepositoryURL
+	return *d.Manifest
 }
 
-// GetStatus returns the Status field if it's non-nil, zero value otherwise.
-func (i *Import) GetStatus() string {
-	if i == nil || i.Status == nil {
+// GetName returns the Name field if it's non-nil, zero value otherwise.
+func (d *DependencyChange) GetName() string {
+	if d == nil || d.Name == nil {
 		return ""
 	}
-	return *i.Status
+	return *d.Name
 }
 
-// GetStatusText returns the StatusText field if it's non-nil, zero value otherwise.
-func (i *Import) GetStatusText() string {
-	if i == nil || i.StatusText == nil {
+// GetPackageURL returns the PackageURL field if it's non-nil, zero value otherwise.
+func (d *DependencyChange) GetPackageURL() string {
+	if d == nil || d.PackageURL == nil {
 		return ""
 	}
-	return *i.StatusText
+	return *d.PackageURL
 }
 
-// GetTFVCProject returns the TFVCProject field if it's non-nil, zero value otherwise.
-func (i *Import) GetTFVCProject() string {
-	if i == nil || i.TFVCProject == nil {
+// GetScope returns the Scope field if it's non-nil, zero value otherwise.
+func (d *DependencyChange) GetScope() string {
+	if d == nil || d.Scope == nil {
 		return ""
 	}
-	return *i.TFVCProject
+	return *d.Scope
 }
 
-// GetURL returns the URL field if it's non-nil, zero value otherwise.
-func (i *Import) GetURL() string {
-	if i == nil || i.URL == nil {
+// GetSourceRepositoryURL returns the SourceRepositoryURL field if it's non-nil, zero value otherwise.
+func (d *DependencyChange) GetSourceRepositoryURL() string {
+	if d == nil || d.SourceRepositoryURL == nil {
 		return ""
 	}
-	return *i.URL
+	return *d.SourceRepositoryURL
 }
 
-// GetUseLFS returns the UseLFS field if it's non-nil, zero value otherwise.
-func (i *Import) GetUseLFS() string {
-	if i == nil || i.UseLFS == nil {
+// GetVersion returns the Version field if it's non-nil, zero value otherwise.
+func (d *DependencyChange) GetVersion() string {
+	if d == nil || d.Version == nil {
 		return ""
 	}
-	return *i.UseLFS
+	return *d.Version
 }
 
-// GetVCS returns the VCS field if it's non-nil, zero value otherwise.
-func (i *Import) GetVCS() string {
-	if i == nil || i.VCS == nil {
+// GetAdvisoryGHSAID returns the AdvisoryGHSAID field if it's non-nil, zero value otherwise.
+func (d *DependencyChangeVulnerability) GetAdvisoryGHSAID() string {
+	if d == nil || d.AdvisoryGHSAID == nil {
 		return ""
 	}
-	return *i.VCS
+	return *d.AdvisoryGHSAID
 }
 
-// GetVCSPassword returns the VCSPassword field if it's non-nil, zero value otherwise.
-func (i *Import) GetVCSPassword() string {
-	if i == nil || i.VCSPassword == nil {
+// GetAdvisorySummary returns the AdvisorySummary field if it's non-nil, zero value otherwise.
+func (d *DependencyChangeVulnerability) GetAdvisorySummary() string {
+	if d == nil || d.AdvisorySummary == nil {
 		return ""
 	}
-	return *i.VCSPassword
+	return *d.AdvisorySummary
 }
 
-// GetVCSURL returns the VCSURL field if it's non-nil, zero value otherwise.
-func (i *Import) GetVCSURL() string {
-	if i == nil || i.VCSURL == nil {
+// GetAdvisoryURL returns the AdvisoryURL field if it's non-nil, zero value otherwise.
+func (d *DependencyChangeVulnerability) GetAdvisoryURL() string {
+	if d == nil || d.AdvisoryURL == nil {
 		return ""
 	}
-	return *i.VCSURL
+	return *d.AdvisoryURL
 }
 
-// GetVCSUsername returns the VCSUsername field if it's non-nil, zero value otherwise.
-func (i *Import) GetVCSUsername() string {
-	if i == nil || i.VCSUsername == nil {
+// GetSeverity returns the Severity field if it's non-nil, zero value otherwise.
+func (d *DependencyChangeVulnerability) GetSeverity() string {
+	if d == nil || d.Severity == nil {
 		return ""
 	}
-	return *i.VCSUsername
+	return *d.Severity
 }
 
-// GetAccessTokensURL returns the AccessTokensURL field if it's non-nil, zero value otherwise.
-func (i *Installation) GetAccessTokensURL() string {
-	if i == nil || i.AccessTokensURL == nil {
-		return ""
+// GetDetector returns the Detector field.
+func (d *DependencyGraphSnapshot) GetDetector() *DependencyGraphSnapshotDetector {
+	if d == nil {
+		return nil
 	}
-	return *i.AccessTokensURL
+	return d.Detector
 }
 
-// GetAccount returns the Account field.
-func (i *Installation) GetAccount() *User {
-	if i == nil {
+// GetJob returns the Job field.
+func (d *DependencyGraphSnapshot) GetJob() *DependencyGraphSnapshotJob {
+	if d == nil {
 		return nil
 	}
-	return i.Account
+	return d.Job
 }
 
-// GetAppID returns the AppID field if it's non-nil, zero value otherwise.
-func (i *Installation) GetAppID() int64 {
-	if i == nil || i.AppID == nil {
-		return 0
+// GetRef returns the Ref field if it's non-nil, zero value otherwise.
+func (d *DependencyGraphSnapshot) GetRef() string {
+	if d == nil || d.Ref == nil {
+		return ""
 	}
-	return *i.AppID
+	return *d.Ref
 }
 
-// GetCreatedAt returns the CreatedAt field if it's non-nil, zero value otherwise.
-func (i *Installation) GetCreatedAt() Timestamp {
-	if i == nil || i.CreatedAt == nil {
+// GetScanned returns the Scanned field if it's non-nil, zero value otherwise.
+func (d *DependencyGraphSnapshot) GetScanned() Timestamp {
+	if d == nil || d.Scanned == nil {
 		return Timestamp{}
 	}
-	return *i.CreatedAt
+	return *d.Scanned
 }
 
-// GetHTMLURL returns the HTMLURL field if it's non-nil, zero value otherwise.
-func (i *Installation) GetHTMLURL() string {
-	if i == nil || i.HTMLURL == nil {
+// GetSHA returns the SHA field if it's non-nil, zero value otherwise.
+func (d *DependencyGraphSnapshot) GetSHA() string {
+	if d == nil || d.SHA == nil {
 		return ""
 	}
-	return *i.HTMLURL
+	return *d.SHA
+}
+
+// GetCreatedAt returns the CreatedAt field if it's non-nil, zero value otherwise.
+func (d *DependencyGraphSnapshotCreationData) GetCreatedAt() Timestamp {
+	if d == nil || d.CreatedAt == nil {
+		return Timestamp{}
+	}
+	return *d.CreatedAt
 }
 
 // GetID returns the ID field if it's non-nil, zero value otherwise.
-func (i *Installation) GetID() int64 {
-	if i == nil || i.ID == nil {
+func (d *DependencyGraphSnapshotCreationData) GetID() int64 {
+	if d == nil || d.ID == nil {
 		return 0
 	}
-	return *i.ID
+	return *d.ID
 }
 
-// GetNodeID returns the NodeID field if it's non-nil, zero value otherwise.
-func (i *Installation) GetNodeID() string {
-	if i == nil || i.NodeID == nil {
+// GetMessage returns the Message field if it's non-nil, zero value otherwise.
+func (d *DependencyGraphSnapshotCreationData) GetMessage() string {
+	if d == nil || d.Message == nil {
 		return ""
 	}
-	return *i.NodeID
+	return *d.Message
 }
 
-// GetPermissions returns the Permissions field.
-func (i *Installation) GetPermissions() *InstallationPermissions {
-	if i == nil {
-		return nil
+// GetResult returns the Result field if it's non-nil, zero value otherwise.
+func (d *DependencyGraphSnapshotCreationData) GetResult() string {
+	if d == nil || d.Result == nil {
+		return ""
 	}
-	return i.Permissions
+	return *d.Result
 }
 
-// GetRepositoriesURL returns the RepositoriesURL field if it's non-nil, zero value otherwise.
-func (i *Installation) GetRepositoriesURL() string {
-	if i == nil || i.RepositoriesURL == nil {
+// GetName returns the Name field if it's non-nil, zero value otherwise.
+func (d *DependencyGraphSnapshotDetector) GetName() string {
+	if d == nil || d.Name == nil {
 		return ""
 	}
-	return *i.RepositoriesURL
+	return *d.Name
 }
 
-// GetRepositorySelection returns the RepositorySelection field if it's non-nil, zero value otherwise.
-func (i *Installation) GetRepositorySelection() string {
-	if i == nil || i.RepositorySelection == nil {
+// GetURL returns the URL field if it's non-nil, zero value otherwise.
+func (d *DependencyGraphSnapshotDetector) GetURL() string {
+	if d == nil || d.URL == nil {
 		return ""
 	}
-	return *i.RepositorySelection
+	return *d.URL
 }
 
-// GetSingleFileName returns the SingleFileName field if it's non-nil, zero value otherwise.
-func (i *Installation) GetSingleFileName() string {
-	if i == nil || i.SingleFileName == nil {
+// GetVersion returns the Version field if it's non-nil, zero value otherwise.
+func (d *DependencyGraphSnapshotDetector) GetVersion() string {
+	if d == nil || d.Version == nil {
 		return ""
 	}
-	return *i.SingleFileName
-}
-
-// GetTargetID returns the TargetID field if it's non-nil, zero value otherwise.
-func (i *Installation) GetTargetID() int64 {
-	if i == nil || i.TargetID == nil {
-		return 0
-	}
-	return *i.TargetID
+	return *d.Version
 }
 
-// GetTargetType returns the TargetType field if it's non-nil, zero value otherwise.
-func (i *Installation) GetTargetType() string {
-	if i == nil || i.TargetType == nil {
+// GetCorrelator returns the Correlator field if it's non-nil, zero value otherwise.
+func (d *DependencyGraphSnapshotJob) GetCorrelator() string {
+	if d == nil || d.Correlator == nil {
 		return ""
 	}
-	return *i.TargetType
+	return *d.Correlator
 }
 
-// GetUpdatedAt returns the UpdatedAt field if it's non-nil, zero value otherwise.
-func (i *Installation) GetUpdatedAt() Timestamp {
-	if i == nil || i.UpdatedAt == nil {
-		return Timestamp{}
+// GetHTMLURL returns the HTMLURL field if it's non-nil, zero value otherwise.
+func (d *DependencyGraphSnapshotJob) GetHTMLURL() string {
+	if d == nil || d.HTMLURL == nil {
+		return ""
 	}
-	return *i.UpdatedAt
+	return *d.HTMLURL
 }
 
-// GetAction returns the Action field if it's non-nil, zero value otherwise.
-func (i *InstallationEvent) GetAction() string {
-	if i == nil || i.Action == nil {
+// GetID returns the ID field if it's non-nil, zero value otherwise.
+func (d *DependencyGraphSnapshotJob) GetID() string {
+	if d == nil || d.ID == nil {
 		return ""
 	}
-	return *i.Action
+	return *d.ID
 }
 
-// GetInstallation returns the Installation field.
-func (i *InstallationEvent) GetInstallation() *Installation {
-	if i == nil {
+// GetFile returns the File field.
+func (d *DependencyGraphSnapshotManifest) GetFile() *DependencyGraphSnapshotManifestFile {
+	if d == nil {
 		return nil
 	}
-	return i.Installation
+	return d.File
 }
 
-// GetSender returns the Sender field.
-func (i *InstallationEvent) GetSender() *User {
-	if i == nil {
-		return nil
+// GetName returns the Name field if it's non-nil, zero value otherwise.
+func (d *DependencyGraphSnapshotManifest) GetName() string {
+	if d == nil || d.Name == nil {
+		return ""
 	}
-	return i.Sender
+	return *d.Name
 }
 
-// GetAdministration returns the Administration field if it's non-nil, zero value otherwise.
-func (i *InstallationPermissions) GetAdministration() string {
-	if i == nil || i.Administration == nil {
+// GetSourceLocation returns the SourceLocation field if it's non-nil, zero value otherwise.
+func (d *DependencyGraphSnapshotManifestFile) GetSourceLocation() string {
+	if d == nil || d.SourceLocation == nil {
 		return ""
 	}
-	return *i.Administration
+	return *d.SourceLocation
 }
 
-// GetBlocking returns the Blocking field if it's non-nil, zero value otherwise.
-func (i *InstallationPermissions) GetBlocking() string {
-	if i == nil || i.Blocking == nil {
+// GetPackageURL returns the PackageURL field if it's non-nil, zero value otherwise.
+func (d *DependencyGraphSnapshotResolvedDependency) GetPackageURL() string {
+	if d == nil || d.PackageURL == nil {
 		return ""
 	}
-	return *i.Blocking
+	return *d.PackageURL
 }
 
-// GetChecks returns the Checks field if it's non-nil, zero value otherwise.
-func (i *InstallationPermissions) GetChecks() string {
-	if i == nil || i.Checks == nil {
+// GetRelationship returns the Relationship field if it's non-nil, zero value otherwise.
+func (d *DependencyGraphSnapshotResolvedDependency) GetRelationship() string {
+	if d == nil || d.Relationship == nil {
 		return ""
 	}
-	return *i.Checks
+	return *d.Relationship
 }
 
-// GetContentReferences returns the ContentReferences field if it's non-nil, zero value otherwise.
-func (i *InstallationPermissions) GetContentReferences() string {
-	if i == nil || i.ContentReferences == nil {
+// GetScope returns the Scope field if it's non-nil, zero value otherwise.
+func (d *DependencyGraphSnapshotResolvedDependency) GetScope() string {
+	if d == nil || d.Scope == nil {
 		return ""
 	}
-	return *i.ContentReferences
+	return *d.Scope
 }
 
-// GetContents returns the Contents field if it's non-nil, zero value otherwise.
-func (i *InstallationPermissions) GetContents() string {
-	if i == nil || i.Contents == nil {
+// GetAction returns the Action field if it's non-nil, zero value otherwise.
+func (d *DeployKeyEvent) GetAction() string {
+	if d == nil || d.Action == nil {
 		return ""
 	}
-	return *i.Contents
+	return *d.Action
 }
 
-// GetDeployments returns the Deployments field if it's non-nil, zero value otherwise.
-func (i *InstallationPermissions) GetDeployments() string {
-	if i == nil || i.Deployments == nil {
-		return ""
+// GetKey returns the Key field.
+func (d *DeployKeyEvent) GetKey() *Key {
+	if d == nil {
+		return nil
 	}
-	return *i.Deployments
+	return d.Key
 }
 
-// GetEmails returns the Emails field if it's non-nil, zero value otherwise.
-func (i *InstallationPermissions) GetEmails() string {
-	if i == nil || i.Emails == nil {
-		return ""
+// GetCreatedAt returns the CreatedAt field if it's non-nil, zero value otherwise.
+func (d *Deployment) GetCreatedAt() Timestamp {
+	if d == nil || d.CreatedAt == nil {
+		return Timestamp{}
 	}
-	return *i.Emails
+	return *d.CreatedAt
 }
 
-// GetFollowers returns the Followers field if it's non-nil, zero value otherwise.
-func (i *InstallationPermissions) GetFollowers() string {
-	if i == nil || i.Followers == nil {
-		return ""
+// GetCreator returns the Creator field.
+func (d *Deployment) GetCreator() *User {
+	if d == nil {
+		return nil
 	}
-	return *i.Followers
+	return d.Creator
 }
 
-// GetIssues returns the Issues field if it's non-nil, zero value otherwise.
-func (i *InstallationPermissions) GetIssues() string {
-	if i == nil || i.Issues == nil {
+// GetDescription returns the Description field if it's non-nil, zero value otherwise.
+func (d *Deployment) GetDescription() string {
+	if d == nil || d.Description == nil {
 		return ""
 	}
-	return *i.Issues
+	return *d.Description
 }
 
-// GetMembers returns the Members field if it's non-nil, zero value otherwise.
-func (i *InstallationPermissions) GetMembers() string {
-	if i == nil || i.Members == nil {
+// GetEnvironment returns the Environment field if it's non-nil, zero value otherwise.
+func (d *Deployment) GetEnvironment() string {
+	if d == nil || d.Environment == nil {
 		return ""
 	}
-	return *i.Members
+	return *d.Environment
 }
 
-// GetMetadata returns the Metadata field if it's non-nil, zero value otherwise.
-func (i *InstallationPermissions) GetMetadata() string {
-	if i == nil || i.Metadata == nil {
-		return ""
+// GetID returns the ID field if it's non-nil, zero value otherwise.
+func (d *Deployment) GetID() int64 {
+	if d == nil || d.ID == nil {
+		return 0
 	}
-	return *i.Metadata
+	return *d.ID
 }
 
-// GetOrganizationAdministration returns the OrganizationAdministration field if it's non-nil, zero value otherwise.
-func (i *InstallationPermissions) GetOrganizationAdministration() string {
-	if i == nil || i.OrganizationAdministration == nil {
+// GetNodeID returns the NodeID field if it's non-nil, zero value otherwise.
+func (d *Deployment) GetNodeID() string {
+	if d == nil || d.NodeID == nil {
 		return ""
 	}
-	return *i.OrganizationAdministration
+	return *d.NodeID
 }
 
-// GetOrganizationHooks returns the OrganizationHooks field if it's non-nil, zero value otherwise.
-func (i *InstallationPermissions) GetOrganizationHooks() string {
-	if i == nil || i.OrganizationHooks == nil {
+// GetRef returns the Ref field if it's non-nil, zero value otherwise.
+func (d *Deployment) GetRef() string {
+	if d == nil || d.Ref == nil {
 		return ""
 	}
-	return *i.OrganizationHooks
+	return *d.Ref
 }
 
-// GetOrganizationPlan returns the OrganizationPlan field if it's non-nil, zero value otherwise.
-func (i *InstallationPermissions) GetOrganizationPlan() string {
-	if i == nil || i.OrganizationPlan == nil {
+// GetRepositoryURL returns the RepositoryURL field if it's non-nil, zero value otherwise.
+func (d *Deployment) GetRepositoryURL() string {
+	if d == nil || d.RepositoryURL == nil {
 		return ""
 	}
-	return *i.OrganizationPlan
+	return *d.RepositoryURL
 }
 
-// GetOrganizationPreReceiveHooks returns the OrganizationPreReceiveHooks field if it's non-nil, zero value otherwise.
-func (i *InstallationPermissions) GetOrganizationPreReceiveHooks() string {
-	if i == nil || i.OrganizationPreReceiveHooks == nil {
+// GetSHA returns the SHA field if it's non-nil, zero value otherwise.
+func (d *Deployment) GetSHA() string {
+	if d == nil || d.SHA == nil {
 		return ""
 	}
-	return *i.OrganizationPreReceiveHooks
+	return *d.SHA
 }
 
-// GetOrganizationProjects returns the OrganizationProjects field if it's non-nil, zero value otherwise.
-func (i *InstallationPermissions) GetOrganizationProjects() string {
-	if i == nil || i.OrganizationProjects == nil {
+// GetStatusesURL returns the StatusesURL field if it's non-nil, zero value otherwise.
+func (d *Deployment) GetStatusesURL() string {
+	if d == nil || d.StatusesURL == nil {
 		return ""
 	}
-	return *i.OrganizationProjects
+	return *d.StatusesURL
 }
 
-// GetOrganizationUserBlocking returns the OrganizationUserBlocking field if it's non-nil, zero value otherwise.
-func (i *InstallationPermissions) GetOrganizationUserBlocking() string {
-	if i == nil || i.OrganizationUserBlocking == nil {
+// GetTask returns the Task field if it's non-nil, zero value otherwise.
+func (d *Deployment) GetTask() string {
+	if d == nil || d.Task == nil {
 		return ""
 	}
-	return *i.OrganizationUserBlocking
+	return *d.Task
 }
 
-// GetPackages returns the Packages field if it's non-nil, zero value otherwise.
-func (i *InstallationPermissions) GetPackages() string {
-	if i == nil || i.Packages == nil {
-		return ""
+// GetUpdatedAt returns the UpdatedAt field if it's non-nil, zero value otherwise.
+func (d *Deployment) GetUpdatedAt() Timestamp {
+	if d == nil || d.UpdatedAt == nil {
+		return Timestamp{}
 	}
-	return *i.Packages
+	return *d.UpdatedAt
 }
 
-// GetPages returns the Pages field if it's non-nil, zero value otherwise.
-func (i *InstallationPermissions) GetPages() string {
-	if i == nil || i.Pages == nil {
+// GetURL returns the URL field if it's non-nil, zero value otherwise.
+func (d *Deployment) GetURL() string {
+	if d == nil || d.URL == nil {
 		return ""
 	}
-	return *i.Pages
+	return *d.URL
 }
 
-// GetPullRequests returns the PullRequests field if it's non-nil, zero value otherwise.
-func (i *InstallationPermissions) GetPullRequests() string {
-	if i == nil || i.PullRequests == nil {
-		return ""
+// GetDeployment returns the Deployment field.
+func (d *DeploymentEvent) GetDeployment() *Deployment {
+	if d == nil {
+		return nil
 	}
-	return *i.PullRequests
+	return d.Deployment
 }
 
-// GetRepositoryHooks returns the RepositoryHooks field if it's non-nil, zero value otherwise.
-func (i *InstallationPermissions) GetRepositoryHooks() string {
-	if i == nil || i.RepositoryHooks == nil {
-		return ""
+// GetInstallation returns the Installation field.
+func (d *DeploymentEvent) GetInstallation() *Installation {
+	if d == nil {
+		return nil
 	}
-	return *i.RepositoryHooks
+	return d.Installation
 }
 
-// GetRepositoryPreReceiveHooks returns the RepositoryPreReceiveHooks field if it's non-nil, zero value otherwise.
-func (i *InstallationPermissions) GetRepositoryPreReceiveHooks() string {
-	if i == nil || i.RepositoryPreReceiveHooks == nil {
-		return ""
+// GetRepo returns the Repo field.
+func (d *DeploymentEvent) GetRepo() *Repository {
+	if d == nil {
+		return nil
 	}
-	return *i.RepositoryPreReceiveHooks
+	return d.Repo
 }
 
-// GetRepositoryProjects returns the RepositoryProjects field if it's non-nil, zero value otherwise.
-func (i *InstallationPermissions) GetRepositoryProjects() string {
-	if i == nil || i.RepositoryProjects == nil {
-		return ""
+// GetSender returns the Sender field.
+func (d *DeploymentEvent) GetSender() *User {
+	if d == nil {
+		return nil
 	}
-	return *i.RepositoryProjects
+	return d.Sender
 }
 
-// GetSingleFile returns the SingleFile field if it's non-nil, zero value otherwise.
-func (i *InstallationPermissions) GetSingleFile() string {
-	if i == nil || i.SingleFile == nil {
-		return ""
+// GetAutoMerge returns the AutoMerge field if it's non-nil, zero value otherwise.
+func (d *DeploymentRequest) GetAutoMerge() bool {
+	if d == nil || d.AutoMerge == nil {
+		return false
 	}
-	return *i.SingleFile
+	return *d.AutoMerge
 }
 
-// GetStatuses returns the Statuses field if it's non-nil, zero value otherwise.
-func (i *InstallationPermissions) GetStatuses() string {
-	if i == nil || i.Statuses == nil {
+// GetDescription returns the Description field if it's non-nil, zero value otherwise.
+func (d *DeploymentRequest) GetDescription() string {
+	if d == nil || d.Description == nil {
 		return ""
 	}
-	return *i.Statuses
+	return *d.Description
 }
 
-// GetTeamDiscussions returns the TeamDiscussions field if it's non-nil, zero value otherwise.
-func (i *InstallationPermissions) GetTeamDiscussions() string {
-	if i == nil || i.TeamDiscussions == nil {
+// GetEnvironment returns the Environment field if it's non-nil, zero value otherwise.
+func (d *DeploymentRequest) GetEnvironment() string {
+	if d == nil || d.Environment == nil {
 		return ""
 	}
-	return *i.TeamDiscussions
+	return *d.Environment
 }
 
-// GetVulnerabilityAlerts returns the VulnerabilityAlerts field if it's non-nil, zero value otherwise.
-func (i *InstallationPermissions) GetVulnerabilityAlerts() string {
-	if i == nil || i.VulnerabilityAlerts == nil {
-		return ""
+// GetProductionEnvironment returns the ProductionEnvironment field if it's non-nil, zero value otherwise.
+func (d *DeploymentRequest) GetProductionEnvironment() bool {
+	if d == nil || d.ProductionEnvironment == nil {
+		return false
 	}
-	return *i.VulnerabilityAlerts
+	return *d.ProductionEnvironment
 }
 
-// GetAction returns the Action field if it's non-nil, zero value otherwise.
-func (i *InstallationRepositoriesEvent) GetAction() string {
-	if i == nil || i.Action == nil {
+// GetRef returns the Ref field if it's non-nil, zero value otherwise.
+func (d *DeploymentRequest) GetRef() string {
+	if d == nil || d.Ref == nil {
 		return ""
 	}
-	return *i.Action
+	return *d.Ref
 }
 
-// GetInstallation returns the Installation field.
-func (i *InstallationRepositoriesEvent) GetInstallation() *Installation {
-	if i == nil {
+// GetRequiredContexts returns the RequiredContexts field if it's non-nil, zero value otherwise.
+func (d *DeploymentRequest) GetRequiredContexts() []string {
+	if d == nil || d.RequiredContexts == nil {
 		return nil
 	}
-	return i.Installation
+	return *d.RequiredContexts
 }
 
-// GetRepositorySelection returns the RepositorySelection field if it's non-nil, zero value otherwise.
-func (i *InstallationRepositoriesEvent) GetRepositorySelection() string {
-	if i == nil || i.RepositorySelection == nil {
+// GetTask returns the Task field if it's non-nil, zero value otherwise.
+func (d *DeploymentRequest) GetTask() string {
+	if d == nil || d.Task == nil {
 		return ""
 	}
-	return *i.RepositorySelection
+	return *d.Task
 }
 
-// GetSender returns the Sender field.
-func (i *InstallationRepositoriesEvent) GetSender() *User {
-	if i == nil {
-		return nil
+// GetTransientEnvironment returns the TransientEnvironment field if it's non-nil, zero value otherwise.
+func (d *DeploymentRequest) GetTransientEnvironment() bool {
+	if d == nil || d.TransientEnvironment == nil {
+		return false
 	}
-	return i.Sender
+	return *d.TransientEnvironment
 }
 
-// GetExpiresAt returns the ExpiresAt field if it's non-nil, zero value otherwise.
-func (i *InstallationToken) GetExpiresAt() time.Time {
-	if i == nil || i.ExpiresAt == nil {
-		return time.Time{}
+// GetCreatedAt returns the CreatedAt field if it's non-nil, zero value otherwise.
+func (d *DeploymentStatus) GetCreatedAt() Timestamp {
+	if d == nil || d.CreatedAt == nil {
+		return Timestamp{}
 	}
-	return *i.ExpiresAt
+	return *d.CreatedAt
 }
 
-// GetPermissions returns the Permissions field.
-func (i *InstallationToken) GetPermissions() *InstallationPermissions {
-	if i == nil {
+// GetCreator returns the Creator field.
+func (d *DeploymentStatus) GetCreator() *User {
+	if d == nil {
 		return nil
 	}
-	return i.Permissions
+	return d.Creator
 }
 
-// GetToken returns the Token field if it's non-nil, zero value otherwise.
-func (i *InstallationToken) GetToken() string {
-	if i == nil || i.Token == nil {
+// GetDeploymentURL returns the DeploymentURL field if it's non-nil, zero value otherwise.
+func (d *DeploymentStatus) GetDeploymentURL() string {
+	if d == nil || d.DeploymentURL == nil {
 		return ""
 	}
-	return *i.Token
-}
-
-// GetPermissions returns the Permissions field.
-func (i *InstallationTokenOptions) GetPermissions() *InstallationPermissions {
-	if i == nil {
-		return nil
-	}
-	return i.Permissions
-}
-
-// GetExpiresAt returns the ExpiresAt field if it's non-nil, zero value otherwise.
-func (i *InteractionRestriction) GetExpiresAt() Timestamp {
-	if i == nil || i.ExpiresAt == nil {
-		return Timestamp{}
-	}
-	return *i.ExpiresAt
+	return *d.DeploymentURL
 }
 
-// GetLimit returns the Limit field if it's non-nil, zero value otherwise.
-func (i *InteractionRestriction) GetLimit() string {
-	if i == nil || i.Limit == nil {
+// GetDescription returns the Description field if it's non-nil, zero value otherwise.
+func (d *DeploymentStatus) GetDescription() string {
+	if d == nil || d.Description == nil {
 		return ""
 	}
-	return *i.Limit
+	return *d.Description
 }
 
-// GetOrigin returns the Origin field if it's non-nil, zero value otherwise.
-func (i *InteractionRestriction) GetOrigin() string {
-	if i == nil || i.Origin == nil {
+// GetEnvironment returns the Environment field if it's non-nil, zero value otherwise.
+func (d *DeploymentStatus) GetEnvironment() string {
+	if d == nil || d.Environment == nil {
 		return ""
 	}
-	return *i.Origin
-}
-
-// GetCreatedAt returns the CreatedAt field if it's non-nil, zero value otherwise.
-func (i *Invitation) GetCreatedAt() time.Time {
-	if i == nil || i.CreatedAt == nil {
-		return time.Time{}
-	}
-	return *i.CreatedAt
+	return *d.Environment
 }
 
-// GetEmail returns the Email field if it's non-nil, zero value otherwise.
-func (i *Invitation) GetEmail() string {
-	if i == nil || i.Email == nil {
+// GetEnvironmentURL returns the EnvironmentURL field if it's non-nil, zero value otherwise.
+func (d *DeploymentStatus) GetEnvironmentURL() string {
+	if d == nil || d.EnvironmentURL == nil {
 		return ""
 	}
-	return *i.Email
+	return *d.EnvironmentURL
 }
 
 // GetID returns the ID field if it's non-nil, zero value otherwise.
-func (i *Invitation) GetID() int64 {
-	if i == nil || i.ID == nil {
+func (d *DeploymentStatus) GetID() int64 {
+	if d == nil || d.ID == nil {
 		return 0
 	}
-	return *i.ID
+	return *d.ID
 }
 
-// GetInvitationTeamURL returns the InvitationTeamURL field if it's non-nil, zero value otherwise.
-func (i *Invitation) GetInvitationTeamURL() string {
-	if i == nil || i.InvitationTeamURL == nil {
+// GetLogURL returns the LogURL field if it's non-nil, zero value otherwise.
+func (d *DeploymentStatus) GetLogURL() string {
+	if d == nil || d.LogURL == nil {
 		return ""
 	}
-	return *i.InvitationTeamURL
+	return *d.LogURL
 }
 
-// GetInviter returns the Inviter field.
-func (i *Invitation) GetInviter() *User {
-	if i == nil {
-		return nil
+// GetNodeID returns the NodeID field if it's non-nil, zero value otherwise.
+func (d *DeploymentStatus) GetNodeID() string {
+	if d == nil || d.NodeID == nil {
+		return ""
 	}
-	return i.Inviter
+	return *d.NodeID
 }
 
-// GetLogin returns the Login field if it's non-nil, zero value otherwise.
-func (i *Invitation) GetLogin() string {
-	if i == nil || i.Login == nil {
+// GetRepositoryURL returns the RepositoryURL field if it's non-nil, zero value otherwise.
+func (d *DeploymentStatus) GetRepositoryURL() string {
+	if d == nil || d.RepositoryURL == nil {
 		return ""
 	}
-	return *i.Login
+	return *d.RepositoryURL
 }
 
-// GetNodeID returns the NodeID field if it's non-nil, zero value otherwise.
-func (i *Invitation) GetNodeID() string {
-	if i == nil || i.NodeID == nil {
+// GetState returns the State field if it's non-nil, zero value otherwise.
+func (d *DeploymentStatus) GetState() string {
+	if d == nil || d.State == nil {
 		return ""
 	}
-	return *i.NodeID
+	return *d.State
 }
 
-// GetRole returns the Role field if it's non-nil, zero value otherwise.
-func (i *Invitation) GetRole() string {
-	if i == nil || i.Role == nil {
+// GetTargetURL returns the TargetURL field if it's non-nil, zero value otherwise.
+func (d *DeploymentStatus) GetTargetURL() string {
+	if d == nil || d.TargetURL == nil {
 		return ""
 	}
-	return *i.Role
+	return *d.TargetURL
 }
 
-// GetTeamCount returns the TeamCount field if it's non-nil, zero value otherwise.
-func (i *Invitation) GetTeamCount() int {
-	if i == nil || i.TeamCount == nil {
-		return 0
+// GetUpdatedAt returns the UpdatedAt field if it's non-nil, zero value otherwise.
+func (d *DeploymentStatus) GetUpdatedAt() Timestamp {
+	if d == nil || d.UpdatedAt == nil {
+		return Timestamp{}
 	}
-	return *i.TeamCount
+	return *d.UpdatedAt
 }
 
-// GetActiveLockReason returns the ActiveLockReason field if it's non-nil, zero value otherwise.
-func (i *Issue) GetActiveLockReason() string {
-	if i == nil || i.ActiveLockReason == nil {
+// GetURL returns the URL field if it's non-nil, zero value otherwise.
+func (d *DeploymentStatus) GetURL() string {
+	if d == nil || d.URL == nil {
 		return ""
 	}
-	return *i.ActiveLockReason
+	return *d.URL
 }
 
-// GetAssignee returns the Assignee field.
-func (i *Issue) GetAssignee() *User {
-	if i == nil {
+// GetDeployment returns the Deployment field.
+func (d *DeploymentStatusEvent) GetDeployment() *Deployment {
+	if d == nil {
 		return nil
 	}
-	return i.Assignee
+	return d.Deployment
 }
 
-// GetAuthorAssociation returns the AuthorAssociation field if it's non-nil, zero value otherwise.
-func (i *Issue) GetAuthorAssociation() string {
-	if i == nil || i.AuthorAssociation == nil {
-		return ""
+// GetDeploymentStatus returns the DeploymentStatus field.
+func (d *DeploymentStatusEvent) GetDeploymentStatus() *DeploymentStatus {
+	if d == nil {
+		return nil
 	}
-	return *i.AuthorAssociation
+	return d.DeploymentStatus
 }
 
-// GetBody returns the Body field if it's non-nil, zero value otherwise.
-func (i *Issue) GetBody() string {
-	if i == nil || i.Body == nil {
-		return ""
+// GetInstallation returns the Installation field.
+func (d *DeploymentStatusEvent) GetInstallation() *Installation {
+	if d == nil {
+		return nil
 	}
-	return *i.Body
+	return d.Installation
 }
 
-// GetClosedAt returns the ClosedAt field if it's non-nil, zero value otherwise.
-func (i *Issue) GetClosedAt() time.Time {
-	if i == nil || i.ClosedAt == nil {
-		return time.Time{}
+// GetRepo returns the Repo field.
+func (d *DeploymentStatusEvent) GetRepo() *Repository {
+	if d == nil {
+		return nil
 	}
-	return *i.ClosedAt
+	return d.Repo
 }
 
-// GetClosedBy returns the ClosedBy field.
-func (i *Issue) GetClosedBy() *User {
-	if i == nil {
+// GetSender returns the Sender field.
+func (d *DeploymentStatusEvent) GetSender() *User {
+	if d == nil {
 		return nil
 	}
-	return i.ClosedBy
+	return d.Sender
 }
 
-// GetComments returns the Comments field if it's non-nil, zero value otherwise.
-func (i *Issue) GetComments() int {
-	if i == nil || i.Comments == nil {
-		return 0
+// GetAutoInactive returns the AutoInactive field if it's non-nil, zero value otherwise.
+func (d *DeploymentStatusRequest) GetAutoInactive() bool {
+	if d == nil || d.AutoInactive == nil {
+		return false
 	}
-	return *i.Comments
+	return *d.AutoInactive
 }
 
-// GetCommentsURL returns the CommentsURL field if it's non-nil, zero value otherwise.
-func (i *Issue) GetCommentsURL() string {
-	if i == nil || i.CommentsURL == nil {
+// GetDescription returns the Description field if it's non-nil, zero value otherwise.
+func (d *DeploymentStatusRequest) GetDescription() string {
+	if d == nil || d.Description == nil {
 		return ""
 	}
-	return *i.CommentsURL
+	return *d.Description
 }
 
-// GetCreatedAt returns the CreatedAt field if it's non-nil, zero value otherwise.
-func (i *Issue) GetCreatedAt() time.Time {
-	if i == nil || i.CreatedAt == nil {
-		return time.Time{}
+// GetEnvironment returns the Environment field if it's non-nil, zero value otherwise.
+func (d *DeploymentStatusRequest) GetEnvironment() string {
+	if d == nil || d.Environment == nil {
+		return ""
 	}
-	return *i.CreatedAt
+	return *d.Environment
 }
 
-// GetEventsURL returns the EventsURL field if it's non-nil, zero value otherwise.
-func (i *Issue) GetEventsURL() string {
-	if i == nil || i.EventsURL == nil {
+// GetEnvironmentURL returns the EnvironmentURL field if it's non-nil, zero value otherwise.
+func (d *DeploymentStatusRequest) GetEnvironmentURL() string {
+	if d == nil || d.EnvironmentURL == nil {
 		return ""
 	}
-	return *i.EventsURL
+	return *d.EnvironmentURL
 }
 
-// GetHTMLURL returns the HTMLURL field if it's non-nil, zero value otherwise.
-func (i *Issue) GetHTMLURL() string {
-	if i == nil || i.HTMLURL == nil {
+// GetLogURL returns the LogURL field if it's non-nil, zero value otherwise.
+func (d *DeploymentStatusRequest) GetLogURL() string {
+	if d == nil || d.LogURL == nil {
 		return ""
 	}
-	return *i.HTMLURL
+	return *d.LogURL
 }
 
-// GetID returns the ID field if it's non-nil, zero value otherwise.
-func (i *Issue) GetID() int64 {
-	if i == nil || i.ID == nil {
-		return 0
+// GetState returns the State field if it's non-nil, zero value otherwise.
+func (d *DeploymentStatusRequest) GetState() string {
+	if d == nil || d.State == nil {
+		return ""
 	}
-	return *i.ID
+	return *d.State
 }
 
-// GetLabelsURL returns the LabelsURL field if it's non-nil, zero value otherwise.
-func (i *Issue) GetLabelsURL() string {
-	if i == nil || i.LabelsURL == nil {
-		return ""
+// GetAuthor returns the Author field.
+func (d *DiscussionComment) GetAuthor() *User {
+	if d == nil {
+		return nil
 	}
-	return *i.LabelsURL
+	return d.Author
 }
 
-// GetLocked returns the Locked field if it's non-nil, zero value otherwise.
-func (i *Issue) GetLocked() bool {
-	if i == nil || i.Locked == nil {
-		return false
+// GetBody returns the Body field if it's non-nil, zero value otherwise.
+func (d *DiscussionComment) GetBody() string {
+	if d == nil || d.Body == nil {
+		return ""
 	}
-	return *i.Locked
+	return *d.Body
 }
 
-// GetMilestone returns the Milestone field.
-func (i *Issue) GetMilestone() *Milestone {
-	if i == nil {
-		return nil
+// GetBodyHTML returns the BodyHTML field if it's non-nil, zero value otherwise.
+func (d *DiscussionComment) GetBodyHTML() string {
+	if d == nil || d.BodyHTML == nil {
+		return ""
 	}
-	return i.Milestone
+	return *d.BodyHTML
 }
 
-// GetNodeID returns the NodeID field if it's non-nil, zero value otherwise.
-func (i *Issue) GetNodeID() string {
-	if i == nil || i.NodeID == nil {
+// GetBodyVersion returns the BodyVersion field if it's non-nil, zero value otherwise.
+func (d *DiscussionComment) GetBodyVersion() string {
+	if d == nil || d.BodyVersion == nil {
 		return ""
 	}
-	return *i.NodeID
+	return *d.BodyVersion
 }
 
-// GetNumber returns the Number field if it's non-nil, zero value otherwise.
-func (i *Issue) GetNumber() int {
-	if i == nil || i.Number == nil {
-		return 0
+// GetCreatedAt returns the CreatedAt field if it's non-nil, zero value otherwise.
+func (d *DiscussionComment) GetCreatedAt() Timestamp {
+	if d == nil || d.CreatedAt == nil {
+		return Timestamp{}
 	}
-	return *i.Number
+	return *d.CreatedAt
 }
 
-// GetPullRequestLinks returns the PullRequestLinks field.
-func (i *Issue) GetPullRequestLinks() *PullRequestLinks {
-	if i == nil {
-		return nil
+// GetDiscussionURL returns the DiscussionURL field if it's non-nil, zero value otherwise.
+func (d *DiscussionComment) GetDiscussionURL() string {
+	if d == nil || d.DiscussionURL == nil {
+		return ""
 	}
-	return i.PullRequestLinks
+	return *d.DiscussionURL
 }
 
-// GetReactions returns the Reactions field.
-func (i *Issue) GetReactions() *Reactions {
-	if i == nil {
-		return nil
+// GetHTMLURL returns the HTMLURL field if it's non-nil, zero value otherwise.
+func (d *DiscussionComment) GetHTMLURL() string {
+	if d == nil || d.HTMLURL == nil {
+		return ""
 	}
-	return i.Reactions
+	return *d.HTMLURL
 }
 
-// GetRepository returns the Repository field.
-func (i *Issue) GetRepository() *Repository {
-	if i == nil {
-		return nil
+// GetLastEditedAt returns the LastEditedAt field if it's non-nil, zero value otherwise.
+func (d *DiscussionComment) GetLastEditedAt() Timestamp {
+	if d == nil || d.LastEditedAt == nil {
+		return Timestamp{}
 	}
-	return i.Repository
+	return *d.LastEditedAt
 }
 
-// GetRepositoryURL returns the RepositoryURL field if it's non-nil, zero value otherwise.
-func (i *Issue) GetRepositoryURL() string {
-	if i == nil || i.RepositoryURL == nil {
+// GetNodeID returns the NodeID field if it's non-nil, zero value otherwise.
+func (d *DiscussionComment) GetNodeID() string {
+	if d == nil || d.NodeID == nil {
 		return ""
 	}
-	return *i.RepositoryURL
+	return *d.NodeID
 }
 
-// GetState returns the State field if it's non-nil, zero value otherwise.
-func (i *Issue) GetState() string {
-	if i == nil || i.State == nil {
-		return ""
+// GetNumber returns the Number field if it's non-nil, zero value otherwise.
+func (d *DiscussionComment) GetNumber() int {
+	if d == nil || d.Number == nil {
+		return 0
 	}
-	return *i.State
+	return *d.Number
 }
 
-// GetTitle returns the Title field if it's non-nil, zero value otherwise.
-func (i *Issue) GetTitle() string {
-	if i == nil || i.Title == nil {
-		return ""
+// GetReactions returns the Reactions field.
+func (d *DiscussionComment) GetReactions() *Reactions {
+	if d == nil {
+		return nil
 	}
-	return *i.Title
+	return d.Reactions
 }
 
 // GetUpdatedAt returns the UpdatedAt field if it's non-nil, zero value otherwise.
-func (i *Issue) GetUpdatedAt() time.Time {
-	if i == nil || i.UpdatedAt == nil {
-		return time.Time{}
+func (d *DiscussionComment) GetUpdatedAt() Timestamp {
+	if d == nil || d.UpdatedAt == nil {
+		return Timestamp{}
 	}
-	return *i.UpdatedAt
+	return *d.UpdatedAt
 }
 
 // GetURL returns the URL field if it's non-nil, zero value otherwise.
-func (i *Issue) GetURL() string {
-	if i == nil || i.URL == nil {
+func (d *DiscussionComment) GetURL() string {
+	if d == nil || d.URL == nil {
 		return ""
 	}
-	return *i.URL
+	return *d.URL
 }
 
-// GetUser returns the User field.
-func (i *Issue) GetUser() *User {
-	if i == nil {
+// GetTeams returns the Teams field if it's non-nil, zero value otherwise.
+func (d *DismissalRestrictionsRequest) GetTeams() []string {
+	if d == nil || d.Teams == nil {
 		return nil
 	}
-	return i.User
+	return *d.Teams
 }
 
-// GetAuthorAssociation returns the AuthorAssociation field if it's non-nil, zero value otherwise.
-func (i *IssueComment) GetAuthorAssociation() string {
-	if i == nil || i.AuthorAssociation == nil {
-		return ""
+// GetUsers returns the Users field if it's non-nil, zero value otherwise.
+func (d *DismissalRestrictionsRequest) GetUsers() []string {
+	if d == nil || d.Users == nil {
+		return nil
 	}
-	return *i.AuthorAssociation
+	return *d.Users
 }
 
-// GetBody returns the Body field if it's non-nil, zero value otherwise.
-func (i *IssueComment) GetBody() string {
-	if i == nil || i.Body == nil {
+// GetDismissalCommitID returns the DismissalCommitID field if it's non-nil, zero value otherwise.
+func (d *DismissedReview) GetDismissalCommitID() string {
+	if d == nil || d.DismissalCommitID == nil {
 		return ""
 	}
-	return *i.Body
-}
-
-// GetCreatedAt returns the CreatedAt field if it's non-nil, zero value otherwise.
-func (i *IssueComment) GetCreatedAt() time.Time {
-	if i == nil || i.CreatedAt == nil {
-		return time.Time{}
-	}
-	return *i.CreatedAt
+	return *d.DismissalCommitID
 }
 
-// GetHTMLURL returns the HTMLURL field if it's non-nil, zero value otherwise.
-func (i *IssueComment) GetHTMLURL() string {
-	if i == nil || i.HTMLURL == nil {
+// GetDismissalMessage returns the DismissalMessage field if it's non-nil, zero value otherwise.
+func (d *DismissedReview) GetDismissalMessage() string {
+	if d == nil || d.DismissalMessage == nil {
 		return ""
 	}
-	return *i.HTMLURL
+	return *d.DismissalMessage
 }
 
-// GetID returns the ID field if it's non-nil, zero value otherwise.
-func (i *IssueComment) GetID() int64 {
-	if i == nil || i.ID == nil {
+// GetReviewID returns the ReviewID field if it's non-nil, zero value otherwise.
+func (d *DismissedReview) GetReviewID() int64 {
+	if d == nil || d.ReviewID == nil {
 		return 0
 	}
-	return *i.ID
+	return *d.ReviewID
 }
 
-// GetIssueURL returns the IssueURL field if it's non-nil, zero value otherwise.
-func (i *IssueComment) GetIssueURL() string {
-	if i == nil || i.IssueURL == nil {
+// GetState returns the State field if it's non-nil, zero value otherwise.
+func (d *DismissedReview) GetState() string {
+	if d == nil || d.State == nil {
 		return ""
 	}
-	return *i.IssueURL
+	return *d.State
 }
 
-// GetNodeID returns the NodeID field if it's non-nil, zero value otherwise.
-func (i *IssueComment) GetNodeID() string {
-	if i == nil || i.NodeID == nil {
-		return ""
+// GetClientPayload returns the ClientPayload field if it's non-nil, zero value otherwise.
+func (d *DispatchRequestOptions) GetClientPayload() json.RawMessage {
+	if d == nil || d.ClientPayload == nil {
+		return json.RawMessage{}
 	}
-	return *i.NodeID
+	return *d.ClientPayload
 }
 
-// GetReactions returns the Reactions field.
-func (i *IssueComment) GetReactions() *Reactions {
-	if i == nil {
-		return nil
+// GetBody returns the Body field if it's non-nil, zero value otherwise.
+func (d *DraftReviewComment) GetBody() string {
+	if d == nil || d.Body == nil {
+		return ""
 	}
-	return i.Reactions
+	return *d.Body
 }
 
-// GetUpdatedAt returns the UpdatedAt field if it's non-nil, zero value otherwise.
-func (i *IssueComment) GetUpdatedAt() time.Time {
-	if i == nil || i.UpdatedAt == nil {
-		return time.Time{}
+// GetLine returns the Line field if it's non-nil, zero value otherwise.
+func (d *DraftReviewComment) GetLine() int {
+	if d == nil || d.Line == nil {
+		return 0
 	}
-	return *i.UpdatedAt
+	return *d.Line
 }
 
-// GetURL returns the URL field if it's non-nil, zero value otherwise.
-func (i *IssueComment) GetURL() string {
-	if i == nil || i.URL == nil {
+// GetPath returns the Path field if it's non-nil, zero value otherwise.
+func (d *DraftReviewComment) GetPath() string {
+	if d == nil || d.Path == nil {
 		return ""
 	}
-	return *i.URL
+	return *d.Path
 }
 
-// GetUser returns the User field.
-func (i *IssueComment) GetUser() *User {
-	if i == nil {
-		return nil
+// GetPosition returns the Position field if it's non-nil, zero value otherwise.
+func (d *DraftReviewComment) GetPosition() int {
+	if d == nil || d.Position == nil {
+		return 0
+	}
+	return *d.Position
+}
+
+// GetSide returns the Side field if it's non-nil, zero value otherwise.
+func (d *DraftReviewComment) GetSide() string {
+	if d == nil || d.Side == nil {
+		return ""
+	}
+	return *d.Side
+}
+
+// GetStartLine returns the StartLine field if it's non-nil, zero value otherwise.
+func (d *DraftReviewComment) GetStartLine() int {
+	if d == nil || d.StartLine == nil {
+		return 0
+	}
+	return *d.StartLine
+}
+
+// GetStartSide returns the StartSide field if it's non-nil, zero value otherwise.
+func (d *DraftReviewComment) GetStartSide() string {
+	if d == nil || d.StartSide == nil {
+		return ""
+	}
+	return *d.StartSide
+}
+
+// GetAvatarURL returns the AvatarURL field if it's non-nil, zero value otherwise.
+func (e *Enterprise) GetAvatarURL() string {
+	if e == nil || e.AvatarURL == nil {
+		return ""
+	}
+	return *e.AvatarURL
+}
+
+// GetCreatedAt returns the CreatedAt field if it's non-nil, zero value otherwise.
+func (e *Enterprise) GetCreatedAt() Timestamp {
+	if e == nil || e.CreatedAt == nil {
+		return Timestamp{}
+	}
+	return *e.CreatedAt
+}
+
+// GetDescription returns the Description field if it's non-nil, zero value otherwise.
+func (e *Enterprise) GetDescription() string {
+	if e == nil || e.Description == nil {
+		return ""
+	}
+	return *e.Description
+}
+
+// GetHTMLURL returns the HTMLURL field if it's non-nil, zero value otherwise.
+func (e *Enterprise) GetHTMLURL() string {
+	if e == nil || e.HTMLURL == nil {
+		return ""
+	}
+	return *e.HTMLURL
+}
+
+// GetID returns the ID field if it's non-nil, zero value otherwise.
+func (e *Enterprise) GetID() int {
+	if e == nil || e.ID == nil {
+		return 0
+	}
+	return *e.ID
+}
+
+// GetName returns the Name field if it's non-nil, zero value otherwise.
+func (e *Enterprise) GetName() string {
+	if e == nil || e.Name == nil {
+		return ""
+	}
+	return *e.Name
+}
+
+// GetNodeID returns the NodeID field if it's non-nil, zero value otherwise.
+func (e *Enterprise) GetNodeID() string {
+	if e == nil || e.NodeID == nil {
+		return ""
+	}
+	return *e.NodeID
+}
+
+// GetSlug returns the Slug field if it's non-nil, zero value otherwise.
+func (e *Enterprise) GetSlug() string {
+	if e == nil || e.Slug == nil {
+		return ""
+	}
+	return *e.Slug
+}
+
+// GetUpdatedAt returns the UpdatedAt field if it's non-nil, zero value otherwise.
+func (e *Enterprise) GetUpdatedAt() Timestamp {
+	if e == nil || e.UpdatedAt == nil {
+		return Timestamp{}
+	}
+	return *e.UpdatedAt
+}
+
+// GetWebsiteURL returns the WebsiteURL field if it's non-nil, zero value otherwise.
+func (e *Enterprise) GetWebsiteURL() string {
+	if e == nil || e.WebsiteURL == nil {
+		return ""
+	}
+	return *e.WebsiteURL
+}
+
+// GetActor returns the Actor field.
+func (e *Event) GetActor() *User {
+	if e == nil {
+		return nil
+	}
+	return e.Actor
+}
+
+// GetCreatedAt returns the CreatedAt field if it's non-nil, zero value otherwise.
+func (e *Event) GetCreatedAt() time.Time {
+	if e == nil || e.CreatedAt == nil {
+		return time.Time{}
+	}
+	return *e.CreatedAt
+}
+
+// GetID returns the ID field if it's non-nil, zero value otherwise.
+func (e *Event) GetID() string {
+	if e == nil || e.ID == nil {
+		return ""
+	}
+	return *e.ID
+}
+
+// GetOrg returns the Org field.
+func (e *Event) GetOrg() *Organization {
+	if e == nil {
+		return nil
+	}
+	return e.Org
+}
+
+// GetPublic returns the Public field if it's non-nil, zero value otherwise.
+func (e *Event) GetPublic() bool {
+	if e == nil || e.Public == nil {
+		return false
+	}
+	return *e.Public
+}
+
+// GetRawPayload returns the RawPayload field if it's non-nil, zero value otherwise.
+func (e *Event) GetRawPayload() json.RawMessage {
+	if e == nil || e.RawPayload == nil {
+		return json.RawMessage{}
+	}
+	return *e.RawPayload
+}
+
+// GetRepo returns the Repo field.
+func (e *Event) GetRepo() *Repository {
+	if e == nil {
+		return nil
+	}
+	return e.Repo
+}
+
+// GetType returns the Type field if it's non-nil, zero value otherwise.
+func (e *Event) GetType() string {
+	if e == nil || e.Type == nil {
+		return ""
+	}
+	return *e.Type
+}
+
+// GetHRef returns the HRef field if it's non-nil, zero value otherwise.
+func (f *FeedLink) GetHRef() string {
+	if f == nil || f.HRef == nil {
+		return ""
+	}
+	return *f.HRef
+}
+
+// GetType returns the Type field if it's non-nil, zero value otherwise.
+func (f *FeedLink) GetType() string {
+	if f == nil || f.Type == nil {
+		return ""
+	}
+	return *f.Type
+}
+
+// GetCurrentUserActorURL returns the CurrentUserActorURL field if it's non-nil, zero value otherwise.
+func (f *Feeds) GetCurrentUserActorURL() string {
+	if f == nil || f.CurrentUserActorURL == nil {
+		return ""
+	}
+	return *f.CurrentUserActorURL
+}
+
+// GetCurrentUserOrganizationURL returns the CurrentUserOrganizationURL field if it's non-nil, zero value otherwise.
+func (f *Feeds) GetCurrentUserOrganizationURL() string {
+	if f == nil || f.CurrentUserOrganizationURL == nil {
+		return ""
+	}
+	return *f.CurrentUserOrganizationURL
+}
+
+// GetCurrentUserPublicURL returns the CurrentUserPublicURL field if it's non-nil, zero value otherwise.
+func (f *Feeds) GetCurrentUserPublicURL() string {
+	if f == nil || f.CurrentUserPublicURL == nil {
+		return ""
+	}
+	return *f.CurrentUserPublicURL
+}
+
+// GetCurrentUserURL returns the CurrentUserURL field if it's non-nil, zero value otherwise.
+func (f *Feeds) GetCurrentUserURL() string {
+	if f == nil || f.CurrentUserURL == nil {
+		return ""
+	}
+	return *f.CurrentUserURL
+}
+
+// GetTimelineURL returns the TimelineURL field if it's non-nil, zero value otherwise.
+func (f *Feeds) GetTimelineURL() string {
+	if f == nil || f.TimelineURL == nil {
+		return ""
+	}
+	return *f.TimelineURL
+}
+
+// GetUserURL returns the UserURL field if it's non-nil, zero value otherwise.
+func (f *Feeds) GetUserURL() string {
+	if f == nil || f.UserURL == nil {
+		return ""
+	}
+	return *f.UserURL
+}
+
+// GetIdentifier returns the Identifier field if it's non-nil, zero value otherwise.
+func (f *FirstPatchedVersion) GetIdentifier() string {
+	if f == nil || f.Identifier == nil {
+		return ""
+	}
+	return *f.Identifier
+}
+
+// GetForkee returns the Forkee field.
+func (f *ForkEvent) GetForkee() *Repository {
+	if f == nil {
+		return nil
+	}
+	return f.Forkee
+}
+
+// GetInstallation returns the Installation field.
+func (f *ForkEvent) GetInstallation() *Installation {
+	if f == nil {
+		return nil
+	}
+	return f.Installation
+}
+
+// GetRepo returns the Repo field.
+func (f *ForkEvent) GetRepo() *Repository {
+	if f == nil {
+		return nil
+	}
+	return f.Repo
+}
+
+// GetSender returns the Sender field.
+func (f *ForkEvent) GetSender() *User {
+	if f == nil {
+		return nil
+	}
+	return f.Sender
+}
+
+// GetConfigurationFilePath returns the ConfigurationFilePath field if it's non-nil, zero value otherwise.
+func (g *GenerateNotesOptions) GetConfigurationFilePath() string {
+	if g == nil || g.ConfigurationFilePath == nil {
+		return ""
+	}
+	return *g.ConfigurationFilePath
+}
+
+// GetPreviousTagName returns the PreviousTagName field if it's non-nil, zero value otherwise.
+func (g *GenerateNotesOptions) GetPreviousTagName() string {
+	if g == nil || g.PreviousTagName == nil {
+		return ""
+	}
+	return *g.PreviousTagName
+}
+
+// GetTargetCommitish returns the TargetCommitish field if it's non-nil, zero value otherwise.
+func (g *GenerateNotesOptions) GetTargetCommitish() string {
+	if g == nil || g.TargetCommitish == nil {
+		return ""
+	}
+	return *g.TargetCommitish
+}
+
+// GetComments returns the Comments field if it's non-nil, zero value otherwise.
+func (g *Gist) GetComments() int {
+	if g == nil || g.Comments == nil {
+		return 0
+	}
+	return *g.Comments
+}
+
+// GetCreatedAt returns the CreatedAt field if it's non-nil, zero value otherwise.
+func (g *Gist) GetCreatedAt() time.Time {
+	if g == nil || g.CreatedAt == nil {
+		return time.Time{}
+	}
+	return *g.CreatedAt
+}
+
+// GetDescription returns the Description field if it's non-nil, zero value otherwise.
+func (g *Gist) GetDescription() string {
+	if g == nil || g.Description == nil {
+		return ""
+	}
+	return *g.Description
+}
+
+// GetGitPullURL returns the GitPullURL field if it's non-nil, zero value otherwise.
+func (g *Gist) GetGitPullURL() string {
+	if g == nil || g.GitPullURL == nil {
+		return ""
+	}
+	return *g.GitPullURL
+}
+
+// GetGitPushURL returns the GitPushURL field if it's non-nil, zero value otherwise.
+func (g *Gist) GetGitPushURL() string {
+	if g == nil || g.GitPushURL == nil {
+		return ""
+	}
+	return *g.GitPushURL
+}
+
+// GetHTMLURL returns the HTMLURL field if it's non-nil, zero value otherwise.
+func (g *Gist) GetHTMLURL() string {
+	if g == nil || g.HTMLURL == nil {
+		return ""
+	}
+	return *g.HTMLURL
+}
+
+// GetID returns the ID field if it's non-nil, zero value otherwise.
+func (g *Gist) GetID() string {
+	if g == nil || g.ID == nil {
+		return ""
+	}
+	return *g.ID
+}
+
+// GetNodeID returns the NodeID field if it's non-nil, zero value otherwise.
+func (g *Gist) GetNodeID() string {
+	if g == nil || g.NodeID == nil {
+		return ""
+	}
+	return *g.NodeID
+}
+
+// GetOwner returns the Owner field.
+func (g *Gist) GetOwner() *User {
+	if g == nil {
+		return nil
+	}
+	return g.Owner
+}
+
+// GetPublic returns the Public field if it's non-nil, zero value otherwise.
+func (g *Gist) GetPublic() bool {
+	if g == nil || g.Public == nil {
+		return false
+	}
+	return *g.Public
+}
+
+// GetUpdatedAt returns the UpdatedAt field if it's non-nil, zero value otherwise.
+func (g *Gist) GetUpdatedAt() time.Time {
+	if g == nil || g.UpdatedAt == nil {
+		return time.Time{}
+	}
+	return *g.UpdatedAt
+}
+
+// GetBody returns the Body field if it's non-nil, zero value otherwise.
+func (g *GistComment) GetBody() string {
+	if g == nil || g.Body == nil {
+		return ""
+	}
+	return *g.Body
+}
+
+// GetCreatedAt returns the CreatedAt field if it's non-nil, zero value otherwise.
+func (g *GistComment) GetCreatedAt() time.Time {
+	if g == nil || g.CreatedAt == nil {
+		return time.Time{}
+	}
+	return *g.CreatedAt
+}
+
+// GetID returns the ID field if it's non-nil, zero value otherwise.
+func (g *GistComment) GetID() int64 {
+	if g == nil || g.ID == nil {
+		return 0
+	}
+	return *g.ID
+}
+
+// GetURL returns the URL field if it's non-nil, zero value otherwise.
+func (g *GistComment) GetURL() string {
+	if g == nil || g.URL == nil {
+		return ""
+	}
+	return *g.URL
+}
+
+// GetUser returns the User field.
+func (g *GistComment) GetUser() *User {
+	if g == nil {
+		return nil
+	}
+	return g.User
+}
+
+// GetChangeStatus returns the ChangeStatus field.
+func (g *GistCommit) GetChangeStatus() *CommitStats {
+	if g == nil {
+		return nil
+	}
+	return g.ChangeStatus
+}
+
+// GetCommittedAt returns the CommittedAt field if it's non-nil, zero value otherwise.
+func (g *GistCommit) GetCommittedAt() Timestamp {
+	if g == nil || g.CommittedAt == nil {
+		return Timestamp{}
+	}
+	return *g.CommittedAt
+}
+
+// GetNodeID returns the NodeID field if it's non-nil, zero value otherwise.
+func (g *GistCommit) GetNodeID() string {
+	if g == nil || g.NodeID == nil {
+		return ""
+	}
+	return *g.NodeID
+}
+
+// GetURL returns the URL field if it's non-nil, zero value otherwise.
+func (g *GistCommit) GetURL() string {
+	if g == nil || g.URL == nil {
+		return ""
+	}
+	return *g.URL
+}
+
+// GetUser returns the User field.
+func (g *GistCommit) GetUser() *User {
+	if g == nil {
+		return nil
+	}
+	return g.User
+}
+
+// GetVersion returns the Version field if it's non-nil, zero value otherwise.
+func (g *GistCommit) GetVersion() string {
+	if g == nil || g.Version == nil {
+		return ""
+	}
+	return *g.Version
+}
+
+// GetContent returns the Content field if it's non-nil, zero value otherwise.
+func (g *GistFile) GetContent() string {
+	if g == nil || g.Content == nil {
+		return ""
+	}
+	return *g.Content
+}
+
+// GetFilename returns the Filename field if it's non-nil, zero value otherwise.
+func (g *GistFile) GetFilename() string {
+	if g == nil || g.Filename == nil {
+		return ""
+	}
+	return *g.Filename
+}
+
+// GetLanguage returns the Language field if it's non-nil, zero value otherwise.
+func (g *GistFile) GetLanguage() string {
+	if g == nil || g.Language == nil {
+		return ""
+	}
+	return *g.Language
+}
+
+// GetRawURL returns the RawURL field if it's non-nil, zero value otherwise.
+func (g *GistFile) GetRawURL() string {
+	if g == nil || g.RawURL == nil {
+		return ""
+	}
+	return *g.RawURL
+}
+
+// GetSize returns the Size field if it's non-nil, zero value otherwise.
+func (g *GistFile) GetSize() int {
+	if g == nil || g.Size == nil {
+		return 0
+	}
+	return *g.Size
+}
+
+// GetType returns the Type field if it's non-nil, zero value otherwise.
+func (g *GistFile) GetType() string {
+	if g == nil || g.Type == nil {
+		return ""
+	}
+	return *g.Type
+}
+
+// GetCreatedAt returns the CreatedAt field if it's non-nil, zero value otherwise.
+func (g *GistFork) GetCreatedAt() Timestamp {
+	if g == nil || g.CreatedAt == nil {
+		return Timestamp{}
+	}
+	return *g.CreatedAt
+}
+
+// GetID returns the ID field if it's non-nil, zero value otherwise.
+func (g *GistFork) GetID() string {
+	if g == nil || g.ID == nil {
+		return ""
+	}
+	return *g.ID
+}
+
+// GetNodeID returns the NodeID field if it's non-nil, zero value otherwise.
+func (g *GistFork) GetNodeID() string {
+	if g == nil || g.NodeID == nil {
+		return ""
+	}
+	return *g.NodeID
+}
+
+// GetUpdatedAt returns the UpdatedAt field if it's non-nil, zero value otherwise.
+func (g *GistFork) GetUpdatedAt() Timestamp {
+	if g == nil || g.UpdatedAt == nil {
+		return Timestamp{}
+	}
+	return *g.UpdatedAt
+}
+
+// GetURL returns the URL field if it's non-nil, zero value otherwise.
+func (g *GistFork) GetURL() string {
+	if g == nil || g.URL == nil {
+		return ""
+	}
+	return *g.URL
+}
+
+// GetUser returns the User field.
+func (g *GistFork) GetUser() *User {
+	if g == nil {
+		return nil
+	}
+	return g.User
+}
+
+// GetPrivateGists returns the PrivateGists field if it's non-nil, zero value otherwise.
+func (g *GistStats) GetPrivateGists() int {
+	if g == nil || g.PrivateGists == nil {
+		return 0
+	}
+	return *g.PrivateGists
+}
+
+// GetPublicGists returns the PublicGists field if it's non-nil, zero value otherwise.
+func (g *GistStats) GetPublicGists() int {
+	if g == nil || g.PublicGists == nil {
+		return 0
+	}
+	return *g.PublicGists
+}
+
+// GetTotalGists returns the TotalGists field if it's non-nil, zero value otherwise.
+func (g *GistStats) GetTotalGists() int {
+	if g == nil || g.TotalGists == nil {
+		return 0
+	}
+	return *g.TotalGists
+}
+
+// GetAction returns the Action field if it's non-nil, zero value otherwise.
+func (g *GitHubAppAuthorizationEvent) GetAction() string {
+	if g == nil || g.Action == nil {
+		return ""
+	}
+	return *g.Action
+}
+
+// GetSender returns the Sender field.
+func (g *GitHubAppAuthorizationEvent) GetSender() *User {
+	if g == nil {
+		return nil
+	}
+	return g.Sender
+}
+
+// GetName returns the Name field if it's non-nil, zero value otherwise.
+func (g *Gitignore) GetName() string {
+	if g == nil || g.Name == nil {
+		return ""
+	}
+	return *g.Name
+}
+
+// GetSource returns the Source field if it's non-nil, zero value otherwise.
+func (g *Gitignore) GetSource() string {
+	if g == nil || g.Source == nil {
+		return ""
+	}
+	return *g.Source
+}
+
+// GetSHA returns the SHA field if it's non-nil, zero value otherwise.
+func (g *GitObject) GetSHA() string {
+	if g == nil || g.SHA == nil {
+		return ""
+	}
+	return *g.SHA
+}
+
+// GetType returns the Type field if it's non-nil, zero value otherwise.
+func (g *GitObject) GetType() string {
+	if g == nil || g.Type == nil {
+		return ""
+	}
+	return *g.Type
+}
+
+// GetURL returns the URL field if it's non-nil, zero value otherwise.
+func (g *GitObject) GetURL() string {
+	if g == nil || g.URL == nil {
+		return ""
+	}
+	return *g.URL
+}
+
+// GetCVEID returns the CVEID field if it's non-nil, zero value otherwise.
+func (g *GlobalSecurityAdvisory) GetCVEID() string {
+	if g == nil || g.CVEID == nil {
+		return ""
+	}
+	return *g.CVEID
+}
+
+// GetCVSS returns the CVSS field.
+func (g *GlobalSecurityAdvisory) GetCVSS() *AdvisoryCVSS {
+	if g == nil {
+		return nil
+	}
+	return g.CVSS
+}
+
+// GetDescription returns the Description field if it's non-nil, zero value otherwise.
+func (g *GlobalSecurityAdvisory) GetDescription() string {
+	if g == nil || g.Description == nil {
+		return ""
+	}
+	return *g.Description
+}
+
+// GetEPSS returns the EPSS field.
+func (g *GlobalSecurityAdvisory) GetEPSS() *AdvisoryEPSS {
+	if g == nil {
+		return nil
+	}
+	return g.EPSS
+}
+
+// GetGHSAID returns the GHSAID field if it's non-nil, zero value otherwise.
+func (g *GlobalSecurityAdvisory) GetGHSAID() string {
+	if g == nil || g.GHSAID == nil {
+		return ""
+	}
+	return *g.GHSAID
+}
+
+// GetGithubReviewedAt returns the GithubReviewedAt field if it's non-nil, zero value otherwise.
+func (g *GlobalSecurityAdvisory) GetGithubReviewedAt() Timestamp {
+	if g == nil || g.GithubReviewedAt == nil {
+		return Timestamp{}
+	}
+	return *g.GithubReviewedAt
+}
+
+// GetHTMLURL returns the HTMLURL field if it's non-nil, zero value otherwise.
+func (g *GlobalSecurityAdvisory) GetHTMLURL() string {
+	if g == nil || g.HTMLURL == nil {
+		return ""
+	}
+	return *g.HTMLURL
+}
+
+// GetNVDPublishedAt returns the NVDPublishedAt field if it's non-nil, zero value otherwise.
+func (g *GlobalSecurityAdvisory) GetNVDPublishedAt() Timestamp {
+	if g == nil || g.NVDPublishedAt == nil {
+		return Timestamp{}
+	}
+	return *g.NVDPublishedAt
+}
+
+// GetPublishedAt returns the PublishedAt field if it's non-nil, zero value otherwise.
+func (g *GlobalSecurityAdvisory) GetPublishedAt() Timestamp {
+	if g == nil || g.PublishedAt == nil {
+		return Timestamp{}
+	}
+	return *g.PublishedAt
+}
+
+// GetRepositoryAdvisoryURL returns the RepositoryAdvisoryURL field if it's non-nil, zero value otherwise.
+func (g *GlobalSecurityAdvisory) GetRepositoryAdvisoryURL() string {
+	if g == nil || g.RepositoryAdvisoryURL == nil {
+		return ""
+	}
+	return *g.RepositoryAdvisoryURL
+}
+
+// GetSeverity returns the Severity field if it's non-nil, zero value otherwise.
+func (g *GlobalSecurityAdvisory) GetSeverity() string {
+	if g == nil || g.Severity == nil {
+		return ""
+	}
+	return *g.Severity
+}
+
+// GetSourceCodeLocation returns the SourceCodeLocation field if it's non-nil, zero value otherwise.
+func (g *GlobalSecurityAdvisory) GetSourceCodeLocation() string {
+	if g == nil || g.SourceCodeLocation == nil {
+		return ""
+	}
+	return *g.SourceCodeLocation
+}
+
+// GetSummary returns the Summary field if it's non-nil, zero value otherwise.
+func (g *GlobalSecurityAdvisory) GetSummary() string {
+	if g == nil || g.Summary == nil {
+		return ""
+	}
+	return *g.Summary
+}
+
+// GetType returns the Type field if it's non-nil, zero value otherwise.
+func (g *GlobalSecurityAdvisory) GetType() string {
+	if g == nil || g.Type == nil {
+		return ""
+	}
+	return *g.Type
+}
+
+// GetUpdatedAt returns the UpdatedAt field if it's non-nil, zero value otherwise.
+func (g *GlobalSecurityAdvisory) GetUpdatedAt() Timestamp {
+	if g == nil || g.UpdatedAt == nil {
+		return Timestamp{}
+	}
+	return *g.UpdatedAt
+}
+
+// GetURL returns the URL field if it's non-nil, zero value otherwise.
+func (g *GlobalSecurityAdvisory) GetURL() string {
+	if g == nil || g.URL == nil {
+		return ""
+	}
+	return *g.URL
+}
+
+// GetWithdrawnAt returns the WithdrawnAt field if it's non-nil, zero value otherwise.
+func (g *GlobalSecurityAdvisory) GetWithdrawnAt() Timestamp {
+	if g == nil || g.WithdrawnAt == nil {
+		return Timestamp{}
+	}
+	return *g.WithdrawnAt
+}
+
+// GetFirstPatchedVersion returns the FirstPatchedVersion field if it's non-nil, zero value otherwise.
+func (g *GlobalSecurityVulnerability) GetFirstPatchedVersion() string {
+	if g == nil || g.FirstPatchedVersion == nil {
+		return ""
+	}
+	return *g.FirstPatchedVersion
+}
+
+// GetPackage returns the Package field.
+func (g *GlobalSecurityVulnerability) GetPackage() *VulnerabilityPackage {
+	if g == nil {
+		return nil
+	}
+	return g.Package
+}
+
+// GetVulnerableVersionRange returns the VulnerableVersionRange field if it's non-nil, zero value otherwise.
+func (g *GlobalSecurityVulnerability) GetVulnerableVersionRange() string {
+	if g == nil || g.VulnerableVersionRange == nil {
+		return ""
+	}
+	return *g.VulnerableVersionRange
+}
+
+// GetInstallation returns the Installation field.
+func (g *GollumEvent) GetInstallation() *Installation {
+	if g == nil {
+		return nil
+	}
+	return g.Installation
+}
+
+// GetRepo returns the Repo field.
+func (g *GollumEvent) GetRepo() *Repository {
+	if g == nil {
+		return nil
+	}
+	return g.Repo
+}
+
+// GetSender returns the Sender field.
+func (g *GollumEvent) GetSender() *User {
+	if g == nil {
+		return nil
+	}
+	return g.Sender
+}
+
+// GetEmail returns the Email field if it's non-nil, zero value otherwise.
+func (g *GPGEmail) GetEmail() string {
+	if g == nil || g.Email == nil {
+		return ""
+	}
+	return *g.Email
+}
+
+// GetVerified returns the Verified field if it's non-nil, zero value otherwise.
+func (g *GPGEmail) GetVerified() bool {
+	if g == nil || g.Verified == nil {
+		return false
+	}
+	return *g.Verified
+}
+
+// GetCanCertify returns the CanCertify field if it's non-nil, zero value otherwise.
+func (g *GPGKey) GetCanCertify() bool {
+	if g == nil || g.CanCertify == nil {
+		return false
+	}
+	return *g.CanCertify
+}
+
+// GetCanEncryptComms returns the CanEncryptComms field if it's non-nil, zero value otherwise.
+func (g *GPGKey) GetCanEncryptComms() bool {
+	if g == nil || g.CanEncryptComms == nil {
+		return false
+	}
+	return *g.CanEncryptComms
+}
+
+// GetCanEncryptStorage returns the CanEncryptStorage field if it's non-nil, zero value otherwise.
+func (g *GPGKey) GetCanEncryptStorage() bool {
+	if g == nil || g.CanEncryptStorage == nil {
+		return false
+	}
+	return *g.CanEncryptStorage
+}
+
+// GetCanSign returns the CanSign field if it's non-nil, zero value otherwise.
+func (g *GPGKey) GetCanSign() bool {
+	if g == nil || g.CanSign == nil {
+		return false
+	}
+	return *g.CanSign
+}
+
+// GetCreatedAt returns the CreatedAt field if it's non-nil, zero value otherwise.
+func (g *GPGKey) GetCreatedAt() time.Time {
+	if g == nil || g.CreatedAt == nil {
+		return time.Time{}
+	}
+	return *g.CreatedAt
+}
+
+// GetExpiresAt returns the ExpiresAt field if it's non-nil, zero value otherwise.
+func (g *GPGKey) GetExpiresAt() time.Time {
+	if g == nil || g.ExpiresAt == nil {
+		return time.Time{}
+	}
+	return *g.ExpiresAt
+}
+
+// GetID returns the ID field if it's non-nil, zero value otherwise.
+func (g *GPGKey) GetID() int64 {
+	if g == nil || g.ID == nil {
+		return 0
+	}
+	return *g.ID
+}
+
+// GetKeyID returns the KeyID field if it's non-nil, zero value otherwise.
+func (g *GPGKey) GetKeyID() string {
+	if g == nil || g.KeyID == nil {
+		return ""
+	}
+	return *g.KeyID
+}
+
+// GetName returns the Name field if it's non-nil, zero value otherwise.
+func (g *GPGKey) GetName() string {
+	if g == nil || g.Name == nil {
+		return ""
+	}
+	return *g.Name
+}
+
+// GetPrimaryKeyID returns the PrimaryKeyID field if it's non-nil, zero value otherwise.
+func (g *GPGKey) GetPrimaryKeyID() int64 {
+	if g == nil || g.PrimaryKeyID == nil {
+		return 0
+	}
+	return *g.PrimaryKeyID
+}
+
+// GetPublicKey returns the PublicKey field if it's non-nil, zero value otherwise.
+func (g *GPGKey) GetPublicKey() string {
+	if g == nil || g.PublicKey == nil {
+		return ""
+	}
+	return *g.PublicKey
+}
+
+// GetRawKey returns the RawKey field if it's non-nil, zero value otherwise.
+func (g *GPGKey) GetRawKey() string {
+	if g == nil || g.RawKey == nil {
+		return ""
+	}
+	return *g.RawKey
+}
+
+// GetRevoked returns the Revoked field if it's non-nil, zero value otherwise.
+func (g *GPGKey) GetRevoked() bool {
+	if g == nil || g.Revoked == nil {
+		return false
+	}
+	return *g.Revoked
+}
+
+// GetApp returns the App field.
+func (g *Grant) GetApp() *AuthorizationApp {
+	if g == nil {
+		return nil
+	}
+	return g.App
+}
+
+// GetCreatedAt returns the CreatedAt field if it's non-nil, zero value otherwise.
+func (g *Grant) GetCreatedAt() Timestamp {
+	if g == nil || g.CreatedAt == nil {
+		return Timestamp{}
+	}
+	return *g.CreatedAt
+}
+
+// GetID returns the ID field if it's non-nil, zero value otherwise.
+func (g *Grant) GetID() int64 {
+	if g == nil || g.ID == nil {
+		return 0
+	}
+	return *g.ID
+}
+
+// GetUpdatedAt returns the UpdatedAt field if it's non-nil, zero value otherwise.
+func (g *Grant) GetUpdatedAt() Timestamp {
+	if g == nil || g.UpdatedAt == nil {
+		return Timestamp{}
+	}
+	return *g.UpdatedAt
+}
+
+// GetURL returns the URL field if it's non-nil, zero value otherwise.
+func (g *Grant) GetURL() string {
+	if g == nil || g.URL == nil {
+		return ""
+	}
+	return *g.URL
+}
+
+// GetAuthor returns the Author field.
+func (h *HeadCommit) GetAuthor() *CommitAuthor {
+	if h == nil {
+		return nil
+	}
+	return h.Author
+}
+
+// GetCommitter returns the Committer field.
+func (h *HeadCommit) GetCommitter() *CommitAuthor {
+	if h == nil {
+		return nil
+	}
+	return h.Committer
+}
+
+// GetDistinct returns the Distinct field if it's non-nil, zero value otherwise.
+func (h *HeadCommit) GetDistinct() bool {
+	if h == nil || h.Distinct == nil {
+		return false
+	}
+	return *h.Distinct
+}
+
+// GetID returns the ID field if it's non-nil, zero value otherwise.
+func (h *HeadCommit) GetID() string {
+	if h == nil || h.ID == nil {
+		return ""
+	}
+	return *h.ID
+}
+
+// GetMessage returns the Message field if it's non-nil, zero value otherwise.
+func (h *HeadCommit) GetMessage() string {
+	if h == nil || h.Message == nil {
+		return ""
+	}
+	return *h.Message
+}
+
+// GetSHA returns the SHA field if it's non-nil, zero value otherwise.
+func (h *HeadCommit) GetSHA() string {
+	if h == nil || h.SHA == nil {
+		return ""
+	}
+	return *h.SHA
+}
+
+// GetTimestamp returns the Timestamp field if it's non-nil, zero value otherwise.
+func (h *HeadCommit) GetTimestamp() Timestamp {
+	if h == nil || h.Timestamp == nil {
+		return Timestamp{}
+	}
+	return *h.Timestamp
+}
+
+// GetTreeID returns the TreeID field if it's non-nil, zero value otherwise.
+func (h *HeadCommit) GetTreeID() string {
+	if h == nil || h.TreeID == nil {
+		return ""
+	}
+	return *h.TreeID
+}
+
+// GetURL returns the URL field if it's non-nil, zero value otherwise.
+func (h *HeadCommit) GetURL() string {
+	if h == nil || h.URL == nil {
+		return ""
+	}
+	return *h.URL
+}
+
+// GetActive returns the Active field if it's non-nil, zero value otherwise.
+func (h *Hook) GetActive() bool {
+	if h == nil || h.Active == nil {
+		return false
+	}
+	return *h.Active
+}
+
+// GetConfig returns the Config field.
+func (h *Hook) GetConfig() *HookConfig {
+	if h == nil {
+		return nil
+	}
+	return h.Config
+}
+
+// GetCreatedAt returns the CreatedAt field if it's non-nil, zero value otherwise.
+func (h *Hook) GetCreatedAt() time.Time {
+	if h == nil || h.CreatedAt == nil {
+		return time.Time{}
+	}
+	return *h.CreatedAt
+}
+
+// GetID returns the ID field if it's non-nil, zero value otherwise.
+func (h *Hook) GetID() int64 {
+	if h == nil || h.ID == nil {
+		return 0
+	}
+	return *h.ID
+}
+
+// GetUpdatedAt returns the UpdatedAt field if it's non-nil, zero value otherwise.
+func (h *Hook) GetUpdatedAt() time.Time {
+	if h == nil || h.UpdatedAt == nil {
+		return time.Time{}
+	}
+	return *h.UpdatedAt
+}
+
+// GetURL returns the URL field if it's non-nil, zero value otherwise.
+func (h *Hook) GetURL() string {
+	if h == nil || h.URL == nil {
+		return ""
+	}
+	return *h.URL
+}
+
+// GetContentType returns the ContentType field if it's non-nil, zero value otherwise.
+func (h *HookConfig) GetContentType() string {
+	if h == nil || h.ContentType == nil {
+		return ""
+	}
+	return *h.ContentType
+}
+
+// GetInsecureSSL returns the InsecureSSL field if it's non-nil, zero value otherwise.
+func (h *HookConfig) GetInsecureSSL() string {
+	if h == nil || h.InsecureSSL == nil {
+		return ""
+	}
+	return *h.InsecureSSL
+}
+
+// GetSecret returns the Secret field if it's non-nil, zero value otherwise.
+func (h *HookConfig) GetSecret() string {
+	if h == nil || h.Secret == nil {
+		return ""
+	}
+	return *h.Secret
+}
+
+// GetURL returns the URL field if it's non-nil, zero value otherwise.
+func (h *HookConfig) GetURL() string {
+	if h == nil || h.URL == nil {
+		return ""
+	}
+	return *h.URL
+}
+
+// GetAction returns the Action field if it's non-nil, zero value otherwise.
+func (h *HookDelivery) GetAction() string {
+	if h == nil || h.Action == nil {
+		return ""
+	}
+	return *h.Action
+}
+
+// GetDeliveredAt returns the DeliveredAt field if it's non-nil, zero value otherwise.
+func (h *HookDelivery) GetDeliveredAt() Timestamp {
+	if h == nil || h.DeliveredAt == nil {
+		return Timestamp{}
+	}
+	return *h.DeliveredAt
+}
+
+// GetDuration returns the Duration field.
+func (h *HookDelivery) GetDuration() *float64 {
+	if h == nil {
+		return nil
+	}
+	return h.Duration
+}
+
+// GetEvent returns the Event field if it's non-nil, zero value otherwise.
+func (h *HookDelivery) GetEvent() string {
+	if h == nil || h.Event == nil {
+		return ""
+	}
+	return *h.Event
+}
+
+// GetGUID returns the GUID field if it's non-nil, zero value otherwise.
+func (h *HookDelivery) GetGUID() string {
+	if h == nil || h.GUID == nil {
+		return ""
+	}
+	return *h.GUID
+}
+
+// GetID returns the ID field if it's non-nil, zero value otherwise.
+func (h *HookDelivery) GetID() int64 {
+	if h == nil || h.ID == nil {
+		return 0
+	}
+	return *h.ID
+}
+
+// GetInstallationID returns the InstallationID field if it's non-nil, zero value otherwise.
+func (h *HookDelivery) GetInstallationID() int64 {
+	if h == nil || h.InstallationID == nil {
+		return 0
+	}
+	return *h.InstallationID
+}
+
+// GetRedelivery returns the Redelivery field if it's non-nil, zero value otherwise.
+func (h *HookDelivery) GetRedelivery() bool {
+	if h == nil || h.Redelivery == nil {
+		return false
+	}
+	return *h.Redelivery
+}
+
+// GetRepositoryID returns the RepositoryID field if it's non-nil, zero value otherwise.
+func (h *HookDelivery) GetRepositoryID() int64 {
+	if h == nil || h.RepositoryID == nil {
+		return 0
+	}
+	return *h.RepositoryID
+}
+
+// GetRequest returns the Request field.
+func (h *HookDelivery) GetRequest() *HookRequest {
+	if h == nil {
+		return nil
+	}
+	return h.Request
+}
+
+// GetResponse returns the Response field.
+func (h *HookDelivery) GetResponse() *HookResponse {
+	if h == nil {
+		return nil
+	}
+	return h.Response
+}
+
+// GetStatus returns the Status field if it's non-nil, zero value otherwise.
+func (h *HookDelivery) GetStatus() string {
+	if h == nil || h.Status == nil {
+		return ""
+	}
+	return *h.Status
+}
+
+// GetStatusCode returns the StatusCode field if it's non-nil, zero value otherwise.
+func (h *HookDelivery) GetStatusCode() int {
+	if h == nil || h.StatusCode == nil {
+		return 0
+	}
+	return *h.StatusCode
+}
+
+// GetRawPayload returns the RawPayload field if it's non-nil, zero value otherwise.
+func (h *HookRequest) GetRawPayload() json.RawMessage {
+	if h == nil || h.RawPayload == nil {
+		return json.RawMessage{}
+	}
+	return *h.RawPayload
+}
+
+// GetPayload returns the Payload field if it's non-nil, zero value otherwise.
+func (h *HookResponse) GetPayload() string {
+	if h == nil || h.Payload == nil {
+		return ""
+	}
+	return *h.Payload
+}
+
+// GetActiveHooks returns the ActiveHooks field if it's non-nil, zero value otherwise.
+func (h *HookStats) GetActiveHooks() int {
+	if h == nil || h.ActiveHooks == nil {
+		return 0
+	}
+	return *h.ActiveHooks
+}
+
+// GetInactiveHooks returns the InactiveHooks field if it's non-nil, zero value otherwise.
+func (h *HookStats) GetInactiveHooks() int {
+	if h == nil || h.InactiveHooks == nil {
+		return 0
+	}
+	return *h.InactiveHooks
+}
+
+// GetTotalHooks returns the TotalHooks field if it's non-nil, zero value otherwise.
+func (h *HookStats) GetTotalHooks() int {
+	if h == nil || h.TotalHooks == nil {
+		return 0
+	}
+	return *h.TotalHooks
+}
+
+// GetGroupDescription returns the GroupDescription field if it's non-nil, zero value otherwise.
+func (i *IDPGroup) GetGroupDescription() string {
+	if i == nil || i.GroupDescription == nil {
+		return ""
+	}
+	return *i.GroupDescription
+}
+
+// GetGroupID returns the GroupID field if it's non-nil, zero value otherwise.
+func (i *IDPGroup) GetGroupID() string {
+	if i == nil || i.GroupID == nil {
+		return ""
+	}
+	return *i.GroupID
+}
+
+// GetGroupName returns the GroupName field if it's non-nil, zero value otherwise.
+func (i *IDPGroup) GetGroupName() string {
+	if i == nil || i.GroupName == nil {
+		return ""
+	}
+	return *i.GroupName
+}
+
+// GetAuthorsCount returns the AuthorsCount field if it's non-nil, zero value otherwise.
+func (i *Import) GetAuthorsCount() int {
+	if i == nil || i.AuthorsCount == nil {
+		return 0
+	}
+	return *i.AuthorsCount
+}
+
+// GetAuthorsURL returns the AuthorsURL field if it's non-nil, zero value otherwise.
+func (i *Import) GetAuthorsURL() string {
+	if i == nil || i.AuthorsURL == nil {
+		return ""
+	}
+	return *i.AuthorsURL
+}
+
+// GetCommitCount returns the CommitCount field if it's non-nil, zero value otherwise.
+func (i *Import) GetCommitCount() int {
+	if i == nil || i.CommitCount == nil {
+		return 0
+	}
+	return *i.CommitCount
+}
+
+// GetFailedStep returns the FailedStep field if it's non-nil, zero value otherwise.
+func (i *Import) GetFailedStep() string {
+	if i == nil || i.FailedStep == nil {
+		return ""
+	}
+	return *i.FailedStep
+}
+
+// GetHasLargeFiles returns the HasLargeFiles field if it's non-nil, zero value otherwise.
+func (i *Import) GetHasLargeFiles() bool {
+	if i == nil || i.HasLargeFiles == nil {
+		return false
+	}
+	return *i.HasLargeFiles
+}
+
+// GetHTMLURL returns the HTMLURL field if it's non-nil, zero value otherwise.
+func (i *Import) GetHTMLURL() string {
+	if i == nil || i.HTMLURL == nil {
+		return ""
+	}
+	return *i.HTMLURL
+}
+
+// GetHumanName returns the HumanName field if it's non-nil, zero value otherwise.
+func (i *Import) GetHumanName() string {
+	if i == nil || i.HumanName == nil {
+		return ""
+	}
+	return *i.HumanName
+}
+
+// GetLargeFilesCount returns the LargeFilesCount field if it's non-nil, zero value otherwise.
+func (i *Import) GetLargeFilesCount() int {
+	if i == nil || i.LargeFilesCount == nil {
+		return 0
+	}
+	return *i.LargeFilesCount
+}
+
+// GetLargeFilesSize returns the LargeFilesSize field if it's non-nil, zero value otherwise.
+func (i *Import) GetLargeFilesSize() int {
+	if i == nil || i.LargeFilesSize == nil {
+		return 0
+	}
+	return *i.LargeFilesSize
+}
+
+// GetMessage returns the Message field if it's non-nil, zero value otherwise.
+func (i *Import) GetMessage() string {
+	if i == nil || i.Message == nil {
+		return ""
+	}
+	return *i.Message
+}
+
+// GetPercent returns the Percent field if it's non-nil, zero value otherwise.
+func (i *Import) GetPercent() int {
+	if i == nil || i.Percent == nil {
+		return 0
+	}
+	return *i.Percent
+}
+
+// GetPushPercent returns the PushPercent field if it's non-nil, zero value otherwise.
+func (i *Import) GetPushPercent() int {
+	if i == nil || i.PushPercent == nil {
+		return 0
+	}
+	return *i.PushPercent
+}
+
+// GetRepositoryURL returns the RepositoryURL field if it's non-nil, zero value otherwise.
+func (i *Import) GetRepositoryURL() string {
+	if i == nil || i.RepositoryURL == nil {
+		return ""
+	}
+	return *i.RepositoryURL
+}
+
+// GetStatus returns the Status field if it's non-nil, zero value otherwise.
+func (i *Import) GetStatus() string {
+	if i == nil || i.Status == nil {
+		return ""
+	}
+	return *i.Status
+}
+
+// GetStatusText returns the StatusText field if it's non-nil, zero value otherwise.
+func (i *Import) GetStatusText() string {
+	if i == nil || i.StatusText == nil {
+		return ""
+	}
+	return *i.StatusText
+}
+
+// GetTFVCProject returns the TFVCProject field if it's non-nil, zero value otherwise.
+func (i *Import) GetTFVCProject() string {
+	if i == nil || i.TFVCProject == nil {
+		return ""
+	}
+	return *i.TFVCProject
+}
+
+// GetURL returns the URL field if it's non-nil, zero value otherwise.
+func (i *Import) GetURL() string {
+	if i == nil || i.URL == nil {
+		return ""
+	}
+	return *i.URL
+}
+
+// GetUseLFS returns the UseLFS field if it's non-nil, zero value otherwise.
+func (i *Import) GetUseLFS() string {
+	if i == nil || i.UseLFS == nil {
+		return ""
+	}
+	return *i.UseLFS
+}
+
+// GetVCS returns the VCS field if it's non-nil, zero value otherwise.
+func (i *Import) GetVCS() string {
+	if i == nil || i.VCS == nil {
+		return ""
+	}
+	return *i.VCS
+}
+
+// GetVCSPassword returns the VCSPassword field if it's non-nil, zero value otherwise.
+func (i *Import) GetVCSPassword() string {
+	if i == nil || i.VCSPassword == nil {
+		return ""
+	}
+	return *i.VCSPassword
+}
+
+// GetVCSURL returns the VCSURL field if it's non-nil, zero value otherwise.
+func (i *Import) GetVCSURL() string {
+	if i == nil || i.VCSURL == nil {
+		return ""
+	}
+	return *i.VCSURL
+}
+
+// GetVCSUsername returns the VCSUsername field if it's non-nil, zero value otherwise.
+func (i *Import) GetVCSUsername() string {
+	if i == nil || i.VCSUsername == nil {
+		return ""
+	}
+	return *i.VCSUsername
+}
+
+// GetAccessTokensURL returns the AccessTokensURL field if it's non-nil, zero value otherwise.
+func (i *Installation) GetAccessTokensURL() string {
+	if i == nil || i.AccessTokensURL == nil {
+		return ""
+	}
+	return *i.AccessTokensURL
+}
+
+// GetAccount returns the Account field.
+func (i *Installation) GetAccount() *User {
+	if i == nil {
+		return nil
+	}
+	return i.Account
+}
+
+// GetAppID returns the AppID field if it's non-nil, zero value otherwise.
+func (i *Installation) GetAppID() int64 {
+	if i == nil || i.AppID == nil {
+		return 0
+	}
+	return *i.AppID
+}
+
+// GetCreatedAt returns the CreatedAt field if it's non-nil, zero value otherwise.
+func (i *Installation) GetCreatedAt() Timestamp {
+	if i == nil || i.CreatedAt == nil {
+		return Timestamp{}
+	}
+	return *i.CreatedAt
+}
+
+// GetHTMLURL returns the HTMLURL field if it's non-nil, zero value otherwise.
+func (i *Installation) GetHTMLURL() string {
+	if i == nil || i.HTMLURL == nil {
+		return ""
+	}
+	return *i.HTMLURL
+}
+
+// GetID returns the ID field if it's non-nil, zero value otherwise.
+func (i *Installation) GetID() int64 {
+	if i == nil || i.ID == nil {
+		return 0
+	}
+	return *i.ID
+}
+
+// GetNodeID returns the NodeID field if it's non-nil, zero value otherwise.
+func (i *Installation) GetNodeID() string {
+	if i == nil || i.NodeID == nil {
+		return ""
+	}
+	return *i.NodeID
+}
+
+// GetPermissions returns the Permissions field.
+func (i *Installation) GetPermissions() *InstallationPermissions {
+	if i == nil {
+		return nil
+	}
+	return i.Permissions
+}
+
+// GetRepositoriesURL returns the RepositoriesURL field if it's non-nil, zero value otherwise.
+func (i *Installation) GetRepositoriesURL() string {
+	if i == nil || i.RepositoriesURL == nil {
+		return ""
+	}
+	return *i.RepositoriesURL
+}
+
+// GetRepositorySelection returns the RepositorySelection field if it's non-nil, zero value otherwise.
+func (i *Installation) GetRepositorySelection() string {
+	if i == nil || i.RepositorySelection == nil {
+		return ""
+	}
+	return *i.RepositorySelection
+}
+
+// GetSingleFileName returns the SingleFileName field if it's non-nil, zero value otherwise.
+func (i *Installation) GetSingleFileName() string {
+	if i == nil || i.SingleFileName == nil {
+		return ""
+	}
+	return *i.SingleFileName
+}
+
+// GetTargetID returns the TargetID field if it's non-nil, zero value otherwise.
+func (i *Installation) GetTargetID() int64 {
+	if i == nil || i.TargetID == nil {
+		return 0
+	}
+	return *i.TargetID
+}
+
+// GetTargetType returns the TargetType field if it's non-nil, zero value otherwise.
+func (i *Installation) GetTargetType() string {
+	if i == nil || i.TargetType == nil {
+		return ""
+	}
+	return *i.TargetType
+}
+
+// GetUpdatedAt returns the UpdatedAt field if it's non-nil, zero value otherwise.
+func (i *Installation) GetUpdatedAt() Timestamp {
+	if i == nil || i.UpdatedAt == nil {
+		return Timestamp{}
+	}
+	return *i.UpdatedAt
+}
+
+// GetAction returns the Action field if it's non-nil, zero value otherwise.
+func (i *InstallationEvent) GetAction() string {
+	if i == nil || i.Action == nil {
+		return ""
+	}
+	return *i.Action
+}
+
+// GetInstallation returns the Installation field.
+func (i *InstallationEvent) GetInstallation() *Installation {
+	if i == nil {
+		return nil
+	}
+	return i.Installation
+}
+
+// GetSender returns the Sender field.
+func (i *InstallationEvent) GetSender() *User {
+	if i == nil {
+		return nil
+	}
+	return i.Sender
+}
+
+// GetActions returns the Actions field if it's non-nil, zero value otherwise.
+func (i *InstallationPermissions) GetActions() string {
+	if i == nil || i.Actions == nil {
+		return ""
+	}
+	return *i.Actions
+}
+
+// GetAdministration returns the Administration field if it's non-nil, zero value otherwise.
+func (i *InstallationPermissions) GetAdministration() string {
+	if i == nil || i.Administration == nil {
+		return ""
+	}
+	return *i.Administration
+}
+
+// GetBlocking returns the Blocking field if it's non-nil, zero value otherwise.
+func (i *InstallationPermissions) GetBlocking() string {
+	if i == nil || i.Blocking == nil {
+		return ""
+	}
+	return *i.Blocking
+}
+
+// GetChecks returns the Checks field if it's non-nil, zero value otherwise.
+func (i *InstallationPermissions) GetChecks() string {
+	if i == nil || i.Checks == nil {
+		return ""
+	}
+	return *i.Checks
+}
+
+// GetCodespaces returns the Codespaces field if it's non-nil, zero value otherwise.
+func (i *InstallationPermissions) GetCodespaces() string {
+	if i == nil || i.Codespaces == nil {
+		return ""
+	}
+	return *i.Codespaces
+}
+
+// GetContentReferences returns the ContentReferences field if it's non-nil, zero value otherwise.
+func (i *InstallationPermissions) GetContentReferences() string {
+	if i == nil || i.ContentReferences == nil {
+		return ""
+	}
+	return *i.ContentReferences
+}
+
+// GetContents returns the Contents field if it's non-nil, zero value otherwise.
+func (i *InstallationPermissions) GetContents() string {
+	if i == nil || i.Contents == nil {
+		return ""
+	}
+	return *i.Contents
+}
+
+// GetDependabotSecrets returns the DependabotSecrets field if it's non-nil, zero value otherwise.
+func (i *InstallationPermissions) GetDependabotSecrets() string {
+	if i == nil || i.DependabotSecrets == nil {
+		return ""
+	}
+	return *i.DependabotSecrets
+}
+
+// GetDeployments returns the Deployments field if it's non-nil, zero value otherwise.
+func (i *InstallationPermissions) GetDeployments() string {
+	if i == nil || i.Deployments == nil {
+		return ""
+	}
+	return *i.Deployments
+}
+
+// GetEmailAddresses returns the EmailAddresses field if it's non-nil, zero value otherwise.
+func (i *InstallationPermissions) GetEmailAddresses() string {
+	if i == nil || i.EmailAddresses == nil {
+		return ""
+	}
+	return *i.EmailAddresses
+}
+
+// GetEmails returns the Emails field if it's non-nil, zero value otherwise.
+func (i *InstallationPermissions) GetEmails() string {
+	if i == nil || i.Emails == nil {
+		return ""
+	}
+	return *i.Emails
+}
+
+// GetEnvironments returns the Environments field if it's non-nil, zero value otherwise.
+func (i *InstallationPermissions) GetEnvironments() string {
+	if i == nil || i.Environments == nil {
+		return ""
+	}
+	return *i.Environments
+}
+
+// GetFollowers returns the Followers field if it's non-nil, zero value otherwise.
+func (i *InstallationPermissions) GetFollowers() string {
+	if i == nil || i.Followers == nil {
+		return ""
+	}
+	return *i.Followers
+}
+
+// GetGitSSHKeys returns the GitSSHKeys field if it's non-nil, zero value otherwise.
+func (i *InstallationPermissions) GetGitSSHKeys() string {
+	if i == nil || i.GitSSHKeys == nil {
+		return ""
+	}
+	return *i.GitSSHKeys
+}
+
+// GetGPGKeys returns the GPGKeys field if it's non-nil, zero value otherwise.
+func (i *InstallationPermissions) GetGPGKeys() string {
+	if i == nil || i.GPGKeys == nil {
+		return ""
+	}
+	return *i.GPGKeys
+}
+
+// GetInteractionLimits returns the InteractionLimits field if it's non-nil, zero value otherwise.
+func (i *InstallationPermissions) GetInteractionLimits() string {
+	if i == nil || i.InteractionLimits == nil {
+		return ""
+	}
+	return *i.InteractionLimits
+}
+
+// GetIssues returns the Issues field if it's non-nil, zero value otherwise.
+func (i *InstallationPermissions) GetIssues() string {
+	if i == nil || i.Issues == nil {
+		return ""
+	}
+	return *i.Issues
+}
+
+// GetMembers returns the Members field if it's non-nil, zero value otherwise.
+func (i *InstallationPermissions) GetMembers() string {
+	if i == nil || i.Members == nil {
+		return ""
+	}
+	return *i.Members
+}
+
+// GetMergeQueues returns the MergeQueues field if it's non-nil, zero value otherwise.
+func (i *InstallationPermissions) GetMergeQueues() string {
+	if i == nil || i.MergeQueues == nil {
+		return ""
+	}
+	return *i.MergeQueues
+}
+
+// GetMetadata returns the Metadata field if it's non-nil, zero value otherwise.
+func (i *InstallationPermissions) GetMetadata() string {
+	if i == nil || i.Metadata == nil {
+		return ""
+	}
+	return *i.Metadata
+}
+
+// GetOrganizationAdministration returns the OrganizationAdministration field if it's non-nil, zero value otherwise.
+func (i *InstallationPermissions) GetOrganizationAdministration() string {
+	if i == nil || i.OrganizationAdministration == nil {
+		return ""
+	}
+	return *i.OrganizationAdministration
+}
+
+// GetOrganizationAnnouncementBanners returns the OrganizationAnnouncementBanners field if it's non-nil, zero value otherwise.
+func (i *InstallationPermissions) GetOrganizationAnnouncementBanners() string {
+	if i == nil || i.OrganizationAnnouncementBanners == nil {
+		return ""
+	}
+	return *i.OrganizationAnnouncementBanners
+}
+
+// GetOrganizationCustomProperties returns the OrganizationCustomProperties field if it's non-nil, zero value otherwise.
+func (i *InstallationPermissions) GetOrganizationCustomProperties() string {
+	if i == nil || i.OrganizationCustomProperties == nil {
+		return ""
+	}
+	return *i.OrganizationCustomProperties
+}
+
+// GetOrganizationCustomRoles returns the OrganizationCustomRoles field if it's non-nil, zero value otherwise.
+func (i *InstallationPermissions) GetOrganizationCustomRoles() string {
+	if i == nil || i.OrganizationCustomRoles == nil {
+		return ""
+	}
+	return *i.OrganizationCustomRoles
+}
+
+// GetOrganizationHooks returns the OrganizationHooks field if it's non-nil, zero value otherwise.
+func (i *InstallationPermissions) GetOrganizationHooks() string {
+	if i == nil || i.OrganizationHooks == nil {
+		return ""
+	}
+	return *i.OrganizationHooks
+}
+
+// GetOrganizationPackages returns the OrganizationPackages field if it's non-nil, zero value otherwise.
+func (i *InstallationPermissions) GetOrganizationPackages() string {
+	if i == nil || i.OrganizationPackages == nil {
+		return ""
+	}
+	return *i.OrganizationPackages
+}
+
+// GetOrganizationPersonalAccessTokenRequests returns the OrganizationPersonalAccessTokenRequests field if it's non-nil, zero value otherwise.
+func (i *InstallationPermissions) GetOrganizationPersonalAccessTokenRequests() string {
+	if i == nil || i.OrganizationPersonalAccessTokenRequests == nil {
+		return ""
+	}
+	return *i.OrganizationPersonalAccessTokenRequests
+}
+
+// GetOrganizationPersonalAccessTokens returns the OrganizationPersonalAccessTokens field if it's non-nil, zero value otherwise.
+func (i *InstallationPermissions) GetOrganizationPersonalAccessTokens() string {
+	if i == nil || i.OrganizationPersonalAccessTokens == nil {
+		return ""
+	}
+	return *i.OrganizationPersonalAccessTokens
+}
+
+// GetOrganizationPlan returns the OrganizationPlan field if it's non-nil, zero value otherwise.
+func (i *InstallationPermissions) GetOrganizationPlan() string {
+	if i == nil || i.OrganizationPlan == nil {
+		return ""
+	}
+	return *i.OrganizationPlan
+}
+
+// GetOrganizationPreReceiveHooks returns the OrganizationPreReceiveHooks field if it's non-nil, zero value otherwise.
+func (i *InstallationPermissions) GetOrganizationPreReceiveHooks() string {
+	if i == nil || i.OrganizationPreReceiveHooks == nil {
+		return ""
+	}
+	return *i.OrganizationPreReceiveHooks
+}
+
+// GetOrganizationProjects returns the OrganizationProjects field if it's non-nil, zero value otherwise.
+func (i *InstallationPermissions) GetOrganizationProjects() string {
+	if i == nil || i.OrganizationProjects == nil {
+		return ""
+	}
+	return *i.OrganizationProjects
+}
+
+// GetOrganizationSecrets returns the OrganizationSecrets field if it's non-nil, zero value otherwise.
+func (i *InstallationPermissions) GetOrganizationSecrets() string {
+	if i == nil || i.OrganizationSecrets == nil {
+		return ""
+	}
+	return *i.OrganizationSecrets
+}
+
+// GetOrganizationSelfHostedRunners returns the OrganizationSelfHostedRunners field if it's non-nil, zero value otherwise.
+func (i *InstallationPermissions) GetOrganizationSelfHostedRunners() string {
+	if i == nil || i.OrganizationSelfHostedRunners == nil {
+		return ""
+	}
+	return *i.OrganizationSelfHostedRunners
+}
+
+// GetOrganizationUserBlocking returns the OrganizationUserBlocking field if it's non-nil, zero value otherwise.
+func (i *InstallationPermissions) GetOrganizationUserBlocking() string {
+	if i == nil || i.OrganizationUserBlocking == nil {
+		return ""
+	}
+	return *i.OrganizationUserBlocking
+}
+
+// GetPackages returns the Packages field if it's non-nil, zero value otherwise.
+func (i *InstallationPermissions) GetPackages() string {
+	if i == nil || i.Packages == nil {
+		return ""
+	}
+	return *i.Packages
+}
+
+// GetPages returns the Pages field if it's non-nil, zero value otherwise.
+func (i *InstallationPermissions) GetPages() string {
+	if i == nil || i.Pages == nil {
+		return ""
+	}
+	return *i.Pages
+}
+
+// GetProfile returns the Profile field if it's non-nil, zero value otherwise.
+func (i *InstallationPermissions) GetProfile() string {
+	if i == nil || i.Profile == nil {
+		return ""
+	}
+	return *i.Profile
+}
+
+// GetPullRequests returns the PullRequests field if it's non-nil, zero value otherwise.
+func (i *InstallationPermissions) GetPullRequests() string {
+	if i == nil || i.PullRequests == nil {
+		return ""
+	}
+	return *i.PullRequests
+}
+
+// GetRepositoryAdvisories returns the RepositoryAdvisories field if it's non-nil, zero value otherwise.
+func (i *InstallationPermissions) GetRepositoryAdvisories() string {
+	if i == nil || i.RepositoryAdvisories == nil {
+		return ""
+	}
+	return *i.RepositoryAdvisories
+}
+
+// GetRepositoryCustomProperties returns the RepositoryCustomProperties field if it's non-nil, zero value otherwise.
+func (i *InstallationPermissions) GetRepositoryCustomProperties() string {
+	if i == nil || i.RepositoryCustomProperties == nil {
+		return ""
+	}
+	return *i.RepositoryCustomProperties
+}
+
+// GetRepositoryHooks returns the RepositoryHooks field if it's non-nil, zero value otherwise.
+func (i *InstallationPermissions) GetRepositoryHooks() string {
+	if i == nil || i.RepositoryHooks == nil {
+		return ""
+	}
+	return *i.RepositoryHooks
+}
+
+// GetRepositoryPreReceiveHooks returns the RepositoryPreReceiveHooks field if it's non-nil, zero value otherwise.
+func (i *InstallationPermissions) GetRepositoryPreReceiveHooks() string {
+	if i == nil || i.RepositoryPreReceiveHooks == nil {
+		return ""
+	}
+	return *i.RepositoryPreReceiveHooks
+}
+
+// GetRepositoryProjects returns the RepositoryProjects field if it's non-nil, zero value otherwise.
+func (i *InstallationPermissions) GetRepositoryProjects() string {
+	if i == nil || i.RepositoryProjects == nil {
+		return ""
+	}
+	return *i.RepositoryProjects
+}
+
+// GetSecrets returns the Secrets field if it's non-nil, zero value otherwise.
+func (i *InstallationPermissions) GetSecrets() string {
+	if i == nil || i.Secrets == nil {
+		return ""
+	}
+	return *i.Secrets
+}
+
+// GetSecretScanningAlerts returns the SecretScanningAlerts field if it's non-nil, zero value otherwise.
+func (i *InstallationPermissions) GetSecretScanningAlerts() string {
+	if i == nil || i.SecretScanningAlerts == nil {
+		return ""
+	}
+	return *i.SecretScanningAlerts
+}
+
+// GetSecurityEvents returns the SecurityEvents field if it's non-nil, zero value otherwise.
+func (i *InstallationPermissions) GetSecurityEvents() string {
+	if i == nil || i.SecurityEvents == nil {
+		return ""
+	}
+	return *i.SecurityEvents
+}
+
+// GetSingleFile returns the SingleFile field if it's non-nil, zero value otherwise.
+func (i *InstallationPermissions) GetSingleFile() string {
+	if i == nil || i.SingleFile == nil {
+		return ""
+	}
+	return *i.SingleFile
+}
+
+// GetStarring returns the Starring field if it's non-nil, zero value otherwise.
+func (i *InstallationPermissions) GetStarring() string {
+	if i == nil || i.Starring == nil {
+		return ""
+	}
+	return *i.Starring
+}
+
+// GetStatuses returns the Statuses field if it's non-nil, zero value otherwise.
+func (i *InstallationPermissions) GetStatuses() string {
+	if i == nil || i.Statuses == nil {
+		return ""
+	}
+	return *i.Statuses
+}
+
+// GetTeamDiscussions returns the TeamDiscussions field if it's non-nil, zero value otherwise.
+func (i *InstallationPermissions) GetTeamDiscussions() string {
+	if i == nil || i.TeamDiscussions == nil {
+		return ""
+	}
+	return *i.TeamDiscussions
+}
+
+// GetVulnerabilityAlerts returns the VulnerabilityAlerts field if it's non-nil, zero value otherwise.
+func (i *InstallationPermissions) GetVulnerabilityAlerts() string {
+	if i == nil || i.VulnerabilityAlerts == nil {
+		return ""
+	}
+	return *i.VulnerabilityAlerts
+}
+
+// GetWorkflows returns the Workflows field if it's non-nil, zero value otherwise.
+func (i *InstallationPermissions) GetWorkflows() string {
+	if i == nil || i.Workflows == nil {
+		return ""
+	}
+	return *i.Workflows
+}
+
+// GetAction returns the Action field if it's non-nil, zero value otherwise.
+func (i *InstallationRepositoriesEvent) GetAction() string {
+	if i == nil || i.Action == nil {
+		return ""
+	}
+	return *i.Action
+}
+
+// GetInstallation returns the Installation field.
+func (i *InstallationRepositoriesEvent) GetInstallation() *Installation {
+	if i == nil {
+		return nil
+	}
+	return i.Installation
+}
+
+// GetRepositorySelection returns the RepositorySelection field if it's non-nil, zero value otherwise.
+func (i *InstallationRepositoriesEvent) GetRepositorySelection() string {
+	if i == nil || i.RepositorySelection == nil {
+		return ""
+	}
+	return *i.RepositorySelection
+}
+
+// GetSender returns the Sender field.
+func (i *InstallationRepositoriesEvent) GetSender() *User {
+	if i == nil {
+		return nil
+	}
+	return i.Sender
+}
+
+// GetExpiresAt returns the ExpiresAt field if it's non-nil, zero value otherwise.
+func (i *InstallationToken) GetExpiresAt() time.Time {
+	if i == nil || i.ExpiresAt == nil {
+		return time.Time{}
+	}
+	return *i.ExpiresAt
+}
+
+// GetPermissions returns the Permissions field.
+func (i *InstallationToken) GetPermissions() *InstallationPermissions {
+	if i == nil {
+		return nil
+	}
+	return i.Permissions
+}
+
+// GetToken returns the Token field if it's non-nil, zero value otherwise.
+func (i *InstallationToken) GetToken() string {
+	if i == nil || i.Token == nil {
+		return ""
+	}
+	return *i.Token
+}
+
+// GetPermissions returns the Permissions field.
+func (i *InstallationTokenOptions) GetPermissions() *InstallationPermissions {
+	if i == nil {
+		return nil
+	}
+	return i.Permissions
+}
+
+// GetExpiresAt returns the ExpiresAt field if it's non-nil, zero value otherwise.
+func (i *InteractionRestriction) GetExpiresAt() Timestamp {
+	if i == nil || i.ExpiresAt == nil {
+		return Timestamp{}
+	}
+	return *i.ExpiresAt
+}
+
+// GetLimit returns the Limit field if it's non-nil, zero value otherwise.
+func (i *InteractionRestriction) GetLimit() string {
+	if i == nil || i.Limit == nil {
+		return ""
+	}
+	return *i.Limit
+}
+
+// GetOrigin returns the Origin field if it's non-nil, zero value otherwise.
+func (i *InteractionRestriction) GetOrigin() string {
+	if i == nil || i.Origin == nil {
+		return ""
+	}
+	return *i.Origin
+}
+
+// GetPredicateType returns the PredicateType field if it's non-nil, zero value otherwise.
+func (i *InTotoStatement) GetPredicateType() string {
+	if i == nil || i.PredicateType == nil {
+		return ""
+	}
+	return *i.PredicateType
+}
+
+// GetType returns the Type field if it's non-nil, zero value otherwise.
+func (i *InTotoStatement) GetType() string {
+	if i == nil || i.Type == nil {
+		return ""
+	}
+	return *i.Type
+}
+
+// GetName returns the Name field if it's non-nil, zero value otherwise.
+func (i *InTotoSubject) GetName() string {
+	if i == nil || i.Name == nil {
+		return ""
+	}
+	return *i.Name
+}
+
+// GetCreatedAt returns the CreatedAt field if it's non-nil, zero value otherwise.
+func (i *Invitation) GetCreatedAt() time.Time {
+	if i == nil || i.CreatedAt == nil {
+		return time.Time{}
+	}
+	return *i.CreatedAt
+}
+
+// GetEmail returns the Email field if it's non-nil, zero value otherwise.
+func (i *Invitation) GetEmail() string {
+	if i == nil || i.Email == nil {
+		return ""
+	}
+	return *i.Email
+}
+
+// GetFailedAt returns the FailedAt field if it's non-nil, zero value otherwise.
+func (i *Invitation) GetFailedAt() Timestamp {
+	if i == nil || i.FailedAt == nil {
+		return Timestamp{}
+	}
+	return *i.FailedAt
+}
+
+// GetFailedReason returns the FailedReason field if it's non-nil, zero value otherwise.
+func (i *Invitation) GetFailedReason() string {
+	if i == nil || i.FailedReason == nil {
+		return ""
+	}
+	return *i.FailedReason
+}
+
+// GetID returns the ID field if it's non-nil, zero value otherwise.
+func (i *Invitation) GetID() int64 {
+	if i == nil || i.ID == nil {
+		return 0
+	}
+	return *i.ID
+}
+
+// GetInvitationTeamURL returns the InvitationTeamURL field if it's non-nil, zero value otherwise.
+func (i *Invitation) GetInvitationTeamURL() string {
+	if i == nil || i.InvitationTeamURL == nil {
+		return ""
+	}
+	return *i.InvitationTeamURL
+}
+
+// GetInviter returns the Inviter field.
+func (i *Invitation) GetInviter() *User {
+	if i == nil {
+		return nil
+	}
+	return i.Inviter
+}
+
+// GetLogin returns the Login field if it's non-nil, zero value otherwise.
+func (i *Invitation) GetLogin() string {
+	if i == nil || i.Login == nil {
+		return ""
+	}
+	return *i.Login
+}
+
+// GetNodeID returns the NodeID field if it's non-nil, zero value otherwise.
+func (i *Invitation) GetNodeID() string {
+	if i == nil || i.NodeID == nil {
+		return ""
+	}
+	return *i.NodeID
+}
+
+// GetRole returns the Role field if it's non-nil, zero value otherwise.
+func (i *Invitation) GetRole() string {
+	if i == nil || i.Role == nil {
+		return ""
+	}
+	return *i.Role
+}
+
+// GetTeamCount returns the TeamCount field if it's non-nil, zero value otherwise.
+func (i *Invitation) GetTeamCount() int {
+	if i == nil || i.TeamCount == nil {
+		return 0
+	}
+	return *i.TeamCount
+}
+
+// GetActiveLockReason returns the ActiveLockReason field if it's non-nil, zero value otherwise.
+func (i *Issue) GetActiveLockReason() string {
+	if i == nil || i.ActiveLockReason == nil {
+		return ""
+	}
+	return *i.ActiveLockReason
+}
+
+// GetAssignee returns the Assignee field.
+func (i *Issue) GetAssignee() *User {
+	if i == nil {
+		return nil
+	}
+	return i.Assignee
+}
+
+// GetAuthorAssociation returns the AuthorAssociation field if it's non-nil, zero value otherwise.
+func (i *Issue) GetAuthorAssociation() string {
+	if i == nil || i.AuthorAssociation == nil {
+		return ""
+	}
+	return *i.AuthorAssociation
+}
+
+// GetBody returns the Body field if it's non-nil, zero value otherwise.
+func (i *Issue) GetBody() string {
+	if i == nil || i.Body == nil {
+		return ""
+	}
+	return *i.Body
+}
+
+// GetClosedAt returns the ClosedAt field if it's non-nil, zero value otherwise.
+func (i *Issue) GetClosedAt() time.Time {
+	if i == nil || i.ClosedAt == nil {
+		return time.Time{}
+	}
+	return *i.ClosedAt
+}
+
+// GetClosedBy returns the ClosedBy field.
+func (i *Issue) GetClosedBy() *User {
+	if i == nil {
+		return nil
+	}
+	return i.ClosedBy
+}
+
+// GetComments returns the Comments field if it's non-nil, zero value otherwise.
+func (i *Issue) GetComments() int {
+	if i == nil || i.Comments == nil {
+		return 0
+	}
+	return *i.Comments
+}
+
+// GetCommentsURL returns the CommentsURL field if it's non-nil, zero value otherwise.
+func (i *Issue) GetCommentsURL() string {
+	if i == nil || i.CommentsURL == nil {
+		return ""
+	}
+	return *i.CommentsURL
+}
+
+// GetCreatedAt returns the CreatedAt field if it's non-nil, zero value otherwise.
+func (i *Issue) GetCreatedAt() time.Time {
+	if i == nil || i.CreatedAt == nil {
+		return time.Time{}
+	}
+	return *i.CreatedAt
+}
+
+// GetEventsURL returns the EventsURL field if it's non-nil, zero value otherwise.
+func (i *Issue) GetEventsURL() string {
+	if i == nil || i.EventsURL == nil {
+		return ""
+	}
+	return *i.EventsURL
+}
+
+// GetHTMLURL returns the HTMLURL field if it's non-nil, zero value otherwise.
+func (i *Issue) GetHTMLURL() string {
+	if i == nil || i.HTMLURL == nil {
+		return ""
+	}
+	return *i.HTMLURL
+}
+
+// GetID returns the ID field if it's non-nil, zero value otherwise.
+func (i *Issue) GetID() int64 {
+	if i == nil || i.ID == nil {
+		return 0
+	}
+	return *i.ID
+}
+
+// GetLabelsURL returns the LabelsURL field if it's non-nil, zero value otherwise.
+func (i *Issue) GetLabelsURL() string {
+	if i == nil || i.LabelsURL == nil {
+		return ""
+	}
+	return *i.LabelsURL
+}
+
+// GetLocked returns the Locked field if it's non-nil, zero value otherwise.
+func (i *Issue) GetLocked() bool {
+	if i == nil || i.Locked == nil {
+		return false
+	}
+	return *i.Locked
+}
+
+// GetMilestone returns the Milestone field.
+func (i *Issue) GetMilestone() *Milestone {
+	if i == nil {
+		return nil
+	}
+	return i.Milestone
+}
+
+// GetNodeID returns the NodeID field if it's non-nil, zero value otherwise.
+func (i *Issue) GetNodeID() string {
+	if i == nil || i.NodeID == nil {
+		return ""
+	}
+	return *i.NodeID
+}
+
+// GetNumber returns the Number field if it's non-nil, zero value otherwise.
+func (i *Issue) GetNumber() int {
+	if i == nil || i.Number == nil {
+		return 0
+	}
+	return *i.Number
+}
+
+// GetPullRequestLinks returns the PullRequestLinks field.
+func (i *Issue) GetPullRequestLinks() *PullRequestLinks {
+	if i == nil {
+		return nil
+	}
+	return i.PullRequestLinks
+}
+
+// GetReactions returns the Reactions field.
+func (i *Issue) GetReactions() *Reactions {
+	if i == nil {
+		return nil
+	}
+	return i.Reactions
+}
+
+// GetRepository returns the Repository field.
+func (i *Issue) GetRepository() *Repository {
+	if i == nil {
+		return nil
+	}
+	return i.Repository
+}
+
+// GetRepositoryURL returns the RepositoryURL field if it's non-nil, zero value otherwise.
+func (i *Issue) GetRepositoryURL() string {
+	if i == nil || i.RepositoryURL == nil {
+		return ""
+	}
+	return *i.RepositoryURL
+}
+
+// GetState returns the State field if it's non-nil, zero value otherwise.
+func (i *Issue) GetState() string {
+	if i == nil || i.State == nil {
+		return ""
+	}
+	return *i.State
+}
+
+// GetTitle returns the Title field if it's non-nil, zero value otherwise.
+func (i *Issue) GetTitle() string {
+	if i == nil || i.Title == nil {
+		return ""
+	}
+	return *i.Title
+}
+
+// GetUpdatedAt returns the UpdatedAt field if it's non-nil, zero value otherwise.
+func (i *Issue) GetUpdatedAt() time.Time {
+	if i == nil || i.UpdatedAt == nil {
+		return time.Time{}
+	}
+	return *i.UpdatedAt
+}
+
+// GetURL returns the URL field if it's non-nil, zero value otherwise.
+func (i *Issue) GetURL() string {
+	if i == nil || i.URL == nil {
+		return ""
+	}
+	return *i.URL
+}
+
+// GetUser returns the User field.
+func (i *Issue) GetUser() *User {
+	if i == nil {
+		return nil
+	}
+	return i.User
+}
+
+// GetAuthorAssociation returns the AuthorAssociation field if it's non-nil, zero value otherwise.
+func (i *IssueComment) GetAuthorAssociation() string {
+	if i == nil || i.AuthorAssociation == nil {
+		return ""
+	}
+	return *i.AuthorAssociation
+}
+
+// GetBody returns the Body field if it's non-nil, zero value otherwise.
+func (i *IssueComment) GetBody() string {
+	if i == nil || i.Body == nil {
+		return ""
+	}
+	return *i.Body
+}
+
+// GetCreatedAt returns the CreatedAt field if it's non-nil, zero value otherwise.
+func (i *IssueComment) GetCreatedAt() time.Time {
+	if i == nil || i.CreatedAt == nil {
+		return time.Time{}
+	}
+	return *i.CreatedAt
+}
+
+// GetHTMLURL returns the HTMLURL field if it's non-nil, zero value otherwise.
+func (i *IssueComment) GetHTMLURL() string {
+	if i == nil || i.HTMLURL == nil {
+		return ""
+	}
+	return *i.HTMLURL
+}
+
+// GetID returns the ID field if it's non-nil, zero value otherwise.
+func (i *IssueComment) GetID() int64 {
+	if i == nil || i.ID == nil {
+		return 0
+	}
+	return *i.ID
+}
+
+// GetIssueURL returns the IssueURL field if it's non-nil, zero value otherwise.
+func (i *IssueComment) GetIssueURL() string {
+	if i == nil || i.IssueURL == nil {
+		return ""
+	}
+	return *i.IssueURL
+}
+
+// GetNodeID returns the NodeID field if it's non-nil, zero value otherwise.
+func (i *IssueComment) GetNodeID() string {
+	if i == nil || i.NodeID == nil {
+		return ""
+	}
+	return *i.NodeID
+}
+
+// GetReactions returns the Reactions field.
+func (i *IssueComment) GetReactions() *Reactions {
+	if i == nil {
+		return nil
+	}
+	return i.Reactions
+}
+
+// GetUpdatedAt returns the UpdatedAt field if it's non-nil, zero value otherwise.
+func (i *IssueComment) GetUpdatedAt() time.Time {
+	if i == nil || i.UpdatedAt == nil {
+		return time.Time{}
+	}
+	return *i.UpdatedAt
+}
+
+// GetURL returns the URL field if it's non-nil, zero value otherwise.
+func (i *IssueComment) GetURL() string {
+	if i == nil || i.URL == nil {
+		return ""
+	}
+	return *i.URL
+}
+
+// GetUser returns the User field.
+func (i *IssueComment) GetUser() *User {
+	if i == nil {
+		return nil
+	}
+	return i.User
+}
+
+// GetAction returns the Action field if it's non-nil, zero value otherwise.
+func (i *IssueCommentEvent) GetAction() string {
+	if i == nil || i.Action == nil {
+		return ""
+	}
+	return *i.Action
+}
+
+// GetChanges returns the Changes field.
+func (i *IssueCommentEvent) GetChanges() *EditChange {
+	if i == nil {
+		return nil
+	}
+	return i.Changes
+}
+
+// GetComment returns the Comment field.
+func (i *IssueCommentEvent) GetComment() *IssueComment {
+	if i == nil {
+		return nil
+	}
+	return i.Comment
+}
+
+// GetInstallation returns the Installation field.
+func (i *IssueCommentEvent) GetInstallation() *Installation {
+	if i == nil {
+		return nil
+	}
+	return i.Installation
+}
+
+// GetIssue returns the Issue field.
+func (i *IssueCommentEvent) GetIssue() *Issue {
+	if i == nil {
+		return nil
+	}
+	return i.Issue
+}
+
+// GetRepo returns the Repo field.
+func (i *IssueCommentEvent) GetRepo() *Repository {
+	if i == nil {
+		return nil
+	}
+	return i.Repo
+}
+
+// GetSender returns the Sender field.
+func (i *IssueCommentEvent) GetSender() *User {
+	if i == nil {
+		return nil
+	}
+	return i.Sender
+}
+
+// GetAction returns the Action field if it's non-nil, zero value otherwise.
+func (i *IssueDependenciesEvent) GetAction() string {
+	if i == nil || i.Action == nil {
+		return ""
+	}
+	return *i.Action
+}
+
+// GetBlockedIssue returns the BlockedIssue field.
+func (i *IssueDependenciesEvent) GetBlockedIssue() *Issue {
+	if i == nil {
+		return nil
+	}
+	return i.BlockedIssue
+}
+
+// GetBlockedIssueRepo returns the BlockedIssueRepo field.
+func (i *IssueDependenciesEvent) GetBlockedIssueRepo() *Repository {
+	if i == nil {
+		return nil
+	}
+	return i.BlockedIssueRepo
+}
+
+// GetBlockingIssue returns the BlockingIssue field.
+func (i *IssueDependenciesEvent) GetBlockingIssue() *Issue {
+	if i == nil {
+		return nil
+	}
+	return i.BlockingIssue
+}
+
+// GetBlockingIssueRepo returns the BlockingIssueRepo field.
+func (i *IssueDependenciesEvent) GetBlockingIssueRepo() *Repository {
+	if i == nil {
+		return nil
+	}
+	return i.BlockingIssueRepo
+}
+
+// GetInstallation returns the Installation field.
+func (i *IssueDependenciesEvent) GetInstallation() *Installation {
+	if i == nil {
+		return nil
+	}
+	return i.Installation
+}
+
+// GetOrg returns the Org field.
+func (i *IssueDependenciesEvent) GetOrg() *Organization {
+	if i == nil {
+		return nil
+	}
+	return i.Org
+}
+
+// GetRepo returns the Repo field.
+func (i *IssueDependenciesEvent) GetRepo() *Repository {
+	if i == nil {
+		return nil
+	}
+	return i.Repo
+}
+
+// GetSender returns the Sender field.
+func (i *IssueDependenciesEvent) GetSender() *User {
+	if i == nil {
+		return nil
+	}
+	return i.Sender
+}
+
+// GetActor returns the Actor field.
+func (i *IssueEvent) GetActor() *User {
+	if i == nil {
+		return nil
+	}
+	return i.Actor
+}
+
+// GetAssignee returns the Assignee field.
+func (i *IssueEvent) GetAssignee() *User {
+	if i == nil {
+		return nil
+	}
+	return i.Assignee
+}
+
+// GetAssigner returns the Assigner field.
+func (i *IssueEvent) GetAssigner() *User {
+	if i == nil {
+		return nil
+	}
+	return i.Assigner
+}
+
+// GetCommitID returns the CommitID field if it's non-nil, zero value otherwise.
+func (i *IssueEvent) GetCommitID() string {
+	if i == nil || i.CommitID == nil {
+		return ""
+	}
+	return *i.CommitID
+}
+
+// GetCreatedAt returns the CreatedAt field if it's non-nil, zero value otherwise.
+func (i *IssueEvent) GetCreatedAt() time.Time {
+	if i == nil || i.CreatedAt == nil {
+		return time.Time{}
+	}
+	return *i.CreatedAt
+}
+
+// GetDismissedReview returns the DismissedReview field.
+func (i *IssueEvent) GetDismissedReview() *DismissedReview {
+	if i == nil {
+		return nil
+	}
+	return i.DismissedReview
+}
+
+// GetEvent returns the Event field if it's non-nil, zero value otherwise.
+func (i *IssueEvent) GetEvent() string {
+	if i == nil || i.Event == nil {
+		return ""
+	}
+	return *i.Event
+}
+
+// GetID returns the ID field if it's non-nil, zero value otherwise.
+func (i *IssueEvent) GetID() int64 {
+	if i == nil || i.ID == nil {
+		return 0
+	}
+	return *i.ID
+}
+
+// GetIssue returns the Issue field.
+func (i *IssueEvent) GetIssue() *Issue {
+	if i == nil {
+		return nil
+	}
+	return i.Issue
+}
+
+// GetLabel returns the Label field.
+func (i *IssueEvent) GetLabel() *Label {
+	if i == nil {
+		return nil
+	}
+	return i.Label
+}
+
+// GetLockReason returns the LockReason field if it's non-nil, zero value otherwise.
+func (i *IssueEvent) GetLockReason() string {
+	if i == nil || i.LockReason == nil {
+		return ""
+	}
+	return *i.LockReason
+}
+
+// GetMilestone returns the Milestone field.
+func (i *IssueEvent) GetMilestone() *Milestone {
+	if i == nil {
+		return nil
+	}
+	return i.Milestone
+}
+
+// GetProjectCard returns the ProjectCard field.
+func (i *IssueEvent) GetProjectCard() *ProjectCard {
+	if i == nil {
+		return nil
+	}
+	return i.ProjectCard
+}
+
+// GetRename returns the Rename field.
+func (i *IssueEvent) GetRename() *Rename {
+	if i == nil {
+		return nil
+	}
+	return i.Rename
+}
+
+// GetRequestedReviewer returns the RequestedReviewer field.
+func (i *IssueEvent) GetRequestedReviewer() *User {
+	if i == nil {
+		return nil
+	}
+	return i.RequestedReviewer
+}
+
+// GetReviewRequester returns the ReviewRequester field.
+func (i *IssueEvent) GetReviewRequester() *User {
+	if i == nil {
+		return nil
+	}
+	return i.ReviewRequester
+}
+
+// GetURL returns the URL field if it's non-nil, zero value otherwise.
+func (i *IssueEvent) GetURL() string {
+	if i == nil || i.URL == nil {
+		return ""
+	}
+	return *i.URL
+}
+
+// GetAssignee returns the Assignee field if it's non-nil, zero value otherwise.
+func (i *IssueImport) GetAssignee() string {
+	if i == nil || i.Assignee == nil {
+		return ""
+	}
+	return *i.Assignee
+}
+
+// GetClosed returns the Closed field if it's non-nil, zero value otherwise.
+func (i *IssueImport) GetClosed() bool {
+	if i == nil || i.Closed == nil {
+		return false
+	}
+	return *i.Closed
+}
+
+// GetClosedAt returns the ClosedAt field if it's non-nil, zero value otherwise.
+func (i *IssueImport) GetClosedAt() time.Time {
+	if i == nil || i.ClosedAt == nil {
+		return time.Time{}
+	}
+	return *i.ClosedAt
+}
+
+// GetCreatedAt returns the CreatedAt field if it's non-nil, zero value otherwise.
+func (i *IssueImport) GetCreatedAt() time.Time {
+	if i == nil || i.CreatedAt == nil {
+		return time.Time{}
+	}
+	return *i.CreatedAt
+}
+
+// GetMilestone returns the Milestone field if it's non-nil, zero value otherwise.
+func (i *IssueImport) GetMilestone() int {
+	if i == nil || i.Milestone == nil {
+		return 0
+	}
+	return *i.Milestone
+}
+
+// GetUpdatedAt returns the UpdatedAt field if it's non-nil, zero value otherwise.
+func (i *IssueImport) GetUpdatedAt() time.Time {
+	if i == nil || i.UpdatedAt == nil {
+		return time.Time{}
+	}
+	return *i.UpdatedAt
+}
+
+// GetCode returns the Code field if it's non-nil, zero value otherwise.
+func (i *IssueImportError) GetCode() string {
+	if i == nil || i.Code == nil {
+		return ""
+	}
+	return *i.Code
+}
+
+// GetField returns the Field field if it's non-nil, zero value otherwise.
+func (i *IssueImportError) GetField() string {
+	if i == nil || i.Field == nil {
+		return ""
+	}
+	return *i.Field
+}
+
+// GetLocation returns the Location field if it's non-nil, zero value otherwise.
+func (i *IssueImportError) GetLocation() string {
+	if i == nil || i.Location == nil {
+		return ""
+	}
+	return *i.Location
+}
+
+// GetResource returns the Resource field if it's non-nil, zero value otherwise.
+func (i *IssueImportError) GetResource() string {
+	if i == nil || i.Resource == nil {
+		return ""
+	}
+	return *i.Resource
+}
+
+// GetValue returns the Value field if it's non-nil, zero value otherwise.
+func (i *IssueImportError) GetValue() string {
+	if i == nil || i.Value == nil {
+		return ""
+	}
+	return *i.Value
+}
+
+// GetCreatedAt returns the CreatedAt field if it's non-nil, zero value otherwise.
+func (i *IssueImportResponse) GetCreatedAt() time.Time {
+	if i == nil || i.CreatedAt == nil {
+		return time.Time{}
+	}
+	return *i.CreatedAt
+}
+
+// GetDocumentationURL returns the DocumentationURL field if it's non-nil, zero value otherwise.
+func (i *IssueImportResponse) GetDocumentationURL() string {
+	if i == nil || i.DocumentationURL == nil {
+		return ""
+	}
+	return *i.DocumentationURL
+}
+
+// GetID returns the ID field if it's non-nil, zero value otherwise.
+func (i *IssueImportResponse) GetID() int {
+	if i == nil || i.ID == nil {
+		return 0
+	}
+	return *i.ID
+}
+
+// GetImportIssuesURL returns the ImportIssuesURL field if it's non-nil, zero value otherwise.
+func (i *IssueImportResponse) GetImportIssuesURL() string {
+	if i == nil || i.ImportIssuesURL == nil {
+		return ""
+	}
+	return *i.ImportIssuesURL
+}
+
+// GetMessage returns the Message field if it's non-nil, zero value otherwise.
+func (i *IssueImportResponse) GetMessage() string {
+	if i == nil || i.Message == nil {
+		return ""
+	}
+	return *i.Message
+}
+
+// GetRepositoryURL returns the RepositoryURL field if it's non-nil, zero value otherwise.
+func (i *IssueImportResponse) GetRepositoryURL() string {
+	if i == nil || i.RepositoryURL == nil {
+		return ""
+	}
+	return *i.RepositoryURL
+}
+
+// GetStatus returns the Status field if it's non-nil, zero value otherwise.
+func (i *IssueImportResponse) GetStatus() string {
+	if i == nil || i.Status == nil {
+		return ""
+	}
+	return *i.Status
+}
+
+// GetUpdatedAt returns the UpdatedAt field if it's non-nil, zero value otherwise.
+func (i *IssueImportResponse) GetUpdatedAt() time.Time {
+	if i == nil || i.UpdatedAt == nil {
+		return time.Time{}
+	}
+	return *i.UpdatedAt
+}
+
+// GetURL returns the URL field if it's non-nil, zero value otherwise.
+func (i *IssueImportResponse) GetURL() string {
+	if i == nil || i.URL == nil {
+		return ""
+	}
+	return *i.URL
+}
+
+// GetSince returns the Since field if it's non-nil, zero value otherwise.
+func (i *IssueListCommentsOptions) GetSince() time.Time {
+	if i == nil || i.Since == nil {
+		return time.Time{}
+	}
+	return *i.Since
+}
+
+// GetAssignee returns the Assignee field if it's non-nil, zero value otherwise.
+func (i *IssueRequest) GetAssignee() string {
+	if i == nil || i.Assignee == nil {
+		return ""
+	}
+	return *i.Assignee
+}
+
+// GetAssignees returns the Assignees field if it's non-nil, zero value otherwise.
+func (i *IssueRequest) GetAssignees() []string {
+	if i == nil || i.Assignees == nil {
+		return nil
+	}
+	return *i.Assignees
+}
+
+// GetBody returns the Body field if it's non-nil, zero value otherwise.
+func (i *IssueRequest) GetBody() string {
+	if i == nil || i.Body == nil {
+		return ""
+	}
+	return *i.Body
+}
+
+// GetLabels returns the Labels field if it's non-nil, zero value otherwise.
+func (i *IssueRequest) GetLabels() []string {
+	if i == nil || i.Labels == nil {
+		return nil
+	}
+	return *i.Labels
+}
+
+// GetMilestone returns the Milestone field if it's non-nil, zero value otherwise.
+func (i *IssueRequest) GetMilestone() int {
+	if i == nil || i.Milestone == nil {
+		return 0
+	}
+	return *i.Milestone
+}
+
+// GetState returns the State field if it's non-nil, zero value otherwise.
+func (i *IssueRequest) GetState() string {
+	if i == nil || i.State == nil {
+		return ""
+	}
+	return *i.State
+}
+
+// GetTitle returns the Title field if it's non-nil, zero value otherwise.
+func (i *IssueRequest) GetTitle() string {
+	if i == nil || i.Title == nil {
+		return ""
+	}
+	return *i.Title
+}
+
+// GetAction returns the Action field if it's non-nil, zero value otherwise.
+func (i *IssuesEvent) GetAction() string {
+	if i == nil || i.Action == nil {
+		return ""
+	}
+	return *i.Action
+}
+
+// GetAssignee returns the Assignee field.
+func (i *IssuesEvent) GetAssignee() *User {
+	if i == nil {
+		return nil
+	}
+	return i.Assignee
+}
+
+// GetChanges returns the Changes field.
+func (i *IssuesEvent) GetChanges() *EditChange {
+	if i == nil {
+		return nil
+	}
+	return i.Changes
+}
+
+// GetInstallation returns the Installation field.
+func (i *IssuesEvent) GetInstallation() *Installation {
+	if i == nil {
+		return nil
+	}
+	return i.Installation
+}
+
+// GetIssue returns the Issue field.
+func (i *IssuesEvent) GetIssue() *Issue {
+	if i == nil {
+		return nil
+	}
+	return i.Issue
+}
+
+// GetLabel returns the Label field.
+func (i *IssuesEvent) GetLabel() *Label {
+	if i == nil {
+		return nil
+	}
+	return i.Label
+}
+
+// GetRepo returns the Repo field.
+func (i *IssuesEvent) GetRepo() *Repository {
+	if i == nil {
+		return nil
+	}
+	return i.Repo
+}
+
+// GetSender returns the Sender field.
+func (i *IssuesEvent) GetSender() *User {
+	if i == nil {
+		return nil
+	}
+	return i.Sender
+}
+
+// GetIncompleteResults returns the IncompleteResults field if it's non-nil, zero value otherwise.
+func (i *IssuesSearchResult) GetIncompleteResults() bool {
+	if i == nil || i.IncompleteResults == nil {
+		return false
+	}
+	return *i.IncompleteResults
+}
+
+// GetTotal returns the Total field if it's non-nil, zero value otherwise.
+func (i *IssuesSearchResult) GetTotal() int {
+	if i == nil || i.Total == nil {
+		return 0
+	}
+	return *i.Total
+}
+
+// GetClosedIssues returns the ClosedIssues field if it's non-nil, zero value otherwise.
+func (i *IssueStats) GetClosedIssues() int {
+	if i == nil || i.ClosedIssues == nil {
+		return 0
+	}
+	return *i.ClosedIssues
+}
+
+// GetOpenIssues returns the OpenIssues field if it's non-nil, zero value otherwise.
+func (i *IssueStats) GetOpenIssues() int {
+	if i == nil || i.OpenIssues == nil {
+		return 0
+	}
+	return *i.OpenIssues
+}
+
+// GetTotalIssues returns the TotalIssues field if it's non-nil, zero value otherwise.
+func (i *IssueStats) GetTotalIssues() int {
+	if i == nil || i.TotalIssues == nil {
+		return 0
+	}
+	return *i.TotalIssues
+}
+
+// GetTotalCount returns the TotalCount field if it's non-nil, zero value otherwise.
+func (j *Jobs) GetTotalCount() int {
+	if j == nil || j.TotalCount == nil {
+		return 0
+	}
+	return *j.TotalCount
+}
+
+// GetCreatedAt returns the CreatedAt field if it's non-nil, zero value otherwise.
+func (k *Key) GetCreatedAt() Timestamp {
+	if k == nil || k.CreatedAt == nil {
+		return Timestamp{}
+	}
+	return *k.CreatedAt
+}
+
+// GetID returns the ID field if it's non-nil, zero value otherwise.
+func (k *Key) GetID() int64 {
+	if k == nil || k.ID == nil {
+		return 0
+	}
+	return *k.ID
+}
+
+// GetKey returns the Key field if it's non-nil, zero value otherwise.
+func (k *Key) GetKey() string {
+	if k == nil || k.Key == nil {
+		return ""
+	}
+	return *k.Key
+}
+
+// GetReadOnly returns the ReadOnly field if it's non-nil, zero value otherwise.
+func (k *Key) GetReadOnly() bool {
+	if k == nil || k.ReadOnly == nil {
+		return false
+	}
+	return *k.ReadOnly
+}
+
+// GetTitle returns the Title field if it's non-nil, zero value otherwise.
+func (k *Key) GetTitle() string {
+	if k == nil || k.Title == nil {
+		return ""
+	}
+	return *k.Title
+}
+
+// GetURL returns the URL field if it's non-nil, zero value otherwise.
+func (k *Key) GetURL() string {
+	if k == nil || k.URL == nil {
+		return ""
+	}
+	return *k.URL
+}
+
+// GetColor returns the Color field if it's non-nil, zero value otherwise.
+func (l *Label) GetColor() string {
+	if l == nil || l.Color == nil {
+		return ""
+	}
+	return *l.Color
+}
+
+// GetDefault returns the Default field if it's non-nil, zero value otherwise.
+func (l *Label) GetDefault() bool {
+	if l == nil || l.Default == nil {
+		return false
+	}
+	return *l.Default
+}
+
+// GetDescription returns the Description field if it's non-nil, zero value otherwise.
+func (l *Label) GetDescription() string {
+	if l == nil || l.Description == nil {
+		return ""
+	}
+	return *l.Description
+}
+
+// GetID returns the ID field if it's non-nil, zero value otherwise.
+func (l *Label) GetID() int64 {
+	if l == nil || l.ID == nil {
+		return 0
+	}
+	return *l.ID
+}
+
+// GetName returns the Name field if it's non-nil, zero value otherwise.
+func (l *Label) GetName() string {
+	if l == nil || l.Name == nil {
+		return ""
+	}
+	return *l.Name
+}
+
+// GetNodeID returns the NodeID field if it's non-nil, zero value otherwise.
+func (l *Label) GetNodeID() string {
+	if l == nil || l.NodeID == nil {
+		return ""
+	}
+	return *l.NodeID
+}
+
+// GetURL returns the URL field if it's non-nil, zero value otherwise.
+func (l *Label) GetURL() string {
+	if l == nil || l.URL == nil {
+		return ""
+	}
+	return *l.URL
+}
+
+// GetAction returns the Action field if it's non-nil, zero value otherwise.
+func (l *LabelEvent) GetAction() string {
+	if l == nil || l.Action == nil {
+		return ""
+	}
+	return *l.Action
+}
+
+// GetChanges returns the Changes field.
+func (l *LabelEvent) GetChanges() *EditChange {
+	if l == nil {
+		return nil
+	}
+	return l.Changes
+}
+
+// GetInstallation returns the Installation field.
+func (l *LabelEvent) GetInstallation() *Installation {
+	if l == nil {
+		return nil
+	}
+	return l.Installation
+}
+
+// GetLabel returns the Label field.
+func (l *LabelEvent) GetLabel() *Label {
+	if l == nil {
+		return nil
+	}
+	return l.Label
+}
+
+// GetOrg returns the Org field.
+func (l *LabelEvent) GetOrg() *Organization {
+	if l == nil {
+		return nil
+	}
+	return l.Org
+}
+
+// GetRepo returns the Repo field.
+func (l *LabelEvent) GetRepo() *Repository {
+	if l == nil {
+		return nil
+	}
+	return l.Repo
+}
+
+// GetColor returns the Color field if it's non-nil, zero value otherwise.
+func (l *LabelResult) GetColor() string {
+	if l == nil || l.Color == nil {
+		return ""
+	}
+	return *l.Color
+}
+
+// GetDefault returns the Default field if it's non-nil, zero value otherwise.
+func (l *LabelResult) GetDefault() bool {
+	if l == nil || l.Default == nil {
+		return false
+	}
+	return *l.Default
+}
+
+// GetDescription returns the Description field if it's non-nil, zero value otherwise.
+func (l *LabelResult) GetDescription() string {
+	if l == nil || l.Description == nil {
+		return ""
+	}
+	return *l.Description
+}
+
+// GetID returns the ID field if it's non-nil, zero value otherwise.
+func (l *LabelResult) GetID() int64 {
+	if l == nil || l.ID == nil {
+		return 0
+	}
+	return *l.ID
+}
+
+// GetName returns the Name field if it's non-nil, zero value otherwise.
+func (l *LabelResult) GetName() string {
+	if l == nil || l.Name == nil {
+		return ""
+	}
+	return *l.Name
+}
+
+// GetNodeID returns the NodeID field if it's non-nil, zero value otherwise.
+func (l *LabelResult) GetNodeID() string {
+	if l == nil || l.NodeID == nil {
+		return ""
+	}
+	return *l.NodeID
+}
+
+// GetScore returns the Score field.
+func (l *LabelResult) GetScore() *float64 {
+	if l == nil {
+		return nil
+	}
+	return l.Score
+}
+
+// GetURL returns the URL field if it's non-nil, zero value otherwise.
+func (l *LabelResult) GetURL() string {
+	if l == nil || l.URL == nil {
+		return ""
+	}
+	return *l.URL
+}
+
+// GetIncompleteResults returns the IncompleteResults field if it's non-nil, zero value otherwise.
+func (l *LabelsSearchResult) GetIncompleteResults() bool {
+	if l == nil || l.IncompleteResults == nil {
+		return false
+	}
+	return *l.IncompleteResults
+}
+
+// GetTotal returns the Total field if it's non-nil, zero value otherwise.
+func (l *LabelsSearchResult) GetTotal() int {
+	if l == nil || l.Total == nil {
+		return 0
+	}
+	return *l.Total
+}
+
+// GetOID returns the OID field if it's non-nil, zero value otherwise.
+func (l *LargeFile) GetOID() string {
+	if l == nil || l.OID == nil {
+		return ""
+	}
+	return *l.OID
+}
+
+// GetPath returns the Path field if it's non-nil, zero value otherwise.
+func (l *LargeFile) GetPath() string {
+	if l == nil || l.Path == nil {
+		return ""
+	}
+	return *l.Path
+}
+
+// GetRefName returns the RefName field if it's non-nil, zero value otherwise.
+func (l *LargeFile) GetRefName() string {
+	if l == nil || l.RefName == nil {
+		return ""
+	}
+	return *l.RefName
+}
+
+// GetSize returns the Size field if it's non-nil, zero value otherwise.
+func (l *LargeFile) GetSize() int {
+	if l == nil || l.Size == nil {
+		return 0
+	}
+	return *l.Size
+}
+
+// GetID returns the ID field if it's non-nil, zero value otherwise.
+func (l *LFSLock) GetID() string {
+	if l == nil || l.ID == nil {
+		return ""
+	}
+	return *l.ID
+}
+
+// GetLockedAt returns the LockedAt field if it's non-nil, zero value otherwise.
+func (l *LFSLock) GetLockedAt() Timestamp {
+	if l == nil || l.LockedAt == nil {
+		return Timestamp{}
+	}
+	return *l.LockedAt
+}
+
+// GetOwner returns the Owner field.
+func (l *LFSLock) GetOwner() *LFSLockOwner {
+	if l == nil {
+		return nil
+	}
+	return l.Owner
+}
+
+// GetPath returns the Path field if it's non-nil, zero value otherwise.
+func (l *LFSLock) GetPath() string {
+	if l == nil || l.Path == nil {
+		return ""
+	}
+	return *l.Path
+}
+
+// GetName returns the Name field if it's non-nil, zero value otherwise.
+func (l *LFSLockOwner) GetName() string {
+	if l == nil || l.Name == nil {
+		return ""
+	}
+	return *l.Name
+}
+
+// GetNextCursor returns the NextCursor field if it's non-nil, zero value otherwise.
+func (l *LFSLocks) GetNextCursor() string {
+	if l == nil || l.NextCursor == nil {
+		return ""
+	}
+	return *l.NextCursor
+}
+
+// GetNextCursor returns the NextCursor field if it's non-nil, zero value otherwise.
+func (l *LFSLocksVerification) GetNextCursor() string {
+	if l == nil || l.NextCursor == nil {
+		return ""
+	}
+	return *l.NextCursor
+}
+
+// GetBody returns the Body field if it's non-nil, zero value otherwise.
+func (l *License) GetBody() string {
+	if l == nil || l.Body == nil {
+		return ""
+	}
+	return *l.Body
+}
+
+// GetConditions returns the Conditions field if it's non-nil, zero value otherwise.
+func (l *License) GetConditions() []string {
+	if l == nil || l.Conditions == nil {
+		return nil
+	}
+	return *l.Conditions
+}
+
+// GetDescription returns the Description field if it's non-nil, zero value otherwise.
+func (l *License) GetDescription() string {
+	if l == nil || l.Description == nil {
+		return ""
+	}
+	return *l.Description
+}
+
+// GetFeatured returns the Featured field if it's non-nil, zero value otherwise.
+func (l *License) GetFeatured() bool {
+	if l == nil || l.Featured == nil {
+		return false
+	}
+	return *l.Featured
+}
+
+// GetHTMLURL returns the HTMLURL field if it's non-nil, zero value otherwise.
+func (l *License) GetHTMLURL() string {
+	if l == nil || l.HTMLURL == nil {
+		return ""
+	}
+	return *l.HTMLURL
+}
+
+// GetImplementation returns the Implementation field if it's non-nil, zero value otherwise.
+func (l *License) GetImplementation() string {
+	if l == nil || l.Implementation == nil {
+		return ""
+	}
+	return *l.Implementation
+}
+
+// GetKey returns the Key field if it's non-nil, zero value otherwise.
+func (l *License) GetKey() string {
+	if l == nil || l.Key == nil {
+		return ""
+	}
+	return *l.Key
+}
+
+// GetLimitations returns the Limitations field if it's non-nil, zero value otherwise.
+func (l *License) GetLimitations() []string {
+	if l == nil || l.Limitations == nil {
+		return nil
+	}
+	return *l.Limitations
+}
+
+// GetName returns the Name field if it's non-nil, zero value otherwise.
+func (l *License) GetName() string {
+	if l == nil || l.Name == nil {
+		return ""
+	}
+	return *l.Name
+}
+
+// GetPermissions returns the Permissions field if it's non-nil, zero value otherwise.
+func (l *License) GetPermissions() []string {
+	if l == nil || l.Permissions == nil {
+		return nil
+	}
+	return *l.Permissions
+}
+
+// GetSPDXID returns the SPDXID field if it's non-nil, zero value otherwise.
+func (l *License) GetSPDXID() string {
+	if l == nil || l.SPDXID == nil {
+		return ""
+	}
+	return *l.SPDXID
+}
+
+// GetURL returns the URL field if it's non-nil, zero value otherwise.
+func (l *License) GetURL() string {
+	if l == nil || l.URL == nil {
+		return ""
+	}
+	return *l.URL
+}
+
+// GetCheckName returns the CheckName field if it's non-nil, zero value otherwise.
+func (l *ListCheckRunsOptions) GetCheckName() string {
+	if l == nil || l.CheckName == nil {
+		return ""
+	}
+	return *l.CheckName
+}
+
+// GetFilter returns the Filter field if it's non-nil, zero value otherwise.
+func (l *ListCheckRunsOptions) GetFilter() string {
+	if l == nil || l.Filter == nil {
+		return ""
+	}
+	return *l.Filter
+}
+
+// GetStatus returns the Status field if it's non-nil, zero value otherwise.
+func (l *ListCheckRunsOptions) GetStatus() string {
+	if l == nil || l.Status == nil {
+		return ""
+	}
+	return *l.Status
+}
+
+// GetTotal returns the Total field if it's non-nil, zero value otherwise.
+func (l *ListCheckRunsResults) GetTotal() int {
+	if l == nil || l.Total == nil {
+		return 0
+	}
+	return *l.Total
+}
+
+// GetAppID returns the AppID field if it's non-nil, zero value otherwise.
+func (l *ListCheckSuiteOptions) GetAppID() int {
+	if l == nil || l.AppID == nil {
+		return 0
+	}
+	return *l.AppID
+}
+
+// GetCheckName returns the CheckName field if it's non-nil, zero value otherwise.
+func (l *ListCheckSuiteOptions) GetCheckName() string {
+	if l == nil || l.CheckName == nil {
+		return ""
+	}
+	return *l.CheckName
+}
+
+// GetTotal returns the Total field if it's non-nil, zero value otherwise.
+func (l *ListCheckSuiteResults) GetTotal() int {
+	if l == nil || l.Total == nil {
+		return 0
+	}
+	return *l.Total
+}
+
+// GetAffiliation returns the Affiliation field if it's non-nil, zero value otherwise.
+func (l *ListCollaboratorOptions) GetAffiliation() string {
+	if l == nil || l.Affiliation == nil {
+		return ""
+	}
+	return *l.Affiliation
+}
+
+// GetTotalSeats returns the TotalSeats field if it's non-nil, zero value otherwise.
+func (l *ListCopilotSeatsResponse) GetTotalSeats() int64 {
+	if l == nil || l.TotalSeats == nil {
+		return 0
+	}
+	return *l.TotalSeats
+}
+
+// GetTotalCount returns the TotalCount field if it's non-nil, zero value otherwise.
+func (l *ListCustomDeploymentRuleIntegrationsResponse) GetTotalCount() int {
+	if l == nil || l.TotalCount == nil {
+		return 0
+	}
+	return *l.TotalCount
+}
+
+// GetTotalCount returns the TotalCount field if it's non-nil, zero value otherwise.
+func (l *ListDeploymentProtectionRuleResponse) GetTotalCount() int {
+	if l == nil || l.TotalCount == nil {
+		return 0
+	}
+	return *l.TotalCount
+}
+
+// GetIsWithdrawn returns the IsWithdrawn field if it's non-nil, zero value otherwise.
+func (l *ListGlobalSecurityAdvisoriesOptions) GetIsWithdrawn() bool {
+	if l == nil || l.IsWithdrawn == nil {
+		return false
+	}
+	return *l.IsWithdrawn
+}
+
+// GetEffectiveDate returns the EffectiveDate field if it's non-nil, zero value otherwise.
+func (m *MarketplacePendingChange) GetEffectiveDate() Timestamp {
+	if m == nil || m.EffectiveDate == nil {
+		return Timestamp{}
+	}
+	return *m.EffectiveDate
+}
+
+// GetID returns the ID field if it's non-nil, zero value otherwise.
+func (m *MarketplacePendingChange) GetID() int64 {
+	if m == nil || m.ID == nil {
+		return 0
+	}
+	return *m.ID
+}
+
+// GetPlan returns the Plan field.
+func (m *MarketplacePendingChange) GetPlan() *MarketplacePlan {
+	if m == nil {
+		return nil
+	}
+	return m.Plan
+}
+
+// GetUnitCount returns the UnitCount field if it's non-nil, zero value otherwise.
+func (m *MarketplacePendingChange) GetUnitCount() int {
+	if m == nil || m.UnitCount == nil {
+		return 0
+	}
+	return *m.UnitCount
+}
+
+// GetAccountsURL returns the AccountsURL field if it's non-nil, zero value otherwise.
+func (m *MarketplacePlan) GetAccountsURL() string {
+	if m == nil || m.AccountsURL == nil {
+		return ""
+	}
+	return *m.AccountsURL
+}
+
+// GetBullets returns the Bullets field if it's non-nil, zero value otherwise.
+func (m *MarketplacePlan) GetBullets() []string {
+	if m == nil || m.Bullets == nil {
+		return nil
+	}
+	return *m.Bullets
+}
+
+// GetDescription returns the Description field if it's non-nil, zero value otherwise.
+func (m *MarketplacePlan) GetDescription() string {
+	if m == nil || m.Description == nil {
+		return ""
+	}
+	return *m.Description
+}
+
+// GetHasFreeTrial returns the HasFreeTrial field if it's non-nil, zero value otherwise.
+func (m *MarketplacePlan) GetHasFreeTrial() bool {
+	if m == nil || m.HasFreeTrial == nil {
+		return false
+	}
+	return *m.HasFreeTrial
+}
+
+// GetID returns the ID field if it's non-nil, zero value otherwise.
+func (m *MarketplacePlan) GetID() int64 {
+	if m == nil || m.ID == nil {
+		return 0
+	}
+	return *m.ID
+}
+
+// GetMonthlyPriceInCents returns the MonthlyPriceInCents field if it's non-nil, zero value otherwise.
+func (m *MarketplacePlan) GetMonthlyPriceInCents() int {
+	if m == nil || m.MonthlyPriceInCents == nil {
+		return 0
+	}
+	return *m.MonthlyPriceInCents
+}
+
+// GetName returns the Name field if it's non-nil, zero value otherwise.
+func (m *MarketplacePlan) GetName() string {
+	if m == nil || m.Name == nil {
+		return ""
+	}
+	return *m.Name
+}
+
+// GetPriceModel returns the PriceModel field if it's non-nil, zero value otherwise.
+func (m *MarketplacePlan) GetPriceModel() string {
+	if m == nil || m.PriceModel == nil {
+		return ""
+	}
+	return *m.PriceModel
+}
+
+// GetState returns the State field if it's non-nil, zero value otherwise.
+func (m *MarketplacePlan) GetState() string {
+	if m == nil || m.State == nil {
+		return ""
+	}
+	return *m.State
+}
+
+// GetUnitName returns the UnitName field if it's non-nil, zero value otherwise.
+func (m *MarketplacePlan) GetUnitName() string {
+	if m == nil || m.UnitName == nil {
+		return ""
+	}
+	return *m.UnitName
+}
+
+// GetURL returns the URL field if it's non-nil, zero value otherwise.
+func (m *MarketplacePlan) GetURL() string {
+	if m == nil || m.URL == nil {
+		return ""
+	}
+	return *m.URL
+}
+
+// GetYearlyPriceInCents returns the YearlyPriceInCents field if it's non-nil, zero value otherwise.
+func (m *MarketplacePlan) GetYearlyPriceInCents() int {
+	if m == nil || m.YearlyPriceInCents == nil {
+		return 0
+	}
+	return *m.YearlyPriceInCents
+}
+
+// GetEmail returns the Email field if it's non-nil, zero value otherwise.
+func (m *MarketplacePlanAccount) GetEmail() string {
+	if m == nil || m.Email == nil {
+		return ""
+	}
+	return *m.Email
+}
+
+// GetID returns the ID field if it's non-nil, zero value otherwise.
+func (m *MarketplacePlanAccount) GetID() int64 {
+	if m == nil || m.ID == nil {
+		return 0
+	}
+	return *m.ID
+}
+
+// GetLogin returns the Login field if it's non-nil, zero value otherwise.
+func (m *MarketplacePlanAccount) GetLogin() string {
+	if m == nil || m.Login == nil {
+		return ""
+	}
+	return *m.Login
+}
+
+// GetMarketplacePendingChange returns the MarketplacePendingChange field.
+func (m *MarketplacePlanAccount) GetMarketplacePendingChange() *MarketplacePendingChange {
+	if m == nil {
+		return nil
+	}
+	return m.MarketplacePendingChange
+}
+
+// GetMarketplacePurchase returns the MarketplacePurchase field.
+func (m *MarketplacePlanAccount) GetMarketplacePurchase() *MarketplacePurchase {
+	if m == nil {
+		return nil
+	}
+	return m.MarketplacePurchase
+}
+
+// GetNodeID returns the NodeID field if it's non-nil, zero value otherwise.
+func (m *MarketplacePlanAccount) GetNodeID() string {
+	if m == nil || m.NodeID == nil {
+		return ""
+	}
+	return *m.NodeID
+}
+
+// GetOrganizationBillingEmail returns the OrganizationBillingEmail field if it's non-nil, zero value otherwise.
+func (m *MarketplacePlanAccount) GetOrganizationBillingEmail() string {
+	if m == nil || m.OrganizationBillingEmail == nil {
+		return ""
+	}
+	return *m.OrganizationBillingEmail
+}
+
+// GetType returns the Type field if it's non-nil, zero value otherwise.
+func (m *MarketplacePlanAccount) GetType() string {
+	if m == nil || m.Type == nil {
+		return ""
+	}
+	return *m.Type
+}
+
+// GetURL returns the URL field if it's non-nil, zero value otherwise.
+func (m *MarketplacePlanAccount) GetURL() string {
+	if m == nil || m.URL == nil {
+		return ""
+	}
+	return *m.URL
+}
+
+// GetAccount returns the Account field.
+func (m *MarketplacePurchase) GetAccount() *MarketplacePlanAccount {
+	if m == nil {
+		return nil
+	}
+	return m.Account
+}
+
+// GetBillingCycle returns the BillingCycle field if it's non-nil, zero value otherwise.
+func (m *MarketplacePurchase) GetBillingCycle() string {
+	if m == nil || m.BillingCycle == nil {
+		return ""
+	}
+	return *m.BillingCycle
+}
+
+// GetFreeTrialEndsOn returns the FreeTrialEndsOn field if it's non-nil, zero value otherwise.
+func (m *MarketplacePurchase) GetFreeTrialEndsOn() Timestamp {
+	if m == nil || m.FreeTrialEndsOn == nil {
+		return Timestamp{}
+	}
+	return *m.FreeTrialEndsOn
+}
+
+// GetNextBillingDate returns the NextBillingDate field if it's non-nil, zero value otherwise.
+func (m *MarketplacePurchase) GetNextBillingDate() Timestamp {
+	if m == nil || m.NextBillingDate == nil {
+		return Timestamp{}
+	}
+	return *m.NextBillingDate
+}
+
+// GetOnFreeTrial returns the OnFreeTrial field if it's non-nil, zero value otherwise.
+func (m *MarketplacePurchase) GetOnFreeTrial() bool {
+	if m == nil || m.OnFreeTrial == nil {
+		return false
+	}
+	return *m.OnFreeTrial
+}
+
+// GetPlan returns the Plan field.
+func (m *MarketplacePurchase) GetPlan() *MarketplacePlan {
+	if m == nil {
+		return nil
+	}
+	return m.Plan
+}
+
+// GetUnitCount returns the UnitCount field if it's non-nil, zero value otherwise.
+func (m *MarketplacePurchase) GetUnitCount() int {
+	if m == nil || m.UnitCount == nil {
+		return 0
+	}
+	return *m.UnitCount
+}
+
+// GetAction returns the Action field if it's non-nil, zero value otherwise.
+func (m *MarketplacePurchaseEvent) GetAction() string {
+	if m == nil || m.Action == nil {
+		return ""
+	}
+	return *m.Action
+}
+
+// GetEffectiveDate returns the EffectiveDate field if it's non-nil, zero value otherwise.
+func (m *MarketplacePurchaseEvent) GetEffectiveDate() Timestamp {
+	if m == nil || m.EffectiveDate == nil {
+		return Timestamp{}
+	}
+	return *m.EffectiveDate
+}
+
+// GetInstallation returns the Installation field.
+func (m *MarketplacePurchaseEvent) GetInstallation() *Installation {
+	if m == nil {
+		return nil
+	}
+	return m.Installation
+}
+
+// GetMarketplacePurchase returns the MarketplacePurchase field.
+func (m *MarketplacePurchaseEvent) GetMarketplacePurchase() *MarketplacePurchase {
+	if m == nil {
+		return nil
+	}
+	return m.MarketplacePurchase
+}
+
+// GetPreviousMarketplacePurchase returns the PreviousMarketplacePurchase field.
+func (m *MarketplacePurchaseEvent) GetPreviousMarketplacePurchase() *MarketplacePurchase {
+	if m == nil {
+		return nil
+	}
+	return m.PreviousMarketplacePurchase
+}
+
+// GetSender returns the Sender field.
+func (m *MarketplacePurchaseEvent) GetSender() *User {
+	if m == nil {
+		return nil
+	}
+	return m.Sender
+}
+
+// GetText returns the Text field if it's non-nil, zero value otherwise.
+func (m *Match) GetText() string {
+	if m == nil || m.Text == nil {
+		return ""
+	}
+	return *m.Text
+}
+
+// GetAction returns the Action field if it's non-nil, zero value otherwise.
+func (m *MemberEvent) GetAction() string {
+	if m == nil || m.Action == nil {
+		return ""
+	}
+	return *m.Action
+}
+
+// GetInstallation returns the Installation field.
+func (m *MemberEvent) GetInstallation() *Installation {
+	if m == nil {
+		return nil
+	}
+	return m.Installation
+}
+
+// GetMember returns the Member field.
+func (m *MemberEvent) GetMember() *User {
+	if m == nil {
+		return nil
+	}
+	return m.Member
+}
+
+// GetRepo returns the Repo field.
+func (m *MemberEvent) GetRepo() *Repository {
+	if m == nil {
+		return nil
+	}
+	return m.Repo
+}
+
+// GetSender returns the Sender field.
+func (m *MemberEvent) GetSender() *User {
+	if m == nil {
+		return nil
+	}
+	return m.Sender
+}
+
+// GetOrganization returns the Organization field.
+func (m *Membership) GetOrganization() *Organization {
+	if m == nil {
+		return nil
+	}
+	return m.Organization
+}
+
+// GetOrganizationURL returns the OrganizationURL field if it's non-nil, zero value otherwise.
+func (m *Membership) GetOrganizationURL() string {
+	if m == nil || m.OrganizationURL == nil {
+		return ""
+	}
+	return *m.OrganizationURL
+}
+
+// GetRole returns the Role field if it's non-nil, zero value otherwise.
+func (m *Membership) GetRole() string {
+	if m == nil || m.Role == nil {
+		return ""
+	}
+	return *m.Role
+}
+
+// GetState returns the State field if it's non-nil, zero value otherwise.
+func (m *Membership) GetState() string {
+	if m == nil || m.State == nil {
+		return ""
+	}
+	return *m.State
+}
+
+// GetURL returns the URL field if it's non-nil, zero value otherwise.
+func (m *Membership) GetURL() string {
+	if m == nil || m.URL == nil {
+		return ""
+	}
+	return *m.URL
+}
+
+// GetUser returns the User field.
+func (m *Membership) GetUser() *User {
+	if m == nil {
+		return nil
+	}
+	return m.User
+}
+
+// GetAction returns the Action field if it's non-nil, zero value otherwise.
+func (m *MembershipEvent) GetAction() string {
+	if m == nil || m.Action == nil {
+		return ""
+	}
+	return *m.Action
+}
+
+// GetInstallation returns the Installation field.
+func (m *MembershipEvent) GetInstallation() *Installation {
+	if m == nil {
+		return nil
+	}
+	return m.Installation
+}
+
+// GetMember returns the Member field.
+func (m *MembershipEvent) GetMember() *User {
+	if m == nil {
+		return nil
+	}
+	return m.Member
+}
+
+// GetOrg returns the Org field.
+func (m *MembershipEvent) GetOrg() *Organization {
+	if m == nil {
+		return nil
+	}
+	return m.Org
+}
+
+// GetScope returns the Scope field if it's non-nil, zero value otherwise.
+func (m *MembershipEvent) GetScope() string {
+	if m == nil || m.Scope == nil {
+		return ""
+	}
+	return *m.Scope
+}
+
+// GetSender returns the Sender field.
+func (m *MembershipEvent) GetSender() *User {
+	if m == nil {
+		return nil
+	}
+	return m.Sender
+}
+
+// GetTeam returns the Team field.
+func (m *MembershipEvent) GetTeam() *Team {
+	if m == nil {
+		return nil
+	}
+	return m.Team
+}
+
+// GetBaseRef returns the BaseRef field if it's non-nil, zero value otherwise.
+func (m *MergeGroup) GetBaseRef() string {
+	if m == nil || m.BaseRef == nil {
+		return ""
+	}
+	return *m.BaseRef
+}
+
+// GetBaseSHA returns the BaseSHA field if it's non-nil, zero value otherwise.
+func (m *MergeGroup) GetBaseSHA() string {
+	if m == nil || m.BaseSHA == nil {
+		return ""
+	}
+	return *m.BaseSHA
+}
+
+// GetHeadCommit returns the HeadCommit field.
+func (m *MergeGroup) GetHeadCommit() *HeadCommit {
+	if m == nil {
+		return nil
+	}
+	return m.HeadCommit
+}
+
+// GetHeadRef returns the HeadRef field if it's non-nil, zero value otherwise.
+func (m *MergeGroup) GetHeadRef() string {
+	if m == nil || m.HeadRef == nil {
+		return ""
+	}
+	return *m.HeadRef
+}
+
+// GetHeadSHA returns the HeadSHA field if it's non-nil, zero value otherwise.
+func (m *MergeGroup) GetHeadSHA() string {
+	if m == nil || m.HeadSHA == nil {
+		return ""
+	}
+	return *m.HeadSHA
+}
+
+// GetAction returns the Action field if it's non-nil, zero value otherwise.
+func (m *MergeGroupEvent) GetAction() string {
+	if m == nil || m.Action == nil {
+		return ""
+	}
+	return *m.Action
+}
+
+// GetInstallation returns the Installation field.
+func (m *MergeGroupEvent) GetInstallation() *Installation {
+	if m == nil {
+		return nil
+	}
+	return m.Installation
+}
+
+// GetMergeGroup returns the MergeGroup field.
+func (m *MergeGroupEvent) GetMergeGroup() *MergeGroup {
+	if m == nil {
+		return nil
+	}
+	return m.MergeGroup
+}
+
+// GetOrg returns the Org field.
+func (m *MergeGroupEvent) GetOrg() *Organization {
+	if m == nil {
+		return nil
+	}
+	return m.Org
+}
+
+// GetReason returns the Reason field if it's non-nil, zero value otherwise.
+func (m *MergeGroupEvent) GetReason() string {
+	if m == nil || m.Reason == nil {
+		return ""
+	}
+	return *m.Reason
+}
+
+// GetRepo returns the Repo field.
+func (m *MergeGroupEvent) GetRepo() *Repository {
+	if m == nil {
+		return nil
+	}
+	return m.Repo
+}
+
+// GetSender returns the Sender field.
+func (m *MergeGroupEvent) GetSender() *User {
+	if m == nil {
+		return nil
+	}
+	return m.Sender
+}
+
+// GetBaseSHA returns the BaseSHA field if it's non-nil, zero value otherwise.
+func (m *MergeQueueEntry) GetBaseSHA() string {
+	if m == nil || m.BaseSHA == nil {
+		return ""
+	}
+	return *m.BaseSHA
+}
+
+// GetEnqueuedAt returns the EnqueuedAt field if it's non-nil, zero value otherwise.
+func (m *MergeQueueEntry) GetEnqueuedAt() time.Time {
+	if m == nil || m.EnqueuedAt == nil {
+		return time.Time{}
+	}
+	return *m.EnqueuedAt
+}
+
+// GetEnqueuer returns the Enqueuer field.
+func (m *MergeQueueEntry) GetEnqueuer() *User {
+	if m == nil {
+		return nil
+	}
+	return m.Enqueuer
+}
+
+// GetEstimatedTimeToMerge returns the EstimatedTimeToMerge field if it's non-nil, zero value otherwise.
+func (m *MergeQueueEntry) GetEstimatedTimeToMerge() int {
+	if m == nil || m.EstimatedTimeToMerge == nil {
+		return 0
+	}
+	return *m.EstimatedTimeToMerge
+}
+
+// GetHeadSHA returns the HeadSHA field if it's non-nil, zero value otherwise.
+func (m *MergeQueueEntry) GetHeadSHA() string {
+	if m == nil || m.HeadSHA == nil {
+		return ""
+	}
+	return *m.HeadSHA
+}
+
+// GetID returns the ID field if it's non-nil, zero value otherwise.
+func (m *MergeQueueEntry) GetID() string {
+	if m == nil || m.ID == nil {
+		return ""
+	}
+	return *m.ID
+}
+
+// GetJump returns the Jump field if it's non-nil, zero value otherwise.
+func (m *MergeQueueEntry) GetJump() bool {
+	if m == nil || m.Jump == nil {
+		return false
+	}
+	return *m.Jump
+}
+
+// GetPosition returns the Position field if it's non-nil, zero value otherwise.
+func (m *MergeQueueEntry) GetPosition() int {
+	if m == nil || m.Position == nil {
+		return 0
+	}
+	return *m.Position
+}
+
+// GetPullRequest returns the PullRequest field.
+func (m *MergeQueueEntry) GetPullRequest() *PullRequest {
+	if m == nil {
+		return nil
+	}
+	return m.PullRequest
+}
+
+// GetSolo returns the Solo field if it's non-nil, zero value otherwise.
+func (m *MergeQueueEntry) GetSolo() bool {
+	if m == nil || m.Solo == nil {
+		return false
+	}
+	return *m.Solo
+}
+
+// GetState returns the State field if it's non-nil, zero value otherwise.
+func (m *MergeQueueEntry) GetState() string {
+	if m == nil || m.State == nil {
+		return ""
+	}
+	return *m.State
+}
+
+// GetAction returns the Action field if it's non-nil, zero value otherwise.
+func (m *MetaEvent) GetAction() string {
+	if m == nil || m.Action == nil {
+		return ""
+	}
+	return *m.Action
+}
+
+// GetHook returns the Hook field.
+func (m *MetaEvent) GetHook() *Hook {
+	if m == nil {
+		return nil
+	}
+	return m.Hook
+}
+
+// GetHookID returns the HookID field if it's non-nil, zero value otherwise.
+func (m *MetaEvent) GetHookID() int64 {
+	if m == nil || m.HookID == nil {
+		return 0
+	}
+	return *m.HookID
+}
+
+// GetHTMLURL returns the HTMLURL field if it's non-nil, zero value otherwise.
+func (m *Metric) GetHTMLURL() string {
+	if m == nil || m.HTMLURL == nil {
+		return ""
+	}
+	return *m.HTMLURL
+}
+
+// GetKey returns the Key field if it's non-nil, zero value otherwise.
+func (m *Metric) GetKey() string {
+	if m == nil || m.Key == nil {
+		return ""
+	}
+	return *m.Key
+}
+
+// GetName returns the Name field if it's non-nil, zero value otherwise.
+func (m *Metric) GetName() string {
+	if m == nil || m.Name == nil {
+		return ""
+	}
+	return *m.Name
+}
+
+// GetURL returns the URL field if it's non-nil, zero value otherwise.
+func (m *Metric) GetURL() string {
+	if m == nil || m.URL == nil {
+		return ""
+	}
+	return *m.URL
+}
+
+// GetCreatedAt returns the CreatedAt field if it's non-nil, zero value otherwise.
+func (m *Migration) GetCreatedAt() string {
+	if m == nil || m.CreatedAt == nil {
+		return ""
+	}
+	return *m.CreatedAt
+}
+
+// GetExcludeAttachments returns the ExcludeAttachments field if it's non-nil, zero value otherwise.
+func (m *Migration) GetExcludeAttachments() bool {
+	if m == nil || m.ExcludeAttachments == nil {
+		return false
+	}
+	return *m.ExcludeAttachments
+}
+
+// GetGUID returns the GUID field if it's non-nil, zero value otherwise.
+func (m *Migration) GetGUID() string {
+	if m == nil || m.GUID == nil {
+		return ""
+	}
+	return *m.GUID
+}
+
+// GetID returns the ID field if it's non-nil, zero value otherwise.
+func (m *Migration) GetID() int64 {
+	if m == nil || m.ID == nil {
+		return 0
+	}
+	return *m.ID
+}
+
+// GetLockRepositories returns the LockRepositories field if it's non-nil, zero value otherwise.
+func (m *Migration) GetLockRepositories() bool {
+	if m == nil || m.LockRepositories == nil {
+		return false
+	}
+	return *m.LockRepositories
+}
+
+// GetState returns the State field if it's non-nil, zero value otherwise.
+func (m *Migration) GetState() string {
+	if m == nil || m.State == nil {
+		return ""
+	}
+	return *m.State
+}
+
+// GetUpdatedAt returns the UpdatedAt field if it's non-nil, zero value otherwise.
+func (m *Migration) GetUpdatedAt() string {
+	if m == nil || m.UpdatedAt == nil {
+		return ""
+	}
+	return *m.UpdatedAt
+}
+
+// GetURL returns the URL field if it's non-nil, zero value otherwise.
+func (m *Migration) GetURL() string {
+	if m == nil || m.URL == nil {
+		return ""
+	}
+	return *m.URL
+}
+
+// GetClosedAt returns the ClosedAt field if it's non-nil, zero value otherwise.
+func (m *Milestone) GetClosedAt() time.Time {
+	if m == nil || m.ClosedAt == nil {
+		return time.Time{}
+	}
+	return *m.ClosedAt
+}
+
+// GetClosedIssues returns the ClosedIssues field if it's non-nil, zero value otherwise.
+func (m *Milestone) GetClosedIssues() int {
+	if m == nil || m.ClosedIssues == nil {
+		return 0
+	}
+	return *m.ClosedIssues
+}
+
+// GetCreatedAt returns the CreatedAt field if it's non-nil, zero value otherwise.
+func (m *Milestone) GetCreatedAt() time.Time {
+	if m == nil || m.CreatedAt == nil {
+		return time.Time{}
+	}
+	return *m.CreatedAt
+}
+
+// GetCreator returns the Creator field.
+func (m *Milestone) GetCreator() *User {
+	if m == nil {
+		return nil
+	}
+	return m.Creator
+}
+
+// GetDescription returns the Description field if it's non-nil, zero value otherwise.
+func (m *Milestone) GetDescription() string {
+	if m == nil || m.Description == nil {
+		return ""
+	}
+	return *m.Description
+}
+
+// GetDueOn returns the DueOn field if it's non-nil, zero value otherwise.
+func (m *Milestone) GetDueOn() time.Time {
+	if m == nil || m.DueOn == nil {
+		return time.Time{}
+	}
+	return *m.DueOn
+}
+
+// GetHTMLURL returns the HTMLURL field if it's non-nil, zero value otherwise.
+func (m *Milestone) GetHTMLURL() string {
+	if m == nil || m.HTMLURL == nil {
+		return ""
+	}
+	return *m.HTMLURL
+}
+
+// GetID returns the ID field if it's non-nil, zero value otherwise.
+func (m *Milestone) GetID() int64 {
+	if m == nil || m.ID == nil {
+		return 0
+	}
+	return *m.ID
+}
+
+// GetLabelsURL returns the LabelsURL field if it's non-nil, zero value otherwise.
+func (m *Milestone) GetLabelsURL() string {
+	if m == nil || m.LabelsURL == nil {
+		return ""
+	}
+	return *m.LabelsURL
+}
+
+// GetNodeID returns the NodeID field if it's non-nil, zero value otherwise.
+func (m *Milestone) GetNodeID() string {
+	if m == nil || m.NodeID == nil {
+		return ""
+	}
+	return *m.NodeID
+}
+
+// GetNumber returns the Number field if it's non-nil, zero value otherwise.
+func (m *Milestone) GetNumber() int {
+	if m == nil || m.Number == nil {
+		return 0
+	}
+	return *m.Number
+}
+
+// GetOpenIssues returns the OpenIssues field if it's non-nil, zero value otherwise.
+func (m *Milestone) GetOpenIssues() int {
+	if m == nil || m.OpenIssues == nil {
+		return 0
+	}
+	return *m.OpenIssues
+}
+
+// GetState returns the State field if it's non-nil, zero value otherwise.
+func (m *Milestone) GetState() string {
+	if m == nil || m.State == nil {
+		return ""
+	}
+	return *m.State
+}
+
+// GetTitle returns the Title field if it's non-nil, zero value otherwise.
+func (m *Milestone) GetTitle() string {
+	if m == nil || m.Title == nil {
+		return ""
+	}
+	return *m.Title
+}
+
+// GetUpdatedAt returns the UpdatedAt field if it's non-nil, zero value otherwise.
+func (m *Milestone) GetUpdatedAt() time.Time {
+	if m == nil || m.UpdatedAt == nil {
+		return time.Time{}
+	}
+	return *m.UpdatedAt
+}
+
+// GetURL returns the URL field if it's non-nil, zero value otherwise.
+func (m *Milestone) GetURL() string {
+	if m == nil || m.URL == nil {
+		return ""
+	}
+	return *m.URL
+}
+
+// GetAction returns the Action field if it's non-nil, zero value otherwise.
+func (m *MilestoneEvent) GetAction() string {
+	if m == nil || m.Action == nil {
+		return ""
+	}
+	return *m.Action
+}
+
+// GetChanges returns the Changes field.
+func (m *MilestoneEvent) GetChanges() *EditChange {
+	if m == nil {
+		return nil
+	}
+	return m.Changes
+}
+
+// GetInstallation returns the Installation field.
+func (m *MilestoneEvent) GetInstallation() *Installation {
+	if m == nil {
+		return nil
+	}
+	return m.Installation
+}
+
+// GetMilestone returns the Milestone field.
+func (m *MilestoneEvent) GetMilestone() *Milestone {
+	if m == nil {
+		return nil
+	}
+	return m.Milestone
+}
+
+// GetOrg returns the Org field.
+func (m *MilestoneEvent) GetOrg() *Organization {
+	if m == nil {
+		return nil
+	}
+	return m.Org
+}
+
+// GetRepo returns the Repo field.
+func (m *MilestoneEvent) GetRepo() *Repository {
+	if m == nil {
+		return nil
+	}
+	return m.Repo
+}
+
+// GetSender returns the Sender field.
+func (m *MilestoneEvent) GetSender() *User {
+	if m == nil {
+		return nil
+	}
+	return m.Sender
+}
+
+// GetClosedMilestones returns the ClosedMilestones field if it's non-nil, zero value otherwise.
+func (m *MilestoneStats) GetClosedMilestones() int {
+	if m == nil || m.ClosedMilestones == nil {
+		return 0
+	}
+	return *m.ClosedMilestones
+}
+
+// GetOpenMilestones returns the OpenMilestones field if it's non-nil, zero value otherwise.
+func (m *MilestoneStats) GetOpenMilestones() int {
+	if m == nil || m.OpenMilestones == nil {
+		return 0
+	}
+	return *m.OpenMilestones
+}
+
+// GetTotalMilestones returns the TotalMilestones field if it's non-nil, zero value otherwise.
+func (m *MilestoneStats) GetTotalMilestones() int {
+	if m == nil || m.TotalMilestones == nil {
+		return 0
+	}
+	return *m.TotalMilestones
+}
+
+// GetBase returns the Base field if it's non-nil, zero value otherwise.
+func (n *NewPullRequest) GetBase() string {
+	if n == nil || n.Base == nil {
+		return ""
+	}
+	return *n.Base
+}
+
+// GetBody returns the Body field if it's non-nil, zero value otherwise.
+func (n *NewPullRequest) GetBody() string {
+	if n == nil || n.Body == nil {
+		return ""
+	}
+	return *n.Body
+}
+
+// GetDraft returns the Draft field if it's non-nil, zero value otherwise.
+func (n *NewPullRequest) GetDraft() bool {
+	if n == nil || n.Draft == nil {
+		return false
+	}
+	return *n.Draft
+}
+
+// GetHead returns the Head field if it's non-nil, zero value otherwise.
+func (n *NewPullRequest) GetHead() string {
+	if n == nil || n.Head == nil {
+		return ""
+	}
+	return *n.Head
+}
+
+// GetIssue returns the Issue field if it's non-nil, zero value otherwise.
+func (n *NewPullRequest) GetIssue() int {
+	if n == nil || n.Issue == nil {
+		return 0
+	}
+	return *n.Issue
+}
+
+// GetMaintainerCanModify returns the MaintainerCanModify field if it's non-nil, zero value otherwise.
+func (n *NewPullRequest) GetMaintainerCanModify() bool {
+	if n == nil || n.MaintainerCanModify == nil {
+		return false
+	}
+	return *n.MaintainerCanModify
+}
+
+// GetTitle returns the Title field if it's non-nil, zero value otherwise.
+func (n *NewPullRequest) GetTitle() string {
+	if n == nil || n.Title == nil {
+		return ""
+	}
+	return *n.Title
+}
+
+// GetDescription returns the Description field if it's non-nil, zero value otherwise.
+func (n *NewTeam) GetDescription() string {
+	if n == nil || n.Description == nil {
+		return ""
+	}
+	return *n.Description
+}
+
+// GetLDAPDN returns the LDAPDN field if it's non-nil, zero value otherwise.
+func (n *NewTeam) GetLDAPDN() string {
+	if n == nil || n.LDAPDN == nil {
+		return ""
+	}
+	return *n.LDAPDN
+}
+
+// GetParentTeamID returns the ParentTeamID field if it's non-nil, zero value otherwise.
+func (n *NewTeam) GetParentTeamID() int64 {
+	if n == nil || n.ParentTeamID == nil {
+		return 0
+	}
+	return *n.ParentTeamID
+}
+
+// GetPermission returns the Permission field if it's non-nil, zero value otherwise.
+func (n *NewTeam) GetPermission() string {
+	if n == nil || n.Permission == nil {
+		return ""
+	}
+	return *n.Permission
+}
+
+// GetPrivacy returns the Privacy field if it's non-nil, zero value otherwise.
+func (n *NewTeam) GetPrivacy() string {
+	if n == nil || n.Privacy == nil {
+		return ""
+	}
+	return *n.Privacy
+}
+
+// GetID returns the ID field if it's non-nil, zero value otherwise.
+func (n *Notification) GetID() string {
+	if n == nil || n.ID == nil {
+		return ""
+	}
+	return *n.ID
+}
+
+// GetLastReadAt returns the LastReadAt field if it's non-nil, zero value otherwise.
+func (n *Notification) GetLastReadAt() time.Time {
+	if n == nil || n.LastReadAt == nil {
+		return time.Time{}
+	}
+	return *n.LastReadAt
+}
+
+// GetReason returns the Reason field if it's non-nil, zero value otherwise.
+func (n *Notification) GetReason() string {
+	if n == nil || n.Reason == nil {
+		return ""
+	}
+	return *n.Reason
+}
+
+// GetRepository returns the Repository field.
+func (n *Notification) GetRepository() *Repository {
+	if n == nil {
+		return nil
+	}
+	return n.Repository
+}
+
+// GetSubject returns the Subject field.
+func (n *Notification) GetSubject() *NotificationSubject {
+	if n == nil {
+		return nil
+	}
+	return n.Subject
+}
+
+// GetUnread returns the Unread field if it's non-nil, zero value otherwise.
+func (n *Notification) GetUnread() bool {
+	if n == nil || n.Unread == nil {
+		return false
+	}
+	return *n.Unread
+}
+
+// GetUpdatedAt returns the UpdatedAt field if it's non-nil, zero value otherwise.
+func (n *Notification) GetUpdatedAt() time.Time {
+	if n == nil || n.UpdatedAt == nil {
+		return time.Time{}
+	}
+	return *n.UpdatedAt
+}
+
+// GetURL returns the URL field if it's non-nil, zero value otherwise.
+func (n *Notification) GetURL() string {
+	if n == nil || n.URL == nil {
+		return ""
+	}
+	return *n.URL
+}
+
+// GetLatestCommentURL returns the LatestCommentURL field if it's non-nil, zero value otherwise.
+func (n *NotificationSubject) GetLatestCommentURL() string {
+	if n == nil || n.LatestCommentURL == nil {
+		return ""
+	}
+	return *n.LatestCommentURL
+}
+
+// GetTitle returns the Title field if it's non-nil, zero value otherwise.
+func (n *NotificationSubject) GetTitle() string {
+	if n == nil || n.Title == nil {
+		return ""
+	}
+	return *n.Title
+}
+
+// GetType returns the Type field if it's non-nil, zero value otherwise.
+func (n *NotificationSubject) GetType() string {
+	if n == nil || n.Type == nil {
+		return ""
+	}
+	return *n.Type
+}
+
+// GetURL returns the URL field if it's non-nil, zero value otherwise.
+func (n *NotificationSubject) GetURL() string {
+	if n == nil || n.URL == nil {
+		return ""
+	}
+	return *n.URL
+}
+
+// GetClientID returns the ClientID field if it's non-nil, zero value otherwise.
+func (o *OAuthAPP) GetClientID() string {
+	if o == nil || o.ClientID == nil {
+		return ""
+	}
+	return *o.ClientID
+}
+
+// GetName returns the Name field if it's non-nil, zero value otherwise.
+func (o *OAuthAPP) GetName() string {
+	if o == nil || o.Name == nil {
+		return ""
+	}
+	return *o.Name
+}
+
+// GetURL returns the URL field if it's non-nil, zero value otherwise.
+func (o *OAuthAPP) GetURL() string {
+	if o == nil || o.URL == nil {
+		return ""
+	}
+	return *o.URL
+}
+
+// GetExpiry returns the Expiry field if it's non-nil, zero value otherwise.
+func (o *OAuthToken) GetExpiry() Timestamp {
+	if o == nil || o.Expiry == nil {
+		return Timestamp{}
+	}
+	return *o.Expiry
+}
+
+// GetRefreshTokenExpiry returns the RefreshTokenExpiry field if it's non-nil, zero value otherwise.
+func (o *OAuthToken) GetRefreshTokenExpiry() Timestamp {
+	if o == nil || o.RefreshTokenExpiry == nil {
+		return Timestamp{}
+	}
+	return *o.RefreshTokenExpiry
+}
+
+// GetAvatarURL returns the AvatarURL field if it's non-nil, zero value otherwise.
+func (o *Organization) GetAvatarURL() string {
+	if o == nil || o.AvatarURL == nil {
+		return ""
+	}
+	return *o.AvatarURL
+}
+
+// GetBillingEmail returns the BillingEmail field if it's non-nil, zero value otherwise.
+func (o *Organization) GetBillingEmail() string {
+	if o == nil || o.BillingEmail == nil {
+		return ""
+	}
+	return *o.BillingEmail
+}
+
+// GetBlog returns the Blog field if it's non-nil, zero value otherwise.
+func (o *Organization) GetBlog() string {
+	if o == nil || o.Blog == nil {
+		return ""
+	}
+	return *o.Blog
+}
+
+// GetCollaborators returns the Collaborators field if it's non-nil, zero value otherwise.
+func (o *Organization) GetCollaborators() int {
+	if o == nil || o.Collaborators == nil {
+		return 0
+	}
+	return *o.Collaborators
+}
+
+// GetCompany returns the Company field if it's non-nil, zero value otherwise.
+func (o *Organization) GetCompany() string {
+	if o == nil || o.Company == nil {
+		return ""
+	}
+	return *o.Company
+}
+
+// GetCreatedAt returns the CreatedAt field if it's non-nil, zero value otherwise.
+func (o *Organization) GetCreatedAt() time.Time {
+	if o == nil || o.CreatedAt == nil {
+		return time.Time{}
+	}
+	return *o.CreatedAt
+}
+
+// GetDefaultRepoPermission returns the DefaultRepoPermission field if it's non-nil, zero value otherwise.
+func (o *Organization) GetDefaultRepoPermission() string {
+	if o == nil || o.DefaultRepoPermission == nil {
+		return ""
+	}
+	return *o.DefaultRepoPermission
+}
+
+// GetDefaultRepoSettings returns the DefaultRepoSettings field if it's non-nil, zero value otherwise.
+func (o *Organization) GetDefaultRepoSettings() string {
+	if o == nil || o.DefaultRepoSettings == nil {
+		return ""
+	}
+	return *o.DefaultRepoSettings
+}
+
+// GetDescription returns the Description field if it's non-nil, zero value otherwise.
+func (o *Organization) GetDescription() string {
+	if o == nil || o.Description == nil {
+		return ""
+	}
+	return *o.Description
+}
+
+// GetDiskUsage returns the DiskUsage field if it's non-nil, zero value otherwise.
+func (o *Organization) GetDiskUsage() int {
+	if o == nil || o.DiskUsage == nil {
+		return 0
+	}
+	return *o.DiskUsage
+}
+
+// GetEmail returns the Email field if it's non-nil, zero value otherwise.
+func (o *Organization) GetEmail() string {
+	if o == nil || o.Email == nil {
+		return ""
+	}
+	return *o.Email
+}
+
+// GetEventsURL returns the EventsURL field if it's non-nil, zero value otherwise.
+func (o *Organization) GetEventsURL() string {
+	if o == nil || o.EventsURL == nil {
+		return ""
+	}
+	return *o.EventsURL
+}
+
+// GetFollowers returns the Followers field if it's non-nil, zero value otherwise.
+func (o *Organization) GetFollowers() int {
+	if o == nil || o.Followers == nil {
+		return 0
+	}
+	return *o.Followers
+}
+
+// GetFollowing returns the Following field if it's non-nil, zero value otherwise.
+func (o *Organization) GetFollowing() int {
+	if o == nil || o.Following == nil {
+		return 0
+	}
+	return *o.Following
+}
+
+// GetHasOrganizationProjects returns the HasOrganizationProjects field if it's non-nil, zero value otherwise.
+func (o *Organization) GetHasOrganizationProjects() bool {
+	if o == nil || o.HasOrganizationProjects == nil {
+		return false
+	}
+	return *o.HasOrganizationProjects
+}
+
+// GetHasRepositoryProjects returns the HasRepositoryProjects field if it's non-nil, zero value otherwise.
+func (o *Organization) GetHasRepositoryProjects() bool {
+	if o == nil || o.HasRepositoryProjects == nil {
+		return false
+	}
+	return *o.HasRepositoryProjects
+}
+
+// GetHooksURL returns the HooksURL field if it's non-nil, zero value otherwise.
+func (o *Organization) GetHooksURL() string {
+	if o == nil || o.HooksURL == nil {
+		return ""
+	}
+	return *o.HooksURL
+}
+
+// GetHTMLURL returns the HTMLURL field if it's non-nil, zero value otherwise.
+func (o *Organization) GetHTMLURL() string {
+	if o == nil || o.HTMLURL == nil {
+		return ""
+	}
+	return *o.HTMLURL
+}
+
+// GetID returns the ID field if it's non-nil, zero value otherwise.
+func (o *Organization) GetID() int64 {
+	if o == nil || o.ID == nil {
+		return 0
+	}
+	return *o.ID
+}
+
+// GetIssuesURL returns the IssuesURL field if it's non-nil, zero value otherwise.
+func (o *Organization) GetIssuesURL() string {
+	if o == nil || o.IssuesURL == nil {
+		return ""
+	}
+	return *o.IssuesURL
+}
+
+// GetIsVerified returns the IsVerified field if it's non-nil, zero value otherwise.
+func (o *Organization) GetIsVerified() bool {
+	if o == nil || o.IsVerified == nil {
+		return false
+	}
+	return *o.IsVerified
+}
+
+// GetLocation returns the Location field if it's non-nil, zero value otherwise.
+func (o *Organization) GetLocation() string {
+	if o == nil || o.Location == nil {
+		return ""
+	}
+	return *o.Location
+}
+
+// GetLogin returns the Login field if it's non-nil, zero value otherwise.
+func (o *Organization) GetLogin() string {
+	if o == nil || o.Login == nil {
+		return ""
+	}
+	return *o.Login
+}
+
+// GetMembersAllowedRepositoryCreationType returns the MembersAllowedRepositoryCreationType field if it's non-nil, zero value otherwise.
+func (o *Organization) GetMembersAllowedRepositoryCreationType() string {
+	if o == nil || o.MembersAllowedRepositoryCreationType == nil {
+		return ""
+	}
+	return *o.MembersAllowedRepositoryCreationType
+}
+
+// GetMembersCanCreateInternalRepos returns the MembersCanCreateInternalRepos field if it's non-nil, zero value otherwise.
+func (o *Organization) GetMembersCanCreateInternalRepos() bool {
+	if o == nil || o.MembersCanCreateInternalRepos == nil {
+		return false
+	}
+	return *o.MembersCanCreateInternalRepos
+}
+
+// GetMembersCanCreatePrivateRepos returns the MembersCanCreatePrivateRepos field if it's non-nil, zero value otherwise.
+func (o *Organization) GetMembersCanCreatePrivateRepos() bool {
+	if o == nil || o.MembersCanCreatePrivateRepos == nil {
+		return false
+	}
+	return *o.MembersCanCreatePrivateRepos
+}
+
+// GetMembersCanCreatePublicRepos returns the MembersCanCreatePublicRepos field if it's non-nil, zero value otherwise.
+func (o *Organization) GetMembersCanCreatePublicRepos() bool {
+	if o == nil || o.MembersCanCreatePublicRepos == nil {
+		return false
+	}
+	return *o.MembersCanCreatePublicRepos
+}
+
+// GetMembersCanCreateRepos returns the MembersCanCreateRepos field if it's non-nil, zero value otherwise.
+func (o *Organization) GetMembersCanCreateRepos() bool {
+	if o == nil || o.MembersCanCreateRepos == nil {
+		return false
+	}
+	return *o.MembersCanCreateRepos
+}
+
+// GetMembersURL returns the MembersURL field if it's non-nil, zero value otherwise.
+func (o *Organization) GetMembersURL() string {
+	if o == nil || o.MembersURL == nil {
+		return ""
+	}
+	return *o.MembersURL
+}
+
+// GetName returns the Name field if it's non-nil, zero value otherwise.
+func (o *Organization) GetName() string {
+	if o == nil || o.Name == nil {
+		return ""
+	}
+	return *o.Name
+}
+
+// GetNodeID returns the NodeID field if it's non-nil, zero value otherwise.
+func (o *Organization) GetNodeID() string {
+	if o == nil || o.NodeID == nil {
+		return ""
+	}
+	return *o.NodeID
+}
+
+// GetOwnedPrivateRepos returns the OwnedPrivateRepos field if it's non-nil, zero value otherwise.
+func (o *Organization) GetOwnedPrivateRepos() int {
+	if o == nil || o.OwnedPrivateRepos == nil {
+		return 0
+	}
+	return *o.OwnedPrivateRepos
+}
+
+// GetPlan returns the Plan field.
+func (o *Organization) GetPlan() *Plan {
+	if o == nil {
+		return nil
+	}
+	return o.Plan
+}
+
+// GetPrivateGists returns the PrivateGists field if it's non-nil, zero value otherwise.
+func (o *Organization) GetPrivateGists() int {
+	if o == nil || o.PrivateGists == nil {
+		return 0
+	}
+	return *o.PrivateGists
+}
+
+// GetPublicGists returns the PublicGists field if it's non-nil, zero value otherwise.
+func (o *Organization) GetPublicGists() int {
+	if o == nil || o.PublicGists == nil {
+		return 0
+	}
+	return *o.PublicGists
+}
+
+// GetPublicMembersURL returns the PublicMembersURL field if it's non-nil, zero value otherwise.
+func (o *Organization) GetPublicMembersURL() string {
+	if o == nil || o.PublicMembersURL == nil {
+		return ""
+	}
+	return *o.PublicMembersURL
+}
+
+// GetPublicRepos returns the PublicRepos field if it's non-nil, zero value otherwise.
+func (o *Organization) GetPublicRepos() int {
+	if o == nil || o.PublicRepos == nil {
+		return 0
+	}
+	return *o.PublicRepos
+}
+
+// GetReposURL returns the ReposURL field if it's non-nil, zero value otherwise.
+func (o *Organization) GetReposURL() string {
+	if o == nil || o.ReposURL == nil {
+		return ""
+	}
+	return *o.ReposURL
+}
+
+// GetTotalPrivateRepos returns the TotalPrivateRepos field if it's non-nil, zero value otherwise.
+func (o *Organization) GetTotalPrivateRepos() int {
+	if o == nil || o.TotalPrivateRepos == nil {
+		return 0
+	}
+	return *o.TotalPrivateRepos
+}
+
+// GetTwitterUsername returns the TwitterUsername field if it's non-nil, zero value otherwise.
+func (o *Organization) GetTwitterUsername() string {
+	if o == nil || o.TwitterUsername == nil {
+		return ""
+	}
+	return *o.TwitterUsername
+}
+
+// GetTwoFactorRequirementEnabled returns the TwoFactorRequirementEnabled field if it's non-nil, zero value otherwise.
+func (o *Organization) GetTwoFactorRequirementEnabled() bool {
+	if o == nil || o.TwoFactorRequirementEnabled == nil {
+		return false
+	}
+	return *o.TwoFactorRequirementEnabled
+}
+
+// GetType returns the Type field if it's non-nil, zero value otherwise.
+func (o *Organization) GetType() string {
+	if o == nil || o.Type == nil {
+		return ""
+	}
+	return *o.Type
+}
+
+// GetUpdatedAt returns the UpdatedAt field if it's non-nil, zero value otherwise.
+func (o *Organization) GetUpdatedAt() time.Time {
+	if o == nil || o.UpdatedAt == nil {
+		return time.Time{}
+	}
+	return *o.UpdatedAt
+}
+
+// GetURL returns the URL field if it's non-nil, zero value otherwise.
+func (o *Organization) GetURL() string {
+	if o == nil || o.URL == nil {
+		return ""
+	}
+	return *o.URL
+}
+
+// GetTotalCount returns the TotalCount field if it's non-nil, zero value otherwise.
+func (o *OrganizationCustomRepoRoles) GetTotalCount() int {
+	if o == nil || o.TotalCount == nil {
+		return 0
+	}
+	return *o.TotalCount
+}
+
+// GetTotalCount returns the TotalCount field if it's non-nil, zero value otherwise.
+func (o *OrganizationCustomRoles) GetTotalCount() int {
+	if o == nil || o.TotalCount == nil {
+		return 0
+	}
+	return *o.TotalCount
+}
+
+// GetAction returns the Action field if it's non-nil, zero value otherwise.
+func (o *OrganizationEvent) GetAction() string {
+	if o == nil || o.Action == nil {
+		return ""
+	}
+	return *o.Action
+}
+
+// GetInstallation returns the Installation field.
+func (o *OrganizationEvent) GetInstallation() *Installation {
+	if o == nil {
+		return nil
+	}
+	return o.Installation
+}
+
+// GetInvitation returns the Invitation field.
+func (o *OrganizationEvent) GetInvitation() *Invitation {
+	if o == nil {
+		return nil
+	}
+	return o.Invitation
+}
+
+// GetMembership returns the Membership field.
+func (o *OrganizationEvent) GetMembership() *Membership {
+	if o == nil {
+		return nil
+	}
+	return o.Membership
+}
+
+// GetOrganization returns the Organization field.
+func (o *OrganizationEvent) GetOrganization() *Organization {
+	if o == nil {
+		return nil
+	}
+	return o.Organization
+}
+
+// GetSender returns the Sender field.
+func (o *OrganizationEvent) GetSender() *User {
+	if o == nil {
+		return nil
+	}
+	return o.Sender
+}
+
+// GetTotalCount returns the TotalCount field if it's non-nil, zero value otherwise.
+func (o *OrganizationInstallations) GetTotalCount() int {
+	if o == nil || o.TotalCount == nil {
+		return 0
+	}
+	return *o.TotalCount
+}
+
+// GetAction returns the Action field if it's non-nil, zero value otherwise.
+func (o *OrgBlockEvent) GetAction() string {
+	if o == nil || o.Action == nil {
+		return ""
+	}
+	return *o.Action
+}
+
+// GetBlockedUser returns the BlockedUser field.
+func (o *OrgBlockEvent) GetBlockedUser() *User {
+	if o == nil {
+		return nil
+	}
+	return o.BlockedUser
+}
+
+// GetInstallation returns the Installation field.
+func (o *OrgBlockEvent) GetInstallation() *Installation {
+	if o == nil {
+		return nil
+	}
+	return o.Installation
+}
+
+// GetOrganization returns the Organization field.
+func (o *OrgBlockEvent) GetOrganization() *Organization {
+	if o == nil {
+		return nil
+	}
+	return o.Organization
+}
+
+// GetSender returns the Sender field.
+func (o *OrgBlockEvent) GetSender() *User {
+	if o == nil {
+		return nil
+	}
+	return o.Sender
+}
+
+// GetDisabledOrgs returns the DisabledOrgs field if it's non-nil, zero value otherwise.
+func (o *OrgStats) GetDisabledOrgs() int {
+	if o == nil || o.DisabledOrgs == nil {
+		return 0
 	}
-	return i.User
+	return *o.DisabledOrgs
 }
 
-// GetAction returns the Action field if it's non-nil, zero value otherwise.
-func (i *IssueCommentEvent) GetAction() string {
-	if i == nil || i.Action == nil {
-		return ""
+// GetTotalOrgs returns the TotalOrgs field if it's non-nil, zero value otherwise.
+func (o *OrgStats) GetTotalOrgs() int {
+	if o == nil || o.TotalOrgs == nil {
+		return 0
 	}
-	return *i.Action
+	return *o.TotalOrgs
 }
 
-// GetChanges returns the Changes field.
-func (i *IssueCommentEvent) GetChanges() *EditChange {
-	if i == nil {
-		return nil
+// GetTotalTeamMembers returns the TotalTeamMembers field if it's non-nil, zero value otherwise.
+func (o *OrgStats) GetTotalTeamMembers() int {
+	if o == nil || o.TotalTeamMembers == nil {
+		return 0
 	}
-	return i.Changes
+	return *o.TotalTeamMembers
 }
 
-// GetComment returns the Comment field.
-func (i *IssueCommentEvent) GetComment() *IssueComment {
-	if i == nil {
-		return nil
+// GetTotalTeams returns the TotalTeams field if it's non-nil, zero value otherwise.
+func (o *OrgStats) GetTotalTeams() int {
+	if o == nil || o.TotalTeams == nil {
+		return 0
 	}
-	return i.Comment
+	return *o.TotalTeams
 }
 
-// GetInstallation returns the Installation field.
-func (i *IssueCommentEvent) GetInstallation() *Installation {
-	if i == nil {
-		return nil
+// GetCreatedAt returns the CreatedAt field if it's non-nil, zero value otherwise.
+func (p *Package) GetCreatedAt() Timestamp {
+	if p == nil || p.CreatedAt == nil {
+		return Timestamp{}
 	}
-	return i.Installation
+	return *p.CreatedAt
 }
 
-// GetIssue returns the Issue field.
-func (i *IssueCommentEvent) GetIssue() *Issue {
-	if i == nil {
-		return nil
+// GetHTMLURL returns the HTMLURL field if it's non-nil, zero value otherwise.
+func (p *Package) GetHTMLURL() string {
+	if p == nil || p.HTMLURL == nil {
+		return ""
 	}
-	return i.Issue
+	return *p.HTMLURL
 }
 
-// GetRepo returns the Repo field.
-func (i *IssueCommentEvent) GetRepo() *Repository {
-	if i == nil {
-		return nil
+// GetID returns the ID field if it's non-nil, zero value otherwise.
+func (p *Package) GetID() int64 {
+	if p == nil || p.ID == nil {
+		return 0
 	}
-	return i.Repo
+	return *p.ID
 }
 
-// GetSender returns the Sender field.
-func (i *IssueCommentEvent) GetSender() *User {
-	if i == nil {
+// GetName returns the Name field if it's non-nil, zero value otherwise.
+func (p *Package) GetName() string {
+	if p == nil || p.Name == nil {
+		return ""
+	}
+	return *p.Name
+}
+
+// GetOwner returns the Owner field.
+func (p *Package) GetOwner() *User {
+	if p == nil {
 		return nil
 	}
-	return i.Sender
+	return p.Owner
 }
 
-// GetActor returns the Actor field.
-func (i *IssueEvent) GetActor() *User {
-	if i == nil {
+// GetPackageType returns the PackageType field if it's non-nil, zero value otherwise.
+func (p *Package) GetPackageType() string {
+	if p == nil || p.PackageType == nil {
+		return ""
+	}
+	return *p.PackageType
+}
+
+// GetPackageVersion returns the PackageVersion field.
+func (p *Package) GetPackageVersion() *PackageVersion {
+	if p == nil {
 		return nil
 	}
-	return i.Actor
+	return p.PackageVersion
 }
 
-// GetAssignee returns the Assignee field.
-func (i *IssueEvent) GetAssignee() *User {
-	if i == nil {
+// GetRegistry returns the Registry field.
+func (p *Package) GetRegistry() *PackageRegistry {
+	if p == nil {
 		return nil
 	}
-	return i.Assignee
+	return p.Registry
 }
 
-// GetAssigner returns the Assigner field.
-func (i *IssueEvent) GetAssigner() *User {
-	if i == nil {
+// GetRepository returns the Repository field.
+func (p *Package) GetRepository() *Repository {
+	if p == nil {
 		return nil
 	}
-	return i.Assigner
+	return p.Repository
 }
 
-// GetCommitID returns the CommitID field if it's non-nil, zero value otherwise.
-func (i *IssueEvent) GetCommitID() string {
-	if i == nil || i.CommitID == nil {
+// GetUpdatedAt returns the UpdatedAt field if it's non-nil, zero value otherwise.
+func (p *Package) GetUpdatedAt() Timestamp {
+	if p == nil || p.UpdatedAt == nil {
+		return Timestamp{}
+	}
+	return *p.UpdatedAt
+}
+
+// GetURL returns the URL field if it's non-nil, zero value otherwise.
+func (p *Package) GetURL() string {
+	if p == nil || p.URL == nil {
 		return ""
 	}
-	return *i.CommitID
+	return *p.URL
 }
 
-// GetCreatedAt returns the CreatedAt field if it's non-nil, zero value otherwise.
-func (i *IssueEvent) GetCreatedAt() time.Time {
-	if i == nil || i.CreatedAt == nil {
-		return time.Time{}
+// GetVersionCount returns the VersionCount field if it's non-nil, zero value otherwise.
+func (p *Package) GetVersionCount() int64 {
+	if p == nil || p.VersionCount == nil {
+		return 0
 	}
-	return *i.CreatedAt
+	return *p.VersionCount
 }
 
-// GetDismissedReview returns the DismissedReview field.
-func (i *IssueEvent) GetDismissedReview() *DismissedReview {
-	if i == nil {
-		return nil
+// GetVisibility returns the Visibility field if it's non-nil, zero value otherwise.
+func (p *Package) GetVisibility() string {
+	if p == nil || p.Visibility == nil {
+		return ""
 	}
-	return i.DismissedReview
+	return *p.Visibility
 }
 
-// GetEvent returns the Event field if it's non-nil, zero value otherwise.
-func (i *IssueEvent) GetEvent() string {
-	if i == nil || i.Event == nil {
+// GetAction returns the Action field if it's non-nil, zero value otherwise.
+func (p *PackageEvent) GetAction() string {
+	if p == nil || p.Action == nil {
 		return ""
 	}
-	return *i.Event
+	return *p.Action
 }
 
-// GetID returns the ID field if it's non-nil, zero value otherwise.
-func (i *IssueEvent) GetID() int64 {
-	if i == nil || i.ID == nil {
-		return 0
+// GetOrg returns the Org field.
+func (p *PackageEvent) GetOrg() *Organization {
+	if p == nil {
+		return nil
 	}
-	return *i.ID
+	return p.Org
 }
 
-// GetIssue returns the Issue field.
-func (i *IssueEvent) GetIssue() *Issue {
-	if i == nil {
+// GetPackage returns the Package field.
+func (p *PackageEvent) GetPackage() *Package {
+	if p == nil {
 		return nil
 	}
-	return i.Issue
+	return p.Package
 }
 
-// GetLabel returns the Label field.
-func (i *IssueEvent) GetLabel() *Label {
-	if i == nil {
+// GetRepo returns the Repo field.
+func (p *PackageEvent) GetRepo() *Repository {
+	if p == nil {
 		return nil
 	}
-	return i.Label
+	return p.Repo
 }
 
-// GetLockReason returns the LockReason field if it's non-nil, zero value otherwise.
-func (i *IssueEvent) GetLockReason() string {
-	if i == nil || i.LockReason == nil {
+// GetSender returns the Sender field.
+func (p *PackageEvent) GetSender() *User {
+	if p == nil {
+		return nil
+	}
+	return p.Sender
+}
+
+// GetReferenceCategory returns the ReferenceCategory field if it's non-nil, zero value otherwise.
+func (p *PackageExternalRef) GetReferenceCategory() string {
+	if p == nil || p.ReferenceCategory == nil {
 		return ""
 	}
-	return *i.LockReason
+	return *p.ReferenceCategory
 }
 
-// GetMilestone returns the Milestone field.
-func (i *IssueEvent) GetMilestone() *Milestone {
-	if i == nil {
-		return nil
+// GetReferenceLocator returns the ReferenceLocator field if it's non-nil, zero value otherwise.
+func (p *PackageExternalRef) GetReferenceLocator() string {
+	if p == nil || p.ReferenceLocator == nil {
+		return ""
 	}
-	return i.Milestone
+	return *p.ReferenceLocator
 }
 
-// GetProjectCard returns the ProjectCard field.
-func (i *IssueEvent) GetProjectCard() *ProjectCard {
-	if i == nil {
-		return nil
+// GetReferenceType returns the ReferenceType field if it's non-nil, zero value otherwise.
+func (p *PackageExternalRef) GetReferenceType() string {
+	if p == nil || p.ReferenceType == nil {
+		return ""
 	}
-	return i.ProjectCard
+	return *p.ReferenceType
 }
 
-// GetRename returns the Rename field.
-func (i *IssueEvent) GetRename() *Rename {
-	if i == nil {
+// GetAuthor returns the Author field.
+func (p *PackageFile) GetAuthor() *User {
+	if p == nil {
 		return nil
 	}
-	return i.Rename
+	return p.Author
 }
 
-// GetRequestedReviewer returns the RequestedReviewer field.
-func (i *IssueEvent) GetRequestedReviewer() *User {
-	if i == nil {
-		return nil
+// GetContentType returns the ContentType field if it's non-nil, zero value otherwise.
+func (p *PackageFile) GetContentType() string {
+	if p == nil || p.ContentType == nil {
+		return ""
 	}
-	return i.RequestedReviewer
+	return *p.ContentType
 }
 
-// GetReviewRequester returns the ReviewRequester field.
-func (i *IssueEvent) GetReviewRequester() *User {
-	if i == nil {
-		return nil
+// GetCreatedAt returns the CreatedAt field if it's non-nil, zero value otherwise.
+func (p *PackageFile) GetCreatedAt() Timestamp {
+	if p == nil || p.CreatedAt == nil {
+		return Timestamp{}
 	}
-	return i.ReviewRequester
+	return *p.CreatedAt
 }
 
-// GetURL returns the URL field if it's non-nil, zero value otherwise.
-func (i *IssueEvent) GetURL() string {
-	if i == nil || i.URL == nil {
+// GetDownloadURL returns the DownloadURL field if it's non-nil, zero value otherwise.
+func (p *PackageFile) GetDownloadURL() string {
+	if p == nil || p.DownloadURL == nil {
 		return ""
 	}
-	return *i.URL
+	return *p.DownloadURL
 }
 
-// GetAssignee returns the Assignee field if it's non-nil, zero value otherwise.
-func (i *IssueImport) GetAssignee() string {
-	if i == nil || i.Assignee == nil {
+// GetID returns the ID field if it's non-nil, zero value otherwise.
+func (p *PackageFile) GetID() int64 {
+	if p == nil || p.ID == nil {
+		return 0
+	}
+	return *p.ID
+}
+
+// GetMD5 returns the MD5 field if it's non-nil, zero value otherwise.
+func (p *PackageFile) GetMD5() string {
+	if p == nil || p.MD5 == nil {
+		return ""
+	}
+	return *p.MD5
+}
+
+// GetName returns the Name field if it's non-nil, zero value otherwise.
+func (p *PackageFile) GetName() string {
+	if p == nil || p.Name == nil {
 		return ""
 	}
-	return *i.Assignee
+	return *p.Name
 }
 
-// GetClosed returns the Closed field if it's non-nil, zero value otherwise.
-func (i *IssueImport) GetClosed() bool {
-	if i == nil || i.Closed == nil {
-		return false
+// GetSHA1 returns the SHA1 field if it's non-nil, zero value otherwise.
+func (p *PackageFile) GetSHA1() string {
+	if p == nil || p.SHA1 == nil {
+		return ""
 	}
-	return *i.Closed
+	return *p.SHA1
 }
 
-// GetClosedAt returns the ClosedAt field if it's non-nil, zero value otherwise.
-func (i *IssueImport) GetClosedAt() time.Time {
-	if i == nil || i.ClosedAt == nil {
-		return time.Time{}
+// GetSHA256 returns the SHA256 field if it's non-nil, zero value otherwise.
+func (p *PackageFile) GetSHA256() string {
+	if p == nil || p.SHA256 == nil {
+		return ""
 	}
-	return *i.ClosedAt
+	return *p.SHA256
 }
 
-// GetCreatedAt returns the CreatedAt field if it's non-nil, zero value otherwise.
-func (i *IssueImport) GetCreatedAt() time.Time {
-	if i == nil || i.CreatedAt == nil {
-		return time.Time{}
+// GetSize returns the Size field if it's non-nil, zero value otherwise.
+func (p *PackageFile) GetSize() int64 {
+	if p == nil || p.Size == nil {
+		return 0
 	}
-	return *i.CreatedAt
+	return *p.Size
 }
 
-// GetMilestone returns the Milestone field if it's non-nil, zero value otherwise.
-func (i *IssueImport) GetMilestone() int {
-	if i == nil || i.Milestone == nil {
-		return 0
+// GetState returns the State field if it's non-nil, zero value otherwise.
+func (p *PackageFile) GetState() string {
+	if p == nil || p.State == nil {
+		return ""
 	}
-	return *i.Milestone
+	return *p.State
 }
 
 // GetUpdatedAt returns the UpdatedAt field if it's non-nil, zero value otherwise.
-func (i *IssueImport) GetUpdatedAt() time.Time {
-	if i == nil || i.UpdatedAt == nil {
-		return time.Time{}
+func (p *PackageFile) GetUpdatedAt() Timestamp {
+	if p == nil || p.UpdatedAt == nil {
+		return Timestamp{}
 	}
-	return *i.UpdatedAt
+	return *p.UpdatedAt
 }
 
-// GetCode returns the Code field if it's non-nil, zero value otherwise.
-func (i *IssueImportError) GetCode() string {
-	if i == nil || i.Code == nil {
+// GetPackageType returns the PackageType field if it's non-nil, zero value otherwise.
+func (p *PackageListOptions) GetPackageType() string {
+	if p == nil || p.PackageType == nil {
 		return ""
 	}
-	return *i.Code
+	return *p.PackageType
 }
 
-// GetField returns the Field field if it's non-nil, zero value otherwise.
-func (i *IssueImportError) GetField() string {
-	if i == nil || i.Field == nil {
+// GetState returns the State field if it's non-nil, zero value otherwise.
+func (p *PackageListOptions) GetState() string {
+	if p == nil || p.State == nil {
 		return ""
 	}
-	return *i.Field
+	return *p.State
 }
 
-// GetLocation returns the Location field if it's non-nil, zero value otherwise.
-func (i *IssueImportError) GetLocation() string {
-	if i == nil || i.Location == nil {
+// GetVisibility returns the Visibility field if it's non-nil, zero value otherwise.
+func (p *PackageListOptions) GetVisibility() string {
+	if p == nil || p.Visibility == nil {
 		return ""
 	}
-	return *i.Location
+	return *p.Visibility
 }
 
-// GetResource returns the Resource field if it's non-nil, zero value otherwise.
-func (i *IssueImportError) GetResource() string {
-	if i == nil || i.Resource == nil {
-		return ""
+// GetContainer returns the Container field.
+func (p *PackageMetadata) GetContainer() *PackageContainerMetadata {
+	if p == nil {
+		return nil
 	}
-	return *i.Resource
+	return p.Container
 }
 
-// GetValue returns the Value field if it's non-nil, zero value otherwise.
-func (i *IssueImportError) GetValue() string {
-	if i == nil || i.Value == nil {
-		return ""
+// GetDocker returns the Docker field.
+func (p *PackageMetadata) GetDocker() *PackageDockerMetadata {
+	if p == nil {
+		return nil
 	}
-	return *i.Value
+	return p.Docker
 }
 
-// GetCreatedAt returns the CreatedAt field if it's non-nil, zero value otherwise.
-func (i *IssueImportResponse) GetCreatedAt() time.Time {
-	if i == nil || i.CreatedAt == nil {
-		return time.Time{}
+// GetPackageType returns the PackageType field if it's non-nil, zero value otherwise.
+func (p *PackageMetadata) GetPackageType() string {
+	if p == nil || p.PackageType == nil {
+		return ""
 	}
-	return *i.CreatedAt
+	return *p.PackageType
 }
 
-// GetDocumentationURL returns the DocumentationURL field if it's non-nil, zero value otherwise.
-func (i *IssueImportResponse) GetDocumentationURL() string {
-	if i == nil || i.DocumentationURL == nil {
+// GetAboutURL returns the AboutURL field if it's non-nil, zero value otherwise.
+func (p *PackageRegistry) GetAboutURL() string {
+	if p == nil || p.AboutURL == nil {
 		return ""
 	}
-	return *i.DocumentationURL
+	return *p.AboutURL
 }
 
-// GetID returns the ID field if it's non-nil, zero value otherwise.
-func (i *IssueImportResponse) GetID() int {
-	if i == nil || i.ID == nil {
-		return 0
+// GetName returns the Name field if it's non-nil, zero value otherwise.
+func (p *PackageRegistry) GetName() string {
+	if p == nil || p.Name == nil {
+		return ""
 	}
-	return *i.ID
+	return *p.Name
 }
 
-// GetImportIssuesURL returns the ImportIssuesURL field if it's non-nil, zero value otherwise.
-func (i *IssueImportResponse) GetImportIssuesURL() string {
-	if i == nil || i.ImportIssuesURL == nil {
+// GetType returns the Type field if it's non-nil, zero value otherwise.
+func (p *PackageRegistry) GetType() string {
+	if p == nil || p.Type == nil {
 		return ""
 	}
-	return *i.ImportIssuesURL
+	return *p.Type
 }
 
-// GetMessage returns the Message field if it's non-nil, zero value otherwise.
-func (i *IssueImportResponse) GetMessage() string {
-	if i == nil || i.Message == nil {
+// GetURL returns the URL field if it's non-nil, zero value otherwise.
+func (p *PackageRegistry) GetURL() string {
+	if p == nil || p.URL == nil {
 		return ""
 	}
-	return *i.Message
+	return *p.URL
 }
 
-// GetRepositoryURL returns the RepositoryURL field if it's non-nil, zero value otherwise.
-func (i *IssueImportResponse) GetRepositoryURL() string {
-	if i == nil || i.RepositoryURL == nil {
+// GetVendor returns the Vendor field if it's non-nil, zero value otherwise.
+func (p *PackageRegistry) GetVendor() string {
+	if p == nil || p.Vendor == nil {
 		return ""
 	}
-	return *i.RepositoryURL
+	return *p.Vendor
 }
 
-// GetStatus returns the Status field if it's non-nil, zero value otherwise.
-func (i *IssueImportResponse) GetStatus() string {
-	if i == nil || i.Status == nil {
-		return ""
+// GetAuthor returns the Author field.
+func (p *PackageRelease) GetAuthor() *User {
+	if p == nil {
+		return nil
 	}
-	return *i.Status
+	return p.Author
 }
 
-// GetUpdatedAt returns the UpdatedAt field if it's non-nil, zero value otherwise.
-func (i *IssueImportResponse) GetUpdatedAt() time.Time {
-	if i == nil || i.UpdatedAt == nil {
-		return time.Time{}
+// GetCreatedAt returns the CreatedAt field if it's non-nil, zero value otherwise.
+func (p *PackageRelease) GetCreatedAt() Timestamp {
+	if p == nil || p.CreatedAt == nil {
+		return Timestamp{}
 	}
-	return *i.UpdatedAt
+	return *p.CreatedAt
 }
 
-// GetURL returns the URL field if it's non-nil, zero value otherwise.
-func (i *IssueImportResponse) GetURL() string {
-	if i == nil || i.URL == nil {
+// GetDraft returns the Draft field if it's non-nil, zero value otherwise.
+func (p *PackageRelease) GetDraft() bool {
+	if p == nil || p.Draft == nil {
+		return false
+	}
+	return *p.Draft
+}
+
+// GetHTMLURL returns the HTMLURL field if it's non-nil, zero value otherwise.
+func (p *PackageRelease) GetHTMLURL() string {
+	if p == nil || p.HTMLURL == nil {
 		return ""
 	}
-	return *i.URL
+	return *p.HTMLURL
 }
 
-// GetSince returns the Since field if it's non-nil, zero value otherwise.
-func (i *IssueListCommentsOptions) GetSince() time.Time {
-	if i == nil || i.Since == nil {
-		return time.Time{}
+// GetID returns the ID field if it's non-nil, zero value otherwise.
+func (p *PackageRelease) GetID() int64 {
+	if p == nil || p.ID == nil {
+		return 0
 	}
-	return *i.Since
+	return *p.ID
 }
 
-// GetAssignee returns the Assignee field if it's non-nil, zero value otherwise.
-func (i *IssueRequest) GetAssignee() string {
-	if i == nil || i.Assignee == nil {
+// GetName returns the Name field if it's non-nil, zero value otherwise.
+func (p *PackageRelease) GetName() string {
+	if p == nil || p.Name == nil {
 		return ""
 	}
-	return *i.Assignee
+	return *p.Name
 }
 
-// GetAssignees returns the Assignees field if it's non-nil, zero value otherwise.
-func (i *IssueRequest) GetAssignees() []string {
-	if i == nil || i.Assignees == nil {
-		return nil
+// GetPrerelease returns the Prerelease field if it's non-nil, zero value otherwise.
+func (p *PackageRelease) GetPrerelease() bool {
+	if p == nil || p.Prerelease == nil {
+		return false
 	}
-	return *i.Assignees
+	return *p.Prerelease
 }
 
-// GetBody returns the Body field if it's non-nil, zero value otherwise.
-func (i *IssueRequest) GetBody() string {
-	if i == nil || i.Body == nil {
-		return ""
+// GetPublishedAt returns the PublishedAt field if it's non-nil, zero value otherwise.
+func (p *PackageRelease) GetPublishedAt() Timestamp {
+	if p == nil || p.PublishedAt == nil {
+		return Timestamp{}
 	}
-	return *i.Body
+	return *p.PublishedAt
 }
 
-// GetLabels returns the Labels field if it's non-nil, zero value otherwise.
-func (i *IssueRequest) GetLabels() []string {
-	if i == nil || i.Labels == nil {
-		return nil
+// GetTagName returns the TagName field if it's non-nil, zero value otherwise.
+func (p *PackageRelease) GetTagName() string {
+	if p == nil || p.TagName == nil {
+		return ""
 	}
-	return *i.Labels
+	return *p.TagName
 }
 
-// GetMilestone returns the Milestone field if it's non-nil, zero value otherwise.
-func (i *IssueRequest) GetMilestone() int {
-	if i == nil || i.Milestone == nil {
-		return 0
+// GetTargetCommitish returns the TargetCommitish field if it's non-nil, zero value otherwise.
+func (p *PackageRelease) GetTargetCommitish() string {
+	if p == nil || p.TargetCommitish == nil {
+		return ""
 	}
-	return *i.Milestone
+	return *p.TargetCommitish
 }
 
-// GetState returns the State field if it's non-nil, zero value otherwise.
-func (i *IssueRequest) GetState() string {
-	if i == nil || i.State == nil {
+// GetURL returns the URL field if it's non-nil, zero value otherwise.
+func (p *PackageRelease) GetURL() string {
+	if p == nil || p.URL == nil {
 		return ""
 	}
-	return *i.State
+	return *p.URL
 }
 
-// GetTitle returns the Title field if it's non-nil, zero value otherwise.
-func (i *IssueRequest) GetTitle() string {
-	if i == nil || i.Title == nil {
-		return ""
+// GetAuthor returns the Author field.
+func (p *PackageVersion) GetAuthor() *User {
+	if p == nil {
+		return nil
 	}
-	return *i.Title
+	return p.Author
 }
 
-// GetAction returns the Action field if it's non-nil, zero value otherwise.
-func (i *IssuesEvent) GetAction() string {
-	if i == nil || i.Action == nil {
+// GetBody returns the Body field if it's non-nil, zero value otherwise.
+func (p *PackageVersion) GetBody() string {
+	if p == nil || p.Body == nil {
+		return ""
+	}
+	return *p.Body
+}
+
+// GetBodyHTML returns the BodyHTML field if it's non-nil, zero value otherwise.
+func (p *PackageVersion) GetBodyHTML() string {
+	if p == nil || p.BodyHTML == nil {
 		return ""
 	}
-	return *i.Action
+	return *p.BodyHTML
 }
 
-// GetAssignee returns the Assignee field.
-func (i *IssuesEvent) GetAssignee() *User {
-	if i == nil {
-		return nil
+// GetCreatedAt returns the CreatedAt field if it's non-nil, zero value otherwise.
+func (p *PackageVersion) GetCreatedAt() Timestamp {
+	if p == nil || p.CreatedAt == nil {
+		return Timestamp{}
 	}
-	return i.Assignee
+	return *p.CreatedAt
 }
 
-// GetChanges returns the Changes field.
-func (i *IssuesEvent) GetChanges() *EditChange {
-	if i == nil {
-		return nil
+// GetDeletedAt returns the DeletedAt field if it's non-nil, zero value otherwise.
+func (p *PackageVersion) GetDeletedAt() Timestamp {
+	if p == nil || p.DeletedAt == nil {
+		return Timestamp{}
 	}
-	return i.Changes
+	return *p.DeletedAt
 }
 
-// GetInstallation returns the Installation field.
-func (i *IssuesEvent) GetInstallation() *Installation {
-	if i == nil {
-		return nil
+// GetDescription returns the Description field if it's non-nil, zero value otherwise.
+func (p *PackageVersion) GetDescription() string {
+	if p == nil || p.Description == nil {
+		return ""
 	}
-	return i.Installation
+	return *p.Description
 }
 
-// GetIssue returns the Issue field.
-func (i *IssuesEvent) GetIssue() *Issue {
-	if i == nil {
-		return nil
+// GetDraft returns the Draft field if it's non-nil, zero value otherwise.
+func (p *PackageVersion) GetDraft() bool {
+	if p == nil || p.Draft == nil {
+		return false
 	}
-	return i.Issue
+	return *p.Draft
 }
 
-// GetLabel returns the Label field.
-func (i *IssuesEvent) GetLabel() *Label {
-	if i == nil {
-		return nil
+// GetHTMLURL returns the HTMLURL field if it's non-nil, zero value otherwise.
+func (p *PackageVersion) GetHTMLURL() string {
+	if p == nil || p.HTMLURL == nil {
+		return ""
 	}
-	return i.Label
+	return *p.HTMLURL
 }
 
-// GetRepo returns the Repo field.
-func (i *IssuesEvent) GetRepo() *Repository {
-	if i == nil {
-		return nil
+// GetID returns the ID field if it's non-nil, zero value otherwise.
+func (p *PackageVersion) GetID() int64 {
+	if p == nil || p.ID == nil {
+		return 0
 	}
-	return i.Repo
+	return *p.ID
 }
 
-// GetSender returns the Sender field.
-func (i *IssuesEvent) GetSender() *User {
-	if i == nil {
-		return nil
+// GetInstallationCommand returns the InstallationCommand field if it's non-nil, zero value otherwise.
+func (p *PackageVersion) GetInstallationCommand() string {
+	if p == nil || p.InstallationCommand == nil {
+		return ""
 	}
-	return i.Sender
+	return *p.InstallationCommand
 }
 
-// GetIncompleteResults returns the IncompleteResults field if it's non-nil, zero value otherwise.
-func (i *IssuesSearchResult) GetIncompleteResults() bool {
-	if i == nil || i.IncompleteResults == nil {
-		return false
+// GetLicense returns the License field if it's non-nil, zero value otherwise.
+func (p *PackageVersion) GetLicense() string {
+	if p == nil || p.License == nil {
+		return ""
 	}
-	return *i.IncompleteResults
+	return *p.License
 }
 
-// GetTotal returns the Total field if it's non-nil, zero value otherwise.
-func (i *IssuesSearchResult) GetTotal() int {
-	if i == nil || i.Total == nil {
-		return 0
+// GetManifest returns the Manifest field if it's non-nil, zero value otherwise.
+func (p *PackageVersion) GetManifest() string {
+	if p == nil || p.Manifest == nil {
+		return ""
 	}
-	return *i.Total
+	return *p.Manifest
 }
 
-// GetClosedIssues returns the ClosedIssues field if it's non-nil, zero value otherwise.
-func (i *IssueStats) GetClosedIssues() int {
-	if i == nil || i.ClosedIssues == nil {
-		return 0
+// GetMetadata returns the Metadata field.
+func (p *PackageVersion) GetMetadata() *PackageMetadata {
+	if p == nil {
+		return nil
 	}
-	return *i.ClosedIssues
+	return p.Metadata
 }
 
-// GetOpenIssues returns the OpenIssues field if it's non-nil, zero value otherwise.
-func (i *IssueStats) GetOpenIssues() int {
-	if i == nil || i.OpenIssues == nil {
-		return 0
+// GetName returns the Name field if it's non-nil, zero value otherwise.
+func (p *PackageVersion) GetName() string {
+	if p == nil || p.Name == nil {
+		return ""
 	}
-	return *i.OpenIssues
+	return *p.Name
 }
 
-// GetTotalIssues returns the TotalIssues field if it's non-nil, zero value otherwise.
-func (i *IssueStats) GetTotalIssues() int {
-	if i == nil || i.TotalIssues == nil {
-		return 0
+// GetPackageHTMLURL returns the PackageHTMLURL field if it's non-nil, zero value otherwise.
+func (p *PackageVersion) GetPackageHTMLURL() string {
+	if p == nil || p.PackageHTMLURL == nil {
+		return ""
 	}
-	return *i.TotalIssues
+	return *p.PackageHTMLURL
 }
 
-// GetTotalCount returns the TotalCount field if it's non-nil, zero value otherwise.
-func (j *Jobs) GetTotalCount() int {
-	if j == nil || j.TotalCount == nil {
-		return 0
+// GetPrerelease returns the Prerelease field if it's non-nil, zero value otherwise.
+func (p *PackageVersion) GetPrerelease() bool {
+	if p == nil || p.Prerelease == nil {
+		return false
 	}
-	return *j.TotalCount
+	return *p.Prerelease
 }
 
-// GetCreatedAt returns the CreatedAt field if it's non-nil, zero value otherwise.
-func (k *Key) GetCreatedAt() Timestamp {
-	if k == nil || k.CreatedAt == nil {
-		return Timestamp{}
+// GetRelease returns the Release field.
+func (p *PackageVersion) GetRelease() *PackageRelease {
+	if p == nil {
+		return nil
 	}
-	return *k.CreatedAt
+	return p.Release
 }
 
-// GetID returns the ID field if it's non-nil, zero value otherwise.
-func (k *Key) GetID() int64 {
-	if k == nil || k.ID == nil {
-		return 0
+// GetSummary returns the Summary field if it's non-nil, zero value otherwise.
+func (p *PackageVersion) GetSummary() string {
+	if p == nil || p.Summary == nil {
+		return ""
 	}
-	return *k.ID
+	return *p.Summary
 }
 
-// GetKey returns the Key field if it's non-nil, zero value otherwise.
-func (k *Key) GetKey() string {
-	if k == nil || k.Key == nil {
+// GetTagName returns the TagName field if it's non-nil, zero value otherwise.
+func (p *PackageVersion) GetTagName() string {
+	if p == nil || p.TagName == nil {
 		return ""
 	}
-	return *k.Key
+	return *p.TagName
 }
 
-// GetReadOnly returns the ReadOnly field if it's non-nil, zero value otherwise.
-func (k *Key) GetReadOnly() bool {
-	if k == nil || k.ReadOnly == nil {
-		return false
+// GetTargetCommitish returns the TargetCommitish field if it's non-nil, zero value otherwise.
+func (p *PackageVersion) GetTargetCommitish() string {
+	if p == nil || p.TargetCommitish == nil {
+		return ""
 	}
-	return *k.ReadOnly
+	return *p.TargetCommitish
 }
 
-// GetTitle returns the Title field if it's non-nil, zero value otherwise.
-func (k *Key) GetTitle() string {
-	if k == nil || k.Title == nil {
+// GetTargetOID returns the TargetOID field if it's non-nil, zero value otherwise.
+func (p *PackageVersion) GetTargetOID() string {
+	if p == nil || p.TargetOID == nil {
 		return ""
 	}
-	return *k.Title
+	return *p.TargetOID
 }
 
-// GetURL returns the URL field if it's non-nil, zero value otherwise.
-func (k *Key) GetURL() string {
-	if k == nil || k.URL == nil {
-		return ""
+// GetUpdatedAt returns the UpdatedAt field if it's non-nil, zero value otherwise.
+func (p *PackageVersion) GetUpdatedAt() Timestamp {
+	if p == nil || p.UpdatedAt == nil {
+		return Timestamp{}
 	}
-	return *k.URL
+	return *p.UpdatedAt
 }
 
-// GetColor returns the Color field if it's non-nil, zero value otherwise.
-func (l *Label) GetColor() string {
-	if l == nil || l.Color == nil {
+// GetURL returns the URL field if it's non-nil, zero value otherwise.
+func (p *PackageVersion) GetURL() string {
+	if p == nil || p.URL == nil {
 		return ""
 	}
-	return *l.Color
+	return *p.URL
 }
 
-// GetDefault returns the Default field if it's non-nil, zero value otherwise.
-func (l *Label) GetDefault() bool {
-	if l == nil || l.Default == nil {
-		return false
+// GetVersion returns the Version field if it's non-nil, zero value otherwise.
+func (p *PackageVersion) GetVersion() string {
+	if p == nil || p.Version == nil {
+		return ""
 	}
-	return *l.Default
+	return *p.Version
 }
 
-// GetDescription returns the Description field if it's non-nil, zero value otherwise.
-func (l *Label) GetDescription() string {
-	if l == nil || l.Description == nil {
+// GetAction returns the Action field if it's non-nil, zero value otherwise.
+func (p *Page) GetAction() string {
+	if p == nil || p.Action == nil {
 		return ""
 	}
-	return *l.Description
+	return *p.Action
 }
 
-// GetID returns the ID field if it's non-nil, zero value otherwise.
-func (l *Label) GetID() int64 {
-	if l == nil || l.ID == nil {
-		return 0
+// GetHTMLURL returns the HTMLURL field if it's non-nil, zero value otherwise.
+func (p *Page) GetHTMLURL() string {
+	if p == nil || p.HTMLURL == nil {
+		return ""
 	}
-	return *l.ID
+	return *p.HTMLURL
 }
 
-// GetName returns the Name field if it's non-nil, zero value otherwise.
-func (l *Label) GetName() string {
-	if l == nil || l.Name == nil {
+// GetPageName returns the PageName field if it's non-nil, zero value otherwise.
+func (p *Page) GetPageName() string {
+	if p == nil || p.PageName == nil {
 		return ""
 	}
-	return *l.Name
+	return *p.PageName
 }
 
-// GetNodeID returns the NodeID field if it's non-nil, zero value otherwise.
-func (l *Label) GetNodeID() string {
-	if l == nil || l.NodeID == nil {
+// GetSHA returns the SHA field if it's non-nil, zero value otherwise.
+func (p *Page) GetSHA() string {
+	if p == nil || p.SHA == nil {
 		return ""
 	}
-	return *l.NodeID
+	return *p.SHA
 }
 
-// GetURL returns the URL field if it's non-nil, zero value otherwise.
-func (l *Label) GetURL() string {
-	if l == nil || l.URL == nil {
+// GetSummary returns the Summary field if it's non-nil, zero value otherwise.
+func (p *Page) GetSummary() string {
+	if p == nil || p.Summary == nil {
 		return ""
 	}
-	return *l.URL
+	return *p.Summary
 }
 
-// GetAction returns the Action field if it's non-nil, zero value otherwise.
-func (l *LabelEvent) GetAction() string {
-	if l == nil || l.Action == nil {
+// GetTitle returns the Title field if it's non-nil, zero value otherwise.
+func (p *Page) GetTitle() string {
+	if p == nil || p.Title == nil {
 		return ""
 	}
-	return *l.Action
+	return *p.Title
 }
 
-// GetChanges returns the Changes field.
-func (l *LabelEvent) GetChanges() *EditChange {
-	if l == nil {
+// GetBuild returns the Build field.
+func (p *PageBuildEvent) GetBuild() *PagesBuild {
+	if p == nil {
 		return nil
 	}
-	return l.Changes
+	return p.Build
 }
 
-// GetInstallation returns the Installation field.
-func (l *LabelEvent) GetInstallation() *Installation {
-	if l == nil {
-		return nil
+// GetID returns the ID field if it's non-nil, zero value otherwise.
+func (p *PageBuildEvent) GetID() int64 {
+	if p == nil || p.ID == nil {
+		return 0
 	}
-	return l.Installation
+	return *p.ID
 }
 
-// GetLabel returns the Label field.
-func (l *LabelEvent) GetLabel() *Label {
-	if l == nil {
+// GetInstallation returns the Installation field.
+func (p *PageBuildEvent) GetInstallation() *Installation {
+	if p == nil {
 		return nil
 	}
-	return l.Label
+	return p.Installation
 }
 
-// GetOrg returns the Org field.
-func (l *LabelEvent) GetOrg() *Organization {
-	if l == nil {
+// GetRepo returns the Repo field.
+func (p *PageBuildEvent) GetRepo() *Repository {
+	if p == nil {
 		return nil
 	}
-	return l.Org
+	return p.Repo
 }
 
-// GetRepo returns the Repo field.
-func (l *LabelEvent) GetRepo() *Repository {
-	if l == nil {
+// GetSender returns the Sender field.
+func (p *PageBuildEvent) GetSender() *User {
+	if p == nil {
 		return nil
 	}
-	return l.Repo
+	return p.Sender
 }
 
-// GetColor returns the Color field if it's non-nil, zero value otherwise.
-func (l *LabelResult) GetColor() string {
-	if l == nil || l.Color == nil {
+// GetCNAME returns the CNAME field if it's non-nil, zero value otherwise.
+func (p *Pages) GetCNAME() string {
+	if p == nil || p.CNAME == nil {
 		return ""
 	}
-	return *l.Color
+	return *p.CNAME
 }
 
-// GetDefault returns the Default field if it's non-nil, zero value otherwise.
-func (l *LabelResult) GetDefault() bool {
-	if l == nil || l.Default == nil {
+// GetCustom404 returns the Custom404 field if it's non-nil, zero value otherwise.
+func (p *Pages) GetCustom404() bool {
+	if p == nil || p.Custom404 == nil {
 		return false
 	}
-	return *l.Default
+	return *p.Custom404
 }
 
-// GetDescription returns the Description field if it's non-nil, zero value otherwise.
-func (l *LabelResult) GetDescription() string {
-	if l == nil || l.Description == nil {
+// GetHTMLURL returns the HTMLURL field if it's non-nil, zero value otherwise.
+func (p *Pages) GetHTMLURL() string {
+	if p == nil || p.HTMLURL == nil {
 		return ""
 	}
-	return *l.Description
+	return *p.HTMLURL
 }
 
-// GetID returns the ID field if it's non-nil, zero value otherwise.
-func (l *LabelResult) GetID() int64 {
-	if l == nil || l.ID == nil {
-		return 0
+// GetSource returns the Source field.
+func (p *Pages) GetSource() *PagesSource {
+	if p == nil {
+		return nil
 	}
-	return *l.ID
+	return p.Source
 }
 
-// GetName returns the Name field if it's non-nil, zero value otherwise.
-func (l *LabelResult) GetName() string {
-	if l == nil || l.Name == nil {
+// GetStatus returns the Status field if it's non-nil, zero value otherwise.
+func (p *Pages) GetStatus() string {
+	if p == nil || p.Status == nil {
 		return ""
 	}
-	return *l.Name
+	return *p.Status
 }
 
-// GetScore returns the Score field.
-func (l *LabelResult) GetScore() *float64 {
-	if l == nil {
-		return nil
+// GetURL returns the URL field if it's non-nil, zero value otherwise.
+func (p *Pages) GetURL() string {
+	if p == nil || p.URL == nil {
+		return ""
 	}
-	return l.Score
+	return *p.URL
 }
 
-// GetURL returns the URL field if it's non-nil, zero value otherwise.
-func (l *LabelResult) GetURL() string {
-	if l == nil || l.URL == nil {
+// GetCommit returns the Commit field if it's non-nil, zero value otherwise.
+func (p *PagesBuild) GetCommit() string {
+	if p == nil || p.Commit == nil {
 		return ""
 	}
-	return *l.URL
+	return *p.Commit
 }
 
-// GetIncompleteResults returns the IncompleteResults field if it's non-nil, zero value otherwise.
-func (l *LabelsSearchResult) GetIncompleteResults() bool {
-	if l == nil || l.IncompleteResults == nil {
-		return false
+// GetCreatedAt returns the CreatedAt field if it's non-nil, zero value otherwise.
+func (p *PagesBuild) GetCreatedAt() Timestamp {
+	if p == nil || p.CreatedAt == nil {
+		return Timestamp{}
 	}
-	return *l.IncompleteResults
+	return *p.CreatedAt
 }
 
-// GetTotal returns the Total field if it's non-nil, zero value otherwise.
-func (l *LabelsSearchResult) GetTotal() int {
-	if l == nil || l.Total == nil {
+// GetDuration returns the Duration field if it's non-nil, zero value otherwise.
+func (p *PagesBuild) GetDuration() int {
+	if p == nil || p.Duration == nil {
 		return 0
 	}
-	return *l.Total
+	return *p.Duration
 }
 
-// GetOID returns the OID field if it's non-nil, zero value otherwise.
-func (l *LargeFile) GetOID() string {
-	if l == nil || l.OID == nil {
-		return ""
+// GetError returns the Error field.
+func (p *PagesBuild) GetError() *PagesError {
+	if p == nil {
+		return nil
 	}
-	return *l.OID
+	return p.Error
 }
 
-// GetPath returns the Path field if it's non-nil, zero value otherwise.
-func (l *LargeFile) GetPath() string {
-	if l == nil || l.Path == nil {
-		return ""
+// GetPusher returns the Pusher field.
+func (p *PagesBuild) GetPusher() *User {
+	if p == nil {
+		return nil
 	}
-	return *l.Path
+	return p.Pusher
 }
 
-// GetRefName returns the RefName field if it's non-nil, zero value otherwise.
-func (l *LargeFile) GetRefName() string {
-	if l == nil || l.RefName == nil {
+// GetStatus returns the Status field if it's non-nil, zero value otherwise.
+func (p *PagesBuild) GetStatus() string {
+	if p == nil || p.Status == nil {
 		return ""
 	}
-	return *l.RefName
+	return *p.Status
 }
 
-// GetSize returns the Size field if it's non-nil, zero value otherwise.
-func (l *LargeFile) GetSize() int {
-	if l == nil || l.Size == nil {
-		return 0
+// GetUpdatedAt returns the UpdatedAt field if it's non-nil, zero value otherwise.
+func (p *PagesBuild) GetUpdatedAt() Timestamp {
+	if p == nil || p.UpdatedAt == nil {
+		return Timestamp{}
 	}
-	return *l.Size
+	return *p.UpdatedAt
 }
 
-// GetBody returns the Body field if it's non-nil, zero value otherwise.
-func (l *License) GetBody() string {
-	if l == nil || l.Body == nil {
+// GetURL returns the URL field if it's non-nil, zero value otherwise.
+func (p *PagesBuild) GetURL() string {
+	if p == nil || p.URL == nil {
 		return ""
 	}
-	return *l.Body
-}
-
-// GetConditions returns the Conditions field if it's non-nil, zero value otherwise.
-func (l *License) GetConditions() []string {
-	if l == nil || l.Conditions == nil {
-		return nil
-	}
-	return *l.Conditions
+	return *p.URL
 }
 
-// GetDescription returns the Description field if it's non-nil, zero value otherwise.
-func (l *License) GetDescription() string {
-	if l == nil || l.Description == nil {
+// GetMessage returns the Message field if it's non-nil, zero value otherwise.
+func (p *PagesError) GetMessage() string {
+	if p == nil || p.Message == nil {
 		return ""
 	}
-	return *l.Description
-}
-
-// GetFeatured returns the Featured field if it's non-nil, zero value otherwise.
-func (l *License) GetFeatured() bool {
-	if l == nil || l.Featured == nil {
-		return false
-	}
-	return *l.Featured
+	return *p.Message
 }
 
-// GetHTMLURL returns the HTMLURL field if it's non-nil, zero value otherwise.
-func (l *License) GetHTMLURL() string {
-	if l == nil || l.HTMLURL == nil {
+// GetBranch returns the Branch field if it's non-nil, zero value otherwise.
+func (p *PagesSource) GetBranch() string {
+	if p == nil || p.Branch == nil {
 		return ""
 	}
-	return *l.HTMLURL
+	return *p.Branch
 }
 
-// GetImplementation returns the Implementation field if it's non-nil, zero value otherwise.
-func (l *License) GetImplementation() string {
-	if l == nil || l.Implementation == nil {
+// GetPath returns the Path field if it's non-nil, zero value otherwise.
+func (p *PagesSource) GetPath() string {
+	if p == nil || p.Path == nil {
 		return ""
 	}
-	return *l.Implementation
+	return *p.Path
 }
 
-// GetKey returns the Key field if it's non-nil, zero value otherwise.
-func (l *License) GetKey() string {
-	if l == nil || l.Key == nil {
-		return ""
+// GetTotalPages returns the TotalPages field if it's non-nil, zero value otherwise.
+func (p *PageStats) GetTotalPages() int {
+	if p == nil || p.TotalPages == nil {
+		return 0
 	}
-	return *l.Key
+	return *p.TotalPages
 }
 
-// GetLimitations returns the Limitations field if it's non-nil, zero value otherwise.
-func (l *License) GetLimitations() []string {
-	if l == nil || l.Limitations == nil {
-		return nil
+// GetCNAME returns the CNAME field if it's non-nil, zero value otherwise.
+func (p *PagesUpdate) GetCNAME() string {
+	if p == nil || p.CNAME == nil {
+		return ""
 	}
-	return *l.Limitations
+	return *p.CNAME
 }
 
-// GetName returns the Name field if it's non-nil, zero value otherwise.
-func (l *License) GetName() string {
-	if l == nil || l.Name == nil {
+// GetSource returns the Source field if it's non-nil, zero value otherwise.
+func (p *PagesUpdate) GetSource() string {
+	if p == nil || p.Source == nil {
 		return ""
 	}
-	return *l.Name
+	return *p.Source
 }
 
-// GetPermissions returns the Permissions field if it's non-nil, zero value otherwise.
-func (l *License) GetPermissions() []string {
-	if l == nil || l.Permissions == nil {
-		return nil
+// GetAccessGrantedAt returns the AccessGrantedAt field if it's non-nil, zero value otherwise.
+func (p *PersonalAccessToken) GetAccessGrantedAt() Timestamp {
+	if p == nil || p.AccessGrantedAt == nil {
+		return Timestamp{}
 	}
-	return *l.Permissions
+	return *p.AccessGrantedAt
 }
 
-// GetSPDXID returns the SPDXID field if it's non-nil, zero value otherwise.
-func (l *License) GetSPDXID() string {
-	if l == nil || l.SPDXID == nil {
-		return ""
+// GetID returns the ID field if it's non-nil, zero value otherwise.
+func (p *PersonalAccessToken) GetID() int64 {
+	if p == nil || p.ID == nil {
+		return 0
 	}
-	return *l.SPDXID
+	return *p.ID
 }
 
-// GetURL returns the URL field if it's non-nil, zero value otherwise.
-func (l *License) GetURL() string {
-	if l == nil || l.URL == nil {
-		return ""
+// GetOwner returns the Owner field.
+func (p *PersonalAccessToken) GetOwner() *User {
+	if p == nil {
+		return nil
 	}
-	return *l.URL
+	return p.Owner
 }
 
-// GetCheckName returns the CheckName field if it's non-nil, zero value otherwise.
-func (l *ListCheckRunsOptions) GetCheckName() string {
-	if l == nil || l.CheckName == nil {
-		return ""
+// GetPermissions returns the Permissions field.
+func (p *PersonalAccessToken) GetPermissions() *PersonalAccessTokenPermissions {
+	if p == nil {
+		return nil
 	}
-	return *l.CheckName
+	return p.Permissions
 }
-
-// GetFilter returns the Filter field if it's non-nil, zero value otherwise.
-func (l *ListCheckRunsOptions) GetFilter() string {
-	if l == nil || l.Filter == nil {
+
+// GetRepositoriesURL returns the RepositoriesURL field if it's non-nil, zero value otherwise.
+func (p *PersonalAccessToken) GetRepositoriesURL() string {
+	if p == nil || p.RepositoriesURL == nil {
 		return ""
 	}
-	return *l.Filter
+	return *p.RepositoriesURL
 }
 
-// GetStatus returns the Status field if it's non-nil, zero value otherwise.
-func (l *ListCheckRunsOptions) GetStatus() string {
-	if l == nil || l.Status == nil {
+// GetRepositorySelection returns the RepositorySelection field if it's non-nil, zero value otherwise.
+func (p *PersonalAccessToken) GetRepositorySelection() string {
+	if p == nil || p.RepositorySelection == nil {
 		return ""
 	}
-	return *l.Status
+	return *p.RepositorySelection
 }
 
-// GetTotal returns the Total field if it's non-nil, zero value otherwise.
-func (l *ListCheckRunsResults) GetTotal() int {
-	if l == nil || l.Total == nil {
-		return 0
+// GetTokenExpired returns the TokenExpired field if it's non-nil, zero value otherwise.
+func (p *PersonalAccessToken) GetTokenExpired() bool {
+	if p == nil || p.TokenExpired == nil {
+		return false
 	}
-	return *l.Total
+	return *p.TokenExpired
 }
 
-// GetAppID returns the AppID field if it's non-nil, zero value otherwise.
-func (l *ListCheckSuiteOptions) GetAppID() int {
-	if l == nil || l.AppID == nil {
-		return 0
+// GetTokenExpiresAt returns the TokenExpiresAt field if it's non-nil, zero value otherwise.
+func (p *PersonalAccessToken) GetTokenExpiresAt() Timestamp {
+	if p == nil || p.TokenExpiresAt == nil {
+		return Timestamp{}
 	}
-	return *l.AppID
+	return *p.TokenExpiresAt
 }
 
-// GetCheckName returns the CheckName field if it's non-nil, zero value otherwise.
-func (l *ListCheckSuiteOptions) GetCheckName() string {
-	if l == nil || l.CheckName == nil {
-		return ""
+// GetTokenID returns the TokenID field if it's non-nil, zero value otherwise.
+func (p *PersonalAccessToken) GetTokenID() int64 {
+	if p == nil || p.TokenID == nil {
+		return 0
 	}
-	return *l.CheckName
+	return *p.TokenID
 }
 
-// GetTotal returns the Total field if it's non-nil, zero value otherwise.
-func (l *ListCheckSuiteResults) GetTotal() int {
-	if l == nil || l.Total == nil {
-		return 0
+// GetTokenLastUsedAt returns the TokenLastUsedAt field if it's non-nil, zero value otherwise.
+func (p *PersonalAccessToken) GetTokenLastUsedAt() Timestamp {
+	if p == nil || p.TokenLastUsedAt == nil {
+		return Timestamp{}
 	}
-	return *l.Total
+	return *p.TokenLastUsedAt
 }
 
-// GetAffiliation returns the Affiliation field if it's non-nil, zero value otherwise.
-func (l *ListCollaboratorOptions) GetAffiliation() string {
-	if l == nil || l.Affiliation == nil {
+// GetTokenName returns the TokenName field if it's non-nil, zero value otherwise.
+func (p *PersonalAccessToken) GetTokenName() string {
+	if p == nil || p.TokenName == nil {
 		return ""
 	}
-	return *l.Affiliation
+	return *p.TokenName
 }
 
-// GetEffectiveDate returns the EffectiveDate field if it's non-nil, zero value otherwise.
-func (m *MarketplacePendingChange) GetEffectiveDate() Timestamp {
-	if m == nil || m.EffectiveDate == nil {
+// GetCreatedAt returns the CreatedAt field if it's non-nil, zero value otherwise.
+func (p *PersonalAccessTokenRequest) GetCreatedAt() Timestamp {
+	if p == nil || p.CreatedAt == nil {
 		return Timestamp{}
 	}
-	return *m.EffectiveDate
+	return *p.CreatedAt
 }
 
 // GetID returns the ID field if it's non-nil, zero value otherwise.
-func (m *MarketplacePendingChange) GetID() int64 {
-	if m == nil || m.ID == nil {
+func (p *PersonalAccessTokenRequest) GetID() int64 {
+	if p == nil || p.ID == nil {
 		return 0
 	}
-	return *m.ID
+	return *p.ID
 }
 
-// GetPlan returns the Plan field.
-func (m *MarketplacePendingChange) GetPlan() *MarketplacePlan {
-	if m == nil {
+// GetOwner returns the Owner field.
+func (p *PersonalAccessTokenRequest) GetOwner() *User {
+	if p == nil {
 		return nil
 	}
-	return m.Plan
+	return p.Owner
 }
 
-// GetUnitCount returns the UnitCount field if it's non-nil, zero value otherwise.
-func (m *MarketplacePendingChange) GetUnitCount() int {
-	if m == nil || m.UnitCount == nil {
-		return 0
+// GetPermissionsAdded returns the PermissionsAdded field.
+func (p *PersonalAccessTokenRequest) GetPermissionsAdded() *PersonalAccessTokenPermissions {
+	if p == nil {
+		return nil
 	}
-	return *m.UnitCount
+	return p.PermissionsAdded
 }
 
-// GetAccountsURL returns the AccountsURL field if it's non-nil, zero value otherwise.
-func (m *MarketplacePlan) GetAccountsURL() string {
-	if m == nil || m.AccountsURL == nil {
-		return ""
+// GetPermissionsResult returns the PermissionsResult field.
+func (p *PersonalAccessTokenRequest) GetPermissionsResult() *PersonalAccessTokenPermissions {
+	if p == nil {
+		return nil
 	}
-	return *m.AccountsURL
+	return p.PermissionsResult
 }
 
-// GetBullets returns the Bullets field if it's non-nil, zero value otherwise.
-func (m *MarketplacePlan) GetBullets() []string {
-	if m == nil || m.Bullets == nil {
+// GetPermissionsUpgraded returns the PermissionsUpgraded field.
+func (p *PersonalAccessTokenRequest) GetPermissionsUpgraded() *PersonalAccessTokenPermissions {
+	if p == nil {
 		return nil
 	}
-	return *m.Bullets
+	return p.PermissionsUpgraded
 }
 
-// GetDescription returns the Description field if it's non-nil, zero value otherwise.
-func (m *MarketplacePlan) GetDescription() string {
-	if m == nil || m.Description == nil {
+// GetReason returns the Reason field if it's non-nil, zero value otherwise.
+func (p *PersonalAccessTokenRequest) GetReason() string {
+	if p == nil || p.Reason == nil {
 		return ""
 	}
-	return *m.Description
-}
-
-// GetHasFreeTrial returns the HasFreeTrial field if it's non-nil, zero value otherwise.
-func (m *MarketplacePlan) GetHasFreeTrial() bool {
-	if m == nil || m.HasFreeTrial == nil {
-		return false
-	}
-	return *m.HasFreeTrial
+	return *p.Reason
 }
 
-// GetID returns the ID field if it's non-nil, zero value otherwise.
-func (m *MarketplacePlan) GetID() int64 {
-	if m == nil || m.ID == nil {
+// GetRepositoryCount returns the RepositoryCount field if it's non-nil, zero value otherwise.
+func (p *PersonalAccessTokenRequest) GetRepositoryCount() int64 {
+	if p == nil || p.RepositoryCount == nil {
 		return 0
 	}
-	return *m.ID
+	return *p.RepositoryCount
 }
 
-// GetMonthlyPriceInCents returns the MonthlyPriceInCents field if it's non-nil, zero value otherwise.
-func (m *MarketplacePlan) GetMonthlyPriceInCents() int {
-	if m == nil || m.MonthlyPriceInCents == nil {
-		return 0
+// GetRepositorySelection returns the RepositorySelection field if it's non-nil, zero value otherwise.
+func (p *PersonalAccessTokenRequest) GetRepositorySelection() string {
+	if p == nil || p.RepositorySelection == nil {
+		return ""
 	}
-	return *m.MonthlyPriceInCents
+	return *p.RepositorySelection
 }
 
-// GetName returns the Name field if it's non-nil, zero value otherwise.
-func (m *MarketplacePlan) GetName() string {
-	if m == nil || m.Name == nil {
-		return ""
+// GetTokenExpired returns the TokenExpired field if it's non-nil, zero value otherwise.
+func (p *PersonalAccessTokenRequest) GetTokenExpired() bool {
+	if p == nil || p.TokenExpired == nil {
+		return false
 	}
-	return *m.Name
+	return *p.TokenExpired
 }
 
-// GetPriceModel returns the PriceModel field if it's non-nil, zero value otherwise.
-func (m *MarketplacePlan) GetPriceModel() string {
-	if m == nil || m.PriceModel == nil {
-		return ""
+// GetTokenExpiresAt returns the TokenExpiresAt field if it's non-nil, zero value otherwise.
+func (p *PersonalAccessTokenRequest) GetTokenExpiresAt() Timestamp {
+	if p == nil || p.TokenExpiresAt == nil {
+		return Timestamp{}
 	}
-	return *m.PriceModel
+	return *p.TokenExpiresAt
 }
 
-// GetState returns the State field if it's non-nil, zero value otherwise.
-func (m *MarketplacePlan) GetState() string {
-	if m == nil || m.State == nil {
-		return ""
+// GetTokenID returns the TokenID field if it's non-nil, zero value otherwise.
+func (p *PersonalAccessTokenRequest) GetTokenID() int64 {
+	if p == nil || p.TokenID == nil {
+		return 0
 	}
-	return *m.State
+	return *p.TokenID
 }
 
-// GetUnitName returns the UnitName field if it's non-nil, zero value otherwise.
-func (m *MarketplacePlan) GetUnitName() string {
-	if m == nil || m.UnitName == nil {
-		return ""
+// GetTokenLastUsedAt returns the TokenLastUsedAt field if it's non-nil, zero value otherwise.
+func (p *PersonalAccessTokenRequest) GetTokenLastUsedAt() Timestamp {
+	if p == nil || p.TokenLastUsedAt == nil {
+		return Timestamp{}
 	}
-	return *m.UnitName
+	return *p.TokenLastUsedAt
 }
 
-// GetURL returns the URL field if it's non-nil, zero value otherwise.
-func (m *MarketplacePlan) GetURL() string {
-	if m == nil || m.URL == nil {
+// GetTokenName returns the TokenName field if it's non-nil, zero value otherwise.
+func (p *PersonalAccessTokenRequest) GetTokenName() string {
+	if p == nil || p.TokenName == nil {
 		return ""
 	}
-	return *m.URL
+	return *p.TokenName
 }
 
-// GetYearlyPriceInCents returns the YearlyPriceInCents field if it's non-nil, zero value otherwise.
-func (m *MarketplacePlan) GetYearlyPriceInCents() int {
-	if m == nil || m.YearlyPriceInCents == nil {
-		return 0
+// GetHook returns the Hook field.
+func (p *PingEvent) GetHook() *Hook {
+	if p == nil {
+		return nil
 	}
-	return *m.YearlyPriceInCents
+	return p.Hook
 }
 
-// GetEmail returns the Email field if it's non-nil, zero value otherwise.
-func (m *MarketplacePlanAccount) GetEmail() string {
-	if m == nil || m.Email == nil {
-		return ""
+// GetHookID returns the HookID field if it's non-nil, zero value otherwise.
+func (p *PingEvent) GetHookID() int64 {
+	if p == nil || p.HookID == nil {
+		return 0
 	}
-	return *m.Email
+	return *p.HookID
 }
 
-// GetID returns the ID field if it's non-nil, zero value otherwise.
-func (m *MarketplacePlanAccount) GetID() int64 {
-	if m == nil || m.ID == nil {
-		return 0
+// GetInstallation returns the Installation field.
+func (p *PingEvent) GetInstallation() *Installation {
+	if p == nil {
+		return nil
 	}
-	return *m.ID
+	return p.Installation
 }
 
-// GetLogin returns the Login field if it's non-nil, zero value otherwise.
-func (m *MarketplacePlanAccount) GetLogin() string {
-	if m == nil || m.Login == nil {
+// GetZen returns the Zen field if it's non-nil, zero value otherwise.
+func (p *PingEvent) GetZen() string {
+	if p == nil || p.Zen == nil {
 		return ""
 	}
-	return *m.Login
+	return *p.Zen
 }
 
-// GetMarketplacePendingChange returns the MarketplacePendingChange field.
-func (m *MarketplacePlanAccount) GetMarketplacePendingChange() *MarketplacePendingChange {
-	if m == nil {
-		return nil
+// GetCollaborators returns the Collaborators field if it's non-nil, zero value otherwise.
+func (p *Plan) GetCollaborators() int {
+	if p == nil || p.Collaborators == nil {
+		return 0
 	}
-	return m.MarketplacePendingChange
+	return *p.Collaborators
 }
 
-// GetMarketplacePurchase returns the MarketplacePurchase field.
-func (m *MarketplacePlanAccount) GetMarketplacePurchase() *MarketplacePurchase {
-	if m == nil {
-		return nil
+// GetFilledSeats returns the FilledSeats field if it's non-nil, zero value otherwise.
+func (p *Plan) GetFilledSeats() int {
+	if p == nil || p.FilledSeats == nil {
+		return 0
 	}
-	return m.MarketplacePurchase
+	return *p.FilledSeats
 }
 
-// GetNodeID returns the NodeID field if it's non-nil, zero value otherwise.
-func (m *MarketplacePlanAccount) GetNodeID() string {
-	if m == nil || m.NodeID == nil {
+// GetName returns the Name field if it's non-nil, zero value otherwise.
+func (p *Plan) GetName() string {
+	if p == nil || p.Name == nil {
 		return ""
 	}
-	return *m.NodeID
+	return *p.Name
 }
 
-// GetOrganizationBillingEmail returns the OrganizationBillingEmail field if it's non-nil, zero value otherwise.
-func (m *MarketplacePlanAccount) GetOrganizationBillingEmail() string {
-	if m == nil || m.OrganizationBillingEmail == nil {
-		return ""
+// GetPrivateRepos returns the PrivateRepos field if it's non-nil, zero value otherwise.
+func (p *Plan) GetPrivateRepos() int {
+	if p == nil || p.PrivateRepos == nil {
+		return 0
 	}
-	return *m.OrganizationBillingEmail
+	return *p.PrivateRepos
 }
 
-// GetType returns the Type field if it's non-nil, zero value otherwise.
-func (m *MarketplacePlanAccount) GetType() string {
-	if m == nil || m.Type == nil {
-		return ""
+// GetSeats returns the Seats field if it's non-nil, zero value otherwise.
+func (p *Plan) GetSeats() int {
+	if p == nil || p.Seats == nil {
+		return 0
 	}
-	return *m.Type
+	return *p.Seats
 }
 
-// GetURL returns the URL field if it's non-nil, zero value otherwise.
-func (m *MarketplacePlanAccount) GetURL() string {
-	if m == nil || m.URL == nil {
-		return ""
+// GetSpace returns the Space field if it's non-nil, zero value otherwise.
+func (p *Plan) GetSpace() int {
+	if p == nil || p.Space == nil {
+		return 0
 	}
-	return *m.URL
+	return *p.Space
 }
 
-// GetAccount returns the Account field.
-func (m *MarketplacePurchase) GetAccount() *MarketplacePlanAccount {
-	if m == nil {
-		return nil
+// GetConfigURL returns the ConfigURL field if it's non-nil, zero value otherwise.
+func (p *PreReceiveHook) GetConfigURL() string {
+	if p == nil || p.ConfigURL == nil {
+		return ""
 	}
-	return m.Account
+	return *p.ConfigURL
 }
 
-// GetBillingCycle returns the BillingCycle field if it's non-nil, zero value otherwise.
-func (m *MarketplacePurchase) GetBillingCycle() string {
-	if m == nil || m.BillingCycle == nil {
+// GetEnforcement returns the Enforcement field if it's non-nil, zero value otherwise.
+func (p *PreReceiveHook) GetEnforcement() string {
+	if p == nil || p.Enforcement == nil {
 		return ""
 	}
-	return *m.BillingCycle
+	return *p.Enforcement
 }
 
-// GetFreeTrialEndsOn returns the FreeTrialEndsOn field if it's non-nil, zero value otherwise.
-func (m *MarketplacePurchase) GetFreeTrialEndsOn() Timestamp {
-	if m == nil || m.FreeTrialEndsOn == nil {
-		return Timestamp{}
+// GetID returns the ID field if it's non-nil, zero value otherwise.
+func (p *PreReceiveHook) GetID() int64 {
+	if p == nil || p.ID == nil {
+		return 0
 	}
-	return *m.FreeTrialEndsOn
+	return *p.ID
 }
 
-// GetNextBillingDate returns the NextBillingDate field if it's non-nil, zero value otherwise.
-func (m *MarketplacePurchase) GetNextBillingDate() Timestamp {
-	if m == nil || m.NextBillingDate == nil {
-		return Timestamp{}
+// GetName returns the Name field if it's non-nil, zero value otherwise.
+func (p *PreReceiveHook) GetName() string {
+	if p == nil || p.Name == nil {
+		return ""
 	}
-	return *m.NextBillingDate
+	return *p.Name
 }
 
-// GetOnFreeTrial returns the OnFreeTrial field if it's non-nil, zero value otherwise.
-func (m *MarketplacePurchase) GetOnFreeTrial() bool {
-	if m == nil || m.OnFreeTrial == nil {
-		return false
+// GetHRef returns the HRef field if it's non-nil, zero value otherwise.
+func (p *PRLink) GetHRef() string {
+	if p == nil || p.HRef == nil {
+		return ""
 	}
-	return *m.OnFreeTrial
+	return *p.HRef
 }
 
-// GetPlan returns the Plan field.
-func (m *MarketplacePurchase) GetPlan() *MarketplacePlan {
-	if m == nil {
+// GetComments returns the Comments field.
+func (p *PRLinks) GetComments() *PRLink {
+	if p == nil {
 		return nil
 	}
-	return m.Plan
+	return p.Comments
 }
 
-// GetUnitCount returns the UnitCount field if it's non-nil, zero value otherwise.
-func (m *MarketplacePurchase) GetUnitCount() int {
-	if m == nil || m.UnitCount == nil {
-		return 0
+// GetCommits returns the Commits field.
+func (p *PRLinks) GetCommits() *PRLink {
+	if p == nil {
+		return nil
 	}
-	return *m.UnitCount
+	return p.Commits
 }
 
-// GetAction returns the Action field if it's non-nil, zero value otherwise.
-func (m *MarketplacePurchaseEvent) GetAction() string {
-	if m == nil || m.Action == nil {
-		return ""
+// GetHTML returns the HTML field.
+func (p *PRLinks) GetHTML() *PRLink {
+	if p == nil {
+		return nil
 	}
-	return *m.Action
+	return p.HTML
 }
 
-// GetEffectiveDate returns the EffectiveDate field if it's non-nil, zero value otherwise.
-func (m *MarketplacePurchaseEvent) GetEffectiveDate() Timestamp {
-	if m == nil || m.EffectiveDate == nil {
-		return Timestamp{}
+// GetIssue returns the Issue field.
+func (p *PRLinks) GetIssue() *PRLink {
+	if p == nil {
+		return nil
 	}
-	return *m.EffectiveDate
+	return p.Issue
 }
 
-// GetInstallation returns the Installation field.
-func (m *MarketplacePurchaseEvent) GetInstallation() *Installation {
-	if m == nil {
+// GetReviewComment returns the ReviewComment field.
+func (p *PRLinks) GetReviewComment() *PRLink {
+	if p == nil {
 		return nil
 	}
-	return m.Installation
+	return p.ReviewComment
 }
 
-// GetMarketplacePurchase returns the MarketplacePurchase field.
-func (m *MarketplacePurchaseEvent) GetMarketplacePurchase() *MarketplacePurchase {
-	if m == nil {
+// GetReviewComments returns the ReviewComments field.
+func (p *PRLinks) GetReviewComments() *PRLink {
+	if p == nil {
 		return nil
 	}
-	return m.MarketplacePurchase
+	return p.ReviewComments
 }
 
-// GetPreviousMarketplacePurchase returns the PreviousMarketplacePurchase field.
-func (m *MarketplacePurchaseEvent) GetPreviousMarketplacePurchase() *MarketplacePurchase {
-	if m == nil {
+// GetSelf returns the Self field.
+func (p *PRLinks) GetSelf() *PRLink {
+	if p == nil {
 		return nil
 	}
-	return m.PreviousMarketplacePurchase
+	return p.Self
 }
 
-// GetSender returns the Sender field.
-func (m *MarketplacePurchaseEvent) GetSender() *User {
-	if m == nil {
+// GetStatuses returns the Statuses field.
+func (p *PRLinks) GetStatuses() *PRLink {
+	if p == nil {
 		return nil
 	}
-	return m.Sender
+	return p.Statuses
 }
 
-// GetText returns the Text field if it's non-nil, zero value otherwise.
-func (m *Match) GetText() string {
-	if m == nil || m.Text == nil {
+// GetBody returns the Body field if it's non-nil, zero value otherwise.
+func (p *Project) GetBody() string {
+	if p == nil || p.Body == nil {
 		return ""
 	}
-	return *m.Text
+	return *p.Body
 }
 
-// GetAction returns the Action field if it's non-nil, zero value otherwise.
-func (m *MemberEvent) GetAction() string {
-	if m == nil || m.Action == nil {
+// GetColumnsURL returns the ColumnsURL field if it's non-nil, zero value otherwise.
+func (p *Project) GetColumnsURL() string {
+	if p == nil || p.ColumnsURL == nil {
 		return ""
 	}
-	return *m.Action
+	return *p.ColumnsURL
 }
 
-// GetInstallation returns the Installation field.
-func (m *MemberEvent) GetInstallation() *Installation {
-	if m == nil {
-		return nil
+// GetCreatedAt returns the CreatedAt field if it's non-nil, zero value otherwise.
+func (p *Project) GetCreatedAt() Timestamp {
+	if p == nil || p.CreatedAt == nil {
+		return Timestamp{}
 	}
-	return m.Installation
+	return *p.CreatedAt
 }
 
-// GetMember returns the Member field.
-func (m *MemberEvent) GetMember() *User {
-	if m == nil {
+// GetCreator returns the Creator field.
+func (p *Project) GetCreator() *User {
+	if p == nil {
 		return nil
 	}
-	return m.Member
+	return p.Creator
 }
 
-// GetRepo returns the Repo field.
-func (m *MemberEvent) GetRepo() *Repository {
-	if m == nil {
-		return nil
+// GetHTMLURL returns the HTMLURL field if it's non-nil, zero value otherwise.
+func (p *Project) GetHTMLURL() string {
+	if p == nil || p.HTMLURL == nil {
+		return ""
 	}
-	return m.Repo
+	return *p.HTMLURL
 }
 
-// GetSender returns the Sender field.
-func (m *MemberEvent) GetSender() *User {
-	if m == nil {
-		return nil
+// GetID returns the ID field if it's non-nil, zero value otherwise.
+func (p *Project) GetID() int64 {
+	if p == nil || p.ID == nil {
+		return 0
 	}
-	return m.Sender
+	return *p.ID
 }
 
-// GetOrganization returns the Organization field.
-func (m *Membership) GetOrganization() *Organization {
-	if m == nil {
-		return nil
+// GetName returns the Name field if it's non-nil, zero value otherwise.
+func (p *Project) GetName() string {
+	if p == nil || p.Name == nil {
+		return ""
 	}
-	return m.Organization
+	return *p.Name
 }
 
-// GetOrganizationURL returns the OrganizationURL field if it's non-nil, zero value otherwise.
-func (m *Membership) GetOrganizationURL() string {
-	if m == nil || m.OrganizationURL == nil {
+// GetNodeID returns the NodeID field if it's non-nil, zero value otherwise.
+func (p *Project) GetNodeID() string {
+	if p == nil || p.NodeID == nil {
 		return ""
 	}
-	return *m.OrganizationURL
+	return *p.NodeID
 }
 
-// GetRole returns the Role field if it's non-nil, zero value otherwise.
-func (m *Membership) GetRole() string {
-	if m == nil || m.Role == nil {
+// GetNumber returns the Number field if it's non-nil, zero value otherwise.
+func (p *Project) GetNumber() int {
+	if p == nil || p.Number == nil {
+		return 0
+	}
+	return *p.Number
+}
+
+// GetOwnerURL returns the OwnerURL field if it's non-nil, zero value otherwise.
+func (p *Project) GetOwnerURL() string {
+	if p == nil || p.OwnerURL == nil {
 		return ""
 	}
-	return *m.Role
+	return *p.OwnerURL
 }
 
 // GetState returns the State field if it's non-nil, zero value otherwise.
-func (m *Membership) GetState() string {
-	if m == nil || m.State == nil {
+func (p *Project) GetState() string {
+	if p == nil || p.State == nil {
 		return ""
 	}
-	return *m.State
+	return *p.State
+}
+
+// GetUpdatedAt returns the UpdatedAt field if it's non-nil, zero value otherwise.
+func (p *Project) GetUpdatedAt() Timestamp {
+	if p == nil || p.UpdatedAt == nil {
+		return Timestamp{}
+	}
+	return *p.UpdatedAt
 }
 
 // GetURL returns the URL field if it's non-nil, zero value otherwise.
-func (m *Membership) GetURL() string {
-	if m == nil || m.URL == nil {
+func (p *Project) GetURL() string {
+	if p == nil || p.URL == nil {
 		return ""
 	}
-	return *m.URL
+	return *p.URL
 }
 
-// GetUser returns the User field.
-func (m *Membership) GetUser() *User {
-	if m == nil {
-		return nil
+// GetArchived returns the Archived field if it's non-nil, zero value otherwise.
+func (p *ProjectCard) GetArchived() bool {
+	if p == nil || p.Archived == nil {
+		return false
 	}
-	return m.User
+	return *p.Archived
 }
 
-// GetAction returns the Action field if it's non-nil, zero value otherwise.
-func (m *MembershipEvent) GetAction() string {
-	if m == nil || m.Action == nil {
+// GetColumnID returns the ColumnID field if it's non-nil, zero value otherwise.
+func (p *ProjectCard) GetColumnID() int64 {
+	if p == nil || p.ColumnID == nil {
+		return 0
+	}
+	return *p.ColumnID
+}
+
+// GetColumnName returns the ColumnName field if it's non-nil, zero value otherwise.
+func (p *ProjectCard) GetColumnName() string {
+	if p == nil || p.ColumnName == nil {
 		return ""
 	}
-	return *m.Action
+	return *p.ColumnName
 }
 
-// GetInstallation returns the Installation field.
-func (m *MembershipEvent) GetInstallation() *Installation {
-	if m == nil {
-		return nil
+// GetColumnURL returns the ColumnURL field if it's non-nil, zero value otherwise.
+func (p *ProjectCard) GetColumnURL() string {
+	if p == nil || p.ColumnURL == nil {
+		return ""
 	}
-	return m.Installation
+	return *p.ColumnURL
 }
 
-// GetMember returns the Member field.
-func (m *MembershipEvent) GetMember() *User {
-	if m == nil {
-		return nil
+// GetContentURL returns the ContentURL field if it's non-nil, zero value otherwise.
+func (p *ProjectCard) GetContentURL() string {
+	if p == nil || p.ContentURL == nil {
+		return ""
 	}
-	return m.Member
+	return *p.ContentURL
 }
 
-// GetOrg returns the Org field.
-func (m *MembershipEvent) GetOrg() *Organization {
-	if m == nil {
-		return nil
+// GetCreatedAt returns the CreatedAt field if it's non-nil, zero value otherwise.
+func (p *ProjectCard) GetCreatedAt() Timestamp {
+	if p == nil || p.CreatedAt == nil {
+		return Timestamp{}
 	}
-	return m.Org
+	return *p.CreatedAt
 }
 
-// GetScope returns the Scope field if it's non-nil, zero value otherwise.
-func (m *MembershipEvent) GetScope() string {
-	if m == nil || m.Scope == nil {
-		return ""
+// GetCreator returns the Creator field.
+func (p *ProjectCard) GetCreator() *User {
+	if p == nil {
+		return nil
 	}
-	return *m.Scope
+	return p.Creator
 }
 
-// GetSender returns the Sender field.
-func (m *MembershipEvent) GetSender() *User {
-	if m == nil {
-		return nil
+// GetID returns the ID field if it's non-nil, zero value otherwise.
+func (p *ProjectCard) GetID() int64 {
+	if p == nil || p.ID == nil {
+		return 0
 	}
-	return m.Sender
+	return *p.ID
 }
 
-// GetTeam returns the Team field.
-func (m *MembershipEvent) GetTeam() *Team {
-	if m == nil {
-		return nil
+// GetNodeID returns the NodeID field if it's non-nil, zero value otherwise.
+func (p *ProjectCard) GetNodeID() string {
+	if p == nil || p.NodeID == nil {
+		return ""
 	}
-	return m.Team
+	return *p.NodeID
 }
 
-// GetAction returns the Action field if it's non-nil, zero value otherwise.
-func (m *MetaEvent) GetAction() string {
-	if m == nil || m.Action == nil {
+// GetNote returns the Note field if it's non-nil, zero value otherwise.
+func (p *ProjectCard) GetNote() string {
+	if p == nil || p.Note == nil {
 		return ""
 	}
-	return *m.Action
+	return *p.Note
 }
 
-// GetHook returns the Hook field.
-func (m *MetaEvent) GetHook() *Hook {
-	if m == nil {
-		return nil
+// GetPreviousColumnName returns the PreviousColumnName field if it's non-nil, zero value otherwise.
+func (p *ProjectCard) GetPreviousColumnName() string {
+	if p == nil || p.PreviousColumnName == nil {
+		return ""
 	}
-	return m.Hook
+	return *p.PreviousColumnName
 }
 
-// GetHookID returns the HookID field if it's non-nil, zero value otherwise.
-func (m *MetaEvent) GetHookID() int64 {
-	if m == nil || m.HookID == nil {
+// GetProjectID returns the ProjectID field if it's non-nil, zero value otherwise.
+func (p *ProjectCard) GetProjectID() int64 {
+	if p == nil || p.ProjectID == nil {
 		return 0
 	}
-	return *m.HookID
+	return *p.ProjectID
 }
 
-// GetHTMLURL returns the HTMLURL field if it's non-nil, zero value otherwise.
-func (m *Metric) GetHTMLURL() string {
-	if m == nil || m.HTMLURL == nil {
+// GetProjectURL returns the ProjectURL field if it's non-nil, zero value otherwise.
+func (p *ProjectCard) GetProjectURL() string {
+	if p == nil || p.ProjectURL == nil {
 		return ""
 	}
-	return *m.HTMLURL
+	return *p.ProjectURL
 }
 
-// GetKey returns the Key field if it's non-nil, zero value otherwise.
-func (m *Metric) GetKey() string {
-	if m == nil || m.Key == nil {
-		return ""
+// GetUpdatedAt returns the UpdatedAt field if it's non-nil, zero value otherwise.
+func (p *ProjectCard) GetUpdatedAt() Timestamp {
+	if p == nil || p.UpdatedAt == nil {
+		return Timestamp{}
 	}
-	return *m.Key
+	return *p.UpdatedAt
 }
 
-// GetName returns the Name field if it's non-nil, zero value otherwise.
-func (m *Metric) GetName() string {
-	if m == nil || m.Name == nil {
+// GetURL returns the URL field if it's non-nil, zero value otherwise.
+func (p *ProjectCard) GetURL() string {
+	if p == nil || p.URL == nil {
 		return ""
 	}
-	return *m.Name
+	return *p.URL
 }
 
-// GetURL returns the URL field if it's non-nil, zero value otherwise.
-func (m *Metric) GetURL() string {
-	if m == nil || m.URL == nil {
+// GetAction returns the Action field if it's non-nil, zero value otherwise.
+func (p *ProjectCardEvent) GetAction() string {
+	if p == nil || p.Action == nil {
 		return ""
 	}
-	return *m.URL
+	return *p.Action
 }
 
-// GetCreatedAt returns the CreatedAt field if it's non-nil, zero value otherwise.
-func (m *Migration) GetCreatedAt() string {
-	if m == nil || m.CreatedAt == nil {
-		return ""
+// GetAfterID returns the AfterID field if it's non-nil, zero value otherwise.
+func (p *ProjectCardEvent) GetAfterID() int64 {
+	if p == nil || p.AfterID == nil {
+		return 0
 	}
-	return *m.CreatedAt
+	return *p.AfterID
 }
 
-// GetExcludeAttachments returns the ExcludeAttachments field if it's non-nil, zero value otherwise.
-func (m *Migration) GetExcludeAttachments() bool {
-	if m == nil || m.ExcludeAttachments == nil {
-		return false
+// GetChanges returns the Changes field.
+func (p *ProjectCardEvent) GetChanges() *ProjectCardChange {
+	if p == nil {
+		return nil
 	}
-	return *m.ExcludeAttachments
+	return p.Changes
 }
 
-// GetGUID returns the GUID field if it's non-nil, zero value otherwise.
-func (m *Migration) GetGUID() string {
-	if m == nil || m.GUID == nil {
-		return ""
+// GetInstallation returns the Installation field.
+func (p *ProjectCardEvent) GetInstallation() *Installation {
+	if p == nil {
+		return nil
 	}
-	return *m.GUID
+	return p.Installation
 }
 
-// GetID returns the ID field if it's non-nil, zero value otherwise.
-func (m *Migration) GetID() int64 {
-	if m == nil || m.ID == nil {
-		return 0
+// GetOrg returns the Org field.
+func (p *ProjectCardEvent) GetOrg() *Organization {
+	if p == nil {
+		return nil
 	}
-	return *m.ID
+	return p.Org
 }
 
-// GetLockRepositories returns the LockRepositories field if it's non-nil, zero value otherwise.
-func (m *Migration) GetLockRepositories() bool {
-	if m == nil || m.LockRepositories == nil {
-		return false
+// GetProjectCard returns the ProjectCard field.
+func (p *ProjectCardEvent) GetProjectCard() *ProjectCard {
+	if p == nil {
+		return nil
 	}
-	return *m.LockRepositories
+	return p.ProjectCard
 }
 
-// GetState returns the State field if it's non-nil, zero value otherwise.
-func (m *Migration) GetState() string {
-	if m == nil || m.State == nil {
-		return ""
+// GetRepo returns the Repo field.
+func (p *ProjectCardEvent) GetRepo() *Repository {
+	if p == nil {
+		return nil
 	}
-	return *m.State
+	return p.Repo
 }
 
-// GetUpdatedAt returns the UpdatedAt field if it's non-nil, zero value otherwise.
-func (m *Migration) GetUpdatedAt() string {
-	if m == nil || m.UpdatedAt == nil {
-		return ""
+// GetSender returns the Sender field.
+func (p *ProjectCardEvent) GetSender() *User {
+	if p == nil {
+		return nil
 	}
-	return *m.UpdatedAt
+	return p.Sender
 }
 
-// GetURL returns the URL field if it's non-nil, zero value otherwise.
-func (m *Migration) GetURL() string {
-	if m == nil || m.URL == nil {
+// GetArchivedState returns the ArchivedState field if it's non-nil, zero value otherwise.
+func (p *ProjectCardListOptions) GetArchivedState() string {
+	if p == nil || p.ArchivedState == nil {
 		return ""
 	}
-	return *m.URL
+	return *p.ArchivedState
 }
 
-// GetClosedAt returns the ClosedAt field if it's non-nil, zero value otherwise.
-func (m *Milestone) GetClosedAt() time.Time {
-	if m == nil || m.ClosedAt == nil {
-		return time.Time{}
+// GetArchived returns the Archived field if it's non-nil, zero value otherwise.
+func (p *ProjectCardOptions) GetArchived() bool {
+	if p == nil || p.Archived == nil {
+		return false
 	}
-	return *m.ClosedAt
+	return *p.Archived
 }
 
-// GetClosedIssues returns the ClosedIssues field if it's non-nil, zero value otherwise.
-func (m *Milestone) GetClosedIssues() int {
-	if m == nil || m.ClosedIssues == nil {
-		return 0
+// GetPermission returns the Permission field if it's non-nil, zero value otherwise.
+func (p *ProjectCollaboratorOptions) GetPermission() string {
+	if p == nil || p.Permission == nil {
+		return ""
 	}
-	return *m.ClosedIssues
+	return *p.Permission
+}
+
+// GetCardsURL returns the CardsURL field if it's non-nil, zero value otherwise.
+func (p *ProjectColumn) GetCardsURL() string {
+	if p == nil || p.CardsURL == nil {
+		return ""
+	}
+	return *p.CardsURL
 }
 
 // GetCreatedAt returns the CreatedAt field if it's non-nil, zero value otherwise.
-func (m *Milestone) GetCreatedAt() time.Time {
-	if m == nil || m.CreatedAt == nil {
-		return time.Time{}
+func (p *ProjectColumn) GetCreatedAt() Timestamp {
+	if p == nil || p.CreatedAt == nil {
+		return Timestamp{}
 	}
-	return *m.CreatedAt
+	return *p.CreatedAt
 }
 
-// GetCreator returns the Creator field.
-func (m *Milestone) GetCreator() *User {
-	if m == nil {
-		return nil
+// GetID returns the ID field if it's non-nil, zero value otherwise.
+func (p *ProjectColumn) GetID() int64 {
+	if p == nil || p.ID == nil {
+		return 0
 	}
-	return m.Creator
+	return *p.ID
 }
 
-// GetDescription returns the Description field if it's non-nil, zero value otherwise.
-func (m *Milestone) GetDescription() string {
-	if m == nil || m.Description == nil {
+// GetName returns the Name field if it's non-nil, zero value otherwise.
+func (p *ProjectColumn) GetName() string {
+	if p == nil || p.Name == nil {
 		return ""
 	}
-	return *m.Description
+	return *p.Name
 }
 
-// GetDueOn returns the DueOn field if it's non-nil, zero value otherwise.
-func (m *Milestone) GetDueOn() time.Time {
-	if m == nil || m.DueOn == nil {
-		return time.Time{}
+// GetNodeID returns the NodeID field if it's non-nil, zero value otherwise.
+func (p *ProjectColumn) GetNodeID() string {
+	if p == nil || p.NodeID == nil {
+		return ""
 	}
-	return *m.DueOn
+	return *p.NodeID
 }
 
-// GetHTMLURL returns the HTMLURL field if it's non-nil, zero value otherwise.
-func (m *Milestone) GetHTMLURL() string {
-	if m == nil || m.HTMLURL == nil {
+// GetProjectURL returns the ProjectURL field if it's non-nil, zero value otherwise.
+func (p *ProjectColumn) GetProjectURL() string {
+	if p == nil || p.ProjectURL == nil {
 		return ""
 	}
-	return *m.HTMLURL
+	return *p.ProjectURL
 }
 
-// GetID returns the ID field if it's non-nil, zero value otherwise.
-func (m *Milestone) GetID() int64 {
-	if m == nil || m.ID == nil {
-		return 0
+// GetUpdatedAt returns the UpdatedAt field if it's non-nil, zero value otherwise.
+func (p *ProjectColumn) GetUpdatedAt() Timestamp {
+	if p == nil || p.UpdatedAt == nil {
+		return Timestamp{}
 	}
-	return *m.ID
+	return *p.UpdatedAt
 }
 
-// GetLabelsURL returns the LabelsURL field if it's non-nil, zero value otherwise.
-func (m *Milestone) GetLabelsURL() string {
-	if m == nil || m.LabelsURL == nil {
+// GetURL returns the URL field if it's non-nil, zero value otherwise.
+func (p *ProjectColumn) GetURL() string {
+	if p == nil || p.URL == nil {
 		return ""
 	}
-	return *m.LabelsURL
+	return *p.URL
 }
 
-// GetNodeID returns the NodeID field if it's non-nil, zero value otherwise.
-func (m *Milestone) GetNodeID() string {
-	if m == nil || m.NodeID == nil {
+// GetAction returns the Action field if it's non-nil, zero value otherwise.
+func (p *ProjectColumnEvent) GetAction() string {
+	if p == nil || p.Action == nil {
 		return ""
 	}
-	return *m.NodeID
+	return *p.Action
 }
 
-// GetNumber returns the Number field if it's non-nil, zero value otherwise.
-func (m *Milestone) GetNumber() int {
-	if m == nil || m.Number == nil {
+// GetAfterID returns the AfterID field if it's non-nil, zero value otherwise.
+func (p *ProjectColumnEvent) GetAfterID() int64 {
+	if p == nil || p.AfterID == nil {
 		return 0
 	}
-	return *m.Number
+	return *p.AfterID
 }
 
-// GetOpenIssues returns the OpenIssues field if it's non-nil, zero value otherwise.
-func (m *Milestone) GetOpenIssues() int {
-	if m == nil || m.OpenIssues == nil {
-		return 0
+// GetChanges returns the Changes field.
+func (p *ProjectColumnEvent) GetChanges() *ProjectColumnChange {
+	if p == nil {
+		return nil
 	}
-	return *m.OpenIssues
+	return p.Changes
 }
 
-// GetState returns the State field if it's non-nil, zero value otherwise.
-func (m *Milestone) GetState() string {
-	if m == nil || m.State == nil {
-		return ""
+// GetInstallation returns the Installation field.
+func (p *ProjectColumnEvent) GetInstallation() *Installation {
+	if p == nil {
+		return nil
 	}
-	return *m.State
+	return p.Installation
 }
 
-// GetTitle returns the Title field if it's non-nil, zero value otherwise.
-func (m *Milestone) GetTitle() string {
-	if m == nil || m.Title == nil {
-		return ""
+// GetOrg returns the Org field.
+func (p *ProjectColumnEvent) GetOrg() *Organization {
+	if p == nil {
+		return nil
 	}
-	return *m.Title
+	return p.Org
 }
 
-// GetUpdatedAt returns the UpdatedAt field if it's non-nil, zero value otherwise.
-func (m *Milestone) GetUpdatedAt() time.Time {
-	if m == nil || m.UpdatedAt == nil {
-		return time.Time{}
+// GetProjectColumn returns the ProjectColumn field.
+func (p *ProjectColumnEvent) GetProjectColumn() *ProjectColumn {
+	if p == nil {
+		return nil
 	}
-	return *m.UpdatedAt
+	return p.ProjectColumn
 }
 
-// GetURL returns the URL field if it's non-nil, zero value otherwise.
-func (m *Milestone) GetURL() string {
-	if m == nil || m.URL == nil {
-		return ""
+// GetRepo returns the Repo field.
+func (p *ProjectColumnEvent) GetRepo() *Repository {
+	if p == nil {
+		return nil
 	}
-	return *m.URL
+	return p.Repo
+}
+
+// GetSender returns the Sender field.
+func (p *ProjectColumnEvent) GetSender() *User {
+	if p == nil {
+		return nil
+	}
+	return p.Sender
 }
 
 // GetAction returns the Action field if it's non-nil, zero value otherwise.
-func (m *MilestoneEvent) GetAction() string {
-	if m == nil || m.Action == nil {
+func (p *ProjectEvent) GetAction() string {
+	if p == nil || p.Action == nil {
 		return ""
 	}
-	return *m.Action
+	return *p.Action
 }
 
 // GetChanges returns the Changes field.
-func (m *MilestoneEvent) GetChanges() *EditChange {
-	if m == nil {
+func (p *ProjectEvent) GetChanges() *ProjectChange {
+	if p == nil {
 		return nil
 	}
-	return m.Changes
+	return p.Changes
 }
 
 // GetInstallation returns the Installation field.
-func (m *MilestoneEvent) GetInstallation() *Installation {
-	if m == nil {
+func (p *ProjectEvent) GetInstallation() *Installation {
+	if p == nil {
 		return nil
 	}
-	return m.Installation
+	return p.Installation
 }
 
-// GetMilestone returns the Milestone field.
-func (m *MilestoneEvent) GetMilestone() *Milestone {
-	if m == nil {
+// GetOrg returns the Org field.
+func (p *ProjectEvent) GetOrg() *Organization {
+	if p == nil {
 		return nil
 	}
-	return m.Milestone
+	return p.Org
 }
 
-// GetOrg returns the Org field.
-func (m *MilestoneEvent) GetOrg() *Organization {
-	if m == nil {
+// GetProject returns the Project field.
+func (p *ProjectEvent) GetProject() *Project {
+	if p == nil {
 		return nil
 	}
-	return m.Org
+	return p.Project
 }
 
 // GetRepo returns the Repo field.
-func (m *MilestoneEvent) GetRepo() *Repository {
-	if m == nil {
+func (p *ProjectEvent) GetRepo() *Repository {
+	if p == nil {
 		return nil
 	}
-	return m.Repo
+	return p.Repo
 }
 
 // GetSender returns the Sender field.
-func (m *MilestoneEvent) GetSender() *User {
-	if m == nil {
+func (p *ProjectEvent) GetSender() *User {
+	if p == nil {
 		return nil
 	}
-	return m.Sender
-}
-
-// GetClosedMilestones returns the ClosedMilestones field if it's non-nil, zero value otherwise.
-func (m *MilestoneStats) GetClosedMilestones() int {
-	if m == nil || m.ClosedMilestones == nil {
-		return 0
-	}
-	return *m.ClosedMilestones
-}
-
-// GetOpenMilestones returns the OpenMilestones field if it's non-nil, zero value otherwise.
-func (m *MilestoneStats) GetOpenMilestones() int {
-	if m == nil || m.OpenMilestones == nil {
-		return 0
-	}
-	return *m.OpenMilestones
+	return p.Sender
 }
 
-// GetTotalMilestones returns the TotalMilestones field if it's non-nil, zero value otherwise.
-func (m *MilestoneStats) GetTotalMilestones() int {
-	if m == nil || m.TotalMilestones == nil {
-		return 0
+// GetBody returns the Body field if it's non-nil, zero value otherwise.
+func (p *ProjectOptions) GetBody() string {
+	if p == nil || p.Body == nil {
+		return ""
 	}
-	return *m.TotalMilestones
+	return *p.Body
 }
 
-// GetBase returns the Base field if it's non-nil, zero value otherwise.
-func (n *NewPullRequest) GetBase() string {
-	if n == nil || n.Base == nil {
+// GetName returns the Name field if it's non-nil, zero value otherwise.
+func (p *ProjectOptions) GetName() string {
+	if p == nil || p.Name == nil {
 		return ""
 	}
-	return *n.Base
+	return *p.Name
 }
 
-// GetBody returns the Body field if it's non-nil, zero value otherwise.
-func (n *NewPullRequest) GetBody() string {
-	if n == nil || n.Body == nil {
+// GetOrganizationPermission returns the OrganizationPermission field if it's non-nil, zero value otherwise.
+func (p *ProjectOptions) GetOrganizationPermission() string {
+	if p == nil || p.OrganizationPermission == nil {
 		return ""
 	}
-	return *n.Body
+	return *p.OrganizationPermission
 }
 
-// GetDraft returns the Draft field if it's non-nil, zero value otherwise.
-func (n *NewPullRequest) GetDraft() bool {
-	if n == nil || n.Draft == nil {
+// GetPublic returns the Public field if it's non-nil, zero value otherwise.
+func (p *ProjectOptions) GetPublic() bool {
+	if p == nil || p.Public == nil {
 		return false
 	}
-	return *n.Draft
+	return *p.Public
 }
 
-// GetHead returns the Head field if it's non-nil, zero value otherwise.
-func (n *NewPullRequest) GetHead() string {
-	if n == nil || n.Head == nil {
+// GetState returns the State field if it's non-nil, zero value otherwise.
+func (p *ProjectOptions) GetState() string {
+	if p == nil || p.State == nil {
 		return ""
 	}
-	return *n.Head
+	return *p.State
 }
 
-// GetIssue returns the Issue field if it's non-nil, zero value otherwise.
-func (n *NewPullRequest) GetIssue() int {
-	if n == nil || n.Issue == nil {
-		return 0
+// GetPermission returns the Permission field if it's non-nil, zero value otherwise.
+func (p *ProjectPermissionLevel) GetPermission() string {
+	if p == nil || p.Permission == nil {
+		return ""
 	}
-	return *n.Issue
+	return *p.Permission
 }
 
-// GetMaintainerCanModify returns the MaintainerCanModify field if it's non-nil, zero value otherwise.
-func (n *NewPullRequest) GetMaintainerCanModify() bool {
-	if n == nil || n.MaintainerCanModify == nil {
-		return false
+// GetUser returns the User field.
+func (p *ProjectPermissionLevel) GetUser() *User {
+	if p == nil {
+		return nil
 	}
-	return *n.MaintainerCanModify
+	return p.User
 }
 
-// GetTitle returns the Title field if it's non-nil, zero value otherwise.
-func (n *NewPullRequest) GetTitle() string {
-	if n == nil || n.Title == nil {
+// GetBody returns the Body field if it's non-nil, zero value otherwise.
+func (p *ProjectV2StatusUpdate) GetBody() string {
+	if p == nil || p.Body == nil {
 		return ""
 	}
-	return *n.Title
+	return *p.Body
 }
 
-// GetDescription returns the Description field if it's non-nil, zero value otherwise.
-func (n *NewTeam) GetDescription() string {
-	if n == nil || n.Description == nil {
-		return ""
+// GetCreatedAt returns the CreatedAt field if it's non-nil, zero value otherwise.
+func (p *ProjectV2StatusUpdate) GetCreatedAt() Timestamp {
+	if p == nil || p.CreatedAt == nil {
+		return Timestamp{}
 	}
-	return *n.Description
+	return *p.CreatedAt
 }
 
-// GetLDAPDN returns the LDAPDN field if it's non-nil, zero value otherwise.
-func (n *NewTeam) GetLDAPDN() string {
-	if n == nil || n.LDAPDN == nil {
-		return ""
+// GetCreator returns the Creator field.
+func (p *ProjectV2StatusUpdate) GetCreator() *User {
+	if p == nil {
+		return nil
 	}
-	return *n.LDAPDN
+	return p.Creator
 }
 
-// GetParentTeamID returns the ParentTeamID field if it's non-nil, zero value otherwise.
-func (n *NewTeam) GetParentTeamID() int64 {
-	if n == nil || n.ParentTeamID == nil {
+// GetID returns the ID field if it's non-nil, zero value otherwise.
+func (p *ProjectV2StatusUpdate) GetID() int64 {
+	if p == nil || p.ID == nil {
 		return 0
 	}
-	return *n.ParentTeamID
+	return *p.ID
 }
 
-// GetPermission returns the Permission field if it's non-nil, zero value otherwise.
-func (n *NewTeam) GetPermission() string {
-	if n == nil || n.Permission == nil {
+// GetNodeID returns the NodeID field if it's non-nil, zero value otherwise.
+func (p *ProjectV2StatusUpdate) GetNodeID() string {
+	if p == nil || p.NodeID == nil {
 		return ""
 	}
-	return *n.Permission
+	return *p.NodeID
 }
 
-// GetPrivacy returns the Privacy field if it's non-nil, zero value otherwise.
-func (n *NewTeam) GetPrivacy() string {
-	if n == nil || n.Privacy == nil {
+// GetProjectNodeID returns the ProjectNodeID field if it's non-nil, zero value otherwise.
+func (p *ProjectV2StatusUpdate) GetProjectNodeID() string {
+	if p == nil || p.ProjectNodeID == nil {
 		return ""
 	}
-	return *n.Privacy
+	return *p.ProjectNodeID
 }
 
-// GetID returns the ID field if it's non-nil, zero value otherwise.
-func (n *Notification) GetID() string {
-	if n == nil || n.ID == nil {
+// GetStartDate returns the StartDate field if it's non-nil, zero value otherwise.
+func (p *ProjectV2StatusUpdate) GetStartDate() string {
+	if p == nil || p.StartDate == nil {
 		return ""
 	}
-	return *n.ID
+	return *p.StartDate
 }
 
-// GetLastReadAt returns the LastReadAt field if it's non-nil, zero value otherwise.
-func (n *Notification) GetLastReadAt() time.Time {
-	if n == nil || n.LastReadAt == nil {
-		return time.Time{}
+// GetStatus returns the Status field if it's non-nil, zero value otherwise.
+func (p *ProjectV2StatusUpdate) GetStatus() string {
+	if p == nil || p.Status == nil {
+		return ""
 	}
-	return *n.LastReadAt
+	return *p.Status
 }
 
-// GetReason returns the Reason field if it's non-nil, zero value otherwise.
-func (n *Notification) GetReason() string {
-	if n == nil || n.Reason == nil {
+// GetTargetDate returns the TargetDate field if it's non-nil, zero value otherwise.
+func (p *ProjectV2StatusUpdate) GetTargetDate() string {
+	if p == nil || p.TargetDate == nil {
 		return ""
 	}
-	return *n.Reason
+	return *p.TargetDate
+}
+
+// GetUpdatedAt returns the UpdatedAt field if it's non-nil, zero value otherwise.
+func (p *ProjectV2StatusUpdate) GetUpdatedAt() Timestamp {
+	if p == nil || p.UpdatedAt == nil {
+		return Timestamp{}
+	}
+	return *p.UpdatedAt
 }
 
-// GetRepository returns the Repository field.
-func (n *Notification) GetRepository() *Repository {
-	if n == nil {
+// GetBody returns the Body field.
+func (p *ProjectV2StatusUpdateChange) GetBody() *ProjectV2StatusUpdateFieldChange {
+	if p == nil {
 		return nil
 	}
-	return n.Repository
+	return p.Body
 }
 
-// GetSubject returns the Subject field.
-func (n *Notification) GetSubject() *NotificationSubject {
-	if n == nil {
+// GetStartDate returns the StartDate field.
+func (p *ProjectV2StatusUpdateChange) GetStartDate() *ProjectV2StatusUpdateFieldChange {
+	if p == nil {
 		return nil
 	}
-	return n.Subject
+	return p.StartDate
 }
 
-// GetUnread returns the Unread field if it's non-nil, zero value otherwise.
-func (n *Notification) GetUnread() bool {
-	if n == nil || n.Unread == nil {
-		return false
+// GetStatus returns the Status field.
+func (p *ProjectV2StatusUpdateChange) GetStatus() *ProjectV2StatusUpdateFieldChange {
+	if p == nil {
+		return nil
 	}
-	return *n.Unread
+	return p.Status
 }
 
-// GetUpdatedAt returns the UpdatedAt field if it's non-nil, zero value otherwise.
-func (n *Notification) GetUpdatedAt() time.Time {
-	if n == nil || n.UpdatedAt == nil {
-		return time.Time{}
+// GetTargetDate returns the TargetDate field.
+func (p *ProjectV2StatusUpdateChange) GetTargetDate() *ProjectV2StatusUpdateFieldChange {
+	if p == nil {
+		return nil
 	}
-	return *n.UpdatedAt
+	return p.TargetDate
 }
 
-// GetURL returns the URL field if it's non-nil, zero value otherwise.
-func (n *Notification) GetURL() string {
-	if n == nil || n.URL == nil {
+// GetAction returns the Action field if it's non-nil, zero value otherwise.
+func (p *ProjectV2StatusUpdateEvent) GetAction() string {
+	if p == nil || p.Action == nil {
 		return ""
 	}
-	return *n.URL
+	return *p.Action
 }
 
-// GetLatestCommentURL returns the LatestCommentURL field if it's non-nil, zero value otherwise.
-func (n *NotificationSubject) GetLatestCommentURL() string {
-	if n == nil || n.LatestCommentURL == nil {
-		return ""
+// GetChanges returns the Changes field.
+func (p *ProjectV2StatusUpdateEvent) GetChanges() *ProjectV2StatusUpdateChange {
+	if p == nil {
+		return nil
 	}
-	return *n.LatestCommentURL
+	return p.Changes
 }
 
-// GetTitle returns the Title field if it's non-nil, zero value otherwise.
-func (n *NotificationSubject) GetTitle() string {
-	if n == nil || n.Title == nil {
-		return ""
+// GetInstallation returns the Installation field.
+func (p *ProjectV2StatusUpdateEvent) GetInstallation() *Installation {
+	if p == nil {
+		return nil
 	}
-	return *n.Title
+	return p.Installation
 }
 
-// GetType returns the Type field if it's non-nil, zero value otherwise.
-func (n *NotificationSubject) GetType() string {
-	if n == nil || n.Type == nil {
-		return ""
+// GetOrg returns the Org field.
+func (p *ProjectV2StatusUpdateEvent) GetOrg() *Organization {
+	if p == nil {
+		return nil
 	}
-	return *n.Type
+	return p.Org
 }
 
-// GetURL returns the URL field if it's non-nil, zero value otherwise.
-func (n *NotificationSubject) GetURL() string {
-	if n == nil || n.URL == nil {
-		return ""
+// GetProjectV2StatusUpdate returns the ProjectV2StatusUpdate field.
+func (p *ProjectV2StatusUpdateEvent) GetProjectV2StatusUpdate() *ProjectV2StatusUpdate {
+	if p == nil {
+		return nil
 	}
-	return *n.URL
+	return p.ProjectV2StatusUpdate
 }
 
-// GetClientID returns the ClientID field if it's non-nil, zero value otherwise.
-func (o *OAuthAPP) GetClientID() string {
-	if o == nil || o.ClientID == nil {
-		return ""
+// GetSender returns the Sender field.
+func (p *ProjectV2StatusUpdateEvent) GetSender() *User {
+	if p == nil {
+		return nil
 	}
-	return *o.ClientID
+	return p.Sender
 }
 
-// GetName returns the Name field if it's non-nil, zero value otherwise.
-func (o *OAuthAPP) GetName() string {
-	if o == nil || o.Name == nil {
+// GetFrom returns the From field if it's non-nil, zero value otherwise.
+func (p *ProjectV2StatusUpdateFieldChange) GetFrom() string {
+	if p == nil || p.From == nil {
 		return ""
 	}
-	return *o.Name
+	return *p.From
 }
 
-// GetURL returns the URL field if it's non-nil, zero value otherwise.
-func (o *OAuthAPP) GetURL() string {
-	if o == nil || o.URL == nil {
+// GetTo returns the To field if it's non-nil, zero value otherwise.
+func (p *ProjectV2StatusUpdateFieldChange) GetTo() string {
+	if p == nil || p.To == nil {
 		return ""
 	}
-	return *o.URL
+	return *p.To
 }
 
-// GetAvatarURL returns the AvatarURL field if it's non-nil, zero value otherwise.
-func (o *Organization) GetAvatarURL() string {
-	if o == nil || o.AvatarURL == nil {
-		return ""
+// GetAllowDeletions returns the AllowDeletions field.
+func (p *Protection) GetAllowDeletions() *AllowDeletions {
+	if p == nil {
+		return nil
 	}
-	return *o.AvatarURL
+	return p.AllowDeletions
 }
 
-// GetBillingEmail returns the BillingEmail field if it's non-nil, zero value otherwise.
-func (o *Organization) GetBillingEmail() string {
-	if o == nil || o.BillingEmail == nil {
-		return ""
+// GetAllowForcePushes returns the AllowForcePushes field.
+func (p *Protection) GetAllowForcePushes() *AllowForcePushes {
+	if p == nil {
+		return nil
 	}
-	return *o.BillingEmail
+	return p.AllowForcePushes
 }
 
-// GetBlog returns the Blog field if it's non-nil, zero value otherwise.
-func (o *Organization) GetBlog() string {
-	if o == nil || o.Blog == nil {
-		return ""
+// GetEnforceAdmins returns the EnforceAdmins field.
+func (p *Protection) GetEnforceAdmins() *AdminEnforcement {
+	if p == nil {
+		return nil
 	}
-	return *o.Blog
+	return p.EnforceAdmins
 }
 
-// GetCollaborators returns the Collaborators field if it's non-nil, zero value otherwise.
-func (o *Organization) GetCollaborators() int {
-	if o == nil || o.Collaborators == nil {
-		return 0
+// GetRequiredPullRequestReviews returns the RequiredPullRequestReviews field.
+func (p *Protection) GetRequiredPullRequestReviews() *PullRequestReviewsEnforcement {
+	if p == nil {
+		return nil
 	}
-	return *o.Collaborators
+	return p.RequiredPullRequestReviews
 }
 
-// GetCompany returns the Company field if it's non-nil, zero value otherwise.
-func (o *Organization) GetCompany() string {
-	if o == nil || o.Company == nil {
-		return ""
+// GetRequiredStatusChecks returns the RequiredStatusChecks field.
+func (p *Protection) GetRequiredStatusChecks() *RequiredStatusChecks {
+	if p == nil {
+		return nil
 	}
-	return *o.Company
+	return p.RequiredStatusChecks
 }
 
-// GetCreatedAt returns the CreatedAt field if it's non-nil, zero value otherwise.
-func (o *Organization) GetCreatedAt() time.Time {
-	if o == nil || o.CreatedAt == nil {
-		return time.Time{}
+// GetRequireLinearHistory returns the RequireLinearHistory field.
+func (p *Protection) GetRequireLinearHistory() *RequireLinearHistory {
+	if p == nil {
+		return nil
 	}
-	return *o.CreatedAt
+	return p.RequireLinearHistory
 }
 
-// GetDefaultRepoPermission returns the DefaultRepoPermission field if it's non-nil, zero value otherwise.
-func (o *Organization) GetDefaultRepoPermission() string {
-	if o == nil || o.DefaultRepoPermission == nil {
-		return ""
+// GetRestrictions returns the Restrictions field.
+func (p *Protection) GetRestrictions() *BranchRestrictions {
+	if p == nil {
+		return nil
 	}
-	return *o.DefaultRepoPermission
+	return p.Restrictions
 }
 
-// GetDefaultRepoSettings returns the DefaultRepoSettings field if it's non-nil, zero value otherwise.
-func (o *Organization) GetDefaultRepoSettings() string {
-	if o == nil || o.DefaultRepoSettings == nil {
-		return ""
+// GetAllowDeletions returns the AllowDeletions field if it's non-nil, zero value otherwise.
+func (p *ProtectionRequest) GetAllowDeletions() bool {
+	if p == nil || p.AllowDeletions == nil {
+		return false
 	}
-	return *o.DefaultRepoSettings
+	return *p.AllowDeletions
 }
 
-// GetDescription returns the Description field if it's non-nil, zero value otherwise.
-func (o *Organization) GetDescription() string {
-	if o == nil || o.Description == nil {
-		return ""
+// GetAllowForcePushes returns the AllowForcePushes field if it's non-nil, zero value otherwise.
+func (p *ProtectionRequest) GetAllowForcePushes() bool {
+	if p == nil || p.AllowForcePushes == nil {
+		return false
 	}
-	return *o.Description
+	return *p.AllowForcePushes
 }
 
-// GetDiskUsage returns the DiskUsage field if it's non-nil, zero value otherwise.
-func (o *Organization) GetDiskUsage() int {
-	if o == nil || o.DiskUsage == nil {
-		return 0
+// GetRequiredPullRequestReviews returns the RequiredPullRequestReviews field.
+func (p *ProtectionRequest) GetRequiredPullRequestReviews() *PullRequestReviewsEnforcementRequest {
+	if p == nil {
+		return nil
 	}
-	return *o.DiskUsage
+	return p.RequiredPullRequestReviews
 }
 
-// GetEmail returns the Email field if it's non-nil, zero value otherwise.
-func (o *Organization) GetEmail() string {
-	if o == nil || o.Email == nil {
-		return ""
+// GetRequiredStatusChecks returns the RequiredStatusChecks field.
+func (p *ProtectionRequest) GetRequiredStatusChecks() *RequiredStatusChecks {
+	if p == nil {
+		return nil
 	}
-	return *o.Email
+	return p.RequiredStatusChecks
 }
 
-// GetEventsURL returns the EventsURL field if it's non-nil, zero value otherwise.
-func (o *Organization) GetEventsURL() string {
-	if o == nil || o.EventsURL == nil {
-		return ""
+// GetRequireLinearHistory returns the RequireLinearHistory field if it's non-nil, zero value otherwise.
+func (p *ProtectionRequest) GetRequireLinearHistory() bool {
+	if p == nil || p.RequireLinearHistory == nil {
+		return false
 	}
-	return *o.EventsURL
+	return *p.RequireLinearHistory
 }
 
-// GetFollowers returns the Followers field if it's non-nil, zero value otherwise.
-func (o *Organization) GetFollowers() int {
-	if o == nil || o.Followers == nil {
-		return 0
+// GetRestrictions returns the Restrictions field.
+func (p *ProtectionRequest) GetRestrictions() *BranchRestrictionsRequest {
+	if p == nil {
+		return nil
 	}
-	return *o.Followers
+	return p.Restrictions
 }
 
-// GetFollowing returns the Following field if it's non-nil, zero value otherwise.
-func (o *Organization) GetFollowing() int {
-	if o == nil || o.Following == nil {
-		return 0
+// GetInstallation returns the Installation field.
+func (p *PublicEvent) GetInstallation() *Installation {
+	if p == nil {
+		return nil
 	}
-	return *o.Following
+	return p.Installation
 }
 
-// GetHasOrganizationProjects returns the HasOrganizationProjects field if it's non-nil, zero value otherwise.
-func (o *Organization) GetHasOrganizationProjects() bool {
-	if o == nil || o.HasOrganizationProjects == nil {
-		return false
+// GetRepo returns the Repo field.
+func (p *PublicEvent) GetRepo() *Repository {
+	if p == nil {
+		return nil
 	}
-	return *o.HasOrganizationProjects
+	return p.Repo
 }
 
-// GetHasRepositoryProjects returns the HasRepositoryProjects field if it's non-nil, zero value otherwise.
-func (o *Organization) GetHasRepositoryProjects() bool {
-	if o == nil || o.HasRepositoryProjects == nil {
-		return false
+// GetSender returns the Sender field.
+func (p *PublicEvent) GetSender() *User {
+	if p == nil {
+		return nil
 	}
-	return *o.HasRepositoryProjects
+	return p.Sender
 }
 
-// GetHooksURL returns the HooksURL field if it's non-nil, zero value otherwise.
-func (o *Organization) GetHooksURL() string {
-	if o == nil || o.HooksURL == nil {
+// GetKey returns the Key field if it's non-nil, zero value otherwise.
+func (p *PublicKey) GetKey() string {
+	if p == nil || p.Key == nil {
+		return ""
+	}
+	return *p.Key
+}
+
+// GetKeyID returns the KeyID field if it's non-nil, zero value otherwise.
+func (p *PublicKey) GetKeyID() string {
+	if p == nil || p.KeyID == nil {
 		return ""
 	}
-	return *o.HooksURL
+	return *p.KeyID
 }
 
-// GetHTMLURL returns the HTMLURL field if it's non-nil, zero value otherwise.
-func (o *Organization) GetHTMLURL() string {
-	if o == nil || o.HTMLURL == nil {
+// GetActiveLockReason returns the ActiveLockReason field if it's non-nil, zero value otherwise.
+func (p *PullRequest) GetActiveLockReason() string {
+	if p == nil || p.ActiveLockReason == nil {
 		return ""
 	}
-	return *o.HTMLURL
+	return *p.ActiveLockReason
 }
 
-// GetID returns the ID field if it's non-nil, zero value otherwise.
-func (o *Organization) GetID() int64 {
-	if o == nil || o.ID == nil {
+// GetAdditions returns the Additions field if it's non-nil, zero value otherwise.
+func (p *PullRequest) GetAdditions() int {
+	if p == nil || p.Additions == nil {
 		return 0
 	}
-	return *o.ID
+	return *p.Additions
 }
 
-// GetIssuesURL returns the IssuesURL field if it's non-nil, zero value otherwise.
-func (o *Organization) GetIssuesURL() string {
-	if o == nil || o.IssuesURL == nil {
-		return ""
+// GetAssignee returns the Assignee field.
+func (p *PullRequest) GetAssignee() *User {
+	if p == nil {
+		return nil
 	}
-	return *o.IssuesURL
+	return p.Assignee
 }
 
-// GetIsVerified returns the IsVerified field if it's non-nil, zero value otherwise.
-func (o *Organization) GetIsVerified() bool {
-	if o == nil || o.IsVerified == nil {
-		return false
+// GetAuthorAssociation returns the AuthorAssociation field if it's non-nil, zero value otherwise.
+func (p *PullRequest) GetAuthorAssociation() string {
+	if p == nil || p.AuthorAssociation == nil {
+		return ""
 	}
-	return *o.IsVerified
+	return *p.AuthorAssociation
 }
 
-// GetLocation returns the Location field if it's non-nil, zero value otherwise.
-func (o *Organization) GetLocation() string {
-	if o == nil || o.Location == nil {
-		return ""
+// GetAutoMerge returns the AutoMerge field.
+func (p *PullRequest) GetAutoMerge() *PullRequestAutoMerge {
+	if p == nil {
+		return nil
 	}
-	return *o.Location
+	return p.AutoMerge
 }
 
-// GetLogin returns the Login field if it's non-nil, zero value otherwise.
-func (o *Organization) GetLogin() string {
-	if o == nil || o.Login == nil {
-		return ""
+// GetBase returns the Base field.
+func (p *PullRequest) GetBase() *PullRequestBranch {
+	if p == nil {
+		return nil
 	}
-	return *o.Login
+	return p.Base
 }
 
-// GetMembersAllowedRepositoryCreationType returns the MembersAllowedRepositoryCreationType field if it's non-nil, zero value otherwise.
-func (o *Organization) GetMembersAllowedRepositoryCreationType() string {
-	if o == nil || o.MembersAllowedRepositoryCreationType == nil {
+// GetBody returns the Body field if it's non-nil, zero value otherwise.
+func (p *PullRequest) GetBody() string {
+	if p == nil || p.Body == nil {
 		return ""
 	}
-	return *o.MembersAllowedRepositoryCreationType
+	return *p.Body
 }
 
-// GetMembersCanCreateInternalRepos returns the MembersCanCreateInternalRepos field if it's non-nil, zero value otherwise.
-func (o *Organization) GetMembersCanCreateInternalRepos() bool {
-	if o == nil || o.MembersCanCreateInternalRepos == nil {
-		return false
+// GetChangedFiles returns the ChangedFiles field if it's non-nil, zero value otherwise.
+func (p *PullRequest) GetChangedFiles() int {
+	if p == nil || p.ChangedFiles == nil {
+		return 0
 	}
-	return *o.MembersCanCreateInternalRepos
+	return *p.ChangedFiles
 }
 
-// GetMembersCanCreatePrivateRepos returns the MembersCanCreatePrivateRepos field if it's non-nil, zero value otherwise.
-func (o *Organization) GetMembersCanCreatePrivateRepos() bool {
-	if o == nil || o.MembersCanCreatePrivateRepos == nil {
-		return false
+// GetClosedAt returns the ClosedAt field if it's non-nil, zero value otherwise.
+func (p *PullRequest) GetClosedAt() time.Time {
+	if p == nil || p.ClosedAt == nil {
+		return time.Time{}
 	}
-	return *o.MembersCanCreatePrivateRepos
+	return *p.ClosedAt
 }
 
-// GetMembersCanCreatePublicRepos returns the MembersCanCreatePublicRepos field if it's non-nil, zero value otherwise.
-func (o *Organization) GetMembersCanCreatePublicRepos() bool {
-	if o == nil || o.MembersCanCreatePublicRepos == nil {
-		return false
+// GetComments returns the Comments field if it's non-nil, zero value otherwise.
+func (p *PullRequest) GetComments() int {
+	if p == nil || p.Comments == nil {
+		return 0
 	}
-	return *o.MembersCanCreatePublicRepos
+	return *p.Comments
 }
 
-// GetMembersCanCreateRepos returns the MembersCanCreateRepos field if it's non-nil, zero value otherwise.
-func (o *Organization) GetMembersCanCreateRepos() bool {
-	if o == nil || o.MembersCanCreateRepos == nil {
-		return false
+// GetCommentsURL returns the CommentsURL field if it's non-nil, zero value otherwise.
+func (p *PullRequest) GetCommentsURL() string {
+	if p == nil || p.CommentsURL == nil {
+		return ""
 	}
-	return *o.MembersCanCreateRepos
+	return *p.CommentsURL
 }
 
-// GetMembersURL returns the MembersURL field if it's non-nil, zero value otherwise.
-func (o *Organization) GetMembersURL() string {
-	if o == nil || o.MembersURL == nil {
-		return ""
+// GetCommits returns the Commits field if it's non-nil, zero value otherwise.
+func (p *PullRequest) GetCommits() int {
+	if p == nil || p.Commits == nil {
+		return 0
 	}
-	return *o.MembersURL
+	return *p.Commits
 }
 
-// GetName returns the Name field if it's non-nil, zero value otherwise.
-func (o *Organization) GetName() string {
-	if o == nil || o.Name == nil {
+// GetCommitsURL returns the CommitsURL field if it's non-nil, zero value otherwise.
+func (p *PullRequest) GetCommitsURL() string {
+	if p == nil || p.CommitsURL == nil {
 		return ""
 	}
-	return *o.Name
+	return *p.CommitsURL
 }
 
-// GetNodeID returns the NodeID field if it's non-nil, zero value otherwise.
-func (o *Organization) GetNodeID() string {
-	if o == nil || o.NodeID == nil {
-		return ""
+// GetCreatedAt returns the CreatedAt field if it's non-nil, zero value otherwise.
+func (p *PullRequest) GetCreatedAt() time.Time {
+	if p == nil || p.CreatedAt == nil {
+		return time.Time{}
 	}
-	return *o.NodeID
+	return *p.CreatedAt
 }
 
-// GetOwnedPrivateRepos returns the OwnedPrivateRepos field if it's non-nil, zero value otherwise.
-func (o *Organization) GetOwnedPrivateRepos() int {
-	if o == nil || o.OwnedPrivateRepos == nil {
+// GetDeletions returns the Deletions field if it's non-nil, zero value otherwise.
+func (p *PullRequest) GetDeletions() int {
+	if p == nil || p.Deletions == nil {
 		return 0
 	}
-	return *o.OwnedPrivateRepos
+	return *p.Deletions
 }
 
-// GetPlan returns the Plan field.
-func (o *Organization) GetPlan() *Plan {
-	if o == nil {
-		return nil
+// GetDiffURL returns the DiffURL field if it's non-nil, zero value otherwise.
+func (p *PullRequest) GetDiffURL() string {
+	if p == nil || p.DiffURL == nil {
+		return ""
 	}
-	return o.Plan
+	return *p.DiffURL
 }
 
-// GetPrivateGists returns the PrivateGists field if it's non-nil, zero value otherwise.
-func (o *Organization) GetPrivateGists() int {
-	if o == nil || o.PrivateGists == nil {
-		return 0
+// GetDraft returns the Draft field if it's non-nil, zero value otherwise.
+func (p *PullRequest) GetDraft() bool {
+	if p == nil || p.Draft == nil {
+		return false
 	}
-	return *o.PrivateGists
+	return *p.Draft
 }
 
-// GetPublicGists returns the PublicGists field if it's non-nil, zero value otherwise.
-func (o *Organization) GetPublicGists() int {
-	if o == nil || o.PublicGists == nil {
-		return 0
+// GetHead returns the Head field.
+func (p *PullRequest) GetHead() *PullRequestBranch {
+	if p == nil {
+		return nil
 	}
-	return *o.PublicGists
+	return p.Head
 }
 
-// GetPublicMembersURL returns the PublicMembersURL field if it's non-nil, zero value otherwise.
-func (o *Organization) GetPublicMembersURL() string {
-	if o == nil || o.PublicMembersURL == nil {
+// GetHTMLURL returns the HTMLURL field if it's non-nil, zero value otherwise.
+func (p *PullRequest) GetHTMLURL() string {
+	if p == nil || p.HTMLURL == nil {
 		return ""
 	}
-	return *o.PublicMembersURL
+	return *p.HTMLURL
 }
 
-// GetPublicRepos returns the PublicRepos field if it's non-nil, zero value otherwise.
-func (o *Organization) GetPublicRepos() int {
-	if o == nil || o.PublicRepos == nil {
+// GetID returns the ID field if it's non-nil, zero value otherwise.
+func (p *PullRequest) GetID() int64 {
+	if p == nil || p.ID == nil {
 		return 0
 	}
-	return *o.PublicRepos
+	return *p.ID
 }
 
-// GetReposURL returns the ReposURL field if it's non-nil, zero value otherwise.
-func (o *Organization) GetReposURL() string {
-	if o == nil || o.ReposURL == nil {
+// GetIssueURL returns the IssueURL field if it's non-nil, zero value otherwise.
+func (p *PullRequest) GetIssueURL() string {
+	if p == nil || p.IssueURL == nil {
 		return ""
 	}
-	return *o.ReposURL
-}
-
-// GetTotalPrivateRepos returns the TotalPrivateRepos field if it's non-nil, zero value otherwise.
-func (o *Organization) GetTotalPrivateRepos() int {
-	if o == nil || o.TotalPrivateRepos == nil {
-		return 0
-	}
-	return *o.TotalPrivateRepos
+	return *p.IssueURL
 }
 
-// GetTwitterUsername returns the TwitterUsername field if it's non-nil, zero value otherwise.
-func (o *Organization) GetTwitterUsername() string {
-	if o == nil || o.TwitterUsername == nil {
-		return ""
+// GetLinks returns the Links field.
+func (p *PullRequest) GetLinks() *PRLinks {
+	if p == nil {
+		return nil
 	}
-	return *o.TwitterUsername
+	return p.Links
 }
 
-// GetTwoFactorRequirementEnabled returns the TwoFactorRequirementEnabled field if it's non-nil, zero value otherwise.
-func (o *Organization) GetTwoFactorRequirementEnabled() bool {
-	if o == nil || o.TwoFactorRequirementEnabled == nil {
+// GetLocked returns the Locked field if it's non-nil, zero value otherwise.
+func (p *PullRequest) GetLocked() bool {
+	if p == nil || p.Locked == nil {
 		return false
 	}
-	return *o.TwoFactorRequirementEnabled
+	return *p.Locked
 }
 
-// GetType returns the Type field if it's non-nil, zero value otherwise.
-func (o *Organization) GetType() string {
-	if o == nil || o.Type == nil {
-		return ""
+// GetMaintainerCanModify returns the MaintainerCanModify field if it's non-nil, zero value otherwise.
+func (p *PullRequest) GetMaintainerCanModify() bool {
+	if p == nil || p.MaintainerCanModify == nil {
+		return false
 	}
-	return *o.Type
+	return *p.MaintainerCanModify
 }
 
-// GetUpdatedAt returns the UpdatedAt field if it's non-nil, zero value otherwise.
-func (o *Organization) GetUpdatedAt() time.Time {
-	if o == nil || o.UpdatedAt == nil {
-		return time.Time{}
+// GetMergeable returns the Mergeable field if it's non-nil, zero value otherwise.
+func (p *PullRequest) GetMergeable() bool {
+	if p == nil || p.Mergeable == nil {
+		return false
 	}
-	return *o.UpdatedAt
+	return *p.Mergeable
 }
 
-// GetURL returns the URL field if it's non-nil, zero value otherwise.
-func (o *Organization) GetURL() string {
-	if o == nil || o.URL == nil {
+// GetMergeableState returns the MergeableState field if it's non-nil, zero value otherwise.
+func (p *PullRequest) GetMergeableState() string {
+	if p == nil || p.MergeableState == nil {
 		return ""
 	}
-	return *o.URL
+	return *p.MergeableState
 }
 
-// GetAction returns the Action field if it's non-nil, zero value otherwise.
-func (o *OrganizationEvent) GetAction() string {
-	if o == nil || o.Action == nil {
+// GetMergeCommitSHA returns the MergeCommitSHA field if it's non-nil, zero value otherwise.
+func (p *PullRequest) GetMergeCommitSHA() string {
+	if p == nil || p.MergeCommitSHA == nil {
 		return ""
 	}
-	return *o.Action
+	return *p.MergeCommitSHA
 }
 
-// GetInstallation returns the Installation field.
-func (o *OrganizationEvent) GetInstallation() *Installation {
-	if o == nil {
-		return nil
+// GetMerged returns the Merged field if it's non-nil, zero value otherwise.
+func (p *PullRequest) GetMerged() bool {
+	if p == nil || p.Merged == nil {
+		return false
 	}
-	return o.Installation
+	return *p.Merged
 }
 
-// GetInvitation returns the Invitation field.
-func (o *OrganizationEvent) GetInvitation() *Invitation {
-	if o == nil {
-		return nil
+// GetMergedAt returns the MergedAt field if it's non-nil, zero value otherwise.
+func (p *PullRequest) GetMergedAt() time.Time {
+	if p == nil || p.MergedAt == nil {
+		return time.Time{}
 	}
-	return o.Invitation
+	return *p.MergedAt
 }
 
-// GetMembership returns the Membership field.
-func (o *OrganizationEvent) GetMembership() *Membership {
-	if o == nil {
+// GetMergedBy returns the MergedBy field.
+func (p *PullRequest) GetMergedBy() *User {
+	if p == nil {
 		return nil
 	}
-	return o.Membership
+	return p.MergedBy
 }
 
-// GetOrganization returns the Organization field.
-func (o *OrganizationEvent) GetOrganization() *Organization {
-	if o == nil {
+// GetMilestone returns the Milestone field.
+func (p *PullRequest) GetMilestone() *Milestone {
+	if p == nil {
 		return nil
 	}
-	return o.Organization
+	return p.Milestone
 }
 
-// GetSender returns the Sender field.
-func (o *OrganizationEvent) GetSender() *User {
-	if o == nil {
-		return nil
+// GetNodeID returns the NodeID field if it's non-nil, zero value otherwise.
+func (p *PullRequest) GetNodeID() string {
+	if p == nil || p.NodeID == nil {
+		return ""
 	}
-	return o.Sender
+	return *p.NodeID
 }
 
-// GetTotalCount returns the TotalCount field if it's non-nil, zero value otherwise.
-func (o *OrganizationInstallations) GetTotalCount() int {
-	if o == nil || o.TotalCount == nil {
+// GetNumber returns the Number field if it's non-nil, zero value otherwise.
+func (p *PullRequest) GetNumber() int {
+	if p == nil || p.Number == nil {
 		return 0
 	}
-	return *o.TotalCount
+	return *p.Number
 }
 
-// GetAction returns the Action field if it's non-nil, zero value otherwise.
-func (o *OrgBlockEvent) GetAction() string {
-	if o == nil || o.Action == nil {
+// GetPatchURL returns the PatchURL field if it's non-nil, zero value otherwise.
+func (p *PullRequest) GetPatchURL() string {
+	if p == nil || p.PatchURL == nil {
 		return ""
 	}
-	return *o.Action
+	return *p.PatchURL
 }
 
-// GetBlockedUser returns the BlockedUser field.
-func (o *OrgBlockEvent) GetBlockedUser() *User {
-	if o == nil {
-		return nil
+// GetRebaseable returns the Rebaseable field if it's non-nil, zero value otherwise.
+func (p *PullRequest) GetRebaseable() bool {
+	if p == nil || p.Rebaseable == nil {
+		return false
 	}
-	return o.BlockedUser
+	return *p.Rebaseable
 }
 
-// GetInstallation returns the Installation field.
-func (o *OrgBlockEvent) GetInstallation() *Installation {
-	if o == nil {
-		return nil
+// GetReviewComments returns the ReviewComments field if it's non-nil, zero value otherwise.
+func (p *PullRequest) GetReviewComments() int {
+	if p == nil || p.ReviewComments == nil {
+		return 0
 	}
-	return o.Installation
+	return *p.ReviewComments
 }
 
-// GetOrganization returns the Organization field.
-func (o *OrgBlockEvent) GetOrganization() *Organization {
-	if o == nil {
-		return nil
+// GetReviewCommentsURL returns the ReviewCommentsURL field if it's non-nil, zero value otherwise.
+func (p *PullRequest) GetReviewCommentsURL() string {
+	if p == nil || p.ReviewCommentsURL == nil {
+		return ""
 	}
-	return o.Organization
+	return *p.ReviewCommentsURL
 }
 
-// GetSender returns the Sender field.
-func (o *OrgBlockEvent) GetSender() *User {
-	if o == nil {
-		return nil
+// GetReviewCommentURL returns the ReviewCommentURL field if it's non-nil, zero value otherwise.
+func (p *PullRequest) GetReviewCommentURL() string {
+	if p == nil || p.ReviewCommentURL == nil {
+		return ""
 	}
-	return o.Sender
+	return *p.ReviewCommentURL
 }
 
-// GetDisabledOrgs returns the DisabledOrgs field if it's non-nil, zero value otherwise.
-func (o *OrgStats) GetDisabledOrgs() int {
-	if o == nil || o.DisabledOrgs == nil {
-		return 0
+// GetState returns the State field if it's non-nil, zero value otherwise.
+func (p *PullRequest) GetState() string {
+	if p == nil || p.State == nil {
+		return ""
 	}
-	return *o.DisabledOrgs
+	return *p.State
 }
 
-// GetTotalOrgs returns the TotalOrgs field if it's non-nil, zero value otherwise.
-func (o *OrgStats) GetTotalOrgs() int {
-	if o == nil || o.TotalOrgs == nil {
-		return 0
+// GetStatusesURL returns the StatusesURL field if it's non-nil, zero value otherwise.
+func (p *PullRequest) GetStatusesURL() string {
+	if p == nil || p.StatusesURL == nil {
+		return ""
 	}
-	return *o.TotalOrgs
+	return *p.StatusesURL
 }
 
-// GetTotalTeamMembers returns the TotalTeamMembers field if it's non-nil, zero value otherwise.
-func (o *OrgStats) GetTotalTeamMembers() int {
-	if o == nil || o.TotalTeamMembers == nil {
-		return 0
+// GetTitle returns the Title field if it's non-nil, zero value otherwise.
+func (p *PullRequest) GetTitle() string {
+	if p == nil || p.Title == nil {
+		return ""
 	}
-	return *o.TotalTeamMembers
+	return *p.Title
 }
 
-// GetTotalTeams returns the TotalTeams field if it's non-nil, zero value otherwise.
-func (o *OrgStats) GetTotalTeams() int {
-	if o == nil || o.TotalTeams == nil {
-		return 0
+// GetUpdatedAt returns the UpdatedAt field if it's non-nil, zero value otherwise.
+func (p *PullRequest) GetUpdatedAt() time.Time {
+	if p == nil || p.UpdatedAt == nil {
+		return time.Time{}
 	}
-	return *o.TotalTeams
+	return *p.UpdatedAt
 }
 
-// GetCreatedAt returns the CreatedAt field if it's non-nil, zero value otherwise.
-func (p *Package) GetCreatedAt() Timestamp {
-	if p == nil || p.CreatedAt == nil {
-		return Timestamp{}
+// GetURL returns the URL field if it's non-nil, zero value otherwise.
+func (p *PullRequest) GetURL() string {
+	if p == nil || p.URL == nil {
+		return ""
 	}
-	return *p.CreatedAt
+	return *p.URL
 }
 
-// GetHTMLURL returns the HTMLURL field if it's non-nil, zero value otherwise.
-func (p *Package) GetHTMLURL() string {
-	if p == nil || p.HTMLURL == nil {
-		return ""
+// GetUser returns the User field.
+func (p *PullRequest) GetUser() *User {
+	if p == nil {
+		return nil
 	}
-	return *p.HTMLURL
+	return p.User
 }
 
-// GetID returns the ID field if it's non-nil, zero value otherwise.
-func (p *Package) GetID() int64 {
-	if p == nil || p.ID == nil {
-		return 0
+// GetCommitMessage returns the CommitMessage field if it's non-nil, zero value otherwise.
+func (p *PullRequestAutoMerge) GetCommitMessage() string {
+	if p == nil || p.CommitMessage == nil {
+		return ""
 	}
-	return *p.ID
+	return *p.CommitMessage
 }
 
-// GetName returns the Name field if it's non-nil, zero value otherwise.
-func (p *Package) GetName() string {
-	if p == nil || p.Name == nil {
+// GetCommitTitle returns the CommitTitle field if it's non-nil, zero value otherwise.
+func (p *PullRequestAutoMerge) GetCommitTitle() string {
+	if p == nil || p.CommitTitle == nil {
 		return ""
 	}
-	return *p.Name
+	return *p.CommitTitle
 }
 
-// GetOwner returns the Owner field.
-func (p *Package) GetOwner() *User {
+// GetEnabledBy returns the EnabledBy field.
+func (p *PullRequestAutoMerge) GetEnabledBy() *User {
 	if p == nil {
 		return nil
 	}
-	return p.Owner
+	return p.EnabledBy
 }
 
-// GetPackageType returns the PackageType field if it's non-nil, zero value otherwise.
-func (p *Package) GetPackageType() string {
-	if p == nil || p.PackageType == nil {
+// GetMergeMethod returns the MergeMethod field if it's non-nil, zero value otherwise.
+func (p *PullRequestAutoMerge) GetMergeMethod() string {
+	if p == nil || p.MergeMethod == nil {
 		return ""
 	}
-	return *p.PackageType
+	return *p.MergeMethod
 }
 
-// GetPackageVersion returns the PackageVersion field.
-func (p *Package) GetPackageVersion() *PackageVersion {
-	if p == nil {
-		return nil
+// GetLabel returns the Label field if it's non-nil, zero value otherwise.
+func (p *PullRequestBranch) GetLabel() string {
+	if p == nil || p.Label == nil {
+		return ""
 	}
-	return p.PackageVersion
+	return *p.Label
 }
 
-// GetRegistry returns the Registry field.
-func (p *Package) GetRegistry() *PackageRegistry {
-	if p == nil {
-		return nil
+// GetRef returns the Ref field if it's non-nil, zero value otherwise.
+func (p *PullRequestBranch) GetRef() string {
+	if p == nil || p.Ref == nil {
+		return ""
 	}
-	return p.Registry
+	return *p.Ref
 }
 
-// GetUpdatedAt returns the UpdatedAt field if it's non-nil, zero value otherwise.
-func (p *Package) GetUpdatedAt() Timestamp {
-	if p == nil || p.UpdatedAt == nil {
-		return Timestamp{}
+// GetRepo returns the Repo field.
+func (p *PullRequestBranch) GetRepo() *Repository {
+	if p == nil {
+		return nil
 	}
-	return *p.UpdatedAt
+	return p.Repo
 }
 
-// GetAction returns the Action field if it's non-nil, zero value otherwise.
-func (p *PackageEvent) GetAction() string {
-	if p == nil || p.Action == nil {
+// GetSHA returns the SHA field if it's non-nil, zero value otherwise.
+func (p *PullRequestBranch) GetSHA() string {
+	if p == nil || p.SHA == nil {
 		return ""
 	}
-	return *p.Action
+	return *p.SHA
 }
 
-// GetOrg returns the Org field.
-func (p *PackageEvent) GetOrg() *Organization {
+// GetUser returns the User field.
+func (p *PullRequestBranch) GetUser() *User {
 	if p == nil {
 		return nil
 	}
-	return p.Org
+	return p.User
 }
 
-// GetPackage returns the Package field.
-func (p *PackageEvent) GetPackage() *Package {
-	if p == nil {
-		return nil
+// GetExpectedHeadSHA returns the ExpectedHeadSHA field if it's non-nil, zero value otherwise.
+func (p *PullRequestBranchUpdateOptions) GetExpectedHeadSHA() string {
+	if p == nil || p.ExpectedHeadSHA == nil {
+		return ""
 	}
-	return p.Package
+	return *p.ExpectedHeadSHA
 }
 
-// GetRepo returns the Repo field.
-func (p *PackageEvent) GetRepo() *Repository {
-	if p == nil {
-		return nil
+// GetMessage returns the Message field if it's non-nil, zero value otherwise.
+func (p *PullRequestBranchUpdateResponse) GetMessage() string {
+	if p == nil || p.Message == nil {
+		return ""
 	}
-	return p.Repo
+	return *p.Message
+}
+
+// GetURL returns the URL field if it's non-nil, zero value otherwise.
+func (p *PullRequestBranchUpdateResponse) GetURL() string {
+	if p == nil || p.URL == nil {
+		return ""
+	}
+	return *p.URL
 }
 
-// GetSender returns the Sender field.
-func (p *PackageEvent) GetSender() *User {
-	if p == nil {
-		return nil
+// GetAuthorAssociation returns the AuthorAssociation field if it's non-nil, zero value otherwise.
+func (p *PullRequestComment) GetAuthorAssociation() string {
+	if p == nil || p.AuthorAssociation == nil {
+		return ""
 	}
-	return p.Sender
+	return *p.AuthorAssociation
 }
 
-// GetAuthor returns the Author field.
-func (p *PackageFile) GetAuthor() *User {
-	if p == nil {
-		return nil
+// GetBody returns the Body field if it's non-nil, zero value otherwise.
+func (p *PullRequestComment) GetBody() string {
+	if p == nil || p.Body == nil {
+		return ""
 	}
-	return p.Author
+	return *p.Body
 }
 
-// GetContentType returns the ContentType field if it's non-nil, zero value otherwise.
-func (p *PackageFile) GetContentType() string {
-	if p == nil || p.ContentType == nil {
+// GetCommitID returns the CommitID field if it's non-nil, zero value otherwise.
+func (p *PullRequestComment) GetCommitID() string {
+	if p == nil || p.CommitID == nil {
 		return ""
 	}
-	return *p.ContentType
+	return *p.CommitID
 }
 
 // GetCreatedAt returns the CreatedAt field if it's non-nil, zero value otherwise.
-func (p *PackageFile) GetCreatedAt() Timestamp {
+func (p *PullRequestComment) GetCreatedAt() time.Time {
 	if p == nil || p.CreatedAt == nil {
-		return Timestamp{}
+		return time.Time{}
 	}
 	return *p.CreatedAt
 }
 
-// GetDownloadURL returns the DownloadURL field if it's non-nil, zero value otherwise.
-func (p *PackageFile) GetDownloadURL() string {
-	if p == nil || p.DownloadURL == nil {
+// GetDiffHunk returns the DiffHunk field if it's non-nil, zero value otherwise.
+func (p *PullRequestComment) GetDiffHunk() string {
+	if p == nil || p.DiffHunk == nil {
 		return ""
 	}
-	return *p.DownloadURL
+	return *p.DiffHunk
+}
+
+// GetHTMLURL returns the HTMLURL field if it's non-nil, zero value otherwise.
+func (p *PullRequestComment) GetHTMLURL() string {
+	if p == nil || p.HTMLURL == nil {
+		return ""
+	}
+	return *p.HTMLURL
 }
 
 // GetID returns the ID field if it's non-nil, zero value otherwise.
-func (p *PackageFile) GetID() int64 {
+func (p *PullRequestComment) GetID() int64 {
 	if p == nil || p.ID == nil {
 		return 0
 	}
 	return *p.ID
 }
 
-// GetMD5 returns the MD5 field if it's non-nil, zero value otherwise.
-func (p *PackageFile) GetMD5() string {
-	if p == nil || p.MD5 == nil {
-		return ""
+// GetInReplyTo returns the InReplyTo field if it's non-nil, zero value otherwise.
+func (p *PullRequestComment) GetInReplyTo() int64 {
+	if p == nil || p.InReplyTo == nil {
+		return 0
 	}
-	return *p.MD5
+	return *p.InReplyTo
 }
 
-// GetName returns the Name field if it's non-nil, zero value otherwise.
-func (p *PackageFile) GetName() string {
-	if p == nil || p.Name == nil {
-		return ""
+// GetLine returns the Line field if it's non-nil, zero value otherwise.
+func (p *PullRequestComment) GetLine() int {
+	if p == nil || p.Line == nil {
+		return 0
 	}
-	return *p.Name
+	return *p.Line
 }
 
-// GetSHA1 returns the SHA1 field if it's non-nil, zero value otherwise.
-func (p *PackageFile) GetSHA1() string {
-	if p == nil || p.SHA1 == nil {
+// GetNodeID returns the NodeID field if it's non-nil, zero value otherwise.
+func (p *PullRequestComment) GetNodeID() string {
+	if p == nil || p.NodeID == nil {
 		return ""
 	}
-	return *p.SHA1
+	return *p.NodeID
 }
 
-// GetSHA256 returns the SHA256 field if it's non-nil, zero value otherwise.
-func (p *PackageFile) GetSHA256() string {
-	if p == nil || p.SHA256 == nil {
+// GetOriginalCommitID returns the OriginalCommitID field if it's non-nil, zero value otherwise.
+func (p *PullRequestComment) GetOriginalCommitID() string {
+	if p == nil || p.OriginalCommitID == nil {
 		return ""
 	}
-	return *p.SHA256
+	return *p.OriginalCommitID
 }
 
-// GetSize returns the Size field if it's non-nil, zero value otherwise.
-func (p *PackageFile) GetSize() int64 {
-	if p == nil || p.Size == nil {
+// GetOriginalLine returns the OriginalLine field if it's non-nil, zero value otherwise.
+func (p *PullRequestComment) GetOriginalLine() int {
+	if p == nil || p.OriginalLine == nil {
 		return 0
 	}
-	return *p.Size
+	return *p.OriginalLine
 }
 
-// GetState returns the State field if it's non-nil, zero value otherwise.
-func (p *PackageFile) GetState() string {
-	if p == nil || p.State == nil {
+// GetOriginalPosition returns the OriginalPosition field if it's non-nil, zero value otherwise.
+func (p *PullRequestComment) GetOriginalPosition() int {
+	if p == nil || p.OriginalPosition == nil {
+		return 0
+	}
+	return *p.OriginalPosition
+}
+
+// GetOriginalStartLine returns the OriginalStartLine field if it's non-nil, zero value otherwise.
+func (p *PullRequestComment) GetOriginalStartLine() int {
+	if p == nil || p.OriginalStartLine == nil {
+		return 0
+	}
+	return *p.OriginalStartLine
+}
+
+// GetPath returns the Path field if it's non-nil, zero value otherwise.
+func (p *PullRequestComment) GetPath() string {
+	if p == nil || p.Path == nil {
 		return ""
 	}
-	return *p.State
+	return *p.Path
 }
 
-// GetUpdatedAt returns the UpdatedAt field if it's non-nil, zero value otherwise.
-func (p *PackageFile) GetUpdatedAt() Timestamp {
-	if p == nil || p.UpdatedAt == nil {
-		return Timestamp{}
+// GetPosition returns the Position field if it's non-nil, zero value otherwise.
+func (p *PullRequestComment) GetPosition() int {
+	if p == nil || p.Position == nil {
+		return 0
 	}
-	return *p.UpdatedAt
+	return *p.Position
 }
 
-// GetAboutURL returns the AboutURL field if it's non-nil, zero value otherwise.
-func (p *PackageRegistry) GetAboutURL() string {
-	if p == nil || p.AboutURL == nil {
+// GetPullRequestReviewID returns the PullRequestReviewID field if it's non-nil, zero value otherwise.
+func (p *PullRequestComment) GetPullRequestReviewID() int64 {
+	if p == nil || p.PullRequestReviewID == nil {
+		return 0
+	}
+	return *p.PullRequestReviewID
+}
+
+// GetPullRequestURL returns the PullRequestURL field if it's non-nil, zero value otherwise.
+func (p *PullRequestComment) GetPullRequestURL() string {
+	if p == nil || p.PullRequestURL == nil {
 		return ""
 	}
-	return *p.AboutURL
+	return *p.PullRequestURL
 }
 
-// GetName returns the Name field if it's non-nil, zero value otherwise.
-func (p *PackageRegistry) GetName() string {
-	if p == nil || p.Name == nil {
+// GetReactions returns the Reactions field.
+func (p *PullRequestComment) GetReactions() *Reactions {
+	if p == nil {
+		return nil
+	}
+	return p.Reactions
+}
+
+// GetSide returns the Side field if it's non-nil, zero value otherwise.
+func (p *PullRequestComment) GetSide() string {
+	if p == nil || p.Side == nil {
 		return ""
 	}
-	return *p.Name
+	return *p.Side
 }
 
-// GetType returns the Type field if it's non-nil, zero value otherwise.
-func (p *PackageRegistry) GetType() string {
-	if p == nil || p.Type == nil {
+// GetStartLine returns the StartLine field if it's non-nil, zero value otherwise.
+func (p *PullRequestComment) GetStartLine() int {
+	if p == nil || p.StartLine == nil {
+		return 0
+	}
+	return *p.StartLine
+}
+
+// GetStartSide returns the StartSide field if it's non-nil, zero value otherwise.
+func (p *PullRequestComment) GetStartSide() string {
+	if p == nil || p.StartSide == nil {
 		return ""
 	}
-	return *p.Type
+	return *p.StartSide
+}
+
+// GetUpdatedAt returns the UpdatedAt field if it's non-nil, zero value otherwise.
+func (p *PullRequestComment) GetUpdatedAt() time.Time {
+	if p == nil || p.UpdatedAt == nil {
+		return time.Time{}
+	}
+	return *p.UpdatedAt
 }
 
 // GetURL returns the URL field if it's non-nil, zero value otherwise.
-func (p *PackageRegistry) GetURL() string {
+func (p *PullRequestComment) GetURL() string {
 	if p == nil || p.URL == nil {
 		return ""
 	}
 	return *p.URL
 }
 
-// GetVendor returns the Vendor field if it's non-nil, zero value otherwise.
-func (p *PackageRegistry) GetVendor() string {
-	if p == nil || p.Vendor == nil {
-		return ""
+// GetUser returns the User field.
+func (p *PullRequestComment) GetUser() *User {
+	if p == nil {
+		return nil
 	}
-	return *p.Vendor
+	return p.User
 }
 
-// GetAuthor returns the Author field.
-func (p *PackageRelease) GetAuthor() *User {
+// GetComment returns the Comment field.
+func (p *PullRequestCommentThread) GetComment() *PullRequestComment {
 	if p == nil {
 		return nil
 	}
-	return p.Author
+	return p.Comment
 }
 
-// GetCreatedAt returns the CreatedAt field if it's non-nil, zero value otherwise.
-func (p *PackageRelease) GetCreatedAt() Timestamp {
-	if p == nil || p.CreatedAt == nil {
-		return Timestamp{}
+// GetAction returns the Action field if it's non-nil, zero value otherwise.
+func (p *PullRequestEvent) GetAction() string {
+	if p == nil || p.Action == nil {
+		return ""
 	}
-	return *p.CreatedAt
+	return *p.Action
 }
 
-// GetDraft returns the Draft field if it's non-nil, zero value otherwise.
-func (p *PackageRelease) GetDraft() bool {
-	if p == nil || p.Draft == nil {
-		return false
+// GetAfter returns the After field if it's non-nil, zero value otherwise.
+func (p *PullRequestEvent) GetAfter() string {
+	if p == nil || p.After == nil {
+		return ""
 	}
-	return *p.Draft
+	return *p.After
 }
 
-// GetHTMLURL returns the HTMLURL field if it's non-nil, zero value otherwise.
-func (p *PackageRelease) GetHTMLURL() string {
-	if p == nil || p.HTMLURL == nil {
-		return ""
+// GetAssignee returns the Assignee field.
+func (p *PullRequestEvent) GetAssignee() *User {
+	if p == nil {
+		return nil
 	}
-	return *p.HTMLURL
+	return p.Assignee
 }
 
-// GetID returns the ID field if it's non-nil, zero value otherwise.
-func (p *PackageRelease) GetID() int64 {
-	if p == nil || p.ID == nil {
-		return 0
+// GetBefore returns the Before field if it's non-nil, zero value otherwise.
+func (p *PullRequestEvent) GetBefore() string {
+	if p == nil || p.Before == nil {
+		return ""
 	}
-	return *p.ID
+	return *p.Before
 }
 
-// GetName returns the Name field if it's non-nil, zero value otherwise.
-func (p *PackageRelease) GetName() string {
-	if p == nil || p.Name == nil {
-		return ""
+// GetChanges returns the Changes field.
+func (p *PullRequestEvent) GetChanges() *EditChange {
+	if p == nil {
+		return nil
 	}
-	return *p.Name
+	return p.Changes
 }
 
-// GetPrerelease returns the Prerelease field if it's non-nil, zero value otherwise.
-func (p *PackageRelease) GetPrerelease() bool {
-	if p == nil || p.Prerelease == nil {
-		return false
+// GetInstallation returns the Installation field.
+func (p *PullRequestEvent) GetInstallation() *Installation {
+	if p == nil {
+		return nil
 	}
-	return *p.Prerelease
+	return p.Installation
 }
 
-// GetPublishedAt returns the PublishedAt field if it's non-nil, zero value otherwise.
-func (p *PackageRelease) GetPublishedAt() Timestamp {
-	if p == nil || p.PublishedAt == nil {
-		return Timestamp{}
+// GetLabel returns the Label field.
+func (p *PullRequestEvent) GetLabel() *Label {
+	if p == nil {
+		return nil
 	}
-	return *p.PublishedAt
+	return p.Label
 }
 
-// GetTagName returns the TagName field if it's non-nil, zero value otherwise.
-func (p *PackageRelease) GetTagName() string {
-	if p == nil || p.TagName == nil {
-		return ""
+// GetNumber returns the Number field if it's non-nil, zero value otherwise.
+func (p *PullRequestEvent) GetNumber() int {
+	if p == nil || p.Number == nil {
+		return 0
 	}
-	return *p.TagName
+	return *p.Number
 }
 
-// GetTargetCommitish returns the TargetCommitish field if it's non-nil, zero value otherwise.
-func (p *PackageRelease) GetTargetCommitish() string {
-	if p == nil || p.TargetCommitish == nil {
-		return ""
+// GetOrganization returns the Organization field.
+func (p *PullRequestEvent) GetOrganization() *Organization {
+	if p == nil {
+		return nil
 	}
-	return *p.TargetCommitish
+	return p.Organization
 }
 
-// GetURL returns the URL field if it's non-nil, zero value otherwise.
-func (p *PackageRelease) GetURL() string {
-	if p == nil || p.URL == nil {
-		return ""
+// GetPullRequest returns the PullRequest field.
+func (p *PullRequestEvent) GetPullRequest() *PullRequest {
+	if p == nil {
+		return nil
 	}
-	return *p.URL
+	return p.PullRequest
 }
 
-// GetAuthor returns the Author field.
-func (p *PackageVersion) GetAuthor() *User {
+// GetRepo returns the Repo field.
+func (p *PullRequestEvent) GetRepo() *Repository {
 	if p == nil {
 		return nil
 	}
-	return p.Author
+	return p.Repo
 }
 
-// GetBody returns the Body field if it's non-nil, zero value otherwise.
-func (p *PackageVersion) GetBody() string {
-	if p == nil || p.Body == nil {
-		return ""
+// GetRequestedReviewer returns the RequestedReviewer field.
+func (p *PullRequestEvent) GetRequestedReviewer() *User {
+	if p == nil {
+		return nil
 	}
-	return *p.Body
+	return p.RequestedReviewer
 }
 
-// GetBodyHTML returns the BodyHTML field if it's non-nil, zero value otherwise.
-func (p *PackageVersion) GetBodyHTML() string {
-	if p == nil || p.BodyHTML == nil {
-		return ""
+// GetRequestedTeam returns the RequestedTeam field.
+func (p *PullRequestEvent) GetRequestedTeam() *Team {
+	if p == nil {
+		return nil
 	}
-	return *p.BodyHTML
+	return p.RequestedTeam
 }
 
-// GetCreatedAt returns the CreatedAt field if it's non-nil, zero value otherwise.
-func (p *PackageVersion) GetCreatedAt() Timestamp {
-	if p == nil || p.CreatedAt == nil {
-		return Timestamp{}
+// GetSender returns the Sender field.
+func (p *PullRequestEvent) GetSender() *User {
+	if p == nil {
+		return nil
 	}
-	return *p.CreatedAt
+	return p.Sender
 }
 
-// GetDraft returns the Draft field if it's non-nil, zero value otherwise.
-func (p *PackageVersion) GetDraft() bool {
-	if p == nil || p.Draft == nil {
-		return false
+// GetDiffURL returns the DiffURL field if it's non-nil, zero value otherwise.
+func (p *PullRequestLinks) GetDiffURL() string {
+	if p == nil || p.DiffURL == nil {
+		return ""
 	}
-	return *p.Draft
+	return *p.DiffURL
 }
 
 // GetHTMLURL returns the HTMLURL field if it's non-nil, zero value otherwise.
-func (p *PackageVersion) GetHTMLURL() string {
+func (p *PullRequestLinks) GetHTMLURL() string {
 	if p == nil || p.HTMLURL == nil {
 		return ""
 	}
 	return *p.HTMLURL
 }
 
-// GetID returns the ID field if it's non-nil, zero value otherwise.
-func (p *PackageVersion) GetID() int64 {
-	if p == nil || p.ID == nil {
-		return 0
+// GetPatchURL returns the PatchURL field if it's non-nil, zero value otherwise.
+func (p *PullRequestLinks) GetPatchURL() string {
+	if p == nil || p.PatchURL == nil {
+		return ""
 	}
-	return *p.ID
+	return *p.PatchURL
 }
 
-// GetInstallationCommand returns the InstallationCommand field if it's non-nil, zero value otherwise.
-func (p *PackageVersion) GetInstallationCommand() string {
-	if p == nil || p.InstallationCommand == nil {
+// GetURL returns the URL field if it's non-nil, zero value otherwise.
+func (p *PullRequestLinks) GetURL() string {
+	if p == nil || p.URL == nil {
 		return ""
 	}
-	return *p.InstallationCommand
+	return *p.URL
 }
 
-// GetManifest returns the Manifest field if it's non-nil, zero value otherwise.
-func (p *PackageVersion) GetManifest() string {
-	if p == nil || p.Manifest == nil {
-		return ""
+// GetPullRequest returns the PullRequest field.
+func (p *PullRequestMergeability) GetPullRequest() *PullRequest {
+	if p == nil {
+		return nil
 	}
-	return *p.Manifest
+	return p.PullRequest
 }
 
-// GetPrerelease returns the Prerelease field if it's non-nil, zero value otherwise.
-func (p *PackageVersion) GetPrerelease() bool {
-	if p == nil || p.Prerelease == nil {
+// GetMerged returns the Merged field if it's non-nil, zero value otherwise.
+func (p *PullRequestMergeResult) GetMerged() bool {
+	if p == nil || p.Merged == nil {
 		return false
 	}
-	return *p.Prerelease
+	return *p.Merged
 }
 
-// GetRelease returns the Release field.
-func (p *PackageVersion) GetRelease() *PackageRelease {
-	if p == nil {
-		return nil
+// GetMessage returns the Message field if it's non-nil, zero value otherwise.
+func (p *PullRequestMergeResult) GetMessage() string {
+	if p == nil || p.Message == nil {
+		return ""
 	}
-	return p.Release
+	return *p.Message
 }
 
-// GetSummary returns the Summary field if it's non-nil, zero value otherwise.
-func (p *PackageVersion) GetSummary() string {
-	if p == nil || p.Summary == nil {
+// GetSHA returns the SHA field if it's non-nil, zero value otherwise.
+func (p *PullRequestMergeResult) GetSHA() string {
+	if p == nil || p.SHA == nil {
 		return ""
 	}
-	return *p.Summary
+	return *p.SHA
 }
 
-// GetTagName returns the TagName field if it's non-nil, zero value otherwise.
-func (p *PackageVersion) GetTagName() string {
-	if p == nil || p.TagName == nil {
+// GetAuthorAssociation returns the AuthorAssociation field if it's non-nil, zero value otherwise.
+func (p *PullRequestReview) GetAuthorAssociation() string {
+	if p == nil || p.AuthorAssociation == nil {
 		return ""
 	}
-	return *p.TagName
+	return *p.AuthorAssociation
 }
 
-// GetTargetCommitish returns the TargetCommitish field if it's non-nil, zero value otherwise.
-func (p *PackageVersion) GetTargetCommitish() string {
-	if p == nil || p.TargetCommitish == nil {
+// GetBody returns the Body field if it's non-nil, zero value otherwise.
+func (p *PullRequestReview) GetBody() string {
+	if p == nil || p.Body == nil {
 		return ""
 	}
-	return *p.TargetCommitish
+	return *p.Body
 }
 
-// GetTargetOID returns the TargetOID field if it's non-nil, zero value otherwise.
-func (p *PackageVersion) GetTargetOID() string {
-	if p == nil || p.TargetOID == nil {
+// GetCommitID returns the CommitID field if it's non-nil, zero value otherwise.
+func (p *PullRequestReview) GetCommitID() string {
+	if p == nil || p.CommitID == nil {
 		return ""
 	}
-	return *p.TargetOID
+	return *p.CommitID
 }
 
-// GetUpdatedAt returns the UpdatedAt field if it's non-nil, zero value otherwise.
-func (p *PackageVersion) GetUpdatedAt() Timestamp {
-	if p == nil || p.UpdatedAt == nil {
-		return Timestamp{}
+// GetHTMLURL returns the HTMLURL field if it's non-nil, zero value otherwise.
+func (p *PullRequestReview) GetHTMLURL() string {
+	if p == nil || p.HTMLURL == nil {
+		return ""
 	}
-	return *p.UpdatedAt
+	return *p.HTMLURL
 }
 
-// GetVersion returns the Version field if it's non-nil, zero value otherwise.
-func (p *PackageVersion) GetVersion() string {
-	if p == nil || p.Version == nil {
-		return ""
+// GetID returns the ID field if it's non-nil, zero value otherwise.
+func (p *PullRequestReview) GetID() int64 {
+	if p == nil || p.ID == nil {
+		return 0
 	}
-	return *p.Version
+	return *p.ID
 }
 
-// GetAction returns the Action field if it's non-nil, zero value otherwise.
-func (p *Page) GetAction() string {
-	if p == nil || p.Action == nil {
+// GetNodeID returns the NodeID field if it's non-nil, zero value otherwise.
+func (p *PullRequestReview) GetNodeID() string {
+	if p == nil || p.NodeID == nil {
 		return ""
 	}
-	return *p.Action
+	return *p.NodeID
 }
 
-// GetHTMLURL returns the HTMLURL field if it's non-nil, zero value otherwise.
-func (p *Page) GetHTMLURL() string {
-	if p == nil || p.HTMLURL == nil {
+// GetPullRequestURL returns the PullRequestURL field if it's non-nil, zero value otherwise.
+func (p *PullRequestReview) GetPullRequestURL() string {
+	if p == nil || p.PullRequestURL == nil {
 		return ""
 	}
-	return *p.HTMLURL
+	return *p.PullRequestURL
 }
 
-// GetPageName returns the PageName field if it's non-nil, zero value otherwise.
-func (p *Page) GetPageName() string {
-	if p == nil || p.PageName == nil {
+// GetState returns the State field if it's non-nil, zero value otherwise.
+func (p *PullRequestReview) GetState() string {
+	if p == nil || p.State == nil {
 		return ""
 	}
-	return *p.PageName
+	return *p.State
 }
 
-// GetSHA returns the SHA field if it's non-nil, zero value otherwise.
-func (p *Page) GetSHA() string {
-	if p == nil || p.SHA == nil {
-		return ""
+// GetSubmittedAt returns the SubmittedAt field if it's non-nil, zero value otherwise.
+func (p *PullRequestReview) GetSubmittedAt() time.Time {
+	if p == nil || p.SubmittedAt == nil {
+		return time.Time{}
 	}
-	return *p.SHA
+	return *p.SubmittedAt
 }
 
-// GetSummary returns the Summary field if it's non-nil, zero value otherwise.
-func (p *Page) GetSummary() string {
-	if p == nil || p.Summary == nil {
-		return ""
+// GetUser returns the User field.
+func (p *PullRequestReview) GetUser() *User {
+	if p == nil {
+		return nil
 	}
-	return *p.Summary
+	return p.User
 }
 
-// GetTitle returns the Title field if it's non-nil, zero value otherwise.
-func (p *Page) GetTitle() string {
-	if p == nil || p.Title == nil {
+// GetAction returns the Action field if it's non-nil, zero value otherwise.
+func (p *PullRequestReviewCommentEvent) GetAction() string {
+	if p == nil || p.Action == nil {
 		return ""
 	}
-	return *p.Title
+	return *p.Action
 }
 
-// GetBuild returns the Build field.
-func (p *PageBuildEvent) GetBuild() *PagesBuild {
+// GetChanges returns the Changes field.
+func (p *PullRequestReviewCommentEvent) GetChanges() *EditChange {
 	if p == nil {
 		return nil
 	}
-	return p.Build
+	return p.Changes
 }
 
-// GetID returns the ID field if it's non-nil, zero value otherwise.
-func (p *PageBuildEvent) GetID() int64 {
-	if p == nil || p.ID == nil {
-		return 0
+// GetComment returns the Comment field.
+func (p *PullRequestReviewCommentEvent) GetComment() *PullRequestComment {
+	if p == nil {
+		return nil
 	}
-	return *p.ID
+	return p.Comment
 }
 
 // GetInstallation returns the Installation field.
-func (p *PageBuildEvent) GetInstallation() *Installation {
+func (p *PullRequestReviewCommentEvent) GetInstallation() *Installation {
 	if p == nil {
 		return nil
 	}
 	return p.Installation
 }
 
+// GetPullRequest returns the PullRequest field.
+func (p *PullRequestReviewCommentEvent) GetPullRequest() *PullRequest {
+	if p == nil {
+		return nil
+	}
+	return p.PullRequest
+}
+
 // GetRepo returns the Repo field.
-func (p *PageBuildEvent) GetRepo() *Repository {
+func (p *PullRequestReviewCommentEvent) GetRepo() *Repository {
 	if p == nil {
 		return nil
 	}
@@ -7981,719 +13621,711 @@ func (p *PageBuildEvent) GetRepo() *Repository {
 }
 
 // GetSender returns the Sender field.
-func (p *PageBuildEvent) GetSender() *User {
+func (p *PullRequestReviewCommentEvent) GetSender() *User {
 	if p == nil {
 		return nil
 	}
 	return p.Sender
 }
 
-// GetCNAME returns the CNAME field if it's non-nil, zero value otherwise.
-func (p *Pages) GetCNAME() string {
-	if p == nil || p.CNAME == nil {
+// GetMessage returns the Message field if it's non-nil, zero value otherwise.
+func (p *PullRequestReviewDismissalRequest) GetMessage() string {
+	if p == nil || p.Message == nil {
 		return ""
 	}
-	return *p.CNAME
+	return *p.Message
 }
 
-// GetCustom404 returns the Custom404 field if it's non-nil, zero value otherwise.
-func (p *Pages) GetCustom404() bool {
-	if p == nil || p.Custom404 == nil {
-		return false
+// GetAction returns the Action field if it's non-nil, zero value otherwise.
+func (p *PullRequestReviewEvent) GetAction() string {
+	if p == nil || p.Action == nil {
+		return ""
 	}
-	return *p.Custom404
+	return *p.Action
 }
 
-// GetHTMLURL returns the HTMLURL field if it's non-nil, zero value otherwise.
-func (p *Pages) GetHTMLURL() string {
-	if p == nil || p.HTMLURL == nil {
-		return ""
+// GetInstallation returns the Installation field.
+func (p *PullRequestReviewEvent) GetInstallation() *Installation {
+	if p == nil {
+		return nil
 	}
-	return *p.HTMLURL
+	return p.Installation
 }
 
-// GetSource returns the Source field.
-func (p *Pages) GetSource() *PagesSource {
+// GetOrganization returns the Organization field.
+func (p *PullRequestReviewEvent) GetOrganization() *Organization {
 	if p == nil {
 		return nil
 	}
-	return p.Source
+	return p.Organization
 }
 
-// GetStatus returns the Status field if it's non-nil, zero value otherwise.
-func (p *Pages) GetStatus() string {
-	if p == nil || p.Status == nil {
-		return ""
+// GetPullRequest returns the PullRequest field.
+func (p *PullRequestReviewEvent) GetPullRequest() *PullRequest {
+	if p == nil {
+		return nil
 	}
-	return *p.Status
+	return p.PullRequest
 }
 
-// GetURL returns the URL field if it's non-nil, zero value otherwise.
-func (p *Pages) GetURL() string {
-	if p == nil || p.URL == nil {
+// GetRepo returns the Repo field.
+func (p *PullRequestReviewEvent) GetRepo() *Repository {
+	if p == nil {
+		return nil
+	}
+	return p.Repo
+}
+
+// GetReview returns the Review field.
+func (p *PullRequestReviewEvent) GetReview() *PullRequestReview {
+	if p == nil {
+		return nil
+	}
+	return p.Review
+}
+
+// GetSender returns the Sender field.
+func (p *PullRequestReviewEvent) GetSender() *User {
+	if p == nil {
+		return nil
+	}
+	return p.Sender
+}
+
+// GetBody returns the Body field if it's non-nil, zero value otherwise.
+func (p *PullRequestReviewRequest) GetBody() string {
+	if p == nil || p.Body == nil {
 		return ""
 	}
-	return *p.URL
+	return *p.Body
 }
 
-// GetCommit returns the Commit field if it's non-nil, zero value otherwise.
-func (p *PagesBuild) GetCommit() string {
-	if p == nil || p.Commit == nil {
+// GetCommitID returns the CommitID field if it's non-nil, zero value otherwise.
+func (p *PullRequestReviewRequest) GetCommitID() string {
+	if p == nil || p.CommitID == nil {
 		return ""
 	}
-	return *p.Commit
+	return *p.CommitID
 }
 
-// GetCreatedAt returns the CreatedAt field if it's non-nil, zero value otherwise.
-func (p *PagesBuild) GetCreatedAt() Timestamp {
-	if p == nil || p.CreatedAt == nil {
-		return Timestamp{}
+// GetEvent returns the Event field if it's non-nil, zero value otherwise.
+func (p *PullRequestReviewRequest) GetEvent() string {
+	if p == nil || p.Event == nil {
+		return ""
 	}
-	return *p.CreatedAt
+	return *p.Event
 }
 
-// GetDuration returns the Duration field if it's non-nil, zero value otherwise.
-func (p *PagesBuild) GetDuration() int {
-	if p == nil || p.Duration == nil {
-		return 0
+// GetNodeID returns the NodeID field if it's non-nil, zero value otherwise.
+func (p *PullRequestReviewRequest) GetNodeID() string {
+	if p == nil || p.NodeID == nil {
+		return ""
 	}
-	return *p.Duration
+	return *p.NodeID
 }
 
-// GetError returns the Error field.
-func (p *PagesBuild) GetError() *PagesError {
+// GetDismissalRestrictions returns the DismissalRestrictions field.
+func (p *PullRequestReviewsEnforcement) GetDismissalRestrictions() *DismissalRestrictions {
 	if p == nil {
 		return nil
 	}
-	return p.Error
+	return p.DismissalRestrictions
 }
 
-// GetPusher returns the Pusher field.
-func (p *PagesBuild) GetPusher() *User {
+// GetDismissalRestrictionsRequest returns the DismissalRestrictionsRequest field.
+func (p *PullRequestReviewsEnforcementRequest) GetDismissalRestrictionsRequest() *DismissalRestrictionsRequest {
 	if p == nil {
 		return nil
 	}
-	return p.Pusher
+	return p.DismissalRestrictionsRequest
 }
 
-// GetStatus returns the Status field if it's non-nil, zero value otherwise.
-func (p *PagesBuild) GetStatus() string {
-	if p == nil || p.Status == nil {
-		return ""
+// GetDismissalRestrictionsRequest returns the DismissalRestrictionsRequest field.
+func (p *PullRequestReviewsEnforcementUpdate) GetDismissalRestrictionsRequest() *DismissalRestrictionsRequest {
+	if p == nil {
+		return nil
 	}
-	return *p.Status
+	return p.DismissalRestrictionsRequest
 }
 
-// GetUpdatedAt returns the UpdatedAt field if it's non-nil, zero value otherwise.
-func (p *PagesBuild) GetUpdatedAt() Timestamp {
-	if p == nil || p.UpdatedAt == nil {
-		return Timestamp{}
+// GetDismissStaleReviews returns the DismissStaleReviews field if it's non-nil, zero value otherwise.
+func (p *PullRequestReviewsEnforcementUpdate) GetDismissStaleReviews() bool {
+	if p == nil || p.DismissStaleReviews == nil {
+		return false
 	}
-	return *p.UpdatedAt
+	return *p.DismissStaleReviews
 }
 
-// GetURL returns the URL field if it's non-nil, zero value otherwise.
-func (p *PagesBuild) GetURL() string {
-	if p == nil || p.URL == nil {
+// GetDiffSide returns the DiffSide field if it's non-nil, zero value otherwise.
+func (p *PullRequestReviewThread) GetDiffSide() string {
+	if p == nil || p.DiffSide == nil {
 		return ""
 	}
-	return *p.URL
+	return *p.DiffSide
 }
 
-// GetMessage returns the Message field if it's non-nil, zero value otherwise.
-func (p *PagesError) GetMessage() string {
-	if p == nil || p.Message == nil {
+// GetID returns the ID field if it's non-nil, zero value otherwise.
+func (p *PullRequestReviewThread) GetID() string {
+	if p == nil || p.ID == nil {
 		return ""
 	}
-	return *p.Message
+	return *p.ID
 }
 
-// GetBranch returns the Branch field if it's non-nil, zero value otherwise.
-func (p *PagesSource) GetBranch() string {
-	if p == nil || p.Branch == nil {
-		return ""
+// GetIsCollapsed returns the IsCollapsed field if it's non-nil, zero value otherwise.
+func (p *PullRequestReviewThread) GetIsCollapsed() bool {
+	if p == nil || p.IsCollapsed == nil {
+		return false
 	}
-	return *p.Branch
+	return *p.IsCollapsed
 }
 
-// GetPath returns the Path field if it's non-nil, zero value otherwise.
-func (p *PagesSource) GetPath() string {
-	if p == nil || p.Path == nil {
-		return ""
+// GetIsOutdated returns the IsOutdated field if it's non-nil, zero value otherwise.
+func (p *PullRequestReviewThread) GetIsOutdated() bool {
+	if p == nil || p.IsOutdated == nil {
+		return false
 	}
-	return *p.Path
+	return *p.IsOutdated
 }
 
-// GetTotalPages returns the TotalPages field if it's non-nil, zero value otherwise.
-func (p *PageStats) GetTotalPages() int {
-	if p == nil || p.TotalPages == nil {
-		return 0
+// GetIsResolved returns the IsResolved field if it's non-nil, zero value otherwise.
+func (p *PullRequestReviewThread) GetIsResolved() bool {
+	if p == nil || p.IsResolved == nil {
+		return false
 	}
-	return *p.TotalPages
+	return *p.IsResolved
 }
 
-// GetCNAME returns the CNAME field if it's non-nil, zero value otherwise.
-func (p *PagesUpdate) GetCNAME() string {
-	if p == nil || p.CNAME == nil {
-		return ""
+// GetLine returns the Line field if it's non-nil, zero value otherwise.
+func (p *PullRequestReviewThread) GetLine() int {
+	if p == nil || p.Line == nil {
+		return 0
 	}
-	return *p.CNAME
+	return *p.Line
 }
 
-// GetSource returns the Source field if it's non-nil, zero value otherwise.
-func (p *PagesUpdate) GetSource() string {
-	if p == nil || p.Source == nil {
-		return ""
+// GetOriginalLine returns the OriginalLine field if it's non-nil, zero value otherwise.
+func (p *PullRequestReviewThread) GetOriginalLine() int {
+	if p == nil || p.OriginalLine == nil {
+		return 0
 	}
-	return *p.Source
+	return *p.OriginalLine
 }
 
-// GetHook returns the Hook field.
-func (p *PingEvent) GetHook() *Hook {
-	if p == nil {
-		return nil
+// GetOriginalStartLine returns the OriginalStartLine field if it's non-nil, zero value otherwise.
+func (p *PullRequestReviewThread) GetOriginalStartLine() int {
+	if p == nil || p.OriginalStartLine == nil {
+		return 0
 	}
-	return p.Hook
+	return *p.OriginalStartLine
 }
 
-// GetHookID returns the HookID field if it's non-nil, zero value otherwise.
-func (p *PingEvent) GetHookID() int64 {
-	if p == nil || p.HookID == nil {
-		return 0
+// GetPath returns the Path field if it's non-nil, zero value otherwise.
+func (p *PullRequestReviewThread) GetPath() string {
+	if p == nil || p.Path == nil {
+		return ""
 	}
-	return *p.HookID
+	return *p.Path
 }
 
-// GetInstallation returns the Installation field.
-func (p *PingEvent) GetInstallation() *Installation {
+// GetResolvedBy returns the ResolvedBy field.
+func (p *PullRequestReviewThread) GetResolvedBy() *User {
 	if p == nil {
 		return nil
 	}
-	return p.Installation
+	return p.ResolvedBy
 }
 
-// GetZen returns the Zen field if it's non-nil, zero value otherwise.
-func (p *PingEvent) GetZen() string {
-	if p == nil || p.Zen == nil {
+// GetStartDiffSide returns the StartDiffSide field if it's non-nil, zero value otherwise.
+func (p *PullRequestReviewThread) GetStartDiffSide() string {
+	if p == nil || p.StartDiffSide == nil {
 		return ""
 	}
-	return *p.Zen
+	return *p.StartDiffSide
 }
 
-// GetCollaborators returns the Collaborators field if it's non-nil, zero value otherwise.
-func (p *Plan) GetCollaborators() int {
-	if p == nil || p.Collaborators == nil {
+// GetStartLine returns the StartLine field if it's non-nil, zero value otherwise.
+func (p *PullRequestReviewThread) GetStartLine() int {
+	if p == nil || p.StartLine == nil {
 		return 0
 	}
-	return *p.Collaborators
+	return *p.StartLine
 }
 
-// GetFilledSeats returns the FilledSeats field if it's non-nil, zero value otherwise.
-func (p *Plan) GetFilledSeats() int {
-	if p == nil || p.FilledSeats == nil {
+// GetMergablePulls returns the MergablePulls field if it's non-nil, zero value otherwise.
+func (p *PullStats) GetMergablePulls() int {
+	if p == nil || p.MergablePulls == nil {
 		return 0
 	}
-	return *p.FilledSeats
+	return *p.MergablePulls
 }
 
-// GetName returns the Name field if it's non-nil, zero value otherwise.
-func (p *Plan) GetName() string {
-	if p == nil || p.Name == nil {
-		return ""
+// GetMergedPulls returns the MergedPulls field if it's non-nil, zero value otherwise.
+func (p *PullStats) GetMergedPulls() int {
+	if p == nil || p.MergedPulls == nil {
+		return 0
 	}
-	return *p.Name
+	return *p.MergedPulls
+}
+
+// GetTotalPulls returns the TotalPulls field if it's non-nil, zero value otherwise.
+func (p *PullStats) GetTotalPulls() int {
+	if p == nil || p.TotalPulls == nil {
+		return 0
+	}
+	return *p.TotalPulls
 }
 
-// GetPrivateRepos returns the PrivateRepos field if it's non-nil, zero value otherwise.
-func (p *Plan) GetPrivateRepos() int {
-	if p == nil || p.PrivateRepos == nil {
+// GetUnmergablePulls returns the UnmergablePulls field if it's non-nil, zero value otherwise.
+func (p *PullStats) GetUnmergablePulls() int {
+	if p == nil || p.UnmergablePulls == nil {
 		return 0
 	}
-	return *p.PrivateRepos
+	return *p.UnmergablePulls
 }
 
-// GetSeats returns the Seats field if it's non-nil, zero value otherwise.
-func (p *Plan) GetSeats() int {
-	if p == nil || p.Seats == nil {
+// GetCommits returns the Commits field if it's non-nil, zero value otherwise.
+func (p *PunchCard) GetCommits() int {
+	if p == nil || p.Commits == nil {
 		return 0
 	}
-	return *p.Seats
+	return *p.Commits
 }
 
-// GetSpace returns the Space field if it's non-nil, zero value otherwise.
-func (p *Plan) GetSpace() int {
-	if p == nil || p.Space == nil {
+// GetDay returns the Day field if it's non-nil, zero value otherwise.
+func (p *PunchCard) GetDay() int {
+	if p == nil || p.Day == nil {
 		return 0
 	}
-	return *p.Space
+	return *p.Day
 }
 
-// GetConfigURL returns the ConfigURL field if it's non-nil, zero value otherwise.
-func (p *PreReceiveHook) GetConfigURL() string {
-	if p == nil || p.ConfigURL == nil {
-		return ""
+// GetHour returns the Hour field if it's non-nil, zero value otherwise.
+func (p *PunchCard) GetHour() int {
+	if p == nil || p.Hour == nil {
+		return 0
 	}
-	return *p.ConfigURL
+	return *p.Hour
 }
 
-// GetEnforcement returns the Enforcement field if it's non-nil, zero value otherwise.
-func (p *PreReceiveHook) GetEnforcement() string {
-	if p == nil || p.Enforcement == nil {
+// GetAfter returns the After field if it's non-nil, zero value otherwise.
+func (p *PushEvent) GetAfter() string {
+	if p == nil || p.After == nil {
 		return ""
 	}
-	return *p.Enforcement
+	return *p.After
 }
 
-// GetID returns the ID field if it's non-nil, zero value otherwise.
-func (p *PreReceiveHook) GetID() int64 {
-	if p == nil || p.ID == nil {
-		return 0
+// GetBaseRef returns the BaseRef field if it's non-nil, zero value otherwise.
+func (p *PushEvent) GetBaseRef() string {
+	if p == nil || p.BaseRef == nil {
+		return ""
 	}
-	return *p.ID
+	return *p.BaseRef
 }
 
-// GetName returns the Name field if it's non-nil, zero value otherwise.
-func (p *PreReceiveHook) GetName() string {
-	if p == nil || p.Name == nil {
+// GetBefore returns the Before field if it's non-nil, zero value otherwise.
+func (p *PushEvent) GetBefore() string {
+	if p == nil || p.Before == nil {
 		return ""
 	}
-	return *p.Name
+	return *p.Before
 }
 
-// GetHRef returns the HRef field if it's non-nil, zero value otherwise.
-func (p *PRLink) GetHRef() string {
-	if p == nil || p.HRef == nil {
+// GetCompare returns the Compare field if it's non-nil, zero value otherwise.
+func (p *PushEvent) GetCompare() string {
+	if p == nil || p.Compare == nil {
 		return ""
 	}
-	return *p.HRef
+	return *p.Compare
 }
 
-// GetComments returns the Comments field.
-func (p *PRLinks) GetComments() *PRLink {
-	if p == nil {
-		return nil
+// GetCreated returns the Created field if it's non-nil, zero value otherwise.
+func (p *PushEvent) GetCreated() bool {
+	if p == nil || p.Created == nil {
+		return false
 	}
-	return p.Comments
+	return *p.Created
 }
 
-// GetCommits returns the Commits field.
-func (p *PRLinks) GetCommits() *PRLink {
-	if p == nil {
-		return nil
+// GetDeleted returns the Deleted field if it's non-nil, zero value otherwise.
+func (p *PushEvent) GetDeleted() bool {
+	if p == nil || p.Deleted == nil {
+		return false
 	}
-	return p.Commits
+	return *p.Deleted
 }
 
-// GetHTML returns the HTML field.
-func (p *PRLinks) GetHTML() *PRLink {
-	if p == nil {
-		return nil
+// GetDistinctSize returns the DistinctSize field if it's non-nil, zero value otherwise.
+func (p *PushEvent) GetDistinctSize() int {
+	if p == nil || p.DistinctSize == nil {
+		return 0
 	}
-	return p.HTML
+	return *p.DistinctSize
 }
 
-// GetIssue returns the Issue field.
-func (p *PRLinks) GetIssue() *PRLink {
-	if p == nil {
-		return nil
+// GetForced returns the Forced field if it's non-nil, zero value otherwise.
+func (p *PushEvent) GetForced() bool {
+	if p == nil || p.Forced == nil {
+		return false
 	}
-	return p.Issue
+	return *p.Forced
 }
 
-// GetReviewComment returns the ReviewComment field.
-func (p *PRLinks) GetReviewComment() *PRLink {
-	if p == nil {
-		return nil
+// GetHead returns the Head field if it's non-nil, zero value otherwise.
+func (p *PushEvent) GetHead() string {
+	if p == nil || p.Head == nil {
+		return ""
 	}
-	return p.ReviewComment
+	return *p.Head
 }
 
-// GetReviewComments returns the ReviewComments field.
-func (p *PRLinks) GetReviewComments() *PRLink {
+// GetHeadCommit returns the HeadCommit field.
+func (p *PushEvent) GetHeadCommit() *HeadCommit {
 	if p == nil {
 		return nil
 	}
-	return p.ReviewComments
+	return p.HeadCommit
 }
 
-// GetSelf returns the Self field.
-func (p *PRLinks) GetSelf() *PRLink {
+// GetInstallation returns the Installation field.
+func (p *PushEvent) GetInstallation() *Installation {
 	if p == nil {
 		return nil
 	}
-	return p.Self
+	return p.Installation
 }
 
-// GetStatuses returns the Statuses field.
-func (p *PRLinks) GetStatuses() *PRLink {
+// GetPusher returns the Pusher field.
+func (p *PushEvent) GetPusher() *User {
 	if p == nil {
 		return nil
 	}
-	return p.Statuses
+	return p.Pusher
 }
 
-// GetBody returns the Body field if it's non-nil, zero value otherwise.
-func (p *Project) GetBody() string {
-	if p == nil || p.Body == nil {
-		return ""
+// GetPushID returns the PushID field if it's non-nil, zero value otherwise.
+func (p *PushEvent) GetPushID() int64 {
+	if p == nil || p.PushID == nil {
+		return 0
 	}
-	return *p.Body
+	return *p.PushID
 }
 
-// GetColumnsURL returns the ColumnsURL field if it's non-nil, zero value otherwise.
-func (p *Project) GetColumnsURL() string {
-	if p == nil || p.ColumnsURL == nil {
+// GetRef returns the Ref field if it's non-nil, zero value otherwise.
+func (p *PushEvent) GetRef() string {
+	if p == nil || p.Ref == nil {
 		return ""
 	}
-	return *p.ColumnsURL
+	return *p.Ref
 }
 
-// GetCreatedAt returns the CreatedAt field if it's non-nil, zero value otherwise.
-func (p *Project) GetCreatedAt() Timestamp {
-	if p == nil || p.CreatedAt == nil {
-		return Timestamp{}
+// GetRepo returns the Repo field.
+func (p *PushEvent) GetRepo() *PushEventRepository {
+	if p == nil {
+		return nil
 	}
-	return *p.CreatedAt
+	return p.Repo
 }
 
-// GetCreator returns the Creator field.
-func (p *Project) GetCreator() *User {
+// GetSender returns the Sender field.
+func (p *PushEvent) GetSender() *User {
 	if p == nil {
 		return nil
 	}
-	return p.Creator
+	return p.Sender
 }
 
-// GetHTMLURL returns the HTMLURL field if it's non-nil, zero value otherwise.
-func (p *Project) GetHTMLURL() string {
-	if p == nil || p.HTMLURL == nil {
-		return ""
+// GetSize returns the Size field if it's non-nil, zero value otherwise.
+func (p *PushEvent) GetSize() int {
+	if p == nil || p.Size == nil {
+		return 0
 	}
-	return *p.HTMLURL
+	return *p.Size
 }
 
-// GetID returns the ID field if it's non-nil, zero value otherwise.
-func (p *Project) GetID() int64 {
-	if p == nil || p.ID == nil {
-		return 0
+// GetEmail returns the Email field if it's non-nil, zero value otherwise.
+func (p *PushEventRepoOwner) GetEmail() string {
+	if p == nil || p.Email == nil {
+		return ""
 	}
-	return *p.ID
+	return *p.Email
 }
 
 // GetName returns the Name field if it's non-nil, zero value otherwise.
-func (p *Project) GetName() string {
+func (p *PushEventRepoOwner) GetName() string {
 	if p == nil || p.Name == nil {
 		return ""
 	}
 	return *p.Name
 }
 
-// GetNodeID returns the NodeID field if it's non-nil, zero value otherwise.
-func (p *Project) GetNodeID() string {
-	if p == nil || p.NodeID == nil {
-		return ""
-	}
-	return *p.NodeID
-}
-
-// GetNumber returns the Number field if it's non-nil, zero value otherwise.
-func (p *Project) GetNumber() int {
-	if p == nil || p.Number == nil {
-		return 0
-	}
-	return *p.Number
-}
-
-// GetOwnerURL returns the OwnerURL field if it's non-nil, zero value otherwise.
-func (p *Project) GetOwnerURL() string {
-	if p == nil || p.OwnerURL == nil {
-		return ""
-	}
-	return *p.OwnerURL
-}
-
-// GetState returns the State field if it's non-nil, zero value otherwise.
-func (p *Project) GetState() string {
-	if p == nil || p.State == nil {
-		return ""
-	}
-	return *p.State
-}
-
-// GetUpdatedAt returns the UpdatedAt field if it's non-nil, zero value otherwise.
-func (p *Project) GetUpdatedAt() Timestamp {
-	if p == nil || p.UpdatedAt == nil {
-		return Timestamp{}
-	}
-	return *p.UpdatedAt
-}
-
-// GetURL returns the URL field if it's non-nil, zero value otherwise.
-func (p *Project) GetURL() string {
-	if p == nil || p.URL == nil {
-		return ""
-	}
-	return *p.URL
-}
-
 // GetArchived returns the Archived field if it's non-nil, zero value otherwise.
-func (p *ProjectCard) GetArchived() bool {
+func (p *PushEventRepository) GetArchived() bool {
 	if p == nil || p.Archived == nil {
 		return false
 	}
 	return *p.Archived
 }
 
-// GetColumnID returns the ColumnID field if it's non-nil, zero value otherwise.
-func (p *ProjectCard) GetColumnID() int64 {
-	if p == nil || p.ColumnID == nil {
-		return 0
+// GetArchiveURL returns the ArchiveURL field if it's non-nil, zero value otherwise.
+func (p *PushEventRepository) GetArchiveURL() string {
+	if p == nil || p.ArchiveURL == nil {
+		return ""
+	}
+	return *p.ArchiveURL
+}
+
+// GetCloneURL returns the CloneURL field if it's non-nil, zero value otherwise.
+func (p *PushEventRepository) GetCloneURL() string {
+	if p == nil || p.CloneURL == nil {
+		return ""
 	}
-	return *p.ColumnID
+	return *p.CloneURL
 }
 
-// GetColumnName returns the ColumnName field if it's non-nil, zero value otherwise.
-func (p *ProjectCard) GetColumnName() string {
-	if p == nil || p.ColumnName == nil {
-		return ""
+// GetCreatedAt returns the CreatedAt field if it's non-nil, zero value otherwise.
+func (p *PushEventRepository) GetCreatedAt() Timestamp {
+	if p == nil || p.CreatedAt == nil {
+		return Timestamp{}
 	}
-	return *p.ColumnName
+	return *p.CreatedAt
 }
 
-// GetColumnURL returns the ColumnURL field if it's non-nil, zero value otherwise.
-func (p *ProjectCard) GetColumnURL() string {
-	if p == nil || p.ColumnURL == nil {
+// GetDefaultBranch returns the DefaultBranch field if it's non-nil, zero value otherwise.
+func (p *PushEventRepository) GetDefaultBranch() string {
+	if p == nil || p.DefaultBranch == nil {
 		return ""
 	}
-	return *p.ColumnURL
+	return *p.DefaultBranch
 }
 
-// GetContentURL returns the ContentURL field if it's non-nil, zero value otherwise.
-func (p *ProjectCard) GetContentURL() string {
-	if p == nil || p.ContentURL == nil {
+// GetDescription returns the Description field if it's non-nil, zero value otherwise.
+func (p *PushEventRepository) GetDescription() string {
+	if p == nil || p.Description == nil {
 		return ""
 	}
-	return *p.ContentURL
+	return *p.Description
 }
 
-// GetCreatedAt returns the CreatedAt field if it's non-nil, zero value otherwise.
-func (p *ProjectCard) GetCreatedAt() Timestamp {
-	if p == nil || p.CreatedAt == nil {
-		return Timestamp{}
+// GetDisabled returns the Disabled field if it's non-nil, zero value otherwise.
+func (p *PushEventRepository) GetDisabled() bool {
+	if p == nil || p.Disabled == nil {
+		return false
 	}
-	return *p.CreatedAt
+	return *p.Disabled
 }
 
-// GetCreator returns the Creator field.
-func (p *ProjectCard) GetCreator() *User {
-	if p == nil {
-		return nil
+// GetFork returns the Fork field if it's non-nil, zero value otherwise.
+func (p *PushEventRepository) GetFork() bool {
+	if p == nil || p.Fork == nil {
+		return false
 	}
-	return p.Creator
+	return *p.Fork
 }
 
-// GetID returns the ID field if it's non-nil, zero value otherwise.
-func (p *ProjectCard) GetID() int64 {
-	if p == nil || p.ID == nil {
+// GetForksCount returns the ForksCount field if it's non-nil, zero value otherwise.
+func (p *PushEventRepository) GetForksCount() int {
+	if p == nil || p.ForksCount == nil {
 		return 0
 	}
-	return *p.ID
+	return *p.ForksCount
 }
 
-// GetNodeID returns the NodeID field if it's non-nil, zero value otherwise.
-func (p *ProjectCard) GetNodeID() string {
-	if p == nil || p.NodeID == nil {
+// GetFullName returns the FullName field if it's non-nil, zero value otherwise.
+func (p *PushEventRepository) GetFullName() string {
+	if p == nil || p.FullName == nil {
 		return ""
 	}
-	return *p.NodeID
+	return *p.FullName
 }
 
-// GetNote returns the Note field if it's non-nil, zero value otherwise.
-func (p *ProjectCard) GetNote() string {
-	if p == nil || p.Note == nil {
+// GetGitURL returns the GitURL field if it's non-nil, zero value otherwise.
+func (p *PushEventRepository) GetGitURL() string {
+	if p == nil || p.GitURL == nil {
 		return ""
 	}
-	return *p.Note
+	return *p.GitURL
 }
 
-// GetPreviousColumnName returns the PreviousColumnName field if it's non-nil, zero value otherwise.
-func (p *ProjectCard) GetPreviousColumnName() string {
-	if p == nil || p.PreviousColumnName == nil {
-		return ""
+// GetHasDownloads returns the HasDownloads field if it's non-nil, zero value otherwise.
+func (p *PushEventRepository) GetHasDownloads() bool {
+	if p == nil || p.HasDownloads == nil {
+		return false
 	}
-	return *p.PreviousColumnName
+	return *p.HasDownloads
 }
 
-// GetProjectID returns the ProjectID field if it's non-nil, zero value otherwise.
-func (p *ProjectCard) GetProjectID() int64 {
-	if p == nil || p.ProjectID == nil {
-		return 0
+// GetHasIssues returns the HasIssues field if it's non-nil, zero value otherwise.
+func (p *PushEventRepository) GetHasIssues() bool {
+	if p == nil || p.HasIssues == nil {
+		return false
 	}
-	return *p.ProjectID
+	return *p.HasIssues
 }
 
-// GetProjectURL returns the ProjectURL field if it's non-nil, zero value otherwise.
-func (p *ProjectCard) GetProjectURL() string {
-	if p == nil || p.ProjectURL == nil {
-		return ""
+// GetHasPages returns the HasPages field if it's non-nil, zero value otherwise.
+func (p *PushEventRepository) GetHasPages() bool {
+	if p == nil || p.HasPages == nil {
+		return false
 	}
-	return *p.ProjectURL
+	return *p.HasPages
 }
 
-// GetUpdatedAt returns the UpdatedAt field if it's non-nil, zero value otherwise.
-func (p *ProjectCard) GetUpdatedAt() Timestamp {
-	if p == nil || p.UpdatedAt == nil {
-		return Timestamp{}
+// GetHasWiki returns the HasWiki field if it's non-nil, zero value otherwise.
+func (p *PushEventRepository) GetHasWiki() bool {
+	if p == nil || p.HasWiki == nil {
+		return false
 	}
-	return *p.UpdatedAt
+	return *p.HasWiki
 }
 
-// GetURL returns the URL field if it's non-nil, zero value otherwise.
-func (p *ProjectCard) GetURL() string {
-	if p == nil || p.URL == nil {
+// GetHomepage returns the Homepage field if it's non-nil, zero value otherwise.
+func (p *PushEventRepository) GetHomepage() string {
+	if p == nil || p.Homepage == nil {
 		return ""
 	}
-	return *p.URL
+	return *p.Homepage
 }
 
-// GetAction returns the Action field if it's non-nil, zero value otherwise.
-func (p *ProjectCardEvent) GetAction() string {
-	if p == nil || p.Action == nil {
+// GetHTMLURL returns the HTMLURL field if it's non-nil, zero value otherwise.
+func (p *PushEventRepository) GetHTMLURL() string {
+	if p == nil || p.HTMLURL == nil {
 		return ""
 	}
-	return *p.Action
+	return *p.HTMLURL
 }
 
-// GetAfterID returns the AfterID field if it's non-nil, zero value otherwise.
-func (p *ProjectCardEvent) GetAfterID() int64 {
-	if p == nil || p.AfterID == nil {
+// GetID returns the ID field if it's non-nil, zero value otherwise.
+func (p *PushEventRepository) GetID() int64 {
+	if p == nil || p.ID == nil {
 		return 0
 	}
-	return *p.AfterID
-}
-
-// GetChanges returns the Changes field.
-func (p *ProjectCardEvent) GetChanges() *ProjectCardChange {
-	if p == nil {
-		return nil
-	}
-	return p.Changes
+	return *p.ID
 }
 
-// GetInstallation returns the Installation field.
-func (p *ProjectCardEvent) GetInstallation() *Installation {
-	if p == nil {
-		return nil
+// GetLanguage returns the Language field if it's non-nil, zero value otherwise.
+func (p *PushEventRepository) GetLanguage() string {
+	if p == nil || p.Language == nil {
+		return ""
 	}
-	return p.Installation
+	return *p.Language
 }
 
-// GetOrg returns the Org field.
-func (p *ProjectCardEvent) GetOrg() *Organization {
-	if p == nil {
-		return nil
+// GetMasterBranch returns the MasterBranch field if it's non-nil, zero value otherwise.
+func (p *PushEventRepository) GetMasterBranch() string {
+	if p == nil || p.MasterBranch == nil {
+		return ""
 	}
-	return p.Org
+	return *p.MasterBranch
 }
 
-// GetProjectCard returns the ProjectCard field.
-func (p *ProjectCardEvent) GetProjectCard() *ProjectCard {
-	if p == nil {
-		return nil
+// GetName returns the Name field if it's non-nil, zero value otherwise.
+func (p *PushEventRepository) GetName() string {
+	if p == nil || p.Name == nil {
+		return ""
 	}
-	return p.ProjectCard
+	return *p.Name
 }
 
-// GetRepo returns the Repo field.
-func (p *ProjectCardEvent) GetRepo() *Repository {
-	if p == nil {
-		return nil
+// GetNodeID returns the NodeID field if it's non-nil, zero value otherwise.
+func (p *PushEventRepository) GetNodeID() string {
+	if p == nil || p.NodeID == nil {
+		return ""
 	}
-	return p.Repo
+	return *p.NodeID
 }
 
-// GetSender returns the Sender field.
-func (p *ProjectCardEvent) GetSender() *User {
-	if p == nil {
-		return nil
+// GetOpenIssuesCount returns the OpenIssuesCount field if it's non-nil, zero value otherwise.
+func (p *PushEventRepository) GetOpenIssuesCount() int {
+	if p == nil || p.OpenIssuesCount == nil {
+		return 0
 	}
-	return p.Sender
+	return *p.OpenIssuesCount
 }
 
-// GetArchivedState returns the ArchivedState field if it's non-nil, zero value otherwise.
-func (p *ProjectCardListOptions) GetArchivedState() string {
-	if p == nil || p.ArchivedState == nil {
+// GetOrganization returns the Organization field if it's non-nil, zero value otherwise.
+func (p *PushEventRepository) GetOrganization() string {
+	if p == nil || p.Organization == nil {
 		return ""
 	}
-	return *p.ArchivedState
+	return *p.Organization
 }
 
-// GetArchived returns the Archived field if it's non-nil, zero value otherwise.
-func (p *ProjectCardOptions) GetArchived() bool {
-	if p == nil || p.Archived == nil {
-		return false
+// GetOwner returns the Owner field.
+func (p *PushEventRepository) GetOwner() *User {
+	if p == nil {
+		return nil
 	}
-	return *p.Archived
+	return p.Owner
 }
 
-// GetPermission returns the Permission field if it's non-nil, zero value otherwise.
-func (p *ProjectCollaboratorOptions) GetPermission() string {
-	if p == nil || p.Permission == nil {
-		return ""
+// GetPrivate returns the Private field if it's non-nil, zero value otherwise.
+func (p *PushEventRepository) GetPrivate() bool {
+	if p == nil || p.Private == nil {
+		return false
 	}
-	return *p.Permission
+	return *p.Private
 }
 
-// GetCardsURL returns the CardsURL field if it's non-nil, zero value otherwise.
-func (p *ProjectColumn) GetCardsURL() string {
-	if p == nil || p.CardsURL == nil {
+// GetPullsURL returns the PullsURL field if it's non-nil, zero value otherwise.
+func (p *PushEventRepository) GetPullsURL() string {
+	if p == nil || p.PullsURL == nil {
 		return ""
 	}
-	return *p.CardsURL
+	return *p.PullsURL
 }
 
-// GetCreatedAt returns the CreatedAt field if it's non-nil, zero value otherwise.
-func (p *ProjectColumn) GetCreatedAt() Timestamp {
-	if p == nil || p.CreatedAt == nil {
+// GetPushedAt returns the PushedAt field if it's non-nil, zero value otherwise.
+func (p *PushEventRepository) GetPushedAt() Timestamp {
+	if p == nil || p.PushedAt == nil {
 		return Timestamp{}
 	}
-	return *p.CreatedAt
+	return *p.PushedAt
 }
 
-// GetID returns the ID field if it's non-nil, zero value otherwise.
-func (p *ProjectColumn) GetID() int64 {
-	if p == nil || p.ID == nil {
+// GetSize returns the Size field if it's non-nil, zero value otherwise.
+func (p *PushEventRepository) GetSize() int {
+	if p == nil || p.Size == nil {
 		return 0
 	}
-	return *p.ID
+	return *p.Size
 }
 
-// GetName returns the Name field if it's non-nil, zero value otherwise.
-func (p *ProjectColumn) GetName() string {
-	if p == nil || p.Name == nil {
+// GetSSHURL returns the SSHURL field if it's non-nil, zero value otherwise.
+func (p *PushEventRepository) GetSSHURL() string {
+	if p == nil || p.SSHURL == nil {
 		return ""
 	}
-	return *p.Name
+	return *p.SSHURL
+}
+
+// GetStargazersCount returns the StargazersCount field if it's non-nil, zero value otherwise.
+func (p *PushEventRepository) GetStargazersCount() int {
+	if p == nil || p.StargazersCount == nil {
+		return 0
+	}
+	return *p.StargazersCount
 }
 
-// GetNodeID returns the NodeID field if it's non-nil, zero value otherwise.
-func (p *ProjectColumn) GetNodeID() string {
-	if p == nil || p.NodeID == nil {
+// GetStatusesURL returns the StatusesURL field if it's non-nil, zero value otherwise.
+func (p *PushEventRepository) GetStatusesURL() string {
+	if p == nil || p.StatusesURL == nil {
 		return ""
 	}
-	return *p.NodeID
+	return *p.StatusesURL
 }
 
-// GetProjectURL returns the ProjectURL field if it's non-nil, zero value otherwise.
-func (p *ProjectColumn) GetProjectURL() string {
-	if p == nil || p.ProjectURL == nil {
+// GetSVNURL returns the SVNURL field if it's non-nil, zero value otherwise.
+func (p *PushEventRepository) GetSVNURL() string {
+	if p == nil || p.SVNURL == nil {
 		return ""
 	}
-	return *p.ProjectURL
+	return *p.SVNURL
 }
 
 // GetUpdatedAt returns the UpdatedAt field if it's non-nil, zero value otherwise.
-func (p *ProjectColumn) GetUpdatedAt() Timestamp {
+func (p *PushEventRepository) GetUpdatedAt() Timestamp {
 	if p == nil || p.UpdatedAt == nil {
 		return Timestamp{}
 	}
@@ -8701,2095 +14333,2127 @@ func (p *ProjectColumn) GetUpdatedAt() Timestamp {
 }
 
 // GetURL returns the URL field if it's non-nil, zero value otherwise.
-func (p *ProjectColumn) GetURL() string {
+func (p *PushEventRepository) GetURL() string {
 	if p == nil || p.URL == nil {
 		return ""
 	}
 	return *p.URL
 }
 
-// GetAction returns the Action field if it's non-nil, zero value otherwise.
-func (p *ProjectColumnEvent) GetAction() string {
-	if p == nil || p.Action == nil {
-		return ""
+// GetWatchersCount returns the WatchersCount field if it's non-nil, zero value otherwise.
+func (p *PushEventRepository) GetWatchersCount() int {
+	if p == nil || p.WatchersCount == nil {
+		return 0
 	}
-	return *p.Action
+	return *p.WatchersCount
 }
 
-// GetAfterID returns the AfterID field if it's non-nil, zero value otherwise.
-func (p *ProjectColumnEvent) GetAfterID() int64 {
-	if p == nil || p.AfterID == nil {
-		return 0
+// GetExpireAt returns the ExpireAt field if it's non-nil, zero value otherwise.
+func (p *PushProtectionBypass) GetExpireAt() Timestamp {
+	if p == nil || p.ExpireAt == nil {
+		return Timestamp{}
 	}
-	return *p.AfterID
+	return *p.ExpireAt
 }
 
-// GetChanges returns the Changes field.
-func (p *ProjectColumnEvent) GetChanges() *ProjectColumnChange {
-	if p == nil {
-		return nil
+// GetReason returns the Reason field if it's non-nil, zero value otherwise.
+func (p *PushProtectionBypass) GetReason() string {
+	if p == nil || p.Reason == nil {
+		return ""
 	}
-	return p.Changes
+	return *p.Reason
 }
 
-// GetInstallation returns the Installation field.
-func (p *ProjectColumnEvent) GetInstallation() *Installation {
-	if p == nil {
-		return nil
+// GetTokenType returns the TokenType field if it's non-nil, zero value otherwise.
+func (p *PushProtectionBypass) GetTokenType() string {
+	if p == nil || p.TokenType == nil {
+		return ""
 	}
-	return p.Installation
+	return *p.TokenType
 }
 
-// GetOrg returns the Org field.
-func (p *ProjectColumnEvent) GetOrg() *Organization {
-	if p == nil {
+// GetActionsRunnerRegistration returns the ActionsRunnerRegistration field.
+func (r *RateLimits) GetActionsRunnerRegistration() *Rate {
+	if r == nil {
 		return nil
 	}
-	return p.Org
+	return r.ActionsRunnerRegistration
 }
 
-// GetProjectColumn returns the ProjectColumn field.
-func (p *ProjectColumnEvent) GetProjectColumn() *ProjectColumn {
-	if p == nil {
+// GetCodeScanningUpload returns the CodeScanningUpload field.
+func (r *RateLimits) GetCodeScanningUpload() *Rate {
+	if r == nil {
 		return nil
 	}
-	return p.ProjectColumn
+	return r.CodeScanningUpload
 }
 
-// GetRepo returns the Repo field.
-func (p *ProjectColumnEvent) GetRepo() *Repository {
-	if p == nil {
+// GetCore returns the Core field.
+func (r *RateLimits) GetCore() *Rate {
+	if r == nil {
 		return nil
 	}
-	return p.Repo
+	return r.Core
 }
 
-// GetSender returns the Sender field.
-func (p *ProjectColumnEvent) GetSender() *User {
-	if p == nil {
+// GetGraphQL returns the GraphQL field.
+func (r *RateLimits) GetGraphQL() *Rate {
+	if r == nil {
 		return nil
 	}
-	return p.Sender
+	return r.GraphQL
 }
 
-// GetAction returns the Action field if it's non-nil, zero value otherwise.
-func (p *ProjectEvent) GetAction() string {
-	if p == nil || p.Action == nil {
-		return ""
+// GetIntegrationManifest returns the IntegrationManifest field.
+func (r *RateLimits) GetIntegrationManifest() *Rate {
+	if r == nil {
+		return nil
 	}
-	return *p.Action
+	return r.IntegrationManifest
 }
 
-// GetChanges returns the Changes field.
-func (p *ProjectEvent) GetChanges() *ProjectChange {
-	if p == nil {
+// GetSCIM returns the SCIM field.
+func (r *RateLimits) GetSCIM() *Rate {
+	if r == nil {
 		return nil
 	}
-	return p.Changes
+	return r.SCIM
 }
 
-// GetInstallation returns the Installation field.
-func (p *ProjectEvent) GetInstallation() *Installation {
-	if p == nil {
+// GetSearch returns the Search field.
+func (r *RateLimits) GetSearch() *Rate {
+	if r == nil {
 		return nil
 	}
-	return p.Installation
+	return r.Search
 }
 
-// GetOrg returns the Org field.
-func (p *ProjectEvent) GetOrg() *Organization {
-	if p == nil {
+// GetSourceImport returns the SourceImport field.
+func (r *RateLimits) GetSourceImport() *Rate {
+	if r == nil {
 		return nil
 	}
-	return p.Org
+	return r.SourceImport
 }
 
-// GetProject returns the Project field.
-func (p *ProjectEvent) GetProject() *Project {
-	if p == nil {
-		return nil
+// GetContent returns the Content field if it's non-nil, zero value otherwise.
+func (r *Reaction) GetContent() string {
+	if r == nil || r.Content == nil {
+		return ""
 	}
-	return p.Project
+	return *r.Content
 }
 
-// GetRepo returns the Repo field.
-func (p *ProjectEvent) GetRepo() *Repository {
-	if p == nil {
-		return nil
+// GetID returns the ID field if it's non-nil, zero value otherwise.
+func (r *Reaction) GetID() int64 {
+	if r == nil || r.ID == nil {
+		return 0
 	}
-	return p.Repo
+	return *r.ID
 }
 
-// GetSender returns the Sender field.
-func (p *ProjectEvent) GetSender() *User {
-	if p == nil {
-		return nil
+// GetNodeID returns the NodeID field if it's non-nil, zero value otherwise.
+func (r *Reaction) GetNodeID() string {
+	if r == nil || r.NodeID == nil {
+		return ""
 	}
-	return p.Sender
+	return *r.NodeID
 }
 
-// GetBody returns the Body field if it's non-nil, zero value otherwise.
-func (p *ProjectOptions) GetBody() string {
-	if p == nil || p.Body == nil {
-		return ""
+// GetUser returns the User field.
+func (r *Reaction) GetUser() *User {
+	if r == nil {
+		return nil
 	}
-	return *p.Body
+	return r.User
 }
 
-// GetName returns the Name field if it's non-nil, zero value otherwise.
-func (p *ProjectOptions) GetName() string {
-	if p == nil || p.Name == nil {
-		return ""
+// GetConfused returns the Confused field if it's non-nil, zero value otherwise.
+func (r *Reactions) GetConfused() int {
+	if r == nil || r.Confused == nil {
+		return 0
 	}
-	return *p.Name
+	return *r.Confused
 }
 
-// GetOrganizationPermission returns the OrganizationPermission field if it's non-nil, zero value otherwise.
-func (p *ProjectOptions) GetOrganizationPermission() string {
-	if p == nil || p.OrganizationPermission == nil {
-		return ""
+// GetEyes returns the Eyes field if it's non-nil, zero value otherwise.
+func (r *Reactions) GetEyes() int {
+	if r == nil || r.Eyes == nil {
+		return 0
 	}
-	return *p.OrganizationPermission
+	return *r.Eyes
 }
 
-// GetPublic returns the Public field if it's non-nil, zero value otherwise.
-func (p *ProjectOptions) GetPublic() bool {
-	if p == nil || p.Public == nil {
-		return false
+// GetHeart returns the Heart field if it's non-nil, zero value otherwise.
+func (r *Reactions) GetHeart() int {
+	if r == nil || r.Heart == nil {
+		return 0
 	}
-	return *p.Public
+	return *r.Heart
 }
 
-// GetState returns the State field if it's non-nil, zero value otherwise.
-func (p *ProjectOptions) GetState() string {
-	if p == nil || p.State == nil {
-		return ""
+// GetHooray returns the Hooray field if it's non-nil, zero value otherwise.
+func (r *Reactions) GetHooray() int {
+	if r == nil || r.Hooray == nil {
+		return 0
 	}
-	return *p.State
+	return *r.Hooray
 }
 
-// GetPermission returns the Permission field if it's non-nil, zero value otherwise.
-func (p *ProjectPermissionLevel) GetPermission() string {
-	if p == nil || p.Permission == nil {
-		return ""
+// GetLaugh returns the Laugh field if it's non-nil, zero value otherwise.
+func (r *Reactions) GetLaugh() int {
+	if r == nil || r.Laugh == nil {
+		return 0
 	}
-	return *p.Permission
+	return *r.Laugh
 }
 
-// GetUser returns the User field.
-func (p *ProjectPermissionLevel) GetUser() *User {
-	if p == nil {
-		return nil
+// GetMinusOne returns the MinusOne field if it's non-nil, zero value otherwise.
+func (r *Reactions) GetMinusOne() int {
+	if r == nil || r.MinusOne == nil {
+		return 0
 	}
-	return p.User
+	return *r.MinusOne
 }
 
-// GetAllowDeletions returns the AllowDeletions field.
-func (p *Protection) GetAllowDeletions() *AllowDeletions {
-	if p == nil {
-		return nil
+// GetPlusOne returns the PlusOne field if it's non-nil, zero value otherwise.
+func (r *Reactions) GetPlusOne() int {
+	if r == nil || r.PlusOne == nil {
+		return 0
 	}
-	return p.AllowDeletions
+	return *r.PlusOne
 }
 
-// GetAllowForcePushes returns the AllowForcePushes field.
-func (p *Protection) GetAllowForcePushes() *AllowForcePushes {
-	if p == nil {
-		return nil
+// GetRocket returns the Rocket field if it's non-nil, zero value otherwise.
+func (r *Reactions) GetRocket() int {
+	if r == nil || r.Rocket == nil {
+		return 0
 	}
-	return p.AllowForcePushes
+	return *r.Rocket
 }
 
-// GetEnforceAdmins returns the EnforceAdmins field.
-func (p *Protection) GetEnforceAdmins() *AdminEnforcement {
-	if p == nil {
-		return nil
+// GetTotalCount returns the TotalCount field if it's non-nil, zero value otherwise.
+func (r *Reactions) GetTotalCount() int {
+	if r == nil || r.TotalCount == nil {
+		return 0
 	}
-	return p.EnforceAdmins
+	return *r.TotalCount
 }
 
-// GetRequiredPullRequestReviews returns the RequiredPullRequestReviews field.
-func (p *Protection) GetRequiredPullRequestReviews() *PullRequestReviewsEnforcement {
-	if p == nil {
-		return nil
+// GetURL returns the URL field if it's non-nil, zero value otherwise.
+func (r *Reactions) GetURL() string {
+	if r == nil || r.URL == nil {
+		return ""
 	}
-	return p.RequiredPullRequestReviews
+	return *r.URL
 }
 
-// GetRequiredStatusChecks returns the RequiredStatusChecks field.
-func (p *Protection) GetRequiredStatusChecks() *RequiredStatusChecks {
-	if p == nil {
-		return nil
+// GetNodeID returns the NodeID field if it's non-nil, zero value otherwise.
+func (r *Reference) GetNodeID() string {
+	if r == nil || r.NodeID == nil {
+		return ""
 	}
-	return p.RequiredStatusChecks
+	return *r.NodeID
 }
 
-// GetRequireLinearHistory returns the RequireLinearHistory field.
-func (p *Protection) GetRequireLinearHistory() *RequireLinearHistory {
-	if p == nil {
+// GetObject returns the Object field.
+func (r *Reference) GetObject() *GitObject {
+	if r == nil {
 		return nil
 	}
-	return p.RequireLinearHistory
+	return r.Object
 }
 
-// GetRestrictions returns the Restrictions field.
-func (p *Protection) GetRestrictions() *BranchRestrictions {
-	if p == nil {
-		return nil
+// GetRef returns the Ref field if it's non-nil, zero value otherwise.
+func (r *Reference) GetRef() string {
+	if r == nil || r.Ref == nil {
+		return ""
 	}
-	return p.Restrictions
+	return *r.Ref
 }
 
-// GetAllowDeletions returns the AllowDeletions field if it's non-nil, zero value otherwise.
-func (p *ProtectionRequest) GetAllowDeletions() bool {
-	if p == nil || p.AllowDeletions == nil {
-		return false
+// GetURL returns the URL field if it's non-nil, zero value otherwise.
+func (r *Reference) GetURL() string {
+	if r == nil || r.URL == nil {
+		return ""
 	}
-	return *p.AllowDeletions
+	return *r.URL
 }
 
-// GetAllowForcePushes returns the AllowForcePushes field if it's non-nil, zero value otherwise.
-func (p *ProtectionRequest) GetAllowForcePushes() bool {
-	if p == nil || p.AllowForcePushes == nil {
-		return false
+// GetRef returns the Ref field.
+func (r *RefUpdateResult) GetRef() *Reference {
+	if r == nil {
+		return nil
 	}
-	return *p.AllowForcePushes
+	return r.Ref
 }
 
-// GetRequiredPullRequestReviews returns the RequiredPullRequestReviews field.
-func (p *ProtectionRequest) GetRequiredPullRequestReviews() *PullRequestReviewsEnforcementRequest {
-	if p == nil {
+// GetUpdate returns the Update field.
+func (r *RefUpdateResult) GetUpdate() *RefUpdate {
+	if r == nil {
 		return nil
 	}
-	return p.RequiredPullRequestReviews
+	return r.Update
 }
 
-// GetRequiredStatusChecks returns the RequiredStatusChecks field.
-func (p *ProtectionRequest) GetRequiredStatusChecks() *RequiredStatusChecks {
-	if p == nil {
-		return nil
+// GetExpiresAt returns the ExpiresAt field if it's non-nil, zero value otherwise.
+func (r *RegistrationToken) GetExpiresAt() Timestamp {
+	if r == nil || r.ExpiresAt == nil {
+		return Timestamp{}
 	}
-	return p.RequiredStatusChecks
+	return *r.ExpiresAt
 }
 
-// GetRequireLinearHistory returns the RequireLinearHistory field if it's non-nil, zero value otherwise.
-func (p *ProtectionRequest) GetRequireLinearHistory() bool {
-	if p == nil || p.RequireLinearHistory == nil {
-		return false
+// GetToken returns the Token field if it's non-nil, zero value otherwise.
+func (r *RegistrationToken) GetToken() string {
+	if r == nil || r.Token == nil {
+		return ""
 	}
-	return *p.RequireLinearHistory
+	return *r.Token
 }
 
-// GetRestrictions returns the Restrictions field.
-func (p *ProtectionRequest) GetRestrictions() *BranchRestrictionsRequest {
-	if p == nil {
-		return nil
+// GetBrowserDownloadURL returns the BrowserDownloadURL field if it's non-nil, zero value otherwise.
+func (r *ReleaseAsset) GetBrowserDownloadURL() string {
+	if r == nil || r.BrowserDownloadURL == nil {
+		return ""
 	}
-	return p.Restrictions
+	return *r.BrowserDownloadURL
 }
 
-// GetInstallation returns the Installation field.
-func (p *PublicEvent) GetInstallation() *Installation {
-	if p == nil {
-		return nil
+// GetContentType returns the ContentType field if it's non-nil, zero value otherwise.
+func (r *ReleaseAsset) GetContentType() string {
+	if r == nil || r.ContentType == nil {
+		return ""
 	}
-	return p.Installation
+	return *r.ContentType
 }
 
-// GetRepo returns the Repo field.
-func (p *PublicEvent) GetRepo() *Repository {
-	if p == nil {
-		return nil
+// GetCreatedAt returns the CreatedAt field if it's non-nil, zero value otherwise.
+func (r *ReleaseAsset) GetCreatedAt() Timestamp {
+	if r == nil || r.CreatedAt == nil {
+		return Timestamp{}
 	}
-	return p.Repo
+	return *r.CreatedAt
 }
 
-// GetSender returns the Sender field.
-func (p *PublicEvent) GetSender() *User {
-	if p == nil {
-		return nil
+// GetDownloadCount returns the DownloadCount field if it's non-nil, zero value otherwise.
+func (r *ReleaseAsset) GetDownloadCount() int {
+	if r == nil || r.DownloadCount == nil {
+		return 0
 	}
-	return p.Sender
+	return *r.DownloadCount
 }
 
-// GetKey returns the Key field if it's non-nil, zero value otherwise.
-func (p *PublicKey) GetKey() string {
-	if p == nil || p.Key == nil {
+// GetID returns the ID field if it's non-nil, zero value otherwise.
+func (r *ReleaseAsset) GetID() int64 {
+	if r == nil || r.ID == nil {
+		return 0
+	}
+	return *r.ID
+}
+
+// GetLabel returns the Label field if it's non-nil, zero value otherwise.
+func (r *ReleaseAsset) GetLabel() string {
+	if r == nil || r.Label == nil {
 		return ""
 	}
-	return *p.Key
+	return *r.Label
 }
 
-// GetKeyID returns the KeyID field if it's non-nil, zero value otherwise.
-func (p *PublicKey) GetKeyID() string {
-	if p == nil || p.KeyID == nil {
+// GetName returns the Name field if it's non-nil, zero value otherwise.
+func (r *ReleaseAsset) GetName() string {
+	if r == nil || r.Name == nil {
 		return ""
 	}
-	return *p.KeyID
+	return *r.Name
 }
 
-// GetActiveLockReason returns the ActiveLockReason field if it's non-nil, zero value otherwise.
-func (p *PullRequest) GetActiveLockReason() string {
-	if p == nil || p.ActiveLockReason == nil {
+// GetNodeID returns the NodeID field if it's non-nil, zero value otherwise.
+func (r *ReleaseAsset) GetNodeID() string {
+	if r == nil || r.NodeID == nil {
 		return ""
 	}
-	return *p.ActiveLockReason
+	return *r.NodeID
 }
 
-// GetAdditions returns the Additions field if it's non-nil, zero value otherwise.
-func (p *PullRequest) GetAdditions() int {
-	if p == nil || p.Additions == nil {
+// GetSize returns the Size field if it's non-nil, zero value otherwise.
+func (r *ReleaseAsset) GetSize() int {
+	if r == nil || r.Size == nil {
 		return 0
 	}
-	return *p.Additions
+	return *r.Size
 }
 
-// GetAssignee returns the Assignee field.
-func (p *PullRequest) GetAssignee() *User {
-	if p == nil {
-		return nil
+// GetState returns the State field if it's non-nil, zero value otherwise.
+func (r *ReleaseAsset) GetState() string {
+	if r == nil || r.State == nil {
+		return ""
 	}
-	return p.Assignee
+	return *r.State
 }
 
-// GetAuthorAssociation returns the AuthorAssociation field if it's non-nil, zero value otherwise.
-func (p *PullRequest) GetAuthorAssociation() string {
-	if p == nil || p.AuthorAssociation == nil {
-		return ""
+// GetUpdatedAt returns the UpdatedAt field if it's non-nil, zero value otherwise.
+func (r *ReleaseAsset) GetUpdatedAt() Timestamp {
+	if r == nil || r.UpdatedAt == nil {
+		return Timestamp{}
 	}
-	return *p.AuthorAssociation
+	return *r.UpdatedAt
 }
 
-// GetBase returns the Base field.
-func (p *PullRequest) GetBase() *PullRequestBranch {
-	if p == nil {
+// GetUploader returns the Uploader field.
+func (r *ReleaseAsset) GetUploader() *User {
+	if r == nil {
 		return nil
 	}
-	return p.Base
+	return r.Uploader
 }
 
-// GetBody returns the Body field if it's non-nil, zero value otherwise.
-func (p *PullRequest) GetBody() string {
-	if p == nil || p.Body == nil {
+// GetURL returns the URL field if it's non-nil, zero value otherwise.
+func (r *ReleaseAsset) GetURL() string {
+	if r == nil || r.URL == nil {
 		return ""
 	}
-	return *p.Body
+	return *r.URL
 }
 
-// GetChangedFiles returns the ChangedFiles field if it's non-nil, zero value otherwise.
-func (p *PullRequest) GetChangedFiles() int {
-	if p == nil || p.ChangedFiles == nil {
-		return 0
+// GetAction returns the Action field if it's non-nil, zero value otherwise.
+func (r *ReleaseEvent) GetAction() string {
+	if r == nil || r.Action == nil {
+		return ""
 	}
-	return *p.ChangedFiles
+	return *r.Action
 }
 
-// GetClosedAt returns the ClosedAt field if it's non-nil, zero value otherwise.
-func (p *PullRequest) GetClosedAt() time.Time {
-	if p == nil || p.ClosedAt == nil {
-		return time.Time{}
+// GetInstallation returns the Installation field.
+func (r *ReleaseEvent) GetInstallation() *Installation {
+	if r == nil {
+		return nil
 	}
-	return *p.ClosedAt
+	return r.Installation
 }
 
-// GetComments returns the Comments field if it's non-nil, zero value otherwise.
-func (p *PullRequest) GetComments() int {
-	if p == nil || p.Comments == nil {
-		return 0
+// GetRelease returns the Release field.
+func (r *ReleaseEvent) GetRelease() *RepositoryRelease {
+	if r == nil {
+		return nil
 	}
-	return *p.Comments
+	return r.Release
 }
 
-// GetCommentsURL returns the CommentsURL field if it's non-nil, zero value otherwise.
-func (p *PullRequest) GetCommentsURL() string {
-	if p == nil || p.CommentsURL == nil {
-		return ""
+// GetRepo returns the Repo field.
+func (r *ReleaseEvent) GetRepo() *Repository {
+	if r == nil {
+		return nil
 	}
-	return *p.CommentsURL
+	return r.Repo
 }
 
-// GetCommits returns the Commits field if it's non-nil, zero value otherwise.
-func (p *PullRequest) GetCommits() int {
-	if p == nil || p.Commits == nil {
-		return 0
+// GetSender returns the Sender field.
+func (r *ReleaseEvent) GetSender() *User {
+	if r == nil {
+		return nil
 	}
-	return *p.Commits
+	return r.Sender
 }
 
-// GetCommitsURL returns the CommitsURL field if it's non-nil, zero value otherwise.
-func (p *PullRequest) GetCommitsURL() string {
-	if p == nil || p.CommitsURL == nil {
-		return ""
+// GetExpiresAt returns the ExpiresAt field if it's non-nil, zero value otherwise.
+func (r *RemoveToken) GetExpiresAt() Timestamp {
+	if r == nil || r.ExpiresAt == nil {
+		return Timestamp{}
 	}
-	return *p.CommitsURL
+	return *r.ExpiresAt
 }
 
-// GetCreatedAt returns the CreatedAt field if it's non-nil, zero value otherwise.
-func (p *PullRequest) GetCreatedAt() time.Time {
-	if p == nil || p.CreatedAt == nil {
-		return time.Time{}
+// GetToken returns the Token field if it's non-nil, zero value otherwise.
+func (r *RemoveToken) GetToken() string {
+	if r == nil || r.Token == nil {
+		return ""
 	}
-	return *p.CreatedAt
+	return *r.Token
 }
 
-// GetDeletions returns the Deletions field if it's non-nil, zero value otherwise.
-func (p *PullRequest) GetDeletions() int {
-	if p == nil || p.Deletions == nil {
-		return 0
+// GetFrom returns the From field if it's non-nil, zero value otherwise.
+func (r *Rename) GetFrom() string {
+	if r == nil || r.From == nil {
+		return ""
 	}
-	return *p.Deletions
+	return *r.From
 }
 
-// GetDiffURL returns the DiffURL field if it's non-nil, zero value otherwise.
-func (p *PullRequest) GetDiffURL() string {
-	if p == nil || p.DiffURL == nil {
+// GetTo returns the To field if it's non-nil, zero value otherwise.
+func (r *Rename) GetTo() string {
+	if r == nil || r.To == nil {
 		return ""
 	}
-	return *p.DiffURL
+	return *r.To
 }
 
-// GetDraft returns the Draft field if it's non-nil, zero value otherwise.
-func (p *PullRequest) GetDraft() bool {
-	if p == nil || p.Draft == nil {
-		return false
+// GetMessage returns the Message field if it's non-nil, zero value otherwise.
+func (r *RenameOrgResponse) GetMessage() string {
+	if r == nil || r.Message == nil {
+		return ""
 	}
-	return *p.Draft
+	return *r.Message
 }
 
-// GetHead returns the Head field.
-func (p *PullRequest) GetHead() *PullRequestBranch {
-	if p == nil {
-		return nil
+// GetURL returns the URL field if it's non-nil, zero value otherwise.
+func (r *RenameOrgResponse) GetURL() string {
+	if r == nil || r.URL == nil {
+		return ""
 	}
-	return p.Head
+	return *r.URL
 }
 
-// GetHTMLURL returns the HTMLURL field if it's non-nil, zero value otherwise.
-func (p *PullRequest) GetHTMLURL() string {
-	if p == nil || p.HTMLURL == nil {
+// GetLogin returns the Login field if it's non-nil, zero value otherwise.
+func (r *RepoAdvisoryCredit) GetLogin() string {
+	if r == nil || r.Login == nil {
 		return ""
 	}
-	return *p.HTMLURL
+	return *r.Login
 }
 
-// GetID returns the ID field if it's non-nil, zero value otherwise.
-func (p *PullRequest) GetID() int64 {
-	if p == nil || p.ID == nil {
-		return 0
+// GetType returns the Type field if it's non-nil, zero value otherwise.
+func (r *RepoAdvisoryCredit) GetType() string {
+	if r == nil || r.Type == nil {
+		return ""
 	}
-	return *p.ID
+	return *r.Type
 }
 
-// GetIssueURL returns the IssueURL field if it's non-nil, zero value otherwise.
-func (p *PullRequest) GetIssueURL() string {
-	if p == nil || p.IssueURL == nil {
+// GetState returns the State field if it's non-nil, zero value otherwise.
+func (r *RepoAdvisoryCreditDetailed) GetState() string {
+	if r == nil || r.State == nil {
 		return ""
 	}
-	return *p.IssueURL
+	return *r.State
 }
 
-// GetLinks returns the Links field.
-func (p *PullRequest) GetLinks() *PRLinks {
-	if p == nil {
-		return nil
+// GetType returns the Type field if it's non-nil, zero value otherwise.
+func (r *RepoAdvisoryCreditDetailed) GetType() string {
+	if r == nil || r.Type == nil {
+		return ""
 	}
-	return p.Links
+	return *r.Type
 }
 
-// GetLocked returns the Locked field if it's non-nil, zero value otherwise.
-func (p *PullRequest) GetLocked() bool {
-	if p == nil || p.Locked == nil {
-		return false
+// GetUser returns the User field.
+func (r *RepoAdvisoryCreditDetailed) GetUser() *User {
+	if r == nil {
+		return nil
 	}
-	return *p.Locked
+	return r.User
 }
 
-// GetMaintainerCanModify returns the MaintainerCanModify field if it's non-nil, zero value otherwise.
-func (p *PullRequest) GetMaintainerCanModify() bool {
-	if p == nil || p.MaintainerCanModify == nil {
-		return false
+// GetRepositoryFullName returns the RepositoryFullName field if it's non-nil, zero value otherwise.
+func (r *RepoCustomPropertyValue) GetRepositoryFullName() string {
+	if r == nil || r.RepositoryFullName == nil {
+		return ""
 	}
-	return *p.MaintainerCanModify
+	return *r.RepositoryFullName
 }
 
-// GetMergeable returns the Mergeable field if it's non-nil, zero value otherwise.
-func (p *PullRequest) GetMergeable() bool {
-	if p == nil || p.Mergeable == nil {
-		return false
+// GetRepositoryID returns the RepositoryID field if it's non-nil, zero value otherwise.
+func (r *RepoCustomPropertyValue) GetRepositoryID() int64 {
+	if r == nil || r.RepositoryID == nil {
+		return 0
 	}
-	return *p.Mergeable
+	return *r.RepositoryID
 }
 
-// GetMergeableState returns the MergeableState field if it's non-nil, zero value otherwise.
-func (p *PullRequest) GetMergeableState() string {
-	if p == nil || p.MergeableState == nil {
+// GetRepositoryName returns the RepositoryName field if it's non-nil, zero value otherwise.
+func (r *RepoCustomPropertyValue) GetRepositoryName() string {
+	if r == nil || r.RepositoryName == nil {
 		return ""
 	}
-	return *p.MergeableState
+	return *r.RepositoryName
 }
 
-// GetMergeCommitSHA returns the MergeCommitSHA field if it's non-nil, zero value otherwise.
-func (p *PullRequest) GetMergeCommitSHA() string {
-	if p == nil || p.MergeCommitSHA == nil {
+// GetCopyrightText returns the CopyrightText field if it's non-nil, zero value otherwise.
+func (r *RepoDependencies) GetCopyrightText() string {
+	if r == nil || r.CopyrightText == nil {
 		return ""
 	}
-	return *p.MergeCommitSHA
+	return *r.CopyrightText
 }
 
-// GetMerged returns the Merged field if it's non-nil, zero value otherwise.
-func (p *PullRequest) GetMerged() bool {
-	if p == nil || p.Merged == nil {
-		return false
+// GetDownloadLocation returns the DownloadLocation field if it's non-nil, zero value otherwise.
+func (r *RepoDependencies) GetDownloadLocation() string {
+	if r == nil || r.DownloadLocation == nil {
+		return ""
 	}
-	return *p.Merged
+	return *r.DownloadLocation
 }
 
-// GetMergedAt returns the MergedAt field if it's non-nil, zero value otherwise.
-func (p *PullRequest) GetMergedAt() time.Time {
-	if p == nil || p.MergedAt == nil {
-		return time.Time{}
+// GetFilesAnalyzed returns the FilesAnalyzed field if it's non-nil, zero value otherwise.
+func (r *RepoDependencies) GetFilesAnalyzed() bool {
+	if r == nil || r.FilesAnalyzed == nil {
+		return false
 	}
-	return *p.MergedAt
+	return *r.FilesAnalyzed
 }
 
-// GetMergedBy returns the MergedBy field.
-func (p *PullRequest) GetMergedBy() *User {
-	if p == nil {
-		return nil
+// GetLicenseConcluded returns the LicenseConcluded field if it's non-nil, zero value otherwise.
+func (r *RepoDependencies) GetLicenseConcluded() string {
+	if r == nil || r.LicenseConcluded == nil {
+		return ""
 	}
-	return p.MergedBy
+	return *r.LicenseConcluded
 }
 
-// GetMilestone returns the Milestone field.
-func (p *PullRequest) GetMilestone() *Milestone {
-	if p == nil {
-		return nil
+// GetLicenseDeclared returns the LicenseDeclared field if it's non-nil, zero value otherwise.
+func (r *RepoDependencies) GetLicenseDeclared() string {
+	if r == nil || r.LicenseDeclared == nil {
+		return ""
 	}
-	return p.Milestone
+	return *r.LicenseDeclared
 }
 
-// GetNodeID returns the NodeID field if it's non-nil, zero value otherwise.
-func (p *PullRequest) GetNodeID() string {
-	if p == nil || p.NodeID == nil {
+// GetName returns the Name field if it's non-nil, zero value otherwise.
+func (r *RepoDependencies) GetName() string {
+	if r == nil || r.Name == nil {
 		return ""
 	}
-	return *p.NodeID
+	return *r.Name
 }
 
-// GetNumber returns the Number field if it's non-nil, zero value otherwise.
-func (p *PullRequest) GetNumber() int {
-	if p == nil || p.Number == nil {
-		return 0
+// GetSPDXID returns the SPDXID field if it's non-nil, zero value otherwise.
+func (r *RepoDependencies) GetSPDXID() string {
+	if r == nil || r.SPDXID == nil {
+		return ""
 	}
-	return *p.Number
+	return *r.SPDXID
 }
 
-// GetPatchURL returns the PatchURL field if it's non-nil, zero value otherwise.
-func (p *PullRequest) GetPatchURL() string {
-	if p == nil || p.PatchURL == nil {
+// GetVersionInfo returns the VersionInfo field if it's non-nil, zero value otherwise.
+func (r *RepoDependencies) GetVersionInfo() string {
+	if r == nil || r.VersionInfo == nil {
 		return ""
 	}
-	return *p.PatchURL
+	return *r.VersionInfo
 }
 
-// GetRebaseable returns the Rebaseable field if it's non-nil, zero value otherwise.
-func (p *PullRequest) GetRebaseable() bool {
-	if p == nil || p.Rebaseable == nil {
+// GetIncompleteResults returns the IncompleteResults field if it's non-nil, zero value otherwise.
+func (r *RepositoriesSearchResult) GetIncompleteResults() bool {
+	if r == nil || r.IncompleteResults == nil {
 		return false
 	}
-	return *p.Rebaseable
+	return *r.IncompleteResults
 }
 
-// GetReviewComments returns the ReviewComments field if it's non-nil, zero value otherwise.
-func (p *PullRequest) GetReviewComments() int {
-	if p == nil || p.ReviewComments == nil {
+// GetTotal returns the Total field if it's non-nil, zero value otherwise.
+func (r *RepositoriesSearchResult) GetTotal() int {
+	if r == nil || r.Total == nil {
 		return 0
 	}
-	return *p.ReviewComments
+	return *r.Total
 }
 
-// GetReviewCommentsURL returns the ReviewCommentsURL field if it's non-nil, zero value otherwise.
-func (p *PullRequest) GetReviewCommentsURL() string {
-	if p == nil || p.ReviewCommentsURL == nil {
-		return ""
+// GetAllowMergeCommit returns the AllowMergeCommit field if it's non-nil, zero value otherwise.
+func (r *Repository) GetAllowMergeCommit() bool {
+	if r == nil || r.AllowMergeCommit == nil {
+		return false
 	}
-	return *p.ReviewCommentsURL
+	return *r.AllowMergeCommit
 }
 
-// GetReviewCommentURL returns the ReviewCommentURL field if it's non-nil, zero value otherwise.
-func (p *PullRequest) GetReviewCommentURL() string {
-	if p == nil || p.ReviewCommentURL == nil {
-		return ""
+// GetAllowRebaseMerge returns the AllowRebaseMerge field if it's non-nil, zero value otherwise.
+func (r *Repository) GetAllowRebaseMerge() bool {
+	if r == nil || r.AllowRebaseMerge == nil {
+		return false
 	}
-	return *p.ReviewCommentURL
+	return *r.AllowRebaseMerge
 }
 
-// GetState returns the State field if it's non-nil, zero value otherwise.
-func (p *PullRequest) GetState() string {
-	if p == nil || p.State == nil {
-		return ""
+// GetAllowSquashMerge returns the AllowSquashMerge field if it's non-nil, zero value otherwise.
+func (r *Repository) GetAllowSquashMerge() bool {
+	if r == nil || r.AllowSquashMerge == nil {
+		return false
 	}
-	return *p.State
+	return *r.AllowSquashMerge
 }
 
-// GetStatusesURL returns the StatusesURL field if it's non-nil, zero value otherwise.
-func (p *PullRequest) GetStatusesURL() string {
-	if p == nil || p.StatusesURL == nil {
-		return ""
+// GetArchived returns the Archived field if it's non-nil, zero value otherwise.
+func (r *Repository) GetArchived() bool {
+	if r == nil || r.Archived == nil {
+		return false
 	}
-	return *p.StatusesURL
+	return *r.Archived
 }
 
-// GetTitle returns the Title field if it's non-nil, zero value otherwise.
-func (p *PullRequest) GetTitle() string {
-	if p == nil || p.Title == nil {
+// GetArchiveURL returns the ArchiveURL field if it's non-nil, zero value otherwise.
+func (r *Repository) GetArchiveURL() string {
+	if r == nil || r.ArchiveURL == nil {
 		return ""
 	}
-	return *p.Title
-}
-
-// GetUpdatedAt returns the UpdatedAt field if it's non-nil, zero value otherwise.
-func (p *PullRequest) GetUpdatedAt() time.Time {
-	if p == nil || p.UpdatedAt == nil {
-		return time.Time{}
-	}
-	return *p.UpdatedAt
+	return *r.ArchiveURL
 }
 
-// GetURL returns the URL field if it's non-nil, zero value otherwise.
-func (p *PullRequest) GetURL() string {
-	if p == nil || p.URL == nil {
+// GetAssigneesURL returns the AssigneesURL field if it's non-nil, zero value otherwise.
+func (r *Repository) GetAssigneesURL() string {
+	if r == nil || r.AssigneesURL == nil {
 		return ""
 	}
-	return *p.URL
+	return *r.AssigneesURL
 }
 
-// GetUser returns the User field.
-func (p *PullRequest) GetUser() *User {
-	if p == nil {
-		return nil
+// GetAutoInit returns the AutoInit field if it's non-nil, zero value otherwise.
+func (r *Repository) GetAutoInit() bool {
+	if r == nil || r.AutoInit == nil {
+		return false
 	}
-	return p.User
+	return *r.AutoInit
 }
 
-// GetLabel returns the Label field if it's non-nil, zero value otherwise.
-func (p *PullRequestBranch) GetLabel() string {
-	if p == nil || p.Label == nil {
+// GetBlobsURL returns the BlobsURL field if it's non-nil, zero value otherwise.
+func (r *Repository) GetBlobsURL() string {
+	if r == nil || r.BlobsURL == nil {
 		return ""
 	}
-	return *p.Label
+	return *r.BlobsURL
 }
 
-// GetRef returns the Ref field if it's non-nil, zero value otherwise.
-func (p *PullRequestBranch) GetRef() string {
-	if p == nil || p.Ref == nil {
+// GetBranchesURL returns the BranchesURL field if it's non-nil, zero value otherwise.
+func (r *Repository) GetBranchesURL() string {
+	if r == nil || r.BranchesURL == nil {
 		return ""
 	}
-	return *p.Ref
-}
-
-// GetRepo returns the Repo field.
-func (p *PullRequestBranch) GetRepo() *Repository {
-	if p == nil {
-		return nil
-	}
-	return p.Repo
+	return *r.BranchesURL
 }
 
-// GetSHA returns the SHA field if it's non-nil, zero value otherwise.
-func (p *PullRequestBranch) GetSHA() string {
-	if p == nil || p.SHA == nil {
+// GetCloneURL returns the CloneURL field if it's non-nil, zero value otherwise.
+func (r *Repository) GetCloneURL() string {
+	if r == nil || r.CloneURL == nil {
 		return ""
 	}
-	return *p.SHA
+	return *r.CloneURL
 }
 
-// GetUser returns the User field.
-func (p *PullRequestBranch) GetUser() *User {
-	if p == nil {
+// GetCodeOfConduct returns the CodeOfConduct field.
+func (r *Repository) GetCodeOfConduct() *CodeOfConduct {
+	if r == nil {
 		return nil
 	}
-	return p.User
+	return r.CodeOfConduct
 }
 
-// GetExpectedHeadSHA returns the ExpectedHeadSHA field if it's non-nil, zero value otherwise.
-func (p *PullRequestBranchUpdateOptions) GetExpectedHeadSHA() string {
-	if p == nil || p.ExpectedHeadSHA == nil {
+// GetCollaboratorsURL returns the CollaboratorsURL field if it's non-nil, zero value otherwise.
+func (r *Repository) GetCollaboratorsURL() string {
+	if r == nil || r.CollaboratorsURL == nil {
 		return ""
 	}
-	return *p.ExpectedHeadSHA
+	return *r.CollaboratorsURL
 }
 
-// GetMessage returns the Message field if it's non-nil, zero value otherwise.
-func (p *PullRequestBranchUpdateResponse) GetMessage() string {
-	if p == nil || p.Message == nil {
+// GetCommentsURL returns the CommentsURL field if it's non-nil, zero value otherwise.
+func (r *Repository) GetCommentsURL() string {
+	if r == nil || r.CommentsURL == nil {
 		return ""
 	}
-	return *p.Message
+	return *r.CommentsURL
 }
 
-// GetURL returns the URL field if it's non-nil, zero value otherwise.
-func (p *PullRequestBranchUpdateResponse) GetURL() string {
-	if p == nil || p.URL == nil {
+// GetCommitsURL returns the CommitsURL field if it's non-nil, zero value otherwise.
+func (r *Repository) GetCommitsURL() string {
+	if r == nil || r.CommitsURL == nil {
 		return ""
 	}
-	return *p.URL
+	return *r.CommitsURL
 }
 
-// GetAuthorAssociation returns the AuthorAssociation field if it's non-nil, zero value otherwise.
-func (p *PullRequestComment) GetAuthorAssociation() string {
-	if p == nil || p.AuthorAssociation == nil {
+// GetCompareURL returns the CompareURL field if it's non-nil, zero value otherwise.
+func (r *Repository) GetCompareURL() string {
+	if r == nil || r.CompareURL == nil {
 		return ""
 	}
-	return *p.AuthorAssociation
+	return *r.CompareURL
 }
 
-// GetBody returns the Body field if it's non-nil, zero value otherwise.
-func (p *PullRequestComment) GetBody() string {
-	if p == nil || p.Body == nil {
+// GetContentsURL returns the ContentsURL field if it's non-nil, zero value otherwise.
+func (r *Repository) GetContentsURL() string {
+	if r == nil || r.ContentsURL == nil {
 		return ""
 	}
-	return *p.Body
+	return *r.ContentsURL
 }
 
-// GetCommitID returns the CommitID field if it's non-nil, zero value otherwise.
-func (p *PullRequestComment) GetCommitID() string {
-	if p == nil || p.CommitID == nil {
+// GetContributorsURL returns the ContributorsURL field if it's non-nil, zero value otherwise.
+func (r *Repository) GetContributorsURL() string {
+	if r == nil || r.ContributorsURL == nil {
 		return ""
 	}
-	return *p.CommitID
+	return *r.ContributorsURL
 }
 
 // GetCreatedAt returns the CreatedAt field if it's non-nil, zero value otherwise.
-func (p *PullRequestComment) GetCreatedAt() time.Time {
-	if p == nil || p.CreatedAt == nil {
-		return time.Time{}
+func (r *Repository) GetCreatedAt() Timestamp {
+	if r == nil || r.CreatedAt == nil {
+		return Timestamp{}
 	}
-	return *p.CreatedAt
+	return *r.CreatedAt
 }
 
-// GetDiffHunk returns the DiffHunk field if it's non-nil, zero value otherwise.
-func (p *PullRequestComment) GetDiffHunk() string {
-	if p == nil || p.DiffHunk == nil {
+// GetDefaultBranch returns the DefaultBranch field if it's non-nil, zero value otherwise.
+func (r *Repository) GetDefaultBranch() string {
+	if r == nil || r.DefaultBranch == nil {
 		return ""
 	}
-	return *p.DiffHunk
+	return *r.DefaultBranch
 }
 
-// GetHTMLURL returns the HTMLURL field if it's non-nil, zero value otherwise.
-func (p *PullRequestComment) GetHTMLURL() string {
-	if p == nil || p.HTMLURL == nil {
-		return ""
+// GetDeleteBranchOnMerge returns the DeleteBranchOnMerge field if it's non-nil, zero value otherwise.
+func (r *Repository) GetDeleteBranchOnMerge() bool {
+	if r == nil || r.DeleteBranchOnMerge == nil {
+		return false
 	}
-	return *p.HTMLURL
+	return *r.DeleteBranchOnMerge
 }
 
-// GetID returns the ID field if it's non-nil, zero value otherwise.
-func (p *PullRequestComment) GetID() int64 {
-	if p == nil || p.ID == nil {
-		return 0
+// GetDeploymentsURL returns the DeploymentsURL field if it's non-nil, zero value otherwise.
+func (r *Repository) GetDeploymentsURL() string {
+	if r == nil || r.DeploymentsURL == nil {
+		return ""
 	}
-	return *p.ID
+	return *r.DeploymentsURL
 }
 
-// GetInReplyTo returns the InReplyTo field if it's non-nil, zero value otherwise.
-func (p *PullRequestComment) GetInReplyTo() int64 {
-	if p == nil || p.InReplyTo == nil {
-		return 0
+// GetDescription returns the Description field if it's non-nil, zero value otherwise.
+func (r *Repository) GetDescription() string {
+	if r == nil || r.Description == nil {
+		return ""
 	}
-	return *p.InReplyTo
+	return *r.Description
 }
 
-// GetLine returns the Line field if it's non-nil, zero value otherwise.
-func (p *PullRequestComment) GetLine() int {
-	if p == nil || p.Line == nil {
-		return 0
+// GetDisabled returns the Disabled field if it's non-nil, zero value otherwise.
+func (r *Repository) GetDisabled() bool {
+	if r == nil || r.Disabled == nil {
+		return false
 	}
-	return *p.Line
+	return *r.Disabled
 }
 
-// GetNodeID returns the NodeID field if it's non-nil, zero value otherwise.
-func (p *PullRequestComment) GetNodeID() string {
-	if p == nil || p.NodeID == nil {
+// GetDownloadsURL returns the DownloadsURL field if it's non-nil, zero value otherwise.
+func (r *Repository) GetDownloadsURL() string {
+	if r == nil || r.DownloadsURL == nil {
 		return ""
 	}
-	return *p.NodeID
+	return *r.DownloadsURL
 }
 
-// GetOriginalCommitID returns the OriginalCommitID field if it's non-nil, zero value otherwise.
-func (p *PullRequestComment) GetOriginalCommitID() string {
-	if p == nil || p.OriginalCommitID == nil {
+// GetEventsURL returns the EventsURL field if it's non-nil, zero value otherwise.
+func (r *Repository) GetEventsURL() string {
+	if r == nil || r.EventsURL == nil {
 		return ""
 	}
-	return *p.OriginalCommitID
+	return *r.EventsURL
 }
 
-// GetOriginalLine returns the OriginalLine field if it's non-nil, zero value otherwise.
-func (p *PullRequestComment) GetOriginalLine() int {
-	if p == nil || p.OriginalLine == nil {
-		return 0
+// GetFork returns the Fork field if it's non-nil, zero value otherwise.
+func (r *Repository) GetFork() bool {
+	if r == nil || r.Fork == nil {
+		return false
 	}
-	return *p.OriginalLine
+	return *r.Fork
 }
 
-// GetOriginalPosition returns the OriginalPosition field if it's non-nil, zero value otherwise.
-func (p *PullRequestComment) GetOriginalPosition() int {
-	if p == nil || p.OriginalPosition == nil {
+// GetForksCount returns the ForksCount field if it's non-nil, zero value otherwise.
+func (r *Repository) GetForksCount() int {
+	if r == nil || r.ForksCount == nil {
 		return 0
 	}
-	return *p.OriginalPosition
+	return *r.ForksCount
 }
 
-// GetOriginalStartLine returns the OriginalStartLine field if it's non-nil, zero value otherwise.
-func (p *PullRequestComment) GetOriginalStartLine() int {
-	if p == nil || p.OriginalStartLine == nil {
-		return 0
+// GetForksURL returns the ForksURL field if it's non-nil, zero value otherwise.
+func (r *Repository) GetForksURL() string {
+	if r == nil || r.ForksURL == nil {
+		return ""
 	}
-	return *p.OriginalStartLine
+	return *r.ForksURL
 }
 
-// GetPath returns the Path field if it's non-nil, zero value otherwise.
-func (p *PullRequestComment) GetPath() string {
-	if p == nil || p.Path == nil {
+// GetFullName returns the FullName field if it's non-nil, zero value otherwise.
+func (r *Repository) GetFullName() string {
+	if r == nil || r.FullName == nil {
 		return ""
 	}
-	return *p.Path
+	return *r.FullName
 }
 
-// GetPosition returns the Position field if it's non-nil, zero value otherwise.
-func (p *PullRequestComment) GetPosition() int {
-	if p == nil || p.Position == nil {
-		return 0
+// GetGitCommitsURL returns the GitCommitsURL field if it's non-nil, zero value otherwise.
+func (r *Repository) GetGitCommitsURL() string {
+	if r == nil || r.GitCommitsURL == nil {
+		return ""
 	}
-	return *p.Position
+	return *r.GitCommitsURL
 }
 
-// GetPullRequestReviewID returns the PullRequestReviewID field if it's non-nil, zero value otherwise.
-func (p *PullRequestComment) GetPullRequestReviewID() int64 {
-	if p == nil || p.PullRequestReviewID == nil {
-		return 0
+// GetGitignoreTemplate returns the GitignoreTemplate field if it's non-nil, zero value otherwise.
+func (r *Repository) GetGitignoreTemplate() string {
+	if r == nil || r.GitignoreTemplate == nil {
+		return ""
 	}
-	return *p.PullRequestReviewID
+	return *r.GitignoreTemplate
 }
 
-// GetPullRequestURL returns the PullRequestURL field if it's non-nil, zero value otherwise.
-func (p *PullRequestComment) GetPullRequestURL() string {
-	if p == nil || p.PullRequestURL == nil {
+// GetGitRefsURL returns the GitRefsURL field if it's non-nil, zero value otherwise.
+func (r *Repository) GetGitRefsURL() string {
+	if r == nil || r.GitRefsURL == nil {
 		return ""
 	}
-	return *p.PullRequestURL
+	return *r.GitRefsURL
 }
 
-// GetReactions returns the Reactions field.
-func (p *PullRequestComment) GetReactions() *Reactions {
-	if p == nil {
-		return nil
+// GetGitTagsURL returns the GitTagsURL field if it's non-nil, zero value otherwise.
+func (r *Repository) GetGitTagsURL() string {
+	if r == nil || r.GitTagsURL == nil {
+		return ""
 	}
-	return p.Reactions
+	return *r.GitTagsURL
 }
 
-// GetSide returns the Side field if it's non-nil, zero value otherwise.
-func (p *PullRequestComment) GetSide() string {
-	if p == nil || p.Side == nil {
+// GetGitURL returns the GitURL field if it's non-nil, zero value otherwise.
+func (r *Repository) GetGitURL() string {
+	if r == nil || r.GitURL == nil {
 		return ""
 	}
-	return *p.Side
+	return *r.GitURL
 }
 
-// GetStartLine returns the StartLine field if it's non-nil, zero value otherwise.
-func (p *PullRequestComment) GetStartLine() int {
-	if p == nil || p.StartLine == nil {
-		return 0
+// GetHasDownloads returns the HasDownloads field if it's non-nil, zero value otherwise.
+func (r *Repository) GetHasDownloads() bool {
+	if r == nil || r.HasDownloads == nil {
+		return false
 	}
-	return *p.StartLine
+	return *r.HasDownloads
 }
 
-// GetStartSide returns the StartSide field if it's non-nil, zero value otherwise.
-func (p *PullRequestComment) GetStartSide() string {
-	if p == nil || p.StartSide == nil {
-		return ""
+// GetHasIssues returns the HasIssues field if it's non-nil, zero value otherwise.
+func (r *Repository) GetHasIssues() bool {
+	if r == nil || r.HasIssues == nil {
+		return false
 	}
-	return *p.StartSide
+	return *r.HasIssues
 }
 
-// GetUpdatedAt returns the UpdatedAt field if it's non-nil, zero value otherwise.
-func (p *PullRequestComment) GetUpdatedAt() time.Time {
-	if p == nil || p.UpdatedAt == nil {
-		return time.Time{}
+// GetHasPages returns the HasPages field if it's non-nil, zero value otherwise.
+func (r *Repository) GetHasPages() bool {
+	if r == nil || r.HasPages == nil {
+		return false
 	}
-	return *p.UpdatedAt
+	return *r.HasPages
 }
 
-// GetURL returns the URL field if it's non-nil, zero value otherwise.
-func (p *PullRequestComment) GetURL() string {
-	if p == nil || p.URL == nil {
-		return ""
+// GetHasProjects returns the HasProjects field if it's non-nil, zero value otherwise.
+func (r *Repository) GetHasProjects() bool {
+	if r == nil || r.HasProjects == nil {
+		return false
 	}
-	return *p.URL
+	return *r.HasProjects
 }
 
-// GetUser returns the User field.
-func (p *PullRequestComment) GetUser() *User {
-	if p == nil {
-		return nil
+// GetHasWiki returns the HasWiki field if it's non-nil, zero value otherwise.
+func (r *Repository) GetHasWiki() bool {
+	if r == nil || r.HasWiki == nil {
+		return false
+	}
+	return *r.HasWiki
+}
+
+// GetHomepage returns the Homepage field if it's non-nil, zero value otherwise.
+func (r *Repository) GetHomepage() string {
+	if r == nil || r.Homepage == nil {
+		return ""
 	}
-	return p.User
+	return *r.Homepage
 }
 
-// GetAction returns the Action field if it's non-nil, zero value otherwise.
-func (p *PullRequestEvent) GetAction() string {
-	if p == nil || p.Action == nil {
+// GetHooksURL returns the HooksURL field if it's non-nil, zero value otherwise.
+func (r *Repository) GetHooksURL() string {
+	if r == nil || r.HooksURL == nil {
 		return ""
 	}
-	return *p.Action
+	return *r.HooksURL
 }
 
-// GetAfter returns the After field if it's non-nil, zero value otherwise.
-func (p *PullRequestEvent) GetAfter() string {
-	if p == nil || p.After == nil {
+// GetHTMLURL returns the HTMLURL field if it's non-nil, zero value otherwise.
+func (r *Repository) GetHTMLURL() string {
+	if r == nil || r.HTMLURL == nil {
 		return ""
 	}
-	return *p.After
+	return *r.HTMLURL
 }
 
-// GetAssignee returns the Assignee field.
-func (p *PullRequestEvent) GetAssignee() *User {
-	if p == nil {
-		return nil
+// GetID returns the ID field if it's non-nil, zero value otherwise.
+func (r *Repository) GetID() int64 {
+	if r == nil || r.ID == nil {
+		return 0
 	}
-	return p.Assignee
+	return *r.ID
 }
 
-// GetBefore returns the Before field if it's non-nil, zero value otherwise.
-func (p *PullRequestEvent) GetBefore() string {
-	if p == nil || p.Before == nil {
+// GetIssueCommentURL returns the IssueCommentURL field if it's non-nil, zero value otherwise.
+func (r *Repository) GetIssueCommentURL() string {
+	if r == nil || r.IssueCommentURL == nil {
 		return ""
 	}
-	return *p.Before
+	return *r.IssueCommentURL
 }
 
-// GetChanges returns the Changes field.
-func (p *PullRequestEvent) GetChanges() *EditChange {
-	if p == nil {
-		return nil
+// GetIssueEventsURL returns the IssueEventsURL field if it's non-nil, zero value otherwise.
+func (r *Repository) GetIssueEventsURL() string {
+	if r == nil || r.IssueEventsURL == nil {
+		return ""
 	}
-	return p.Changes
+	return *r.IssueEventsURL
 }
 
-// GetInstallation returns the Installation field.
-func (p *PullRequestEvent) GetInstallation() *Installation {
-	if p == nil {
-		return nil
+// GetIssuesURL returns the IssuesURL field if it's non-nil, zero value otherwise.
+func (r *Repository) GetIssuesURL() string {
+	if r == nil || r.IssuesURL == nil {
+		return ""
 	}
-	return p.Installation
+	return *r.IssuesURL
 }
 
-// GetLabel returns the Label field.
-func (p *PullRequestEvent) GetLabel() *Label {
-	if p == nil {
-		return nil
+// GetIsTemplate returns the IsTemplate field if it's non-nil, zero value otherwise.
+func (r *Repository) GetIsTemplate() bool {
+	if r == nil || r.IsTemplate == nil {
+		return false
 	}
-	return p.Label
+	return *r.IsTemplate
 }
 
-// GetNumber returns the Number field if it's non-nil, zero value otherwise.
-func (p *PullRequestEvent) GetNumber() int {
-	if p == nil || p.Number == nil {
-		return 0
+// GetKeysURL returns the KeysURL field if it's non-nil, zero value otherwise.
+func (r *Repository) GetKeysURL() string {
+	if r == nil || r.KeysURL == nil {
+		return ""
 	}
-	return *p.Number
+	return *r.KeysURL
 }
 
-// GetOrganization returns the Organization field.
-func (p *PullRequestEvent) GetOrganization() *Organization {
-	if p == nil {
-		return nil
+// GetLabelsURL returns the LabelsURL field if it's non-nil, zero value otherwise.
+func (r *Repository) GetLabelsURL() string {
+	if r == nil || r.LabelsURL == nil {
+		return ""
 	}
-	return p.Organization
+	return *r.LabelsURL
 }
 
-// GetPullRequest returns the PullRequest field.
-func (p *PullRequestEvent) GetPullRequest() *PullRequest {
-	if p == nil {
-		return nil
+// GetLanguage returns the Language field if it's non-nil, zero value otherwise.
+func (r *Repository) GetLanguage() string {
+	if r == nil || r.Language == nil {
+		return ""
 	}
-	return p.PullRequest
+	return *r.Language
 }
 
-// GetRepo returns the Repo field.
-func (p *PullRequestEvent) GetRepo() *Repository {
-	if p == nil {
-		return nil
+// GetLanguagesURL returns the LanguagesURL field if it's non-nil, zero value otherwise.
+func (r *Repository) GetLanguagesURL() string {
+	if r == nil || r.LanguagesURL == nil {
+		return ""
 	}
-	return p.Repo
+	return *r.LanguagesURL
 }
 
-// GetRequestedReviewer returns the RequestedReviewer field.
-func (p *PullRequestEvent) GetRequestedReviewer() *User {
-	if p == nil {
+// GetLicense returns the License field.
+func (r *Repository) GetLicense() *License {
+	if r == nil {
 		return nil
 	}
-	return p.RequestedReviewer
+	return r.License
 }
 
-// GetRequestedTeam returns the RequestedTeam field.
-func (p *PullRequestEvent) GetRequestedTeam() *Team {
-	if p == nil {
-		return nil
+// GetLicenseTemplate returns the LicenseTemplate field if it's non-nil, zero value otherwise.
+func (r *Repository) GetLicenseTemplate() string {
+	if r == nil || r.LicenseTemplate == nil {
+		return ""
 	}
-	return p.RequestedTeam
+	return *r.LicenseTemplate
 }
 
-// GetSender returns the Sender field.
-func (p *PullRequestEvent) GetSender() *User {
-	if p == nil {
-		return nil
+// GetMasterBranch returns the MasterBranch field if it's non-nil, zero value otherwise.
+func (r *Repository) GetMasterBranch() string {
+	if r == nil || r.MasterBranch == nil {
+		return ""
 	}
-	return p.Sender
+	return *r.MasterBranch
 }
 
-// GetDiffURL returns the DiffURL field if it's non-nil, zero value otherwise.
-func (p *PullRequestLinks) GetDiffURL() string {
-	if p == nil || p.DiffURL == nil {
+// GetMergesURL returns the MergesURL field if it's non-nil, zero value otherwise.
+func (r *Repository) GetMergesURL() string {
+	if r == nil || r.MergesURL == nil {
 		return ""
 	}
-	return *p.DiffURL
+	return *r.MergesURL
 }
 
-// GetHTMLURL returns the HTMLURL field if it's non-nil, zero value otherwise.
-func (p *PullRequestLinks) GetHTMLURL() string {
-	if p == nil || p.HTMLURL == nil {
+// GetMilestonesURL returns the MilestonesURL field if it's non-nil, zero value otherwise.
+func (r *Repository) GetMilestonesURL() string {
+	if r == nil || r.MilestonesURL == nil {
 		return ""
 	}
-	return *p.HTMLURL
+	return *r.MilestonesURL
 }
 
-// GetPatchURL returns the PatchURL field if it's non-nil, zero value otherwise.
-func (p *PullRequestLinks) GetPatchURL() string {
-	if p == nil || p.PatchURL == nil {
+// GetMirrorURL returns the MirrorURL field if it's non-nil, zero value otherwise.
+func (r *Repository) GetMirrorURL() string {
+	if r == nil || r.MirrorURL == nil {
 		return ""
 	}
-	return *p.PatchURL
+	return *r.MirrorURL
 }
 
-// GetURL returns the URL field if it's non-nil, zero value otherwise.
-func (p *PullRequestLinks) GetURL() string {
-	if p == nil || p.URL == nil {
+// GetName returns the Name field if it's non-nil, zero value otherwise.
+func (r *Repository) GetName() string {
+	if r == nil || r.Name == nil {
 		return ""
 	}
-	return *p.URL
+	return *r.Name
 }
 
-// GetMerged returns the Merged field if it's non-nil, zero value otherwise.
-func (p *PullRequestMergeResult) GetMerged() bool {
-	if p == nil || p.Merged == nil {
-		return false
+// GetNetworkCount returns the NetworkCount field if it's non-nil, zero value otherwise.
+func (r *Repository) GetNetworkCount() int {
+	if r == nil || r.NetworkCount == nil {
+		return 0
 	}
-	return *p.Merged
+	return *r.NetworkCount
 }
 
-// GetMessage returns the Message field if it's non-nil, zero value otherwise.
-func (p *PullRequestMergeResult) GetMessage() string {
-	if p == nil || p.Message == nil {
+// GetNodeID returns the NodeID field if it's non-nil, zero value otherwise.
+func (r *Repository) GetNodeID() string {
+	if r == nil || r.NodeID == nil {
 		return ""
 	}
-	return *p.Message
+	return *r.NodeID
 }
 
-// GetSHA returns the SHA field if it's non-nil, zero value otherwise.
-func (p *PullRequestMergeResult) GetSHA() string {
-	if p == nil || p.SHA == nil {
+// GetNotificationsURL returns the NotificationsURL field if it's non-nil, zero value otherwise.
+func (r *Repository) GetNotificationsURL() string {
+	if r == nil || r.NotificationsURL == nil {
 		return ""
 	}
-	return *p.SHA
+	return *r.NotificationsURL
 }
 
-// GetAuthorAssociation returns the AuthorAssociation field if it's non-nil, zero value otherwise.
-func (p *PullRequestReview) GetAuthorAssociation() string {
-	if p == nil || p.AuthorAssociation == nil {
-		return ""
+// GetOpenIssuesCount returns the OpenIssuesCount field if it's non-nil, zero value otherwise.
+func (r *Repository) GetOpenIssuesCount() int {
+	if r == nil || r.OpenIssuesCount == nil {
+		return 0
 	}
-	return *p.AuthorAssociation
+	return *r.OpenIssuesCount
 }
 
-// GetBody returns the Body field if it's non-nil, zero value otherwise.
-func (p *PullRequestReview) GetBody() string {
-	if p == nil || p.Body == nil {
-		return ""
+// GetOrganization returns the Organization field.
+func (r *Repository) GetOrganization() *Organization {
+	if r == nil {
+		return nil
 	}
-	return *p.Body
+	return r.Organization
 }
 
-// GetCommitID returns the CommitID field if it's non-nil, zero value otherwise.
-func (p *PullRequestReview) GetCommitID() string {
-	if p == nil || p.CommitID == nil {
-		return ""
+// GetOwner returns the Owner field.
+func (r *Repository) GetOwner() *User {
+	if r == nil {
+		return nil
 	}
-	return *p.CommitID
+	return r.Owner
 }
 
-// GetHTMLURL returns the HTMLURL field if it's non-nil, zero value otherwise.
-func (p *PullRequestReview) GetHTMLURL() string {
-	if p == nil || p.HTMLURL == nil {
-		return ""
+// GetParent returns the Parent field.
+func (r *Repository) GetParent() *Repository {
+	if r == nil {
+		return nil
 	}
-	return *p.HTMLURL
+	return r.Parent
 }
 
-// GetID returns the ID field if it's non-nil, zero value otherwise.
-func (p *PullRequestReview) GetID() int64 {
-	if p == nil || p.ID == nil {
-		return 0
+// GetPermissions returns the Permissions field if it's non-nil, zero value otherwise.
+func (r *Repository) GetPermissions() map[string]bool {
+	if r == nil || r.Permissions == nil {
+		return map[string]bool{}
 	}
-	return *p.ID
+	return *r.Permissions
 }
 
-// GetNodeID returns the NodeID field if it's non-nil, zero value otherwise.
-func (p *PullRequestReview) GetNodeID() string {
-	if p == nil || p.NodeID == nil {
+// GetPrivate returns the Private field if it's non-nil, zero value otherwise.
+func (r *Repository) GetPrivate() bool {
+	if r == nil || r.Private == nil {
+		return false
+	}
+	return *r.Private
+}
+
+// GetPullsURL returns the PullsURL field if it's non-nil, zero value otherwise.
+func (r *Repository) GetPullsURL() string {
+	if r == nil || r.PullsURL == nil {
 		return ""
 	}
-	return *p.NodeID
+	return *r.PullsURL
 }
 
-// GetPullRequestURL returns the PullRequestURL field if it's non-nil, zero value otherwise.
-func (p *PullRequestReview) GetPullRequestURL() string {
-	if p == nil || p.PullRequestURL == nil {
-		return ""
+// GetPushedAt returns the PushedAt field if it's non-nil, zero value otherwise.
+func (r *Repository) GetPushedAt() Timestamp {
+	if r == nil || r.PushedAt == nil {
+		return Timestamp{}
 	}
-	return *p.PullRequestURL
+	return *r.PushedAt
 }
 
-// GetState returns the State field if it's non-nil, zero value otherwise.
-func (p *PullRequestReview) GetState() string {
-	if p == nil || p.State == nil {
+// GetReleasesURL returns the ReleasesURL field if it's non-nil, zero value otherwise.
+func (r *Repository) GetReleasesURL() string {
+	if r == nil || r.ReleasesURL == nil {
 		return ""
 	}
-	return *p.State
+	return *r.ReleasesURL
 }
 
-// GetSubmittedAt returns the SubmittedAt field if it's non-nil, zero value otherwise.
-func (p *PullRequestReview) GetSubmittedAt() time.Time {
-	if p == nil || p.SubmittedAt == nil {
-		return time.Time{}
+// GetSize returns the Size field if it's non-nil, zero value otherwise.
+func (r *Repository) GetSize() int {
+	if r == nil || r.Size == nil {
+		return 0
 	}
-	return *p.SubmittedAt
+	return *r.Size
 }
 
-// GetUser returns the User field.
-func (p *PullRequestReview) GetUser() *User {
-	if p == nil {
+// GetSource returns the Source field.
+func (r *Repository) GetSource() *Repository {
+	if r == nil {
 		return nil
 	}
-	return p.User
+	return r.Source
 }
 
-// GetAction returns the Action field if it's non-nil, zero value otherwise.
-func (p *PullRequestReviewCommentEvent) GetAction() string {
-	if p == nil || p.Action == nil {
+// GetSSHURL returns the SSHURL field if it's non-nil, zero value otherwise.
+func (r *Repository) GetSSHURL() string {
+	if r == nil || r.SSHURL == nil {
 		return ""
 	}
-	return *p.Action
-}
-
-// GetChanges returns the Changes field.
-func (p *PullRequestReviewCommentEvent) GetChanges() *EditChange {
-	if p == nil {
-		return nil
-	}
-	return p.Changes
+	return *r.SSHURL
 }
 
-// GetComment returns the Comment field.
-func (p *PullRequestReviewCommentEvent) GetComment() *PullRequestComment {
-	if p == nil {
-		return nil
+// GetStargazersCount returns the StargazersCount field if it's non-nil, zero value otherwise.
+func (r *Repository) GetStargazersCount() int {
+	if r == nil || r.StargazersCount == nil {
+		return 0
 	}
-	return p.Comment
+	return *r.StargazersCount
 }
 
-// GetInstallation returns the Installation field.
-func (p *PullRequestReviewCommentEvent) GetInstallation() *Installation {
-	if p == nil {
-		return nil
+// GetStargazersURL returns the StargazersURL field if it's non-nil, zero value otherwise.
+func (r *Repository) GetStargazersURL() string {
+	if r == nil || r.StargazersURL == nil {
+		return ""
 	}
-	return p.Installation
+	return *r.StargazersURL
 }
 
-// GetPullRequest returns the PullRequest field.
-func (p *PullRequestReviewCommentEvent) GetPullRequest() *PullRequest {
-	if p == nil {
-		return nil
+// GetStatusesURL returns the StatusesURL field if it's non-nil, zero value otherwise.
+func (r *Repository) GetStatusesURL() string {
+	if r == nil || r.StatusesURL == nil {
+		return ""
 	}
-	return p.PullRequest
+	return *r.StatusesURL
 }
 
-// GetRepo returns the Repo field.
-func (p *PullRequestReviewCommentEvent) GetRepo() *Repository {
-	if p == nil {
-		return nil
+// GetSubscribersCount returns the SubscribersCount field if it's non-nil, zero value otherwise.
+func (r *Repository) GetSubscribersCount() int {
+	if r == nil || r.SubscribersCount == nil {
+		return 0
 	}
-	return p.Repo
+	return *r.SubscribersCount
 }
 
-// GetSender returns the Sender field.
-func (p *PullRequestReviewCommentEvent) GetSender() *User {
-	if p == nil {
-		return nil
+// GetSubscribersURL returns the SubscribersURL field if it's non-nil, zero value otherwise.
+func (r *Repository) GetSubscribersURL() string {
+	if r == nil || r.SubscribersURL == nil {
+		return ""
 	}
-	return p.Sender
+	return *r.SubscribersURL
 }
 
-// GetMessage returns the Message field if it's non-nil, zero value otherwise.
-func (p *PullRequestReviewDismissalRequest) GetMessage() string {
-	if p == nil || p.Message == nil {
+// GetSubscriptionURL returns the SubscriptionURL field if it's non-nil, zero value otherwise.
+func (r *Repository) GetSubscriptionURL() string {
+	if r == nil || r.SubscriptionURL == nil {
 		return ""
 	}
-	return *p.Message
+	return *r.SubscriptionURL
 }
 
-// GetAction returns the Action field if it's non-nil, zero value otherwise.
-func (p *PullRequestReviewEvent) GetAction() string {
-	if p == nil || p.Action == nil {
+// GetSVNURL returns the SVNURL field if it's non-nil, zero value otherwise.
+func (r *Repository) GetSVNURL() string {
+	if r == nil || r.SVNURL == nil {
 		return ""
 	}
-	return *p.Action
+	return *r.SVNURL
 }
 
-// GetInstallation returns the Installation field.
-func (p *PullRequestReviewEvent) GetInstallation() *Installation {
-	if p == nil {
-		return nil
+// GetTagsURL returns the TagsURL field if it's non-nil, zero value otherwise.
+func (r *Repository) GetTagsURL() string {
+	if r == nil || r.TagsURL == nil {
+		return ""
 	}
-	return p.Installation
+	return *r.TagsURL
 }
 
-// GetOrganization returns the Organization field.
-func (p *PullRequestReviewEvent) GetOrganization() *Organization {
-	if p == nil {
-		return nil
+// GetTeamID returns the TeamID field if it's non-nil, zero value otherwise.
+func (r *Repository) GetTeamID() int64 {
+	if r == nil || r.TeamID == nil {
+		return 0
 	}
-	return p.Organization
+	return *r.TeamID
 }
 
-// GetPullRequest returns the PullRequest field.
-func (p *PullRequestReviewEvent) GetPullRequest() *PullRequest {
-	if p == nil {
-		return nil
+// GetTeamsURL returns the TeamsURL field if it's non-nil, zero value otherwise.
+func (r *Repository) GetTeamsURL() string {
+	if r == nil || r.TeamsURL == nil {
+		return ""
 	}
-	return p.PullRequest
+	return *r.TeamsURL
 }
 
-// GetRepo returns the Repo field.
-func (p *PullRequestReviewEvent) GetRepo() *Repository {
-	if p == nil {
+// GetTemplateRepository returns the TemplateRepository field.
+func (r *Repository) GetTemplateRepository() *Repository {
+	if r == nil {
 		return nil
 	}
-	return p.Repo
+	return r.TemplateRepository
 }
 
-// GetReview returns the Review field.
-func (p *PullRequestReviewEvent) GetReview() *PullRequestReview {
-	if p == nil {
-		return nil
+// GetTreesURL returns the TreesURL field if it's non-nil, zero value otherwise.
+func (r *Repository) GetTreesURL() string {
+	if r == nil || r.TreesURL == nil {
+		return ""
 	}
-	return p.Review
+	return *r.TreesURL
 }
 
-// GetSender returns the Sender field.
-func (p *PullRequestReviewEvent) GetSender() *User {
-	if p == nil {
-		return nil
+// GetUpdatedAt returns the UpdatedAt field if it's non-nil, zero value otherwise.
+func (r *Repository) GetUpdatedAt() Timestamp {
+	if r == nil || r.UpdatedAt == nil {
+		return Timestamp{}
 	}
-	return p.Sender
+	return *r.UpdatedAt
 }
 
-// GetBody returns the Body field if it's non-nil, zero value otherwise.
-func (p *PullRequestReviewRequest) GetBody() string {
-	if p == nil || p.Body == nil {
+// GetURL returns the URL field if it's non-nil, zero value otherwise.
+func (r *Repository) GetURL() string {
+	if r == nil || r.URL == nil {
 		return ""
 	}
-	return *p.Body
+	return *r.URL
 }
 
-// GetCommitID returns the CommitID field if it's non-nil, zero value otherwise.
-func (p *PullRequestReviewRequest) GetCommitID() string {
-	if p == nil || p.CommitID == nil {
+// GetVisibility returns the Visibility field if it's non-nil, zero value otherwise.
+func (r *Repository) GetVisibility() string {
+	if r == nil || r.Visibility == nil {
 		return ""
 	}
-	return *p.CommitID
+	return *r.Visibility
 }
 
-// GetEvent returns the Event field if it's non-nil, zero value otherwise.
-func (p *PullRequestReviewRequest) GetEvent() string {
-	if p == nil || p.Event == nil {
-		return ""
+// GetWatchersCount returns the WatchersCount field if it's non-nil, zero value otherwise.
+func (r *Repository) GetWatchersCount() int {
+	if r == nil || r.WatchersCount == nil {
+		return 0
 	}
-	return *p.Event
+	return *r.WatchersCount
 }
 
-// GetNodeID returns the NodeID field if it's non-nil, zero value otherwise.
-func (p *PullRequestReviewRequest) GetNodeID() string {
-	if p == nil || p.NodeID == nil {
+// GetActivityType returns the ActivityType field if it's non-nil, zero value otherwise.
+func (r *RepositoryActivity) GetActivityType() string {
+	if r == nil || r.ActivityType == nil {
 		return ""
 	}
-	return *p.NodeID
+	return *r.ActivityType
 }
 
-// GetDismissalRestrictions returns the DismissalRestrictions field.
-func (p *PullRequestReviewsEnforcement) GetDismissalRestrictions() *DismissalRestrictions {
-	if p == nil {
+// GetActor returns the Actor field.
+func (r *RepositoryActivity) GetActor() *User {
+	if r == nil {
 		return nil
 	}
-	return p.DismissalRestrictions
+	return r.Actor
 }
 
-// GetDismissalRestrictionsRequest returns the DismissalRestrictionsRequest field.
-func (p *PullRequestReviewsEnforcementRequest) GetDismissalRestrictionsRequest() *DismissalRestrictionsRequest {
-	if p == nil {
-		return nil
+// GetAfter returns the After field if it's non-nil, zero value otherwise.
+func (r *RepositoryActivity) GetAfter() string {
+	if r == nil || r.After == nil {
+		return ""
 	}
-	return p.DismissalRestrictionsRequest
+	return *r.After
 }
 
-// GetDismissalRestrictionsRequest returns the DismissalRestrictionsRequest field.
-func (p *PullRequestReviewsEnforcementUpdate) GetDismissalRestrictionsRequest() *DismissalRestrictionsRequest {
-	if p == nil {
-		return nil
+// GetBefore returns the Before field if it's non-nil, zero value otherwise.
+func (r *RepositoryActivity) GetBefore() string {
+	if r == nil || r.Before == nil {
+		return ""
 	}
-	return p.DismissalRestrictionsRequest
+	return *r.Before
 }
 
-// GetDismissStaleReviews returns the DismissStaleReviews field if it's non-nil, zero value otherwise.
-func (p *PullRequestReviewsEnforcementUpdate) GetDismissStaleReviews() bool {
-	if p == nil || p.DismissStaleReviews == nil {
-		return false
+// GetID returns the ID field if it's non-nil, zero value otherwise.
+func (r *RepositoryActivity) GetID() int64 {
+	if r == nil || r.ID == nil {
+		return 0
 	}
-	return *p.DismissStaleReviews
+	return *r.ID
 }
 
-// GetMergablePulls returns the MergablePulls field if it's non-nil, zero value otherwise.
-func (p *PullStats) GetMergablePulls() int {
-	if p == nil || p.MergablePulls == nil {
-		return 0
+// GetNodeID returns the NodeID field if it's non-nil, zero value otherwise.
+func (r *RepositoryActivity) GetNodeID() string {
+	if r == nil || r.NodeID == nil {
+		return ""
 	}
-	return *p.MergablePulls
+	return *r.NodeID
 }
 
-// GetMergedPulls returns the MergedPulls field if it's non-nil, zero value otherwise.
-func (p *PullStats) GetMergedPulls() int {
-	if p == nil || p.MergedPulls == nil {
-		return 0
+// GetRef returns the Ref field if it's non-nil, zero value otherwise.
+func (r *RepositoryActivity) GetRef() string {
+	if r == nil || r.Ref == nil {
+		return ""
 	}
-	return *p.MergedPulls
+	return *r.Ref
 }
 
-// GetTotalPulls returns the TotalPulls field if it's non-nil, zero value otherwise.
-func (p *PullStats) GetTotalPulls() int {
-	if p == nil || p.TotalPulls == nil {
-		return 0
+// GetTimestamp returns the Timestamp field if it's non-nil, zero value otherwise.
+func (r *RepositoryActivity) GetTimestamp() Timestamp {
+	if r == nil || r.Timestamp == nil {
+		return Timestamp{}
 	}
-	return *p.TotalPulls
+	return *r.Timestamp
 }
 
-// GetUnmergablePulls returns the UnmergablePulls field if it's non-nil, zero value otherwise.
-func (p *PullStats) GetUnmergablePulls() int {
-	if p == nil || p.UnmergablePulls == nil {
-		return 0
+// GetCVEID returns the CVEID field if it's non-nil, zero value otherwise.
+func (r *RepositoryAdvisoryRequest) GetCVEID() string {
+	if r == nil || r.CVEID == nil {
+		return ""
 	}
-	return *p.UnmergablePulls
+	return *r.CVEID
 }
 
-// GetCommits returns the Commits field if it's non-nil, zero value otherwise.
-func (p *PunchCard) GetCommits() int {
-	if p == nil || p.Commits == nil {
-		return 0
+// GetCVSSVectorString returns the CVSSVectorString field if it's non-nil, zero value otherwise.
+func (r *RepositoryAdvisoryRequest) GetCVSSVectorString() string {
+	if r == nil || r.CVSSVectorString == nil {
+		return ""
 	}
-	return *p.Commits
+	return *r.CVSSVectorString
 }
 
-// GetDay returns the Day field if it's non-nil, zero value otherwise.
-func (p *PunchCard) GetDay() int {
-	if p == nil || p.Day == nil {
-		return 0
+// GetDescription returns the Description field if it's non-nil, zero value otherwise.
+func (r *RepositoryAdvisoryRequest) GetDescription() string {
+	if r == nil || r.Description == nil {
+		return ""
 	}
-	return *p.Day
+	return *r.Description
 }
 
-// GetHour returns the Hour field if it's non-nil, zero value otherwise.
-func (p *PunchCard) GetHour() int {
-	if p == nil || p.Hour == nil {
-		return 0
+// GetSeverity returns the Severity field if it's non-nil, zero value otherwise.
+func (r *RepositoryAdvisoryRequest) GetSeverity() string {
+	if r == nil || r.Severity == nil {
+		return ""
 	}
-	return *p.Hour
+	return *r.Severity
 }
 
-// GetAfter returns the After field if it's non-nil, zero value otherwise.
-func (p *PushEvent) GetAfter() string {
-	if p == nil || p.After == nil {
+// GetState returns the State field if it's non-nil, zero value otherwise.
+func (r *RepositoryAdvisoryRequest) GetState() string {
+	if r == nil || r.State == nil {
 		return ""
 	}
-	return *p.After
+	return *r.State
 }
 
-// GetBaseRef returns the BaseRef field if it's non-nil, zero value otherwise.
-func (p *PushEvent) GetBaseRef() string {
-	if p == nil || p.BaseRef == nil {
+// GetSummary returns the Summary field if it's non-nil, zero value otherwise.
+func (r *RepositoryAdvisoryRequest) GetSummary() string {
+	if r == nil || r.Summary == nil {
 		return ""
 	}
-	return *p.BaseRef
+	return *r.Summary
 }
 
-// GetBefore returns the Before field if it's non-nil, zero value otherwise.
-func (p *PushEvent) GetBefore() string {
-	if p == nil || p.Before == nil {
+// GetBody returns the Body field if it's non-nil, zero value otherwise.
+func (r *RepositoryComment) GetBody() string {
+	if r == nil || r.Body == nil {
 		return ""
 	}
-	return *p.Before
+	return *r.Body
 }
 
-// GetCompare returns the Compare field if it's non-nil, zero value otherwise.
-func (p *PushEvent) GetCompare() string {
-	if p == nil || p.Compare == nil {
+// GetCommitID returns the CommitID field if it's non-nil, zero value otherwise.
+func (r *RepositoryComment) GetCommitID() string {
+	if r == nil || r.CommitID == nil {
 		return ""
 	}
-	return *p.Compare
+	return *r.CommitID
 }
 
-// GetCreated returns the Created field if it's non-nil, zero value otherwise.
-func (p *PushEvent) GetCreated() bool {
-	if p == nil || p.Created == nil {
-		return false
+// GetCreatedAt returns the CreatedAt field if it's non-nil, zero value otherwise.
+func (r *RepositoryComment) GetCreatedAt() time.Time {
+	if r == nil || r.CreatedAt == nil {
+		return time.Time{}
 	}
-	return *p.Created
+	return *r.CreatedAt
 }
 
-// GetDeleted returns the Deleted field if it's non-nil, zero value otherwise.
-func (p *PushEvent) GetDeleted() bool {
-	if p == nil || p.Deleted == nil {
-		return false
+// GetHTMLURL returns the HTMLURL field if it's non-nil, zero value otherwise.
+func (r *RepositoryComment) GetHTMLURL() string {
+	if r == nil || r.HTMLURL == nil {
+		return ""
 	}
-	return *p.Deleted
+	return *r.HTMLURL
 }
 
-// GetDistinctSize returns the DistinctSize field if it's non-nil, zero value otherwise.
-func (p *PushEvent) GetDistinctSize() int {
-	if p == nil || p.DistinctSize == nil {
+// GetID returns the ID field if it's non-nil, zero value otherwise.
+func (r *RepositoryComment) GetID() int64 {
+	if r == nil || r.ID == nil {
 		return 0
 	}
-	return *p.DistinctSize
+	return *r.ID
 }
 
-// GetForced returns the Forced field if it's non-nil, zero value otherwise.
-func (p *PushEvent) GetForced() bool {
-	if p == nil || p.Forced == nil {
-		return false
+// GetNodeID returns the NodeID field if it's non-nil, zero value otherwise.
+func (r *RepositoryComment) GetNodeID() string {
+	if r == nil || r.NodeID == nil {
+		return ""
 	}
-	return *p.Forced
+	return *r.NodeID
 }
 
-// GetHead returns the Head field if it's non-nil, zero value otherwise.
-func (p *PushEvent) GetHead() string {
-	if p == nil || p.Head == nil {
+// GetPath returns the Path field if it's non-nil, zero value otherwise.
+func (r *RepositoryComment) GetPath() string {
+	if r == nil || r.Path == nil {
 		return ""
 	}
-	return *p.Head
+	return *r.Path
 }
 
-// GetHeadCommit returns the HeadCommit field.
-func (p *PushEvent) GetHeadCommit() *HeadCommit {
-	if p == nil {
-		return nil
+// GetPosition returns the Position field if it's non-nil, zero value otherwise.
+func (r *RepositoryComment) GetPosition() int {
+	if r == nil || r.Position == nil {
+		return 0
 	}
-	return p.HeadCommit
+	return *r.Position
 }
 
-// GetInstallation returns the Installation field.
-func (p *PushEvent) GetInstallation() *Installation {
-	if p == nil {
+// GetReactions returns the Reactions field.
+func (r *RepositoryComment) GetReactions() *Reactions {
+	if r == nil {
 		return nil
 	}
-	return p.Installation
+	return r.Reactions
 }
 
-// GetPusher returns the Pusher field.
-func (p *PushEvent) GetPusher() *User {
-	if p == nil {
-		return nil
+// GetUpdatedAt returns the UpdatedAt field if it's non-nil, zero value otherwise.
+func (r *RepositoryComment) GetUpdatedAt() time.Time {
+	if r == nil || r.UpdatedAt == nil {
+		return time.Time{}
 	}
-	return p.Pusher
+	return *r.UpdatedAt
 }
 
-// GetPushID returns the PushID field if it's non-nil, zero value otherwise.
-func (p *PushEvent) GetPushID() int64 {
-	if p == nil || p.PushID == nil {
-		return 0
+// GetURL returns the URL field if it's non-nil, zero value otherwise.
+func (r *RepositoryComment) GetURL() string {
+	if r == nil || r.URL == nil {
+		return ""
 	}
-	return *p.PushID
+	return *r.URL
 }
 
-// GetRef returns the Ref field if it's non-nil, zero value otherwise.
-func (p *PushEvent) GetRef() string {
-	if p == nil || p.Ref == nil {
-		return ""
+// GetUser returns the User field.
+func (r *RepositoryComment) GetUser() *User {
+	if r == nil {
+		return nil
 	}
-	return *p.Ref
+	return r.User
 }
 
-// GetRepo returns the Repo field.
-func (p *PushEvent) GetRepo() *PushEventRepository {
-	if p == nil {
+// GetAuthor returns the Author field.
+func (r *RepositoryCommit) GetAuthor() *User {
+	if r == nil {
 		return nil
 	}
-	return p.Repo
+	return r.Author
 }
 
-// GetSender returns the Sender field.
-func (p *PushEvent) GetSender() *User {
-	if p == nil {
-		return nil
+// GetCommentsURL returns the CommentsURL field if it's non-nil, zero value otherwise.
+func (r *RepositoryCommit) GetCommentsURL() string {
+	if r == nil || r.CommentsURL == nil {
+		return ""
 	}
-	return p.Sender
+	return *r.CommentsURL
 }
 
-// GetSize returns the Size field if it's non-nil, zero value otherwise.
-func (p *PushEvent) GetSize() int {
-	if p == nil || p.Size == nil {
-		return 0
+// GetCommit returns the Commit field.
+func (r *RepositoryCommit) GetCommit() *Commit {
+	if r == nil {
+		return nil
 	}
-	return *p.Size
+	return r.Commit
 }
 
-// GetEmail returns the Email field if it's non-nil, zero value otherwise.
-func (p *PushEventRepoOwner) GetEmail() string {
-	if p == nil || p.Email == nil {
-		return ""
+// GetCommitter returns the Committer field.
+func (r *RepositoryCommit) GetCommitter() *User {
+	if r == nil {
+		return nil
 	}
-	return *p.Email
+	return r.Committer
 }
 
-// GetName returns the Name field if it's non-nil, zero value otherwise.
-func (p *PushEventRepoOwner) GetName() string {
-	if p == nil || p.Name == nil {
+// GetHTMLURL returns the HTMLURL field if it's non-nil, zero value otherwise.
+func (r *RepositoryCommit) GetHTMLURL() string {
+	if r == nil || r.HTMLURL == nil {
 		return ""
 	}
-	return *p.Name
+	return *r.HTMLURL
 }
 
-// GetArchived returns the Archived field if it's non-nil, zero value otherwise.
-func (p *PushEventRepository) GetArchived() bool {
-	if p == nil || p.Archived == nil {
-		return false
+// GetNodeID returns the NodeID field if it's non-nil, zero value otherwise.
+func (r *RepositoryCommit) GetNodeID() string {
+	if r == nil || r.NodeID == nil {
+		return ""
 	}
-	return *p.Archived
+	return *r.NodeID
 }
 
-// GetArchiveURL returns the ArchiveURL field if it's non-nil, zero value otherwise.
-func (p *PushEventRepository) GetArchiveURL() string {
-	if p == nil || p.ArchiveURL == nil {
+// GetSHA returns the SHA field if it's non-nil, zero value otherwise.
+func (r *RepositoryCommit) GetSHA() string {
+	if r == nil || r.SHA == nil {
 		return ""
 	}
-	return *p.ArchiveURL
+	return *r.SHA
 }
 
-// GetCloneURL returns the CloneURL field if it's non-nil, zero value otherwise.
-func (p *PushEventRepository) GetCloneURL() string {
-	if p == nil || p.CloneURL == nil {
-		return ""
+// GetStats returns the Stats field.
+func (r *RepositoryCommit) GetStats() *CommitStats {
+	if r == nil {
+		return nil
 	}
-	return *p.CloneURL
+	return r.Stats
 }
 
-// GetCreatedAt returns the CreatedAt field if it's non-nil, zero value otherwise.
-func (p *PushEventRepository) GetCreatedAt() Timestamp {
-	if p == nil || p.CreatedAt == nil {
-		return Timestamp{}
+// GetURL returns the URL field if it's non-nil, zero value otherwise.
+func (r *RepositoryCommit) GetURL() string {
+	if r == nil || r.URL == nil {
+		return ""
 	}
-	return *p.CreatedAt
+	return *r.URL
 }
 
-// GetDefaultBranch returns the DefaultBranch field if it's non-nil, zero value otherwise.
-func (p *PushEventRepository) GetDefaultBranch() string {
-	if p == nil || p.DefaultBranch == nil {
+// GetDownloadURL returns the DownloadURL field if it's non-nil, zero value otherwise.
+func (r *RepositoryContent) GetDownloadURL() string {
+	if r == nil || r.DownloadURL == nil {
 		return ""
 	}
-	return *p.DefaultBranch
+	return *r.DownloadURL
 }
 
-// GetDescription returns the Description field if it's non-nil, zero value otherwise.
-func (p *PushEventRepository) GetDescription() string {
-	if p == nil || p.Description == nil {
+// GetEncoding returns the Encoding field if it's non-nil, zero value otherwise.
+func (r *RepositoryContent) GetEncoding() string {
+	if r == nil || r.Encoding == nil {
 		return ""
 	}
-	return *p.Description
+	return *r.Encoding
 }
 
-// GetDisabled returns the Disabled field if it's non-nil, zero value otherwise.
-func (p *PushEventRepository) GetDisabled() bool {
-	if p == nil || p.Disabled == nil {
-		return false
+// GetGitURL returns the GitURL field if it's non-nil, zero value otherwise.
+func (r *RepositoryContent) GetGitURL() string {
+	if r == nil || r.GitURL == nil {
+		return ""
 	}
-	return *p.Disabled
+	return *r.GitURL
 }
 
-// GetFork returns the Fork field if it's non-nil, zero value otherwise.
-func (p *PushEventRepository) GetFork() bool {
-	if p == nil || p.Fork == nil {
-		return false
+// GetHTMLURL returns the HTMLURL field if it's non-nil, zero value otherwise.
+func (r *RepositoryContent) GetHTMLURL() string {
+	if r == nil || r.HTMLURL == nil {
+		return ""
 	}
-	return *p.Fork
+	return *r.HTMLURL
 }
 
-// GetForksCount returns the ForksCount field if it's non-nil, zero value otherwise.
-func (p *PushEventRepository) GetForksCount() int {
-	if p == nil || p.ForksCount == nil {
-		return 0
+// GetName returns the Name field if it's non-nil, zero value otherwise.
+func (r *RepositoryContent) GetName() string {
+	if r == nil || r.Name == nil {
+		return ""
 	}
-	return *p.ForksCount
+	return *r.Name
 }
 
-// GetFullName returns the FullName field if it's non-nil, zero value otherwise.
-func (p *PushEventRepository) GetFullName() string {
-	if p == nil || p.FullName == nil {
+// GetPath returns the Path field if it's non-nil, zero value otherwise.
+func (r *RepositoryContent) GetPath() string {
+	if r == nil || r.Path == nil {
 		return ""
 	}
-	return *p.FullName
+	return *r.Path
 }
 
-// GetGitURL returns the GitURL field if it's non-nil, zero value otherwise.
-func (p *PushEventRepository) GetGitURL() string {
-	if p == nil || p.GitURL == nil {
+// GetSHA returns the SHA field if it's non-nil, zero value otherwise.
+func (r *RepositoryContent) GetSHA() string {
+	if r == nil || r.SHA == nil {
 		return ""
 	}
-	return *p.GitURL
+	return *r.SHA
 }
 
-// GetHasDownloads returns the HasDownloads field if it's non-nil, zero value otherwise.
-func (p *PushEventRepository) GetHasDownloads() bool {
-	if p == nil || p.HasDownloads == nil {
-		return false
+// GetSize returns the Size field if it's non-nil, zero value otherwise.
+func (r *RepositoryContent) GetSize() int {
+	if r == nil || r.Size == nil {
+		return 0
 	}
-	return *p.HasDownloads
+	return *r.Size
 }
 
-// GetHasIssues returns the HasIssues field if it's non-nil, zero value otherwise.
-func (p *PushEventRepository) GetHasIssues() bool {
-	if p == nil || p.HasIssues == nil {
-		return false
+// GetTarget returns the Target field if it's non-nil, zero value otherwise.
+func (r *RepositoryContent) GetTarget() string {
+	if r == nil || r.Target == nil {
+		return ""
 	}
-	return *p.HasIssues
+	return *r.Target
 }
 
-// GetHasPages returns the HasPages field if it's non-nil, zero value otherwise.
-func (p *PushEventRepository) GetHasPages() bool {
-	if p == nil || p.HasPages == nil {
-		return false
+// GetType returns the Type field if it's non-nil, zero value otherwise.
+func (r *RepositoryContent) GetType() string {
+	if r == nil || r.Type == nil {
+		return ""
 	}
-	return *p.HasPages
+	return *r.Type
 }
 
-// GetHasWiki returns the HasWiki field if it's non-nil, zero value otherwise.
-func (p *PushEventRepository) GetHasWiki() bool {
-	if p == nil || p.HasWiki == nil {
-		return false
+// GetURL returns the URL field if it's non-nil, zero value otherwise.
+func (r *RepositoryContent) GetURL() string {
+	if r == nil || r.URL == nil {
+		return ""
 	}
-	return *p.HasWiki
+	return *r.URL
 }
 
-// GetHomepage returns the Homepage field if it's non-nil, zero value otherwise.
-func (p *PushEventRepository) GetHomepage() string {
-	if p == nil || p.Homepage == nil {
-		return ""
+// GetAuthor returns the Author field.
+func (r *RepositoryContentFileOptions) GetAuthor() *CommitAuthor {
+	if r == nil {
+		return nil
 	}
-	return *p.Homepage
+	return r.Author
 }
 
-// GetHTMLURL returns the HTMLURL field if it's non-nil, zero value otherwise.
-func (p *PushEventRepository) GetHTMLURL() string {
-	if p == nil || p.HTMLURL == nil {
+// GetBranch returns the Branch field if it's non-nil, zero value otherwise.
+func (r *RepositoryContentFileOptions) GetBranch() string {
+	if r == nil || r.Branch == nil {
 		return ""
 	}
-	return *p.HTMLURL
+	return *r.Branch
 }
 
-// GetID returns the ID field if it's non-nil, zero value otherwise.
-func (p *PushEventRepository) GetID() int64 {
-	if p == nil || p.ID == nil {
-		return 0
+// GetCommitter returns the Committer field.
+func (r *RepositoryContentFileOptions) GetCommitter() *CommitAuthor {
+	if r == nil {
+		return nil
 	}
-	return *p.ID
+	return r.Committer
 }
 
-// GetLanguage returns the Language field if it's non-nil, zero value otherwise.
-func (p *PushEventRepository) GetLanguage() string {
-	if p == nil || p.Language == nil {
+// GetMessage returns the Message field if it's non-nil, zero value otherwise.
+func (r *RepositoryContentFileOptions) GetMessage() string {
+	if r == nil || r.Message == nil {
 		return ""
 	}
-	return *p.Language
+	return *r.Message
 }
 
-// GetMasterBranch returns the MasterBranch field if it's non-nil, zero value otherwise.
-func (p *PushEventRepository) GetMasterBranch() string {
-	if p == nil || p.MasterBranch == nil {
+// GetSHA returns the SHA field if it's non-nil, zero value otherwise.
+func (r *RepositoryContentFileOptions) GetSHA() string {
+	if r == nil || r.SHA == nil {
 		return ""
 	}
-	return *p.MasterBranch
+	return *r.SHA
 }
 
-// GetName returns the Name field if it's non-nil, zero value otherwise.
-func (p *PushEventRepository) GetName() string {
-	if p == nil || p.Name == nil {
+// GetContent returns the Content field.
+func (r *RepositoryContentResponse) GetContent() *RepositoryContent {
+	if r == nil {
+		return nil
+	}
+	return r.Content
+}
+
+// GetAction returns the Action field if it's non-nil, zero value otherwise.
+func (r *RepositoryDispatchEvent) GetAction() string {
+	if r == nil || r.Action == nil {
 		return ""
 	}
-	return *p.Name
+	return *r.Action
 }
 
-// GetNodeID returns the NodeID field if it's non-nil, zero value otherwise.
-func (p *PushEventRepository) GetNodeID() string {
-	if p == nil || p.NodeID == nil {
+// GetBranch returns the Branch field if it's non-nil, zero value otherwise.
+func (r *RepositoryDispatchEvent) GetBranch() string {
+	if r == nil || r.Branch == nil {
 		return ""
 	}
-	return *p.NodeID
+	return *r.Branch
 }
 
-// GetOpenIssuesCount returns the OpenIssuesCount field if it's non-nil, zero value otherwise.
-func (p *PushEventRepository) GetOpenIssuesCount() int {
-	if p == nil || p.OpenIssuesCount == nil {
-		return 0
+// GetInstallation returns the Installation field.
+func (r *RepositoryDispatchEvent) GetInstallation() *Installation {
+	if r == nil {
+		return nil
 	}
-	return *p.OpenIssuesCount
+	return r.Installation
 }
 
-// GetOrganization returns the Organization field if it's non-nil, zero value otherwise.
-func (p *PushEventRepository) GetOrganization() string {
-	if p == nil || p.Organization == nil {
-		return ""
+// GetOrg returns the Org field.
+func (r *RepositoryDispatchEvent) GetOrg() *Organization {
+	if r == nil {
+		return nil
 	}
-	return *p.Organization
+	return r.Org
 }
 
-// GetOwner returns the Owner field.
-func (p *PushEventRepository) GetOwner() *User {
-	if p == nil {
+// GetRepo returns the Repo field.
+func (r *RepositoryDispatchEvent) GetRepo() *Repository {
+	if r == nil {
 		return nil
 	}
-	return p.Owner
+	return r.Repo
 }
 
-// GetPrivate returns the Private field if it's non-nil, zero value otherwise.
-func (p *PushEventRepository) GetPrivate() bool {
-	if p == nil || p.Private == nil {
-		return false
+// GetSender returns the Sender field.
+func (r *RepositoryDispatchEvent) GetSender() *User {
+	if r == nil {
+		return nil
 	}
-	return *p.Private
+	return r.Sender
 }
 
-// GetPullsURL returns the PullsURL field if it's non-nil, zero value otherwise.
-func (p *PushEventRepository) GetPullsURL() string {
-	if p == nil || p.PullsURL == nil {
+// GetAction returns the Action field if it's non-nil, zero value otherwise.
+func (r *RepositoryEvent) GetAction() string {
+	if r == nil || r.Action == nil {
 		return ""
 	}
-	return *p.PullsURL
+	return *r.Action
 }
 
-// GetPushedAt returns the PushedAt field if it's non-nil, zero value otherwise.
-func (p *PushEventRepository) GetPushedAt() Timestamp {
-	if p == nil || p.PushedAt == nil {
-		return Timestamp{}
+// GetInstallation returns the Installation field.
+func (r *RepositoryEvent) GetInstallation() *Installation {
+	if r == nil {
+		return nil
 	}
-	return *p.PushedAt
+	return r.Installation
 }
 
-// GetSize returns the Size field if it's non-nil, zero value otherwise.
-func (p *PushEventRepository) GetSize() int {
-	if p == nil || p.Size == nil {
-		return 0
+// GetOrg returns the Org field.
+func (r *RepositoryEvent) GetOrg() *Organization {
+	if r == nil {
+		return nil
 	}
-	return *p.Size
+	return r.Org
 }
 
-// GetSSHURL returns the SSHURL field if it's non-nil, zero value otherwise.
-func (p *PushEventRepository) GetSSHURL() string {
-	if p == nil || p.SSHURL == nil {
-		return ""
+// GetRepo returns the Repo field.
+func (r *RepositoryEvent) GetRepo() *Repository {
+	if r == nil {
+		return nil
 	}
-	return *p.SSHURL
+	return r.Repo
 }
 
-// GetStargazersCount returns the StargazersCount field if it's non-nil, zero value otherwise.
-func (p *PushEventRepository) GetStargazersCount() int {
-	if p == nil || p.StargazersCount == nil {
-		return 0
+// GetSender returns the Sender field.
+func (r *RepositoryEvent) GetSender() *User {
+	if r == nil {
+		return nil
 	}
-	return *p.StargazersCount
+	return r.Sender
 }
 
-// GetStatusesURL returns the StatusesURL field if it's non-nil, zero value otherwise.
-func (p *PushEventRepository) GetStatusesURL() string {
-	if p == nil || p.StatusesURL == nil {
-		return ""
+// GetEnabled returns the Enabled field if it's non-nil, zero value otherwise.
+func (r *RepositoryImmutableReleases) GetEnabled() bool {
+	if r == nil || r.Enabled == nil {
+		return false
 	}
-	return *p.StatusesURL
+	return *r.Enabled
 }
-
-// GetSVNURL returns the SVNURL field if it's non-nil, zero value otherwise.
-func (p *PushEventRepository) GetSVNURL() string {
-	if p == nil || p.SVNURL == nil {
-		return ""
+
+// GetEnforcedByOwner returns the EnforcedByOwner field if it's non-nil, zero value otherwise.
+func (r *RepositoryImmutableReleases) GetEnforcedByOwner() bool {
+	if r == nil || r.EnforcedByOwner == nil {
+		return false
 	}
-	return *p.SVNURL
+	return *r.EnforcedByOwner
 }
 
-// GetUpdatedAt returns the UpdatedAt field if it's non-nil, zero value otherwise.
-func (p *PushEventRepository) GetUpdatedAt() Timestamp {
-	if p == nil || p.UpdatedAt == nil {
+// GetCreatedAt returns the CreatedAt field if it's non-nil, zero value otherwise.
+func (r *RepositoryInvitation) GetCreatedAt() Timestamp {
+	if r == nil || r.CreatedAt == nil {
 		return Timestamp{}
 	}
-	return *p.UpdatedAt
+	return *r.CreatedAt
 }
 
-// GetURL returns the URL field if it's non-nil, zero value otherwise.
-func (p *PushEventRepository) GetURL() string {
-	if p == nil || p.URL == nil {
+// GetHTMLURL returns the HTMLURL field if it's non-nil, zero value otherwise.
+func (r *RepositoryInvitation) GetHTMLURL() string {
+	if r == nil || r.HTMLURL == nil {
 		return ""
 	}
-	return *p.URL
+	return *r.HTMLURL
 }
 
-// GetWatchersCount returns the WatchersCount field if it's non-nil, zero value otherwise.
-func (p *PushEventRepository) GetWatchersCount() int {
-	if p == nil || p.WatchersCount == nil {
+// GetID returns the ID field if it's non-nil, zero value otherwise.
+func (r *RepositoryInvitation) GetID() int64 {
+	if r == nil || r.ID == nil {
 		return 0
 	}
-	return *p.WatchersCount
+	return *r.ID
 }
 
-// GetCore returns the Core field.
-func (r *RateLimits) GetCore() *Rate {
+// GetInvitee returns the Invitee field.
+func (r *RepositoryInvitation) GetInvitee() *User {
 	if r == nil {
 		return nil
 	}
-	return r.Core
+	return r.Invitee
 }
 
-// GetSearch returns the Search field.
-func (r *RateLimits) GetSearch() *Rate {
+// GetInviter returns the Inviter field.
+func (r *RepositoryInvitation) GetInviter() *User {
 	if r == nil {
 		return nil
 	}
-	return r.Search
+	return r.Inviter
 }
 
-// GetContent returns the Content field if it's non-nil, zero value otherwise.
-func (r *Reaction) GetContent() string {
-	if r == nil || r.Content == nil {
+// GetPermissions returns the Permissions field if it's non-nil, zero value otherwise.
+func (r *RepositoryInvitation) GetPermissions() string {
+	if r == nil || r.Permissions == nil {
 		return ""
 	}
-	return *r.Content
+	return *r.Permissions
 }
 
-// GetID returns the ID field if it's non-nil, zero value otherwise.
-func (r *Reaction) GetID() int64 {
-	if r == nil || r.ID == nil {
-		return 0
+// GetRepo returns the Repo field.
+func (r *RepositoryInvitation) GetRepo() *Repository {
+	if r == nil {
+		return nil
 	}
-	return *r.ID
+	return r.Repo
 }
 
-// GetNodeID returns the NodeID field if it's non-nil, zero value otherwise.
-func (r *Reaction) GetNodeID() string {
-	if r == nil || r.NodeID == nil {
+// GetURL returns the URL field if it's non-nil, zero value otherwise.
+func (r *RepositoryInvitation) GetURL() string {
+	if r == nil || r.URL == nil {
 		return ""
 	}
-	return *r.NodeID
+	return *r.URL
 }
 
-// GetUser returns the User field.
-func (r *Reaction) GetUser() *User {
-	if r == nil {
-		return nil
+// GetContent returns the Content field if it's non-nil, zero value otherwise.
+func (r *RepositoryLicense) GetContent() string {
+	if r == nil || r.Content == nil {
+		return ""
 	}
-	return r.User
+	return *r.Content
 }
 
-// GetConfused returns the Confused field if it's non-nil, zero value otherwise.
-func (r *Reactions) GetConfused() int {
-	if r == nil || r.Confused == nil {
-		return 0
+// GetDownloadURL returns the DownloadURL field if it's non-nil, zero value otherwise.
+func (r *RepositoryLicense) GetDownloadURL() string {
+	if r == nil || r.DownloadURL == nil {
+		return ""
 	}
-	return *r.Confused
+	return *r.DownloadURL
 }
 
-// GetEyes returns the Eyes field if it's non-nil, zero value otherwise.
-func (r *Reactions) GetEyes() int {
-	if r == nil || r.Eyes == nil {
-		return 0
+// GetEncoding returns the Encoding field if it's non-nil, zero value otherwise.
+func (r *RepositoryLicense) GetEncoding() string {
+	if r == nil || r.Encoding == nil {
+		return ""
 	}
-	return *r.Eyes
+	return *r.Encoding
 }
 
-// GetHeart returns the Heart field if it's non-nil, zero value otherwise.
-func (r *Reactions) GetHeart() int {
-	if r == nil || r.Heart == nil {
-		return 0
+// GetGitURL returns the GitURL field if it's non-nil, zero value otherwise.
+func (r *RepositoryLicense) GetGitURL() string {
+	if r == nil || r.GitURL == nil {
+		return ""
 	}
-	return *r.Heart
+	return *r.GitURL
 }
 
-// GetHooray returns the Hooray field if it's non-nil, zero value otherwise.
-func (r *Reactions) GetHooray() int {
-	if r == nil || r.Hooray == nil {
-		return 0
+// GetHTMLURL returns the HTMLURL field if it's non-nil, zero value otherwise.
+func (r *RepositoryLicense) GetHTMLURL() string {
+	if r == nil || r.HTMLURL == nil {
+		return ""
 	}
-	return *r.Hooray
+	return *r.HTMLURL
 }
 
-// GetLaugh returns the Laugh field if it's non-nil, zero value otherwise.
-func (r *Reactions) GetLaugh() int {
-	if r == nil || r.Laugh == nil {
-		return 0
+// GetLicense returns the License field.
+func (r *RepositoryLicense) GetLicense() *License {
+	if r == nil {
+		return nil
 	}
-	return *r.Laugh
+	return r.License
 }
 
-// GetMinusOne returns the MinusOne field if it's non-nil, zero value otherwise.
-func (r *Reactions) GetMinusOne() int {
-	if r == nil || r.MinusOne == nil {
-		return 0
+// GetName returns the Name field if it's non-nil, zero value otherwise.
+func (r *RepositoryLicense) GetName() string {
+	if r == nil || r.Name == nil {
+		return ""
 	}
-	return *r.MinusOne
+	return *r.Name
 }
 
-// GetPlusOne returns the PlusOne field if it's non-nil, zero value otherwise.
-func (r *Reactions) GetPlusOne() int {
-	if r == nil || r.PlusOne == nil {
-		return 0
+// GetPath returns the Path field if it's non-nil, zero value otherwise.
+func (r *RepositoryLicense) GetPath() string {
+	if r == nil || r.Path == nil {
+		return ""
 	}
-	return *r.PlusOne
+	return *r.Path
 }
 
-// GetRocket returns the Rocket field if it's non-nil, zero value otherwise.
-func (r *Reactions) GetRocket() int {
-	if r == nil || r.Rocket == nil {
-		return 0
+// GetSHA returns the SHA field if it's non-nil, zero value otherwise.
+func (r *RepositoryLicense) GetSHA() string {
+	if r == nil || r.SHA == nil {
+		return ""
 	}
-	return *r.Rocket
+	return *r.SHA
 }
 
-// GetTotalCount returns the TotalCount field if it's non-nil, zero value otherwise.
-func (r *Reactions) GetTotalCount() int {
-	if r == nil || r.TotalCount == nil {
+// GetSize returns the Size field if it's non-nil, zero value otherwise.
+func (r *RepositoryLicense) GetSize() int {
+	if r == nil || r.Size == nil {
 		return 0
 	}
-	return *r.TotalCount
+	return *r.Size
+}
+
+// GetType returns the Type field if it's non-nil, zero value otherwise.
+func (r *RepositoryLicense) GetType() string {
+	if r == nil || r.Type == nil {
+		return ""
+	}
+	return *r.Type
 }
 
 // GetURL returns the URL field if it's non-nil, zero value otherwise.
-func (r *Reactions) GetURL() string {
+func (r *RepositoryLicense) GetURL() string {
 	if r == nil || r.URL == nil {
 		return ""
 	}
 	return *r.URL
 }
 
-// GetNodeID returns the NodeID field if it's non-nil, zero value otherwise.
-func (r *Reference) GetNodeID() string {
-	if r == nil || r.NodeID == nil {
+// GetBase returns the Base field if it's non-nil, zero value otherwise.
+func (r *RepositoryMergeRequest) GetBase() string {
+	if r == nil || r.Base == nil {
 		return ""
 	}
-	return *r.NodeID
+	return *r.Base
 }
 
-// GetObject returns the Object field.
-func (r *Reference) GetObject() *GitObject {
-	if r == nil {
-		return nil
+// GetCommitMessage returns the CommitMessage field if it's non-nil, zero value otherwise.
+func (r *RepositoryMergeRequest) GetCommitMessage() string {
+	if r == nil || r.CommitMessage == nil {
+		return ""
 	}
-	return r.Object
+	return *r.CommitMessage
 }
 
-// GetRef returns the Ref field if it's non-nil, zero value otherwise.
-func (r *Reference) GetRef() string {
-	if r == nil || r.Ref == nil {
+// GetHead returns the Head field if it's non-nil, zero value otherwise.
+func (r *RepositoryMergeRequest) GetHead() string {
+	if r == nil || r.Head == nil {
 		return ""
 	}
-	return *r.Ref
+	return *r.Head
 }
 
-// GetURL returns the URL field if it's non-nil, zero value otherwise.
-func (r *Reference) GetURL() string {
-	if r == nil || r.URL == nil {
+// GetPermission returns the Permission field if it's non-nil, zero value otherwise.
+func (r *RepositoryPermissionLevel) GetPermission() string {
+	if r == nil || r.Permission == nil {
 		return ""
 	}
-	return *r.URL
+	return *r.Permission
 }
 
-// GetExpiresAt returns the ExpiresAt field if it's non-nil, zero value otherwise.
-func (r *RegistrationToken) GetExpiresAt() Timestamp {
-	if r == nil || r.ExpiresAt == nil {
-		return Timestamp{}
+// GetUser returns the User field.
+func (r *RepositoryPermissionLevel) GetUser() *User {
+	if r == nil {
+		return nil
 	}
-	return *r.ExpiresAt
+	return r.User
 }
 
-// GetToken returns the Token field if it's non-nil, zero value otherwise.
-func (r *RegistrationToken) GetToken() string {
-	if r == nil || r.Token == nil {
+// GetAssetsURL returns the AssetsURL field if it's non-nil, zero value otherwise.
+func (r *RepositoryRelease) GetAssetsURL() string {
+	if r == nil || r.AssetsURL == nil {
 		return ""
 	}
-	return *r.Token
+	return *r.AssetsURL
 }
 
-// GetBrowserDownloadURL returns the BrowserDownloadURL field if it's non-nil, zero value otherwise.
-func (r *ReleaseAsset) GetBrowserDownloadURL() string {
-	if r == nil || r.BrowserDownloadURL == nil {
-		return ""
+// GetAuthor returns the Author field.
+func (r *RepositoryRelease) GetAuthor() *User {
+	if r == nil {
+		return nil
 	}
-	return *r.BrowserDownloadURL
+	return r.Author
 }
 
-// GetContentType returns the ContentType field if it's non-nil, zero value otherwise.
-func (r *ReleaseAsset) GetContentType() string {
-	if r == nil || r.ContentType == nil {
+// GetBody returns the Body field if it's non-nil, zero value otherwise.
+func (r *RepositoryRelease) GetBody() string {
+	if r == nil || r.Body == nil {
 		return ""
 	}
-	return *r.ContentType
+	return *r.Body
 }
 
 // GetCreatedAt returns the CreatedAt field if it's non-nil, zero value otherwise.
-func (r *ReleaseAsset) GetCreatedAt() Timestamp {
+func (r *RepositoryRelease) GetCreatedAt() Timestamp {
 	if r == nil || r.CreatedAt == nil {
 		return Timestamp{}
 	}
-	return *r.CreatedAt
+	return *r.CreatedAt
+}
+
+// GetDiscussionCategoryName returns the DiscussionCategoryName field if it's non-nil, zero value otherwise.
+func (r *RepositoryRelease) GetDiscussionCategoryName() string {
+	if r == nil || r.DiscussionCategoryName == nil {
+		return ""
+	}
+	return *r.DiscussionCategoryName
+}
+
+// GetDiscussionURL returns the DiscussionURL field if it's non-nil, zero value otherwise.
+func (r *RepositoryRelease) GetDiscussionURL() string {
+	if r == nil || r.DiscussionURL == nil {
+		return ""
+	}
+	return *r.DiscussionURL
+}
+
+// GetDraft returns the Draft field if it's non-nil, zero value otherwise.
+func (r *RepositoryRelease) GetDraft() bool {
+	if r == nil || r.Draft == nil {
+		return false
+	}
+	return *r.Draft
 }
 
-// GetDownloadCount returns the DownloadCount field if it's non-nil, zero value otherwise.
-func (r *ReleaseAsset) GetDownloadCount() int {
-	if r == nil || r.DownloadCount == nil {
-		return 0
+// GetHTMLURL returns the HTMLURL field if it's non-nil, zero value otherwise.
+func (r *RepositoryRelease) GetHTMLURL() string {
+	if r == nil || r.HTMLURL == nil {
+		return ""
 	}
-	return *r.DownloadCount
+	return *r.HTMLURL
 }
 
 // GetID returns the ID field if it's non-nil, zero value otherwise.
-func (r *ReleaseAsset) GetID() int64 {
+func (r *RepositoryRelease) GetID() int64 {
 	if r == nil || r.ID == nil {
 		return 0
 	}
 	return *r.ID
 }
 
-// GetLabel returns the Label field if it's non-nil, zero value otherwise.
-func (r *ReleaseAsset) GetLabel() string {
-	if r == nil || r.Label == nil {
-		return ""
-	}
-	return *r.Label
-}
-
 // GetName returns the Name field if it's non-nil, zero value otherwise.
-func (r *ReleaseAsset) GetName() string {
+func (r *RepositoryRelease) GetName() string {
 	if r == nil || r.Name == nil {
 		return ""
 	}
@@ -10797,1883 +16461,1899 @@ func (r *ReleaseAsset) GetName() string {
 }
 
 // GetNodeID returns the NodeID field if it's non-nil, zero value otherwise.
-func (r *ReleaseAsset) GetNodeID() string {
+func (r *RepositoryRelease) GetNodeID() string {
 	if r == nil || r.NodeID == nil {
 		return ""
 	}
 	return *r.NodeID
 }
 
-// GetSize returns the Size field if it's non-nil, zero value otherwise.
-func (r *ReleaseAsset) GetSize() int {
-	if r == nil || r.Size == nil {
-		return 0
+// GetPrerelease returns the Prerelease field if it's non-nil, zero value otherwise.
+func (r *RepositoryRelease) GetPrerelease() bool {
+	if r == nil || r.Prerelease == nil {
+		return false
 	}
-	return *r.Size
+	return *r.Prerelease
 }
 
-// GetState returns the State field if it's non-nil, zero value otherwise.
-func (r *ReleaseAsset) GetState() string {
-	if r == nil || r.State == nil {
-		return ""
+// GetPublishedAt returns the PublishedAt field if it's non-nil, zero value otherwise.
+func (r *RepositoryRelease) GetPublishedAt() Timestamp {
+	if r == nil || r.PublishedAt == nil {
+		return Timestamp{}
 	}
-	return *r.State
+	return *r.PublishedAt
 }
 
-// GetUpdatedAt returns the UpdatedAt field if it's non-nil, zero value otherwise.
-func (r *ReleaseAsset) GetUpdatedAt() Timestamp {
-	if r == nil || r.UpdatedAt == nil {
-		return Timestamp{}
+// GetTagName returns the TagName field if it's non-nil, zero value otherwise.
+func (r *RepositoryRelease) GetTagName() string {
+	if r == nil || r.TagName == nil {
+		return ""
 	}
-	return *r.UpdatedAt
+	return *r.TagName
 }
 
-// GetUploader returns the Uploader field.
-func (r *ReleaseAsset) GetUploader() *User {
-	if r == nil {
-		return nil
+// GetTarballURL returns the TarballURL field if it's non-nil, zero value otherwise.
+func (r *RepositoryRelease) GetTarballURL() string {
+	if r == nil || r.TarballURL == nil {
+		return ""
 	}
-	return r.Uploader
+	return *r.TarballURL
 }
 
-// GetURL returns the URL field if it's non-nil, zero value otherwise.
-func (r *ReleaseAsset) GetURL() string {
-	if r == nil || r.URL == nil {
+// GetTargetCommitish returns the TargetCommitish field if it's non-nil, zero value otherwise.
+func (r *RepositoryRelease) GetTargetCommitish() string {
+	if r == nil || r.TargetCommitish == nil {
 		return ""
 	}
-	return *r.URL
+	return *r.TargetCommitish
 }
 
-// GetAction returns the Action field if it's non-nil, zero value otherwise.
-func (r *ReleaseEvent) GetAction() string {
-	if r == nil || r.Action == nil {
+// GetUploadURL returns the UploadURL field if it's non-nil, zero value otherwise.
+func (r *RepositoryRelease) GetUploadURL() string {
+	if r == nil || r.UploadURL == nil {
 		return ""
 	}
-	return *r.Action
+	return *r.UploadURL
 }
 
-// GetInstallation returns the Installation field.
-func (r *ReleaseEvent) GetInstallation() *Installation {
-	if r == nil {
-		return nil
+// GetURL returns the URL field if it's non-nil, zero value otherwise.
+func (r *RepositoryRelease) GetURL() string {
+	if r == nil || r.URL == nil {
+		return ""
 	}
-	return r.Installation
+	return *r.URL
 }
 
-// GetRelease returns the Release field.
-func (r *ReleaseEvent) GetRelease() *RepositoryRelease {
-	if r == nil {
-		return nil
+// GetZipballURL returns the ZipballURL field if it's non-nil, zero value otherwise.
+func (r *RepositoryRelease) GetZipballURL() string {
+	if r == nil || r.ZipballURL == nil {
+		return ""
 	}
-	return r.Release
+	return *r.ZipballURL
 }
 
-// GetRepo returns the Repo field.
-func (r *ReleaseEvent) GetRepo() *Repository {
-	if r == nil {
-		return nil
+// GetParameters returns the Parameters field if it's non-nil, zero value otherwise.
+func (r *RepositoryRule) GetParameters() json.RawMessage {
+	if r == nil || r.Parameters == nil {
+		return json.RawMessage{}
 	}
-	return r.Repo
+	return *r.Parameters
 }
 
-// GetSender returns the Sender field.
-func (r *ReleaseEvent) GetSender() *User {
+// GetCommit returns the Commit field.
+func (r *RepositoryTag) GetCommit() *Commit {
 	if r == nil {
 		return nil
 	}
-	return r.Sender
-}
-
-// GetExpiresAt returns the ExpiresAt field if it's non-nil, zero value otherwise.
-func (r *RemoveToken) GetExpiresAt() Timestamp {
-	if r == nil || r.ExpiresAt == nil {
-		return Timestamp{}
-	}
-	return *r.ExpiresAt
-}
-
-// GetToken returns the Token field if it's non-nil, zero value otherwise.
-func (r *RemoveToken) GetToken() string {
-	if r == nil || r.Token == nil {
-		return ""
-	}
-	return *r.Token
+	return r.Commit
 }
 
-// GetFrom returns the From field if it's non-nil, zero value otherwise.
-func (r *Rename) GetFrom() string {
-	if r == nil || r.From == nil {
+// GetName returns the Name field if it's non-nil, zero value otherwise.
+func (r *RepositoryTag) GetName() string {
+	if r == nil || r.Name == nil {
 		return ""
 	}
-	return *r.From
+	return *r.Name
 }
 
-// GetTo returns the To field if it's non-nil, zero value otherwise.
-func (r *Rename) GetTo() string {
-	if r == nil || r.To == nil {
+// GetTarballURL returns the TarballURL field if it's non-nil, zero value otherwise.
+func (r *RepositoryTag) GetTarballURL() string {
+	if r == nil || r.TarballURL == nil {
 		return ""
 	}
-	return *r.To
+	return *r.TarballURL
 }
 
-// GetMessage returns the Message field if it's non-nil, zero value otherwise.
-func (r *RenameOrgResponse) GetMessage() string {
-	if r == nil || r.Message == nil {
+// GetZipballURL returns the ZipballURL field if it's non-nil, zero value otherwise.
+func (r *RepositoryTag) GetZipballURL() string {
+	if r == nil || r.ZipballURL == nil {
 		return ""
 	}
-	return *r.Message
+	return *r.ZipballURL
 }
 
-// GetURL returns the URL field if it's non-nil, zero value otherwise.
-func (r *RenameOrgResponse) GetURL() string {
-	if r == nil || r.URL == nil {
+// GetAction returns the Action field if it's non-nil, zero value otherwise.
+func (r *RepositoryVulnerabilityAlertEvent) GetAction() string {
+	if r == nil || r.Action == nil {
 		return ""
 	}
-	return *r.URL
+	return *r.Action
 }
 
-// GetIncompleteResults returns the IncompleteResults field if it's non-nil, zero value otherwise.
-func (r *RepositoriesSearchResult) GetIncompleteResults() bool {
-	if r == nil || r.IncompleteResults == nil {
-		return false
+// GetRepository returns the Repository field.
+func (r *RepositoryVulnerabilityAlertEvent) GetRepository() *Repository {
+	if r == nil {
+		return nil
 	}
-	return *r.IncompleteResults
+	return r.Repository
 }
 
-// GetTotal returns the Total field if it's non-nil, zero value otherwise.
-func (r *RepositoriesSearchResult) GetTotal() int {
-	if r == nil || r.Total == nil {
+// GetForkRepos returns the ForkRepos field if it's non-nil, zero value otherwise.
+func (r *RepoStats) GetForkRepos() int {
+	if r == nil || r.ForkRepos == nil {
 		return 0
 	}
-	return *r.Total
-}
-
-// GetAllowMergeCommit returns the AllowMergeCommit field if it's non-nil, zero value otherwise.
-func (r *Repository) GetAllowMergeCommit() bool {
-	if r == nil || r.AllowMergeCommit == nil {
-		return false
-	}
-	return *r.AllowMergeCommit
-}
-
-// GetAllowRebaseMerge returns the AllowRebaseMerge field if it's non-nil, zero value otherwise.
-func (r *Repository) GetAllowRebaseMerge() bool {
-	if r == nil || r.AllowRebaseMerge == nil {
-		return false
-	}
-	return *r.AllowRebaseMerge
-}
-
-// GetAllowSquashMerge returns the AllowSquashMerge field if it's non-nil, zero value otherwise.
-func (r *Repository) GetAllowSquashMerge() bool {
-	if r == nil || r.AllowSquashMerge == nil {
-		return false
-	}
-	return *r.AllowSquashMerge
+	return *r.ForkRepos
 }
 
-// GetArchived returns the Archived field if it's non-nil, zero value otherwise.
-func (r *Repository) GetArchived() bool {
-	if r == nil || r.Archived == nil {
-		return false
+// GetOrgRepos returns the OrgRepos field if it's non-nil, zero value otherwise.
+func (r *RepoStats) GetOrgRepos() int {
+	if r == nil || r.OrgRepos == nil {
+		return 0
 	}
-	return *r.Archived
+	return *r.OrgRepos
 }
 
-// GetArchiveURL returns the ArchiveURL field if it's non-nil, zero value otherwise.
-func (r *Repository) GetArchiveURL() string {
-	if r == nil || r.ArchiveURL == nil {
-		return ""
+// GetRootRepos returns the RootRepos field if it's non-nil, zero value otherwise.
+func (r *RepoStats) GetRootRepos() int {
+	if r == nil || r.RootRepos == nil {
+		return 0
 	}
-	return *r.ArchiveURL
+	return *r.RootRepos
 }
 
-// GetAssigneesURL returns the AssigneesURL field if it's non-nil, zero value otherwise.
-func (r *Repository) GetAssigneesURL() string {
-	if r == nil || r.AssigneesURL == nil {
-		return ""
+// GetTotalPushes returns the TotalPushes field if it's non-nil, zero value otherwise.
+func (r *RepoStats) GetTotalPushes() int {
+	if r == nil || r.TotalPushes == nil {
+		return 0
 	}
-	return *r.AssigneesURL
+	return *r.TotalPushes
 }
 
-// GetAutoInit returns the AutoInit field if it's non-nil, zero value otherwise.
-func (r *Repository) GetAutoInit() bool {
-	if r == nil || r.AutoInit == nil {
-		return false
+// GetTotalRepos returns the TotalRepos field if it's non-nil, zero value otherwise.
+func (r *RepoStats) GetTotalRepos() int {
+	if r == nil || r.TotalRepos == nil {
+		return 0
 	}
-	return *r.AutoInit
+	return *r.TotalRepos
 }
 
-// GetBlobsURL returns the BlobsURL field if it's non-nil, zero value otherwise.
-func (r *Repository) GetBlobsURL() string {
-	if r == nil || r.BlobsURL == nil {
-		return ""
+// GetTotalWikis returns the TotalWikis field if it's non-nil, zero value otherwise.
+func (r *RepoStats) GetTotalWikis() int {
+	if r == nil || r.TotalWikis == nil {
+		return 0
 	}
-	return *r.BlobsURL
+	return *r.TotalWikis
 }
 
-// GetBranchesURL returns the BranchesURL field if it's non-nil, zero value otherwise.
-func (r *Repository) GetBranchesURL() string {
-	if r == nil || r.BranchesURL == nil {
+// GetContext returns the Context field if it's non-nil, zero value otherwise.
+func (r *RepoStatus) GetContext() string {
+	if r == nil || r.Context == nil {
 		return ""
 	}
-	return *r.BranchesURL
+	return *r.Context
 }
 
-// GetCloneURL returns the CloneURL field if it's non-nil, zero value otherwise.
-func (r *Repository) GetCloneURL() string {
-	if r == nil || r.CloneURL == nil {
-		return ""
+// GetCreatedAt returns the CreatedAt field if it's non-nil, zero value otherwise.
+func (r *RepoStatus) GetCreatedAt() time.Time {
+	if r == nil || r.CreatedAt == nil {
+		return time.Time{}
 	}
-	return *r.CloneURL
+	return *r.CreatedAt
 }
 
-// GetCodeOfConduct returns the CodeOfConduct field.
-func (r *Repository) GetCodeOfConduct() *CodeOfConduct {
+// GetCreator returns the Creator field.
+func (r *RepoStatus) GetCreator() *User {
 	if r == nil {
 		return nil
 	}
-	return r.CodeOfConduct
+	return r.Creator
 }
 
-// GetCollaboratorsURL returns the CollaboratorsURL field if it's non-nil, zero value otherwise.
-func (r *Repository) GetCollaboratorsURL() string {
-	if r == nil || r.CollaboratorsURL == nil {
+// GetDescription returns the Description field if it's non-nil, zero value otherwise.
+func (r *RepoStatus) GetDescription() string {
+	if r == nil || r.Description == nil {
 		return ""
 	}
-	return *r.CollaboratorsURL
+	return *r.Description
 }
 
-// GetCommentsURL returns the CommentsURL field if it's non-nil, zero value otherwise.
-func (r *Repository) GetCommentsURL() string {
-	if r == nil || r.CommentsURL == nil {
-		return ""
+// GetID returns the ID field if it's non-nil, zero value otherwise.
+func (r *RepoStatus) GetID() int64 {
+	if r == nil || r.ID == nil {
+		return 0
 	}
-	return *r.CommentsURL
+	return *r.ID
 }
 
-// GetCommitsURL returns the CommitsURL field if it's non-nil, zero value otherwise.
-func (r *Repository) GetCommitsURL() string {
-	if r == nil || r.CommitsURL == nil {
+// GetNodeID returns the NodeID field if it's non-nil, zero value otherwise.
+func (r *RepoStatus) GetNodeID() string {
+	if r == nil || r.NodeID == nil {
 		return ""
 	}
-	return *r.CommitsURL
+	return *r.NodeID
 }
 
-// GetCompareURL returns the CompareURL field if it's non-nil, zero value otherwise.
-func (r *Repository) GetCompareURL() string {
-	if r == nil || r.CompareURL == nil {
+// GetState returns the State field if it's non-nil, zero value otherwise.
+func (r *RepoStatus) GetState() string {
+	if r == nil || r.State == nil {
 		return ""
 	}
-	return *r.CompareURL
+	return *r.State
 }
 
-// GetContentsURL returns the ContentsURL field if it's non-nil, zero value otherwise.
-func (r *Repository) GetContentsURL() string {
-	if r == nil || r.ContentsURL == nil {
+// GetTargetURL returns the TargetURL field if it's non-nil, zero value otherwise.
+func (r *RepoStatus) GetTargetURL() string {
+	if r == nil || r.TargetURL == nil {
 		return ""
 	}
-	return *r.ContentsURL
+	return *r.TargetURL
 }
 
-// GetContributorsURL returns the ContributorsURL field if it's non-nil, zero value otherwise.
-func (r *Repository) GetContributorsURL() string {
-	if r == nil || r.ContributorsURL == nil {
+// GetUpdatedAt returns the UpdatedAt field if it's non-nil, zero value otherwise.
+func (r *RepoStatus) GetUpdatedAt() time.Time {
+	if r == nil || r.UpdatedAt == nil {
+		return time.Time{}
+	}
+	return *r.UpdatedAt
+}
+
+// GetURL returns the URL field if it's non-nil, zero value otherwise.
+func (r *RepoStatus) GetURL() string {
+	if r == nil || r.URL == nil {
 		return ""
 	}
-	return *r.ContributorsURL
+	return *r.URL
 }
 
-// GetCreatedAt returns the CreatedAt field if it's non-nil, zero value otherwise.
-func (r *Repository) GetCreatedAt() Timestamp {
-	if r == nil || r.CreatedAt == nil {
-		return Timestamp{}
+// GetStrict returns the Strict field if it's non-nil, zero value otherwise.
+func (r *RequiredStatusChecksRequest) GetStrict() bool {
+	if r == nil || r.Strict == nil {
+		return false
 	}
-	return *r.CreatedAt
+	return *r.Strict
 }
 
-// GetDefaultBranch returns the DefaultBranch field if it's non-nil, zero value otherwise.
-func (r *Repository) GetDefaultBranch() string {
-	if r == nil || r.DefaultBranch == nil {
-		return ""
+// GetSSO returns the SSO field.
+func (r *Response) GetSSO() *SSOStatus {
+	if r == nil {
+		return nil
 	}
-	return *r.DefaultBranch
+	return r.SSO
 }
 
-// GetDeleteBranchOnMerge returns the DeleteBranchOnMerge field if it's non-nil, zero value otherwise.
-func (r *Repository) GetDeleteBranchOnMerge() bool {
-	if r == nil || r.DeleteBranchOnMerge == nil {
-		return false
+// GetPullRequest returns the PullRequest field.
+func (r *ReviewersChange) GetPullRequest() *PullRequest {
+	if r == nil {
+		return nil
 	}
-	return *r.DeleteBranchOnMerge
+	return r.PullRequest
 }
 
-// GetDeploymentsURL returns the DeploymentsURL field if it's non-nil, zero value otherwise.
-func (r *Repository) GetDeploymentsURL() string {
-	if r == nil || r.DeploymentsURL == nil {
+// GetNodeID returns the NodeID field if it's non-nil, zero value otherwise.
+func (r *ReviewersRequest) GetNodeID() string {
+	if r == nil || r.NodeID == nil {
 		return ""
 	}
-	return *r.DeploymentsURL
+	return *r.NodeID
 }
 
-// GetDescription returns the Description field if it's non-nil, zero value otherwise.
-func (r *Repository) GetDescription() string {
-	if r == nil || r.Description == nil {
+// GetReason returns the Reason field if it's non-nil, zero value otherwise.
+func (r *ReviewPersonalAccessTokenRequestOptions) GetReason() string {
+	if r == nil || r.Reason == nil {
 		return ""
 	}
-	return *r.Description
+	return *r.Reason
 }
 
-// GetDisabled returns the Disabled field if it's non-nil, zero value otherwise.
-func (r *Repository) GetDisabled() bool {
-	if r == nil || r.Disabled == nil {
-		return false
+// GetReason returns the Reason field if it's non-nil, zero value otherwise.
+func (r *ReviewPersonalAccessTokenRequestsOptions) GetReason() string {
+	if r == nil || r.Reason == nil {
+		return ""
 	}
-	return *r.Disabled
+	return *r.Reason
 }
 
-// GetDownloadsURL returns the DownloadsURL field if it's non-nil, zero value otherwise.
-func (r *Repository) GetDownloadsURL() string {
-	if r == nil || r.DownloadsURL == nil {
+// GetDetails returns the Details field if it's non-nil, zero value otherwise.
+func (r *RuleEvaluation) GetDetails() string {
+	if r == nil || r.Details == nil {
 		return ""
 	}
-	return *r.DownloadsURL
+	return *r.Details
 }
 
-// GetEventsURL returns the EventsURL field if it's non-nil, zero value otherwise.
-func (r *Repository) GetEventsURL() string {
-	if r == nil || r.EventsURL == nil {
+// GetEnforcement returns the Enforcement field if it's non-nil, zero value otherwise.
+func (r *RuleEvaluation) GetEnforcement() string {
+	if r == nil || r.Enforcement == nil {
 		return ""
 	}
-	return *r.EventsURL
+	return *r.Enforcement
 }
 
-// GetFork returns the Fork field if it's non-nil, zero value otherwise.
-func (r *Repository) GetFork() bool {
-	if r == nil || r.Fork == nil {
-		return false
+// GetResult returns the Result field if it's non-nil, zero value otherwise.
+func (r *RuleEvaluation) GetResult() string {
+	if r == nil || r.Result == nil {
+		return ""
 	}
-	return *r.Fork
+	return *r.Result
 }
 
-// GetForksCount returns the ForksCount field if it's non-nil, zero value otherwise.
-func (r *Repository) GetForksCount() int {
-	if r == nil || r.ForksCount == nil {
-		return 0
+// GetRuleSource returns the RuleSource field.
+func (r *RuleEvaluation) GetRuleSource() *RuleEvaluationSource {
+	if r == nil {
+		return nil
 	}
-	return *r.ForksCount
+	return r.RuleSource
 }
 
-// GetForksURL returns the ForksURL field if it's non-nil, zero value otherwise.
-func (r *Repository) GetForksURL() string {
-	if r == nil || r.ForksURL == nil {
+// GetRuleType returns the RuleType field if it's non-nil, zero value otherwise.
+func (r *RuleEvaluation) GetRuleType() string {
+	if r == nil || r.RuleType == nil {
 		return ""
 	}
-	return *r.ForksURL
+	return *r.RuleType
 }
 
-// GetFullName returns the FullName field if it's non-nil, zero value otherwise.
-func (r *Repository) GetFullName() string {
-	if r == nil || r.FullName == nil {
-		return ""
+// GetID returns the ID field if it's non-nil, zero value otherwise.
+func (r *RuleEvaluationSource) GetID() int64 {
+	if r == nil || r.ID == nil {
+		return 0
 	}
-	return *r.FullName
+	return *r.ID
 }
 
-// GetGitCommitsURL returns the GitCommitsURL field if it's non-nil, zero value otherwise.
-func (r *Repository) GetGitCommitsURL() string {
-	if r == nil || r.GitCommitsURL == nil {
+// GetName returns the Name field if it's non-nil, zero value otherwise.
+func (r *RuleEvaluationSource) GetName() string {
+	if r == nil || r.Name == nil {
 		return ""
 	}
-	return *r.GitCommitsURL
+	return *r.Name
 }
 
-// GetGitignoreTemplate returns the GitignoreTemplate field if it's non-nil, zero value otherwise.
-func (r *Repository) GetGitignoreTemplate() string {
-	if r == nil || r.GitignoreTemplate == nil {
+// GetType returns the Type field if it's non-nil, zero value otherwise.
+func (r *RuleEvaluationSource) GetType() string {
+	if r == nil || r.Type == nil {
 		return ""
 	}
-	return *r.GitignoreTemplate
+	return *r.Type
 }
 
-// GetGitRefsURL returns the GitRefsURL field if it's non-nil, zero value otherwise.
-func (r *Repository) GetGitRefsURL() string {
-	if r == nil || r.GitRefsURL == nil {
+// GetName returns the Name field if it's non-nil, zero value otherwise.
+func (r *RulePatternParameters) GetName() string {
+	if r == nil || r.Name == nil {
 		return ""
 	}
-	return *r.GitRefsURL
+	return *r.Name
 }
 
-// GetGitTagsURL returns the GitTagsURL field if it's non-nil, zero value otherwise.
-func (r *Repository) GetGitTagsURL() string {
-	if r == nil || r.GitTagsURL == nil {
-		return ""
+// GetNegate returns the Negate field if it's non-nil, zero value otherwise.
+func (r *RulePatternParameters) GetNegate() bool {
+	if r == nil || r.Negate == nil {
+		return false
 	}
-	return *r.GitTagsURL
+	return *r.Negate
 }
 
-// GetGitURL returns the GitURL field if it's non-nil, zero value otherwise.
-func (r *Repository) GetGitURL() string {
-	if r == nil || r.GitURL == nil {
-		return ""
+// GetConditions returns the Conditions field.
+func (r *Ruleset) GetConditions() *RulesetConditions {
+	if r == nil {
+		return nil
 	}
-	return *r.GitURL
+	return r.Conditions
 }
 
-// GetHasDownloads returns the HasDownloads field if it's non-nil, zero value otherwise.
-func (r *Repository) GetHasDownloads() bool {
-	if r == nil || r.HasDownloads == nil {
-		return false
+// GetID returns the ID field if it's non-nil, zero value otherwise.
+func (r *Ruleset) GetID() int64 {
+	if r == nil || r.ID == nil {
+		return 0
 	}
-	return *r.HasDownloads
+	return *r.ID
 }
 
-// GetHasIssues returns the HasIssues field if it's non-nil, zero value otherwise.
-func (r *Repository) GetHasIssues() bool {
-	if r == nil || r.HasIssues == nil {
-		return false
+// GetLinks returns the Links field.
+func (r *Ruleset) GetLinks() *RulesetLinks {
+	if r == nil {
+		return nil
 	}
-	return *r.HasIssues
+	return r.Links
 }
 
-// GetHasPages returns the HasPages field if it's non-nil, zero value otherwise.
-func (r *Repository) GetHasPages() bool {
-	if r == nil || r.HasPages == nil {
-		return false
+// GetNodeID returns the NodeID field if it's non-nil, zero value otherwise.
+func (r *Ruleset) GetNodeID() string {
+	if r == nil || r.NodeID == nil {
+		return ""
 	}
-	return *r.HasPages
+	return *r.NodeID
 }
 
-// GetHasProjects returns the HasProjects field if it's non-nil, zero value otherwise.
-func (r *Repository) GetHasProjects() bool {
-	if r == nil || r.HasProjects == nil {
-		return false
+// GetSource returns the Source field if it's non-nil, zero value otherwise.
+func (r *Ruleset) GetSource() string {
+	if r == nil || r.Source == nil {
+		return ""
 	}
-	return *r.HasProjects
+	return *r.Source
 }
 
-// GetHasWiki returns the HasWiki field if it's non-nil, zero value otherwise.
-func (r *Repository) GetHasWiki() bool {
-	if r == nil || r.HasWiki == nil {
-		return false
+// GetSourceType returns the SourceType field if it's non-nil, zero value otherwise.
+func (r *Ruleset) GetSourceType() string {
+	if r == nil || r.SourceType == nil {
+		return ""
 	}
-	return *r.HasWiki
+	return *r.SourceType
 }
 
-// GetHomepage returns the Homepage field if it's non-nil, zero value otherwise.
-func (r *Repository) GetHomepage() string {
-	if r == nil || r.Homepage == nil {
+// GetTarget returns the Target field if it's non-nil, zero value otherwise.
+func (r *Ruleset) GetTarget() string {
+	if r == nil || r.Target == nil {
 		return ""
 	}
-	return *r.Homepage
+	return *r.Target
+}
+
+// GetRefName returns the RefName field.
+func (r *RulesetConditions) GetRefName() *RulesetRefConditionParameters {
+	if r == nil {
+		return nil
+	}
+	return r.RefName
+}
+
+// GetRepositoryID returns the RepositoryID field.
+func (r *RulesetConditions) GetRepositoryID() *RulesetRepositoryIDsConditionParameters {
+	if r == nil {
+		return nil
+	}
+	return r.RepositoryID
 }
 
-// GetHooksURL returns the HooksURL field if it's non-nil, zero value otherwise.
-func (r *Repository) GetHooksURL() string {
-	if r == nil || r.HooksURL == nil {
-		return ""
+// GetRepositoryName returns the RepositoryName field.
+func (r *RulesetConditions) GetRepositoryName() *RulesetRepositoryNamesConditionParameters {
+	if r == nil {
+		return nil
 	}
-	return *r.HooksURL
+	return r.RepositoryName
 }
 
-// GetHTMLURL returns the HTMLURL field if it's non-nil, zero value otherwise.
-func (r *Repository) GetHTMLURL() string {
-	if r == nil || r.HTMLURL == nil {
-		return ""
+// GetRepositoryProperty returns the RepositoryProperty field.
+func (r *RulesetConditions) GetRepositoryProperty() *RulesetRepositoryPropertyConditionParameters {
+	if r == nil {
+		return nil
 	}
-	return *r.HTMLURL
+	return r.RepositoryProperty
 }
 
-// GetID returns the ID field if it's non-nil, zero value otherwise.
-func (r *Repository) GetID() int64 {
-	if r == nil || r.ID == nil {
-		return 0
+// GetHRef returns the HRef field if it's non-nil, zero value otherwise.
+func (r *RulesetLink) GetHRef() string {
+	if r == nil || r.HRef == nil {
+		return ""
 	}
-	return *r.ID
+	return *r.HRef
 }
 
-// GetIssueCommentURL returns the IssueCommentURL field if it's non-nil, zero value otherwise.
-func (r *Repository) GetIssueCommentURL() string {
-	if r == nil || r.IssueCommentURL == nil {
-		return ""
+// GetSelf returns the Self field.
+func (r *RulesetLinks) GetSelf() *RulesetLink {
+	if r == nil {
+		return nil
 	}
-	return *r.IssueCommentURL
+	return r.Self
 }
 
-// GetIssueEventsURL returns the IssueEventsURL field if it's non-nil, zero value otherwise.
-func (r *Repository) GetIssueEventsURL() string {
-	if r == nil || r.IssueEventsURL == nil {
-		return ""
+// GetProtected returns the Protected field if it's non-nil, zero value otherwise.
+func (r *RulesetRepositoryNamesConditionParameters) GetProtected() bool {
+	if r == nil || r.Protected == nil {
+		return false
 	}
-	return *r.IssueEventsURL
+	return *r.Protected
 }
 
-// GetIssuesURL returns the IssuesURL field if it's non-nil, zero value otherwise.
-func (r *Repository) GetIssuesURL() string {
-	if r == nil || r.IssuesURL == nil {
+// GetSource returns the Source field if it's non-nil, zero value otherwise.
+func (r *RulesetRepositoryPropertyTargetParameters) GetSource() string {
+	if r == nil || r.Source == nil {
 		return ""
 	}
-	return *r.IssuesURL
+	return *r.Source
 }
 
-// GetIsTemplate returns the IsTemplate field if it's non-nil, zero value otherwise.
-func (r *Repository) GetIsTemplate() bool {
-	if r == nil || r.IsTemplate == nil {
-		return false
+// GetActorID returns the ActorID field if it's non-nil, zero value otherwise.
+func (r *RuleSuite) GetActorID() int64 {
+	if r == nil || r.ActorID == nil {
+		return 0
 	}
-	return *r.IsTemplate
+	return *r.ActorID
 }
 
-// GetKeysURL returns the KeysURL field if it's non-nil, zero value otherwise.
-func (r *Repository) GetKeysURL() string {
-	if r == nil || r.KeysURL == nil {
+// GetActorName returns the ActorName field if it's non-nil, zero value otherwise.
+func (r *RuleSuite) GetActorName() string {
+	if r == nil || r.ActorName == nil {
 		return ""
 	}
-	return *r.KeysURL
+	return *r.ActorName
 }
 
-// GetLabelsURL returns the LabelsURL field if it's non-nil, zero value otherwise.
-func (r *Repository) GetLabelsURL() string {
-	if r == nil || r.LabelsURL == nil {
+// GetAfterSHA returns the AfterSHA field if it's non-nil, zero value otherwise.
+func (r *RuleSuite) GetAfterSHA() string {
+	if r == nil || r.AfterSHA == nil {
 		return ""
 	}
-	return *r.LabelsURL
+	return *r.AfterSHA
 }
 
-// GetLanguage returns the Language field if it's non-nil, zero value otherwise.
-func (r *Repository) GetLanguage() string {
-	if r == nil || r.Language == nil {
+// GetBeforeSHA returns the BeforeSHA field if it's non-nil, zero value otherwise.
+func (r *RuleSuite) GetBeforeSHA() string {
+	if r == nil || r.BeforeSHA == nil {
 		return ""
 	}
-	return *r.Language
+	return *r.BeforeSHA
 }
 
-// GetLanguagesURL returns the LanguagesURL field if it's non-nil, zero value otherwise.
-func (r *Repository) GetLanguagesURL() string {
-	if r == nil || r.LanguagesURL == nil {
+// GetEvaluationResult returns the EvaluationResult field if it's non-nil, zero value otherwise.
+func (r *RuleSuite) GetEvaluationResult() string {
+	if r == nil || r.EvaluationResult == nil {
 		return ""
 	}
-	return *r.LanguagesURL
+	return *r.EvaluationResult
 }
 
-// GetLicense returns the License field.
-func (r *Repository) GetLicense() *License {
-	if r == nil {
-		return nil
+// GetID returns the ID field if it's non-nil, zero value otherwise.
+func (r *RuleSuite) GetID() int64 {
+	if r == nil || r.ID == nil {
+		return 0
 	}
-	return r.License
+	return *r.ID
 }
 
-// GetLicenseTemplate returns the LicenseTemplate field if it's non-nil, zero value otherwise.
-func (r *Repository) GetLicenseTemplate() string {
-	if r == nil || r.LicenseTemplate == nil {
-		return ""
+// GetPushedAt returns the PushedAt field if it's non-nil, zero value otherwise.
+func (r *RuleSuite) GetPushedAt() Timestamp {
+	if r == nil || r.PushedAt == nil {
+		return Timestamp{}
 	}
-	return *r.LicenseTemplate
+	return *r.PushedAt
 }
 
-// GetMasterBranch returns the MasterBranch field if it's non-nil, zero value otherwise.
-func (r *Repository) GetMasterBranch() string {
-	if r == nil || r.MasterBranch == nil {
+// GetRef returns the Ref field if it's non-nil, zero value otherwise.
+func (r *RuleSuite) GetRef() string {
+	if r == nil || r.Ref == nil {
 		return ""
 	}
-	return *r.MasterBranch
+	return *r.Ref
 }
 
-// GetMergesURL returns the MergesURL field if it's non-nil, zero value otherwise.
-func (r *Repository) GetMergesURL() string {
-	if r == nil || r.MergesURL == nil {
-		return ""
+// GetRepositoryID returns the RepositoryID field if it's non-nil, zero value otherwise.
+func (r *RuleSuite) GetRepositoryID() int64 {
+	if r == nil || r.RepositoryID == nil {
+		return 0
 	}
-	return *r.MergesURL
+	return *r.RepositoryID
 }
 
-// GetMilestonesURL returns the MilestonesURL field if it's non-nil, zero value otherwise.
-func (r *Repository) GetMilestonesURL() string {
-	if r == nil || r.MilestonesURL == nil {
+// GetRepositoryName returns the RepositoryName field if it's non-nil, zero value otherwise.
+func (r *RuleSuite) GetRepositoryName() string {
+	if r == nil || r.RepositoryName == nil {
 		return ""
 	}
-	return *r.MilestonesURL
+	return *r.RepositoryName
 }
 
-// GetMirrorURL returns the MirrorURL field if it's non-nil, zero value otherwise.
-func (r *Repository) GetMirrorURL() string {
-	if r == nil || r.MirrorURL == nil {
+// GetResult returns the Result field if it's non-nil, zero value otherwise.
+func (r *RuleSuite) GetResult() string {
+	if r == nil || r.Result == nil {
 		return ""
 	}
-	return *r.MirrorURL
+	return *r.Result
 }
 
-// GetName returns the Name field if it's non-nil, zero value otherwise.
-func (r *Repository) GetName() string {
-	if r == nil || r.Name == nil {
-		return ""
+// GetBusy returns the Busy field if it's non-nil, zero value otherwise.
+func (r *Runner) GetBusy() bool {
+	if r == nil || r.Busy == nil {
+		return false
 	}
-	return *r.Name
+	return *r.Busy
 }
 
-// GetNetworkCount returns the NetworkCount field if it's non-nil, zero value otherwise.
-func (r *Repository) GetNetworkCount() int {
-	if r == nil || r.NetworkCount == nil {
+// GetID returns the ID field if it's non-nil, zero value otherwise.
+func (r *Runner) GetID() int64 {
+	if r == nil || r.ID == nil {
 		return 0
 	}
-	return *r.NetworkCount
+	return *r.ID
 }
 
-// GetNodeID returns the NodeID field if it's non-nil, zero value otherwise.
-func (r *Repository) GetNodeID() string {
-	if r == nil || r.NodeID == nil {
+// GetName returns the Name field if it's non-nil, zero value otherwise.
+func (r *Runner) GetName() string {
+	if r == nil || r.Name == nil {
 		return ""
 	}
-	return *r.NodeID
+	return *r.Name
 }
 
-// GetNotificationsURL returns the NotificationsURL field if it's non-nil, zero value otherwise.
-func (r *Repository) GetNotificationsURL() string {
-	if r == nil || r.NotificationsURL == nil {
+// GetOS returns the OS field if it's non-nil, zero value otherwise.
+func (r *Runner) GetOS() string {
+	if r == nil || r.OS == nil {
 		return ""
 	}
-	return *r.NotificationsURL
+	return *r.OS
 }
 
-// GetOpenIssuesCount returns the OpenIssuesCount field if it's non-nil, zero value otherwise.
-func (r *Repository) GetOpenIssuesCount() int {
-	if r == nil || r.OpenIssuesCount == nil {
-		return 0
+// GetStatus returns the Status field if it's non-nil, zero value otherwise.
+func (r *Runner) GetStatus() string {
+	if r == nil || r.Status == nil {
+		return ""
 	}
-	return *r.OpenIssuesCount
+	return *r.Status
 }
 
-// GetOrganization returns the Organization field.
-func (r *Repository) GetOrganization() *Organization {
-	if r == nil {
-		return nil
+// GetArchitecture returns the Architecture field if it's non-nil, zero value otherwise.
+func (r *RunnerApplicationDownload) GetArchitecture() string {
+	if r == nil || r.Architecture == nil {
+		return ""
 	}
-	return r.Organization
+	return *r.Architecture
 }
 
-// GetOwner returns the Owner field.
-func (r *Repository) GetOwner() *User {
-	if r == nil {
-		return nil
+// GetDownloadURL returns the DownloadURL field if it's non-nil, zero value otherwise.
+func (r *RunnerApplicationDownload) GetDownloadURL() string {
+	if r == nil || r.DownloadURL == nil {
+		return ""
 	}
-	return r.Owner
+	return *r.DownloadURL
 }
 
-// GetParent returns the Parent field.
-func (r *Repository) GetParent() *Repository {
-	if r == nil {
-		return nil
+// GetFilename returns the Filename field if it's non-nil, zero value otherwise.
+func (r *RunnerApplicationDownload) GetFilename() string {
+	if r == nil || r.Filename == nil {
+		return ""
 	}
-	return r.Parent
+	return *r.Filename
 }
 
-// GetPermissions returns the Permissions field if it's non-nil, zero value otherwise.
-func (r *Repository) GetPermissions() map[string]bool {
-	if r == nil || r.Permissions == nil {
-		return map[string]bool{}
+// GetOS returns the OS field if it's non-nil, zero value otherwise.
+func (r *RunnerApplicationDownload) GetOS() string {
+	if r == nil || r.OS == nil {
+		return ""
 	}
-	return *r.Permissions
+	return *r.OS
 }
 
-// GetPrivate returns the Private field if it's non-nil, zero value otherwise.
-func (r *Repository) GetPrivate() bool {
-	if r == nil || r.Private == nil {
-		return false
+// GetID returns the ID field if it's non-nil, zero value otherwise.
+func (r *RunnerLabels) GetID() int64 {
+	if r == nil || r.ID == nil {
+		return 0
 	}
-	return *r.Private
+	return *r.ID
 }
 
-// GetPullsURL returns the PullsURL field if it's non-nil, zero value otherwise.
-func (r *Repository) GetPullsURL() string {
-	if r == nil || r.PullsURL == nil {
+// GetName returns the Name field if it's non-nil, zero value otherwise.
+func (r *RunnerLabels) GetName() string {
+	if r == nil || r.Name == nil {
 		return ""
 	}
-	return *r.PullsURL
-}
-
-// GetPushedAt returns the PushedAt field if it's non-nil, zero value otherwise.
-func (r *Repository) GetPushedAt() Timestamp {
-	if r == nil || r.PushedAt == nil {
-		return Timestamp{}
-	}
-	return *r.PushedAt
+	return *r.Name
 }
 
-// GetReleasesURL returns the ReleasesURL field if it's non-nil, zero value otherwise.
-func (r *Repository) GetReleasesURL() string {
-	if r == nil || r.ReleasesURL == nil {
+// GetType returns the Type field if it's non-nil, zero value otherwise.
+func (r *RunnerLabels) GetType() string {
+	if r == nil || r.Type == nil {
 		return ""
 	}
-	return *r.ReleasesURL
+	return *r.Type
 }
 
-// GetSize returns the Size field if it's non-nil, zero value otherwise.
-func (r *Repository) GetSize() int {
-	if r == nil || r.Size == nil {
-		return 0
+// GetID returns the ID field if it's non-nil, zero value otherwise.
+func (s *SARIFID) GetID() string {
+	if s == nil || s.ID == nil {
+		return ""
 	}
-	return *r.Size
+	return *s.ID
 }
 
-// GetSource returns the Source field.
-func (r *Repository) GetSource() *Repository {
-	if r == nil {
-		return nil
+// GetURL returns the URL field if it's non-nil, zero value otherwise.
+func (s *SARIFID) GetURL() string {
+	if s == nil || s.URL == nil {
+		return ""
 	}
-	return r.Source
+	return *s.URL
 }
 
-// GetSSHURL returns the SSHURL field if it's non-nil, zero value otherwise.
-func (r *Repository) GetSSHURL() string {
-	if r == nil || r.SSHURL == nil {
+// GetAnalysesURL returns the AnalysesURL field if it's non-nil, zero value otherwise.
+func (s *SARIFUpload) GetAnalysesURL() string {
+	if s == nil || s.AnalysesURL == nil {
 		return ""
 	}
-	return *r.SSHURL
+	return *s.AnalysesURL
 }
 
-// GetStargazersCount returns the StargazersCount field if it's non-nil, zero value otherwise.
-func (r *Repository) GetStargazersCount() int {
-	if r == nil || r.StargazersCount == nil {
-		return 0
+// GetProcessingStatus returns the ProcessingStatus field if it's non-nil, zero value otherwise.
+func (s *SARIFUpload) GetProcessingStatus() string {
+	if s == nil || s.ProcessingStatus == nil {
+		return ""
 	}
-	return *r.StargazersCount
+	return *s.ProcessingStatus
 }
 
-// GetStargazersURL returns the StargazersURL field if it's non-nil, zero value otherwise.
-func (r *Repository) GetStargazersURL() string {
-	if r == nil || r.StargazersURL == nil {
-		return ""
+// GetStartedAt returns the StartedAt field if it's non-nil, zero value otherwise.
+func (s *SARIFUploadOptions) GetStartedAt() Timestamp {
+	if s == nil || s.StartedAt == nil {
+		return Timestamp{}
 	}
-	return *r.StargazersURL
+	return *s.StartedAt
 }
 
-// GetStatusesURL returns the StatusesURL field if it's non-nil, zero value otherwise.
-func (r *Repository) GetStatusesURL() string {
-	if r == nil || r.StatusesURL == nil {
-		return ""
+// GetSBOM returns the SBOM field.
+func (s *SBOM) GetSBOM() *SBOMInfo {
+	if s == nil {
+		return nil
 	}
-	return *r.StatusesURL
+	return s.SBOM
 }
 
-// GetSubscribersCount returns the SubscribersCount field if it's non-nil, zero value otherwise.
-func (r *Repository) GetSubscribersCount() int {
-	if r == nil || r.SubscribersCount == nil {
-		return 0
+// GetCreationInfo returns the CreationInfo field.
+func (s *SBOMInfo) GetCreationInfo() *CreationInfo {
+	if s == nil {
+		return nil
 	}
-	return *r.SubscribersCount
+	return s.CreationInfo
 }
 
-// GetSubscribersURL returns the SubscribersURL field if it's non-nil, zero value otherwise.
-func (r *Repository) GetSubscribersURL() string {
-	if r == nil || r.SubscribersURL == nil {
+// GetDataLicense returns the DataLicense field if it's non-nil, zero value otherwise.
+func (s *SBOMInfo) GetDataLicense() string {
+	if s == nil || s.DataLicense == nil {
 		return ""
 	}
-	return *r.SubscribersURL
+	return *s.DataLicense
 }
 
-// GetSubscriptionURL returns the SubscriptionURL field if it's non-nil, zero value otherwise.
-func (r *Repository) GetSubscriptionURL() string {
-	if r == nil || r.SubscriptionURL == nil {
+// GetDocumentNamespace returns the DocumentNamespace field if it's non-nil, zero value otherwise.
+func (s *SBOMInfo) GetDocumentNamespace() string {
+	if s == nil || s.DocumentNamespace == nil {
 		return ""
 	}
-	return *r.SubscriptionURL
+	return *s.DocumentNamespace
 }
 
-// GetSVNURL returns the SVNURL field if it's non-nil, zero value otherwise.
-func (r *Repository) GetSVNURL() string {
-	if r == nil || r.SVNURL == nil {
+// GetName returns the Name field if it's non-nil, zero value otherwise.
+func (s *SBOMInfo) GetName() string {
+	if s == nil || s.Name == nil {
 		return ""
 	}
-	return *r.SVNURL
+	return *s.Name
 }
 
-// GetTagsURL returns the TagsURL field if it's non-nil, zero value otherwise.
-func (r *Repository) GetTagsURL() string {
-	if r == nil || r.TagsURL == nil {
+// GetSPDXID returns the SPDXID field if it's non-nil, zero value otherwise.
+func (s *SBOMInfo) GetSPDXID() string {
+	if s == nil || s.SPDXID == nil {
 		return ""
 	}
-	return *r.TagsURL
+	return *s.SPDXID
 }
 
-// GetTeamID returns the TeamID field if it's non-nil, zero value otherwise.
-func (r *Repository) GetTeamID() int64 {
-	if r == nil || r.TeamID == nil {
-		return 0
+// GetSPDXVersion returns the SPDXVersion field if it's non-nil, zero value otherwise.
+func (s *SBOMInfo) GetSPDXVersion() string {
+	if s == nil || s.SPDXVersion == nil {
+		return ""
 	}
-	return *r.TeamID
+	return *s.SPDXVersion
 }
 
-// GetTeamsURL returns the TeamsURL field if it's non-nil, zero value otherwise.
-func (r *Repository) GetTeamsURL() string {
-	if r == nil || r.TeamsURL == nil {
+// GetRelatedSPDXElement returns the RelatedSPDXElement field if it's non-nil, zero value otherwise.
+func (s *SBOMRelationship) GetRelatedSPDXElement() string {
+	if s == nil || s.RelatedSPDXElement == nil {
 		return ""
 	}
-	return *r.TeamsURL
+	return *s.RelatedSPDXElement
 }
 
-// GetTemplateRepository returns the TemplateRepository field.
-func (r *Repository) GetTemplateRepository() *Repository {
-	if r == nil {
-		return nil
+// GetRelationshipType returns the RelationshipType field if it's non-nil, zero value otherwise.
+func (s *SBOMRelationship) GetRelationshipType() string {
+	if s == nil || s.RelationshipType == nil {
+		return ""
 	}
-	return r.TemplateRepository
+	return *s.RelationshipType
 }
 
-// GetTreesURL returns the TreesURL field if it's non-nil, zero value otherwise.
-func (r *Repository) GetTreesURL() string {
-	if r == nil || r.TreesURL == nil {
+// GetSPDXElementID returns the SPDXElementID field if it's non-nil, zero value otherwise.
+func (s *SBOMRelationship) GetSPDXElementID() string {
+	if s == nil || s.SPDXElementID == nil {
 		return ""
 	}
-	return *r.TreesURL
+	return *s.SPDXElementID
 }
 
-// GetUpdatedAt returns the UpdatedAt field if it's non-nil, zero value otherwise.
-func (r *Repository) GetUpdatedAt() Timestamp {
-	if r == nil || r.UpdatedAt == nil {
-		return Timestamp{}
+// GetAnalysisKey returns the AnalysisKey field if it's non-nil, zero value otherwise.
+func (s *ScanningAnalysis) GetAnalysisKey() string {
+	if s == nil || s.AnalysisKey == nil {
+		return ""
 	}
-	return *r.UpdatedAt
+	return *s.AnalysisKey
 }
 
-// GetURL returns the URL field if it's non-nil, zero value otherwise.
-func (r *Repository) GetURL() string {
-	if r == nil || r.URL == nil {
+// GetCategory returns the Category field if it's non-nil, zero value otherwise.
+func (s *ScanningAnalysis) GetCategory() string {
+	if s == nil || s.Category == nil {
 		return ""
 	}
-	return *r.URL
+	return *s.Category
 }
 
-// GetVisibility returns the Visibility field if it's non-nil, zero value otherwise.
-func (r *Repository) GetVisibility() string {
-	if r == nil || r.Visibility == nil {
+// GetCommitSHA returns the CommitSHA field if it's non-nil, zero value otherwise.
+func (s *ScanningAnalysis) GetCommitSHA() string {
+	if s == nil || s.CommitSHA == nil {
 		return ""
 	}
-	return *r.Visibility
+	return *s.CommitSHA
 }
 
-// GetWatchersCount returns the WatchersCount field if it's non-nil, zero value otherwise.
-func (r *Repository) GetWatchersCount() int {
-	if r == nil || r.WatchersCount == nil {
-		return 0
+// GetCreatedAt returns the CreatedAt field if it's non-nil, zero value otherwise.
+func (s *ScanningAnalysis) GetCreatedAt() Timestamp {
+	if s == nil || s.CreatedAt == nil {
+		return Timestamp{}
 	}
-	return *r.WatchersCount
+	return *s.CreatedAt
 }
 
-// GetBody returns the Body field if it's non-nil, zero value otherwise.
-func (r *RepositoryComment) GetBody() string {
-	if r == nil || r.Body == nil {
-		return ""
+// GetDeletable returns the Deletable field if it's non-nil, zero value otherwise.
+func (s *ScanningAnalysis) GetDeletable() bool {
+	if s == nil || s.Deletable == nil {
+		return false
 	}
-	return *r.Body
+	return *s.Deletable
 }
 
-// GetCommitID returns the CommitID field if it's non-nil, zero value otherwise.
-func (r *RepositoryComment) GetCommitID() string {
-	if r == nil || r.CommitID == nil {
+// GetEnvironment returns the Environment field if it's non-nil, zero value otherwise.
+func (s *ScanningAnalysis) GetEnvironment() string {
+	if s == nil || s.Environment == nil {
 		return ""
 	}
-	return *r.CommitID
-}
-
-// GetCreatedAt returns the CreatedAt field if it's non-nil, zero value otherwise.
-func (r *RepositoryComment) GetCreatedAt() time.Time {
-	if r == nil || r.CreatedAt == nil {
-		return time.Time{}
-	}
-	return *r.CreatedAt
+	return *s.Environment
 }
 
-// GetHTMLURL returns the HTMLURL field if it's non-nil, zero value otherwise.
-func (r *RepositoryComment) GetHTMLURL() string {
-	if r == nil || r.HTMLURL == nil {
+// GetError returns the Error field if it's non-nil, zero value otherwise.
+func (s *ScanningAnalysis) GetError() string {
+	if s == nil || s.Error == nil {
 		return ""
 	}
-	return *r.HTMLURL
+	return *s.Error
 }
 
 // GetID returns the ID field if it's non-nil, zero value otherwise.
-func (r *RepositoryComment) GetID() int64 {
-	if r == nil || r.ID == nil {
+func (s *ScanningAnalysis) GetID() int64 {
+	if s == nil || s.ID == nil {
 		return 0
 	}
-	return *r.ID
+	return *s.ID
 }
 
-// GetNodeID returns the NodeID field if it's non-nil, zero value otherwise.
-func (r *RepositoryComment) GetNodeID() string {
-	if r == nil || r.NodeID == nil {
+// GetRef returns the Ref field if it's non-nil, zero value otherwise.
+func (s *ScanningAnalysis) GetRef() string {
+	if s == nil || s.Ref == nil {
 		return ""
 	}
-	return *r.NodeID
+	return *s.Ref
 }
 
-// GetPath returns the Path field if it's non-nil, zero value otherwise.
-func (r *RepositoryComment) GetPath() string {
-	if r == nil || r.Path == nil {
-		return ""
+// GetResultsCount returns the ResultsCount field if it's non-nil, zero value otherwise.
+func (s *ScanningAnalysis) GetResultsCount() int {
+	if s == nil || s.ResultsCount == nil {
+		return 0
 	}
-	return *r.Path
+	return *s.ResultsCount
 }
 
-// GetPosition returns the Position field if it's non-nil, zero value otherwise.
-func (r *RepositoryComment) GetPosition() int {
-	if r == nil || r.Position == nil {
+// GetRulesCount returns the RulesCount field if it's non-nil, zero value otherwise.
+func (s *ScanningAnalysis) GetRulesCount() int {
+	if s == nil || s.RulesCount == nil {
 		return 0
 	}
-	return *r.Position
+	return *s.RulesCount
 }
 
-// GetReactions returns the Reactions field.
-func (r *RepositoryComment) GetReactions() *Reactions {
-	if r == nil {
-		return nil
+// GetSARIFID returns the SARIFID field if it's non-nil, zero value otherwise.
+func (s *ScanningAnalysis) GetSARIFID() string {
+	if s == nil || s.SARIFID == nil {
+		return ""
 	}
-	return r.Reactions
+	return *s.SARIFID
 }
 
-// GetUpdatedAt returns the UpdatedAt field if it's non-nil, zero value otherwise.
-func (r *RepositoryComment) GetUpdatedAt() time.Time {
-	if r == nil || r.UpdatedAt == nil {
-		return time.Time{}
+// GetTool returns the Tool field.
+func (s *ScanningAnalysis) GetTool() *Tool {
+	if s == nil {
+		return nil
 	}
-	return *r.UpdatedAt
+	return s.Tool
 }
 
 // GetURL returns the URL field if it's non-nil, zero value otherwise.
-func (r *RepositoryComment) GetURL() string {
-	if r == nil || r.URL == nil {
+func (s *ScanningAnalysis) GetURL() string {
+	if s == nil || s.URL == nil {
 		return ""
 	}
-	return *r.URL
+	return *s.URL
 }
 
-// GetUser returns the User field.
-func (r *RepositoryComment) GetUser() *User {
-	if r == nil {
-		return nil
+// GetWarning returns the Warning field if it's non-nil, zero value otherwise.
+func (s *ScanningAnalysis) GetWarning() string {
+	if s == nil || s.Warning == nil {
+		return ""
 	}
-	return r.User
+	return *s.Warning
 }
 
-// GetAuthor returns the Author field.
-func (r *RepositoryCommit) GetAuthor() *User {
-	if r == nil {
+// GetResponse returns the Response field.
+func (s *SchemaDrift) GetResponse() *Response {
+	if s == nil {
 		return nil
 	}
-	return r.Author
+	return s.Response
 }
 
-// GetCommentsURL returns the CommentsURL field if it's non-nil, zero value otherwise.
-func (r *RepositoryCommit) GetCommentsURL() string {
-	if r == nil || r.CommentsURL == nil {
+// GetPath returns the Path field if it's non-nil, zero value otherwise.
+func (s *SCIMEnterpriseAttributeOperation) GetPath() string {
+	if s == nil || s.Path == nil {
 		return ""
 	}
-	return *r.CommentsURL
+	return *s.Path
 }
 
-// GetCommit returns the Commit field.
-func (r *RepositoryCommit) GetCommit() *Commit {
-	if r == nil {
-		return nil
+// GetDisplay returns the Display field if it's non-nil, zero value otherwise.
+func (s *SCIMEnterpriseDisplayReference) GetDisplay() string {
+	if s == nil || s.Display == nil {
+		return ""
 	}
-	return r.Commit
+	return *s.Display
 }
 
-// GetCommitter returns the Committer field.
-func (r *RepositoryCommit) GetCommitter() *User {
-	if r == nil {
-		return nil
+// GetRef returns the Ref field if it's non-nil, zero value otherwise.
+func (s *SCIMEnterpriseDisplayReference) GetRef() string {
+	if s == nil || s.Ref == nil {
+		return ""
 	}
-	return r.Committer
+	return *s.Ref
 }
 
-// GetHTMLURL returns the HTMLURL field if it's non-nil, zero value otherwise.
-func (r *RepositoryCommit) GetHTMLURL() string {
-	if r == nil || r.HTMLURL == nil {
+// GetValue returns the Value field if it's non-nil, zero value otherwise.
+func (s *SCIMEnterpriseDisplayReference) GetValue() string {
+	if s == nil || s.Value == nil {
 		return ""
 	}
-	return *r.HTMLURL
+	return *s.Value
 }
 
-// GetNodeID returns the NodeID field if it's non-nil, zero value otherwise.
-func (r *RepositoryCommit) GetNodeID() string {
-	if r == nil || r.NodeID == nil {
+// GetDisplayName returns the DisplayName field if it's non-nil, zero value otherwise.
+func (s *SCIMEnterpriseGroupAttributes) GetDisplayName() string {
+	if s == nil || s.DisplayName == nil {
 		return ""
 	}
-	return *r.NodeID
+	return *s.DisplayName
 }
 
-// GetSHA returns the SHA field if it's non-nil, zero value otherwise.
-func (r *RepositoryCommit) GetSHA() string {
-	if r == nil || r.SHA == nil {
+// GetExternalID returns the ExternalID field if it's non-nil, zero value otherwise.
+func (s *SCIMEnterpriseGroupAttributes) GetExternalID() string {
+	if s == nil || s.ExternalID == nil {
 		return ""
 	}
-	return *r.SHA
+	return *s.ExternalID
 }
 
-// GetStats returns the Stats field.
-func (r *RepositoryCommit) GetStats() *CommitStats {
-	if r == nil {
+// GetID returns the ID field if it's non-nil, zero value otherwise.
+func (s *SCIMEnterpriseGroupAttributes) GetID() string {
+	if s == nil || s.ID == nil {
+		return ""
+	}
+	return *s.ID
+}
+
+// GetMeta returns the Meta field.
+func (s *SCIMEnterpriseGroupAttributes) GetMeta() *SCIMMeta {
+	if s == nil {
 		return nil
 	}
-	return r.Stats
+	return s.Meta
 }
 
-// GetURL returns the URL field if it's non-nil, zero value otherwise.
-func (r *RepositoryCommit) GetURL() string {
-	if r == nil || r.URL == nil {
-		return ""
+// GetItemsPerPage returns the ItemsPerPage field if it's non-nil, zero value otherwise.
+func (s *SCIMEnterpriseGroups) GetItemsPerPage() int {
+	if s == nil || s.ItemsPerPage == nil {
+		return 0
 	}
-	return *r.URL
+	return *s.ItemsPerPage
 }
 
-// GetDownloadURL returns the DownloadURL field if it's non-nil, zero value otherwise.
-func (r *RepositoryContent) GetDownloadURL() string {
-	if r == nil || r.DownloadURL == nil {
-		return ""
+// GetStartIndex returns the StartIndex field if it's non-nil, zero value otherwise.
+func (s *SCIMEnterpriseGroups) GetStartIndex() int {
+	if s == nil || s.StartIndex == nil {
+		return 0
 	}
-	return *r.DownloadURL
+	return *s.StartIndex
 }
 
-// GetEncoding returns the Encoding field if it's non-nil, zero value otherwise.
-func (r *RepositoryContent) GetEncoding() string {
-	if r == nil || r.Encoding == nil {
-		return ""
+// GetTotalResults returns the TotalResults field if it's non-nil, zero value otherwise.
+func (s *SCIMEnterpriseGroups) GetTotalResults() int {
+	if s == nil || s.TotalResults == nil {
+		return 0
 	}
-	return *r.Encoding
+	return *s.TotalResults
 }
 
-// GetGitURL returns the GitURL field if it's non-nil, zero value otherwise.
-func (r *RepositoryContent) GetGitURL() string {
-	if r == nil || r.GitURL == nil {
-		return ""
+// GetActive returns the Active field if it's non-nil, zero value otherwise.
+func (s *SCIMEnterpriseUserAttributes) GetActive() bool {
+	if s == nil || s.Active == nil {
+		return false
 	}
-	return *r.GitURL
+	return *s.Active
 }
 
-// GetHTMLURL returns the HTMLURL field if it's non-nil, zero value otherwise.
-func (r *RepositoryContent) GetHTMLURL() string {
-	if r == nil || r.HTMLURL == nil {
+// GetDisplayName returns the DisplayName field if it's non-nil, zero value otherwise.
+func (s *SCIMEnterpriseUserAttributes) GetDisplayName() string {
+	if s == nil || s.DisplayName == nil {
 		return ""
 	}
-	return *r.HTMLURL
+	return *s.DisplayName
 }
 
-// GetName returns the Name field if it's non-nil, zero value otherwise.
-func (r *RepositoryContent) GetName() string {
-	if r == nil || r.Name == nil {
+// GetExternalID returns the ExternalID field if it's non-nil, zero value otherwise.
+func (s *SCIMEnterpriseUserAttributes) GetExternalID() string {
+	if s == nil || s.ExternalID == nil {
 		return ""
 	}
-	return *r.Name
+	return *s.ExternalID
 }
 
-// GetPath returns the Path field if it's non-nil, zero value otherwise.
-func (r *RepositoryContent) GetPath() string {
-	if r == nil || r.Path == nil {
+// GetID returns the ID field if it's non-nil, zero value otherwise.
+func (s *SCIMEnterpriseUserAttributes) GetID() string {
+	if s == nil || s.ID == nil {
 		return ""
 	}
-	return *r.Path
+	return *s.ID
 }
 
-// GetSHA returns the SHA field if it's non-nil, zero value otherwise.
-func (r *RepositoryContent) GetSHA() string {
-	if r == nil || r.SHA == nil {
-		return ""
+// GetMeta returns the Meta field.
+func (s *SCIMEnterpriseUserAttributes) GetMeta() *SCIMMeta {
+	if s == nil {
+		return nil
 	}
-	return *r.SHA
+	return s.Meta
 }
 
-// GetSize returns the Size field if it's non-nil, zero value otherwise.
-func (r *RepositoryContent) GetSize() int {
-	if r == nil || r.Size == nil {
-		return 0
+// GetName returns the Name field.
+func (s *SCIMEnterpriseUserAttributes) GetName() *SCIMEnterpriseUserName {
+	if s == nil {
+		return nil
 	}
-	return *r.Size
+	return s.Name
 }
 
-// GetTarget returns the Target field if it's non-nil, zero value otherwise.
-func (r *RepositoryContent) GetTarget() string {
-	if r == nil || r.Target == nil {
+// GetUserName returns the UserName field if it's non-nil, zero value otherwise.
+func (s *SCIMEnterpriseUserAttributes) GetUserName() string {
+	if s == nil || s.UserName == nil {
 		return ""
 	}
-	return *r.Target
+	return *s.UserName
+}
+
+// GetPrimary returns the Primary field if it's non-nil, zero value otherwise.
+func (s *SCIMEnterpriseUserEmail) GetPrimary() bool {
+	if s == nil || s.Primary == nil {
+		return false
+	}
+	return *s.Primary
 }
 
 // GetType returns the Type field if it's non-nil, zero value otherwise.
-func (r *RepositoryContent) GetType() string {
-	if r == nil || r.Type == nil {
+func (s *SCIMEnterpriseUserEmail) GetType() string {
+	if s == nil || s.Type == nil {
 		return ""
 	}
-	return *r.Type
+	return *s.Type
 }
 
-// GetURL returns the URL field if it's non-nil, zero value otherwise.
-func (r *RepositoryContent) GetURL() string {
-	if r == nil || r.URL == nil {
+// GetValue returns the Value field if it's non-nil, zero value otherwise.
+func (s *SCIMEnterpriseUserEmail) GetValue() string {
+	if s == nil || s.Value == nil {
 		return ""
 	}
-	return *r.URL
+	return *s.Value
 }
 
-// GetAuthor returns the Author field.
-func (r *RepositoryContentFileOptions) GetAuthor() *CommitAuthor {
-	if r == nil {
-		return nil
+// GetFamilyName returns the FamilyName field if it's non-nil, zero value otherwise.
+func (s *SCIMEnterpriseUserName) GetFamilyName() string {
+	if s == nil || s.FamilyName == nil {
+		return ""
 	}
-	return r.Author
+	return *s.FamilyName
 }
 
-// GetBranch returns the Branch field if it's non-nil, zero value otherwise.
-func (r *RepositoryContentFileOptions) GetBranch() string {
-	if r == nil || r.Branch == nil {
+// GetFormatted returns the Formatted field if it's non-nil, zero value otherwise.
+func (s *SCIMEnterpriseUserName) GetFormatted() string {
+	if s == nil || s.Formatted == nil {
 		return ""
 	}
-	return *r.Branch
+	return *s.Formatted
 }
 
-// GetCommitter returns the Committer field.
-func (r *RepositoryContentFileOptions) GetCommitter() *CommitAuthor {
-	if r == nil {
-		return nil
+// GetGivenName returns the GivenName field if it's non-nil, zero value otherwise.
+func (s *SCIMEnterpriseUserName) GetGivenName() string {
+	if s == nil || s.GivenName == nil {
+		return ""
 	}
-	return r.Committer
+	return *s.GivenName
 }
 
-// GetMessage returns the Message field if it's non-nil, zero value otherwise.
-func (r *RepositoryContentFileOptions) GetMessage() string {
-	if r == nil || r.Message == nil {
+// GetMiddleName returns the MiddleName field if it's non-nil, zero value otherwise.
+func (s *SCIMEnterpriseUserName) GetMiddleName() string {
+	if s == nil || s.MiddleName == nil {
 		return ""
 	}
-	return *r.Message
+	return *s.MiddleName
 }
 
-// GetSHA returns the SHA field if it's non-nil, zero value otherwise.
-func (r *RepositoryContentFileOptions) GetSHA() string {
-	if r == nil || r.SHA == nil {
+// GetDisplay returns the Display field if it's non-nil, zero value otherwise.
+func (s *SCIMEnterpriseUserRole) GetDisplay() string {
+	if s == nil || s.Display == nil {
 		return ""
 	}
-	return *r.SHA
+	return *s.Display
 }
 
-// GetContent returns the Content field.
-func (r *RepositoryContentResponse) GetContent() *RepositoryContent {
-	if r == nil {
-		return nil
+// GetPrimary returns the Primary field if it's non-nil, zero value otherwise.
+func (s *SCIMEnterpriseUserRole) GetPrimary() bool {
+	if s == nil || s.Primary == nil {
+		return false
 	}
-	return r.Content
+	return *s.Primary
 }
 
-// GetAction returns the Action field if it's non-nil, zero value otherwise.
-func (r *RepositoryDispatchEvent) GetAction() string {
-	if r == nil || r.Action == nil {
+// GetType returns the Type field if it's non-nil, zero value otherwise.
+func (s *SCIMEnterpriseUserRole) GetType() string {
+	if s == nil || s.Type == nil {
 		return ""
 	}
-	return *r.Action
+	return *s.Type
 }
 
-// GetBranch returns the Branch field if it's non-nil, zero value otherwise.
-func (r *RepositoryDispatchEvent) GetBranch() string {
-	if r == nil || r.Branch == nil {
+// GetValue returns the Value field if it's non-nil, zero value otherwise.
+func (s *SCIMEnterpriseUserRole) GetValue() string {
+	if s == nil || s.Value == nil {
 		return ""
 	}
-	return *r.Branch
+	return *s.Value
 }
 
-// GetInstallation returns the Installation field.
-func (r *RepositoryDispatchEvent) GetInstallation() *Installation {
-	if r == nil {
-		return nil
+// GetItemsPerPage returns the ItemsPerPage field if it's non-nil, zero value otherwise.
+func (s *SCIMEnterpriseUsers) GetItemsPerPage() int {
+	if s == nil || s.ItemsPerPage == nil {
+		return 0
 	}
-	return r.Installation
+	return *s.ItemsPerPage
 }
 
-// GetOrg returns the Org field.
-func (r *RepositoryDispatchEvent) GetOrg() *Organization {
-	if r == nil {
-		return nil
+// GetStartIndex returns the StartIndex field if it's non-nil, zero value otherwise.
+func (s *SCIMEnterpriseUsers) GetStartIndex() int {
+	if s == nil || s.StartIndex == nil {
+		return 0
 	}
-	return r.Org
+	return *s.StartIndex
 }
 
-// GetRepo returns the Repo field.
-func (r *RepositoryDispatchEvent) GetRepo() *Repository {
-	if r == nil {
-		return nil
+// GetTotalResults returns the TotalResults field if it's non-nil, zero value otherwise.
+func (s *SCIMEnterpriseUsers) GetTotalResults() int {
+	if s == nil || s.TotalResults == nil {
+		return 0
 	}
-	return r.Repo
+	return *s.TotalResults
 }
 
-// GetSender returns the Sender field.
-func (r *RepositoryDispatchEvent) GetSender() *User {
-	if r == nil {
-		return nil
+// GetCreated returns the Created field if it's non-nil, zero value otherwise.
+func (s *SCIMMeta) GetCreated() Timestamp {
+	if s == nil || s.Created == nil {
+		return Timestamp{}
 	}
-	return r.Sender
+	return *s.Created
 }
 
-// GetAction returns the Action field if it's non-nil, zero value otherwise.
-func (r *RepositoryEvent) GetAction() string {
-	if r == nil || r.Action == nil {
-		return ""
+// GetLastModified returns the LastModified field if it's non-nil, zero value otherwise.
+func (s *SCIMMeta) GetLastModified() Timestamp {
+	if s == nil || s.LastModified == nil {
+		return Timestamp{}
 	}
-	return *r.Action
+	return *s.LastModified
 }
 
-// GetInstallation returns the Installation field.
-func (r *RepositoryEvent) GetInstallation() *Installation {
-	if r == nil {
-		return nil
+// GetLocation returns the Location field if it's non-nil, zero value otherwise.
+func (s *SCIMMeta) GetLocation() string {
+	if s == nil || s.Location == nil {
+		return ""
 	}
-	return r.Installation
+	return *s.Location
 }
 
-// GetOrg returns the Org field.
-func (r *RepositoryEvent) GetOrg() *Organization {
-	if r == nil {
-		return nil
+// GetResourceType returns the ResourceType field if it's non-nil, zero value otherwise.
+func (s *SCIMMeta) GetResourceType() string {
+	if s == nil || s.ResourceType == nil {
+		return ""
 	}
-	return r.Org
+	return *s.ResourceType
 }
 
-// GetRepo returns the Repo field.
-func (r *RepositoryEvent) GetRepo() *Repository {
-	if r == nil {
-		return nil
+// GetSeatsCreated returns the SeatsCreated field if it's non-nil, zero value otherwise.
+func (s *SeatAssignments) GetSeatsCreated() int {
+	if s == nil || s.SeatsCreated == nil {
+		return 0
 	}
-	return r.Repo
+	return *s.SeatsCreated
 }
 
-// GetSender returns the Sender field.
-func (r *RepositoryEvent) GetSender() *User {
-	if r == nil {
-		return nil
+// GetSeatsCancelled returns the SeatsCancelled field if it's non-nil, zero value otherwise.
+func (s *SeatCancellations) GetSeatsCancelled() int {
+	if s == nil || s.SeatsCancelled == nil {
+		return 0
 	}
-	return r.Sender
+	return *s.SeatsCancelled
 }
 
 // GetCreatedAt returns the CreatedAt field if it's non-nil, zero value otherwise.
-func (r *RepositoryInvitation) GetCreatedAt() Timestamp {
-	if r == nil || r.CreatedAt == nil {
+func (s *SecretScanningAlert) GetCreatedAt() Timestamp {
+	if s == nil || s.CreatedAt == nil {
 		return Timestamp{}
 	}
-	return *r.CreatedAt
+	return *s.CreatedAt
 }
 
 // GetHTMLURL returns the HTMLURL field if it's non-nil, zero value otherwise.
-func (r *RepositoryInvitation) GetHTMLURL() string {
-	if r == nil || r.HTMLURL == nil {
+func (s *SecretScanningAlert) GetHTMLURL() string {
+	if s == nil || s.HTMLURL == nil {
 		return ""
 	}
-	return *r.HTMLURL
+	return *s.HTMLURL
 }
 
-// GetID returns the ID field if it's non-nil, zero value otherwise.
-func (r *RepositoryInvitation) GetID() int64 {
-	if r == nil || r.ID == nil {
-		return 0
+// GetIsBase64Encoded returns the IsBase64Encoded field if it's non-nil, zero value otherwise.
+func (s *SecretScanningAlert) GetIsBase64Encoded() bool {
+	if s == nil || s.IsBase64Encoded == nil {
+		return false
 	}
-	return *r.ID
+	return *s.IsBase64Encoded
 }
 
-// GetInvitee returns the Invitee field.
-func (r *RepositoryInvitation) GetInvitee() *User {
-	if r == nil {
-		return nil
+// GetLocationsURL returns the LocationsURL field if it's non-nil, zero value otherwise.
+func (s *SecretScanningAlert) GetLocationsURL() string {
+	if s == nil || s.LocationsURL == nil {
+		return ""
 	}
-	return r.Invitee
+	return *s.LocationsURL
 }
 
-// GetInviter returns the Inviter field.
-func (r *RepositoryInvitation) GetInviter() *User {
-	if r == nil {
-		return nil
+// GetMultiRepo returns the MultiRepo field if it's non-nil, zero value otherwise.
+func (s *SecretScanningAlert) GetMultiRepo() bool {
+	if s == nil || s.MultiRepo == nil {
+		return false
 	}
-	return r.Inviter
+	return *s.MultiRepo
 }
 
-// GetPermissions returns the Permissions field if it's non-nil, zero value otherwise.
-func (r *RepositoryInvitation) GetPermissions() string {
-	if r == nil || r.Permissions == nil {
-		return ""
+// GetNumber returns the Number field if it's non-nil, zero value otherwise.
+func (s *SecretScanningAlert) GetNumber() int {
+	if s == nil || s.Number == nil {
+		return 0
 	}
-	return *r.Permissions
+	return *s.Number
 }
 
-// GetRepo returns the Repo field.
-func (r *RepositoryInvitation) GetRepo() *Repository {
-	if r == nil {
-		return nil
+// GetPubliclyLeaked returns the PubliclyLeaked field if it's non-nil, zero value otherwise.
+func (s *SecretScanningAlert) GetPubliclyLeaked() bool {
+	if s == nil || s.PubliclyLeaked == nil {
+		return false
 	}
-	return r.Repo
+	return *s.PubliclyLeaked
 }
 
-// GetURL returns the URL field if it's non-nil, zero value otherwise.
-func (r *RepositoryInvitation) GetURL() string {
-	if r == nil || r.URL == nil {
-		return ""
+// GetPushProtectionBypassed returns the PushProtectionBypassed field if it's non-nil, zero value otherwise.
+func (s *SecretScanningAlert) GetPushProtectionBypassed() bool {
+	if s == nil || s.PushProtectionBypassed == nil {
+		return false
 	}
-	return *r.URL
+	return *s.PushProtectionBypassed
 }
 
-// GetContent returns the Content field if it's non-nil, zero value otherwise.
-func (r *RepositoryLicense) GetContent() string {
-	if r == nil || r.Content == nil {
-		return ""
+// GetPushProtectionBypassedAt returns the PushProtectionBypassedAt field if it's non-nil, zero value otherwise.
+func (s *SecretScanningAlert) GetPushProtectionBypassedAt() Timestamp {
+	if s == nil || s.PushProtectionBypassedAt == nil {
+		return Timestamp{}
 	}
-	return *r.Content
+	return *s.PushProtectionBypassedAt
 }
 
-// GetDownloadURL returns the DownloadURL field if it's non-nil, zero value otherwise.
-func (r *RepositoryLicense) GetDownloadURL() string {
-	if r == nil || r.DownloadURL == nil {
-		return ""
+// GetPushProtectionBypassedBy returns the PushProtectionBypassedBy field.
+func (s *SecretScanningAlert) GetPushProtectionBypassedBy() *User {
+	if s == nil {
+		return nil
 	}
-	return *r.DownloadURL
+	return s.PushProtectionBypassedBy
 }
 
-// GetEncoding returns the Encoding field if it's non-nil, zero value otherwise.
-func (r *RepositoryLicense) GetEncoding() string {
-	if r == nil || r.Encoding == nil {
-		return ""
+// GetRepository returns the Repository field.
+func (s *SecretScanningAlert) GetRepository() *Repository {
+	if s == nil {
+		return nil
 	}
-	return *r.Encoding
+	return s.Repository
 }
 
-// GetGitURL returns the GitURL field if it's non-nil, zero value otherwise.
-func (r *RepositoryLicense) GetGitURL() string {
-	if r == nil || r.GitURL == nil {
+// GetResolution returns the Resolution field if it's non-nil, zero value otherwise.
+func (s *SecretScanningAlert) GetResolution() string {
+	if s == nil || s.Resolution == nil {
 		return ""
 	}
-	return *r.GitURL
+	return *s.Resolution
 }
 
-// GetHTMLURL returns the HTMLURL field if it's non-nil, zero value otherwise.
-func (r *RepositoryLicense) GetHTMLURL() string {
-	if r == nil || r.HTMLURL == nil {
+// GetResolutionComment returns the ResolutionComment field if it's non-nil, zero value otherwise.
+func (s *SecretScanningAlert) GetResolutionComment() string {
+	if s == nil || s.ResolutionComment == nil {
 		return ""
 	}
-	return *r.HTMLURL
+	return *s.ResolutionComment
 }
 
-// GetLicense returns the License field.
-func (r *RepositoryLicense) GetLicense() *License {
-	if r == nil {
+// GetResolvedAt returns the ResolvedAt field if it's non-nil, zero value otherwise.
+func (s *SecretScanningAlert) GetResolvedAt() Timestamp {
+	if s == nil || s.ResolvedAt == nil {
+		return Timestamp{}
+	}
+	return *s.ResolvedAt
+}
+
+// GetResolvedBy returns the ResolvedBy field.
+func (s *SecretScanningAlert) GetResolvedBy() *User {
+	if s == nil {
 		return nil
 	}
-	return r.License
+	return s.ResolvedBy
 }
 
-// GetName returns the Name field if it's non-nil, zero value otherwise.
-func (r *RepositoryLicense) GetName() string {
-	if r == nil || r.Name == nil {
+// GetSecret returns the Secret field if it's non-nil, zero value otherwise.
+func (s *SecretScanningAlert) GetSecret() string {
+	if s == nil || s.Secret == nil {
 		return ""
 	}
-	return *r.Name
+	return *s.Secret
 }
 
-// GetPath returns the Path field if it's non-nil, zero value otherwise.
-func (r *RepositoryLicense) GetPath() string {
-	if r == nil || r.Path == nil {
+// GetSecretType returns the SecretType field if it's non-nil, zero value otherwise.
+func (s *SecretScanningAlert) GetSecretType() string {
+	if s == nil || s.SecretType == nil {
 		return ""
 	}
-	return *r.Path
+	return *s.SecretType
 }
 
-// GetSHA returns the SHA field if it's non-nil, zero value otherwise.
-func (r *RepositoryLicense) GetSHA() string {
-	if r == nil || r.SHA == nil {
+// GetSecretTypeDisplayName returns the SecretTypeDisplayName field if it's non-nil, zero value otherwise.
+func (s *SecretScanningAlert) GetSecretTypeDisplayName() string {
+	if s == nil || s.SecretTypeDisplayName == nil {
 		return ""
 	}
-	return *r.SHA
+	return *s.SecretTypeDisplayName
 }
 
-// GetSize returns the Size field if it's non-nil, zero value otherwise.
-func (r *RepositoryLicense) GetSize() int {
-	if r == nil || r.Size == nil {
-		return 0
+// GetState returns the State field if it's non-nil, zero value otherwise.
+func (s *SecretScanningAlert) GetState() string {
+	if s == nil || s.State == nil {
+		return ""
 	}
-	return *r.Size
+	return *s.State
 }
 
-// GetType returns the Type field if it's non-nil, zero value otherwise.
-func (r *RepositoryLicense) GetType() string {
-	if r == nil || r.Type == nil {
-		return ""
+// GetUpdatedAt returns the UpdatedAt field if it's non-nil, zero value otherwise.
+func (s *SecretScanningAlert) GetUpdatedAt() Timestamp {
+	if s == nil || s.UpdatedAt == nil {
+		return Timestamp{}
 	}
-	return *r.Type
+	return *s.UpdatedAt
 }
 
 // GetURL returns the URL field if it's non-nil, zero value otherwise.
-func (r *RepositoryLicense) GetURL() string {
-	if r == nil || r.URL == nil {
+func (s *SecretScanningAlert) GetURL() string {
+	if s == nil || s.URL == nil {
 		return ""
 	}
-	return *r.URL
+	return *s.URL
 }
 
-// GetBase returns the Base field if it's non-nil, zero value otherwise.
-func (r *RepositoryMergeRequest) GetBase() string {
-	if r == nil || r.Base == nil {
+// GetValidity returns the Validity field if it's non-nil, zero value otherwise.
+func (s *SecretScanningAlert) GetValidity() string {
+	if s == nil || s.Validity == nil {
 		return ""
 	}
-	return *r.Base
+	return *s.Validity
 }
 
-// GetCommitMessage returns the CommitMessage field if it's non-nil, zero value otherwise.
-func (r *RepositoryMergeRequest) GetCommitMessage() string {
-	if r == nil || r.CommitMessage == nil {
+// GetDetails returns the Details field.
+func (s *SecretScanningAlertLocation) GetDetails() *SecretScanningAlertLocationDetails {
+	if s == nil {
+		return nil
+	}
+	return s.Details
+}
+
+// GetType returns the Type field if it's non-nil, zero value otherwise.
+func (s *SecretScanningAlertLocation) GetType() string {
+	if s == nil || s.Type == nil {
 		return ""
 	}
-	return *r.CommitMessage
+	return *s.Type
 }
 
-// GetHead returns the Head field if it's non-nil, zero value otherwise.
-func (r *RepositoryMergeRequest) GetHead() string {
-	if r == nil || r.Head == nil {
+// GetBlobSHA returns the BlobSHA field if it's non-nil, zero value otherwise.
+func (s *SecretScanningAlertLocationDetails) GetBlobSHA() string {
+	if s == nil || s.BlobSHA == nil {
+		return ""
+	}
+	return *s.BlobSHA
+}
+
+// GetBlobURL returns the BlobURL field if it's non-nil, zero value otherwise.
+func (s *SecretScanningAlertLocationDetails) GetBlobURL() string {
+	if s == nil || s.BlobURL == nil {
 		return ""
 	}
-	return *r.Head
+	return *s.BlobURL
 }
 
-// GetPermission returns the Permission field if it's non-nil, zero value otherwise.
-func (r *RepositoryPermissionLevel) GetPermission() string {
-	if r == nil || r.Permission == nil {
+// GetCommitSHA returns the CommitSHA field if it's non-nil, zero value otherwise.
+func (s *SecretScanningAlertLocationDetails) GetCommitSHA() string {
+	if s == nil || s.CommitSHA == nil {
 		return ""
 	}
-	return *r.Permission
+	return *s.CommitSHA
 }
 
-// GetUser returns the User field.
-func (r *RepositoryPermissionLevel) GetUser() *User {
-	if r == nil {
-		return nil
+// GetCommitURL returns the CommitURL field if it's non-nil, zero value otherwise.
+func (s *SecretScanningAlertLocationDetails) GetCommitURL() string {
+	if s == nil || s.CommitURL == nil {
+		return ""
 	}
-	return r.User
+	return *s.CommitURL
 }
 
-// GetAssetsURL returns the AssetsURL field if it's non-nil, zero value otherwise.
-func (r *RepositoryRelease) GetAssetsURL() string {
-	if r == nil || r.AssetsURL == nil {
+// GetDiscussionBodyURL returns the DiscussionBodyURL field if it's non-nil, zero value otherwise.
+func (s *SecretScanningAlertLocationDetails) GetDiscussionBodyURL() string {
+	if s == nil || s.DiscussionBodyURL == nil {
 		return ""
 	}
-	return *r.AssetsURL
+	return *s.DiscussionBodyURL
 }
 
-// GetAuthor returns the Author field.
-func (r *RepositoryRelease) GetAuthor() *User {
-	if r == nil {
-		return nil
+// GetDiscussionCommentURL returns the DiscussionCommentURL field if it's non-nil, zero value otherwise.
+func (s *SecretScanningAlertLocationDetails) GetDiscussionCommentURL() string {
+	if s == nil || s.DiscussionCommentURL == nil {
+		return ""
 	}
-	return r.Author
+	return *s.DiscussionCommentURL
 }
 
-// GetBody returns the Body field if it's non-nil, zero value otherwise.
-func (r *RepositoryRelease) GetBody() string {
-	if r == nil || r.Body == nil {
+// GetDiscussionTitleURL returns the DiscussionTitleURL field if it's non-nil, zero value otherwise.
+func (s *SecretScanningAlertLocationDetails) GetDiscussionTitleURL() string {
+	if s == nil || s.DiscussionTitleURL == nil {
 		return ""
 	}
-	return *r.Body
+	return *s.DiscussionTitleURL
 }
 
-// GetCreatedAt returns the CreatedAt field if it's non-nil, zero value otherwise.
-func (r *RepositoryRelease) GetCreatedAt() Timestamp {
-	if r == nil || r.CreatedAt == nil {
-		return Timestamp{}
+// GetEndColumn returns the EndColumn field if it's non-nil, zero value otherwise.
+func (s *SecretScanningAlertLocationDetails) GetEndColumn() int {
+	if s == nil || s.EndColumn == nil {
+		return 0
 	}
-	return *r.CreatedAt
+	return *s.EndColumn
 }
 
-// GetDraft returns the Draft field if it's non-nil, zero value otherwise.
-func (r *RepositoryRelease) GetDraft() bool {
-	if r == nil || r.Draft == nil {
-		return false
+// GetEndLine returns the EndLine field if it's non-nil, zero value otherwise.
+func (s *SecretScanningAlertLocationDetails) GetEndLine() int {
+	if s == nil || s.EndLine == nil {
+		return 0
 	}
-	return *r.Draft
+	return *s.EndLine
 }
 
-// GetHTMLURL returns the HTMLURL field if it's non-nil, zero value otherwise.
-func (r *RepositoryRelease) GetHTMLURL() string {
-	if r == nil || r.HTMLURL == nil {
+// GetIssueBodyURL returns the IssueBodyURL field if it's non-nil, zero value otherwise.
+func (s *SecretScanningAlertLocationDetails) GetIssueBodyURL() string {
+	if s == nil || s.IssueBodyURL == nil {
 		return ""
 	}
-	return *r.HTMLURL
+	return *s.IssueBodyURL
 }
 
-// GetID returns the ID field if it's non-nil, zero value otherwise.
-func (r *RepositoryRelease) GetID() int64 {
-	if r == nil || r.ID == nil {
-		return 0
+// GetIssueCommentURL returns the IssueCommentURL field if it's non-nil, zero value otherwise.
+func (s *SecretScanningAlertLocationDetails) GetIssueCommentURL() string {
+	if s == nil || s.IssueCommentURL == nil {
+		return ""
 	}
-	return *r.ID
+	return *s.IssueCommentURL
 }
 
-// GetName returns the Name field if it's non-nil, zero value otherwise.
-func (r *RepositoryRelease) GetName() string {
-	if r == nil || r.Name == nil {
+// GetIssueTitleURL returns the IssueTitleURL field if it's non-nil, zero value otherwise.
+func (s *SecretScanningAlertLocationDetails) GetIssueTitleURL() string {
+	if s == nil || s.IssueTitleURL == nil {
 		return ""
 	}
-	return *r.Name
+	return *s.IssueTitleURL
 }
 
-// GetNodeID returns the NodeID field if it's non-nil, zero value otherwise.
-func (r *RepositoryRelease) GetNodeID() string {
-	if r == nil || r.NodeID == nil {
+// GetPageURL returns the PageURL field if it's non-nil, zero value otherwise.
+func (s *SecretScanningAlertLocationDetails) GetPageURL() string {
+	if s == nil || s.PageURL == nil {
 		return ""
 	}
-	return *r.NodeID
+	return *s.PageURL
 }
 
-// GetPrerelease returns the Prerelease field if it's non-nil, zero value otherwise.
-func (r *RepositoryRelease) GetPrerelease() bool {
-	if r == nil || r.Prerelease == nil {
-		return false
+// GetPath returns the Path field if it's non-nil, zero value otherwise.
+func (s *SecretScanningAlertLocationDetails) GetPath() string {
+	if s == nil || s.Path == nil {
+		return ""
 	}
-	return *r.Prerelease
+	return *s.Path
 }
 
-// GetPublishedAt returns the PublishedAt field if it's non-nil, zero value otherwise.
-func (r *RepositoryRelease) GetPublishedAt() Timestamp {
-	if r == nil || r.PublishedAt == nil {
-		return Timestamp{}
+// GetPullRequestBodyURL returns the PullRequestBodyURL field if it's non-nil, zero value otherwise.
+func (s *SecretScanningAlertLocationDetails) GetPullRequestBodyURL() string {
+	if s == nil || s.PullRequestBodyURL == nil {
+		return ""
 	}
-	return *r.PublishedAt
+	return *s.PullRequestBodyURL
 }
 
-// GetTagName returns the TagName field if it's non-nil, zero value otherwise.
-func (r *RepositoryRelease) GetTagName() string {
-	if r == nil || r.TagName == nil {
+// GetPullRequestCommentURL returns the PullRequestCommentURL field if it's non-nil, zero value otherwise.
+func (s *SecretScanningAlertLocationDetails) GetPullRequestCommentURL() string {
+	if s == nil || s.PullRequestCommentURL == nil {
 		return ""
 	}
-	return *r.TagName
+	return *s.PullRequestCommentURL
 }
 
-// GetTarballURL returns the TarballURL field if it's non-nil, zero value otherwise.
-func (r *RepositoryRelease) GetTarballURL() string {
-	if r == nil || r.TarballURL == nil {
+// GetPullRequestReviewCommentURL returns the PullRequestReviewCommentURL field if it's non-nil, zero value otherwise.
+func (s *SecretScanningAlertLocationDetails) GetPullRequestReviewCommentURL() string {
+	if s == nil || s.PullRequestReviewCommentURL == nil {
 		return ""
 	}
-	return *r.TarballURL
+	return *s.PullRequestReviewCommentURL
 }
 
-// GetTargetCommitish returns the TargetCommitish field if it's non-nil, zero value otherwise.
-func (r *RepositoryRelease) GetTargetCommitish() string {
-	if r == nil || r.TargetCommitish == nil {
+// GetPullRequestReviewURL returns the PullRequestReviewURL field if it's non-nil, zero value otherwise.
+func (s *SecretScanningAlertLocationDetails) GetPullRequestReviewURL() string {
+	if s == nil || s.PullRequestReviewURL == nil {
 		return ""
 	}
-	return *r.TargetCommitish
+	return *s.PullRequestReviewURL
 }
 
-// GetUploadURL returns the UploadURL field if it's non-nil, zero value otherwise.
-func (r *RepositoryRelease) GetUploadURL() string {
-	if r == nil || r.UploadURL == nil {
+// GetPullRequestTitleURL returns the PullRequestTitleURL field if it's non-nil, zero value otherwise.
+func (s *SecretScanningAlertLocationDetails) GetPullRequestTitleURL() string {
+	if s == nil || s.PullRequestTitleURL == nil {
 		return ""
 	}
-	return *r.UploadURL
+	return *s.PullRequestTitleURL
 }
 
-// GetURL returns the URL field if it's non-nil, zero value otherwise.
-func (r *RepositoryRelease) GetURL() string {
-	if r == nil || r.URL == nil {
-		return ""
+// GetStartColumn returns the StartColumn field if it's non-nil, zero value otherwise.
+func (s *SecretScanningAlertLocationDetails) GetStartColumn() int {
+	if s == nil || s.StartColumn == nil {
+		return 0
 	}
-	return *r.URL
+	return *s.StartColumn
 }
 
-// GetZipballURL returns the ZipballURL field if it's non-nil, zero value otherwise.
-func (r *RepositoryRelease) GetZipballURL() string {
-	if r == nil || r.ZipballURL == nil {
-		return ""
+// GetStartLine returns the StartLine field if it's non-nil, zero value otherwise.
+func (s *SecretScanningAlertLocationDetails) GetStartLine() int {
+	if s == nil || s.StartLine == nil {
+		return 0
 	}
-	return *r.ZipballURL
+	return *s.StartLine
 }
 
-// GetCommit returns the Commit field.
-func (r *RepositoryTag) GetCommit() *Commit {
-	if r == nil {
-		return nil
+// GetAction returns the Action field if it's non-nil, zero value otherwise.
+func (s *SecretScanningAlertLocationEvent) GetAction() string {
+	if s == nil || s.Action == nil {
+		return ""
 	}
-	return r.Commit
+	return *s.Action
 }
 
-// GetName returns the Name field if it's non-nil, zero value otherwise.
-func (r *RepositoryTag) GetName() string {
-	if r == nil || r.Name == nil {
-		return ""
+// GetAlert returns the Alert field.
+func (s *SecretScanningAlertLocationEvent) GetAlert() *SecretScanningAlert {
+	if s == nil {
+		return nil
 	}
-	return *r.Name
+	return s.Alert
 }
 
-// GetTarballURL returns the TarballURL field if it's non-nil, zero value otherwise.
-func (r *RepositoryTag) GetTarballURL() string {
-	if r == nil || r.TarballURL == nil {
-		return ""
+// GetInstallation returns the Installation field.
+func (s *SecretScanningAlertLocationEvent) GetInstallation() *Installation {
+	if s == nil {
+		return nil
 	}
-	return *r.TarballURL
+	return s.Installation
 }
 
-// GetZipballURL returns the ZipballURL field if it's non-nil, zero value otherwise.
-func (r *RepositoryTag) GetZipballURL() string {
-	if r == nil || r.ZipballURL == nil {
-		return ""
+// GetLocation returns the Location field.
+func (s *SecretScanningAlertLocationEvent) GetLocation() *SecretScanningAlertLocation {
+	if s == nil {
+		return nil
 	}
-	return *r.ZipballURL
+	return s.Location
 }
 
-// GetAction returns the Action field if it's non-nil, zero value otherwise.
-func (r *RepositoryVulnerabilityAlertEvent) GetAction() string {
-	if r == nil || r.Action == nil {
-		return ""
+// GetOrg returns the Org field.
+func (s *SecretScanningAlertLocationEvent) GetOrg() *Organization {
+	if s == nil {
+		return nil
 	}
-	return *r.Action
+	return s.Org
 }
 
-// GetRepository returns the Repository field.
-func (r *RepositoryVulnerabilityAlertEvent) GetRepository() *Repository {
-	if r == nil {
+// GetRepo returns the Repo field.
+func (s *SecretScanningAlertLocationEvent) GetRepo() *Repository {
+	if s == nil {
 		return nil
 	}
-	return r.Repository
+	return s.Repo
 }
 
-// GetForkRepos returns the ForkRepos field if it's non-nil, zero value otherwise.
-func (r *RepoStats) GetForkRepos() int {
-	if r == nil || r.ForkRepos == nil {
-		return 0
+// GetSender returns the Sender field.
+func (s *SecretScanningAlertLocationEvent) GetSender() *User {
+	if s == nil {
+		return nil
 	}
-	return *r.ForkRepos
+	return s.Sender
 }
 
-// GetOrgRepos returns the OrgRepos field if it's non-nil, zero value otherwise.
-func (r *RepoStats) GetOrgRepos() int {
-	if r == nil || r.OrgRepos == nil {
-		return 0
+// GetCreatedAt returns the CreatedAt field if it's non-nil, zero value otherwise.
+func (s *SecretScanningBypassRequest) GetCreatedAt() Timestamp {
+	if s == nil || s.CreatedAt == nil {
+		return Timestamp{}
 	}
-	return *r.OrgRepos
+	return *s.CreatedAt
 }
 
-// GetRootRepos returns the RootRepos field if it's non-nil, zero value otherwise.
-func (r *RepoStats) GetRootRepos() int {
-	if r == nil || r.RootRepos == nil {
-		return 0
+// GetExpiresAt returns the ExpiresAt field if it's non-nil, zero value otherwise.
+func (s *SecretScanningBypassRequest) GetExpiresAt() Timestamp {
+	if s == nil || s.ExpiresAt == nil {
+		return Timestamp{}
 	}
-	return *r.RootRepos
+	return *s.ExpiresAt
 }
 
-// GetTotalPushes returns the TotalPushes field if it's non-nil, zero value otherwise.
-func (r *RepoStats) GetTotalPushes() int {
-	if r == nil || r.TotalPushes == nil {
-		return 0
+// GetHTMLURL returns the HTMLURL field if it's non-nil, zero value otherwise.
+func (s *SecretScanningBypassRequest) GetHTMLURL() string {
+	if s == nil || s.HTMLURL == nil {
+		return ""
 	}
-	return *r.TotalPushes
+	return *s.HTMLURL
 }
 
-// GetTotalRepos returns the TotalRepos field if it's non-nil, zero value otherwise.
-func (r *RepoStats) GetTotalRepos() int {
-	if r == nil || r.TotalRepos == nil {
+// GetID returns the ID field if it's non-nil, zero value otherwise.
+func (s *SecretScanningBypassRequest) GetID() int64 {
+	if s == nil || s.ID == nil {
 		return 0
 	}
-	return *r.TotalRepos
+	return *s.ID
 }
 
-// GetTotalWikis returns the TotalWikis field if it's non-nil, zero value otherwise.
-func (r *RepoStats) GetTotalWikis() int {
-	if r == nil || r.TotalWikis == nil {
+// GetNumber returns the Number field if it's non-nil, zero value otherwise.
+func (s *SecretScanningBypassRequest) GetNumber() int64 {
+	if s == nil || s.Number == nil {
 		return 0
 	}
-	return *r.TotalWikis
+	return *s.Number
 }
 
-// GetContext returns the Context field if it's non-nil, zero value otherwise.
-func (r *RepoStatus) GetContext() string {
-	if r == nil || r.Context == nil {
-		return ""
+// GetOrganization returns the Organization field.
+func (s *SecretScanningBypassRequest) GetOrganization() *Organization {
+	if s == nil {
+		return nil
 	}
-	return *r.Context
+	return s.Organization
 }
 
-// GetCreatedAt returns the CreatedAt field if it's non-nil, zero value otherwise.
-func (r *RepoStatus) GetCreatedAt() time.Time {
-	if r == nil || r.CreatedAt == nil {
-		return time.Time{}
+// GetRepository returns the Repository field.
+func (s *SecretScanningBypassRequest) GetRepository() *Repository {
+	if s == nil {
+		return nil
 	}
-	return *r.CreatedAt
+	return s.Repository
 }
 
-// GetCreator returns the Creator field.
-func (r *RepoStatus) GetCreator() *User {
-	if r == nil {
+// GetRequester returns the Requester field.
+func (s *SecretScanningBypassRequest) GetRequester() *BypassRequestActor {
+	if s == nil {
 		return nil
 	}
-	return r.Creator
+	return s.Requester
 }
 
-// GetDescription returns the Description field if it's non-nil, zero value otherwise.
-func (r *RepoStatus) GetDescription() string {
-	if r == nil || r.Description == nil {
+// GetRequesterComment returns the RequesterComment field if it's non-nil, zero value otherwise.
+func (s *SecretScanningBypassRequest) GetRequesterComment() string {
+	if s == nil || s.RequesterComment == nil {
 		return ""
 	}
-	return *r.Description
+	return *s.RequesterComment
 }
 
-// GetID returns the ID field if it's non-nil, zero value otherwise.
-func (r *RepoStatus) GetID() int64 {
-	if r == nil || r.ID == nil {
-		return 0
+// GetStatus returns the Status field if it's non-nil, zero value otherwise.
+func (s *SecretScanningBypassRequest) GetStatus() string {
+	if s == nil || s.Status == nil {
+		return ""
 	}
-	return *r.ID
+	return *s.Status
 }
 
-// GetNodeID returns the NodeID field if it's non-nil, zero value otherwise.
-func (r *RepoStatus) GetNodeID() string {
-	if r == nil || r.NodeID == nil {
+// GetURL returns the URL field if it's non-nil, zero value otherwise.
+func (s *SecretScanningBypassRequest) GetURL() string {
+	if s == nil || s.URL == nil {
 		return ""
 	}
-	return *r.NodeID
+	return *s.URL
 }
 
-// GetState returns the State field if it's non-nil, zero value otherwise.
-func (r *RepoStatus) GetState() string {
-	if r == nil || r.State == nil {
-		return ""
+// GetAuthor returns the Author field.
+func (s *SecurityAdvisory) GetAuthor() *User {
+	if s == nil {
+		return nil
 	}
-	return *r.State
+	return s.Author
 }
 
-// GetTargetURL returns the TargetURL field if it's non-nil, zero value otherwise.
-func (r *RepoStatus) GetTargetURL() string {
-	if r == nil || r.TargetURL == nil {
+// GetClosedAt returns the ClosedAt field if it's non-nil, zero value otherwise.
+func (s *SecurityAdvisory) GetClosedAt() Timestamp {
+	if s == nil || s.ClosedAt == nil {
+		return Timestamp{}
+	}
+	return *s.ClosedAt
+}
+
+// GetCreatedAt returns the CreatedAt field if it's non-nil, zero value otherwise.
+func (s *SecurityAdvisory) GetCreatedAt() Timestamp {
+	if s == nil || s.CreatedAt == nil {
+		return Timestamp{}
+	}
+	return *s.CreatedAt
+}
+
+// GetCVEID returns the CVEID field if it's non-nil, zero value otherwise.
+func (s *SecurityAdvisory) GetCVEID() string {
+	if s == nil || s.CVEID == nil {
 		return ""
 	}
-	return *r.TargetURL
+	return *s.CVEID
 }
 
-// GetUpdatedAt returns the UpdatedAt field if it's non-nil, zero value otherwise.
-func (r *RepoStatus) GetUpdatedAt() time.Time {
-	if r == nil || r.UpdatedAt == nil {
-		return time.Time{}
+// GetCVSS returns the CVSS field.
+func (s *SecurityAdvisory) GetCVSS() *AdvisoryCVSS {
+	if s == nil {
+		return nil
 	}
-	return *r.UpdatedAt
+	return s.CVSS
 }
 
-// GetURL returns the URL field if it's non-nil, zero value otherwise.
-func (r *RepoStatus) GetURL() string {
-	if r == nil || r.URL == nil {
+// GetDescription returns the Description field if it's non-nil, zero value otherwise.
+func (s *SecurityAdvisory) GetDescription() string {
+	if s == nil || s.Description == nil {
 		return ""
 	}
-	return *r.URL
+	return *s.Description
 }
 
-// GetStrict returns the Strict field if it's non-nil, zero value otherwise.
-func (r *RequiredStatusChecksRequest) GetStrict() bool {
-	if r == nil || r.Strict == nil {
-		return false
+// GetGHSAID returns the GHSAID field if it's non-nil, zero value otherwise.
+func (s *SecurityAdvisory) GetGHSAID() string {
+	if s == nil || s.GHSAID == nil {
+		return ""
 	}
-	return *r.Strict
+	return *s.GHSAID
 }
 
-// GetNodeID returns the NodeID field if it's non-nil, zero value otherwise.
-func (r *ReviewersRequest) GetNodeID() string {
-	if r == nil || r.NodeID == nil {
+// GetHTMLURL returns the HTMLURL field if it's non-nil, zero value otherwise.
+func (s *SecurityAdvisory) GetHTMLURL() string {
+	if s == nil || s.HTMLURL == nil {
 		return ""
 	}
-	return *r.NodeID
+	return *s.HTMLURL
 }
 
-// GetBusy returns the Busy field if it's non-nil, zero value otherwise.
-func (r *Runner) GetBusy() bool {
-	if r == nil || r.Busy == nil {
-		return false
+// GetPrivateFork returns the PrivateFork field.
+func (s *SecurityAdvisory) GetPrivateFork() *Repository {
+	if s == nil {
+		return nil
 	}
-	return *r.Busy
+	return s.PrivateFork
 }
 
-// GetID returns the ID field if it's non-nil, zero value otherwise.
-func (r *Runner) GetID() int64 {
-	if r == nil || r.ID == nil {
-		return 0
+// GetPublishedAt returns the PublishedAt field if it's non-nil, zero value otherwise.
+func (s *SecurityAdvisory) GetPublishedAt() Timestamp {
+	if s == nil || s.PublishedAt == nil {
+		return Timestamp{}
 	}
-	return *r.ID
+	return *s.PublishedAt
 }
 
-// GetName returns the Name field if it's non-nil, zero value otherwise.
-func (r *Runner) GetName() string {
-	if r == nil || r.Name == nil {
-		return ""
+// GetPublisher returns the Publisher field.
+func (s *SecurityAdvisory) GetPublisher() *User {
+	if s == nil {
+		return nil
 	}
-	return *r.Name
+	return s.Publisher
 }
 
-// GetOS returns the OS field if it's non-nil, zero value otherwise.
-func (r *Runner) GetOS() string {
-	if r == nil || r.OS == nil {
+// GetSeverity returns the Severity field if it's non-nil, zero value otherwise.
+func (s *SecurityAdvisory) GetSeverity() string {
+	if s == nil || s.Severity == nil {
 		return ""
 	}
-	return *r.OS
+	return *s.Severity
 }
 
-// GetStatus returns the Status field if it's non-nil, zero value otherwise.
-func (r *Runner) GetStatus() string {
-	if r == nil || r.Status == nil {
+// GetState returns the State field if it's non-nil, zero value otherwise.
+func (s *SecurityAdvisory) GetState() string {
+	if s == nil || s.State == nil {
 		return ""
 	}
-	return *r.Status
+	return *s.State
 }
 
-// GetArchitecture returns the Architecture field if it's non-nil, zero value otherwise.
-func (r *RunnerApplicationDownload) GetArchitecture() string {
-	if r == nil || r.Architecture == nil {
-		return ""
+// GetSubmission returns the Submission field.
+func (s *SecurityAdvisory) GetSubmission() *SecurityAdvisorySubmission {
+	if s == nil {
+		return nil
 	}
-	return *r.Architecture
+	return s.Submission
 }
 
-// GetDownloadURL returns the DownloadURL field if it's non-nil, zero value otherwise.
-func (r *RunnerApplicationDownload) GetDownloadURL() string {
-	if r == nil || r.DownloadURL == nil {
+// GetSummary returns the Summary field if it's non-nil, zero value otherwise.
+func (s *SecurityAdvisory) GetSummary() string {
+	if s == nil || s.Summary == nil {
 		return ""
 	}
-	return *r.DownloadURL
+	return *s.Summary
 }
 
-// GetFilename returns the Filename field if it's non-nil, zero value otherwise.
-func (r *RunnerApplicationDownload) GetFilename() string {
-	if r == nil || r.Filename == nil {
-		return ""
+// GetUpdatedAt returns the UpdatedAt field if it's non-nil, zero value otherwise.
+func (s *SecurityAdvisory) GetUpdatedAt() Timestamp {
+	if s == nil || s.UpdatedAt == nil {
+		return Timestamp{}
 	}
-	return *r.Filename
+	return *s.UpdatedAt
 }
 
-// GetOS returns the OS field if it's non-nil, zero value otherwise.
-func (r *RunnerApplicationDownload) GetOS() string {
-	if r == nil || r.OS == nil {
+// GetURL returns the URL field if it's non-nil, zero value otherwise.
+func (s *SecurityAdvisory) GetURL() string {
+	if s == nil || s.URL == nil {
 		return ""
 	}
-	return *r.OS
-}
-
-// GetID returns the ID field if it's non-nil, zero value otherwise.
-func (r *RunnerLabels) GetID() int64 {
-	if r == nil || r.ID == nil {
-		return 0
-	}
-	return *r.ID
+	return *s.URL
 }
 
-// GetName returns the Name field if it's non-nil, zero value otherwise.
-func (r *RunnerLabels) GetName() string {
-	if r == nil || r.Name == nil {
-		return ""
+// GetWithdrawnAt returns the WithdrawnAt field if it's non-nil, zero value otherwise.
+func (s *SecurityAdvisory) GetWithdrawnAt() Timestamp {
+	if s == nil || s.WithdrawnAt == nil {
+		return Timestamp{}
 	}
-	return *r.Name
+	return *s.WithdrawnAt
 }
 
-// GetType returns the Type field if it's non-nil, zero value otherwise.
-func (r *RunnerLabels) GetType() string {
-	if r == nil || r.Type == nil {
-		return ""
+// GetAccepted returns the Accepted field if it's non-nil, zero value otherwise.
+func (s *SecurityAdvisorySubmission) GetAccepted() bool {
+	if s == nil || s.Accepted == nil {
+		return false
 	}
-	return *r.Type
+	return *s.Accepted
 }
 
 // GetTotalCount returns the TotalCount field if it's non-nil, zero value otherwise.
@@ -12740,6 +18420,30 @@ func (s *SignatureVerification) GetVerified() bool {
 	return *s.Verified
 }
 
+// GetVerifiedAt returns the VerifiedAt field if it's non-nil, zero value otherwise.
+func (s *SignatureVerification) GetVerifiedAt() Timestamp {
+	if s == nil || s.VerifiedAt == nil {
+		return Timestamp{}
+	}
+	return *s.VerifiedAt
+}
+
+// GetProvider returns the Provider field if it's non-nil, zero value otherwise.
+func (s *SocialAccount) GetProvider() string {
+	if s == nil || s.Provider == nil {
+		return ""
+	}
+	return *s.Provider
+}
+
+// GetURL returns the URL field if it's non-nil, zero value otherwise.
+func (s *SocialAccount) GetURL() string {
+	if s == nil || s.URL == nil {
+		return ""
+	}
+	return *s.URL
+}
+
 // GetActor returns the Actor field.
 func (s *Source) GetActor() *User {
 	if s == nil {
@@ -12988,6 +18692,94 @@ func (s *StatusEvent) GetUpdatedAt() Timestamp {
 	return *s.UpdatedAt
 }
 
+// GetAction returns the Action field if it's non-nil, zero value otherwise.
+func (s *SubIssuesEvent) GetAction() string {
+	if s == nil || s.Action == nil {
+		return ""
+	}
+	return *s.Action
+}
+
+// GetInstallation returns the Installation field.
+func (s *SubIssuesEvent) GetInstallation() *Installation {
+	if s == nil {
+		return nil
+	}
+	return s.Installation
+}
+
+// GetOrg returns the Org field.
+func (s *SubIssuesEvent) GetOrg() *Organization {
+	if s == nil {
+		return nil
+	}
+	return s.Org
+}
+
+// GetParentIssue returns the ParentIssue field.
+func (s *SubIssuesEvent) GetParentIssue() *Issue {
+	if s == nil {
+		return nil
+	}
+	return s.ParentIssue
+}
+
+// GetParentIssueID returns the ParentIssueID field if it's non-nil, zero value otherwise.
+func (s *SubIssuesEvent) GetParentIssueID() int64 {
+	if s == nil || s.ParentIssueID == nil {
+		return 0
+	}
+	return *s.ParentIssueID
+}
+
+// GetParentIssueRepo returns the ParentIssueRepo field.
+func (s *SubIssuesEvent) GetParentIssueRepo() *Repository {
+	if s == nil {
+		return nil
+	}
+	return s.ParentIssueRepo
+}
+
+// GetRepo returns the Repo field.
+func (s *SubIssuesEvent) GetRepo() *Repository {
+	if s == nil {
+		return nil
+	}
+	return s.Repo
+}
+
+// GetSender returns the Sender field.
+func (s *SubIssuesEvent) GetSender() *User {
+	if s == nil {
+		return nil
+	}
+	return s.Sender
+}
+
+// GetSubIssue returns the SubIssue field.
+func (s *SubIssuesEvent) GetSubIssue() *Issue {
+	if s == nil {
+		return nil
+	}
+	return s.SubIssue
+}
+
+// GetSubIssueID returns the SubIssueID field if it's non-nil, zero value otherwise.
+func (s *SubIssuesEvent) GetSubIssueID() int64 {
+	if s == nil || s.SubIssueID == nil {
+		return 0
+	}
+	return *s.SubIssueID
+}
+
+// GetSubIssueRepo returns the SubIssueRepo field.
+func (s *SubIssuesEvent) GetSubIssueRepo() *Repository {
+	if s == nil {
+		return nil
+	}
+	return s.SubIssueRepo
+}
+
 // GetCreatedAt returns the CreatedAt field if it's non-nil, zero value otherwise.
 func (s *Subscription) GetCreatedAt() Timestamp {
 	if s == nil || s.CreatedAt == nil {
@@ -13108,6 +18900,22 @@ func (t *Tag) GetVerification() *SignatureVerification {
 	return t.Verification
 }
 
+// GetID returns the ID field if it's non-nil, zero value otherwise.
+func (t *TagProtection) GetID() int64 {
+	if t == nil || t.ID == nil {
+		return 0
+	}
+	return *t.ID
+}
+
+// GetPattern returns the Pattern field if it's non-nil, zero value otherwise.
+func (t *TagProtection) GetPattern() string {
+	if t == nil || t.Pattern == nil {
+		return ""
+	}
+	return *t.Pattern
+}
+
 // GetCompletedAt returns the CompletedAt field if it's non-nil, zero value otherwise.
 func (t *TaskStep) GetCompletedAt() Timestamp {
 	if t == nil || t.CompletedAt == nil {
@@ -13772,6 +19580,30 @@ func (t *Timeline) GetURL() string {
 	return *t.URL
 }
 
+// GetGUID returns the GUID field if it's non-nil, zero value otherwise.
+func (t *Tool) GetGUID() string {
+	if t == nil || t.GUID == nil {
+		return ""
+	}
+	return *t.GUID
+}
+
+// GetName returns the Name field if it's non-nil, zero value otherwise.
+func (t *Tool) GetName() string {
+	if t == nil || t.Name == nil {
+		return ""
+	}
+	return *t.Name
+}
+
+// GetVersion returns the Version field if it's non-nil, zero value otherwise.
+func (t *Tool) GetVersion() string {
+	if t == nil || t.Version == nil {
+		return ""
+	}
+	return *t.Version
+}
+
 // GetCreatedAt returns the CreatedAt field if it's non-nil, zero value otherwise.
 func (t *TopicResult) GetCreatedAt() Timestamp {
 	if t == nil || t.CreatedAt == nil {
@@ -13820,6 +19652,14 @@ func (t *TopicResult) GetFeatured() bool {
 	return *t.Featured
 }
 
+// GetLogoURL returns the LogoURL field if it's non-nil, zero value otherwise.
+func (t *TopicResult) GetLogoURL() string {
+	if t == nil || t.LogoURL == nil {
+		return ""
+	}
+	return *t.LogoURL
+}
+
 // GetName returns the Name field if it's non-nil, zero value otherwise.
 func (t *TopicResult) GetName() string {
 	if t == nil || t.Name == nil {
@@ -13828,6 +19668,22 @@ func (t *TopicResult) GetName() string {
 	return *t.Name
 }
 
+// GetReleased returns the Released field if it's non-nil, zero value otherwise.
+func (t *TopicResult) GetReleased() string {
+	if t == nil || t.Released == nil {
+		return ""
+	}
+	return *t.Released
+}
+
+// GetRepositoryCount returns the RepositoryCount field if it's non-nil, zero value otherwise.
+func (t *TopicResult) GetRepositoryCount() int {
+	if t == nil || t.RepositoryCount == nil {
+		return 0
+	}
+	return *t.RepositoryCount
+}
+
 // GetScore returns the Score field.
 func (t *TopicResult) GetScore() *float64 {
 	if t == nil {
@@ -14100,6 +19956,30 @@ func (u *UpdateCheckRunOptions) GetStatus() string {
 	return *u.Status
 }
 
+// GetQuerySuite returns the QuerySuite field if it's non-nil, zero value otherwise.
+func (u *UpdateDefaultSetupConfigurationOptions) GetQuerySuite() string {
+	if u == nil || u.QuerySuite == nil {
+		return ""
+	}
+	return *u.QuerySuite
+}
+
+// GetRunID returns the RunID field if it's non-nil, zero value otherwise.
+func (u *UpdateDefaultSetupConfigurationResponse) GetRunID() int64 {
+	if u == nil || u.RunID == nil {
+		return 0
+	}
+	return *u.RunID
+}
+
+// GetRunURL returns the RunURL field if it's non-nil, zero value otherwise.
+func (u *UpdateDefaultSetupConfigurationResponse) GetRunURL() string {
+	if u == nil || u.RunURL == nil {
+		return ""
+	}
+	return *u.RunURL
+}
+
 // GetAvatarURL returns the AvatarURL field if it's non-nil, zero value otherwise.
 func (u *User) GetAvatarURL() string {
 	if u == nil || u.AvatarURL == nil {
@@ -14852,6 +20732,22 @@ func (u *UserSuspendOptions) GetReason() string {
 	return *u.Reason
 }
 
+// GetEcosystem returns the Ecosystem field if it's non-nil, zero value otherwise.
+func (v *VulnerabilityPackage) GetEcosystem() string {
+	if v == nil || v.Ecosystem == nil {
+		return ""
+	}
+	return *v.Ecosystem
+}
+
+// GetName returns the Name field if it's non-nil, zero value otherwise.
+func (v *VulnerabilityPackage) GetName() string {
+	if v == nil || v.Name == nil {
+		return ""
+	}
+	return *v.Name
+}
+
 // GetAction returns the Action field if it's non-nil, zero value otherwise.
 func (w *WatchEvent) GetAction() string {
 	if w == nil || w.Action == nil {
@@ -14956,6 +20852,22 @@ func (w *WebHookCommit) GetTimestamp() time.Time {
 	return *w.Timestamp
 }
 
+// GetInstallations returns the Installations field.
+func (w *WebHookDispatcher) GetInstallations() *InstallationCache {
+	if w == nil {
+		return nil
+	}
+	return w.Installations
+}
+
+// GetOptions returns the Options field.
+func (w *WebHookDispatcher) GetOptions() *ParseWebHookOptions {
+	if w == nil {
+		return nil
+	}
+	return w.Options
+}
+
 // GetAction returns the Action field if it's non-nil, zero value otherwise.
 func (w *WebHookPayload) GetAction() string {
 	if w == nil || w.Action == nil {
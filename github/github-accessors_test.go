@@ -23,6 +23,36 @@ func TestAbuseRateLimitError_GetRetryAfter(tt *testing.T) {
 	a.GetRetryAfter()
 }
 
+func TestActivityListStargazersOptions_GetStarredAt(tt *testing.T) {
+	var zeroValue bool
+	a := &ActivityListStargazersOptions{StarredAt: &zeroValue}
+	a.GetStarredAt()
+	a = &ActivityListStargazersOptions{}
+	a.GetStarredAt()
+	a = nil
+	a.GetStarredAt()
+}
+
+func TestActivityListStarredOptions_GetStarredAt(tt *testing.T) {
+	var zeroValue bool
+	a := &ActivityListStarredOptions{StarredAt: &zeroValue}
+	a.GetStarredAt()
+	a = &ActivityListStarredOptions{}
+	a.GetStarredAt()
+	a = nil
+	a.GetStarredAt()
+}
+
+func TestActorLocation_GetCountryCode(tt *testing.T) {
+	var zeroValue string
+	a := &ActorLocation{CountryCode: &zeroValue}
+	a.GetCountryCode()
+	a = &ActorLocation{}
+	a.GetCountryCode()
+	a = nil
+	a.GetCountryCode()
+}
+
 func TestAdminEnforcement_GetURL(tt *testing.T) {
 	var zeroValue string
 	a := &AdminEnforcement{URL: &zeroValue}
@@ -103,6 +133,131 @@ func TestAdminStats_GetUsers(tt *testing.T) {
 	a.GetUsers()
 }
 
+func TestAdvisoryCVSS_GetScore(tt *testing.T) {
+	a := &AdvisoryCVSS{}
+	a.GetScore()
+	a = nil
+	a.GetScore()
+}
+
+func TestAdvisoryCVSS_GetVectorString(tt *testing.T) {
+	var zeroValue string
+	a := &AdvisoryCVSS{VectorString: &zeroValue}
+	a.GetVectorString()
+	a = &AdvisoryCVSS{}
+	a.GetVectorString()
+	a = nil
+	a.GetVectorString()
+}
+
+func TestAdvisoryCWE_GetCWEID(tt *testing.T) {
+	var zeroValue string
+	a := &AdvisoryCWE{CWEID: &zeroValue}
+	a.GetCWEID()
+	a = &AdvisoryCWE{}
+	a.GetCWEID()
+	a = nil
+	a.GetCWEID()
+}
+
+func TestAdvisoryCWE_GetName(tt *testing.T) {
+	var zeroValue string
+	a := &AdvisoryCWE{Name: &zeroValue}
+	a.GetName()
+	a = &AdvisoryCWE{}
+	a.GetName()
+	a = nil
+	a.GetName()
+}
+
+func TestAdvisoryEPSS_GetPercentage(tt *testing.T) {
+	a := &AdvisoryEPSS{}
+	a.GetPercentage()
+	a = nil
+	a.GetPercentage()
+}
+
+func TestAdvisoryEPSS_GetPercentile(tt *testing.T) {
+	a := &AdvisoryEPSS{}
+	a.GetPercentile()
+	a = nil
+	a.GetPercentile()
+}
+
+func TestAdvisoryIdentifier_GetType(tt *testing.T) {
+	var zeroValue string
+	a := &AdvisoryIdentifier{Type: &zeroValue}
+	a.GetType()
+	a = &AdvisoryIdentifier{}
+	a.GetType()
+	a = nil
+	a.GetType()
+}
+
+func TestAdvisoryIdentifier_GetValue(tt *testing.T) {
+	var zeroValue string
+	a := &AdvisoryIdentifier{Value: &zeroValue}
+	a.GetValue()
+	a = &AdvisoryIdentifier{}
+	a.GetValue()
+	a = nil
+	a.GetValue()
+}
+
+func TestAdvisoryReference_GetURL(tt *testing.T) {
+	var zeroValue string
+	a := &AdvisoryReference{URL: &zeroValue}
+	a.GetURL()
+	a = &AdvisoryReference{}
+	a.GetURL()
+	a = nil
+	a.GetURL()
+}
+
+func TestAdvisoryVulnerability_GetFirstPatchedVersion(tt *testing.T) {
+	a := &AdvisoryVulnerability{}
+	a.GetFirstPatchedVersion()
+	a = nil
+	a.GetFirstPatchedVersion()
+}
+
+func TestAdvisoryVulnerability_GetPackage(tt *testing.T) {
+	a := &AdvisoryVulnerability{}
+	a.GetPackage()
+	a = nil
+	a.GetPackage()
+}
+
+func TestAdvisoryVulnerability_GetPatchedVersions(tt *testing.T) {
+	var zeroValue string
+	a := &AdvisoryVulnerability{PatchedVersions: &zeroValue}
+	a.GetPatchedVersions()
+	a = &AdvisoryVulnerability{}
+	a.GetPatchedVersions()
+	a = nil
+	a.GetPatchedVersions()
+}
+
+func TestAdvisoryVulnerability_GetSeverity(tt *testing.T) {
+	var zeroValue string
+	a := &AdvisoryVulnerability{Severity: &zeroValue}
+	a.GetSeverity()
+	a = &AdvisoryVulnerability{}
+	a.GetSeverity()
+	a = nil
+	a.GetSeverity()
+}
+
+func TestAdvisoryVulnerability_GetVulnerableVersionRange(tt *testing.T) {
+	var zeroValue string
+	a := &AdvisoryVulnerability{VulnerableVersionRange: &zeroValue}
+	a.GetVulnerableVersionRange()
+	a = &AdvisoryVulnerability{}
+	a.GetVulnerableVersionRange()
+	a = nil
+	a.GetVulnerableVersionRange()
+}
+
 func TestAlert_GetClosedAt(tt *testing.T) {
 	var zeroValue Timestamp
 	a := &Alert{ClosedAt: &zeroValue}
@@ -200,6 +355,46 @@ func TestAlert_GetURL(tt *testing.T) {
 	a.GetURL()
 }
 
+func TestAnnouncementBanner_GetAnnouncement(tt *testing.T) {
+	var zeroValue string
+	a := &AnnouncementBanner{Announcement: &zeroValue}
+	a.GetAnnouncement()
+	a = &AnnouncementBanner{}
+	a.GetAnnouncement()
+	a = nil
+	a.GetAnnouncement()
+}
+
+func TestAnnouncementBanner_GetExpiresAt(tt *testing.T) {
+	var zeroValue Timestamp
+	a := &AnnouncementBanner{ExpiresAt: &zeroValue}
+	a.GetExpiresAt()
+	a = &AnnouncementBanner{}
+	a.GetExpiresAt()
+	a = nil
+	a.GetExpiresAt()
+}
+
+func TestAnnouncementBanner_GetUserDismissible(tt *testing.T) {
+	var zeroValue bool
+	a := &AnnouncementBanner{UserDismissible: &zeroValue}
+	a.GetUserDismissible()
+	a = &AnnouncementBanner{}
+	a.GetUserDismissible()
+	a = nil
+	a.GetUserDismissible()
+}
+
+func TestAPIMeta_GetInstalledVersion(tt *testing.T) {
+	var zeroValue string
+	a := &APIMeta{InstalledVersion: &zeroValue}
+	a.GetInstalledVersion()
+	a = &APIMeta{}
+	a.GetInstalledVersion()
+	a = nil
+	a.GetInstalledVersion()
+}
+
 func TestAPIMeta_GetVerifiablePasswordAuthentication(tt *testing.T) {
 	var zeroValue bool
 	a := &APIMeta{VerifiablePasswordAuthentication: &zeroValue}
@@ -421,6 +616,23 @@ func TestAppConfig_GetPEM(tt *testing.T) {
 	a.GetPEM()
 }
 
+func TestAppConfig_GetPermissions(tt *testing.T) {
+	a := &AppConfig{}
+	a.GetPermissions()
+	a = nil
+	a.GetPermissions()
+}
+
+func TestAppConfig_GetSlug(tt *testing.T) {
+	var zeroValue string
+	a := &AppConfig{Slug: &zeroValue}
+	a.GetSlug()
+	a = &AppConfig{}
+	a.GetSlug()
+	a = nil
+	a.GetSlug()
+}
+
 func TestAppConfig_GetUpdatedAt(tt *testing.T) {
 	var zeroValue Timestamp
 	a := &AppConfig{UpdatedAt: &zeroValue}
@@ -441,6 +653,90 @@ func TestAppConfig_GetWebhookSecret(tt *testing.T) {
 	a.GetWebhookSecret()
 }
 
+func TestAppManifest_GetDefaultPermissions(tt *testing.T) {
+	a := &AppManifest{}
+	a.GetDefaultPermissions()
+	a = nil
+	a.GetDefaultPermissions()
+}
+
+func TestAppManifest_GetDescription(tt *testing.T) {
+	var zeroValue string
+	a := &AppManifest{Description: &zeroValue}
+	a.GetDescription()
+	a = &AppManifest{}
+	a.GetDescription()
+	a = nil
+	a.GetDescription()
+}
+
+func TestAppManifest_GetHookAttributes(tt *testing.T) {
+	a := &AppManifest{}
+	a.GetHookAttributes()
+	a = nil
+	a.GetHookAttributes()
+}
+
+func TestAppManifest_GetName(tt *testing.T) {
+	var zeroValue string
+	a := &AppManifest{Name: &zeroValue}
+	a.GetName()
+	a = &AppManifest{}
+	a.GetName()
+	a = nil
+	a.GetName()
+}
+
+func TestAppManifest_GetPublic(tt *testing.T) {
+	var zeroValue bool
+	a := &AppManifest{Public: &zeroValue}
+	a.GetPublic()
+	a = &AppManifest{}
+	a.GetPublic()
+	a = nil
+	a.GetPublic()
+}
+
+func TestAppManifest_GetRedirectURL(tt *testing.T) {
+	var zeroValue string
+	a := &AppManifest{RedirectURL: &zeroValue}
+	a.GetRedirectURL()
+	a = &AppManifest{}
+	a.GetRedirectURL()
+	a = nil
+	a.GetRedirectURL()
+}
+
+func TestAppManifest_GetURL(tt *testing.T) {
+	var zeroValue string
+	a := &AppManifest{URL: &zeroValue}
+	a.GetURL()
+	a = &AppManifest{}
+	a.GetURL()
+	a = nil
+	a.GetURL()
+}
+
+func TestAppManifestHookAttributes_GetActive(tt *testing.T) {
+	var zeroValue bool
+	a := &AppManifestHookAttributes{Active: &zeroValue}
+	a.GetActive()
+	a = &AppManifestHookAttributes{}
+	a.GetActive()
+	a = nil
+	a.GetActive()
+}
+
+func TestAppManifestHookAttributes_GetURL(tt *testing.T) {
+	var zeroValue string
+	a := &AppManifestHookAttributes{URL: &zeroValue}
+	a.GetURL()
+	a = &AppManifestHookAttributes{}
+	a.GetURL()
+	a = nil
+	a.GetURL()
+}
+
 func TestArtifact_GetArchiveDownloadURL(tt *testing.T) {
 	var zeroValue string
 	a := &Artifact{ArchiveDownloadURL: &zeroValue}
@@ -531,14284 +827,20677 @@ func TestArtifactList_GetTotalCount(tt *testing.T) {
 	a.GetTotalCount()
 }
 
-func TestAttachment_GetBody(tt *testing.T) {
+func TestAtomAuthor_GetEmail(tt *testing.T) {
 	var zeroValue string
-	a := &Attachment{Body: &zeroValue}
+	a := &AtomAuthor{Email: &zeroValue}
+	a.GetEmail()
+	a = &AtomAuthor{}
+	a.GetEmail()
+	a = nil
+	a.GetEmail()
+}
+
+func TestAtomAuthor_GetName(tt *testing.T) {
+	var zeroValue string
+	a := &AtomAuthor{Name: &zeroValue}
+	a.GetName()
+	a = &AtomAuthor{}
+	a.GetName()
+	a = nil
+	a.GetName()
+}
+
+func TestAtomAuthor_GetURI(tt *testing.T) {
+	var zeroValue string
+	a := &AtomAuthor{URI: &zeroValue}
+	a.GetURI()
+	a = &AtomAuthor{}
+	a.GetURI()
+	a = nil
+	a.GetURI()
+}
+
+func TestAtomContent_GetBody(tt *testing.T) {
+	var zeroValue string
+	a := &AtomContent{Body: &zeroValue}
 	a.GetBody()
-	a = &Attachment{}
+	a = &AtomContent{}
 	a.GetBody()
 	a = nil
 	a.GetBody()
 }
 
-func TestAttachment_GetID(tt *testing.T) {
-	var zeroValue int64
-	a := &Attachment{ID: &zeroValue}
-	a.GetID()
-	a = &Attachment{}
-	a.GetID()
+func TestAtomContent_GetType(tt *testing.T) {
+	var zeroValue string
+	a := &AtomContent{Type: &zeroValue}
+	a.GetType()
+	a = &AtomContent{}
+	a.GetType()
 	a = nil
-	a.GetID()
+	a.GetType()
 }
 
-func TestAttachment_GetTitle(tt *testing.T) {
+func TestAtomEntry_GetAuthor(tt *testing.T) {
+	a := &AtomEntry{}
+	a.GetAuthor()
+	a = nil
+	a.GetAuthor()
+}
+
+func TestAtomEntry_GetContent(tt *testing.T) {
+	a := &AtomEntry{}
+	a.GetContent()
+	a = nil
+	a.GetContent()
+}
+
+func TestAtomEntry_GetID(tt *testing.T) {
 	var zeroValue string
-	a := &Attachment{Title: &zeroValue}
-	a.GetTitle()
-	a = &Attachment{}
-	a.GetTitle()
+	a := &AtomEntry{ID: &zeroValue}
+	a.GetID()
+	a = &AtomEntry{}
+	a.GetID()
 	a = nil
-	a.GetTitle()
+	a.GetID()
 }
 
-func TestAuthorization_GetApp(tt *testing.T) {
-	a := &Authorization{}
-	a.GetApp()
+func TestAtomEntry_GetPublished(tt *testing.T) {
+	var zeroValue time.Time
+	a := &AtomEntry{Published: &zeroValue}
+	a.GetPublished()
+	a = &AtomEntry{}
+	a.GetPublished()
 	a = nil
-	a.GetApp()
+	a.GetPublished()
 }
 
-func TestAuthorization_GetCreatedAt(tt *testing.T) {
-	var zeroValue Timestamp
-	a := &Authorization{CreatedAt: &zeroValue}
-	a.GetCreatedAt()
-	a = &Authorization{}
-	a.GetCreatedAt()
+func TestAtomEntry_GetThumbnail(tt *testing.T) {
+	a := &AtomEntry{}
+	a.GetThumbnail()
 	a = nil
-	a.GetCreatedAt()
+	a.GetThumbnail()
 }
 
-func TestAuthorization_GetFingerprint(tt *testing.T) {
+func TestAtomEntry_GetTitle(tt *testing.T) {
 	var zeroValue string
-	a := &Authorization{Fingerprint: &zeroValue}
-	a.GetFingerprint()
-	a = &Authorization{}
-	a.GetFingerprint()
+	a := &AtomEntry{Title: &zeroValue}
+	a.GetTitle()
+	a = &AtomEntry{}
+	a.GetTitle()
 	a = nil
-	a.GetFingerprint()
+	a.GetTitle()
 }
 
-func TestAuthorization_GetHashedToken(tt *testing.T) {
-	var zeroValue string
-	a := &Authorization{HashedToken: &zeroValue}
-	a.GetHashedToken()
-	a = &Authorization{}
-	a.GetHashedToken()
+func TestAtomEntry_GetUpdated(tt *testing.T) {
+	var zeroValue time.Time
+	a := &AtomEntry{Updated: &zeroValue}
+	a.GetUpdated()
+	a = &AtomEntry{}
+	a.GetUpdated()
 	a = nil
-	a.GetHashedToken()
+	a.GetUpdated()
 }
 
-func TestAuthorization_GetID(tt *testing.T) {
-	var zeroValue int64
-	a := &Authorization{ID: &zeroValue}
+func TestAtomFeed_GetID(tt *testing.T) {
+	var zeroValue string
+	a := &AtomFeed{ID: &zeroValue}
 	a.GetID()
-	a = &Authorization{}
+	a = &AtomFeed{}
 	a.GetID()
 	a = nil
 	a.GetID()
 }
 
-func TestAuthorization_GetNote(tt *testing.T) {
+func TestAtomFeed_GetTitle(tt *testing.T) {
 	var zeroValue string
-	a := &Authorization{Note: &zeroValue}
-	a.GetNote()
-	a = &Authorization{}
-	a.GetNote()
+	a := &AtomFeed{Title: &zeroValue}
+	a.GetTitle()
+	a = &AtomFeed{}
+	a.GetTitle()
 	a = nil
-	a.GetNote()
+	a.GetTitle()
 }
 
-func TestAuthorization_GetNoteURL(tt *testing.T) {
+func TestAtomFeed_GetUpdated(tt *testing.T) {
+	var zeroValue time.Time
+	a := &AtomFeed{Updated: &zeroValue}
+	a.GetUpdated()
+	a = &AtomFeed{}
+	a.GetUpdated()
+	a = nil
+	a.GetUpdated()
+}
+
+func TestAtomLink_GetHRef(tt *testing.T) {
 	var zeroValue string
-	a := &Authorization{NoteURL: &zeroValue}
-	a.GetNoteURL()
-	a = &Authorization{}
-	a.GetNoteURL()
+	a := &AtomLink{HRef: &zeroValue}
+	a.GetHRef()
+	a = &AtomLink{}
+	a.GetHRef()
 	a = nil
-	a.GetNoteURL()
+	a.GetHRef()
 }
 
-func TestAuthorization_GetToken(tt *testing.T) {
+func TestAtomLink_GetRel(tt *testing.T) {
 	var zeroValue string
-	a := &Authorization{Token: &zeroValue}
-	a.GetToken()
-	a = &Authorization{}
-	a.GetToken()
+	a := &AtomLink{Rel: &zeroValue}
+	a.GetRel()
+	a = &AtomLink{}
+	a.GetRel()
 	a = nil
-	a.GetToken()
+	a.GetRel()
 }
 
-func TestAuthorization_GetTokenLastEight(tt *testing.T) {
+func TestAtomLink_GetType(tt *testing.T) {
 	var zeroValue string
-	a := &Authorization{TokenLastEight: &zeroValue}
-	a.GetTokenLastEight()
-	a = &Authorization{}
-	a.GetTokenLastEight()
+	a := &AtomLink{Type: &zeroValue}
+	a.GetType()
+	a = &AtomLink{}
+	a.GetType()
 	a = nil
-	a.GetTokenLastEight()
+	a.GetType()
 }
 
-func TestAuthorization_GetUpdatedAt(tt *testing.T) {
-	var zeroValue Timestamp
-	a := &Authorization{UpdatedAt: &zeroValue}
-	a.GetUpdatedAt()
-	a = &Authorization{}
-	a.GetUpdatedAt()
+func TestAtomThumbnail_GetHeight(tt *testing.T) {
+	var zeroValue int
+	a := &AtomThumbnail{Height: &zeroValue}
+	a.GetHeight()
+	a = &AtomThumbnail{}
+	a.GetHeight()
 	a = nil
-	a.GetUpdatedAt()
+	a.GetHeight()
 }
 
-func TestAuthorization_GetURL(tt *testing.T) {
+func TestAtomThumbnail_GetURL(tt *testing.T) {
 	var zeroValue string
-	a := &Authorization{URL: &zeroValue}
+	a := &AtomThumbnail{URL: &zeroValue}
 	a.GetURL()
-	a = &Authorization{}
+	a = &AtomThumbnail{}
 	a.GetURL()
 	a = nil
 	a.GetURL()
 }
 
-func TestAuthorization_GetUser(tt *testing.T) {
-	a := &Authorization{}
-	a.GetUser()
+func TestAtomThumbnail_GetWidth(tt *testing.T) {
+	var zeroValue int
+	a := &AtomThumbnail{Width: &zeroValue}
+	a.GetWidth()
+	a = &AtomThumbnail{}
+	a.GetWidth()
 	a = nil
-	a.GetUser()
+	a.GetWidth()
 }
 
-func TestAuthorizationApp_GetClientID(tt *testing.T) {
+func TestAttachment_GetBody(tt *testing.T) {
 	var zeroValue string
-	a := &AuthorizationApp{ClientID: &zeroValue}
-	a.GetClientID()
-	a = &AuthorizationApp{}
-	a.GetClientID()
+	a := &Attachment{Body: &zeroValue}
+	a.GetBody()
+	a = &Attachment{}
+	a.GetBody()
 	a = nil
-	a.GetClientID()
+	a.GetBody()
 }
 
-func TestAuthorizationApp_GetName(tt *testing.T) {
-	var zeroValue string
-	a := &AuthorizationApp{Name: &zeroValue}
-	a.GetName()
-	a = &AuthorizationApp{}
-	a.GetName()
+func TestAttachment_GetID(tt *testing.T) {
+	var zeroValue int64
+	a := &Attachment{ID: &zeroValue}
+	a.GetID()
+	a = &Attachment{}
+	a.GetID()
 	a = nil
-	a.GetName()
+	a.GetID()
 }
 
-func TestAuthorizationApp_GetURL(tt *testing.T) {
+func TestAttachment_GetTitle(tt *testing.T) {
 	var zeroValue string
-	a := &AuthorizationApp{URL: &zeroValue}
-	a.GetURL()
-	a = &AuthorizationApp{}
-	a.GetURL()
+	a := &Attachment{Title: &zeroValue}
+	a.GetTitle()
+	a = &Attachment{}
+	a.GetTitle()
 	a = nil
-	a.GetURL()
+	a.GetTitle()
 }
 
-func TestAuthorizationRequest_GetClientID(tt *testing.T) {
+func TestAttestation_GetRepositoryID(tt *testing.T) {
+	var zeroValue int64
+	a := &Attestation{RepositoryID: &zeroValue}
+	a.GetRepositoryID()
+	a = &Attestation{}
+	a.GetRepositoryID()
+	a = nil
+	a.GetRepositoryID()
+}
+
+func TestAuditEntry_GetAction(tt *testing.T) {
 	var zeroValue string
-	a := &AuthorizationRequest{ClientID: &zeroValue}
-	a.GetClientID()
-	a = &AuthorizationRequest{}
-	a.GetClientID()
+	a := &AuditEntry{Action: &zeroValue}
+	a.GetAction()
+	a = &AuditEntry{}
+	a.GetAction()
 	a = nil
-	a.GetClientID()
+	a.GetAction()
 }
 
-func TestAuthorizationRequest_GetClientSecret(tt *testing.T) {
+func TestAuditEntry_GetActor(tt *testing.T) {
 	var zeroValue string
-	a := &AuthorizationRequest{ClientSecret: &zeroValue}
-	a.GetClientSecret()
-	a = &AuthorizationRequest{}
-	a.GetClientSecret()
+	a := &AuditEntry{Actor: &zeroValue}
+	a.GetActor()
+	a = &AuditEntry{}
+	a.GetActor()
 	a = nil
-	a.GetClientSecret()
+	a.GetActor()
 }
 
-func TestAuthorizationRequest_GetFingerprint(tt *testing.T) {
+func TestAuditEntry_GetActorID(tt *testing.T) {
+	var zeroValue int64
+	a := &AuditEntry{ActorID: &zeroValue}
+	a.GetActorID()
+	a = &AuditEntry{}
+	a.GetActorID()
+	a = nil
+	a.GetActorID()
+}
+
+func TestAuditEntry_GetActorLocation(tt *testing.T) {
+	a := &AuditEntry{}
+	a.GetActorLocation()
+	a = nil
+	a.GetActorLocation()
+}
+
+func TestAuditEntry_GetBusiness(tt *testing.T) {
 	var zeroValue string
-	a := &AuthorizationRequest{Fingerprint: &zeroValue}
-	a.GetFingerprint()
-	a = &AuthorizationRequest{}
-	a.GetFingerprint()
+	a := &AuditEntry{Business: &zeroValue}
+	a.GetBusiness()
+	a = &AuditEntry{}
+	a.GetBusiness()
 	a = nil
-	a.GetFingerprint()
+	a.GetBusiness()
 }
 
-func TestAuthorizationRequest_GetNote(tt *testing.T) {
+func TestAuditEntry_GetBusinessID(tt *testing.T) {
+	var zeroValue int64
+	a := &AuditEntry{BusinessID: &zeroValue}
+	a.GetBusinessID()
+	a = &AuditEntry{}
+	a.GetBusinessID()
+	a = nil
+	a.GetBusinessID()
+}
+
+func TestAuditEntry_GetCreatedAt(tt *testing.T) {
+	var zeroValue Timestamp
+	a := &AuditEntry{CreatedAt: &zeroValue}
+	a.GetCreatedAt()
+	a = &AuditEntry{}
+	a.GetCreatedAt()
+	a = nil
+	a.GetCreatedAt()
+}
+
+func TestAuditEntry_GetDocumentID(tt *testing.T) {
 	var zeroValue string
-	a := &AuthorizationRequest{Note: &zeroValue}
-	a.GetNote()
-	a = &AuthorizationRequest{}
-	a.GetNote()
+	a := &AuditEntry{DocumentID: &zeroValue}
+	a.GetDocumentID()
+	a = &AuditEntry{}
+	a.GetDocumentID()
 	a = nil
-	a.GetNote()
+	a.GetDocumentID()
 }
 
-func TestAuthorizationRequest_GetNoteURL(tt *testing.T) {
+func TestAuditEntry_GetExternalIdentityNameID(tt *testing.T) {
 	var zeroValue string
-	a := &AuthorizationRequest{NoteURL: &zeroValue}
-	a.GetNoteURL()
-	a = &AuthorizationRequest{}
-	a.GetNoteURL()
+	a := &AuditEntry{ExternalIdentityNameID: &zeroValue}
+	a.GetExternalIdentityNameID()
+	a = &AuditEntry{}
+	a.GetExternalIdentityNameID()
 	a = nil
-	a.GetNoteURL()
+	a.GetExternalIdentityNameID()
 }
 
-func TestAuthorizationUpdateRequest_GetFingerprint(tt *testing.T) {
+func TestAuditEntry_GetExternalIdentityUsername(tt *testing.T) {
 	var zeroValue string
-	a := &AuthorizationUpdateRequest{Fingerprint: &zeroValue}
-	a.GetFingerprint()
-	a = &AuthorizationUpdateRequest{}
-	a.GetFingerprint()
+	a := &AuditEntry{ExternalIdentityUsername: &zeroValue}
+	a.GetExternalIdentityUsername()
+	a = &AuditEntry{}
+	a.GetExternalIdentityUsername()
 	a = nil
-	a.GetFingerprint()
+	a.GetExternalIdentityUsername()
 }
 
-func TestAuthorizationUpdateRequest_GetNote(tt *testing.T) {
+func TestAuditEntry_GetHashedToken(tt *testing.T) {
 	var zeroValue string
-	a := &AuthorizationUpdateRequest{Note: &zeroValue}
-	a.GetNote()
-	a = &AuthorizationUpdateRequest{}
-	a.GetNote()
+	a := &AuditEntry{HashedToken: &zeroValue}
+	a.GetHashedToken()
+	a = &AuditEntry{}
+	a.GetHashedToken()
 	a = nil
-	a.GetNote()
+	a.GetHashedToken()
 }
 
-func TestAuthorizationUpdateRequest_GetNoteURL(tt *testing.T) {
+func TestAuditEntry_GetOrg(tt *testing.T) {
 	var zeroValue string
-	a := &AuthorizationUpdateRequest{NoteURL: &zeroValue}
-	a.GetNoteURL()
-	a = &AuthorizationUpdateRequest{}
-	a.GetNoteURL()
+	a := &AuditEntry{Org: &zeroValue}
+	a.GetOrg()
+	a = &AuditEntry{}
+	a.GetOrg()
 	a = nil
-	a.GetNoteURL()
+	a.GetOrg()
 }
 
-func TestAutoTriggerCheck_GetAppID(tt *testing.T) {
+func TestAuditEntry_GetOrgID(tt *testing.T) {
 	var zeroValue int64
-	a := &AutoTriggerCheck{AppID: &zeroValue}
-	a.GetAppID()
-	a = &AutoTriggerCheck{}
-	a.GetAppID()
+	a := &AuditEntry{OrgID: &zeroValue}
+	a.GetOrgID()
+	a = &AuditEntry{}
+	a.GetOrgID()
 	a = nil
-	a.GetAppID()
+	a.GetOrgID()
 }
 
-func TestAutoTriggerCheck_GetSetting(tt *testing.T) {
-	var zeroValue bool
-	a := &AutoTriggerCheck{Setting: &zeroValue}
-	a.GetSetting()
-	a = &AutoTriggerCheck{}
-	a.GetSetting()
+func TestAuditEntry_GetRepo(tt *testing.T) {
+	var zeroValue string
+	a := &AuditEntry{Repo: &zeroValue}
+	a.GetRepo()
+	a = &AuditEntry{}
+	a.GetRepo()
 	a = nil
-	a.GetSetting()
+	a.GetRepo()
 }
 
-func TestBlob_GetContent(tt *testing.T) {
+func TestAuditEntry_GetTeam(tt *testing.T) {
 	var zeroValue string
-	b := &Blob{Content: &zeroValue}
-	b.GetContent()
-	b = &Blob{}
-	b.GetContent()
-	b = nil
-	b.GetContent()
+	a := &AuditEntry{Team: &zeroValue}
+	a.GetTeam()
+	a = &AuditEntry{}
+	a.GetTeam()
+	a = nil
+	a.GetTeam()
 }
 
-func TestBlob_GetEncoding(tt *testing.T) {
-	var zeroValue string
-	b := &Blob{Encoding: &zeroValue}
-	b.GetEncoding()
-	b = &Blob{}
-	b.GetEncoding()
-	b = nil
-	b.GetEncoding()
+func TestAuditEntry_GetTimestamp(tt *testing.T) {
+	var zeroValue Timestamp
+	a := &AuditEntry{Timestamp: &zeroValue}
+	a.GetTimestamp()
+	a = &AuditEntry{}
+	a.GetTimestamp()
+	a = nil
+	a.GetTimestamp()
 }
 
-func TestBlob_GetNodeID(tt *testing.T) {
+func TestAuditEntry_GetTokenID(tt *testing.T) {
+	var zeroValue int64
+	a := &AuditEntry{TokenID: &zeroValue}
+	a.GetTokenID()
+	a = &AuditEntry{}
+	a.GetTokenID()
+	a = nil
+	a.GetTokenID()
+}
+
+func TestAuditEntry_GetTokenScopes(tt *testing.T) {
 	var zeroValue string
-	b := &Blob{NodeID: &zeroValue}
-	b.GetNodeID()
-	b = &Blob{}
-	b.GetNodeID()
-	b = nil
-	b.GetNodeID()
+	a := &AuditEntry{TokenScopes: &zeroValue}
+	a.GetTokenScopes()
+	a = &AuditEntry{}
+	a.GetTokenScopes()
+	a = nil
+	a.GetTokenScopes()
 }
 
-func TestBlob_GetSHA(tt *testing.T) {
+func TestAuditEntry_GetUser(tt *testing.T) {
 	var zeroValue string
-	b := &Blob{SHA: &zeroValue}
-	b.GetSHA()
-	b = &Blob{}
-	b.GetSHA()
-	b = nil
-	b.GetSHA()
+	a := &AuditEntry{User: &zeroValue}
+	a.GetUser()
+	a = &AuditEntry{}
+	a.GetUser()
+	a = nil
+	a.GetUser()
 }
 
-func TestBlob_GetSize(tt *testing.T) {
-	var zeroValue int
-	b := &Blob{Size: &zeroValue}
-	b.GetSize()
-	b = &Blob{}
-	b.GetSize()
-	b = nil
-	b.GetSize()
+func TestAuditEntry_GetUserID(tt *testing.T) {
+	var zeroValue int64
+	a := &AuditEntry{UserID: &zeroValue}
+	a.GetUserID()
+	a = &AuditEntry{}
+	a.GetUserID()
+	a = nil
+	a.GetUserID()
 }
 
-func TestBlob_GetURL(tt *testing.T) {
+func TestAuditEntryGitPayload_GetRepository(tt *testing.T) {
 	var zeroValue string
-	b := &Blob{URL: &zeroValue}
-	b.GetURL()
-	b = &Blob{}
-	b.GetURL()
-	b = nil
-	b.GetURL()
+	a := &AuditEntryGitPayload{Repository: &zeroValue}
+	a.GetRepository()
+	a = &AuditEntryGitPayload{}
+	a.GetRepository()
+	a = nil
+	a.GetRepository()
 }
 
-func TestBranch_GetCommit(tt *testing.T) {
-	b := &Branch{}
-	b.GetCommit()
-	b = nil
-	b.GetCommit()
+func TestAuditEntryGitPayload_GetRepositoryPublic(tt *testing.T) {
+	var zeroValue bool
+	a := &AuditEntryGitPayload{RepositoryPublic: &zeroValue}
+	a.GetRepositoryPublic()
+	a = &AuditEntryGitPayload{}
+	a.GetRepositoryPublic()
+	a = nil
+	a.GetRepositoryPublic()
 }
 
-func TestBranch_GetName(tt *testing.T) {
+func TestAuditEntryGitPayload_GetTransportProtocol(tt *testing.T) {
+	var zeroValue int
+	a := &AuditEntryGitPayload{TransportProtocol: &zeroValue}
+	a.GetTransportProtocol()
+	a = &AuditEntryGitPayload{}
+	a.GetTransportProtocol()
+	a = nil
+	a.GetTransportProtocol()
+}
+
+func TestAuditEntryGitPayload_GetTransportProtocolName(tt *testing.T) {
 	var zeroValue string
-	b := &Branch{Name: &zeroValue}
-	b.GetName()
-	b = &Branch{}
-	b.GetName()
-	b = nil
-	b.GetName()
+	a := &AuditEntryGitPayload{TransportProtocolName: &zeroValue}
+	a.GetTransportProtocolName()
+	a = &AuditEntryGitPayload{}
+	a.GetTransportProtocolName()
+	a = nil
+	a.GetTransportProtocolName()
 }
 
-func TestBranch_GetProtected(tt *testing.T) {
-	var zeroValue bool
-	b := &Branch{Protected: &zeroValue}
-	b.GetProtected()
-	b = &Branch{}
-	b.GetProtected()
-	b = nil
-	b.GetProtected()
+func TestAuditEntryMemberPayload_GetOldPermission(tt *testing.T) {
+	var zeroValue string
+	a := &AuditEntryMemberPayload{OldPermission: &zeroValue}
+	a.GetOldPermission()
+	a = &AuditEntryMemberPayload{}
+	a.GetOldPermission()
+	a = nil
+	a.GetOldPermission()
 }
 
-func TestBranchCommit_GetCommit(tt *testing.T) {
-	b := &BranchCommit{}
-	b.GetCommit()
-	b = nil
-	b.GetCommit()
+func TestAuditEntryMemberPayload_GetPermission(tt *testing.T) {
+	var zeroValue string
+	a := &AuditEntryMemberPayload{Permission: &zeroValue}
+	a.GetPermission()
+	a = &AuditEntryMemberPayload{}
+	a.GetPermission()
+	a = nil
+	a.GetPermission()
 }
 
-func TestBranchCommit_GetName(tt *testing.T) {
+func TestAuditEntryRepoPayload_GetPreviousVisibility(tt *testing.T) {
 	var zeroValue string
-	b := &BranchCommit{Name: &zeroValue}
-	b.GetName()
-	b = &BranchCommit{}
-	b.GetName()
-	b = nil
-	b.GetName()
+	a := &AuditEntryRepoPayload{PreviousVisibility: &zeroValue}
+	a.GetPreviousVisibility()
+	a = &AuditEntryRepoPayload{}
+	a.GetPreviousVisibility()
+	a = nil
+	a.GetPreviousVisibility()
 }
 
-func TestBranchCommit_GetProtected(tt *testing.T) {
+func TestAuditEntryRepoPayload_GetPublicRepo(tt *testing.T) {
 	var zeroValue bool
-	b := &BranchCommit{Protected: &zeroValue}
-	b.GetProtected()
-	b = &BranchCommit{}
-	b.GetProtected()
-	b = nil
-	b.GetProtected()
+	a := &AuditEntryRepoPayload{PublicRepo: &zeroValue}
+	a.GetPublicRepo()
+	a = &AuditEntryRepoPayload{}
+	a.GetPublicRepo()
+	a = nil
+	a.GetPublicRepo()
 }
 
-func TestBranchListOptions_GetProtected(tt *testing.T) {
-	var zeroValue bool
-	b := &BranchListOptions{Protected: &zeroValue}
-	b.GetProtected()
-	b = &BranchListOptions{}
-	b.GetProtected()
-	b = nil
-	b.GetProtected()
+func TestAuditEntryRepoPayload_GetVisibility(tt *testing.T) {
+	var zeroValue string
+	a := &AuditEntryRepoPayload{Visibility: &zeroValue}
+	a.GetVisibility()
+	a = &AuditEntryRepoPayload{}
+	a.GetVisibility()
+	a = nil
+	a.GetVisibility()
 }
 
-func TestCheckRun_GetApp(tt *testing.T) {
-	c := &CheckRun{}
-	c.GetApp()
-	c = nil
-	c.GetApp()
+func TestAuthorization_GetApp(tt *testing.T) {
+	a := &Authorization{}
+	a.GetApp()
+	a = nil
+	a.GetApp()
 }
 
-func TestCheckRun_GetCheckSuite(tt *testing.T) {
-	c := &CheckRun{}
-	c.GetCheckSuite()
-	c = nil
-	c.GetCheckSuite()
+func TestAuthorization_GetCreatedAt(tt *testing.T) {
+	var zeroValue Timestamp
+	a := &Authorization{CreatedAt: &zeroValue}
+	a.GetCreatedAt()
+	a = &Authorization{}
+	a.GetCreatedAt()
+	a = nil
+	a.GetCreatedAt()
 }
 
-func TestCheckRun_GetCompletedAt(tt *testing.T) {
+func TestAuthorization_GetExpiresAt(tt *testing.T) {
 	var zeroValue Timestamp
-	c := &CheckRun{CompletedAt: &zeroValue}
-	c.GetCompletedAt()
-	c = &CheckRun{}
-	c.GetCompletedAt()
-	c = nil
-	c.GetCompletedAt()
+	a := &Authorization{ExpiresAt: &zeroValue}
+	a.GetExpiresAt()
+	a = &Authorization{}
+	a.GetExpiresAt()
+	a = nil
+	a.GetExpiresAt()
 }
 
-func TestCheckRun_GetConclusion(tt *testing.T) {
+func TestAuthorization_GetFingerprint(tt *testing.T) {
 	var zeroValue string
-	c := &CheckRun{Conclusion: &zeroValue}
-	c.GetConclusion()
-	c = &CheckRun{}
-	c.GetConclusion()
-	c = nil
-	c.GetConclusion()
+	a := &Authorization{Fingerprint: &zeroValue}
+	a.GetFingerprint()
+	a = &Authorization{}
+	a.GetFingerprint()
+	a = nil
+	a.GetFingerprint()
 }
 
-func TestCheckRun_GetDetailsURL(tt *testing.T) {
+func TestAuthorization_GetHashedToken(tt *testing.T) {
 	var zeroValue string
-	c := &CheckRun{DetailsURL: &zeroValue}
-	c.GetDetailsURL()
-	c = &CheckRun{}
-	c.GetDetailsURL()
-	c = nil
-	c.GetDetailsURL()
+	a := &Authorization{HashedToken: &zeroValue}
+	a.GetHashedToken()
+	a = &Authorization{}
+	a.GetHashedToken()
+	a = nil
+	a.GetHashedToken()
 }
 
-func TestCheckRun_GetExternalID(tt *testing.T) {
-	var zeroValue string
-	c := &CheckRun{ExternalID: &zeroValue}
-	c.GetExternalID()
-	c = &CheckRun{}
-	c.GetExternalID()
-	c = nil
-	c.GetExternalID()
+func TestAuthorization_GetID(tt *testing.T) {
+	var zeroValue int64
+	a := &Authorization{ID: &zeroValue}
+	a.GetID()
+	a = &Authorization{}
+	a.GetID()
+	a = nil
+	a.GetID()
 }
 
-func TestCheckRun_GetHeadSHA(tt *testing.T) {
-	var zeroValue string
-	c := &CheckRun{HeadSHA: &zeroValue}
-	c.GetHeadSHA()
-	c = &CheckRun{}
-	c.GetHeadSHA()
-	c = nil
-	c.GetHeadSHA()
+func TestAuthorization_GetInstallation(tt *testing.T) {
+	a := &Authorization{}
+	a.GetInstallation()
+	a = nil
+	a.GetInstallation()
 }
 
-func TestCheckRun_GetHTMLURL(tt *testing.T) {
+func TestAuthorization_GetNote(tt *testing.T) {
 	var zeroValue string
-	c := &CheckRun{HTMLURL: &zeroValue}
-	c.GetHTMLURL()
-	c = &CheckRun{}
-	c.GetHTMLURL()
-	c = nil
-	c.GetHTMLURL()
-}
-
-func TestCheckRun_GetID(tt *testing.T) {
-	var zeroValue int64
-	c := &CheckRun{ID: &zeroValue}
-	c.GetID()
-	c = &CheckRun{}
-	c.GetID()
-	c = nil
-	c.GetID()
+	a := &Authorization{Note: &zeroValue}
+	a.GetNote()
+	a = &Authorization{}
+	a.GetNote()
+	a = nil
+	a.GetNote()
 }
 
-func TestCheckRun_GetName(tt *testing.T) {
+func TestAuthorization_GetNoteURL(tt *testing.T) {
 	var zeroValue string
-	c := &CheckRun{Name: &zeroValue}
-	c.GetName()
-	c = &CheckRun{}
-	c.GetName()
-	c = nil
-	c.GetName()
+	a := &Authorization{NoteURL: &zeroValue}
+	a.GetNoteURL()
+	a = &Authorization{}
+	a.GetNoteURL()
+	a = nil
+	a.GetNoteURL()
 }
 
-func TestCheckRun_GetNodeID(tt *testing.T) {
+func TestAuthorization_GetToken(tt *testing.T) {
 	var zeroValue string
-	c := &CheckRun{NodeID: &zeroValue}
-	c.GetNodeID()
-	c = &CheckRun{}
-	c.GetNodeID()
-	c = nil
-	c.GetNodeID()
+	a := &Authorization{Token: &zeroValue}
+	a.GetToken()
+	a = &Authorization{}
+	a.GetToken()
+	a = nil
+	a.GetToken()
 }
 
-func TestCheckRun_GetOutput(tt *testing.T) {
-	c := &CheckRun{}
-	c.GetOutput()
-	c = nil
-	c.GetOutput()
+func TestAuthorization_GetTokenLastEight(tt *testing.T) {
+	var zeroValue string
+	a := &Authorization{TokenLastEight: &zeroValue}
+	a.GetTokenLastEight()
+	a = &Authorization{}
+	a.GetTokenLastEight()
+	a = nil
+	a.GetTokenLastEight()
 }
 
-func TestCheckRun_GetStartedAt(tt *testing.T) {
+func TestAuthorization_GetUpdatedAt(tt *testing.T) {
 	var zeroValue Timestamp
-	c := &CheckRun{StartedAt: &zeroValue}
-	c.GetStartedAt()
-	c = &CheckRun{}
-	c.GetStartedAt()
-	c = nil
-	c.GetStartedAt()
+	a := &Authorization{UpdatedAt: &zeroValue}
+	a.GetUpdatedAt()
+	a = &Authorization{}
+	a.GetUpdatedAt()
+	a = nil
+	a.GetUpdatedAt()
 }
 
-func TestCheckRun_GetStatus(tt *testing.T) {
+func TestAuthorization_GetURL(tt *testing.T) {
 	var zeroValue string
-	c := &CheckRun{Status: &zeroValue}
-	c.GetStatus()
-	c = &CheckRun{}
-	c.GetStatus()
-	c = nil
-	c.GetStatus()
+	a := &Authorization{URL: &zeroValue}
+	a.GetURL()
+	a = &Authorization{}
+	a.GetURL()
+	a = nil
+	a.GetURL()
 }
 
-func TestCheckRun_GetURL(tt *testing.T) {
-	var zeroValue string
-	c := &CheckRun{URL: &zeroValue}
-	c.GetURL()
-	c = &CheckRun{}
-	c.GetURL()
-	c = nil
-	c.GetURL()
+func TestAuthorization_GetUser(tt *testing.T) {
+	a := &Authorization{}
+	a.GetUser()
+	a = nil
+	a.GetUser()
 }
 
-func TestCheckRunAnnotation_GetAnnotationLevel(tt *testing.T) {
+func TestAuthorizationApp_GetClientID(tt *testing.T) {
 	var zeroValue string
-	c := &CheckRunAnnotation{AnnotationLevel: &zeroValue}
-	c.GetAnnotationLevel()
-	c = &CheckRunAnnotation{}
-	c.GetAnnotationLevel()
-	c = nil
-	c.GetAnnotationLevel()
-}
-
-func TestCheckRunAnnotation_GetEndColumn(tt *testing.T) {
-	var zeroValue int
-	c := &CheckRunAnnotation{EndColumn: &zeroValue}
-	c.GetEndColumn()
-	c = &CheckRunAnnotation{}
-	c.GetEndColumn()
-	c = nil
-	c.GetEndColumn()
+	a := &AuthorizationApp{ClientID: &zeroValue}
+	a.GetClientID()
+	a = &AuthorizationApp{}
+	a.GetClientID()
+	a = nil
+	a.GetClientID()
 }
 
-func TestCheckRunAnnotation_GetEndLine(tt *testing.T) {
-	var zeroValue int
-	c := &CheckRunAnnotation{EndLine: &zeroValue}
-	c.GetEndLine()
-	c = &CheckRunAnnotation{}
-	c.GetEndLine()
-	c = nil
-	c.GetEndLine()
+func TestAuthorizationApp_GetName(tt *testing.T) {
+	var zeroValue string
+	a := &AuthorizationApp{Name: &zeroValue}
+	a.GetName()
+	a = &AuthorizationApp{}
+	a.GetName()
+	a = nil
+	a.GetName()
 }
 
-func TestCheckRunAnnotation_GetMessage(tt *testing.T) {
+func TestAuthorizationApp_GetURL(tt *testing.T) {
 	var zeroValue string
-	c := &CheckRunAnnotation{Message: &zeroValue}
-	c.GetMessage()
-	c = &CheckRunAnnotation{}
-	c.GetMessage()
-	c = nil
-	c.GetMessage()
+	a := &AuthorizationApp{URL: &zeroValue}
+	a.GetURL()
+	a = &AuthorizationApp{}
+	a.GetURL()
+	a = nil
+	a.GetURL()
 }
 
-func TestCheckRunAnnotation_GetPath(tt *testing.T) {
+func TestAuthorizationRequest_GetClientID(tt *testing.T) {
 	var zeroValue string
-	c := &CheckRunAnnotation{Path: &zeroValue}
-	c.GetPath()
-	c = &CheckRunAnnotation{}
-	c.GetPath()
-	c = nil
-	c.GetPath()
+	a := &AuthorizationRequest{ClientID: &zeroValue}
+	a.GetClientID()
+	a = &AuthorizationRequest{}
+	a.GetClientID()
+	a = nil
+	a.GetClientID()
 }
 
-func TestCheckRunAnnotation_GetRawDetails(tt *testing.T) {
+func TestAuthorizationRequest_GetClientSecret(tt *testing.T) {
 	var zeroValue string
-	c := &CheckRunAnnotation{RawDetails: &zeroValue}
-	c.GetRawDetails()
-	c = &CheckRunAnnotation{}
-	c.GetRawDetails()
-	c = nil
-	c.GetRawDetails()
+	a := &AuthorizationRequest{ClientSecret: &zeroValue}
+	a.GetClientSecret()
+	a = &AuthorizationRequest{}
+	a.GetClientSecret()
+	a = nil
+	a.GetClientSecret()
 }
 
-func TestCheckRunAnnotation_GetStartColumn(tt *testing.T) {
-	var zeroValue int
-	c := &CheckRunAnnotation{StartColumn: &zeroValue}
-	c.GetStartColumn()
-	c = &CheckRunAnnotation{}
-	c.GetStartColumn()
-	c = nil
-	c.GetStartColumn()
+func TestAuthorizationRequest_GetFingerprint(tt *testing.T) {
+	var zeroValue string
+	a := &AuthorizationRequest{Fingerprint: &zeroValue}
+	a.GetFingerprint()
+	a = &AuthorizationRequest{}
+	a.GetFingerprint()
+	a = nil
+	a.GetFingerprint()
 }
 
-func TestCheckRunAnnotation_GetStartLine(tt *testing.T) {
-	var zeroValue int
-	c := &CheckRunAnnotation{StartLine: &zeroValue}
-	c.GetStartLine()
-	c = &CheckRunAnnotation{}
-	c.GetStartLine()
-	c = nil
-	c.GetStartLine()
+func TestAuthorizationRequest_GetNote(tt *testing.T) {
+	var zeroValue string
+	a := &AuthorizationRequest{Note: &zeroValue}
+	a.GetNote()
+	a = &AuthorizationRequest{}
+	a.GetNote()
+	a = nil
+	a.GetNote()
 }
 
-func TestCheckRunAnnotation_GetTitle(tt *testing.T) {
+func TestAuthorizationRequest_GetNoteURL(tt *testing.T) {
 	var zeroValue string
-	c := &CheckRunAnnotation{Title: &zeroValue}
-	c.GetTitle()
-	c = &CheckRunAnnotation{}
-	c.GetTitle()
-	c = nil
-	c.GetTitle()
+	a := &AuthorizationRequest{NoteURL: &zeroValue}
+	a.GetNoteURL()
+	a = &AuthorizationRequest{}
+	a.GetNoteURL()
+	a = nil
+	a.GetNoteURL()
 }
 
-func TestCheckRunEvent_GetAction(tt *testing.T) {
+func TestAuthorizationUpdateRequest_GetFingerprint(tt *testing.T) {
 	var zeroValue string
-	c := &CheckRunEvent{Action: &zeroValue}
-	c.GetAction()
-	c = &CheckRunEvent{}
-	c.GetAction()
-	c = nil
-	c.GetAction()
+	a := &AuthorizationUpdateRequest{Fingerprint: &zeroValue}
+	a.GetFingerprint()
+	a = &AuthorizationUpdateRequest{}
+	a.GetFingerprint()
+	a = nil
+	a.GetFingerprint()
 }
 
-func TestCheckRunEvent_GetCheckRun(tt *testing.T) {
-	c := &CheckRunEvent{}
-	c.GetCheckRun()
-	c = nil
-	c.GetCheckRun()
+func TestAuthorizationUpdateRequest_GetNote(tt *testing.T) {
+	var zeroValue string
+	a := &AuthorizationUpdateRequest{Note: &zeroValue}
+	a.GetNote()
+	a = &AuthorizationUpdateRequest{}
+	a.GetNote()
+	a = nil
+	a.GetNote()
 }
 
-func TestCheckRunEvent_GetInstallation(tt *testing.T) {
-	c := &CheckRunEvent{}
-	c.GetInstallation()
-	c = nil
-	c.GetInstallation()
+func TestAuthorizationUpdateRequest_GetNoteURL(tt *testing.T) {
+	var zeroValue string
+	a := &AuthorizationUpdateRequest{NoteURL: &zeroValue}
+	a.GetNoteURL()
+	a = &AuthorizationUpdateRequest{}
+	a.GetNoteURL()
+	a = nil
+	a.GetNoteURL()
 }
 
-func TestCheckRunEvent_GetOrg(tt *testing.T) {
-	c := &CheckRunEvent{}
-	c.GetOrg()
-	c = nil
-	c.GetOrg()
+func TestAutolink_GetID(tt *testing.T) {
+	var zeroValue int64
+	a := &Autolink{ID: &zeroValue}
+	a.GetID()
+	a = &Autolink{}
+	a.GetID()
+	a = nil
+	a.GetID()
 }
 
-func TestCheckRunEvent_GetRepo(tt *testing.T) {
-	c := &CheckRunEvent{}
-	c.GetRepo()
-	c = nil
-	c.GetRepo()
+func TestAutolink_GetIsAlphanumeric(tt *testing.T) {
+	var zeroValue bool
+	a := &Autolink{IsAlphanumeric: &zeroValue}
+	a.GetIsAlphanumeric()
+	a = &Autolink{}
+	a.GetIsAlphanumeric()
+	a = nil
+	a.GetIsAlphanumeric()
 }
 
-func TestCheckRunEvent_GetRequestedAction(tt *testing.T) {
-	c := &CheckRunEvent{}
-	c.GetRequestedAction()
-	c = nil
-	c.GetRequestedAction()
+func TestAutolink_GetKeyPrefix(tt *testing.T) {
+	var zeroValue string
+	a := &Autolink{KeyPrefix: &zeroValue}
+	a.GetKeyPrefix()
+	a = &Autolink{}
+	a.GetKeyPrefix()
+	a = nil
+	a.GetKeyPrefix()
 }
 
-func TestCheckRunEvent_GetSender(tt *testing.T) {
-	c := &CheckRunEvent{}
-	c.GetSender()
-	c = nil
-	c.GetSender()
+func TestAutolink_GetURLTemplate(tt *testing.T) {
+	var zeroValue string
+	a := &Autolink{URLTemplate: &zeroValue}
+	a.GetURLTemplate()
+	a = &Autolink{}
+	a.GetURLTemplate()
+	a = nil
+	a.GetURLTemplate()
 }
 
-func TestCheckRunImage_GetAlt(tt *testing.T) {
-	var zeroValue string
-	c := &CheckRunImage{Alt: &zeroValue}
-	c.GetAlt()
-	c = &CheckRunImage{}
-	c.GetAlt()
-	c = nil
-	c.GetAlt()
+func TestAutolinkOptions_GetIsAlphanumeric(tt *testing.T) {
+	var zeroValue bool
+	a := &AutolinkOptions{IsAlphanumeric: &zeroValue}
+	a.GetIsAlphanumeric()
+	a = &AutolinkOptions{}
+	a.GetIsAlphanumeric()
+	a = nil
+	a.GetIsAlphanumeric()
 }
 
-func TestCheckRunImage_GetCaption(tt *testing.T) {
+func TestAutolinkOptions_GetKeyPrefix(tt *testing.T) {
 	var zeroValue string
-	c := &CheckRunImage{Caption: &zeroValue}
-	c.GetCaption()
-	c = &CheckRunImage{}
-	c.GetCaption()
-	c = nil
-	c.GetCaption()
+	a := &AutolinkOptions{KeyPrefix: &zeroValue}
+	a.GetKeyPrefix()
+	a = &AutolinkOptions{}
+	a.GetKeyPrefix()
+	a = nil
+	a.GetKeyPrefix()
 }
 
-func TestCheckRunImage_GetImageURL(tt *testing.T) {
+func TestAutolinkOptions_GetURLTemplate(tt *testing.T) {
 	var zeroValue string
-	c := &CheckRunImage{ImageURL: &zeroValue}
-	c.GetImageURL()
-	c = &CheckRunImage{}
-	c.GetImageURL()
-	c = nil
-	c.GetImageURL()
+	a := &AutolinkOptions{URLTemplate: &zeroValue}
+	a.GetURLTemplate()
+	a = &AutolinkOptions{}
+	a.GetURLTemplate()
+	a = nil
+	a.GetURLTemplate()
 }
 
-func TestCheckRunOutput_GetAnnotationsCount(tt *testing.T) {
-	var zeroValue int
-	c := &CheckRunOutput{AnnotationsCount: &zeroValue}
-	c.GetAnnotationsCount()
-	c = &CheckRunOutput{}
-	c.GetAnnotationsCount()
-	c = nil
-	c.GetAnnotationsCount()
+func TestAutoTriggerCheck_GetAppID(tt *testing.T) {
+	var zeroValue int64
+	a := &AutoTriggerCheck{AppID: &zeroValue}
+	a.GetAppID()
+	a = &AutoTriggerCheck{}
+	a.GetAppID()
+	a = nil
+	a.GetAppID()
 }
 
-func TestCheckRunOutput_GetAnnotationsURL(tt *testing.T) {
-	var zeroValue string
-	c := &CheckRunOutput{AnnotationsURL: &zeroValue}
-	c.GetAnnotationsURL()
-	c = &CheckRunOutput{}
-	c.GetAnnotationsURL()
-	c = nil
-	c.GetAnnotationsURL()
+func TestAutoTriggerCheck_GetSetting(tt *testing.T) {
+	var zeroValue bool
+	a := &AutoTriggerCheck{Setting: &zeroValue}
+	a.GetSetting()
+	a = &AutoTriggerCheck{}
+	a.GetSetting()
+	a = nil
+	a.GetSetting()
 }
 
-func TestCheckRunOutput_GetSummary(tt *testing.T) {
+func TestBlob_GetContent(tt *testing.T) {
 	var zeroValue string
-	c := &CheckRunOutput{Summary: &zeroValue}
-	c.GetSummary()
-	c = &CheckRunOutput{}
-	c.GetSummary()
-	c = nil
-	c.GetSummary()
+	b := &Blob{Content: &zeroValue}
+	b.GetContent()
+	b = &Blob{}
+	b.GetContent()
+	b = nil
+	b.GetContent()
 }
 
-func TestCheckRunOutput_GetText(tt *testing.T) {
+func TestBlob_GetEncoding(tt *testing.T) {
 	var zeroValue string
-	c := &CheckRunOutput{Text: &zeroValue}
-	c.GetText()
-	c = &CheckRunOutput{}
-	c.GetText()
-	c = nil
-	c.GetText()
+	b := &Blob{Encoding: &zeroValue}
+	b.GetEncoding()
+	b = &Blob{}
+	b.GetEncoding()
+	b = nil
+	b.GetEncoding()
 }
 
-func TestCheckRunOutput_GetTitle(tt *testing.T) {
+func TestBlob_GetNodeID(tt *testing.T) {
 	var zeroValue string
-	c := &CheckRunOutput{Title: &zeroValue}
-	c.GetTitle()
-	c = &CheckRunOutput{}
-	c.GetTitle()
-	c = nil
-	c.GetTitle()
+	b := &Blob{NodeID: &zeroValue}
+	b.GetNodeID()
+	b = &Blob{}
+	b.GetNodeID()
+	b = nil
+	b.GetNodeID()
 }
 
-func TestCheckSuite_GetAfterSHA(tt *testing.T) {
+func TestBlob_GetSHA(tt *testing.T) {
 	var zeroValue string
-	c := &CheckSuite{AfterSHA: &zeroValue}
-	c.GetAfterSHA()
-	c = &CheckSuite{}
-	c.GetAfterSHA()
-	c = nil
-	c.GetAfterSHA()
-}
-
-func TestCheckSuite_GetApp(tt *testing.T) {
-	c := &CheckSuite{}
-	c.GetApp()
-	c = nil
-	c.GetApp()
+	b := &Blob{SHA: &zeroValue}
+	b.GetSHA()
+	b = &Blob{}
+	b.GetSHA()
+	b = nil
+	b.GetSHA()
 }
 
-func TestCheckSuite_GetBeforeSHA(tt *testing.T) {
-	var zeroValue string
-	c := &CheckSuite{BeforeSHA: &zeroValue}
-	c.GetBeforeSHA()
-	c = &CheckSuite{}
-	c.GetBeforeSHA()
-	c = nil
-	c.GetBeforeSHA()
+func TestBlob_GetSize(tt *testing.T) {
+	var zeroValue int
+	b := &Blob{Size: &zeroValue}
+	b.GetSize()
+	b = &Blob{}
+	b.GetSize()
+	b = nil
+	b.GetSize()
 }
 
-func TestCheckSuite_GetConclusion(tt *testing.T) {
+func TestBlob_GetURL(tt *testing.T) {
 	var zeroValue string
-	c := &CheckSuite{Conclusion: &zeroValue}
-	c.GetConclusion()
-	c = &CheckSuite{}
-	c.GetConclusion()
-	c = nil
-	c.GetConclusion()
+	b := &Blob{URL: &zeroValue}
+	b.GetURL()
+	b = &Blob{}
+	b.GetURL()
+	b = nil
+	b.GetURL()
 }
 
-func TestCheckSuite_GetHeadBranch(tt *testing.T) {
+func TestBranch_GetCommit(tt *testing.T) {
+	b := &Branch{}
+	b.GetCommit()
+	b = nil
+	b.GetCommit()
+}
+
+func TestBranch_GetName(tt *testing.T) {
 	var zeroValue string
-	c := &CheckSuite{HeadBranch: &zeroValue}
-	c.GetHeadBranch()
-	c = &CheckSuite{}
-	c.GetHeadBranch()
-	c = nil
-	c.GetHeadBranch()
+	b := &Branch{Name: &zeroValue}
+	b.GetName()
+	b = &Branch{}
+	b.GetName()
+	b = nil
+	b.GetName()
 }
 
-func TestCheckSuite_GetHeadCommit(tt *testing.T) {
-	c := &CheckSuite{}
-	c.GetHeadCommit()
-	c = nil
-	c.GetHeadCommit()
+func TestBranch_GetProtected(tt *testing.T) {
+	var zeroValue bool
+	b := &Branch{Protected: &zeroValue}
+	b.GetProtected()
+	b = &Branch{}
+	b.GetProtected()
+	b = nil
+	b.GetProtected()
 }
 
-func TestCheckSuite_GetHeadSHA(tt *testing.T) {
+func TestBranchCommit_GetCommit(tt *testing.T) {
+	b := &BranchCommit{}
+	b.GetCommit()
+	b = nil
+	b.GetCommit()
+}
+
+func TestBranchCommit_GetName(tt *testing.T) {
 	var zeroValue string
-	c := &CheckSuite{HeadSHA: &zeroValue}
-	c.GetHeadSHA()
-	c = &CheckSuite{}
-	c.GetHeadSHA()
-	c = nil
-	c.GetHeadSHA()
+	b := &BranchCommit{Name: &zeroValue}
+	b.GetName()
+	b = &BranchCommit{}
+	b.GetName()
+	b = nil
+	b.GetName()
 }
 
-func TestCheckSuite_GetID(tt *testing.T) {
+func TestBranchCommit_GetProtected(tt *testing.T) {
+	var zeroValue bool
+	b := &BranchCommit{Protected: &zeroValue}
+	b.GetProtected()
+	b = &BranchCommit{}
+	b.GetProtected()
+	b = nil
+	b.GetProtected()
+}
+
+func TestBranchListOptions_GetProtected(tt *testing.T) {
+	var zeroValue bool
+	b := &BranchListOptions{Protected: &zeroValue}
+	b.GetProtected()
+	b = &BranchListOptions{}
+	b.GetProtected()
+	b = nil
+	b.GetProtected()
+}
+
+func TestBypassActor_GetActorID(tt *testing.T) {
 	var zeroValue int64
-	c := &CheckSuite{ID: &zeroValue}
-	c.GetID()
-	c = &CheckSuite{}
-	c.GetID()
-	c = nil
-	c.GetID()
+	b := &BypassActor{ActorID: &zeroValue}
+	b.GetActorID()
+	b = &BypassActor{}
+	b.GetActorID()
+	b = nil
+	b.GetActorID()
 }
 
-func TestCheckSuite_GetNodeID(tt *testing.T) {
+func TestBypassActor_GetActorType(tt *testing.T) {
 	var zeroValue string
-	c := &CheckSuite{NodeID: &zeroValue}
-	c.GetNodeID()
-	c = &CheckSuite{}
-	c.GetNodeID()
-	c = nil
-	c.GetNodeID()
+	b := &BypassActor{ActorType: &zeroValue}
+	b.GetActorType()
+	b = &BypassActor{}
+	b.GetActorType()
+	b = nil
+	b.GetActorType()
 }
 
-func TestCheckSuite_GetRepository(tt *testing.T) {
-	c := &CheckSuite{}
-	c.GetRepository()
-	c = nil
-	c.GetRepository()
+func TestBypassActor_GetBypassMode(tt *testing.T) {
+	var zeroValue string
+	b := &BypassActor{BypassMode: &zeroValue}
+	b.GetBypassMode()
+	b = &BypassActor{}
+	b.GetBypassMode()
+	b = nil
+	b.GetBypassMode()
 }
 
-func TestCheckSuite_GetStatus(tt *testing.T) {
+func TestBypassRequestActor_GetActorID(tt *testing.T) {
+	var zeroValue int64
+	b := &BypassRequestActor{ActorID: &zeroValue}
+	b.GetActorID()
+	b = &BypassRequestActor{}
+	b.GetActorID()
+	b = nil
+	b.GetActorID()
+}
+
+func TestBypassRequestActor_GetActorName(tt *testing.T) {
 	var zeroValue string
-	c := &CheckSuite{Status: &zeroValue}
-	c.GetStatus()
-	c = &CheckSuite{}
-	c.GetStatus()
-	c = nil
-	c.GetStatus()
+	b := &BypassRequestActor{ActorName: &zeroValue}
+	b.GetActorName()
+	b = &BypassRequestActor{}
+	b.GetActorName()
+	b = nil
+	b.GetActorName()
 }
 
-func TestCheckSuite_GetURL(tt *testing.T) {
+func TestBypassRequestData_GetBypassReason(tt *testing.T) {
 	var zeroValue string
-	c := &CheckSuite{URL: &zeroValue}
-	c.GetURL()
-	c = &CheckSuite{}
-	c.GetURL()
-	c = nil
-	c.GetURL()
+	b := &BypassRequestData{BypassReason: &zeroValue}
+	b.GetBypassReason()
+	b = &BypassRequestData{}
+	b.GetBypassReason()
+	b = nil
+	b.GetBypassReason()
 }
 
-func TestCheckSuiteEvent_GetAction(tt *testing.T) {
+func TestBypassRequestData_GetLocation(tt *testing.T) {
+	b := &BypassRequestData{}
+	b.GetLocation()
+	b = nil
+	b.GetLocation()
+}
+
+func TestBypassRequestData_GetSecretType(tt *testing.T) {
 	var zeroValue string
-	c := &CheckSuiteEvent{Action: &zeroValue}
-	c.GetAction()
-	c = &CheckSuiteEvent{}
-	c.GetAction()
-	c = nil
-	c.GetAction()
+	b := &BypassRequestData{SecretType: &zeroValue}
+	b.GetSecretType()
+	b = &BypassRequestData{}
+	b.GetSecretType()
+	b = nil
+	b.GetSecretType()
 }
 
-func TestCheckSuiteEvent_GetCheckSuite(tt *testing.T) {
-	c := &CheckSuiteEvent{}
-	c.GetCheckSuite()
-	c = nil
-	c.GetCheckSuite()
+func TestBypassRequestLocation_GetCommitSHA(tt *testing.T) {
+	var zeroValue string
+	b := &BypassRequestLocation{CommitSHA: &zeroValue}
+	b.GetCommitSHA()
+	b = &BypassRequestLocation{}
+	b.GetCommitSHA()
+	b = nil
+	b.GetCommitSHA()
 }
 
-func TestCheckSuiteEvent_GetInstallation(tt *testing.T) {
-	c := &CheckSuiteEvent{}
-	c.GetInstallation()
-	c = nil
-	c.GetInstallation()
+func TestBypassRequestLocation_GetEndLine(tt *testing.T) {
+	var zeroValue int
+	b := &BypassRequestLocation{EndLine: &zeroValue}
+	b.GetEndLine()
+	b = &BypassRequestLocation{}
+	b.GetEndLine()
+	b = nil
+	b.GetEndLine()
 }
 
-func TestCheckSuiteEvent_GetOrg(tt *testing.T) {
-	c := &CheckSuiteEvent{}
-	c.GetOrg()
-	c = nil
-	c.GetOrg()
+func TestBypassRequestLocation_GetPath(tt *testing.T) {
+	var zeroValue string
+	b := &BypassRequestLocation{Path: &zeroValue}
+	b.GetPath()
+	b = &BypassRequestLocation{}
+	b.GetPath()
+	b = nil
+	b.GetPath()
 }
 
-func TestCheckSuiteEvent_GetRepo(tt *testing.T) {
-	c := &CheckSuiteEvent{}
-	c.GetRepo()
-	c = nil
-	c.GetRepo()
+func TestBypassRequestLocation_GetStartLine(tt *testing.T) {
+	var zeroValue int
+	b := &BypassRequestLocation{StartLine: &zeroValue}
+	b.GetStartLine()
+	b = &BypassRequestLocation{}
+	b.GetStartLine()
+	b = nil
+	b.GetStartLine()
 }
 
-func TestCheckSuiteEvent_GetSender(tt *testing.T) {
-	c := &CheckSuiteEvent{}
-	c.GetSender()
-	c = nil
-	c.GetSender()
+func TestBypassResponse_GetCreatedAt(tt *testing.T) {
+	var zeroValue Timestamp
+	b := &BypassResponse{CreatedAt: &zeroValue}
+	b.GetCreatedAt()
+	b = &BypassResponse{}
+	b.GetCreatedAt()
+	b = nil
+	b.GetCreatedAt()
 }
 
-func TestCheckSuitePreferenceResults_GetPreferences(tt *testing.T) {
-	c := &CheckSuitePreferenceResults{}
-	c.GetPreferences()
-	c = nil
-	c.GetPreferences()
+func TestBypassResponse_GetID(tt *testing.T) {
+	var zeroValue int64
+	b := &BypassResponse{ID: &zeroValue}
+	b.GetID()
+	b = &BypassResponse{}
+	b.GetID()
+	b = nil
+	b.GetID()
 }
 
-func TestCheckSuitePreferenceResults_GetRepository(tt *testing.T) {
-	c := &CheckSuitePreferenceResults{}
-	c.GetRepository()
-	c = nil
-	c.GetRepository()
+func TestBypassResponse_GetReviewer(tt *testing.T) {
+	b := &BypassResponse{}
+	b.GetReviewer()
+	b = nil
+	b.GetReviewer()
 }
 
-func TestCodeOfConduct_GetBody(tt *testing.T) {
+func TestBypassResponse_GetStatus(tt *testing.T) {
 	var zeroValue string
-	c := &CodeOfConduct{Body: &zeroValue}
-	c.GetBody()
-	c = &CodeOfConduct{}
-	c.GetBody()
+	b := &BypassResponse{Status: &zeroValue}
+	b.GetStatus()
+	b = &BypassResponse{}
+	b.GetStatus()
+	b = nil
+	b.GetStatus()
+}
+
+func TestCheckRun_GetApp(tt *testing.T) {
+	c := &CheckRun{}
+	c.GetApp()
 	c = nil
-	c.GetBody()
+	c.GetApp()
 }
 
-func TestCodeOfConduct_GetKey(tt *testing.T) {
-	var zeroValue string
-	c := &CodeOfConduct{Key: &zeroValue}
-	c.GetKey()
-	c = &CodeOfConduct{}
-	c.GetKey()
+func TestCheckRun_GetCheckSuite(tt *testing.T) {
+	c := &CheckRun{}
+	c.GetCheckSuite()
 	c = nil
-	c.GetKey()
+	c.GetCheckSuite()
 }
 
-func TestCodeOfConduct_GetName(tt *testing.T) {
-	var zeroValue string
-	c := &CodeOfConduct{Name: &zeroValue}
-	c.GetName()
-	c = &CodeOfConduct{}
-	c.GetName()
+func TestCheckRun_GetCompletedAt(tt *testing.T) {
+	var zeroValue Timestamp
+	c := &CheckRun{CompletedAt: &zeroValue}
+	c.GetCompletedAt()
+	c = &CheckRun{}
+	c.GetCompletedAt()
 	c = nil
-	c.GetName()
+	c.GetCompletedAt()
 }
 
-func TestCodeOfConduct_GetURL(tt *testing.T) {
+func TestCheckRun_GetConclusion(tt *testing.T) {
 	var zeroValue string
-	c := &CodeOfConduct{URL: &zeroValue}
-	c.GetURL()
-	c = &CodeOfConduct{}
-	c.GetURL()
-	c = nil
-	c.GetURL()
-}
-
-func TestCodeResult_GetHTMLURL(tt *testing.T) {
-	var zeroValue string
-	c := &CodeResult{HTMLURL: &zeroValue}
-	c.GetHTMLURL()
-	c = &CodeResult{}
-	c.GetHTMLURL()
+	c := &CheckRun{Conclusion: &zeroValue}
+	c.GetConclusion()
+	c = &CheckRun{}
+	c.GetConclusion()
 	c = nil
-	c.GetHTMLURL()
+	c.GetConclusion()
 }
 
-func TestCodeResult_GetName(tt *testing.T) {
+func TestCheckRun_GetDetailsURL(tt *testing.T) {
 	var zeroValue string
-	c := &CodeResult{Name: &zeroValue}
-	c.GetName()
-	c = &CodeResult{}
-	c.GetName()
+	c := &CheckRun{DetailsURL: &zeroValue}
+	c.GetDetailsURL()
+	c = &CheckRun{}
+	c.GetDetailsURL()
 	c = nil
-	c.GetName()
+	c.GetDetailsURL()
 }
 
-func TestCodeResult_GetPath(tt *testing.T) {
+func TestCheckRun_GetExternalID(tt *testing.T) {
 	var zeroValue string
-	c := &CodeResult{Path: &zeroValue}
-	c.GetPath()
-	c = &CodeResult{}
-	c.GetPath()
-	c = nil
-	c.GetPath()
-}
-
-func TestCodeResult_GetRepository(tt *testing.T) {
-	c := &CodeResult{}
-	c.GetRepository()
+	c := &CheckRun{ExternalID: &zeroValue}
+	c.GetExternalID()
+	c = &CheckRun{}
+	c.GetExternalID()
 	c = nil
-	c.GetRepository()
+	c.GetExternalID()
 }
 
-func TestCodeResult_GetSHA(tt *testing.T) {
+func TestCheckRun_GetHeadSHA(tt *testing.T) {
 	var zeroValue string
-	c := &CodeResult{SHA: &zeroValue}
-	c.GetSHA()
-	c = &CodeResult{}
-	c.GetSHA()
-	c = nil
-	c.GetSHA()
-}
-
-func TestCodeSearchResult_GetIncompleteResults(tt *testing.T) {
-	var zeroValue bool
-	c := &CodeSearchResult{IncompleteResults: &zeroValue}
-	c.GetIncompleteResults()
-	c = &CodeSearchResult{}
-	c.GetIncompleteResults()
-	c = nil
-	c.GetIncompleteResults()
-}
-
-func TestCodeSearchResult_GetTotal(tt *testing.T) {
-	var zeroValue int
-	c := &CodeSearchResult{Total: &zeroValue}
-	c.GetTotal()
-	c = &CodeSearchResult{}
-	c.GetTotal()
-	c = nil
-	c.GetTotal()
-}
-
-func TestCollaboratorInvitation_GetCreatedAt(tt *testing.T) {
-	var zeroValue Timestamp
-	c := &CollaboratorInvitation{CreatedAt: &zeroValue}
-	c.GetCreatedAt()
-	c = &CollaboratorInvitation{}
-	c.GetCreatedAt()
+	c := &CheckRun{HeadSHA: &zeroValue}
+	c.GetHeadSHA()
+	c = &CheckRun{}
+	c.GetHeadSHA()
 	c = nil
-	c.GetCreatedAt()
+	c.GetHeadSHA()
 }
 
-func TestCollaboratorInvitation_GetHTMLURL(tt *testing.T) {
+func TestCheckRun_GetHTMLURL(tt *testing.T) {
 	var zeroValue string
-	c := &CollaboratorInvitation{HTMLURL: &zeroValue}
+	c := &CheckRun{HTMLURL: &zeroValue}
 	c.GetHTMLURL()
-	c = &CollaboratorInvitation{}
+	c = &CheckRun{}
 	c.GetHTMLURL()
 	c = nil
 	c.GetHTMLURL()
 }
 
-func TestCollaboratorInvitation_GetID(tt *testing.T) {
+func TestCheckRun_GetID(tt *testing.T) {
 	var zeroValue int64
-	c := &CollaboratorInvitation{ID: &zeroValue}
+	c := &CheckRun{ID: &zeroValue}
 	c.GetID()
-	c = &CollaboratorInvitation{}
+	c = &CheckRun{}
 	c.GetID()
 	c = nil
 	c.GetID()
 }
 
-func TestCollaboratorInvitation_GetInvitee(tt *testing.T) {
-	c := &CollaboratorInvitation{}
-	c.GetInvitee()
+func TestCheckRun_GetName(tt *testing.T) {
+	var zeroValue string
+	c := &CheckRun{Name: &zeroValue}
+	c.GetName()
+	c = &CheckRun{}
+	c.GetName()
 	c = nil
-	c.GetInvitee()
+	c.GetName()
 }
 
-func TestCollaboratorInvitation_GetInviter(tt *testing.T) {
-	c := &CollaboratorInvitation{}
-	c.GetInviter()
+func TestCheckRun_GetNodeID(tt *testing.T) {
+	var zeroValue string
+	c := &CheckRun{NodeID: &zeroValue}
+	c.GetNodeID()
+	c = &CheckRun{}
+	c.GetNodeID()
 	c = nil
-	c.GetInviter()
+	c.GetNodeID()
 }
 
-func TestCollaboratorInvitation_GetPermissions(tt *testing.T) {
-	var zeroValue string
-	c := &CollaboratorInvitation{Permissions: &zeroValue}
-	c.GetPermissions()
-	c = &CollaboratorInvitation{}
-	c.GetPermissions()
+func TestCheckRun_GetOutput(tt *testing.T) {
+	c := &CheckRun{}
+	c.GetOutput()
 	c = nil
-	c.GetPermissions()
+	c.GetOutput()
 }
 
-func TestCollaboratorInvitation_GetRepo(tt *testing.T) {
-	c := &CollaboratorInvitation{}
-	c.GetRepo()
+func TestCheckRun_GetStartedAt(tt *testing.T) {
+	var zeroValue Timestamp
+	c := &CheckRun{StartedAt: &zeroValue}
+	c.GetStartedAt()
+	c = &CheckRun{}
+	c.GetStartedAt()
 	c = nil
-	c.GetRepo()
+	c.GetStartedAt()
 }
 
-func TestCollaboratorInvitation_GetURL(tt *testing.T) {
+func TestCheckRun_GetStatus(tt *testing.T) {
 	var zeroValue string
-	c := &CollaboratorInvitation{URL: &zeroValue}
+	c := &CheckRun{Status: &zeroValue}
+	c.GetStatus()
+	c = &CheckRun{}
+	c.GetStatus()
+	c = nil
+	c.GetStatus()
+}
+
+func TestCheckRun_GetURL(tt *testing.T) {
+	var zeroValue string
+	c := &CheckRun{URL: &zeroValue}
 	c.GetURL()
-	c = &CollaboratorInvitation{}
+	c = &CheckRun{}
 	c.GetURL()
 	c = nil
 	c.GetURL()
 }
 
-func TestCombinedStatus_GetCommitURL(tt *testing.T) {
+func TestCheckRunAnnotation_GetAnnotationLevel(tt *testing.T) {
 	var zeroValue string
-	c := &CombinedStatus{CommitURL: &zeroValue}
-	c.GetCommitURL()
-	c = &CombinedStatus{}
-	c.GetCommitURL()
+	c := &CheckRunAnnotation{AnnotationLevel: &zeroValue}
+	c.GetAnnotationLevel()
+	c = &CheckRunAnnotation{}
+	c.GetAnnotationLevel()
 	c = nil
-	c.GetCommitURL()
+	c.GetAnnotationLevel()
 }
 
-func TestCombinedStatus_GetName(tt *testing.T) {
-	var zeroValue string
-	c := &CombinedStatus{Name: &zeroValue}
-	c.GetName()
-	c = &CombinedStatus{}
-	c.GetName()
+func TestCheckRunAnnotation_GetEndColumn(tt *testing.T) {
+	var zeroValue int
+	c := &CheckRunAnnotation{EndColumn: &zeroValue}
+	c.GetEndColumn()
+	c = &CheckRunAnnotation{}
+	c.GetEndColumn()
 	c = nil
-	c.GetName()
+	c.GetEndColumn()
 }
 
-func TestCombinedStatus_GetRepositoryURL(tt *testing.T) {
-	var zeroValue string
-	c := &CombinedStatus{RepositoryURL: &zeroValue}
-	c.GetRepositoryURL()
-	c = &CombinedStatus{}
-	c.GetRepositoryURL()
+func TestCheckRunAnnotation_GetEndLine(tt *testing.T) {
+	var zeroValue int
+	c := &CheckRunAnnotation{EndLine: &zeroValue}
+	c.GetEndLine()
+	c = &CheckRunAnnotation{}
+	c.GetEndLine()
 	c = nil
-	c.GetRepositoryURL()
+	c.GetEndLine()
 }
 
-func TestCombinedStatus_GetSHA(tt *testing.T) {
+func TestCheckRunAnnotation_GetMessage(tt *testing.T) {
 	var zeroValue string
-	c := &CombinedStatus{SHA: &zeroValue}
-	c.GetSHA()
-	c = &CombinedStatus{}
-	c.GetSHA()
+	c := &CheckRunAnnotation{Message: &zeroValue}
+	c.GetMessage()
+	c = &CheckRunAnnotation{}
+	c.GetMessage()
 	c = nil
-	c.GetSHA()
+	c.GetMessage()
 }
 
-func TestCombinedStatus_GetState(tt *testing.T) {
+func TestCheckRunAnnotation_GetPath(tt *testing.T) {
 	var zeroValue string
-	c := &CombinedStatus{State: &zeroValue}
-	c.GetState()
-	c = &CombinedStatus{}
-	c.GetState()
+	c := &CheckRunAnnotation{Path: &zeroValue}
+	c.GetPath()
+	c = &CheckRunAnnotation{}
+	c.GetPath()
 	c = nil
-	c.GetState()
+	c.GetPath()
 }
 
-func TestCombinedStatus_GetTotalCount(tt *testing.T) {
-	var zeroValue int
-	c := &CombinedStatus{TotalCount: &zeroValue}
-	c.GetTotalCount()
-	c = &CombinedStatus{}
-	c.GetTotalCount()
+func TestCheckRunAnnotation_GetRawDetails(tt *testing.T) {
+	var zeroValue string
+	c := &CheckRunAnnotation{RawDetails: &zeroValue}
+	c.GetRawDetails()
+	c = &CheckRunAnnotation{}
+	c.GetRawDetails()
 	c = nil
-	c.GetTotalCount()
+	c.GetRawDetails()
 }
 
-func TestComment_GetCreatedAt(tt *testing.T) {
-	var zeroValue time.Time
-	c := &Comment{CreatedAt: &zeroValue}
-	c.GetCreatedAt()
-	c = &Comment{}
-	c.GetCreatedAt()
+func TestCheckRunAnnotation_GetStartColumn(tt *testing.T) {
+	var zeroValue int
+	c := &CheckRunAnnotation{StartColumn: &zeroValue}
+	c.GetStartColumn()
+	c = &CheckRunAnnotation{}
+	c.GetStartColumn()
 	c = nil
-	c.GetCreatedAt()
+	c.GetStartColumn()
 }
 
-func TestCommentStats_GetTotalCommitComments(tt *testing.T) {
+func TestCheckRunAnnotation_GetStartLine(tt *testing.T) {
 	var zeroValue int
-	c := &CommentStats{TotalCommitComments: &zeroValue}
-	c.GetTotalCommitComments()
-	c = &CommentStats{}
-	c.GetTotalCommitComments()
+	c := &CheckRunAnnotation{StartLine: &zeroValue}
+	c.GetStartLine()
+	c = &CheckRunAnnotation{}
+	c.GetStartLine()
 	c = nil
-	c.GetTotalCommitComments()
+	c.GetStartLine()
 }
 
-func TestCommentStats_GetTotalGistComments(tt *testing.T) {
-	var zeroValue int
-	c := &CommentStats{TotalGistComments: &zeroValue}
-	c.GetTotalGistComments()
-	c = &CommentStats{}
-	c.GetTotalGistComments()
+func TestCheckRunAnnotation_GetTitle(tt *testing.T) {
+	var zeroValue string
+	c := &CheckRunAnnotation{Title: &zeroValue}
+	c.GetTitle()
+	c = &CheckRunAnnotation{}
+	c.GetTitle()
 	c = nil
-	c.GetTotalGistComments()
+	c.GetTitle()
 }
 
-func TestCommentStats_GetTotalIssueComments(tt *testing.T) {
-	var zeroValue int
-	c := &CommentStats{TotalIssueComments: &zeroValue}
-	c.GetTotalIssueComments()
-	c = &CommentStats{}
-	c.GetTotalIssueComments()
+func TestCheckRunEvent_GetAction(tt *testing.T) {
+	var zeroValue string
+	c := &CheckRunEvent{Action: &zeroValue}
+	c.GetAction()
+	c = &CheckRunEvent{}
+	c.GetAction()
 	c = nil
-	c.GetTotalIssueComments()
+	c.GetAction()
 }
 
-func TestCommentStats_GetTotalPullRequestComments(tt *testing.T) {
-	var zeroValue int
-	c := &CommentStats{TotalPullRequestComments: &zeroValue}
-	c.GetTotalPullRequestComments()
-	c = &CommentStats{}
-	c.GetTotalPullRequestComments()
+func TestCheckRunEvent_GetCheckRun(tt *testing.T) {
+	c := &CheckRunEvent{}
+	c.GetCheckRun()
 	c = nil
-	c.GetTotalPullRequestComments()
+	c.GetCheckRun()
 }
 
-func TestCommit_GetAuthor(tt *testing.T) {
-	c := &Commit{}
-	c.GetAuthor()
+func TestCheckRunEvent_GetInstallation(tt *testing.T) {
+	c := &CheckRunEvent{}
+	c.GetInstallation()
 	c = nil
-	c.GetAuthor()
+	c.GetInstallation()
 }
 
-func TestCommit_GetCommentCount(tt *testing.T) {
-	var zeroValue int
-	c := &Commit{CommentCount: &zeroValue}
-	c.GetCommentCount()
-	c = &Commit{}
-	c.GetCommentCount()
+func TestCheckRunEvent_GetOrg(tt *testing.T) {
+	c := &CheckRunEvent{}
+	c.GetOrg()
 	c = nil
-	c.GetCommentCount()
+	c.GetOrg()
 }
 
-func TestCommit_GetCommitter(tt *testing.T) {
-	c := &Commit{}
-	c.GetCommitter()
+func TestCheckRunEvent_GetRepo(tt *testing.T) {
+	c := &CheckRunEvent{}
+	c.GetRepo()
 	c = nil
-	c.GetCommitter()
+	c.GetRepo()
 }
 
-func TestCommit_GetHTMLURL(tt *testing.T) {
-	var zeroValue string
-	c := &Commit{HTMLURL: &zeroValue}
-	c.GetHTMLURL()
-	c = &Commit{}
-	c.GetHTMLURL()
+func TestCheckRunEvent_GetRequestedAction(tt *testing.T) {
+	c := &CheckRunEvent{}
+	c.GetRequestedAction()
 	c = nil
-	c.GetHTMLURL()
+	c.GetRequestedAction()
 }
 
-func TestCommit_GetMessage(tt *testing.T) {
-	var zeroValue string
-	c := &Commit{Message: &zeroValue}
-	c.GetMessage()
-	c = &Commit{}
-	c.GetMessage()
+func TestCheckRunEvent_GetSender(tt *testing.T) {
+	c := &CheckRunEvent{}
+	c.GetSender()
 	c = nil
-	c.GetMessage()
+	c.GetSender()
 }
 
-func TestCommit_GetNodeID(tt *testing.T) {
+func TestCheckRunImage_GetAlt(tt *testing.T) {
 	var zeroValue string
-	c := &Commit{NodeID: &zeroValue}
-	c.GetNodeID()
-	c = &Commit{}
-	c.GetNodeID()
+	c := &CheckRunImage{Alt: &zeroValue}
+	c.GetAlt()
+	c = &CheckRunImage{}
+	c.GetAlt()
 	c = nil
-	c.GetNodeID()
+	c.GetAlt()
 }
 
-func TestCommit_GetSHA(tt *testing.T) {
+func TestCheckRunImage_GetCaption(tt *testing.T) {
 	var zeroValue string
-	c := &Commit{SHA: &zeroValue}
-	c.GetSHA()
-	c = &Commit{}
-	c.GetSHA()
+	c := &CheckRunImage{Caption: &zeroValue}
+	c.GetCaption()
+	c = &CheckRunImage{}
+	c.GetCaption()
 	c = nil
-	c.GetSHA()
+	c.GetCaption()
 }
 
-func TestCommit_GetStats(tt *testing.T) {
-	c := &Commit{}
-	c.GetStats()
+func TestCheckRunImage_GetImageURL(tt *testing.T) {
+	var zeroValue string
+	c := &CheckRunImage{ImageURL: &zeroValue}
+	c.GetImageURL()
+	c = &CheckRunImage{}
+	c.GetImageURL()
 	c = nil
-	c.GetStats()
+	c.GetImageURL()
 }
 
-func TestCommit_GetTree(tt *testing.T) {
-	c := &Commit{}
-	c.GetTree()
+func TestCheckRunOutput_GetAnnotationsCount(tt *testing.T) {
+	var zeroValue int
+	c := &CheckRunOutput{AnnotationsCount: &zeroValue}
+	c.GetAnnotationsCount()
+	c = &CheckRunOutput{}
+	c.GetAnnotationsCount()
 	c = nil
-	c.GetTree()
+	c.GetAnnotationsCount()
 }
 
-func TestCommit_GetURL(tt *testing.T) {
+func TestCheckRunOutput_GetAnnotationsURL(tt *testing.T) {
 	var zeroValue string
-	c := &Commit{URL: &zeroValue}
-	c.GetURL()
-	c = &Commit{}
-	c.GetURL()
+	c := &CheckRunOutput{AnnotationsURL: &zeroValue}
+	c.GetAnnotationsURL()
+	c = &CheckRunOutput{}
+	c.GetAnnotationsURL()
 	c = nil
-	c.GetURL()
+	c.GetAnnotationsURL()
 }
 
-func TestCommit_GetVerification(tt *testing.T) {
-	c := &Commit{}
-	c.GetVerification()
+func TestCheckRunOutput_GetSummary(tt *testing.T) {
+	var zeroValue string
+	c := &CheckRunOutput{Summary: &zeroValue}
+	c.GetSummary()
+	c = &CheckRunOutput{}
+	c.GetSummary()
 	c = nil
-	c.GetVerification()
+	c.GetSummary()
 }
 
-func TestCommitAuthor_GetDate(tt *testing.T) {
-	var zeroValue time.Time
-	c := &CommitAuthor{Date: &zeroValue}
-	c.GetDate()
-	c = &CommitAuthor{}
-	c.GetDate()
+func TestCheckRunOutput_GetText(tt *testing.T) {
+	var zeroValue string
+	c := &CheckRunOutput{Text: &zeroValue}
+	c.GetText()
+	c = &CheckRunOutput{}
+	c.GetText()
 	c = nil
-	c.GetDate()
+	c.GetText()
 }
 
-func TestCommitAuthor_GetEmail(tt *testing.T) {
+func TestCheckRunOutput_GetTitle(tt *testing.T) {
 	var zeroValue string
-	c := &CommitAuthor{Email: &zeroValue}
-	c.GetEmail()
-	c = &CommitAuthor{}
-	c.GetEmail()
+	c := &CheckRunOutput{Title: &zeroValue}
+	c.GetTitle()
+	c = &CheckRunOutput{}
+	c.GetTitle()
 	c = nil
-	c.GetEmail()
+	c.GetTitle()
 }
 
-func TestCommitAuthor_GetLogin(tt *testing.T) {
-	var zeroValue string
-	c := &CommitAuthor{Login: &zeroValue}
-	c.GetLogin()
-	c = &CommitAuthor{}
-	c.GetLogin()
+func TestChecksStatusContext_GetCheckRun(tt *testing.T) {
+	c := &ChecksStatusContext{}
+	c.GetCheckRun()
 	c = nil
-	c.GetLogin()
+	c.GetCheckRun()
 }
 
-func TestCommitAuthor_GetName(tt *testing.T) {
-	var zeroValue string
-	c := &CommitAuthor{Name: &zeroValue}
-	c.GetName()
-	c = &CommitAuthor{}
-	c.GetName()
+func TestChecksStatusContext_GetStatus(tt *testing.T) {
+	c := &ChecksStatusContext{}
+	c.GetStatus()
 	c = nil
-	c.GetName()
+	c.GetStatus()
 }
 
-func TestCommitCommentEvent_GetAction(tt *testing.T) {
+func TestCheckSuite_GetAfterSHA(tt *testing.T) {
 	var zeroValue string
-	c := &CommitCommentEvent{Action: &zeroValue}
-	c.GetAction()
-	c = &CommitCommentEvent{}
-	c.GetAction()
+	c := &CheckSuite{AfterSHA: &zeroValue}
+	c.GetAfterSHA()
+	c = &CheckSuite{}
+	c.GetAfterSHA()
 	c = nil
-	c.GetAction()
+	c.GetAfterSHA()
 }
 
-func TestCommitCommentEvent_GetComment(tt *testing.T) {
-	c := &CommitCommentEvent{}
-	c.GetComment()
+func TestCheckSuite_GetApp(tt *testing.T) {
+	c := &CheckSuite{}
+	c.GetApp()
 	c = nil
-	c.GetComment()
+	c.GetApp()
 }
 
-func TestCommitCommentEvent_GetInstallation(tt *testing.T) {
-	c := &CommitCommentEvent{}
-	c.GetInstallation()
+func TestCheckSuite_GetBeforeSHA(tt *testing.T) {
+	var zeroValue string
+	c := &CheckSuite{BeforeSHA: &zeroValue}
+	c.GetBeforeSHA()
+	c = &CheckSuite{}
+	c.GetBeforeSHA()
 	c = nil
-	c.GetInstallation()
+	c.GetBeforeSHA()
 }
 
-func TestCommitCommentEvent_GetRepo(tt *testing.T) {
-	c := &CommitCommentEvent{}
-	c.GetRepo()
+func TestCheckSuite_GetConclusion(tt *testing.T) {
+	var zeroValue string
+	c := &CheckSuite{Conclusion: &zeroValue}
+	c.GetConclusion()
+	c = &CheckSuite{}
+	c.GetConclusion()
 	c = nil
-	c.GetRepo()
+	c.GetConclusion()
 }
 
-func TestCommitCommentEvent_GetSender(tt *testing.T) {
-	c := &CommitCommentEvent{}
-	c.GetSender()
+func TestCheckSuite_GetHeadBranch(tt *testing.T) {
+	var zeroValue string
+	c := &CheckSuite{HeadBranch: &zeroValue}
+	c.GetHeadBranch()
+	c = &CheckSuite{}
+	c.GetHeadBranch()
 	c = nil
-	c.GetSender()
+	c.GetHeadBranch()
 }
 
-func TestCommitFile_GetAdditions(tt *testing.T) {
-	var zeroValue int
-	c := &CommitFile{Additions: &zeroValue}
-	c.GetAdditions()
-	c = &CommitFile{}
-	c.GetAdditions()
+func TestCheckSuite_GetHeadCommit(tt *testing.T) {
+	c := &CheckSuite{}
+	c.GetHeadCommit()
 	c = nil
-	c.GetAdditions()
+	c.GetHeadCommit()
 }
 
-func TestCommitFile_GetBlobURL(tt *testing.T) {
+func TestCheckSuite_GetHeadSHA(tt *testing.T) {
 	var zeroValue string
-	c := &CommitFile{BlobURL: &zeroValue}
-	c.GetBlobURL()
-	c = &CommitFile{}
-	c.GetBlobURL()
+	c := &CheckSuite{HeadSHA: &zeroValue}
+	c.GetHeadSHA()
+	c = &CheckSuite{}
+	c.GetHeadSHA()
 	c = nil
-	c.GetBlobURL()
+	c.GetHeadSHA()
 }
 
-func TestCommitFile_GetChanges(tt *testing.T) {
-	var zeroValue int
-	c := &CommitFile{Changes: &zeroValue}
-	c.GetChanges()
-	c = &CommitFile{}
-	c.GetChanges()
+func TestCheckSuite_GetID(tt *testing.T) {
+	var zeroValue int64
+	c := &CheckSuite{ID: &zeroValue}
+	c.GetID()
+	c = &CheckSuite{}
+	c.GetID()
 	c = nil
-	c.GetChanges()
+	c.GetID()
 }
 
-func TestCommitFile_GetContentsURL(tt *testing.T) {
+func TestCheckSuite_GetNodeID(tt *testing.T) {
 	var zeroValue string
-	c := &CommitFile{ContentsURL: &zeroValue}
-	c.GetContentsURL()
-	c = &CommitFile{}
-	c.GetContentsURL()
+	c := &CheckSuite{NodeID: &zeroValue}
+	c.GetNodeID()
+	c = &CheckSuite{}
+	c.GetNodeID()
 	c = nil
-	c.GetContentsURL()
+	c.GetNodeID()
 }
 
-func TestCommitFile_GetDeletions(tt *testing.T) {
-	var zeroValue int
-	c := &CommitFile{Deletions: &zeroValue}
-	c.GetDeletions()
-	c = &CommitFile{}
-	c.GetDeletions()
+func TestCheckSuite_GetRepository(tt *testing.T) {
+	c := &CheckSuite{}
+	c.GetRepository()
 	c = nil
-	c.GetDeletions()
+	c.GetRepository()
 }
 
-func TestCommitFile_GetFilename(tt *testing.T) {
+func TestCheckSuite_GetStatus(tt *testing.T) {
 	var zeroValue string
-	c := &CommitFile{Filename: &zeroValue}
-	c.GetFilename()
-	c = &CommitFile{}
-	c.GetFilename()
+	c := &CheckSuite{Status: &zeroValue}
+	c.GetStatus()
+	c = &CheckSuite{}
+	c.GetStatus()
 	c = nil
-	c.GetFilename()
+	c.GetStatus()
 }
 
-func TestCommitFile_GetPatch(tt *testing.T) {
+func TestCheckSuite_GetURL(tt *testing.T) {
 	var zeroValue string
-	c := &CommitFile{Patch: &zeroValue}
-	c.GetPatch()
-	c = &CommitFile{}
-	c.GetPatch()
+	c := &CheckSuite{URL: &zeroValue}
+	c.GetURL()
+	c = &CheckSuite{}
+	c.GetURL()
 	c = nil
-	c.GetPatch()
+	c.GetURL()
 }
 
-func TestCommitFile_GetPreviousFilename(tt *testing.T) {
+func TestCheckSuiteEvent_GetAction(tt *testing.T) {
 	var zeroValue string
-	c := &CommitFile{PreviousFilename: &zeroValue}
-	c.GetPreviousFilename()
-	c = &CommitFile{}
-	c.GetPreviousFilename()
+	c := &CheckSuiteEvent{Action: &zeroValue}
+	c.GetAction()
+	c = &CheckSuiteEvent{}
+	c.GetAction()
 	c = nil
-	c.GetPreviousFilename()
+	c.GetAction()
 }
 
-func TestCommitFile_GetRawURL(tt *testing.T) {
-	var zeroValue string
-	c := &CommitFile{RawURL: &zeroValue}
-	c.GetRawURL()
-	c = &CommitFile{}
-	c.GetRawURL()
+func TestCheckSuiteEvent_GetCheckSuite(tt *testing.T) {
+	c := &CheckSuiteEvent{}
+	c.GetCheckSuite()
 	c = nil
-	c.GetRawURL()
+	c.GetCheckSuite()
 }
 
-func TestCommitFile_GetSHA(tt *testing.T) {
-	var zeroValue string
-	c := &CommitFile{SHA: &zeroValue}
-	c.GetSHA()
-	c = &CommitFile{}
-	c.GetSHA()
+func TestCheckSuiteEvent_GetInstallation(tt *testing.T) {
+	c := &CheckSuiteEvent{}
+	c.GetInstallation()
 	c = nil
-	c.GetSHA()
+	c.GetInstallation()
 }
 
-func TestCommitFile_GetStatus(tt *testing.T) {
-	var zeroValue string
-	c := &CommitFile{Status: &zeroValue}
-	c.GetStatus()
-	c = &CommitFile{}
-	c.GetStatus()
+func TestCheckSuiteEvent_GetOrg(tt *testing.T) {
+	c := &CheckSuiteEvent{}
+	c.GetOrg()
 	c = nil
-	c.GetStatus()
+	c.GetOrg()
 }
 
-func TestCommitResult_GetAuthor(tt *testing.T) {
-	c := &CommitResult{}
-	c.GetAuthor()
+func TestCheckSuiteEvent_GetRepo(tt *testing.T) {
+	c := &CheckSuiteEvent{}
+	c.GetRepo()
 	c = nil
-	c.GetAuthor()
+	c.GetRepo()
 }
 
-func TestCommitResult_GetCommentsURL(tt *testing.T) {
-	var zeroValue string
-	c := &CommitResult{CommentsURL: &zeroValue}
-	c.GetCommentsURL()
-	c = &CommitResult{}
-	c.GetCommentsURL()
+func TestCheckSuiteEvent_GetSender(tt *testing.T) {
+	c := &CheckSuiteEvent{}
+	c.GetSender()
 	c = nil
-	c.GetCommentsURL()
+	c.GetSender()
 }
 
-func TestCommitResult_GetCommit(tt *testing.T) {
-	c := &CommitResult{}
-	c.GetCommit()
+func TestCheckSuitePreferenceResults_GetPreferences(tt *testing.T) {
+	c := &CheckSuitePreferenceResults{}
+	c.GetPreferences()
 	c = nil
-	c.GetCommit()
+	c.GetPreferences()
 }
 
-func TestCommitResult_GetCommitter(tt *testing.T) {
-	c := &CommitResult{}
-	c.GetCommitter()
+func TestCheckSuitePreferenceResults_GetRepository(tt *testing.T) {
+	c := &CheckSuitePreferenceResults{}
+	c.GetRepository()
 	c = nil
-	c.GetCommitter()
+	c.GetRepository()
 }
 
-func TestCommitResult_GetHTMLURL(tt *testing.T) {
-	var zeroValue string
-	c := &CommitResult{HTMLURL: &zeroValue}
-	c.GetHTMLURL()
-	c = &CommitResult{}
-	c.GetHTMLURL()
+func TestCloudEvent_GetTime(tt *testing.T) {
+	var zeroValue time.Time
+	c := &CloudEvent{Time: &zeroValue}
+	c.GetTime()
+	c = &CloudEvent{}
+	c.GetTime()
 	c = nil
-	c.GetHTMLURL()
+	c.GetTime()
 }
 
-func TestCommitResult_GetRepository(tt *testing.T) {
-	c := &CommitResult{}
-	c.GetRepository()
+func TestCodeOfConduct_GetBody(tt *testing.T) {
+	var zeroValue string
+	c := &CodeOfConduct{Body: &zeroValue}
+	c.GetBody()
+	c = &CodeOfConduct{}
+	c.GetBody()
 	c = nil
-	c.GetRepository()
+	c.GetBody()
 }
 
-func TestCommitResult_GetScore(tt *testing.T) {
-	c := &CommitResult{}
-	c.GetScore()
+func TestCodeOfConduct_GetKey(tt *testing.T) {
+	var zeroValue string
+	c := &CodeOfConduct{Key: &zeroValue}
+	c.GetKey()
+	c = &CodeOfConduct{}
+	c.GetKey()
 	c = nil
-	c.GetScore()
+	c.GetKey()
 }
 
-func TestCommitResult_GetSHA(tt *testing.T) {
+func TestCodeOfConduct_GetName(tt *testing.T) {
 	var zeroValue string
-	c := &CommitResult{SHA: &zeroValue}
-	c.GetSHA()
-	c = &CommitResult{}
-	c.GetSHA()
+	c := &CodeOfConduct{Name: &zeroValue}
+	c.GetName()
+	c = &CodeOfConduct{}
+	c.GetName()
 	c = nil
-	c.GetSHA()
+	c.GetName()
 }
 
-func TestCommitResult_GetURL(tt *testing.T) {
+func TestCodeOfConduct_GetURL(tt *testing.T) {
 	var zeroValue string
-	c := &CommitResult{URL: &zeroValue}
+	c := &CodeOfConduct{URL: &zeroValue}
 	c.GetURL()
-	c = &CommitResult{}
+	c = &CodeOfConduct{}
 	c.GetURL()
 	c = nil
 	c.GetURL()
 }
 
-func TestCommitsComparison_GetAheadBy(tt *testing.T) {
+func TestCodeownersError_GetColumn(tt *testing.T) {
 	var zeroValue int
-	c := &CommitsComparison{AheadBy: &zeroValue}
-	c.GetAheadBy()
-	c = &CommitsComparison{}
-	c.GetAheadBy()
+	c := &CodeownersError{Column: &zeroValue}
+	c.GetColumn()
+	c = &CodeownersError{}
+	c.GetColumn()
 	c = nil
-	c.GetAheadBy()
+	c.GetColumn()
 }
 
-func TestCommitsComparison_GetBaseCommit(tt *testing.T) {
-	c := &CommitsComparison{}
-	c.GetBaseCommit()
+func TestCodeownersError_GetKind(tt *testing.T) {
+	var zeroValue string
+	c := &CodeownersError{Kind: &zeroValue}
+	c.GetKind()
+	c = &CodeownersError{}
+	c.GetKind()
 	c = nil
-	c.GetBaseCommit()
+	c.GetKind()
 }
 
-func TestCommitsComparison_GetBehindBy(tt *testing.T) {
+func TestCodeownersError_GetLine(tt *testing.T) {
 	var zeroValue int
-	c := &CommitsComparison{BehindBy: &zeroValue}
-	c.GetBehindBy()
-	c = &CommitsComparison{}
-	c.GetBehindBy()
+	c := &CodeownersError{Line: &zeroValue}
+	c.GetLine()
+	c = &CodeownersError{}
+	c.GetLine()
 	c = nil
-	c.GetBehindBy()
+	c.GetLine()
 }
 
-func TestCommitsComparison_GetDiffURL(tt *testing.T) {
+func TestCodeownersError_GetMessage(tt *testing.T) {
 	var zeroValue string
-	c := &CommitsComparison{DiffURL: &zeroValue}
-	c.GetDiffURL()
-	c = &CommitsComparison{}
-	c.GetDiffURL()
+	c := &CodeownersError{Message: &zeroValue}
+	c.GetMessage()
+	c = &CodeownersError{}
+	c.GetMessage()
 	c = nil
-	c.GetDiffURL()
+	c.GetMessage()
 }
 
-func TestCommitsComparison_GetHTMLURL(tt *testing.T) {
+func TestCodeownersError_GetPath(tt *testing.T) {
 	var zeroValue string
-	c := &CommitsComparison{HTMLURL: &zeroValue}
-	c.GetHTMLURL()
-	c = &CommitsComparison{}
-	c.GetHTMLURL()
+	c := &CodeownersError{Path: &zeroValue}
+	c.GetPath()
+	c = &CodeownersError{}
+	c.GetPath()
 	c = nil
-	c.GetHTMLURL()
-}
-
-func TestCommitsComparison_GetMergeBaseCommit(tt *testing.T) {
-	c := &CommitsComparison{}
-	c.GetMergeBaseCommit()
-	c = nil
-	c.GetMergeBaseCommit()
-}
-
-func TestCommitsComparison_GetPatchURL(tt *testing.T) {
-	var zeroValue string
-	c := &CommitsComparison{PatchURL: &zeroValue}
-	c.GetPatchURL()
-	c = &CommitsComparison{}
-	c.GetPatchURL()
-	c = nil
-	c.GetPatchURL()
+	c.GetPath()
 }
 
-func TestCommitsComparison_GetPermalinkURL(tt *testing.T) {
+func TestCodeownersError_GetSource(tt *testing.T) {
 	var zeroValue string
-	c := &CommitsComparison{PermalinkURL: &zeroValue}
-	c.GetPermalinkURL()
-	c = &CommitsComparison{}
-	c.GetPermalinkURL()
+	c := &CodeownersError{Source: &zeroValue}
+	c.GetSource()
+	c = &CodeownersError{}
+	c.GetSource()
 	c = nil
-	c.GetPermalinkURL()
+	c.GetSource()
 }
 
-func TestCommitsComparison_GetStatus(tt *testing.T) {
+func TestCodeownersError_GetSuggestion(tt *testing.T) {
 	var zeroValue string
-	c := &CommitsComparison{Status: &zeroValue}
-	c.GetStatus()
-	c = &CommitsComparison{}
-	c.GetStatus()
+	c := &CodeownersError{Suggestion: &zeroValue}
+	c.GetSuggestion()
+	c = &CodeownersError{}
+	c.GetSuggestion()
 	c = nil
-	c.GetStatus()
+	c.GetSuggestion()
 }
 
-func TestCommitsComparison_GetTotalCommits(tt *testing.T) {
+func TestCodeResult_GetFileSize(tt *testing.T) {
 	var zeroValue int
-	c := &CommitsComparison{TotalCommits: &zeroValue}
-	c.GetTotalCommits()
-	c = &CommitsComparison{}
-	c.GetTotalCommits()
+	c := &CodeResult{FileSize: &zeroValue}
+	c.GetFileSize()
+	c = &CodeResult{}
+	c.GetFileSize()
 	c = nil
-	c.GetTotalCommits()
+	c.GetFileSize()
 }
 
-func TestCommitsComparison_GetURL(tt *testing.T) {
+func TestCodeResult_GetGitURL(tt *testing.T) {
 	var zeroValue string
-	c := &CommitsComparison{URL: &zeroValue}
-	c.GetURL()
-	c = &CommitsComparison{}
-	c.GetURL()
-	c = nil
-	c.GetURL()
-}
-
-func TestCommitsSearchResult_GetIncompleteResults(tt *testing.T) {
-	var zeroValue bool
-	c := &CommitsSearchResult{IncompleteResults: &zeroValue}
-	c.GetIncompleteResults()
-	c = &CommitsSearchResult{}
-	c.GetIncompleteResults()
+	c := &CodeResult{GitURL: &zeroValue}
+	c.GetGitURL()
+	c = &CodeResult{}
+	c.GetGitURL()
 	c = nil
-	c.GetIncompleteResults()
+	c.GetGitURL()
 }
 
-func TestCommitsSearchResult_GetTotal(tt *testing.T) {
-	var zeroValue int
-	c := &CommitsSearchResult{Total: &zeroValue}
-	c.GetTotal()
-	c = &CommitsSearchResult{}
-	c.GetTotal()
+func TestCodeResult_GetHTMLURL(tt *testing.T) {
+	var zeroValue string
+	c := &CodeResult{HTMLURL: &zeroValue}
+	c.GetHTMLURL()
+	c = &CodeResult{}
+	c.GetHTMLURL()
 	c = nil
-	c.GetTotal()
+	c.GetHTMLURL()
 }
 
-func TestCommitStats_GetAdditions(tt *testing.T) {
-	var zeroValue int
-	c := &CommitStats{Additions: &zeroValue}
-	c.GetAdditions()
-	c = &CommitStats{}
-	c.GetAdditions()
+func TestCodeResult_GetLanguage(tt *testing.T) {
+	var zeroValue string
+	c := &CodeResult{Language: &zeroValue}
+	c.GetLanguage()
+	c = &CodeResult{}
+	c.GetLanguage()
 	c = nil
-	c.GetAdditions()
+	c.GetLanguage()
 }
 
-func TestCommitStats_GetDeletions(tt *testing.T) {
-	var zeroValue int
-	c := &CommitStats{Deletions: &zeroValue}
-	c.GetDeletions()
-	c = &CommitStats{}
-	c.GetDeletions()
+func TestCodeResult_GetLastModifiedAt(tt *testing.T) {
+	var zeroValue Timestamp
+	c := &CodeResult{LastModifiedAt: &zeroValue}
+	c.GetLastModifiedAt()
+	c = &CodeResult{}
+	c.GetLastModifiedAt()
 	c = nil
-	c.GetDeletions()
+	c.GetLastModifiedAt()
 }
 
-func TestCommitStats_GetTotal(tt *testing.T) {
-	var zeroValue int
-	c := &CommitStats{Total: &zeroValue}
-	c.GetTotal()
-	c = &CommitStats{}
-	c.GetTotal()
+func TestCodeResult_GetName(tt *testing.T) {
+	var zeroValue string
+	c := &CodeResult{Name: &zeroValue}
+	c.GetName()
+	c = &CodeResult{}
+	c.GetName()
 	c = nil
-	c.GetTotal()
+	c.GetName()
 }
 
-func TestCommunityHealthFiles_GetCodeOfConduct(tt *testing.T) {
-	c := &CommunityHealthFiles{}
-	c.GetCodeOfConduct()
+func TestCodeResult_GetPath(tt *testing.T) {
+	var zeroValue string
+	c := &CodeResult{Path: &zeroValue}
+	c.GetPath()
+	c = &CodeResult{}
+	c.GetPath()
 	c = nil
-	c.GetCodeOfConduct()
+	c.GetPath()
 }
 
-func TestCommunityHealthFiles_GetContributing(tt *testing.T) {
-	c := &CommunityHealthFiles{}
-	c.GetContributing()
+func TestCodeResult_GetRepository(tt *testing.T) {
+	c := &CodeResult{}
+	c.GetRepository()
 	c = nil
-	c.GetContributing()
+	c.GetRepository()
 }
 
-func TestCommunityHealthFiles_GetIssueTemplate(tt *testing.T) {
-	c := &CommunityHealthFiles{}
-	c.GetIssueTemplate()
+func TestCodeResult_GetScore(tt *testing.T) {
+	c := &CodeResult{}
+	c.GetScore()
 	c = nil
-	c.GetIssueTemplate()
+	c.GetScore()
 }
 
-func TestCommunityHealthFiles_GetLicense(tt *testing.T) {
-	c := &CommunityHealthFiles{}
-	c.GetLicense()
+func TestCodeResult_GetSHA(tt *testing.T) {
+	var zeroValue string
+	c := &CodeResult{SHA: &zeroValue}
+	c.GetSHA()
+	c = &CodeResult{}
+	c.GetSHA()
 	c = nil
-	c.GetLicense()
+	c.GetSHA()
 }
 
-func TestCommunityHealthFiles_GetPullRequestTemplate(tt *testing.T) {
-	c := &CommunityHealthFiles{}
-	c.GetPullRequestTemplate()
+func TestCodeResult_GetURL(tt *testing.T) {
+	var zeroValue string
+	c := &CodeResult{URL: &zeroValue}
+	c.GetURL()
+	c = &CodeResult{}
+	c.GetURL()
 	c = nil
-	c.GetPullRequestTemplate()
+	c.GetURL()
 }
 
-func TestCommunityHealthFiles_GetReadme(tt *testing.T) {
-	c := &CommunityHealthFiles{}
-	c.GetReadme()
+func TestCodeSearchResult_GetIncompleteResults(tt *testing.T) {
+	var zeroValue bool
+	c := &CodeSearchResult{IncompleteResults: &zeroValue}
+	c.GetIncompleteResults()
+	c = &CodeSearchResult{}
+	c.GetIncompleteResults()
 	c = nil
-	c.GetReadme()
+	c.GetIncompleteResults()
 }
 
-func TestCommunityHealthMetrics_GetFiles(tt *testing.T) {
-	c := &CommunityHealthMetrics{}
-	c.GetFiles()
+func TestCodeSearchResult_GetTotal(tt *testing.T) {
+	var zeroValue int
+	c := &CodeSearchResult{Total: &zeroValue}
+	c.GetTotal()
+	c = &CodeSearchResult{}
+	c.GetTotal()
 	c = nil
-	c.GetFiles()
+	c.GetTotal()
 }
 
-func TestCommunityHealthMetrics_GetHealthPercentage(tt *testing.T) {
-	var zeroValue int
-	c := &CommunityHealthMetrics{HealthPercentage: &zeroValue}
-	c.GetHealthPercentage()
-	c = &CommunityHealthMetrics{}
-	c.GetHealthPercentage()
+func TestCollaboratorInvitation_GetCreatedAt(tt *testing.T) {
+	var zeroValue Timestamp
+	c := &CollaboratorInvitation{CreatedAt: &zeroValue}
+	c.GetCreatedAt()
+	c = &CollaboratorInvitation{}
+	c.GetCreatedAt()
 	c = nil
-	c.GetHealthPercentage()
+	c.GetCreatedAt()
 }
 
-func TestCommunityHealthMetrics_GetUpdatedAt(tt *testing.T) {
-	var zeroValue time.Time
-	c := &CommunityHealthMetrics{UpdatedAt: &zeroValue}
-	c.GetUpdatedAt()
-	c = &CommunityHealthMetrics{}
-	c.GetUpdatedAt()
+func TestCollaboratorInvitation_GetHTMLURL(tt *testing.T) {
+	var zeroValue string
+	c := &CollaboratorInvitation{HTMLURL: &zeroValue}
+	c.GetHTMLURL()
+	c = &CollaboratorInvitation{}
+	c.GetHTMLURL()
 	c = nil
-	c.GetUpdatedAt()
+	c.GetHTMLURL()
 }
 
-func TestContentReference_GetID(tt *testing.T) {
+func TestCollaboratorInvitation_GetID(tt *testing.T) {
 	var zeroValue int64
-	c := &ContentReference{ID: &zeroValue}
+	c := &CollaboratorInvitation{ID: &zeroValue}
 	c.GetID()
-	c = &ContentReference{}
+	c = &CollaboratorInvitation{}
 	c.GetID()
 	c = nil
 	c.GetID()
 }
 
-func TestContentReference_GetNodeID(tt *testing.T) {
-	var zeroValue string
-	c := &ContentReference{NodeID: &zeroValue}
-	c.GetNodeID()
-	c = &ContentReference{}
-	c.GetNodeID()
+func TestCollaboratorInvitation_GetInvitee(tt *testing.T) {
+	c := &CollaboratorInvitation{}
+	c.GetInvitee()
 	c = nil
-	c.GetNodeID()
+	c.GetInvitee()
 }
 
-func TestContentReference_GetReference(tt *testing.T) {
-	var zeroValue string
-	c := &ContentReference{Reference: &zeroValue}
-	c.GetReference()
-	c = &ContentReference{}
-	c.GetReference()
+func TestCollaboratorInvitation_GetInviter(tt *testing.T) {
+	c := &CollaboratorInvitation{}
+	c.GetInviter()
 	c = nil
-	c.GetReference()
+	c.GetInviter()
 }
 
-func TestContentReferenceEvent_GetAction(tt *testing.T) {
+func TestCollaboratorInvitation_GetPermissions(tt *testing.T) {
 	var zeroValue string
-	c := &ContentReferenceEvent{Action: &zeroValue}
-	c.GetAction()
-	c = &ContentReferenceEvent{}
-	c.GetAction()
+	c := &CollaboratorInvitation{Permissions: &zeroValue}
+	c.GetPermissions()
+	c = &CollaboratorInvitation{}
+	c.GetPermissions()
 	c = nil
-	c.GetAction()
+	c.GetPermissions()
 }
 
-func TestContentReferenceEvent_GetContentReference(tt *testing.T) {
-	c := &ContentReferenceEvent{}
-	c.GetContentReference()
+func TestCollaboratorInvitation_GetRepo(tt *testing.T) {
+	c := &CollaboratorInvitation{}
+	c.GetRepo()
 	c = nil
-	c.GetContentReference()
+	c.GetRepo()
 }
 
-func TestContentReferenceEvent_GetInstallation(tt *testing.T) {
-	c := &ContentReferenceEvent{}
-	c.GetInstallation()
+func TestCollaboratorInvitation_GetURL(tt *testing.T) {
+	var zeroValue string
+	c := &CollaboratorInvitation{URL: &zeroValue}
+	c.GetURL()
+	c = &CollaboratorInvitation{}
+	c.GetURL()
 	c = nil
-	c.GetInstallation()
+	c.GetURL()
 }
 
-func TestContentReferenceEvent_GetRepo(tt *testing.T) {
-	c := &ContentReferenceEvent{}
-	c.GetRepo()
+func TestCombinedStatus_GetCommitURL(tt *testing.T) {
+	var zeroValue string
+	c := &CombinedStatus{CommitURL: &zeroValue}
+	c.GetCommitURL()
+	c = &CombinedStatus{}
+	c.GetCommitURL()
 	c = nil
-	c.GetRepo()
+	c.GetCommitURL()
 }
 
-func TestContentReferenceEvent_GetSender(tt *testing.T) {
-	c := &ContentReferenceEvent{}
-	c.GetSender()
+func TestCombinedStatus_GetName(tt *testing.T) {
+	var zeroValue string
+	c := &CombinedStatus{Name: &zeroValue}
+	c.GetName()
+	c = &CombinedStatus{}
+	c.GetName()
 	c = nil
-	c.GetSender()
+	c.GetName()
 }
 
-func TestContributor_GetAvatarURL(tt *testing.T) {
+func TestCombinedStatus_GetRepositoryURL(tt *testing.T) {
 	var zeroValue string
-	c := &Contributor{AvatarURL: &zeroValue}
-	c.GetAvatarURL()
-	c = &Contributor{}
-	c.GetAvatarURL()
+	c := &CombinedStatus{RepositoryURL: &zeroValue}
+	c.GetRepositoryURL()
+	c = &CombinedStatus{}
+	c.GetRepositoryURL()
 	c = nil
-	c.GetAvatarURL()
+	c.GetRepositoryURL()
 }
 
-func TestContributor_GetContributions(tt *testing.T) {
-	var zeroValue int
-	c := &Contributor{Contributions: &zeroValue}
-	c.GetContributions()
-	c = &Contributor{}
-	c.GetContributions()
+func TestCombinedStatus_GetSHA(tt *testing.T) {
+	var zeroValue string
+	c := &CombinedStatus{SHA: &zeroValue}
+	c.GetSHA()
+	c = &CombinedStatus{}
+	c.GetSHA()
 	c = nil
-	c.GetContributions()
+	c.GetSHA()
 }
 
-func TestContributor_GetEmail(tt *testing.T) {
+func TestCombinedStatus_GetState(tt *testing.T) {
 	var zeroValue string
-	c := &Contributor{Email: &zeroValue}
-	c.GetEmail()
-	c = &Contributor{}
-	c.GetEmail()
+	c := &CombinedStatus{State: &zeroValue}
+	c.GetState()
+	c = &CombinedStatus{}
+	c.GetState()
 	c = nil
-	c.GetEmail()
+	c.GetState()
 }
 
-func TestContributor_GetEventsURL(tt *testing.T) {
-	var zeroValue string
-	c := &Contributor{EventsURL: &zeroValue}
-	c.GetEventsURL()
-	c = &Contributor{}
-	c.GetEventsURL()
+func TestCombinedStatus_GetTotalCount(tt *testing.T) {
+	var zeroValue int
+	c := &CombinedStatus{TotalCount: &zeroValue}
+	c.GetTotalCount()
+	c = &CombinedStatus{}
+	c.GetTotalCount()
 	c = nil
-	c.GetEventsURL()
+	c.GetTotalCount()
 }
 
-func TestContributor_GetFollowersURL(tt *testing.T) {
-	var zeroValue string
-	c := &Contributor{FollowersURL: &zeroValue}
-	c.GetFollowersURL()
-	c = &Contributor{}
-	c.GetFollowersURL()
+func TestComment_GetCreatedAt(tt *testing.T) {
+	var zeroValue time.Time
+	c := &Comment{CreatedAt: &zeroValue}
+	c.GetCreatedAt()
+	c = &Comment{}
+	c.GetCreatedAt()
 	c = nil
-	c.GetFollowersURL()
+	c.GetCreatedAt()
 }
 
-func TestContributor_GetFollowingURL(tt *testing.T) {
-	var zeroValue string
-	c := &Contributor{FollowingURL: &zeroValue}
-	c.GetFollowingURL()
-	c = &Contributor{}
-	c.GetFollowingURL()
+func TestCommentStats_GetTotalCommitComments(tt *testing.T) {
+	var zeroValue int
+	c := &CommentStats{TotalCommitComments: &zeroValue}
+	c.GetTotalCommitComments()
+	c = &CommentStats{}
+	c.GetTotalCommitComments()
 	c = nil
-	c.GetFollowingURL()
+	c.GetTotalCommitComments()
 }
 
-func TestContributor_GetGistsURL(tt *testing.T) {
-	var zeroValue string
-	c := &Contributor{GistsURL: &zeroValue}
-	c.GetGistsURL()
-	c = &Contributor{}
-	c.GetGistsURL()
+func TestCommentStats_GetTotalGistComments(tt *testing.T) {
+	var zeroValue int
+	c := &CommentStats{TotalGistComments: &zeroValue}
+	c.GetTotalGistComments()
+	c = &CommentStats{}
+	c.GetTotalGistComments()
 	c = nil
-	c.GetGistsURL()
+	c.GetTotalGistComments()
 }
 
-func TestContributor_GetGravatarID(tt *testing.T) {
-	var zeroValue string
-	c := &Contributor{GravatarID: &zeroValue}
-	c.GetGravatarID()
-	c = &Contributor{}
-	c.GetGravatarID()
+func TestCommentStats_GetTotalIssueComments(tt *testing.T) {
+	var zeroValue int
+	c := &CommentStats{TotalIssueComments: &zeroValue}
+	c.GetTotalIssueComments()
+	c = &CommentStats{}
+	c.GetTotalIssueComments()
 	c = nil
-	c.GetGravatarID()
+	c.GetTotalIssueComments()
 }
 
-func TestContributor_GetHTMLURL(tt *testing.T) {
-	var zeroValue string
-	c := &Contributor{HTMLURL: &zeroValue}
-	c.GetHTMLURL()
-	c = &Contributor{}
-	c.GetHTMLURL()
+func TestCommentStats_GetTotalPullRequestComments(tt *testing.T) {
+	var zeroValue int
+	c := &CommentStats{TotalPullRequestComments: &zeroValue}
+	c.GetTotalPullRequestComments()
+	c = &CommentStats{}
+	c.GetTotalPullRequestComments()
 	c = nil
-	c.GetHTMLURL()
+	c.GetTotalPullRequestComments()
 }
 
-func TestContributor_GetID(tt *testing.T) {
-	var zeroValue int64
-	c := &Contributor{ID: &zeroValue}
-	c.GetID()
-	c = &Contributor{}
-	c.GetID()
+func TestCommit_GetAuthor(tt *testing.T) {
+	c := &Commit{}
+	c.GetAuthor()
 	c = nil
-	c.GetID()
+	c.GetAuthor()
 }
 
-func TestContributor_GetLogin(tt *testing.T) {
-	var zeroValue string
-	c := &Contributor{Login: &zeroValue}
-	c.GetLogin()
-	c = &Contributor{}
-	c.GetLogin()
+func TestCommit_GetCommentCount(tt *testing.T) {
+	var zeroValue int
+	c := &Commit{CommentCount: &zeroValue}
+	c.GetCommentCount()
+	c = &Commit{}
+	c.GetCommentCount()
 	c = nil
-	c.GetLogin()
+	c.GetCommentCount()
 }
 
-func TestContributor_GetName(tt *testing.T) {
-	var zeroValue string
-	c := &Contributor{Name: &zeroValue}
-	c.GetName()
-	c = &Contributor{}
-	c.GetName()
+func TestCommit_GetCommitter(tt *testing.T) {
+	c := &Commit{}
+	c.GetCommitter()
 	c = nil
-	c.GetName()
+	c.GetCommitter()
 }
 
-func TestContributor_GetNodeID(tt *testing.T) {
+func TestCommit_GetHTMLURL(tt *testing.T) {
 	var zeroValue string
-	c := &Contributor{NodeID: &zeroValue}
-	c.GetNodeID()
-	c = &Contributor{}
-	c.GetNodeID()
+	c := &Commit{HTMLURL: &zeroValue}
+	c.GetHTMLURL()
+	c = &Commit{}
+	c.GetHTMLURL()
 	c = nil
-	c.GetNodeID()
+	c.GetHTMLURL()
 }
 
-func TestContributor_GetOrganizationsURL(tt *testing.T) {
+func TestCommit_GetMessage(tt *testing.T) {
 	var zeroValue string
-	c := &Contributor{OrganizationsURL: &zeroValue}
-	c.GetOrganizationsURL()
-	c = &Contributor{}
-	c.GetOrganizationsURL()
+	c := &Commit{Message: &zeroValue}
+	c.GetMessage()
+	c = &Commit{}
+	c.GetMessage()
 	c = nil
-	c.GetOrganizationsURL()
+	c.GetMessage()
 }
 
-func TestContributor_GetReceivedEventsURL(tt *testing.T) {
+func TestCommit_GetNodeID(tt *testing.T) {
 	var zeroValue string
-	c := &Contributor{ReceivedEventsURL: &zeroValue}
-	c.GetReceivedEventsURL()
-	c = &Contributor{}
-	c.GetReceivedEventsURL()
+	c := &Commit{NodeID: &zeroValue}
+	c.GetNodeID()
+	c = &Commit{}
+	c.GetNodeID()
 	c = nil
-	c.GetReceivedEventsURL()
+	c.GetNodeID()
 }
 
-func TestContributor_GetReposURL(tt *testing.T) {
+func TestCommit_GetSHA(tt *testing.T) {
 	var zeroValue string
-	c := &Contributor{ReposURL: &zeroValue}
-	c.GetReposURL()
-	c = &Contributor{}
-	c.GetReposURL()
+	c := &Commit{SHA: &zeroValue}
+	c.GetSHA()
+	c = &Commit{}
+	c.GetSHA()
 	c = nil
-	c.GetReposURL()
+	c.GetSHA()
 }
 
-func TestContributor_GetSiteAdmin(tt *testing.T) {
-	var zeroValue bool
-	c := &Contributor{SiteAdmin: &zeroValue}
-	c.GetSiteAdmin()
-	c = &Contributor{}
-	c.GetSiteAdmin()
+func TestCommit_GetStats(tt *testing.T) {
+	c := &Commit{}
+	c.GetStats()
 	c = nil
-	c.GetSiteAdmin()
+	c.GetStats()
 }
 
-func TestContributor_GetStarredURL(tt *testing.T) {
-	var zeroValue string
-	c := &Contributor{StarredURL: &zeroValue}
-	c.GetStarredURL()
-	c = &Contributor{}
-	c.GetStarredURL()
+func TestCommit_GetTree(tt *testing.T) {
+	c := &Commit{}
+	c.GetTree()
 	c = nil
-	c.GetStarredURL()
+	c.GetTree()
 }
 
-func TestContributor_GetSubscriptionsURL(tt *testing.T) {
+func TestCommit_GetURL(tt *testing.T) {
 	var zeroValue string
-	c := &Contributor{SubscriptionsURL: &zeroValue}
-	c.GetSubscriptionsURL()
-	c = &Contributor{}
-	c.GetSubscriptionsURL()
-	c = nil
-	c.GetSubscriptionsURL()
-}
-
-func TestContributor_GetType(tt *testing.T) {
-	var zeroValue string
-	c := &Contributor{Type: &zeroValue}
-	c.GetType()
-	c = &Contributor{}
-	c.GetType()
-	c = nil
-	c.GetType()
-}
-
-func TestContributor_GetURL(tt *testing.T) {
-	var zeroValue string
-	c := &Contributor{URL: &zeroValue}
+	c := &Commit{URL: &zeroValue}
 	c.GetURL()
-	c = &Contributor{}
+	c = &Commit{}
 	c.GetURL()
 	c = nil
 	c.GetURL()
 }
 
-func TestContributorStats_GetAuthor(tt *testing.T) {
-	c := &ContributorStats{}
-	c.GetAuthor()
+func TestCommit_GetVerification(tt *testing.T) {
+	c := &Commit{}
+	c.GetVerification()
 	c = nil
-	c.GetAuthor()
+	c.GetVerification()
 }
 
-func TestContributorStats_GetTotal(tt *testing.T) {
-	var zeroValue int
-	c := &ContributorStats{Total: &zeroValue}
-	c.GetTotal()
-	c = &ContributorStats{}
-	c.GetTotal()
+func TestCommitAuthor_GetDate(tt *testing.T) {
+	var zeroValue time.Time
+	c := &CommitAuthor{Date: &zeroValue}
+	c.GetDate()
+	c = &CommitAuthor{}
+	c.GetDate()
 	c = nil
-	c.GetTotal()
+	c.GetDate()
 }
 
-func TestCreateCheckRunOptions_GetCompletedAt(tt *testing.T) {
-	var zeroValue Timestamp
-	c := &CreateCheckRunOptions{CompletedAt: &zeroValue}
-	c.GetCompletedAt()
-	c = &CreateCheckRunOptions{}
-	c.GetCompletedAt()
+func TestCommitAuthor_GetEmail(tt *testing.T) {
+	var zeroValue string
+	c := &CommitAuthor{Email: &zeroValue}
+	c.GetEmail()
+	c = &CommitAuthor{}
+	c.GetEmail()
 	c = nil
-	c.GetCompletedAt()
+	c.GetEmail()
 }
 
-func TestCreateCheckRunOptions_GetConclusion(tt *testing.T) {
+func TestCommitAuthor_GetLogin(tt *testing.T) {
 	var zeroValue string
-	c := &CreateCheckRunOptions{Conclusion: &zeroValue}
-	c.GetConclusion()
-	c = &CreateCheckRunOptions{}
-	c.GetConclusion()
+	c := &CommitAuthor{Login: &zeroValue}
+	c.GetLogin()
+	c = &CommitAuthor{}
+	c.GetLogin()
 	c = nil
-	c.GetConclusion()
+	c.GetLogin()
 }
 
-func TestCreateCheckRunOptions_GetDetailsURL(tt *testing.T) {
+func TestCommitAuthor_GetName(tt *testing.T) {
 	var zeroValue string
-	c := &CreateCheckRunOptions{DetailsURL: &zeroValue}
-	c.GetDetailsURL()
-	c = &CreateCheckRunOptions{}
-	c.GetDetailsURL()
+	c := &CommitAuthor{Name: &zeroValue}
+	c.GetName()
+	c = &CommitAuthor{}
+	c.GetName()
 	c = nil
-	c.GetDetailsURL()
+	c.GetName()
 }
 
-func TestCreateCheckRunOptions_GetExternalID(tt *testing.T) {
+func TestCommitCommentEvent_GetAction(tt *testing.T) {
 	var zeroValue string
-	c := &CreateCheckRunOptions{ExternalID: &zeroValue}
-	c.GetExternalID()
-	c = &CreateCheckRunOptions{}
-	c.GetExternalID()
+	c := &CommitCommentEvent{Action: &zeroValue}
+	c.GetAction()
+	c = &CommitCommentEvent{}
+	c.GetAction()
 	c = nil
-	c.GetExternalID()
+	c.GetAction()
 }
 
-func TestCreateCheckRunOptions_GetOutput(tt *testing.T) {
-	c := &CreateCheckRunOptions{}
-	c.GetOutput()
+func TestCommitCommentEvent_GetComment(tt *testing.T) {
+	c := &CommitCommentEvent{}
+	c.GetComment()
 	c = nil
-	c.GetOutput()
+	c.GetComment()
 }
 
-func TestCreateCheckRunOptions_GetStartedAt(tt *testing.T) {
-	var zeroValue Timestamp
-	c := &CreateCheckRunOptions{StartedAt: &zeroValue}
-	c.GetStartedAt()
-	c = &CreateCheckRunOptions{}
-	c.GetStartedAt()
+func TestCommitCommentEvent_GetInstallation(tt *testing.T) {
+	c := &CommitCommentEvent{}
+	c.GetInstallation()
 	c = nil
-	c.GetStartedAt()
+	c.GetInstallation()
 }
 
-func TestCreateCheckRunOptions_GetStatus(tt *testing.T) {
-	var zeroValue string
-	c := &CreateCheckRunOptions{Status: &zeroValue}
-	c.GetStatus()
-	c = &CreateCheckRunOptions{}
-	c.GetStatus()
+func TestCommitCommentEvent_GetRepo(tt *testing.T) {
+	c := &CommitCommentEvent{}
+	c.GetRepo()
 	c = nil
-	c.GetStatus()
+	c.GetRepo()
 }
 
-func TestCreateCheckSuiteOptions_GetHeadBranch(tt *testing.T) {
-	var zeroValue string
-	c := &CreateCheckSuiteOptions{HeadBranch: &zeroValue}
-	c.GetHeadBranch()
-	c = &CreateCheckSuiteOptions{}
-	c.GetHeadBranch()
+func TestCommitCommentEvent_GetSender(tt *testing.T) {
+	c := &CommitCommentEvent{}
+	c.GetSender()
 	c = nil
-	c.GetHeadBranch()
+	c.GetSender()
 }
 
-func TestCreateEvent_GetDescription(tt *testing.T) {
+func TestCommitFile_GetAdditions(tt *testing.T) {
+	var zeroValue int
+	c := &CommitFile{Additions: &zeroValue}
+	c.GetAdditions()
+	c = &CommitFile{}
+	c.GetAdditions()
+	c = nil
+	c.GetAdditions()
+}
+
+func TestCommitFile_GetBlobURL(tt *testing.T) {
 	var zeroValue string
-	c := &CreateEvent{Description: &zeroValue}
-	c.GetDescription()
-	c = &CreateEvent{}
-	c.GetDescription()
+	c := &CommitFile{BlobURL: &zeroValue}
+	c.GetBlobURL()
+	c = &CommitFile{}
+	c.GetBlobURL()
 	c = nil
-	c.GetDescription()
+	c.GetBlobURL()
 }
 
-func TestCreateEvent_GetInstallation(tt *testing.T) {
-	c := &CreateEvent{}
-	c.GetInstallation()
+func TestCommitFile_GetChanges(tt *testing.T) {
+	var zeroValue int
+	c := &CommitFile{Changes: &zeroValue}
+	c.GetChanges()
+	c = &CommitFile{}
+	c.GetChanges()
 	c = nil
-	c.GetInstallation()
+	c.GetChanges()
 }
 
-func TestCreateEvent_GetMasterBranch(tt *testing.T) {
+func TestCommitFile_GetContentsURL(tt *testing.T) {
 	var zeroValue string
-	c := &CreateEvent{MasterBranch: &zeroValue}
-	c.GetMasterBranch()
-	c = &CreateEvent{}
-	c.GetMasterBranch()
+	c := &CommitFile{ContentsURL: &zeroValue}
+	c.GetContentsURL()
+	c = &CommitFile{}
+	c.GetContentsURL()
 	c = nil
-	c.GetMasterBranch()
+	c.GetContentsURL()
 }
 
-func TestCreateEvent_GetPusherType(tt *testing.T) {
-	var zeroValue string
-	c := &CreateEvent{PusherType: &zeroValue}
-	c.GetPusherType()
-	c = &CreateEvent{}
-	c.GetPusherType()
+func TestCommitFile_GetDeletions(tt *testing.T) {
+	var zeroValue int
+	c := &CommitFile{Deletions: &zeroValue}
+	c.GetDeletions()
+	c = &CommitFile{}
+	c.GetDeletions()
 	c = nil
-	c.GetPusherType()
+	c.GetDeletions()
 }
 
-func TestCreateEvent_GetRef(tt *testing.T) {
+func TestCommitFile_GetFilename(tt *testing.T) {
 	var zeroValue string
-	c := &CreateEvent{Ref: &zeroValue}
-	c.GetRef()
-	c = &CreateEvent{}
-	c.GetRef()
+	c := &CommitFile{Filename: &zeroValue}
+	c.GetFilename()
+	c = &CommitFile{}
+	c.GetFilename()
 	c = nil
-	c.GetRef()
+	c.GetFilename()
 }
 
-func TestCreateEvent_GetRefType(tt *testing.T) {
+func TestCommitFile_GetPatch(tt *testing.T) {
 	var zeroValue string
-	c := &CreateEvent{RefType: &zeroValue}
-	c.GetRefType()
-	c = &CreateEvent{}
-	c.GetRefType()
+	c := &CommitFile{Patch: &zeroValue}
+	c.GetPatch()
+	c = &CommitFile{}
+	c.GetPatch()
 	c = nil
-	c.GetRefType()
+	c.GetPatch()
 }
 
-func TestCreateEvent_GetRepo(tt *testing.T) {
-	c := &CreateEvent{}
-	c.GetRepo()
+func TestCommitFile_GetPreviousFilename(tt *testing.T) {
+	var zeroValue string
+	c := &CommitFile{PreviousFilename: &zeroValue}
+	c.GetPreviousFilename()
+	c = &CommitFile{}
+	c.GetPreviousFilename()
 	c = nil
-	c.GetRepo()
+	c.GetPreviousFilename()
 }
 
-func TestCreateEvent_GetSender(tt *testing.T) {
-	c := &CreateEvent{}
-	c.GetSender()
+func TestCommitFile_GetRawURL(tt *testing.T) {
+	var zeroValue string
+	c := &CommitFile{RawURL: &zeroValue}
+	c.GetRawURL()
+	c = &CommitFile{}
+	c.GetRawURL()
 	c = nil
-	c.GetSender()
+	c.GetRawURL()
 }
 
-func TestCreateOrgInvitationOptions_GetEmail(tt *testing.T) {
+func TestCommitFile_GetSHA(tt *testing.T) {
 	var zeroValue string
-	c := &CreateOrgInvitationOptions{Email: &zeroValue}
-	c.GetEmail()
-	c = &CreateOrgInvitationOptions{}
-	c.GetEmail()
+	c := &CommitFile{SHA: &zeroValue}
+	c.GetSHA()
+	c = &CommitFile{}
+	c.GetSHA()
 	c = nil
-	c.GetEmail()
+	c.GetSHA()
 }
 
-func TestCreateOrgInvitationOptions_GetInviteeID(tt *testing.T) {
-	var zeroValue int64
-	c := &CreateOrgInvitationOptions{InviteeID: &zeroValue}
-	c.GetInviteeID()
-	c = &CreateOrgInvitationOptions{}
-	c.GetInviteeID()
-	c = nil
-	c.GetInviteeID()
+func TestCommitFile_GetStatus(tt *testing.T) {
+	var zeroValue string
+	c := &CommitFile{Status: &zeroValue}
+	c.GetStatus()
+	c = &CommitFile{}
+	c.GetStatus()
+	c = nil
+	c.GetStatus()
 }
 
-func TestCreateOrgInvitationOptions_GetRole(tt *testing.T) {
-	var zeroValue string
-	c := &CreateOrgInvitationOptions{Role: &zeroValue}
-	c.GetRole()
-	c = &CreateOrgInvitationOptions{}
-	c.GetRole()
+func TestCommitResult_GetAuthor(tt *testing.T) {
+	c := &CommitResult{}
+	c.GetAuthor()
 	c = nil
-	c.GetRole()
+	c.GetAuthor()
 }
 
-func TestCreateUserProjectOptions_GetBody(tt *testing.T) {
+func TestCommitResult_GetCommentsURL(tt *testing.T) {
 	var zeroValue string
-	c := &CreateUserProjectOptions{Body: &zeroValue}
-	c.GetBody()
-	c = &CreateUserProjectOptions{}
-	c.GetBody()
+	c := &CommitResult{CommentsURL: &zeroValue}
+	c.GetCommentsURL()
+	c = &CommitResult{}
+	c.GetCommentsURL()
 	c = nil
-	c.GetBody()
+	c.GetCommentsURL()
 }
 
-func TestDeleteEvent_GetInstallation(tt *testing.T) {
-	d := &DeleteEvent{}
-	d.GetInstallation()
-	d = nil
-	d.GetInstallation()
+func TestCommitResult_GetCommit(tt *testing.T) {
+	c := &CommitResult{}
+	c.GetCommit()
+	c = nil
+	c.GetCommit()
 }
 
-func TestDeleteEvent_GetPusherType(tt *testing.T) {
-	var zeroValue string
-	d := &DeleteEvent{PusherType: &zeroValue}
-	d.GetPusherType()
-	d = &DeleteEvent{}
-	d.GetPusherType()
-	d = nil
-	d.GetPusherType()
+func TestCommitResult_GetCommitter(tt *testing.T) {
+	c := &CommitResult{}
+	c.GetCommitter()
+	c = nil
+	c.GetCommitter()
 }
 
-func TestDeleteEvent_GetRef(tt *testing.T) {
+func TestCommitResult_GetHTMLURL(tt *testing.T) {
 	var zeroValue string
-	d := &DeleteEvent{Ref: &zeroValue}
-	d.GetRef()
-	d = &DeleteEvent{}
-	d.GetRef()
-	d = nil
-	d.GetRef()
+	c := &CommitResult{HTMLURL: &zeroValue}
+	c.GetHTMLURL()
+	c = &CommitResult{}
+	c.GetHTMLURL()
+	c = nil
+	c.GetHTMLURL()
 }
 
-func TestDeleteEvent_GetRefType(tt *testing.T) {
-	var zeroValue string
-	d := &DeleteEvent{RefType: &zeroValue}
-	d.GetRefType()
-	d = &DeleteEvent{}
-	d.GetRefType()
-	d = nil
-	d.GetRefType()
+func TestCommitResult_GetRepository(tt *testing.T) {
+	c := &CommitResult{}
+	c.GetRepository()
+	c = nil
+	c.GetRepository()
 }
 
-func TestDeleteEvent_GetRepo(tt *testing.T) {
-	d := &DeleteEvent{}
-	d.GetRepo()
-	d = nil
-	d.GetRepo()
+func TestCommitResult_GetScore(tt *testing.T) {
+	c := &CommitResult{}
+	c.GetScore()
+	c = nil
+	c.GetScore()
 }
 
-func TestDeleteEvent_GetSender(tt *testing.T) {
-	d := &DeleteEvent{}
-	d.GetSender()
-	d = nil
-	d.GetSender()
+func TestCommitResult_GetSHA(tt *testing.T) {
+	var zeroValue string
+	c := &CommitResult{SHA: &zeroValue}
+	c.GetSHA()
+	c = &CommitResult{}
+	c.GetSHA()
+	c = nil
+	c.GetSHA()
 }
 
-func TestDeployKeyEvent_GetAction(tt *testing.T) {
+func TestCommitResult_GetURL(tt *testing.T) {
 	var zeroValue string
-	d := &DeployKeyEvent{Action: &zeroValue}
-	d.GetAction()
-	d = &DeployKeyEvent{}
-	d.GetAction()
-	d = nil
-	d.GetAction()
+	c := &CommitResult{URL: &zeroValue}
+	c.GetURL()
+	c = &CommitResult{}
+	c.GetURL()
+	c = nil
+	c.GetURL()
 }
 
-func TestDeployKeyEvent_GetKey(tt *testing.T) {
-	d := &DeployKeyEvent{}
-	d.GetKey()
-	d = nil
-	d.GetKey()
+func TestCommitsComparison_GetAheadBy(tt *testing.T) {
+	var zeroValue int
+	c := &CommitsComparison{AheadBy: &zeroValue}
+	c.GetAheadBy()
+	c = &CommitsComparison{}
+	c.GetAheadBy()
+	c = nil
+	c.GetAheadBy()
 }
 
-func TestDeployment_GetCreatedAt(tt *testing.T) {
-	var zeroValue Timestamp
-	d := &Deployment{CreatedAt: &zeroValue}
-	d.GetCreatedAt()
-	d = &Deployment{}
-	d.GetCreatedAt()
-	d = nil
-	d.GetCreatedAt()
+func TestCommitsComparison_GetBaseCommit(tt *testing.T) {
+	c := &CommitsComparison{}
+	c.GetBaseCommit()
+	c = nil
+	c.GetBaseCommit()
 }
 
-func TestDeployment_GetCreator(tt *testing.T) {
-	d := &Deployment{}
-	d.GetCreator()
-	d = nil
-	d.GetCreator()
+func TestCommitsComparison_GetBehindBy(tt *testing.T) {
+	var zeroValue int
+	c := &CommitsComparison{BehindBy: &zeroValue}
+	c.GetBehindBy()
+	c = &CommitsComparison{}
+	c.GetBehindBy()
+	c = nil
+	c.GetBehindBy()
 }
 
-func TestDeployment_GetDescription(tt *testing.T) {
+func TestCommitsComparison_GetDiffURL(tt *testing.T) {
 	var zeroValue string
-	d := &Deployment{Description: &zeroValue}
-	d.GetDescription()
-	d = &Deployment{}
-	d.GetDescription()
-	d = nil
-	d.GetDescription()
+	c := &CommitsComparison{DiffURL: &zeroValue}
+	c.GetDiffURL()
+	c = &CommitsComparison{}
+	c.GetDiffURL()
+	c = nil
+	c.GetDiffURL()
 }
 
-func TestDeployment_GetEnvironment(tt *testing.T) {
+func TestCommitsComparison_GetHTMLURL(tt *testing.T) {
 	var zeroValue string
-	d := &Deployment{Environment: &zeroValue}
-	d.GetEnvironment()
-	d = &Deployment{}
-	d.GetEnvironment()
-	d = nil
-	d.GetEnvironment()
+	c := &CommitsComparison{HTMLURL: &zeroValue}
+	c.GetHTMLURL()
+	c = &CommitsComparison{}
+	c.GetHTMLURL()
+	c = nil
+	c.GetHTMLURL()
 }
 
-func TestDeployment_GetID(tt *testing.T) {
-	var zeroValue int64
-	d := &Deployment{ID: &zeroValue}
-	d.GetID()
-	d = &Deployment{}
-	d.GetID()
-	d = nil
-	d.GetID()
+func TestCommitsComparison_GetMergeBaseCommit(tt *testing.T) {
+	c := &CommitsComparison{}
+	c.GetMergeBaseCommit()
+	c = nil
+	c.GetMergeBaseCommit()
 }
 
-func TestDeployment_GetNodeID(tt *testing.T) {
+func TestCommitsComparison_GetPatchURL(tt *testing.T) {
 	var zeroValue string
-	d := &Deployment{NodeID: &zeroValue}
-	d.GetNodeID()
-	d = &Deployment{}
-	d.GetNodeID()
-	d = nil
-	d.GetNodeID()
+	c := &CommitsComparison{PatchURL: &zeroValue}
+	c.GetPatchURL()
+	c = &CommitsComparison{}
+	c.GetPatchURL()
+	c = nil
+	c.GetPatchURL()
 }
 
-func TestDeployment_GetRef(tt *testing.T) {
+func TestCommitsComparison_GetPermalinkURL(tt *testing.T) {
 	var zeroValue string
-	d := &Deployment{Ref: &zeroValue}
-	d.GetRef()
-	d = &Deployment{}
-	d.GetRef()
-	d = nil
-	d.GetRef()
+	c := &CommitsComparison{PermalinkURL: &zeroValue}
+	c.GetPermalinkURL()
+	c = &CommitsComparison{}
+	c.GetPermalinkURL()
+	c = nil
+	c.GetPermalinkURL()
 }
 
-func TestDeployment_GetRepositoryURL(tt *testing.T) {
+func TestCommitsComparison_GetStatus(tt *testing.T) {
 	var zeroValue string
-	d := &Deployment{RepositoryURL: &zeroValue}
-	d.GetRepositoryURL()
-	d = &Deployment{}
-	d.GetRepositoryURL()
-	d = nil
-	d.GetRepositoryURL()
+	c := &CommitsComparison{Status: &zeroValue}
+	c.GetStatus()
+	c = &CommitsComparison{}
+	c.GetStatus()
+	c = nil
+	c.GetStatus()
 }
 
-func TestDeployment_GetSHA(tt *testing.T) {
+func TestCommitsComparison_GetTotalCommits(tt *testing.T) {
+	var zeroValue int
+	c := &CommitsComparison{TotalCommits: &zeroValue}
+	c.GetTotalCommits()
+	c = &CommitsComparison{}
+	c.GetTotalCommits()
+	c = nil
+	c.GetTotalCommits()
+}
+
+func TestCommitsComparison_GetURL(tt *testing.T) {
 	var zeroValue string
-	d := &Deployment{SHA: &zeroValue}
-	d.GetSHA()
-	d = &Deployment{}
+	c := &CommitsComparison{URL: &zeroValue}
+	c.GetURL()
+	c = &CommitsComparison{}
+	c.GetURL()
+	c = nil
+	c.GetURL()
+}
+
+func TestCommitsSearchResult_GetIncompleteResults(tt *testing.T) {
+	var zeroValue bool
+	c := &CommitsSearchResult{IncompleteResults: &zeroValue}
+	c.GetIncompleteResults()
+	c = &CommitsSearchResult{}
+	c.GetIncompleteResults()
+	c = nil
+	c.GetIncompleteResults()
+}
+
+func TestCommitsSearchResult_GetTotal(tt *testing.T) {
+	var zeroValue int
+	c := &CommitsSearchResult{Total: &zeroValue}
+	c.GetTotal()
+	c = &CommitsSearchResult{}
+	c.GetTotal()
+	c = nil
+	c.GetTotal()
+}
+
+func TestCommitStats_GetAdditions(tt *testing.T) {
+	var zeroValue int
+	c := &CommitStats{Additions: &zeroValue}
+	c.GetAdditions()
+	c = &CommitStats{}
+	c.GetAdditions()
+	c = nil
+	c.GetAdditions()
+}
+
+func TestCommitStats_GetDeletions(tt *testing.T) {
+	var zeroValue int
+	c := &CommitStats{Deletions: &zeroValue}
+	c.GetDeletions()
+	c = &CommitStats{}
+	c.GetDeletions()
+	c = nil
+	c.GetDeletions()
+}
+
+func TestCommitStats_GetTotal(tt *testing.T) {
+	var zeroValue int
+	c := &CommitStats{Total: &zeroValue}
+	c.GetTotal()
+	c = &CommitStats{}
+	c.GetTotal()
+	c = nil
+	c.GetTotal()
+}
+
+func TestCommunityHealthFiles_GetCodeOfConduct(tt *testing.T) {
+	c := &CommunityHealthFiles{}
+	c.GetCodeOfConduct()
+	c = nil
+	c.GetCodeOfConduct()
+}
+
+func TestCommunityHealthFiles_GetContributing(tt *testing.T) {
+	c := &CommunityHealthFiles{}
+	c.GetContributing()
+	c = nil
+	c.GetContributing()
+}
+
+func TestCommunityHealthFiles_GetIssueTemplate(tt *testing.T) {
+	c := &CommunityHealthFiles{}
+	c.GetIssueTemplate()
+	c = nil
+	c.GetIssueTemplate()
+}
+
+func TestCommunityHealthFiles_GetLicense(tt *testing.T) {
+	c := &CommunityHealthFiles{}
+	c.GetLicense()
+	c = nil
+	c.GetLicense()
+}
+
+func TestCommunityHealthFiles_GetPullRequestTemplate(tt *testing.T) {
+	c := &CommunityHealthFiles{}
+	c.GetPullRequestTemplate()
+	c = nil
+	c.GetPullRequestTemplate()
+}
+
+func TestCommunityHealthFiles_GetReadme(tt *testing.T) {
+	c := &CommunityHealthFiles{}
+	c.GetReadme()
+	c = nil
+	c.GetReadme()
+}
+
+func TestCommunityHealthMetrics_GetFiles(tt *testing.T) {
+	c := &CommunityHealthMetrics{}
+	c.GetFiles()
+	c = nil
+	c.GetFiles()
+}
+
+func TestCommunityHealthMetrics_GetHealthPercentage(tt *testing.T) {
+	var zeroValue int
+	c := &CommunityHealthMetrics{HealthPercentage: &zeroValue}
+	c.GetHealthPercentage()
+	c = &CommunityHealthMetrics{}
+	c.GetHealthPercentage()
+	c = nil
+	c.GetHealthPercentage()
+}
+
+func TestCommunityHealthMetrics_GetUpdatedAt(tt *testing.T) {
+	var zeroValue time.Time
+	c := &CommunityHealthMetrics{UpdatedAt: &zeroValue}
+	c.GetUpdatedAt()
+	c = &CommunityHealthMetrics{}
+	c.GetUpdatedAt()
+	c = nil
+	c.GetUpdatedAt()
+}
+
+func TestContentReference_GetID(tt *testing.T) {
+	var zeroValue int64
+	c := &ContentReference{ID: &zeroValue}
+	c.GetID()
+	c = &ContentReference{}
+	c.GetID()
+	c = nil
+	c.GetID()
+}
+
+func TestContentReference_GetNodeID(tt *testing.T) {
+	var zeroValue string
+	c := &ContentReference{NodeID: &zeroValue}
+	c.GetNodeID()
+	c = &ContentReference{}
+	c.GetNodeID()
+	c = nil
+	c.GetNodeID()
+}
+
+func TestContentReference_GetReference(tt *testing.T) {
+	var zeroValue string
+	c := &ContentReference{Reference: &zeroValue}
+	c.GetReference()
+	c = &ContentReference{}
+	c.GetReference()
+	c = nil
+	c.GetReference()
+}
+
+func TestContentReferenceEvent_GetAction(tt *testing.T) {
+	var zeroValue string
+	c := &ContentReferenceEvent{Action: &zeroValue}
+	c.GetAction()
+	c = &ContentReferenceEvent{}
+	c.GetAction()
+	c = nil
+	c.GetAction()
+}
+
+func TestContentReferenceEvent_GetContentReference(tt *testing.T) {
+	c := &ContentReferenceEvent{}
+	c.GetContentReference()
+	c = nil
+	c.GetContentReference()
+}
+
+func TestContentReferenceEvent_GetInstallation(tt *testing.T) {
+	c := &ContentReferenceEvent{}
+	c.GetInstallation()
+	c = nil
+	c.GetInstallation()
+}
+
+func TestContentReferenceEvent_GetRepo(tt *testing.T) {
+	c := &ContentReferenceEvent{}
+	c.GetRepo()
+	c = nil
+	c.GetRepo()
+}
+
+func TestContentReferenceEvent_GetSender(tt *testing.T) {
+	c := &ContentReferenceEvent{}
+	c.GetSender()
+	c = nil
+	c.GetSender()
+}
+
+func TestContributor_GetAvatarURL(tt *testing.T) {
+	var zeroValue string
+	c := &Contributor{AvatarURL: &zeroValue}
+	c.GetAvatarURL()
+	c = &Contributor{}
+	c.GetAvatarURL()
+	c = nil
+	c.GetAvatarURL()
+}
+
+func TestContributor_GetContributions(tt *testing.T) {
+	var zeroValue int
+	c := &Contributor{Contributions: &zeroValue}
+	c.GetContributions()
+	c = &Contributor{}
+	c.GetContributions()
+	c = nil
+	c.GetContributions()
+}
+
+func TestContributor_GetEmail(tt *testing.T) {
+	var zeroValue string
+	c := &Contributor{Email: &zeroValue}
+	c.GetEmail()
+	c = &Contributor{}
+	c.GetEmail()
+	c = nil
+	c.GetEmail()
+}
+
+func TestContributor_GetEventsURL(tt *testing.T) {
+	var zeroValue string
+	c := &Contributor{EventsURL: &zeroValue}
+	c.GetEventsURL()
+	c = &Contributor{}
+	c.GetEventsURL()
+	c = nil
+	c.GetEventsURL()
+}
+
+func TestContributor_GetFollowersURL(tt *testing.T) {
+	var zeroValue string
+	c := &Contributor{FollowersURL: &zeroValue}
+	c.GetFollowersURL()
+	c = &Contributor{}
+	c.GetFollowersURL()
+	c = nil
+	c.GetFollowersURL()
+}
+
+func TestContributor_GetFollowingURL(tt *testing.T) {
+	var zeroValue string
+	c := &Contributor{FollowingURL: &zeroValue}
+	c.GetFollowingURL()
+	c = &Contributor{}
+	c.GetFollowingURL()
+	c = nil
+	c.GetFollowingURL()
+}
+
+func TestContributor_GetGistsURL(tt *testing.T) {
+	var zeroValue string
+	c := &Contributor{GistsURL: &zeroValue}
+	c.GetGistsURL()
+	c = &Contributor{}
+	c.GetGistsURL()
+	c = nil
+	c.GetGistsURL()
+}
+
+func TestContributor_GetGravatarID(tt *testing.T) {
+	var zeroValue string
+	c := &Contributor{GravatarID: &zeroValue}
+	c.GetGravatarID()
+	c = &Contributor{}
+	c.GetGravatarID()
+	c = nil
+	c.GetGravatarID()
+}
+
+func TestContributor_GetHTMLURL(tt *testing.T) {
+	var zeroValue string
+	c := &Contributor{HTMLURL: &zeroValue}
+	c.GetHTMLURL()
+	c = &Contributor{}
+	c.GetHTMLURL()
+	c = nil
+	c.GetHTMLURL()
+}
+
+func TestContributor_GetID(tt *testing.T) {
+	var zeroValue int64
+	c := &Contributor{ID: &zeroValue}
+	c.GetID()
+	c = &Contributor{}
+	c.GetID()
+	c = nil
+	c.GetID()
+}
+
+func TestContributor_GetLogin(tt *testing.T) {
+	var zeroValue string
+	c := &Contributor{Login: &zeroValue}
+	c.GetLogin()
+	c = &Contributor{}
+	c.GetLogin()
+	c = nil
+	c.GetLogin()
+}
+
+func TestContributor_GetName(tt *testing.T) {
+	var zeroValue string
+	c := &Contributor{Name: &zeroValue}
+	c.GetName()
+	c = &Contributor{}
+	c.GetName()
+	c = nil
+	c.GetName()
+}
+
+func TestContributor_GetNodeID(tt *testing.T) {
+	var zeroValue string
+	c := &Contributor{NodeID: &zeroValue}
+	c.GetNodeID()
+	c = &Contributor{}
+	c.GetNodeID()
+	c = nil
+	c.GetNodeID()
+}
+
+func TestContributor_GetOrganizationsURL(tt *testing.T) {
+	var zeroValue string
+	c := &Contributor{OrganizationsURL: &zeroValue}
+	c.GetOrganizationsURL()
+	c = &Contributor{}
+	c.GetOrganizationsURL()
+	c = nil
+	c.GetOrganizationsURL()
+}
+
+func TestContributor_GetReceivedEventsURL(tt *testing.T) {
+	var zeroValue string
+	c := &Contributor{ReceivedEventsURL: &zeroValue}
+	c.GetReceivedEventsURL()
+	c = &Contributor{}
+	c.GetReceivedEventsURL()
+	c = nil
+	c.GetReceivedEventsURL()
+}
+
+func TestContributor_GetReposURL(tt *testing.T) {
+	var zeroValue string
+	c := &Contributor{ReposURL: &zeroValue}
+	c.GetReposURL()
+	c = &Contributor{}
+	c.GetReposURL()
+	c = nil
+	c.GetReposURL()
+}
+
+func TestContributor_GetSiteAdmin(tt *testing.T) {
+	var zeroValue bool
+	c := &Contributor{SiteAdmin: &zeroValue}
+	c.GetSiteAdmin()
+	c = &Contributor{}
+	c.GetSiteAdmin()
+	c = nil
+	c.GetSiteAdmin()
+}
+
+func TestContributor_GetStarredURL(tt *testing.T) {
+	var zeroValue string
+	c := &Contributor{StarredURL: &zeroValue}
+	c.GetStarredURL()
+	c = &Contributor{}
+	c.GetStarredURL()
+	c = nil
+	c.GetStarredURL()
+}
+
+func TestContributor_GetSubscriptionsURL(tt *testing.T) {
+	var zeroValue string
+	c := &Contributor{SubscriptionsURL: &zeroValue}
+	c.GetSubscriptionsURL()
+	c = &Contributor{}
+	c.GetSubscriptionsURL()
+	c = nil
+	c.GetSubscriptionsURL()
+}
+
+func TestContributor_GetType(tt *testing.T) {
+	var zeroValue string
+	c := &Contributor{Type: &zeroValue}
+	c.GetType()
+	c = &Contributor{}
+	c.GetType()
+	c = nil
+	c.GetType()
+}
+
+func TestContributor_GetURL(tt *testing.T) {
+	var zeroValue string
+	c := &Contributor{URL: &zeroValue}
+	c.GetURL()
+	c = &Contributor{}
+	c.GetURL()
+	c = nil
+	c.GetURL()
+}
+
+func TestContributorStats_GetAuthor(tt *testing.T) {
+	c := &ContributorStats{}
+	c.GetAuthor()
+	c = nil
+	c.GetAuthor()
+}
+
+func TestContributorStats_GetTotal(tt *testing.T) {
+	var zeroValue int
+	c := &ContributorStats{Total: &zeroValue}
+	c.GetTotal()
+	c = &ContributorStats{}
+	c.GetTotal()
+	c = nil
+	c.GetTotal()
+}
+
+func TestCopilotOrganizationDetails_GetCLI(tt *testing.T) {
+	var zeroValue string
+	c := &CopilotOrganizationDetails{CLI: &zeroValue}
+	c.GetCLI()
+	c = &CopilotOrganizationDetails{}
+	c.GetCLI()
+	c = nil
+	c.GetCLI()
+}
+
+func TestCopilotOrganizationDetails_GetIDEChat(tt *testing.T) {
+	var zeroValue string
+	c := &CopilotOrganizationDetails{IDEChat: &zeroValue}
+	c.GetIDEChat()
+	c = &CopilotOrganizationDetails{}
+	c.GetIDEChat()
+	c = nil
+	c.GetIDEChat()
+}
+
+func TestCopilotOrganizationDetails_GetPlanType(tt *testing.T) {
+	var zeroValue string
+	c := &CopilotOrganizationDetails{PlanType: &zeroValue}
+	c.GetPlanType()
+	c = &CopilotOrganizationDetails{}
+	c.GetPlanType()
+	c = nil
+	c.GetPlanType()
+}
+
+func TestCopilotOrganizationDetails_GetPlatformChat(tt *testing.T) {
+	var zeroValue string
+	c := &CopilotOrganizationDetails{PlatformChat: &zeroValue}
+	c.GetPlatformChat()
+	c = &CopilotOrganizationDetails{}
+	c.GetPlatformChat()
+	c = nil
+	c.GetPlatformChat()
+}
+
+func TestCopilotOrganizationDetails_GetPublicCodeSuggestions(tt *testing.T) {
+	var zeroValue string
+	c := &CopilotOrganizationDetails{PublicCodeSuggestions: &zeroValue}
+	c.GetPublicCodeSuggestions()
+	c = &CopilotOrganizationDetails{}
+	c.GetPublicCodeSuggestions()
+	c = nil
+	c.GetPublicCodeSuggestions()
+}
+
+func TestCopilotOrganizationDetails_GetSeatBreakdown(tt *testing.T) {
+	c := &CopilotOrganizationDetails{}
+	c.GetSeatBreakdown()
+	c = nil
+	c.GetSeatBreakdown()
+}
+
+func TestCopilotOrganizationDetails_GetSeatManagementSetting(tt *testing.T) {
+	var zeroValue string
+	c := &CopilotOrganizationDetails{SeatManagementSetting: &zeroValue}
+	c.GetSeatManagementSetting()
+	c = &CopilotOrganizationDetails{}
+	c.GetSeatManagementSetting()
+	c = nil
+	c.GetSeatManagementSetting()
+}
+
+func TestCopilotSeatBreakdown_GetActiveThisCycle(tt *testing.T) {
+	var zeroValue int64
+	c := &CopilotSeatBreakdown{ActiveThisCycle: &zeroValue}
+	c.GetActiveThisCycle()
+	c = &CopilotSeatBreakdown{}
+	c.GetActiveThisCycle()
+	c = nil
+	c.GetActiveThisCycle()
+}
+
+func TestCopilotSeatBreakdown_GetAddedThisCycle(tt *testing.T) {
+	var zeroValue int64
+	c := &CopilotSeatBreakdown{AddedThisCycle: &zeroValue}
+	c.GetAddedThisCycle()
+	c = &CopilotSeatBreakdown{}
+	c.GetAddedThisCycle()
+	c = nil
+	c.GetAddedThisCycle()
+}
+
+func TestCopilotSeatBreakdown_GetInactiveThisCycle(tt *testing.T) {
+	var zeroValue int64
+	c := &CopilotSeatBreakdown{InactiveThisCycle: &zeroValue}
+	c.GetInactiveThisCycle()
+	c = &CopilotSeatBreakdown{}
+	c.GetInactiveThisCycle()
+	c = nil
+	c.GetInactiveThisCycle()
+}
+
+func TestCopilotSeatBreakdown_GetPendingCancellation(tt *testing.T) {
+	var zeroValue int64
+	c := &CopilotSeatBreakdown{PendingCancellation: &zeroValue}
+	c.GetPendingCancellation()
+	c = &CopilotSeatBreakdown{}
+	c.GetPendingCancellation()
+	c = nil
+	c.GetPendingCancellation()
+}
+
+func TestCopilotSeatBreakdown_GetPendingInvitation(tt *testing.T) {
+	var zeroValue int64
+	c := &CopilotSeatBreakdown{PendingInvitation: &zeroValue}
+	c.GetPendingInvitation()
+	c = &CopilotSeatBreakdown{}
+	c.GetPendingInvitation()
+	c = nil
+	c.GetPendingInvitation()
+}
+
+func TestCopilotSeatBreakdown_GetTotal(tt *testing.T) {
+	var zeroValue int64
+	c := &CopilotSeatBreakdown{Total: &zeroValue}
+	c.GetTotal()
+	c = &CopilotSeatBreakdown{}
+	c.GetTotal()
+	c = nil
+	c.GetTotal()
+}
+
+func TestCopilotSeatDetails_GetAssigningTeam(tt *testing.T) {
+	c := &CopilotSeatDetails{}
+	c.GetAssigningTeam()
+	c = nil
+	c.GetAssigningTeam()
+}
+
+func TestCopilotSeatDetails_GetCreatedAt(tt *testing.T) {
+	var zeroValue Timestamp
+	c := &CopilotSeatDetails{CreatedAt: &zeroValue}
+	c.GetCreatedAt()
+	c = &CopilotSeatDetails{}
+	c.GetCreatedAt()
+	c = nil
+	c.GetCreatedAt()
+}
+
+func TestCopilotSeatDetails_GetLastActivityAt(tt *testing.T) {
+	var zeroValue Timestamp
+	c := &CopilotSeatDetails{LastActivityAt: &zeroValue}
+	c.GetLastActivityAt()
+	c = &CopilotSeatDetails{}
+	c.GetLastActivityAt()
+	c = nil
+	c.GetLastActivityAt()
+}
+
+func TestCopilotSeatDetails_GetLastActivityEditor(tt *testing.T) {
+	var zeroValue string
+	c := &CopilotSeatDetails{LastActivityEditor: &zeroValue}
+	c.GetLastActivityEditor()
+	c = &CopilotSeatDetails{}
+	c.GetLastActivityEditor()
+	c = nil
+	c.GetLastActivityEditor()
+}
+
+func TestCopilotSeatDetails_GetPendingCancellationDate(tt *testing.T) {
+	var zeroValue string
+	c := &CopilotSeatDetails{PendingCancellationDate: &zeroValue}
+	c.GetPendingCancellationDate()
+	c = &CopilotSeatDetails{}
+	c.GetPendingCancellationDate()
+	c = nil
+	c.GetPendingCancellationDate()
+}
+
+func TestCopilotSeatDetails_GetPlanType(tt *testing.T) {
+	var zeroValue string
+	c := &CopilotSeatDetails{PlanType: &zeroValue}
+	c.GetPlanType()
+	c = &CopilotSeatDetails{}
+	c.GetPlanType()
+	c = nil
+	c.GetPlanType()
+}
+
+func TestCopilotSeatDetails_GetUpdatedAt(tt *testing.T) {
+	var zeroValue Timestamp
+	c := &CopilotSeatDetails{UpdatedAt: &zeroValue}
+	c.GetUpdatedAt()
+	c = &CopilotSeatDetails{}
+	c.GetUpdatedAt()
+	c = nil
+	c.GetUpdatedAt()
+}
+
+func TestCreateCheckRunOptions_GetCompletedAt(tt *testing.T) {
+	var zeroValue Timestamp
+	c := &CreateCheckRunOptions{CompletedAt: &zeroValue}
+	c.GetCompletedAt()
+	c = &CreateCheckRunOptions{}
+	c.GetCompletedAt()
+	c = nil
+	c.GetCompletedAt()
+}
+
+func TestCreateCheckRunOptions_GetConclusion(tt *testing.T) {
+	var zeroValue string
+	c := &CreateCheckRunOptions{Conclusion: &zeroValue}
+	c.GetConclusion()
+	c = &CreateCheckRunOptions{}
+	c.GetConclusion()
+	c = nil
+	c.GetConclusion()
+}
+
+func TestCreateCheckRunOptions_GetDetailsURL(tt *testing.T) {
+	var zeroValue string
+	c := &CreateCheckRunOptions{DetailsURL: &zeroValue}
+	c.GetDetailsURL()
+	c = &CreateCheckRunOptions{}
+	c.GetDetailsURL()
+	c = nil
+	c.GetDetailsURL()
+}
+
+func TestCreateCheckRunOptions_GetExternalID(tt *testing.T) {
+	var zeroValue string
+	c := &CreateCheckRunOptions{ExternalID: &zeroValue}
+	c.GetExternalID()
+	c = &CreateCheckRunOptions{}
+	c.GetExternalID()
+	c = nil
+	c.GetExternalID()
+}
+
+func TestCreateCheckRunOptions_GetOutput(tt *testing.T) {
+	c := &CreateCheckRunOptions{}
+	c.GetOutput()
+	c = nil
+	c.GetOutput()
+}
+
+func TestCreateCheckRunOptions_GetStartedAt(tt *testing.T) {
+	var zeroValue Timestamp
+	c := &CreateCheckRunOptions{StartedAt: &zeroValue}
+	c.GetStartedAt()
+	c = &CreateCheckRunOptions{}
+	c.GetStartedAt()
+	c = nil
+	c.GetStartedAt()
+}
+
+func TestCreateCheckRunOptions_GetStatus(tt *testing.T) {
+	var zeroValue string
+	c := &CreateCheckRunOptions{Status: &zeroValue}
+	c.GetStatus()
+	c = &CreateCheckRunOptions{}
+	c.GetStatus()
+	c = nil
+	c.GetStatus()
+}
+
+func TestCreateCheckSuiteOptions_GetHeadBranch(tt *testing.T) {
+	var zeroValue string
+	c := &CreateCheckSuiteOptions{HeadBranch: &zeroValue}
+	c.GetHeadBranch()
+	c = &CreateCheckSuiteOptions{}
+	c.GetHeadBranch()
+	c = nil
+	c.GetHeadBranch()
+}
+
+func TestCreateEvent_GetDescription(tt *testing.T) {
+	var zeroValue string
+	c := &CreateEvent{Description: &zeroValue}
+	c.GetDescription()
+	c = &CreateEvent{}
+	c.GetDescription()
+	c = nil
+	c.GetDescription()
+}
+
+func TestCreateEvent_GetInstallation(tt *testing.T) {
+	c := &CreateEvent{}
+	c.GetInstallation()
+	c = nil
+	c.GetInstallation()
+}
+
+func TestCreateEvent_GetMasterBranch(tt *testing.T) {
+	var zeroValue string
+	c := &CreateEvent{MasterBranch: &zeroValue}
+	c.GetMasterBranch()
+	c = &CreateEvent{}
+	c.GetMasterBranch()
+	c = nil
+	c.GetMasterBranch()
+}
+
+func TestCreateEvent_GetPusherType(tt *testing.T) {
+	var zeroValue string
+	c := &CreateEvent{PusherType: &zeroValue}
+	c.GetPusherType()
+	c = &CreateEvent{}
+	c.GetPusherType()
+	c = nil
+	c.GetPusherType()
+}
+
+func TestCreateEvent_GetRef(tt *testing.T) {
+	var zeroValue string
+	c := &CreateEvent{Ref: &zeroValue}
+	c.GetRef()
+	c = &CreateEvent{}
+	c.GetRef()
+	c = nil
+	c.GetRef()
+}
+
+func TestCreateEvent_GetRefType(tt *testing.T) {
+	var zeroValue string
+	c := &CreateEvent{RefType: &zeroValue}
+	c.GetRefType()
+	c = &CreateEvent{}
+	c.GetRefType()
+	c = nil
+	c.GetRefType()
+}
+
+func TestCreateEvent_GetRepo(tt *testing.T) {
+	c := &CreateEvent{}
+	c.GetRepo()
+	c = nil
+	c.GetRepo()
+}
+
+func TestCreateEvent_GetSender(tt *testing.T) {
+	c := &CreateEvent{}
+	c.GetSender()
+	c = nil
+	c.GetSender()
+}
+
+func TestCreateOrgInvitationOptions_GetEmail(tt *testing.T) {
+	var zeroValue string
+	c := &CreateOrgInvitationOptions{Email: &zeroValue}
+	c.GetEmail()
+	c = &CreateOrgInvitationOptions{}
+	c.GetEmail()
+	c = nil
+	c.GetEmail()
+}
+
+func TestCreateOrgInvitationOptions_GetInviteeID(tt *testing.T) {
+	var zeroValue int64
+	c := &CreateOrgInvitationOptions{InviteeID: &zeroValue}
+	c.GetInviteeID()
+	c = &CreateOrgInvitationOptions{}
+	c.GetInviteeID()
+	c = nil
+	c.GetInviteeID()
+}
+
+func TestCreateOrgInvitationOptions_GetRole(tt *testing.T) {
+	var zeroValue string
+	c := &CreateOrgInvitationOptions{Role: &zeroValue}
+	c.GetRole()
+	c = &CreateOrgInvitationOptions{}
+	c.GetRole()
+	c = nil
+	c.GetRole()
+}
+
+func TestCreateOrUpdateCustomRepoRoleOptions_GetBaseRole(tt *testing.T) {
+	var zeroValue string
+	c := &CreateOrUpdateCustomRepoRoleOptions{BaseRole: &zeroValue}
+	c.GetBaseRole()
+	c = &CreateOrUpdateCustomRepoRoleOptions{}
+	c.GetBaseRole()
+	c = nil
+	c.GetBaseRole()
+}
+
+func TestCreateOrUpdateCustomRepoRoleOptions_GetDescription(tt *testing.T) {
+	var zeroValue string
+	c := &CreateOrUpdateCustomRepoRoleOptions{Description: &zeroValue}
+	c.GetDescription()
+	c = &CreateOrUpdateCustomRepoRoleOptions{}
+	c.GetDescription()
+	c = nil
+	c.GetDescription()
+}
+
+func TestCreateOrUpdateCustomRepoRoleOptions_GetName(tt *testing.T) {
+	var zeroValue string
+	c := &CreateOrUpdateCustomRepoRoleOptions{Name: &zeroValue}
+	c.GetName()
+	c = &CreateOrUpdateCustomRepoRoleOptions{}
+	c.GetName()
+	c = nil
+	c.GetName()
+}
+
+func TestCreateOrUpdateOrgRoleOptions_GetBaseRole(tt *testing.T) {
+	var zeroValue string
+	c := &CreateOrUpdateOrgRoleOptions{BaseRole: &zeroValue}
+	c.GetBaseRole()
+	c = &CreateOrUpdateOrgRoleOptions{}
+	c.GetBaseRole()
+	c = nil
+	c.GetBaseRole()
+}
+
+func TestCreateOrUpdateOrgRoleOptions_GetDescription(tt *testing.T) {
+	var zeroValue string
+	c := &CreateOrUpdateOrgRoleOptions{Description: &zeroValue}
+	c.GetDescription()
+	c = &CreateOrUpdateOrgRoleOptions{}
+	c.GetDescription()
+	c = nil
+	c.GetDescription()
+}
+
+func TestCreateOrUpdateOrgRoleOptions_GetName(tt *testing.T) {
+	var zeroValue string
+	c := &CreateOrUpdateOrgRoleOptions{Name: &zeroValue}
+	c.GetName()
+	c = &CreateOrUpdateOrgRoleOptions{}
+	c.GetName()
+	c = nil
+	c.GetName()
+}
+
+func TestCreateUserProjectOptions_GetBody(tt *testing.T) {
+	var zeroValue string
+	c := &CreateUserProjectOptions{Body: &zeroValue}
+	c.GetBody()
+	c = &CreateUserProjectOptions{}
+	c.GetBody()
+	c = nil
+	c.GetBody()
+}
+
+func TestCreationInfo_GetCreated(tt *testing.T) {
+	var zeroValue Timestamp
+	c := &CreationInfo{Created: &zeroValue}
+	c.GetCreated()
+	c = &CreationInfo{}
+	c.GetCreated()
+	c = nil
+	c.GetCreated()
+}
+
+func TestCredit_GetType(tt *testing.T) {
+	var zeroValue string
+	c := &Credit{Type: &zeroValue}
+	c.GetType()
+	c = &Credit{}
+	c.GetType()
+	c = nil
+	c.GetType()
+}
+
+func TestCredit_GetUser(tt *testing.T) {
+	c := &Credit{}
+	c.GetUser()
+	c = nil
+	c.GetUser()
+}
+
+func TestCustomDeploymentProtectionRule_GetApp(tt *testing.T) {
+	c := &CustomDeploymentProtectionRule{}
+	c.GetApp()
+	c = nil
+	c.GetApp()
+}
+
+func TestCustomDeploymentProtectionRule_GetEnabled(tt *testing.T) {
+	var zeroValue bool
+	c := &CustomDeploymentProtectionRule{Enabled: &zeroValue}
+	c.GetEnabled()
+	c = &CustomDeploymentProtectionRule{}
+	c.GetEnabled()
+	c = nil
+	c.GetEnabled()
+}
+
+func TestCustomDeploymentProtectionRule_GetID(tt *testing.T) {
+	var zeroValue int64
+	c := &CustomDeploymentProtectionRule{ID: &zeroValue}
+	c.GetID()
+	c = &CustomDeploymentProtectionRule{}
+	c.GetID()
+	c = nil
+	c.GetID()
+}
+
+func TestCustomDeploymentProtectionRule_GetNodeID(tt *testing.T) {
+	var zeroValue string
+	c := &CustomDeploymentProtectionRule{NodeID: &zeroValue}
+	c.GetNodeID()
+	c = &CustomDeploymentProtectionRule{}
+	c.GetNodeID()
+	c = nil
+	c.GetNodeID()
+}
+
+func TestCustomDeploymentProtectionRuleApp_GetID(tt *testing.T) {
+	var zeroValue int64
+	c := &CustomDeploymentProtectionRuleApp{ID: &zeroValue}
+	c.GetID()
+	c = &CustomDeploymentProtectionRuleApp{}
+	c.GetID()
+	c = nil
+	c.GetID()
+}
+
+func TestCustomDeploymentProtectionRuleApp_GetIntegrationURL(tt *testing.T) {
+	var zeroValue string
+	c := &CustomDeploymentProtectionRuleApp{IntegrationURL: &zeroValue}
+	c.GetIntegrationURL()
+	c = &CustomDeploymentProtectionRuleApp{}
+	c.GetIntegrationURL()
+	c = nil
+	c.GetIntegrationURL()
+}
+
+func TestCustomDeploymentProtectionRuleApp_GetNodeID(tt *testing.T) {
+	var zeroValue string
+	c := &CustomDeploymentProtectionRuleApp{NodeID: &zeroValue}
+	c.GetNodeID()
+	c = &CustomDeploymentProtectionRuleApp{}
+	c.GetNodeID()
+	c = nil
+	c.GetNodeID()
+}
+
+func TestCustomDeploymentProtectionRuleApp_GetSlug(tt *testing.T) {
+	var zeroValue string
+	c := &CustomDeploymentProtectionRuleApp{Slug: &zeroValue}
+	c.GetSlug()
+	c = &CustomDeploymentProtectionRuleApp{}
+	c.GetSlug()
+	c = nil
+	c.GetSlug()
+}
+
+func TestCustomDeploymentProtectionRuleRequest_GetIntegrationID(tt *testing.T) {
+	var zeroValue int64
+	c := &CustomDeploymentProtectionRuleRequest{IntegrationID: &zeroValue}
+	c.GetIntegrationID()
+	c = &CustomDeploymentProtectionRuleRequest{}
+	c.GetIntegrationID()
+	c = nil
+	c.GetIntegrationID()
+}
+
+func TestCustomOrgRoles_GetBaseRole(tt *testing.T) {
+	var zeroValue string
+	c := &CustomOrgRoles{BaseRole: &zeroValue}
+	c.GetBaseRole()
+	c = &CustomOrgRoles{}
+	c.GetBaseRole()
+	c = nil
+	c.GetBaseRole()
+}
+
+func TestCustomOrgRoles_GetCreatedAt(tt *testing.T) {
+	var zeroValue Timestamp
+	c := &CustomOrgRoles{CreatedAt: &zeroValue}
+	c.GetCreatedAt()
+	c = &CustomOrgRoles{}
+	c.GetCreatedAt()
+	c = nil
+	c.GetCreatedAt()
+}
+
+func TestCustomOrgRoles_GetDescription(tt *testing.T) {
+	var zeroValue string
+	c := &CustomOrgRoles{Description: &zeroValue}
+	c.GetDescription()
+	c = &CustomOrgRoles{}
+	c.GetDescription()
+	c = nil
+	c.GetDescription()
+}
+
+func TestCustomOrgRoles_GetID(tt *testing.T) {
+	var zeroValue int64
+	c := &CustomOrgRoles{ID: &zeroValue}
+	c.GetID()
+	c = &CustomOrgRoles{}
+	c.GetID()
+	c = nil
+	c.GetID()
+}
+
+func TestCustomOrgRoles_GetName(tt *testing.T) {
+	var zeroValue string
+	c := &CustomOrgRoles{Name: &zeroValue}
+	c.GetName()
+	c = &CustomOrgRoles{}
+	c.GetName()
+	c = nil
+	c.GetName()
+}
+
+func TestCustomOrgRoles_GetOrg(tt *testing.T) {
+	c := &CustomOrgRoles{}
+	c.GetOrg()
+	c = nil
+	c.GetOrg()
+}
+
+func TestCustomOrgRoles_GetSource(tt *testing.T) {
+	var zeroValue string
+	c := &CustomOrgRoles{Source: &zeroValue}
+	c.GetSource()
+	c = &CustomOrgRoles{}
+	c.GetSource()
+	c = nil
+	c.GetSource()
+}
+
+func TestCustomOrgRoles_GetUpdatedAt(tt *testing.T) {
+	var zeroValue Timestamp
+	c := &CustomOrgRoles{UpdatedAt: &zeroValue}
+	c.GetUpdatedAt()
+	c = &CustomOrgRoles{}
+	c.GetUpdatedAt()
+	c = nil
+	c.GetUpdatedAt()
+}
+
+func TestCustomProperty_GetDefaultValue(tt *testing.T) {
+	var zeroValue string
+	c := &CustomProperty{DefaultValue: &zeroValue}
+	c.GetDefaultValue()
+	c = &CustomProperty{}
+	c.GetDefaultValue()
+	c = nil
+	c.GetDefaultValue()
+}
+
+func TestCustomProperty_GetDescription(tt *testing.T) {
+	var zeroValue string
+	c := &CustomProperty{Description: &zeroValue}
+	c.GetDescription()
+	c = &CustomProperty{}
+	c.GetDescription()
+	c = nil
+	c.GetDescription()
+}
+
+func TestCustomProperty_GetPropertyName(tt *testing.T) {
+	var zeroValue string
+	c := &CustomProperty{PropertyName: &zeroValue}
+	c.GetPropertyName()
+	c = &CustomProperty{}
+	c.GetPropertyName()
+	c = nil
+	c.GetPropertyName()
+}
+
+func TestCustomProperty_GetRequired(tt *testing.T) {
+	var zeroValue bool
+	c := &CustomProperty{Required: &zeroValue}
+	c.GetRequired()
+	c = &CustomProperty{}
+	c.GetRequired()
+	c = nil
+	c.GetRequired()
+}
+
+func TestCustomProperty_GetValueType(tt *testing.T) {
+	var zeroValue string
+	c := &CustomProperty{ValueType: &zeroValue}
+	c.GetValueType()
+	c = &CustomProperty{}
+	c.GetValueType()
+	c = nil
+	c.GetValueType()
+}
+
+func TestCustomPropertyEvent_GetAction(tt *testing.T) {
+	var zeroValue string
+	c := &CustomPropertyEvent{Action: &zeroValue}
+	c.GetAction()
+	c = &CustomPropertyEvent{}
+	c.GetAction()
+	c = nil
+	c.GetAction()
+}
+
+func TestCustomPropertyEvent_GetDefinition(tt *testing.T) {
+	c := &CustomPropertyEvent{}
+	c.GetDefinition()
+	c = nil
+	c.GetDefinition()
+}
+
+func TestCustomPropertyEvent_GetInstallation(tt *testing.T) {
+	c := &CustomPropertyEvent{}
+	c.GetInstallation()
+	c = nil
+	c.GetInstallation()
+}
+
+func TestCustomPropertyEvent_GetOrg(tt *testing.T) {
+	c := &CustomPropertyEvent{}
+	c.GetOrg()
+	c = nil
+	c.GetOrg()
+}
+
+func TestCustomPropertyEvent_GetSender(tt *testing.T) {
+	c := &CustomPropertyEvent{}
+	c.GetSender()
+	c = nil
+	c.GetSender()
+}
+
+func TestCustomPropertyValue_GetValue(tt *testing.T) {
+	var zeroValue string
+	c := &CustomPropertyValue{Value: &zeroValue}
+	c.GetValue()
+	c = &CustomPropertyValue{}
+	c.GetValue()
+	c = nil
+	c.GetValue()
+}
+
+func TestCustomPropertyValuesEvent_GetAction(tt *testing.T) {
+	var zeroValue string
+	c := &CustomPropertyValuesEvent{Action: &zeroValue}
+	c.GetAction()
+	c = &CustomPropertyValuesEvent{}
+	c.GetAction()
+	c = nil
+	c.GetAction()
+}
+
+func TestCustomPropertyValuesEvent_GetInstallation(tt *testing.T) {
+	c := &CustomPropertyValuesEvent{}
+	c.GetInstallation()
+	c = nil
+	c.GetInstallation()
+}
+
+func TestCustomPropertyValuesEvent_GetOrg(tt *testing.T) {
+	c := &CustomPropertyValuesEvent{}
+	c.GetOrg()
+	c = nil
+	c.GetOrg()
+}
+
+func TestCustomPropertyValuesEvent_GetRepo(tt *testing.T) {
+	c := &CustomPropertyValuesEvent{}
+	c.GetRepo()
+	c = nil
+	c.GetRepo()
+}
+
+func TestCustomPropertyValuesEvent_GetSender(tt *testing.T) {
+	c := &CustomPropertyValuesEvent{}
+	c.GetSender()
+	c = nil
+	c.GetSender()
+}
+
+func TestCustomRepoRoles_GetBaseRole(tt *testing.T) {
+	var zeroValue string
+	c := &CustomRepoRoles{BaseRole: &zeroValue}
+	c.GetBaseRole()
+	c = &CustomRepoRoles{}
+	c.GetBaseRole()
+	c = nil
+	c.GetBaseRole()
+}
+
+func TestCustomRepoRoles_GetCreatedAt(tt *testing.T) {
+	var zeroValue Timestamp
+	c := &CustomRepoRoles{CreatedAt: &zeroValue}
+	c.GetCreatedAt()
+	c = &CustomRepoRoles{}
+	c.GetCreatedAt()
+	c = nil
+	c.GetCreatedAt()
+}
+
+func TestCustomRepoRoles_GetDescription(tt *testing.T) {
+	var zeroValue string
+	c := &CustomRepoRoles{Description: &zeroValue}
+	c.GetDescription()
+	c = &CustomRepoRoles{}
+	c.GetDescription()
+	c = nil
+	c.GetDescription()
+}
+
+func TestCustomRepoRoles_GetID(tt *testing.T) {
+	var zeroValue int64
+	c := &CustomRepoRoles{ID: &zeroValue}
+	c.GetID()
+	c = &CustomRepoRoles{}
+	c.GetID()
+	c = nil
+	c.GetID()
+}
+
+func TestCustomRepoRoles_GetName(tt *testing.T) {
+	var zeroValue string
+	c := &CustomRepoRoles{Name: &zeroValue}
+	c.GetName()
+	c = &CustomRepoRoles{}
+	c.GetName()
+	c = nil
+	c.GetName()
+}
+
+func TestCustomRepoRoles_GetOrg(tt *testing.T) {
+	c := &CustomRepoRoles{}
+	c.GetOrg()
+	c = nil
+	c.GetOrg()
+}
+
+func TestCustomRepoRoles_GetUpdatedAt(tt *testing.T) {
+	var zeroValue Timestamp
+	c := &CustomRepoRoles{UpdatedAt: &zeroValue}
+	c.GetUpdatedAt()
+	c = &CustomRepoRoles{}
+	c.GetUpdatedAt()
+	c = nil
+	c.GetUpdatedAt()
+}
+
+func TestDefaultSetupConfiguration_GetQuerySuite(tt *testing.T) {
+	var zeroValue string
+	d := &DefaultSetupConfiguration{QuerySuite: &zeroValue}
+	d.GetQuerySuite()
+	d = &DefaultSetupConfiguration{}
+	d.GetQuerySuite()
+	d = nil
+	d.GetQuerySuite()
+}
+
+func TestDefaultSetupConfiguration_GetSchedule(tt *testing.T) {
+	var zeroValue string
+	d := &DefaultSetupConfiguration{Schedule: &zeroValue}
+	d.GetSchedule()
+	d = &DefaultSetupConfiguration{}
+	d.GetSchedule()
+	d = nil
+	d.GetSchedule()
+}
+
+func TestDefaultSetupConfiguration_GetState(tt *testing.T) {
+	var zeroValue string
+	d := &DefaultSetupConfiguration{State: &zeroValue}
+	d.GetState()
+	d = &DefaultSetupConfiguration{}
+	d.GetState()
+	d = nil
+	d.GetState()
+}
+
+func TestDefaultSetupConfiguration_GetUpdatedAt(tt *testing.T) {
+	var zeroValue Timestamp
+	d := &DefaultSetupConfiguration{UpdatedAt: &zeroValue}
+	d.GetUpdatedAt()
+	d = &DefaultSetupConfiguration{}
+	d.GetUpdatedAt()
+	d = nil
+	d.GetUpdatedAt()
+}
+
+func TestDeleteEvent_GetInstallation(tt *testing.T) {
+	d := &DeleteEvent{}
+	d.GetInstallation()
+	d = nil
+	d.GetInstallation()
+}
+
+func TestDeleteEvent_GetPusherType(tt *testing.T) {
+	var zeroValue string
+	d := &DeleteEvent{PusherType: &zeroValue}
+	d.GetPusherType()
+	d = &DeleteEvent{}
+	d.GetPusherType()
+	d = nil
+	d.GetPusherType()
+}
+
+func TestDeleteEvent_GetRef(tt *testing.T) {
+	var zeroValue string
+	d := &DeleteEvent{Ref: &zeroValue}
+	d.GetRef()
+	d = &DeleteEvent{}
+	d.GetRef()
+	d = nil
+	d.GetRef()
+}
+
+func TestDeleteEvent_GetRefType(tt *testing.T) {
+	var zeroValue string
+	d := &DeleteEvent{RefType: &zeroValue}
+	d.GetRefType()
+	d = &DeleteEvent{}
+	d.GetRefType()
+	d = nil
+	d.GetRefType()
+}
+
+func TestDeleteEvent_GetRepo(tt *testing.T) {
+	d := &DeleteEvent{}
+	d.GetRepo()
+	d = nil
+	d.GetRepo()
+}
+
+func TestDeleteEvent_GetSender(tt *testing.T) {
+	d := &DeleteEvent{}
+	d.GetSender()
+	d = nil
+	d.GetSender()
+}
+
+func TestDependabotAlert_GetAutoDismissedAt(tt *testing.T) {
+	var zeroValue Timestamp
+	d := &DependabotAlert{AutoDismissedAt: &zeroValue}
+	d.GetAutoDismissedAt()
+	d = &DependabotAlert{}
+	d.GetAutoDismissedAt()
+	d = nil
+	d.GetAutoDismissedAt()
+}
+
+func TestDependabotAlert_GetCreatedAt(tt *testing.T) {
+	var zeroValue Timestamp
+	d := &DependabotAlert{CreatedAt: &zeroValue}
+	d.GetCreatedAt()
+	d = &DependabotAlert{}
+	d.GetCreatedAt()
+	d = nil
+	d.GetCreatedAt()
+}
+
+func TestDependabotAlert_GetDependency(tt *testing.T) {
+	d := &DependabotAlert{}
+	d.GetDependency()
+	d = nil
+	d.GetDependency()
+}
+
+func TestDependabotAlert_GetDismissedAt(tt *testing.T) {
+	var zeroValue Timestamp
+	d := &DependabotAlert{DismissedAt: &zeroValue}
+	d.GetDismissedAt()
+	d = &DependabotAlert{}
+	d.GetDismissedAt()
+	d = nil
+	d.GetDismissedAt()
+}
+
+func TestDependabotAlert_GetDismissedBy(tt *testing.T) {
+	d := &DependabotAlert{}
+	d.GetDismissedBy()
+	d = nil
+	d.GetDismissedBy()
+}
+
+func TestDependabotAlert_GetDismissedComment(tt *testing.T) {
+	var zeroValue string
+	d := &DependabotAlert{DismissedComment: &zeroValue}
+	d.GetDismissedComment()
+	d = &DependabotAlert{}
+	d.GetDismissedComment()
+	d = nil
+	d.GetDismissedComment()
+}
+
+func TestDependabotAlert_GetDismissedReason(tt *testing.T) {
+	var zeroValue string
+	d := &DependabotAlert{DismissedReason: &zeroValue}
+	d.GetDismissedReason()
+	d = &DependabotAlert{}
+	d.GetDismissedReason()
+	d = nil
+	d.GetDismissedReason()
+}
+
+func TestDependabotAlert_GetFixedAt(tt *testing.T) {
+	var zeroValue Timestamp
+	d := &DependabotAlert{FixedAt: &zeroValue}
+	d.GetFixedAt()
+	d = &DependabotAlert{}
+	d.GetFixedAt()
+	d = nil
+	d.GetFixedAt()
+}
+
+func TestDependabotAlert_GetHTMLURL(tt *testing.T) {
+	var zeroValue string
+	d := &DependabotAlert{HTMLURL: &zeroValue}
+	d.GetHTMLURL()
+	d = &DependabotAlert{}
+	d.GetHTMLURL()
+	d = nil
+	d.GetHTMLURL()
+}
+
+func TestDependabotAlert_GetNumber(tt *testing.T) {
+	var zeroValue int
+	d := &DependabotAlert{Number: &zeroValue}
+	d.GetNumber()
+	d = &DependabotAlert{}
+	d.GetNumber()
+	d = nil
+	d.GetNumber()
+}
+
+func TestDependabotAlert_GetRepository(tt *testing.T) {
+	d := &DependabotAlert{}
+	d.GetRepository()
+	d = nil
+	d.GetRepository()
+}
+
+func TestDependabotAlert_GetSecurityAdvisory(tt *testing.T) {
+	d := &DependabotAlert{}
+	d.GetSecurityAdvisory()
+	d = nil
+	d.GetSecurityAdvisory()
+}
+
+func TestDependabotAlert_GetSecurityVulnerability(tt *testing.T) {
+	d := &DependabotAlert{}
+	d.GetSecurityVulnerability()
+	d = nil
+	d.GetSecurityVulnerability()
+}
+
+func TestDependabotAlert_GetState(tt *testing.T) {
+	var zeroValue string
+	d := &DependabotAlert{State: &zeroValue}
+	d.GetState()
+	d = &DependabotAlert{}
+	d.GetState()
+	d = nil
+	d.GetState()
+}
+
+func TestDependabotAlert_GetUpdatedAt(tt *testing.T) {
+	var zeroValue Timestamp
+	d := &DependabotAlert{UpdatedAt: &zeroValue}
+	d.GetUpdatedAt()
+	d = &DependabotAlert{}
+	d.GetUpdatedAt()
+	d = nil
+	d.GetUpdatedAt()
+}
+
+func TestDependabotAlert_GetURL(tt *testing.T) {
+	var zeroValue string
+	d := &DependabotAlert{URL: &zeroValue}
+	d.GetURL()
+	d = &DependabotAlert{}
+	d.GetURL()
+	d = nil
+	d.GetURL()
+}
+
+func TestDependabotAlertState_GetDismissedComment(tt *testing.T) {
+	var zeroValue string
+	d := &DependabotAlertState{DismissedComment: &zeroValue}
+	d.GetDismissedComment()
+	d = &DependabotAlertState{}
+	d.GetDismissedComment()
+	d = nil
+	d.GetDismissedComment()
+}
+
+func TestDependabotAlertState_GetDismissedReason(tt *testing.T) {
+	var zeroValue string
+	d := &DependabotAlertState{DismissedReason: &zeroValue}
+	d.GetDismissedReason()
+	d = &DependabotAlertState{}
+	d.GetDismissedReason()
+	d = nil
+	d.GetDismissedReason()
+}
+
+func TestDependabotSecurityAdvisory_GetCVEID(tt *testing.T) {
+	var zeroValue string
+	d := &DependabotSecurityAdvisory{CVEID: &zeroValue}
+	d.GetCVEID()
+	d = &DependabotSecurityAdvisory{}
+	d.GetCVEID()
+	d = nil
+	d.GetCVEID()
+}
+
+func TestDependabotSecurityAdvisory_GetCVSS(tt *testing.T) {
+	d := &DependabotSecurityAdvisory{}
+	d.GetCVSS()
+	d = nil
+	d.GetCVSS()
+}
+
+func TestDependabotSecurityAdvisory_GetDescription(tt *testing.T) {
+	var zeroValue string
+	d := &DependabotSecurityAdvisory{Description: &zeroValue}
+	d.GetDescription()
+	d = &DependabotSecurityAdvisory{}
+	d.GetDescription()
+	d = nil
+	d.GetDescription()
+}
+
+func TestDependabotSecurityAdvisory_GetEPSS(tt *testing.T) {
+	d := &DependabotSecurityAdvisory{}
+	d.GetEPSS()
+	d = nil
+	d.GetEPSS()
+}
+
+func TestDependabotSecurityAdvisory_GetGHSAID(tt *testing.T) {
+	var zeroValue string
+	d := &DependabotSecurityAdvisory{GHSAID: &zeroValue}
+	d.GetGHSAID()
+	d = &DependabotSecurityAdvisory{}
+	d.GetGHSAID()
+	d = nil
+	d.GetGHSAID()
+}
+
+func TestDependabotSecurityAdvisory_GetPublishedAt(tt *testing.T) {
+	var zeroValue Timestamp
+	d := &DependabotSecurityAdvisory{PublishedAt: &zeroValue}
+	d.GetPublishedAt()
+	d = &DependabotSecurityAdvisory{}
+	d.GetPublishedAt()
+	d = nil
+	d.GetPublishedAt()
+}
+
+func TestDependabotSecurityAdvisory_GetSeverity(tt *testing.T) {
+	var zeroValue string
+	d := &DependabotSecurityAdvisory{Severity: &zeroValue}
+	d.GetSeverity()
+	d = &DependabotSecurityAdvisory{}
+	d.GetSeverity()
+	d = nil
+	d.GetSeverity()
+}
+
+func TestDependabotSecurityAdvisory_GetSummary(tt *testing.T) {
+	var zeroValue string
+	d := &DependabotSecurityAdvisory{Summary: &zeroValue}
+	d.GetSummary()
+	d = &DependabotSecurityAdvisory{}
+	d.GetSummary()
+	d = nil
+	d.GetSummary()
+}
+
+func TestDependabotSecurityAdvisory_GetUpdatedAt(tt *testing.T) {
+	var zeroValue Timestamp
+	d := &DependabotSecurityAdvisory{UpdatedAt: &zeroValue}
+	d.GetUpdatedAt()
+	d = &DependabotSecurityAdvisory{}
+	d.GetUpdatedAt()
+	d = nil
+	d.GetUpdatedAt()
+}
+
+func TestDependabotSecurityAdvisory_GetWithdrawnAt(tt *testing.T) {
+	var zeroValue Timestamp
+	d := &DependabotSecurityAdvisory{WithdrawnAt: &zeroValue}
+	d.GetWithdrawnAt()
+	d = &DependabotSecurityAdvisory{}
+	d.GetWithdrawnAt()
+	d = nil
+	d.GetWithdrawnAt()
+}
+
+func TestDependency_GetManifestPath(tt *testing.T) {
+	var zeroValue string
+	d := &Dependency{ManifestPath: &zeroValue}
+	d.GetManifestPath()
+	d = &Dependency{}
+	d.GetManifestPath()
+	d = nil
+	d.GetManifestPath()
+}
+
+func TestDependency_GetPackage(tt *testing.T) {
+	d := &Dependency{}
+	d.GetPackage()
+	d = nil
+	d.GetPackage()
+}
+
+func TestDependency_GetScope(tt *testing.T) {
+	var zeroValue string
+	d := &Dependency{Scope: &zeroValue}
+	d.GetScope()
+	d = &Dependency{}
+	d.GetScope()
+	d = nil
+	d.GetScope()
+}
+
+func TestDependencyChange_GetChangeType(tt *testing.T) {
+	var zeroValue string
+	d := &DependencyChange{ChangeType: &zeroValue}
+	d.GetChangeType()
+	d = &DependencyChange{}
+	d.GetChangeType()
+	d = nil
+	d.GetChangeType()
+}
+
+func TestDependencyChange_GetEcosystem(tt *testing.T) {
+	var zeroValue string
+	d := &DependencyChange{Ecosystem: &zeroValue}
+	d.GetEcosystem()
+	d = &DependencyChange{}
+	d.GetEcosystem()
+	d = nil
+	d.GetEcosystem()
+}
+
+func TestDependencyChange_GetLicense(tt *testing.T) {
+	var zeroValue string
+	d := &DependencyChange{License: &zeroValue}
+	d.GetLicense()
+	d = &DependencyChange{}
+	d.GetLicense()
+	d = nil
+	d.GetLicense()
+}
+
+func TestDependencyChange_GetManifest(tt *testing.T) {
+	var zeroValue string
+	d := &DependencyChange{Manifest: &zeroValue}
+	d.GetManifest()
+	d = &DependencyChange{}
+	d.GetManifest()
+	d = nil
+	d.GetManifest()
+}
+
+func TestDependencyChange_GetName(tt *testing.T) {
+	var zeroValue string
+	d := &DependencyChange{Name: &zeroValue}
+	d.GetName()
+	d = &DependencyChange{}
+	d.GetName()
+	d = nil
+	d.GetName()
+}
+
+func TestDependencyChange_GetPackageURL(tt *testing.T) {
+	var zeroValue string
+	d := &DependencyChange{PackageURL: &zeroValue}
+	d.GetPackageURL()
+	d = &DependencyChange{}
+	d.GetPackageURL()
+	d = nil
+	d.GetPackageURL()
+}
+
+func TestDependencyChange_GetScope(tt *testing.T) {
+	var zeroValue string
+	d := &DependencyChange{Scope: &zeroValue}
+	d.GetScope()
+	d = &DependencyChange{}
+	d.GetScope()
+	d = nil
+	d.GetScope()
+}
+
+func TestDependencyChange_GetSourceRepositoryURL(tt *testing.T) {
+	var zeroValue string
+	d := &DependencyChange{SourceRepositoryURL: &zeroValue}
+	d.GetSourceRepositoryURL()
+	d = &DependencyChange{}
+	d.GetSourceRepositoryURL()
+	d = nil
+	d.GetSourceRepositoryURL()
+}
+
+func TestDependencyChange_GetVersion(tt *testing.T) {
+	var zeroValue string
+	d := &DependencyChange{Version: &zeroValue}
+	d.GetVersion()
+	d = &DependencyChange{}
+	d.GetVersion()
+	d = nil
+	d.GetVersion()
+}
+
+func TestDependencyChangeVulnerability_GetAdvisoryGHSAID(tt *testing.T) {
+	var zeroValue string
+	d := &DependencyChangeVulnerability{AdvisoryGHSAID: &zeroValue}
+	d.GetAdvisoryGHSAID()
+	d = &DependencyChangeVulnerability{}
+	d.GetAdvisoryGHSAID()
+	d = nil
+	d.GetAdvisoryGHSAID()
+}
+
+func TestDependencyChangeVulnerability_GetAdvisorySummary(tt *testing.T) {
+	var zeroValue string
+	d := &DependencyChangeVulnerability{AdvisorySummary: &zeroValue}
+	d.GetAdvisorySummary()
+	d = &DependencyChangeVulnerability{}
+	d.GetAdvisorySummary()
+	d = nil
+	d.GetAdvisorySummary()
+}
+
+func TestDependencyChangeVulnerability_GetAdvisoryURL(tt *testing.T) {
+	var zeroValue string
+	d := &DependencyChangeVulnerability{AdvisoryURL: &zeroValue}
+	d.GetAdvisoryURL()
+	d = &DependencyChangeVulnerability{}
+	d.GetAdvisoryURL()
+	d = nil
+	d.GetAdvisoryURL()
+}
+
+func TestDependencyChangeVulnerability_GetSeverity(tt *testing.T) {
+	var zeroValue string
+	d := &DependencyChangeVulnerability{Severity: &zeroValue}
+	d.GetSeverity()
+	d = &DependencyChangeVulnerability{}
+	d.GetSeverity()
+	d = nil
+	d.GetSeverity()
+}
+
+func TestDependencyGraphSnapshot_GetDetector(tt *testing.T) {
+	d := &DependencyGraphSnapshot{}
+	d.GetDetector()
+	d = nil
+	d.GetDetector()
+}
+
+func TestDependencyGraphSnapshot_GetJob(tt *testing.T) {
+	d := &DependencyGraphSnapshot{}
+	d.GetJob()
+	d = nil
+	d.GetJob()
+}
+
+func TestDependencyGraphSnapshot_GetRef(tt *testing.T) {
+	var zeroValue string
+	d := &DependencyGraphSnapshot{Ref: &zeroValue}
+	d.GetRef()
+	d = &DependencyGraphSnapshot{}
+	d.GetRef()
+	d = nil
+	d.GetRef()
+}
+
+func TestDependencyGraphSnapshot_GetScanned(tt *testing.T) {
+	var zeroValue Timestamp
+	d := &DependencyGraphSnapshot{Scanned: &zeroValue}
+	d.GetScanned()
+	d = &DependencyGraphSnapshot{}
+	d.GetScanned()
+	d = nil
+	d.GetScanned()
+}
+
+func TestDependencyGraphSnapshot_GetSHA(tt *testing.T) {
+	var zeroValue string
+	d := &DependencyGraphSnapshot{SHA: &zeroValue}
+	d.GetSHA()
+	d = &DependencyGraphSnapshot{}
+	d.GetSHA()
+	d = nil
+	d.GetSHA()
+}
+
+func TestDependencyGraphSnapshotCreationData_GetCreatedAt(tt *testing.T) {
+	var zeroValue Timestamp
+	d := &DependencyGraphSnapshotCreationData{CreatedAt: &zeroValue}
+	d.GetCreatedAt()
+	d = &DependencyGraphSnapshotCreationData{}
+	d.GetCreatedAt()
+	d = nil
+	d.GetCreatedAt()
+}
+
+func TestDependencyGraphSnapshotCreationData_GetID(tt *testing.T) {
+	var zeroValue int64
+	d := &DependencyGraphSnapshotCreationData{ID: &zeroValue}
+	d.GetID()
+	d = &DependencyGraphSnapshotCreationData{}
+	d.GetID()
+	d = nil
+	d.GetID()
+}
+
+func TestDependencyGraphSnapshotCreationData_GetMessage(tt *testing.T) {
+	var zeroValue string
+	d := &DependencyGraphSnapshotCreationData{Message: &zeroValue}
+	d.GetMessage()
+	d = &DependencyGraphSnapshotCreationData{}
+	d.GetMessage()
+	d = nil
+	d.GetMessage()
+}
+
+func TestDependencyGraphSnapshotCreationData_GetResult(tt *testing.T) {
+	var zeroValue string
+	d := &DependencyGraphSnapshotCreationData{Result: &zeroValue}
+	d.GetResult()
+	d = &DependencyGraphSnapshotCreationData{}
+	d.GetResult()
+	d = nil
+	d.GetResult()
+}
+
+func TestDependencyGraphSnapshotDetector_GetName(tt *testing.T) {
+	var zeroValue string
+	d := &DependencyGraphSnapshotDetector{Name: &zeroValue}
+	d.GetName()
+	d = &DependencyGraphSnapshotDetector{}
+	d.GetName()
+	d = nil
+	d.GetName()
+}
+
+func TestDependencyGraphSnapshotDetector_GetURL(tt *testing.T) {
+	var zeroValue string
+	d := &DependencyGraphSnapshotDetector{URL: &zeroValue}
+	d.GetURL()
+	d = &DependencyGraphSnapshotDetector{}
+	d.GetURL()
+	d = nil
+	d.GetURL()
+}
+
+func TestDependencyGraphSnapshotDetector_GetVersion(tt *testing.T) {
+	var zeroValue string
+	d := &DependencyGraphSnapshotDetector{Version: &zeroValue}
+	d.GetVersion()
+	d = &DependencyGraphSnapshotDetector{}
+	d.GetVersion()
+	d = nil
+	d.GetVersion()
+}
+
+func TestDependencyGraphSnapshotJob_GetCorrelator(tt *testing.T) {
+	var zeroValue string
+	d := &DependencyGraphSnapshotJob{Correlator: &zeroValue}
+	d.GetCorrelator()
+	d = &DependencyGraphSnapshotJob{}
+	d.GetCorrelator()
+	d = nil
+	d.GetCorrelator()
+}
+
+func TestDependencyGraphSnapshotJob_GetHTMLURL(tt *testing.T) {
+	var zeroValue string
+	d := &DependencyGraphSnapshotJob{HTMLURL: &zeroValue}
+	d.GetHTMLURL()
+	d = &DependencyGraphSnapshotJob{}
+	d.GetHTMLURL()
+	d = nil
+	d.GetHTMLURL()
+}
+
+func TestDependencyGraphSnapshotJob_GetID(tt *testing.T) {
+	var zeroValue string
+	d := &DependencyGraphSnapshotJob{ID: &zeroValue}
+	d.GetID()
+	d = &DependencyGraphSnapshotJob{}
+	d.GetID()
+	d = nil
+	d.GetID()
+}
+
+func TestDependencyGraphSnapshotManifest_GetFile(tt *testing.T) {
+	d := &DependencyGraphSnapshotManifest{}
+	d.GetFile()
+	d = nil
+	d.GetFile()
+}
+
+func TestDependencyGraphSnapshotManifest_GetName(tt *testing.T) {
+	var zeroValue string
+	d := &DependencyGraphSnapshotManifest{Name: &zeroValue}
+	d.GetName()
+	d = &DependencyGraphSnapshotManifest{}
+	d.GetName()
+	d = nil
+	d.GetName()
+}
+
+func TestDependencyGraphSnapshotManifestFile_GetSourceLocation(tt *testing.T) {
+	var zeroValue string
+	d := &DependencyGraphSnapshotManifestFile{SourceLocation: &zeroValue}
+	d.GetSourceLocation()
+	d = &DependencyGraphSnapshotManifestFile{}
+	d.GetSourceLocation()
+	d = nil
+	d.GetSourceLocation()
+}
+
+func TestDependencyGraphSnapshotResolvedDependency_GetPackageURL(tt *testing.T) {
+	var zeroValue string
+	d := &DependencyGraphSnapshotResolvedDependency{PackageURL: &zeroValue}
+	d.GetPackageURL()
+	d = &DependencyGraphSnapshotResolvedDependency{}
+	d.GetPackageURL()
+	d = nil
+	d.GetPackageURL()
+}
+
+func TestDependencyGraphSnapshotResolvedDependency_GetRelationship(tt *testing.T) {
+	var zeroValue string
+	d := &DependencyGraphSnapshotResolvedDependency{Relationship: &zeroValue}
+	d.GetRelationship()
+	d = &DependencyGraphSnapshotResolvedDependency{}
+	d.GetRelationship()
+	d = nil
+	d.GetRelationship()
+}
+
+func TestDependencyGraphSnapshotResolvedDependency_GetScope(tt *testing.T) {
+	var zeroValue string
+	d := &DependencyGraphSnapshotResolvedDependency{Scope: &zeroValue}
+	d.GetScope()
+	d = &DependencyGraphSnapshotResolvedDependency{}
+	d.GetScope()
+	d = nil
+	d.GetScope()
+}
+
+func TestDeployKeyEvent_GetAction(tt *testing.T) {
+	var zeroValue string
+	d := &DeployKeyEvent{Action: &zeroValue}
+	d.GetAction()
+	d = &DeployKeyEvent{}
+	d.GetAction()
+	d = nil
+	d.GetAction()
+}
+
+func TestDeployKeyEvent_GetKey(tt *testing.T) {
+	d := &DeployKeyEvent{}
+	d.GetKey()
+	d = nil
+	d.GetKey()
+}
+
+func TestDeployment_GetCreatedAt(tt *testing.T) {
+	var zeroValue Timestamp
+	d := &Deployment{CreatedAt: &zeroValue}
+	d.GetCreatedAt()
+	d = &Deployment{}
+	d.GetCreatedAt()
+	d = nil
+	d.GetCreatedAt()
+}
+
+func TestDeployment_GetCreator(tt *testing.T) {
+	d := &Deployment{}
+	d.GetCreator()
+	d = nil
+	d.GetCreator()
+}
+
+func TestDeployment_GetDescription(tt *testing.T) {
+	var zeroValue string
+	d := &Deployment{Description: &zeroValue}
+	d.GetDescription()
+	d = &Deployment{}
+	d.GetDescription()
+	d = nil
+	d.GetDescription()
+}
+
+func TestDeployment_GetEnvironment(tt *testing.T) {
+	var zeroValue string
+	d := &Deployment{Environment: &zeroValue}
+	d.GetEnvironment()
+	d = &Deployment{}
+	d.GetEnvironment()
+	d = nil
+	d.GetEnvironment()
+}
+
+func TestDeployment_GetID(tt *testing.T) {
+	var zeroValue int64
+	d := &Deployment{ID: &zeroValue}
+	d.GetID()
+	d = &Deployment{}
+	d.GetID()
+	d = nil
+	d.GetID()
+}
+
+func TestDeployment_GetNodeID(tt *testing.T) {
+	var zeroValue string
+	d := &Deployment{NodeID: &zeroValue}
+	d.GetNodeID()
+	d = &Deployment{}
+	d.GetNodeID()
+	d = nil
+	d.GetNodeID()
+}
+
+func TestDeployment_GetRef(tt *testing.T) {
+	var zeroValue string
+	d := &Deployment{Ref: &zeroValue}
+	d.GetRef()
+	d = &Deployment{}
+	d.GetRef()
+	d = nil
+	d.GetRef()
+}
+
+func TestDeployment_GetRepositoryURL(tt *testing.T) {
+	var zeroValue string
+	d := &Deployment{RepositoryURL: &zeroValue}
+	d.GetRepositoryURL()
+	d = &Deployment{}
+	d.GetRepositoryURL()
+	d = nil
+	d.GetRepositoryURL()
+}
+
+func TestDeployment_GetSHA(tt *testing.T) {
+	var zeroValue string
+	d := &Deployment{SHA: &zeroValue}
+	d.GetSHA()
+	d = &Deployment{}
+	d.GetSHA()
+	d = nil
 	d.GetSHA()
+}
+
+func TestDeployment_GetStatusesURL(tt *testing.T) {
+	var zeroValue string
+	d := &Deployment{StatusesURL: &zeroValue}
+	d.GetStatusesURL()
+	d = &Deployment{}
+	d.GetStatusesURL()
+	d = nil
+	d.GetStatusesURL()
+}
+
+func TestDeployment_GetTask(tt *testing.T) {
+	var zeroValue string
+	d := &Deployment{Task: &zeroValue}
+	d.GetTask()
+	d = &Deployment{}
+	d.GetTask()
+	d = nil
+	d.GetTask()
+}
+
+func TestDeployment_GetUpdatedAt(tt *testing.T) {
+	var zeroValue Timestamp
+	d := &Deployment{UpdatedAt: &zeroValue}
+	d.GetUpdatedAt()
+	d = &Deployment{}
+	d.GetUpdatedAt()
+	d = nil
+	d.GetUpdatedAt()
+}
+
+func TestDeployment_GetURL(tt *testing.T) {
+	var zeroValue string
+	d := &Deployment{URL: &zeroValue}
+	d.GetURL()
+	d = &Deployment{}
+	d.GetURL()
+	d = nil
+	d.GetURL()
+}
+
+func TestDeploymentEvent_GetDeployment(tt *testing.T) {
+	d := &DeploymentEvent{}
+	d.GetDeployment()
+	d = nil
+	d.GetDeployment()
+}
+
+func TestDeploymentEvent_GetInstallation(tt *testing.T) {
+	d := &DeploymentEvent{}
+	d.GetInstallation()
+	d = nil
+	d.GetInstallation()
+}
+
+func TestDeploymentEvent_GetRepo(tt *testing.T) {
+	d := &DeploymentEvent{}
+	d.GetRepo()
+	d = nil
+	d.GetRepo()
+}
+
+func TestDeploymentEvent_GetSender(tt *testing.T) {
+	d := &DeploymentEvent{}
+	d.GetSender()
+	d = nil
+	d.GetSender()
+}
+
+func TestDeploymentRequest_GetAutoMerge(tt *testing.T) {
+	var zeroValue bool
+	d := &DeploymentRequest{AutoMerge: &zeroValue}
+	d.GetAutoMerge()
+	d = &DeploymentRequest{}
+	d.GetAutoMerge()
+	d = nil
+	d.GetAutoMerge()
+}
+
+func TestDeploymentRequest_GetDescription(tt *testing.T) {
+	var zeroValue string
+	d := &DeploymentRequest{Description: &zeroValue}
+	d.GetDescription()
+	d = &DeploymentRequest{}
+	d.GetDescription()
+	d = nil
+	d.GetDescription()
+}
+
+func TestDeploymentRequest_GetEnvironment(tt *testing.T) {
+	var zeroValue string
+	d := &DeploymentRequest{Environment: &zeroValue}
+	d.GetEnvironment()
+	d = &DeploymentRequest{}
+	d.GetEnvironment()
+	d = nil
+	d.GetEnvironment()
+}
+
+func TestDeploymentRequest_GetProductionEnvironment(tt *testing.T) {
+	var zeroValue bool
+	d := &DeploymentRequest{ProductionEnvironment: &zeroValue}
+	d.GetProductionEnvironment()
+	d = &DeploymentRequest{}
+	d.GetProductionEnvironment()
+	d = nil
+	d.GetProductionEnvironment()
+}
+
+func TestDeploymentRequest_GetRef(tt *testing.T) {
+	var zeroValue string
+	d := &DeploymentRequest{Ref: &zeroValue}
+	d.GetRef()
+	d = &DeploymentRequest{}
+	d.GetRef()
+	d = nil
+	d.GetRef()
+}
+
+func TestDeploymentRequest_GetRequiredContexts(tt *testing.T) {
+	var zeroValue []string
+	d := &DeploymentRequest{RequiredContexts: &zeroValue}
+	d.GetRequiredContexts()
+	d = &DeploymentRequest{}
+	d.GetRequiredContexts()
+	d = nil
+	d.GetRequiredContexts()
+}
+
+func TestDeploymentRequest_GetTask(tt *testing.T) {
+	var zeroValue string
+	d := &DeploymentRequest{Task: &zeroValue}
+	d.GetTask()
+	d = &DeploymentRequest{}
+	d.GetTask()
+	d = nil
+	d.GetTask()
+}
+
+func TestDeploymentRequest_GetTransientEnvironment(tt *testing.T) {
+	var zeroValue bool
+	d := &DeploymentRequest{TransientEnvironment: &zeroValue}
+	d.GetTransientEnvironment()
+	d = &DeploymentRequest{}
+	d.GetTransientEnvironment()
+	d = nil
+	d.GetTransientEnvironment()
+}
+
+func TestDeploymentStatus_GetCreatedAt(tt *testing.T) {
+	var zeroValue Timestamp
+	d := &DeploymentStatus{CreatedAt: &zeroValue}
+	d.GetCreatedAt()
+	d = &DeploymentStatus{}
+	d.GetCreatedAt()
+	d = nil
+	d.GetCreatedAt()
+}
+
+func TestDeploymentStatus_GetCreator(tt *testing.T) {
+	d := &DeploymentStatus{}
+	d.GetCreator()
+	d = nil
+	d.GetCreator()
+}
+
+func TestDeploymentStatus_GetDeploymentURL(tt *testing.T) {
+	var zeroValue string
+	d := &DeploymentStatus{DeploymentURL: &zeroValue}
+	d.GetDeploymentURL()
+	d = &DeploymentStatus{}
+	d.GetDeploymentURL()
+	d = nil
+	d.GetDeploymentURL()
+}
+
+func TestDeploymentStatus_GetDescription(tt *testing.T) {
+	var zeroValue string
+	d := &DeploymentStatus{Description: &zeroValue}
+	d.GetDescription()
+	d = &DeploymentStatus{}
+	d.GetDescription()
+	d = nil
+	d.GetDescription()
+}
+
+func TestDeploymentStatus_GetEnvironment(tt *testing.T) {
+	var zeroValue string
+	d := &DeploymentStatus{Environment: &zeroValue}
+	d.GetEnvironment()
+	d = &DeploymentStatus{}
+	d.GetEnvironment()
+	d = nil
+	d.GetEnvironment()
+}
+
+func TestDeploymentStatus_GetEnvironmentURL(tt *testing.T) {
+	var zeroValue string
+	d := &DeploymentStatus{EnvironmentURL: &zeroValue}
+	d.GetEnvironmentURL()
+	d = &DeploymentStatus{}
+	d.GetEnvironmentURL()
+	d = nil
+	d.GetEnvironmentURL()
+}
+
+func TestDeploymentStatus_GetID(tt *testing.T) {
+	var zeroValue int64
+	d := &DeploymentStatus{ID: &zeroValue}
+	d.GetID()
+	d = &DeploymentStatus{}
+	d.GetID()
+	d = nil
+	d.GetID()
+}
+
+func TestDeploymentStatus_GetLogURL(tt *testing.T) {
+	var zeroValue string
+	d := &DeploymentStatus{LogURL: &zeroValue}
+	d.GetLogURL()
+	d = &DeploymentStatus{}
+	d.GetLogURL()
+	d = nil
+	d.GetLogURL()
+}
+
+func TestDeploymentStatus_GetNodeID(tt *testing.T) {
+	var zeroValue string
+	d := &DeploymentStatus{NodeID: &zeroValue}
+	d.GetNodeID()
+	d = &DeploymentStatus{}
+	d.GetNodeID()
+	d = nil
+	d.GetNodeID()
+}
+
+func TestDeploymentStatus_GetRepositoryURL(tt *testing.T) {
+	var zeroValue string
+	d := &DeploymentStatus{RepositoryURL: &zeroValue}
+	d.GetRepositoryURL()
+	d = &DeploymentStatus{}
+	d.GetRepositoryURL()
+	d = nil
+	d.GetRepositoryURL()
+}
+
+func TestDeploymentStatus_GetState(tt *testing.T) {
+	var zeroValue string
+	d := &DeploymentStatus{State: &zeroValue}
+	d.GetState()
+	d = &DeploymentStatus{}
+	d.GetState()
+	d = nil
+	d.GetState()
+}
+
+func TestDeploymentStatus_GetTargetURL(tt *testing.T) {
+	var zeroValue string
+	d := &DeploymentStatus{TargetURL: &zeroValue}
+	d.GetTargetURL()
+	d = &DeploymentStatus{}
+	d.GetTargetURL()
+	d = nil
+	d.GetTargetURL()
+}
+
+func TestDeploymentStatus_GetUpdatedAt(tt *testing.T) {
+	var zeroValue Timestamp
+	d := &DeploymentStatus{UpdatedAt: &zeroValue}
+	d.GetUpdatedAt()
+	d = &DeploymentStatus{}
+	d.GetUpdatedAt()
+	d = nil
+	d.GetUpdatedAt()
+}
+
+func TestDeploymentStatus_GetURL(tt *testing.T) {
+	var zeroValue string
+	d := &DeploymentStatus{URL: &zeroValue}
+	d.GetURL()
+	d = &DeploymentStatus{}
+	d.GetURL()
+	d = nil
+	d.GetURL()
+}
+
+func TestDeploymentStatusEvent_GetDeployment(tt *testing.T) {
+	d := &DeploymentStatusEvent{}
+	d.GetDeployment()
+	d = nil
+	d.GetDeployment()
+}
+
+func TestDeploymentStatusEvent_GetDeploymentStatus(tt *testing.T) {
+	d := &DeploymentStatusEvent{}
+	d.GetDeploymentStatus()
+	d = nil
+	d.GetDeploymentStatus()
+}
+
+func TestDeploymentStatusEvent_GetInstallation(tt *testing.T) {
+	d := &DeploymentStatusEvent{}
+	d.GetInstallation()
+	d = nil
+	d.GetInstallation()
+}
+
+func TestDeploymentStatusEvent_GetRepo(tt *testing.T) {
+	d := &DeploymentStatusEvent{}
+	d.GetRepo()
+	d = nil
+	d.GetRepo()
+}
+
+func TestDeploymentStatusEvent_GetSender(tt *testing.T) {
+	d := &DeploymentStatusEvent{}
+	d.GetSender()
+	d = nil
+	d.GetSender()
+}
+
+func TestDeploymentStatusRequest_GetAutoInactive(tt *testing.T) {
+	var zeroValue bool
+	d := &DeploymentStatusRequest{AutoInactive: &zeroValue}
+	d.GetAutoInactive()
+	d = &DeploymentStatusRequest{}
+	d.GetAutoInactive()
+	d = nil
+	d.GetAutoInactive()
+}
+
+func TestDeploymentStatusRequest_GetDescription(tt *testing.T) {
+	var zeroValue string
+	d := &DeploymentStatusRequest{Description: &zeroValue}
+	d.GetDescription()
+	d = &DeploymentStatusRequest{}
+	d.GetDescription()
+	d = nil
+	d.GetDescription()
+}
+
+func TestDeploymentStatusRequest_GetEnvironment(tt *testing.T) {
+	var zeroValue string
+	d := &DeploymentStatusRequest{Environment: &zeroValue}
+	d.GetEnvironment()
+	d = &DeploymentStatusRequest{}
+	d.GetEnvironment()
+	d = nil
+	d.GetEnvironment()
+}
+
+func TestDeploymentStatusRequest_GetEnvironmentURL(tt *testing.T) {
+	var zeroValue string
+	d := &DeploymentStatusRequest{EnvironmentURL: &zeroValue}
+	d.GetEnvironmentURL()
+	d = &DeploymentStatusRequest{}
+	d.GetEnvironmentURL()
+	d = nil
+	d.GetEnvironmentURL()
+}
+
+func TestDeploymentStatusRequest_GetLogURL(tt *testing.T) {
+	var zeroValue string
+	d := &DeploymentStatusRequest{LogURL: &zeroValue}
+	d.GetLogURL()
+	d = &DeploymentStatusRequest{}
+	d.GetLogURL()
+	d = nil
+	d.GetLogURL()
+}
+
+func TestDeploymentStatusRequest_GetState(tt *testing.T) {
+	var zeroValue string
+	d := &DeploymentStatusRequest{State: &zeroValue}
+	d.GetState()
+	d = &DeploymentStatusRequest{}
+	d.GetState()
+	d = nil
+	d.GetState()
+}
+
+func TestDiscussionComment_GetAuthor(tt *testing.T) {
+	d := &DiscussionComment{}
+	d.GetAuthor()
+	d = nil
+	d.GetAuthor()
+}
+
+func TestDiscussionComment_GetBody(tt *testing.T) {
+	var zeroValue string
+	d := &DiscussionComment{Body: &zeroValue}
+	d.GetBody()
+	d = &DiscussionComment{}
+	d.GetBody()
+	d = nil
+	d.GetBody()
+}
+
+func TestDiscussionComment_GetBodyHTML(tt *testing.T) {
+	var zeroValue string
+	d := &DiscussionComment{BodyHTML: &zeroValue}
+	d.GetBodyHTML()
+	d = &DiscussionComment{}
+	d.GetBodyHTML()
+	d = nil
+	d.GetBodyHTML()
+}
+
+func TestDiscussionComment_GetBodyVersion(tt *testing.T) {
+	var zeroValue string
+	d := &DiscussionComment{BodyVersion: &zeroValue}
+	d.GetBodyVersion()
+	d = &DiscussionComment{}
+	d.GetBodyVersion()
+	d = nil
+	d.GetBodyVersion()
+}
+
+func TestDiscussionComment_GetCreatedAt(tt *testing.T) {
+	var zeroValue Timestamp
+	d := &DiscussionComment{CreatedAt: &zeroValue}
+	d.GetCreatedAt()
+	d = &DiscussionComment{}
+	d.GetCreatedAt()
+	d = nil
+	d.GetCreatedAt()
+}
+
+func TestDiscussionComment_GetDiscussionURL(tt *testing.T) {
+	var zeroValue string
+	d := &DiscussionComment{DiscussionURL: &zeroValue}
+	d.GetDiscussionURL()
+	d = &DiscussionComment{}
+	d.GetDiscussionURL()
+	d = nil
+	d.GetDiscussionURL()
+}
+
+func TestDiscussionComment_GetHTMLURL(tt *testing.T) {
+	var zeroValue string
+	d := &DiscussionComment{HTMLURL: &zeroValue}
+	d.GetHTMLURL()
+	d = &DiscussionComment{}
+	d.GetHTMLURL()
+	d = nil
+	d.GetHTMLURL()
+}
+
+func TestDiscussionComment_GetLastEditedAt(tt *testing.T) {
+	var zeroValue Timestamp
+	d := &DiscussionComment{LastEditedAt: &zeroValue}
+	d.GetLastEditedAt()
+	d = &DiscussionComment{}
+	d.GetLastEditedAt()
+	d = nil
+	d.GetLastEditedAt()
+}
+
+func TestDiscussionComment_GetNodeID(tt *testing.T) {
+	var zeroValue string
+	d := &DiscussionComment{NodeID: &zeroValue}
+	d.GetNodeID()
+	d = &DiscussionComment{}
+	d.GetNodeID()
+	d = nil
+	d.GetNodeID()
+}
+
+func TestDiscussionComment_GetNumber(tt *testing.T) {
+	var zeroValue int
+	d := &DiscussionComment{Number: &zeroValue}
+	d.GetNumber()
+	d = &DiscussionComment{}
+	d.GetNumber()
+	d = nil
+	d.GetNumber()
+}
+
+func TestDiscussionComment_GetReactions(tt *testing.T) {
+	d := &DiscussionComment{}
+	d.GetReactions()
+	d = nil
+	d.GetReactions()
+}
+
+func TestDiscussionComment_GetUpdatedAt(tt *testing.T) {
+	var zeroValue Timestamp
+	d := &DiscussionComment{UpdatedAt: &zeroValue}
+	d.GetUpdatedAt()
+	d = &DiscussionComment{}
+	d.GetUpdatedAt()
+	d = nil
+	d.GetUpdatedAt()
+}
+
+func TestDiscussionComment_GetURL(tt *testing.T) {
+	var zeroValue string
+	d := &DiscussionComment{URL: &zeroValue}
+	d.GetURL()
+	d = &DiscussionComment{}
+	d.GetURL()
+	d = nil
+	d.GetURL()
+}
+
+func TestDismissalRestrictionsRequest_GetTeams(tt *testing.T) {
+	var zeroValue []string
+	d := &DismissalRestrictionsRequest{Teams: &zeroValue}
+	d.GetTeams()
+	d = &DismissalRestrictionsRequest{}
+	d.GetTeams()
+	d = nil
+	d.GetTeams()
+}
+
+func TestDismissalRestrictionsRequest_GetUsers(tt *testing.T) {
+	var zeroValue []string
+	d := &DismissalRestrictionsRequest{Users: &zeroValue}
+	d.GetUsers()
+	d = &DismissalRestrictionsRequest{}
+	d.GetUsers()
+	d = nil
+	d.GetUsers()
+}
+
+func TestDismissedReview_GetDismissalCommitID(tt *testing.T) {
+	var zeroValue string
+	d := &DismissedReview{DismissalCommitID: &zeroValue}
+	d.GetDismissalCommitID()
+	d = &DismissedReview{}
+	d.GetDismissalCommitID()
+	d = nil
+	d.GetDismissalCommitID()
+}
+
+func TestDismissedReview_GetDismissalMessage(tt *testing.T) {
+	var zeroValue string
+	d := &DismissedReview{DismissalMessage: &zeroValue}
+	d.GetDismissalMessage()
+	d = &DismissedReview{}
+	d.GetDismissalMessage()
+	d = nil
+	d.GetDismissalMessage()
+}
+
+func TestDismissedReview_GetReviewID(tt *testing.T) {
+	var zeroValue int64
+	d := &DismissedReview{ReviewID: &zeroValue}
+	d.GetReviewID()
+	d = &DismissedReview{}
+	d.GetReviewID()
+	d = nil
+	d.GetReviewID()
+}
+
+func TestDismissedReview_GetState(tt *testing.T) {
+	var zeroValue string
+	d := &DismissedReview{State: &zeroValue}
+	d.GetState()
+	d = &DismissedReview{}
+	d.GetState()
 	d = nil
-	d.GetSHA()
+	d.GetState()
+}
+
+func TestDispatchRequestOptions_GetClientPayload(tt *testing.T) {
+	var zeroValue json.RawMessage
+	d := &DispatchRequestOptions{ClientPayload: &zeroValue}
+	d.GetClientPayload()
+	d = &DispatchRequestOptions{}
+	d.GetClientPayload()
+	d = nil
+	d.GetClientPayload()
+}
+
+func TestDraftReviewComment_GetBody(tt *testing.T) {
+	var zeroValue string
+	d := &DraftReviewComment{Body: &zeroValue}
+	d.GetBody()
+	d = &DraftReviewComment{}
+	d.GetBody()
+	d = nil
+	d.GetBody()
+}
+
+func TestDraftReviewComment_GetLine(tt *testing.T) {
+	var zeroValue int
+	d := &DraftReviewComment{Line: &zeroValue}
+	d.GetLine()
+	d = &DraftReviewComment{}
+	d.GetLine()
+	d = nil
+	d.GetLine()
+}
+
+func TestDraftReviewComment_GetPath(tt *testing.T) {
+	var zeroValue string
+	d := &DraftReviewComment{Path: &zeroValue}
+	d.GetPath()
+	d = &DraftReviewComment{}
+	d.GetPath()
+	d = nil
+	d.GetPath()
+}
+
+func TestDraftReviewComment_GetPosition(tt *testing.T) {
+	var zeroValue int
+	d := &DraftReviewComment{Position: &zeroValue}
+	d.GetPosition()
+	d = &DraftReviewComment{}
+	d.GetPosition()
+	d = nil
+	d.GetPosition()
+}
+
+func TestDraftReviewComment_GetSide(tt *testing.T) {
+	var zeroValue string
+	d := &DraftReviewComment{Side: &zeroValue}
+	d.GetSide()
+	d = &DraftReviewComment{}
+	d.GetSide()
+	d = nil
+	d.GetSide()
+}
+
+func TestDraftReviewComment_GetStartLine(tt *testing.T) {
+	var zeroValue int
+	d := &DraftReviewComment{StartLine: &zeroValue}
+	d.GetStartLine()
+	d = &DraftReviewComment{}
+	d.GetStartLine()
+	d = nil
+	d.GetStartLine()
+}
+
+func TestDraftReviewComment_GetStartSide(tt *testing.T) {
+	var zeroValue string
+	d := &DraftReviewComment{StartSide: &zeroValue}
+	d.GetStartSide()
+	d = &DraftReviewComment{}
+	d.GetStartSide()
+	d = nil
+	d.GetStartSide()
+}
+
+func TestEnterprise_GetAvatarURL(tt *testing.T) {
+	var zeroValue string
+	e := &Enterprise{AvatarURL: &zeroValue}
+	e.GetAvatarURL()
+	e = &Enterprise{}
+	e.GetAvatarURL()
+	e = nil
+	e.GetAvatarURL()
+}
+
+func TestEnterprise_GetCreatedAt(tt *testing.T) {
+	var zeroValue Timestamp
+	e := &Enterprise{CreatedAt: &zeroValue}
+	e.GetCreatedAt()
+	e = &Enterprise{}
+	e.GetCreatedAt()
+	e = nil
+	e.GetCreatedAt()
+}
+
+func TestEnterprise_GetDescription(tt *testing.T) {
+	var zeroValue string
+	e := &Enterprise{Description: &zeroValue}
+	e.GetDescription()
+	e = &Enterprise{}
+	e.GetDescription()
+	e = nil
+	e.GetDescription()
+}
+
+func TestEnterprise_GetHTMLURL(tt *testing.T) {
+	var zeroValue string
+	e := &Enterprise{HTMLURL: &zeroValue}
+	e.GetHTMLURL()
+	e = &Enterprise{}
+	e.GetHTMLURL()
+	e = nil
+	e.GetHTMLURL()
+}
+
+func TestEnterprise_GetID(tt *testing.T) {
+	var zeroValue int
+	e := &Enterprise{ID: &zeroValue}
+	e.GetID()
+	e = &Enterprise{}
+	e.GetID()
+	e = nil
+	e.GetID()
+}
+
+func TestEnterprise_GetName(tt *testing.T) {
+	var zeroValue string
+	e := &Enterprise{Name: &zeroValue}
+	e.GetName()
+	e = &Enterprise{}
+	e.GetName()
+	e = nil
+	e.GetName()
+}
+
+func TestEnterprise_GetNodeID(tt *testing.T) {
+	var zeroValue string
+	e := &Enterprise{NodeID: &zeroValue}
+	e.GetNodeID()
+	e = &Enterprise{}
+	e.GetNodeID()
+	e = nil
+	e.GetNodeID()
+}
+
+func TestEnterprise_GetSlug(tt *testing.T) {
+	var zeroValue string
+	e := &Enterprise{Slug: &zeroValue}
+	e.GetSlug()
+	e = &Enterprise{}
+	e.GetSlug()
+	e = nil
+	e.GetSlug()
+}
+
+func TestEnterprise_GetUpdatedAt(tt *testing.T) {
+	var zeroValue Timestamp
+	e := &Enterprise{UpdatedAt: &zeroValue}
+	e.GetUpdatedAt()
+	e = &Enterprise{}
+	e.GetUpdatedAt()
+	e = nil
+	e.GetUpdatedAt()
+}
+
+func TestEnterprise_GetWebsiteURL(tt *testing.T) {
+	var zeroValue string
+	e := &Enterprise{WebsiteURL: &zeroValue}
+	e.GetWebsiteURL()
+	e = &Enterprise{}
+	e.GetWebsiteURL()
+	e = nil
+	e.GetWebsiteURL()
+}
+
+func TestEvent_GetActor(tt *testing.T) {
+	e := &Event{}
+	e.GetActor()
+	e = nil
+	e.GetActor()
+}
+
+func TestEvent_GetCreatedAt(tt *testing.T) {
+	var zeroValue time.Time
+	e := &Event{CreatedAt: &zeroValue}
+	e.GetCreatedAt()
+	e = &Event{}
+	e.GetCreatedAt()
+	e = nil
+	e.GetCreatedAt()
+}
+
+func TestEvent_GetID(tt *testing.T) {
+	var zeroValue string
+	e := &Event{ID: &zeroValue}
+	e.GetID()
+	e = &Event{}
+	e.GetID()
+	e = nil
+	e.GetID()
+}
+
+func TestEvent_GetOrg(tt *testing.T) {
+	e := &Event{}
+	e.GetOrg()
+	e = nil
+	e.GetOrg()
+}
+
+func TestEvent_GetPublic(tt *testing.T) {
+	var zeroValue bool
+	e := &Event{Public: &zeroValue}
+	e.GetPublic()
+	e = &Event{}
+	e.GetPublic()
+	e = nil
+	e.GetPublic()
+}
+
+func TestEvent_GetRawPayload(tt *testing.T) {
+	var zeroValue json.RawMessage
+	e := &Event{RawPayload: &zeroValue}
+	e.GetRawPayload()
+	e = &Event{}
+	e.GetRawPayload()
+	e = nil
+	e.GetRawPayload()
+}
+
+func TestEvent_GetRepo(tt *testing.T) {
+	e := &Event{}
+	e.GetRepo()
+	e = nil
+	e.GetRepo()
+}
+
+func TestEvent_GetType(tt *testing.T) {
+	var zeroValue string
+	e := &Event{Type: &zeroValue}
+	e.GetType()
+	e = &Event{}
+	e.GetType()
+	e = nil
+	e.GetType()
+}
+
+func TestFeedLink_GetHRef(tt *testing.T) {
+	var zeroValue string
+	f := &FeedLink{HRef: &zeroValue}
+	f.GetHRef()
+	f = &FeedLink{}
+	f.GetHRef()
+	f = nil
+	f.GetHRef()
+}
+
+func TestFeedLink_GetType(tt *testing.T) {
+	var zeroValue string
+	f := &FeedLink{Type: &zeroValue}
+	f.GetType()
+	f = &FeedLink{}
+	f.GetType()
+	f = nil
+	f.GetType()
+}
+
+func TestFeeds_GetCurrentUserActorURL(tt *testing.T) {
+	var zeroValue string
+	f := &Feeds{CurrentUserActorURL: &zeroValue}
+	f.GetCurrentUserActorURL()
+	f = &Feeds{}
+	f.GetCurrentUserActorURL()
+	f = nil
+	f.GetCurrentUserActorURL()
+}
+
+func TestFeeds_GetCurrentUserOrganizationURL(tt *testing.T) {
+	var zeroValue string
+	f := &Feeds{CurrentUserOrganizationURL: &zeroValue}
+	f.GetCurrentUserOrganizationURL()
+	f = &Feeds{}
+	f.GetCurrentUserOrganizationURL()
+	f = nil
+	f.GetCurrentUserOrganizationURL()
+}
+
+func TestFeeds_GetCurrentUserPublicURL(tt *testing.T) {
+	var zeroValue string
+	f := &Feeds{CurrentUserPublicURL: &zeroValue}
+	f.GetCurrentUserPublicURL()
+	f = &Feeds{}
+	f.GetCurrentUserPublicURL()
+	f = nil
+	f.GetCurrentUserPublicURL()
+}
+
+func TestFeeds_GetCurrentUserURL(tt *testing.T) {
+	var zeroValue string
+	f := &Feeds{CurrentUserURL: &zeroValue}
+	f.GetCurrentUserURL()
+	f = &Feeds{}
+	f.GetCurrentUserURL()
+	f = nil
+	f.GetCurrentUserURL()
+}
+
+func TestFeeds_GetTimelineURL(tt *testing.T) {
+	var zeroValue string
+	f := &Feeds{TimelineURL: &zeroValue}
+	f.GetTimelineURL()
+	f = &Feeds{}
+	f.GetTimelineURL()
+	f = nil
+	f.GetTimelineURL()
+}
+
+func TestFeeds_GetUserURL(tt *testing.T) {
+	var zeroValue string
+	f := &Feeds{UserURL: &zeroValue}
+	f.GetUserURL()
+	f = &Feeds{}
+	f.GetUserURL()
+	f = nil
+	f.GetUserURL()
+}
+
+func TestFirstPatchedVersion_GetIdentifier(tt *testing.T) {
+	var zeroValue string
+	f := &FirstPatchedVersion{Identifier: &zeroValue}
+	f.GetIdentifier()
+	f = &FirstPatchedVersion{}
+	f.GetIdentifier()
+	f = nil
+	f.GetIdentifier()
+}
+
+func TestForkEvent_GetForkee(tt *testing.T) {
+	f := &ForkEvent{}
+	f.GetForkee()
+	f = nil
+	f.GetForkee()
+}
+
+func TestForkEvent_GetInstallation(tt *testing.T) {
+	f := &ForkEvent{}
+	f.GetInstallation()
+	f = nil
+	f.GetInstallation()
+}
+
+func TestForkEvent_GetRepo(tt *testing.T) {
+	f := &ForkEvent{}
+	f.GetRepo()
+	f = nil
+	f.GetRepo()
+}
+
+func TestForkEvent_GetSender(tt *testing.T) {
+	f := &ForkEvent{}
+	f.GetSender()
+	f = nil
+	f.GetSender()
+}
+
+func TestGenerateNotesOptions_GetConfigurationFilePath(tt *testing.T) {
+	var zeroValue string
+	g := &GenerateNotesOptions{ConfigurationFilePath: &zeroValue}
+	g.GetConfigurationFilePath()
+	g = &GenerateNotesOptions{}
+	g.GetConfigurationFilePath()
+	g = nil
+	g.GetConfigurationFilePath()
+}
+
+func TestGenerateNotesOptions_GetPreviousTagName(tt *testing.T) {
+	var zeroValue string
+	g := &GenerateNotesOptions{PreviousTagName: &zeroValue}
+	g.GetPreviousTagName()
+	g = &GenerateNotesOptions{}
+	g.GetPreviousTagName()
+	g = nil
+	g.GetPreviousTagName()
+}
+
+func TestGenerateNotesOptions_GetTargetCommitish(tt *testing.T) {
+	var zeroValue string
+	g := &GenerateNotesOptions{TargetCommitish: &zeroValue}
+	g.GetTargetCommitish()
+	g = &GenerateNotesOptions{}
+	g.GetTargetCommitish()
+	g = nil
+	g.GetTargetCommitish()
+}
+
+func TestGist_GetComments(tt *testing.T) {
+	var zeroValue int
+	g := &Gist{Comments: &zeroValue}
+	g.GetComments()
+	g = &Gist{}
+	g.GetComments()
+	g = nil
+	g.GetComments()
+}
+
+func TestGist_GetCreatedAt(tt *testing.T) {
+	var zeroValue time.Time
+	g := &Gist{CreatedAt: &zeroValue}
+	g.GetCreatedAt()
+	g = &Gist{}
+	g.GetCreatedAt()
+	g = nil
+	g.GetCreatedAt()
+}
+
+func TestGist_GetDescription(tt *testing.T) {
+	var zeroValue string
+	g := &Gist{Description: &zeroValue}
+	g.GetDescription()
+	g = &Gist{}
+	g.GetDescription()
+	g = nil
+	g.GetDescription()
+}
+
+func TestGist_GetGitPullURL(tt *testing.T) {
+	var zeroValue string
+	g := &Gist{GitPullURL: &zeroValue}
+	g.GetGitPullURL()
+	g = &Gist{}
+	g.GetGitPullURL()
+	g = nil
+	g.GetGitPullURL()
+}
+
+func TestGist_GetGitPushURL(tt *testing.T) {
+	var zeroValue string
+	g := &Gist{GitPushURL: &zeroValue}
+	g.GetGitPushURL()
+	g = &Gist{}
+	g.GetGitPushURL()
+	g = nil
+	g.GetGitPushURL()
+}
+
+func TestGist_GetHTMLURL(tt *testing.T) {
+	var zeroValue string
+	g := &Gist{HTMLURL: &zeroValue}
+	g.GetHTMLURL()
+	g = &Gist{}
+	g.GetHTMLURL()
+	g = nil
+	g.GetHTMLURL()
+}
+
+func TestGist_GetID(tt *testing.T) {
+	var zeroValue string
+	g := &Gist{ID: &zeroValue}
+	g.GetID()
+	g = &Gist{}
+	g.GetID()
+	g = nil
+	g.GetID()
+}
+
+func TestGist_GetNodeID(tt *testing.T) {
+	var zeroValue string
+	g := &Gist{NodeID: &zeroValue}
+	g.GetNodeID()
+	g = &Gist{}
+	g.GetNodeID()
+	g = nil
+	g.GetNodeID()
+}
+
+func TestGist_GetOwner(tt *testing.T) {
+	g := &Gist{}
+	g.GetOwner()
+	g = nil
+	g.GetOwner()
+}
+
+func TestGist_GetPublic(tt *testing.T) {
+	var zeroValue bool
+	g := &Gist{Public: &zeroValue}
+	g.GetPublic()
+	g = &Gist{}
+	g.GetPublic()
+	g = nil
+	g.GetPublic()
+}
+
+func TestGist_GetUpdatedAt(tt *testing.T) {
+	var zeroValue time.Time
+	g := &Gist{UpdatedAt: &zeroValue}
+	g.GetUpdatedAt()
+	g = &Gist{}
+	g.GetUpdatedAt()
+	g = nil
+	g.GetUpdatedAt()
+}
+
+func TestGistComment_GetBody(tt *testing.T) {
+	var zeroValue string
+	g := &GistComment{Body: &zeroValue}
+	g.GetBody()
+	g = &GistComment{}
+	g.GetBody()
+	g = nil
+	g.GetBody()
+}
+
+func TestGistComment_GetCreatedAt(tt *testing.T) {
+	var zeroValue time.Time
+	g := &GistComment{CreatedAt: &zeroValue}
+	g.GetCreatedAt()
+	g = &GistComment{}
+	g.GetCreatedAt()
+	g = nil
+	g.GetCreatedAt()
+}
+
+func TestGistComment_GetID(tt *testing.T) {
+	var zeroValue int64
+	g := &GistComment{ID: &zeroValue}
+	g.GetID()
+	g = &GistComment{}
+	g.GetID()
+	g = nil
+	g.GetID()
+}
+
+func TestGistComment_GetURL(tt *testing.T) {
+	var zeroValue string
+	g := &GistComment{URL: &zeroValue}
+	g.GetURL()
+	g = &GistComment{}
+	g.GetURL()
+	g = nil
+	g.GetURL()
+}
+
+func TestGistComment_GetUser(tt *testing.T) {
+	g := &GistComment{}
+	g.GetUser()
+	g = nil
+	g.GetUser()
+}
+
+func TestGistCommit_GetChangeStatus(tt *testing.T) {
+	g := &GistCommit{}
+	g.GetChangeStatus()
+	g = nil
+	g.GetChangeStatus()
+}
+
+func TestGistCommit_GetCommittedAt(tt *testing.T) {
+	var zeroValue Timestamp
+	g := &GistCommit{CommittedAt: &zeroValue}
+	g.GetCommittedAt()
+	g = &GistCommit{}
+	g.GetCommittedAt()
+	g = nil
+	g.GetCommittedAt()
+}
+
+func TestGistCommit_GetNodeID(tt *testing.T) {
+	var zeroValue string
+	g := &GistCommit{NodeID: &zeroValue}
+	g.GetNodeID()
+	g = &GistCommit{}
+	g.GetNodeID()
+	g = nil
+	g.GetNodeID()
+}
+
+func TestGistCommit_GetURL(tt *testing.T) {
+	var zeroValue string
+	g := &GistCommit{URL: &zeroValue}
+	g.GetURL()
+	g = &GistCommit{}
+	g.GetURL()
+	g = nil
+	g.GetURL()
+}
+
+func TestGistCommit_GetUser(tt *testing.T) {
+	g := &GistCommit{}
+	g.GetUser()
+	g = nil
+	g.GetUser()
+}
+
+func TestGistCommit_GetVersion(tt *testing.T) {
+	var zeroValue string
+	g := &GistCommit{Version: &zeroValue}
+	g.GetVersion()
+	g = &GistCommit{}
+	g.GetVersion()
+	g = nil
+	g.GetVersion()
+}
+
+func TestGistFile_GetContent(tt *testing.T) {
+	var zeroValue string
+	g := &GistFile{Content: &zeroValue}
+	g.GetContent()
+	g = &GistFile{}
+	g.GetContent()
+	g = nil
+	g.GetContent()
+}
+
+func TestGistFile_GetFilename(tt *testing.T) {
+	var zeroValue string
+	g := &GistFile{Filename: &zeroValue}
+	g.GetFilename()
+	g = &GistFile{}
+	g.GetFilename()
+	g = nil
+	g.GetFilename()
+}
+
+func TestGistFile_GetLanguage(tt *testing.T) {
+	var zeroValue string
+	g := &GistFile{Language: &zeroValue}
+	g.GetLanguage()
+	g = &GistFile{}
+	g.GetLanguage()
+	g = nil
+	g.GetLanguage()
+}
+
+func TestGistFile_GetRawURL(tt *testing.T) {
+	var zeroValue string
+	g := &GistFile{RawURL: &zeroValue}
+	g.GetRawURL()
+	g = &GistFile{}
+	g.GetRawURL()
+	g = nil
+	g.GetRawURL()
+}
+
+func TestGistFile_GetSize(tt *testing.T) {
+	var zeroValue int
+	g := &GistFile{Size: &zeroValue}
+	g.GetSize()
+	g = &GistFile{}
+	g.GetSize()
+	g = nil
+	g.GetSize()
+}
+
+func TestGistFile_GetType(tt *testing.T) {
+	var zeroValue string
+	g := &GistFile{Type: &zeroValue}
+	g.GetType()
+	g = &GistFile{}
+	g.GetType()
+	g = nil
+	g.GetType()
+}
+
+func TestGistFork_GetCreatedAt(tt *testing.T) {
+	var zeroValue Timestamp
+	g := &GistFork{CreatedAt: &zeroValue}
+	g.GetCreatedAt()
+	g = &GistFork{}
+	g.GetCreatedAt()
+	g = nil
+	g.GetCreatedAt()
+}
+
+func TestGistFork_GetID(tt *testing.T) {
+	var zeroValue string
+	g := &GistFork{ID: &zeroValue}
+	g.GetID()
+	g = &GistFork{}
+	g.GetID()
+	g = nil
+	g.GetID()
+}
+
+func TestGistFork_GetNodeID(tt *testing.T) {
+	var zeroValue string
+	g := &GistFork{NodeID: &zeroValue}
+	g.GetNodeID()
+	g = &GistFork{}
+	g.GetNodeID()
+	g = nil
+	g.GetNodeID()
+}
+
+func TestGistFork_GetUpdatedAt(tt *testing.T) {
+	var zeroValue Timestamp
+	g := &GistFork{UpdatedAt: &zeroValue}
+	g.GetUpdatedAt()
+	g = &GistFork{}
+	g.GetUpdatedAt()
+	g = nil
+	g.GetUpdatedAt()
+}
+
+func TestGistFork_GetURL(tt *testing.T) {
+	var zeroValue string
+	g := &GistFork{URL: &zeroValue}
+	g.GetURL()
+	g = &GistFork{}
+	g.GetURL()
+	g = nil
+	g.GetURL()
+}
+
+func TestGistFork_GetUser(tt *testing.T) {
+	g := &GistFork{}
+	g.GetUser()
+	g = nil
+	g.GetUser()
+}
+
+func TestGistStats_GetPrivateGists(tt *testing.T) {
+	var zeroValue int
+	g := &GistStats{PrivateGists: &zeroValue}
+	g.GetPrivateGists()
+	g = &GistStats{}
+	g.GetPrivateGists()
+	g = nil
+	g.GetPrivateGists()
+}
+
+func TestGistStats_GetPublicGists(tt *testing.T) {
+	var zeroValue int
+	g := &GistStats{PublicGists: &zeroValue}
+	g.GetPublicGists()
+	g = &GistStats{}
+	g.GetPublicGists()
+	g = nil
+	g.GetPublicGists()
+}
+
+func TestGistStats_GetTotalGists(tt *testing.T) {
+	var zeroValue int
+	g := &GistStats{TotalGists: &zeroValue}
+	g.GetTotalGists()
+	g = &GistStats{}
+	g.GetTotalGists()
+	g = nil
+	g.GetTotalGists()
+}
+
+func TestGitHubAppAuthorizationEvent_GetAction(tt *testing.T) {
+	var zeroValue string
+	g := &GitHubAppAuthorizationEvent{Action: &zeroValue}
+	g.GetAction()
+	g = &GitHubAppAuthorizationEvent{}
+	g.GetAction()
+	g = nil
+	g.GetAction()
+}
+
+func TestGitHubAppAuthorizationEvent_GetSender(tt *testing.T) {
+	g := &GitHubAppAuthorizationEvent{}
+	g.GetSender()
+	g = nil
+	g.GetSender()
+}
+
+func TestGitignore_GetName(tt *testing.T) {
+	var zeroValue string
+	g := &Gitignore{Name: &zeroValue}
+	g.GetName()
+	g = &Gitignore{}
+	g.GetName()
+	g = nil
+	g.GetName()
+}
+
+func TestGitignore_GetSource(tt *testing.T) {
+	var zeroValue string
+	g := &Gitignore{Source: &zeroValue}
+	g.GetSource()
+	g = &Gitignore{}
+	g.GetSource()
+	g = nil
+	g.GetSource()
+}
+
+func TestGitObject_GetSHA(tt *testing.T) {
+	var zeroValue string
+	g := &GitObject{SHA: &zeroValue}
+	g.GetSHA()
+	g = &GitObject{}
+	g.GetSHA()
+	g = nil
+	g.GetSHA()
+}
+
+func TestGitObject_GetType(tt *testing.T) {
+	var zeroValue string
+	g := &GitObject{Type: &zeroValue}
+	g.GetType()
+	g = &GitObject{}
+	g.GetType()
+	g = nil
+	g.GetType()
+}
+
+func TestGitObject_GetURL(tt *testing.T) {
+	var zeroValue string
+	g := &GitObject{URL: &zeroValue}
+	g.GetURL()
+	g = &GitObject{}
+	g.GetURL()
+	g = nil
+	g.GetURL()
+}
+
+func TestGlobalSecurityAdvisory_GetCVEID(tt *testing.T) {
+	var zeroValue string
+	g := &GlobalSecurityAdvisory{CVEID: &zeroValue}
+	g.GetCVEID()
+	g = &GlobalSecurityAdvisory{}
+	g.GetCVEID()
+	g = nil
+	g.GetCVEID()
+}
+
+func TestGlobalSecurityAdvisory_GetCVSS(tt *testing.T) {
+	g := &GlobalSecurityAdvisory{}
+	g.GetCVSS()
+	g = nil
+	g.GetCVSS()
+}
+
+func TestGlobalSecurityAdvisory_GetDescription(tt *testing.T) {
+	var zeroValue string
+	g := &GlobalSecurityAdvisory{Description: &zeroValue}
+	g.GetDescription()
+	g = &GlobalSecurityAdvisory{}
+	g.GetDescription()
+	g = nil
+	g.GetDescription()
+}
+
+func TestGlobalSecurityAdvisory_GetEPSS(tt *testing.T) {
+	g := &GlobalSecurityAdvisory{}
+	g.GetEPSS()
+	g = nil
+	g.GetEPSS()
+}
+
+func TestGlobalSecurityAdvisory_GetGHSAID(tt *testing.T) {
+	var zeroValue string
+	g := &GlobalSecurityAdvisory{GHSAID: &zeroValue}
+	g.GetGHSAID()
+	g = &GlobalSecurityAdvisory{}
+	g.GetGHSAID()
+	g = nil
+	g.GetGHSAID()
+}
+
+func TestGlobalSecurityAdvisory_GetGithubReviewedAt(tt *testing.T) {
+	var zeroValue Timestamp
+	g := &GlobalSecurityAdvisory{GithubReviewedAt: &zeroValue}
+	g.GetGithubReviewedAt()
+	g = &GlobalSecurityAdvisory{}
+	g.GetGithubReviewedAt()
+	g = nil
+	g.GetGithubReviewedAt()
+}
+
+func TestGlobalSecurityAdvisory_GetHTMLURL(tt *testing.T) {
+	var zeroValue string
+	g := &GlobalSecurityAdvisory{HTMLURL: &zeroValue}
+	g.GetHTMLURL()
+	g = &GlobalSecurityAdvisory{}
+	g.GetHTMLURL()
+	g = nil
+	g.GetHTMLURL()
+}
+
+func TestGlobalSecurityAdvisory_GetNVDPublishedAt(tt *testing.T) {
+	var zeroValue Timestamp
+	g := &GlobalSecurityAdvisory{NVDPublishedAt: &zeroValue}
+	g.GetNVDPublishedAt()
+	g = &GlobalSecurityAdvisory{}
+	g.GetNVDPublishedAt()
+	g = nil
+	g.GetNVDPublishedAt()
+}
+
+func TestGlobalSecurityAdvisory_GetPublishedAt(tt *testing.T) {
+	var zeroValue Timestamp
+	g := &GlobalSecurityAdvisory{PublishedAt: &zeroValue}
+	g.GetPublishedAt()
+	g = &GlobalSecurityAdvisory{}
+	g.GetPublishedAt()
+	g = nil
+	g.GetPublishedAt()
+}
+
+func TestGlobalSecurityAdvisory_GetRepositoryAdvisoryURL(tt *testing.T) {
+	var zeroValue string
+	g := &GlobalSecurityAdvisory{RepositoryAdvisoryURL: &zeroValue}
+	g.GetRepositoryAdvisoryURL()
+	g = &GlobalSecurityAdvisory{}
+	g.GetRepositoryAdvisoryURL()
+	g = nil
+	g.GetRepositoryAdvisoryURL()
+}
+
+func TestGlobalSecurityAdvisory_GetSeverity(tt *testing.T) {
+	var zeroValue string
+	g := &GlobalSecurityAdvisory{Severity: &zeroValue}
+	g.GetSeverity()
+	g = &GlobalSecurityAdvisory{}
+	g.GetSeverity()
+	g = nil
+	g.GetSeverity()
+}
+
+func TestGlobalSecurityAdvisory_GetSourceCodeLocation(tt *testing.T) {
+	var zeroValue string
+	g := &GlobalSecurityAdvisory{SourceCodeLocation: &zeroValue}
+	g.GetSourceCodeLocation()
+	g = &GlobalSecurityAdvisory{}
+	g.GetSourceCodeLocation()
+	g = nil
+	g.GetSourceCodeLocation()
+}
+
+func TestGlobalSecurityAdvisory_GetSummary(tt *testing.T) {
+	var zeroValue string
+	g := &GlobalSecurityAdvisory{Summary: &zeroValue}
+	g.GetSummary()
+	g = &GlobalSecurityAdvisory{}
+	g.GetSummary()
+	g = nil
+	g.GetSummary()
+}
+
+func TestGlobalSecurityAdvisory_GetType(tt *testing.T) {
+	var zeroValue string
+	g := &GlobalSecurityAdvisory{Type: &zeroValue}
+	g.GetType()
+	g = &GlobalSecurityAdvisory{}
+	g.GetType()
+	g = nil
+	g.GetType()
+}
+
+func TestGlobalSecurityAdvisory_GetUpdatedAt(tt *testing.T) {
+	var zeroValue Timestamp
+	g := &GlobalSecurityAdvisory{UpdatedAt: &zeroValue}
+	g.GetUpdatedAt()
+	g = &GlobalSecurityAdvisory{}
+	g.GetUpdatedAt()
+	g = nil
+	g.GetUpdatedAt()
+}
+
+func TestGlobalSecurityAdvisory_GetURL(tt *testing.T) {
+	var zeroValue string
+	g := &GlobalSecurityAdvisory{URL: &zeroValue}
+	g.GetURL()
+	g = &GlobalSecurityAdvisory{}
+	g.GetURL()
+	g = nil
+	g.GetURL()
+}
+
+func TestGlobalSecurityAdvisory_GetWithdrawnAt(tt *testing.T) {
+	var zeroValue Timestamp
+	g := &GlobalSecurityAdvisory{WithdrawnAt: &zeroValue}
+	g.GetWithdrawnAt()
+	g = &GlobalSecurityAdvisory{}
+	g.GetWithdrawnAt()
+	g = nil
+	g.GetWithdrawnAt()
+}
+
+func TestGlobalSecurityVulnerability_GetFirstPatchedVersion(tt *testing.T) {
+	var zeroValue string
+	g := &GlobalSecurityVulnerability{FirstPatchedVersion: &zeroValue}
+	g.GetFirstPatchedVersion()
+	g = &GlobalSecurityVulnerability{}
+	g.GetFirstPatchedVersion()
+	g = nil
+	g.GetFirstPatchedVersion()
+}
+
+func TestGlobalSecurityVulnerability_GetPackage(tt *testing.T) {
+	g := &GlobalSecurityVulnerability{}
+	g.GetPackage()
+	g = nil
+	g.GetPackage()
+}
+
+func TestGlobalSecurityVulnerability_GetVulnerableVersionRange(tt *testing.T) {
+	var zeroValue string
+	g := &GlobalSecurityVulnerability{VulnerableVersionRange: &zeroValue}
+	g.GetVulnerableVersionRange()
+	g = &GlobalSecurityVulnerability{}
+	g.GetVulnerableVersionRange()
+	g = nil
+	g.GetVulnerableVersionRange()
+}
+
+func TestGollumEvent_GetInstallation(tt *testing.T) {
+	g := &GollumEvent{}
+	g.GetInstallation()
+	g = nil
+	g.GetInstallation()
+}
+
+func TestGollumEvent_GetRepo(tt *testing.T) {
+	g := &GollumEvent{}
+	g.GetRepo()
+	g = nil
+	g.GetRepo()
+}
+
+func TestGollumEvent_GetSender(tt *testing.T) {
+	g := &GollumEvent{}
+	g.GetSender()
+	g = nil
+	g.GetSender()
+}
+
+func TestGPGEmail_GetEmail(tt *testing.T) {
+	var zeroValue string
+	g := &GPGEmail{Email: &zeroValue}
+	g.GetEmail()
+	g = &GPGEmail{}
+	g.GetEmail()
+	g = nil
+	g.GetEmail()
+}
+
+func TestGPGEmail_GetVerified(tt *testing.T) {
+	var zeroValue bool
+	g := &GPGEmail{Verified: &zeroValue}
+	g.GetVerified()
+	g = &GPGEmail{}
+	g.GetVerified()
+	g = nil
+	g.GetVerified()
+}
+
+func TestGPGKey_GetCanCertify(tt *testing.T) {
+	var zeroValue bool
+	g := &GPGKey{CanCertify: &zeroValue}
+	g.GetCanCertify()
+	g = &GPGKey{}
+	g.GetCanCertify()
+	g = nil
+	g.GetCanCertify()
+}
+
+func TestGPGKey_GetCanEncryptComms(tt *testing.T) {
+	var zeroValue bool
+	g := &GPGKey{CanEncryptComms: &zeroValue}
+	g.GetCanEncryptComms()
+	g = &GPGKey{}
+	g.GetCanEncryptComms()
+	g = nil
+	g.GetCanEncryptComms()
+}
+
+func TestGPGKey_GetCanEncryptStorage(tt *testing.T) {
+	var zeroValue bool
+	g := &GPGKey{CanEncryptStorage: &zeroValue}
+	g.GetCanEncryptStorage()
+	g = &GPGKey{}
+	g.GetCanEncryptStorage()
+	g = nil
+	g.GetCanEncryptStorage()
+}
+
+func TestGPGKey_GetCanSign(tt *testing.T) {
+	var zeroValue bool
+	g := &GPGKey{CanSign: &zeroValue}
+	g.GetCanSign()
+	g = &GPGKey{}
+	g.GetCanSign()
+	g = nil
+	g.GetCanSign()
+}
+
+func TestGPGKey_GetCreatedAt(tt *testing.T) {
+	var zeroValue time.Time
+	g := &GPGKey{CreatedAt: &zeroValue}
+	g.GetCreatedAt()
+	g = &GPGKey{}
+	g.GetCreatedAt()
+	g = nil
+	g.GetCreatedAt()
+}
+
+func TestGPGKey_GetExpiresAt(tt *testing.T) {
+	var zeroValue time.Time
+	g := &GPGKey{ExpiresAt: &zeroValue}
+	g.GetExpiresAt()
+	g = &GPGKey{}
+	g.GetExpiresAt()
+	g = nil
+	g.GetExpiresAt()
+}
+
+func TestGPGKey_GetID(tt *testing.T) {
+	var zeroValue int64
+	g := &GPGKey{ID: &zeroValue}
+	g.GetID()
+	g = &GPGKey{}
+	g.GetID()
+	g = nil
+	g.GetID()
+}
+
+func TestGPGKey_GetKeyID(tt *testing.T) {
+	var zeroValue string
+	g := &GPGKey{KeyID: &zeroValue}
+	g.GetKeyID()
+	g = &GPGKey{}
+	g.GetKeyID()
+	g = nil
+	g.GetKeyID()
+}
+
+func TestGPGKey_GetName(tt *testing.T) {
+	var zeroValue string
+	g := &GPGKey{Name: &zeroValue}
+	g.GetName()
+	g = &GPGKey{}
+	g.GetName()
+	g = nil
+	g.GetName()
+}
+
+func TestGPGKey_GetPrimaryKeyID(tt *testing.T) {
+	var zeroValue int64
+	g := &GPGKey{PrimaryKeyID: &zeroValue}
+	g.GetPrimaryKeyID()
+	g = &GPGKey{}
+	g.GetPrimaryKeyID()
+	g = nil
+	g.GetPrimaryKeyID()
+}
+
+func TestGPGKey_GetPublicKey(tt *testing.T) {
+	var zeroValue string
+	g := &GPGKey{PublicKey: &zeroValue}
+	g.GetPublicKey()
+	g = &GPGKey{}
+	g.GetPublicKey()
+	g = nil
+	g.GetPublicKey()
+}
+
+func TestGPGKey_GetRawKey(tt *testing.T) {
+	var zeroValue string
+	g := &GPGKey{RawKey: &zeroValue}
+	g.GetRawKey()
+	g = &GPGKey{}
+	g.GetRawKey()
+	g = nil
+	g.GetRawKey()
+}
+
+func TestGPGKey_GetRevoked(tt *testing.T) {
+	var zeroValue bool
+	g := &GPGKey{Revoked: &zeroValue}
+	g.GetRevoked()
+	g = &GPGKey{}
+	g.GetRevoked()
+	g = nil
+	g.GetRevoked()
+}
+
+func TestGrant_GetApp(tt *testing.T) {
+	g := &Grant{}
+	g.GetApp()
+	g = nil
+	g.GetApp()
+}
+
+func TestGrant_GetCreatedAt(tt *testing.T) {
+	var zeroValue Timestamp
+	g := &Grant{CreatedAt: &zeroValue}
+	g.GetCreatedAt()
+	g = &Grant{}
+	g.GetCreatedAt()
+	g = nil
+	g.GetCreatedAt()
+}
+
+func TestGrant_GetID(tt *testing.T) {
+	var zeroValue int64
+	g := &Grant{ID: &zeroValue}
+	g.GetID()
+	g = &Grant{}
+	g.GetID()
+	g = nil
+	g.GetID()
+}
+
+func TestGrant_GetUpdatedAt(tt *testing.T) {
+	var zeroValue Timestamp
+	g := &Grant{UpdatedAt: &zeroValue}
+	g.GetUpdatedAt()
+	g = &Grant{}
+	g.GetUpdatedAt()
+	g = nil
+	g.GetUpdatedAt()
+}
+
+func TestGrant_GetURL(tt *testing.T) {
+	var zeroValue string
+	g := &Grant{URL: &zeroValue}
+	g.GetURL()
+	g = &Grant{}
+	g.GetURL()
+	g = nil
+	g.GetURL()
+}
+
+func TestHeadCommit_GetAuthor(tt *testing.T) {
+	h := &HeadCommit{}
+	h.GetAuthor()
+	h = nil
+	h.GetAuthor()
+}
+
+func TestHeadCommit_GetCommitter(tt *testing.T) {
+	h := &HeadCommit{}
+	h.GetCommitter()
+	h = nil
+	h.GetCommitter()
+}
+
+func TestHeadCommit_GetDistinct(tt *testing.T) {
+	var zeroValue bool
+	h := &HeadCommit{Distinct: &zeroValue}
+	h.GetDistinct()
+	h = &HeadCommit{}
+	h.GetDistinct()
+	h = nil
+	h.GetDistinct()
+}
+
+func TestHeadCommit_GetID(tt *testing.T) {
+	var zeroValue string
+	h := &HeadCommit{ID: &zeroValue}
+	h.GetID()
+	h = &HeadCommit{}
+	h.GetID()
+	h = nil
+	h.GetID()
+}
+
+func TestHeadCommit_GetMessage(tt *testing.T) {
+	var zeroValue string
+	h := &HeadCommit{Message: &zeroValue}
+	h.GetMessage()
+	h = &HeadCommit{}
+	h.GetMessage()
+	h = nil
+	h.GetMessage()
+}
+
+func TestHeadCommit_GetSHA(tt *testing.T) {
+	var zeroValue string
+	h := &HeadCommit{SHA: &zeroValue}
+	h.GetSHA()
+	h = &HeadCommit{}
+	h.GetSHA()
+	h = nil
+	h.GetSHA()
+}
+
+func TestHeadCommit_GetTimestamp(tt *testing.T) {
+	var zeroValue Timestamp
+	h := &HeadCommit{Timestamp: &zeroValue}
+	h.GetTimestamp()
+	h = &HeadCommit{}
+	h.GetTimestamp()
+	h = nil
+	h.GetTimestamp()
+}
+
+func TestHeadCommit_GetTreeID(tt *testing.T) {
+	var zeroValue string
+	h := &HeadCommit{TreeID: &zeroValue}
+	h.GetTreeID()
+	h = &HeadCommit{}
+	h.GetTreeID()
+	h = nil
+	h.GetTreeID()
+}
+
+func TestHeadCommit_GetURL(tt *testing.T) {
+	var zeroValue string
+	h := &HeadCommit{URL: &zeroValue}
+	h.GetURL()
+	h = &HeadCommit{}
+	h.GetURL()
+	h = nil
+	h.GetURL()
+}
+
+func TestHook_GetActive(tt *testing.T) {
+	var zeroValue bool
+	h := &Hook{Active: &zeroValue}
+	h.GetActive()
+	h = &Hook{}
+	h.GetActive()
+	h = nil
+	h.GetActive()
+}
+
+func TestHook_GetConfig(tt *testing.T) {
+	h := &Hook{}
+	h.GetConfig()
+	h = nil
+	h.GetConfig()
+}
+
+func TestHook_GetCreatedAt(tt *testing.T) {
+	var zeroValue time.Time
+	h := &Hook{CreatedAt: &zeroValue}
+	h.GetCreatedAt()
+	h = &Hook{}
+	h.GetCreatedAt()
+	h = nil
+	h.GetCreatedAt()
+}
+
+func TestHook_GetID(tt *testing.T) {
+	var zeroValue int64
+	h := &Hook{ID: &zeroValue}
+	h.GetID()
+	h = &Hook{}
+	h.GetID()
+	h = nil
+	h.GetID()
+}
+
+func TestHook_GetUpdatedAt(tt *testing.T) {
+	var zeroValue time.Time
+	h := &Hook{UpdatedAt: &zeroValue}
+	h.GetUpdatedAt()
+	h = &Hook{}
+	h.GetUpdatedAt()
+	h = nil
+	h.GetUpdatedAt()
+}
+
+func TestHook_GetURL(tt *testing.T) {
+	var zeroValue string
+	h := &Hook{URL: &zeroValue}
+	h.GetURL()
+	h = &Hook{}
+	h.GetURL()
+	h = nil
+	h.GetURL()
+}
+
+func TestHookConfig_GetContentType(tt *testing.T) {
+	var zeroValue string
+	h := &HookConfig{ContentType: &zeroValue}
+	h.GetContentType()
+	h = &HookConfig{}
+	h.GetContentType()
+	h = nil
+	h.GetContentType()
+}
+
+func TestHookConfig_GetInsecureSSL(tt *testing.T) {
+	var zeroValue string
+	h := &HookConfig{InsecureSSL: &zeroValue}
+	h.GetInsecureSSL()
+	h = &HookConfig{}
+	h.GetInsecureSSL()
+	h = nil
+	h.GetInsecureSSL()
+}
+
+func TestHookConfig_GetSecret(tt *testing.T) {
+	var zeroValue string
+	h := &HookConfig{Secret: &zeroValue}
+	h.GetSecret()
+	h = &HookConfig{}
+	h.GetSecret()
+	h = nil
+	h.GetSecret()
+}
+
+func TestHookConfig_GetURL(tt *testing.T) {
+	var zeroValue string
+	h := &HookConfig{URL: &zeroValue}
+	h.GetURL()
+	h = &HookConfig{}
+	h.GetURL()
+	h = nil
+	h.GetURL()
+}
+
+func TestHookDelivery_GetAction(tt *testing.T) {
+	var zeroValue string
+	h := &HookDelivery{Action: &zeroValue}
+	h.GetAction()
+	h = &HookDelivery{}
+	h.GetAction()
+	h = nil
+	h.GetAction()
+}
+
+func TestHookDelivery_GetDeliveredAt(tt *testing.T) {
+	var zeroValue Timestamp
+	h := &HookDelivery{DeliveredAt: &zeroValue}
+	h.GetDeliveredAt()
+	h = &HookDelivery{}
+	h.GetDeliveredAt()
+	h = nil
+	h.GetDeliveredAt()
+}
+
+func TestHookDelivery_GetDuration(tt *testing.T) {
+	h := &HookDelivery{}
+	h.GetDuration()
+	h = nil
+	h.GetDuration()
+}
+
+func TestHookDelivery_GetEvent(tt *testing.T) {
+	var zeroValue string
+	h := &HookDelivery{Event: &zeroValue}
+	h.GetEvent()
+	h = &HookDelivery{}
+	h.GetEvent()
+	h = nil
+	h.GetEvent()
+}
+
+func TestHookDelivery_GetGUID(tt *testing.T) {
+	var zeroValue string
+	h := &HookDelivery{GUID: &zeroValue}
+	h.GetGUID()
+	h = &HookDelivery{}
+	h.GetGUID()
+	h = nil
+	h.GetGUID()
+}
+
+func TestHookDelivery_GetID(tt *testing.T) {
+	var zeroValue int64
+	h := &HookDelivery{ID: &zeroValue}
+	h.GetID()
+	h = &HookDelivery{}
+	h.GetID()
+	h = nil
+	h.GetID()
+}
+
+func TestHookDelivery_GetInstallationID(tt *testing.T) {
+	var zeroValue int64
+	h := &HookDelivery{InstallationID: &zeroValue}
+	h.GetInstallationID()
+	h = &HookDelivery{}
+	h.GetInstallationID()
+	h = nil
+	h.GetInstallationID()
+}
+
+func TestHookDelivery_GetRedelivery(tt *testing.T) {
+	var zeroValue bool
+	h := &HookDelivery{Redelivery: &zeroValue}
+	h.GetRedelivery()
+	h = &HookDelivery{}
+	h.GetRedelivery()
+	h = nil
+	h.GetRedelivery()
+}
+
+func TestHookDelivery_GetRepositoryID(tt *testing.T) {
+	var zeroValue int64
+	h := &HookDelivery{RepositoryID: &zeroValue}
+	h.GetRepositoryID()
+	h = &HookDelivery{}
+	h.GetRepositoryID()
+	h = nil
+	h.GetRepositoryID()
+}
+
+func TestHookDelivery_GetRequest(tt *testing.T) {
+	h := &HookDelivery{}
+	h.GetRequest()
+	h = nil
+	h.GetRequest()
+}
+
+func TestHookDelivery_GetResponse(tt *testing.T) {
+	h := &HookDelivery{}
+	h.GetResponse()
+	h = nil
+	h.GetResponse()
+}
+
+func TestHookDelivery_GetStatus(tt *testing.T) {
+	var zeroValue string
+	h := &HookDelivery{Status: &zeroValue}
+	h.GetStatus()
+	h = &HookDelivery{}
+	h.GetStatus()
+	h = nil
+	h.GetStatus()
+}
+
+func TestHookDelivery_GetStatusCode(tt *testing.T) {
+	var zeroValue int
+	h := &HookDelivery{StatusCode: &zeroValue}
+	h.GetStatusCode()
+	h = &HookDelivery{}
+	h.GetStatusCode()
+	h = nil
+	h.GetStatusCode()
+}
+
+func TestHookRequest_GetRawPayload(tt *testing.T) {
+	var zeroValue json.RawMessage
+	h := &HookRequest{RawPayload: &zeroValue}
+	h.GetRawPayload()
+	h = &HookRequest{}
+	h.GetRawPayload()
+	h = nil
+	h.GetRawPayload()
+}
+
+func TestHookResponse_GetPayload(tt *testing.T) {
+	var zeroValue string
+	h := &HookResponse{Payload: &zeroValue}
+	h.GetPayload()
+	h = &HookResponse{}
+	h.GetPayload()
+	h = nil
+	h.GetPayload()
+}
+
+func TestHookStats_GetActiveHooks(tt *testing.T) {
+	var zeroValue int
+	h := &HookStats{ActiveHooks: &zeroValue}
+	h.GetActiveHooks()
+	h = &HookStats{}
+	h.GetActiveHooks()
+	h = nil
+	h.GetActiveHooks()
+}
+
+func TestHookStats_GetInactiveHooks(tt *testing.T) {
+	var zeroValue int
+	h := &HookStats{InactiveHooks: &zeroValue}
+	h.GetInactiveHooks()
+	h = &HookStats{}
+	h.GetInactiveHooks()
+	h = nil
+	h.GetInactiveHooks()
+}
+
+func TestHookStats_GetTotalHooks(tt *testing.T) {
+	var zeroValue int
+	h := &HookStats{TotalHooks: &zeroValue}
+	h.GetTotalHooks()
+	h = &HookStats{}
+	h.GetTotalHooks()
+	h = nil
+	h.GetTotalHooks()
+}
+
+func TestIDPGroup_GetGroupDescription(tt *testing.T) {
+	var zeroValue string
+	i := &IDPGroup{GroupDescription: &zeroValue}
+	i.GetGroupDescription()
+	i = &IDPGroup{}
+	i.GetGroupDescription()
+	i = nil
+	i.GetGroupDescription()
+}
+
+func TestIDPGroup_GetGroupID(tt *testing.T) {
+	var zeroValue string
+	i := &IDPGroup{GroupID: &zeroValue}
+	i.GetGroupID()
+	i = &IDPGroup{}
+	i.GetGroupID()
+	i = nil
+	i.GetGroupID()
+}
+
+func TestIDPGroup_GetGroupName(tt *testing.T) {
+	var zeroValue string
+	i := &IDPGroup{GroupName: &zeroValue}
+	i.GetGroupName()
+	i = &IDPGroup{}
+	i.GetGroupName()
+	i = nil
+	i.GetGroupName()
+}
+
+func TestImport_GetAuthorsCount(tt *testing.T) {
+	var zeroValue int
+	i := &Import{AuthorsCount: &zeroValue}
+	i.GetAuthorsCount()
+	i = &Import{}
+	i.GetAuthorsCount()
+	i = nil
+	i.GetAuthorsCount()
+}
+
+func TestImport_GetAuthorsURL(tt *testing.T) {
+	var zeroValue string
+	i := &Import{AuthorsURL: &zeroValue}
+	i.GetAuthorsURL()
+	i = &Import{}
+	i.GetAuthorsURL()
+	i = nil
+	i.GetAuthorsURL()
+}
+
+func TestImport_GetCommitCount(tt *testing.T) {
+	var zeroValue int
+	i := &Import{CommitCount: &zeroValue}
+	i.GetCommitCount()
+	i = &Import{}
+	i.GetCommitCount()
+	i = nil
+	i.GetCommitCount()
+}
+
+func TestImport_GetFailedStep(tt *testing.T) {
+	var zeroValue string
+	i := &Import{FailedStep: &zeroValue}
+	i.GetFailedStep()
+	i = &Import{}
+	i.GetFailedStep()
+	i = nil
+	i.GetFailedStep()
+}
+
+func TestImport_GetHasLargeFiles(tt *testing.T) {
+	var zeroValue bool
+	i := &Import{HasLargeFiles: &zeroValue}
+	i.GetHasLargeFiles()
+	i = &Import{}
+	i.GetHasLargeFiles()
+	i = nil
+	i.GetHasLargeFiles()
+}
+
+func TestImport_GetHTMLURL(tt *testing.T) {
+	var zeroValue string
+	i := &Import{HTMLURL: &zeroValue}
+	i.GetHTMLURL()
+	i = &Import{}
+	i.GetHTMLURL()
+	i = nil
+	i.GetHTMLURL()
+}
+
+func TestImport_GetHumanName(tt *testing.T) {
+	var zeroValue string
+	i := &Import{HumanName: &zeroValue}
+	i.GetHumanName()
+	i = &Import{}
+	i.GetHumanName()
+	i = nil
+	i.GetHumanName()
+}
+
+func TestImport_GetLargeFilesCount(tt *testing.T) {
+	var zeroValue int
+	i := &Import{LargeFilesCount: &zeroValue}
+	i.GetLargeFilesCount()
+	i = &Import{}
+	i.GetLargeFilesCount()
+	i = nil
+	i.GetLargeFilesCount()
+}
+
+func TestImport_GetLargeFilesSize(tt *testing.T) {
+	var zeroValue int
+	i := &Import{LargeFilesSize: &zeroValue}
+	i.GetLargeFilesSize()
+	i = &Import{}
+	i.GetLargeFilesSize()
+	i = nil
+	i.GetLargeFilesSize()
+}
+
+func TestImport_GetMessage(tt *testing.T) {
+	var zeroValue string
+	i := &Import{Message: &zeroValue}
+	i.GetMessage()
+	i = &Import{}
+	i.GetMessage()
+	i = nil
+	i.GetMessage()
+}
+
+func TestImport_GetPercent(tt *testing.T) {
+	var zeroValue int
+	i := &Import{Percent: &zeroValue}
+	i.GetPercent()
+	i = &Import{}
+	i.GetPercent()
+	i = nil
+	i.GetPercent()
+}
+
+func TestImport_GetPushPercent(tt *testing.T) {
+	var zeroValue int
+	i := &Import{PushPercent: &zeroValue}
+	i.GetPushPercent()
+	i = &Import{}
+	i.GetPushPercent()
+	i = nil
+	i.GetPushPercent()
+}
+
+func TestImport_GetRepositoryURL(tt *testing.T) {
+	var zeroValue string
+	i := &Import{RepositoryURL: &zeroValue}
+	i.GetRepositoryURL()
+	i = &Import{}
+	i.GetRepositoryURL()
+	i = nil
+	i.GetRepositoryURL()
+}
+
+func TestImport_GetStatus(tt *testing.T) {
+	var zeroValue string
+	i := &Import{Status: &zeroValue}
+	i.GetStatus()
+	i = &Import{}
+	i.GetStatus()
+	i = nil
+	i.GetStatus()
+}
+
+func TestImport_GetStatusText(tt *testing.T) {
+	var zeroValue string
+	i := &Import{StatusText: &zeroValue}
+	i.GetStatusText()
+	i = &Import{}
+	i.GetStatusText()
+	i = nil
+	i.GetStatusText()
+}
+
+func TestImport_GetTFVCProject(tt *testing.T) {
+	var zeroValue string
+	i := &Import{TFVCProject: &zeroValue}
+	i.GetTFVCProject()
+	i = &Import{}
+	i.GetTFVCProject()
+	i = nil
+	i.GetTFVCProject()
+}
+
+func TestImport_GetURL(tt *testing.T) {
+	var zeroValue string
+	i := &Import{URL: &zeroValue}
+	i.GetURL()
+	i = &Import{}
+	i.GetURL()
+	i = nil
+	i.GetURL()
+}
+
+func TestImport_GetUseLFS(tt *testing.T) {
+	var zeroValue string
+	i := &Import{UseLFS: &zeroValue}
+	i.GetUseLFS()
+	i = &Import{}
+	i.GetUseLFS()
+	i = nil
+	i.GetUseLFS()
+}
+
+func TestImport_GetVCS(tt *testing.T) {
+	var zeroValue string
+	i := &Import{VCS: &zeroValue}
+	i.GetVCS()
+	i = &Import{}
+	i.GetVCS()
+	i = nil
+	i.GetVCS()
+}
+
+func TestImport_GetVCSPassword(tt *testing.T) {
+	var zeroValue string
+	i := &Import{VCSPassword: &zeroValue}
+	i.GetVCSPassword()
+	i = &Import{}
+	i.GetVCSPassword()
+	i = nil
+	i.GetVCSPassword()
+}
+
+func TestImport_GetVCSURL(tt *testing.T) {
+	var zeroValue string
+	i := &Import{VCSURL: &zeroValue}
+	i.GetVCSURL()
+	i = &Import{}
+	i.GetVCSURL()
+	i = nil
+	i.GetVCSURL()
+}
+
+func TestImport_GetVCSUsername(tt *testing.T) {
+	var zeroValue string
+	i := &Import{VCSUsername: &zeroValue}
+	i.GetVCSUsername()
+	i = &Import{}
+	i.GetVCSUsername()
+	i = nil
+	i.GetVCSUsername()
+}
+
+func TestInstallation_GetAccessTokensURL(tt *testing.T) {
+	var zeroValue string
+	i := &Installation{AccessTokensURL: &zeroValue}
+	i.GetAccessTokensURL()
+	i = &Installation{}
+	i.GetAccessTokensURL()
+	i = nil
+	i.GetAccessTokensURL()
+}
+
+func TestInstallation_GetAccount(tt *testing.T) {
+	i := &Installation{}
+	i.GetAccount()
+	i = nil
+	i.GetAccount()
+}
+
+func TestInstallation_GetAppID(tt *testing.T) {
+	var zeroValue int64
+	i := &Installation{AppID: &zeroValue}
+	i.GetAppID()
+	i = &Installation{}
+	i.GetAppID()
+	i = nil
+	i.GetAppID()
+}
+
+func TestInstallation_GetCreatedAt(tt *testing.T) {
+	var zeroValue Timestamp
+	i := &Installation{CreatedAt: &zeroValue}
+	i.GetCreatedAt()
+	i = &Installation{}
+	i.GetCreatedAt()
+	i = nil
+	i.GetCreatedAt()
+}
+
+func TestInstallation_GetHTMLURL(tt *testing.T) {
+	var zeroValue string
+	i := &Installation{HTMLURL: &zeroValue}
+	i.GetHTMLURL()
+	i = &Installation{}
+	i.GetHTMLURL()
+	i = nil
+	i.GetHTMLURL()
+}
+
+func TestInstallation_GetID(tt *testing.T) {
+	var zeroValue int64
+	i := &Installation{ID: &zeroValue}
+	i.GetID()
+	i = &Installation{}
+	i.GetID()
+	i = nil
+	i.GetID()
+}
+
+func TestInstallation_GetNodeID(tt *testing.T) {
+	var zeroValue string
+	i := &Installation{NodeID: &zeroValue}
+	i.GetNodeID()
+	i = &Installation{}
+	i.GetNodeID()
+	i = nil
+	i.GetNodeID()
+}
+
+func TestInstallation_GetPermissions(tt *testing.T) {
+	i := &Installation{}
+	i.GetPermissions()
+	i = nil
+	i.GetPermissions()
+}
+
+func TestInstallation_GetRepositoriesURL(tt *testing.T) {
+	var zeroValue string
+	i := &Installation{RepositoriesURL: &zeroValue}
+	i.GetRepositoriesURL()
+	i = &Installation{}
+	i.GetRepositoriesURL()
+	i = nil
+	i.GetRepositoriesURL()
+}
+
+func TestInstallation_GetRepositorySelection(tt *testing.T) {
+	var zeroValue string
+	i := &Installation{RepositorySelection: &zeroValue}
+	i.GetRepositorySelection()
+	i = &Installation{}
+	i.GetRepositorySelection()
+	i = nil
+	i.GetRepositorySelection()
+}
+
+func TestInstallation_GetSingleFileName(tt *testing.T) {
+	var zeroValue string
+	i := &Installation{SingleFileName: &zeroValue}
+	i.GetSingleFileName()
+	i = &Installation{}
+	i.GetSingleFileName()
+	i = nil
+	i.GetSingleFileName()
+}
+
+func TestInstallation_GetTargetID(tt *testing.T) {
+	var zeroValue int64
+	i := &Installation{TargetID: &zeroValue}
+	i.GetTargetID()
+	i = &Installation{}
+	i.GetTargetID()
+	i = nil
+	i.GetTargetID()
+}
+
+func TestInstallation_GetTargetType(tt *testing.T) {
+	var zeroValue string
+	i := &Installation{TargetType: &zeroValue}
+	i.GetTargetType()
+	i = &Installation{}
+	i.GetTargetType()
+	i = nil
+	i.GetTargetType()
+}
+
+func TestInstallation_GetUpdatedAt(tt *testing.T) {
+	var zeroValue Timestamp
+	i := &Installation{UpdatedAt: &zeroValue}
+	i.GetUpdatedAt()
+	i = &Installation{}
+	i.GetUpdatedAt()
+	i = nil
+	i.GetUpdatedAt()
+}
+
+func TestInstallationEvent_GetAction(tt *testing.T) {
+	var zeroValue string
+	i := &InstallationEvent{Action: &zeroValue}
+	i.GetAction()
+	i = &InstallationEvent{}
+	i.GetAction()
+	i = nil
+	i.GetAction()
+}
+
+func TestInstallationEvent_GetInstallation(tt *testing.T) {
+	i := &InstallationEvent{}
+	i.GetInstallation()
+	i = nil
+	i.GetInstallation()
+}
+
+func TestInstallationEvent_GetSender(tt *testing.T) {
+	i := &InstallationEvent{}
+	i.GetSender()
+	i = nil
+	i.GetSender()
+}
+
+func TestInstallationPermissions_GetActions(tt *testing.T) {
+	var zeroValue string
+	i := &InstallationPermissions{Actions: &zeroValue}
+	i.GetActions()
+	i = &InstallationPermissions{}
+	i.GetActions()
+	i = nil
+	i.GetActions()
+}
+
+func TestInstallationPermissions_GetAdministration(tt *testing.T) {
+	var zeroValue string
+	i := &InstallationPermissions{Administration: &zeroValue}
+	i.GetAdministration()
+	i = &InstallationPermissions{}
+	i.GetAdministration()
+	i = nil
+	i.GetAdministration()
+}
+
+func TestInstallationPermissions_GetBlocking(tt *testing.T) {
+	var zeroValue string
+	i := &InstallationPermissions{Blocking: &zeroValue}
+	i.GetBlocking()
+	i = &InstallationPermissions{}
+	i.GetBlocking()
+	i = nil
+	i.GetBlocking()
+}
+
+func TestInstallationPermissions_GetChecks(tt *testing.T) {
+	var zeroValue string
+	i := &InstallationPermissions{Checks: &zeroValue}
+	i.GetChecks()
+	i = &InstallationPermissions{}
+	i.GetChecks()
+	i = nil
+	i.GetChecks()
+}
+
+func TestInstallationPermissions_GetCodespaces(tt *testing.T) {
+	var zeroValue string
+	i := &InstallationPermissions{Codespaces: &zeroValue}
+	i.GetCodespaces()
+	i = &InstallationPermissions{}
+	i.GetCodespaces()
+	i = nil
+	i.GetCodespaces()
+}
+
+func TestInstallationPermissions_GetContentReferences(tt *testing.T) {
+	var zeroValue string
+	i := &InstallationPermissions{ContentReferences: &zeroValue}
+	i.GetContentReferences()
+	i = &InstallationPermissions{}
+	i.GetContentReferences()
+	i = nil
+	i.GetContentReferences()
+}
+
+func TestInstallationPermissions_GetContents(tt *testing.T) {
+	var zeroValue string
+	i := &InstallationPermissions{Contents: &zeroValue}
+	i.GetContents()
+	i = &InstallationPermissions{}
+	i.GetContents()
+	i = nil
+	i.GetContents()
+}
+
+func TestInstallationPermissions_GetDependabotSecrets(tt *testing.T) {
+	var zeroValue string
+	i := &InstallationPermissions{DependabotSecrets: &zeroValue}
+	i.GetDependabotSecrets()
+	i = &InstallationPermissions{}
+	i.GetDependabotSecrets()
+	i = nil
+	i.GetDependabotSecrets()
+}
+
+func TestInstallationPermissions_GetDeployments(tt *testing.T) {
+	var zeroValue string
+	i := &InstallationPermissions{Deployments: &zeroValue}
+	i.GetDeployments()
+	i = &InstallationPermissions{}
+	i.GetDeployments()
+	i = nil
+	i.GetDeployments()
+}
+
+func TestInstallationPermissions_GetEmailAddresses(tt *testing.T) {
+	var zeroValue string
+	i := &InstallationPermissions{EmailAddresses: &zeroValue}
+	i.GetEmailAddresses()
+	i = &InstallationPermissions{}
+	i.GetEmailAddresses()
+	i = nil
+	i.GetEmailAddresses()
+}
+
+func TestInstallationPermissions_GetEmails(tt *testing.T) {
+	var zeroValue string
+	i := &InstallationPermissions{Emails: &zeroValue}
+	i.GetEmails()
+	i = &InstallationPermissions{}
+	i.GetEmails()
+	i = nil
+	i.GetEmails()
+}
+
+func TestInstallationPermissions_GetEnvironments(tt *testing.T) {
+	var zeroValue string
+	i := &InstallationPermissions{Environments: &zeroValue}
+	i.GetEnvironments()
+	i = &InstallationPermissions{}
+	i.GetEnvironments()
+	i = nil
+	i.GetEnvironments()
+}
+
+func TestInstallationPermissions_GetFollowers(tt *testing.T) {
+	var zeroValue string
+	i := &InstallationPermissions{Followers: &zeroValue}
+	i.GetFollowers()
+	i = &InstallationPermissions{}
+	i.GetFollowers()
+	i = nil
+	i.GetFollowers()
+}
+
+func TestInstallationPermissions_GetGitSSHKeys(tt *testing.T) {
+	var zeroValue string
+	i := &InstallationPermissions{GitSSHKeys: &zeroValue}
+	i.GetGitSSHKeys()
+	i = &InstallationPermissions{}
+	i.GetGitSSHKeys()
+	i = nil
+	i.GetGitSSHKeys()
+}
+
+func TestInstallationPermissions_GetGPGKeys(tt *testing.T) {
+	var zeroValue string
+	i := &InstallationPermissions{GPGKeys: &zeroValue}
+	i.GetGPGKeys()
+	i = &InstallationPermissions{}
+	i.GetGPGKeys()
+	i = nil
+	i.GetGPGKeys()
+}
+
+func TestInstallationPermissions_GetInteractionLimits(tt *testing.T) {
+	var zeroValue string
+	i := &InstallationPermissions{InteractionLimits: &zeroValue}
+	i.GetInteractionLimits()
+	i = &InstallationPermissions{}
+	i.GetInteractionLimits()
+	i = nil
+	i.GetInteractionLimits()
+}
+
+func TestInstallationPermissions_GetIssues(tt *testing.T) {
+	var zeroValue string
+	i := &InstallationPermissions{Issues: &zeroValue}
+	i.GetIssues()
+	i = &InstallationPermissions{}
+	i.GetIssues()
+	i = nil
+	i.GetIssues()
+}
+
+func TestInstallationPermissions_GetMembers(tt *testing.T) {
+	var zeroValue string
+	i := &InstallationPermissions{Members: &zeroValue}
+	i.GetMembers()
+	i = &InstallationPermissions{}
+	i.GetMembers()
+	i = nil
+	i.GetMembers()
+}
+
+func TestInstallationPermissions_GetMergeQueues(tt *testing.T) {
+	var zeroValue string
+	i := &InstallationPermissions{MergeQueues: &zeroValue}
+	i.GetMergeQueues()
+	i = &InstallationPermissions{}
+	i.GetMergeQueues()
+	i = nil
+	i.GetMergeQueues()
+}
+
+func TestInstallationPermissions_GetMetadata(tt *testing.T) {
+	var zeroValue string
+	i := &InstallationPermissions{Metadata: &zeroValue}
+	i.GetMetadata()
+	i = &InstallationPermissions{}
+	i.GetMetadata()
+	i = nil
+	i.GetMetadata()
+}
+
+func TestInstallationPermissions_GetOrganizationAdministration(tt *testing.T) {
+	var zeroValue string
+	i := &InstallationPermissions{OrganizationAdministration: &zeroValue}
+	i.GetOrganizationAdministration()
+	i = &InstallationPermissions{}
+	i.GetOrganizationAdministration()
+	i = nil
+	i.GetOrganizationAdministration()
+}
+
+func TestInstallationPermissions_GetOrganizationAnnouncementBanners(tt *testing.T) {
+	var zeroValue string
+	i := &InstallationPermissions{OrganizationAnnouncementBanners: &zeroValue}
+	i.GetOrganizationAnnouncementBanners()
+	i = &InstallationPermissions{}
+	i.GetOrganizationAnnouncementBanners()
+	i = nil
+	i.GetOrganizationAnnouncementBanners()
+}
+
+func TestInstallationPermissions_GetOrganizationCustomProperties(tt *testing.T) {
+	var zeroValue string
+	i := &InstallationPermissions{OrganizationCustomProperties: &zeroValue}
+	i.GetOrganizationCustomProperties()
+	i = &InstallationPermissions{}
+	i.GetOrganizationCustomProperties()
+	i = nil
+	i.GetOrganizationCustomProperties()
+}
+
+func TestInstallationPermissions_GetOrganizationCustomRoles(tt *testing.T) {
+	var zeroValue string
+	i := &InstallationPermissions{OrganizationCustomRoles: &zeroValue}
+	i.GetOrganizationCustomRoles()
+	i = &InstallationPermissions{}
+	i.GetOrganizationCustomRoles()
+	i = nil
+	i.GetOrganizationCustomRoles()
+}
+
+func TestInstallationPermissions_GetOrganizationHooks(tt *testing.T) {
+	var zeroValue string
+	i := &InstallationPermissions{OrganizationHooks: &zeroValue}
+	i.GetOrganizationHooks()
+	i = &InstallationPermissions{}
+	i.GetOrganizationHooks()
+	i = nil
+	i.GetOrganizationHooks()
+}
+
+func TestInstallationPermissions_GetOrganizationPackages(tt *testing.T) {
+	var zeroValue string
+	i := &InstallationPermissions{OrganizationPackages: &zeroValue}
+	i.GetOrganizationPackages()
+	i = &InstallationPermissions{}
+	i.GetOrganizationPackages()
+	i = nil
+	i.GetOrganizationPackages()
+}
+
+func TestInstallationPermissions_GetOrganizationPersonalAccessTokenRequests(tt *testing.T) {
+	var zeroValue string
+	i := &InstallationPermissions{OrganizationPersonalAccessTokenRequests: &zeroValue}
+	i.GetOrganizationPersonalAccessTokenRequests()
+	i = &InstallationPermissions{}
+	i.GetOrganizationPersonalAccessTokenRequests()
+	i = nil
+	i.GetOrganizationPersonalAccessTokenRequests()
+}
+
+func TestInstallationPermissions_GetOrganizationPersonalAccessTokens(tt *testing.T) {
+	var zeroValue string
+	i := &InstallationPermissions{OrganizationPersonalAccessTokens: &zeroValue}
+	i.GetOrganizationPersonalAccessTokens()
+	i = &InstallationPermissions{}
+	i.GetOrganizationPersonalAccessTokens()
+	i = nil
+	i.GetOrganizationPersonalAccessTokens()
+}
+
+func TestInstallationPermissions_GetOrganizationPlan(tt *testing.T) {
+	var zeroValue string
+	i := &InstallationPermissions{OrganizationPlan: &zeroValue}
+	i.GetOrganizationPlan()
+	i = &InstallationPermissions{}
+	i.GetOrganizationPlan()
+	i = nil
+	i.GetOrganizationPlan()
+}
+
+func TestInstallationPermissions_GetOrganizationPreReceiveHooks(tt *testing.T) {
+	var zeroValue string
+	i := &InstallationPermissions{OrganizationPreReceiveHooks: &zeroValue}
+	i.GetOrganizationPreReceiveHooks()
+	i = &InstallationPermissions{}
+	i.GetOrganizationPreReceiveHooks()
+	i = nil
+	i.GetOrganizationPreReceiveHooks()
+}
+
+func TestInstallationPermissions_GetOrganizationProjects(tt *testing.T) {
+	var zeroValue string
+	i := &InstallationPermissions{OrganizationProjects: &zeroValue}
+	i.GetOrganizationProjects()
+	i = &InstallationPermissions{}
+	i.GetOrganizationProjects()
+	i = nil
+	i.GetOrganizationProjects()
+}
+
+func TestInstallationPermissions_GetOrganizationSecrets(tt *testing.T) {
+	var zeroValue string
+	i := &InstallationPermissions{OrganizationSecrets: &zeroValue}
+	i.GetOrganizationSecrets()
+	i = &InstallationPermissions{}
+	i.GetOrganizationSecrets()
+	i = nil
+	i.GetOrganizationSecrets()
+}
+
+func TestInstallationPermissions_GetOrganizationSelfHostedRunners(tt *testing.T) {
+	var zeroValue string
+	i := &InstallationPermissions{OrganizationSelfHostedRunners: &zeroValue}
+	i.GetOrganizationSelfHostedRunners()
+	i = &InstallationPermissions{}
+	i.GetOrganizationSelfHostedRunners()
+	i = nil
+	i.GetOrganizationSelfHostedRunners()
+}
+
+func TestInstallationPermissions_GetOrganizationUserBlocking(tt *testing.T) {
+	var zeroValue string
+	i := &InstallationPermissions{OrganizationUserBlocking: &zeroValue}
+	i.GetOrganizationUserBlocking()
+	i = &InstallationPermissions{}
+	i.GetOrganizationUserBlocking()
+	i = nil
+	i.GetOrganizationUserBlocking()
+}
+
+func TestInstallationPermissions_GetPackages(tt *testing.T) {
+	var zeroValue string
+	i := &InstallationPermissions{Packages: &zeroValue}
+	i.GetPackages()
+	i = &InstallationPermissions{}
+	i.GetPackages()
+	i = nil
+	i.GetPackages()
+}
+
+func TestInstallationPermissions_GetPages(tt *testing.T) {
+	var zeroValue string
+	i := &InstallationPermissions{Pages: &zeroValue}
+	i.GetPages()
+	i = &InstallationPermissions{}
+	i.GetPages()
+	i = nil
+	i.GetPages()
+}
+
+func TestInstallationPermissions_GetProfile(tt *testing.T) {
+	var zeroValue string
+	i := &InstallationPermissions{Profile: &zeroValue}
+	i.GetProfile()
+	i = &InstallationPermissions{}
+	i.GetProfile()
+	i = nil
+	i.GetProfile()
+}
+
+func TestInstallationPermissions_GetPullRequests(tt *testing.T) {
+	var zeroValue string
+	i := &InstallationPermissions{PullRequests: &zeroValue}
+	i.GetPullRequests()
+	i = &InstallationPermissions{}
+	i.GetPullRequests()
+	i = nil
+	i.GetPullRequests()
+}
+
+func TestInstallationPermissions_GetRepositoryAdvisories(tt *testing.T) {
+	var zeroValue string
+	i := &InstallationPermissions{RepositoryAdvisories: &zeroValue}
+	i.GetRepositoryAdvisories()
+	i = &InstallationPermissions{}
+	i.GetRepositoryAdvisories()
+	i = nil
+	i.GetRepositoryAdvisories()
+}
+
+func TestInstallationPermissions_GetRepositoryCustomProperties(tt *testing.T) {
+	var zeroValue string
+	i := &InstallationPermissions{RepositoryCustomProperties: &zeroValue}
+	i.GetRepositoryCustomProperties()
+	i = &InstallationPermissions{}
+	i.GetRepositoryCustomProperties()
+	i = nil
+	i.GetRepositoryCustomProperties()
+}
+
+func TestInstallationPermissions_GetRepositoryHooks(tt *testing.T) {
+	var zeroValue string
+	i := &InstallationPermissions{RepositoryHooks: &zeroValue}
+	i.GetRepositoryHooks()
+	i = &InstallationPermissions{}
+	i.GetRepositoryHooks()
+	i = nil
+	i.GetRepositoryHooks()
+}
+
+func TestInstallationPermissions_GetRepositoryPreReceiveHooks(tt *testing.T) {
+	var zeroValue string
+	i := &InstallationPermissions{RepositoryPreReceiveHooks: &zeroValue}
+	i.GetRepositoryPreReceiveHooks()
+	i = &InstallationPermissions{}
+	i.GetRepositoryPreReceiveHooks()
+	i = nil
+	i.GetRepositoryPreReceiveHooks()
+}
+
+func TestInstallationPermissions_GetRepositoryProjects(tt *testing.T) {
+	var zeroValue string
+	i := &InstallationPermissions{RepositoryProjects: &zeroValue}
+	i.GetRepositoryProjects()
+	i = &InstallationPermissions{}
+	i.GetRepositoryProjects()
+	i = nil
+	i.GetRepositoryProjects()
+}
+
+func TestInstallationPermissions_GetSecrets(tt *testing.T) {
+	var zeroValue string
+	i := &InstallationPermissions{Secrets: &zeroValue}
+	i.GetSecrets()
+	i = &InstallationPermissions{}
+	i.GetSecrets()
+	i = nil
+	i.GetSecrets()
+}
+
+func TestInstallationPermissions_GetSecretScanningAlerts(tt *testing.T) {
+	var zeroValue string
+	i := &InstallationPermissions{SecretScanningAlerts: &zeroValue}
+	i.GetSecretScanningAlerts()
+	i = &InstallationPermissions{}
+	i.GetSecretScanningAlerts()
+	i = nil
+	i.GetSecretScanningAlerts()
+}
+
+func TestInstallationPermissions_GetSecurityEvents(tt *testing.T) {
+	var zeroValue string
+	i := &InstallationPermissions{SecurityEvents: &zeroValue}
+	i.GetSecurityEvents()
+	i = &InstallationPermissions{}
+	i.GetSecurityEvents()
+	i = nil
+	i.GetSecurityEvents()
+}
+
+func TestInstallationPermissions_GetSingleFile(tt *testing.T) {
+	var zeroValue string
+	i := &InstallationPermissions{SingleFile: &zeroValue}
+	i.GetSingleFile()
+	i = &InstallationPermissions{}
+	i.GetSingleFile()
+	i = nil
+	i.GetSingleFile()
+}
+
+func TestInstallationPermissions_GetStarring(tt *testing.T) {
+	var zeroValue string
+	i := &InstallationPermissions{Starring: &zeroValue}
+	i.GetStarring()
+	i = &InstallationPermissions{}
+	i.GetStarring()
+	i = nil
+	i.GetStarring()
+}
+
+func TestInstallationPermissions_GetStatuses(tt *testing.T) {
+	var zeroValue string
+	i := &InstallationPermissions{Statuses: &zeroValue}
+	i.GetStatuses()
+	i = &InstallationPermissions{}
+	i.GetStatuses()
+	i = nil
+	i.GetStatuses()
+}
+
+func TestInstallationPermissions_GetTeamDiscussions(tt *testing.T) {
+	var zeroValue string
+	i := &InstallationPermissions{TeamDiscussions: &zeroValue}
+	i.GetTeamDiscussions()
+	i = &InstallationPermissions{}
+	i.GetTeamDiscussions()
+	i = nil
+	i.GetTeamDiscussions()
+}
+
+func TestInstallationPermissions_GetVulnerabilityAlerts(tt *testing.T) {
+	var zeroValue string
+	i := &InstallationPermissions{VulnerabilityAlerts: &zeroValue}
+	i.GetVulnerabilityAlerts()
+	i = &InstallationPermissions{}
+	i.GetVulnerabilityAlerts()
+	i = nil
+	i.GetVulnerabilityAlerts()
+}
+
+func TestInstallationPermissions_GetWorkflows(tt *testing.T) {
+	var zeroValue string
+	i := &InstallationPermissions{Workflows: &zeroValue}
+	i.GetWorkflows()
+	i = &InstallationPermissions{}
+	i.GetWorkflows()
+	i = nil
+	i.GetWorkflows()
+}
+
+func TestInstallationRepositoriesEvent_GetAction(tt *testing.T) {
+	var zeroValue string
+	i := &InstallationRepositoriesEvent{Action: &zeroValue}
+	i.GetAction()
+	i = &InstallationRepositoriesEvent{}
+	i.GetAction()
+	i = nil
+	i.GetAction()
+}
+
+func TestInstallationRepositoriesEvent_GetInstallation(tt *testing.T) {
+	i := &InstallationRepositoriesEvent{}
+	i.GetInstallation()
+	i = nil
+	i.GetInstallation()
+}
+
+func TestInstallationRepositoriesEvent_GetRepositorySelection(tt *testing.T) {
+	var zeroValue string
+	i := &InstallationRepositoriesEvent{RepositorySelection: &zeroValue}
+	i.GetRepositorySelection()
+	i = &InstallationRepositoriesEvent{}
+	i.GetRepositorySelection()
+	i = nil
+	i.GetRepositorySelection()
+}
+
+func TestInstallationRepositoriesEvent_GetSender(tt *testing.T) {
+	i := &InstallationRepositoriesEvent{}
+	i.GetSender()
+	i = nil
+	i.GetSender()
+}
+
+func TestInstallationToken_GetExpiresAt(tt *testing.T) {
+	var zeroValue time.Time
+	i := &InstallationToken{ExpiresAt: &zeroValue}
+	i.GetExpiresAt()
+	i = &InstallationToken{}
+	i.GetExpiresAt()
+	i = nil
+	i.GetExpiresAt()
+}
+
+func TestInstallationToken_GetPermissions(tt *testing.T) {
+	i := &InstallationToken{}
+	i.GetPermissions()
+	i = nil
+	i.GetPermissions()
+}
+
+func TestInstallationToken_GetToken(tt *testing.T) {
+	var zeroValue string
+	i := &InstallationToken{Token: &zeroValue}
+	i.GetToken()
+	i = &InstallationToken{}
+	i.GetToken()
+	i = nil
+	i.GetToken()
+}
+
+func TestInstallationTokenOptions_GetPermissions(tt *testing.T) {
+	i := &InstallationTokenOptions{}
+	i.GetPermissions()
+	i = nil
+	i.GetPermissions()
+}
+
+func TestInteractionRestriction_GetExpiresAt(tt *testing.T) {
+	var zeroValue Timestamp
+	i := &InteractionRestriction{ExpiresAt: &zeroValue}
+	i.GetExpiresAt()
+	i = &InteractionRestriction{}
+	i.GetExpiresAt()
+	i = nil
+	i.GetExpiresAt()
+}
+
+func TestInteractionRestriction_GetLimit(tt *testing.T) {
+	var zeroValue string
+	i := &InteractionRestriction{Limit: &zeroValue}
+	i.GetLimit()
+	i = &InteractionRestriction{}
+	i.GetLimit()
+	i = nil
+	i.GetLimit()
+}
+
+func TestInteractionRestriction_GetOrigin(tt *testing.T) {
+	var zeroValue string
+	i := &InteractionRestriction{Origin: &zeroValue}
+	i.GetOrigin()
+	i = &InteractionRestriction{}
+	i.GetOrigin()
+	i = nil
+	i.GetOrigin()
+}
+
+func TestInTotoStatement_GetPredicateType(tt *testing.T) {
+	var zeroValue string
+	i := &InTotoStatement{PredicateType: &zeroValue}
+	i.GetPredicateType()
+	i = &InTotoStatement{}
+	i.GetPredicateType()
+	i = nil
+	i.GetPredicateType()
+}
+
+func TestInTotoStatement_GetType(tt *testing.T) {
+	var zeroValue string
+	i := &InTotoStatement{Type: &zeroValue}
+	i.GetType()
+	i = &InTotoStatement{}
+	i.GetType()
+	i = nil
+	i.GetType()
+}
+
+func TestInTotoSubject_GetName(tt *testing.T) {
+	var zeroValue string
+	i := &InTotoSubject{Name: &zeroValue}
+	i.GetName()
+	i = &InTotoSubject{}
+	i.GetName()
+	i = nil
+	i.GetName()
+}
+
+func TestInvitation_GetCreatedAt(tt *testing.T) {
+	var zeroValue time.Time
+	i := &Invitation{CreatedAt: &zeroValue}
+	i.GetCreatedAt()
+	i = &Invitation{}
+	i.GetCreatedAt()
+	i = nil
+	i.GetCreatedAt()
+}
+
+func TestInvitation_GetEmail(tt *testing.T) {
+	var zeroValue string
+	i := &Invitation{Email: &zeroValue}
+	i.GetEmail()
+	i = &Invitation{}
+	i.GetEmail()
+	i = nil
+	i.GetEmail()
+}
+
+func TestInvitation_GetFailedAt(tt *testing.T) {
+	var zeroValue Timestamp
+	i := &Invitation{FailedAt: &zeroValue}
+	i.GetFailedAt()
+	i = &Invitation{}
+	i.GetFailedAt()
+	i = nil
+	i.GetFailedAt()
+}
+
+func TestInvitation_GetFailedReason(tt *testing.T) {
+	var zeroValue string
+	i := &Invitation{FailedReason: &zeroValue}
+	i.GetFailedReason()
+	i = &Invitation{}
+	i.GetFailedReason()
+	i = nil
+	i.GetFailedReason()
+}
+
+func TestInvitation_GetID(tt *testing.T) {
+	var zeroValue int64
+	i := &Invitation{ID: &zeroValue}
+	i.GetID()
+	i = &Invitation{}
+	i.GetID()
+	i = nil
+	i.GetID()
+}
+
+func TestInvitation_GetInvitationTeamURL(tt *testing.T) {
+	var zeroValue string
+	i := &Invitation{InvitationTeamURL: &zeroValue}
+	i.GetInvitationTeamURL()
+	i = &Invitation{}
+	i.GetInvitationTeamURL()
+	i = nil
+	i.GetInvitationTeamURL()
+}
+
+func TestInvitation_GetInviter(tt *testing.T) {
+	i := &Invitation{}
+	i.GetInviter()
+	i = nil
+	i.GetInviter()
+}
+
+func TestInvitation_GetLogin(tt *testing.T) {
+	var zeroValue string
+	i := &Invitation{Login: &zeroValue}
+	i.GetLogin()
+	i = &Invitation{}
+	i.GetLogin()
+	i = nil
+	i.GetLogin()
+}
+
+func TestInvitation_GetNodeID(tt *testing.T) {
+	var zeroValue string
+	i := &Invitation{NodeID: &zeroValue}
+	i.GetNodeID()
+	i = &Invitation{}
+	i.GetNodeID()
+	i = nil
+	i.GetNodeID()
+}
+
+func TestInvitation_GetRole(tt *testing.T) {
+	var zeroValue string
+	i := &Invitation{Role: &zeroValue}
+	i.GetRole()
+	i = &Invitation{}
+	i.GetRole()
+	i = nil
+	i.GetRole()
+}
+
+func TestInvitation_GetTeamCount(tt *testing.T) {
+	var zeroValue int
+	i := &Invitation{TeamCount: &zeroValue}
+	i.GetTeamCount()
+	i = &Invitation{}
+	i.GetTeamCount()
+	i = nil
+	i.GetTeamCount()
+}
+
+func TestIssue_GetActiveLockReason(tt *testing.T) {
+	var zeroValue string
+	i := &Issue{ActiveLockReason: &zeroValue}
+	i.GetActiveLockReason()
+	i = &Issue{}
+	i.GetActiveLockReason()
+	i = nil
+	i.GetActiveLockReason()
+}
+
+func TestIssue_GetAssignee(tt *testing.T) {
+	i := &Issue{}
+	i.GetAssignee()
+	i = nil
+	i.GetAssignee()
+}
+
+func TestIssue_GetAuthorAssociation(tt *testing.T) {
+	var zeroValue string
+	i := &Issue{AuthorAssociation: &zeroValue}
+	i.GetAuthorAssociation()
+	i = &Issue{}
+	i.GetAuthorAssociation()
+	i = nil
+	i.GetAuthorAssociation()
+}
+
+func TestIssue_GetBody(tt *testing.T) {
+	var zeroValue string
+	i := &Issue{Body: &zeroValue}
+	i.GetBody()
+	i = &Issue{}
+	i.GetBody()
+	i = nil
+	i.GetBody()
+}
+
+func TestIssue_GetClosedAt(tt *testing.T) {
+	var zeroValue time.Time
+	i := &Issue{ClosedAt: &zeroValue}
+	i.GetClosedAt()
+	i = &Issue{}
+	i.GetClosedAt()
+	i = nil
+	i.GetClosedAt()
+}
+
+func TestIssue_GetClosedBy(tt *testing.T) {
+	i := &Issue{}
+	i.GetClosedBy()
+	i = nil
+	i.GetClosedBy()
+}
+
+func TestIssue_GetComments(tt *testing.T) {
+	var zeroValue int
+	i := &Issue{Comments: &zeroValue}
+	i.GetComments()
+	i = &Issue{}
+	i.GetComments()
+	i = nil
+	i.GetComments()
+}
+
+func TestIssue_GetCommentsURL(tt *testing.T) {
+	var zeroValue string
+	i := &Issue{CommentsURL: &zeroValue}
+	i.GetCommentsURL()
+	i = &Issue{}
+	i.GetCommentsURL()
+	i = nil
+	i.GetCommentsURL()
+}
+
+func TestIssue_GetCreatedAt(tt *testing.T) {
+	var zeroValue time.Time
+	i := &Issue{CreatedAt: &zeroValue}
+	i.GetCreatedAt()
+	i = &Issue{}
+	i.GetCreatedAt()
+	i = nil
+	i.GetCreatedAt()
+}
+
+func TestIssue_GetEventsURL(tt *testing.T) {
+	var zeroValue string
+	i := &Issue{EventsURL: &zeroValue}
+	i.GetEventsURL()
+	i = &Issue{}
+	i.GetEventsURL()
+	i = nil
+	i.GetEventsURL()
+}
+
+func TestIssue_GetHTMLURL(tt *testing.T) {
+	var zeroValue string
+	i := &Issue{HTMLURL: &zeroValue}
+	i.GetHTMLURL()
+	i = &Issue{}
+	i.GetHTMLURL()
+	i = nil
+	i.GetHTMLURL()
+}
+
+func TestIssue_GetID(tt *testing.T) {
+	var zeroValue int64
+	i := &Issue{ID: &zeroValue}
+	i.GetID()
+	i = &Issue{}
+	i.GetID()
+	i = nil
+	i.GetID()
+}
+
+func TestIssue_GetLabelsURL(tt *testing.T) {
+	var zeroValue string
+	i := &Issue{LabelsURL: &zeroValue}
+	i.GetLabelsURL()
+	i = &Issue{}
+	i.GetLabelsURL()
+	i = nil
+	i.GetLabelsURL()
+}
+
+func TestIssue_GetLocked(tt *testing.T) {
+	var zeroValue bool
+	i := &Issue{Locked: &zeroValue}
+	i.GetLocked()
+	i = &Issue{}
+	i.GetLocked()
+	i = nil
+	i.GetLocked()
+}
+
+func TestIssue_GetMilestone(tt *testing.T) {
+	i := &Issue{}
+	i.GetMilestone()
+	i = nil
+	i.GetMilestone()
+}
+
+func TestIssue_GetNodeID(tt *testing.T) {
+	var zeroValue string
+	i := &Issue{NodeID: &zeroValue}
+	i.GetNodeID()
+	i = &Issue{}
+	i.GetNodeID()
+	i = nil
+	i.GetNodeID()
+}
+
+func TestIssue_GetNumber(tt *testing.T) {
+	var zeroValue int
+	i := &Issue{Number: &zeroValue}
+	i.GetNumber()
+	i = &Issue{}
+	i.GetNumber()
+	i = nil
+	i.GetNumber()
+}
+
+func TestIssue_GetPullRequestLinks(tt *testing.T) {
+	i := &Issue{}
+	i.GetPullRequestLinks()
+	i = nil
+	i.GetPullRequestLinks()
+}
+
+func TestIssue_GetReactions(tt *testing.T) {
+	i := &Issue{}
+	i.GetReactions()
+	i = nil
+	i.GetReactions()
+}
+
+func TestIssue_GetRepository(tt *testing.T) {
+	i := &Issue{}
+	i.GetRepository()
+	i = nil
+	i.GetRepository()
+}
+
+func TestIssue_GetRepositoryURL(tt *testing.T) {
+	var zeroValue string
+	i := &Issue{RepositoryURL: &zeroValue}
+	i.GetRepositoryURL()
+	i = &Issue{}
+	i.GetRepositoryURL()
+	i = nil
+	i.GetRepositoryURL()
+}
+
+func TestIssue_GetState(tt *testing.T) {
+	var zeroValue string
+	i := &Issue{State: &zeroValue}
+	i.GetState()
+	i = &Issue{}
+	i.GetState()
+	i = nil
+	i.GetState()
+}
+
+func TestIssue_GetTitle(tt *testing.T) {
+	var zeroValue string
+	i := &Issue{Title: &zeroValue}
+	i.GetTitle()
+	i = &Issue{}
+	i.GetTitle()
+	i = nil
+	i.GetTitle()
 }
 
-func TestDeployment_GetStatusesURL(tt *testing.T) {
-	var zeroValue string
-	d := &Deployment{StatusesURL: &zeroValue}
-	d.GetStatusesURL()
-	d = &Deployment{}
-	d.GetStatusesURL()
-	d = nil
-	d.GetStatusesURL()
+func TestIssue_GetUpdatedAt(tt *testing.T) {
+	var zeroValue time.Time
+	i := &Issue{UpdatedAt: &zeroValue}
+	i.GetUpdatedAt()
+	i = &Issue{}
+	i.GetUpdatedAt()
+	i = nil
+	i.GetUpdatedAt()
 }
 
-func TestDeployment_GetTask(tt *testing.T) {
+func TestIssue_GetURL(tt *testing.T) {
 	var zeroValue string
-	d := &Deployment{Task: &zeroValue}
-	d.GetTask()
-	d = &Deployment{}
-	d.GetTask()
-	d = nil
-	d.GetTask()
+	i := &Issue{URL: &zeroValue}
+	i.GetURL()
+	i = &Issue{}
+	i.GetURL()
+	i = nil
+	i.GetURL()
 }
 
-func TestDeployment_GetUpdatedAt(tt *testing.T) {
-	var zeroValue Timestamp
-	d := &Deployment{UpdatedAt: &zeroValue}
-	d.GetUpdatedAt()
-	d = &Deployment{}
-	d.GetUpdatedAt()
-	d = nil
-	d.GetUpdatedAt()
+func TestIssue_GetUser(tt *testing.T) {
+	i := &Issue{}
+	i.GetUser()
+	i = nil
+	i.GetUser()
 }
 
-func TestDeployment_GetURL(tt *testing.T) {
+func TestIssueComment_GetAuthorAssociation(tt *testing.T) {
 	var zeroValue string
-	d := &Deployment{URL: &zeroValue}
-	d.GetURL()
-	d = &Deployment{}
-	d.GetURL()
-	d = nil
-	d.GetURL()
+	i := &IssueComment{AuthorAssociation: &zeroValue}
+	i.GetAuthorAssociation()
+	i = &IssueComment{}
+	i.GetAuthorAssociation()
+	i = nil
+	i.GetAuthorAssociation()
 }
 
-func TestDeploymentEvent_GetDeployment(tt *testing.T) {
-	d := &DeploymentEvent{}
-	d.GetDeployment()
-	d = nil
-	d.GetDeployment()
+func TestIssueComment_GetBody(tt *testing.T) {
+	var zeroValue string
+	i := &IssueComment{Body: &zeroValue}
+	i.GetBody()
+	i = &IssueComment{}
+	i.GetBody()
+	i = nil
+	i.GetBody()
 }
 
-func TestDeploymentEvent_GetInstallation(tt *testing.T) {
-	d := &DeploymentEvent{}
-	d.GetInstallation()
-	d = nil
-	d.GetInstallation()
+func TestIssueComment_GetCreatedAt(tt *testing.T) {
+	var zeroValue time.Time
+	i := &IssueComment{CreatedAt: &zeroValue}
+	i.GetCreatedAt()
+	i = &IssueComment{}
+	i.GetCreatedAt()
+	i = nil
+	i.GetCreatedAt()
 }
 
-func TestDeploymentEvent_GetRepo(tt *testing.T) {
-	d := &DeploymentEvent{}
-	d.GetRepo()
-	d = nil
-	d.GetRepo()
+func TestIssueComment_GetHTMLURL(tt *testing.T) {
+	var zeroValue string
+	i := &IssueComment{HTMLURL: &zeroValue}
+	i.GetHTMLURL()
+	i = &IssueComment{}
+	i.GetHTMLURL()
+	i = nil
+	i.GetHTMLURL()
 }
 
-func TestDeploymentEvent_GetSender(tt *testing.T) {
-	d := &DeploymentEvent{}
-	d.GetSender()
-	d = nil
-	d.GetSender()
+func TestIssueComment_GetID(tt *testing.T) {
+	var zeroValue int64
+	i := &IssueComment{ID: &zeroValue}
+	i.GetID()
+	i = &IssueComment{}
+	i.GetID()
+	i = nil
+	i.GetID()
 }
 
-func TestDeploymentRequest_GetAutoMerge(tt *testing.T) {
-	var zeroValue bool
-	d := &DeploymentRequest{AutoMerge: &zeroValue}
-	d.GetAutoMerge()
-	d = &DeploymentRequest{}
-	d.GetAutoMerge()
-	d = nil
-	d.GetAutoMerge()
+func TestIssueComment_GetIssueURL(tt *testing.T) {
+	var zeroValue string
+	i := &IssueComment{IssueURL: &zeroValue}
+	i.GetIssueURL()
+	i = &IssueComment{}
+	i.GetIssueURL()
+	i = nil
+	i.GetIssueURL()
 }
 
-func TestDeploymentRequest_GetDescription(tt *testing.T) {
+func TestIssueComment_GetNodeID(tt *testing.T) {
 	var zeroValue string
-	d := &DeploymentRequest{Description: &zeroValue}
-	d.GetDescription()
-	d = &DeploymentRequest{}
-	d.GetDescription()
-	d = nil
-	d.GetDescription()
+	i := &IssueComment{NodeID: &zeroValue}
+	i.GetNodeID()
+	i = &IssueComment{}
+	i.GetNodeID()
+	i = nil
+	i.GetNodeID()
 }
 
-func TestDeploymentRequest_GetEnvironment(tt *testing.T) {
+func TestIssueComment_GetReactions(tt *testing.T) {
+	i := &IssueComment{}
+	i.GetReactions()
+	i = nil
+	i.GetReactions()
+}
+
+func TestIssueComment_GetUpdatedAt(tt *testing.T) {
+	var zeroValue time.Time
+	i := &IssueComment{UpdatedAt: &zeroValue}
+	i.GetUpdatedAt()
+	i = &IssueComment{}
+	i.GetUpdatedAt()
+	i = nil
+	i.GetUpdatedAt()
+}
+
+func TestIssueComment_GetURL(tt *testing.T) {
 	var zeroValue string
-	d := &DeploymentRequest{Environment: &zeroValue}
-	d.GetEnvironment()
-	d = &DeploymentRequest{}
-	d.GetEnvironment()
-	d = nil
-	d.GetEnvironment()
+	i := &IssueComment{URL: &zeroValue}
+	i.GetURL()
+	i = &IssueComment{}
+	i.GetURL()
+	i = nil
+	i.GetURL()
 }
 
-func TestDeploymentRequest_GetProductionEnvironment(tt *testing.T) {
-	var zeroValue bool
-	d := &DeploymentRequest{ProductionEnvironment: &zeroValue}
-	d.GetProductionEnvironment()
-	d = &DeploymentRequest{}
-	d.GetProductionEnvironment()
-	d = nil
-	d.GetProductionEnvironment()
+func TestIssueComment_GetUser(tt *testing.T) {
+	i := &IssueComment{}
+	i.GetUser()
+	i = nil
+	i.GetUser()
 }
 
-func TestDeploymentRequest_GetRef(tt *testing.T) {
+func TestIssueCommentEvent_GetAction(tt *testing.T) {
 	var zeroValue string
-	d := &DeploymentRequest{Ref: &zeroValue}
-	d.GetRef()
-	d = &DeploymentRequest{}
-	d.GetRef()
-	d = nil
-	d.GetRef()
+	i := &IssueCommentEvent{Action: &zeroValue}
+	i.GetAction()
+	i = &IssueCommentEvent{}
+	i.GetAction()
+	i = nil
+	i.GetAction()
 }
 
-func TestDeploymentRequest_GetRequiredContexts(tt *testing.T) {
-	var zeroValue []string
-	d := &DeploymentRequest{RequiredContexts: &zeroValue}
-	d.GetRequiredContexts()
-	d = &DeploymentRequest{}
-	d.GetRequiredContexts()
-	d = nil
-	d.GetRequiredContexts()
+func TestIssueCommentEvent_GetChanges(tt *testing.T) {
+	i := &IssueCommentEvent{}
+	i.GetChanges()
+	i = nil
+	i.GetChanges()
+}
+
+func TestIssueCommentEvent_GetComment(tt *testing.T) {
+	i := &IssueCommentEvent{}
+	i.GetComment()
+	i = nil
+	i.GetComment()
+}
+
+func TestIssueCommentEvent_GetInstallation(tt *testing.T) {
+	i := &IssueCommentEvent{}
+	i.GetInstallation()
+	i = nil
+	i.GetInstallation()
+}
+
+func TestIssueCommentEvent_GetIssue(tt *testing.T) {
+	i := &IssueCommentEvent{}
+	i.GetIssue()
+	i = nil
+	i.GetIssue()
+}
+
+func TestIssueCommentEvent_GetRepo(tt *testing.T) {
+	i := &IssueCommentEvent{}
+	i.GetRepo()
+	i = nil
+	i.GetRepo()
+}
+
+func TestIssueCommentEvent_GetSender(tt *testing.T) {
+	i := &IssueCommentEvent{}
+	i.GetSender()
+	i = nil
+	i.GetSender()
+}
+
+func TestIssueDependenciesEvent_GetAction(tt *testing.T) {
+	var zeroValue string
+	i := &IssueDependenciesEvent{Action: &zeroValue}
+	i.GetAction()
+	i = &IssueDependenciesEvent{}
+	i.GetAction()
+	i = nil
+	i.GetAction()
 }
 
-func TestDeploymentRequest_GetTask(tt *testing.T) {
-	var zeroValue string
-	d := &DeploymentRequest{Task: &zeroValue}
-	d.GetTask()
-	d = &DeploymentRequest{}
-	d.GetTask()
-	d = nil
-	d.GetTask()
+func TestIssueDependenciesEvent_GetBlockedIssue(tt *testing.T) {
+	i := &IssueDependenciesEvent{}
+	i.GetBlockedIssue()
+	i = nil
+	i.GetBlockedIssue()
 }
 
-func TestDeploymentRequest_GetTransientEnvironment(tt *testing.T) {
-	var zeroValue bool
-	d := &DeploymentRequest{TransientEnvironment: &zeroValue}
-	d.GetTransientEnvironment()
-	d = &DeploymentRequest{}
-	d.GetTransientEnvironment()
-	d = nil
-	d.GetTransientEnvironment()
+func TestIssueDependenciesEvent_GetBlockedIssueRepo(tt *testing.T) {
+	i := &IssueDependenciesEvent{}
+	i.GetBlockedIssueRepo()
+	i = nil
+	i.GetBlockedIssueRepo()
 }
 
-func TestDeploymentStatus_GetCreatedAt(tt *testing.T) {
-	var zeroValue Timestamp
-	d := &DeploymentStatus{CreatedAt: &zeroValue}
-	d.GetCreatedAt()
-	d = &DeploymentStatus{}
-	d.GetCreatedAt()
-	d = nil
-	d.GetCreatedAt()
+func TestIssueDependenciesEvent_GetBlockingIssue(tt *testing.T) {
+	i := &IssueDependenciesEvent{}
+	i.GetBlockingIssue()
+	i = nil
+	i.GetBlockingIssue()
 }
 
-func TestDeploymentStatus_GetCreator(tt *testing.T) {
-	d := &DeploymentStatus{}
-	d.GetCreator()
-	d = nil
-	d.GetCreator()
+func TestIssueDependenciesEvent_GetBlockingIssueRepo(tt *testing.T) {
+	i := &IssueDependenciesEvent{}
+	i.GetBlockingIssueRepo()
+	i = nil
+	i.GetBlockingIssueRepo()
 }
 
-func TestDeploymentStatus_GetDeploymentURL(tt *testing.T) {
-	var zeroValue string
-	d := &DeploymentStatus{DeploymentURL: &zeroValue}
-	d.GetDeploymentURL()
-	d = &DeploymentStatus{}
-	d.GetDeploymentURL()
-	d = nil
-	d.GetDeploymentURL()
+func TestIssueDependenciesEvent_GetInstallation(tt *testing.T) {
+	i := &IssueDependenciesEvent{}
+	i.GetInstallation()
+	i = nil
+	i.GetInstallation()
 }
 
-func TestDeploymentStatus_GetDescription(tt *testing.T) {
-	var zeroValue string
-	d := &DeploymentStatus{Description: &zeroValue}
-	d.GetDescription()
-	d = &DeploymentStatus{}
-	d.GetDescription()
-	d = nil
-	d.GetDescription()
+func TestIssueDependenciesEvent_GetOrg(tt *testing.T) {
+	i := &IssueDependenciesEvent{}
+	i.GetOrg()
+	i = nil
+	i.GetOrg()
 }
 
-func TestDeploymentStatus_GetEnvironment(tt *testing.T) {
-	var zeroValue string
-	d := &DeploymentStatus{Environment: &zeroValue}
-	d.GetEnvironment()
-	d = &DeploymentStatus{}
-	d.GetEnvironment()
-	d = nil
-	d.GetEnvironment()
+func TestIssueDependenciesEvent_GetRepo(tt *testing.T) {
+	i := &IssueDependenciesEvent{}
+	i.GetRepo()
+	i = nil
+	i.GetRepo()
 }
 
-func TestDeploymentStatus_GetEnvironmentURL(tt *testing.T) {
-	var zeroValue string
-	d := &DeploymentStatus{EnvironmentURL: &zeroValue}
-	d.GetEnvironmentURL()
-	d = &DeploymentStatus{}
-	d.GetEnvironmentURL()
-	d = nil
-	d.GetEnvironmentURL()
+func TestIssueDependenciesEvent_GetSender(tt *testing.T) {
+	i := &IssueDependenciesEvent{}
+	i.GetSender()
+	i = nil
+	i.GetSender()
 }
 
-func TestDeploymentStatus_GetID(tt *testing.T) {
-	var zeroValue int64
-	d := &DeploymentStatus{ID: &zeroValue}
-	d.GetID()
-	d = &DeploymentStatus{}
-	d.GetID()
-	d = nil
-	d.GetID()
+func TestIssueEvent_GetActor(tt *testing.T) {
+	i := &IssueEvent{}
+	i.GetActor()
+	i = nil
+	i.GetActor()
 }
 
-func TestDeploymentStatus_GetLogURL(tt *testing.T) {
-	var zeroValue string
-	d := &DeploymentStatus{LogURL: &zeroValue}
-	d.GetLogURL()
-	d = &DeploymentStatus{}
-	d.GetLogURL()
-	d = nil
-	d.GetLogURL()
+func TestIssueEvent_GetAssignee(tt *testing.T) {
+	i := &IssueEvent{}
+	i.GetAssignee()
+	i = nil
+	i.GetAssignee()
 }
 
-func TestDeploymentStatus_GetNodeID(tt *testing.T) {
-	var zeroValue string
-	d := &DeploymentStatus{NodeID: &zeroValue}
-	d.GetNodeID()
-	d = &DeploymentStatus{}
-	d.GetNodeID()
-	d = nil
-	d.GetNodeID()
+func TestIssueEvent_GetAssigner(tt *testing.T) {
+	i := &IssueEvent{}
+	i.GetAssigner()
+	i = nil
+	i.GetAssigner()
 }
 
-func TestDeploymentStatus_GetRepositoryURL(tt *testing.T) {
+func TestIssueEvent_GetCommitID(tt *testing.T) {
 	var zeroValue string
-	d := &DeploymentStatus{RepositoryURL: &zeroValue}
-	d.GetRepositoryURL()
-	d = &DeploymentStatus{}
-	d.GetRepositoryURL()
-	d = nil
-	d.GetRepositoryURL()
+	i := &IssueEvent{CommitID: &zeroValue}
+	i.GetCommitID()
+	i = &IssueEvent{}
+	i.GetCommitID()
+	i = nil
+	i.GetCommitID()
 }
 
-func TestDeploymentStatus_GetState(tt *testing.T) {
-	var zeroValue string
-	d := &DeploymentStatus{State: &zeroValue}
-	d.GetState()
-	d = &DeploymentStatus{}
-	d.GetState()
-	d = nil
-	d.GetState()
+func TestIssueEvent_GetCreatedAt(tt *testing.T) {
+	var zeroValue time.Time
+	i := &IssueEvent{CreatedAt: &zeroValue}
+	i.GetCreatedAt()
+	i = &IssueEvent{}
+	i.GetCreatedAt()
+	i = nil
+	i.GetCreatedAt()
 }
 
-func TestDeploymentStatus_GetTargetURL(tt *testing.T) {
+func TestIssueEvent_GetDismissedReview(tt *testing.T) {
+	i := &IssueEvent{}
+	i.GetDismissedReview()
+	i = nil
+	i.GetDismissedReview()
+}
+
+func TestIssueEvent_GetEvent(tt *testing.T) {
 	var zeroValue string
-	d := &DeploymentStatus{TargetURL: &zeroValue}
-	d.GetTargetURL()
-	d = &DeploymentStatus{}
-	d.GetTargetURL()
-	d = nil
-	d.GetTargetURL()
+	i := &IssueEvent{Event: &zeroValue}
+	i.GetEvent()
+	i = &IssueEvent{}
+	i.GetEvent()
+	i = nil
+	i.GetEvent()
 }
 
-func TestDeploymentStatus_GetUpdatedAt(tt *testing.T) {
-	var zeroValue Timestamp
-	d := &DeploymentStatus{UpdatedAt: &zeroValue}
-	d.GetUpdatedAt()
-	d = &DeploymentStatus{}
-	d.GetUpdatedAt()
-	d = nil
-	d.GetUpdatedAt()
+func TestIssueEvent_GetID(tt *testing.T) {
+	var zeroValue int64
+	i := &IssueEvent{ID: &zeroValue}
+	i.GetID()
+	i = &IssueEvent{}
+	i.GetID()
+	i = nil
+	i.GetID()
 }
 
-func TestDeploymentStatus_GetURL(tt *testing.T) {
-	var zeroValue string
-	d := &DeploymentStatus{URL: &zeroValue}
-	d.GetURL()
-	d = &DeploymentStatus{}
-	d.GetURL()
-	d = nil
-	d.GetURL()
+func TestIssueEvent_GetIssue(tt *testing.T) {
+	i := &IssueEvent{}
+	i.GetIssue()
+	i = nil
+	i.GetIssue()
 }
 
-func TestDeploymentStatusEvent_GetDeployment(tt *testing.T) {
-	d := &DeploymentStatusEvent{}
-	d.GetDeployment()
-	d = nil
-	d.GetDeployment()
+func TestIssueEvent_GetLabel(tt *testing.T) {
+	i := &IssueEvent{}
+	i.GetLabel()
+	i = nil
+	i.GetLabel()
 }
 
-func TestDeploymentStatusEvent_GetDeploymentStatus(tt *testing.T) {
-	d := &DeploymentStatusEvent{}
-	d.GetDeploymentStatus()
-	d = nil
-	d.GetDeploymentStatus()
+func TestIssueEvent_GetLockReason(tt *testing.T) {
+	var zeroValue string
+	i := &IssueEvent{LockReason: &zeroValue}
+	i.GetLockReason()
+	i = &IssueEvent{}
+	i.GetLockReason()
+	i = nil
+	i.GetLockReason()
 }
 
-func TestDeploymentStatusEvent_GetInstallation(tt *testing.T) {
-	d := &DeploymentStatusEvent{}
-	d.GetInstallation()
-	d = nil
-	d.GetInstallation()
+func TestIssueEvent_GetMilestone(tt *testing.T) {
+	i := &IssueEvent{}
+	i.GetMilestone()
+	i = nil
+	i.GetMilestone()
 }
 
-func TestDeploymentStatusEvent_GetRepo(tt *testing.T) {
-	d := &DeploymentStatusEvent{}
-	d.GetRepo()
-	d = nil
-	d.GetRepo()
+func TestIssueEvent_GetProjectCard(tt *testing.T) {
+	i := &IssueEvent{}
+	i.GetProjectCard()
+	i = nil
+	i.GetProjectCard()
 }
 
-func TestDeploymentStatusEvent_GetSender(tt *testing.T) {
-	d := &DeploymentStatusEvent{}
-	d.GetSender()
-	d = nil
-	d.GetSender()
+func TestIssueEvent_GetRename(tt *testing.T) {
+	i := &IssueEvent{}
+	i.GetRename()
+	i = nil
+	i.GetRename()
+}
+
+func TestIssueEvent_GetRequestedReviewer(tt *testing.T) {
+	i := &IssueEvent{}
+	i.GetRequestedReviewer()
+	i = nil
+	i.GetRequestedReviewer()
 }
 
-func TestDeploymentStatusRequest_GetAutoInactive(tt *testing.T) {
-	var zeroValue bool
-	d := &DeploymentStatusRequest{AutoInactive: &zeroValue}
-	d.GetAutoInactive()
-	d = &DeploymentStatusRequest{}
-	d.GetAutoInactive()
-	d = nil
-	d.GetAutoInactive()
+func TestIssueEvent_GetReviewRequester(tt *testing.T) {
+	i := &IssueEvent{}
+	i.GetReviewRequester()
+	i = nil
+	i.GetReviewRequester()
 }
 
-func TestDeploymentStatusRequest_GetDescription(tt *testing.T) {
+func TestIssueEvent_GetURL(tt *testing.T) {
 	var zeroValue string
-	d := &DeploymentStatusRequest{Description: &zeroValue}
-	d.GetDescription()
-	d = &DeploymentStatusRequest{}
-	d.GetDescription()
-	d = nil
-	d.GetDescription()
+	i := &IssueEvent{URL: &zeroValue}
+	i.GetURL()
+	i = &IssueEvent{}
+	i.GetURL()
+	i = nil
+	i.GetURL()
 }
 
-func TestDeploymentStatusRequest_GetEnvironment(tt *testing.T) {
+func TestIssueImport_GetAssignee(tt *testing.T) {
 	var zeroValue string
-	d := &DeploymentStatusRequest{Environment: &zeroValue}
-	d.GetEnvironment()
-	d = &DeploymentStatusRequest{}
-	d.GetEnvironment()
-	d = nil
-	d.GetEnvironment()
+	i := &IssueImport{Assignee: &zeroValue}
+	i.GetAssignee()
+	i = &IssueImport{}
+	i.GetAssignee()
+	i = nil
+	i.GetAssignee()
 }
 
-func TestDeploymentStatusRequest_GetEnvironmentURL(tt *testing.T) {
-	var zeroValue string
-	d := &DeploymentStatusRequest{EnvironmentURL: &zeroValue}
-	d.GetEnvironmentURL()
-	d = &DeploymentStatusRequest{}
-	d.GetEnvironmentURL()
-	d = nil
-	d.GetEnvironmentURL()
+func TestIssueImport_GetClosed(tt *testing.T) {
+	var zeroValue bool
+	i := &IssueImport{Closed: &zeroValue}
+	i.GetClosed()
+	i = &IssueImport{}
+	i.GetClosed()
+	i = nil
+	i.GetClosed()
 }
 
-func TestDeploymentStatusRequest_GetLogURL(tt *testing.T) {
-	var zeroValue string
-	d := &DeploymentStatusRequest{LogURL: &zeroValue}
-	d.GetLogURL()
-	d = &DeploymentStatusRequest{}
-	d.GetLogURL()
-	d = nil
-	d.GetLogURL()
+func TestIssueImport_GetClosedAt(tt *testing.T) {
+	var zeroValue time.Time
+	i := &IssueImport{ClosedAt: &zeroValue}
+	i.GetClosedAt()
+	i = &IssueImport{}
+	i.GetClosedAt()
+	i = nil
+	i.GetClosedAt()
 }
 
-func TestDeploymentStatusRequest_GetState(tt *testing.T) {
-	var zeroValue string
-	d := &DeploymentStatusRequest{State: &zeroValue}
-	d.GetState()
-	d = &DeploymentStatusRequest{}
-	d.GetState()
-	d = nil
-	d.GetState()
+func TestIssueImport_GetCreatedAt(tt *testing.T) {
+	var zeroValue time.Time
+	i := &IssueImport{CreatedAt: &zeroValue}
+	i.GetCreatedAt()
+	i = &IssueImport{}
+	i.GetCreatedAt()
+	i = nil
+	i.GetCreatedAt()
 }
 
-func TestDiscussionComment_GetAuthor(tt *testing.T) {
-	d := &DiscussionComment{}
-	d.GetAuthor()
-	d = nil
-	d.GetAuthor()
+func TestIssueImport_GetMilestone(tt *testing.T) {
+	var zeroValue int
+	i := &IssueImport{Milestone: &zeroValue}
+	i.GetMilestone()
+	i = &IssueImport{}
+	i.GetMilestone()
+	i = nil
+	i.GetMilestone()
 }
 
-func TestDiscussionComment_GetBody(tt *testing.T) {
-	var zeroValue string
-	d := &DiscussionComment{Body: &zeroValue}
-	d.GetBody()
-	d = &DiscussionComment{}
-	d.GetBody()
-	d = nil
-	d.GetBody()
+func TestIssueImport_GetUpdatedAt(tt *testing.T) {
+	var zeroValue time.Time
+	i := &IssueImport{UpdatedAt: &zeroValue}
+	i.GetUpdatedAt()
+	i = &IssueImport{}
+	i.GetUpdatedAt()
+	i = nil
+	i.GetUpdatedAt()
 }
 
-func TestDiscussionComment_GetBodyHTML(tt *testing.T) {
+func TestIssueImportError_GetCode(tt *testing.T) {
 	var zeroValue string
-	d := &DiscussionComment{BodyHTML: &zeroValue}
-	d.GetBodyHTML()
-	d = &DiscussionComment{}
-	d.GetBodyHTML()
-	d = nil
-	d.GetBodyHTML()
+	i := &IssueImportError{Code: &zeroValue}
+	i.GetCode()
+	i = &IssueImportError{}
+	i.GetCode()
+	i = nil
+	i.GetCode()
 }
 
-func TestDiscussionComment_GetBodyVersion(tt *testing.T) {
+func TestIssueImportError_GetField(tt *testing.T) {
 	var zeroValue string
-	d := &DiscussionComment{BodyVersion: &zeroValue}
-	d.GetBodyVersion()
-	d = &DiscussionComment{}
-	d.GetBodyVersion()
-	d = nil
-	d.GetBodyVersion()
+	i := &IssueImportError{Field: &zeroValue}
+	i.GetField()
+	i = &IssueImportError{}
+	i.GetField()
+	i = nil
+	i.GetField()
 }
 
-func TestDiscussionComment_GetCreatedAt(tt *testing.T) {
-	var zeroValue Timestamp
-	d := &DiscussionComment{CreatedAt: &zeroValue}
-	d.GetCreatedAt()
-	d = &DiscussionComment{}
-	d.GetCreatedAt()
-	d = nil
-	d.GetCreatedAt()
+func TestIssueImportError_GetLocation(tt *testing.T) {
+	var zeroValue string
+	i := &IssueImportError{Location: &zeroValue}
+	i.GetLocation()
+	i = &IssueImportError{}
+	i.GetLocation()
+	i = nil
+	i.GetLocation()
 }
 
-func TestDiscussionComment_GetDiscussionURL(tt *testing.T) {
+func TestIssueImportError_GetResource(tt *testing.T) {
 	var zeroValue string
-	d := &DiscussionComment{DiscussionURL: &zeroValue}
-	d.GetDiscussionURL()
-	d = &DiscussionComment{}
-	d.GetDiscussionURL()
-	d = nil
-	d.GetDiscussionURL()
+	i := &IssueImportError{Resource: &zeroValue}
+	i.GetResource()
+	i = &IssueImportError{}
+	i.GetResource()
+	i = nil
+	i.GetResource()
 }
 
-func TestDiscussionComment_GetHTMLURL(tt *testing.T) {
+func TestIssueImportError_GetValue(tt *testing.T) {
 	var zeroValue string
-	d := &DiscussionComment{HTMLURL: &zeroValue}
-	d.GetHTMLURL()
-	d = &DiscussionComment{}
-	d.GetHTMLURL()
-	d = nil
-	d.GetHTMLURL()
+	i := &IssueImportError{Value: &zeroValue}
+	i.GetValue()
+	i = &IssueImportError{}
+	i.GetValue()
+	i = nil
+	i.GetValue()
 }
 
-func TestDiscussionComment_GetLastEditedAt(tt *testing.T) {
-	var zeroValue Timestamp
-	d := &DiscussionComment{LastEditedAt: &zeroValue}
-	d.GetLastEditedAt()
-	d = &DiscussionComment{}
-	d.GetLastEditedAt()
-	d = nil
-	d.GetLastEditedAt()
+func TestIssueImportResponse_GetCreatedAt(tt *testing.T) {
+	var zeroValue time.Time
+	i := &IssueImportResponse{CreatedAt: &zeroValue}
+	i.GetCreatedAt()
+	i = &IssueImportResponse{}
+	i.GetCreatedAt()
+	i = nil
+	i.GetCreatedAt()
 }
 
-func TestDiscussionComment_GetNodeID(tt *testing.T) {
+func TestIssueImportResponse_GetDocumentationURL(tt *testing.T) {
 	var zeroValue string
-	d := &DiscussionComment{NodeID: &zeroValue}
-	d.GetNodeID()
-	d = &DiscussionComment{}
-	d.GetNodeID()
-	d = nil
-	d.GetNodeID()
+	i := &IssueImportResponse{DocumentationURL: &zeroValue}
+	i.GetDocumentationURL()
+	i = &IssueImportResponse{}
+	i.GetDocumentationURL()
+	i = nil
+	i.GetDocumentationURL()
 }
 
-func TestDiscussionComment_GetNumber(tt *testing.T) {
+func TestIssueImportResponse_GetID(tt *testing.T) {
 	var zeroValue int
-	d := &DiscussionComment{Number: &zeroValue}
-	d.GetNumber()
-	d = &DiscussionComment{}
-	d.GetNumber()
-	d = nil
-	d.GetNumber()
+	i := &IssueImportResponse{ID: &zeroValue}
+	i.GetID()
+	i = &IssueImportResponse{}
+	i.GetID()
+	i = nil
+	i.GetID()
 }
 
-func TestDiscussionComment_GetReactions(tt *testing.T) {
-	d := &DiscussionComment{}
-	d.GetReactions()
-	d = nil
-	d.GetReactions()
+func TestIssueImportResponse_GetImportIssuesURL(tt *testing.T) {
+	var zeroValue string
+	i := &IssueImportResponse{ImportIssuesURL: &zeroValue}
+	i.GetImportIssuesURL()
+	i = &IssueImportResponse{}
+	i.GetImportIssuesURL()
+	i = nil
+	i.GetImportIssuesURL()
 }
 
-func TestDiscussionComment_GetUpdatedAt(tt *testing.T) {
-	var zeroValue Timestamp
-	d := &DiscussionComment{UpdatedAt: &zeroValue}
-	d.GetUpdatedAt()
-	d = &DiscussionComment{}
-	d.GetUpdatedAt()
-	d = nil
-	d.GetUpdatedAt()
+func TestIssueImportResponse_GetMessage(tt *testing.T) {
+	var zeroValue string
+	i := &IssueImportResponse{Message: &zeroValue}
+	i.GetMessage()
+	i = &IssueImportResponse{}
+	i.GetMessage()
+	i = nil
+	i.GetMessage()
 }
 
-func TestDiscussionComment_GetURL(tt *testing.T) {
+func TestIssueImportResponse_GetRepositoryURL(tt *testing.T) {
 	var zeroValue string
-	d := &DiscussionComment{URL: &zeroValue}
-	d.GetURL()
-	d = &DiscussionComment{}
-	d.GetURL()
-	d = nil
-	d.GetURL()
+	i := &IssueImportResponse{RepositoryURL: &zeroValue}
+	i.GetRepositoryURL()
+	i = &IssueImportResponse{}
+	i.GetRepositoryURL()
+	i = nil
+	i.GetRepositoryURL()
 }
 
-func TestDismissalRestrictionsRequest_GetTeams(tt *testing.T) {
-	var zeroValue []string
-	d := &DismissalRestrictionsRequest{Teams: &zeroValue}
-	d.GetTeams()
-	d = &DismissalRestrictionsRequest{}
-	d.GetTeams()
-	d = nil
-	d.GetTeams()
+func TestIssueImportResponse_GetStatus(tt *testing.T) {
+	var zeroValue string
+	i := &IssueImportResponse{Status: &zeroValue}
+	i.GetStatus()
+	i = &IssueImportResponse{}
+	i.GetStatus()
+	i = nil
+	i.GetStatus()
 }
 
-func TestDismissalRestrictionsRequest_GetUsers(tt *testing.T) {
-	var zeroValue []string
-	d := &DismissalRestrictionsRequest{Users: &zeroValue}
-	d.GetUsers()
-	d = &DismissalRestrictionsRequest{}
-	d.GetUsers()
-	d = nil
-	d.GetUsers()
+func TestIssueImportResponse_GetUpdatedAt(tt *testing.T) {
+	var zeroValue time.Time
+	i := &IssueImportResponse{UpdatedAt: &zeroValue}
+	i.GetUpdatedAt()
+	i = &IssueImportResponse{}
+	i.GetUpdatedAt()
+	i = nil
+	i.GetUpdatedAt()
 }
 
-func TestDismissedReview_GetDismissalCommitID(tt *testing.T) {
+func TestIssueImportResponse_GetURL(tt *testing.T) {
 	var zeroValue string
-	d := &DismissedReview{DismissalCommitID: &zeroValue}
-	d.GetDismissalCommitID()
-	d = &DismissedReview{}
-	d.GetDismissalCommitID()
-	d = nil
-	d.GetDismissalCommitID()
+	i := &IssueImportResponse{URL: &zeroValue}
+	i.GetURL()
+	i = &IssueImportResponse{}
+	i.GetURL()
+	i = nil
+	i.GetURL()
 }
 
-func TestDismissedReview_GetDismissalMessage(tt *testing.T) {
+func TestIssueListCommentsOptions_GetSince(tt *testing.T) {
+	var zeroValue time.Time
+	i := &IssueListCommentsOptions{Since: &zeroValue}
+	i.GetSince()
+	i = &IssueListCommentsOptions{}
+	i.GetSince()
+	i = nil
+	i.GetSince()
+}
+
+func TestIssueRequest_GetAssignee(tt *testing.T) {
 	var zeroValue string
-	d := &DismissedReview{DismissalMessage: &zeroValue}
-	d.GetDismissalMessage()
-	d = &DismissedReview{}
-	d.GetDismissalMessage()
-	d = nil
-	d.GetDismissalMessage()
+	i := &IssueRequest{Assignee: &zeroValue}
+	i.GetAssignee()
+	i = &IssueRequest{}
+	i.GetAssignee()
+	i = nil
+	i.GetAssignee()
 }
 
-func TestDismissedReview_GetReviewID(tt *testing.T) {
-	var zeroValue int64
-	d := &DismissedReview{ReviewID: &zeroValue}
-	d.GetReviewID()
-	d = &DismissedReview{}
-	d.GetReviewID()
-	d = nil
-	d.GetReviewID()
+func TestIssueRequest_GetAssignees(tt *testing.T) {
+	var zeroValue []string
+	i := &IssueRequest{Assignees: &zeroValue}
+	i.GetAssignees()
+	i = &IssueRequest{}
+	i.GetAssignees()
+	i = nil
+	i.GetAssignees()
 }
 
-func TestDismissedReview_GetState(tt *testing.T) {
+func TestIssueRequest_GetBody(tt *testing.T) {
 	var zeroValue string
-	d := &DismissedReview{State: &zeroValue}
-	d.GetState()
-	d = &DismissedReview{}
-	d.GetState()
-	d = nil
-	d.GetState()
+	i := &IssueRequest{Body: &zeroValue}
+	i.GetBody()
+	i = &IssueRequest{}
+	i.GetBody()
+	i = nil
+	i.GetBody()
 }
 
-func TestDispatchRequestOptions_GetClientPayload(tt *testing.T) {
-	var zeroValue json.RawMessage
-	d := &DispatchRequestOptions{ClientPayload: &zeroValue}
-	d.GetClientPayload()
-	d = &DispatchRequestOptions{}
-	d.GetClientPayload()
-	d = nil
-	d.GetClientPayload()
+func TestIssueRequest_GetLabels(tt *testing.T) {
+	var zeroValue []string
+	i := &IssueRequest{Labels: &zeroValue}
+	i.GetLabels()
+	i = &IssueRequest{}
+	i.GetLabels()
+	i = nil
+	i.GetLabels()
 }
 
-func TestDraftReviewComment_GetBody(tt *testing.T) {
+func TestIssueRequest_GetMilestone(tt *testing.T) {
+	var zeroValue int
+	i := &IssueRequest{Milestone: &zeroValue}
+	i.GetMilestone()
+	i = &IssueRequest{}
+	i.GetMilestone()
+	i = nil
+	i.GetMilestone()
+}
+
+func TestIssueRequest_GetState(tt *testing.T) {
 	var zeroValue string
-	d := &DraftReviewComment{Body: &zeroValue}
-	d.GetBody()
-	d = &DraftReviewComment{}
-	d.GetBody()
-	d = nil
-	d.GetBody()
+	i := &IssueRequest{State: &zeroValue}
+	i.GetState()
+	i = &IssueRequest{}
+	i.GetState()
+	i = nil
+	i.GetState()
 }
 
-func TestDraftReviewComment_GetLine(tt *testing.T) {
-	var zeroValue int
-	d := &DraftReviewComment{Line: &zeroValue}
-	d.GetLine()
-	d = &DraftReviewComment{}
-	d.GetLine()
-	d = nil
-	d.GetLine()
+func TestIssueRequest_GetTitle(tt *testing.T) {
+	var zeroValue string
+	i := &IssueRequest{Title: &zeroValue}
+	i.GetTitle()
+	i = &IssueRequest{}
+	i.GetTitle()
+	i = nil
+	i.GetTitle()
 }
 
-func TestDraftReviewComment_GetPath(tt *testing.T) {
+func TestIssuesEvent_GetAction(tt *testing.T) {
 	var zeroValue string
-	d := &DraftReviewComment{Path: &zeroValue}
-	d.GetPath()
-	d = &DraftReviewComment{}
-	d.GetPath()
-	d = nil
-	d.GetPath()
+	i := &IssuesEvent{Action: &zeroValue}
+	i.GetAction()
+	i = &IssuesEvent{}
+	i.GetAction()
+	i = nil
+	i.GetAction()
 }
 
-func TestDraftReviewComment_GetPosition(tt *testing.T) {
-	var zeroValue int
-	d := &DraftReviewComment{Position: &zeroValue}
-	d.GetPosition()
-	d = &DraftReviewComment{}
-	d.GetPosition()
-	d = nil
-	d.GetPosition()
+func TestIssuesEvent_GetAssignee(tt *testing.T) {
+	i := &IssuesEvent{}
+	i.GetAssignee()
+	i = nil
+	i.GetAssignee()
 }
 
-func TestDraftReviewComment_GetSide(tt *testing.T) {
-	var zeroValue string
-	d := &DraftReviewComment{Side: &zeroValue}
-	d.GetSide()
-	d = &DraftReviewComment{}
-	d.GetSide()
-	d = nil
-	d.GetSide()
+func TestIssuesEvent_GetChanges(tt *testing.T) {
+	i := &IssuesEvent{}
+	i.GetChanges()
+	i = nil
+	i.GetChanges()
 }
 
-func TestDraftReviewComment_GetStartLine(tt *testing.T) {
-	var zeroValue int
-	d := &DraftReviewComment{StartLine: &zeroValue}
-	d.GetStartLine()
-	d = &DraftReviewComment{}
-	d.GetStartLine()
-	d = nil
-	d.GetStartLine()
+func TestIssuesEvent_GetInstallation(tt *testing.T) {
+	i := &IssuesEvent{}
+	i.GetInstallation()
+	i = nil
+	i.GetInstallation()
 }
 
-func TestDraftReviewComment_GetStartSide(tt *testing.T) {
-	var zeroValue string
-	d := &DraftReviewComment{StartSide: &zeroValue}
-	d.GetStartSide()
-	d = &DraftReviewComment{}
-	d.GetStartSide()
-	d = nil
-	d.GetStartSide()
+func TestIssuesEvent_GetIssue(tt *testing.T) {
+	i := &IssuesEvent{}
+	i.GetIssue()
+	i = nil
+	i.GetIssue()
 }
 
-func TestEnterprise_GetAvatarURL(tt *testing.T) {
-	var zeroValue string
-	e := &Enterprise{AvatarURL: &zeroValue}
-	e.GetAvatarURL()
-	e = &Enterprise{}
-	e.GetAvatarURL()
-	e = nil
-	e.GetAvatarURL()
+func TestIssuesEvent_GetLabel(tt *testing.T) {
+	i := &IssuesEvent{}
+	i.GetLabel()
+	i = nil
+	i.GetLabel()
 }
 
-func TestEnterprise_GetCreatedAt(tt *testing.T) {
-	var zeroValue Timestamp
-	e := &Enterprise{CreatedAt: &zeroValue}
-	e.GetCreatedAt()
-	e = &Enterprise{}
-	e.GetCreatedAt()
-	e = nil
-	e.GetCreatedAt()
+func TestIssuesEvent_GetRepo(tt *testing.T) {
+	i := &IssuesEvent{}
+	i.GetRepo()
+	i = nil
+	i.GetRepo()
 }
 
-func TestEnterprise_GetDescription(tt *testing.T) {
-	var zeroValue string
-	e := &Enterprise{Description: &zeroValue}
-	e.GetDescription()
-	e = &Enterprise{}
-	e.GetDescription()
-	e = nil
-	e.GetDescription()
+func TestIssuesEvent_GetSender(tt *testing.T) {
+	i := &IssuesEvent{}
+	i.GetSender()
+	i = nil
+	i.GetSender()
 }
 
-func TestEnterprise_GetHTMLURL(tt *testing.T) {
-	var zeroValue string
-	e := &Enterprise{HTMLURL: &zeroValue}
-	e.GetHTMLURL()
-	e = &Enterprise{}
-	e.GetHTMLURL()
-	e = nil
-	e.GetHTMLURL()
+func TestIssuesSearchResult_GetIncompleteResults(tt *testing.T) {
+	var zeroValue bool
+	i := &IssuesSearchResult{IncompleteResults: &zeroValue}
+	i.GetIncompleteResults()
+	i = &IssuesSearchResult{}
+	i.GetIncompleteResults()
+	i = nil
+	i.GetIncompleteResults()
 }
 
-func TestEnterprise_GetID(tt *testing.T) {
+func TestIssuesSearchResult_GetTotal(tt *testing.T) {
 	var zeroValue int
-	e := &Enterprise{ID: &zeroValue}
-	e.GetID()
-	e = &Enterprise{}
-	e.GetID()
-	e = nil
-	e.GetID()
+	i := &IssuesSearchResult{Total: &zeroValue}
+	i.GetTotal()
+	i = &IssuesSearchResult{}
+	i.GetTotal()
+	i = nil
+	i.GetTotal()
 }
 
-func TestEnterprise_GetName(tt *testing.T) {
-	var zeroValue string
-	e := &Enterprise{Name: &zeroValue}
-	e.GetName()
-	e = &Enterprise{}
-	e.GetName()
-	e = nil
-	e.GetName()
+func TestIssueStats_GetClosedIssues(tt *testing.T) {
+	var zeroValue int
+	i := &IssueStats{ClosedIssues: &zeroValue}
+	i.GetClosedIssues()
+	i = &IssueStats{}
+	i.GetClosedIssues()
+	i = nil
+	i.GetClosedIssues()
 }
 
-func TestEnterprise_GetNodeID(tt *testing.T) {
-	var zeroValue string
-	e := &Enterprise{NodeID: &zeroValue}
-	e.GetNodeID()
-	e = &Enterprise{}
-	e.GetNodeID()
-	e = nil
-	e.GetNodeID()
+func TestIssueStats_GetOpenIssues(tt *testing.T) {
+	var zeroValue int
+	i := &IssueStats{OpenIssues: &zeroValue}
+	i.GetOpenIssues()
+	i = &IssueStats{}
+	i.GetOpenIssues()
+	i = nil
+	i.GetOpenIssues()
 }
 
-func TestEnterprise_GetSlug(tt *testing.T) {
-	var zeroValue string
-	e := &Enterprise{Slug: &zeroValue}
-	e.GetSlug()
-	e = &Enterprise{}
-	e.GetSlug()
-	e = nil
-	e.GetSlug()
+func TestIssueStats_GetTotalIssues(tt *testing.T) {
+	var zeroValue int
+	i := &IssueStats{TotalIssues: &zeroValue}
+	i.GetTotalIssues()
+	i = &IssueStats{}
+	i.GetTotalIssues()
+	i = nil
+	i.GetTotalIssues()
 }
 
-func TestEnterprise_GetUpdatedAt(tt *testing.T) {
+func TestJobs_GetTotalCount(tt *testing.T) {
+	var zeroValue int
+	j := &Jobs{TotalCount: &zeroValue}
+	j.GetTotalCount()
+	j = &Jobs{}
+	j.GetTotalCount()
+	j = nil
+	j.GetTotalCount()
+}
+
+func TestKey_GetCreatedAt(tt *testing.T) {
 	var zeroValue Timestamp
-	e := &Enterprise{UpdatedAt: &zeroValue}
-	e.GetUpdatedAt()
-	e = &Enterprise{}
-	e.GetUpdatedAt()
-	e = nil
-	e.GetUpdatedAt()
+	k := &Key{CreatedAt: &zeroValue}
+	k.GetCreatedAt()
+	k = &Key{}
+	k.GetCreatedAt()
+	k = nil
+	k.GetCreatedAt()
 }
 
-func TestEnterprise_GetWebsiteURL(tt *testing.T) {
+func TestKey_GetID(tt *testing.T) {
+	var zeroValue int64
+	k := &Key{ID: &zeroValue}
+	k.GetID()
+	k = &Key{}
+	k.GetID()
+	k = nil
+	k.GetID()
+}
+
+func TestKey_GetKey(tt *testing.T) {
 	var zeroValue string
-	e := &Enterprise{WebsiteURL: &zeroValue}
-	e.GetWebsiteURL()
-	e = &Enterprise{}
-	e.GetWebsiteURL()
-	e = nil
-	e.GetWebsiteURL()
+	k := &Key{Key: &zeroValue}
+	k.GetKey()
+	k = &Key{}
+	k.GetKey()
+	k = nil
+	k.GetKey()
 }
 
-func TestEvent_GetActor(tt *testing.T) {
-	e := &Event{}
-	e.GetActor()
-	e = nil
-	e.GetActor()
+func TestKey_GetReadOnly(tt *testing.T) {
+	var zeroValue bool
+	k := &Key{ReadOnly: &zeroValue}
+	k.GetReadOnly()
+	k = &Key{}
+	k.GetReadOnly()
+	k = nil
+	k.GetReadOnly()
 }
 
-func TestEvent_GetCreatedAt(tt *testing.T) {
-	var zeroValue time.Time
-	e := &Event{CreatedAt: &zeroValue}
-	e.GetCreatedAt()
-	e = &Event{}
-	e.GetCreatedAt()
-	e = nil
-	e.GetCreatedAt()
+func TestKey_GetTitle(tt *testing.T) {
+	var zeroValue string
+	k := &Key{Title: &zeroValue}
+	k.GetTitle()
+	k = &Key{}
+	k.GetTitle()
+	k = nil
+	k.GetTitle()
 }
 
-func TestEvent_GetID(tt *testing.T) {
+func TestKey_GetURL(tt *testing.T) {
 	var zeroValue string
-	e := &Event{ID: &zeroValue}
-	e.GetID()
-	e = &Event{}
-	e.GetID()
-	e = nil
-	e.GetID()
+	k := &Key{URL: &zeroValue}
+	k.GetURL()
+	k = &Key{}
+	k.GetURL()
+	k = nil
+	k.GetURL()
 }
 
-func TestEvent_GetOrg(tt *testing.T) {
-	e := &Event{}
-	e.GetOrg()
-	e = nil
-	e.GetOrg()
+func TestLabel_GetColor(tt *testing.T) {
+	var zeroValue string
+	l := &Label{Color: &zeroValue}
+	l.GetColor()
+	l = &Label{}
+	l.GetColor()
+	l = nil
+	l.GetColor()
 }
 
-func TestEvent_GetPublic(tt *testing.T) {
+func TestLabel_GetDefault(tt *testing.T) {
 	var zeroValue bool
-	e := &Event{Public: &zeroValue}
-	e.GetPublic()
-	e = &Event{}
-	e.GetPublic()
-	e = nil
-	e.GetPublic()
+	l := &Label{Default: &zeroValue}
+	l.GetDefault()
+	l = &Label{}
+	l.GetDefault()
+	l = nil
+	l.GetDefault()
 }
 
-func TestEvent_GetRawPayload(tt *testing.T) {
-	var zeroValue json.RawMessage
-	e := &Event{RawPayload: &zeroValue}
-	e.GetRawPayload()
-	e = &Event{}
-	e.GetRawPayload()
-	e = nil
-	e.GetRawPayload()
+func TestLabel_GetDescription(tt *testing.T) {
+	var zeroValue string
+	l := &Label{Description: &zeroValue}
+	l.GetDescription()
+	l = &Label{}
+	l.GetDescription()
+	l = nil
+	l.GetDescription()
 }
 
-func TestEvent_GetRepo(tt *testing.T) {
-	e := &Event{}
-	e.GetRepo()
-	e = nil
-	e.GetRepo()
+func TestLabel_GetID(tt *testing.T) {
+	var zeroValue int64
+	l := &Label{ID: &zeroValue}
+	l.GetID()
+	l = &Label{}
+	l.GetID()
+	l = nil
+	l.GetID()
+}
+
+func TestLabel_GetName(tt *testing.T) {
+	var zeroValue string
+	l := &Label{Name: &zeroValue}
+	l.GetName()
+	l = &Label{}
+	l.GetName()
+	l = nil
+	l.GetName()
 }
 
-func TestEvent_GetType(tt *testing.T) {
+func TestLabel_GetNodeID(tt *testing.T) {
 	var zeroValue string
-	e := &Event{Type: &zeroValue}
-	e.GetType()
-	e = &Event{}
-	e.GetType()
-	e = nil
-	e.GetType()
+	l := &Label{NodeID: &zeroValue}
+	l.GetNodeID()
+	l = &Label{}
+	l.GetNodeID()
+	l = nil
+	l.GetNodeID()
 }
 
-func TestFeedLink_GetHRef(tt *testing.T) {
+func TestLabel_GetURL(tt *testing.T) {
 	var zeroValue string
-	f := &FeedLink{HRef: &zeroValue}
-	f.GetHRef()
-	f = &FeedLink{}
-	f.GetHRef()
-	f = nil
-	f.GetHRef()
+	l := &Label{URL: &zeroValue}
+	l.GetURL()
+	l = &Label{}
+	l.GetURL()
+	l = nil
+	l.GetURL()
 }
 
-func TestFeedLink_GetType(tt *testing.T) {
+func TestLabelEvent_GetAction(tt *testing.T) {
 	var zeroValue string
-	f := &FeedLink{Type: &zeroValue}
-	f.GetType()
-	f = &FeedLink{}
-	f.GetType()
-	f = nil
-	f.GetType()
+	l := &LabelEvent{Action: &zeroValue}
+	l.GetAction()
+	l = &LabelEvent{}
+	l.GetAction()
+	l = nil
+	l.GetAction()
 }
 
-func TestFeeds_GetCurrentUserActorURL(tt *testing.T) {
-	var zeroValue string
-	f := &Feeds{CurrentUserActorURL: &zeroValue}
-	f.GetCurrentUserActorURL()
-	f = &Feeds{}
-	f.GetCurrentUserActorURL()
-	f = nil
-	f.GetCurrentUserActorURL()
+func TestLabelEvent_GetChanges(tt *testing.T) {
+	l := &LabelEvent{}
+	l.GetChanges()
+	l = nil
+	l.GetChanges()
 }
 
-func TestFeeds_GetCurrentUserOrganizationURL(tt *testing.T) {
-	var zeroValue string
-	f := &Feeds{CurrentUserOrganizationURL: &zeroValue}
-	f.GetCurrentUserOrganizationURL()
-	f = &Feeds{}
-	f.GetCurrentUserOrganizationURL()
-	f = nil
-	f.GetCurrentUserOrganizationURL()
+func TestLabelEvent_GetInstallation(tt *testing.T) {
+	l := &LabelEvent{}
+	l.GetInstallation()
+	l = nil
+	l.GetInstallation()
 }
 
-func TestFeeds_GetCurrentUserPublicURL(tt *testing.T) {
-	var zeroValue string
-	f := &Feeds{CurrentUserPublicURL: &zeroValue}
-	f.GetCurrentUserPublicURL()
-	f = &Feeds{}
-	f.GetCurrentUserPublicURL()
-	f = nil
-	f.GetCurrentUserPublicURL()
+func TestLabelEvent_GetLabel(tt *testing.T) {
+	l := &LabelEvent{}
+	l.GetLabel()
+	l = nil
+	l.GetLabel()
 }
 
-func TestFeeds_GetCurrentUserURL(tt *testing.T) {
-	var zeroValue string
-	f := &Feeds{CurrentUserURL: &zeroValue}
-	f.GetCurrentUserURL()
-	f = &Feeds{}
-	f.GetCurrentUserURL()
-	f = nil
-	f.GetCurrentUserURL()
+func TestLabelEvent_GetOrg(tt *testing.T) {
+	l := &LabelEvent{}
+	l.GetOrg()
+	l = nil
+	l.GetOrg()
 }
 
-func TestFeeds_GetTimelineURL(tt *testing.T) {
-	var zeroValue string
-	f := &Feeds{TimelineURL: &zeroValue}
-	f.GetTimelineURL()
-	f = &Feeds{}
-	f.GetTimelineURL()
-	f = nil
-	f.GetTimelineURL()
+func TestLabelEvent_GetRepo(tt *testing.T) {
+	l := &LabelEvent{}
+	l.GetRepo()
+	l = nil
+	l.GetRepo()
 }
 
-func TestFeeds_GetUserURL(tt *testing.T) {
+func TestLabelResult_GetColor(tt *testing.T) {
 	var zeroValue string
-	f := &Feeds{UserURL: &zeroValue}
-	f.GetUserURL()
-	f = &Feeds{}
-	f.GetUserURL()
-	f = nil
-	f.GetUserURL()
+	l := &LabelResult{Color: &zeroValue}
+	l.GetColor()
+	l = &LabelResult{}
+	l.GetColor()
+	l = nil
+	l.GetColor()
 }
 
-func TestForkEvent_GetForkee(tt *testing.T) {
-	f := &ForkEvent{}
-	f.GetForkee()
-	f = nil
-	f.GetForkee()
+func TestLabelResult_GetDefault(tt *testing.T) {
+	var zeroValue bool
+	l := &LabelResult{Default: &zeroValue}
+	l.GetDefault()
+	l = &LabelResult{}
+	l.GetDefault()
+	l = nil
+	l.GetDefault()
 }
 
-func TestForkEvent_GetInstallation(tt *testing.T) {
-	f := &ForkEvent{}
-	f.GetInstallation()
-	f = nil
-	f.GetInstallation()
+func TestLabelResult_GetDescription(tt *testing.T) {
+	var zeroValue string
+	l := &LabelResult{Description: &zeroValue}
+	l.GetDescription()
+	l = &LabelResult{}
+	l.GetDescription()
+	l = nil
+	l.GetDescription()
 }
 
-func TestForkEvent_GetRepo(tt *testing.T) {
-	f := &ForkEvent{}
-	f.GetRepo()
-	f = nil
-	f.GetRepo()
+func TestLabelResult_GetID(tt *testing.T) {
+	var zeroValue int64
+	l := &LabelResult{ID: &zeroValue}
+	l.GetID()
+	l = &LabelResult{}
+	l.GetID()
+	l = nil
+	l.GetID()
 }
 
-func TestForkEvent_GetSender(tt *testing.T) {
-	f := &ForkEvent{}
-	f.GetSender()
-	f = nil
-	f.GetSender()
+func TestLabelResult_GetName(tt *testing.T) {
+	var zeroValue string
+	l := &LabelResult{Name: &zeroValue}
+	l.GetName()
+	l = &LabelResult{}
+	l.GetName()
+	l = nil
+	l.GetName()
 }
 
-func TestGist_GetComments(tt *testing.T) {
-	var zeroValue int
-	g := &Gist{Comments: &zeroValue}
-	g.GetComments()
-	g = &Gist{}
-	g.GetComments()
-	g = nil
-	g.GetComments()
+func TestLabelResult_GetNodeID(tt *testing.T) {
+	var zeroValue string
+	l := &LabelResult{NodeID: &zeroValue}
+	l.GetNodeID()
+	l = &LabelResult{}
+	l.GetNodeID()
+	l = nil
+	l.GetNodeID()
 }
 
-func TestGist_GetCreatedAt(tt *testing.T) {
-	var zeroValue time.Time
-	g := &Gist{CreatedAt: &zeroValue}
-	g.GetCreatedAt()
-	g = &Gist{}
-	g.GetCreatedAt()
-	g = nil
-	g.GetCreatedAt()
+func TestLabelResult_GetScore(tt *testing.T) {
+	l := &LabelResult{}
+	l.GetScore()
+	l = nil
+	l.GetScore()
 }
 
-func TestGist_GetDescription(tt *testing.T) {
+func TestLabelResult_GetURL(tt *testing.T) {
 	var zeroValue string
-	g := &Gist{Description: &zeroValue}
-	g.GetDescription()
-	g = &Gist{}
-	g.GetDescription()
-	g = nil
-	g.GetDescription()
+	l := &LabelResult{URL: &zeroValue}
+	l.GetURL()
+	l = &LabelResult{}
+	l.GetURL()
+	l = nil
+	l.GetURL()
 }
 
-func TestGist_GetGitPullURL(tt *testing.T) {
-	var zeroValue string
-	g := &Gist{GitPullURL: &zeroValue}
-	g.GetGitPullURL()
-	g = &Gist{}
-	g.GetGitPullURL()
-	g = nil
-	g.GetGitPullURL()
+func TestLabelsSearchResult_GetIncompleteResults(tt *testing.T) {
+	var zeroValue bool
+	l := &LabelsSearchResult{IncompleteResults: &zeroValue}
+	l.GetIncompleteResults()
+	l = &LabelsSearchResult{}
+	l.GetIncompleteResults()
+	l = nil
+	l.GetIncompleteResults()
 }
 
-func TestGist_GetGitPushURL(tt *testing.T) {
+func TestLabelsSearchResult_GetTotal(tt *testing.T) {
+	var zeroValue int
+	l := &LabelsSearchResult{Total: &zeroValue}
+	l.GetTotal()
+	l = &LabelsSearchResult{}
+	l.GetTotal()
+	l = nil
+	l.GetTotal()
+}
+
+func TestLargeFile_GetOID(tt *testing.T) {
 	var zeroValue string
-	g := &Gist{GitPushURL: &zeroValue}
-	g.GetGitPushURL()
-	g = &Gist{}
-	g.GetGitPushURL()
-	g = nil
-	g.GetGitPushURL()
+	l := &LargeFile{OID: &zeroValue}
+	l.GetOID()
+	l = &LargeFile{}
+	l.GetOID()
+	l = nil
+	l.GetOID()
 }
 
-func TestGist_GetHTMLURL(tt *testing.T) {
+func TestLargeFile_GetPath(tt *testing.T) {
 	var zeroValue string
-	g := &Gist{HTMLURL: &zeroValue}
-	g.GetHTMLURL()
-	g = &Gist{}
-	g.GetHTMLURL()
-	g = nil
-	g.GetHTMLURL()
+	l := &LargeFile{Path: &zeroValue}
+	l.GetPath()
+	l = &LargeFile{}
+	l.GetPath()
+	l = nil
+	l.GetPath()
 }
 
-func TestGist_GetID(tt *testing.T) {
+func TestLargeFile_GetRefName(tt *testing.T) {
 	var zeroValue string
-	g := &Gist{ID: &zeroValue}
-	g.GetID()
-	g = &Gist{}
-	g.GetID()
-	g = nil
-	g.GetID()
+	l := &LargeFile{RefName: &zeroValue}
+	l.GetRefName()
+	l = &LargeFile{}
+	l.GetRefName()
+	l = nil
+	l.GetRefName()
 }
 
-func TestGist_GetNodeID(tt *testing.T) {
+func TestLargeFile_GetSize(tt *testing.T) {
+	var zeroValue int
+	l := &LargeFile{Size: &zeroValue}
+	l.GetSize()
+	l = &LargeFile{}
+	l.GetSize()
+	l = nil
+	l.GetSize()
+}
+
+func TestLFSLock_GetID(tt *testing.T) {
 	var zeroValue string
-	g := &Gist{NodeID: &zeroValue}
-	g.GetNodeID()
-	g = &Gist{}
-	g.GetNodeID()
-	g = nil
-	g.GetNodeID()
+	l := &LFSLock{ID: &zeroValue}
+	l.GetID()
+	l = &LFSLock{}
+	l.GetID()
+	l = nil
+	l.GetID()
 }
 
-func TestGist_GetOwner(tt *testing.T) {
-	g := &Gist{}
-	g.GetOwner()
-	g = nil
-	g.GetOwner()
+func TestLFSLock_GetLockedAt(tt *testing.T) {
+	var zeroValue Timestamp
+	l := &LFSLock{LockedAt: &zeroValue}
+	l.GetLockedAt()
+	l = &LFSLock{}
+	l.GetLockedAt()
+	l = nil
+	l.GetLockedAt()
 }
 
-func TestGist_GetPublic(tt *testing.T) {
-	var zeroValue bool
-	g := &Gist{Public: &zeroValue}
-	g.GetPublic()
-	g = &Gist{}
-	g.GetPublic()
-	g = nil
-	g.GetPublic()
+func TestLFSLock_GetOwner(tt *testing.T) {
+	l := &LFSLock{}
+	l.GetOwner()
+	l = nil
+	l.GetOwner()
 }
 
-func TestGist_GetUpdatedAt(tt *testing.T) {
-	var zeroValue time.Time
-	g := &Gist{UpdatedAt: &zeroValue}
-	g.GetUpdatedAt()
-	g = &Gist{}
-	g.GetUpdatedAt()
-	g = nil
-	g.GetUpdatedAt()
+func TestLFSLock_GetPath(tt *testing.T) {
+	var zeroValue string
+	l := &LFSLock{Path: &zeroValue}
+	l.GetPath()
+	l = &LFSLock{}
+	l.GetPath()
+	l = nil
+	l.GetPath()
 }
 
-func TestGistComment_GetBody(tt *testing.T) {
+func TestLFSLockOwner_GetName(tt *testing.T) {
 	var zeroValue string
-	g := &GistComment{Body: &zeroValue}
-	g.GetBody()
-	g = &GistComment{}
-	g.GetBody()
-	g = nil
-	g.GetBody()
+	l := &LFSLockOwner{Name: &zeroValue}
+	l.GetName()
+	l = &LFSLockOwner{}
+	l.GetName()
+	l = nil
+	l.GetName()
 }
 
-func TestGistComment_GetCreatedAt(tt *testing.T) {
-	var zeroValue time.Time
-	g := &GistComment{CreatedAt: &zeroValue}
-	g.GetCreatedAt()
-	g = &GistComment{}
-	g.GetCreatedAt()
-	g = nil
-	g.GetCreatedAt()
+func TestLFSLocks_GetNextCursor(tt *testing.T) {
+	var zeroValue string
+	l := &LFSLocks{NextCursor: &zeroValue}
+	l.GetNextCursor()
+	l = &LFSLocks{}
+	l.GetNextCursor()
+	l = nil
+	l.GetNextCursor()
 }
 
-func TestGistComment_GetID(tt *testing.T) {
-	var zeroValue int64
-	g := &GistComment{ID: &zeroValue}
-	g.GetID()
-	g = &GistComment{}
-	g.GetID()
-	g = nil
-	g.GetID()
+func TestLFSLocksVerification_GetNextCursor(tt *testing.T) {
+	var zeroValue string
+	l := &LFSLocksVerification{NextCursor: &zeroValue}
+	l.GetNextCursor()
+	l = &LFSLocksVerification{}
+	l.GetNextCursor()
+	l = nil
+	l.GetNextCursor()
 }
 
-func TestGistComment_GetURL(tt *testing.T) {
+func TestLicense_GetBody(tt *testing.T) {
 	var zeroValue string
-	g := &GistComment{URL: &zeroValue}
-	g.GetURL()
-	g = &GistComment{}
-	g.GetURL()
-	g = nil
-	g.GetURL()
+	l := &License{Body: &zeroValue}
+	l.GetBody()
+	l = &License{}
+	l.GetBody()
+	l = nil
+	l.GetBody()
 }
 
-func TestGistComment_GetUser(tt *testing.T) {
-	g := &GistComment{}
-	g.GetUser()
-	g = nil
-	g.GetUser()
+func TestLicense_GetConditions(tt *testing.T) {
+	var zeroValue []string
+	l := &License{Conditions: &zeroValue}
+	l.GetConditions()
+	l = &License{}
+	l.GetConditions()
+	l = nil
+	l.GetConditions()
 }
 
-func TestGistCommit_GetChangeStatus(tt *testing.T) {
-	g := &GistCommit{}
-	g.GetChangeStatus()
-	g = nil
-	g.GetChangeStatus()
+func TestLicense_GetDescription(tt *testing.T) {
+	var zeroValue string
+	l := &License{Description: &zeroValue}
+	l.GetDescription()
+	l = &License{}
+	l.GetDescription()
+	l = nil
+	l.GetDescription()
 }
 
-func TestGistCommit_GetCommittedAt(tt *testing.T) {
-	var zeroValue Timestamp
-	g := &GistCommit{CommittedAt: &zeroValue}
-	g.GetCommittedAt()
-	g = &GistCommit{}
-	g.GetCommittedAt()
-	g = nil
-	g.GetCommittedAt()
+func TestLicense_GetFeatured(tt *testing.T) {
+	var zeroValue bool
+	l := &License{Featured: &zeroValue}
+	l.GetFeatured()
+	l = &License{}
+	l.GetFeatured()
+	l = nil
+	l.GetFeatured()
 }
 
-func TestGistCommit_GetNodeID(tt *testing.T) {
+func TestLicense_GetHTMLURL(tt *testing.T) {
 	var zeroValue string
-	g := &GistCommit{NodeID: &zeroValue}
-	g.GetNodeID()
-	g = &GistCommit{}
-	g.GetNodeID()
-	g = nil
-	g.GetNodeID()
+	l := &License{HTMLURL: &zeroValue}
+	l.GetHTMLURL()
+	l = &License{}
+	l.GetHTMLURL()
+	l = nil
+	l.GetHTMLURL()
 }
 
-func TestGistCommit_GetURL(tt *testing.T) {
+func TestLicense_GetImplementation(tt *testing.T) {
 	var zeroValue string
-	g := &GistCommit{URL: &zeroValue}
-	g.GetURL()
-	g = &GistCommit{}
-	g.GetURL()
-	g = nil
-	g.GetURL()
+	l := &License{Implementation: &zeroValue}
+	l.GetImplementation()
+	l = &License{}
+	l.GetImplementation()
+	l = nil
+	l.GetImplementation()
 }
 
-func TestGistCommit_GetUser(tt *testing.T) {
-	g := &GistCommit{}
-	g.GetUser()
-	g = nil
-	g.GetUser()
+func TestLicense_GetKey(tt *testing.T) {
+	var zeroValue string
+	l := &License{Key: &zeroValue}
+	l.GetKey()
+	l = &License{}
+	l.GetKey()
+	l = nil
+	l.GetKey()
 }
 
-func TestGistCommit_GetVersion(tt *testing.T) {
+func TestLicense_GetLimitations(tt *testing.T) {
+	var zeroValue []string
+	l := &License{Limitations: &zeroValue}
+	l.GetLimitations()
+	l = &License{}
+	l.GetLimitations()
+	l = nil
+	l.GetLimitations()
+}
+
+func TestLicense_GetName(tt *testing.T) {
 	var zeroValue string
-	g := &GistCommit{Version: &zeroValue}
-	g.GetVersion()
-	g = &GistCommit{}
-	g.GetVersion()
-	g = nil
-	g.GetVersion()
+	l := &License{Name: &zeroValue}
+	l.GetName()
+	l = &License{}
+	l.GetName()
+	l = nil
+	l.GetName()
 }
 
-func TestGistFile_GetContent(tt *testing.T) {
+func TestLicense_GetPermissions(tt *testing.T) {
+	var zeroValue []string
+	l := &License{Permissions: &zeroValue}
+	l.GetPermissions()
+	l = &License{}
+	l.GetPermissions()
+	l = nil
+	l.GetPermissions()
+}
+
+func TestLicense_GetSPDXID(tt *testing.T) {
 	var zeroValue string
-	g := &GistFile{Content: &zeroValue}
-	g.GetContent()
-	g = &GistFile{}
-	g.GetContent()
-	g = nil
-	g.GetContent()
+	l := &License{SPDXID: &zeroValue}
+	l.GetSPDXID()
+	l = &License{}
+	l.GetSPDXID()
+	l = nil
+	l.GetSPDXID()
 }
 
-func TestGistFile_GetFilename(tt *testing.T) {
+func TestLicense_GetURL(tt *testing.T) {
 	var zeroValue string
-	g := &GistFile{Filename: &zeroValue}
-	g.GetFilename()
-	g = &GistFile{}
-	g.GetFilename()
-	g = nil
-	g.GetFilename()
+	l := &License{URL: &zeroValue}
+	l.GetURL()
+	l = &License{}
+	l.GetURL()
+	l = nil
+	l.GetURL()
 }
 
-func TestGistFile_GetLanguage(tt *testing.T) {
+func TestListCheckRunsOptions_GetCheckName(tt *testing.T) {
 	var zeroValue string
-	g := &GistFile{Language: &zeroValue}
-	g.GetLanguage()
-	g = &GistFile{}
-	g.GetLanguage()
-	g = nil
-	g.GetLanguage()
+	l := &ListCheckRunsOptions{CheckName: &zeroValue}
+	l.GetCheckName()
+	l = &ListCheckRunsOptions{}
+	l.GetCheckName()
+	l = nil
+	l.GetCheckName()
 }
 
-func TestGistFile_GetRawURL(tt *testing.T) {
+func TestListCheckRunsOptions_GetFilter(tt *testing.T) {
 	var zeroValue string
-	g := &GistFile{RawURL: &zeroValue}
-	g.GetRawURL()
-	g = &GistFile{}
-	g.GetRawURL()
-	g = nil
-	g.GetRawURL()
-}
-
-func TestGistFile_GetSize(tt *testing.T) {
-	var zeroValue int
-	g := &GistFile{Size: &zeroValue}
-	g.GetSize()
-	g = &GistFile{}
-	g.GetSize()
-	g = nil
-	g.GetSize()
+	l := &ListCheckRunsOptions{Filter: &zeroValue}
+	l.GetFilter()
+	l = &ListCheckRunsOptions{}
+	l.GetFilter()
+	l = nil
+	l.GetFilter()
 }
 
-func TestGistFile_GetType(tt *testing.T) {
+func TestListCheckRunsOptions_GetStatus(tt *testing.T) {
 	var zeroValue string
-	g := &GistFile{Type: &zeroValue}
-	g.GetType()
-	g = &GistFile{}
-	g.GetType()
-	g = nil
-	g.GetType()
+	l := &ListCheckRunsOptions{Status: &zeroValue}
+	l.GetStatus()
+	l = &ListCheckRunsOptions{}
+	l.GetStatus()
+	l = nil
+	l.GetStatus()
 }
 
-func TestGistFork_GetCreatedAt(tt *testing.T) {
-	var zeroValue Timestamp
-	g := &GistFork{CreatedAt: &zeroValue}
-	g.GetCreatedAt()
-	g = &GistFork{}
-	g.GetCreatedAt()
-	g = nil
-	g.GetCreatedAt()
+func TestListCheckRunsResults_GetTotal(tt *testing.T) {
+	var zeroValue int
+	l := &ListCheckRunsResults{Total: &zeroValue}
+	l.GetTotal()
+	l = &ListCheckRunsResults{}
+	l.GetTotal()
+	l = nil
+	l.GetTotal()
 }
 
-func TestGistFork_GetID(tt *testing.T) {
-	var zeroValue string
-	g := &GistFork{ID: &zeroValue}
-	g.GetID()
-	g = &GistFork{}
-	g.GetID()
-	g = nil
-	g.GetID()
+func TestListCheckSuiteOptions_GetAppID(tt *testing.T) {
+	var zeroValue int
+	l := &ListCheckSuiteOptions{AppID: &zeroValue}
+	l.GetAppID()
+	l = &ListCheckSuiteOptions{}
+	l.GetAppID()
+	l = nil
+	l.GetAppID()
 }
 
-func TestGistFork_GetNodeID(tt *testing.T) {
+func TestListCheckSuiteOptions_GetCheckName(tt *testing.T) {
 	var zeroValue string
-	g := &GistFork{NodeID: &zeroValue}
-	g.GetNodeID()
-	g = &GistFork{}
-	g.GetNodeID()
-	g = nil
-	g.GetNodeID()
+	l := &ListCheckSuiteOptions{CheckName: &zeroValue}
+	l.GetCheckName()
+	l = &ListCheckSuiteOptions{}
+	l.GetCheckName()
+	l = nil
+	l.GetCheckName()
 }
 
-func TestGistFork_GetUpdatedAt(tt *testing.T) {
-	var zeroValue Timestamp
-	g := &GistFork{UpdatedAt: &zeroValue}
-	g.GetUpdatedAt()
-	g = &GistFork{}
-	g.GetUpdatedAt()
-	g = nil
-	g.GetUpdatedAt()
+func TestListCheckSuiteResults_GetTotal(tt *testing.T) {
+	var zeroValue int
+	l := &ListCheckSuiteResults{Total: &zeroValue}
+	l.GetTotal()
+	l = &ListCheckSuiteResults{}
+	l.GetTotal()
+	l = nil
+	l.GetTotal()
 }
 
-func TestGistFork_GetURL(tt *testing.T) {
+func TestListCollaboratorOptions_GetAffiliation(tt *testing.T) {
 	var zeroValue string
-	g := &GistFork{URL: &zeroValue}
-	g.GetURL()
-	g = &GistFork{}
-	g.GetURL()
-	g = nil
-	g.GetURL()
+	l := &ListCollaboratorOptions{Affiliation: &zeroValue}
+	l.GetAffiliation()
+	l = &ListCollaboratorOptions{}
+	l.GetAffiliation()
+	l = nil
+	l.GetAffiliation()
 }
 
-func TestGistFork_GetUser(tt *testing.T) {
-	g := &GistFork{}
-	g.GetUser()
-	g = nil
-	g.GetUser()
+func TestListCopilotSeatsResponse_GetTotalSeats(tt *testing.T) {
+	var zeroValue int64
+	l := &ListCopilotSeatsResponse{TotalSeats: &zeroValue}
+	l.GetTotalSeats()
+	l = &ListCopilotSeatsResponse{}
+	l.GetTotalSeats()
+	l = nil
+	l.GetTotalSeats()
 }
 
-func TestGistStats_GetPrivateGists(tt *testing.T) {
+func TestListCustomDeploymentRuleIntegrationsResponse_GetTotalCount(tt *testing.T) {
 	var zeroValue int
-	g := &GistStats{PrivateGists: &zeroValue}
-	g.GetPrivateGists()
-	g = &GistStats{}
-	g.GetPrivateGists()
-	g = nil
-	g.GetPrivateGists()
+	l := &ListCustomDeploymentRuleIntegrationsResponse{TotalCount: &zeroValue}
+	l.GetTotalCount()
+	l = &ListCustomDeploymentRuleIntegrationsResponse{}
+	l.GetTotalCount()
+	l = nil
+	l.GetTotalCount()
 }
 
-func TestGistStats_GetPublicGists(tt *testing.T) {
+func TestListDeploymentProtectionRuleResponse_GetTotalCount(tt *testing.T) {
 	var zeroValue int
-	g := &GistStats{PublicGists: &zeroValue}
-	g.GetPublicGists()
-	g = &GistStats{}
-	g.GetPublicGists()
-	g = nil
-	g.GetPublicGists()
+	l := &ListDeploymentProtectionRuleResponse{TotalCount: &zeroValue}
+	l.GetTotalCount()
+	l = &ListDeploymentProtectionRuleResponse{}
+	l.GetTotalCount()
+	l = nil
+	l.GetTotalCount()
 }
 
-func TestGistStats_GetTotalGists(tt *testing.T) {
-	var zeroValue int
-	g := &GistStats{TotalGists: &zeroValue}
-	g.GetTotalGists()
-	g = &GistStats{}
-	g.GetTotalGists()
-	g = nil
-	g.GetTotalGists()
+func TestListGlobalSecurityAdvisoriesOptions_GetIsWithdrawn(tt *testing.T) {
+	var zeroValue bool
+	l := &ListGlobalSecurityAdvisoriesOptions{IsWithdrawn: &zeroValue}
+	l.GetIsWithdrawn()
+	l = &ListGlobalSecurityAdvisoriesOptions{}
+	l.GetIsWithdrawn()
+	l = nil
+	l.GetIsWithdrawn()
 }
 
-func TestGitHubAppAuthorizationEvent_GetAction(tt *testing.T) {
-	var zeroValue string
-	g := &GitHubAppAuthorizationEvent{Action: &zeroValue}
-	g.GetAction()
-	g = &GitHubAppAuthorizationEvent{}
-	g.GetAction()
-	g = nil
-	g.GetAction()
+func TestMarketplacePendingChange_GetEffectiveDate(tt *testing.T) {
+	var zeroValue Timestamp
+	m := &MarketplacePendingChange{EffectiveDate: &zeroValue}
+	m.GetEffectiveDate()
+	m = &MarketplacePendingChange{}
+	m.GetEffectiveDate()
+	m = nil
+	m.GetEffectiveDate()
 }
 
-func TestGitHubAppAuthorizationEvent_GetSender(tt *testing.T) {
-	g := &GitHubAppAuthorizationEvent{}
-	g.GetSender()
-	g = nil
-	g.GetSender()
+func TestMarketplacePendingChange_GetID(tt *testing.T) {
+	var zeroValue int64
+	m := &MarketplacePendingChange{ID: &zeroValue}
+	m.GetID()
+	m = &MarketplacePendingChange{}
+	m.GetID()
+	m = nil
+	m.GetID()
 }
 
-func TestGitignore_GetName(tt *testing.T) {
-	var zeroValue string
-	g := &Gitignore{Name: &zeroValue}
-	g.GetName()
-	g = &Gitignore{}
-	g.GetName()
-	g = nil
-	g.GetName()
+func TestMarketplacePendingChange_GetPlan(tt *testing.T) {
+	m := &MarketplacePendingChange{}
+	m.GetPlan()
+	m = nil
+	m.GetPlan()
 }
 
-func TestGitignore_GetSource(tt *testing.T) {
-	var zeroValue string
-	g := &Gitignore{Source: &zeroValue}
-	g.GetSource()
-	g = &Gitignore{}
-	g.GetSource()
-	g = nil
-	g.GetSource()
+func TestMarketplacePendingChange_GetUnitCount(tt *testing.T) {
+	var zeroValue int
+	m := &MarketplacePendingChange{UnitCount: &zeroValue}
+	m.GetUnitCount()
+	m = &MarketplacePendingChange{}
+	m.GetUnitCount()
+	m = nil
+	m.GetUnitCount()
 }
 
-func TestGitObject_GetSHA(tt *testing.T) {
+func TestMarketplacePlan_GetAccountsURL(tt *testing.T) {
 	var zeroValue string
-	g := &GitObject{SHA: &zeroValue}
-	g.GetSHA()
-	g = &GitObject{}
-	g.GetSHA()
-	g = nil
-	g.GetSHA()
+	m := &MarketplacePlan{AccountsURL: &zeroValue}
+	m.GetAccountsURL()
+	m = &MarketplacePlan{}
+	m.GetAccountsURL()
+	m = nil
+	m.GetAccountsURL()
 }
 
-func TestGitObject_GetType(tt *testing.T) {
-	var zeroValue string
-	g := &GitObject{Type: &zeroValue}
-	g.GetType()
-	g = &GitObject{}
-	g.GetType()
-	g = nil
-	g.GetType()
+func TestMarketplacePlan_GetBullets(tt *testing.T) {
+	var zeroValue []string
+	m := &MarketplacePlan{Bullets: &zeroValue}
+	m.GetBullets()
+	m = &MarketplacePlan{}
+	m.GetBullets()
+	m = nil
+	m.GetBullets()
 }
 
-func TestGitObject_GetURL(tt *testing.T) {
+func TestMarketplacePlan_GetDescription(tt *testing.T) {
 	var zeroValue string
-	g := &GitObject{URL: &zeroValue}
-	g.GetURL()
-	g = &GitObject{}
-	g.GetURL()
-	g = nil
-	g.GetURL()
+	m := &MarketplacePlan{Description: &zeroValue}
+	m.GetDescription()
+	m = &MarketplacePlan{}
+	m.GetDescription()
+	m = nil
+	m.GetDescription()
 }
 
-func TestGollumEvent_GetInstallation(tt *testing.T) {
-	g := &GollumEvent{}
-	g.GetInstallation()
-	g = nil
-	g.GetInstallation()
+func TestMarketplacePlan_GetHasFreeTrial(tt *testing.T) {
+	var zeroValue bool
+	m := &MarketplacePlan{HasFreeTrial: &zeroValue}
+	m.GetHasFreeTrial()
+	m = &MarketplacePlan{}
+	m.GetHasFreeTrial()
+	m = nil
+	m.GetHasFreeTrial()
 }
 
-func TestGollumEvent_GetRepo(tt *testing.T) {
-	g := &GollumEvent{}
-	g.GetRepo()
-	g = nil
-	g.GetRepo()
+func TestMarketplacePlan_GetID(tt *testing.T) {
+	var zeroValue int64
+	m := &MarketplacePlan{ID: &zeroValue}
+	m.GetID()
+	m = &MarketplacePlan{}
+	m.GetID()
+	m = nil
+	m.GetID()
 }
 
-func TestGollumEvent_GetSender(tt *testing.T) {
-	g := &GollumEvent{}
-	g.GetSender()
-	g = nil
-	g.GetSender()
+func TestMarketplacePlan_GetMonthlyPriceInCents(tt *testing.T) {
+	var zeroValue int
+	m := &MarketplacePlan{MonthlyPriceInCents: &zeroValue}
+	m.GetMonthlyPriceInCents()
+	m = &MarketplacePlan{}
+	m.GetMonthlyPriceInCents()
+	m = nil
+	m.GetMonthlyPriceInCents()
 }
 
-func TestGPGEmail_GetEmail(tt *testing.T) {
+func TestMarketplacePlan_GetName(tt *testing.T) {
 	var zeroValue string
-	g := &GPGEmail{Email: &zeroValue}
-	g.GetEmail()
-	g = &GPGEmail{}
-	g.GetEmail()
-	g = nil
-	g.GetEmail()
-}
-
-func TestGPGEmail_GetVerified(tt *testing.T) {
-	var zeroValue bool
-	g := &GPGEmail{Verified: &zeroValue}
-	g.GetVerified()
-	g = &GPGEmail{}
-	g.GetVerified()
-	g = nil
-	g.GetVerified()
+	m := &MarketplacePlan{Name: &zeroValue}
+	m.GetName()
+	m = &MarketplacePlan{}
+	m.GetName()
+	m = nil
+	m.GetName()
 }
 
-func TestGPGKey_GetCanCertify(tt *testing.T) {
-	var zeroValue bool
-	g := &GPGKey{CanCertify: &zeroValue}
-	g.GetCanCertify()
-	g = &GPGKey{}
-	g.GetCanCertify()
-	g = nil
-	g.GetCanCertify()
+func TestMarketplacePlan_GetPriceModel(tt *testing.T) {
+	var zeroValue string
+	m := &MarketplacePlan{PriceModel: &zeroValue}
+	m.GetPriceModel()
+	m = &MarketplacePlan{}
+	m.GetPriceModel()
+	m = nil
+	m.GetPriceModel()
 }
 
-func TestGPGKey_GetCanEncryptComms(tt *testing.T) {
-	var zeroValue bool
-	g := &GPGKey{CanEncryptComms: &zeroValue}
-	g.GetCanEncryptComms()
-	g = &GPGKey{}
-	g.GetCanEncryptComms()
-	g = nil
-	g.GetCanEncryptComms()
+func TestMarketplacePlan_GetState(tt *testing.T) {
+	var zeroValue string
+	m := &MarketplacePlan{State: &zeroValue}
+	m.GetState()
+	m = &MarketplacePlan{}
+	m.GetState()
+	m = nil
+	m.GetState()
 }
 
-func TestGPGKey_GetCanEncryptStorage(tt *testing.T) {
-	var zeroValue bool
-	g := &GPGKey{CanEncryptStorage: &zeroValue}
-	g.GetCanEncryptStorage()
-	g = &GPGKey{}
-	g.GetCanEncryptStorage()
-	g = nil
-	g.GetCanEncryptStorage()
+func TestMarketplacePlan_GetUnitName(tt *testing.T) {
+	var zeroValue string
+	m := &MarketplacePlan{UnitName: &zeroValue}
+	m.GetUnitName()
+	m = &MarketplacePlan{}
+	m.GetUnitName()
+	m = nil
+	m.GetUnitName()
 }
 
-func TestGPGKey_GetCanSign(tt *testing.T) {
-	var zeroValue bool
-	g := &GPGKey{CanSign: &zeroValue}
-	g.GetCanSign()
-	g = &GPGKey{}
-	g.GetCanSign()
-	g = nil
-	g.GetCanSign()
+func TestMarketplacePlan_GetURL(tt *testing.T) {
+	var zeroValue string
+	m := &MarketplacePlan{URL: &zeroValue}
+	m.GetURL()
+	m = &MarketplacePlan{}
+	m.GetURL()
+	m = nil
+	m.GetURL()
 }
 
-func TestGPGKey_GetCreatedAt(tt *testing.T) {
-	var zeroValue time.Time
-	g := &GPGKey{CreatedAt: &zeroValue}
-	g.GetCreatedAt()
-	g = &GPGKey{}
-	g.GetCreatedAt()
-	g = nil
-	g.GetCreatedAt()
+func TestMarketplacePlan_GetYearlyPriceInCents(tt *testing.T) {
+	var zeroValue int
+	m := &MarketplacePlan{YearlyPriceInCents: &zeroValue}
+	m.GetYearlyPriceInCents()
+	m = &MarketplacePlan{}
+	m.GetYearlyPriceInCents()
+	m = nil
+	m.GetYearlyPriceInCents()
 }
 
-func TestGPGKey_GetExpiresAt(tt *testing.T) {
-	var zeroValue time.Time
-	g := &GPGKey{ExpiresAt: &zeroValue}
-	g.GetExpiresAt()
-	g = &GPGKey{}
-	g.GetExpiresAt()
-	g = nil
-	g.GetExpiresAt()
+func TestMarketplacePlanAccount_GetEmail(tt *testing.T) {
+	var zeroValue string
+	m := &MarketplacePlanAccount{Email: &zeroValue}
+	m.GetEmail()
+	m = &MarketplacePlanAccount{}
+	m.GetEmail()
+	m = nil
+	m.GetEmail()
 }
 
-func TestGPGKey_GetID(tt *testing.T) {
+func TestMarketplacePlanAccount_GetID(tt *testing.T) {
 	var zeroValue int64
-	g := &GPGKey{ID: &zeroValue}
-	g.GetID()
-	g = &GPGKey{}
-	g.GetID()
-	g = nil
-	g.GetID()
+	m := &MarketplacePlanAccount{ID: &zeroValue}
+	m.GetID()
+	m = &MarketplacePlanAccount{}
+	m.GetID()
+	m = nil
+	m.GetID()
 }
 
-func TestGPGKey_GetKeyID(tt *testing.T) {
+func TestMarketplacePlanAccount_GetLogin(tt *testing.T) {
 	var zeroValue string
-	g := &GPGKey{KeyID: &zeroValue}
-	g.GetKeyID()
-	g = &GPGKey{}
-	g.GetKeyID()
-	g = nil
-	g.GetKeyID()
+	m := &MarketplacePlanAccount{Login: &zeroValue}
+	m.GetLogin()
+	m = &MarketplacePlanAccount{}
+	m.GetLogin()
+	m = nil
+	m.GetLogin()
 }
 
-func TestGPGKey_GetPrimaryKeyID(tt *testing.T) {
-	var zeroValue int64
-	g := &GPGKey{PrimaryKeyID: &zeroValue}
-	g.GetPrimaryKeyID()
-	g = &GPGKey{}
-	g.GetPrimaryKeyID()
-	g = nil
-	g.GetPrimaryKeyID()
+func TestMarketplacePlanAccount_GetMarketplacePendingChange(tt *testing.T) {
+	m := &MarketplacePlanAccount{}
+	m.GetMarketplacePendingChange()
+	m = nil
+	m.GetMarketplacePendingChange()
 }
 
-func TestGPGKey_GetPublicKey(tt *testing.T) {
+func TestMarketplacePlanAccount_GetMarketplacePurchase(tt *testing.T) {
+	m := &MarketplacePlanAccount{}
+	m.GetMarketplacePurchase()
+	m = nil
+	m.GetMarketplacePurchase()
+}
+
+func TestMarketplacePlanAccount_GetNodeID(tt *testing.T) {
 	var zeroValue string
-	g := &GPGKey{PublicKey: &zeroValue}
-	g.GetPublicKey()
-	g = &GPGKey{}
-	g.GetPublicKey()
-	g = nil
-	g.GetPublicKey()
+	m := &MarketplacePlanAccount{NodeID: &zeroValue}
+	m.GetNodeID()
+	m = &MarketplacePlanAccount{}
+	m.GetNodeID()
+	m = nil
+	m.GetNodeID()
 }
 
-func TestGrant_GetApp(tt *testing.T) {
-	g := &Grant{}
-	g.GetApp()
-	g = nil
-	g.GetApp()
+func TestMarketplacePlanAccount_GetOrganizationBillingEmail(tt *testing.T) {
+	var zeroValue string
+	m := &MarketplacePlanAccount{OrganizationBillingEmail: &zeroValue}
+	m.GetOrganizationBillingEmail()
+	m = &MarketplacePlanAccount{}
+	m.GetOrganizationBillingEmail()
+	m = nil
+	m.GetOrganizationBillingEmail()
 }
 
-func TestGrant_GetCreatedAt(tt *testing.T) {
-	var zeroValue Timestamp
-	g := &Grant{CreatedAt: &zeroValue}
-	g.GetCreatedAt()
-	g = &Grant{}
-	g.GetCreatedAt()
-	g = nil
-	g.GetCreatedAt()
+func TestMarketplacePlanAccount_GetType(tt *testing.T) {
+	var zeroValue string
+	m := &MarketplacePlanAccount{Type: &zeroValue}
+	m.GetType()
+	m = &MarketplacePlanAccount{}
+	m.GetType()
+	m = nil
+	m.GetType()
 }
 
-func TestGrant_GetID(tt *testing.T) {
-	var zeroValue int64
-	g := &Grant{ID: &zeroValue}
-	g.GetID()
-	g = &Grant{}
-	g.GetID()
-	g = nil
-	g.GetID()
+func TestMarketplacePlanAccount_GetURL(tt *testing.T) {
+	var zeroValue string
+	m := &MarketplacePlanAccount{URL: &zeroValue}
+	m.GetURL()
+	m = &MarketplacePlanAccount{}
+	m.GetURL()
+	m = nil
+	m.GetURL()
 }
 
-func TestGrant_GetUpdatedAt(tt *testing.T) {
-	var zeroValue Timestamp
-	g := &Grant{UpdatedAt: &zeroValue}
-	g.GetUpdatedAt()
-	g = &Grant{}
-	g.GetUpdatedAt()
-	g = nil
-	g.GetUpdatedAt()
+func TestMarketplacePurchase_GetAccount(tt *testing.T) {
+	m := &MarketplacePurchase{}
+	m.GetAccount()
+	m = nil
+	m.GetAccount()
 }
 
-func TestGrant_GetURL(tt *testing.T) {
+func TestMarketplacePurchase_GetBillingCycle(tt *testing.T) {
 	var zeroValue string
-	g := &Grant{URL: &zeroValue}
-	g.GetURL()
-	g = &Grant{}
-	g.GetURL()
-	g = nil
-	g.GetURL()
+	m := &MarketplacePurchase{BillingCycle: &zeroValue}
+	m.GetBillingCycle()
+	m = &MarketplacePurchase{}
+	m.GetBillingCycle()
+	m = nil
+	m.GetBillingCycle()
 }
 
-func TestHeadCommit_GetAuthor(tt *testing.T) {
-	h := &HeadCommit{}
-	h.GetAuthor()
-	h = nil
-	h.GetAuthor()
+func TestMarketplacePurchase_GetFreeTrialEndsOn(tt *testing.T) {
+	var zeroValue Timestamp
+	m := &MarketplacePurchase{FreeTrialEndsOn: &zeroValue}
+	m.GetFreeTrialEndsOn()
+	m = &MarketplacePurchase{}
+	m.GetFreeTrialEndsOn()
+	m = nil
+	m.GetFreeTrialEndsOn()
 }
 
-func TestHeadCommit_GetCommitter(tt *testing.T) {
-	h := &HeadCommit{}
-	h.GetCommitter()
-	h = nil
-	h.GetCommitter()
+func TestMarketplacePurchase_GetNextBillingDate(tt *testing.T) {
+	var zeroValue Timestamp
+	m := &MarketplacePurchase{NextBillingDate: &zeroValue}
+	m.GetNextBillingDate()
+	m = &MarketplacePurchase{}
+	m.GetNextBillingDate()
+	m = nil
+	m.GetNextBillingDate()
 }
 
-func TestHeadCommit_GetDistinct(tt *testing.T) {
+func TestMarketplacePurchase_GetOnFreeTrial(tt *testing.T) {
 	var zeroValue bool
-	h := &HeadCommit{Distinct: &zeroValue}
-	h.GetDistinct()
-	h = &HeadCommit{}
-	h.GetDistinct()
-	h = nil
-	h.GetDistinct()
+	m := &MarketplacePurchase{OnFreeTrial: &zeroValue}
+	m.GetOnFreeTrial()
+	m = &MarketplacePurchase{}
+	m.GetOnFreeTrial()
+	m = nil
+	m.GetOnFreeTrial()
 }
 
-func TestHeadCommit_GetID(tt *testing.T) {
-	var zeroValue string
-	h := &HeadCommit{ID: &zeroValue}
-	h.GetID()
-	h = &HeadCommit{}
-	h.GetID()
-	h = nil
-	h.GetID()
+func TestMarketplacePurchase_GetPlan(tt *testing.T) {
+	m := &MarketplacePurchase{}
+	m.GetPlan()
+	m = nil
+	m.GetPlan()
 }
 
-func TestHeadCommit_GetMessage(tt *testing.T) {
-	var zeroValue string
-	h := &HeadCommit{Message: &zeroValue}
-	h.GetMessage()
-	h = &HeadCommit{}
-	h.GetMessage()
-	h = nil
-	h.GetMessage()
+func TestMarketplacePurchase_GetUnitCount(tt *testing.T) {
+	var zeroValue int
+	m := &MarketplacePurchase{UnitCount: &zeroValue}
+	m.GetUnitCount()
+	m = &MarketplacePurchase{}
+	m.GetUnitCount()
+	m = nil
+	m.GetUnitCount()
 }
 
-func TestHeadCommit_GetSHA(tt *testing.T) {
+func TestMarketplacePurchaseEvent_GetAction(tt *testing.T) {
 	var zeroValue string
-	h := &HeadCommit{SHA: &zeroValue}
-	h.GetSHA()
-	h = &HeadCommit{}
-	h.GetSHA()
-	h = nil
-	h.GetSHA()
+	m := &MarketplacePurchaseEvent{Action: &zeroValue}
+	m.GetAction()
+	m = &MarketplacePurchaseEvent{}
+	m.GetAction()
+	m = nil
+	m.GetAction()
 }
 
-func TestHeadCommit_GetTimestamp(tt *testing.T) {
+func TestMarketplacePurchaseEvent_GetEffectiveDate(tt *testing.T) {
 	var zeroValue Timestamp
-	h := &HeadCommit{Timestamp: &zeroValue}
-	h.GetTimestamp()
-	h = &HeadCommit{}
-	h.GetTimestamp()
-	h = nil
-	h.GetTimestamp()
+	m := &MarketplacePurchaseEvent{EffectiveDate: &zeroValue}
+	m.GetEffectiveDate()
+	m = &MarketplacePurchaseEvent{}
+	m.GetEffectiveDate()
+	m = nil
+	m.GetEffectiveDate()
 }
 
-func TestHeadCommit_GetTreeID(tt *testing.T) {
-	var zeroValue string
-	h := &HeadCommit{TreeID: &zeroValue}
-	h.GetTreeID()
-	h = &HeadCommit{}
-	h.GetTreeID()
-	h = nil
-	h.GetTreeID()
+func TestMarketplacePurchaseEvent_GetInstallation(tt *testing.T) {
+	m := &MarketplacePurchaseEvent{}
+	m.GetInstallation()
+	m = nil
+	m.GetInstallation()
 }
 
-func TestHeadCommit_GetURL(tt *testing.T) {
+func TestMarketplacePurchaseEvent_GetMarketplacePurchase(tt *testing.T) {
+	m := &MarketplacePurchaseEvent{}
+	m.GetMarketplacePurchase()
+	m = nil
+	m.GetMarketplacePurchase()
+}
+
+func TestMarketplacePurchaseEvent_GetPreviousMarketplacePurchase(tt *testing.T) {
+	m := &MarketplacePurchaseEvent{}
+	m.GetPreviousMarketplacePurchase()
+	m = nil
+	m.GetPreviousMarketplacePurchase()
+}
+
+func TestMarketplacePurchaseEvent_GetSender(tt *testing.T) {
+	m := &MarketplacePurchaseEvent{}
+	m.GetSender()
+	m = nil
+	m.GetSender()
+}
+
+func TestMatch_GetText(tt *testing.T) {
 	var zeroValue string
-	h := &HeadCommit{URL: &zeroValue}
-	h.GetURL()
-	h = &HeadCommit{}
-	h.GetURL()
-	h = nil
-	h.GetURL()
+	m := &Match{Text: &zeroValue}
+	m.GetText()
+	m = &Match{}
+	m.GetText()
+	m = nil
+	m.GetText()
 }
 
-func TestHook_GetActive(tt *testing.T) {
-	var zeroValue bool
-	h := &Hook{Active: &zeroValue}
-	h.GetActive()
-	h = &Hook{}
-	h.GetActive()
-	h = nil
-	h.GetActive()
+func TestMemberEvent_GetAction(tt *testing.T) {
+	var zeroValue string
+	m := &MemberEvent{Action: &zeroValue}
+	m.GetAction()
+	m = &MemberEvent{}
+	m.GetAction()
+	m = nil
+	m.GetAction()
 }
 
-func TestHook_GetCreatedAt(tt *testing.T) {
-	var zeroValue time.Time
-	h := &Hook{CreatedAt: &zeroValue}
-	h.GetCreatedAt()
-	h = &Hook{}
-	h.GetCreatedAt()
-	h = nil
-	h.GetCreatedAt()
+func TestMemberEvent_GetInstallation(tt *testing.T) {
+	m := &MemberEvent{}
+	m.GetInstallation()
+	m = nil
+	m.GetInstallation()
 }
 
-func TestHook_GetID(tt *testing.T) {
-	var zeroValue int64
-	h := &Hook{ID: &zeroValue}
-	h.GetID()
-	h = &Hook{}
-	h.GetID()
-	h = nil
-	h.GetID()
+func TestMemberEvent_GetMember(tt *testing.T) {
+	m := &MemberEvent{}
+	m.GetMember()
+	m = nil
+	m.GetMember()
 }
 
-func TestHook_GetUpdatedAt(tt *testing.T) {
-	var zeroValue time.Time
-	h := &Hook{UpdatedAt: &zeroValue}
-	h.GetUpdatedAt()
-	h = &Hook{}
-	h.GetUpdatedAt()
-	h = nil
-	h.GetUpdatedAt()
+func TestMemberEvent_GetRepo(tt *testing.T) {
+	m := &MemberEvent{}
+	m.GetRepo()
+	m = nil
+	m.GetRepo()
 }
 
-func TestHook_GetURL(tt *testing.T) {
-	var zeroValue string
-	h := &Hook{URL: &zeroValue}
-	h.GetURL()
-	h = &Hook{}
-	h.GetURL()
-	h = nil
-	h.GetURL()
+func TestMemberEvent_GetSender(tt *testing.T) {
+	m := &MemberEvent{}
+	m.GetSender()
+	m = nil
+	m.GetSender()
 }
 
-func TestHookStats_GetActiveHooks(tt *testing.T) {
-	var zeroValue int
-	h := &HookStats{ActiveHooks: &zeroValue}
-	h.GetActiveHooks()
-	h = &HookStats{}
-	h.GetActiveHooks()
-	h = nil
-	h.GetActiveHooks()
+func TestMembership_GetOrganization(tt *testing.T) {
+	m := &Membership{}
+	m.GetOrganization()
+	m = nil
+	m.GetOrganization()
 }
 
-func TestHookStats_GetInactiveHooks(tt *testing.T) {
-	var zeroValue int
-	h := &HookStats{InactiveHooks: &zeroValue}
-	h.GetInactiveHooks()
-	h = &HookStats{}
-	h.GetInactiveHooks()
-	h = nil
-	h.GetInactiveHooks()
+func TestMembership_GetOrganizationURL(tt *testing.T) {
+	var zeroValue string
+	m := &Membership{OrganizationURL: &zeroValue}
+	m.GetOrganizationURL()
+	m = &Membership{}
+	m.GetOrganizationURL()
+	m = nil
+	m.GetOrganizationURL()
 }
 
-func TestHookStats_GetTotalHooks(tt *testing.T) {
-	var zeroValue int
-	h := &HookStats{TotalHooks: &zeroValue}
-	h.GetTotalHooks()
-	h = &HookStats{}
-	h.GetTotalHooks()
-	h = nil
-	h.GetTotalHooks()
+func TestMembership_GetRole(tt *testing.T) {
+	var zeroValue string
+	m := &Membership{Role: &zeroValue}
+	m.GetRole()
+	m = &Membership{}
+	m.GetRole()
+	m = nil
+	m.GetRole()
 }
 
-func TestIDPGroup_GetGroupDescription(tt *testing.T) {
+func TestMembership_GetState(tt *testing.T) {
 	var zeroValue string
-	i := &IDPGroup{GroupDescription: &zeroValue}
-	i.GetGroupDescription()
-	i = &IDPGroup{}
-	i.GetGroupDescription()
-	i = nil
-	i.GetGroupDescription()
+	m := &Membership{State: &zeroValue}
+	m.GetState()
+	m = &Membership{}
+	m.GetState()
+	m = nil
+	m.GetState()
 }
 
-func TestIDPGroup_GetGroupID(tt *testing.T) {
+func TestMembership_GetURL(tt *testing.T) {
 	var zeroValue string
-	i := &IDPGroup{GroupID: &zeroValue}
-	i.GetGroupID()
-	i = &IDPGroup{}
-	i.GetGroupID()
-	i = nil
-	i.GetGroupID()
+	m := &Membership{URL: &zeroValue}
+	m.GetURL()
+	m = &Membership{}
+	m.GetURL()
+	m = nil
+	m.GetURL()
 }
 
-func TestIDPGroup_GetGroupName(tt *testing.T) {
+func TestMembership_GetUser(tt *testing.T) {
+	m := &Membership{}
+	m.GetUser()
+	m = nil
+	m.GetUser()
+}
+
+func TestMembershipEvent_GetAction(tt *testing.T) {
 	var zeroValue string
-	i := &IDPGroup{GroupName: &zeroValue}
-	i.GetGroupName()
-	i = &IDPGroup{}
-	i.GetGroupName()
-	i = nil
-	i.GetGroupName()
+	m := &MembershipEvent{Action: &zeroValue}
+	m.GetAction()
+	m = &MembershipEvent{}
+	m.GetAction()
+	m = nil
+	m.GetAction()
 }
 
-func TestImport_GetAuthorsCount(tt *testing.T) {
-	var zeroValue int
-	i := &Import{AuthorsCount: &zeroValue}
-	i.GetAuthorsCount()
-	i = &Import{}
-	i.GetAuthorsCount()
-	i = nil
-	i.GetAuthorsCount()
+func TestMembershipEvent_GetInstallation(tt *testing.T) {
+	m := &MembershipEvent{}
+	m.GetInstallation()
+	m = nil
+	m.GetInstallation()
 }
 
-func TestImport_GetAuthorsURL(tt *testing.T) {
-	var zeroValue string
-	i := &Import{AuthorsURL: &zeroValue}
-	i.GetAuthorsURL()
-	i = &Import{}
-	i.GetAuthorsURL()
-	i = nil
-	i.GetAuthorsURL()
+func TestMembershipEvent_GetMember(tt *testing.T) {
+	m := &MembershipEvent{}
+	m.GetMember()
+	m = nil
+	m.GetMember()
 }
 
-func TestImport_GetCommitCount(tt *testing.T) {
-	var zeroValue int
-	i := &Import{CommitCount: &zeroValue}
-	i.GetCommitCount()
-	i = &Import{}
-	i.GetCommitCount()
-	i = nil
-	i.GetCommitCount()
+func TestMembershipEvent_GetOrg(tt *testing.T) {
+	m := &MembershipEvent{}
+	m.GetOrg()
+	m = nil
+	m.GetOrg()
 }
 
-func TestImport_GetFailedStep(tt *testing.T) {
+func TestMembershipEvent_GetScope(tt *testing.T) {
 	var zeroValue string
-	i := &Import{FailedStep: &zeroValue}
-	i.GetFailedStep()
-	i = &Import{}
-	i.GetFailedStep()
-	i = nil
-	i.GetFailedStep()
+	m := &MembershipEvent{Scope: &zeroValue}
+	m.GetScope()
+	m = &MembershipEvent{}
+	m.GetScope()
+	m = nil
+	m.GetScope()
 }
 
-func TestImport_GetHasLargeFiles(tt *testing.T) {
-	var zeroValue bool
-	i := &Import{HasLargeFiles: &zeroValue}
-	i.GetHasLargeFiles()
-	i = &Import{}
-	i.GetHasLargeFiles()
-	i = nil
-	i.GetHasLargeFiles()
+func TestMembershipEvent_GetSender(tt *testing.T) {
+	m := &MembershipEvent{}
+	m.GetSender()
+	m = nil
+	m.GetSender()
 }
 
-func TestImport_GetHTMLURL(tt *testing.T) {
-	var zeroValue string
-	i := &Import{HTMLURL: &zeroValue}
-	i.GetHTMLURL()
-	i = &Import{}
-	i.GetHTMLURL()
-	i = nil
-	i.GetHTMLURL()
+func TestMembershipEvent_GetTeam(tt *testing.T) {
+	m := &MembershipEvent{}
+	m.GetTeam()
+	m = nil
+	m.GetTeam()
 }
 
-func TestImport_GetHumanName(tt *testing.T) {
+func TestMergeGroup_GetBaseRef(tt *testing.T) {
 	var zeroValue string
-	i := &Import{HumanName: &zeroValue}
-	i.GetHumanName()
-	i = &Import{}
-	i.GetHumanName()
-	i = nil
-	i.GetHumanName()
+	m := &MergeGroup{BaseRef: &zeroValue}
+	m.GetBaseRef()
+	m = &MergeGroup{}
+	m.GetBaseRef()
+	m = nil
+	m.GetBaseRef()
 }
 
-func TestImport_GetLargeFilesCount(tt *testing.T) {
-	var zeroValue int
-	i := &Import{LargeFilesCount: &zeroValue}
-	i.GetLargeFilesCount()
-	i = &Import{}
-	i.GetLargeFilesCount()
-	i = nil
-	i.GetLargeFilesCount()
+func TestMergeGroup_GetBaseSHA(tt *testing.T) {
+	var zeroValue string
+	m := &MergeGroup{BaseSHA: &zeroValue}
+	m.GetBaseSHA()
+	m = &MergeGroup{}
+	m.GetBaseSHA()
+	m = nil
+	m.GetBaseSHA()
 }
 
-func TestImport_GetLargeFilesSize(tt *testing.T) {
-	var zeroValue int
-	i := &Import{LargeFilesSize: &zeroValue}
-	i.GetLargeFilesSize()
-	i = &Import{}
-	i.GetLargeFilesSize()
-	i = nil
-	i.GetLargeFilesSize()
+func TestMergeGroup_GetHeadCommit(tt *testing.T) {
+	m := &MergeGroup{}
+	m.GetHeadCommit()
+	m = nil
+	m.GetHeadCommit()
 }
 
-func TestImport_GetMessage(tt *testing.T) {
+func TestMergeGroup_GetHeadRef(tt *testing.T) {
 	var zeroValue string
-	i := &Import{Message: &zeroValue}
-	i.GetMessage()
-	i = &Import{}
-	i.GetMessage()
-	i = nil
-	i.GetMessage()
+	m := &MergeGroup{HeadRef: &zeroValue}
+	m.GetHeadRef()
+	m = &MergeGroup{}
+	m.GetHeadRef()
+	m = nil
+	m.GetHeadRef()
 }
 
-func TestImport_GetPercent(tt *testing.T) {
-	var zeroValue int
-	i := &Import{Percent: &zeroValue}
-	i.GetPercent()
-	i = &Import{}
-	i.GetPercent()
-	i = nil
-	i.GetPercent()
+func TestMergeGroup_GetHeadSHA(tt *testing.T) {
+	var zeroValue string
+	m := &MergeGroup{HeadSHA: &zeroValue}
+	m.GetHeadSHA()
+	m = &MergeGroup{}
+	m.GetHeadSHA()
+	m = nil
+	m.GetHeadSHA()
 }
 
-func TestImport_GetPushPercent(tt *testing.T) {
-	var zeroValue int
-	i := &Import{PushPercent: &zeroValue}
-	i.GetPushPercent()
-	i = &Import{}
-	i.GetPushPercent()
-	i = nil
-	i.GetPushPercent()
+func TestMergeGroupEvent_GetAction(tt *testing.T) {
+	var zeroValue string
+	m := &MergeGroupEvent{Action: &zeroValue}
+	m.GetAction()
+	m = &MergeGroupEvent{}
+	m.GetAction()
+	m = nil
+	m.GetAction()
 }
 
-func TestImport_GetRepositoryURL(tt *testing.T) {
-	var zeroValue string
-	i := &Import{RepositoryURL: &zeroValue}
-	i.GetRepositoryURL()
-	i = &Import{}
-	i.GetRepositoryURL()
-	i = nil
-	i.GetRepositoryURL()
+func TestMergeGroupEvent_GetInstallation(tt *testing.T) {
+	m := &MergeGroupEvent{}
+	m.GetInstallation()
+	m = nil
+	m.GetInstallation()
 }
 
-func TestImport_GetStatus(tt *testing.T) {
-	var zeroValue string
-	i := &Import{Status: &zeroValue}
-	i.GetStatus()
-	i = &Import{}
-	i.GetStatus()
-	i = nil
-	i.GetStatus()
+func TestMergeGroupEvent_GetMergeGroup(tt *testing.T) {
+	m := &MergeGroupEvent{}
+	m.GetMergeGroup()
+	m = nil
+	m.GetMergeGroup()
 }
 
-func TestImport_GetStatusText(tt *testing.T) {
-	var zeroValue string
-	i := &Import{StatusText: &zeroValue}
-	i.GetStatusText()
-	i = &Import{}
-	i.GetStatusText()
-	i = nil
-	i.GetStatusText()
+func TestMergeGroupEvent_GetOrg(tt *testing.T) {
+	m := &MergeGroupEvent{}
+	m.GetOrg()
+	m = nil
+	m.GetOrg()
 }
 
-func TestImport_GetTFVCProject(tt *testing.T) {
+func TestMergeGroupEvent_GetReason(tt *testing.T) {
 	var zeroValue string
-	i := &Import{TFVCProject: &zeroValue}
-	i.GetTFVCProject()
-	i = &Import{}
-	i.GetTFVCProject()
-	i = nil
-	i.GetTFVCProject()
+	m := &MergeGroupEvent{Reason: &zeroValue}
+	m.GetReason()
+	m = &MergeGroupEvent{}
+	m.GetReason()
+	m = nil
+	m.GetReason()
 }
 
-func TestImport_GetURL(tt *testing.T) {
-	var zeroValue string
-	i := &Import{URL: &zeroValue}
-	i.GetURL()
-	i = &Import{}
-	i.GetURL()
-	i = nil
-	i.GetURL()
+func TestMergeGroupEvent_GetRepo(tt *testing.T) {
+	m := &MergeGroupEvent{}
+	m.GetRepo()
+	m = nil
+	m.GetRepo()
 }
 
-func TestImport_GetUseLFS(tt *testing.T) {
-	var zeroValue string
-	i := &Import{UseLFS: &zeroValue}
-	i.GetUseLFS()
-	i = &Import{}
-	i.GetUseLFS()
-	i = nil
-	i.GetUseLFS()
+func TestMergeGroupEvent_GetSender(tt *testing.T) {
+	m := &MergeGroupEvent{}
+	m.GetSender()
+	m = nil
+	m.GetSender()
 }
 
-func TestImport_GetVCS(tt *testing.T) {
+func TestMergeQueueEntry_GetBaseSHA(tt *testing.T) {
 	var zeroValue string
-	i := &Import{VCS: &zeroValue}
-	i.GetVCS()
-	i = &Import{}
-	i.GetVCS()
-	i = nil
-	i.GetVCS()
+	m := &MergeQueueEntry{BaseSHA: &zeroValue}
+	m.GetBaseSHA()
+	m = &MergeQueueEntry{}
+	m.GetBaseSHA()
+	m = nil
+	m.GetBaseSHA()
 }
 
-func TestImport_GetVCSPassword(tt *testing.T) {
-	var zeroValue string
-	i := &Import{VCSPassword: &zeroValue}
-	i.GetVCSPassword()
-	i = &Import{}
-	i.GetVCSPassword()
-	i = nil
-	i.GetVCSPassword()
+func TestMergeQueueEntry_GetEnqueuedAt(tt *testing.T) {
+	var zeroValue time.Time
+	m := &MergeQueueEntry{EnqueuedAt: &zeroValue}
+	m.GetEnqueuedAt()
+	m = &MergeQueueEntry{}
+	m.GetEnqueuedAt()
+	m = nil
+	m.GetEnqueuedAt()
 }
 
-func TestImport_GetVCSURL(tt *testing.T) {
-	var zeroValue string
-	i := &Import{VCSURL: &zeroValue}
-	i.GetVCSURL()
-	i = &Import{}
-	i.GetVCSURL()
-	i = nil
-	i.GetVCSURL()
+func TestMergeQueueEntry_GetEnqueuer(tt *testing.T) {
+	m := &MergeQueueEntry{}
+	m.GetEnqueuer()
+	m = nil
+	m.GetEnqueuer()
 }
 
-func TestImport_GetVCSUsername(tt *testing.T) {
+func TestMergeQueueEntry_GetEstimatedTimeToMerge(tt *testing.T) {
+	var zeroValue int
+	m := &MergeQueueEntry{EstimatedTimeToMerge: &zeroValue}
+	m.GetEstimatedTimeToMerge()
+	m = &MergeQueueEntry{}
+	m.GetEstimatedTimeToMerge()
+	m = nil
+	m.GetEstimatedTimeToMerge()
+}
+
+func TestMergeQueueEntry_GetHeadSHA(tt *testing.T) {
 	var zeroValue string
-	i := &Import{VCSUsername: &zeroValue}
-	i.GetVCSUsername()
-	i = &Import{}
-	i.GetVCSUsername()
-	i = nil
-	i.GetVCSUsername()
+	m := &MergeQueueEntry{HeadSHA: &zeroValue}
+	m.GetHeadSHA()
+	m = &MergeQueueEntry{}
+	m.GetHeadSHA()
+	m = nil
+	m.GetHeadSHA()
 }
 
-func TestInstallation_GetAccessTokensURL(tt *testing.T) {
+func TestMergeQueueEntry_GetID(tt *testing.T) {
 	var zeroValue string
-	i := &Installation{AccessTokensURL: &zeroValue}
-	i.GetAccessTokensURL()
-	i = &Installation{}
-	i.GetAccessTokensURL()
-	i = nil
-	i.GetAccessTokensURL()
+	m := &MergeQueueEntry{ID: &zeroValue}
+	m.GetID()
+	m = &MergeQueueEntry{}
+	m.GetID()
+	m = nil
+	m.GetID()
 }
 
-func TestInstallation_GetAccount(tt *testing.T) {
-	i := &Installation{}
-	i.GetAccount()
-	i = nil
-	i.GetAccount()
+func TestMergeQueueEntry_GetJump(tt *testing.T) {
+	var zeroValue bool
+	m := &MergeQueueEntry{Jump: &zeroValue}
+	m.GetJump()
+	m = &MergeQueueEntry{}
+	m.GetJump()
+	m = nil
+	m.GetJump()
 }
 
-func TestInstallation_GetAppID(tt *testing.T) {
-	var zeroValue int64
-	i := &Installation{AppID: &zeroValue}
-	i.GetAppID()
-	i = &Installation{}
-	i.GetAppID()
-	i = nil
-	i.GetAppID()
+func TestMergeQueueEntry_GetPosition(tt *testing.T) {
+	var zeroValue int
+	m := &MergeQueueEntry{Position: &zeroValue}
+	m.GetPosition()
+	m = &MergeQueueEntry{}
+	m.GetPosition()
+	m = nil
+	m.GetPosition()
 }
 
-func TestInstallation_GetCreatedAt(tt *testing.T) {
-	var zeroValue Timestamp
-	i := &Installation{CreatedAt: &zeroValue}
-	i.GetCreatedAt()
-	i = &Installation{}
-	i.GetCreatedAt()
-	i = nil
-	i.GetCreatedAt()
+func TestMergeQueueEntry_GetPullRequest(tt *testing.T) {
+	m := &MergeQueueEntry{}
+	m.GetPullRequest()
+	m = nil
+	m.GetPullRequest()
 }
 
-func TestInstallation_GetHTMLURL(tt *testing.T) {
-	var zeroValue string
-	i := &Installation{HTMLURL: &zeroValue}
-	i.GetHTMLURL()
-	i = &Installation{}
-	i.GetHTMLURL()
-	i = nil
-	i.GetHTMLURL()
+func TestMergeQueueEntry_GetSolo(tt *testing.T) {
+	var zeroValue bool
+	m := &MergeQueueEntry{Solo: &zeroValue}
+	m.GetSolo()
+	m = &MergeQueueEntry{}
+	m.GetSolo()
+	m = nil
+	m.GetSolo()
 }
 
-func TestInstallation_GetID(tt *testing.T) {
-	var zeroValue int64
-	i := &Installation{ID: &zeroValue}
-	i.GetID()
-	i = &Installation{}
-	i.GetID()
-	i = nil
-	i.GetID()
+func TestMergeQueueEntry_GetState(tt *testing.T) {
+	var zeroValue string
+	m := &MergeQueueEntry{State: &zeroValue}
+	m.GetState()
+	m = &MergeQueueEntry{}
+	m.GetState()
+	m = nil
+	m.GetState()
 }
 
-func TestInstallation_GetNodeID(tt *testing.T) {
+func TestMetaEvent_GetAction(tt *testing.T) {
 	var zeroValue string
-	i := &Installation{NodeID: &zeroValue}
-	i.GetNodeID()
-	i = &Installation{}
-	i.GetNodeID()
-	i = nil
-	i.GetNodeID()
+	m := &MetaEvent{Action: &zeroValue}
+	m.GetAction()
+	m = &MetaEvent{}
+	m.GetAction()
+	m = nil
+	m.GetAction()
 }
 
-func TestInstallation_GetPermissions(tt *testing.T) {
-	i := &Installation{}
-	i.GetPermissions()
-	i = nil
-	i.GetPermissions()
+func TestMetaEvent_GetHook(tt *testing.T) {
+	m := &MetaEvent{}
+	m.GetHook()
+	m = nil
+	m.GetHook()
 }
 
-func TestInstallation_GetRepositoriesURL(tt *testing.T) {
+func TestMetaEvent_GetHookID(tt *testing.T) {
+	var zeroValue int64
+	m := &MetaEvent{HookID: &zeroValue}
+	m.GetHookID()
+	m = &MetaEvent{}
+	m.GetHookID()
+	m = nil
+	m.GetHookID()
+}
+
+func TestMetric_GetHTMLURL(tt *testing.T) {
 	var zeroValue string
-	i := &Installation{RepositoriesURL: &zeroValue}
-	i.GetRepositoriesURL()
-	i = &Installation{}
-	i.GetRepositoriesURL()
-	i = nil
-	i.GetRepositoriesURL()
+	m := &Metric{HTMLURL: &zeroValue}
+	m.GetHTMLURL()
+	m = &Metric{}
+	m.GetHTMLURL()
+	m = nil
+	m.GetHTMLURL()
 }
 
-func TestInstallation_GetRepositorySelection(tt *testing.T) {
+func TestMetric_GetKey(tt *testing.T) {
 	var zeroValue string
-	i := &Installation{RepositorySelection: &zeroValue}
-	i.GetRepositorySelection()
-	i = &Installation{}
-	i.GetRepositorySelection()
-	i = nil
-	i.GetRepositorySelection()
+	m := &Metric{Key: &zeroValue}
+	m.GetKey()
+	m = &Metric{}
+	m.GetKey()
+	m = nil
+	m.GetKey()
 }
 
-func TestInstallation_GetSingleFileName(tt *testing.T) {
+func TestMetric_GetName(tt *testing.T) {
 	var zeroValue string
-	i := &Installation{SingleFileName: &zeroValue}
-	i.GetSingleFileName()
-	i = &Installation{}
-	i.GetSingleFileName()
-	i = nil
-	i.GetSingleFileName()
+	m := &Metric{Name: &zeroValue}
+	m.GetName()
+	m = &Metric{}
+	m.GetName()
+	m = nil
+	m.GetName()
 }
 
-func TestInstallation_GetTargetID(tt *testing.T) {
-	var zeroValue int64
-	i := &Installation{TargetID: &zeroValue}
-	i.GetTargetID()
-	i = &Installation{}
-	i.GetTargetID()
-	i = nil
-	i.GetTargetID()
+func TestMetric_GetURL(tt *testing.T) {
+	var zeroValue string
+	m := &Metric{URL: &zeroValue}
+	m.GetURL()
+	m = &Metric{}
+	m.GetURL()
+	m = nil
+	m.GetURL()
 }
 
-func TestInstallation_GetTargetType(tt *testing.T) {
+func TestMigration_GetCreatedAt(tt *testing.T) {
 	var zeroValue string
-	i := &Installation{TargetType: &zeroValue}
-	i.GetTargetType()
-	i = &Installation{}
-	i.GetTargetType()
-	i = nil
-	i.GetTargetType()
+	m := &Migration{CreatedAt: &zeroValue}
+	m.GetCreatedAt()
+	m = &Migration{}
+	m.GetCreatedAt()
+	m = nil
+	m.GetCreatedAt()
 }
 
-func TestInstallation_GetUpdatedAt(tt *testing.T) {
-	var zeroValue Timestamp
-	i := &Installation{UpdatedAt: &zeroValue}
-	i.GetUpdatedAt()
-	i = &Installation{}
-	i.GetUpdatedAt()
-	i = nil
-	i.GetUpdatedAt()
+func TestMigration_GetExcludeAttachments(tt *testing.T) {
+	var zeroValue bool
+	m := &Migration{ExcludeAttachments: &zeroValue}
+	m.GetExcludeAttachments()
+	m = &Migration{}
+	m.GetExcludeAttachments()
+	m = nil
+	m.GetExcludeAttachments()
 }
 
-func TestInstallationEvent_GetAction(tt *testing.T) {
+func TestMigration_GetGUID(tt *testing.T) {
 	var zeroValue string
-	i := &InstallationEvent{Action: &zeroValue}
-	i.GetAction()
-	i = &InstallationEvent{}
-	i.GetAction()
-	i = nil
-	i.GetAction()
+	m := &Migration{GUID: &zeroValue}
+	m.GetGUID()
+	m = &Migration{}
+	m.GetGUID()
+	m = nil
+	m.GetGUID()
 }
 
-func TestInstallationEvent_GetInstallation(tt *testing.T) {
-	i := &InstallationEvent{}
-	i.GetInstallation()
-	i = nil
-	i.GetInstallation()
+func TestMigration_GetID(tt *testing.T) {
+	var zeroValue int64
+	m := &Migration{ID: &zeroValue}
+	m.GetID()
+	m = &Migration{}
+	m.GetID()
+	m = nil
+	m.GetID()
 }
 
-func TestInstallationEvent_GetSender(tt *testing.T) {
-	i := &InstallationEvent{}
-	i.GetSender()
-	i = nil
-	i.GetSender()
+func TestMigration_GetLockRepositories(tt *testing.T) {
+	var zeroValue bool
+	m := &Migration{LockRepositories: &zeroValue}
+	m.GetLockRepositories()
+	m = &Migration{}
+	m.GetLockRepositories()
+	m = nil
+	m.GetLockRepositories()
 }
 
-func TestInstallationPermissions_GetAdministration(tt *testing.T) {
+func TestMigration_GetState(tt *testing.T) {
 	var zeroValue string
-	i := &InstallationPermissions{Administration: &zeroValue}
-	i.GetAdministration()
-	i = &InstallationPermissions{}
-	i.GetAdministration()
-	i = nil
-	i.GetAdministration()
+	m := &Migration{State: &zeroValue}
+	m.GetState()
+	m = &Migration{}
+	m.GetState()
+	m = nil
+	m.GetState()
 }
 
-func TestInstallationPermissions_GetBlocking(tt *testing.T) {
+func TestMigration_GetUpdatedAt(tt *testing.T) {
 	var zeroValue string
-	i := &InstallationPermissions{Blocking: &zeroValue}
-	i.GetBlocking()
-	i = &InstallationPermissions{}
-	i.GetBlocking()
-	i = nil
-	i.GetBlocking()
+	m := &Migration{UpdatedAt: &zeroValue}
+	m.GetUpdatedAt()
+	m = &Migration{}
+	m.GetUpdatedAt()
+	m = nil
+	m.GetUpdatedAt()
 }
 
-func TestInstallationPermissions_GetChecks(tt *testing.T) {
+func TestMigration_GetURL(tt *testing.T) {
 	var zeroValue string
-	i := &InstallationPermissions{Checks: &zeroValue}
-	i.GetChecks()
-	i = &InstallationPermissions{}
-	i.GetChecks()
-	i = nil
-	i.GetChecks()
+	m := &Migration{URL: &zeroValue}
+	m.GetURL()
+	m = &Migration{}
+	m.GetURL()
+	m = nil
+	m.GetURL()
 }
 
-func TestInstallationPermissions_GetContentReferences(tt *testing.T) {
-	var zeroValue string
-	i := &InstallationPermissions{ContentReferences: &zeroValue}
-	i.GetContentReferences()
-	i = &InstallationPermissions{}
-	i.GetContentReferences()
-	i = nil
-	i.GetContentReferences()
+func TestMilestone_GetClosedAt(tt *testing.T) {
+	var zeroValue time.Time
+	m := &Milestone{ClosedAt: &zeroValue}
+	m.GetClosedAt()
+	m = &Milestone{}
+	m.GetClosedAt()
+	m = nil
+	m.GetClosedAt()
 }
 
-func TestInstallationPermissions_GetContents(tt *testing.T) {
-	var zeroValue string
-	i := &InstallationPermissions{Contents: &zeroValue}
-	i.GetContents()
-	i = &InstallationPermissions{}
-	i.GetContents()
-	i = nil
-	i.GetContents()
+func TestMilestone_GetClosedIssues(tt *testing.T) {
+	var zeroValue int
+	m := &Milestone{ClosedIssues: &zeroValue}
+	m.GetClosedIssues()
+	m = &Milestone{}
+	m.GetClosedIssues()
+	m = nil
+	m.GetClosedIssues()
 }
 
-func TestInstallationPermissions_GetDeployments(tt *testing.T) {
-	var zeroValue string
-	i := &InstallationPermissions{Deployments: &zeroValue}
-	i.GetDeployments()
-	i = &InstallationPermissions{}
-	i.GetDeployments()
-	i = nil
-	i.GetDeployments()
+func TestMilestone_GetCreatedAt(tt *testing.T) {
+	var zeroValue time.Time
+	m := &Milestone{CreatedAt: &zeroValue}
+	m.GetCreatedAt()
+	m = &Milestone{}
+	m.GetCreatedAt()
+	m = nil
+	m.GetCreatedAt()
 }
 
-func TestInstallationPermissions_GetEmails(tt *testing.T) {
-	var zeroValue string
-	i := &InstallationPermissions{Emails: &zeroValue}
-	i.GetEmails()
-	i = &InstallationPermissions{}
-	i.GetEmails()
-	i = nil
-	i.GetEmails()
+func TestMilestone_GetCreator(tt *testing.T) {
+	m := &Milestone{}
+	m.GetCreator()
+	m = nil
+	m.GetCreator()
 }
 
-func TestInstallationPermissions_GetFollowers(tt *testing.T) {
+func TestMilestone_GetDescription(tt *testing.T) {
 	var zeroValue string
-	i := &InstallationPermissions{Followers: &zeroValue}
-	i.GetFollowers()
-	i = &InstallationPermissions{}
-	i.GetFollowers()
-	i = nil
-	i.GetFollowers()
+	m := &Milestone{Description: &zeroValue}
+	m.GetDescription()
+	m = &Milestone{}
+	m.GetDescription()
+	m = nil
+	m.GetDescription()
 }
 
-func TestInstallationPermissions_GetIssues(tt *testing.T) {
-	var zeroValue string
-	i := &InstallationPermissions{Issues: &zeroValue}
-	i.GetIssues()
-	i = &InstallationPermissions{}
-	i.GetIssues()
-	i = nil
-	i.GetIssues()
+func TestMilestone_GetDueOn(tt *testing.T) {
+	var zeroValue time.Time
+	m := &Milestone{DueOn: &zeroValue}
+	m.GetDueOn()
+	m = &Milestone{}
+	m.GetDueOn()
+	m = nil
+	m.GetDueOn()
 }
 
-func TestInstallationPermissions_GetMembers(tt *testing.T) {
+func TestMilestone_GetHTMLURL(tt *testing.T) {
 	var zeroValue string
-	i := &InstallationPermissions{Members: &zeroValue}
-	i.GetMembers()
-	i = &InstallationPermissions{}
-	i.GetMembers()
-	i = nil
-	i.GetMembers()
+	m := &Milestone{HTMLURL: &zeroValue}
+	m.GetHTMLURL()
+	m = &Milestone{}
+	m.GetHTMLURL()
+	m = nil
+	m.GetHTMLURL()
 }
 
-func TestInstallationPermissions_GetMetadata(tt *testing.T) {
+func TestMilestone_GetID(tt *testing.T) {
+	var zeroValue int64
+	m := &Milestone{ID: &zeroValue}
+	m.GetID()
+	m = &Milestone{}
+	m.GetID()
+	m = nil
+	m.GetID()
+}
+
+func TestMilestone_GetLabelsURL(tt *testing.T) {
 	var zeroValue string
-	i := &InstallationPermissions{Metadata: &zeroValue}
-	i.GetMetadata()
-	i = &InstallationPermissions{}
-	i.GetMetadata()
-	i = nil
-	i.GetMetadata()
+	m := &Milestone{LabelsURL: &zeroValue}
+	m.GetLabelsURL()
+	m = &Milestone{}
+	m.GetLabelsURL()
+	m = nil
+	m.GetLabelsURL()
 }
 
-func TestInstallationPermissions_GetOrganizationAdministration(tt *testing.T) {
+func TestMilestone_GetNodeID(tt *testing.T) {
 	var zeroValue string
-	i := &InstallationPermissions{OrganizationAdministration: &zeroValue}
-	i.GetOrganizationAdministration()
-	i = &InstallationPermissions{}
-	i.GetOrganizationAdministration()
-	i = nil
-	i.GetOrganizationAdministration()
+	m := &Milestone{NodeID: &zeroValue}
+	m.GetNodeID()
+	m = &Milestone{}
+	m.GetNodeID()
+	m = nil
+	m.GetNodeID()
+}
+
+func TestMilestone_GetNumber(tt *testing.T) {
+	var zeroValue int
+	m := &Milestone{Number: &zeroValue}
+	m.GetNumber()
+	m = &Milestone{}
+	m.GetNumber()
+	m = nil
+	m.GetNumber()
+}
+
+func TestMilestone_GetOpenIssues(tt *testing.T) {
+	var zeroValue int
+	m := &Milestone{OpenIssues: &zeroValue}
+	m.GetOpenIssues()
+	m = &Milestone{}
+	m.GetOpenIssues()
+	m = nil
+	m.GetOpenIssues()
 }
 
-func TestInstallationPermissions_GetOrganizationHooks(tt *testing.T) {
+func TestMilestone_GetState(tt *testing.T) {
 	var zeroValue string
-	i := &InstallationPermissions{OrganizationHooks: &zeroValue}
-	i.GetOrganizationHooks()
-	i = &InstallationPermissions{}
-	i.GetOrganizationHooks()
-	i = nil
-	i.GetOrganizationHooks()
+	m := &Milestone{State: &zeroValue}
+	m.GetState()
+	m = &Milestone{}
+	m.GetState()
+	m = nil
+	m.GetState()
 }
 
-func TestInstallationPermissions_GetOrganizationPlan(tt *testing.T) {
+func TestMilestone_GetTitle(tt *testing.T) {
 	var zeroValue string
-	i := &InstallationPermissions{OrganizationPlan: &zeroValue}
-	i.GetOrganizationPlan()
-	i = &InstallationPermissions{}
-	i.GetOrganizationPlan()
-	i = nil
-	i.GetOrganizationPlan()
+	m := &Milestone{Title: &zeroValue}
+	m.GetTitle()
+	m = &Milestone{}
+	m.GetTitle()
+	m = nil
+	m.GetTitle()
 }
 
-func TestInstallationPermissions_GetOrganizationPreReceiveHooks(tt *testing.T) {
-	var zeroValue string
-	i := &InstallationPermissions{OrganizationPreReceiveHooks: &zeroValue}
-	i.GetOrganizationPreReceiveHooks()
-	i = &InstallationPermissions{}
-	i.GetOrganizationPreReceiveHooks()
-	i = nil
-	i.GetOrganizationPreReceiveHooks()
+func TestMilestone_GetUpdatedAt(tt *testing.T) {
+	var zeroValue time.Time
+	m := &Milestone{UpdatedAt: &zeroValue}
+	m.GetUpdatedAt()
+	m = &Milestone{}
+	m.GetUpdatedAt()
+	m = nil
+	m.GetUpdatedAt()
 }
 
-func TestInstallationPermissions_GetOrganizationProjects(tt *testing.T) {
+func TestMilestone_GetURL(tt *testing.T) {
 	var zeroValue string
-	i := &InstallationPermissions{OrganizationProjects: &zeroValue}
-	i.GetOrganizationProjects()
-	i = &InstallationPermissions{}
-	i.GetOrganizationProjects()
-	i = nil
-	i.GetOrganizationProjects()
+	m := &Milestone{URL: &zeroValue}
+	m.GetURL()
+	m = &Milestone{}
+	m.GetURL()
+	m = nil
+	m.GetURL()
 }
 
-func TestInstallationPermissions_GetOrganizationUserBlocking(tt *testing.T) {
+func TestMilestoneEvent_GetAction(tt *testing.T) {
 	var zeroValue string
-	i := &InstallationPermissions{OrganizationUserBlocking: &zeroValue}
-	i.GetOrganizationUserBlocking()
-	i = &InstallationPermissions{}
-	i.GetOrganizationUserBlocking()
-	i = nil
-	i.GetOrganizationUserBlocking()
+	m := &MilestoneEvent{Action: &zeroValue}
+	m.GetAction()
+	m = &MilestoneEvent{}
+	m.GetAction()
+	m = nil
+	m.GetAction()
 }
 
-func TestInstallationPermissions_GetPackages(tt *testing.T) {
-	var zeroValue string
-	i := &InstallationPermissions{Packages: &zeroValue}
-	i.GetPackages()
-	i = &InstallationPermissions{}
-	i.GetPackages()
-	i = nil
-	i.GetPackages()
+func TestMilestoneEvent_GetChanges(tt *testing.T) {
+	m := &MilestoneEvent{}
+	m.GetChanges()
+	m = nil
+	m.GetChanges()
 }
 
-func TestInstallationPermissions_GetPages(tt *testing.T) {
-	var zeroValue string
-	i := &InstallationPermissions{Pages: &zeroValue}
-	i.GetPages()
-	i = &InstallationPermissions{}
-	i.GetPages()
-	i = nil
-	i.GetPages()
+func TestMilestoneEvent_GetInstallation(tt *testing.T) {
+	m := &MilestoneEvent{}
+	m.GetInstallation()
+	m = nil
+	m.GetInstallation()
 }
 
-func TestInstallationPermissions_GetPullRequests(tt *testing.T) {
-	var zeroValue string
-	i := &InstallationPermissions{PullRequests: &zeroValue}
-	i.GetPullRequests()
-	i = &InstallationPermissions{}
-	i.GetPullRequests()
-	i = nil
-	i.GetPullRequests()
+func TestMilestoneEvent_GetMilestone(tt *testing.T) {
+	m := &MilestoneEvent{}
+	m.GetMilestone()
+	m = nil
+	m.GetMilestone()
 }
 
-func TestInstallationPermissions_GetRepositoryHooks(tt *testing.T) {
-	var zeroValue string
-	i := &InstallationPermissions{RepositoryHooks: &zeroValue}
-	i.GetRepositoryHooks()
-	i = &InstallationPermissions{}
-	i.GetRepositoryHooks()
-	i = nil
-	i.GetRepositoryHooks()
+func TestMilestoneEvent_GetOrg(tt *testing.T) {
+	m := &MilestoneEvent{}
+	m.GetOrg()
+	m = nil
+	m.GetOrg()
 }
 
-func TestInstallationPermissions_GetRepositoryPreReceiveHooks(tt *testing.T) {
-	var zeroValue string
-	i := &InstallationPermissions{RepositoryPreReceiveHooks: &zeroValue}
-	i.GetRepositoryPreReceiveHooks()
-	i = &InstallationPermissions{}
-	i.GetRepositoryPreReceiveHooks()
-	i = nil
-	i.GetRepositoryPreReceiveHooks()
+func TestMilestoneEvent_GetRepo(tt *testing.T) {
+	m := &MilestoneEvent{}
+	m.GetRepo()
+	m = nil
+	m.GetRepo()
 }
 
-func TestInstallationPermissions_GetRepositoryProjects(tt *testing.T) {
-	var zeroValue string
-	i := &InstallationPermissions{RepositoryProjects: &zeroValue}
-	i.GetRepositoryProjects()
-	i = &InstallationPermissions{}
-	i.GetRepositoryProjects()
-	i = nil
-	i.GetRepositoryProjects()
+func TestMilestoneEvent_GetSender(tt *testing.T) {
+	m := &MilestoneEvent{}
+	m.GetSender()
+	m = nil
+	m.GetSender()
 }
 
-func TestInstallationPermissions_GetSingleFile(tt *testing.T) {
-	var zeroValue string
-	i := &InstallationPermissions{SingleFile: &zeroValue}
-	i.GetSingleFile()
-	i = &InstallationPermissions{}
-	i.GetSingleFile()
-	i = nil
-	i.GetSingleFile()
+func TestMilestoneStats_GetClosedMilestones(tt *testing.T) {
+	var zeroValue int
+	m := &MilestoneStats{ClosedMilestones: &zeroValue}
+	m.GetClosedMilestones()
+	m = &MilestoneStats{}
+	m.GetClosedMilestones()
+	m = nil
+	m.GetClosedMilestones()
 }
 
-func TestInstallationPermissions_GetStatuses(tt *testing.T) {
-	var zeroValue string
-	i := &InstallationPermissions{Statuses: &zeroValue}
-	i.GetStatuses()
-	i = &InstallationPermissions{}
-	i.GetStatuses()
-	i = nil
-	i.GetStatuses()
+func TestMilestoneStats_GetOpenMilestones(tt *testing.T) {
+	var zeroValue int
+	m := &MilestoneStats{OpenMilestones: &zeroValue}
+	m.GetOpenMilestones()
+	m = &MilestoneStats{}
+	m.GetOpenMilestones()
+	m = nil
+	m.GetOpenMilestones()
 }
 
-func TestInstallationPermissions_GetTeamDiscussions(tt *testing.T) {
-	var zeroValue string
-	i := &InstallationPermissions{TeamDiscussions: &zeroValue}
-	i.GetTeamDiscussions()
-	i = &InstallationPermissions{}
-	i.GetTeamDiscussions()
-	i = nil
-	i.GetTeamDiscussions()
+func TestMilestoneStats_GetTotalMilestones(tt *testing.T) {
+	var zeroValue int
+	m := &MilestoneStats{TotalMilestones: &zeroValue}
+	m.GetTotalMilestones()
+	m = &MilestoneStats{}
+	m.GetTotalMilestones()
+	m = nil
+	m.GetTotalMilestones()
 }
 
-func TestInstallationPermissions_GetVulnerabilityAlerts(tt *testing.T) {
+func TestNewPullRequest_GetBase(tt *testing.T) {
 	var zeroValue string
-	i := &InstallationPermissions{VulnerabilityAlerts: &zeroValue}
-	i.GetVulnerabilityAlerts()
-	i = &InstallationPermissions{}
-	i.GetVulnerabilityAlerts()
-	i = nil
-	i.GetVulnerabilityAlerts()
+	n := &NewPullRequest{Base: &zeroValue}
+	n.GetBase()
+	n = &NewPullRequest{}
+	n.GetBase()
+	n = nil
+	n.GetBase()
 }
 
-func TestInstallationRepositoriesEvent_GetAction(tt *testing.T) {
+func TestNewPullRequest_GetBody(tt *testing.T) {
 	var zeroValue string
-	i := &InstallationRepositoriesEvent{Action: &zeroValue}
-	i.GetAction()
-	i = &InstallationRepositoriesEvent{}
-	i.GetAction()
-	i = nil
-	i.GetAction()
+	n := &NewPullRequest{Body: &zeroValue}
+	n.GetBody()
+	n = &NewPullRequest{}
+	n.GetBody()
+	n = nil
+	n.GetBody()
 }
 
-func TestInstallationRepositoriesEvent_GetInstallation(tt *testing.T) {
-	i := &InstallationRepositoriesEvent{}
-	i.GetInstallation()
-	i = nil
-	i.GetInstallation()
+func TestNewPullRequest_GetDraft(tt *testing.T) {
+	var zeroValue bool
+	n := &NewPullRequest{Draft: &zeroValue}
+	n.GetDraft()
+	n = &NewPullRequest{}
+	n.GetDraft()
+	n = nil
+	n.GetDraft()
 }
 
-func TestInstallationRepositoriesEvent_GetRepositorySelection(tt *testing.T) {
+func TestNewPullRequest_GetHead(tt *testing.T) {
 	var zeroValue string
-	i := &InstallationRepositoriesEvent{RepositorySelection: &zeroValue}
-	i.GetRepositorySelection()
-	i = &InstallationRepositoriesEvent{}
-	i.GetRepositorySelection()
-	i = nil
-	i.GetRepositorySelection()
+	n := &NewPullRequest{Head: &zeroValue}
+	n.GetHead()
+	n = &NewPullRequest{}
+	n.GetHead()
+	n = nil
+	n.GetHead()
 }
 
-func TestInstallationRepositoriesEvent_GetSender(tt *testing.T) {
-	i := &InstallationRepositoriesEvent{}
-	i.GetSender()
-	i = nil
-	i.GetSender()
+func TestNewPullRequest_GetIssue(tt *testing.T) {
+	var zeroValue int
+	n := &NewPullRequest{Issue: &zeroValue}
+	n.GetIssue()
+	n = &NewPullRequest{}
+	n.GetIssue()
+	n = nil
+	n.GetIssue()
 }
 
-func TestInstallationToken_GetExpiresAt(tt *testing.T) {
-	var zeroValue time.Time
-	i := &InstallationToken{ExpiresAt: &zeroValue}
-	i.GetExpiresAt()
-	i = &InstallationToken{}
-	i.GetExpiresAt()
-	i = nil
-	i.GetExpiresAt()
+func TestNewPullRequest_GetMaintainerCanModify(tt *testing.T) {
+	var zeroValue bool
+	n := &NewPullRequest{MaintainerCanModify: &zeroValue}
+	n.GetMaintainerCanModify()
+	n = &NewPullRequest{}
+	n.GetMaintainerCanModify()
+	n = nil
+	n.GetMaintainerCanModify()
 }
 
-func TestInstallationToken_GetPermissions(tt *testing.T) {
-	i := &InstallationToken{}
-	i.GetPermissions()
-	i = nil
-	i.GetPermissions()
+func TestNewPullRequest_GetTitle(tt *testing.T) {
+	var zeroValue string
+	n := &NewPullRequest{Title: &zeroValue}
+	n.GetTitle()
+	n = &NewPullRequest{}
+	n.GetTitle()
+	n = nil
+	n.GetTitle()
 }
 
-func TestInstallationToken_GetToken(tt *testing.T) {
+func TestNewTeam_GetDescription(tt *testing.T) {
 	var zeroValue string
-	i := &InstallationToken{Token: &zeroValue}
-	i.GetToken()
-	i = &InstallationToken{}
-	i.GetToken()
-	i = nil
-	i.GetToken()
+	n := &NewTeam{Description: &zeroValue}
+	n.GetDescription()
+	n = &NewTeam{}
+	n.GetDescription()
+	n = nil
+	n.GetDescription()
 }
 
-func TestInstallationTokenOptions_GetPermissions(tt *testing.T) {
-	i := &InstallationTokenOptions{}
-	i.GetPermissions()
-	i = nil
-	i.GetPermissions()
+func TestNewTeam_GetLDAPDN(tt *testing.T) {
+	var zeroValue string
+	n := &NewTeam{LDAPDN: &zeroValue}
+	n.GetLDAPDN()
+	n = &NewTeam{}
+	n.GetLDAPDN()
+	n = nil
+	n.GetLDAPDN()
 }
 
-func TestInteractionRestriction_GetExpiresAt(tt *testing.T) {
-	var zeroValue Timestamp
-	i := &InteractionRestriction{ExpiresAt: &zeroValue}
-	i.GetExpiresAt()
-	i = &InteractionRestriction{}
-	i.GetExpiresAt()
-	i = nil
-	i.GetExpiresAt()
+func TestNewTeam_GetParentTeamID(tt *testing.T) {
+	var zeroValue int64
+	n := &NewTeam{ParentTeamID: &zeroValue}
+	n.GetParentTeamID()
+	n = &NewTeam{}
+	n.GetParentTeamID()
+	n = nil
+	n.GetParentTeamID()
 }
 
-func TestInteractionRestriction_GetLimit(tt *testing.T) {
+func TestNewTeam_GetPermission(tt *testing.T) {
 	var zeroValue string
-	i := &InteractionRestriction{Limit: &zeroValue}
-	i.GetLimit()
-	i = &InteractionRestriction{}
-	i.GetLimit()
-	i = nil
-	i.GetLimit()
+	n := &NewTeam{Permission: &zeroValue}
+	n.GetPermission()
+	n = &NewTeam{}
+	n.GetPermission()
+	n = nil
+	n.GetPermission()
 }
 
-func TestInteractionRestriction_GetOrigin(tt *testing.T) {
+func TestNewTeam_GetPrivacy(tt *testing.T) {
 	var zeroValue string
-	i := &InteractionRestriction{Origin: &zeroValue}
-	i.GetOrigin()
-	i = &InteractionRestriction{}
-	i.GetOrigin()
-	i = nil
-	i.GetOrigin()
+	n := &NewTeam{Privacy: &zeroValue}
+	n.GetPrivacy()
+	n = &NewTeam{}
+	n.GetPrivacy()
+	n = nil
+	n.GetPrivacy()
 }
 
-func TestInvitation_GetCreatedAt(tt *testing.T) {
+func TestNotification_GetID(tt *testing.T) {
+	var zeroValue string
+	n := &Notification{ID: &zeroValue}
+	n.GetID()
+	n = &Notification{}
+	n.GetID()
+	n = nil
+	n.GetID()
+}
+
+func TestNotification_GetLastReadAt(tt *testing.T) {
 	var zeroValue time.Time
-	i := &Invitation{CreatedAt: &zeroValue}
-	i.GetCreatedAt()
-	i = &Invitation{}
-	i.GetCreatedAt()
-	i = nil
-	i.GetCreatedAt()
+	n := &Notification{LastReadAt: &zeroValue}
+	n.GetLastReadAt()
+	n = &Notification{}
+	n.GetLastReadAt()
+	n = nil
+	n.GetLastReadAt()
 }
 
-func TestInvitation_GetEmail(tt *testing.T) {
+func TestNotification_GetReason(tt *testing.T) {
 	var zeroValue string
-	i := &Invitation{Email: &zeroValue}
-	i.GetEmail()
-	i = &Invitation{}
-	i.GetEmail()
-	i = nil
-	i.GetEmail()
+	n := &Notification{Reason: &zeroValue}
+	n.GetReason()
+	n = &Notification{}
+	n.GetReason()
+	n = nil
+	n.GetReason()
 }
 
-func TestInvitation_GetID(tt *testing.T) {
-	var zeroValue int64
-	i := &Invitation{ID: &zeroValue}
-	i.GetID()
-	i = &Invitation{}
-	i.GetID()
-	i = nil
-	i.GetID()
+func TestNotification_GetRepository(tt *testing.T) {
+	n := &Notification{}
+	n.GetRepository()
+	n = nil
+	n.GetRepository()
 }
 
-func TestInvitation_GetInvitationTeamURL(tt *testing.T) {
-	var zeroValue string
-	i := &Invitation{InvitationTeamURL: &zeroValue}
-	i.GetInvitationTeamURL()
-	i = &Invitation{}
-	i.GetInvitationTeamURL()
-	i = nil
-	i.GetInvitationTeamURL()
+func TestNotification_GetSubject(tt *testing.T) {
+	n := &Notification{}
+	n.GetSubject()
+	n = nil
+	n.GetSubject()
 }
 
-func TestInvitation_GetInviter(tt *testing.T) {
-	i := &Invitation{}
-	i.GetInviter()
-	i = nil
-	i.GetInviter()
+func TestNotification_GetUnread(tt *testing.T) {
+	var zeroValue bool
+	n := &Notification{Unread: &zeroValue}
+	n.GetUnread()
+	n = &Notification{}
+	n.GetUnread()
+	n = nil
+	n.GetUnread()
 }
 
-func TestInvitation_GetLogin(tt *testing.T) {
+func TestNotification_GetUpdatedAt(tt *testing.T) {
+	var zeroValue time.Time
+	n := &Notification{UpdatedAt: &zeroValue}
+	n.GetUpdatedAt()
+	n = &Notification{}
+	n.GetUpdatedAt()
+	n = nil
+	n.GetUpdatedAt()
+}
+
+func TestNotification_GetURL(tt *testing.T) {
 	var zeroValue string
-	i := &Invitation{Login: &zeroValue}
-	i.GetLogin()
-	i = &Invitation{}
-	i.GetLogin()
-	i = nil
-	i.GetLogin()
+	n := &Notification{URL: &zeroValue}
+	n.GetURL()
+	n = &Notification{}
+	n.GetURL()
+	n = nil
+	n.GetURL()
 }
 
-func TestInvitation_GetNodeID(tt *testing.T) {
+func TestNotificationSubject_GetLatestCommentURL(tt *testing.T) {
 	var zeroValue string
-	i := &Invitation{NodeID: &zeroValue}
-	i.GetNodeID()
-	i = &Invitation{}
-	i.GetNodeID()
-	i = nil
-	i.GetNodeID()
+	n := &NotificationSubject{LatestCommentURL: &zeroValue}
+	n.GetLatestCommentURL()
+	n = &NotificationSubject{}
+	n.GetLatestCommentURL()
+	n = nil
+	n.GetLatestCommentURL()
 }
 
-func TestInvitation_GetRole(tt *testing.T) {
+func TestNotificationSubject_GetTitle(tt *testing.T) {
 	var zeroValue string
-	i := &Invitation{Role: &zeroValue}
-	i.GetRole()
-	i = &Invitation{}
-	i.GetRole()
-	i = nil
-	i.GetRole()
+	n := &NotificationSubject{Title: &zeroValue}
+	n.GetTitle()
+	n = &NotificationSubject{}
+	n.GetTitle()
+	n = nil
+	n.GetTitle()
 }
 
-func TestInvitation_GetTeamCount(tt *testing.T) {
-	var zeroValue int
-	i := &Invitation{TeamCount: &zeroValue}
-	i.GetTeamCount()
-	i = &Invitation{}
-	i.GetTeamCount()
-	i = nil
-	i.GetTeamCount()
+func TestNotificationSubject_GetType(tt *testing.T) {
+	var zeroValue string
+	n := &NotificationSubject{Type: &zeroValue}
+	n.GetType()
+	n = &NotificationSubject{}
+	n.GetType()
+	n = nil
+	n.GetType()
 }
 
-func TestIssue_GetActiveLockReason(tt *testing.T) {
+func TestNotificationSubject_GetURL(tt *testing.T) {
 	var zeroValue string
-	i := &Issue{ActiveLockReason: &zeroValue}
-	i.GetActiveLockReason()
-	i = &Issue{}
-	i.GetActiveLockReason()
-	i = nil
-	i.GetActiveLockReason()
+	n := &NotificationSubject{URL: &zeroValue}
+	n.GetURL()
+	n = &NotificationSubject{}
+	n.GetURL()
+	n = nil
+	n.GetURL()
 }
 
-func TestIssue_GetAssignee(tt *testing.T) {
-	i := &Issue{}
-	i.GetAssignee()
-	i = nil
-	i.GetAssignee()
+func TestOAuthAPP_GetClientID(tt *testing.T) {
+	var zeroValue string
+	o := &OAuthAPP{ClientID: &zeroValue}
+	o.GetClientID()
+	o = &OAuthAPP{}
+	o.GetClientID()
+	o = nil
+	o.GetClientID()
 }
 
-func TestIssue_GetAuthorAssociation(tt *testing.T) {
+func TestOAuthAPP_GetName(tt *testing.T) {
 	var zeroValue string
-	i := &Issue{AuthorAssociation: &zeroValue}
-	i.GetAuthorAssociation()
-	i = &Issue{}
-	i.GetAuthorAssociation()
-	i = nil
-	i.GetAuthorAssociation()
+	o := &OAuthAPP{Name: &zeroValue}
+	o.GetName()
+	o = &OAuthAPP{}
+	o.GetName()
+	o = nil
+	o.GetName()
 }
 
-func TestIssue_GetBody(tt *testing.T) {
+func TestOAuthAPP_GetURL(tt *testing.T) {
 	var zeroValue string
-	i := &Issue{Body: &zeroValue}
-	i.GetBody()
-	i = &Issue{}
-	i.GetBody()
-	i = nil
-	i.GetBody()
+	o := &OAuthAPP{URL: &zeroValue}
+	o.GetURL()
+	o = &OAuthAPP{}
+	o.GetURL()
+	o = nil
+	o.GetURL()
 }
 
-func TestIssue_GetClosedAt(tt *testing.T) {
-	var zeroValue time.Time
-	i := &Issue{ClosedAt: &zeroValue}
-	i.GetClosedAt()
-	i = &Issue{}
-	i.GetClosedAt()
-	i = nil
-	i.GetClosedAt()
+func TestOAuthToken_GetExpiry(tt *testing.T) {
+	var zeroValue Timestamp
+	o := &OAuthToken{Expiry: &zeroValue}
+	o.GetExpiry()
+	o = &OAuthToken{}
+	o.GetExpiry()
+	o = nil
+	o.GetExpiry()
 }
 
-func TestIssue_GetClosedBy(tt *testing.T) {
-	i := &Issue{}
-	i.GetClosedBy()
-	i = nil
-	i.GetClosedBy()
+func TestOAuthToken_GetRefreshTokenExpiry(tt *testing.T) {
+	var zeroValue Timestamp
+	o := &OAuthToken{RefreshTokenExpiry: &zeroValue}
+	o.GetRefreshTokenExpiry()
+	o = &OAuthToken{}
+	o.GetRefreshTokenExpiry()
+	o = nil
+	o.GetRefreshTokenExpiry()
 }
 
-func TestIssue_GetComments(tt *testing.T) {
-	var zeroValue int
-	i := &Issue{Comments: &zeroValue}
-	i.GetComments()
-	i = &Issue{}
-	i.GetComments()
-	i = nil
-	i.GetComments()
+func TestOrganization_GetAvatarURL(tt *testing.T) {
+	var zeroValue string
+	o := &Organization{AvatarURL: &zeroValue}
+	o.GetAvatarURL()
+	o = &Organization{}
+	o.GetAvatarURL()
+	o = nil
+	o.GetAvatarURL()
 }
 
-func TestIssue_GetCommentsURL(tt *testing.T) {
+func TestOrganization_GetBillingEmail(tt *testing.T) {
 	var zeroValue string
-	i := &Issue{CommentsURL: &zeroValue}
-	i.GetCommentsURL()
-	i = &Issue{}
-	i.GetCommentsURL()
-	i = nil
-	i.GetCommentsURL()
+	o := &Organization{BillingEmail: &zeroValue}
+	o.GetBillingEmail()
+	o = &Organization{}
+	o.GetBillingEmail()
+	o = nil
+	o.GetBillingEmail()
 }
 
-func TestIssue_GetCreatedAt(tt *testing.T) {
-	var zeroValue time.Time
-	i := &Issue{CreatedAt: &zeroValue}
-	i.GetCreatedAt()
-	i = &Issue{}
-	i.GetCreatedAt()
-	i = nil
-	i.GetCreatedAt()
+func TestOrganization_GetBlog(tt *testing.T) {
+	var zeroValue string
+	o := &Organization{Blog: &zeroValue}
+	o.GetBlog()
+	o = &Organization{}
+	o.GetBlog()
+	o = nil
+	o.GetBlog()
 }
 
-func TestIssue_GetEventsURL(tt *testing.T) {
-	var zeroValue string
-	i := &Issue{EventsURL: &zeroValue}
-	i.GetEventsURL()
-	i = &Issue{}
-	i.GetEventsURL()
-	i = nil
-	i.GetEventsURL()
+func TestOrganization_GetCollaborators(tt *testing.T) {
+	var zeroValue int
+	o := &Organization{Collaborators: &zeroValue}
+	o.GetCollaborators()
+	o = &Organization{}
+	o.GetCollaborators()
+	o = nil
+	o.GetCollaborators()
 }
 
-func TestIssue_GetHTMLURL(tt *testing.T) {
+func TestOrganization_GetCompany(tt *testing.T) {
 	var zeroValue string
-	i := &Issue{HTMLURL: &zeroValue}
-	i.GetHTMLURL()
-	i = &Issue{}
-	i.GetHTMLURL()
-	i = nil
-	i.GetHTMLURL()
+	o := &Organization{Company: &zeroValue}
+	o.GetCompany()
+	o = &Organization{}
+	o.GetCompany()
+	o = nil
+	o.GetCompany()
 }
 
-func TestIssue_GetID(tt *testing.T) {
-	var zeroValue int64
-	i := &Issue{ID: &zeroValue}
-	i.GetID()
-	i = &Issue{}
-	i.GetID()
-	i = nil
-	i.GetID()
+func TestOrganization_GetCreatedAt(tt *testing.T) {
+	var zeroValue time.Time
+	o := &Organization{CreatedAt: &zeroValue}
+	o.GetCreatedAt()
+	o = &Organization{}
+	o.GetCreatedAt()
+	o = nil
+	o.GetCreatedAt()
 }
 
-func TestIssue_GetLabelsURL(tt *testing.T) {
+func TestOrganization_GetDefaultRepoPermission(tt *testing.T) {
 	var zeroValue string
-	i := &Issue{LabelsURL: &zeroValue}
-	i.GetLabelsURL()
-	i = &Issue{}
-	i.GetLabelsURL()
-	i = nil
-	i.GetLabelsURL()
-}
-
-func TestIssue_GetLocked(tt *testing.T) {
-	var zeroValue bool
-	i := &Issue{Locked: &zeroValue}
-	i.GetLocked()
-	i = &Issue{}
-	i.GetLocked()
-	i = nil
-	i.GetLocked()
+	o := &Organization{DefaultRepoPermission: &zeroValue}
+	o.GetDefaultRepoPermission()
+	o = &Organization{}
+	o.GetDefaultRepoPermission()
+	o = nil
+	o.GetDefaultRepoPermission()
 }
 
-func TestIssue_GetMilestone(tt *testing.T) {
-	i := &Issue{}
-	i.GetMilestone()
-	i = nil
-	i.GetMilestone()
+func TestOrganization_GetDefaultRepoSettings(tt *testing.T) {
+	var zeroValue string
+	o := &Organization{DefaultRepoSettings: &zeroValue}
+	o.GetDefaultRepoSettings()
+	o = &Organization{}
+	o.GetDefaultRepoSettings()
+	o = nil
+	o.GetDefaultRepoSettings()
 }
 
-func TestIssue_GetNodeID(tt *testing.T) {
+func TestOrganization_GetDescription(tt *testing.T) {
 	var zeroValue string
-	i := &Issue{NodeID: &zeroValue}
-	i.GetNodeID()
-	i = &Issue{}
-	i.GetNodeID()
-	i = nil
-	i.GetNodeID()
+	o := &Organization{Description: &zeroValue}
+	o.GetDescription()
+	o = &Organization{}
+	o.GetDescription()
+	o = nil
+	o.GetDescription()
 }
 
-func TestIssue_GetNumber(tt *testing.T) {
+func TestOrganization_GetDiskUsage(tt *testing.T) {
 	var zeroValue int
-	i := &Issue{Number: &zeroValue}
-	i.GetNumber()
-	i = &Issue{}
-	i.GetNumber()
-	i = nil
-	i.GetNumber()
+	o := &Organization{DiskUsage: &zeroValue}
+	o.GetDiskUsage()
+	o = &Organization{}
+	o.GetDiskUsage()
+	o = nil
+	o.GetDiskUsage()
 }
 
-func TestIssue_GetPullRequestLinks(tt *testing.T) {
-	i := &Issue{}
-	i.GetPullRequestLinks()
-	i = nil
-	i.GetPullRequestLinks()
+func TestOrganization_GetEmail(tt *testing.T) {
+	var zeroValue string
+	o := &Organization{Email: &zeroValue}
+	o.GetEmail()
+	o = &Organization{}
+	o.GetEmail()
+	o = nil
+	o.GetEmail()
+}
+
+func TestOrganization_GetEventsURL(tt *testing.T) {
+	var zeroValue string
+	o := &Organization{EventsURL: &zeroValue}
+	o.GetEventsURL()
+	o = &Organization{}
+	o.GetEventsURL()
+	o = nil
+	o.GetEventsURL()
 }
 
-func TestIssue_GetReactions(tt *testing.T) {
-	i := &Issue{}
-	i.GetReactions()
-	i = nil
-	i.GetReactions()
+func TestOrganization_GetFollowers(tt *testing.T) {
+	var zeroValue int
+	o := &Organization{Followers: &zeroValue}
+	o.GetFollowers()
+	o = &Organization{}
+	o.GetFollowers()
+	o = nil
+	o.GetFollowers()
 }
 
-func TestIssue_GetRepository(tt *testing.T) {
-	i := &Issue{}
-	i.GetRepository()
-	i = nil
-	i.GetRepository()
+func TestOrganization_GetFollowing(tt *testing.T) {
+	var zeroValue int
+	o := &Organization{Following: &zeroValue}
+	o.GetFollowing()
+	o = &Organization{}
+	o.GetFollowing()
+	o = nil
+	o.GetFollowing()
 }
 
-func TestIssue_GetRepositoryURL(tt *testing.T) {
-	var zeroValue string
-	i := &Issue{RepositoryURL: &zeroValue}
-	i.GetRepositoryURL()
-	i = &Issue{}
-	i.GetRepositoryURL()
-	i = nil
-	i.GetRepositoryURL()
+func TestOrganization_GetHasOrganizationProjects(tt *testing.T) {
+	var zeroValue bool
+	o := &Organization{HasOrganizationProjects: &zeroValue}
+	o.GetHasOrganizationProjects()
+	o = &Organization{}
+	o.GetHasOrganizationProjects()
+	o = nil
+	o.GetHasOrganizationProjects()
 }
 
-func TestIssue_GetState(tt *testing.T) {
+func TestOrganization_GetHasRepositoryProjects(tt *testing.T) {
+	var zeroValue bool
+	o := &Organization{HasRepositoryProjects: &zeroValue}
+	o.GetHasRepositoryProjects()
+	o = &Organization{}
+	o.GetHasRepositoryProjects()
+	o = nil
+	o.GetHasRepositoryProjects()
+}
+
+func TestOrganization_GetHooksURL(tt *testing.T) {
 	var zeroValue string
-	i := &Issue{State: &zeroValue}
-	i.GetState()
-	i = &Issue{}
-	i.GetState()
-	i = nil
-	i.GetState()
+	o := &Organization{HooksURL: &zeroValue}
+	o.GetHooksURL()
+	o = &Organization{}
+	o.GetHooksURL()
+	o = nil
+	o.GetHooksURL()
 }
 
-func TestIssue_GetTitle(tt *testing.T) {
+func TestOrganization_GetHTMLURL(tt *testing.T) {
 	var zeroValue string
-	i := &Issue{Title: &zeroValue}
-	i.GetTitle()
-	i = &Issue{}
-	i.GetTitle()
-	i = nil
-	i.GetTitle()
+	o := &Organization{HTMLURL: &zeroValue}
+	o.GetHTMLURL()
+	o = &Organization{}
+	o.GetHTMLURL()
+	o = nil
+	o.GetHTMLURL()
 }
 
-func TestIssue_GetUpdatedAt(tt *testing.T) {
-	var zeroValue time.Time
-	i := &Issue{UpdatedAt: &zeroValue}
-	i.GetUpdatedAt()
-	i = &Issue{}
-	i.GetUpdatedAt()
-	i = nil
-	i.GetUpdatedAt()
+func TestOrganization_GetID(tt *testing.T) {
+	var zeroValue int64
+	o := &Organization{ID: &zeroValue}
+	o.GetID()
+	o = &Organization{}
+	o.GetID()
+	o = nil
+	o.GetID()
 }
 
-func TestIssue_GetURL(tt *testing.T) {
+func TestOrganization_GetIssuesURL(tt *testing.T) {
 	var zeroValue string
-	i := &Issue{URL: &zeroValue}
-	i.GetURL()
-	i = &Issue{}
-	i.GetURL()
-	i = nil
-	i.GetURL()
+	o := &Organization{IssuesURL: &zeroValue}
+	o.GetIssuesURL()
+	o = &Organization{}
+	o.GetIssuesURL()
+	o = nil
+	o.GetIssuesURL()
 }
 
-func TestIssue_GetUser(tt *testing.T) {
-	i := &Issue{}
-	i.GetUser()
-	i = nil
-	i.GetUser()
+func TestOrganization_GetIsVerified(tt *testing.T) {
+	var zeroValue bool
+	o := &Organization{IsVerified: &zeroValue}
+	o.GetIsVerified()
+	o = &Organization{}
+	o.GetIsVerified()
+	o = nil
+	o.GetIsVerified()
 }
 
-func TestIssueComment_GetAuthorAssociation(tt *testing.T) {
+func TestOrganization_GetLocation(tt *testing.T) {
 	var zeroValue string
-	i := &IssueComment{AuthorAssociation: &zeroValue}
-	i.GetAuthorAssociation()
-	i = &IssueComment{}
-	i.GetAuthorAssociation()
-	i = nil
-	i.GetAuthorAssociation()
+	o := &Organization{Location: &zeroValue}
+	o.GetLocation()
+	o = &Organization{}
+	o.GetLocation()
+	o = nil
+	o.GetLocation()
 }
 
-func TestIssueComment_GetBody(tt *testing.T) {
+func TestOrganization_GetLogin(tt *testing.T) {
 	var zeroValue string
-	i := &IssueComment{Body: &zeroValue}
-	i.GetBody()
-	i = &IssueComment{}
-	i.GetBody()
-	i = nil
-	i.GetBody()
+	o := &Organization{Login: &zeroValue}
+	o.GetLogin()
+	o = &Organization{}
+	o.GetLogin()
+	o = nil
+	o.GetLogin()
 }
 
-func TestIssueComment_GetCreatedAt(tt *testing.T) {
-	var zeroValue time.Time
-	i := &IssueComment{CreatedAt: &zeroValue}
-	i.GetCreatedAt()
-	i = &IssueComment{}
-	i.GetCreatedAt()
-	i = nil
-	i.GetCreatedAt()
+func TestOrganization_GetMembersAllowedRepositoryCreationType(tt *testing.T) {
+	var zeroValue string
+	o := &Organization{MembersAllowedRepositoryCreationType: &zeroValue}
+	o.GetMembersAllowedRepositoryCreationType()
+	o = &Organization{}
+	o.GetMembersAllowedRepositoryCreationType()
+	o = nil
+	o.GetMembersAllowedRepositoryCreationType()
 }
 
-func TestIssueComment_GetHTMLURL(tt *testing.T) {
-	var zeroValue string
-	i := &IssueComment{HTMLURL: &zeroValue}
-	i.GetHTMLURL()
-	i = &IssueComment{}
-	i.GetHTMLURL()
-	i = nil
-	i.GetHTMLURL()
+func TestOrganization_GetMembersCanCreateInternalRepos(tt *testing.T) {
+	var zeroValue bool
+	o := &Organization{MembersCanCreateInternalRepos: &zeroValue}
+	o.GetMembersCanCreateInternalRepos()
+	o = &Organization{}
+	o.GetMembersCanCreateInternalRepos()
+	o = nil
+	o.GetMembersCanCreateInternalRepos()
 }
 
-func TestIssueComment_GetID(tt *testing.T) {
-	var zeroValue int64
-	i := &IssueComment{ID: &zeroValue}
-	i.GetID()
-	i = &IssueComment{}
-	i.GetID()
-	i = nil
-	i.GetID()
+func TestOrganization_GetMembersCanCreatePrivateRepos(tt *testing.T) {
+	var zeroValue bool
+	o := &Organization{MembersCanCreatePrivateRepos: &zeroValue}
+	o.GetMembersCanCreatePrivateRepos()
+	o = &Organization{}
+	o.GetMembersCanCreatePrivateRepos()
+	o = nil
+	o.GetMembersCanCreatePrivateRepos()
 }
 
-func TestIssueComment_GetIssueURL(tt *testing.T) {
-	var zeroValue string
-	i := &IssueComment{IssueURL: &zeroValue}
-	i.GetIssueURL()
-	i = &IssueComment{}
-	i.GetIssueURL()
-	i = nil
-	i.GetIssueURL()
+func TestOrganization_GetMembersCanCreatePublicRepos(tt *testing.T) {
+	var zeroValue bool
+	o := &Organization{MembersCanCreatePublicRepos: &zeroValue}
+	o.GetMembersCanCreatePublicRepos()
+	o = &Organization{}
+	o.GetMembersCanCreatePublicRepos()
+	o = nil
+	o.GetMembersCanCreatePublicRepos()
 }
 
-func TestIssueComment_GetNodeID(tt *testing.T) {
-	var zeroValue string
-	i := &IssueComment{NodeID: &zeroValue}
-	i.GetNodeID()
-	i = &IssueComment{}
-	i.GetNodeID()
-	i = nil
-	i.GetNodeID()
+func TestOrganization_GetMembersCanCreateRepos(tt *testing.T) {
+	var zeroValue bool
+	o := &Organization{MembersCanCreateRepos: &zeroValue}
+	o.GetMembersCanCreateRepos()
+	o = &Organization{}
+	o.GetMembersCanCreateRepos()
+	o = nil
+	o.GetMembersCanCreateRepos()
 }
 
-func TestIssueComment_GetReactions(tt *testing.T) {
-	i := &IssueComment{}
-	i.GetReactions()
-	i = nil
-	i.GetReactions()
+func TestOrganization_GetMembersURL(tt *testing.T) {
+	var zeroValue string
+	o := &Organization{MembersURL: &zeroValue}
+	o.GetMembersURL()
+	o = &Organization{}
+	o.GetMembersURL()
+	o = nil
+	o.GetMembersURL()
 }
 
-func TestIssueComment_GetUpdatedAt(tt *testing.T) {
-	var zeroValue time.Time
-	i := &IssueComment{UpdatedAt: &zeroValue}
-	i.GetUpdatedAt()
-	i = &IssueComment{}
-	i.GetUpdatedAt()
-	i = nil
-	i.GetUpdatedAt()
+func TestOrganization_GetName(tt *testing.T) {
+	var zeroValue string
+	o := &Organization{Name: &zeroValue}
+	o.GetName()
+	o = &Organization{}
+	o.GetName()
+	o = nil
+	o.GetName()
 }
 
-func TestIssueComment_GetURL(tt *testing.T) {
+func TestOrganization_GetNodeID(tt *testing.T) {
 	var zeroValue string
-	i := &IssueComment{URL: &zeroValue}
-	i.GetURL()
-	i = &IssueComment{}
-	i.GetURL()
-	i = nil
-	i.GetURL()
+	o := &Organization{NodeID: &zeroValue}
+	o.GetNodeID()
+	o = &Organization{}
+	o.GetNodeID()
+	o = nil
+	o.GetNodeID()
 }
 
-func TestIssueComment_GetUser(tt *testing.T) {
-	i := &IssueComment{}
-	i.GetUser()
-	i = nil
-	i.GetUser()
+func TestOrganization_GetOwnedPrivateRepos(tt *testing.T) {
+	var zeroValue int
+	o := &Organization{OwnedPrivateRepos: &zeroValue}
+	o.GetOwnedPrivateRepos()
+	o = &Organization{}
+	o.GetOwnedPrivateRepos()
+	o = nil
+	o.GetOwnedPrivateRepos()
 }
 
-func TestIssueCommentEvent_GetAction(tt *testing.T) {
-	var zeroValue string
-	i := &IssueCommentEvent{Action: &zeroValue}
-	i.GetAction()
-	i = &IssueCommentEvent{}
-	i.GetAction()
-	i = nil
-	i.GetAction()
+func TestOrganization_GetPlan(tt *testing.T) {
+	o := &Organization{}
+	o.GetPlan()
+	o = nil
+	o.GetPlan()
 }
 
-func TestIssueCommentEvent_GetChanges(tt *testing.T) {
-	i := &IssueCommentEvent{}
-	i.GetChanges()
-	i = nil
-	i.GetChanges()
+func TestOrganization_GetPrivateGists(tt *testing.T) {
+	var zeroValue int
+	o := &Organization{PrivateGists: &zeroValue}
+	o.GetPrivateGists()
+	o = &Organization{}
+	o.GetPrivateGists()
+	o = nil
+	o.GetPrivateGists()
 }
 
-func TestIssueCommentEvent_GetComment(tt *testing.T) {
-	i := &IssueCommentEvent{}
-	i.GetComment()
-	i = nil
-	i.GetComment()
+func TestOrganization_GetPublicGists(tt *testing.T) {
+	var zeroValue int
+	o := &Organization{PublicGists: &zeroValue}
+	o.GetPublicGists()
+	o = &Organization{}
+	o.GetPublicGists()
+	o = nil
+	o.GetPublicGists()
 }
 
-func TestIssueCommentEvent_GetInstallation(tt *testing.T) {
-	i := &IssueCommentEvent{}
-	i.GetInstallation()
-	i = nil
-	i.GetInstallation()
+func TestOrganization_GetPublicMembersURL(tt *testing.T) {
+	var zeroValue string
+	o := &Organization{PublicMembersURL: &zeroValue}
+	o.GetPublicMembersURL()
+	o = &Organization{}
+	o.GetPublicMembersURL()
+	o = nil
+	o.GetPublicMembersURL()
 }
 
-func TestIssueCommentEvent_GetIssue(tt *testing.T) {
-	i := &IssueCommentEvent{}
-	i.GetIssue()
-	i = nil
-	i.GetIssue()
+func TestOrganization_GetPublicRepos(tt *testing.T) {
+	var zeroValue int
+	o := &Organization{PublicRepos: &zeroValue}
+	o.GetPublicRepos()
+	o = &Organization{}
+	o.GetPublicRepos()
+	o = nil
+	o.GetPublicRepos()
 }
 
-func TestIssueCommentEvent_GetRepo(tt *testing.T) {
-	i := &IssueCommentEvent{}
-	i.GetRepo()
-	i = nil
-	i.GetRepo()
+func TestOrganization_GetReposURL(tt *testing.T) {
+	var zeroValue string
+	o := &Organization{ReposURL: &zeroValue}
+	o.GetReposURL()
+	o = &Organization{}
+	o.GetReposURL()
+	o = nil
+	o.GetReposURL()
 }
 
-func TestIssueCommentEvent_GetSender(tt *testing.T) {
-	i := &IssueCommentEvent{}
-	i.GetSender()
-	i = nil
-	i.GetSender()
+func TestOrganization_GetTotalPrivateRepos(tt *testing.T) {
+	var zeroValue int
+	o := &Organization{TotalPrivateRepos: &zeroValue}
+	o.GetTotalPrivateRepos()
+	o = &Organization{}
+	o.GetTotalPrivateRepos()
+	o = nil
+	o.GetTotalPrivateRepos()
 }
 
-func TestIssueEvent_GetActor(tt *testing.T) {
-	i := &IssueEvent{}
-	i.GetActor()
-	i = nil
-	i.GetActor()
+func TestOrganization_GetTwitterUsername(tt *testing.T) {
+	var zeroValue string
+	o := &Organization{TwitterUsername: &zeroValue}
+	o.GetTwitterUsername()
+	o = &Organization{}
+	o.GetTwitterUsername()
+	o = nil
+	o.GetTwitterUsername()
 }
 
-func TestIssueEvent_GetAssignee(tt *testing.T) {
-	i := &IssueEvent{}
-	i.GetAssignee()
-	i = nil
-	i.GetAssignee()
+func TestOrganization_GetTwoFactorRequirementEnabled(tt *testing.T) {
+	var zeroValue bool
+	o := &Organization{TwoFactorRequirementEnabled: &zeroValue}
+	o.GetTwoFactorRequirementEnabled()
+	o = &Organization{}
+	o.GetTwoFactorRequirementEnabled()
+	o = nil
+	o.GetTwoFactorRequirementEnabled()
 }
 
-func TestIssueEvent_GetAssigner(tt *testing.T) {
-	i := &IssueEvent{}
-	i.GetAssigner()
-	i = nil
-	i.GetAssigner()
+func TestOrganization_GetType(tt *testing.T) {
+	var zeroValue string
+	o := &Organization{Type: &zeroValue}
+	o.GetType()
+	o = &Organization{}
+	o.GetType()
+	o = nil
+	o.GetType()
 }
 
-func TestIssueEvent_GetCommitID(tt *testing.T) {
+func TestOrganization_GetUpdatedAt(tt *testing.T) {
+	var zeroValue time.Time
+	o := &Organization{UpdatedAt: &zeroValue}
+	o.GetUpdatedAt()
+	o = &Organization{}
+	o.GetUpdatedAt()
+	o = nil
+	o.GetUpdatedAt()
+}
+
+func TestOrganization_GetURL(tt *testing.T) {
 	var zeroValue string
-	i := &IssueEvent{CommitID: &zeroValue}
-	i.GetCommitID()
-	i = &IssueEvent{}
-	i.GetCommitID()
-	i = nil
-	i.GetCommitID()
+	o := &Organization{URL: &zeroValue}
+	o.GetURL()
+	o = &Organization{}
+	o.GetURL()
+	o = nil
+	o.GetURL()
 }
 
-func TestIssueEvent_GetCreatedAt(tt *testing.T) {
-	var zeroValue time.Time
-	i := &IssueEvent{CreatedAt: &zeroValue}
-	i.GetCreatedAt()
-	i = &IssueEvent{}
-	i.GetCreatedAt()
-	i = nil
-	i.GetCreatedAt()
+func TestOrganizationCustomRepoRoles_GetTotalCount(tt *testing.T) {
+	var zeroValue int
+	o := &OrganizationCustomRepoRoles{TotalCount: &zeroValue}
+	o.GetTotalCount()
+	o = &OrganizationCustomRepoRoles{}
+	o.GetTotalCount()
+	o = nil
+	o.GetTotalCount()
 }
 
-func TestIssueEvent_GetDismissedReview(tt *testing.T) {
-	i := &IssueEvent{}
-	i.GetDismissedReview()
-	i = nil
-	i.GetDismissedReview()
+func TestOrganizationCustomRoles_GetTotalCount(tt *testing.T) {
+	var zeroValue int
+	o := &OrganizationCustomRoles{TotalCount: &zeroValue}
+	o.GetTotalCount()
+	o = &OrganizationCustomRoles{}
+	o.GetTotalCount()
+	o = nil
+	o.GetTotalCount()
 }
 
-func TestIssueEvent_GetEvent(tt *testing.T) {
+func TestOrganizationEvent_GetAction(tt *testing.T) {
 	var zeroValue string
-	i := &IssueEvent{Event: &zeroValue}
-	i.GetEvent()
-	i = &IssueEvent{}
-	i.GetEvent()
-	i = nil
-	i.GetEvent()
+	o := &OrganizationEvent{Action: &zeroValue}
+	o.GetAction()
+	o = &OrganizationEvent{}
+	o.GetAction()
+	o = nil
+	o.GetAction()
 }
 
-func TestIssueEvent_GetID(tt *testing.T) {
-	var zeroValue int64
-	i := &IssueEvent{ID: &zeroValue}
-	i.GetID()
-	i = &IssueEvent{}
-	i.GetID()
-	i = nil
-	i.GetID()
+func TestOrganizationEvent_GetInstallation(tt *testing.T) {
+	o := &OrganizationEvent{}
+	o.GetInstallation()
+	o = nil
+	o.GetInstallation()
 }
 
-func TestIssueEvent_GetIssue(tt *testing.T) {
-	i := &IssueEvent{}
-	i.GetIssue()
-	i = nil
-	i.GetIssue()
+func TestOrganizationEvent_GetInvitation(tt *testing.T) {
+	o := &OrganizationEvent{}
+	o.GetInvitation()
+	o = nil
+	o.GetInvitation()
 }
 
-func TestIssueEvent_GetLabel(tt *testing.T) {
-	i := &IssueEvent{}
-	i.GetLabel()
-	i = nil
-	i.GetLabel()
+func TestOrganizationEvent_GetMembership(tt *testing.T) {
+	o := &OrganizationEvent{}
+	o.GetMembership()
+	o = nil
+	o.GetMembership()
 }
 
-func TestIssueEvent_GetLockReason(tt *testing.T) {
-	var zeroValue string
-	i := &IssueEvent{LockReason: &zeroValue}
-	i.GetLockReason()
-	i = &IssueEvent{}
-	i.GetLockReason()
-	i = nil
-	i.GetLockReason()
+func TestOrganizationEvent_GetOrganization(tt *testing.T) {
+	o := &OrganizationEvent{}
+	o.GetOrganization()
+	o = nil
+	o.GetOrganization()
 }
 
-func TestIssueEvent_GetMilestone(tt *testing.T) {
-	i := &IssueEvent{}
-	i.GetMilestone()
-	i = nil
-	i.GetMilestone()
+func TestOrganizationEvent_GetSender(tt *testing.T) {
+	o := &OrganizationEvent{}
+	o.GetSender()
+	o = nil
+	o.GetSender()
 }
 
-func TestIssueEvent_GetProjectCard(tt *testing.T) {
-	i := &IssueEvent{}
-	i.GetProjectCard()
-	i = nil
-	i.GetProjectCard()
+func TestOrganizationInstallations_GetTotalCount(tt *testing.T) {
+	var zeroValue int
+	o := &OrganizationInstallations{TotalCount: &zeroValue}
+	o.GetTotalCount()
+	o = &OrganizationInstallations{}
+	o.GetTotalCount()
+	o = nil
+	o.GetTotalCount()
 }
 
-func TestIssueEvent_GetRename(tt *testing.T) {
-	i := &IssueEvent{}
-	i.GetRename()
-	i = nil
-	i.GetRename()
+func TestOrgBlockEvent_GetAction(tt *testing.T) {
+	var zeroValue string
+	o := &OrgBlockEvent{Action: &zeroValue}
+	o.GetAction()
+	o = &OrgBlockEvent{}
+	o.GetAction()
+	o = nil
+	o.GetAction()
 }
 
-func TestIssueEvent_GetRequestedReviewer(tt *testing.T) {
-	i := &IssueEvent{}
-	i.GetRequestedReviewer()
-	i = nil
-	i.GetRequestedReviewer()
+func TestOrgBlockEvent_GetBlockedUser(tt *testing.T) {
+	o := &OrgBlockEvent{}
+	o.GetBlockedUser()
+	o = nil
+	o.GetBlockedUser()
 }
 
-func TestIssueEvent_GetReviewRequester(tt *testing.T) {
-	i := &IssueEvent{}
-	i.GetReviewRequester()
-	i = nil
-	i.GetReviewRequester()
+func TestOrgBlockEvent_GetInstallation(tt *testing.T) {
+	o := &OrgBlockEvent{}
+	o.GetInstallation()
+	o = nil
+	o.GetInstallation()
 }
 
-func TestIssueEvent_GetURL(tt *testing.T) {
-	var zeroValue string
-	i := &IssueEvent{URL: &zeroValue}
-	i.GetURL()
-	i = &IssueEvent{}
-	i.GetURL()
-	i = nil
-	i.GetURL()
+func TestOrgBlockEvent_GetOrganization(tt *testing.T) {
+	o := &OrgBlockEvent{}
+	o.GetOrganization()
+	o = nil
+	o.GetOrganization()
 }
 
-func TestIssueImport_GetAssignee(tt *testing.T) {
-	var zeroValue string
-	i := &IssueImport{Assignee: &zeroValue}
-	i.GetAssignee()
-	i = &IssueImport{}
-	i.GetAssignee()
-	i = nil
-	i.GetAssignee()
+func TestOrgBlockEvent_GetSender(tt *testing.T) {
+	o := &OrgBlockEvent{}
+	o.GetSender()
+	o = nil
+	o.GetSender()
 }
 
-func TestIssueImport_GetClosed(tt *testing.T) {
-	var zeroValue bool
-	i := &IssueImport{Closed: &zeroValue}
-	i.GetClosed()
-	i = &IssueImport{}
-	i.GetClosed()
-	i = nil
-	i.GetClosed()
+func TestOrgStats_GetDisabledOrgs(tt *testing.T) {
+	var zeroValue int
+	o := &OrgStats{DisabledOrgs: &zeroValue}
+	o.GetDisabledOrgs()
+	o = &OrgStats{}
+	o.GetDisabledOrgs()
+	o = nil
+	o.GetDisabledOrgs()
 }
 
-func TestIssueImport_GetClosedAt(tt *testing.T) {
-	var zeroValue time.Time
-	i := &IssueImport{ClosedAt: &zeroValue}
-	i.GetClosedAt()
-	i = &IssueImport{}
-	i.GetClosedAt()
-	i = nil
-	i.GetClosedAt()
+func TestOrgStats_GetTotalOrgs(tt *testing.T) {
+	var zeroValue int
+	o := &OrgStats{TotalOrgs: &zeroValue}
+	o.GetTotalOrgs()
+	o = &OrgStats{}
+	o.GetTotalOrgs()
+	o = nil
+	o.GetTotalOrgs()
 }
 
-func TestIssueImport_GetCreatedAt(tt *testing.T) {
-	var zeroValue time.Time
-	i := &IssueImport{CreatedAt: &zeroValue}
-	i.GetCreatedAt()
-	i = &IssueImport{}
-	i.GetCreatedAt()
-	i = nil
-	i.GetCreatedAt()
+func TestOrgStats_GetTotalTeamMembers(tt *testing.T) {
+	var zeroValue int
+	o := &OrgStats{TotalTeamMembers: &zeroValue}
+	o.GetTotalTeamMembers()
+	o = &OrgStats{}
+	o.GetTotalTeamMembers()
+	o = nil
+	o.GetTotalTeamMembers()
 }
 
-func TestIssueImport_GetMilestone(tt *testing.T) {
+func TestOrgStats_GetTotalTeams(tt *testing.T) {
 	var zeroValue int
-	i := &IssueImport{Milestone: &zeroValue}
-	i.GetMilestone()
-	i = &IssueImport{}
-	i.GetMilestone()
-	i = nil
-	i.GetMilestone()
+	o := &OrgStats{TotalTeams: &zeroValue}
+	o.GetTotalTeams()
+	o = &OrgStats{}
+	o.GetTotalTeams()
+	o = nil
+	o.GetTotalTeams()
 }
 
-func TestIssueImport_GetUpdatedAt(tt *testing.T) {
-	var zeroValue time.Time
-	i := &IssueImport{UpdatedAt: &zeroValue}
-	i.GetUpdatedAt()
-	i = &IssueImport{}
-	i.GetUpdatedAt()
-	i = nil
-	i.GetUpdatedAt()
+func TestPackage_GetCreatedAt(tt *testing.T) {
+	var zeroValue Timestamp
+	p := &Package{CreatedAt: &zeroValue}
+	p.GetCreatedAt()
+	p = &Package{}
+	p.GetCreatedAt()
+	p = nil
+	p.GetCreatedAt()
 }
 
-func TestIssueImportError_GetCode(tt *testing.T) {
+func TestPackage_GetHTMLURL(tt *testing.T) {
 	var zeroValue string
-	i := &IssueImportError{Code: &zeroValue}
-	i.GetCode()
-	i = &IssueImportError{}
-	i.GetCode()
-	i = nil
-	i.GetCode()
+	p := &Package{HTMLURL: &zeroValue}
+	p.GetHTMLURL()
+	p = &Package{}
+	p.GetHTMLURL()
+	p = nil
+	p.GetHTMLURL()
 }
 
-func TestIssueImportError_GetField(tt *testing.T) {
-	var zeroValue string
-	i := &IssueImportError{Field: &zeroValue}
-	i.GetField()
-	i = &IssueImportError{}
-	i.GetField()
-	i = nil
-	i.GetField()
+func TestPackage_GetID(tt *testing.T) {
+	var zeroValue int64
+	p := &Package{ID: &zeroValue}
+	p.GetID()
+	p = &Package{}
+	p.GetID()
+	p = nil
+	p.GetID()
 }
 
-func TestIssueImportError_GetLocation(tt *testing.T) {
+func TestPackage_GetName(tt *testing.T) {
 	var zeroValue string
-	i := &IssueImportError{Location: &zeroValue}
-	i.GetLocation()
-	i = &IssueImportError{}
-	i.GetLocation()
-	i = nil
-	i.GetLocation()
+	p := &Package{Name: &zeroValue}
+	p.GetName()
+	p = &Package{}
+	p.GetName()
+	p = nil
+	p.GetName()
 }
 
-func TestIssueImportError_GetResource(tt *testing.T) {
-	var zeroValue string
-	i := &IssueImportError{Resource: &zeroValue}
-	i.GetResource()
-	i = &IssueImportError{}
-	i.GetResource()
-	i = nil
-	i.GetResource()
+func TestPackage_GetOwner(tt *testing.T) {
+	p := &Package{}
+	p.GetOwner()
+	p = nil
+	p.GetOwner()
 }
 
-func TestIssueImportError_GetValue(tt *testing.T) {
+func TestPackage_GetPackageType(tt *testing.T) {
 	var zeroValue string
-	i := &IssueImportError{Value: &zeroValue}
-	i.GetValue()
-	i = &IssueImportError{}
-	i.GetValue()
-	i = nil
-	i.GetValue()
+	p := &Package{PackageType: &zeroValue}
+	p.GetPackageType()
+	p = &Package{}
+	p.GetPackageType()
+	p = nil
+	p.GetPackageType()
 }
 
-func TestIssueImportResponse_GetCreatedAt(tt *testing.T) {
-	var zeroValue time.Time
-	i := &IssueImportResponse{CreatedAt: &zeroValue}
-	i.GetCreatedAt()
-	i = &IssueImportResponse{}
-	i.GetCreatedAt()
-	i = nil
-	i.GetCreatedAt()
+func TestPackage_GetPackageVersion(tt *testing.T) {
+	p := &Package{}
+	p.GetPackageVersion()
+	p = nil
+	p.GetPackageVersion()
 }
 
-func TestIssueImportResponse_GetDocumentationURL(tt *testing.T) {
-	var zeroValue string
-	i := &IssueImportResponse{DocumentationURL: &zeroValue}
-	i.GetDocumentationURL()
-	i = &IssueImportResponse{}
-	i.GetDocumentationURL()
-	i = nil
-	i.GetDocumentationURL()
+func TestPackage_GetRegistry(tt *testing.T) {
+	p := &Package{}
+	p.GetRegistry()
+	p = nil
+	p.GetRegistry()
 }
 
-func TestIssueImportResponse_GetID(tt *testing.T) {
-	var zeroValue int
-	i := &IssueImportResponse{ID: &zeroValue}
-	i.GetID()
-	i = &IssueImportResponse{}
-	i.GetID()
-	i = nil
-	i.GetID()
+func TestPackage_GetRepository(tt *testing.T) {
+	p := &Package{}
+	p.GetRepository()
+	p = nil
+	p.GetRepository()
 }
 
-func TestIssueImportResponse_GetImportIssuesURL(tt *testing.T) {
-	var zeroValue string
-	i := &IssueImportResponse{ImportIssuesURL: &zeroValue}
-	i.GetImportIssuesURL()
-	i = &IssueImportResponse{}
-	i.GetImportIssuesURL()
-	i = nil
-	i.GetImportIssuesURL()
+func TestPackage_GetUpdatedAt(tt *testing.T) {
+	var zeroValue Timestamp
+	p := &Package{UpdatedAt: &zeroValue}
+	p.GetUpdatedAt()
+	p = &Package{}
+	p.GetUpdatedAt()
+	p = nil
+	p.GetUpdatedAt()
 }
 
-func TestIssueImportResponse_GetMessage(tt *testing.T) {
+func TestPackage_GetURL(tt *testing.T) {
 	var zeroValue string
-	i := &IssueImportResponse{Message: &zeroValue}
-	i.GetMessage()
-	i = &IssueImportResponse{}
-	i.GetMessage()
-	i = nil
-	i.GetMessage()
+	p := &Package{URL: &zeroValue}
+	p.GetURL()
+	p = &Package{}
+	p.GetURL()
+	p = nil
+	p.GetURL()
 }
 
-func TestIssueImportResponse_GetRepositoryURL(tt *testing.T) {
-	var zeroValue string
-	i := &IssueImportResponse{RepositoryURL: &zeroValue}
-	i.GetRepositoryURL()
-	i = &IssueImportResponse{}
-	i.GetRepositoryURL()
-	i = nil
-	i.GetRepositoryURL()
+func TestPackage_GetVersionCount(tt *testing.T) {
+	var zeroValue int64
+	p := &Package{VersionCount: &zeroValue}
+	p.GetVersionCount()
+	p = &Package{}
+	p.GetVersionCount()
+	p = nil
+	p.GetVersionCount()
 }
 
-func TestIssueImportResponse_GetStatus(tt *testing.T) {
+func TestPackage_GetVisibility(tt *testing.T) {
 	var zeroValue string
-	i := &IssueImportResponse{Status: &zeroValue}
-	i.GetStatus()
-	i = &IssueImportResponse{}
-	i.GetStatus()
-	i = nil
-	i.GetStatus()
+	p := &Package{Visibility: &zeroValue}
+	p.GetVisibility()
+	p = &Package{}
+	p.GetVisibility()
+	p = nil
+	p.GetVisibility()
 }
 
-func TestIssueImportResponse_GetUpdatedAt(tt *testing.T) {
-	var zeroValue time.Time
-	i := &IssueImportResponse{UpdatedAt: &zeroValue}
-	i.GetUpdatedAt()
-	i = &IssueImportResponse{}
-	i.GetUpdatedAt()
-	i = nil
-	i.GetUpdatedAt()
+func TestPackageEvent_GetAction(tt *testing.T) {
+	var zeroValue string
+	p := &PackageEvent{Action: &zeroValue}
+	p.GetAction()
+	p = &PackageEvent{}
+	p.GetAction()
+	p = nil
+	p.GetAction()
 }
 
-func TestIssueImportResponse_GetURL(tt *testing.T) {
-	var zeroValue string
-	i := &IssueImportResponse{URL: &zeroValue}
-	i.GetURL()
-	i = &IssueImportResponse{}
-	i.GetURL()
-	i = nil
-	i.GetURL()
+func TestPackageEvent_GetOrg(tt *testing.T) {
+	p := &PackageEvent{}
+	p.GetOrg()
+	p = nil
+	p.GetOrg()
 }
 
-func TestIssueListCommentsOptions_GetSince(tt *testing.T) {
-	var zeroValue time.Time
-	i := &IssueListCommentsOptions{Since: &zeroValue}
-	i.GetSince()
-	i = &IssueListCommentsOptions{}
-	i.GetSince()
-	i = nil
-	i.GetSince()
+func TestPackageEvent_GetPackage(tt *testing.T) {
+	p := &PackageEvent{}
+	p.GetPackage()
+	p = nil
+	p.GetPackage()
 }
 
-func TestIssueRequest_GetAssignee(tt *testing.T) {
-	var zeroValue string
-	i := &IssueRequest{Assignee: &zeroValue}
-	i.GetAssignee()
-	i = &IssueRequest{}
-	i.GetAssignee()
-	i = nil
-	i.GetAssignee()
+func TestPackageEvent_GetRepo(tt *testing.T) {
+	p := &PackageEvent{}
+	p.GetRepo()
+	p = nil
+	p.GetRepo()
 }
 
-func TestIssueRequest_GetAssignees(tt *testing.T) {
-	var zeroValue []string
-	i := &IssueRequest{Assignees: &zeroValue}
-	i.GetAssignees()
-	i = &IssueRequest{}
-	i.GetAssignees()
-	i = nil
-	i.GetAssignees()
+func TestPackageEvent_GetSender(tt *testing.T) {
+	p := &PackageEvent{}
+	p.GetSender()
+	p = nil
+	p.GetSender()
 }
 
-func TestIssueRequest_GetBody(tt *testing.T) {
+func TestPackageExternalRef_GetReferenceCategory(tt *testing.T) {
 	var zeroValue string
-	i := &IssueRequest{Body: &zeroValue}
-	i.GetBody()
-	i = &IssueRequest{}
-	i.GetBody()
-	i = nil
-	i.GetBody()
+	p := &PackageExternalRef{ReferenceCategory: &zeroValue}
+	p.GetReferenceCategory()
+	p = &PackageExternalRef{}
+	p.GetReferenceCategory()
+	p = nil
+	p.GetReferenceCategory()
 }
 
-func TestIssueRequest_GetLabels(tt *testing.T) {
-	var zeroValue []string
-	i := &IssueRequest{Labels: &zeroValue}
-	i.GetLabels()
-	i = &IssueRequest{}
-	i.GetLabels()
-	i = nil
-	i.GetLabels()
+func TestPackageExternalRef_GetReferenceLocator(tt *testing.T) {
+	var zeroValue string
+	p := &PackageExternalRef{ReferenceLocator: &zeroValue}
+	p.GetReferenceLocator()
+	p = &PackageExternalRef{}
+	p.GetReferenceLocator()
+	p = nil
+	p.GetReferenceLocator()
 }
 
-func TestIssueRequest_GetMilestone(tt *testing.T) {
-	var zeroValue int
-	i := &IssueRequest{Milestone: &zeroValue}
-	i.GetMilestone()
-	i = &IssueRequest{}
-	i.GetMilestone()
-	i = nil
-	i.GetMilestone()
+func TestPackageExternalRef_GetReferenceType(tt *testing.T) {
+	var zeroValue string
+	p := &PackageExternalRef{ReferenceType: &zeroValue}
+	p.GetReferenceType()
+	p = &PackageExternalRef{}
+	p.GetReferenceType()
+	p = nil
+	p.GetReferenceType()
 }
 
-func TestIssueRequest_GetState(tt *testing.T) {
-	var zeroValue string
-	i := &IssueRequest{State: &zeroValue}
-	i.GetState()
-	i = &IssueRequest{}
-	i.GetState()
-	i = nil
-	i.GetState()
+func TestPackageFile_GetAuthor(tt *testing.T) {
+	p := &PackageFile{}
+	p.GetAuthor()
+	p = nil
+	p.GetAuthor()
 }
 
-func TestIssueRequest_GetTitle(tt *testing.T) {
+func TestPackageFile_GetContentType(tt *testing.T) {
 	var zeroValue string
-	i := &IssueRequest{Title: &zeroValue}
-	i.GetTitle()
-	i = &IssueRequest{}
-	i.GetTitle()
-	i = nil
-	i.GetTitle()
+	p := &PackageFile{ContentType: &zeroValue}
+	p.GetContentType()
+	p = &PackageFile{}
+	p.GetContentType()
+	p = nil
+	p.GetContentType()
 }
 
-func TestIssuesEvent_GetAction(tt *testing.T) {
-	var zeroValue string
-	i := &IssuesEvent{Action: &zeroValue}
-	i.GetAction()
-	i = &IssuesEvent{}
-	i.GetAction()
-	i = nil
-	i.GetAction()
+func TestPackageFile_GetCreatedAt(tt *testing.T) {
+	var zeroValue Timestamp
+	p := &PackageFile{CreatedAt: &zeroValue}
+	p.GetCreatedAt()
+	p = &PackageFile{}
+	p.GetCreatedAt()
+	p = nil
+	p.GetCreatedAt()
 }
 
-func TestIssuesEvent_GetAssignee(tt *testing.T) {
-	i := &IssuesEvent{}
-	i.GetAssignee()
-	i = nil
-	i.GetAssignee()
+func TestPackageFile_GetDownloadURL(tt *testing.T) {
+	var zeroValue string
+	p := &PackageFile{DownloadURL: &zeroValue}
+	p.GetDownloadURL()
+	p = &PackageFile{}
+	p.GetDownloadURL()
+	p = nil
+	p.GetDownloadURL()
 }
 
-func TestIssuesEvent_GetChanges(tt *testing.T) {
-	i := &IssuesEvent{}
-	i.GetChanges()
-	i = nil
-	i.GetChanges()
+func TestPackageFile_GetID(tt *testing.T) {
+	var zeroValue int64
+	p := &PackageFile{ID: &zeroValue}
+	p.GetID()
+	p = &PackageFile{}
+	p.GetID()
+	p = nil
+	p.GetID()
 }
 
-func TestIssuesEvent_GetInstallation(tt *testing.T) {
-	i := &IssuesEvent{}
-	i.GetInstallation()
-	i = nil
-	i.GetInstallation()
+func TestPackageFile_GetMD5(tt *testing.T) {
+	var zeroValue string
+	p := &PackageFile{MD5: &zeroValue}
+	p.GetMD5()
+	p = &PackageFile{}
+	p.GetMD5()
+	p = nil
+	p.GetMD5()
 }
 
-func TestIssuesEvent_GetIssue(tt *testing.T) {
-	i := &IssuesEvent{}
-	i.GetIssue()
-	i = nil
-	i.GetIssue()
+func TestPackageFile_GetName(tt *testing.T) {
+	var zeroValue string
+	p := &PackageFile{Name: &zeroValue}
+	p.GetName()
+	p = &PackageFile{}
+	p.GetName()
+	p = nil
+	p.GetName()
 }
 
-func TestIssuesEvent_GetLabel(tt *testing.T) {
-	i := &IssuesEvent{}
-	i.GetLabel()
-	i = nil
-	i.GetLabel()
+func TestPackageFile_GetSHA1(tt *testing.T) {
+	var zeroValue string
+	p := &PackageFile{SHA1: &zeroValue}
+	p.GetSHA1()
+	p = &PackageFile{}
+	p.GetSHA1()
+	p = nil
+	p.GetSHA1()
 }
 
-func TestIssuesEvent_GetRepo(tt *testing.T) {
-	i := &IssuesEvent{}
-	i.GetRepo()
-	i = nil
-	i.GetRepo()
+func TestPackageFile_GetSHA256(tt *testing.T) {
+	var zeroValue string
+	p := &PackageFile{SHA256: &zeroValue}
+	p.GetSHA256()
+	p = &PackageFile{}
+	p.GetSHA256()
+	p = nil
+	p.GetSHA256()
 }
 
-func TestIssuesEvent_GetSender(tt *testing.T) {
-	i := &IssuesEvent{}
-	i.GetSender()
-	i = nil
-	i.GetSender()
+func TestPackageFile_GetSize(tt *testing.T) {
+	var zeroValue int64
+	p := &PackageFile{Size: &zeroValue}
+	p.GetSize()
+	p = &PackageFile{}
+	p.GetSize()
+	p = nil
+	p.GetSize()
 }
 
-func TestIssuesSearchResult_GetIncompleteResults(tt *testing.T) {
-	var zeroValue bool
-	i := &IssuesSearchResult{IncompleteResults: &zeroValue}
-	i.GetIncompleteResults()
-	i = &IssuesSearchResult{}
-	i.GetIncompleteResults()
-	i = nil
-	i.GetIncompleteResults()
+func TestPackageFile_GetState(tt *testing.T) {
+	var zeroValue string
+	p := &PackageFile{State: &zeroValue}
+	p.GetState()
+	p = &PackageFile{}
+	p.GetState()
+	p = nil
+	p.GetState()
 }
 
-func TestIssuesSearchResult_GetTotal(tt *testing.T) {
-	var zeroValue int
-	i := &IssuesSearchResult{Total: &zeroValue}
-	i.GetTotal()
-	i = &IssuesSearchResult{}
-	i.GetTotal()
-	i = nil
-	i.GetTotal()
+func TestPackageFile_GetUpdatedAt(tt *testing.T) {
+	var zeroValue Timestamp
+	p := &PackageFile{UpdatedAt: &zeroValue}
+	p.GetUpdatedAt()
+	p = &PackageFile{}
+	p.GetUpdatedAt()
+	p = nil
+	p.GetUpdatedAt()
 }
 
-func TestIssueStats_GetClosedIssues(tt *testing.T) {
-	var zeroValue int
-	i := &IssueStats{ClosedIssues: &zeroValue}
-	i.GetClosedIssues()
-	i = &IssueStats{}
-	i.GetClosedIssues()
-	i = nil
-	i.GetClosedIssues()
+func TestPackageListOptions_GetPackageType(tt *testing.T) {
+	var zeroValue string
+	p := &PackageListOptions{PackageType: &zeroValue}
+	p.GetPackageType()
+	p = &PackageListOptions{}
+	p.GetPackageType()
+	p = nil
+	p.GetPackageType()
 }
 
-func TestIssueStats_GetOpenIssues(tt *testing.T) {
-	var zeroValue int
-	i := &IssueStats{OpenIssues: &zeroValue}
-	i.GetOpenIssues()
-	i = &IssueStats{}
-	i.GetOpenIssues()
-	i = nil
-	i.GetOpenIssues()
+func TestPackageListOptions_GetState(tt *testing.T) {
+	var zeroValue string
+	p := &PackageListOptions{State: &zeroValue}
+	p.GetState()
+	p = &PackageListOptions{}
+	p.GetState()
+	p = nil
+	p.GetState()
 }
 
-func TestIssueStats_GetTotalIssues(tt *testing.T) {
-	var zeroValue int
-	i := &IssueStats{TotalIssues: &zeroValue}
-	i.GetTotalIssues()
-	i = &IssueStats{}
-	i.GetTotalIssues()
-	i = nil
-	i.GetTotalIssues()
+func TestPackageListOptions_GetVisibility(tt *testing.T) {
+	var zeroValue string
+	p := &PackageListOptions{Visibility: &zeroValue}
+	p.GetVisibility()
+	p = &PackageListOptions{}
+	p.GetVisibility()
+	p = nil
+	p.GetVisibility()
 }
 
-func TestJobs_GetTotalCount(tt *testing.T) {
-	var zeroValue int
-	j := &Jobs{TotalCount: &zeroValue}
-	j.GetTotalCount()
-	j = &Jobs{}
-	j.GetTotalCount()
-	j = nil
-	j.GetTotalCount()
+func TestPackageMetadata_GetContainer(tt *testing.T) {
+	p := &PackageMetadata{}
+	p.GetContainer()
+	p = nil
+	p.GetContainer()
 }
 
-func TestKey_GetCreatedAt(tt *testing.T) {
-	var zeroValue Timestamp
-	k := &Key{CreatedAt: &zeroValue}
-	k.GetCreatedAt()
-	k = &Key{}
-	k.GetCreatedAt()
-	k = nil
-	k.GetCreatedAt()
+func TestPackageMetadata_GetDocker(tt *testing.T) {
+	p := &PackageMetadata{}
+	p.GetDocker()
+	p = nil
+	p.GetDocker()
 }
 
-func TestKey_GetID(tt *testing.T) {
-	var zeroValue int64
-	k := &Key{ID: &zeroValue}
-	k.GetID()
-	k = &Key{}
-	k.GetID()
-	k = nil
-	k.GetID()
+func TestPackageMetadata_GetPackageType(tt *testing.T) {
+	var zeroValue string
+	p := &PackageMetadata{PackageType: &zeroValue}
+	p.GetPackageType()
+	p = &PackageMetadata{}
+	p.GetPackageType()
+	p = nil
+	p.GetPackageType()
 }
 
-func TestKey_GetKey(tt *testing.T) {
+func TestPackageRegistry_GetAboutURL(tt *testing.T) {
 	var zeroValue string
-	k := &Key{Key: &zeroValue}
-	k.GetKey()
-	k = &Key{}
-	k.GetKey()
-	k = nil
-	k.GetKey()
+	p := &PackageRegistry{AboutURL: &zeroValue}
+	p.GetAboutURL()
+	p = &PackageRegistry{}
+	p.GetAboutURL()
+	p = nil
+	p.GetAboutURL()
 }
 
-func TestKey_GetReadOnly(tt *testing.T) {
-	var zeroValue bool
-	k := &Key{ReadOnly: &zeroValue}
-	k.GetReadOnly()
-	k = &Key{}
-	k.GetReadOnly()
-	k = nil
-	k.GetReadOnly()
+func TestPackageRegistry_GetName(tt *testing.T) {
+	var zeroValue string
+	p := &PackageRegistry{Name: &zeroValue}
+	p.GetName()
+	p = &PackageRegistry{}
+	p.GetName()
+	p = nil
+	p.GetName()
 }
 
-func TestKey_GetTitle(tt *testing.T) {
+func TestPackageRegistry_GetType(tt *testing.T) {
 	var zeroValue string
-	k := &Key{Title: &zeroValue}
-	k.GetTitle()
-	k = &Key{}
-	k.GetTitle()
-	k = nil
-	k.GetTitle()
+	p := &PackageRegistry{Type: &zeroValue}
+	p.GetType()
+	p = &PackageRegistry{}
+	p.GetType()
+	p = nil
+	p.GetType()
 }
 
-func TestKey_GetURL(tt *testing.T) {
+func TestPackageRegistry_GetURL(tt *testing.T) {
 	var zeroValue string
-	k := &Key{URL: &zeroValue}
-	k.GetURL()
-	k = &Key{}
-	k.GetURL()
-	k = nil
-	k.GetURL()
+	p := &PackageRegistry{URL: &zeroValue}
+	p.GetURL()
+	p = &PackageRegistry{}
+	p.GetURL()
+	p = nil
+	p.GetURL()
 }
 
-func TestLabel_GetColor(tt *testing.T) {
+func TestPackageRegistry_GetVendor(tt *testing.T) {
 	var zeroValue string
-	l := &Label{Color: &zeroValue}
-	l.GetColor()
-	l = &Label{}
-	l.GetColor()
-	l = nil
-	l.GetColor()
+	p := &PackageRegistry{Vendor: &zeroValue}
+	p.GetVendor()
+	p = &PackageRegistry{}
+	p.GetVendor()
+	p = nil
+	p.GetVendor()
 }
 
-func TestLabel_GetDefault(tt *testing.T) {
-	var zeroValue bool
-	l := &Label{Default: &zeroValue}
-	l.GetDefault()
-	l = &Label{}
-	l.GetDefault()
-	l = nil
-	l.GetDefault()
+func TestPackageRelease_GetAuthor(tt *testing.T) {
+	p := &PackageRelease{}
+	p.GetAuthor()
+	p = nil
+	p.GetAuthor()
 }
 
-func TestLabel_GetDescription(tt *testing.T) {
-	var zeroValue string
-	l := &Label{Description: &zeroValue}
-	l.GetDescription()
-	l = &Label{}
-	l.GetDescription()
-	l = nil
-	l.GetDescription()
+func TestPackageRelease_GetCreatedAt(tt *testing.T) {
+	var zeroValue Timestamp
+	p := &PackageRelease{CreatedAt: &zeroValue}
+	p.GetCreatedAt()
+	p = &PackageRelease{}
+	p.GetCreatedAt()
+	p = nil
+	p.GetCreatedAt()
 }
 
-func TestLabel_GetID(tt *testing.T) {
-	var zeroValue int64
-	l := &Label{ID: &zeroValue}
-	l.GetID()
-	l = &Label{}
-	l.GetID()
-	l = nil
-	l.GetID()
+func TestPackageRelease_GetDraft(tt *testing.T) {
+	var zeroValue bool
+	p := &PackageRelease{Draft: &zeroValue}
+	p.GetDraft()
+	p = &PackageRelease{}
+	p.GetDraft()
+	p = nil
+	p.GetDraft()
 }
 
-func TestLabel_GetName(tt *testing.T) {
+func TestPackageRelease_GetHTMLURL(tt *testing.T) {
 	var zeroValue string
-	l := &Label{Name: &zeroValue}
-	l.GetName()
-	l = &Label{}
-	l.GetName()
-	l = nil
-	l.GetName()
+	p := &PackageRelease{HTMLURL: &zeroValue}
+	p.GetHTMLURL()
+	p = &PackageRelease{}
+	p.GetHTMLURL()
+	p = nil
+	p.GetHTMLURL()
 }
 
-func TestLabel_GetNodeID(tt *testing.T) {
-	var zeroValue string
-	l := &Label{NodeID: &zeroValue}
-	l.GetNodeID()
-	l = &Label{}
-	l.GetNodeID()
-	l = nil
-	l.GetNodeID()
+func TestPackageRelease_GetID(tt *testing.T) {
+	var zeroValue int64
+	p := &PackageRelease{ID: &zeroValue}
+	p.GetID()
+	p = &PackageRelease{}
+	p.GetID()
+	p = nil
+	p.GetID()
 }
 
-func TestLabel_GetURL(tt *testing.T) {
+func TestPackageRelease_GetName(tt *testing.T) {
 	var zeroValue string
-	l := &Label{URL: &zeroValue}
-	l.GetURL()
-	l = &Label{}
-	l.GetURL()
-	l = nil
-	l.GetURL()
+	p := &PackageRelease{Name: &zeroValue}
+	p.GetName()
+	p = &PackageRelease{}
+	p.GetName()
+	p = nil
+	p.GetName()
 }
 
-func TestLabelEvent_GetAction(tt *testing.T) {
-	var zeroValue string
-	l := &LabelEvent{Action: &zeroValue}
-	l.GetAction()
-	l = &LabelEvent{}
-	l.GetAction()
-	l = nil
-	l.GetAction()
+func TestPackageRelease_GetPrerelease(tt *testing.T) {
+	var zeroValue bool
+	p := &PackageRelease{Prerelease: &zeroValue}
+	p.GetPrerelease()
+	p = &PackageRelease{}
+	p.GetPrerelease()
+	p = nil
+	p.GetPrerelease()
 }
 
-func TestLabelEvent_GetChanges(tt *testing.T) {
-	l := &LabelEvent{}
-	l.GetChanges()
-	l = nil
-	l.GetChanges()
+func TestPackageRelease_GetPublishedAt(tt *testing.T) {
+	var zeroValue Timestamp
+	p := &PackageRelease{PublishedAt: &zeroValue}
+	p.GetPublishedAt()
+	p = &PackageRelease{}
+	p.GetPublishedAt()
+	p = nil
+	p.GetPublishedAt()
 }
 
-func TestLabelEvent_GetInstallation(tt *testing.T) {
-	l := &LabelEvent{}
-	l.GetInstallation()
-	l = nil
-	l.GetInstallation()
+func TestPackageRelease_GetTagName(tt *testing.T) {
+	var zeroValue string
+	p := &PackageRelease{TagName: &zeroValue}
+	p.GetTagName()
+	p = &PackageRelease{}
+	p.GetTagName()
+	p = nil
+	p.GetTagName()
 }
 
-func TestLabelEvent_GetLabel(tt *testing.T) {
-	l := &LabelEvent{}
-	l.GetLabel()
-	l = nil
-	l.GetLabel()
+func TestPackageRelease_GetTargetCommitish(tt *testing.T) {
+	var zeroValue string
+	p := &PackageRelease{TargetCommitish: &zeroValue}
+	p.GetTargetCommitish()
+	p = &PackageRelease{}
+	p.GetTargetCommitish()
+	p = nil
+	p.GetTargetCommitish()
 }
 
-func TestLabelEvent_GetOrg(tt *testing.T) {
-	l := &LabelEvent{}
-	l.GetOrg()
-	l = nil
-	l.GetOrg()
+func TestPackageRelease_GetURL(tt *testing.T) {
+	var zeroValue string
+	p := &PackageRelease{URL: &zeroValue}
+	p.GetURL()
+	p = &PackageRelease{}
+	p.GetURL()
+	p = nil
+	p.GetURL()
 }
 
-func TestLabelEvent_GetRepo(tt *testing.T) {
-	l := &LabelEvent{}
-	l.GetRepo()
-	l = nil
-	l.GetRepo()
+func TestPackageVersion_GetAuthor(tt *testing.T) {
+	p := &PackageVersion{}
+	p.GetAuthor()
+	p = nil
+	p.GetAuthor()
 }
 
-func TestLabelResult_GetColor(tt *testing.T) {
+func TestPackageVersion_GetBody(tt *testing.T) {
 	var zeroValue string
-	l := &LabelResult{Color: &zeroValue}
-	l.GetColor()
-	l = &LabelResult{}
-	l.GetColor()
-	l = nil
-	l.GetColor()
+	p := &PackageVersion{Body: &zeroValue}
+	p.GetBody()
+	p = &PackageVersion{}
+	p.GetBody()
+	p = nil
+	p.GetBody()
 }
 
-func TestLabelResult_GetDefault(tt *testing.T) {
-	var zeroValue bool
-	l := &LabelResult{Default: &zeroValue}
-	l.GetDefault()
-	l = &LabelResult{}
-	l.GetDefault()
-	l = nil
-	l.GetDefault()
+func TestPackageVersion_GetBodyHTML(tt *testing.T) {
+	var zeroValue string
+	p := &PackageVersion{BodyHTML: &zeroValue}
+	p.GetBodyHTML()
+	p = &PackageVersion{}
+	p.GetBodyHTML()
+	p = nil
+	p.GetBodyHTML()
 }
 
-func TestLabelResult_GetDescription(tt *testing.T) {
-	var zeroValue string
-	l := &LabelResult{Description: &zeroValue}
-	l.GetDescription()
-	l = &LabelResult{}
-	l.GetDescription()
-	l = nil
-	l.GetDescription()
+func TestPackageVersion_GetCreatedAt(tt *testing.T) {
+	var zeroValue Timestamp
+	p := &PackageVersion{CreatedAt: &zeroValue}
+	p.GetCreatedAt()
+	p = &PackageVersion{}
+	p.GetCreatedAt()
+	p = nil
+	p.GetCreatedAt()
 }
 
-func TestLabelResult_GetID(tt *testing.T) {
-	var zeroValue int64
-	l := &LabelResult{ID: &zeroValue}
-	l.GetID()
-	l = &LabelResult{}
-	l.GetID()
-	l = nil
-	l.GetID()
+func TestPackageVersion_GetDeletedAt(tt *testing.T) {
+	var zeroValue Timestamp
+	p := &PackageVersion{DeletedAt: &zeroValue}
+	p.GetDeletedAt()
+	p = &PackageVersion{}
+	p.GetDeletedAt()
+	p = nil
+	p.GetDeletedAt()
 }
 
-func TestLabelResult_GetName(tt *testing.T) {
+func TestPackageVersion_GetDescription(tt *testing.T) {
 	var zeroValue string
-	l := &LabelResult{Name: &zeroValue}
-	l.GetName()
-	l = &LabelResult{}
-	l.GetName()
-	l = nil
-	l.GetName()
+	p := &PackageVersion{Description: &zeroValue}
+	p.GetDescription()
+	p = &PackageVersion{}
+	p.GetDescription()
+	p = nil
+	p.GetDescription()
 }
 
-func TestLabelResult_GetScore(tt *testing.T) {
-	l := &LabelResult{}
-	l.GetScore()
-	l = nil
-	l.GetScore()
+func TestPackageVersion_GetDraft(tt *testing.T) {
+	var zeroValue bool
+	p := &PackageVersion{Draft: &zeroValue}
+	p.GetDraft()
+	p = &PackageVersion{}
+	p.GetDraft()
+	p = nil
+	p.GetDraft()
 }
 
-func TestLabelResult_GetURL(tt *testing.T) {
+func TestPackageVersion_GetHTMLURL(tt *testing.T) {
 	var zeroValue string
-	l := &LabelResult{URL: &zeroValue}
-	l.GetURL()
-	l = &LabelResult{}
-	l.GetURL()
-	l = nil
-	l.GetURL()
+	p := &PackageVersion{HTMLURL: &zeroValue}
+	p.GetHTMLURL()
+	p = &PackageVersion{}
+	p.GetHTMLURL()
+	p = nil
+	p.GetHTMLURL()
 }
 
-func TestLabelsSearchResult_GetIncompleteResults(tt *testing.T) {
-	var zeroValue bool
-	l := &LabelsSearchResult{IncompleteResults: &zeroValue}
-	l.GetIncompleteResults()
-	l = &LabelsSearchResult{}
-	l.GetIncompleteResults()
-	l = nil
-	l.GetIncompleteResults()
+func TestPackageVersion_GetID(tt *testing.T) {
+	var zeroValue int64
+	p := &PackageVersion{ID: &zeroValue}
+	p.GetID()
+	p = &PackageVersion{}
+	p.GetID()
+	p = nil
+	p.GetID()
 }
 
-func TestLabelsSearchResult_GetTotal(tt *testing.T) {
-	var zeroValue int
-	l := &LabelsSearchResult{Total: &zeroValue}
-	l.GetTotal()
-	l = &LabelsSearchResult{}
-	l.GetTotal()
-	l = nil
-	l.GetTotal()
+func TestPackageVersion_GetInstallationCommand(tt *testing.T) {
+	var zeroValue string
+	p := &PackageVersion{InstallationCommand: &zeroValue}
+	p.GetInstallationCommand()
+	p = &PackageVersion{}
+	p.GetInstallationCommand()
+	p = nil
+	p.GetInstallationCommand()
 }
 
-func TestLargeFile_GetOID(tt *testing.T) {
+func TestPackageVersion_GetLicense(tt *testing.T) {
 	var zeroValue string
-	l := &LargeFile{OID: &zeroValue}
-	l.GetOID()
-	l = &LargeFile{}
-	l.GetOID()
-	l = nil
-	l.GetOID()
+	p := &PackageVersion{License: &zeroValue}
+	p.GetLicense()
+	p = &PackageVersion{}
+	p.GetLicense()
+	p = nil
+	p.GetLicense()
 }
 
-func TestLargeFile_GetPath(tt *testing.T) {
+func TestPackageVersion_GetManifest(tt *testing.T) {
 	var zeroValue string
-	l := &LargeFile{Path: &zeroValue}
-	l.GetPath()
-	l = &LargeFile{}
-	l.GetPath()
-	l = nil
-	l.GetPath()
+	p := &PackageVersion{Manifest: &zeroValue}
+	p.GetManifest()
+	p = &PackageVersion{}
+	p.GetManifest()
+	p = nil
+	p.GetManifest()
 }
 
-func TestLargeFile_GetRefName(tt *testing.T) {
-	var zeroValue string
-	l := &LargeFile{RefName: &zeroValue}
-	l.GetRefName()
-	l = &LargeFile{}
-	l.GetRefName()
-	l = nil
-	l.GetRefName()
+func TestPackageVersion_GetMetadata(tt *testing.T) {
+	p := &PackageVersion{}
+	p.GetMetadata()
+	p = nil
+	p.GetMetadata()
 }
 
-func TestLargeFile_GetSize(tt *testing.T) {
-	var zeroValue int
-	l := &LargeFile{Size: &zeroValue}
-	l.GetSize()
-	l = &LargeFile{}
-	l.GetSize()
-	l = nil
-	l.GetSize()
+func TestPackageVersion_GetName(tt *testing.T) {
+	var zeroValue string
+	p := &PackageVersion{Name: &zeroValue}
+	p.GetName()
+	p = &PackageVersion{}
+	p.GetName()
+	p = nil
+	p.GetName()
 }
 
-func TestLicense_GetBody(tt *testing.T) {
+func TestPackageVersion_GetPackageHTMLURL(tt *testing.T) {
 	var zeroValue string
-	l := &License{Body: &zeroValue}
-	l.GetBody()
-	l = &License{}
-	l.GetBody()
-	l = nil
-	l.GetBody()
+	p := &PackageVersion{PackageHTMLURL: &zeroValue}
+	p.GetPackageHTMLURL()
+	p = &PackageVersion{}
+	p.GetPackageHTMLURL()
+	p = nil
+	p.GetPackageHTMLURL()
 }
 
-func TestLicense_GetConditions(tt *testing.T) {
-	var zeroValue []string
-	l := &License{Conditions: &zeroValue}
-	l.GetConditions()
-	l = &License{}
-	l.GetConditions()
-	l = nil
-	l.GetConditions()
+func TestPackageVersion_GetPrerelease(tt *testing.T) {
+	var zeroValue bool
+	p := &PackageVersion{Prerelease: &zeroValue}
+	p.GetPrerelease()
+	p = &PackageVersion{}
+	p.GetPrerelease()
+	p = nil
+	p.GetPrerelease()
 }
 
-func TestLicense_GetDescription(tt *testing.T) {
+func TestPackageVersion_GetRelease(tt *testing.T) {
+	p := &PackageVersion{}
+	p.GetRelease()
+	p = nil
+	p.GetRelease()
+}
+
+func TestPackageVersion_GetSummary(tt *testing.T) {
 	var zeroValue string
-	l := &License{Description: &zeroValue}
-	l.GetDescription()
-	l = &License{}
-	l.GetDescription()
-	l = nil
-	l.GetDescription()
+	p := &PackageVersion{Summary: &zeroValue}
+	p.GetSummary()
+	p = &PackageVersion{}
+	p.GetSummary()
+	p = nil
+	p.GetSummary()
 }
 
-func TestLicense_GetFeatured(tt *testing.T) {
-	var zeroValue bool
-	l := &License{Featured: &zeroValue}
-	l.GetFeatured()
-	l = &License{}
-	l.GetFeatured()
-	l = nil
-	l.GetFeatured()
+func TestPackageVersion_GetTagName(tt *testing.T) {
+	var zeroValue string
+	p := &PackageVersion{TagName: &zeroValue}
+	p.GetTagName()
+	p = &PackageVersion{}
+	p.GetTagName()
+	p = nil
+	p.GetTagName()
 }
 
-func TestLicense_GetHTMLURL(tt *testing.T) {
+func TestPackageVersion_GetTargetCommitish(tt *testing.T) {
 	var zeroValue string
-	l := &License{HTMLURL: &zeroValue}
-	l.GetHTMLURL()
-	l = &License{}
-	l.GetHTMLURL()
-	l = nil
-	l.GetHTMLURL()
+	p := &PackageVersion{TargetCommitish: &zeroValue}
+	p.GetTargetCommitish()
+	p = &PackageVersion{}
+	p.GetTargetCommitish()
+	p = nil
+	p.GetTargetCommitish()
 }
 
-func TestLicense_GetImplementation(tt *testing.T) {
+func TestPackageVersion_GetTargetOID(tt *testing.T) {
 	var zeroValue string
-	l := &License{Implementation: &zeroValue}
-	l.GetImplementation()
-	l = &License{}
-	l.GetImplementation()
-	l = nil
-	l.GetImplementation()
+	p := &PackageVersion{TargetOID: &zeroValue}
+	p.GetTargetOID()
+	p = &PackageVersion{}
+	p.GetTargetOID()
+	p = nil
+	p.GetTargetOID()
 }
 
-func TestLicense_GetKey(tt *testing.T) {
+func TestPackageVersion_GetUpdatedAt(tt *testing.T) {
+	var zeroValue Timestamp
+	p := &PackageVersion{UpdatedAt: &zeroValue}
+	p.GetUpdatedAt()
+	p = &PackageVersion{}
+	p.GetUpdatedAt()
+	p = nil
+	p.GetUpdatedAt()
+}
+
+func TestPackageVersion_GetURL(tt *testing.T) {
 	var zeroValue string
-	l := &License{Key: &zeroValue}
-	l.GetKey()
-	l = &License{}
-	l.GetKey()
-	l = nil
-	l.GetKey()
+	p := &PackageVersion{URL: &zeroValue}
+	p.GetURL()
+	p = &PackageVersion{}
+	p.GetURL()
+	p = nil
+	p.GetURL()
 }
 
-func TestLicense_GetLimitations(tt *testing.T) {
-	var zeroValue []string
-	l := &License{Limitations: &zeroValue}
-	l.GetLimitations()
-	l = &License{}
-	l.GetLimitations()
-	l = nil
-	l.GetLimitations()
+func TestPackageVersion_GetVersion(tt *testing.T) {
+	var zeroValue string
+	p := &PackageVersion{Version: &zeroValue}
+	p.GetVersion()
+	p = &PackageVersion{}
+	p.GetVersion()
+	p = nil
+	p.GetVersion()
 }
 
-func TestLicense_GetName(tt *testing.T) {
+func TestPage_GetAction(tt *testing.T) {
 	var zeroValue string
-	l := &License{Name: &zeroValue}
-	l.GetName()
-	l = &License{}
-	l.GetName()
-	l = nil
-	l.GetName()
+	p := &Page{Action: &zeroValue}
+	p.GetAction()
+	p = &Page{}
+	p.GetAction()
+	p = nil
+	p.GetAction()
 }
 
-func TestLicense_GetPermissions(tt *testing.T) {
-	var zeroValue []string
-	l := &License{Permissions: &zeroValue}
-	l.GetPermissions()
-	l = &License{}
-	l.GetPermissions()
-	l = nil
-	l.GetPermissions()
+func TestPage_GetHTMLURL(tt *testing.T) {
+	var zeroValue string
+	p := &Page{HTMLURL: &zeroValue}
+	p.GetHTMLURL()
+	p = &Page{}
+	p.GetHTMLURL()
+	p = nil
+	p.GetHTMLURL()
 }
 
-func TestLicense_GetSPDXID(tt *testing.T) {
+func TestPage_GetPageName(tt *testing.T) {
 	var zeroValue string
-	l := &License{SPDXID: &zeroValue}
-	l.GetSPDXID()
-	l = &License{}
-	l.GetSPDXID()
-	l = nil
-	l.GetSPDXID()
+	p := &Page{PageName: &zeroValue}
+	p.GetPageName()
+	p = &Page{}
+	p.GetPageName()
+	p = nil
+	p.GetPageName()
 }
 
-func TestLicense_GetURL(tt *testing.T) {
+func TestPage_GetSHA(tt *testing.T) {
 	var zeroValue string
-	l := &License{URL: &zeroValue}
-	l.GetURL()
-	l = &License{}
-	l.GetURL()
-	l = nil
-	l.GetURL()
+	p := &Page{SHA: &zeroValue}
+	p.GetSHA()
+	p = &Page{}
+	p.GetSHA()
+	p = nil
+	p.GetSHA()
 }
 
-func TestListCheckRunsOptions_GetCheckName(tt *testing.T) {
+func TestPage_GetSummary(tt *testing.T) {
 	var zeroValue string
-	l := &ListCheckRunsOptions{CheckName: &zeroValue}
-	l.GetCheckName()
-	l = &ListCheckRunsOptions{}
-	l.GetCheckName()
-	l = nil
-	l.GetCheckName()
+	p := &Page{Summary: &zeroValue}
+	p.GetSummary()
+	p = &Page{}
+	p.GetSummary()
+	p = nil
+	p.GetSummary()
 }
 
-func TestListCheckRunsOptions_GetFilter(tt *testing.T) {
+func TestPage_GetTitle(tt *testing.T) {
 	var zeroValue string
-	l := &ListCheckRunsOptions{Filter: &zeroValue}
-	l.GetFilter()
-	l = &ListCheckRunsOptions{}
-	l.GetFilter()
-	l = nil
-	l.GetFilter()
+	p := &Page{Title: &zeroValue}
+	p.GetTitle()
+	p = &Page{}
+	p.GetTitle()
+	p = nil
+	p.GetTitle()
 }
 
-func TestListCheckRunsOptions_GetStatus(tt *testing.T) {
-	var zeroValue string
-	l := &ListCheckRunsOptions{Status: &zeroValue}
-	l.GetStatus()
-	l = &ListCheckRunsOptions{}
-	l.GetStatus()
-	l = nil
-	l.GetStatus()
+func TestPageBuildEvent_GetBuild(tt *testing.T) {
+	p := &PageBuildEvent{}
+	p.GetBuild()
+	p = nil
+	p.GetBuild()
 }
 
-func TestListCheckRunsResults_GetTotal(tt *testing.T) {
-	var zeroValue int
-	l := &ListCheckRunsResults{Total: &zeroValue}
-	l.GetTotal()
-	l = &ListCheckRunsResults{}
-	l.GetTotal()
-	l = nil
-	l.GetTotal()
+func TestPageBuildEvent_GetID(tt *testing.T) {
+	var zeroValue int64
+	p := &PageBuildEvent{ID: &zeroValue}
+	p.GetID()
+	p = &PageBuildEvent{}
+	p.GetID()
+	p = nil
+	p.GetID()
 }
 
-func TestListCheckSuiteOptions_GetAppID(tt *testing.T) {
-	var zeroValue int
-	l := &ListCheckSuiteOptions{AppID: &zeroValue}
-	l.GetAppID()
-	l = &ListCheckSuiteOptions{}
-	l.GetAppID()
-	l = nil
-	l.GetAppID()
+func TestPageBuildEvent_GetInstallation(tt *testing.T) {
+	p := &PageBuildEvent{}
+	p.GetInstallation()
+	p = nil
+	p.GetInstallation()
 }
 
-func TestListCheckSuiteOptions_GetCheckName(tt *testing.T) {
-	var zeroValue string
-	l := &ListCheckSuiteOptions{CheckName: &zeroValue}
-	l.GetCheckName()
-	l = &ListCheckSuiteOptions{}
-	l.GetCheckName()
-	l = nil
-	l.GetCheckName()
+func TestPageBuildEvent_GetRepo(tt *testing.T) {
+	p := &PageBuildEvent{}
+	p.GetRepo()
+	p = nil
+	p.GetRepo()
 }
 
-func TestListCheckSuiteResults_GetTotal(tt *testing.T) {
-	var zeroValue int
-	l := &ListCheckSuiteResults{Total: &zeroValue}
-	l.GetTotal()
-	l = &ListCheckSuiteResults{}
-	l.GetTotal()
-	l = nil
-	l.GetTotal()
+func TestPageBuildEvent_GetSender(tt *testing.T) {
+	p := &PageBuildEvent{}
+	p.GetSender()
+	p = nil
+	p.GetSender()
 }
 
-func TestListCollaboratorOptions_GetAffiliation(tt *testing.T) {
+func TestPages_GetCNAME(tt *testing.T) {
 	var zeroValue string
-	l := &ListCollaboratorOptions{Affiliation: &zeroValue}
-	l.GetAffiliation()
-	l = &ListCollaboratorOptions{}
-	l.GetAffiliation()
-	l = nil
-	l.GetAffiliation()
+	p := &Pages{CNAME: &zeroValue}
+	p.GetCNAME()
+	p = &Pages{}
+	p.GetCNAME()
+	p = nil
+	p.GetCNAME()
 }
 
-func TestMarketplacePendingChange_GetEffectiveDate(tt *testing.T) {
-	var zeroValue Timestamp
-	m := &MarketplacePendingChange{EffectiveDate: &zeroValue}
-	m.GetEffectiveDate()
-	m = &MarketplacePendingChange{}
-	m.GetEffectiveDate()
-	m = nil
-	m.GetEffectiveDate()
+func TestPages_GetCustom404(tt *testing.T) {
+	var zeroValue bool
+	p := &Pages{Custom404: &zeroValue}
+	p.GetCustom404()
+	p = &Pages{}
+	p.GetCustom404()
+	p = nil
+	p.GetCustom404()
 }
 
-func TestMarketplacePendingChange_GetID(tt *testing.T) {
-	var zeroValue int64
-	m := &MarketplacePendingChange{ID: &zeroValue}
-	m.GetID()
-	m = &MarketplacePendingChange{}
-	m.GetID()
-	m = nil
-	m.GetID()
+func TestPages_GetHTMLURL(tt *testing.T) {
+	var zeroValue string
+	p := &Pages{HTMLURL: &zeroValue}
+	p.GetHTMLURL()
+	p = &Pages{}
+	p.GetHTMLURL()
+	p = nil
+	p.GetHTMLURL()
 }
 
-func TestMarketplacePendingChange_GetPlan(tt *testing.T) {
-	m := &MarketplacePendingChange{}
-	m.GetPlan()
-	m = nil
-	m.GetPlan()
+func TestPages_GetSource(tt *testing.T) {
+	p := &Pages{}
+	p.GetSource()
+	p = nil
+	p.GetSource()
 }
 
-func TestMarketplacePendingChange_GetUnitCount(tt *testing.T) {
-	var zeroValue int
-	m := &MarketplacePendingChange{UnitCount: &zeroValue}
-	m.GetUnitCount()
-	m = &MarketplacePendingChange{}
-	m.GetUnitCount()
-	m = nil
-	m.GetUnitCount()
+func TestPages_GetStatus(tt *testing.T) {
+	var zeroValue string
+	p := &Pages{Status: &zeroValue}
+	p.GetStatus()
+	p = &Pages{}
+	p.GetStatus()
+	p = nil
+	p.GetStatus()
 }
 
-func TestMarketplacePlan_GetAccountsURL(tt *testing.T) {
+func TestPages_GetURL(tt *testing.T) {
 	var zeroValue string
-	m := &MarketplacePlan{AccountsURL: &zeroValue}
-	m.GetAccountsURL()
-	m = &MarketplacePlan{}
-	m.GetAccountsURL()
-	m = nil
-	m.GetAccountsURL()
+	p := &Pages{URL: &zeroValue}
+	p.GetURL()
+	p = &Pages{}
+	p.GetURL()
+	p = nil
+	p.GetURL()
 }
 
-func TestMarketplacePlan_GetBullets(tt *testing.T) {
-	var zeroValue []string
-	m := &MarketplacePlan{Bullets: &zeroValue}
-	m.GetBullets()
-	m = &MarketplacePlan{}
-	m.GetBullets()
-	m = nil
-	m.GetBullets()
+func TestPagesBuild_GetCommit(tt *testing.T) {
+	var zeroValue string
+	p := &PagesBuild{Commit: &zeroValue}
+	p.GetCommit()
+	p = &PagesBuild{}
+	p.GetCommit()
+	p = nil
+	p.GetCommit()
 }
 
-func TestMarketplacePlan_GetDescription(tt *testing.T) {
-	var zeroValue string
-	m := &MarketplacePlan{Description: &zeroValue}
-	m.GetDescription()
-	m = &MarketplacePlan{}
-	m.GetDescription()
-	m = nil
-	m.GetDescription()
+func TestPagesBuild_GetCreatedAt(tt *testing.T) {
+	var zeroValue Timestamp
+	p := &PagesBuild{CreatedAt: &zeroValue}
+	p.GetCreatedAt()
+	p = &PagesBuild{}
+	p.GetCreatedAt()
+	p = nil
+	p.GetCreatedAt()
 }
 
-func TestMarketplacePlan_GetHasFreeTrial(tt *testing.T) {
-	var zeroValue bool
-	m := &MarketplacePlan{HasFreeTrial: &zeroValue}
-	m.GetHasFreeTrial()
-	m = &MarketplacePlan{}
-	m.GetHasFreeTrial()
-	m = nil
-	m.GetHasFreeTrial()
+func TestPagesBuild_GetDuration(tt *testing.T) {
+	var zeroValue int
+	p := &PagesBuild{Duration: &zeroValue}
+	p.GetDuration()
+	p = &PagesBuild{}
+	p.GetDuration()
+	p = nil
+	p.GetDuration()
 }
 
-func TestMarketplacePlan_GetID(tt *testing.T) {
-	var zeroValue int64
-	m := &MarketplacePlan{ID: &zeroValue}
-	m.GetID()
-	m = &MarketplacePlan{}
-	m.GetID()
-	m = nil
-	m.GetID()
+func TestPagesBuild_GetError(tt *testing.T) {
+	p := &PagesBuild{}
+	p.GetError()
+	p = nil
+	p.GetError()
 }
 
-func TestMarketplacePlan_GetMonthlyPriceInCents(tt *testing.T) {
-	var zeroValue int
-	m := &MarketplacePlan{MonthlyPriceInCents: &zeroValue}
-	m.GetMonthlyPriceInCents()
-	m = &MarketplacePlan{}
-	m.GetMonthlyPriceInCents()
-	m = nil
-	m.GetMonthlyPriceInCents()
+func TestPagesBuild_GetPusher(tt *testing.T) {
+	p := &PagesBuild{}
+	p.GetPusher()
+	p = nil
+	p.GetPusher()
 }
 
-func TestMarketplacePlan_GetName(tt *testing.T) {
+func TestPagesBuild_GetStatus(tt *testing.T) {
 	var zeroValue string
-	m := &MarketplacePlan{Name: &zeroValue}
-	m.GetName()
-	m = &MarketplacePlan{}
-	m.GetName()
-	m = nil
-	m.GetName()
+	p := &PagesBuild{Status: &zeroValue}
+	p.GetStatus()
+	p = &PagesBuild{}
+	p.GetStatus()
+	p = nil
+	p.GetStatus()
+}
+
+func TestPagesBuild_GetUpdatedAt(tt *testing.T) {
+	var zeroValue Timestamp
+	p := &PagesBuild{UpdatedAt: &zeroValue}
+	p.GetUpdatedAt()
+	p = &PagesBuild{}
+	p.GetUpdatedAt()
+	p = nil
+	p.GetUpdatedAt()
 }
 
-func TestMarketplacePlan_GetPriceModel(tt *testing.T) {
+func TestPagesBuild_GetURL(tt *testing.T) {
 	var zeroValue string
-	m := &MarketplacePlan{PriceModel: &zeroValue}
-	m.GetPriceModel()
-	m = &MarketplacePlan{}
-	m.GetPriceModel()
-	m = nil
-	m.GetPriceModel()
+	p := &PagesBuild{URL: &zeroValue}
+	p.GetURL()
+	p = &PagesBuild{}
+	p.GetURL()
+	p = nil
+	p.GetURL()
 }
 
-func TestMarketplacePlan_GetState(tt *testing.T) {
+func TestPagesError_GetMessage(tt *testing.T) {
 	var zeroValue string
-	m := &MarketplacePlan{State: &zeroValue}
-	m.GetState()
-	m = &MarketplacePlan{}
-	m.GetState()
-	m = nil
-	m.GetState()
+	p := &PagesError{Message: &zeroValue}
+	p.GetMessage()
+	p = &PagesError{}
+	p.GetMessage()
+	p = nil
+	p.GetMessage()
 }
 
-func TestMarketplacePlan_GetUnitName(tt *testing.T) {
+func TestPagesSource_GetBranch(tt *testing.T) {
 	var zeroValue string
-	m := &MarketplacePlan{UnitName: &zeroValue}
-	m.GetUnitName()
-	m = &MarketplacePlan{}
-	m.GetUnitName()
-	m = nil
-	m.GetUnitName()
+	p := &PagesSource{Branch: &zeroValue}
+	p.GetBranch()
+	p = &PagesSource{}
+	p.GetBranch()
+	p = nil
+	p.GetBranch()
 }
 
-func TestMarketplacePlan_GetURL(tt *testing.T) {
+func TestPagesSource_GetPath(tt *testing.T) {
 	var zeroValue string
-	m := &MarketplacePlan{URL: &zeroValue}
-	m.GetURL()
-	m = &MarketplacePlan{}
-	m.GetURL()
-	m = nil
-	m.GetURL()
+	p := &PagesSource{Path: &zeroValue}
+	p.GetPath()
+	p = &PagesSource{}
+	p.GetPath()
+	p = nil
+	p.GetPath()
 }
 
-func TestMarketplacePlan_GetYearlyPriceInCents(tt *testing.T) {
+func TestPageStats_GetTotalPages(tt *testing.T) {
 	var zeroValue int
-	m := &MarketplacePlan{YearlyPriceInCents: &zeroValue}
-	m.GetYearlyPriceInCents()
-	m = &MarketplacePlan{}
-	m.GetYearlyPriceInCents()
-	m = nil
-	m.GetYearlyPriceInCents()
+	p := &PageStats{TotalPages: &zeroValue}
+	p.GetTotalPages()
+	p = &PageStats{}
+	p.GetTotalPages()
+	p = nil
+	p.GetTotalPages()
 }
 
-func TestMarketplacePlanAccount_GetEmail(tt *testing.T) {
+func TestPagesUpdate_GetCNAME(tt *testing.T) {
 	var zeroValue string
-	m := &MarketplacePlanAccount{Email: &zeroValue}
-	m.GetEmail()
-	m = &MarketplacePlanAccount{}
-	m.GetEmail()
-	m = nil
-	m.GetEmail()
-}
-
-func TestMarketplacePlanAccount_GetID(tt *testing.T) {
-	var zeroValue int64
-	m := &MarketplacePlanAccount{ID: &zeroValue}
-	m.GetID()
-	m = &MarketplacePlanAccount{}
-	m.GetID()
-	m = nil
-	m.GetID()
+	p := &PagesUpdate{CNAME: &zeroValue}
+	p.GetCNAME()
+	p = &PagesUpdate{}
+	p.GetCNAME()
+	p = nil
+	p.GetCNAME()
 }
 
-func TestMarketplacePlanAccount_GetLogin(tt *testing.T) {
+func TestPagesUpdate_GetSource(tt *testing.T) {
 	var zeroValue string
-	m := &MarketplacePlanAccount{Login: &zeroValue}
-	m.GetLogin()
-	m = &MarketplacePlanAccount{}
-	m.GetLogin()
-	m = nil
-	m.GetLogin()
+	p := &PagesUpdate{Source: &zeroValue}
+	p.GetSource()
+	p = &PagesUpdate{}
+	p.GetSource()
+	p = nil
+	p.GetSource()
 }
 
-func TestMarketplacePlanAccount_GetMarketplacePendingChange(tt *testing.T) {
-	m := &MarketplacePlanAccount{}
-	m.GetMarketplacePendingChange()
-	m = nil
-	m.GetMarketplacePendingChange()
+func TestPersonalAccessToken_GetAccessGrantedAt(tt *testing.T) {
+	var zeroValue Timestamp
+	p := &PersonalAccessToken{AccessGrantedAt: &zeroValue}
+	p.GetAccessGrantedAt()
+	p = &PersonalAccessToken{}
+	p.GetAccessGrantedAt()
+	p = nil
+	p.GetAccessGrantedAt()
 }
 
-func TestMarketplacePlanAccount_GetMarketplacePurchase(tt *testing.T) {
-	m := &MarketplacePlanAccount{}
-	m.GetMarketplacePurchase()
-	m = nil
-	m.GetMarketplacePurchase()
+func TestPersonalAccessToken_GetID(tt *testing.T) {
+	var zeroValue int64
+	p := &PersonalAccessToken{ID: &zeroValue}
+	p.GetID()
+	p = &PersonalAccessToken{}
+	p.GetID()
+	p = nil
+	p.GetID()
 }
 
-func TestMarketplacePlanAccount_GetNodeID(tt *testing.T) {
-	var zeroValue string
-	m := &MarketplacePlanAccount{NodeID: &zeroValue}
-	m.GetNodeID()
-	m = &MarketplacePlanAccount{}
-	m.GetNodeID()
-	m = nil
-	m.GetNodeID()
+func TestPersonalAccessToken_GetOwner(tt *testing.T) {
+	p := &PersonalAccessToken{}
+	p.GetOwner()
+	p = nil
+	p.GetOwner()
 }
 
-func TestMarketplacePlanAccount_GetOrganizationBillingEmail(tt *testing.T) {
-	var zeroValue string
-	m := &MarketplacePlanAccount{OrganizationBillingEmail: &zeroValue}
-	m.GetOrganizationBillingEmail()
-	m = &MarketplacePlanAccount{}
-	m.GetOrganizationBillingEmail()
-	m = nil
-	m.GetOrganizationBillingEmail()
+func TestPersonalAccessToken_GetPermissions(tt *testing.T) {
+	p := &PersonalAccessToken{}
+	p.GetPermissions()
+	p = nil
+	p.GetPermissions()
 }
 
-func TestMarketplacePlanAccount_GetType(tt *testing.T) {
+func TestPersonalAccessToken_GetRepositoriesURL(tt *testing.T) {
 	var zeroValue string
-	m := &MarketplacePlanAccount{Type: &zeroValue}
-	m.GetType()
-	m = &MarketplacePlanAccount{}
-	m.GetType()
-	m = nil
-	m.GetType()
+	p := &PersonalAccessToken{RepositoriesURL: &zeroValue}
+	p.GetRepositoriesURL()
+	p = &PersonalAccessToken{}
+	p.GetRepositoriesURL()
+	p = nil
+	p.GetRepositoriesURL()
 }
 
-func TestMarketplacePlanAccount_GetURL(tt *testing.T) {
+func TestPersonalAccessToken_GetRepositorySelection(tt *testing.T) {
 	var zeroValue string
-	m := &MarketplacePlanAccount{URL: &zeroValue}
-	m.GetURL()
-	m = &MarketplacePlanAccount{}
-	m.GetURL()
-	m = nil
-	m.GetURL()
+	p := &PersonalAccessToken{RepositorySelection: &zeroValue}
+	p.GetRepositorySelection()
+	p = &PersonalAccessToken{}
+	p.GetRepositorySelection()
+	p = nil
+	p.GetRepositorySelection()
 }
 
-func TestMarketplacePurchase_GetAccount(tt *testing.T) {
-	m := &MarketplacePurchase{}
-	m.GetAccount()
-	m = nil
-	m.GetAccount()
+func TestPersonalAccessToken_GetTokenExpired(tt *testing.T) {
+	var zeroValue bool
+	p := &PersonalAccessToken{TokenExpired: &zeroValue}
+	p.GetTokenExpired()
+	p = &PersonalAccessToken{}
+	p.GetTokenExpired()
+	p = nil
+	p.GetTokenExpired()
 }
 
-func TestMarketplacePurchase_GetBillingCycle(tt *testing.T) {
-	var zeroValue string
-	m := &MarketplacePurchase{BillingCycle: &zeroValue}
-	m.GetBillingCycle()
-	m = &MarketplacePurchase{}
-	m.GetBillingCycle()
-	m = nil
-	m.GetBillingCycle()
+func TestPersonalAccessToken_GetTokenExpiresAt(tt *testing.T) {
+	var zeroValue Timestamp
+	p := &PersonalAccessToken{TokenExpiresAt: &zeroValue}
+	p.GetTokenExpiresAt()
+	p = &PersonalAccessToken{}
+	p.GetTokenExpiresAt()
+	p = nil
+	p.GetTokenExpiresAt()
 }
 
-func TestMarketplacePurchase_GetFreeTrialEndsOn(tt *testing.T) {
-	var zeroValue Timestamp
-	m := &MarketplacePurchase{FreeTrialEndsOn: &zeroValue}
-	m.GetFreeTrialEndsOn()
-	m = &MarketplacePurchase{}
-	m.GetFreeTrialEndsOn()
-	m = nil
-	m.GetFreeTrialEndsOn()
+func TestPersonalAccessToken_GetTokenID(tt *testing.T) {
+	var zeroValue int64
+	p := &PersonalAccessToken{TokenID: &zeroValue}
+	p.GetTokenID()
+	p = &PersonalAccessToken{}
+	p.GetTokenID()
+	p = nil
+	p.GetTokenID()
 }
 
-func TestMarketplacePurchase_GetNextBillingDate(tt *testing.T) {
+func TestPersonalAccessToken_GetTokenLastUsedAt(tt *testing.T) {
 	var zeroValue Timestamp
-	m := &MarketplacePurchase{NextBillingDate: &zeroValue}
-	m.GetNextBillingDate()
-	m = &MarketplacePurchase{}
-	m.GetNextBillingDate()
-	m = nil
-	m.GetNextBillingDate()
+	p := &PersonalAccessToken{TokenLastUsedAt: &zeroValue}
+	p.GetTokenLastUsedAt()
+	p = &PersonalAccessToken{}
+	p.GetTokenLastUsedAt()
+	p = nil
+	p.GetTokenLastUsedAt()
 }
 
-func TestMarketplacePurchase_GetOnFreeTrial(tt *testing.T) {
-	var zeroValue bool
-	m := &MarketplacePurchase{OnFreeTrial: &zeroValue}
-	m.GetOnFreeTrial()
-	m = &MarketplacePurchase{}
-	m.GetOnFreeTrial()
-	m = nil
-	m.GetOnFreeTrial()
+func TestPersonalAccessToken_GetTokenName(tt *testing.T) {
+	var zeroValue string
+	p := &PersonalAccessToken{TokenName: &zeroValue}
+	p.GetTokenName()
+	p = &PersonalAccessToken{}
+	p.GetTokenName()
+	p = nil
+	p.GetTokenName()
 }
 
-func TestMarketplacePurchase_GetPlan(tt *testing.T) {
-	m := &MarketplacePurchase{}
-	m.GetPlan()
-	m = nil
-	m.GetPlan()
+func TestPersonalAccessTokenRequest_GetCreatedAt(tt *testing.T) {
+	var zeroValue Timestamp
+	p := &PersonalAccessTokenRequest{CreatedAt: &zeroValue}
+	p.GetCreatedAt()
+	p = &PersonalAccessTokenRequest{}
+	p.GetCreatedAt()
+	p = nil
+	p.GetCreatedAt()
 }
 
-func TestMarketplacePurchase_GetUnitCount(tt *testing.T) {
-	var zeroValue int
-	m := &MarketplacePurchase{UnitCount: &zeroValue}
-	m.GetUnitCount()
-	m = &MarketplacePurchase{}
-	m.GetUnitCount()
-	m = nil
-	m.GetUnitCount()
+func TestPersonalAccessTokenRequest_GetID(tt *testing.T) {
+	var zeroValue int64
+	p := &PersonalAccessTokenRequest{ID: &zeroValue}
+	p.GetID()
+	p = &PersonalAccessTokenRequest{}
+	p.GetID()
+	p = nil
+	p.GetID()
 }
 
-func TestMarketplacePurchaseEvent_GetAction(tt *testing.T) {
-	var zeroValue string
-	m := &MarketplacePurchaseEvent{Action: &zeroValue}
-	m.GetAction()
-	m = &MarketplacePurchaseEvent{}
-	m.GetAction()
-	m = nil
-	m.GetAction()
+func TestPersonalAccessTokenRequest_GetOwner(tt *testing.T) {
+	p := &PersonalAccessTokenRequest{}
+	p.GetOwner()
+	p = nil
+	p.GetOwner()
 }
 
-func TestMarketplacePurchaseEvent_GetEffectiveDate(tt *testing.T) {
-	var zeroValue Timestamp
-	m := &MarketplacePurchaseEvent{EffectiveDate: &zeroValue}
-	m.GetEffectiveDate()
-	m = &MarketplacePurchaseEvent{}
-	m.GetEffectiveDate()
-	m = nil
-	m.GetEffectiveDate()
+func TestPersonalAccessTokenRequest_GetPermissionsAdded(tt *testing.T) {
+	p := &PersonalAccessTokenRequest{}
+	p.GetPermissionsAdded()
+	p = nil
+	p.GetPermissionsAdded()
 }
 
-func TestMarketplacePurchaseEvent_GetInstallation(tt *testing.T) {
-	m := &MarketplacePurchaseEvent{}
-	m.GetInstallation()
-	m = nil
-	m.GetInstallation()
+func TestPersonalAccessTokenRequest_GetPermissionsResult(tt *testing.T) {
+	p := &PersonalAccessTokenRequest{}
+	p.GetPermissionsResult()
+	p = nil
+	p.GetPermissionsResult()
 }
 
-func TestMarketplacePurchaseEvent_GetMarketplacePurchase(tt *testing.T) {
-	m := &MarketplacePurchaseEvent{}
-	m.GetMarketplacePurchase()
-	m = nil
-	m.GetMarketplacePurchase()
+func TestPersonalAccessTokenRequest_GetPermissionsUpgraded(tt *testing.T) {
+	p := &PersonalAccessTokenRequest{}
+	p.GetPermissionsUpgraded()
+	p = nil
+	p.GetPermissionsUpgraded()
 }
 
-func TestMarketplacePurchaseEvent_GetPreviousMarketplacePurchase(tt *testing.T) {
-	m := &MarketplacePurchaseEvent{}
-	m.GetPreviousMarketplacePurchase()
-	m = nil
-	m.GetPreviousMarketplacePurchase()
+func TestPersonalAccessTokenRequest_GetReason(tt *testing.T) {
+	var zeroValue string
+	p := &PersonalAccessTokenRequest{Reason: &zeroValue}
+	p.GetReason()
+	p = &PersonalAccessTokenRequest{}
+	p.GetReason()
+	p = nil
+	p.GetReason()
 }
 
-func TestMarketplacePurchaseEvent_GetSender(tt *testing.T) {
-	m := &MarketplacePurchaseEvent{}
-	m.GetSender()
-	m = nil
-	m.GetSender()
+func TestPersonalAccessTokenRequest_GetRepositoryCount(tt *testing.T) {
+	var zeroValue int64
+	p := &PersonalAccessTokenRequest{RepositoryCount: &zeroValue}
+	p.GetRepositoryCount()
+	p = &PersonalAccessTokenRequest{}
+	p.GetRepositoryCount()
+	p = nil
+	p.GetRepositoryCount()
 }
 
-func TestMatch_GetText(tt *testing.T) {
+func TestPersonalAccessTokenRequest_GetRepositorySelection(tt *testing.T) {
 	var zeroValue string
-	m := &Match{Text: &zeroValue}
-	m.GetText()
-	m = &Match{}
-	m.GetText()
-	m = nil
-	m.GetText()
+	p := &PersonalAccessTokenRequest{RepositorySelection: &zeroValue}
+	p.GetRepositorySelection()
+	p = &PersonalAccessTokenRequest{}
+	p.GetRepositorySelection()
+	p = nil
+	p.GetRepositorySelection()
 }
 
-func TestMemberEvent_GetAction(tt *testing.T) {
-	var zeroValue string
-	m := &MemberEvent{Action: &zeroValue}
-	m.GetAction()
-	m = &MemberEvent{}
-	m.GetAction()
-	m = nil
-	m.GetAction()
+func TestPersonalAccessTokenRequest_GetTokenExpired(tt *testing.T) {
+	var zeroValue bool
+	p := &PersonalAccessTokenRequest{TokenExpired: &zeroValue}
+	p.GetTokenExpired()
+	p = &PersonalAccessTokenRequest{}
+	p.GetTokenExpired()
+	p = nil
+	p.GetTokenExpired()
 }
 
-func TestMemberEvent_GetInstallation(tt *testing.T) {
-	m := &MemberEvent{}
-	m.GetInstallation()
-	m = nil
-	m.GetInstallation()
+func TestPersonalAccessTokenRequest_GetTokenExpiresAt(tt *testing.T) {
+	var zeroValue Timestamp
+	p := &PersonalAccessTokenRequest{TokenExpiresAt: &zeroValue}
+	p.GetTokenExpiresAt()
+	p = &PersonalAccessTokenRequest{}
+	p.GetTokenExpiresAt()
+	p = nil
+	p.GetTokenExpiresAt()
 }
 
-func TestMemberEvent_GetMember(tt *testing.T) {
-	m := &MemberEvent{}
-	m.GetMember()
-	m = nil
-	m.GetMember()
+func TestPersonalAccessTokenRequest_GetTokenID(tt *testing.T) {
+	var zeroValue int64
+	p := &PersonalAccessTokenRequest{TokenID: &zeroValue}
+	p.GetTokenID()
+	p = &PersonalAccessTokenRequest{}
+	p.GetTokenID()
+	p = nil
+	p.GetTokenID()
 }
 
-func TestMemberEvent_GetRepo(tt *testing.T) {
-	m := &MemberEvent{}
-	m.GetRepo()
-	m = nil
-	m.GetRepo()
+func TestPersonalAccessTokenRequest_GetTokenLastUsedAt(tt *testing.T) {
+	var zeroValue Timestamp
+	p := &PersonalAccessTokenRequest{TokenLastUsedAt: &zeroValue}
+	p.GetTokenLastUsedAt()
+	p = &PersonalAccessTokenRequest{}
+	p.GetTokenLastUsedAt()
+	p = nil
+	p.GetTokenLastUsedAt()
 }
 
-func TestMemberEvent_GetSender(tt *testing.T) {
-	m := &MemberEvent{}
-	m.GetSender()
-	m = nil
-	m.GetSender()
+func TestPersonalAccessTokenRequest_GetTokenName(tt *testing.T) {
+	var zeroValue string
+	p := &PersonalAccessTokenRequest{TokenName: &zeroValue}
+	p.GetTokenName()
+	p = &PersonalAccessTokenRequest{}
+	p.GetTokenName()
+	p = nil
+	p.GetTokenName()
 }
 
-func TestMembership_GetOrganization(tt *testing.T) {
-	m := &Membership{}
-	m.GetOrganization()
-	m = nil
-	m.GetOrganization()
+func TestPingEvent_GetHook(tt *testing.T) {
+	p := &PingEvent{}
+	p.GetHook()
+	p = nil
+	p.GetHook()
 }
 
-func TestMembership_GetOrganizationURL(tt *testing.T) {
-	var zeroValue string
-	m := &Membership{OrganizationURL: &zeroValue}
-	m.GetOrganizationURL()
-	m = &Membership{}
-	m.GetOrganizationURL()
-	m = nil
-	m.GetOrganizationURL()
+func TestPingEvent_GetHookID(tt *testing.T) {
+	var zeroValue int64
+	p := &PingEvent{HookID: &zeroValue}
+	p.GetHookID()
+	p = &PingEvent{}
+	p.GetHookID()
+	p = nil
+	p.GetHookID()
 }
 
-func TestMembership_GetRole(tt *testing.T) {
-	var zeroValue string
-	m := &Membership{Role: &zeroValue}
-	m.GetRole()
-	m = &Membership{}
-	m.GetRole()
-	m = nil
-	m.GetRole()
+func TestPingEvent_GetInstallation(tt *testing.T) {
+	p := &PingEvent{}
+	p.GetInstallation()
+	p = nil
+	p.GetInstallation()
 }
 
-func TestMembership_GetState(tt *testing.T) {
+func TestPingEvent_GetZen(tt *testing.T) {
 	var zeroValue string
-	m := &Membership{State: &zeroValue}
-	m.GetState()
-	m = &Membership{}
-	m.GetState()
-	m = nil
-	m.GetState()
+	p := &PingEvent{Zen: &zeroValue}
+	p.GetZen()
+	p = &PingEvent{}
+	p.GetZen()
+	p = nil
+	p.GetZen()
 }
 
-func TestMembership_GetURL(tt *testing.T) {
-	var zeroValue string
-	m := &Membership{URL: &zeroValue}
-	m.GetURL()
-	m = &Membership{}
-	m.GetURL()
-	m = nil
-	m.GetURL()
+func TestPlan_GetCollaborators(tt *testing.T) {
+	var zeroValue int
+	p := &Plan{Collaborators: &zeroValue}
+	p.GetCollaborators()
+	p = &Plan{}
+	p.GetCollaborators()
+	p = nil
+	p.GetCollaborators()
 }
 
-func TestMembership_GetUser(tt *testing.T) {
-	m := &Membership{}
-	m.GetUser()
-	m = nil
-	m.GetUser()
+func TestPlan_GetFilledSeats(tt *testing.T) {
+	var zeroValue int
+	p := &Plan{FilledSeats: &zeroValue}
+	p.GetFilledSeats()
+	p = &Plan{}
+	p.GetFilledSeats()
+	p = nil
+	p.GetFilledSeats()
 }
 
-func TestMembershipEvent_GetAction(tt *testing.T) {
+func TestPlan_GetName(tt *testing.T) {
 	var zeroValue string
-	m := &MembershipEvent{Action: &zeroValue}
-	m.GetAction()
-	m = &MembershipEvent{}
-	m.GetAction()
-	m = nil
-	m.GetAction()
+	p := &Plan{Name: &zeroValue}
+	p.GetName()
+	p = &Plan{}
+	p.GetName()
+	p = nil
+	p.GetName()
 }
 
-func TestMembershipEvent_GetInstallation(tt *testing.T) {
-	m := &MembershipEvent{}
-	m.GetInstallation()
-	m = nil
-	m.GetInstallation()
+func TestPlan_GetPrivateRepos(tt *testing.T) {
+	var zeroValue int
+	p := &Plan{PrivateRepos: &zeroValue}
+	p.GetPrivateRepos()
+	p = &Plan{}
+	p.GetPrivateRepos()
+	p = nil
+	p.GetPrivateRepos()
 }
 
-func TestMembershipEvent_GetMember(tt *testing.T) {
-	m := &MembershipEvent{}
-	m.GetMember()
-	m = nil
-	m.GetMember()
+func TestPlan_GetSeats(tt *testing.T) {
+	var zeroValue int
+	p := &Plan{Seats: &zeroValue}
+	p.GetSeats()
+	p = &Plan{}
+	p.GetSeats()
+	p = nil
+	p.GetSeats()
 }
 
-func TestMembershipEvent_GetOrg(tt *testing.T) {
-	m := &MembershipEvent{}
-	m.GetOrg()
-	m = nil
-	m.GetOrg()
+func TestPlan_GetSpace(tt *testing.T) {
+	var zeroValue int
+	p := &Plan{Space: &zeroValue}
+	p.GetSpace()
+	p = &Plan{}
+	p.GetSpace()
+	p = nil
+	p.GetSpace()
 }
 
-func TestMembershipEvent_GetScope(tt *testing.T) {
+func TestPreReceiveHook_GetConfigURL(tt *testing.T) {
 	var zeroValue string
-	m := &MembershipEvent{Scope: &zeroValue}
-	m.GetScope()
-	m = &MembershipEvent{}
-	m.GetScope()
-	m = nil
-	m.GetScope()
+	p := &PreReceiveHook{ConfigURL: &zeroValue}
+	p.GetConfigURL()
+	p = &PreReceiveHook{}
+	p.GetConfigURL()
+	p = nil
+	p.GetConfigURL()
 }
 
-func TestMembershipEvent_GetSender(tt *testing.T) {
-	m := &MembershipEvent{}
-	m.GetSender()
-	m = nil
-	m.GetSender()
+func TestPreReceiveHook_GetEnforcement(tt *testing.T) {
+	var zeroValue string
+	p := &PreReceiveHook{Enforcement: &zeroValue}
+	p.GetEnforcement()
+	p = &PreReceiveHook{}
+	p.GetEnforcement()
+	p = nil
+	p.GetEnforcement()
+}
+
+func TestPreReceiveHook_GetID(tt *testing.T) {
+	var zeroValue int64
+	p := &PreReceiveHook{ID: &zeroValue}
+	p.GetID()
+	p = &PreReceiveHook{}
+	p.GetID()
+	p = nil
+	p.GetID()
+}
+
+func TestPreReceiveHook_GetName(tt *testing.T) {
+	var zeroValue string
+	p := &PreReceiveHook{Name: &zeroValue}
+	p.GetName()
+	p = &PreReceiveHook{}
+	p.GetName()
+	p = nil
+	p.GetName()
+}
+
+func TestPRLink_GetHRef(tt *testing.T) {
+	var zeroValue string
+	p := &PRLink{HRef: &zeroValue}
+	p.GetHRef()
+	p = &PRLink{}
+	p.GetHRef()
+	p = nil
+	p.GetHRef()
 }
 
-func TestMembershipEvent_GetTeam(tt *testing.T) {
-	m := &MembershipEvent{}
-	m.GetTeam()
-	m = nil
-	m.GetTeam()
+func TestPRLinks_GetComments(tt *testing.T) {
+	p := &PRLinks{}
+	p.GetComments()
+	p = nil
+	p.GetComments()
 }
 
-func TestMetaEvent_GetAction(tt *testing.T) {
-	var zeroValue string
-	m := &MetaEvent{Action: &zeroValue}
-	m.GetAction()
-	m = &MetaEvent{}
-	m.GetAction()
-	m = nil
-	m.GetAction()
+func TestPRLinks_GetCommits(tt *testing.T) {
+	p := &PRLinks{}
+	p.GetCommits()
+	p = nil
+	p.GetCommits()
 }
 
-func TestMetaEvent_GetHook(tt *testing.T) {
-	m := &MetaEvent{}
-	m.GetHook()
-	m = nil
-	m.GetHook()
+func TestPRLinks_GetHTML(tt *testing.T) {
+	p := &PRLinks{}
+	p.GetHTML()
+	p = nil
+	p.GetHTML()
 }
 
-func TestMetaEvent_GetHookID(tt *testing.T) {
-	var zeroValue int64
-	m := &MetaEvent{HookID: &zeroValue}
-	m.GetHookID()
-	m = &MetaEvent{}
-	m.GetHookID()
-	m = nil
-	m.GetHookID()
+func TestPRLinks_GetIssue(tt *testing.T) {
+	p := &PRLinks{}
+	p.GetIssue()
+	p = nil
+	p.GetIssue()
 }
 
-func TestMetric_GetHTMLURL(tt *testing.T) {
-	var zeroValue string
-	m := &Metric{HTMLURL: &zeroValue}
-	m.GetHTMLURL()
-	m = &Metric{}
-	m.GetHTMLURL()
-	m = nil
-	m.GetHTMLURL()
+func TestPRLinks_GetReviewComment(tt *testing.T) {
+	p := &PRLinks{}
+	p.GetReviewComment()
+	p = nil
+	p.GetReviewComment()
 }
 
-func TestMetric_GetKey(tt *testing.T) {
-	var zeroValue string
-	m := &Metric{Key: &zeroValue}
-	m.GetKey()
-	m = &Metric{}
-	m.GetKey()
-	m = nil
-	m.GetKey()
+func TestPRLinks_GetReviewComments(tt *testing.T) {
+	p := &PRLinks{}
+	p.GetReviewComments()
+	p = nil
+	p.GetReviewComments()
 }
 
-func TestMetric_GetName(tt *testing.T) {
-	var zeroValue string
-	m := &Metric{Name: &zeroValue}
-	m.GetName()
-	m = &Metric{}
-	m.GetName()
-	m = nil
-	m.GetName()
+func TestPRLinks_GetSelf(tt *testing.T) {
+	p := &PRLinks{}
+	p.GetSelf()
+	p = nil
+	p.GetSelf()
 }
 
-func TestMetric_GetURL(tt *testing.T) {
-	var zeroValue string
-	m := &Metric{URL: &zeroValue}
-	m.GetURL()
-	m = &Metric{}
-	m.GetURL()
-	m = nil
-	m.GetURL()
+func TestPRLinks_GetStatuses(tt *testing.T) {
+	p := &PRLinks{}
+	p.GetStatuses()
+	p = nil
+	p.GetStatuses()
 }
 
-func TestMigration_GetCreatedAt(tt *testing.T) {
+func TestProject_GetBody(tt *testing.T) {
 	var zeroValue string
-	m := &Migration{CreatedAt: &zeroValue}
-	m.GetCreatedAt()
-	m = &Migration{}
-	m.GetCreatedAt()
-	m = nil
-	m.GetCreatedAt()
-}
-
-func TestMigration_GetExcludeAttachments(tt *testing.T) {
-	var zeroValue bool
-	m := &Migration{ExcludeAttachments: &zeroValue}
-	m.GetExcludeAttachments()
-	m = &Migration{}
-	m.GetExcludeAttachments()
-	m = nil
-	m.GetExcludeAttachments()
+	p := &Project{Body: &zeroValue}
+	p.GetBody()
+	p = &Project{}
+	p.GetBody()
+	p = nil
+	p.GetBody()
 }
 
-func TestMigration_GetGUID(tt *testing.T) {
+func TestProject_GetColumnsURL(tt *testing.T) {
 	var zeroValue string
-	m := &Migration{GUID: &zeroValue}
-	m.GetGUID()
-	m = &Migration{}
-	m.GetGUID()
-	m = nil
-	m.GetGUID()
+	p := &Project{ColumnsURL: &zeroValue}
+	p.GetColumnsURL()
+	p = &Project{}
+	p.GetColumnsURL()
+	p = nil
+	p.GetColumnsURL()
 }
 
-func TestMigration_GetID(tt *testing.T) {
-	var zeroValue int64
-	m := &Migration{ID: &zeroValue}
-	m.GetID()
-	m = &Migration{}
-	m.GetID()
-	m = nil
-	m.GetID()
+func TestProject_GetCreatedAt(tt *testing.T) {
+	var zeroValue Timestamp
+	p := &Project{CreatedAt: &zeroValue}
+	p.GetCreatedAt()
+	p = &Project{}
+	p.GetCreatedAt()
+	p = nil
+	p.GetCreatedAt()
 }
 
-func TestMigration_GetLockRepositories(tt *testing.T) {
-	var zeroValue bool
-	m := &Migration{LockRepositories: &zeroValue}
-	m.GetLockRepositories()
-	m = &Migration{}
-	m.GetLockRepositories()
-	m = nil
-	m.GetLockRepositories()
+func TestProject_GetCreator(tt *testing.T) {
+	p := &Project{}
+	p.GetCreator()
+	p = nil
+	p.GetCreator()
 }
 
-func TestMigration_GetState(tt *testing.T) {
+func TestProject_GetHTMLURL(tt *testing.T) {
 	var zeroValue string
-	m := &Migration{State: &zeroValue}
-	m.GetState()
-	m = &Migration{}
-	m.GetState()
-	m = nil
-	m.GetState()
+	p := &Project{HTMLURL: &zeroValue}
+	p.GetHTMLURL()
+	p = &Project{}
+	p.GetHTMLURL()
+	p = nil
+	p.GetHTMLURL()
 }
 
-func TestMigration_GetUpdatedAt(tt *testing.T) {
-	var zeroValue string
-	m := &Migration{UpdatedAt: &zeroValue}
-	m.GetUpdatedAt()
-	m = &Migration{}
-	m.GetUpdatedAt()
-	m = nil
-	m.GetUpdatedAt()
+func TestProject_GetID(tt *testing.T) {
+	var zeroValue int64
+	p := &Project{ID: &zeroValue}
+	p.GetID()
+	p = &Project{}
+	p.GetID()
+	p = nil
+	p.GetID()
 }
 
-func TestMigration_GetURL(tt *testing.T) {
+func TestProject_GetName(tt *testing.T) {
 	var zeroValue string
-	m := &Migration{URL: &zeroValue}
-	m.GetURL()
-	m = &Migration{}
-	m.GetURL()
-	m = nil
-	m.GetURL()
+	p := &Project{Name: &zeroValue}
+	p.GetName()
+	p = &Project{}
+	p.GetName()
+	p = nil
+	p.GetName()
 }
 
-func TestMilestone_GetClosedAt(tt *testing.T) {
-	var zeroValue time.Time
-	m := &Milestone{ClosedAt: &zeroValue}
-	m.GetClosedAt()
-	m = &Milestone{}
-	m.GetClosedAt()
-	m = nil
-	m.GetClosedAt()
+func TestProject_GetNodeID(tt *testing.T) {
+	var zeroValue string
+	p := &Project{NodeID: &zeroValue}
+	p.GetNodeID()
+	p = &Project{}
+	p.GetNodeID()
+	p = nil
+	p.GetNodeID()
 }
 
-func TestMilestone_GetClosedIssues(tt *testing.T) {
+func TestProject_GetNumber(tt *testing.T) {
 	var zeroValue int
-	m := &Milestone{ClosedIssues: &zeroValue}
-	m.GetClosedIssues()
-	m = &Milestone{}
-	m.GetClosedIssues()
-	m = nil
-	m.GetClosedIssues()
-}
-
-func TestMilestone_GetCreatedAt(tt *testing.T) {
-	var zeroValue time.Time
-	m := &Milestone{CreatedAt: &zeroValue}
-	m.GetCreatedAt()
-	m = &Milestone{}
-	m.GetCreatedAt()
-	m = nil
-	m.GetCreatedAt()
+	p := &Project{Number: &zeroValue}
+	p.GetNumber()
+	p = &Project{}
+	p.GetNumber()
+	p = nil
+	p.GetNumber()
 }
 
-func TestMilestone_GetCreator(tt *testing.T) {
-	m := &Milestone{}
-	m.GetCreator()
-	m = nil
-	m.GetCreator()
+func TestProject_GetOwnerURL(tt *testing.T) {
+	var zeroValue string
+	p := &Project{OwnerURL: &zeroValue}
+	p.GetOwnerURL()
+	p = &Project{}
+	p.GetOwnerURL()
+	p = nil
+	p.GetOwnerURL()
 }
 
-func TestMilestone_GetDescription(tt *testing.T) {
+func TestProject_GetState(tt *testing.T) {
 	var zeroValue string
-	m := &Milestone{Description: &zeroValue}
-	m.GetDescription()
-	m = &Milestone{}
-	m.GetDescription()
-	m = nil
-	m.GetDescription()
+	p := &Project{State: &zeroValue}
+	p.GetState()
+	p = &Project{}
+	p.GetState()
+	p = nil
+	p.GetState()
 }
 
-func TestMilestone_GetDueOn(tt *testing.T) {
-	var zeroValue time.Time
-	m := &Milestone{DueOn: &zeroValue}
-	m.GetDueOn()
-	m = &Milestone{}
-	m.GetDueOn()
-	m = nil
-	m.GetDueOn()
+func TestProject_GetUpdatedAt(tt *testing.T) {
+	var zeroValue Timestamp
+	p := &Project{UpdatedAt: &zeroValue}
+	p.GetUpdatedAt()
+	p = &Project{}
+	p.GetUpdatedAt()
+	p = nil
+	p.GetUpdatedAt()
 }
 
-func TestMilestone_GetHTMLURL(tt *testing.T) {
+func TestProject_GetURL(tt *testing.T) {
 	var zeroValue string
-	m := &Milestone{HTMLURL: &zeroValue}
-	m.GetHTMLURL()
-	m = &Milestone{}
-	m.GetHTMLURL()
-	m = nil
-	m.GetHTMLURL()
+	p := &Project{URL: &zeroValue}
+	p.GetURL()
+	p = &Project{}
+	p.GetURL()
+	p = nil
+	p.GetURL()
 }
 
-func TestMilestone_GetID(tt *testing.T) {
+func TestProjectCard_GetArchived(tt *testing.T) {
+	var zeroValue bool
+	p := &ProjectCard{Archived: &zeroValue}
+	p.GetArchived()
+	p = &ProjectCard{}
+	p.GetArchived()
+	p = nil
+	p.GetArchived()
+}
+
+func TestProjectCard_GetColumnID(tt *testing.T) {
 	var zeroValue int64
-	m := &Milestone{ID: &zeroValue}
-	m.GetID()
-	m = &Milestone{}
-	m.GetID()
-	m = nil
-	m.GetID()
+	p := &ProjectCard{ColumnID: &zeroValue}
+	p.GetColumnID()
+	p = &ProjectCard{}
+	p.GetColumnID()
+	p = nil
+	p.GetColumnID()
 }
 
-func TestMilestone_GetLabelsURL(tt *testing.T) {
+func TestProjectCard_GetColumnName(tt *testing.T) {
 	var zeroValue string
-	m := &Milestone{LabelsURL: &zeroValue}
-	m.GetLabelsURL()
-	m = &Milestone{}
-	m.GetLabelsURL()
-	m = nil
-	m.GetLabelsURL()
+	p := &ProjectCard{ColumnName: &zeroValue}
+	p.GetColumnName()
+	p = &ProjectCard{}
+	p.GetColumnName()
+	p = nil
+	p.GetColumnName()
 }
 
-func TestMilestone_GetNodeID(tt *testing.T) {
+func TestProjectCard_GetColumnURL(tt *testing.T) {
 	var zeroValue string
-	m := &Milestone{NodeID: &zeroValue}
-	m.GetNodeID()
-	m = &Milestone{}
-	m.GetNodeID()
-	m = nil
-	m.GetNodeID()
+	p := &ProjectCard{ColumnURL: &zeroValue}
+	p.GetColumnURL()
+	p = &ProjectCard{}
+	p.GetColumnURL()
+	p = nil
+	p.GetColumnURL()
 }
 
-func TestMilestone_GetNumber(tt *testing.T) {
-	var zeroValue int
-	m := &Milestone{Number: &zeroValue}
-	m.GetNumber()
-	m = &Milestone{}
-	m.GetNumber()
-	m = nil
-	m.GetNumber()
+func TestProjectCard_GetContentURL(tt *testing.T) {
+	var zeroValue string
+	p := &ProjectCard{ContentURL: &zeroValue}
+	p.GetContentURL()
+	p = &ProjectCard{}
+	p.GetContentURL()
+	p = nil
+	p.GetContentURL()
 }
 
-func TestMilestone_GetOpenIssues(tt *testing.T) {
-	var zeroValue int
-	m := &Milestone{OpenIssues: &zeroValue}
-	m.GetOpenIssues()
-	m = &Milestone{}
-	m.GetOpenIssues()
-	m = nil
-	m.GetOpenIssues()
+func TestProjectCard_GetCreatedAt(tt *testing.T) {
+	var zeroValue Timestamp
+	p := &ProjectCard{CreatedAt: &zeroValue}
+	p.GetCreatedAt()
+	p = &ProjectCard{}
+	p.GetCreatedAt()
+	p = nil
+	p.GetCreatedAt()
 }
 
-func TestMilestone_GetState(tt *testing.T) {
-	var zeroValue string
-	m := &Milestone{State: &zeroValue}
-	m.GetState()
-	m = &Milestone{}
-	m.GetState()
-	m = nil
-	m.GetState()
+func TestProjectCard_GetCreator(tt *testing.T) {
+	p := &ProjectCard{}
+	p.GetCreator()
+	p = nil
+	p.GetCreator()
 }
 
-func TestMilestone_GetTitle(tt *testing.T) {
-	var zeroValue string
-	m := &Milestone{Title: &zeroValue}
-	m.GetTitle()
-	m = &Milestone{}
-	m.GetTitle()
-	m = nil
-	m.GetTitle()
+func TestProjectCard_GetID(tt *testing.T) {
+	var zeroValue int64
+	p := &ProjectCard{ID: &zeroValue}
+	p.GetID()
+	p = &ProjectCard{}
+	p.GetID()
+	p = nil
+	p.GetID()
 }
 
-func TestMilestone_GetUpdatedAt(tt *testing.T) {
-	var zeroValue time.Time
-	m := &Milestone{UpdatedAt: &zeroValue}
-	m.GetUpdatedAt()
-	m = &Milestone{}
-	m.GetUpdatedAt()
-	m = nil
-	m.GetUpdatedAt()
+func TestProjectCard_GetNodeID(tt *testing.T) {
+	var zeroValue string
+	p := &ProjectCard{NodeID: &zeroValue}
+	p.GetNodeID()
+	p = &ProjectCard{}
+	p.GetNodeID()
+	p = nil
+	p.GetNodeID()
 }
 
-func TestMilestone_GetURL(tt *testing.T) {
+func TestProjectCard_GetNote(tt *testing.T) {
 	var zeroValue string
-	m := &Milestone{URL: &zeroValue}
-	m.GetURL()
-	m = &Milestone{}
-	m.GetURL()
-	m = nil
-	m.GetURL()
+	p := &ProjectCard{Note: &zeroValue}
+	p.GetNote()
+	p = &ProjectCard{}
+	p.GetNote()
+	p = nil
+	p.GetNote()
 }
 
-func TestMilestoneEvent_GetAction(tt *testing.T) {
+func TestProjectCard_GetPreviousColumnName(tt *testing.T) {
 	var zeroValue string
-	m := &MilestoneEvent{Action: &zeroValue}
-	m.GetAction()
-	m = &MilestoneEvent{}
-	m.GetAction()
-	m = nil
-	m.GetAction()
+	p := &ProjectCard{PreviousColumnName: &zeroValue}
+	p.GetPreviousColumnName()
+	p = &ProjectCard{}
+	p.GetPreviousColumnName()
+	p = nil
+	p.GetPreviousColumnName()
 }
 
-func TestMilestoneEvent_GetChanges(tt *testing.T) {
-	m := &MilestoneEvent{}
-	m.GetChanges()
-	m = nil
-	m.GetChanges()
+func TestProjectCard_GetProjectID(tt *testing.T) {
+	var zeroValue int64
+	p := &ProjectCard{ProjectID: &zeroValue}
+	p.GetProjectID()
+	p = &ProjectCard{}
+	p.GetProjectID()
+	p = nil
+	p.GetProjectID()
 }
 
-func TestMilestoneEvent_GetInstallation(tt *testing.T) {
-	m := &MilestoneEvent{}
-	m.GetInstallation()
-	m = nil
-	m.GetInstallation()
+func TestProjectCard_GetProjectURL(tt *testing.T) {
+	var zeroValue string
+	p := &ProjectCard{ProjectURL: &zeroValue}
+	p.GetProjectURL()
+	p = &ProjectCard{}
+	p.GetProjectURL()
+	p = nil
+	p.GetProjectURL()
 }
 
-func TestMilestoneEvent_GetMilestone(tt *testing.T) {
-	m := &MilestoneEvent{}
-	m.GetMilestone()
-	m = nil
-	m.GetMilestone()
+func TestProjectCard_GetUpdatedAt(tt *testing.T) {
+	var zeroValue Timestamp
+	p := &ProjectCard{UpdatedAt: &zeroValue}
+	p.GetUpdatedAt()
+	p = &ProjectCard{}
+	p.GetUpdatedAt()
+	p = nil
+	p.GetUpdatedAt()
 }
 
-func TestMilestoneEvent_GetOrg(tt *testing.T) {
-	m := &MilestoneEvent{}
-	m.GetOrg()
-	m = nil
-	m.GetOrg()
+func TestProjectCard_GetURL(tt *testing.T) {
+	var zeroValue string
+	p := &ProjectCard{URL: &zeroValue}
+	p.GetURL()
+	p = &ProjectCard{}
+	p.GetURL()
+	p = nil
+	p.GetURL()
 }
 
-func TestMilestoneEvent_GetRepo(tt *testing.T) {
-	m := &MilestoneEvent{}
-	m.GetRepo()
-	m = nil
-	m.GetRepo()
+func TestProjectCardEvent_GetAction(tt *testing.T) {
+	var zeroValue string
+	p := &ProjectCardEvent{Action: &zeroValue}
+	p.GetAction()
+	p = &ProjectCardEvent{}
+	p.GetAction()
+	p = nil
+	p.GetAction()
 }
 
-func TestMilestoneEvent_GetSender(tt *testing.T) {
-	m := &MilestoneEvent{}
-	m.GetSender()
-	m = nil
-	m.GetSender()
+func TestProjectCardEvent_GetAfterID(tt *testing.T) {
+	var zeroValue int64
+	p := &ProjectCardEvent{AfterID: &zeroValue}
+	p.GetAfterID()
+	p = &ProjectCardEvent{}
+	p.GetAfterID()
+	p = nil
+	p.GetAfterID()
 }
 
-func TestMilestoneStats_GetClosedMilestones(tt *testing.T) {
-	var zeroValue int
-	m := &MilestoneStats{ClosedMilestones: &zeroValue}
-	m.GetClosedMilestones()
-	m = &MilestoneStats{}
-	m.GetClosedMilestones()
-	m = nil
-	m.GetClosedMilestones()
+func TestProjectCardEvent_GetChanges(tt *testing.T) {
+	p := &ProjectCardEvent{}
+	p.GetChanges()
+	p = nil
+	p.GetChanges()
 }
 
-func TestMilestoneStats_GetOpenMilestones(tt *testing.T) {
-	var zeroValue int
-	m := &MilestoneStats{OpenMilestones: &zeroValue}
-	m.GetOpenMilestones()
-	m = &MilestoneStats{}
-	m.GetOpenMilestones()
-	m = nil
-	m.GetOpenMilestones()
+func TestProjectCardEvent_GetInstallation(tt *testing.T) {
+	p := &ProjectCardEvent{}
+	p.GetInstallation()
+	p = nil
+	p.GetInstallation()
 }
 
-func TestMilestoneStats_GetTotalMilestones(tt *testing.T) {
-	var zeroValue int
-	m := &MilestoneStats{TotalMilestones: &zeroValue}
-	m.GetTotalMilestones()
-	m = &MilestoneStats{}
-	m.GetTotalMilestones()
-	m = nil
-	m.GetTotalMilestones()
+func TestProjectCardEvent_GetOrg(tt *testing.T) {
+	p := &ProjectCardEvent{}
+	p.GetOrg()
+	p = nil
+	p.GetOrg()
 }
 
-func TestNewPullRequest_GetBase(tt *testing.T) {
-	var zeroValue string
-	n := &NewPullRequest{Base: &zeroValue}
-	n.GetBase()
-	n = &NewPullRequest{}
-	n.GetBase()
-	n = nil
-	n.GetBase()
+func TestProjectCardEvent_GetProjectCard(tt *testing.T) {
+	p := &ProjectCardEvent{}
+	p.GetProjectCard()
+	p = nil
+	p.GetProjectCard()
 }
 
-func TestNewPullRequest_GetBody(tt *testing.T) {
-	var zeroValue string
-	n := &NewPullRequest{Body: &zeroValue}
-	n.GetBody()
-	n = &NewPullRequest{}
-	n.GetBody()
-	n = nil
-	n.GetBody()
+func TestProjectCardEvent_GetRepo(tt *testing.T) {
+	p := &ProjectCardEvent{}
+	p.GetRepo()
+	p = nil
+	p.GetRepo()
 }
 
-func TestNewPullRequest_GetDraft(tt *testing.T) {
-	var zeroValue bool
-	n := &NewPullRequest{Draft: &zeroValue}
-	n.GetDraft()
-	n = &NewPullRequest{}
-	n.GetDraft()
-	n = nil
-	n.GetDraft()
+func TestProjectCardEvent_GetSender(tt *testing.T) {
+	p := &ProjectCardEvent{}
+	p.GetSender()
+	p = nil
+	p.GetSender()
 }
 
-func TestNewPullRequest_GetHead(tt *testing.T) {
+func TestProjectCardListOptions_GetArchivedState(tt *testing.T) {
 	var zeroValue string
-	n := &NewPullRequest{Head: &zeroValue}
-	n.GetHead()
-	n = &NewPullRequest{}
-	n.GetHead()
-	n = nil
-	n.GetHead()
-}
-
-func TestNewPullRequest_GetIssue(tt *testing.T) {
-	var zeroValue int
-	n := &NewPullRequest{Issue: &zeroValue}
-	n.GetIssue()
-	n = &NewPullRequest{}
-	n.GetIssue()
-	n = nil
-	n.GetIssue()
+	p := &ProjectCardListOptions{ArchivedState: &zeroValue}
+	p.GetArchivedState()
+	p = &ProjectCardListOptions{}
+	p.GetArchivedState()
+	p = nil
+	p.GetArchivedState()
 }
 
-func TestNewPullRequest_GetMaintainerCanModify(tt *testing.T) {
+func TestProjectCardOptions_GetArchived(tt *testing.T) {
 	var zeroValue bool
-	n := &NewPullRequest{MaintainerCanModify: &zeroValue}
-	n.GetMaintainerCanModify()
-	n = &NewPullRequest{}
-	n.GetMaintainerCanModify()
-	n = nil
-	n.GetMaintainerCanModify()
+	p := &ProjectCardOptions{Archived: &zeroValue}
+	p.GetArchived()
+	p = &ProjectCardOptions{}
+	p.GetArchived()
+	p = nil
+	p.GetArchived()
 }
 
-func TestNewPullRequest_GetTitle(tt *testing.T) {
+func TestProjectCollaboratorOptions_GetPermission(tt *testing.T) {
 	var zeroValue string
-	n := &NewPullRequest{Title: &zeroValue}
-	n.GetTitle()
-	n = &NewPullRequest{}
-	n.GetTitle()
-	n = nil
-	n.GetTitle()
+	p := &ProjectCollaboratorOptions{Permission: &zeroValue}
+	p.GetPermission()
+	p = &ProjectCollaboratorOptions{}
+	p.GetPermission()
+	p = nil
+	p.GetPermission()
 }
 
-func TestNewTeam_GetDescription(tt *testing.T) {
+func TestProjectColumn_GetCardsURL(tt *testing.T) {
 	var zeroValue string
-	n := &NewTeam{Description: &zeroValue}
-	n.GetDescription()
-	n = &NewTeam{}
-	n.GetDescription()
-	n = nil
-	n.GetDescription()
+	p := &ProjectColumn{CardsURL: &zeroValue}
+	p.GetCardsURL()
+	p = &ProjectColumn{}
+	p.GetCardsURL()
+	p = nil
+	p.GetCardsURL()
 }
 
-func TestNewTeam_GetLDAPDN(tt *testing.T) {
-	var zeroValue string
-	n := &NewTeam{LDAPDN: &zeroValue}
-	n.GetLDAPDN()
-	n = &NewTeam{}
-	n.GetLDAPDN()
-	n = nil
-	n.GetLDAPDN()
+func TestProjectColumn_GetCreatedAt(tt *testing.T) {
+	var zeroValue Timestamp
+	p := &ProjectColumn{CreatedAt: &zeroValue}
+	p.GetCreatedAt()
+	p = &ProjectColumn{}
+	p.GetCreatedAt()
+	p = nil
+	p.GetCreatedAt()
 }
 
-func TestNewTeam_GetParentTeamID(tt *testing.T) {
+func TestProjectColumn_GetID(tt *testing.T) {
 	var zeroValue int64
-	n := &NewTeam{ParentTeamID: &zeroValue}
-	n.GetParentTeamID()
-	n = &NewTeam{}
-	n.GetParentTeamID()
-	n = nil
-	n.GetParentTeamID()
+	p := &ProjectColumn{ID: &zeroValue}
+	p.GetID()
+	p = &ProjectColumn{}
+	p.GetID()
+	p = nil
+	p.GetID()
 }
 
-func TestNewTeam_GetPermission(tt *testing.T) {
+func TestProjectColumn_GetName(tt *testing.T) {
 	var zeroValue string
-	n := &NewTeam{Permission: &zeroValue}
-	n.GetPermission()
-	n = &NewTeam{}
-	n.GetPermission()
-	n = nil
-	n.GetPermission()
+	p := &ProjectColumn{Name: &zeroValue}
+	p.GetName()
+	p = &ProjectColumn{}
+	p.GetName()
+	p = nil
+	p.GetName()
 }
 
-func TestNewTeam_GetPrivacy(tt *testing.T) {
+func TestProjectColumn_GetNodeID(tt *testing.T) {
 	var zeroValue string
-	n := &NewTeam{Privacy: &zeroValue}
-	n.GetPrivacy()
-	n = &NewTeam{}
-	n.GetPrivacy()
-	n = nil
-	n.GetPrivacy()
+	p := &ProjectColumn{NodeID: &zeroValue}
+	p.GetNodeID()
+	p = &ProjectColumn{}
+	p.GetNodeID()
+	p = nil
+	p.GetNodeID()
 }
 
-func TestNotification_GetID(tt *testing.T) {
+func TestProjectColumn_GetProjectURL(tt *testing.T) {
 	var zeroValue string
-	n := &Notification{ID: &zeroValue}
-	n.GetID()
-	n = &Notification{}
-	n.GetID()
-	n = nil
-	n.GetID()
-}
-
-func TestNotification_GetLastReadAt(tt *testing.T) {
-	var zeroValue time.Time
-	n := &Notification{LastReadAt: &zeroValue}
-	n.GetLastReadAt()
-	n = &Notification{}
-	n.GetLastReadAt()
-	n = nil
-	n.GetLastReadAt()
+	p := &ProjectColumn{ProjectURL: &zeroValue}
+	p.GetProjectURL()
+	p = &ProjectColumn{}
+	p.GetProjectURL()
+	p = nil
+	p.GetProjectURL()
 }
 
-func TestNotification_GetReason(tt *testing.T) {
-	var zeroValue string
-	n := &Notification{Reason: &zeroValue}
-	n.GetReason()
-	n = &Notification{}
-	n.GetReason()
-	n = nil
-	n.GetReason()
+func TestProjectColumn_GetUpdatedAt(tt *testing.T) {
+	var zeroValue Timestamp
+	p := &ProjectColumn{UpdatedAt: &zeroValue}
+	p.GetUpdatedAt()
+	p = &ProjectColumn{}
+	p.GetUpdatedAt()
+	p = nil
+	p.GetUpdatedAt()
 }
 
-func TestNotification_GetRepository(tt *testing.T) {
-	n := &Notification{}
-	n.GetRepository()
-	n = nil
-	n.GetRepository()
+func TestProjectColumn_GetURL(tt *testing.T) {
+	var zeroValue string
+	p := &ProjectColumn{URL: &zeroValue}
+	p.GetURL()
+	p = &ProjectColumn{}
+	p.GetURL()
+	p = nil
+	p.GetURL()
 }
 
-func TestNotification_GetSubject(tt *testing.T) {
-	n := &Notification{}
-	n.GetSubject()
-	n = nil
-	n.GetSubject()
+func TestProjectColumnEvent_GetAction(tt *testing.T) {
+	var zeroValue string
+	p := &ProjectColumnEvent{Action: &zeroValue}
+	p.GetAction()
+	p = &ProjectColumnEvent{}
+	p.GetAction()
+	p = nil
+	p.GetAction()
 }
 
-func TestNotification_GetUnread(tt *testing.T) {
-	var zeroValue bool
-	n := &Notification{Unread: &zeroValue}
-	n.GetUnread()
-	n = &Notification{}
-	n.GetUnread()
-	n = nil
-	n.GetUnread()
+func TestProjectColumnEvent_GetAfterID(tt *testing.T) {
+	var zeroValue int64
+	p := &ProjectColumnEvent{AfterID: &zeroValue}
+	p.GetAfterID()
+	p = &ProjectColumnEvent{}
+	p.GetAfterID()
+	p = nil
+	p.GetAfterID()
 }
 
-func TestNotification_GetUpdatedAt(tt *testing.T) {
-	var zeroValue time.Time
-	n := &Notification{UpdatedAt: &zeroValue}
-	n.GetUpdatedAt()
-	n = &Notification{}
-	n.GetUpdatedAt()
-	n = nil
-	n.GetUpdatedAt()
+func TestProjectColumnEvent_GetChanges(tt *testing.T) {
+	p := &ProjectColumnEvent{}
+	p.GetChanges()
+	p = nil
+	p.GetChanges()
 }
 
-func TestNotification_GetURL(tt *testing.T) {
-	var zeroValue string
-	n := &Notification{URL: &zeroValue}
-	n.GetURL()
-	n = &Notification{}
-	n.GetURL()
-	n = nil
-	n.GetURL()
+func TestProjectColumnEvent_GetInstallation(tt *testing.T) {
+	p := &ProjectColumnEvent{}
+	p.GetInstallation()
+	p = nil
+	p.GetInstallation()
 }
 
-func TestNotificationSubject_GetLatestCommentURL(tt *testing.T) {
-	var zeroValue string
-	n := &NotificationSubject{LatestCommentURL: &zeroValue}
-	n.GetLatestCommentURL()
-	n = &NotificationSubject{}
-	n.GetLatestCommentURL()
-	n = nil
-	n.GetLatestCommentURL()
+func TestProjectColumnEvent_GetOrg(tt *testing.T) {
+	p := &ProjectColumnEvent{}
+	p.GetOrg()
+	p = nil
+	p.GetOrg()
 }
 
-func TestNotificationSubject_GetTitle(tt *testing.T) {
-	var zeroValue string
-	n := &NotificationSubject{Title: &zeroValue}
-	n.GetTitle()
-	n = &NotificationSubject{}
-	n.GetTitle()
-	n = nil
-	n.GetTitle()
+func TestProjectColumnEvent_GetProjectColumn(tt *testing.T) {
+	p := &ProjectColumnEvent{}
+	p.GetProjectColumn()
+	p = nil
+	p.GetProjectColumn()
 }
 
-func TestNotificationSubject_GetType(tt *testing.T) {
-	var zeroValue string
-	n := &NotificationSubject{Type: &zeroValue}
-	n.GetType()
-	n = &NotificationSubject{}
-	n.GetType()
-	n = nil
-	n.GetType()
+func TestProjectColumnEvent_GetRepo(tt *testing.T) {
+	p := &ProjectColumnEvent{}
+	p.GetRepo()
+	p = nil
+	p.GetRepo()
 }
 
-func TestNotificationSubject_GetURL(tt *testing.T) {
-	var zeroValue string
-	n := &NotificationSubject{URL: &zeroValue}
-	n.GetURL()
-	n = &NotificationSubject{}
-	n.GetURL()
-	n = nil
-	n.GetURL()
+func TestProjectColumnEvent_GetSender(tt *testing.T) {
+	p := &ProjectColumnEvent{}
+	p.GetSender()
+	p = nil
+	p.GetSender()
 }
 
-func TestOAuthAPP_GetClientID(tt *testing.T) {
+func TestProjectEvent_GetAction(tt *testing.T) {
 	var zeroValue string
-	o := &OAuthAPP{ClientID: &zeroValue}
-	o.GetClientID()
-	o = &OAuthAPP{}
-	o.GetClientID()
-	o = nil
-	o.GetClientID()
+	p := &ProjectEvent{Action: &zeroValue}
+	p.GetAction()
+	p = &ProjectEvent{}
+	p.GetAction()
+	p = nil
+	p.GetAction()
 }
 
-func TestOAuthAPP_GetName(tt *testing.T) {
-	var zeroValue string
-	o := &OAuthAPP{Name: &zeroValue}
-	o.GetName()
-	o = &OAuthAPP{}
-	o.GetName()
-	o = nil
-	o.GetName()
+func TestProjectEvent_GetChanges(tt *testing.T) {
+	p := &ProjectEvent{}
+	p.GetChanges()
+	p = nil
+	p.GetChanges()
 }
 
-func TestOAuthAPP_GetURL(tt *testing.T) {
-	var zeroValue string
-	o := &OAuthAPP{URL: &zeroValue}
-	o.GetURL()
-	o = &OAuthAPP{}
-	o.GetURL()
-	o = nil
-	o.GetURL()
+func TestProjectEvent_GetInstallation(tt *testing.T) {
+	p := &ProjectEvent{}
+	p.GetInstallation()
+	p = nil
+	p.GetInstallation()
 }
 
-func TestOrganization_GetAvatarURL(tt *testing.T) {
-	var zeroValue string
-	o := &Organization{AvatarURL: &zeroValue}
-	o.GetAvatarURL()
-	o = &Organization{}
-	o.GetAvatarURL()
-	o = nil
-	o.GetAvatarURL()
+func TestProjectEvent_GetOrg(tt *testing.T) {
+	p := &ProjectEvent{}
+	p.GetOrg()
+	p = nil
+	p.GetOrg()
 }
 
-func TestOrganization_GetBillingEmail(tt *testing.T) {
-	var zeroValue string
-	o := &Organization{BillingEmail: &zeroValue}
-	o.GetBillingEmail()
-	o = &Organization{}
-	o.GetBillingEmail()
-	o = nil
-	o.GetBillingEmail()
+func TestProjectEvent_GetProject(tt *testing.T) {
+	p := &ProjectEvent{}
+	p.GetProject()
+	p = nil
+	p.GetProject()
 }
 
-func TestOrganization_GetBlog(tt *testing.T) {
-	var zeroValue string
-	o := &Organization{Blog: &zeroValue}
-	o.GetBlog()
-	o = &Organization{}
-	o.GetBlog()
-	o = nil
-	o.GetBlog()
+func TestProjectEvent_GetRepo(tt *testing.T) {
+	p := &ProjectEvent{}
+	p.GetRepo()
+	p = nil
+	p.GetRepo()
 }
 
-func TestOrganization_GetCollaborators(tt *testing.T) {
-	var zeroValue int
-	o := &Organization{Collaborators: &zeroValue}
-	o.GetCollaborators()
-	o = &Organization{}
-	o.GetCollaborators()
-	o = nil
-	o.GetCollaborators()
+func TestProjectEvent_GetSender(tt *testing.T) {
+	p := &ProjectEvent{}
+	p.GetSender()
+	p = nil
+	p.GetSender()
 }
 
-func TestOrganization_GetCompany(tt *testing.T) {
+func TestProjectOptions_GetBody(tt *testing.T) {
 	var zeroValue string
-	o := &Organization{Company: &zeroValue}
-	o.GetCompany()
-	o = &Organization{}
-	o.GetCompany()
-	o = nil
-	o.GetCompany()
+	p := &ProjectOptions{Body: &zeroValue}
+	p.GetBody()
+	p = &ProjectOptions{}
+	p.GetBody()
+	p = nil
+	p.GetBody()
 }
 
-func TestOrganization_GetCreatedAt(tt *testing.T) {
-	var zeroValue time.Time
-	o := &Organization{CreatedAt: &zeroValue}
-	o.GetCreatedAt()
-	o = &Organization{}
-	o.GetCreatedAt()
-	o = nil
-	o.GetCreatedAt()
+func TestProjectOptions_GetName(tt *testing.T) {
+	var zeroValue string
+	p := &ProjectOptions{Name: &zeroValue}
+	p.GetName()
+	p = &ProjectOptions{}
+	p.GetName()
+	p = nil
+	p.GetName()
 }
 
-func TestOrganization_GetDefaultRepoPermission(tt *testing.T) {
+func TestProjectOptions_GetOrganizationPermission(tt *testing.T) {
 	var zeroValue string
-	o := &Organization{DefaultRepoPermission: &zeroValue}
-	o.GetDefaultRepoPermission()
-	o = &Organization{}
-	o.GetDefaultRepoPermission()
-	o = nil
-	o.GetDefaultRepoPermission()
+	p := &ProjectOptions{OrganizationPermission: &zeroValue}
+	p.GetOrganizationPermission()
+	p = &ProjectOptions{}
+	p.GetOrganizationPermission()
+	p = nil
+	p.GetOrganizationPermission()
 }
 
-func TestOrganization_GetDefaultRepoSettings(tt *testing.T) {
-	var zeroValue string
-	o := &Organization{DefaultRepoSettings: &zeroValue}
-	o.GetDefaultRepoSettings()
-	o = &Organization{}
-	o.GetDefaultRepoSettings()
-	o = nil
-	o.GetDefaultRepoSettings()
+func TestProjectOptions_GetPublic(tt *testing.T) {
+	var zeroValue bool
+	p := &ProjectOptions{Public: &zeroValue}
+	p.GetPublic()
+	p = &ProjectOptions{}
+	p.GetPublic()
+	p = nil
+	p.GetPublic()
 }
 
-func TestOrganization_GetDescription(tt *testing.T) {
+func TestProjectOptions_GetState(tt *testing.T) {
 	var zeroValue string
-	o := &Organization{Description: &zeroValue}
-	o.GetDescription()
-	o = &Organization{}
-	o.GetDescription()
-	o = nil
-	o.GetDescription()
+	p := &ProjectOptions{State: &zeroValue}
+	p.GetState()
+	p = &ProjectOptions{}
+	p.GetState()
+	p = nil
+	p.GetState()
 }
 
-func TestOrganization_GetDiskUsage(tt *testing.T) {
-	var zeroValue int
-	o := &Organization{DiskUsage: &zeroValue}
-	o.GetDiskUsage()
-	o = &Organization{}
-	o.GetDiskUsage()
-	o = nil
-	o.GetDiskUsage()
+func TestProjectPermissionLevel_GetPermission(tt *testing.T) {
+	var zeroValue string
+	p := &ProjectPermissionLevel{Permission: &zeroValue}
+	p.GetPermission()
+	p = &ProjectPermissionLevel{}
+	p.GetPermission()
+	p = nil
+	p.GetPermission()
 }
 
-func TestOrganization_GetEmail(tt *testing.T) {
-	var zeroValue string
-	o := &Organization{Email: &zeroValue}
-	o.GetEmail()
-	o = &Organization{}
-	o.GetEmail()
-	o = nil
-	o.GetEmail()
+func TestProjectPermissionLevel_GetUser(tt *testing.T) {
+	p := &ProjectPermissionLevel{}
+	p.GetUser()
+	p = nil
+	p.GetUser()
 }
 
-func TestOrganization_GetEventsURL(tt *testing.T) {
+func TestProjectV2StatusUpdate_GetBody(tt *testing.T) {
 	var zeroValue string
-	o := &Organization{EventsURL: &zeroValue}
-	o.GetEventsURL()
-	o = &Organization{}
-	o.GetEventsURL()
-	o = nil
-	o.GetEventsURL()
+	p := &ProjectV2StatusUpdate{Body: &zeroValue}
+	p.GetBody()
+	p = &ProjectV2StatusUpdate{}
+	p.GetBody()
+	p = nil
+	p.GetBody()
 }
 
-func TestOrganization_GetFollowers(tt *testing.T) {
-	var zeroValue int
-	o := &Organization{Followers: &zeroValue}
-	o.GetFollowers()
-	o = &Organization{}
-	o.GetFollowers()
-	o = nil
-	o.GetFollowers()
+func TestProjectV2StatusUpdate_GetCreatedAt(tt *testing.T) {
+	var zeroValue Timestamp
+	p := &ProjectV2StatusUpdate{CreatedAt: &zeroValue}
+	p.GetCreatedAt()
+	p = &ProjectV2StatusUpdate{}
+	p.GetCreatedAt()
+	p = nil
+	p.GetCreatedAt()
 }
 
-func TestOrganization_GetFollowing(tt *testing.T) {
-	var zeroValue int
-	o := &Organization{Following: &zeroValue}
-	o.GetFollowing()
-	o = &Organization{}
-	o.GetFollowing()
-	o = nil
-	o.GetFollowing()
+func TestProjectV2StatusUpdate_GetCreator(tt *testing.T) {
+	p := &ProjectV2StatusUpdate{}
+	p.GetCreator()
+	p = nil
+	p.GetCreator()
 }
 
-func TestOrganization_GetHasOrganizationProjects(tt *testing.T) {
-	var zeroValue bool
-	o := &Organization{HasOrganizationProjects: &zeroValue}
-	o.GetHasOrganizationProjects()
-	o = &Organization{}
-	o.GetHasOrganizationProjects()
-	o = nil
-	o.GetHasOrganizationProjects()
+func TestProjectV2StatusUpdate_GetID(tt *testing.T) {
+	var zeroValue int64
+	p := &ProjectV2StatusUpdate{ID: &zeroValue}
+	p.GetID()
+	p = &ProjectV2StatusUpdate{}
+	p.GetID()
+	p = nil
+	p.GetID()
 }
 
-func TestOrganization_GetHasRepositoryProjects(tt *testing.T) {
-	var zeroValue bool
-	o := &Organization{HasRepositoryProjects: &zeroValue}
-	o.GetHasRepositoryProjects()
-	o = &Organization{}
-	o.GetHasRepositoryProjects()
-	o = nil
-	o.GetHasRepositoryProjects()
+func TestProjectV2StatusUpdate_GetNodeID(tt *testing.T) {
+	var zeroValue string
+	p := &ProjectV2StatusUpdate{NodeID: &zeroValue}
+	p.GetNodeID()
+	p = &ProjectV2StatusUpdate{}
+	p.GetNodeID()
+	p = nil
+	p.GetNodeID()
 }
 
-func TestOrganization_GetHooksURL(tt *testing.T) {
+func TestProjectV2StatusUpdate_GetProjectNodeID(tt *testing.T) {
 	var zeroValue string
-	o := &Organization{HooksURL: &zeroValue}
-	o.GetHooksURL()
-	o = &Organization{}
-	o.GetHooksURL()
-	o = nil
-	o.GetHooksURL()
+	p := &ProjectV2StatusUpdate{ProjectNodeID: &zeroValue}
+	p.GetProjectNodeID()
+	p = &ProjectV2StatusUpdate{}
+	p.GetProjectNodeID()
+	p = nil
+	p.GetProjectNodeID()
 }
 
-func TestOrganization_GetHTMLURL(tt *testing.T) {
+func TestProjectV2StatusUpdate_GetStartDate(tt *testing.T) {
 	var zeroValue string
-	o := &Organization{HTMLURL: &zeroValue}
-	o.GetHTMLURL()
-	o = &Organization{}
-	o.GetHTMLURL()
-	o = nil
-	o.GetHTMLURL()
+	p := &ProjectV2StatusUpdate{StartDate: &zeroValue}
+	p.GetStartDate()
+	p = &ProjectV2StatusUpdate{}
+	p.GetStartDate()
+	p = nil
+	p.GetStartDate()
 }
 
-func TestOrganization_GetID(tt *testing.T) {
-	var zeroValue int64
-	o := &Organization{ID: &zeroValue}
-	o.GetID()
-	o = &Organization{}
-	o.GetID()
-	o = nil
-	o.GetID()
+func TestProjectV2StatusUpdate_GetStatus(tt *testing.T) {
+	var zeroValue string
+	p := &ProjectV2StatusUpdate{Status: &zeroValue}
+	p.GetStatus()
+	p = &ProjectV2StatusUpdate{}
+	p.GetStatus()
+	p = nil
+	p.GetStatus()
 }
 
-func TestOrganization_GetIssuesURL(tt *testing.T) {
+func TestProjectV2StatusUpdate_GetTargetDate(tt *testing.T) {
 	var zeroValue string
-	o := &Organization{IssuesURL: &zeroValue}
-	o.GetIssuesURL()
-	o = &Organization{}
-	o.GetIssuesURL()
-	o = nil
-	o.GetIssuesURL()
+	p := &ProjectV2StatusUpdate{TargetDate: &zeroValue}
+	p.GetTargetDate()
+	p = &ProjectV2StatusUpdate{}
+	p.GetTargetDate()
+	p = nil
+	p.GetTargetDate()
 }
 
-func TestOrganization_GetIsVerified(tt *testing.T) {
-	var zeroValue bool
-	o := &Organization{IsVerified: &zeroValue}
-	o.GetIsVerified()
-	o = &Organization{}
-	o.GetIsVerified()
-	o = nil
-	o.GetIsVerified()
+func TestProjectV2StatusUpdate_GetUpdatedAt(tt *testing.T) {
+	var zeroValue Timestamp
+	p := &ProjectV2StatusUpdate{UpdatedAt: &zeroValue}
+	p.GetUpdatedAt()
+	p = &ProjectV2StatusUpdate{}
+	p.GetUpdatedAt()
+	p = nil
+	p.GetUpdatedAt()
 }
 
-func TestOrganization_GetLocation(tt *testing.T) {
-	var zeroValue string
-	o := &Organization{Location: &zeroValue}
-	o.GetLocation()
-	o = &Organization{}
-	o.GetLocation()
-	o = nil
-	o.GetLocation()
+func TestProjectV2StatusUpdateChange_GetBody(tt *testing.T) {
+	p := &ProjectV2StatusUpdateChange{}
+	p.GetBody()
+	p = nil
+	p.GetBody()
 }
 
-func TestOrganization_GetLogin(tt *testing.T) {
-	var zeroValue string
-	o := &Organization{Login: &zeroValue}
-	o.GetLogin()
-	o = &Organization{}
-	o.GetLogin()
-	o = nil
-	o.GetLogin()
+func TestProjectV2StatusUpdateChange_GetStartDate(tt *testing.T) {
+	p := &ProjectV2StatusUpdateChange{}
+	p.GetStartDate()
+	p = nil
+	p.GetStartDate()
 }
 
-func TestOrganization_GetMembersAllowedRepositoryCreationType(tt *testing.T) {
+func TestProjectV2StatusUpdateChange_GetStatus(tt *testing.T) {
+	p := &ProjectV2StatusUpdateChange{}
+	p.GetStatus()
+	p = nil
+	p.GetStatus()
+}
+
+func TestProjectV2StatusUpdateChange_GetTargetDate(tt *testing.T) {
+	p := &ProjectV2StatusUpdateChange{}
+	p.GetTargetDate()
+	p = nil
+	p.GetTargetDate()
+}
+
+func TestProjectV2StatusUpdateEvent_GetAction(tt *testing.T) {
 	var zeroValue string
-	o := &Organization{MembersAllowedRepositoryCreationType: &zeroValue}
-	o.GetMembersAllowedRepositoryCreationType()
-	o = &Organization{}
-	o.GetMembersAllowedRepositoryCreationType()
-	o = nil
-	o.GetMembersAllowedRepositoryCreationType()
+	p := &ProjectV2StatusUpdateEvent{Action: &zeroValue}
+	p.GetAction()
+	p = &ProjectV2StatusUpdateEvent{}
+	p.GetAction()
+	p = nil
+	p.GetAction()
 }
 
-func TestOrganization_GetMembersCanCreateInternalRepos(tt *testing.T) {
-	var zeroValue bool
-	o := &Organization{MembersCanCreateInternalRepos: &zeroValue}
-	o.GetMembersCanCreateInternalRepos()
-	o = &Organization{}
-	o.GetMembersCanCreateInternalRepos()
-	o = nil
-	o.GetMembersCanCreateInternalRepos()
+func TestProjectV2StatusUpdateEvent_GetChanges(tt *testing.T) {
+	p := &ProjectV2StatusUpdateEvent{}
+	p.GetChanges()
+	p = nil
+	p.GetChanges()
 }
 
-func TestOrganization_GetMembersCanCreatePrivateRepos(tt *testing.T) {
-	var zeroValue bool
-	o := &Organization{MembersCanCreatePrivateRepos: &zeroValue}
-	o.GetMembersCanCreatePrivateRepos()
-	o = &Organization{}
-	o.GetMembersCanCreatePrivateRepos()
-	o = nil
-	o.GetMembersCanCreatePrivateRepos()
+func TestProjectV2StatusUpdateEvent_GetInstallation(tt *testing.T) {
+	p := &ProjectV2StatusUpdateEvent{}
+	p.GetInstallation()
+	p = nil
+	p.GetInstallation()
 }
 
-func TestOrganization_GetMembersCanCreatePublicRepos(tt *testing.T) {
-	var zeroValue bool
-	o := &Organization{MembersCanCreatePublicRepos: &zeroValue}
-	o.GetMembersCanCreatePublicRepos()
-	o = &Organization{}
-	o.GetMembersCanCreatePublicRepos()
-	o = nil
-	o.GetMembersCanCreatePublicRepos()
+func TestProjectV2StatusUpdateEvent_GetOrg(tt *testing.T) {
+	p := &ProjectV2StatusUpdateEvent{}
+	p.GetOrg()
+	p = nil
+	p.GetOrg()
 }
 
-func TestOrganization_GetMembersCanCreateRepos(tt *testing.T) {
-	var zeroValue bool
-	o := &Organization{MembersCanCreateRepos: &zeroValue}
-	o.GetMembersCanCreateRepos()
-	o = &Organization{}
-	o.GetMembersCanCreateRepos()
-	o = nil
-	o.GetMembersCanCreateRepos()
+func TestProjectV2StatusUpdateEvent_GetProjectV2StatusUpdate(tt *testing.T) {
+	p := &ProjectV2StatusUpdateEvent{}
+	p.GetProjectV2StatusUpdate()
+	p = nil
+	p.GetProjectV2StatusUpdate()
 }
 
-func TestOrganization_GetMembersURL(tt *testing.T) {
-	var zeroValue string
-	o := &Organization{MembersURL: &zeroValue}
-	o.GetMembersURL()
-	o = &Organization{}
-	o.GetMembersURL()
-	o = nil
-	o.GetMembersURL()
+func TestProjectV2StatusUpdateEvent_GetSender(tt *testing.T) {
+	p := &ProjectV2StatusUpdateEvent{}
+	p.GetSender()
+	p = nil
+	p.GetSender()
 }
 
-func TestOrganization_GetName(tt *testing.T) {
+func TestProjectV2StatusUpdateFieldChange_GetFrom(tt *testing.T) {
 	var zeroValue string
-	o := &Organization{Name: &zeroValue}
-	o.GetName()
-	o = &Organization{}
-	o.GetName()
-	o = nil
-	o.GetName()
+	p := &ProjectV2StatusUpdateFieldChange{From: &zeroValue}
+	p.GetFrom()
+	p = &ProjectV2StatusUpdateFieldChange{}
+	p.GetFrom()
+	p = nil
+	p.GetFrom()
 }
 
-func TestOrganization_GetNodeID(tt *testing.T) {
+func TestProjectV2StatusUpdateFieldChange_GetTo(tt *testing.T) {
 	var zeroValue string
-	o := &Organization{NodeID: &zeroValue}
-	o.GetNodeID()
-	o = &Organization{}
-	o.GetNodeID()
-	o = nil
-	o.GetNodeID()
+	p := &ProjectV2StatusUpdateFieldChange{To: &zeroValue}
+	p.GetTo()
+	p = &ProjectV2StatusUpdateFieldChange{}
+	p.GetTo()
+	p = nil
+	p.GetTo()
 }
 
-func TestOrganization_GetOwnedPrivateRepos(tt *testing.T) {
-	var zeroValue int
-	o := &Organization{OwnedPrivateRepos: &zeroValue}
-	o.GetOwnedPrivateRepos()
-	o = &Organization{}
-	o.GetOwnedPrivateRepos()
-	o = nil
-	o.GetOwnedPrivateRepos()
+func TestProtection_GetAllowDeletions(tt *testing.T) {
+	p := &Protection{}
+	p.GetAllowDeletions()
+	p = nil
+	p.GetAllowDeletions()
 }
 
-func TestOrganization_GetPlan(tt *testing.T) {
-	o := &Organization{}
-	o.GetPlan()
-	o = nil
-	o.GetPlan()
+func TestProtection_GetAllowForcePushes(tt *testing.T) {
+	p := &Protection{}
+	p.GetAllowForcePushes()
+	p = nil
+	p.GetAllowForcePushes()
 }
 
-func TestOrganization_GetPrivateGists(tt *testing.T) {
-	var zeroValue int
-	o := &Organization{PrivateGists: &zeroValue}
-	o.GetPrivateGists()
-	o = &Organization{}
-	o.GetPrivateGists()
-	o = nil
-	o.GetPrivateGists()
+func TestProtection_GetEnforceAdmins(tt *testing.T) {
+	p := &Protection{}
+	p.GetEnforceAdmins()
+	p = nil
+	p.GetEnforceAdmins()
 }
 
-func TestOrganization_GetPublicGists(tt *testing.T) {
-	var zeroValue int
-	o := &Organization{PublicGists: &zeroValue}
-	o.GetPublicGists()
-	o = &Organization{}
-	o.GetPublicGists()
-	o = nil
-	o.GetPublicGists()
+func TestProtection_GetRequiredPullRequestReviews(tt *testing.T) {
+	p := &Protection{}
+	p.GetRequiredPullRequestReviews()
+	p = nil
+	p.GetRequiredPullRequestReviews()
 }
 
-func TestOrganization_GetPublicMembersURL(tt *testing.T) {
-	var zeroValue string
-	o := &Organization{PublicMembersURL: &zeroValue}
-	o.GetPublicMembersURL()
-	o = &Organization{}
-	o.GetPublicMembersURL()
-	o = nil
-	o.GetPublicMembersURL()
+func TestProtection_GetRequiredStatusChecks(tt *testing.T) {
+	p := &Protection{}
+	p.GetRequiredStatusChecks()
+	p = nil
+	p.GetRequiredStatusChecks()
 }
 
-func TestOrganization_GetPublicRepos(tt *testing.T) {
-	var zeroValue int
-	o := &Organization{PublicRepos: &zeroValue}
-	o.GetPublicRepos()
-	o = &Organization{}
-	o.GetPublicRepos()
-	o = nil
-	o.GetPublicRepos()
+func TestProtection_GetRequireLinearHistory(tt *testing.T) {
+	p := &Protection{}
+	p.GetRequireLinearHistory()
+	p = nil
+	p.GetRequireLinearHistory()
 }
 
-func TestOrganization_GetReposURL(tt *testing.T) {
-	var zeroValue string
-	o := &Organization{ReposURL: &zeroValue}
-	o.GetReposURL()
-	o = &Organization{}
-	o.GetReposURL()
-	o = nil
-	o.GetReposURL()
+func TestProtection_GetRestrictions(tt *testing.T) {
+	p := &Protection{}
+	p.GetRestrictions()
+	p = nil
+	p.GetRestrictions()
 }
 
-func TestOrganization_GetTotalPrivateRepos(tt *testing.T) {
-	var zeroValue int
-	o := &Organization{TotalPrivateRepos: &zeroValue}
-	o.GetTotalPrivateRepos()
-	o = &Organization{}
-	o.GetTotalPrivateRepos()
-	o = nil
-	o.GetTotalPrivateRepos()
+func TestProtectionRequest_GetAllowDeletions(tt *testing.T) {
+	var zeroValue bool
+	p := &ProtectionRequest{AllowDeletions: &zeroValue}
+	p.GetAllowDeletions()
+	p = &ProtectionRequest{}
+	p.GetAllowDeletions()
+	p = nil
+	p.GetAllowDeletions()
 }
 
-func TestOrganization_GetTwitterUsername(tt *testing.T) {
-	var zeroValue string
-	o := &Organization{TwitterUsername: &zeroValue}
-	o.GetTwitterUsername()
-	o = &Organization{}
-	o.GetTwitterUsername()
-	o = nil
-	o.GetTwitterUsername()
+func TestProtectionRequest_GetAllowForcePushes(tt *testing.T) {
+	var zeroValue bool
+	p := &ProtectionRequest{AllowForcePushes: &zeroValue}
+	p.GetAllowForcePushes()
+	p = &ProtectionRequest{}
+	p.GetAllowForcePushes()
+	p = nil
+	p.GetAllowForcePushes()
 }
 
-func TestOrganization_GetTwoFactorRequirementEnabled(tt *testing.T) {
-	var zeroValue bool
-	o := &Organization{TwoFactorRequirementEnabled: &zeroValue}
-	o.GetTwoFactorRequirementEnabled()
-	o = &Organization{}
-	o.GetTwoFactorRequirementEnabled()
-	o = nil
-	o.GetTwoFactorRequirementEnabled()
+func TestProtectionRequest_GetRequiredPullRequestReviews(tt *testing.T) {
+	p := &ProtectionRequest{}
+	p.GetRequiredPullRequestReviews()
+	p = nil
+	p.GetRequiredPullRequestReviews()
 }
 
-func TestOrganization_GetType(tt *testing.T) {
-	var zeroValue string
-	o := &Organization{Type: &zeroValue}
-	o.GetType()
-	o = &Organization{}
-	o.GetType()
-	o = nil
-	o.GetType()
+func TestProtectionRequest_GetRequiredStatusChecks(tt *testing.T) {
+	p := &ProtectionRequest{}
+	p.GetRequiredStatusChecks()
+	p = nil
+	p.GetRequiredStatusChecks()
 }
 
-func TestOrganization_GetUpdatedAt(tt *testing.T) {
-	var zeroValue time.Time
-	o := &Organization{UpdatedAt: &zeroValue}
-	o.GetUpdatedAt()
-	o = &Organization{}
-	o.GetUpdatedAt()
-	o = nil
-	o.GetUpdatedAt()
+func TestProtectionRequest_GetRequireLinearHistory(tt *testing.T) {
+	var zeroValue bool
+	p := &ProtectionRequest{RequireLinearHistory: &zeroValue}
+	p.GetRequireLinearHistory()
+	p = &ProtectionRequest{}
+	p.GetRequireLinearHistory()
+	p = nil
+	p.GetRequireLinearHistory()
 }
 
-func TestOrganization_GetURL(tt *testing.T) {
-	var zeroValue string
-	o := &Organization{URL: &zeroValue}
-	o.GetURL()
-	o = &Organization{}
-	o.GetURL()
-	o = nil
-	o.GetURL()
+func TestProtectionRequest_GetRestrictions(tt *testing.T) {
+	p := &ProtectionRequest{}
+	p.GetRestrictions()
+	p = nil
+	p.GetRestrictions()
 }
 
-func TestOrganizationEvent_GetAction(tt *testing.T) {
-	var zeroValue string
-	o := &OrganizationEvent{Action: &zeroValue}
-	o.GetAction()
-	o = &OrganizationEvent{}
-	o.GetAction()
-	o = nil
-	o.GetAction()
+func TestPublicEvent_GetInstallation(tt *testing.T) {
+	p := &PublicEvent{}
+	p.GetInstallation()
+	p = nil
+	p.GetInstallation()
 }
 
-func TestOrganizationEvent_GetInstallation(tt *testing.T) {
-	o := &OrganizationEvent{}
-	o.GetInstallation()
-	o = nil
-	o.GetInstallation()
+func TestPublicEvent_GetRepo(tt *testing.T) {
+	p := &PublicEvent{}
+	p.GetRepo()
+	p = nil
+	p.GetRepo()
 }
 
-func TestOrganizationEvent_GetInvitation(tt *testing.T) {
-	o := &OrganizationEvent{}
-	o.GetInvitation()
-	o = nil
-	o.GetInvitation()
+func TestPublicEvent_GetSender(tt *testing.T) {
+	p := &PublicEvent{}
+	p.GetSender()
+	p = nil
+	p.GetSender()
 }
 
-func TestOrganizationEvent_GetMembership(tt *testing.T) {
-	o := &OrganizationEvent{}
-	o.GetMembership()
-	o = nil
-	o.GetMembership()
+func TestPublicKey_GetKey(tt *testing.T) {
+	var zeroValue string
+	p := &PublicKey{Key: &zeroValue}
+	p.GetKey()
+	p = &PublicKey{}
+	p.GetKey()
+	p = nil
+	p.GetKey()
 }
 
-func TestOrganizationEvent_GetOrganization(tt *testing.T) {
-	o := &OrganizationEvent{}
-	o.GetOrganization()
-	o = nil
-	o.GetOrganization()
+func TestPublicKey_GetKeyID(tt *testing.T) {
+	var zeroValue string
+	p := &PublicKey{KeyID: &zeroValue}
+	p.GetKeyID()
+	p = &PublicKey{}
+	p.GetKeyID()
+	p = nil
+	p.GetKeyID()
 }
 
-func TestOrganizationEvent_GetSender(tt *testing.T) {
-	o := &OrganizationEvent{}
-	o.GetSender()
-	o = nil
-	o.GetSender()
+func TestPullRequest_GetActiveLockReason(tt *testing.T) {
+	var zeroValue string
+	p := &PullRequest{ActiveLockReason: &zeroValue}
+	p.GetActiveLockReason()
+	p = &PullRequest{}
+	p.GetActiveLockReason()
+	p = nil
+	p.GetActiveLockReason()
 }
 
-func TestOrganizationInstallations_GetTotalCount(tt *testing.T) {
+func TestPullRequest_GetAdditions(tt *testing.T) {
 	var zeroValue int
-	o := &OrganizationInstallations{TotalCount: &zeroValue}
-	o.GetTotalCount()
-	o = &OrganizationInstallations{}
-	o.GetTotalCount()
-	o = nil
-	o.GetTotalCount()
+	p := &PullRequest{Additions: &zeroValue}
+	p.GetAdditions()
+	p = &PullRequest{}
+	p.GetAdditions()
+	p = nil
+	p.GetAdditions()
 }
 
-func TestOrgBlockEvent_GetAction(tt *testing.T) {
-	var zeroValue string
-	o := &OrgBlockEvent{Action: &zeroValue}
-	o.GetAction()
-	o = &OrgBlockEvent{}
-	o.GetAction()
-	o = nil
-	o.GetAction()
+func TestPullRequest_GetAssignee(tt *testing.T) {
+	p := &PullRequest{}
+	p.GetAssignee()
+	p = nil
+	p.GetAssignee()
 }
 
-func TestOrgBlockEvent_GetBlockedUser(tt *testing.T) {
-	o := &OrgBlockEvent{}
-	o.GetBlockedUser()
-	o = nil
-	o.GetBlockedUser()
+func TestPullRequest_GetAuthorAssociation(tt *testing.T) {
+	var zeroValue string
+	p := &PullRequest{AuthorAssociation: &zeroValue}
+	p.GetAuthorAssociation()
+	p = &PullRequest{}
+	p.GetAuthorAssociation()
+	p = nil
+	p.GetAuthorAssociation()
 }
 
-func TestOrgBlockEvent_GetInstallation(tt *testing.T) {
-	o := &OrgBlockEvent{}
-	o.GetInstallation()
-	o = nil
-	o.GetInstallation()
+func TestPullRequest_GetAutoMerge(tt *testing.T) {
+	p := &PullRequest{}
+	p.GetAutoMerge()
+	p = nil
+	p.GetAutoMerge()
 }
 
-func TestOrgBlockEvent_GetOrganization(tt *testing.T) {
-	o := &OrgBlockEvent{}
-	o.GetOrganization()
-	o = nil
-	o.GetOrganization()
+func TestPullRequest_GetBase(tt *testing.T) {
+	p := &PullRequest{}
+	p.GetBase()
+	p = nil
+	p.GetBase()
 }
 
-func TestOrgBlockEvent_GetSender(tt *testing.T) {
-	o := &OrgBlockEvent{}
-	o.GetSender()
-	o = nil
-	o.GetSender()
+func TestPullRequest_GetBody(tt *testing.T) {
+	var zeroValue string
+	p := &PullRequest{Body: &zeroValue}
+	p.GetBody()
+	p = &PullRequest{}
+	p.GetBody()
+	p = nil
+	p.GetBody()
 }
 
-func TestOrgStats_GetDisabledOrgs(tt *testing.T) {
+func TestPullRequest_GetChangedFiles(tt *testing.T) {
 	var zeroValue int
-	o := &OrgStats{DisabledOrgs: &zeroValue}
-	o.GetDisabledOrgs()
-	o = &OrgStats{}
-	o.GetDisabledOrgs()
-	o = nil
-	o.GetDisabledOrgs()
+	p := &PullRequest{ChangedFiles: &zeroValue}
+	p.GetChangedFiles()
+	p = &PullRequest{}
+	p.GetChangedFiles()
+	p = nil
+	p.GetChangedFiles()
 }
 
-func TestOrgStats_GetTotalOrgs(tt *testing.T) {
-	var zeroValue int
-	o := &OrgStats{TotalOrgs: &zeroValue}
-	o.GetTotalOrgs()
-	o = &OrgStats{}
-	o.GetTotalOrgs()
-	o = nil
-	o.GetTotalOrgs()
+func TestPullRequest_GetClosedAt(tt *testing.T) {
+	var zeroValue time.Time
+	p := &PullRequest{ClosedAt: &zeroValue}
+	p.GetClosedAt()
+	p = &PullRequest{}
+	p.GetClosedAt()
+	p = nil
+	p.GetClosedAt()
 }
 
-func TestOrgStats_GetTotalTeamMembers(tt *testing.T) {
+func TestPullRequest_GetComments(tt *testing.T) {
 	var zeroValue int
-	o := &OrgStats{TotalTeamMembers: &zeroValue}
-	o.GetTotalTeamMembers()
-	o = &OrgStats{}
-	o.GetTotalTeamMembers()
-	o = nil
-	o.GetTotalTeamMembers()
+	p := &PullRequest{Comments: &zeroValue}
+	p.GetComments()
+	p = &PullRequest{}
+	p.GetComments()
+	p = nil
+	p.GetComments()
 }
 
-func TestOrgStats_GetTotalTeams(tt *testing.T) {
+func TestPullRequest_GetCommentsURL(tt *testing.T) {
+	var zeroValue string
+	p := &PullRequest{CommentsURL: &zeroValue}
+	p.GetCommentsURL()
+	p = &PullRequest{}
+	p.GetCommentsURL()
+	p = nil
+	p.GetCommentsURL()
+}
+
+func TestPullRequest_GetCommits(tt *testing.T) {
 	var zeroValue int
-	o := &OrgStats{TotalTeams: &zeroValue}
-	o.GetTotalTeams()
-	o = &OrgStats{}
-	o.GetTotalTeams()
-	o = nil
-	o.GetTotalTeams()
+	p := &PullRequest{Commits: &zeroValue}
+	p.GetCommits()
+	p = &PullRequest{}
+	p.GetCommits()
+	p = nil
+	p.GetCommits()
 }
 
-func TestPackage_GetCreatedAt(tt *testing.T) {
-	var zeroValue Timestamp
-	p := &Package{CreatedAt: &zeroValue}
+func TestPullRequest_GetCommitsURL(tt *testing.T) {
+	var zeroValue string
+	p := &PullRequest{CommitsURL: &zeroValue}
+	p.GetCommitsURL()
+	p = &PullRequest{}
+	p.GetCommitsURL()
+	p = nil
+	p.GetCommitsURL()
+}
+
+func TestPullRequest_GetCreatedAt(tt *testing.T) {
+	var zeroValue time.Time
+	p := &PullRequest{CreatedAt: &zeroValue}
 	p.GetCreatedAt()
-	p = &Package{}
+	p = &PullRequest{}
 	p.GetCreatedAt()
 	p = nil
 	p.GetCreatedAt()
 }
 
-func TestPackage_GetHTMLURL(tt *testing.T) {
-	var zeroValue string
-	p := &Package{HTMLURL: &zeroValue}
-	p.GetHTMLURL()
-	p = &Package{}
-	p.GetHTMLURL()
+func TestPullRequest_GetDeletions(tt *testing.T) {
+	var zeroValue int
+	p := &PullRequest{Deletions: &zeroValue}
+	p.GetDeletions()
+	p = &PullRequest{}
+	p.GetDeletions()
 	p = nil
-	p.GetHTMLURL()
+	p.GetDeletions()
 }
 
-func TestPackage_GetID(tt *testing.T) {
-	var zeroValue int64
-	p := &Package{ID: &zeroValue}
-	p.GetID()
-	p = &Package{}
-	p.GetID()
+func TestPullRequest_GetDiffURL(tt *testing.T) {
+	var zeroValue string
+	p := &PullRequest{DiffURL: &zeroValue}
+	p.GetDiffURL()
+	p = &PullRequest{}
+	p.GetDiffURL()
 	p = nil
-	p.GetID()
+	p.GetDiffURL()
 }
 
-func TestPackage_GetName(tt *testing.T) {
-	var zeroValue string
-	p := &Package{Name: &zeroValue}
-	p.GetName()
-	p = &Package{}
-	p.GetName()
+func TestPullRequest_GetDraft(tt *testing.T) {
+	var zeroValue bool
+	p := &PullRequest{Draft: &zeroValue}
+	p.GetDraft()
+	p = &PullRequest{}
+	p.GetDraft()
 	p = nil
-	p.GetName()
+	p.GetDraft()
 }
 
-func TestPackage_GetOwner(tt *testing.T) {
-	p := &Package{}
-	p.GetOwner()
+func TestPullRequest_GetHead(tt *testing.T) {
+	p := &PullRequest{}
+	p.GetHead()
 	p = nil
-	p.GetOwner()
+	p.GetHead()
 }
 
-func TestPackage_GetPackageType(tt *testing.T) {
+func TestPullRequest_GetHTMLURL(tt *testing.T) {
 	var zeroValue string
-	p := &Package{PackageType: &zeroValue}
-	p.GetPackageType()
-	p = &Package{}
-	p.GetPackageType()
+	p := &PullRequest{HTMLURL: &zeroValue}
+	p.GetHTMLURL()
+	p = &PullRequest{}
+	p.GetHTMLURL()
+	p = nil
+	p.GetHTMLURL()
+}
+
+func TestPullRequest_GetID(tt *testing.T) {
+	var zeroValue int64
+	p := &PullRequest{ID: &zeroValue}
+	p.GetID()
+	p = &PullRequest{}
+	p.GetID()
 	p = nil
-	p.GetPackageType()
+	p.GetID()
 }
 
-func TestPackage_GetPackageVersion(tt *testing.T) {
-	p := &Package{}
-	p.GetPackageVersion()
+func TestPullRequest_GetIssueURL(tt *testing.T) {
+	var zeroValue string
+	p := &PullRequest{IssueURL: &zeroValue}
+	p.GetIssueURL()
+	p = &PullRequest{}
+	p.GetIssueURL()
 	p = nil
-	p.GetPackageVersion()
+	p.GetIssueURL()
 }
 
-func TestPackage_GetRegistry(tt *testing.T) {
-	p := &Package{}
-	p.GetRegistry()
+func TestPullRequest_GetLinks(tt *testing.T) {
+	p := &PullRequest{}
+	p.GetLinks()
 	p = nil
-	p.GetRegistry()
+	p.GetLinks()
 }
 
-func TestPackage_GetUpdatedAt(tt *testing.T) {
-	var zeroValue Timestamp
-	p := &Package{UpdatedAt: &zeroValue}
-	p.GetUpdatedAt()
-	p = &Package{}
-	p.GetUpdatedAt()
+func TestPullRequest_GetLocked(tt *testing.T) {
+	var zeroValue bool
+	p := &PullRequest{Locked: &zeroValue}
+	p.GetLocked()
+	p = &PullRequest{}
+	p.GetLocked()
 	p = nil
-	p.GetUpdatedAt()
+	p.GetLocked()
 }
 
-func TestPackageEvent_GetAction(tt *testing.T) {
-	var zeroValue string
-	p := &PackageEvent{Action: &zeroValue}
-	p.GetAction()
-	p = &PackageEvent{}
-	p.GetAction()
+func TestPullRequest_GetMaintainerCanModify(tt *testing.T) {
+	var zeroValue bool
+	p := &PullRequest{MaintainerCanModify: &zeroValue}
+	p.GetMaintainerCanModify()
+	p = &PullRequest{}
+	p.GetMaintainerCanModify()
 	p = nil
-	p.GetAction()
+	p.GetMaintainerCanModify()
 }
 
-func TestPackageEvent_GetOrg(tt *testing.T) {
-	p := &PackageEvent{}
-	p.GetOrg()
+func TestPullRequest_GetMergeable(tt *testing.T) {
+	var zeroValue bool
+	p := &PullRequest{Mergeable: &zeroValue}
+	p.GetMergeable()
+	p = &PullRequest{}
+	p.GetMergeable()
 	p = nil
-	p.GetOrg()
+	p.GetMergeable()
 }
 
-func TestPackageEvent_GetPackage(tt *testing.T) {
-	p := &PackageEvent{}
-	p.GetPackage()
+func TestPullRequest_GetMergeableState(tt *testing.T) {
+	var zeroValue string
+	p := &PullRequest{MergeableState: &zeroValue}
+	p.GetMergeableState()
+	p = &PullRequest{}
+	p.GetMergeableState()
 	p = nil
-	p.GetPackage()
+	p.GetMergeableState()
 }
 
-func TestPackageEvent_GetRepo(tt *testing.T) {
-	p := &PackageEvent{}
-	p.GetRepo()
+func TestPullRequest_GetMergeCommitSHA(tt *testing.T) {
+	var zeroValue string
+	p := &PullRequest{MergeCommitSHA: &zeroValue}
+	p.GetMergeCommitSHA()
+	p = &PullRequest{}
+	p.GetMergeCommitSHA()
 	p = nil
-	p.GetRepo()
+	p.GetMergeCommitSHA()
 }
 
-func TestPackageEvent_GetSender(tt *testing.T) {
-	p := &PackageEvent{}
-	p.GetSender()
+func TestPullRequest_GetMerged(tt *testing.T) {
+	var zeroValue bool
+	p := &PullRequest{Merged: &zeroValue}
+	p.GetMerged()
+	p = &PullRequest{}
+	p.GetMerged()
 	p = nil
-	p.GetSender()
+	p.GetMerged()
 }
 
-func TestPackageFile_GetAuthor(tt *testing.T) {
-	p := &PackageFile{}
-	p.GetAuthor()
+func TestPullRequest_GetMergedAt(tt *testing.T) {
+	var zeroValue time.Time
+	p := &PullRequest{MergedAt: &zeroValue}
+	p.GetMergedAt()
+	p = &PullRequest{}
+	p.GetMergedAt()
 	p = nil
-	p.GetAuthor()
+	p.GetMergedAt()
 }
 
-func TestPackageFile_GetContentType(tt *testing.T) {
-	var zeroValue string
-	p := &PackageFile{ContentType: &zeroValue}
-	p.GetContentType()
-	p = &PackageFile{}
-	p.GetContentType()
+func TestPullRequest_GetMergedBy(tt *testing.T) {
+	p := &PullRequest{}
+	p.GetMergedBy()
 	p = nil
-	p.GetContentType()
+	p.GetMergedBy()
 }
 
-func TestPackageFile_GetCreatedAt(tt *testing.T) {
-	var zeroValue Timestamp
-	p := &PackageFile{CreatedAt: &zeroValue}
-	p.GetCreatedAt()
-	p = &PackageFile{}
-	p.GetCreatedAt()
+func TestPullRequest_GetMilestone(tt *testing.T) {
+	p := &PullRequest{}
+	p.GetMilestone()
 	p = nil
-	p.GetCreatedAt()
+	p.GetMilestone()
 }
 
-func TestPackageFile_GetDownloadURL(tt *testing.T) {
+func TestPullRequest_GetNodeID(tt *testing.T) {
 	var zeroValue string
-	p := &PackageFile{DownloadURL: &zeroValue}
-	p.GetDownloadURL()
-	p = &PackageFile{}
-	p.GetDownloadURL()
+	p := &PullRequest{NodeID: &zeroValue}
+	p.GetNodeID()
+	p = &PullRequest{}
+	p.GetNodeID()
 	p = nil
-	p.GetDownloadURL()
+	p.GetNodeID()
 }
 
-func TestPackageFile_GetID(tt *testing.T) {
-	var zeroValue int64
-	p := &PackageFile{ID: &zeroValue}
-	p.GetID()
-	p = &PackageFile{}
-	p.GetID()
+func TestPullRequest_GetNumber(tt *testing.T) {
+	var zeroValue int
+	p := &PullRequest{Number: &zeroValue}
+	p.GetNumber()
+	p = &PullRequest{}
+	p.GetNumber()
 	p = nil
-	p.GetID()
+	p.GetNumber()
 }
 
-func TestPackageFile_GetMD5(tt *testing.T) {
+func TestPullRequest_GetPatchURL(tt *testing.T) {
 	var zeroValue string
-	p := &PackageFile{MD5: &zeroValue}
-	p.GetMD5()
-	p = &PackageFile{}
-	p.GetMD5()
+	p := &PullRequest{PatchURL: &zeroValue}
+	p.GetPatchURL()
+	p = &PullRequest{}
+	p.GetPatchURL()
 	p = nil
-	p.GetMD5()
+	p.GetPatchURL()
 }
 
-func TestPackageFile_GetName(tt *testing.T) {
-	var zeroValue string
-	p := &PackageFile{Name: &zeroValue}
-	p.GetName()
-	p = &PackageFile{}
-	p.GetName()
+func TestPullRequest_GetRebaseable(tt *testing.T) {
+	var zeroValue bool
+	p := &PullRequest{Rebaseable: &zeroValue}
+	p.GetRebaseable()
+	p = &PullRequest{}
+	p.GetRebaseable()
 	p = nil
-	p.GetName()
+	p.GetRebaseable()
 }
 
-func TestPackageFile_GetSHA1(tt *testing.T) {
-	var zeroValue string
-	p := &PackageFile{SHA1: &zeroValue}
-	p.GetSHA1()
-	p = &PackageFile{}
-	p.GetSHA1()
+func TestPullRequest_GetReviewComments(tt *testing.T) {
+	var zeroValue int
+	p := &PullRequest{ReviewComments: &zeroValue}
+	p.GetReviewComments()
+	p = &PullRequest{}
+	p.GetReviewComments()
 	p = nil
-	p.GetSHA1()
+	p.GetReviewComments()
 }
 
-func TestPackageFile_GetSHA256(tt *testing.T) {
+func TestPullRequest_GetReviewCommentsURL(tt *testing.T) {
 	var zeroValue string
-	p := &PackageFile{SHA256: &zeroValue}
-	p.GetSHA256()
-	p = &PackageFile{}
-	p.GetSHA256()
+	p := &PullRequest{ReviewCommentsURL: &zeroValue}
+	p.GetReviewCommentsURL()
+	p = &PullRequest{}
+	p.GetReviewCommentsURL()
 	p = nil
-	p.GetSHA256()
+	p.GetReviewCommentsURL()
 }
 
-func TestPackageFile_GetSize(tt *testing.T) {
-	var zeroValue int64
-	p := &PackageFile{Size: &zeroValue}
-	p.GetSize()
-	p = &PackageFile{}
-	p.GetSize()
+func TestPullRequest_GetReviewCommentURL(tt *testing.T) {
+	var zeroValue string
+	p := &PullRequest{ReviewCommentURL: &zeroValue}
+	p.GetReviewCommentURL()
+	p = &PullRequest{}
+	p.GetReviewCommentURL()
 	p = nil
-	p.GetSize()
+	p.GetReviewCommentURL()
 }
 
-func TestPackageFile_GetState(tt *testing.T) {
+func TestPullRequest_GetState(tt *testing.T) {
 	var zeroValue string
-	p := &PackageFile{State: &zeroValue}
+	p := &PullRequest{State: &zeroValue}
 	p.GetState()
-	p = &PackageFile{}
+	p = &PullRequest{}
 	p.GetState()
 	p = nil
 	p.GetState()
 }
 
-func TestPackageFile_GetUpdatedAt(tt *testing.T) {
-	var zeroValue Timestamp
-	p := &PackageFile{UpdatedAt: &zeroValue}
-	p.GetUpdatedAt()
-	p = &PackageFile{}
-	p.GetUpdatedAt()
-	p = nil
-	p.GetUpdatedAt()
-}
-
-func TestPackageRegistry_GetAboutURL(tt *testing.T) {
+func TestPullRequest_GetStatusesURL(tt *testing.T) {
 	var zeroValue string
-	p := &PackageRegistry{AboutURL: &zeroValue}
-	p.GetAboutURL()
-	p = &PackageRegistry{}
-	p.GetAboutURL()
+	p := &PullRequest{StatusesURL: &zeroValue}
+	p.GetStatusesURL()
+	p = &PullRequest{}
+	p.GetStatusesURL()
 	p = nil
-	p.GetAboutURL()
+	p.GetStatusesURL()
 }
 
-func TestPackageRegistry_GetName(tt *testing.T) {
+func TestPullRequest_GetTitle(tt *testing.T) {
 	var zeroValue string
-	p := &PackageRegistry{Name: &zeroValue}
-	p.GetName()
-	p = &PackageRegistry{}
-	p.GetName()
+	p := &PullRequest{Title: &zeroValue}
+	p.GetTitle()
+	p = &PullRequest{}
+	p.GetTitle()
 	p = nil
-	p.GetName()
+	p.GetTitle()
 }
 
-func TestPackageRegistry_GetType(tt *testing.T) {
-	var zeroValue string
-	p := &PackageRegistry{Type: &zeroValue}
-	p.GetType()
-	p = &PackageRegistry{}
-	p.GetType()
+func TestPullRequest_GetUpdatedAt(tt *testing.T) {
+	var zeroValue time.Time
+	p := &PullRequest{UpdatedAt: &zeroValue}
+	p.GetUpdatedAt()
+	p = &PullRequest{}
+	p.GetUpdatedAt()
 	p = nil
-	p.GetType()
+	p.GetUpdatedAt()
 }
 
-func TestPackageRegistry_GetURL(tt *testing.T) {
+func TestPullRequest_GetURL(tt *testing.T) {
 	var zeroValue string
-	p := &PackageRegistry{URL: &zeroValue}
+	p := &PullRequest{URL: &zeroValue}
 	p.GetURL()
-	p = &PackageRegistry{}
+	p = &PullRequest{}
 	p.GetURL()
 	p = nil
 	p.GetURL()
 }
 
-func TestPackageRegistry_GetVendor(tt *testing.T) {
-	var zeroValue string
-	p := &PackageRegistry{Vendor: &zeroValue}
-	p.GetVendor()
-	p = &PackageRegistry{}
-	p.GetVendor()
+func TestPullRequest_GetUser(tt *testing.T) {
+	p := &PullRequest{}
+	p.GetUser()
 	p = nil
-	p.GetVendor()
+	p.GetUser()
 }
 
-func TestPackageRelease_GetAuthor(tt *testing.T) {
-	p := &PackageRelease{}
-	p.GetAuthor()
+func TestPullRequestAutoMerge_GetCommitMessage(tt *testing.T) {
+	var zeroValue string
+	p := &PullRequestAutoMerge{CommitMessage: &zeroValue}
+	p.GetCommitMessage()
+	p = &PullRequestAutoMerge{}
+	p.GetCommitMessage()
 	p = nil
-	p.GetAuthor()
+	p.GetCommitMessage()
 }
 
-func TestPackageRelease_GetCreatedAt(tt *testing.T) {
-	var zeroValue Timestamp
-	p := &PackageRelease{CreatedAt: &zeroValue}
-	p.GetCreatedAt()
-	p = &PackageRelease{}
-	p.GetCreatedAt()
+func TestPullRequestAutoMerge_GetCommitTitle(tt *testing.T) {
+	var zeroValue string
+	p := &PullRequestAutoMerge{CommitTitle: &zeroValue}
+	p.GetCommitTitle()
+	p = &PullRequestAutoMerge{}
+	p.GetCommitTitle()
 	p = nil
-	p.GetCreatedAt()
+	p.GetCommitTitle()
 }
 
-func TestPackageRelease_GetDraft(tt *testing.T) {
-	var zeroValue bool
-	p := &PackageRelease{Draft: &zeroValue}
-	p.GetDraft()
-	p = &PackageRelease{}
-	p.GetDraft()
+func TestPullRequestAutoMerge_GetEnabledBy(tt *testing.T) {
+	p := &PullRequestAutoMerge{}
+	p.GetEnabledBy()
 	p = nil
-	p.GetDraft()
+	p.GetEnabledBy()
 }
 
-func TestPackageRelease_GetHTMLURL(tt *testing.T) {
+func TestPullRequestAutoMerge_GetMergeMethod(tt *testing.T) {
 	var zeroValue string
-	p := &PackageRelease{HTMLURL: &zeroValue}
-	p.GetHTMLURL()
-	p = &PackageRelease{}
-	p.GetHTMLURL()
+	p := &PullRequestAutoMerge{MergeMethod: &zeroValue}
+	p.GetMergeMethod()
+	p = &PullRequestAutoMerge{}
+	p.GetMergeMethod()
 	p = nil
-	p.GetHTMLURL()
+	p.GetMergeMethod()
 }
 
-func TestPackageRelease_GetID(tt *testing.T) {
-	var zeroValue int64
-	p := &PackageRelease{ID: &zeroValue}
-	p.GetID()
-	p = &PackageRelease{}
-	p.GetID()
+func TestPullRequestBranch_GetLabel(tt *testing.T) {
+	var zeroValue string
+	p := &PullRequestBranch{Label: &zeroValue}
+	p.GetLabel()
+	p = &PullRequestBranch{}
+	p.GetLabel()
 	p = nil
-	p.GetID()
+	p.GetLabel()
 }
 
-func TestPackageRelease_GetName(tt *testing.T) {
+func TestPullRequestBranch_GetRef(tt *testing.T) {
 	var zeroValue string
-	p := &PackageRelease{Name: &zeroValue}
-	p.GetName()
-	p = &PackageRelease{}
-	p.GetName()
+	p := &PullRequestBranch{Ref: &zeroValue}
+	p.GetRef()
+	p = &PullRequestBranch{}
+	p.GetRef()
 	p = nil
-	p.GetName()
+	p.GetRef()
 }
 
-func TestPackageRelease_GetPrerelease(tt *testing.T) {
-	var zeroValue bool
-	p := &PackageRelease{Prerelease: &zeroValue}
-	p.GetPrerelease()
-	p = &PackageRelease{}
-	p.GetPrerelease()
+func TestPullRequestBranch_GetRepo(tt *testing.T) {
+	p := &PullRequestBranch{}
+	p.GetRepo()
 	p = nil
-	p.GetPrerelease()
+	p.GetRepo()
 }
 
-func TestPackageRelease_GetPublishedAt(tt *testing.T) {
-	var zeroValue Timestamp
-	p := &PackageRelease{PublishedAt: &zeroValue}
-	p.GetPublishedAt()
-	p = &PackageRelease{}
-	p.GetPublishedAt()
+func TestPullRequestBranch_GetSHA(tt *testing.T) {
+	var zeroValue string
+	p := &PullRequestBranch{SHA: &zeroValue}
+	p.GetSHA()
+	p = &PullRequestBranch{}
+	p.GetSHA()
 	p = nil
-	p.GetPublishedAt()
+	p.GetSHA()
 }
 
-func TestPackageRelease_GetTagName(tt *testing.T) {
+func TestPullRequestBranch_GetUser(tt *testing.T) {
+	p := &PullRequestBranch{}
+	p.GetUser()
+	p = nil
+	p.GetUser()
+}
+
+func TestPullRequestBranchUpdateOptions_GetExpectedHeadSHA(tt *testing.T) {
 	var zeroValue string
-	p := &PackageRelease{TagName: &zeroValue}
-	p.GetTagName()
-	p = &PackageRelease{}
-	p.GetTagName()
+	p := &PullRequestBranchUpdateOptions{ExpectedHeadSHA: &zeroValue}
+	p.GetExpectedHeadSHA()
+	p = &PullRequestBranchUpdateOptions{}
+	p.GetExpectedHeadSHA()
 	p = nil
-	p.GetTagName()
+	p.GetExpectedHeadSHA()
 }
 
-func TestPackageRelease_GetTargetCommitish(tt *testing.T) {
+func TestPullRequestBranchUpdateResponse_GetMessage(tt *testing.T) {
 	var zeroValue string
-	p := &PackageRelease{TargetCommitish: &zeroValue}
-	p.GetTargetCommitish()
-	p = &PackageRelease{}
-	p.GetTargetCommitish()
+	p := &PullRequestBranchUpdateResponse{Message: &zeroValue}
+	p.GetMessage()
+	p = &PullRequestBranchUpdateResponse{}
+	p.GetMessage()
 	p = nil
-	p.GetTargetCommitish()
+	p.GetMessage()
 }
 
-func TestPackageRelease_GetURL(tt *testing.T) {
+func TestPullRequestBranchUpdateResponse_GetURL(tt *testing.T) {
 	var zeroValue string
-	p := &PackageRelease{URL: &zeroValue}
+	p := &PullRequestBranchUpdateResponse{URL: &zeroValue}
 	p.GetURL()
-	p = &PackageRelease{}
+	p = &PullRequestBranchUpdateResponse{}
 	p.GetURL()
 	p = nil
 	p.GetURL()
 }
 
-func TestPackageVersion_GetAuthor(tt *testing.T) {
-	p := &PackageVersion{}
-	p.GetAuthor()
+func TestPullRequestComment_GetAuthorAssociation(tt *testing.T) {
+	var zeroValue string
+	p := &PullRequestComment{AuthorAssociation: &zeroValue}
+	p.GetAuthorAssociation()
+	p = &PullRequestComment{}
+	p.GetAuthorAssociation()
 	p = nil
-	p.GetAuthor()
+	p.GetAuthorAssociation()
 }
 
-func TestPackageVersion_GetBody(tt *testing.T) {
+func TestPullRequestComment_GetBody(tt *testing.T) {
 	var zeroValue string
-	p := &PackageVersion{Body: &zeroValue}
+	p := &PullRequestComment{Body: &zeroValue}
 	p.GetBody()
-	p = &PackageVersion{}
+	p = &PullRequestComment{}
 	p.GetBody()
 	p = nil
 	p.GetBody()
 }
 
-func TestPackageVersion_GetBodyHTML(tt *testing.T) {
+func TestPullRequestComment_GetCommitID(tt *testing.T) {
 	var zeroValue string
-	p := &PackageVersion{BodyHTML: &zeroValue}
-	p.GetBodyHTML()
-	p = &PackageVersion{}
-	p.GetBodyHTML()
+	p := &PullRequestComment{CommitID: &zeroValue}
+	p.GetCommitID()
+	p = &PullRequestComment{}
+	p.GetCommitID()
 	p = nil
-	p.GetBodyHTML()
+	p.GetCommitID()
 }
 
-func TestPackageVersion_GetCreatedAt(tt *testing.T) {
-	var zeroValue Timestamp
-	p := &PackageVersion{CreatedAt: &zeroValue}
+func TestPullRequestComment_GetCreatedAt(tt *testing.T) {
+	var zeroValue time.Time
+	p := &PullRequestComment{CreatedAt: &zeroValue}
 	p.GetCreatedAt()
-	p = &PackageVersion{}
+	p = &PullRequestComment{}
 	p.GetCreatedAt()
 	p = nil
 	p.GetCreatedAt()
 }
 
-func TestPackageVersion_GetDraft(tt *testing.T) {
-	var zeroValue bool
-	p := &PackageVersion{Draft: &zeroValue}
-	p.GetDraft()
-	p = &PackageVersion{}
-	p.GetDraft()
+func TestPullRequestComment_GetDiffHunk(tt *testing.T) {
+	var zeroValue string
+	p := &PullRequestComment{DiffHunk: &zeroValue}
+	p.GetDiffHunk()
+	p = &PullRequestComment{}
+	p.GetDiffHunk()
 	p = nil
-	p.GetDraft()
+	p.GetDiffHunk()
 }
 
-func TestPackageVersion_GetHTMLURL(tt *testing.T) {
+func TestPullRequestComment_GetHTMLURL(tt *testing.T) {
 	var zeroValue string
-	p := &PackageVersion{HTMLURL: &zeroValue}
+	p := &PullRequestComment{HTMLURL: &zeroValue}
 	p.GetHTMLURL()
-	p = &PackageVersion{}
+	p = &PullRequestComment{}
 	p.GetHTMLURL()
 	p = nil
 	p.GetHTMLURL()
 }
 
-func TestPackageVersion_GetID(tt *testing.T) {
+func TestPullRequestComment_GetID(tt *testing.T) {
 	var zeroValue int64
-	p := &PackageVersion{ID: &zeroValue}
+	p := &PullRequestComment{ID: &zeroValue}
 	p.GetID()
-	p = &PackageVersion{}
+	p = &PullRequestComment{}
 	p.GetID()
 	p = nil
 	p.GetID()
 }
 
-func TestPackageVersion_GetInstallationCommand(tt *testing.T) {
-	var zeroValue string
-	p := &PackageVersion{InstallationCommand: &zeroValue}
-	p.GetInstallationCommand()
-	p = &PackageVersion{}
-	p.GetInstallationCommand()
+func TestPullRequestComment_GetInReplyTo(tt *testing.T) {
+	var zeroValue int64
+	p := &PullRequestComment{InReplyTo: &zeroValue}
+	p.GetInReplyTo()
+	p = &PullRequestComment{}
+	p.GetInReplyTo()
 	p = nil
-	p.GetInstallationCommand()
+	p.GetInReplyTo()
 }
 
-func TestPackageVersion_GetManifest(tt *testing.T) {
+func TestPullRequestComment_GetLine(tt *testing.T) {
+	var zeroValue int
+	p := &PullRequestComment{Line: &zeroValue}
+	p.GetLine()
+	p = &PullRequestComment{}
+	p.GetLine()
+	p = nil
+	p.GetLine()
+}
+
+func TestPullRequestComment_GetNodeID(tt *testing.T) {
 	var zeroValue string
-	p := &PackageVersion{Manifest: &zeroValue}
-	p.GetManifest()
-	p = &PackageVersion{}
-	p.GetManifest()
+	p := &PullRequestComment{NodeID: &zeroValue}
+	p.GetNodeID()
+	p = &PullRequestComment{}
+	p.GetNodeID()
 	p = nil
-	p.GetManifest()
+	p.GetNodeID()
 }
 
-func TestPackageVersion_GetPrerelease(tt *testing.T) {
-	var zeroValue bool
-	p := &PackageVersion{Prerelease: &zeroValue}
-	p.GetPrerelease()
-	p = &PackageVersion{}
-	p.GetPrerelease()
+func TestPullRequestComment_GetOriginalCommitID(tt *testing.T) {
+	var zeroValue string
+	p := &PullRequestComment{OriginalCommitID: &zeroValue}
+	p.GetOriginalCommitID()
+	p = &PullRequestComment{}
+	p.GetOriginalCommitID()
 	p = nil
-	p.GetPrerelease()
+	p.GetOriginalCommitID()
 }
 
-func TestPackageVersion_GetRelease(tt *testing.T) {
-	p := &PackageVersion{}
-	p.GetRelease()
+func TestPullRequestComment_GetOriginalLine(tt *testing.T) {
+	var zeroValue int
+	p := &PullRequestComment{OriginalLine: &zeroValue}
+	p.GetOriginalLine()
+	p = &PullRequestComment{}
+	p.GetOriginalLine()
 	p = nil
-	p.GetRelease()
+	p.GetOriginalLine()
 }
 
-func TestPackageVersion_GetSummary(tt *testing.T) {
-	var zeroValue string
-	p := &PackageVersion{Summary: &zeroValue}
-	p.GetSummary()
-	p = &PackageVersion{}
-	p.GetSummary()
+func TestPullRequestComment_GetOriginalPosition(tt *testing.T) {
+	var zeroValue int
+	p := &PullRequestComment{OriginalPosition: &zeroValue}
+	p.GetOriginalPosition()
+	p = &PullRequestComment{}
+	p.GetOriginalPosition()
 	p = nil
-	p.GetSummary()
+	p.GetOriginalPosition()
 }
 
-func TestPackageVersion_GetTagName(tt *testing.T) {
-	var zeroValue string
-	p := &PackageVersion{TagName: &zeroValue}
-	p.GetTagName()
-	p = &PackageVersion{}
-	p.GetTagName()
+func TestPullRequestComment_GetOriginalStartLine(tt *testing.T) {
+	var zeroValue int
+	p := &PullRequestComment{OriginalStartLine: &zeroValue}
+	p.GetOriginalStartLine()
+	p = &PullRequestComment{}
+	p.GetOriginalStartLine()
 	p = nil
-	p.GetTagName()
+	p.GetOriginalStartLine()
 }
 
-func TestPackageVersion_GetTargetCommitish(tt *testing.T) {
+func TestPullRequestComment_GetPath(tt *testing.T) {
 	var zeroValue string
-	p := &PackageVersion{TargetCommitish: &zeroValue}
-	p.GetTargetCommitish()
-	p = &PackageVersion{}
-	p.GetTargetCommitish()
+	p := &PullRequestComment{Path: &zeroValue}
+	p.GetPath()
+	p = &PullRequestComment{}
+	p.GetPath()
 	p = nil
-	p.GetTargetCommitish()
+	p.GetPath()
 }
 
-func TestPackageVersion_GetTargetOID(tt *testing.T) {
-	var zeroValue string
-	p := &PackageVersion{TargetOID: &zeroValue}
-	p.GetTargetOID()
-	p = &PackageVersion{}
-	p.GetTargetOID()
+func TestPullRequestComment_GetPosition(tt *testing.T) {
+	var zeroValue int
+	p := &PullRequestComment{Position: &zeroValue}
+	p.GetPosition()
+	p = &PullRequestComment{}
+	p.GetPosition()
 	p = nil
-	p.GetTargetOID()
+	p.GetPosition()
 }
 
-func TestPackageVersion_GetUpdatedAt(tt *testing.T) {
-	var zeroValue Timestamp
-	p := &PackageVersion{UpdatedAt: &zeroValue}
-	p.GetUpdatedAt()
-	p = &PackageVersion{}
-	p.GetUpdatedAt()
+func TestPullRequestComment_GetPullRequestReviewID(tt *testing.T) {
+	var zeroValue int64
+	p := &PullRequestComment{PullRequestReviewID: &zeroValue}
+	p.GetPullRequestReviewID()
+	p = &PullRequestComment{}
+	p.GetPullRequestReviewID()
 	p = nil
-	p.GetUpdatedAt()
+	p.GetPullRequestReviewID()
 }
 
-func TestPackageVersion_GetVersion(tt *testing.T) {
+func TestPullRequestComment_GetPullRequestURL(tt *testing.T) {
 	var zeroValue string
-	p := &PackageVersion{Version: &zeroValue}
-	p.GetVersion()
-	p = &PackageVersion{}
-	p.GetVersion()
+	p := &PullRequestComment{PullRequestURL: &zeroValue}
+	p.GetPullRequestURL()
+	p = &PullRequestComment{}
+	p.GetPullRequestURL()
 	p = nil
-	p.GetVersion()
+	p.GetPullRequestURL()
 }
 
-func TestPage_GetAction(tt *testing.T) {
-	var zeroValue string
-	p := &Page{Action: &zeroValue}
-	p.GetAction()
-	p = &Page{}
-	p.GetAction()
+func TestPullRequestComment_GetReactions(tt *testing.T) {
+	p := &PullRequestComment{}
+	p.GetReactions()
 	p = nil
-	p.GetAction()
+	p.GetReactions()
 }
 
-func TestPage_GetHTMLURL(tt *testing.T) {
+func TestPullRequestComment_GetSide(tt *testing.T) {
 	var zeroValue string
-	p := &Page{HTMLURL: &zeroValue}
-	p.GetHTMLURL()
-	p = &Page{}
-	p.GetHTMLURL()
+	p := &PullRequestComment{Side: &zeroValue}
+	p.GetSide()
+	p = &PullRequestComment{}
+	p.GetSide()
 	p = nil
-	p.GetHTMLURL()
+	p.GetSide()
 }
 
-func TestPage_GetPageName(tt *testing.T) {
-	var zeroValue string
-	p := &Page{PageName: &zeroValue}
-	p.GetPageName()
-	p = &Page{}
-	p.GetPageName()
+func TestPullRequestComment_GetStartLine(tt *testing.T) {
+	var zeroValue int
+	p := &PullRequestComment{StartLine: &zeroValue}
+	p.GetStartLine()
+	p = &PullRequestComment{}
+	p.GetStartLine()
 	p = nil
-	p.GetPageName()
+	p.GetStartLine()
 }
 
-func TestPage_GetSHA(tt *testing.T) {
+func TestPullRequestComment_GetStartSide(tt *testing.T) {
 	var zeroValue string
-	p := &Page{SHA: &zeroValue}
-	p.GetSHA()
-	p = &Page{}
-	p.GetSHA()
+	p := &PullRequestComment{StartSide: &zeroValue}
+	p.GetStartSide()
+	p = &PullRequestComment{}
+	p.GetStartSide()
 	p = nil
-	p.GetSHA()
+	p.GetStartSide()
 }
 
-func TestPage_GetSummary(tt *testing.T) {
-	var zeroValue string
-	p := &Page{Summary: &zeroValue}
-	p.GetSummary()
-	p = &Page{}
-	p.GetSummary()
+func TestPullRequestComment_GetUpdatedAt(tt *testing.T) {
+	var zeroValue time.Time
+	p := &PullRequestComment{UpdatedAt: &zeroValue}
+	p.GetUpdatedAt()
+	p = &PullRequestComment{}
+	p.GetUpdatedAt()
 	p = nil
-	p.GetSummary()
+	p.GetUpdatedAt()
 }
 
-func TestPage_GetTitle(tt *testing.T) {
+func TestPullRequestComment_GetURL(tt *testing.T) {
 	var zeroValue string
-	p := &Page{Title: &zeroValue}
-	p.GetTitle()
-	p = &Page{}
-	p.GetTitle()
+	p := &PullRequestComment{URL: &zeroValue}
+	p.GetURL()
+	p = &PullRequestComment{}
+	p.GetURL()
 	p = nil
-	p.GetTitle()
+	p.GetURL()
 }
 
-func TestPageBuildEvent_GetBuild(tt *testing.T) {
-	p := &PageBuildEvent{}
-	p.GetBuild()
+func TestPullRequestComment_GetUser(tt *testing.T) {
+	p := &PullRequestComment{}
+	p.GetUser()
 	p = nil
-	p.GetBuild()
+	p.GetUser()
 }
 
-func TestPageBuildEvent_GetID(tt *testing.T) {
-	var zeroValue int64
-	p := &PageBuildEvent{ID: &zeroValue}
-	p.GetID()
-	p = &PageBuildEvent{}
-	p.GetID()
+func TestPullRequestCommentThread_GetComment(tt *testing.T) {
+	p := &PullRequestCommentThread{}
+	p.GetComment()
 	p = nil
-	p.GetID()
+	p.GetComment()
 }
 
-func TestPageBuildEvent_GetInstallation(tt *testing.T) {
-	p := &PageBuildEvent{}
-	p.GetInstallation()
+func TestPullRequestEvent_GetAction(tt *testing.T) {
+	var zeroValue string
+	p := &PullRequestEvent{Action: &zeroValue}
+	p.GetAction()
+	p = &PullRequestEvent{}
+	p.GetAction()
 	p = nil
-	p.GetInstallation()
+	p.GetAction()
 }
 
-func TestPageBuildEvent_GetRepo(tt *testing.T) {
-	p := &PageBuildEvent{}
-	p.GetRepo()
+func TestPullRequestEvent_GetAfter(tt *testing.T) {
+	var zeroValue string
+	p := &PullRequestEvent{After: &zeroValue}
+	p.GetAfter()
+	p = &PullRequestEvent{}
+	p.GetAfter()
 	p = nil
-	p.GetRepo()
+	p.GetAfter()
 }
 
-func TestPageBuildEvent_GetSender(tt *testing.T) {
-	p := &PageBuildEvent{}
-	p.GetSender()
+func TestPullRequestEvent_GetAssignee(tt *testing.T) {
+	p := &PullRequestEvent{}
+	p.GetAssignee()
 	p = nil
-	p.GetSender()
+	p.GetAssignee()
 }
 
-func TestPages_GetCNAME(tt *testing.T) {
+func TestPullRequestEvent_GetBefore(tt *testing.T) {
 	var zeroValue string
-	p := &Pages{CNAME: &zeroValue}
-	p.GetCNAME()
-	p = &Pages{}
-	p.GetCNAME()
+	p := &PullRequestEvent{Before: &zeroValue}
+	p.GetBefore()
+	p = &PullRequestEvent{}
+	p.GetBefore()
 	p = nil
-	p.GetCNAME()
+	p.GetBefore()
 }
 
-func TestPages_GetCustom404(tt *testing.T) {
-	var zeroValue bool
-	p := &Pages{Custom404: &zeroValue}
-	p.GetCustom404()
-	p = &Pages{}
-	p.GetCustom404()
+func TestPullRequestEvent_GetChanges(tt *testing.T) {
+	p := &PullRequestEvent{}
+	p.GetChanges()
 	p = nil
-	p.GetCustom404()
+	p.GetChanges()
 }
 
-func TestPages_GetHTMLURL(tt *testing.T) {
-	var zeroValue string
-	p := &Pages{HTMLURL: &zeroValue}
-	p.GetHTMLURL()
-	p = &Pages{}
-	p.GetHTMLURL()
+func TestPullRequestEvent_GetInstallation(tt *testing.T) {
+	p := &PullRequestEvent{}
+	p.GetInstallation()
+	p = nil
+	p.GetInstallation()
+}
+
+func TestPullRequestEvent_GetLabel(tt *testing.T) {
+	p := &PullRequestEvent{}
+	p.GetLabel()
 	p = nil
-	p.GetHTMLURL()
+	p.GetLabel()
 }
 
-func TestPages_GetSource(tt *testing.T) {
-	p := &Pages{}
-	p.GetSource()
+func TestPullRequestEvent_GetNumber(tt *testing.T) {
+	var zeroValue int
+	p := &PullRequestEvent{Number: &zeroValue}
+	p.GetNumber()
+	p = &PullRequestEvent{}
+	p.GetNumber()
 	p = nil
-	p.GetSource()
+	p.GetNumber()
 }
 
-func TestPages_GetStatus(tt *testing.T) {
-	var zeroValue string
-	p := &Pages{Status: &zeroValue}
-	p.GetStatus()
-	p = &Pages{}
-	p.GetStatus()
+func TestPullRequestEvent_GetOrganization(tt *testing.T) {
+	p := &PullRequestEvent{}
+	p.GetOrganization()
 	p = nil
-	p.GetStatus()
+	p.GetOrganization()
 }
 
-func TestPages_GetURL(tt *testing.T) {
-	var zeroValue string
-	p := &Pages{URL: &zeroValue}
-	p.GetURL()
-	p = &Pages{}
-	p.GetURL()
+func TestPullRequestEvent_GetPullRequest(tt *testing.T) {
+	p := &PullRequestEvent{}
+	p.GetPullRequest()
 	p = nil
-	p.GetURL()
+	p.GetPullRequest()
 }
 
-func TestPagesBuild_GetCommit(tt *testing.T) {
-	var zeroValue string
-	p := &PagesBuild{Commit: &zeroValue}
-	p.GetCommit()
-	p = &PagesBuild{}
-	p.GetCommit()
+func TestPullRequestEvent_GetRepo(tt *testing.T) {
+	p := &PullRequestEvent{}
+	p.GetRepo()
 	p = nil
-	p.GetCommit()
+	p.GetRepo()
 }
 
-func TestPagesBuild_GetCreatedAt(tt *testing.T) {
-	var zeroValue Timestamp
-	p := &PagesBuild{CreatedAt: &zeroValue}
-	p.GetCreatedAt()
-	p = &PagesBuild{}
-	p.GetCreatedAt()
+func TestPullRequestEvent_GetRequestedReviewer(tt *testing.T) {
+	p := &PullRequestEvent{}
+	p.GetRequestedReviewer()
 	p = nil
-	p.GetCreatedAt()
+	p.GetRequestedReviewer()
 }
 
-func TestPagesBuild_GetDuration(tt *testing.T) {
-	var zeroValue int
-	p := &PagesBuild{Duration: &zeroValue}
-	p.GetDuration()
-	p = &PagesBuild{}
-	p.GetDuration()
+func TestPullRequestEvent_GetRequestedTeam(tt *testing.T) {
+	p := &PullRequestEvent{}
+	p.GetRequestedTeam()
 	p = nil
-	p.GetDuration()
+	p.GetRequestedTeam()
 }
 
-func TestPagesBuild_GetError(tt *testing.T) {
-	p := &PagesBuild{}
-	p.GetError()
+func TestPullRequestEvent_GetSender(tt *testing.T) {
+	p := &PullRequestEvent{}
+	p.GetSender()
 	p = nil
-	p.GetError()
+	p.GetSender()
 }
 
-func TestPagesBuild_GetPusher(tt *testing.T) {
-	p := &PagesBuild{}
-	p.GetPusher()
+func TestPullRequestLinks_GetDiffURL(tt *testing.T) {
+	var zeroValue string
+	p := &PullRequestLinks{DiffURL: &zeroValue}
+	p.GetDiffURL()
+	p = &PullRequestLinks{}
+	p.GetDiffURL()
 	p = nil
-	p.GetPusher()
+	p.GetDiffURL()
 }
 
-func TestPagesBuild_GetStatus(tt *testing.T) {
+func TestPullRequestLinks_GetHTMLURL(tt *testing.T) {
 	var zeroValue string
-	p := &PagesBuild{Status: &zeroValue}
-	p.GetStatus()
-	p = &PagesBuild{}
-	p.GetStatus()
+	p := &PullRequestLinks{HTMLURL: &zeroValue}
+	p.GetHTMLURL()
+	p = &PullRequestLinks{}
+	p.GetHTMLURL()
 	p = nil
-	p.GetStatus()
+	p.GetHTMLURL()
 }
 
-func TestPagesBuild_GetUpdatedAt(tt *testing.T) {
-	var zeroValue Timestamp
-	p := &PagesBuild{UpdatedAt: &zeroValue}
-	p.GetUpdatedAt()
-	p = &PagesBuild{}
-	p.GetUpdatedAt()
+func TestPullRequestLinks_GetPatchURL(tt *testing.T) {
+	var zeroValue string
+	p := &PullRequestLinks{PatchURL: &zeroValue}
+	p.GetPatchURL()
+	p = &PullRequestLinks{}
+	p.GetPatchURL()
 	p = nil
-	p.GetUpdatedAt()
+	p.GetPatchURL()
 }
 
-func TestPagesBuild_GetURL(tt *testing.T) {
+func TestPullRequestLinks_GetURL(tt *testing.T) {
 	var zeroValue string
-	p := &PagesBuild{URL: &zeroValue}
+	p := &PullRequestLinks{URL: &zeroValue}
 	p.GetURL()
-	p = &PagesBuild{}
+	p = &PullRequestLinks{}
 	p.GetURL()
 	p = nil
 	p.GetURL()
 }
 
-func TestPagesError_GetMessage(tt *testing.T) {
+func TestPullRequestMergeability_GetPullRequest(tt *testing.T) {
+	p := &PullRequestMergeability{}
+	p.GetPullRequest()
+	p = nil
+	p.GetPullRequest()
+}
+
+func TestPullRequestMergeResult_GetMerged(tt *testing.T) {
+	var zeroValue bool
+	p := &PullRequestMergeResult{Merged: &zeroValue}
+	p.GetMerged()
+	p = &PullRequestMergeResult{}
+	p.GetMerged()
+	p = nil
+	p.GetMerged()
+}
+
+func TestPullRequestMergeResult_GetMessage(tt *testing.T) {
 	var zeroValue string
-	p := &PagesError{Message: &zeroValue}
+	p := &PullRequestMergeResult{Message: &zeroValue}
 	p.GetMessage()
-	p = &PagesError{}
+	p = &PullRequestMergeResult{}
 	p.GetMessage()
 	p = nil
 	p.GetMessage()
 }
 
-func TestPagesSource_GetBranch(tt *testing.T) {
+func TestPullRequestMergeResult_GetSHA(tt *testing.T) {
 	var zeroValue string
-	p := &PagesSource{Branch: &zeroValue}
-	p.GetBranch()
-	p = &PagesSource{}
-	p.GetBranch()
+	p := &PullRequestMergeResult{SHA: &zeroValue}
+	p.GetSHA()
+	p = &PullRequestMergeResult{}
+	p.GetSHA()
 	p = nil
-	p.GetBranch()
+	p.GetSHA()
 }
 
-func TestPagesSource_GetPath(tt *testing.T) {
+func TestPullRequestReview_GetAuthorAssociation(tt *testing.T) {
 	var zeroValue string
-	p := &PagesSource{Path: &zeroValue}
-	p.GetPath()
-	p = &PagesSource{}
-	p.GetPath()
-	p = nil
-	p.GetPath()
-}
-
-func TestPageStats_GetTotalPages(tt *testing.T) {
-	var zeroValue int
-	p := &PageStats{TotalPages: &zeroValue}
-	p.GetTotalPages()
-	p = &PageStats{}
-	p.GetTotalPages()
+	p := &PullRequestReview{AuthorAssociation: &zeroValue}
+	p.GetAuthorAssociation()
+	p = &PullRequestReview{}
+	p.GetAuthorAssociation()
 	p = nil
-	p.GetTotalPages()
+	p.GetAuthorAssociation()
 }
 
-func TestPagesUpdate_GetCNAME(tt *testing.T) {
+func TestPullRequestReview_GetBody(tt *testing.T) {
 	var zeroValue string
-	p := &PagesUpdate{CNAME: &zeroValue}
-	p.GetCNAME()
-	p = &PagesUpdate{}
-	p.GetCNAME()
+	p := &PullRequestReview{Body: &zeroValue}
+	p.GetBody()
+	p = &PullRequestReview{}
+	p.GetBody()
 	p = nil
-	p.GetCNAME()
+	p.GetBody()
 }
 
-func TestPagesUpdate_GetSource(tt *testing.T) {
+func TestPullRequestReview_GetCommitID(tt *testing.T) {
 	var zeroValue string
-	p := &PagesUpdate{Source: &zeroValue}
-	p.GetSource()
-	p = &PagesUpdate{}
-	p.GetSource()
+	p := &PullRequestReview{CommitID: &zeroValue}
+	p.GetCommitID()
+	p = &PullRequestReview{}
+	p.GetCommitID()
 	p = nil
-	p.GetSource()
+	p.GetCommitID()
 }
 
-func TestPingEvent_GetHook(tt *testing.T) {
-	p := &PingEvent{}
-	p.GetHook()
+func TestPullRequestReview_GetHTMLURL(tt *testing.T) {
+	var zeroValue string
+	p := &PullRequestReview{HTMLURL: &zeroValue}
+	p.GetHTMLURL()
+	p = &PullRequestReview{}
+	p.GetHTMLURL()
 	p = nil
-	p.GetHook()
+	p.GetHTMLURL()
 }
 
-func TestPingEvent_GetHookID(tt *testing.T) {
+func TestPullRequestReview_GetID(tt *testing.T) {
 	var zeroValue int64
-	p := &PingEvent{HookID: &zeroValue}
-	p.GetHookID()
-	p = &PingEvent{}
-	p.GetHookID()
+	p := &PullRequestReview{ID: &zeroValue}
+	p.GetID()
+	p = &PullRequestReview{}
+	p.GetID()
 	p = nil
-	p.GetHookID()
+	p.GetID()
 }
 
-func TestPingEvent_GetInstallation(tt *testing.T) {
-	p := &PingEvent{}
-	p.GetInstallation()
+func TestPullRequestReview_GetNodeID(tt *testing.T) {
+	var zeroValue string
+	p := &PullRequestReview{NodeID: &zeroValue}
+	p.GetNodeID()
+	p = &PullRequestReview{}
+	p.GetNodeID()
 	p = nil
-	p.GetInstallation()
+	p.GetNodeID()
 }
 
-func TestPingEvent_GetZen(tt *testing.T) {
+func TestPullRequestReview_GetPullRequestURL(tt *testing.T) {
 	var zeroValue string
-	p := &PingEvent{Zen: &zeroValue}
-	p.GetZen()
-	p = &PingEvent{}
-	p.GetZen()
+	p := &PullRequestReview{PullRequestURL: &zeroValue}
+	p.GetPullRequestURL()
+	p = &PullRequestReview{}
+	p.GetPullRequestURL()
 	p = nil
-	p.GetZen()
+	p.GetPullRequestURL()
 }
 
-func TestPlan_GetCollaborators(tt *testing.T) {
-	var zeroValue int
-	p := &Plan{Collaborators: &zeroValue}
-	p.GetCollaborators()
-	p = &Plan{}
-	p.GetCollaborators()
+func TestPullRequestReview_GetState(tt *testing.T) {
+	var zeroValue string
+	p := &PullRequestReview{State: &zeroValue}
+	p.GetState()
+	p = &PullRequestReview{}
+	p.GetState()
 	p = nil
-	p.GetCollaborators()
+	p.GetState()
 }
 
-func TestPlan_GetFilledSeats(tt *testing.T) {
-	var zeroValue int
-	p := &Plan{FilledSeats: &zeroValue}
-	p.GetFilledSeats()
-	p = &Plan{}
-	p.GetFilledSeats()
+func TestPullRequestReview_GetSubmittedAt(tt *testing.T) {
+	var zeroValue time.Time
+	p := &PullRequestReview{SubmittedAt: &zeroValue}
+	p.GetSubmittedAt()
+	p = &PullRequestReview{}
+	p.GetSubmittedAt()
 	p = nil
-	p.GetFilledSeats()
+	p.GetSubmittedAt()
 }
 
-func TestPlan_GetName(tt *testing.T) {
+func TestPullRequestReview_GetUser(tt *testing.T) {
+	p := &PullRequestReview{}
+	p.GetUser()
+	p = nil
+	p.GetUser()
+}
+
+func TestPullRequestReviewCommentEvent_GetAction(tt *testing.T) {
 	var zeroValue string
-	p := &Plan{Name: &zeroValue}
-	p.GetName()
-	p = &Plan{}
-	p.GetName()
+	p := &PullRequestReviewCommentEvent{Action: &zeroValue}
+	p.GetAction()
+	p = &PullRequestReviewCommentEvent{}
+	p.GetAction()
 	p = nil
-	p.GetName()
+	p.GetAction()
 }
 
-func TestPlan_GetPrivateRepos(tt *testing.T) {
-	var zeroValue int
-	p := &Plan{PrivateRepos: &zeroValue}
-	p.GetPrivateRepos()
-	p = &Plan{}
-	p.GetPrivateRepos()
+func TestPullRequestReviewCommentEvent_GetChanges(tt *testing.T) {
+	p := &PullRequestReviewCommentEvent{}
+	p.GetChanges()
 	p = nil
-	p.GetPrivateRepos()
+	p.GetChanges()
 }
 
-func TestPlan_GetSeats(tt *testing.T) {
-	var zeroValue int
-	p := &Plan{Seats: &zeroValue}
-	p.GetSeats()
-	p = &Plan{}
-	p.GetSeats()
+func TestPullRequestReviewCommentEvent_GetComment(tt *testing.T) {
+	p := &PullRequestReviewCommentEvent{}
+	p.GetComment()
 	p = nil
-	p.GetSeats()
+	p.GetComment()
 }
 
-func TestPlan_GetSpace(tt *testing.T) {
-	var zeroValue int
-	p := &Plan{Space: &zeroValue}
-	p.GetSpace()
-	p = &Plan{}
-	p.GetSpace()
+func TestPullRequestReviewCommentEvent_GetInstallation(tt *testing.T) {
+	p := &PullRequestReviewCommentEvent{}
+	p.GetInstallation()
 	p = nil
-	p.GetSpace()
+	p.GetInstallation()
 }
 
-func TestPreReceiveHook_GetConfigURL(tt *testing.T) {
-	var zeroValue string
-	p := &PreReceiveHook{ConfigURL: &zeroValue}
-	p.GetConfigURL()
-	p = &PreReceiveHook{}
-	p.GetConfigURL()
+func TestPullRequestReviewCommentEvent_GetPullRequest(tt *testing.T) {
+	p := &PullRequestReviewCommentEvent{}
+	p.GetPullRequest()
 	p = nil
-	p.GetConfigURL()
+	p.GetPullRequest()
 }
 
-func TestPreReceiveHook_GetEnforcement(tt *testing.T) {
-	var zeroValue string
-	p := &PreReceiveHook{Enforcement: &zeroValue}
-	p.GetEnforcement()
-	p = &PreReceiveHook{}
-	p.GetEnforcement()
+func TestPullRequestReviewCommentEvent_GetRepo(tt *testing.T) {
+	p := &PullRequestReviewCommentEvent{}
+	p.GetRepo()
 	p = nil
-	p.GetEnforcement()
+	p.GetRepo()
 }
 
-func TestPreReceiveHook_GetID(tt *testing.T) {
-	var zeroValue int64
-	p := &PreReceiveHook{ID: &zeroValue}
-	p.GetID()
-	p = &PreReceiveHook{}
-	p.GetID()
+func TestPullRequestReviewCommentEvent_GetSender(tt *testing.T) {
+	p := &PullRequestReviewCommentEvent{}
+	p.GetSender()
 	p = nil
-	p.GetID()
+	p.GetSender()
 }
 
-func TestPreReceiveHook_GetName(tt *testing.T) {
+func TestPullRequestReviewDismissalRequest_GetMessage(tt *testing.T) {
 	var zeroValue string
-	p := &PreReceiveHook{Name: &zeroValue}
-	p.GetName()
-	p = &PreReceiveHook{}
-	p.GetName()
+	p := &PullRequestReviewDismissalRequest{Message: &zeroValue}
+	p.GetMessage()
+	p = &PullRequestReviewDismissalRequest{}
+	p.GetMessage()
 	p = nil
-	p.GetName()
+	p.GetMessage()
 }
 
-func TestPRLink_GetHRef(tt *testing.T) {
+func TestPullRequestReviewEvent_GetAction(tt *testing.T) {
 	var zeroValue string
-	p := &PRLink{HRef: &zeroValue}
-	p.GetHRef()
-	p = &PRLink{}
-	p.GetHRef()
+	p := &PullRequestReviewEvent{Action: &zeroValue}
+	p.GetAction()
+	p = &PullRequestReviewEvent{}
+	p.GetAction()
 	p = nil
-	p.GetHRef()
+	p.GetAction()
 }
 
-func TestPRLinks_GetComments(tt *testing.T) {
-	p := &PRLinks{}
-	p.GetComments()
+func TestPullRequestReviewEvent_GetInstallation(tt *testing.T) {
+	p := &PullRequestReviewEvent{}
+	p.GetInstallation()
 	p = nil
-	p.GetComments()
+	p.GetInstallation()
 }
 
-func TestPRLinks_GetCommits(tt *testing.T) {
-	p := &PRLinks{}
-	p.GetCommits()
+func TestPullRequestReviewEvent_GetOrganization(tt *testing.T) {
+	p := &PullRequestReviewEvent{}
+	p.GetOrganization()
 	p = nil
-	p.GetCommits()
+	p.GetOrganization()
 }
 
-func TestPRLinks_GetHTML(tt *testing.T) {
-	p := &PRLinks{}
-	p.GetHTML()
+func TestPullRequestReviewEvent_GetPullRequest(tt *testing.T) {
+	p := &PullRequestReviewEvent{}
+	p.GetPullRequest()
 	p = nil
-	p.GetHTML()
+	p.GetPullRequest()
 }
 
-func TestPRLinks_GetIssue(tt *testing.T) {
-	p := &PRLinks{}
-	p.GetIssue()
+func TestPullRequestReviewEvent_GetRepo(tt *testing.T) {
+	p := &PullRequestReviewEvent{}
+	p.GetRepo()
 	p = nil
-	p.GetIssue()
+	p.GetRepo()
 }
 
-func TestPRLinks_GetReviewComment(tt *testing.T) {
-	p := &PRLinks{}
-	p.GetReviewComment()
+func TestPullRequestReviewEvent_GetReview(tt *testing.T) {
+	p := &PullRequestReviewEvent{}
+	p.GetReview()
 	p = nil
-	p.GetReviewComment()
+	p.GetReview()
 }
 
-func TestPRLinks_GetReviewComments(tt *testing.T) {
-	p := &PRLinks{}
-	p.GetReviewComments()
+func TestPullRequestReviewEvent_GetSender(tt *testing.T) {
+	p := &PullRequestReviewEvent{}
+	p.GetSender()
 	p = nil
-	p.GetReviewComments()
+	p.GetSender()
 }
 
-func TestPRLinks_GetSelf(tt *testing.T) {
-	p := &PRLinks{}
-	p.GetSelf()
+func TestPullRequestReviewRequest_GetBody(tt *testing.T) {
+	var zeroValue string
+	p := &PullRequestReviewRequest{Body: &zeroValue}
+	p.GetBody()
+	p = &PullRequestReviewRequest{}
+	p.GetBody()
 	p = nil
-	p.GetSelf()
+	p.GetBody()
 }
 
-func TestPRLinks_GetStatuses(tt *testing.T) {
-	p := &PRLinks{}
-	p.GetStatuses()
+func TestPullRequestReviewRequest_GetCommitID(tt *testing.T) {
+	var zeroValue string
+	p := &PullRequestReviewRequest{CommitID: &zeroValue}
+	p.GetCommitID()
+	p = &PullRequestReviewRequest{}
+	p.GetCommitID()
 	p = nil
-	p.GetStatuses()
+	p.GetCommitID()
 }
 
-func TestProject_GetBody(tt *testing.T) {
+func TestPullRequestReviewRequest_GetEvent(tt *testing.T) {
 	var zeroValue string
-	p := &Project{Body: &zeroValue}
-	p.GetBody()
-	p = &Project{}
-	p.GetBody()
+	p := &PullRequestReviewRequest{Event: &zeroValue}
+	p.GetEvent()
+	p = &PullRequestReviewRequest{}
+	p.GetEvent()
 	p = nil
-	p.GetBody()
+	p.GetEvent()
 }
 
-func TestProject_GetColumnsURL(tt *testing.T) {
+func TestPullRequestReviewRequest_GetNodeID(tt *testing.T) {
 	var zeroValue string
-	p := &Project{ColumnsURL: &zeroValue}
-	p.GetColumnsURL()
-	p = &Project{}
-	p.GetColumnsURL()
+	p := &PullRequestReviewRequest{NodeID: &zeroValue}
+	p.GetNodeID()
+	p = &PullRequestReviewRequest{}
+	p.GetNodeID()
 	p = nil
-	p.GetColumnsURL()
+	p.GetNodeID()
 }
 
-func TestProject_GetCreatedAt(tt *testing.T) {
-	var zeroValue Timestamp
-	p := &Project{CreatedAt: &zeroValue}
-	p.GetCreatedAt()
-	p = &Project{}
-	p.GetCreatedAt()
+func TestPullRequestReviewsEnforcement_GetDismissalRestrictions(tt *testing.T) {
+	p := &PullRequestReviewsEnforcement{}
+	p.GetDismissalRestrictions()
 	p = nil
-	p.GetCreatedAt()
+	p.GetDismissalRestrictions()
 }
 
-func TestProject_GetCreator(tt *testing.T) {
-	p := &Project{}
-	p.GetCreator()
+func TestPullRequestReviewsEnforcementRequest_GetDismissalRestrictionsRequest(tt *testing.T) {
+	p := &PullRequestReviewsEnforcementRequest{}
+	p.GetDismissalRestrictionsRequest()
 	p = nil
-	p.GetCreator()
+	p.GetDismissalRestrictionsRequest()
 }
 
-func TestProject_GetHTMLURL(tt *testing.T) {
-	var zeroValue string
-	p := &Project{HTMLURL: &zeroValue}
-	p.GetHTMLURL()
-	p = &Project{}
-	p.GetHTMLURL()
+func TestPullRequestReviewsEnforcementUpdate_GetDismissalRestrictionsRequest(tt *testing.T) {
+	p := &PullRequestReviewsEnforcementUpdate{}
+	p.GetDismissalRestrictionsRequest()
 	p = nil
-	p.GetHTMLURL()
+	p.GetDismissalRestrictionsRequest()
 }
-
-func TestProject_GetID(tt *testing.T) {
-	var zeroValue int64
-	p := &Project{ID: &zeroValue}
-	p.GetID()
-	p = &Project{}
-	p.GetID()
+
+func TestPullRequestReviewsEnforcementUpdate_GetDismissStaleReviews(tt *testing.T) {
+	var zeroValue bool
+	p := &PullRequestReviewsEnforcementUpdate{DismissStaleReviews: &zeroValue}
+	p.GetDismissStaleReviews()
+	p = &PullRequestReviewsEnforcementUpdate{}
+	p.GetDismissStaleReviews()
 	p = nil
-	p.GetID()
+	p.GetDismissStaleReviews()
 }
 
-func TestProject_GetName(tt *testing.T) {
+func TestPullRequestReviewThread_GetDiffSide(tt *testing.T) {
 	var zeroValue string
-	p := &Project{Name: &zeroValue}
-	p.GetName()
-	p = &Project{}
-	p.GetName()
+	p := &PullRequestReviewThread{DiffSide: &zeroValue}
+	p.GetDiffSide()
+	p = &PullRequestReviewThread{}
+	p.GetDiffSide()
 	p = nil
-	p.GetName()
+	p.GetDiffSide()
 }
 
-func TestProject_GetNodeID(tt *testing.T) {
+func TestPullRequestReviewThread_GetID(tt *testing.T) {
 	var zeroValue string
-	p := &Project{NodeID: &zeroValue}
-	p.GetNodeID()
-	p = &Project{}
-	p.GetNodeID()
+	p := &PullRequestReviewThread{ID: &zeroValue}
+	p.GetID()
+	p = &PullRequestReviewThread{}
+	p.GetID()
 	p = nil
-	p.GetNodeID()
+	p.GetID()
 }
 
-func TestProject_GetNumber(tt *testing.T) {
-	var zeroValue int
-	p := &Project{Number: &zeroValue}
-	p.GetNumber()
-	p = &Project{}
-	p.GetNumber()
+func TestPullRequestReviewThread_GetIsCollapsed(tt *testing.T) {
+	var zeroValue bool
+	p := &PullRequestReviewThread{IsCollapsed: &zeroValue}
+	p.GetIsCollapsed()
+	p = &PullRequestReviewThread{}
+	p.GetIsCollapsed()
 	p = nil
-	p.GetNumber()
+	p.GetIsCollapsed()
 }
 
-func TestProject_GetOwnerURL(tt *testing.T) {
-	var zeroValue string
-	p := &Project{OwnerURL: &zeroValue}
-	p.GetOwnerURL()
-	p = &Project{}
-	p.GetOwnerURL()
+func TestPullRequestReviewThread_GetIsOutdated(tt *testing.T) {
+	var zeroValue bool
+	p := &PullRequestReviewThread{IsOutdated: &zeroValue}
+	p.GetIsOutdated()
+	p = &PullRequestReviewThread{}
+	p.GetIsOutdated()
 	p = nil
-	p.GetOwnerURL()
+	p.GetIsOutdated()
 }
 
-func TestProject_GetState(tt *testing.T) {
-	var zeroValue string
-	p := &Project{State: &zeroValue}
-	p.GetState()
-	p = &Project{}
-	p.GetState()
+func TestPullRequestReviewThread_GetIsResolved(tt *testing.T) {
+	var zeroValue bool
+	p := &PullRequestReviewThread{IsResolved: &zeroValue}
+	p.GetIsResolved()
+	p = &PullRequestReviewThread{}
+	p.GetIsResolved()
 	p = nil
-	p.GetState()
+	p.GetIsResolved()
 }
 
-func TestProject_GetUpdatedAt(tt *testing.T) {
-	var zeroValue Timestamp
-	p := &Project{UpdatedAt: &zeroValue}
-	p.GetUpdatedAt()
-	p = &Project{}
-	p.GetUpdatedAt()
+func TestPullRequestReviewThread_GetLine(tt *testing.T) {
+	var zeroValue int
+	p := &PullRequestReviewThread{Line: &zeroValue}
+	p.GetLine()
+	p = &PullRequestReviewThread{}
+	p.GetLine()
 	p = nil
-	p.GetUpdatedAt()
+	p.GetLine()
 }
 
-func TestProject_GetURL(tt *testing.T) {
-	var zeroValue string
-	p := &Project{URL: &zeroValue}
-	p.GetURL()
-	p = &Project{}
-	p.GetURL()
+func TestPullRequestReviewThread_GetOriginalLine(tt *testing.T) {
+	var zeroValue int
+	p := &PullRequestReviewThread{OriginalLine: &zeroValue}
+	p.GetOriginalLine()
+	p = &PullRequestReviewThread{}
+	p.GetOriginalLine()
 	p = nil
-	p.GetURL()
+	p.GetOriginalLine()
 }
 
-func TestProjectCard_GetArchived(tt *testing.T) {
-	var zeroValue bool
-	p := &ProjectCard{Archived: &zeroValue}
-	p.GetArchived()
-	p = &ProjectCard{}
-	p.GetArchived()
+func TestPullRequestReviewThread_GetOriginalStartLine(tt *testing.T) {
+	var zeroValue int
+	p := &PullRequestReviewThread{OriginalStartLine: &zeroValue}
+	p.GetOriginalStartLine()
+	p = &PullRequestReviewThread{}
+	p.GetOriginalStartLine()
 	p = nil
-	p.GetArchived()
+	p.GetOriginalStartLine()
 }
 
-func TestProjectCard_GetColumnID(tt *testing.T) {
-	var zeroValue int64
-	p := &ProjectCard{ColumnID: &zeroValue}
-	p.GetColumnID()
-	p = &ProjectCard{}
-	p.GetColumnID()
+func TestPullRequestReviewThread_GetPath(tt *testing.T) {
+	var zeroValue string
+	p := &PullRequestReviewThread{Path: &zeroValue}
+	p.GetPath()
+	p = &PullRequestReviewThread{}
+	p.GetPath()
 	p = nil
-	p.GetColumnID()
+	p.GetPath()
 }
 
-func TestProjectCard_GetColumnName(tt *testing.T) {
-	var zeroValue string
-	p := &ProjectCard{ColumnName: &zeroValue}
-	p.GetColumnName()
-	p = &ProjectCard{}
-	p.GetColumnName()
+func TestPullRequestReviewThread_GetResolvedBy(tt *testing.T) {
+	p := &PullRequestReviewThread{}
+	p.GetResolvedBy()
 	p = nil
-	p.GetColumnName()
+	p.GetResolvedBy()
 }
 
-func TestProjectCard_GetColumnURL(tt *testing.T) {
+func TestPullRequestReviewThread_GetStartDiffSide(tt *testing.T) {
 	var zeroValue string
-	p := &ProjectCard{ColumnURL: &zeroValue}
-	p.GetColumnURL()
-	p = &ProjectCard{}
-	p.GetColumnURL()
+	p := &PullRequestReviewThread{StartDiffSide: &zeroValue}
+	p.GetStartDiffSide()
+	p = &PullRequestReviewThread{}
+	p.GetStartDiffSide()
 	p = nil
-	p.GetColumnURL()
+	p.GetStartDiffSide()
 }
 
-func TestProjectCard_GetContentURL(tt *testing.T) {
-	var zeroValue string
-	p := &ProjectCard{ContentURL: &zeroValue}
-	p.GetContentURL()
-	p = &ProjectCard{}
-	p.GetContentURL()
+func TestPullRequestReviewThread_GetStartLine(tt *testing.T) {
+	var zeroValue int
+	p := &PullRequestReviewThread{StartLine: &zeroValue}
+	p.GetStartLine()
+	p = &PullRequestReviewThread{}
+	p.GetStartLine()
 	p = nil
-	p.GetContentURL()
+	p.GetStartLine()
 }
 
-func TestProjectCard_GetCreatedAt(tt *testing.T) {
-	var zeroValue Timestamp
-	p := &ProjectCard{CreatedAt: &zeroValue}
-	p.GetCreatedAt()
-	p = &ProjectCard{}
-	p.GetCreatedAt()
+func TestPullStats_GetMergablePulls(tt *testing.T) {
+	var zeroValue int
+	p := &PullStats{MergablePulls: &zeroValue}
+	p.GetMergablePulls()
+	p = &PullStats{}
+	p.GetMergablePulls()
 	p = nil
-	p.GetCreatedAt()
+	p.GetMergablePulls()
 }
 
-func TestProjectCard_GetCreator(tt *testing.T) {
-	p := &ProjectCard{}
-	p.GetCreator()
+func TestPullStats_GetMergedPulls(tt *testing.T) {
+	var zeroValue int
+	p := &PullStats{MergedPulls: &zeroValue}
+	p.GetMergedPulls()
+	p = &PullStats{}
+	p.GetMergedPulls()
 	p = nil
-	p.GetCreator()
+	p.GetMergedPulls()
 }
 
-func TestProjectCard_GetID(tt *testing.T) {
-	var zeroValue int64
-	p := &ProjectCard{ID: &zeroValue}
-	p.GetID()
-	p = &ProjectCard{}
-	p.GetID()
+func TestPullStats_GetTotalPulls(tt *testing.T) {
+	var zeroValue int
+	p := &PullStats{TotalPulls: &zeroValue}
+	p.GetTotalPulls()
+	p = &PullStats{}
+	p.GetTotalPulls()
 	p = nil
-	p.GetID()
+	p.GetTotalPulls()
 }
 
-func TestProjectCard_GetNodeID(tt *testing.T) {
-	var zeroValue string
-	p := &ProjectCard{NodeID: &zeroValue}
-	p.GetNodeID()
-	p = &ProjectCard{}
-	p.GetNodeID()
+func TestPullStats_GetUnmergablePulls(tt *testing.T) {
+	var zeroValue int
+	p := &PullStats{UnmergablePulls: &zeroValue}
+	p.GetUnmergablePulls()
+	p = &PullStats{}
+	p.GetUnmergablePulls()
 	p = nil
-	p.GetNodeID()
+	p.GetUnmergablePulls()
 }
 
-func TestProjectCard_GetNote(tt *testing.T) {
-	var zeroValue string
-	p := &ProjectCard{Note: &zeroValue}
-	p.GetNote()
-	p = &ProjectCard{}
-	p.GetNote()
+func TestPunchCard_GetCommits(tt *testing.T) {
+	var zeroValue int
+	p := &PunchCard{Commits: &zeroValue}
+	p.GetCommits()
+	p = &PunchCard{}
+	p.GetCommits()
 	p = nil
-	p.GetNote()
+	p.GetCommits()
 }
 
-func TestProjectCard_GetPreviousColumnName(tt *testing.T) {
-	var zeroValue string
-	p := &ProjectCard{PreviousColumnName: &zeroValue}
-	p.GetPreviousColumnName()
-	p = &ProjectCard{}
-	p.GetPreviousColumnName()
+func TestPunchCard_GetDay(tt *testing.T) {
+	var zeroValue int
+	p := &PunchCard{Day: &zeroValue}
+	p.GetDay()
+	p = &PunchCard{}
+	p.GetDay()
 	p = nil
-	p.GetPreviousColumnName()
+	p.GetDay()
 }
 
-func TestProjectCard_GetProjectID(tt *testing.T) {
-	var zeroValue int64
-	p := &ProjectCard{ProjectID: &zeroValue}
-	p.GetProjectID()
-	p = &ProjectCard{}
-	p.GetProjectID()
+func TestPunchCard_GetHour(tt *testing.T) {
+	var zeroValue int
+	p := &PunchCard{Hour: &zeroValue}
+	p.GetHour()
+	p = &PunchCard{}
+	p.GetHour()
 	p = nil
-	p.GetProjectID()
+	p.GetHour()
 }
 
-func TestProjectCard_GetProjectURL(tt *testing.T) {
+func TestPushEvent_GetAfter(tt *testing.T) {
 	var zeroValue string
-	p := &ProjectCard{ProjectURL: &zeroValue}
-	p.GetProjectURL()
-	p = &ProjectCard{}
-	p.GetProjectURL()
+	p := &PushEvent{After: &zeroValue}
+	p.GetAfter()
+	p = &PushEvent{}
+	p.GetAfter()
 	p = nil
-	p.GetProjectURL()
+	p.GetAfter()
 }
 
-func TestProjectCard_GetUpdatedAt(tt *testing.T) {
-	var zeroValue Timestamp
-	p := &ProjectCard{UpdatedAt: &zeroValue}
-	p.GetUpdatedAt()
-	p = &ProjectCard{}
-	p.GetUpdatedAt()
+func TestPushEvent_GetBaseRef(tt *testing.T) {
+	var zeroValue string
+	p := &PushEvent{BaseRef: &zeroValue}
+	p.GetBaseRef()
+	p = &PushEvent{}
+	p.GetBaseRef()
 	p = nil
-	p.GetUpdatedAt()
+	p.GetBaseRef()
 }
 
-func TestProjectCard_GetURL(tt *testing.T) {
+func TestPushEvent_GetBefore(tt *testing.T) {
 	var zeroValue string
-	p := &ProjectCard{URL: &zeroValue}
-	p.GetURL()
-	p = &ProjectCard{}
-	p.GetURL()
+	p := &PushEvent{Before: &zeroValue}
+	p.GetBefore()
+	p = &PushEvent{}
+	p.GetBefore()
 	p = nil
-	p.GetURL()
+	p.GetBefore()
 }
 
-func TestProjectCardEvent_GetAction(tt *testing.T) {
+func TestPushEvent_GetCompare(tt *testing.T) {
 	var zeroValue string
-	p := &ProjectCardEvent{Action: &zeroValue}
-	p.GetAction()
-	p = &ProjectCardEvent{}
-	p.GetAction()
+	p := &PushEvent{Compare: &zeroValue}
+	p.GetCompare()
+	p = &PushEvent{}
+	p.GetCompare()
 	p = nil
-	p.GetAction()
+	p.GetCompare()
 }
 
-func TestProjectCardEvent_GetAfterID(tt *testing.T) {
-	var zeroValue int64
-	p := &ProjectCardEvent{AfterID: &zeroValue}
-	p.GetAfterID()
-	p = &ProjectCardEvent{}
-	p.GetAfterID()
+func TestPushEvent_GetCreated(tt *testing.T) {
+	var zeroValue bool
+	p := &PushEvent{Created: &zeroValue}
+	p.GetCreated()
+	p = &PushEvent{}
+	p.GetCreated()
 	p = nil
-	p.GetAfterID()
+	p.GetCreated()
 }
 
-func TestProjectCardEvent_GetChanges(tt *testing.T) {
-	p := &ProjectCardEvent{}
-	p.GetChanges()
+func TestPushEvent_GetDeleted(tt *testing.T) {
+	var zeroValue bool
+	p := &PushEvent{Deleted: &zeroValue}
+	p.GetDeleted()
+	p = &PushEvent{}
+	p.GetDeleted()
 	p = nil
-	p.GetChanges()
+	p.GetDeleted()
 }
 
-func TestProjectCardEvent_GetInstallation(tt *testing.T) {
-	p := &ProjectCardEvent{}
-	p.GetInstallation()
+func TestPushEvent_GetDistinctSize(tt *testing.T) {
+	var zeroValue int
+	p := &PushEvent{DistinctSize: &zeroValue}
+	p.GetDistinctSize()
+	p = &PushEvent{}
+	p.GetDistinctSize()
 	p = nil
-	p.GetInstallation()
+	p.GetDistinctSize()
 }
 
-func TestProjectCardEvent_GetOrg(tt *testing.T) {
-	p := &ProjectCardEvent{}
-	p.GetOrg()
+func TestPushEvent_GetForced(tt *testing.T) {
+	var zeroValue bool
+	p := &PushEvent{Forced: &zeroValue}
+	p.GetForced()
+	p = &PushEvent{}
+	p.GetForced()
 	p = nil
-	p.GetOrg()
+	p.GetForced()
 }
 
-func TestProjectCardEvent_GetProjectCard(tt *testing.T) {
-	p := &ProjectCardEvent{}
-	p.GetProjectCard()
+func TestPushEvent_GetHead(tt *testing.T) {
+	var zeroValue string
+	p := &PushEvent{Head: &zeroValue}
+	p.GetHead()
+	p = &PushEvent{}
+	p.GetHead()
 	p = nil
-	p.GetProjectCard()
+	p.GetHead()
 }
 
-func TestProjectCardEvent_GetRepo(tt *testing.T) {
-	p := &ProjectCardEvent{}
-	p.GetRepo()
+func TestPushEvent_GetHeadCommit(tt *testing.T) {
+	p := &PushEvent{}
+	p.GetHeadCommit()
 	p = nil
-	p.GetRepo()
+	p.GetHeadCommit()
 }
 
-func TestProjectCardEvent_GetSender(tt *testing.T) {
-	p := &ProjectCardEvent{}
-	p.GetSender()
+func TestPushEvent_GetInstallation(tt *testing.T) {
+	p := &PushEvent{}
+	p.GetInstallation()
 	p = nil
-	p.GetSender()
+	p.GetInstallation()
 }
 
-func TestProjectCardListOptions_GetArchivedState(tt *testing.T) {
-	var zeroValue string
-	p := &ProjectCardListOptions{ArchivedState: &zeroValue}
-	p.GetArchivedState()
-	p = &ProjectCardListOptions{}
-	p.GetArchivedState()
+func TestPushEvent_GetPusher(tt *testing.T) {
+	p := &PushEvent{}
+	p.GetPusher()
 	p = nil
-	p.GetArchivedState()
+	p.GetPusher()
 }
 
-func TestProjectCardOptions_GetArchived(tt *testing.T) {
-	var zeroValue bool
-	p := &ProjectCardOptions{Archived: &zeroValue}
-	p.GetArchived()
-	p = &ProjectCardOptions{}
-	p.GetArchived()
+func TestPushEvent_GetPushID(tt *testing.T) {
+	var zeroValue int64
+	p := &PushEvent{PushID: &zeroValue}
+	p.GetPushID()
+	p = &PushEvent{}
+	p.GetPushID()
 	p = nil
-	p.GetArchived()
+	p.GetPushID()
 }
 
-func TestProjectCollaboratorOptions_GetPermission(tt *testing.T) {
+func TestPushEvent_GetRef(tt *testing.T) {
 	var zeroValue string
-	p := &ProjectCollaboratorOptions{Permission: &zeroValue}
-	p.GetPermission()
-	p = &ProjectCollaboratorOptions{}
-	p.GetPermission()
+	p := &PushEvent{Ref: &zeroValue}
+	p.GetRef()
+	p = &PushEvent{}
+	p.GetRef()
 	p = nil
-	p.GetPermission()
+	p.GetRef()
 }
 
-func TestProjectColumn_GetCardsURL(tt *testing.T) {
-	var zeroValue string
-	p := &ProjectColumn{CardsURL: &zeroValue}
-	p.GetCardsURL()
-	p = &ProjectColumn{}
-	p.GetCardsURL()
+func TestPushEvent_GetRepo(tt *testing.T) {
+	p := &PushEvent{}
+	p.GetRepo()
 	p = nil
-	p.GetCardsURL()
+	p.GetRepo()
 }
 
-func TestProjectColumn_GetCreatedAt(tt *testing.T) {
-	var zeroValue Timestamp
-	p := &ProjectColumn{CreatedAt: &zeroValue}
-	p.GetCreatedAt()
-	p = &ProjectColumn{}
-	p.GetCreatedAt()
+func TestPushEvent_GetSender(tt *testing.T) {
+	p := &PushEvent{}
+	p.GetSender()
 	p = nil
-	p.GetCreatedAt()
+	p.GetSender()
 }
 
-func TestProjectColumn_GetID(tt *testing.T) {
-	var zeroValue int64
-	p := &ProjectColumn{ID: &zeroValue}
-	p.GetID()
-	p = &ProjectColumn{}
-	p.GetID()
+func TestPushEvent_GetSize(tt *testing.T) {
+	var zeroValue int
+	p := &PushEvent{Size: &zeroValue}
+	p.GetSize()
+	p = &PushEvent{}
+	p.GetSize()
 	p = nil
-	p.GetID()
+	p.GetSize()
 }
 
-func TestProjectColumn_GetName(tt *testing.T) {
+func TestPushEventRepoOwner_GetEmail(tt *testing.T) {
 	var zeroValue string
-	p := &ProjectColumn{Name: &zeroValue}
+	p := &PushEventRepoOwner{Email: &zeroValue}
+	p.GetEmail()
+	p = &PushEventRepoOwner{}
+	p.GetEmail()
+	p = nil
+	p.GetEmail()
+}
+
+func TestPushEventRepoOwner_GetName(tt *testing.T) {
+	var zeroValue string
+	p := &PushEventRepoOwner{Name: &zeroValue}
 	p.GetName()
-	p = &ProjectColumn{}
+	p = &PushEventRepoOwner{}
 	p.GetName()
 	p = nil
 	p.GetName()
 }
 
-func TestProjectColumn_GetNodeID(tt *testing.T) {
-	var zeroValue string
-	p := &ProjectColumn{NodeID: &zeroValue}
-	p.GetNodeID()
-	p = &ProjectColumn{}
-	p.GetNodeID()
+func TestPushEventRepository_GetArchived(tt *testing.T) {
+	var zeroValue bool
+	p := &PushEventRepository{Archived: &zeroValue}
+	p.GetArchived()
+	p = &PushEventRepository{}
+	p.GetArchived()
 	p = nil
-	p.GetNodeID()
+	p.GetArchived()
 }
 
-func TestProjectColumn_GetProjectURL(tt *testing.T) {
+func TestPushEventRepository_GetArchiveURL(tt *testing.T) {
 	var zeroValue string
-	p := &ProjectColumn{ProjectURL: &zeroValue}
-	p.GetProjectURL()
-	p = &ProjectColumn{}
-	p.GetProjectURL()
+	p := &PushEventRepository{ArchiveURL: &zeroValue}
+	p.GetArchiveURL()
+	p = &PushEventRepository{}
+	p.GetArchiveURL()
 	p = nil
-	p.GetProjectURL()
+	p.GetArchiveURL()
 }
 
-func TestProjectColumn_GetUpdatedAt(tt *testing.T) {
-	var zeroValue Timestamp
-	p := &ProjectColumn{UpdatedAt: &zeroValue}
-	p.GetUpdatedAt()
-	p = &ProjectColumn{}
-	p.GetUpdatedAt()
+func TestPushEventRepository_GetCloneURL(tt *testing.T) {
+	var zeroValue string
+	p := &PushEventRepository{CloneURL: &zeroValue}
+	p.GetCloneURL()
+	p = &PushEventRepository{}
+	p.GetCloneURL()
 	p = nil
-	p.GetUpdatedAt()
+	p.GetCloneURL()
 }
 
-func TestProjectColumn_GetURL(tt *testing.T) {
-	var zeroValue string
-	p := &ProjectColumn{URL: &zeroValue}
-	p.GetURL()
-	p = &ProjectColumn{}
-	p.GetURL()
+func TestPushEventRepository_GetCreatedAt(tt *testing.T) {
+	var zeroValue Timestamp
+	p := &PushEventRepository{CreatedAt: &zeroValue}
+	p.GetCreatedAt()
+	p = &PushEventRepository{}
+	p.GetCreatedAt()
 	p = nil
-	p.GetURL()
+	p.GetCreatedAt()
 }
 
-func TestProjectColumnEvent_GetAction(tt *testing.T) {
+func TestPushEventRepository_GetDefaultBranch(tt *testing.T) {
 	var zeroValue string
-	p := &ProjectColumnEvent{Action: &zeroValue}
-	p.GetAction()
-	p = &ProjectColumnEvent{}
-	p.GetAction()
+	p := &PushEventRepository{DefaultBranch: &zeroValue}
+	p.GetDefaultBranch()
+	p = &PushEventRepository{}
+	p.GetDefaultBranch()
 	p = nil
-	p.GetAction()
+	p.GetDefaultBranch()
 }
 
-func TestProjectColumnEvent_GetAfterID(tt *testing.T) {
-	var zeroValue int64
-	p := &ProjectColumnEvent{AfterID: &zeroValue}
-	p.GetAfterID()
-	p = &ProjectColumnEvent{}
-	p.GetAfterID()
+func TestPushEventRepository_GetDescription(tt *testing.T) {
+	var zeroValue string
+	p := &PushEventRepository{Description: &zeroValue}
+	p.GetDescription()
+	p = &PushEventRepository{}
+	p.GetDescription()
 	p = nil
-	p.GetAfterID()
+	p.GetDescription()
 }
 
-func TestProjectColumnEvent_GetChanges(tt *testing.T) {
-	p := &ProjectColumnEvent{}
-	p.GetChanges()
+func TestPushEventRepository_GetDisabled(tt *testing.T) {
+	var zeroValue bool
+	p := &PushEventRepository{Disabled: &zeroValue}
+	p.GetDisabled()
+	p = &PushEventRepository{}
+	p.GetDisabled()
 	p = nil
-	p.GetChanges()
+	p.GetDisabled()
 }
 
-func TestProjectColumnEvent_GetInstallation(tt *testing.T) {
-	p := &ProjectColumnEvent{}
-	p.GetInstallation()
+func TestPushEventRepository_GetFork(tt *testing.T) {
+	var zeroValue bool
+	p := &PushEventRepository{Fork: &zeroValue}
+	p.GetFork()
+	p = &PushEventRepository{}
+	p.GetFork()
 	p = nil
-	p.GetInstallation()
+	p.GetFork()
 }
 
-func TestProjectColumnEvent_GetOrg(tt *testing.T) {
-	p := &ProjectColumnEvent{}
-	p.GetOrg()
+func TestPushEventRepository_GetForksCount(tt *testing.T) {
+	var zeroValue int
+	p := &PushEventRepository{ForksCount: &zeroValue}
+	p.GetForksCount()
+	p = &PushEventRepository{}
+	p.GetForksCount()
 	p = nil
-	p.GetOrg()
+	p.GetForksCount()
 }
 
-func TestProjectColumnEvent_GetProjectColumn(tt *testing.T) {
-	p := &ProjectColumnEvent{}
-	p.GetProjectColumn()
+func TestPushEventRepository_GetFullName(tt *testing.T) {
+	var zeroValue string
+	p := &PushEventRepository{FullName: &zeroValue}
+	p.GetFullName()
+	p = &PushEventRepository{}
+	p.GetFullName()
 	p = nil
-	p.GetProjectColumn()
+	p.GetFullName()
 }
 
-func TestProjectColumnEvent_GetRepo(tt *testing.T) {
-	p := &ProjectColumnEvent{}
-	p.GetRepo()
+func TestPushEventRepository_GetGitURL(tt *testing.T) {
+	var zeroValue string
+	p := &PushEventRepository{GitURL: &zeroValue}
+	p.GetGitURL()
+	p = &PushEventRepository{}
+	p.GetGitURL()
 	p = nil
-	p.GetRepo()
+	p.GetGitURL()
 }
 
-func TestProjectColumnEvent_GetSender(tt *testing.T) {
-	p := &ProjectColumnEvent{}
-	p.GetSender()
+func TestPushEventRepository_GetHasDownloads(tt *testing.T) {
+	var zeroValue bool
+	p := &PushEventRepository{HasDownloads: &zeroValue}
+	p.GetHasDownloads()
+	p = &PushEventRepository{}
+	p.GetHasDownloads()
 	p = nil
-	p.GetSender()
+	p.GetHasDownloads()
 }
 
-func TestProjectEvent_GetAction(tt *testing.T) {
-	var zeroValue string
-	p := &ProjectEvent{Action: &zeroValue}
-	p.GetAction()
-	p = &ProjectEvent{}
-	p.GetAction()
+func TestPushEventRepository_GetHasIssues(tt *testing.T) {
+	var zeroValue bool
+	p := &PushEventRepository{HasIssues: &zeroValue}
+	p.GetHasIssues()
+	p = &PushEventRepository{}
+	p.GetHasIssues()
 	p = nil
-	p.GetAction()
+	p.GetHasIssues()
 }
 
-func TestProjectEvent_GetChanges(tt *testing.T) {
-	p := &ProjectEvent{}
-	p.GetChanges()
+func TestPushEventRepository_GetHasPages(tt *testing.T) {
+	var zeroValue bool
+	p := &PushEventRepository{HasPages: &zeroValue}
+	p.GetHasPages()
+	p = &PushEventRepository{}
+	p.GetHasPages()
 	p = nil
-	p.GetChanges()
+	p.GetHasPages()
 }
 
-func TestProjectEvent_GetInstallation(tt *testing.T) {
-	p := &ProjectEvent{}
-	p.GetInstallation()
+func TestPushEventRepository_GetHasWiki(tt *testing.T) {
+	var zeroValue bool
+	p := &PushEventRepository{HasWiki: &zeroValue}
+	p.GetHasWiki()
+	p = &PushEventRepository{}
+	p.GetHasWiki()
 	p = nil
-	p.GetInstallation()
+	p.GetHasWiki()
 }
 
-func TestProjectEvent_GetOrg(tt *testing.T) {
-	p := &ProjectEvent{}
-	p.GetOrg()
+func TestPushEventRepository_GetHomepage(tt *testing.T) {
+	var zeroValue string
+	p := &PushEventRepository{Homepage: &zeroValue}
+	p.GetHomepage()
+	p = &PushEventRepository{}
+	p.GetHomepage()
 	p = nil
-	p.GetOrg()
+	p.GetHomepage()
 }
 
-func TestProjectEvent_GetProject(tt *testing.T) {
-	p := &ProjectEvent{}
-	p.GetProject()
+func TestPushEventRepository_GetHTMLURL(tt *testing.T) {
+	var zeroValue string
+	p := &PushEventRepository{HTMLURL: &zeroValue}
+	p.GetHTMLURL()
+	p = &PushEventRepository{}
+	p.GetHTMLURL()
 	p = nil
-	p.GetProject()
+	p.GetHTMLURL()
 }
 
-func TestProjectEvent_GetRepo(tt *testing.T) {
-	p := &ProjectEvent{}
-	p.GetRepo()
+func TestPushEventRepository_GetID(tt *testing.T) {
+	var zeroValue int64
+	p := &PushEventRepository{ID: &zeroValue}
+	p.GetID()
+	p = &PushEventRepository{}
+	p.GetID()
 	p = nil
-	p.GetRepo()
+	p.GetID()
 }
 
-func TestProjectEvent_GetSender(tt *testing.T) {
-	p := &ProjectEvent{}
-	p.GetSender()
+func TestPushEventRepository_GetLanguage(tt *testing.T) {
+	var zeroValue string
+	p := &PushEventRepository{Language: &zeroValue}
+	p.GetLanguage()
+	p = &PushEventRepository{}
+	p.GetLanguage()
 	p = nil
-	p.GetSender()
+	p.GetLanguage()
 }
 
-func TestProjectOptions_GetBody(tt *testing.T) {
+func TestPushEventRepository_GetMasterBranch(tt *testing.T) {
 	var zeroValue string
-	p := &ProjectOptions{Body: &zeroValue}
-	p.GetBody()
-	p = &ProjectOptions{}
-	p.GetBody()
+	p := &PushEventRepository{MasterBranch: &zeroValue}
+	p.GetMasterBranch()
+	p = &PushEventRepository{}
+	p.GetMasterBranch()
 	p = nil
-	p.GetBody()
+	p.GetMasterBranch()
 }
 
-func TestProjectOptions_GetName(tt *testing.T) {
+func TestPushEventRepository_GetName(tt *testing.T) {
 	var zeroValue string
-	p := &ProjectOptions{Name: &zeroValue}
+	p := &PushEventRepository{Name: &zeroValue}
 	p.GetName()
-	p = &ProjectOptions{}
+	p = &PushEventRepository{}
 	p.GetName()
 	p = nil
 	p.GetName()
 }
 
-func TestProjectOptions_GetOrganizationPermission(tt *testing.T) {
+func TestPushEventRepository_GetNodeID(tt *testing.T) {
 	var zeroValue string
-	p := &ProjectOptions{OrganizationPermission: &zeroValue}
-	p.GetOrganizationPermission()
-	p = &ProjectOptions{}
-	p.GetOrganizationPermission()
+	p := &PushEventRepository{NodeID: &zeroValue}
+	p.GetNodeID()
+	p = &PushEventRepository{}
+	p.GetNodeID()
 	p = nil
-	p.GetOrganizationPermission()
+	p.GetNodeID()
 }
 
-func TestProjectOptions_GetPublic(tt *testing.T) {
-	var zeroValue bool
-	p := &ProjectOptions{Public: &zeroValue}
-	p.GetPublic()
-	p = &ProjectOptions{}
-	p.GetPublic()
+func TestPushEventRepository_GetOpenIssuesCount(tt *testing.T) {
+	var zeroValue int
+	p := &PushEventRepository{OpenIssuesCount: &zeroValue}
+	p.GetOpenIssuesCount()
+	p = &PushEventRepository{}
+	p.GetOpenIssuesCount()
 	p = nil
-	p.GetPublic()
+	p.GetOpenIssuesCount()
 }
 
-func TestProjectOptions_GetState(tt *testing.T) {
+func TestPushEventRepository_GetOrganization(tt *testing.T) {
 	var zeroValue string
-	p := &ProjectOptions{State: &zeroValue}
-	p.GetState()
-	p = &ProjectOptions{}
-	p.GetState()
+	p := &PushEventRepository{Organization: &zeroValue}
+	p.GetOrganization()
+	p = &PushEventRepository{}
+	p.GetOrganization()
 	p = nil
-	p.GetState()
+	p.GetOrganization()
 }
 
-func TestProjectPermissionLevel_GetPermission(tt *testing.T) {
+func TestPushEventRepository_GetOwner(tt *testing.T) {
+	p := &PushEventRepository{}
+	p.GetOwner()
+	p = nil
+	p.GetOwner()
+}
+
+func TestPushEventRepository_GetPrivate(tt *testing.T) {
+	var zeroValue bool
+	p := &PushEventRepository{Private: &zeroValue}
+	p.GetPrivate()
+	p = &PushEventRepository{}
+	p.GetPrivate()
+	p = nil
+	p.GetPrivate()
+}
+
+func TestPushEventRepository_GetPullsURL(tt *testing.T) {
 	var zeroValue string
-	p := &ProjectPermissionLevel{Permission: &zeroValue}
-	p.GetPermission()
-	p = &ProjectPermissionLevel{}
-	p.GetPermission()
+	p := &PushEventRepository{PullsURL: &zeroValue}
+	p.GetPullsURL()
+	p = &PushEventRepository{}
+	p.GetPullsURL()
 	p = nil
-	p.GetPermission()
+	p.GetPullsURL()
 }
 
-func TestProjectPermissionLevel_GetUser(tt *testing.T) {
-	p := &ProjectPermissionLevel{}
-	p.GetUser()
+func TestPushEventRepository_GetPushedAt(tt *testing.T) {
+	var zeroValue Timestamp
+	p := &PushEventRepository{PushedAt: &zeroValue}
+	p.GetPushedAt()
+	p = &PushEventRepository{}
+	p.GetPushedAt()
 	p = nil
-	p.GetUser()
+	p.GetPushedAt()
 }
 
-func TestProtection_GetAllowDeletions(tt *testing.T) {
-	p := &Protection{}
-	p.GetAllowDeletions()
+func TestPushEventRepository_GetSize(tt *testing.T) {
+	var zeroValue int
+	p := &PushEventRepository{Size: &zeroValue}
+	p.GetSize()
+	p = &PushEventRepository{}
+	p.GetSize()
 	p = nil
-	p.GetAllowDeletions()
+	p.GetSize()
 }
 
-func TestProtection_GetAllowForcePushes(tt *testing.T) {
-	p := &Protection{}
-	p.GetAllowForcePushes()
+func TestPushEventRepository_GetSSHURL(tt *testing.T) {
+	var zeroValue string
+	p := &PushEventRepository{SSHURL: &zeroValue}
+	p.GetSSHURL()
+	p = &PushEventRepository{}
+	p.GetSSHURL()
 	p = nil
-	p.GetAllowForcePushes()
+	p.GetSSHURL()
 }
 
-func TestProtection_GetEnforceAdmins(tt *testing.T) {
-	p := &Protection{}
-	p.GetEnforceAdmins()
+func TestPushEventRepository_GetStargazersCount(tt *testing.T) {
+	var zeroValue int
+	p := &PushEventRepository{StargazersCount: &zeroValue}
+	p.GetStargazersCount()
+	p = &PushEventRepository{}
+	p.GetStargazersCount()
 	p = nil
-	p.GetEnforceAdmins()
+	p.GetStargazersCount()
 }
 
-func TestProtection_GetRequiredPullRequestReviews(tt *testing.T) {
-	p := &Protection{}
-	p.GetRequiredPullRequestReviews()
+func TestPushEventRepository_GetStatusesURL(tt *testing.T) {
+	var zeroValue string
+	p := &PushEventRepository{StatusesURL: &zeroValue}
+	p.GetStatusesURL()
+	p = &PushEventRepository{}
+	p.GetStatusesURL()
 	p = nil
-	p.GetRequiredPullRequestReviews()
+	p.GetStatusesURL()
 }
 
-func TestProtection_GetRequiredStatusChecks(tt *testing.T) {
-	p := &Protection{}
-	p.GetRequiredStatusChecks()
+func TestPushEventRepository_GetSVNURL(tt *testing.T) {
+	var zeroValue string
+	p := &PushEventRepository{SVNURL: &zeroValue}
+	p.GetSVNURL()
+	p = &PushEventRepository{}
+	p.GetSVNURL()
 	p = nil
-	p.GetRequiredStatusChecks()
+	p.GetSVNURL()
 }
 
-func TestProtection_GetRequireLinearHistory(tt *testing.T) {
-	p := &Protection{}
-	p.GetRequireLinearHistory()
+func TestPushEventRepository_GetUpdatedAt(tt *testing.T) {
+	var zeroValue Timestamp
+	p := &PushEventRepository{UpdatedAt: &zeroValue}
+	p.GetUpdatedAt()
+	p = &PushEventRepository{}
+	p.GetUpdatedAt()
 	p = nil
-	p.GetRequireLinearHistory()
+	p.GetUpdatedAt()
 }
 
-func TestProtection_GetRestrictions(tt *testing.T) {
-	p := &Protection{}
-	p.GetRestrictions()
+func TestPushEventRepository_GetURL(tt *testing.T) {
+	var zeroValue string
+	p := &PushEventRepository{URL: &zeroValue}
+	p.GetURL()
+	p = &PushEventRepository{}
+	p.GetURL()
 	p = nil
-	p.GetRestrictions()
+	p.GetURL()
 }
 
-func TestProtectionRequest_GetAllowDeletions(tt *testing.T) {
-	var zeroValue bool
-	p := &ProtectionRequest{AllowDeletions: &zeroValue}
-	p.GetAllowDeletions()
-	p = &ProtectionRequest{}
-	p.GetAllowDeletions()
+func TestPushEventRepository_GetWatchersCount(tt *testing.T) {
+	var zeroValue int
+	p := &PushEventRepository{WatchersCount: &zeroValue}
+	p.GetWatchersCount()
+	p = &PushEventRepository{}
+	p.GetWatchersCount()
 	p = nil
-	p.GetAllowDeletions()
+	p.GetWatchersCount()
 }
 
-func TestProtectionRequest_GetAllowForcePushes(tt *testing.T) {
-	var zeroValue bool
-	p := &ProtectionRequest{AllowForcePushes: &zeroValue}
-	p.GetAllowForcePushes()
-	p = &ProtectionRequest{}
-	p.GetAllowForcePushes()
+func TestPushProtectionBypass_GetExpireAt(tt *testing.T) {
+	var zeroValue Timestamp
+	p := &PushProtectionBypass{ExpireAt: &zeroValue}
+	p.GetExpireAt()
+	p = &PushProtectionBypass{}
+	p.GetExpireAt()
 	p = nil
-	p.GetAllowForcePushes()
+	p.GetExpireAt()
 }
 
-func TestProtectionRequest_GetRequiredPullRequestReviews(tt *testing.T) {
-	p := &ProtectionRequest{}
-	p.GetRequiredPullRequestReviews()
+func TestPushProtectionBypass_GetReason(tt *testing.T) {
+	var zeroValue string
+	p := &PushProtectionBypass{Reason: &zeroValue}
+	p.GetReason()
+	p = &PushProtectionBypass{}
+	p.GetReason()
 	p = nil
-	p.GetRequiredPullRequestReviews()
+	p.GetReason()
 }
 
-func TestProtectionRequest_GetRequiredStatusChecks(tt *testing.T) {
-	p := &ProtectionRequest{}
-	p.GetRequiredStatusChecks()
+func TestPushProtectionBypass_GetTokenType(tt *testing.T) {
+	var zeroValue string
+	p := &PushProtectionBypass{TokenType: &zeroValue}
+	p.GetTokenType()
+	p = &PushProtectionBypass{}
+	p.GetTokenType()
 	p = nil
-	p.GetRequiredStatusChecks()
+	p.GetTokenType()
 }
 
-func TestProtectionRequest_GetRequireLinearHistory(tt *testing.T) {
-	var zeroValue bool
-	p := &ProtectionRequest{RequireLinearHistory: &zeroValue}
-	p.GetRequireLinearHistory()
-	p = &ProtectionRequest{}
-	p.GetRequireLinearHistory()
-	p = nil
-	p.GetRequireLinearHistory()
+func TestRateLimits_GetActionsRunnerRegistration(tt *testing.T) {
+	r := &RateLimits{}
+	r.GetActionsRunnerRegistration()
+	r = nil
+	r.GetActionsRunnerRegistration()
 }
 
-func TestProtectionRequest_GetRestrictions(tt *testing.T) {
-	p := &ProtectionRequest{}
-	p.GetRestrictions()
-	p = nil
-	p.GetRestrictions()
+func TestRateLimits_GetCodeScanningUpload(tt *testing.T) {
+	r := &RateLimits{}
+	r.GetCodeScanningUpload()
+	r = nil
+	r.GetCodeScanningUpload()
 }
 
-func TestPublicEvent_GetInstallation(tt *testing.T) {
-	p := &PublicEvent{}
-	p.GetInstallation()
-	p = nil
-	p.GetInstallation()
+func TestRateLimits_GetCore(tt *testing.T) {
+	r := &RateLimits{}
+	r.GetCore()
+	r = nil
+	r.GetCore()
 }
 
-func TestPublicEvent_GetRepo(tt *testing.T) {
-	p := &PublicEvent{}
-	p.GetRepo()
-	p = nil
-	p.GetRepo()
+func TestRateLimits_GetGraphQL(tt *testing.T) {
+	r := &RateLimits{}
+	r.GetGraphQL()
+	r = nil
+	r.GetGraphQL()
 }
 
-func TestPublicEvent_GetSender(tt *testing.T) {
-	p := &PublicEvent{}
-	p.GetSender()
-	p = nil
-	p.GetSender()
+func TestRateLimits_GetIntegrationManifest(tt *testing.T) {
+	r := &RateLimits{}
+	r.GetIntegrationManifest()
+	r = nil
+	r.GetIntegrationManifest()
 }
 
-func TestPublicKey_GetKey(tt *testing.T) {
-	var zeroValue string
-	p := &PublicKey{Key: &zeroValue}
-	p.GetKey()
-	p = &PublicKey{}
-	p.GetKey()
-	p = nil
-	p.GetKey()
+func TestRateLimits_GetSCIM(tt *testing.T) {
+	r := &RateLimits{}
+	r.GetSCIM()
+	r = nil
+	r.GetSCIM()
 }
 
-func TestPublicKey_GetKeyID(tt *testing.T) {
-	var zeroValue string
-	p := &PublicKey{KeyID: &zeroValue}
-	p.GetKeyID()
-	p = &PublicKey{}
-	p.GetKeyID()
-	p = nil
-	p.GetKeyID()
+func TestRateLimits_GetSearch(tt *testing.T) {
+	r := &RateLimits{}
+	r.GetSearch()
+	r = nil
+	r.GetSearch()
 }
 
-func TestPullRequest_GetActiveLockReason(tt *testing.T) {
-	var zeroValue string
-	p := &PullRequest{ActiveLockReason: &zeroValue}
-	p.GetActiveLockReason()
-	p = &PullRequest{}
-	p.GetActiveLockReason()
-	p = nil
-	p.GetActiveLockReason()
+func TestRateLimits_GetSourceImport(tt *testing.T) {
+	r := &RateLimits{}
+	r.GetSourceImport()
+	r = nil
+	r.GetSourceImport()
 }
 
-func TestPullRequest_GetAdditions(tt *testing.T) {
-	var zeroValue int
-	p := &PullRequest{Additions: &zeroValue}
-	p.GetAdditions()
-	p = &PullRequest{}
-	p.GetAdditions()
-	p = nil
-	p.GetAdditions()
+func TestReaction_GetContent(tt *testing.T) {
+	var zeroValue string
+	r := &Reaction{Content: &zeroValue}
+	r.GetContent()
+	r = &Reaction{}
+	r.GetContent()
+	r = nil
+	r.GetContent()
 }
 
-func TestPullRequest_GetAssignee(tt *testing.T) {
-	p := &PullRequest{}
-	p.GetAssignee()
-	p = nil
-	p.GetAssignee()
+func TestReaction_GetID(tt *testing.T) {
+	var zeroValue int64
+	r := &Reaction{ID: &zeroValue}
+	r.GetID()
+	r = &Reaction{}
+	r.GetID()
+	r = nil
+	r.GetID()
 }
 
-func TestPullRequest_GetAuthorAssociation(tt *testing.T) {
+func TestReaction_GetNodeID(tt *testing.T) {
 	var zeroValue string
-	p := &PullRequest{AuthorAssociation: &zeroValue}
-	p.GetAuthorAssociation()
-	p = &PullRequest{}
-	p.GetAuthorAssociation()
-	p = nil
-	p.GetAuthorAssociation()
+	r := &Reaction{NodeID: &zeroValue}
+	r.GetNodeID()
+	r = &Reaction{}
+	r.GetNodeID()
+	r = nil
+	r.GetNodeID()
 }
 
-func TestPullRequest_GetBase(tt *testing.T) {
-	p := &PullRequest{}
-	p.GetBase()
-	p = nil
-	p.GetBase()
+func TestReaction_GetUser(tt *testing.T) {
+	r := &Reaction{}
+	r.GetUser()
+	r = nil
+	r.GetUser()
 }
 
-func TestPullRequest_GetBody(tt *testing.T) {
-	var zeroValue string
-	p := &PullRequest{Body: &zeroValue}
-	p.GetBody()
-	p = &PullRequest{}
-	p.GetBody()
-	p = nil
-	p.GetBody()
+func TestReactions_GetConfused(tt *testing.T) {
+	var zeroValue int
+	r := &Reactions{Confused: &zeroValue}
+	r.GetConfused()
+	r = &Reactions{}
+	r.GetConfused()
+	r = nil
+	r.GetConfused()
 }
 
-func TestPullRequest_GetChangedFiles(tt *testing.T) {
+func TestReactions_GetEyes(tt *testing.T) {
 	var zeroValue int
-	p := &PullRequest{ChangedFiles: &zeroValue}
-	p.GetChangedFiles()
-	p = &PullRequest{}
-	p.GetChangedFiles()
-	p = nil
-	p.GetChangedFiles()
+	r := &Reactions{Eyes: &zeroValue}
+	r.GetEyes()
+	r = &Reactions{}
+	r.GetEyes()
+	r = nil
+	r.GetEyes()
 }
 
-func TestPullRequest_GetClosedAt(tt *testing.T) {
-	var zeroValue time.Time
-	p := &PullRequest{ClosedAt: &zeroValue}
-	p.GetClosedAt()
-	p = &PullRequest{}
-	p.GetClosedAt()
-	p = nil
-	p.GetClosedAt()
+func TestReactions_GetHeart(tt *testing.T) {
+	var zeroValue int
+	r := &Reactions{Heart: &zeroValue}
+	r.GetHeart()
+	r = &Reactions{}
+	r.GetHeart()
+	r = nil
+	r.GetHeart()
 }
 
-func TestPullRequest_GetComments(tt *testing.T) {
+func TestReactions_GetHooray(tt *testing.T) {
 	var zeroValue int
-	p := &PullRequest{Comments: &zeroValue}
-	p.GetComments()
-	p = &PullRequest{}
-	p.GetComments()
-	p = nil
-	p.GetComments()
+	r := &Reactions{Hooray: &zeroValue}
+	r.GetHooray()
+	r = &Reactions{}
+	r.GetHooray()
+	r = nil
+	r.GetHooray()
 }
 
-func TestPullRequest_GetCommentsURL(tt *testing.T) {
-	var zeroValue string
-	p := &PullRequest{CommentsURL: &zeroValue}
-	p.GetCommentsURL()
-	p = &PullRequest{}
-	p.GetCommentsURL()
-	p = nil
-	p.GetCommentsURL()
+func TestReactions_GetLaugh(tt *testing.T) {
+	var zeroValue int
+	r := &Reactions{Laugh: &zeroValue}
+	r.GetLaugh()
+	r = &Reactions{}
+	r.GetLaugh()
+	r = nil
+	r.GetLaugh()
 }
 
-func TestPullRequest_GetCommits(tt *testing.T) {
+func TestReactions_GetMinusOne(tt *testing.T) {
 	var zeroValue int
-	p := &PullRequest{Commits: &zeroValue}
-	p.GetCommits()
-	p = &PullRequest{}
-	p.GetCommits()
-	p = nil
-	p.GetCommits()
+	r := &Reactions{MinusOne: &zeroValue}
+	r.GetMinusOne()
+	r = &Reactions{}
+	r.GetMinusOne()
+	r = nil
+	r.GetMinusOne()
 }
 
-func TestPullRequest_GetCommitsURL(tt *testing.T) {
-	var zeroValue string
-	p := &PullRequest{CommitsURL: &zeroValue}
-	p.GetCommitsURL()
-	p = &PullRequest{}
-	p.GetCommitsURL()
-	p = nil
-	p.GetCommitsURL()
+func TestReactions_GetPlusOne(tt *testing.T) {
+	var zeroValue int
+	r := &Reactions{PlusOne: &zeroValue}
+	r.GetPlusOne()
+	r = &Reactions{}
+	r.GetPlusOne()
+	r = nil
+	r.GetPlusOne()
 }
 
-func TestPullRequest_GetCreatedAt(tt *testing.T) {
-	var zeroValue time.Time
-	p := &PullRequest{CreatedAt: &zeroValue}
-	p.GetCreatedAt()
-	p = &PullRequest{}
-	p.GetCreatedAt()
-	p = nil
-	p.GetCreatedAt()
+func TestReactions_GetRocket(tt *testing.T) {
+	var zeroValue int
+	r := &Reactions{Rocket: &zeroValue}
+	r.GetRocket()
+	r = &Reactions{}
+	r.GetRocket()
+	r = nil
+	r.GetRocket()
 }
 
-func TestPullRequest_GetDeletions(tt *testing.T) {
+func TestReactions_GetTotalCount(tt *testing.T) {
 	var zeroValue int
-	p := &PullRequest{Deletions: &zeroValue}
-	p.GetDeletions()
-	p = &PullRequest{}
-	p.GetDeletions()
-	p = nil
-	p.GetDeletions()
+	r := &Reactions{TotalCount: &zeroValue}
+	r.GetTotalCount()
+	r = &Reactions{}
+	r.GetTotalCount()
+	r = nil
+	r.GetTotalCount()
 }
 
-func TestPullRequest_GetDiffURL(tt *testing.T) {
+func TestReactions_GetURL(tt *testing.T) {
 	var zeroValue string
-	p := &PullRequest{DiffURL: &zeroValue}
-	p.GetDiffURL()
-	p = &PullRequest{}
-	p.GetDiffURL()
-	p = nil
-	p.GetDiffURL()
+	r := &Reactions{URL: &zeroValue}
+	r.GetURL()
+	r = &Reactions{}
+	r.GetURL()
+	r = nil
+	r.GetURL()
 }
 
-func TestPullRequest_GetDraft(tt *testing.T) {
-	var zeroValue bool
-	p := &PullRequest{Draft: &zeroValue}
-	p.GetDraft()
-	p = &PullRequest{}
-	p.GetDraft()
-	p = nil
-	p.GetDraft()
+func TestReference_GetNodeID(tt *testing.T) {
+	var zeroValue string
+	r := &Reference{NodeID: &zeroValue}
+	r.GetNodeID()
+	r = &Reference{}
+	r.GetNodeID()
+	r = nil
+	r.GetNodeID()
 }
 
-func TestPullRequest_GetHead(tt *testing.T) {
-	p := &PullRequest{}
-	p.GetHead()
-	p = nil
-	p.GetHead()
+func TestReference_GetObject(tt *testing.T) {
+	r := &Reference{}
+	r.GetObject()
+	r = nil
+	r.GetObject()
 }
 
-func TestPullRequest_GetHTMLURL(tt *testing.T) {
+func TestReference_GetRef(tt *testing.T) {
 	var zeroValue string
-	p := &PullRequest{HTMLURL: &zeroValue}
-	p.GetHTMLURL()
-	p = &PullRequest{}
-	p.GetHTMLURL()
-	p = nil
-	p.GetHTMLURL()
-}
-
-func TestPullRequest_GetID(tt *testing.T) {
-	var zeroValue int64
-	p := &PullRequest{ID: &zeroValue}
-	p.GetID()
-	p = &PullRequest{}
-	p.GetID()
-	p = nil
-	p.GetID()
+	r := &Reference{Ref: &zeroValue}
+	r.GetRef()
+	r = &Reference{}
+	r.GetRef()
+	r = nil
+	r.GetRef()
 }
 
-func TestPullRequest_GetIssueURL(tt *testing.T) {
+func TestReference_GetURL(tt *testing.T) {
 	var zeroValue string
-	p := &PullRequest{IssueURL: &zeroValue}
-	p.GetIssueURL()
-	p = &PullRequest{}
-	p.GetIssueURL()
-	p = nil
-	p.GetIssueURL()
+	r := &Reference{URL: &zeroValue}
+	r.GetURL()
+	r = &Reference{}
+	r.GetURL()
+	r = nil
+	r.GetURL()
 }
 
-func TestPullRequest_GetLinks(tt *testing.T) {
-	p := &PullRequest{}
-	p.GetLinks()
-	p = nil
-	p.GetLinks()
+func TestRefUpdateResult_GetRef(tt *testing.T) {
+	r := &RefUpdateResult{}
+	r.GetRef()
+	r = nil
+	r.GetRef()
 }
 
-func TestPullRequest_GetLocked(tt *testing.T) {
-	var zeroValue bool
-	p := &PullRequest{Locked: &zeroValue}
-	p.GetLocked()
-	p = &PullRequest{}
-	p.GetLocked()
-	p = nil
-	p.GetLocked()
+func TestRefUpdateResult_GetUpdate(tt *testing.T) {
+	r := &RefUpdateResult{}
+	r.GetUpdate()
+	r = nil
+	r.GetUpdate()
 }
 
-func TestPullRequest_GetMaintainerCanModify(tt *testing.T) {
-	var zeroValue bool
-	p := &PullRequest{MaintainerCanModify: &zeroValue}
-	p.GetMaintainerCanModify()
-	p = &PullRequest{}
-	p.GetMaintainerCanModify()
-	p = nil
-	p.GetMaintainerCanModify()
+func TestRegistrationToken_GetExpiresAt(tt *testing.T) {
+	var zeroValue Timestamp
+	r := &RegistrationToken{ExpiresAt: &zeroValue}
+	r.GetExpiresAt()
+	r = &RegistrationToken{}
+	r.GetExpiresAt()
+	r = nil
+	r.GetExpiresAt()
 }
 
-func TestPullRequest_GetMergeable(tt *testing.T) {
-	var zeroValue bool
-	p := &PullRequest{Mergeable: &zeroValue}
-	p.GetMergeable()
-	p = &PullRequest{}
-	p.GetMergeable()
-	p = nil
-	p.GetMergeable()
+func TestRegistrationToken_GetToken(tt *testing.T) {
+	var zeroValue string
+	r := &RegistrationToken{Token: &zeroValue}
+	r.GetToken()
+	r = &RegistrationToken{}
+	r.GetToken()
+	r = nil
+	r.GetToken()
 }
 
-func TestPullRequest_GetMergeableState(tt *testing.T) {
+func TestReleaseAsset_GetBrowserDownloadURL(tt *testing.T) {
 	var zeroValue string
-	p := &PullRequest{MergeableState: &zeroValue}
-	p.GetMergeableState()
-	p = &PullRequest{}
-	p.GetMergeableState()
-	p = nil
-	p.GetMergeableState()
+	r := &ReleaseAsset{BrowserDownloadURL: &zeroValue}
+	r.GetBrowserDownloadURL()
+	r = &ReleaseAsset{}
+	r.GetBrowserDownloadURL()
+	r = nil
+	r.GetBrowserDownloadURL()
 }
 
-func TestPullRequest_GetMergeCommitSHA(tt *testing.T) {
+func TestReleaseAsset_GetContentType(tt *testing.T) {
 	var zeroValue string
-	p := &PullRequest{MergeCommitSHA: &zeroValue}
-	p.GetMergeCommitSHA()
-	p = &PullRequest{}
-	p.GetMergeCommitSHA()
-	p = nil
-	p.GetMergeCommitSHA()
+	r := &ReleaseAsset{ContentType: &zeroValue}
+	r.GetContentType()
+	r = &ReleaseAsset{}
+	r.GetContentType()
+	r = nil
+	r.GetContentType()
+}
+
+func TestReleaseAsset_GetCreatedAt(tt *testing.T) {
+	var zeroValue Timestamp
+	r := &ReleaseAsset{CreatedAt: &zeroValue}
+	r.GetCreatedAt()
+	r = &ReleaseAsset{}
+	r.GetCreatedAt()
+	r = nil
+	r.GetCreatedAt()
 }
 
-func TestPullRequest_GetMerged(tt *testing.T) {
-	var zeroValue bool
-	p := &PullRequest{Merged: &zeroValue}
-	p.GetMerged()
-	p = &PullRequest{}
-	p.GetMerged()
-	p = nil
-	p.GetMerged()
+func TestReleaseAsset_GetDownloadCount(tt *testing.T) {
+	var zeroValue int
+	r := &ReleaseAsset{DownloadCount: &zeroValue}
+	r.GetDownloadCount()
+	r = &ReleaseAsset{}
+	r.GetDownloadCount()
+	r = nil
+	r.GetDownloadCount()
 }
 
-func TestPullRequest_GetMergedAt(tt *testing.T) {
-	var zeroValue time.Time
-	p := &PullRequest{MergedAt: &zeroValue}
-	p.GetMergedAt()
-	p = &PullRequest{}
-	p.GetMergedAt()
-	p = nil
-	p.GetMergedAt()
+func TestReleaseAsset_GetID(tt *testing.T) {
+	var zeroValue int64
+	r := &ReleaseAsset{ID: &zeroValue}
+	r.GetID()
+	r = &ReleaseAsset{}
+	r.GetID()
+	r = nil
+	r.GetID()
 }
 
-func TestPullRequest_GetMergedBy(tt *testing.T) {
-	p := &PullRequest{}
-	p.GetMergedBy()
-	p = nil
-	p.GetMergedBy()
+func TestReleaseAsset_GetLabel(tt *testing.T) {
+	var zeroValue string
+	r := &ReleaseAsset{Label: &zeroValue}
+	r.GetLabel()
+	r = &ReleaseAsset{}
+	r.GetLabel()
+	r = nil
+	r.GetLabel()
 }
 
-func TestPullRequest_GetMilestone(tt *testing.T) {
-	p := &PullRequest{}
-	p.GetMilestone()
-	p = nil
-	p.GetMilestone()
+func TestReleaseAsset_GetName(tt *testing.T) {
+	var zeroValue string
+	r := &ReleaseAsset{Name: &zeroValue}
+	r.GetName()
+	r = &ReleaseAsset{}
+	r.GetName()
+	r = nil
+	r.GetName()
 }
 
-func TestPullRequest_GetNodeID(tt *testing.T) {
+func TestReleaseAsset_GetNodeID(tt *testing.T) {
 	var zeroValue string
-	p := &PullRequest{NodeID: &zeroValue}
-	p.GetNodeID()
-	p = &PullRequest{}
-	p.GetNodeID()
-	p = nil
-	p.GetNodeID()
+	r := &ReleaseAsset{NodeID: &zeroValue}
+	r.GetNodeID()
+	r = &ReleaseAsset{}
+	r.GetNodeID()
+	r = nil
+	r.GetNodeID()
 }
 
-func TestPullRequest_GetNumber(tt *testing.T) {
+func TestReleaseAsset_GetSize(tt *testing.T) {
 	var zeroValue int
-	p := &PullRequest{Number: &zeroValue}
-	p.GetNumber()
-	p = &PullRequest{}
-	p.GetNumber()
-	p = nil
-	p.GetNumber()
+	r := &ReleaseAsset{Size: &zeroValue}
+	r.GetSize()
+	r = &ReleaseAsset{}
+	r.GetSize()
+	r = nil
+	r.GetSize()
 }
 
-func TestPullRequest_GetPatchURL(tt *testing.T) {
+func TestReleaseAsset_GetState(tt *testing.T) {
 	var zeroValue string
-	p := &PullRequest{PatchURL: &zeroValue}
-	p.GetPatchURL()
-	p = &PullRequest{}
-	p.GetPatchURL()
-	p = nil
-	p.GetPatchURL()
+	r := &ReleaseAsset{State: &zeroValue}
+	r.GetState()
+	r = &ReleaseAsset{}
+	r.GetState()
+	r = nil
+	r.GetState()
 }
 
-func TestPullRequest_GetRebaseable(tt *testing.T) {
-	var zeroValue bool
-	p := &PullRequest{Rebaseable: &zeroValue}
-	p.GetRebaseable()
-	p = &PullRequest{}
-	p.GetRebaseable()
-	p = nil
-	p.GetRebaseable()
+func TestReleaseAsset_GetUpdatedAt(tt *testing.T) {
+	var zeroValue Timestamp
+	r := &ReleaseAsset{UpdatedAt: &zeroValue}
+	r.GetUpdatedAt()
+	r = &ReleaseAsset{}
+	r.GetUpdatedAt()
+	r = nil
+	r.GetUpdatedAt()
 }
 
-func TestPullRequest_GetReviewComments(tt *testing.T) {
-	var zeroValue int
-	p := &PullRequest{ReviewComments: &zeroValue}
-	p.GetReviewComments()
-	p = &PullRequest{}
-	p.GetReviewComments()
-	p = nil
-	p.GetReviewComments()
+func TestReleaseAsset_GetUploader(tt *testing.T) {
+	r := &ReleaseAsset{}
+	r.GetUploader()
+	r = nil
+	r.GetUploader()
 }
 
-func TestPullRequest_GetReviewCommentsURL(tt *testing.T) {
+func TestReleaseAsset_GetURL(tt *testing.T) {
 	var zeroValue string
-	p := &PullRequest{ReviewCommentsURL: &zeroValue}
-	p.GetReviewCommentsURL()
-	p = &PullRequest{}
-	p.GetReviewCommentsURL()
-	p = nil
-	p.GetReviewCommentsURL()
+	r := &ReleaseAsset{URL: &zeroValue}
+	r.GetURL()
+	r = &ReleaseAsset{}
+	r.GetURL()
+	r = nil
+	r.GetURL()
 }
 
-func TestPullRequest_GetReviewCommentURL(tt *testing.T) {
+func TestReleaseEvent_GetAction(tt *testing.T) {
 	var zeroValue string
-	p := &PullRequest{ReviewCommentURL: &zeroValue}
-	p.GetReviewCommentURL()
-	p = &PullRequest{}
-	p.GetReviewCommentURL()
-	p = nil
-	p.GetReviewCommentURL()
+	r := &ReleaseEvent{Action: &zeroValue}
+	r.GetAction()
+	r = &ReleaseEvent{}
+	r.GetAction()
+	r = nil
+	r.GetAction()
 }
 
-func TestPullRequest_GetState(tt *testing.T) {
-	var zeroValue string
-	p := &PullRequest{State: &zeroValue}
-	p.GetState()
-	p = &PullRequest{}
-	p.GetState()
-	p = nil
-	p.GetState()
+func TestReleaseEvent_GetInstallation(tt *testing.T) {
+	r := &ReleaseEvent{}
+	r.GetInstallation()
+	r = nil
+	r.GetInstallation()
 }
 
-func TestPullRequest_GetStatusesURL(tt *testing.T) {
-	var zeroValue string
-	p := &PullRequest{StatusesURL: &zeroValue}
-	p.GetStatusesURL()
-	p = &PullRequest{}
-	p.GetStatusesURL()
-	p = nil
-	p.GetStatusesURL()
+func TestReleaseEvent_GetRelease(tt *testing.T) {
+	r := &ReleaseEvent{}
+	r.GetRelease()
+	r = nil
+	r.GetRelease()
 }
 
-func TestPullRequest_GetTitle(tt *testing.T) {
-	var zeroValue string
-	p := &PullRequest{Title: &zeroValue}
-	p.GetTitle()
-	p = &PullRequest{}
-	p.GetTitle()
-	p = nil
-	p.GetTitle()
+func TestReleaseEvent_GetRepo(tt *testing.T) {
+	r := &ReleaseEvent{}
+	r.GetRepo()
+	r = nil
+	r.GetRepo()
 }
 
-func TestPullRequest_GetUpdatedAt(tt *testing.T) {
-	var zeroValue time.Time
-	p := &PullRequest{UpdatedAt: &zeroValue}
-	p.GetUpdatedAt()
-	p = &PullRequest{}
-	p.GetUpdatedAt()
-	p = nil
-	p.GetUpdatedAt()
+func TestReleaseEvent_GetSender(tt *testing.T) {
+	r := &ReleaseEvent{}
+	r.GetSender()
+	r = nil
+	r.GetSender()
 }
 
-func TestPullRequest_GetURL(tt *testing.T) {
+func TestRemoveToken_GetExpiresAt(tt *testing.T) {
+	var zeroValue Timestamp
+	r := &RemoveToken{ExpiresAt: &zeroValue}
+	r.GetExpiresAt()
+	r = &RemoveToken{}
+	r.GetExpiresAt()
+	r = nil
+	r.GetExpiresAt()
+}
+
+func TestRemoveToken_GetToken(tt *testing.T) {
 	var zeroValue string
-	p := &PullRequest{URL: &zeroValue}
-	p.GetURL()
-	p = &PullRequest{}
-	p.GetURL()
-	p = nil
-	p.GetURL()
+	r := &RemoveToken{Token: &zeroValue}
+	r.GetToken()
+	r = &RemoveToken{}
+	r.GetToken()
+	r = nil
+	r.GetToken()
 }
 
-func TestPullRequest_GetUser(tt *testing.T) {
-	p := &PullRequest{}
-	p.GetUser()
-	p = nil
-	p.GetUser()
+func TestRename_GetFrom(tt *testing.T) {
+	var zeroValue string
+	r := &Rename{From: &zeroValue}
+	r.GetFrom()
+	r = &Rename{}
+	r.GetFrom()
+	r = nil
+	r.GetFrom()
+}
+
+func TestRename_GetTo(tt *testing.T) {
+	var zeroValue string
+	r := &Rename{To: &zeroValue}
+	r.GetTo()
+	r = &Rename{}
+	r.GetTo()
+	r = nil
+	r.GetTo()
 }
 
-func TestPullRequestBranch_GetLabel(tt *testing.T) {
+func TestRenameOrgResponse_GetMessage(tt *testing.T) {
 	var zeroValue string
-	p := &PullRequestBranch{Label: &zeroValue}
-	p.GetLabel()
-	p = &PullRequestBranch{}
-	p.GetLabel()
-	p = nil
-	p.GetLabel()
+	r := &RenameOrgResponse{Message: &zeroValue}
+	r.GetMessage()
+	r = &RenameOrgResponse{}
+	r.GetMessage()
+	r = nil
+	r.GetMessage()
 }
 
-func TestPullRequestBranch_GetRef(tt *testing.T) {
+func TestRenameOrgResponse_GetURL(tt *testing.T) {
 	var zeroValue string
-	p := &PullRequestBranch{Ref: &zeroValue}
-	p.GetRef()
-	p = &PullRequestBranch{}
-	p.GetRef()
-	p = nil
-	p.GetRef()
+	r := &RenameOrgResponse{URL: &zeroValue}
+	r.GetURL()
+	r = &RenameOrgResponse{}
+	r.GetURL()
+	r = nil
+	r.GetURL()
 }
 
-func TestPullRequestBranch_GetRepo(tt *testing.T) {
-	p := &PullRequestBranch{}
-	p.GetRepo()
-	p = nil
-	p.GetRepo()
+func TestRepoAdvisoryCredit_GetLogin(tt *testing.T) {
+	var zeroValue string
+	r := &RepoAdvisoryCredit{Login: &zeroValue}
+	r.GetLogin()
+	r = &RepoAdvisoryCredit{}
+	r.GetLogin()
+	r = nil
+	r.GetLogin()
 }
 
-func TestPullRequestBranch_GetSHA(tt *testing.T) {
+func TestRepoAdvisoryCredit_GetType(tt *testing.T) {
 	var zeroValue string
-	p := &PullRequestBranch{SHA: &zeroValue}
-	p.GetSHA()
-	p = &PullRequestBranch{}
-	p.GetSHA()
-	p = nil
-	p.GetSHA()
+	r := &RepoAdvisoryCredit{Type: &zeroValue}
+	r.GetType()
+	r = &RepoAdvisoryCredit{}
+	r.GetType()
+	r = nil
+	r.GetType()
 }
 
-func TestPullRequestBranch_GetUser(tt *testing.T) {
-	p := &PullRequestBranch{}
-	p.GetUser()
-	p = nil
-	p.GetUser()
+func TestRepoAdvisoryCreditDetailed_GetState(tt *testing.T) {
+	var zeroValue string
+	r := &RepoAdvisoryCreditDetailed{State: &zeroValue}
+	r.GetState()
+	r = &RepoAdvisoryCreditDetailed{}
+	r.GetState()
+	r = nil
+	r.GetState()
 }
 
-func TestPullRequestBranchUpdateOptions_GetExpectedHeadSHA(tt *testing.T) {
+func TestRepoAdvisoryCreditDetailed_GetType(tt *testing.T) {
 	var zeroValue string
-	p := &PullRequestBranchUpdateOptions{ExpectedHeadSHA: &zeroValue}
-	p.GetExpectedHeadSHA()
-	p = &PullRequestBranchUpdateOptions{}
-	p.GetExpectedHeadSHA()
-	p = nil
-	p.GetExpectedHeadSHA()
+	r := &RepoAdvisoryCreditDetailed{Type: &zeroValue}
+	r.GetType()
+	r = &RepoAdvisoryCreditDetailed{}
+	r.GetType()
+	r = nil
+	r.GetType()
 }
 
-func TestPullRequestBranchUpdateResponse_GetMessage(tt *testing.T) {
-	var zeroValue string
-	p := &PullRequestBranchUpdateResponse{Message: &zeroValue}
-	p.GetMessage()
-	p = &PullRequestBranchUpdateResponse{}
-	p.GetMessage()
-	p = nil
-	p.GetMessage()
+func TestRepoAdvisoryCreditDetailed_GetUser(tt *testing.T) {
+	r := &RepoAdvisoryCreditDetailed{}
+	r.GetUser()
+	r = nil
+	r.GetUser()
 }
 
-func TestPullRequestBranchUpdateResponse_GetURL(tt *testing.T) {
+func TestRepoCustomPropertyValue_GetRepositoryFullName(tt *testing.T) {
 	var zeroValue string
-	p := &PullRequestBranchUpdateResponse{URL: &zeroValue}
-	p.GetURL()
-	p = &PullRequestBranchUpdateResponse{}
-	p.GetURL()
-	p = nil
-	p.GetURL()
+	r := &RepoCustomPropertyValue{RepositoryFullName: &zeroValue}
+	r.GetRepositoryFullName()
+	r = &RepoCustomPropertyValue{}
+	r.GetRepositoryFullName()
+	r = nil
+	r.GetRepositoryFullName()
 }
 
-func TestPullRequestComment_GetAuthorAssociation(tt *testing.T) {
-	var zeroValue string
-	p := &PullRequestComment{AuthorAssociation: &zeroValue}
-	p.GetAuthorAssociation()
-	p = &PullRequestComment{}
-	p.GetAuthorAssociation()
-	p = nil
-	p.GetAuthorAssociation()
+func TestRepoCustomPropertyValue_GetRepositoryID(tt *testing.T) {
+	var zeroValue int64
+	r := &RepoCustomPropertyValue{RepositoryID: &zeroValue}
+	r.GetRepositoryID()
+	r = &RepoCustomPropertyValue{}
+	r.GetRepositoryID()
+	r = nil
+	r.GetRepositoryID()
 }
 
-func TestPullRequestComment_GetBody(tt *testing.T) {
+func TestRepoCustomPropertyValue_GetRepositoryName(tt *testing.T) {
 	var zeroValue string
-	p := &PullRequestComment{Body: &zeroValue}
-	p.GetBody()
-	p = &PullRequestComment{}
-	p.GetBody()
-	p = nil
-	p.GetBody()
+	r := &RepoCustomPropertyValue{RepositoryName: &zeroValue}
+	r.GetRepositoryName()
+	r = &RepoCustomPropertyValue{}
+	r.GetRepositoryName()
+	r = nil
+	r.GetRepositoryName()
 }
 
-func TestPullRequestComment_GetCommitID(tt *testing.T) {
+func TestRepoDependencies_GetCopyrightText(tt *testing.T) {
 	var zeroValue string
-	p := &PullRequestComment{CommitID: &zeroValue}
-	p.GetCommitID()
-	p = &PullRequestComment{}
-	p.GetCommitID()
-	p = nil
-	p.GetCommitID()
+	r := &RepoDependencies{CopyrightText: &zeroValue}
+	r.GetCopyrightText()
+	r = &RepoDependencies{}
+	r.GetCopyrightText()
+	r = nil
+	r.GetCopyrightText()
 }
 
-func TestPullRequestComment_GetCreatedAt(tt *testing.T) {
-	var zeroValue time.Time
-	p := &PullRequestComment{CreatedAt: &zeroValue}
-	p.GetCreatedAt()
-	p = &PullRequestComment{}
-	p.GetCreatedAt()
-	p = nil
-	p.GetCreatedAt()
+func TestRepoDependencies_GetDownloadLocation(tt *testing.T) {
+	var zeroValue string
+	r := &RepoDependencies{DownloadLocation: &zeroValue}
+	r.GetDownloadLocation()
+	r = &RepoDependencies{}
+	r.GetDownloadLocation()
+	r = nil
+	r.GetDownloadLocation()
 }
 
-func TestPullRequestComment_GetDiffHunk(tt *testing.T) {
-	var zeroValue string
-	p := &PullRequestComment{DiffHunk: &zeroValue}
-	p.GetDiffHunk()
-	p = &PullRequestComment{}
-	p.GetDiffHunk()
-	p = nil
-	p.GetDiffHunk()
+func TestRepoDependencies_GetFilesAnalyzed(tt *testing.T) {
+	var zeroValue bool
+	r := &RepoDependencies{FilesAnalyzed: &zeroValue}
+	r.GetFilesAnalyzed()
+	r = &RepoDependencies{}
+	r.GetFilesAnalyzed()
+	r = nil
+	r.GetFilesAnalyzed()
 }
 
-func TestPullRequestComment_GetHTMLURL(tt *testing.T) {
+func TestRepoDependencies_GetLicenseConcluded(tt *testing.T) {
 	var zeroValue string
-	p := &PullRequestComment{HTMLURL: &zeroValue}
-	p.GetHTMLURL()
-	p = &PullRequestComment{}
-	p.GetHTMLURL()
-	p = nil
-	p.GetHTMLURL()
+	r := &RepoDependencies{LicenseConcluded: &zeroValue}
+	r.GetLicenseConcluded()
+	r = &RepoDependencies{}
+	r.GetLicenseConcluded()
+	r = nil
+	r.GetLicenseConcluded()
 }
 
-func TestPullRequestComment_GetID(tt *testing.T) {
-	var zeroValue int64
-	p := &PullRequestComment{ID: &zeroValue}
-	p.GetID()
-	p = &PullRequestComment{}
-	p.GetID()
-	p = nil
-	p.GetID()
+func TestRepoDependencies_GetLicenseDeclared(tt *testing.T) {
+	var zeroValue string
+	r := &RepoDependencies{LicenseDeclared: &zeroValue}
+	r.GetLicenseDeclared()
+	r = &RepoDependencies{}
+	r.GetLicenseDeclared()
+	r = nil
+	r.GetLicenseDeclared()
 }
 
-func TestPullRequestComment_GetInReplyTo(tt *testing.T) {
-	var zeroValue int64
-	p := &PullRequestComment{InReplyTo: &zeroValue}
-	p.GetInReplyTo()
-	p = &PullRequestComment{}
-	p.GetInReplyTo()
-	p = nil
-	p.GetInReplyTo()
+func TestRepoDependencies_GetName(tt *testing.T) {
+	var zeroValue string
+	r := &RepoDependencies{Name: &zeroValue}
+	r.GetName()
+	r = &RepoDependencies{}
+	r.GetName()
+	r = nil
+	r.GetName()
 }
 
-func TestPullRequestComment_GetLine(tt *testing.T) {
-	var zeroValue int
-	p := &PullRequestComment{Line: &zeroValue}
-	p.GetLine()
-	p = &PullRequestComment{}
-	p.GetLine()
-	p = nil
-	p.GetLine()
+func TestRepoDependencies_GetSPDXID(tt *testing.T) {
+	var zeroValue string
+	r := &RepoDependencies{SPDXID: &zeroValue}
+	r.GetSPDXID()
+	r = &RepoDependencies{}
+	r.GetSPDXID()
+	r = nil
+	r.GetSPDXID()
 }
 
-func TestPullRequestComment_GetNodeID(tt *testing.T) {
+func TestRepoDependencies_GetVersionInfo(tt *testing.T) {
 	var zeroValue string
-	p := &PullRequestComment{NodeID: &zeroValue}
-	p.GetNodeID()
-	p = &PullRequestComment{}
-	p.GetNodeID()
-	p = nil
-	p.GetNodeID()
+	r := &RepoDependencies{VersionInfo: &zeroValue}
+	r.GetVersionInfo()
+	r = &RepoDependencies{}
+	r.GetVersionInfo()
+	r = nil
+	r.GetVersionInfo()
 }
 
-func TestPullRequestComment_GetOriginalCommitID(tt *testing.T) {
-	var zeroValue string
-	p := &PullRequestComment{OriginalCommitID: &zeroValue}
-	p.GetOriginalCommitID()
-	p = &PullRequestComment{}
-	p.GetOriginalCommitID()
-	p = nil
-	p.GetOriginalCommitID()
+func TestRepositoriesSearchResult_GetIncompleteResults(tt *testing.T) {
+	var zeroValue bool
+	r := &RepositoriesSearchResult{IncompleteResults: &zeroValue}
+	r.GetIncompleteResults()
+	r = &RepositoriesSearchResult{}
+	r.GetIncompleteResults()
+	r = nil
+	r.GetIncompleteResults()
 }
 
-func TestPullRequestComment_GetOriginalLine(tt *testing.T) {
+func TestRepositoriesSearchResult_GetTotal(tt *testing.T) {
 	var zeroValue int
-	p := &PullRequestComment{OriginalLine: &zeroValue}
-	p.GetOriginalLine()
-	p = &PullRequestComment{}
-	p.GetOriginalLine()
-	p = nil
-	p.GetOriginalLine()
+	r := &RepositoriesSearchResult{Total: &zeroValue}
+	r.GetTotal()
+	r = &RepositoriesSearchResult{}
+	r.GetTotal()
+	r = nil
+	r.GetTotal()
 }
 
-func TestPullRequestComment_GetOriginalPosition(tt *testing.T) {
-	var zeroValue int
-	p := &PullRequestComment{OriginalPosition: &zeroValue}
-	p.GetOriginalPosition()
-	p = &PullRequestComment{}
-	p.GetOriginalPosition()
-	p = nil
-	p.GetOriginalPosition()
+func TestRepository_GetAllowMergeCommit(tt *testing.T) {
+	var zeroValue bool
+	r := &Repository{AllowMergeCommit: &zeroValue}
+	r.GetAllowMergeCommit()
+	r = &Repository{}
+	r.GetAllowMergeCommit()
+	r = nil
+	r.GetAllowMergeCommit()
 }
 
-func TestPullRequestComment_GetOriginalStartLine(tt *testing.T) {
-	var zeroValue int
-	p := &PullRequestComment{OriginalStartLine: &zeroValue}
-	p.GetOriginalStartLine()
-	p = &PullRequestComment{}
-	p.GetOriginalStartLine()
-	p = nil
-	p.GetOriginalStartLine()
+func TestRepository_GetAllowRebaseMerge(tt *testing.T) {
+	var zeroValue bool
+	r := &Repository{AllowRebaseMerge: &zeroValue}
+	r.GetAllowRebaseMerge()
+	r = &Repository{}
+	r.GetAllowRebaseMerge()
+	r = nil
+	r.GetAllowRebaseMerge()
+}
+
+func TestRepository_GetAllowSquashMerge(tt *testing.T) {
+	var zeroValue bool
+	r := &Repository{AllowSquashMerge: &zeroValue}
+	r.GetAllowSquashMerge()
+	r = &Repository{}
+	r.GetAllowSquashMerge()
+	r = nil
+	r.GetAllowSquashMerge()
 }
 
-func TestPullRequestComment_GetPath(tt *testing.T) {
+func TestRepository_GetArchived(tt *testing.T) {
+	var zeroValue bool
+	r := &Repository{Archived: &zeroValue}
+	r.GetArchived()
+	r = &Repository{}
+	r.GetArchived()
+	r = nil
+	r.GetArchived()
+}
+
+func TestRepository_GetArchiveURL(tt *testing.T) {
 	var zeroValue string
-	p := &PullRequestComment{Path: &zeroValue}
-	p.GetPath()
-	p = &PullRequestComment{}
-	p.GetPath()
-	p = nil
-	p.GetPath()
+	r := &Repository{ArchiveURL: &zeroValue}
+	r.GetArchiveURL()
+	r = &Repository{}
+	r.GetArchiveURL()
+	r = nil
+	r.GetArchiveURL()
 }
 
-func TestPullRequestComment_GetPosition(tt *testing.T) {
-	var zeroValue int
-	p := &PullRequestComment{Position: &zeroValue}
-	p.GetPosition()
-	p = &PullRequestComment{}
-	p.GetPosition()
-	p = nil
-	p.GetPosition()
+func TestRepository_GetAssigneesURL(tt *testing.T) {
+	var zeroValue string
+	r := &Repository{AssigneesURL: &zeroValue}
+	r.GetAssigneesURL()
+	r = &Repository{}
+	r.GetAssigneesURL()
+	r = nil
+	r.GetAssigneesURL()
 }
 
-func TestPullRequestComment_GetPullRequestReviewID(tt *testing.T) {
-	var zeroValue int64
-	p := &PullRequestComment{PullRequestReviewID: &zeroValue}
-	p.GetPullRequestReviewID()
-	p = &PullRequestComment{}
-	p.GetPullRequestReviewID()
-	p = nil
-	p.GetPullRequestReviewID()
+func TestRepository_GetAutoInit(tt *testing.T) {
+	var zeroValue bool
+	r := &Repository{AutoInit: &zeroValue}
+	r.GetAutoInit()
+	r = &Repository{}
+	r.GetAutoInit()
+	r = nil
+	r.GetAutoInit()
 }
 
-func TestPullRequestComment_GetPullRequestURL(tt *testing.T) {
+func TestRepository_GetBlobsURL(tt *testing.T) {
 	var zeroValue string
-	p := &PullRequestComment{PullRequestURL: &zeroValue}
-	p.GetPullRequestURL()
-	p = &PullRequestComment{}
-	p.GetPullRequestURL()
-	p = nil
-	p.GetPullRequestURL()
+	r := &Repository{BlobsURL: &zeroValue}
+	r.GetBlobsURL()
+	r = &Repository{}
+	r.GetBlobsURL()
+	r = nil
+	r.GetBlobsURL()
 }
 
-func TestPullRequestComment_GetReactions(tt *testing.T) {
-	p := &PullRequestComment{}
-	p.GetReactions()
-	p = nil
-	p.GetReactions()
+func TestRepository_GetBranchesURL(tt *testing.T) {
+	var zeroValue string
+	r := &Repository{BranchesURL: &zeroValue}
+	r.GetBranchesURL()
+	r = &Repository{}
+	r.GetBranchesURL()
+	r = nil
+	r.GetBranchesURL()
 }
 
-func TestPullRequestComment_GetSide(tt *testing.T) {
+func TestRepository_GetCloneURL(tt *testing.T) {
 	var zeroValue string
-	p := &PullRequestComment{Side: &zeroValue}
-	p.GetSide()
-	p = &PullRequestComment{}
-	p.GetSide()
-	p = nil
-	p.GetSide()
+	r := &Repository{CloneURL: &zeroValue}
+	r.GetCloneURL()
+	r = &Repository{}
+	r.GetCloneURL()
+	r = nil
+	r.GetCloneURL()
 }
 
-func TestPullRequestComment_GetStartLine(tt *testing.T) {
-	var zeroValue int
-	p := &PullRequestComment{StartLine: &zeroValue}
-	p.GetStartLine()
-	p = &PullRequestComment{}
-	p.GetStartLine()
-	p = nil
-	p.GetStartLine()
+func TestRepository_GetCodeOfConduct(tt *testing.T) {
+	r := &Repository{}
+	r.GetCodeOfConduct()
+	r = nil
+	r.GetCodeOfConduct()
 }
 
-func TestPullRequestComment_GetStartSide(tt *testing.T) {
+func TestRepository_GetCollaboratorsURL(tt *testing.T) {
 	var zeroValue string
-	p := &PullRequestComment{StartSide: &zeroValue}
-	p.GetStartSide()
-	p = &PullRequestComment{}
-	p.GetStartSide()
-	p = nil
-	p.GetStartSide()
+	r := &Repository{CollaboratorsURL: &zeroValue}
+	r.GetCollaboratorsURL()
+	r = &Repository{}
+	r.GetCollaboratorsURL()
+	r = nil
+	r.GetCollaboratorsURL()
 }
 
-func TestPullRequestComment_GetUpdatedAt(tt *testing.T) {
-	var zeroValue time.Time
-	p := &PullRequestComment{UpdatedAt: &zeroValue}
-	p.GetUpdatedAt()
-	p = &PullRequestComment{}
-	p.GetUpdatedAt()
-	p = nil
-	p.GetUpdatedAt()
+func TestRepository_GetCommentsURL(tt *testing.T) {
+	var zeroValue string
+	r := &Repository{CommentsURL: &zeroValue}
+	r.GetCommentsURL()
+	r = &Repository{}
+	r.GetCommentsURL()
+	r = nil
+	r.GetCommentsURL()
 }
 
-func TestPullRequestComment_GetURL(tt *testing.T) {
+func TestRepository_GetCommitsURL(tt *testing.T) {
 	var zeroValue string
-	p := &PullRequestComment{URL: &zeroValue}
-	p.GetURL()
-	p = &PullRequestComment{}
-	p.GetURL()
-	p = nil
-	p.GetURL()
+	r := &Repository{CommitsURL: &zeroValue}
+	r.GetCommitsURL()
+	r = &Repository{}
+	r.GetCommitsURL()
+	r = nil
+	r.GetCommitsURL()
 }
 
-func TestPullRequestComment_GetUser(tt *testing.T) {
-	p := &PullRequestComment{}
-	p.GetUser()
-	p = nil
-	p.GetUser()
+func TestRepository_GetCompareURL(tt *testing.T) {
+	var zeroValue string
+	r := &Repository{CompareURL: &zeroValue}
+	r.GetCompareURL()
+	r = &Repository{}
+	r.GetCompareURL()
+	r = nil
+	r.GetCompareURL()
 }
 
-func TestPullRequestEvent_GetAction(tt *testing.T) {
+func TestRepository_GetContentsURL(tt *testing.T) {
 	var zeroValue string
-	p := &PullRequestEvent{Action: &zeroValue}
-	p.GetAction()
-	p = &PullRequestEvent{}
-	p.GetAction()
-	p = nil
-	p.GetAction()
+	r := &Repository{ContentsURL: &zeroValue}
+	r.GetContentsURL()
+	r = &Repository{}
+	r.GetContentsURL()
+	r = nil
+	r.GetContentsURL()
 }
 
-func TestPullRequestEvent_GetAfter(tt *testing.T) {
+func TestRepository_GetContributorsURL(tt *testing.T) {
 	var zeroValue string
-	p := &PullRequestEvent{After: &zeroValue}
-	p.GetAfter()
-	p = &PullRequestEvent{}
-	p.GetAfter()
-	p = nil
-	p.GetAfter()
+	r := &Repository{ContributorsURL: &zeroValue}
+	r.GetContributorsURL()
+	r = &Repository{}
+	r.GetContributorsURL()
+	r = nil
+	r.GetContributorsURL()
 }
 
-func TestPullRequestEvent_GetAssignee(tt *testing.T) {
-	p := &PullRequestEvent{}
-	p.GetAssignee()
-	p = nil
-	p.GetAssignee()
+func TestRepository_GetCreatedAt(tt *testing.T) {
+	var zeroValue Timestamp
+	r := &Repository{CreatedAt: &zeroValue}
+	r.GetCreatedAt()
+	r = &Repository{}
+	r.GetCreatedAt()
+	r = nil
+	r.GetCreatedAt()
 }
 
-func TestPullRequestEvent_GetBefore(tt *testing.T) {
+func TestRepository_GetDefaultBranch(tt *testing.T) {
 	var zeroValue string
-	p := &PullRequestEvent{Before: &zeroValue}
-	p.GetBefore()
-	p = &PullRequestEvent{}
-	p.GetBefore()
-	p = nil
-	p.GetBefore()
+	r := &Repository{DefaultBranch: &zeroValue}
+	r.GetDefaultBranch()
+	r = &Repository{}
+	r.GetDefaultBranch()
+	r = nil
+	r.GetDefaultBranch()
 }
 
-func TestPullRequestEvent_GetChanges(tt *testing.T) {
-	p := &PullRequestEvent{}
-	p.GetChanges()
-	p = nil
-	p.GetChanges()
+func TestRepository_GetDeleteBranchOnMerge(tt *testing.T) {
+	var zeroValue bool
+	r := &Repository{DeleteBranchOnMerge: &zeroValue}
+	r.GetDeleteBranchOnMerge()
+	r = &Repository{}
+	r.GetDeleteBranchOnMerge()
+	r = nil
+	r.GetDeleteBranchOnMerge()
 }
 
-func TestPullRequestEvent_GetInstallation(tt *testing.T) {
-	p := &PullRequestEvent{}
-	p.GetInstallation()
-	p = nil
-	p.GetInstallation()
+func TestRepository_GetDeploymentsURL(tt *testing.T) {
+	var zeroValue string
+	r := &Repository{DeploymentsURL: &zeroValue}
+	r.GetDeploymentsURL()
+	r = &Repository{}
+	r.GetDeploymentsURL()
+	r = nil
+	r.GetDeploymentsURL()
+}
+
+func TestRepository_GetDescription(tt *testing.T) {
+	var zeroValue string
+	r := &Repository{Description: &zeroValue}
+	r.GetDescription()
+	r = &Repository{}
+	r.GetDescription()
+	r = nil
+	r.GetDescription()
 }
 
-func TestPullRequestEvent_GetLabel(tt *testing.T) {
-	p := &PullRequestEvent{}
-	p.GetLabel()
-	p = nil
-	p.GetLabel()
+func TestRepository_GetDisabled(tt *testing.T) {
+	var zeroValue bool
+	r := &Repository{Disabled: &zeroValue}
+	r.GetDisabled()
+	r = &Repository{}
+	r.GetDisabled()
+	r = nil
+	r.GetDisabled()
 }
 
-func TestPullRequestEvent_GetNumber(tt *testing.T) {
-	var zeroValue int
-	p := &PullRequestEvent{Number: &zeroValue}
-	p.GetNumber()
-	p = &PullRequestEvent{}
-	p.GetNumber()
-	p = nil
-	p.GetNumber()
+func TestRepository_GetDownloadsURL(tt *testing.T) {
+	var zeroValue string
+	r := &Repository{DownloadsURL: &zeroValue}
+	r.GetDownloadsURL()
+	r = &Repository{}
+	r.GetDownloadsURL()
+	r = nil
+	r.GetDownloadsURL()
 }
 
-func TestPullRequestEvent_GetOrganization(tt *testing.T) {
-	p := &PullRequestEvent{}
-	p.GetOrganization()
-	p = nil
-	p.GetOrganization()
+func TestRepository_GetEventsURL(tt *testing.T) {
+	var zeroValue string
+	r := &Repository{EventsURL: &zeroValue}
+	r.GetEventsURL()
+	r = &Repository{}
+	r.GetEventsURL()
+	r = nil
+	r.GetEventsURL()
 }
 
-func TestPullRequestEvent_GetPullRequest(tt *testing.T) {
-	p := &PullRequestEvent{}
-	p.GetPullRequest()
-	p = nil
-	p.GetPullRequest()
+func TestRepository_GetFork(tt *testing.T) {
+	var zeroValue bool
+	r := &Repository{Fork: &zeroValue}
+	r.GetFork()
+	r = &Repository{}
+	r.GetFork()
+	r = nil
+	r.GetFork()
 }
 
-func TestPullRequestEvent_GetRepo(tt *testing.T) {
-	p := &PullRequestEvent{}
-	p.GetRepo()
-	p = nil
-	p.GetRepo()
+func TestRepository_GetForksCount(tt *testing.T) {
+	var zeroValue int
+	r := &Repository{ForksCount: &zeroValue}
+	r.GetForksCount()
+	r = &Repository{}
+	r.GetForksCount()
+	r = nil
+	r.GetForksCount()
 }
 
-func TestPullRequestEvent_GetRequestedReviewer(tt *testing.T) {
-	p := &PullRequestEvent{}
-	p.GetRequestedReviewer()
-	p = nil
-	p.GetRequestedReviewer()
+func TestRepository_GetForksURL(tt *testing.T) {
+	var zeroValue string
+	r := &Repository{ForksURL: &zeroValue}
+	r.GetForksURL()
+	r = &Repository{}
+	r.GetForksURL()
+	r = nil
+	r.GetForksURL()
 }
 
-func TestPullRequestEvent_GetRequestedTeam(tt *testing.T) {
-	p := &PullRequestEvent{}
-	p.GetRequestedTeam()
-	p = nil
-	p.GetRequestedTeam()
+func TestRepository_GetFullName(tt *testing.T) {
+	var zeroValue string
+	r := &Repository{FullName: &zeroValue}
+	r.GetFullName()
+	r = &Repository{}
+	r.GetFullName()
+	r = nil
+	r.GetFullName()
 }
 
-func TestPullRequestEvent_GetSender(tt *testing.T) {
-	p := &PullRequestEvent{}
-	p.GetSender()
-	p = nil
-	p.GetSender()
+func TestRepository_GetGitCommitsURL(tt *testing.T) {
+	var zeroValue string
+	r := &Repository{GitCommitsURL: &zeroValue}
+	r.GetGitCommitsURL()
+	r = &Repository{}
+	r.GetGitCommitsURL()
+	r = nil
+	r.GetGitCommitsURL()
 }
 
-func TestPullRequestLinks_GetDiffURL(tt *testing.T) {
+func TestRepository_GetGitignoreTemplate(tt *testing.T) {
 	var zeroValue string
-	p := &PullRequestLinks{DiffURL: &zeroValue}
-	p.GetDiffURL()
-	p = &PullRequestLinks{}
-	p.GetDiffURL()
-	p = nil
-	p.GetDiffURL()
+	r := &Repository{GitignoreTemplate: &zeroValue}
+	r.GetGitignoreTemplate()
+	r = &Repository{}
+	r.GetGitignoreTemplate()
+	r = nil
+	r.GetGitignoreTemplate()
 }
 
-func TestPullRequestLinks_GetHTMLURL(tt *testing.T) {
+func TestRepository_GetGitRefsURL(tt *testing.T) {
 	var zeroValue string
-	p := &PullRequestLinks{HTMLURL: &zeroValue}
-	p.GetHTMLURL()
-	p = &PullRequestLinks{}
-	p.GetHTMLURL()
-	p = nil
-	p.GetHTMLURL()
+	r := &Repository{GitRefsURL: &zeroValue}
+	r.GetGitRefsURL()
+	r = &Repository{}
+	r.GetGitRefsURL()
+	r = nil
+	r.GetGitRefsURL()
 }
 
-func TestPullRequestLinks_GetPatchURL(tt *testing.T) {
+func TestRepository_GetGitTagsURL(tt *testing.T) {
 	var zeroValue string
-	p := &PullRequestLinks{PatchURL: &zeroValue}
-	p.GetPatchURL()
-	p = &PullRequestLinks{}
-	p.GetPatchURL()
-	p = nil
-	p.GetPatchURL()
+	r := &Repository{GitTagsURL: &zeroValue}
+	r.GetGitTagsURL()
+	r = &Repository{}
+	r.GetGitTagsURL()
+	r = nil
+	r.GetGitTagsURL()
 }
 
-func TestPullRequestLinks_GetURL(tt *testing.T) {
+func TestRepository_GetGitURL(tt *testing.T) {
 	var zeroValue string
-	p := &PullRequestLinks{URL: &zeroValue}
-	p.GetURL()
-	p = &PullRequestLinks{}
-	p.GetURL()
-	p = nil
-	p.GetURL()
+	r := &Repository{GitURL: &zeroValue}
+	r.GetGitURL()
+	r = &Repository{}
+	r.GetGitURL()
+	r = nil
+	r.GetGitURL()
 }
 
-func TestPullRequestMergeResult_GetMerged(tt *testing.T) {
+func TestRepository_GetHasDownloads(tt *testing.T) {
 	var zeroValue bool
-	p := &PullRequestMergeResult{Merged: &zeroValue}
-	p.GetMerged()
-	p = &PullRequestMergeResult{}
-	p.GetMerged()
-	p = nil
-	p.GetMerged()
+	r := &Repository{HasDownloads: &zeroValue}
+	r.GetHasDownloads()
+	r = &Repository{}
+	r.GetHasDownloads()
+	r = nil
+	r.GetHasDownloads()
 }
 
-func TestPullRequestMergeResult_GetMessage(tt *testing.T) {
-	var zeroValue string
-	p := &PullRequestMergeResult{Message: &zeroValue}
-	p.GetMessage()
-	p = &PullRequestMergeResult{}
-	p.GetMessage()
-	p = nil
-	p.GetMessage()
+func TestRepository_GetHasIssues(tt *testing.T) {
+	var zeroValue bool
+	r := &Repository{HasIssues: &zeroValue}
+	r.GetHasIssues()
+	r = &Repository{}
+	r.GetHasIssues()
+	r = nil
+	r.GetHasIssues()
 }
 
-func TestPullRequestMergeResult_GetSHA(tt *testing.T) {
-	var zeroValue string
-	p := &PullRequestMergeResult{SHA: &zeroValue}
-	p.GetSHA()
-	p = &PullRequestMergeResult{}
-	p.GetSHA()
-	p = nil
-	p.GetSHA()
+func TestRepository_GetHasPages(tt *testing.T) {
+	var zeroValue bool
+	r := &Repository{HasPages: &zeroValue}
+	r.GetHasPages()
+	r = &Repository{}
+	r.GetHasPages()
+	r = nil
+	r.GetHasPages()
 }
 
-func TestPullRequestReview_GetAuthorAssociation(tt *testing.T) {
-	var zeroValue string
-	p := &PullRequestReview{AuthorAssociation: &zeroValue}
-	p.GetAuthorAssociation()
-	p = &PullRequestReview{}
-	p.GetAuthorAssociation()
-	p = nil
-	p.GetAuthorAssociation()
+func TestRepository_GetHasProjects(tt *testing.T) {
+	var zeroValue bool
+	r := &Repository{HasProjects: &zeroValue}
+	r.GetHasProjects()
+	r = &Repository{}
+	r.GetHasProjects()
+	r = nil
+	r.GetHasProjects()
 }
 
-func TestPullRequestReview_GetBody(tt *testing.T) {
-	var zeroValue string
-	p := &PullRequestReview{Body: &zeroValue}
-	p.GetBody()
-	p = &PullRequestReview{}
-	p.GetBody()
-	p = nil
-	p.GetBody()
+func TestRepository_GetHasWiki(tt *testing.T) {
+	var zeroValue bool
+	r := &Repository{HasWiki: &zeroValue}
+	r.GetHasWiki()
+	r = &Repository{}
+	r.GetHasWiki()
+	r = nil
+	r.GetHasWiki()
 }
 
-func TestPullRequestReview_GetCommitID(tt *testing.T) {
+func TestRepository_GetHomepage(tt *testing.T) {
 	var zeroValue string
-	p := &PullRequestReview{CommitID: &zeroValue}
-	p.GetCommitID()
-	p = &PullRequestReview{}
-	p.GetCommitID()
-	p = nil
-	p.GetCommitID()
+	r := &Repository{Homepage: &zeroValue}
+	r.GetHomepage()
+	r = &Repository{}
+	r.GetHomepage()
+	r = nil
+	r.GetHomepage()
 }
 
-func TestPullRequestReview_GetHTMLURL(tt *testing.T) {
+func TestRepository_GetHooksURL(tt *testing.T) {
 	var zeroValue string
-	p := &PullRequestReview{HTMLURL: &zeroValue}
-	p.GetHTMLURL()
-	p = &PullRequestReview{}
-	p.GetHTMLURL()
-	p = nil
-	p.GetHTMLURL()
+	r := &Repository{HooksURL: &zeroValue}
+	r.GetHooksURL()
+	r = &Repository{}
+	r.GetHooksURL()
+	r = nil
+	r.GetHooksURL()
 }
 
-func TestPullRequestReview_GetID(tt *testing.T) {
+func TestRepository_GetHTMLURL(tt *testing.T) {
+	var zeroValue string
+	r := &Repository{HTMLURL: &zeroValue}
+	r.GetHTMLURL()
+	r = &Repository{}
+	r.GetHTMLURL()
+	r = nil
+	r.GetHTMLURL()
+}
+
+func TestRepository_GetID(tt *testing.T) {
 	var zeroValue int64
-	p := &PullRequestReview{ID: &zeroValue}
-	p.GetID()
-	p = &PullRequestReview{}
-	p.GetID()
-	p = nil
-	p.GetID()
+	r := &Repository{ID: &zeroValue}
+	r.GetID()
+	r = &Repository{}
+	r.GetID()
+	r = nil
+	r.GetID()
 }
 
-func TestPullRequestReview_GetNodeID(tt *testing.T) {
+func TestRepository_GetIssueCommentURL(tt *testing.T) {
 	var zeroValue string
-	p := &PullRequestReview{NodeID: &zeroValue}
-	p.GetNodeID()
-	p = &PullRequestReview{}
-	p.GetNodeID()
-	p = nil
-	p.GetNodeID()
+	r := &Repository{IssueCommentURL: &zeroValue}
+	r.GetIssueCommentURL()
+	r = &Repository{}
+	r.GetIssueCommentURL()
+	r = nil
+	r.GetIssueCommentURL()
 }
 
-func TestPullRequestReview_GetPullRequestURL(tt *testing.T) {
+func TestRepository_GetIssueEventsURL(tt *testing.T) {
 	var zeroValue string
-	p := &PullRequestReview{PullRequestURL: &zeroValue}
-	p.GetPullRequestURL()
-	p = &PullRequestReview{}
-	p.GetPullRequestURL()
-	p = nil
-	p.GetPullRequestURL()
+	r := &Repository{IssueEventsURL: &zeroValue}
+	r.GetIssueEventsURL()
+	r = &Repository{}
+	r.GetIssueEventsURL()
+	r = nil
+	r.GetIssueEventsURL()
 }
 
-func TestPullRequestReview_GetState(tt *testing.T) {
+func TestRepository_GetIssuesURL(tt *testing.T) {
 	var zeroValue string
-	p := &PullRequestReview{State: &zeroValue}
-	p.GetState()
-	p = &PullRequestReview{}
-	p.GetState()
-	p = nil
-	p.GetState()
+	r := &Repository{IssuesURL: &zeroValue}
+	r.GetIssuesURL()
+	r = &Repository{}
+	r.GetIssuesURL()
+	r = nil
+	r.GetIssuesURL()
 }
 
-func TestPullRequestReview_GetSubmittedAt(tt *testing.T) {
-	var zeroValue time.Time
-	p := &PullRequestReview{SubmittedAt: &zeroValue}
-	p.GetSubmittedAt()
-	p = &PullRequestReview{}
-	p.GetSubmittedAt()
-	p = nil
-	p.GetSubmittedAt()
+func TestRepository_GetIsTemplate(tt *testing.T) {
+	var zeroValue bool
+	r := &Repository{IsTemplate: &zeroValue}
+	r.GetIsTemplate()
+	r = &Repository{}
+	r.GetIsTemplate()
+	r = nil
+	r.GetIsTemplate()
 }
 
-func TestPullRequestReview_GetUser(tt *testing.T) {
-	p := &PullRequestReview{}
-	p.GetUser()
-	p = nil
-	p.GetUser()
+func TestRepository_GetKeysURL(tt *testing.T) {
+	var zeroValue string
+	r := &Repository{KeysURL: &zeroValue}
+	r.GetKeysURL()
+	r = &Repository{}
+	r.GetKeysURL()
+	r = nil
+	r.GetKeysURL()
 }
 
-func TestPullRequestReviewCommentEvent_GetAction(tt *testing.T) {
+func TestRepository_GetLabelsURL(tt *testing.T) {
 	var zeroValue string
-	p := &PullRequestReviewCommentEvent{Action: &zeroValue}
-	p.GetAction()
-	p = &PullRequestReviewCommentEvent{}
-	p.GetAction()
-	p = nil
-	p.GetAction()
+	r := &Repository{LabelsURL: &zeroValue}
+	r.GetLabelsURL()
+	r = &Repository{}
+	r.GetLabelsURL()
+	r = nil
+	r.GetLabelsURL()
 }
 
-func TestPullRequestReviewCommentEvent_GetChanges(tt *testing.T) {
-	p := &PullRequestReviewCommentEvent{}
-	p.GetChanges()
-	p = nil
-	p.GetChanges()
+func TestRepository_GetLanguage(tt *testing.T) {
+	var zeroValue string
+	r := &Repository{Language: &zeroValue}
+	r.GetLanguage()
+	r = &Repository{}
+	r.GetLanguage()
+	r = nil
+	r.GetLanguage()
 }
 
-func TestPullRequestReviewCommentEvent_GetComment(tt *testing.T) {
-	p := &PullRequestReviewCommentEvent{}
-	p.GetComment()
-	p = nil
-	p.GetComment()
+func TestRepository_GetLanguagesURL(tt *testing.T) {
+	var zeroValue string
+	r := &Repository{LanguagesURL: &zeroValue}
+	r.GetLanguagesURL()
+	r = &Repository{}
+	r.GetLanguagesURL()
+	r = nil
+	r.GetLanguagesURL()
 }
 
-func TestPullRequestReviewCommentEvent_GetInstallation(tt *testing.T) {
-	p := &PullRequestReviewCommentEvent{}
-	p.GetInstallation()
-	p = nil
-	p.GetInstallation()
+func TestRepository_GetLicense(tt *testing.T) {
+	r := &Repository{}
+	r.GetLicense()
+	r = nil
+	r.GetLicense()
 }
 
-func TestPullRequestReviewCommentEvent_GetPullRequest(tt *testing.T) {
-	p := &PullRequestReviewCommentEvent{}
-	p.GetPullRequest()
-	p = nil
-	p.GetPullRequest()
+func TestRepository_GetLicenseTemplate(tt *testing.T) {
+	var zeroValue string
+	r := &Repository{LicenseTemplate: &zeroValue}
+	r.GetLicenseTemplate()
+	r = &Repository{}
+	r.GetLicenseTemplate()
+	r = nil
+	r.GetLicenseTemplate()
 }
 
-func TestPullRequestReviewCommentEvent_GetRepo(tt *testing.T) {
-	p := &PullRequestReviewCommentEvent{}
-	p.GetRepo()
-	p = nil
-	p.GetRepo()
+func TestRepository_GetMasterBranch(tt *testing.T) {
+	var zeroValue string
+	r := &Repository{MasterBranch: &zeroValue}
+	r.GetMasterBranch()
+	r = &Repository{}
+	r.GetMasterBranch()
+	r = nil
+	r.GetMasterBranch()
 }
 
-func TestPullRequestReviewCommentEvent_GetSender(tt *testing.T) {
-	p := &PullRequestReviewCommentEvent{}
-	p.GetSender()
-	p = nil
-	p.GetSender()
+func TestRepository_GetMergesURL(tt *testing.T) {
+	var zeroValue string
+	r := &Repository{MergesURL: &zeroValue}
+	r.GetMergesURL()
+	r = &Repository{}
+	r.GetMergesURL()
+	r = nil
+	r.GetMergesURL()
 }
 
-func TestPullRequestReviewDismissalRequest_GetMessage(tt *testing.T) {
+func TestRepository_GetMilestonesURL(tt *testing.T) {
 	var zeroValue string
-	p := &PullRequestReviewDismissalRequest{Message: &zeroValue}
-	p.GetMessage()
-	p = &PullRequestReviewDismissalRequest{}
-	p.GetMessage()
-	p = nil
-	p.GetMessage()
+	r := &Repository{MilestonesURL: &zeroValue}
+	r.GetMilestonesURL()
+	r = &Repository{}
+	r.GetMilestonesURL()
+	r = nil
+	r.GetMilestonesURL()
 }
 
-func TestPullRequestReviewEvent_GetAction(tt *testing.T) {
+func TestRepository_GetMirrorURL(tt *testing.T) {
 	var zeroValue string
-	p := &PullRequestReviewEvent{Action: &zeroValue}
-	p.GetAction()
-	p = &PullRequestReviewEvent{}
-	p.GetAction()
-	p = nil
-	p.GetAction()
+	r := &Repository{MirrorURL: &zeroValue}
+	r.GetMirrorURL()
+	r = &Repository{}
+	r.GetMirrorURL()
+	r = nil
+	r.GetMirrorURL()
 }
 
-func TestPullRequestReviewEvent_GetInstallation(tt *testing.T) {
-	p := &PullRequestReviewEvent{}
-	p.GetInstallation()
-	p = nil
-	p.GetInstallation()
+func TestRepository_GetName(tt *testing.T) {
+	var zeroValue string
+	r := &Repository{Name: &zeroValue}
+	r.GetName()
+	r = &Repository{}
+	r.GetName()
+	r = nil
+	r.GetName()
 }
 
-func TestPullRequestReviewEvent_GetOrganization(tt *testing.T) {
-	p := &PullRequestReviewEvent{}
-	p.GetOrganization()
-	p = nil
-	p.GetOrganization()
+func TestRepository_GetNetworkCount(tt *testing.T) {
+	var zeroValue int
+	r := &Repository{NetworkCount: &zeroValue}
+	r.GetNetworkCount()
+	r = &Repository{}
+	r.GetNetworkCount()
+	r = nil
+	r.GetNetworkCount()
+}
+
+func TestRepository_GetNodeID(tt *testing.T) {
+	var zeroValue string
+	r := &Repository{NodeID: &zeroValue}
+	r.GetNodeID()
+	r = &Repository{}
+	r.GetNodeID()
+	r = nil
+	r.GetNodeID()
 }
 
-func TestPullRequestReviewEvent_GetPullRequest(tt *testing.T) {
-	p := &PullRequestReviewEvent{}
-	p.GetPullRequest()
-	p = nil
-	p.GetPullRequest()
+func TestRepository_GetNotificationsURL(tt *testing.T) {
+	var zeroValue string
+	r := &Repository{NotificationsURL: &zeroValue}
+	r.GetNotificationsURL()
+	r = &Repository{}
+	r.GetNotificationsURL()
+	r = nil
+	r.GetNotificationsURL()
 }
 
-func TestPullRequestReviewEvent_GetRepo(tt *testing.T) {
-	p := &PullRequestReviewEvent{}
-	p.GetRepo()
-	p = nil
-	p.GetRepo()
+func TestRepository_GetOpenIssuesCount(tt *testing.T) {
+	var zeroValue int
+	r := &Repository{OpenIssuesCount: &zeroValue}
+	r.GetOpenIssuesCount()
+	r = &Repository{}
+	r.GetOpenIssuesCount()
+	r = nil
+	r.GetOpenIssuesCount()
 }
 
-func TestPullRequestReviewEvent_GetReview(tt *testing.T) {
-	p := &PullRequestReviewEvent{}
-	p.GetReview()
-	p = nil
-	p.GetReview()
+func TestRepository_GetOrganization(tt *testing.T) {
+	r := &Repository{}
+	r.GetOrganization()
+	r = nil
+	r.GetOrganization()
 }
 
-func TestPullRequestReviewEvent_GetSender(tt *testing.T) {
-	p := &PullRequestReviewEvent{}
-	p.GetSender()
-	p = nil
-	p.GetSender()
+func TestRepository_GetOwner(tt *testing.T) {
+	r := &Repository{}
+	r.GetOwner()
+	r = nil
+	r.GetOwner()
 }
 
-func TestPullRequestReviewRequest_GetBody(tt *testing.T) {
-	var zeroValue string
-	p := &PullRequestReviewRequest{Body: &zeroValue}
-	p.GetBody()
-	p = &PullRequestReviewRequest{}
-	p.GetBody()
-	p = nil
-	p.GetBody()
+func TestRepository_GetParent(tt *testing.T) {
+	r := &Repository{}
+	r.GetParent()
+	r = nil
+	r.GetParent()
 }
 
-func TestPullRequestReviewRequest_GetCommitID(tt *testing.T) {
-	var zeroValue string
-	p := &PullRequestReviewRequest{CommitID: &zeroValue}
-	p.GetCommitID()
-	p = &PullRequestReviewRequest{}
-	p.GetCommitID()
-	p = nil
-	p.GetCommitID()
+func TestRepository_GetPermissions(tt *testing.T) {
+	var zeroValue map[string]bool
+	r := &Repository{Permissions: &zeroValue}
+	r.GetPermissions()
+	r = &Repository{}
+	r.GetPermissions()
+	r = nil
+	r.GetPermissions()
 }
 
-func TestPullRequestReviewRequest_GetEvent(tt *testing.T) {
-	var zeroValue string
-	p := &PullRequestReviewRequest{Event: &zeroValue}
-	p.GetEvent()
-	p = &PullRequestReviewRequest{}
-	p.GetEvent()
-	p = nil
-	p.GetEvent()
+func TestRepository_GetPrivate(tt *testing.T) {
+	var zeroValue bool
+	r := &Repository{Private: &zeroValue}
+	r.GetPrivate()
+	r = &Repository{}
+	r.GetPrivate()
+	r = nil
+	r.GetPrivate()
 }
 
-func TestPullRequestReviewRequest_GetNodeID(tt *testing.T) {
+func TestRepository_GetPullsURL(tt *testing.T) {
 	var zeroValue string
-	p := &PullRequestReviewRequest{NodeID: &zeroValue}
-	p.GetNodeID()
-	p = &PullRequestReviewRequest{}
-	p.GetNodeID()
-	p = nil
-	p.GetNodeID()
+	r := &Repository{PullsURL: &zeroValue}
+	r.GetPullsURL()
+	r = &Repository{}
+	r.GetPullsURL()
+	r = nil
+	r.GetPullsURL()
 }
 
-func TestPullRequestReviewsEnforcement_GetDismissalRestrictions(tt *testing.T) {
-	p := &PullRequestReviewsEnforcement{}
-	p.GetDismissalRestrictions()
-	p = nil
-	p.GetDismissalRestrictions()
+func TestRepository_GetPushedAt(tt *testing.T) {
+	var zeroValue Timestamp
+	r := &Repository{PushedAt: &zeroValue}
+	r.GetPushedAt()
+	r = &Repository{}
+	r.GetPushedAt()
+	r = nil
+	r.GetPushedAt()
 }
 
-func TestPullRequestReviewsEnforcementRequest_GetDismissalRestrictionsRequest(tt *testing.T) {
-	p := &PullRequestReviewsEnforcementRequest{}
-	p.GetDismissalRestrictionsRequest()
-	p = nil
-	p.GetDismissalRestrictionsRequest()
+func TestRepository_GetReleasesURL(tt *testing.T) {
+	var zeroValue string
+	r := &Repository{ReleasesURL: &zeroValue}
+	r.GetReleasesURL()
+	r = &Repository{}
+	r.GetReleasesURL()
+	r = nil
+	r.GetReleasesURL()
 }
 
-func TestPullRequestReviewsEnforcementUpdate_GetDismissalRestrictionsRequest(tt *testing.T) {
-	p := &PullRequestReviewsEnforcementUpdate{}
-	p.GetDismissalRestrictionsRequest()
-	p = nil
-	p.GetDismissalRestrictionsRequest()
+func TestRepository_GetSize(tt *testing.T) {
+	var zeroValue int
+	r := &Repository{Size: &zeroValue}
+	r.GetSize()
+	r = &Repository{}
+	r.GetSize()
+	r = nil
+	r.GetSize()
 }
 
-func TestPullRequestReviewsEnforcementUpdate_GetDismissStaleReviews(tt *testing.T) {
-	var zeroValue bool
-	p := &PullRequestReviewsEnforcementUpdate{DismissStaleReviews: &zeroValue}
-	p.GetDismissStaleReviews()
-	p = &PullRequestReviewsEnforcementUpdate{}
-	p.GetDismissStaleReviews()
-	p = nil
-	p.GetDismissStaleReviews()
+func TestRepository_GetSource(tt *testing.T) {
+	r := &Repository{}
+	r.GetSource()
+	r = nil
+	r.GetSource()
 }
 
-func TestPullStats_GetMergablePulls(tt *testing.T) {
-	var zeroValue int
-	p := &PullStats{MergablePulls: &zeroValue}
-	p.GetMergablePulls()
-	p = &PullStats{}
-	p.GetMergablePulls()
-	p = nil
-	p.GetMergablePulls()
+func TestRepository_GetSSHURL(tt *testing.T) {
+	var zeroValue string
+	r := &Repository{SSHURL: &zeroValue}
+	r.GetSSHURL()
+	r = &Repository{}
+	r.GetSSHURL()
+	r = nil
+	r.GetSSHURL()
 }
 
-func TestPullStats_GetMergedPulls(tt *testing.T) {
+func TestRepository_GetStargazersCount(tt *testing.T) {
 	var zeroValue int
-	p := &PullStats{MergedPulls: &zeroValue}
-	p.GetMergedPulls()
-	p = &PullStats{}
-	p.GetMergedPulls()
-	p = nil
-	p.GetMergedPulls()
+	r := &Repository{StargazersCount: &zeroValue}
+	r.GetStargazersCount()
+	r = &Repository{}
+	r.GetStargazersCount()
+	r = nil
+	r.GetStargazersCount()
 }
 
-func TestPullStats_GetTotalPulls(tt *testing.T) {
-	var zeroValue int
-	p := &PullStats{TotalPulls: &zeroValue}
-	p.GetTotalPulls()
-	p = &PullStats{}
-	p.GetTotalPulls()
-	p = nil
-	p.GetTotalPulls()
+func TestRepository_GetStargazersURL(tt *testing.T) {
+	var zeroValue string
+	r := &Repository{StargazersURL: &zeroValue}
+	r.GetStargazersURL()
+	r = &Repository{}
+	r.GetStargazersURL()
+	r = nil
+	r.GetStargazersURL()
 }
 
-func TestPullStats_GetUnmergablePulls(tt *testing.T) {
-	var zeroValue int
-	p := &PullStats{UnmergablePulls: &zeroValue}
-	p.GetUnmergablePulls()
-	p = &PullStats{}
-	p.GetUnmergablePulls()
-	p = nil
-	p.GetUnmergablePulls()
+func TestRepository_GetStatusesURL(tt *testing.T) {
+	var zeroValue string
+	r := &Repository{StatusesURL: &zeroValue}
+	r.GetStatusesURL()
+	r = &Repository{}
+	r.GetStatusesURL()
+	r = nil
+	r.GetStatusesURL()
 }
 
-func TestPunchCard_GetCommits(tt *testing.T) {
+func TestRepository_GetSubscribersCount(tt *testing.T) {
 	var zeroValue int
-	p := &PunchCard{Commits: &zeroValue}
-	p.GetCommits()
-	p = &PunchCard{}
-	p.GetCommits()
-	p = nil
-	p.GetCommits()
+	r := &Repository{SubscribersCount: &zeroValue}
+	r.GetSubscribersCount()
+	r = &Repository{}
+	r.GetSubscribersCount()
+	r = nil
+	r.GetSubscribersCount()
 }
 
-func TestPunchCard_GetDay(tt *testing.T) {
-	var zeroValue int
-	p := &PunchCard{Day: &zeroValue}
-	p.GetDay()
-	p = &PunchCard{}
-	p.GetDay()
-	p = nil
-	p.GetDay()
+func TestRepository_GetSubscribersURL(tt *testing.T) {
+	var zeroValue string
+	r := &Repository{SubscribersURL: &zeroValue}
+	r.GetSubscribersURL()
+	r = &Repository{}
+	r.GetSubscribersURL()
+	r = nil
+	r.GetSubscribersURL()
 }
 
-func TestPunchCard_GetHour(tt *testing.T) {
-	var zeroValue int
-	p := &PunchCard{Hour: &zeroValue}
-	p.GetHour()
-	p = &PunchCard{}
-	p.GetHour()
-	p = nil
-	p.GetHour()
+func TestRepository_GetSubscriptionURL(tt *testing.T) {
+	var zeroValue string
+	r := &Repository{SubscriptionURL: &zeroValue}
+	r.GetSubscriptionURL()
+	r = &Repository{}
+	r.GetSubscriptionURL()
+	r = nil
+	r.GetSubscriptionURL()
 }
 
-func TestPushEvent_GetAfter(tt *testing.T) {
+func TestRepository_GetSVNURL(tt *testing.T) {
 	var zeroValue string
-	p := &PushEvent{After: &zeroValue}
-	p.GetAfter()
-	p = &PushEvent{}
-	p.GetAfter()
-	p = nil
-	p.GetAfter()
+	r := &Repository{SVNURL: &zeroValue}
+	r.GetSVNURL()
+	r = &Repository{}
+	r.GetSVNURL()
+	r = nil
+	r.GetSVNURL()
 }
 
-func TestPushEvent_GetBaseRef(tt *testing.T) {
+func TestRepository_GetTagsURL(tt *testing.T) {
 	var zeroValue string
-	p := &PushEvent{BaseRef: &zeroValue}
-	p.GetBaseRef()
-	p = &PushEvent{}
-	p.GetBaseRef()
-	p = nil
-	p.GetBaseRef()
+	r := &Repository{TagsURL: &zeroValue}
+	r.GetTagsURL()
+	r = &Repository{}
+	r.GetTagsURL()
+	r = nil
+	r.GetTagsURL()
 }
 
-func TestPushEvent_GetBefore(tt *testing.T) {
-	var zeroValue string
-	p := &PushEvent{Before: &zeroValue}
-	p.GetBefore()
-	p = &PushEvent{}
-	p.GetBefore()
-	p = nil
-	p.GetBefore()
+func TestRepository_GetTeamID(tt *testing.T) {
+	var zeroValue int64
+	r := &Repository{TeamID: &zeroValue}
+	r.GetTeamID()
+	r = &Repository{}
+	r.GetTeamID()
+	r = nil
+	r.GetTeamID()
 }
 
-func TestPushEvent_GetCompare(tt *testing.T) {
+func TestRepository_GetTeamsURL(tt *testing.T) {
 	var zeroValue string
-	p := &PushEvent{Compare: &zeroValue}
-	p.GetCompare()
-	p = &PushEvent{}
-	p.GetCompare()
-	p = nil
-	p.GetCompare()
+	r := &Repository{TeamsURL: &zeroValue}
+	r.GetTeamsURL()
+	r = &Repository{}
+	r.GetTeamsURL()
+	r = nil
+	r.GetTeamsURL()
 }
 
-func TestPushEvent_GetCreated(tt *testing.T) {
-	var zeroValue bool
-	p := &PushEvent{Created: &zeroValue}
-	p.GetCreated()
-	p = &PushEvent{}
-	p.GetCreated()
-	p = nil
-	p.GetCreated()
+func TestRepository_GetTemplateRepository(tt *testing.T) {
+	r := &Repository{}
+	r.GetTemplateRepository()
+	r = nil
+	r.GetTemplateRepository()
 }
 
-func TestPushEvent_GetDeleted(tt *testing.T) {
-	var zeroValue bool
-	p := &PushEvent{Deleted: &zeroValue}
-	p.GetDeleted()
-	p = &PushEvent{}
-	p.GetDeleted()
-	p = nil
-	p.GetDeleted()
+func TestRepository_GetTreesURL(tt *testing.T) {
+	var zeroValue string
+	r := &Repository{TreesURL: &zeroValue}
+	r.GetTreesURL()
+	r = &Repository{}
+	r.GetTreesURL()
+	r = nil
+	r.GetTreesURL()
 }
 
-func TestPushEvent_GetDistinctSize(tt *testing.T) {
-	var zeroValue int
-	p := &PushEvent{DistinctSize: &zeroValue}
-	p.GetDistinctSize()
-	p = &PushEvent{}
-	p.GetDistinctSize()
-	p = nil
-	p.GetDistinctSize()
+func TestRepository_GetUpdatedAt(tt *testing.T) {
+	var zeroValue Timestamp
+	r := &Repository{UpdatedAt: &zeroValue}
+	r.GetUpdatedAt()
+	r = &Repository{}
+	r.GetUpdatedAt()
+	r = nil
+	r.GetUpdatedAt()
 }
 
-func TestPushEvent_GetForced(tt *testing.T) {
-	var zeroValue bool
-	p := &PushEvent{Forced: &zeroValue}
-	p.GetForced()
-	p = &PushEvent{}
-	p.GetForced()
-	p = nil
-	p.GetForced()
+func TestRepository_GetURL(tt *testing.T) {
+	var zeroValue string
+	r := &Repository{URL: &zeroValue}
+	r.GetURL()
+	r = &Repository{}
+	r.GetURL()
+	r = nil
+	r.GetURL()
 }
 
-func TestPushEvent_GetHead(tt *testing.T) {
+func TestRepository_GetVisibility(tt *testing.T) {
 	var zeroValue string
-	p := &PushEvent{Head: &zeroValue}
-	p.GetHead()
-	p = &PushEvent{}
-	p.GetHead()
-	p = nil
-	p.GetHead()
+	r := &Repository{Visibility: &zeroValue}
+	r.GetVisibility()
+	r = &Repository{}
+	r.GetVisibility()
+	r = nil
+	r.GetVisibility()
 }
 
-func TestPushEvent_GetHeadCommit(tt *testing.T) {
-	p := &PushEvent{}
-	p.GetHeadCommit()
-	p = nil
-	p.GetHeadCommit()
+func TestRepository_GetWatchersCount(tt *testing.T) {
+	var zeroValue int
+	r := &Repository{WatchersCount: &zeroValue}
+	r.GetWatchersCount()
+	r = &Repository{}
+	r.GetWatchersCount()
+	r = nil
+	r.GetWatchersCount()
 }
 
-func TestPushEvent_GetInstallation(tt *testing.T) {
-	p := &PushEvent{}
-	p.GetInstallation()
-	p = nil
-	p.GetInstallation()
+func TestRepositoryActivity_GetActivityType(tt *testing.T) {
+	var zeroValue string
+	r := &RepositoryActivity{ActivityType: &zeroValue}
+	r.GetActivityType()
+	r = &RepositoryActivity{}
+	r.GetActivityType()
+	r = nil
+	r.GetActivityType()
 }
 
-func TestPushEvent_GetPusher(tt *testing.T) {
-	p := &PushEvent{}
-	p.GetPusher()
-	p = nil
-	p.GetPusher()
+func TestRepositoryActivity_GetActor(tt *testing.T) {
+	r := &RepositoryActivity{}
+	r.GetActor()
+	r = nil
+	r.GetActor()
 }
 
-func TestPushEvent_GetPushID(tt *testing.T) {
-	var zeroValue int64
-	p := &PushEvent{PushID: &zeroValue}
-	p.GetPushID()
-	p = &PushEvent{}
-	p.GetPushID()
-	p = nil
-	p.GetPushID()
+func TestRepositoryActivity_GetAfter(tt *testing.T) {
+	var zeroValue string
+	r := &RepositoryActivity{After: &zeroValue}
+	r.GetAfter()
+	r = &RepositoryActivity{}
+	r.GetAfter()
+	r = nil
+	r.GetAfter()
 }
 
-func TestPushEvent_GetRef(tt *testing.T) {
+func TestRepositoryActivity_GetBefore(tt *testing.T) {
 	var zeroValue string
-	p := &PushEvent{Ref: &zeroValue}
-	p.GetRef()
-	p = &PushEvent{}
-	p.GetRef()
-	p = nil
-	p.GetRef()
+	r := &RepositoryActivity{Before: &zeroValue}
+	r.GetBefore()
+	r = &RepositoryActivity{}
+	r.GetBefore()
+	r = nil
+	r.GetBefore()
 }
 
-func TestPushEvent_GetRepo(tt *testing.T) {
-	p := &PushEvent{}
-	p.GetRepo()
-	p = nil
-	p.GetRepo()
+func TestRepositoryActivity_GetID(tt *testing.T) {
+	var zeroValue int64
+	r := &RepositoryActivity{ID: &zeroValue}
+	r.GetID()
+	r = &RepositoryActivity{}
+	r.GetID()
+	r = nil
+	r.GetID()
 }
 
-func TestPushEvent_GetSender(tt *testing.T) {
-	p := &PushEvent{}
-	p.GetSender()
-	p = nil
-	p.GetSender()
+func TestRepositoryActivity_GetNodeID(tt *testing.T) {
+	var zeroValue string
+	r := &RepositoryActivity{NodeID: &zeroValue}
+	r.GetNodeID()
+	r = &RepositoryActivity{}
+	r.GetNodeID()
+	r = nil
+	r.GetNodeID()
 }
 
-func TestPushEvent_GetSize(tt *testing.T) {
-	var zeroValue int
-	p := &PushEvent{Size: &zeroValue}
-	p.GetSize()
-	p = &PushEvent{}
-	p.GetSize()
-	p = nil
-	p.GetSize()
+func TestRepositoryActivity_GetRef(tt *testing.T) {
+	var zeroValue string
+	r := &RepositoryActivity{Ref: &zeroValue}
+	r.GetRef()
+	r = &RepositoryActivity{}
+	r.GetRef()
+	r = nil
+	r.GetRef()
 }
 
-func TestPushEventRepoOwner_GetEmail(tt *testing.T) {
+func TestRepositoryActivity_GetTimestamp(tt *testing.T) {
+	var zeroValue Timestamp
+	r := &RepositoryActivity{Timestamp: &zeroValue}
+	r.GetTimestamp()
+	r = &RepositoryActivity{}
+	r.GetTimestamp()
+	r = nil
+	r.GetTimestamp()
+}
+
+func TestRepositoryAdvisoryRequest_GetCVEID(tt *testing.T) {
 	var zeroValue string
-	p := &PushEventRepoOwner{Email: &zeroValue}
-	p.GetEmail()
-	p = &PushEventRepoOwner{}
-	p.GetEmail()
-	p = nil
-	p.GetEmail()
+	r := &RepositoryAdvisoryRequest{CVEID: &zeroValue}
+	r.GetCVEID()
+	r = &RepositoryAdvisoryRequest{}
+	r.GetCVEID()
+	r = nil
+	r.GetCVEID()
 }
 
-func TestPushEventRepoOwner_GetName(tt *testing.T) {
+func TestRepositoryAdvisoryRequest_GetCVSSVectorString(tt *testing.T) {
 	var zeroValue string
-	p := &PushEventRepoOwner{Name: &zeroValue}
-	p.GetName()
-	p = &PushEventRepoOwner{}
-	p.GetName()
-	p = nil
-	p.GetName()
+	r := &RepositoryAdvisoryRequest{CVSSVectorString: &zeroValue}
+	r.GetCVSSVectorString()
+	r = &RepositoryAdvisoryRequest{}
+	r.GetCVSSVectorString()
+	r = nil
+	r.GetCVSSVectorString()
 }
 
-func TestPushEventRepository_GetArchived(tt *testing.T) {
-	var zeroValue bool
-	p := &PushEventRepository{Archived: &zeroValue}
-	p.GetArchived()
-	p = &PushEventRepository{}
-	p.GetArchived()
-	p = nil
-	p.GetArchived()
+func TestRepositoryAdvisoryRequest_GetDescription(tt *testing.T) {
+	var zeroValue string
+	r := &RepositoryAdvisoryRequest{Description: &zeroValue}
+	r.GetDescription()
+	r = &RepositoryAdvisoryRequest{}
+	r.GetDescription()
+	r = nil
+	r.GetDescription()
 }
 
-func TestPushEventRepository_GetArchiveURL(tt *testing.T) {
+func TestRepositoryAdvisoryRequest_GetSeverity(tt *testing.T) {
 	var zeroValue string
-	p := &PushEventRepository{ArchiveURL: &zeroValue}
-	p.GetArchiveURL()
-	p = &PushEventRepository{}
-	p.GetArchiveURL()
-	p = nil
-	p.GetArchiveURL()
+	r := &RepositoryAdvisoryRequest{Severity: &zeroValue}
+	r.GetSeverity()
+	r = &RepositoryAdvisoryRequest{}
+	r.GetSeverity()
+	r = nil
+	r.GetSeverity()
 }
 
-func TestPushEventRepository_GetCloneURL(tt *testing.T) {
+func TestRepositoryAdvisoryRequest_GetState(tt *testing.T) {
 	var zeroValue string
-	p := &PushEventRepository{CloneURL: &zeroValue}
-	p.GetCloneURL()
-	p = &PushEventRepository{}
-	p.GetCloneURL()
-	p = nil
-	p.GetCloneURL()
+	r := &RepositoryAdvisoryRequest{State: &zeroValue}
+	r.GetState()
+	r = &RepositoryAdvisoryRequest{}
+	r.GetState()
+	r = nil
+	r.GetState()
 }
 
-func TestPushEventRepository_GetCreatedAt(tt *testing.T) {
-	var zeroValue Timestamp
-	p := &PushEventRepository{CreatedAt: &zeroValue}
-	p.GetCreatedAt()
-	p = &PushEventRepository{}
-	p.GetCreatedAt()
-	p = nil
-	p.GetCreatedAt()
+func TestRepositoryAdvisoryRequest_GetSummary(tt *testing.T) {
+	var zeroValue string
+	r := &RepositoryAdvisoryRequest{Summary: &zeroValue}
+	r.GetSummary()
+	r = &RepositoryAdvisoryRequest{}
+	r.GetSummary()
+	r = nil
+	r.GetSummary()
 }
 
-func TestPushEventRepository_GetDefaultBranch(tt *testing.T) {
+func TestRepositoryComment_GetBody(tt *testing.T) {
 	var zeroValue string
-	p := &PushEventRepository{DefaultBranch: &zeroValue}
-	p.GetDefaultBranch()
-	p = &PushEventRepository{}
-	p.GetDefaultBranch()
-	p = nil
-	p.GetDefaultBranch()
+	r := &RepositoryComment{Body: &zeroValue}
+	r.GetBody()
+	r = &RepositoryComment{}
+	r.GetBody()
+	r = nil
+	r.GetBody()
 }
 
-func TestPushEventRepository_GetDescription(tt *testing.T) {
+func TestRepositoryComment_GetCommitID(tt *testing.T) {
 	var zeroValue string
-	p := &PushEventRepository{Description: &zeroValue}
-	p.GetDescription()
-	p = &PushEventRepository{}
-	p.GetDescription()
-	p = nil
-	p.GetDescription()
+	r := &RepositoryComment{CommitID: &zeroValue}
+	r.GetCommitID()
+	r = &RepositoryComment{}
+	r.GetCommitID()
+	r = nil
+	r.GetCommitID()
 }
 
-func TestPushEventRepository_GetDisabled(tt *testing.T) {
-	var zeroValue bool
-	p := &PushEventRepository{Disabled: &zeroValue}
-	p.GetDisabled()
-	p = &PushEventRepository{}
-	p.GetDisabled()
-	p = nil
-	p.GetDisabled()
+func TestRepositoryComment_GetCreatedAt(tt *testing.T) {
+	var zeroValue time.Time
+	r := &RepositoryComment{CreatedAt: &zeroValue}
+	r.GetCreatedAt()
+	r = &RepositoryComment{}
+	r.GetCreatedAt()
+	r = nil
+	r.GetCreatedAt()
 }
 
-func TestPushEventRepository_GetFork(tt *testing.T) {
-	var zeroValue bool
-	p := &PushEventRepository{Fork: &zeroValue}
-	p.GetFork()
-	p = &PushEventRepository{}
-	p.GetFork()
-	p = nil
-	p.GetFork()
+func TestRepositoryComment_GetHTMLURL(tt *testing.T) {
+	var zeroValue string
+	r := &RepositoryComment{HTMLURL: &zeroValue}
+	r.GetHTMLURL()
+	r = &RepositoryComment{}
+	r.GetHTMLURL()
+	r = nil
+	r.GetHTMLURL()
 }
 
-func TestPushEventRepository_GetForksCount(tt *testing.T) {
-	var zeroValue int
-	p := &PushEventRepository{ForksCount: &zeroValue}
-	p.GetForksCount()
-	p = &PushEventRepository{}
-	p.GetForksCount()
-	p = nil
-	p.GetForksCount()
+func TestRepositoryComment_GetID(tt *testing.T) {
+	var zeroValue int64
+	r := &RepositoryComment{ID: &zeroValue}
+	r.GetID()
+	r = &RepositoryComment{}
+	r.GetID()
+	r = nil
+	r.GetID()
 }
 
-func TestPushEventRepository_GetFullName(tt *testing.T) {
+func TestRepositoryComment_GetNodeID(tt *testing.T) {
 	var zeroValue string
-	p := &PushEventRepository{FullName: &zeroValue}
-	p.GetFullName()
-	p = &PushEventRepository{}
-	p.GetFullName()
-	p = nil
-	p.GetFullName()
+	r := &RepositoryComment{NodeID: &zeroValue}
+	r.GetNodeID()
+	r = &RepositoryComment{}
+	r.GetNodeID()
+	r = nil
+	r.GetNodeID()
 }
 
-func TestPushEventRepository_GetGitURL(tt *testing.T) {
+func TestRepositoryComment_GetPath(tt *testing.T) {
 	var zeroValue string
-	p := &PushEventRepository{GitURL: &zeroValue}
-	p.GetGitURL()
-	p = &PushEventRepository{}
-	p.GetGitURL()
-	p = nil
-	p.GetGitURL()
+	r := &RepositoryComment{Path: &zeroValue}
+	r.GetPath()
+	r = &RepositoryComment{}
+	r.GetPath()
+	r = nil
+	r.GetPath()
 }
 
-func TestPushEventRepository_GetHasDownloads(tt *testing.T) {
-	var zeroValue bool
-	p := &PushEventRepository{HasDownloads: &zeroValue}
-	p.GetHasDownloads()
-	p = &PushEventRepository{}
-	p.GetHasDownloads()
-	p = nil
-	p.GetHasDownloads()
+func TestRepositoryComment_GetPosition(tt *testing.T) {
+	var zeroValue int
+	r := &RepositoryComment{Position: &zeroValue}
+	r.GetPosition()
+	r = &RepositoryComment{}
+	r.GetPosition()
+	r = nil
+	r.GetPosition()
 }
 
-func TestPushEventRepository_GetHasIssues(tt *testing.T) {
-	var zeroValue bool
-	p := &PushEventRepository{HasIssues: &zeroValue}
-	p.GetHasIssues()
-	p = &PushEventRepository{}
-	p.GetHasIssues()
-	p = nil
-	p.GetHasIssues()
+func TestRepositoryComment_GetReactions(tt *testing.T) {
+	r := &RepositoryComment{}
+	r.GetReactions()
+	r = nil
+	r.GetReactions()
 }
 
-func TestPushEventRepository_GetHasPages(tt *testing.T) {
-	var zeroValue bool
-	p := &PushEventRepository{HasPages: &zeroValue}
-	p.GetHasPages()
-	p = &PushEventRepository{}
-	p.GetHasPages()
-	p = nil
-	p.GetHasPages()
+func TestRepositoryComment_GetUpdatedAt(tt *testing.T) {
+	var zeroValue time.Time
+	r := &RepositoryComment{UpdatedAt: &zeroValue}
+	r.GetUpdatedAt()
+	r = &RepositoryComment{}
+	r.GetUpdatedAt()
+	r = nil
+	r.GetUpdatedAt()
 }
 
-func TestPushEventRepository_GetHasWiki(tt *testing.T) {
-	var zeroValue bool
-	p := &PushEventRepository{HasWiki: &zeroValue}
-	p.GetHasWiki()
-	p = &PushEventRepository{}
-	p.GetHasWiki()
-	p = nil
-	p.GetHasWiki()
+func TestRepositoryComment_GetURL(tt *testing.T) {
+	var zeroValue string
+	r := &RepositoryComment{URL: &zeroValue}
+	r.GetURL()
+	r = &RepositoryComment{}
+	r.GetURL()
+	r = nil
+	r.GetURL()
 }
 
-func TestPushEventRepository_GetHomepage(tt *testing.T) {
-	var zeroValue string
-	p := &PushEventRepository{Homepage: &zeroValue}
-	p.GetHomepage()
-	p = &PushEventRepository{}
-	p.GetHomepage()
-	p = nil
-	p.GetHomepage()
+func TestRepositoryComment_GetUser(tt *testing.T) {
+	r := &RepositoryComment{}
+	r.GetUser()
+	r = nil
+	r.GetUser()
 }
 
-func TestPushEventRepository_GetHTMLURL(tt *testing.T) {
+func TestRepositoryCommit_GetAuthor(tt *testing.T) {
+	r := &RepositoryCommit{}
+	r.GetAuthor()
+	r = nil
+	r.GetAuthor()
+}
+
+func TestRepositoryCommit_GetCommentsURL(tt *testing.T) {
 	var zeroValue string
-	p := &PushEventRepository{HTMLURL: &zeroValue}
-	p.GetHTMLURL()
-	p = &PushEventRepository{}
-	p.GetHTMLURL()
-	p = nil
-	p.GetHTMLURL()
+	r := &RepositoryCommit{CommentsURL: &zeroValue}
+	r.GetCommentsURL()
+	r = &RepositoryCommit{}
+	r.GetCommentsURL()
+	r = nil
+	r.GetCommentsURL()
 }
 
-func TestPushEventRepository_GetID(tt *testing.T) {
-	var zeroValue int64
-	p := &PushEventRepository{ID: &zeroValue}
-	p.GetID()
-	p = &PushEventRepository{}
-	p.GetID()
-	p = nil
-	p.GetID()
+func TestRepositoryCommit_GetCommit(tt *testing.T) {
+	r := &RepositoryCommit{}
+	r.GetCommit()
+	r = nil
+	r.GetCommit()
 }
 
-func TestPushEventRepository_GetLanguage(tt *testing.T) {
-	var zeroValue string
-	p := &PushEventRepository{Language: &zeroValue}
-	p.GetLanguage()
-	p = &PushEventRepository{}
-	p.GetLanguage()
-	p = nil
-	p.GetLanguage()
+func TestRepositoryCommit_GetCommitter(tt *testing.T) {
+	r := &RepositoryCommit{}
+	r.GetCommitter()
+	r = nil
+	r.GetCommitter()
 }
 
-func TestPushEventRepository_GetMasterBranch(tt *testing.T) {
+func TestRepositoryCommit_GetHTMLURL(tt *testing.T) {
 	var zeroValue string
-	p := &PushEventRepository{MasterBranch: &zeroValue}
-	p.GetMasterBranch()
-	p = &PushEventRepository{}
-	p.GetMasterBranch()
-	p = nil
-	p.GetMasterBranch()
+	r := &RepositoryCommit{HTMLURL: &zeroValue}
+	r.GetHTMLURL()
+	r = &RepositoryCommit{}
+	r.GetHTMLURL()
+	r = nil
+	r.GetHTMLURL()
 }
 
-func TestPushEventRepository_GetName(tt *testing.T) {
+func TestRepositoryCommit_GetNodeID(tt *testing.T) {
 	var zeroValue string
-	p := &PushEventRepository{Name: &zeroValue}
-	p.GetName()
-	p = &PushEventRepository{}
-	p.GetName()
-	p = nil
-	p.GetName()
+	r := &RepositoryCommit{NodeID: &zeroValue}
+	r.GetNodeID()
+	r = &RepositoryCommit{}
+	r.GetNodeID()
+	r = nil
+	r.GetNodeID()
 }
 
-func TestPushEventRepository_GetNodeID(tt *testing.T) {
+func TestRepositoryCommit_GetSHA(tt *testing.T) {
 	var zeroValue string
-	p := &PushEventRepository{NodeID: &zeroValue}
-	p.GetNodeID()
-	p = &PushEventRepository{}
-	p.GetNodeID()
-	p = nil
-	p.GetNodeID()
+	r := &RepositoryCommit{SHA: &zeroValue}
+	r.GetSHA()
+	r = &RepositoryCommit{}
+	r.GetSHA()
+	r = nil
+	r.GetSHA()
 }
 
-func TestPushEventRepository_GetOpenIssuesCount(tt *testing.T) {
-	var zeroValue int
-	p := &PushEventRepository{OpenIssuesCount: &zeroValue}
-	p.GetOpenIssuesCount()
-	p = &PushEventRepository{}
-	p.GetOpenIssuesCount()
-	p = nil
-	p.GetOpenIssuesCount()
+func TestRepositoryCommit_GetStats(tt *testing.T) {
+	r := &RepositoryCommit{}
+	r.GetStats()
+	r = nil
+	r.GetStats()
 }
 
-func TestPushEventRepository_GetOrganization(tt *testing.T) {
+func TestRepositoryCommit_GetURL(tt *testing.T) {
 	var zeroValue string
-	p := &PushEventRepository{Organization: &zeroValue}
-	p.GetOrganization()
-	p = &PushEventRepository{}
-	p.GetOrganization()
-	p = nil
-	p.GetOrganization()
+	r := &RepositoryCommit{URL: &zeroValue}
+	r.GetURL()
+	r = &RepositoryCommit{}
+	r.GetURL()
+	r = nil
+	r.GetURL()
 }
 
-func TestPushEventRepository_GetOwner(tt *testing.T) {
-	p := &PushEventRepository{}
-	p.GetOwner()
-	p = nil
-	p.GetOwner()
+func TestRepositoryContent_GetDownloadURL(tt *testing.T) {
+	var zeroValue string
+	r := &RepositoryContent{DownloadURL: &zeroValue}
+	r.GetDownloadURL()
+	r = &RepositoryContent{}
+	r.GetDownloadURL()
+	r = nil
+	r.GetDownloadURL()
 }
 
-func TestPushEventRepository_GetPrivate(tt *testing.T) {
-	var zeroValue bool
-	p := &PushEventRepository{Private: &zeroValue}
-	p.GetPrivate()
-	p = &PushEventRepository{}
-	p.GetPrivate()
-	p = nil
-	p.GetPrivate()
+func TestRepositoryContent_GetEncoding(tt *testing.T) {
+	var zeroValue string
+	r := &RepositoryContent{Encoding: &zeroValue}
+	r.GetEncoding()
+	r = &RepositoryContent{}
+	r.GetEncoding()
+	r = nil
+	r.GetEncoding()
 }
 
-func TestPushEventRepository_GetPullsURL(tt *testing.T) {
+func TestRepositoryContent_GetGitURL(tt *testing.T) {
 	var zeroValue string
-	p := &PushEventRepository{PullsURL: &zeroValue}
-	p.GetPullsURL()
-	p = &PushEventRepository{}
-	p.GetPullsURL()
-	p = nil
-	p.GetPullsURL()
+	r := &RepositoryContent{GitURL: &zeroValue}
+	r.GetGitURL()
+	r = &RepositoryContent{}
+	r.GetGitURL()
+	r = nil
+	r.GetGitURL()
 }
 
-func TestPushEventRepository_GetPushedAt(tt *testing.T) {
-	var zeroValue Timestamp
-	p := &PushEventRepository{PushedAt: &zeroValue}
-	p.GetPushedAt()
-	p = &PushEventRepository{}
-	p.GetPushedAt()
-	p = nil
-	p.GetPushedAt()
+func TestRepositoryContent_GetHTMLURL(tt *testing.T) {
+	var zeroValue string
+	r := &RepositoryContent{HTMLURL: &zeroValue}
+	r.GetHTMLURL()
+	r = &RepositoryContent{}
+	r.GetHTMLURL()
+	r = nil
+	r.GetHTMLURL()
 }
 
-func TestPushEventRepository_GetSize(tt *testing.T) {
-	var zeroValue int
-	p := &PushEventRepository{Size: &zeroValue}
-	p.GetSize()
-	p = &PushEventRepository{}
-	p.GetSize()
-	p = nil
-	p.GetSize()
+func TestRepositoryContent_GetName(tt *testing.T) {
+	var zeroValue string
+	r := &RepositoryContent{Name: &zeroValue}
+	r.GetName()
+	r = &RepositoryContent{}
+	r.GetName()
+	r = nil
+	r.GetName()
 }
 
-func TestPushEventRepository_GetSSHURL(tt *testing.T) {
+func TestRepositoryContent_GetPath(tt *testing.T) {
 	var zeroValue string
-	p := &PushEventRepository{SSHURL: &zeroValue}
-	p.GetSSHURL()
-	p = &PushEventRepository{}
-	p.GetSSHURL()
-	p = nil
-	p.GetSSHURL()
+	r := &RepositoryContent{Path: &zeroValue}
+	r.GetPath()
+	r = &RepositoryContent{}
+	r.GetPath()
+	r = nil
+	r.GetPath()
 }
 
-func TestPushEventRepository_GetStargazersCount(tt *testing.T) {
+func TestRepositoryContent_GetSHA(tt *testing.T) {
+	var zeroValue string
+	r := &RepositoryContent{SHA: &zeroValue}
+	r.GetSHA()
+	r = &RepositoryContent{}
+	r.GetSHA()
+	r = nil
+	r.GetSHA()
+}
+
+func TestRepositoryContent_GetSize(tt *testing.T) {
 	var zeroValue int
-	p := &PushEventRepository{StargazersCount: &zeroValue}
-	p.GetStargazersCount()
-	p = &PushEventRepository{}
-	p.GetStargazersCount()
-	p = nil
-	p.GetStargazersCount()
+	r := &RepositoryContent{Size: &zeroValue}
+	r.GetSize()
+	r = &RepositoryContent{}
+	r.GetSize()
+	r = nil
+	r.GetSize()
 }
 
-func TestPushEventRepository_GetStatusesURL(tt *testing.T) {
+func TestRepositoryContent_GetTarget(tt *testing.T) {
 	var zeroValue string
-	p := &PushEventRepository{StatusesURL: &zeroValue}
-	p.GetStatusesURL()
-	p = &PushEventRepository{}
-	p.GetStatusesURL()
-	p = nil
-	p.GetStatusesURL()
+	r := &RepositoryContent{Target: &zeroValue}
+	r.GetTarget()
+	r = &RepositoryContent{}
+	r.GetTarget()
+	r = nil
+	r.GetTarget()
 }
 
-func TestPushEventRepository_GetSVNURL(tt *testing.T) {
+func TestRepositoryContent_GetType(tt *testing.T) {
 	var zeroValue string
-	p := &PushEventRepository{SVNURL: &zeroValue}
-	p.GetSVNURL()
-	p = &PushEventRepository{}
-	p.GetSVNURL()
-	p = nil
-	p.GetSVNURL()
+	r := &RepositoryContent{Type: &zeroValue}
+	r.GetType()
+	r = &RepositoryContent{}
+	r.GetType()
+	r = nil
+	r.GetType()
 }
 
-func TestPushEventRepository_GetUpdatedAt(tt *testing.T) {
-	var zeroValue Timestamp
-	p := &PushEventRepository{UpdatedAt: &zeroValue}
-	p.GetUpdatedAt()
-	p = &PushEventRepository{}
-	p.GetUpdatedAt()
-	p = nil
-	p.GetUpdatedAt()
+func TestRepositoryContent_GetURL(tt *testing.T) {
+	var zeroValue string
+	r := &RepositoryContent{URL: &zeroValue}
+	r.GetURL()
+	r = &RepositoryContent{}
+	r.GetURL()
+	r = nil
+	r.GetURL()
 }
 
-func TestPushEventRepository_GetURL(tt *testing.T) {
-	var zeroValue string
-	p := &PushEventRepository{URL: &zeroValue}
-	p.GetURL()
-	p = &PushEventRepository{}
-	p.GetURL()
-	p = nil
-	p.GetURL()
+func TestRepositoryContentFileOptions_GetAuthor(tt *testing.T) {
+	r := &RepositoryContentFileOptions{}
+	r.GetAuthor()
+	r = nil
+	r.GetAuthor()
 }
 
-func TestPushEventRepository_GetWatchersCount(tt *testing.T) {
-	var zeroValue int
-	p := &PushEventRepository{WatchersCount: &zeroValue}
-	p.GetWatchersCount()
-	p = &PushEventRepository{}
-	p.GetWatchersCount()
-	p = nil
-	p.GetWatchersCount()
+func TestRepositoryContentFileOptions_GetBranch(tt *testing.T) {
+	var zeroValue string
+	r := &RepositoryContentFileOptions{Branch: &zeroValue}
+	r.GetBranch()
+	r = &RepositoryContentFileOptions{}
+	r.GetBranch()
+	r = nil
+	r.GetBranch()
 }
 
-func TestRateLimits_GetCore(tt *testing.T) {
-	r := &RateLimits{}
-	r.GetCore()
+func TestRepositoryContentFileOptions_GetCommitter(tt *testing.T) {
+	r := &RepositoryContentFileOptions{}
+	r.GetCommitter()
 	r = nil
-	r.GetCore()
+	r.GetCommitter()
 }
 
-func TestRateLimits_GetSearch(tt *testing.T) {
-	r := &RateLimits{}
-	r.GetSearch()
+func TestRepositoryContentFileOptions_GetMessage(tt *testing.T) {
+	var zeroValue string
+	r := &RepositoryContentFileOptions{Message: &zeroValue}
+	r.GetMessage()
+	r = &RepositoryContentFileOptions{}
+	r.GetMessage()
 	r = nil
-	r.GetSearch()
+	r.GetMessage()
 }
 
-func TestReaction_GetContent(tt *testing.T) {
+func TestRepositoryContentFileOptions_GetSHA(tt *testing.T) {
 	var zeroValue string
-	r := &Reaction{Content: &zeroValue}
-	r.GetContent()
-	r = &Reaction{}
+	r := &RepositoryContentFileOptions{SHA: &zeroValue}
+	r.GetSHA()
+	r = &RepositoryContentFileOptions{}
+	r.GetSHA()
+	r = nil
+	r.GetSHA()
+}
+
+func TestRepositoryContentResponse_GetContent(tt *testing.T) {
+	r := &RepositoryContentResponse{}
 	r.GetContent()
 	r = nil
 	r.GetContent()
 }
 
-func TestReaction_GetID(tt *testing.T) {
-	var zeroValue int64
-	r := &Reaction{ID: &zeroValue}
-	r.GetID()
-	r = &Reaction{}
-	r.GetID()
+func TestRepositoryDispatchEvent_GetAction(tt *testing.T) {
+	var zeroValue string
+	r := &RepositoryDispatchEvent{Action: &zeroValue}
+	r.GetAction()
+	r = &RepositoryDispatchEvent{}
+	r.GetAction()
 	r = nil
-	r.GetID()
+	r.GetAction()
 }
 
-func TestReaction_GetNodeID(tt *testing.T) {
+func TestRepositoryDispatchEvent_GetBranch(tt *testing.T) {
 	var zeroValue string
-	r := &Reaction{NodeID: &zeroValue}
-	r.GetNodeID()
-	r = &Reaction{}
-	r.GetNodeID()
+	r := &RepositoryDispatchEvent{Branch: &zeroValue}
+	r.GetBranch()
+	r = &RepositoryDispatchEvent{}
+	r.GetBranch()
 	r = nil
-	r.GetNodeID()
+	r.GetBranch()
 }
 
-func TestReaction_GetUser(tt *testing.T) {
-	r := &Reaction{}
-	r.GetUser()
+func TestRepositoryDispatchEvent_GetInstallation(tt *testing.T) {
+	r := &RepositoryDispatchEvent{}
+	r.GetInstallation()
 	r = nil
-	r.GetUser()
+	r.GetInstallation()
 }
 
-func TestReactions_GetConfused(tt *testing.T) {
-	var zeroValue int
-	r := &Reactions{Confused: &zeroValue}
-	r.GetConfused()
-	r = &Reactions{}
-	r.GetConfused()
+func TestRepositoryDispatchEvent_GetOrg(tt *testing.T) {
+	r := &RepositoryDispatchEvent{}
+	r.GetOrg()
 	r = nil
-	r.GetConfused()
+	r.GetOrg()
 }
 
-func TestReactions_GetEyes(tt *testing.T) {
-	var zeroValue int
-	r := &Reactions{Eyes: &zeroValue}
-	r.GetEyes()
-	r = &Reactions{}
-	r.GetEyes()
+func TestRepositoryDispatchEvent_GetRepo(tt *testing.T) {
+	r := &RepositoryDispatchEvent{}
+	r.GetRepo()
 	r = nil
-	r.GetEyes()
+	r.GetRepo()
 }
 
-func TestReactions_GetHeart(tt *testing.T) {
-	var zeroValue int
-	r := &Reactions{Heart: &zeroValue}
-	r.GetHeart()
-	r = &Reactions{}
-	r.GetHeart()
+func TestRepositoryDispatchEvent_GetSender(tt *testing.T) {
+	r := &RepositoryDispatchEvent{}
+	r.GetSender()
 	r = nil
-	r.GetHeart()
+	r.GetSender()
 }
 
-func TestReactions_GetHooray(tt *testing.T) {
-	var zeroValue int
-	r := &Reactions{Hooray: &zeroValue}
-	r.GetHooray()
-	r = &Reactions{}
-	r.GetHooray()
+func TestRepositoryEvent_GetAction(tt *testing.T) {
+	var zeroValue string
+	r := &RepositoryEvent{Action: &zeroValue}
+	r.GetAction()
+	r = &RepositoryEvent{}
+	r.GetAction()
 	r = nil
-	r.GetHooray()
+	r.GetAction()
 }
 
-func TestReactions_GetLaugh(tt *testing.T) {
-	var zeroValue int
-	r := &Reactions{Laugh: &zeroValue}
-	r.GetLaugh()
-	r = &Reactions{}
-	r.GetLaugh()
+func TestRepositoryEvent_GetInstallation(tt *testing.T) {
+	r := &RepositoryEvent{}
+	r.GetInstallation()
 	r = nil
-	r.GetLaugh()
+	r.GetInstallation()
 }
 
-func TestReactions_GetMinusOne(tt *testing.T) {
-	var zeroValue int
-	r := &Reactions{MinusOne: &zeroValue}
-	r.GetMinusOne()
-	r = &Reactions{}
-	r.GetMinusOne()
+func TestRepositoryEvent_GetOrg(tt *testing.T) {
+	r := &RepositoryEvent{}
+	r.GetOrg()
 	r = nil
-	r.GetMinusOne()
+	r.GetOrg()
 }
 
-func TestReactions_GetPlusOne(tt *testing.T) {
-	var zeroValue int
-	r := &Reactions{PlusOne: &zeroValue}
-	r.GetPlusOne()
-	r = &Reactions{}
-	r.GetPlusOne()
+func TestRepositoryEvent_GetRepo(tt *testing.T) {
+	r := &RepositoryEvent{}
+	r.GetRepo()
 	r = nil
-	r.GetPlusOne()
+	r.GetRepo()
 }
 
-func TestReactions_GetRocket(tt *testing.T) {
-	var zeroValue int
-	r := &Reactions{Rocket: &zeroValue}
-	r.GetRocket()
-	r = &Reactions{}
-	r.GetRocket()
+func TestRepositoryEvent_GetSender(tt *testing.T) {
+	r := &RepositoryEvent{}
+	r.GetSender()
 	r = nil
-	r.GetRocket()
+	r.GetSender()
 }
 
-func TestReactions_GetTotalCount(tt *testing.T) {
-	var zeroValue int
-	r := &Reactions{TotalCount: &zeroValue}
-	r.GetTotalCount()
-	r = &Reactions{}
-	r.GetTotalCount()
+func TestRepositoryImmutableReleases_GetEnabled(tt *testing.T) {
+	var zeroValue bool
+	r := &RepositoryImmutableReleases{Enabled: &zeroValue}
+	r.GetEnabled()
+	r = &RepositoryImmutableReleases{}
+	r.GetEnabled()
 	r = nil
-	r.GetTotalCount()
+	r.GetEnabled()
 }
 
-func TestReactions_GetURL(tt *testing.T) {
-	var zeroValue string
-	r := &Reactions{URL: &zeroValue}
-	r.GetURL()
-	r = &Reactions{}
-	r.GetURL()
+func TestRepositoryImmutableReleases_GetEnforcedByOwner(tt *testing.T) {
+	var zeroValue bool
+	r := &RepositoryImmutableReleases{EnforcedByOwner: &zeroValue}
+	r.GetEnforcedByOwner()
+	r = &RepositoryImmutableReleases{}
+	r.GetEnforcedByOwner()
 	r = nil
-	r.GetURL()
+	r.GetEnforcedByOwner()
 }
 
-func TestReference_GetNodeID(tt *testing.T) {
+func TestRepositoryInvitation_GetCreatedAt(tt *testing.T) {
+	var zeroValue Timestamp
+	r := &RepositoryInvitation{CreatedAt: &zeroValue}
+	r.GetCreatedAt()
+	r = &RepositoryInvitation{}
+	r.GetCreatedAt()
+	r = nil
+	r.GetCreatedAt()
+}
+
+func TestRepositoryInvitation_GetHTMLURL(tt *testing.T) {
 	var zeroValue string
-	r := &Reference{NodeID: &zeroValue}
-	r.GetNodeID()
-	r = &Reference{}
-	r.GetNodeID()
+	r := &RepositoryInvitation{HTMLURL: &zeroValue}
+	r.GetHTMLURL()
+	r = &RepositoryInvitation{}
+	r.GetHTMLURL()
 	r = nil
-	r.GetNodeID()
+	r.GetHTMLURL()
 }
 
-func TestReference_GetObject(tt *testing.T) {
-	r := &Reference{}
-	r.GetObject()
+func TestRepositoryInvitation_GetID(tt *testing.T) {
+	var zeroValue int64
+	r := &RepositoryInvitation{ID: &zeroValue}
+	r.GetID()
+	r = &RepositoryInvitation{}
+	r.GetID()
 	r = nil
-	r.GetObject()
+	r.GetID()
 }
 
-func TestReference_GetRef(tt *testing.T) {
-	var zeroValue string
-	r := &Reference{Ref: &zeroValue}
-	r.GetRef()
-	r = &Reference{}
-	r.GetRef()
+func TestRepositoryInvitation_GetInvitee(tt *testing.T) {
+	r := &RepositoryInvitation{}
+	r.GetInvitee()
 	r = nil
-	r.GetRef()
+	r.GetInvitee()
 }
 
-func TestReference_GetURL(tt *testing.T) {
+func TestRepositoryInvitation_GetInviter(tt *testing.T) {
+	r := &RepositoryInvitation{}
+	r.GetInviter()
+	r = nil
+	r.GetInviter()
+}
+
+func TestRepositoryInvitation_GetPermissions(tt *testing.T) {
 	var zeroValue string
-	r := &Reference{URL: &zeroValue}
-	r.GetURL()
-	r = &Reference{}
-	r.GetURL()
+	r := &RepositoryInvitation{Permissions: &zeroValue}
+	r.GetPermissions()
+	r = &RepositoryInvitation{}
+	r.GetPermissions()
 	r = nil
-	r.GetURL()
+	r.GetPermissions()
 }
 
-func TestRegistrationToken_GetExpiresAt(tt *testing.T) {
-	var zeroValue Timestamp
-	r := &RegistrationToken{ExpiresAt: &zeroValue}
-	r.GetExpiresAt()
-	r = &RegistrationToken{}
-	r.GetExpiresAt()
+func TestRepositoryInvitation_GetRepo(tt *testing.T) {
+	r := &RepositoryInvitation{}
+	r.GetRepo()
 	r = nil
-	r.GetExpiresAt()
+	r.GetRepo()
 }
 
-func TestRegistrationToken_GetToken(tt *testing.T) {
+func TestRepositoryInvitation_GetURL(tt *testing.T) {
 	var zeroValue string
-	r := &RegistrationToken{Token: &zeroValue}
-	r.GetToken()
-	r = &RegistrationToken{}
-	r.GetToken()
+	r := &RepositoryInvitation{URL: &zeroValue}
+	r.GetURL()
+	r = &RepositoryInvitation{}
+	r.GetURL()
 	r = nil
-	r.GetToken()
+	r.GetURL()
 }
 
-func TestReleaseAsset_GetBrowserDownloadURL(tt *testing.T) {
+func TestRepositoryLicense_GetContent(tt *testing.T) {
 	var zeroValue string
-	r := &ReleaseAsset{BrowserDownloadURL: &zeroValue}
-	r.GetBrowserDownloadURL()
-	r = &ReleaseAsset{}
-	r.GetBrowserDownloadURL()
+	r := &RepositoryLicense{Content: &zeroValue}
+	r.GetContent()
+	r = &RepositoryLicense{}
+	r.GetContent()
 	r = nil
-	r.GetBrowserDownloadURL()
+	r.GetContent()
 }
 
-func TestReleaseAsset_GetContentType(tt *testing.T) {
+func TestRepositoryLicense_GetDownloadURL(tt *testing.T) {
 	var zeroValue string
-	r := &ReleaseAsset{ContentType: &zeroValue}
-	r.GetContentType()
-	r = &ReleaseAsset{}
-	r.GetContentType()
+	r := &RepositoryLicense{DownloadURL: &zeroValue}
+	r.GetDownloadURL()
+	r = &RepositoryLicense{}
+	r.GetDownloadURL()
 	r = nil
-	r.GetContentType()
+	r.GetDownloadURL()
 }
 
-func TestReleaseAsset_GetCreatedAt(tt *testing.T) {
-	var zeroValue Timestamp
-	r := &ReleaseAsset{CreatedAt: &zeroValue}
-	r.GetCreatedAt()
-	r = &ReleaseAsset{}
-	r.GetCreatedAt()
+func TestRepositoryLicense_GetEncoding(tt *testing.T) {
+	var zeroValue string
+	r := &RepositoryLicense{Encoding: &zeroValue}
+	r.GetEncoding()
+	r = &RepositoryLicense{}
+	r.GetEncoding()
 	r = nil
-	r.GetCreatedAt()
+	r.GetEncoding()
 }
 
-func TestReleaseAsset_GetDownloadCount(tt *testing.T) {
-	var zeroValue int
-	r := &ReleaseAsset{DownloadCount: &zeroValue}
-	r.GetDownloadCount()
-	r = &ReleaseAsset{}
-	r.GetDownloadCount()
+func TestRepositoryLicense_GetGitURL(tt *testing.T) {
+	var zeroValue string
+	r := &RepositoryLicense{GitURL: &zeroValue}
+	r.GetGitURL()
+	r = &RepositoryLicense{}
+	r.GetGitURL()
 	r = nil
-	r.GetDownloadCount()
+	r.GetGitURL()
 }
 
-func TestReleaseAsset_GetID(tt *testing.T) {
-	var zeroValue int64
-	r := &ReleaseAsset{ID: &zeroValue}
-	r.GetID()
-	r = &ReleaseAsset{}
-	r.GetID()
+func TestRepositoryLicense_GetHTMLURL(tt *testing.T) {
+	var zeroValue string
+	r := &RepositoryLicense{HTMLURL: &zeroValue}
+	r.GetHTMLURL()
+	r = &RepositoryLicense{}
+	r.GetHTMLURL()
 	r = nil
-	r.GetID()
+	r.GetHTMLURL()
 }
 
-func TestReleaseAsset_GetLabel(tt *testing.T) {
-	var zeroValue string
-	r := &ReleaseAsset{Label: &zeroValue}
-	r.GetLabel()
-	r = &ReleaseAsset{}
-	r.GetLabel()
+func TestRepositoryLicense_GetLicense(tt *testing.T) {
+	r := &RepositoryLicense{}
+	r.GetLicense()
 	r = nil
-	r.GetLabel()
+	r.GetLicense()
 }
 
-func TestReleaseAsset_GetName(tt *testing.T) {
+func TestRepositoryLicense_GetName(tt *testing.T) {
 	var zeroValue string
-	r := &ReleaseAsset{Name: &zeroValue}
+	r := &RepositoryLicense{Name: &zeroValue}
 	r.GetName()
-	r = &ReleaseAsset{}
+	r = &RepositoryLicense{}
 	r.GetName()
 	r = nil
 	r.GetName()
 }
 
-func TestReleaseAsset_GetNodeID(tt *testing.T) {
+func TestRepositoryLicense_GetPath(tt *testing.T) {
 	var zeroValue string
-	r := &ReleaseAsset{NodeID: &zeroValue}
-	r.GetNodeID()
-	r = &ReleaseAsset{}
-	r.GetNodeID()
+	r := &RepositoryLicense{Path: &zeroValue}
+	r.GetPath()
+	r = &RepositoryLicense{}
+	r.GetPath()
 	r = nil
-	r.GetNodeID()
+	r.GetPath()
 }
 
-func TestReleaseAsset_GetSize(tt *testing.T) {
+func TestRepositoryLicense_GetSHA(tt *testing.T) {
+	var zeroValue string
+	r := &RepositoryLicense{SHA: &zeroValue}
+	r.GetSHA()
+	r = &RepositoryLicense{}
+	r.GetSHA()
+	r = nil
+	r.GetSHA()
+}
+
+func TestRepositoryLicense_GetSize(tt *testing.T) {
 	var zeroValue int
-	r := &ReleaseAsset{Size: &zeroValue}
+	r := &RepositoryLicense{Size: &zeroValue}
 	r.GetSize()
-	r = &ReleaseAsset{}
+	r = &RepositoryLicense{}
 	r.GetSize()
 	r = nil
 	r.GetSize()
 }
 
-func TestReleaseAsset_GetState(tt *testing.T) {
+func TestRepositoryLicense_GetType(tt *testing.T) {
 	var zeroValue string
-	r := &ReleaseAsset{State: &zeroValue}
-	r.GetState()
-	r = &ReleaseAsset{}
-	r.GetState()
-	r = nil
-	r.GetState()
-}
-
-func TestReleaseAsset_GetUpdatedAt(tt *testing.T) {
-	var zeroValue Timestamp
-	r := &ReleaseAsset{UpdatedAt: &zeroValue}
-	r.GetUpdatedAt()
-	r = &ReleaseAsset{}
-	r.GetUpdatedAt()
-	r = nil
-	r.GetUpdatedAt()
-}
-
-func TestReleaseAsset_GetUploader(tt *testing.T) {
-	r := &ReleaseAsset{}
-	r.GetUploader()
+	r := &RepositoryLicense{Type: &zeroValue}
+	r.GetType()
+	r = &RepositoryLicense{}
+	r.GetType()
 	r = nil
-	r.GetUploader()
+	r.GetType()
 }
 
-func TestReleaseAsset_GetURL(tt *testing.T) {
+func TestRepositoryLicense_GetURL(tt *testing.T) {
 	var zeroValue string
-	r := &ReleaseAsset{URL: &zeroValue}
+	r := &RepositoryLicense{URL: &zeroValue}
 	r.GetURL()
-	r = &ReleaseAsset{}
+	r = &RepositoryLicense{}
 	r.GetURL()
 	r = nil
 	r.GetURL()
 }
 
-func TestReleaseEvent_GetAction(tt *testing.T) {
+func TestRepositoryMergeRequest_GetBase(tt *testing.T) {
 	var zeroValue string
-	r := &ReleaseEvent{Action: &zeroValue}
-	r.GetAction()
-	r = &ReleaseEvent{}
-	r.GetAction()
+	r := &RepositoryMergeRequest{Base: &zeroValue}
+	r.GetBase()
+	r = &RepositoryMergeRequest{}
+	r.GetBase()
 	r = nil
-	r.GetAction()
+	r.GetBase()
 }
 
-func TestReleaseEvent_GetInstallation(tt *testing.T) {
-	r := &ReleaseEvent{}
-	r.GetInstallation()
+func TestRepositoryMergeRequest_GetCommitMessage(tt *testing.T) {
+	var zeroValue string
+	r := &RepositoryMergeRequest{CommitMessage: &zeroValue}
+	r.GetCommitMessage()
+	r = &RepositoryMergeRequest{}
+	r.GetCommitMessage()
 	r = nil
-	r.GetInstallation()
+	r.GetCommitMessage()
 }
 
-func TestReleaseEvent_GetRelease(tt *testing.T) {
-	r := &ReleaseEvent{}
-	r.GetRelease()
+func TestRepositoryMergeRequest_GetHead(tt *testing.T) {
+	var zeroValue string
+	r := &RepositoryMergeRequest{Head: &zeroValue}
+	r.GetHead()
+	r = &RepositoryMergeRequest{}
+	r.GetHead()
 	r = nil
-	r.GetRelease()
+	r.GetHead()
 }
 
-func TestReleaseEvent_GetRepo(tt *testing.T) {
-	r := &ReleaseEvent{}
-	r.GetRepo()
+func TestRepositoryPermissionLevel_GetPermission(tt *testing.T) {
+	var zeroValue string
+	r := &RepositoryPermissionLevel{Permission: &zeroValue}
+	r.GetPermission()
+	r = &RepositoryPermissionLevel{}
+	r.GetPermission()
 	r = nil
-	r.GetRepo()
+	r.GetPermission()
 }
 
-func TestReleaseEvent_GetSender(tt *testing.T) {
-	r := &ReleaseEvent{}
-	r.GetSender()
+func TestRepositoryPermissionLevel_GetUser(tt *testing.T) {
+	r := &RepositoryPermissionLevel{}
+	r.GetUser()
 	r = nil
-	r.GetSender()
+	r.GetUser()
 }
 
-func TestRemoveToken_GetExpiresAt(tt *testing.T) {
-	var zeroValue Timestamp
-	r := &RemoveToken{ExpiresAt: &zeroValue}
-	r.GetExpiresAt()
-	r = &RemoveToken{}
-	r.GetExpiresAt()
+func TestRepositoryRelease_GetAssetsURL(tt *testing.T) {
+	var zeroValue string
+	r := &RepositoryRelease{AssetsURL: &zeroValue}
+	r.GetAssetsURL()
+	r = &RepositoryRelease{}
+	r.GetAssetsURL()
 	r = nil
-	r.GetExpiresAt()
+	r.GetAssetsURL()
 }
 
-func TestRemoveToken_GetToken(tt *testing.T) {
-	var zeroValue string
-	r := &RemoveToken{Token: &zeroValue}
-	r.GetToken()
-	r = &RemoveToken{}
-	r.GetToken()
+func TestRepositoryRelease_GetAuthor(tt *testing.T) {
+	r := &RepositoryRelease{}
+	r.GetAuthor()
 	r = nil
-	r.GetToken()
+	r.GetAuthor()
 }
 
-func TestRename_GetFrom(tt *testing.T) {
+func TestRepositoryRelease_GetBody(tt *testing.T) {
 	var zeroValue string
-	r := &Rename{From: &zeroValue}
-	r.GetFrom()
-	r = &Rename{}
-	r.GetFrom()
+	r := &RepositoryRelease{Body: &zeroValue}
+	r.GetBody()
+	r = &RepositoryRelease{}
+	r.GetBody()
 	r = nil
-	r.GetFrom()
+	r.GetBody()
 }
 
-func TestRename_GetTo(tt *testing.T) {
-	var zeroValue string
-	r := &Rename{To: &zeroValue}
-	r.GetTo()
-	r = &Rename{}
-	r.GetTo()
+func TestRepositoryRelease_GetCreatedAt(tt *testing.T) {
+	var zeroValue Timestamp
+	r := &RepositoryRelease{CreatedAt: &zeroValue}
+	r.GetCreatedAt()
+	r = &RepositoryRelease{}
+	r.GetCreatedAt()
 	r = nil
-	r.GetTo()
+	r.GetCreatedAt()
 }
 
-func TestRenameOrgResponse_GetMessage(tt *testing.T) {
+func TestRepositoryRelease_GetDiscussionCategoryName(tt *testing.T) {
 	var zeroValue string
-	r := &RenameOrgResponse{Message: &zeroValue}
-	r.GetMessage()
-	r = &RenameOrgResponse{}
-	r.GetMessage()
+	r := &RepositoryRelease{DiscussionCategoryName: &zeroValue}
+	r.GetDiscussionCategoryName()
+	r = &RepositoryRelease{}
+	r.GetDiscussionCategoryName()
 	r = nil
-	r.GetMessage()
+	r.GetDiscussionCategoryName()
 }
 
-func TestRenameOrgResponse_GetURL(tt *testing.T) {
+func TestRepositoryRelease_GetDiscussionURL(tt *testing.T) {
 	var zeroValue string
-	r := &RenameOrgResponse{URL: &zeroValue}
-	r.GetURL()
-	r = &RenameOrgResponse{}
-	r.GetURL()
+	r := &RepositoryRelease{DiscussionURL: &zeroValue}
+	r.GetDiscussionURL()
+	r = &RepositoryRelease{}
+	r.GetDiscussionURL()
 	r = nil
-	r.GetURL()
+	r.GetDiscussionURL()
 }
 
-func TestRepositoriesSearchResult_GetIncompleteResults(tt *testing.T) {
+func TestRepositoryRelease_GetDraft(tt *testing.T) {
 	var zeroValue bool
-	r := &RepositoriesSearchResult{IncompleteResults: &zeroValue}
-	r.GetIncompleteResults()
-	r = &RepositoriesSearchResult{}
-	r.GetIncompleteResults()
+	r := &RepositoryRelease{Draft: &zeroValue}
+	r.GetDraft()
+	r = &RepositoryRelease{}
+	r.GetDraft()
 	r = nil
-	r.GetIncompleteResults()
+	r.GetDraft()
 }
 
-func TestRepositoriesSearchResult_GetTotal(tt *testing.T) {
-	var zeroValue int
-	r := &RepositoriesSearchResult{Total: &zeroValue}
-	r.GetTotal()
-	r = &RepositoriesSearchResult{}
-	r.GetTotal()
+func TestRepositoryRelease_GetHTMLURL(tt *testing.T) {
+	var zeroValue string
+	r := &RepositoryRelease{HTMLURL: &zeroValue}
+	r.GetHTMLURL()
+	r = &RepositoryRelease{}
+	r.GetHTMLURL()
 	r = nil
-	r.GetTotal()
+	r.GetHTMLURL()
 }
 
-func TestRepository_GetAllowMergeCommit(tt *testing.T) {
-	var zeroValue bool
-	r := &Repository{AllowMergeCommit: &zeroValue}
-	r.GetAllowMergeCommit()
-	r = &Repository{}
-	r.GetAllowMergeCommit()
+func TestRepositoryRelease_GetID(tt *testing.T) {
+	var zeroValue int64
+	r := &RepositoryRelease{ID: &zeroValue}
+	r.GetID()
+	r = &RepositoryRelease{}
+	r.GetID()
 	r = nil
-	r.GetAllowMergeCommit()
+	r.GetID()
 }
 
-func TestRepository_GetAllowRebaseMerge(tt *testing.T) {
-	var zeroValue bool
-	r := &Repository{AllowRebaseMerge: &zeroValue}
-	r.GetAllowRebaseMerge()
-	r = &Repository{}
-	r.GetAllowRebaseMerge()
+func TestRepositoryRelease_GetName(tt *testing.T) {
+	var zeroValue string
+	r := &RepositoryRelease{Name: &zeroValue}
+	r.GetName()
+	r = &RepositoryRelease{}
+	r.GetName()
 	r = nil
-	r.GetAllowRebaseMerge()
+	r.GetName()
 }
 
-func TestRepository_GetAllowSquashMerge(tt *testing.T) {
-	var zeroValue bool
-	r := &Repository{AllowSquashMerge: &zeroValue}
-	r.GetAllowSquashMerge()
-	r = &Repository{}
-	r.GetAllowSquashMerge()
+func TestRepositoryRelease_GetNodeID(tt *testing.T) {
+	var zeroValue string
+	r := &RepositoryRelease{NodeID: &zeroValue}
+	r.GetNodeID()
+	r = &RepositoryRelease{}
+	r.GetNodeID()
 	r = nil
-	r.GetAllowSquashMerge()
+	r.GetNodeID()
 }
 
-func TestRepository_GetArchived(tt *testing.T) {
+func TestRepositoryRelease_GetPrerelease(tt *testing.T) {
 	var zeroValue bool
-	r := &Repository{Archived: &zeroValue}
-	r.GetArchived()
-	r = &Repository{}
-	r.GetArchived()
+	r := &RepositoryRelease{Prerelease: &zeroValue}
+	r.GetPrerelease()
+	r = &RepositoryRelease{}
+	r.GetPrerelease()
 	r = nil
-	r.GetArchived()
+	r.GetPrerelease()
 }
 
-func TestRepository_GetArchiveURL(tt *testing.T) {
+func TestRepositoryRelease_GetPublishedAt(tt *testing.T) {
+	var zeroValue Timestamp
+	r := &RepositoryRelease{PublishedAt: &zeroValue}
+	r.GetPublishedAt()
+	r = &RepositoryRelease{}
+	r.GetPublishedAt()
+	r = nil
+	r.GetPublishedAt()
+}
+
+func TestRepositoryRelease_GetTagName(tt *testing.T) {
 	var zeroValue string
-	r := &Repository{ArchiveURL: &zeroValue}
-	r.GetArchiveURL()
-	r = &Repository{}
-	r.GetArchiveURL()
+	r := &RepositoryRelease{TagName: &zeroValue}
+	r.GetTagName()
+	r = &RepositoryRelease{}
+	r.GetTagName()
 	r = nil
-	r.GetArchiveURL()
+	r.GetTagName()
 }
 
-func TestRepository_GetAssigneesURL(tt *testing.T) {
+func TestRepositoryRelease_GetTarballURL(tt *testing.T) {
 	var zeroValue string
-	r := &Repository{AssigneesURL: &zeroValue}
-	r.GetAssigneesURL()
-	r = &Repository{}
-	r.GetAssigneesURL()
+	r := &RepositoryRelease{TarballURL: &zeroValue}
+	r.GetTarballURL()
+	r = &RepositoryRelease{}
+	r.GetTarballURL()
 	r = nil
-	r.GetAssigneesURL()
+	r.GetTarballURL()
 }
 
-func TestRepository_GetAutoInit(tt *testing.T) {
-	var zeroValue bool
-	r := &Repository{AutoInit: &zeroValue}
-	r.GetAutoInit()
-	r = &Repository{}
-	r.GetAutoInit()
+func TestRepositoryRelease_GetTargetCommitish(tt *testing.T) {
+	var zeroValue string
+	r := &RepositoryRelease{TargetCommitish: &zeroValue}
+	r.GetTargetCommitish()
+	r = &RepositoryRelease{}
+	r.GetTargetCommitish()
 	r = nil
-	r.GetAutoInit()
+	r.GetTargetCommitish()
 }
 
-func TestRepository_GetBlobsURL(tt *testing.T) {
+func TestRepositoryRelease_GetUploadURL(tt *testing.T) {
 	var zeroValue string
-	r := &Repository{BlobsURL: &zeroValue}
-	r.GetBlobsURL()
-	r = &Repository{}
-	r.GetBlobsURL()
+	r := &RepositoryRelease{UploadURL: &zeroValue}
+	r.GetUploadURL()
+	r = &RepositoryRelease{}
+	r.GetUploadURL()
 	r = nil
-	r.GetBlobsURL()
+	r.GetUploadURL()
 }
 
-func TestRepository_GetBranchesURL(tt *testing.T) {
+func TestRepositoryRelease_GetURL(tt *testing.T) {
 	var zeroValue string
-	r := &Repository{BranchesURL: &zeroValue}
-	r.GetBranchesURL()
-	r = &Repository{}
-	r.GetBranchesURL()
+	r := &RepositoryRelease{URL: &zeroValue}
+	r.GetURL()
+	r = &RepositoryRelease{}
+	r.GetURL()
 	r = nil
-	r.GetBranchesURL()
+	r.GetURL()
 }
 
-func TestRepository_GetCloneURL(tt *testing.T) {
+func TestRepositoryRelease_GetZipballURL(tt *testing.T) {
 	var zeroValue string
-	r := &Repository{CloneURL: &zeroValue}
-	r.GetCloneURL()
-	r = &Repository{}
-	r.GetCloneURL()
+	r := &RepositoryRelease{ZipballURL: &zeroValue}
+	r.GetZipballURL()
+	r = &RepositoryRelease{}
+	r.GetZipballURL()
 	r = nil
-	r.GetCloneURL()
+	r.GetZipballURL()
 }
 
-func TestRepository_GetCodeOfConduct(tt *testing.T) {
-	r := &Repository{}
-	r.GetCodeOfConduct()
+func TestRepositoryRule_GetParameters(tt *testing.T) {
+	var zeroValue json.RawMessage
+	r := &RepositoryRule{Parameters: &zeroValue}
+	r.GetParameters()
+	r = &RepositoryRule{}
+	r.GetParameters()
 	r = nil
-	r.GetCodeOfConduct()
+	r.GetParameters()
 }
 
-func TestRepository_GetCollaboratorsURL(tt *testing.T) {
-	var zeroValue string
-	r := &Repository{CollaboratorsURL: &zeroValue}
-	r.GetCollaboratorsURL()
-	r = &Repository{}
-	r.GetCollaboratorsURL()
+func TestRepositoryTag_GetCommit(tt *testing.T) {
+	r := &RepositoryTag{}
+	r.GetCommit()
 	r = nil
-	r.GetCollaboratorsURL()
+	r.GetCommit()
 }
 
-func TestRepository_GetCommentsURL(tt *testing.T) {
+func TestRepositoryTag_GetName(tt *testing.T) {
 	var zeroValue string
-	r := &Repository{CommentsURL: &zeroValue}
-	r.GetCommentsURL()
-	r = &Repository{}
-	r.GetCommentsURL()
+	r := &RepositoryTag{Name: &zeroValue}
+	r.GetName()
+	r = &RepositoryTag{}
+	r.GetName()
 	r = nil
-	r.GetCommentsURL()
+	r.GetName()
 }
 
-func TestRepository_GetCommitsURL(tt *testing.T) {
+func TestRepositoryTag_GetTarballURL(tt *testing.T) {
 	var zeroValue string
-	r := &Repository{CommitsURL: &zeroValue}
-	r.GetCommitsURL()
-	r = &Repository{}
-	r.GetCommitsURL()
+	r := &RepositoryTag{TarballURL: &zeroValue}
+	r.GetTarballURL()
+	r = &RepositoryTag{}
+	r.GetTarballURL()
 	r = nil
-	r.GetCommitsURL()
+	r.GetTarballURL()
 }
 
-func TestRepository_GetCompareURL(tt *testing.T) {
+func TestRepositoryTag_GetZipballURL(tt *testing.T) {
 	var zeroValue string
-	r := &Repository{CompareURL: &zeroValue}
-	r.GetCompareURL()
-	r = &Repository{}
-	r.GetCompareURL()
+	r := &RepositoryTag{ZipballURL: &zeroValue}
+	r.GetZipballURL()
+	r = &RepositoryTag{}
+	r.GetZipballURL()
 	r = nil
-	r.GetCompareURL()
+	r.GetZipballURL()
 }
 
-func TestRepository_GetContentsURL(tt *testing.T) {
+func TestRepositoryVulnerabilityAlertEvent_GetAction(tt *testing.T) {
 	var zeroValue string
-	r := &Repository{ContentsURL: &zeroValue}
-	r.GetContentsURL()
-	r = &Repository{}
-	r.GetContentsURL()
+	r := &RepositoryVulnerabilityAlertEvent{Action: &zeroValue}
+	r.GetAction()
+	r = &RepositoryVulnerabilityAlertEvent{}
+	r.GetAction()
+	r = nil
+	r.GetAction()
+}
+
+func TestRepositoryVulnerabilityAlertEvent_GetRepository(tt *testing.T) {
+	r := &RepositoryVulnerabilityAlertEvent{}
+	r.GetRepository()
+	r = nil
+	r.GetRepository()
+}
+
+func TestRepoStats_GetForkRepos(tt *testing.T) {
+	var zeroValue int
+	r := &RepoStats{ForkRepos: &zeroValue}
+	r.GetForkRepos()
+	r = &RepoStats{}
+	r.GetForkRepos()
 	r = nil
-	r.GetContentsURL()
+	r.GetForkRepos()
 }
 
-func TestRepository_GetContributorsURL(tt *testing.T) {
-	var zeroValue string
-	r := &Repository{ContributorsURL: &zeroValue}
-	r.GetContributorsURL()
-	r = &Repository{}
-	r.GetContributorsURL()
+func TestRepoStats_GetOrgRepos(tt *testing.T) {
+	var zeroValue int
+	r := &RepoStats{OrgRepos: &zeroValue}
+	r.GetOrgRepos()
+	r = &RepoStats{}
+	r.GetOrgRepos()
 	r = nil
-	r.GetContributorsURL()
+	r.GetOrgRepos()
 }
 
-func TestRepository_GetCreatedAt(tt *testing.T) {
-	var zeroValue Timestamp
-	r := &Repository{CreatedAt: &zeroValue}
-	r.GetCreatedAt()
-	r = &Repository{}
-	r.GetCreatedAt()
+func TestRepoStats_GetRootRepos(tt *testing.T) {
+	var zeroValue int
+	r := &RepoStats{RootRepos: &zeroValue}
+	r.GetRootRepos()
+	r = &RepoStats{}
+	r.GetRootRepos()
 	r = nil
-	r.GetCreatedAt()
+	r.GetRootRepos()
 }
 
-func TestRepository_GetDefaultBranch(tt *testing.T) {
-	var zeroValue string
-	r := &Repository{DefaultBranch: &zeroValue}
-	r.GetDefaultBranch()
-	r = &Repository{}
-	r.GetDefaultBranch()
+func TestRepoStats_GetTotalPushes(tt *testing.T) {
+	var zeroValue int
+	r := &RepoStats{TotalPushes: &zeroValue}
+	r.GetTotalPushes()
+	r = &RepoStats{}
+	r.GetTotalPushes()
 	r = nil
-	r.GetDefaultBranch()
+	r.GetTotalPushes()
 }
 
-func TestRepository_GetDeleteBranchOnMerge(tt *testing.T) {
-	var zeroValue bool
-	r := &Repository{DeleteBranchOnMerge: &zeroValue}
-	r.GetDeleteBranchOnMerge()
-	r = &Repository{}
-	r.GetDeleteBranchOnMerge()
+func TestRepoStats_GetTotalRepos(tt *testing.T) {
+	var zeroValue int
+	r := &RepoStats{TotalRepos: &zeroValue}
+	r.GetTotalRepos()
+	r = &RepoStats{}
+	r.GetTotalRepos()
 	r = nil
-	r.GetDeleteBranchOnMerge()
+	r.GetTotalRepos()
 }
 
-func TestRepository_GetDeploymentsURL(tt *testing.T) {
-	var zeroValue string
-	r := &Repository{DeploymentsURL: &zeroValue}
-	r.GetDeploymentsURL()
-	r = &Repository{}
-	r.GetDeploymentsURL()
+func TestRepoStats_GetTotalWikis(tt *testing.T) {
+	var zeroValue int
+	r := &RepoStats{TotalWikis: &zeroValue}
+	r.GetTotalWikis()
+	r = &RepoStats{}
+	r.GetTotalWikis()
 	r = nil
-	r.GetDeploymentsURL()
+	r.GetTotalWikis()
 }
 
-func TestRepository_GetDescription(tt *testing.T) {
+func TestRepoStatus_GetContext(tt *testing.T) {
 	var zeroValue string
-	r := &Repository{Description: &zeroValue}
-	r.GetDescription()
-	r = &Repository{}
-	r.GetDescription()
+	r := &RepoStatus{Context: &zeroValue}
+	r.GetContext()
+	r = &RepoStatus{}
+	r.GetContext()
 	r = nil
-	r.GetDescription()
+	r.GetContext()
 }
 
-func TestRepository_GetDisabled(tt *testing.T) {
-	var zeroValue bool
-	r := &Repository{Disabled: &zeroValue}
-	r.GetDisabled()
-	r = &Repository{}
-	r.GetDisabled()
+func TestRepoStatus_GetCreatedAt(tt *testing.T) {
+	var zeroValue time.Time
+	r := &RepoStatus{CreatedAt: &zeroValue}
+	r.GetCreatedAt()
+	r = &RepoStatus{}
+	r.GetCreatedAt()
 	r = nil
-	r.GetDisabled()
+	r.GetCreatedAt()
 }
 
-func TestRepository_GetDownloadsURL(tt *testing.T) {
-	var zeroValue string
-	r := &Repository{DownloadsURL: &zeroValue}
-	r.GetDownloadsURL()
-	r = &Repository{}
-	r.GetDownloadsURL()
+func TestRepoStatus_GetCreator(tt *testing.T) {
+	r := &RepoStatus{}
+	r.GetCreator()
 	r = nil
-	r.GetDownloadsURL()
+	r.GetCreator()
 }
 
-func TestRepository_GetEventsURL(tt *testing.T) {
+func TestRepoStatus_GetDescription(tt *testing.T) {
 	var zeroValue string
-	r := &Repository{EventsURL: &zeroValue}
-	r.GetEventsURL()
-	r = &Repository{}
-	r.GetEventsURL()
+	r := &RepoStatus{Description: &zeroValue}
+	r.GetDescription()
+	r = &RepoStatus{}
+	r.GetDescription()
 	r = nil
-	r.GetEventsURL()
+	r.GetDescription()
 }
 
-func TestRepository_GetFork(tt *testing.T) {
-	var zeroValue bool
-	r := &Repository{Fork: &zeroValue}
-	r.GetFork()
-	r = &Repository{}
-	r.GetFork()
+func TestRepoStatus_GetID(tt *testing.T) {
+	var zeroValue int64
+	r := &RepoStatus{ID: &zeroValue}
+	r.GetID()
+	r = &RepoStatus{}
+	r.GetID()
 	r = nil
-	r.GetFork()
+	r.GetID()
 }
 
-func TestRepository_GetForksCount(tt *testing.T) {
-	var zeroValue int
-	r := &Repository{ForksCount: &zeroValue}
-	r.GetForksCount()
-	r = &Repository{}
-	r.GetForksCount()
+func TestRepoStatus_GetNodeID(tt *testing.T) {
+	var zeroValue string
+	r := &RepoStatus{NodeID: &zeroValue}
+	r.GetNodeID()
+	r = &RepoStatus{}
+	r.GetNodeID()
 	r = nil
-	r.GetForksCount()
+	r.GetNodeID()
 }
 
-func TestRepository_GetForksURL(tt *testing.T) {
+func TestRepoStatus_GetState(tt *testing.T) {
 	var zeroValue string
-	r := &Repository{ForksURL: &zeroValue}
-	r.GetForksURL()
-	r = &Repository{}
-	r.GetForksURL()
+	r := &RepoStatus{State: &zeroValue}
+	r.GetState()
+	r = &RepoStatus{}
+	r.GetState()
 	r = nil
-	r.GetForksURL()
+	r.GetState()
 }
 
-func TestRepository_GetFullName(tt *testing.T) {
+func TestRepoStatus_GetTargetURL(tt *testing.T) {
 	var zeroValue string
-	r := &Repository{FullName: &zeroValue}
-	r.GetFullName()
-	r = &Repository{}
-	r.GetFullName()
+	r := &RepoStatus{TargetURL: &zeroValue}
+	r.GetTargetURL()
+	r = &RepoStatus{}
+	r.GetTargetURL()
 	r = nil
-	r.GetFullName()
+	r.GetTargetURL()
 }
 
-func TestRepository_GetGitCommitsURL(tt *testing.T) {
-	var zeroValue string
-	r := &Repository{GitCommitsURL: &zeroValue}
-	r.GetGitCommitsURL()
-	r = &Repository{}
-	r.GetGitCommitsURL()
+func TestRepoStatus_GetUpdatedAt(tt *testing.T) {
+	var zeroValue time.Time
+	r := &RepoStatus{UpdatedAt: &zeroValue}
+	r.GetUpdatedAt()
+	r = &RepoStatus{}
+	r.GetUpdatedAt()
 	r = nil
-	r.GetGitCommitsURL()
+	r.GetUpdatedAt()
 }
 
-func TestRepository_GetGitignoreTemplate(tt *testing.T) {
+func TestRepoStatus_GetURL(tt *testing.T) {
 	var zeroValue string
-	r := &Repository{GitignoreTemplate: &zeroValue}
-	r.GetGitignoreTemplate()
-	r = &Repository{}
-	r.GetGitignoreTemplate()
+	r := &RepoStatus{URL: &zeroValue}
+	r.GetURL()
+	r = &RepoStatus{}
+	r.GetURL()
 	r = nil
-	r.GetGitignoreTemplate()
+	r.GetURL()
 }
 
-func TestRepository_GetGitRefsURL(tt *testing.T) {
-	var zeroValue string
-	r := &Repository{GitRefsURL: &zeroValue}
-	r.GetGitRefsURL()
-	r = &Repository{}
-	r.GetGitRefsURL()
+func TestRequiredStatusChecksRequest_GetStrict(tt *testing.T) {
+	var zeroValue bool
+	r := &RequiredStatusChecksRequest{Strict: &zeroValue}
+	r.GetStrict()
+	r = &RequiredStatusChecksRequest{}
+	r.GetStrict()
 	r = nil
-	r.GetGitRefsURL()
+	r.GetStrict()
 }
 
-func TestRepository_GetGitTagsURL(tt *testing.T) {
-	var zeroValue string
-	r := &Repository{GitTagsURL: &zeroValue}
-	r.GetGitTagsURL()
-	r = &Repository{}
-	r.GetGitTagsURL()
+func TestResponse_GetSSO(tt *testing.T) {
+	r := &Response{}
+	r.GetSSO()
 	r = nil
-	r.GetGitTagsURL()
+	r.GetSSO()
 }
 
-func TestRepository_GetGitURL(tt *testing.T) {
-	var zeroValue string
-	r := &Repository{GitURL: &zeroValue}
-	r.GetGitURL()
-	r = &Repository{}
-	r.GetGitURL()
+func TestReviewersChange_GetPullRequest(tt *testing.T) {
+	r := &ReviewersChange{}
+	r.GetPullRequest()
 	r = nil
-	r.GetGitURL()
+	r.GetPullRequest()
 }
 
-func TestRepository_GetHasDownloads(tt *testing.T) {
-	var zeroValue bool
-	r := &Repository{HasDownloads: &zeroValue}
-	r.GetHasDownloads()
-	r = &Repository{}
-	r.GetHasDownloads()
+func TestReviewersRequest_GetNodeID(tt *testing.T) {
+	var zeroValue string
+	r := &ReviewersRequest{NodeID: &zeroValue}
+	r.GetNodeID()
+	r = &ReviewersRequest{}
+	r.GetNodeID()
 	r = nil
-	r.GetHasDownloads()
+	r.GetNodeID()
 }
 
-func TestRepository_GetHasIssues(tt *testing.T) {
-	var zeroValue bool
-	r := &Repository{HasIssues: &zeroValue}
-	r.GetHasIssues()
-	r = &Repository{}
-	r.GetHasIssues()
+func TestReviewPersonalAccessTokenRequestOptions_GetReason(tt *testing.T) {
+	var zeroValue string
+	r := &ReviewPersonalAccessTokenRequestOptions{Reason: &zeroValue}
+	r.GetReason()
+	r = &ReviewPersonalAccessTokenRequestOptions{}
+	r.GetReason()
 	r = nil
-	r.GetHasIssues()
+	r.GetReason()
 }
 
-func TestRepository_GetHasPages(tt *testing.T) {
-	var zeroValue bool
-	r := &Repository{HasPages: &zeroValue}
-	r.GetHasPages()
-	r = &Repository{}
-	r.GetHasPages()
+func TestReviewPersonalAccessTokenRequestsOptions_GetReason(tt *testing.T) {
+	var zeroValue string
+	r := &ReviewPersonalAccessTokenRequestsOptions{Reason: &zeroValue}
+	r.GetReason()
+	r = &ReviewPersonalAccessTokenRequestsOptions{}
+	r.GetReason()
 	r = nil
-	r.GetHasPages()
+	r.GetReason()
 }
 
-func TestRepository_GetHasProjects(tt *testing.T) {
-	var zeroValue bool
-	r := &Repository{HasProjects: &zeroValue}
-	r.GetHasProjects()
-	r = &Repository{}
-	r.GetHasProjects()
+func TestRuleEvaluation_GetDetails(tt *testing.T) {
+	var zeroValue string
+	r := &RuleEvaluation{Details: &zeroValue}
+	r.GetDetails()
+	r = &RuleEvaluation{}
+	r.GetDetails()
 	r = nil
-	r.GetHasProjects()
+	r.GetDetails()
 }
 
-func TestRepository_GetHasWiki(tt *testing.T) {
-	var zeroValue bool
-	r := &Repository{HasWiki: &zeroValue}
-	r.GetHasWiki()
-	r = &Repository{}
-	r.GetHasWiki()
+func TestRuleEvaluation_GetEnforcement(tt *testing.T) {
+	var zeroValue string
+	r := &RuleEvaluation{Enforcement: &zeroValue}
+	r.GetEnforcement()
+	r = &RuleEvaluation{}
+	r.GetEnforcement()
 	r = nil
-	r.GetHasWiki()
+	r.GetEnforcement()
 }
 
-func TestRepository_GetHomepage(tt *testing.T) {
+func TestRuleEvaluation_GetResult(tt *testing.T) {
 	var zeroValue string
-	r := &Repository{Homepage: &zeroValue}
-	r.GetHomepage()
-	r = &Repository{}
-	r.GetHomepage()
+	r := &RuleEvaluation{Result: &zeroValue}
+	r.GetResult()
+	r = &RuleEvaluation{}
+	r.GetResult()
 	r = nil
-	r.GetHomepage()
+	r.GetResult()
 }
 
-func TestRepository_GetHooksURL(tt *testing.T) {
-	var zeroValue string
-	r := &Repository{HooksURL: &zeroValue}
-	r.GetHooksURL()
-	r = &Repository{}
-	r.GetHooksURL()
+func TestRuleEvaluation_GetRuleSource(tt *testing.T) {
+	r := &RuleEvaluation{}
+	r.GetRuleSource()
 	r = nil
-	r.GetHooksURL()
+	r.GetRuleSource()
 }
 
-func TestRepository_GetHTMLURL(tt *testing.T) {
+func TestRuleEvaluation_GetRuleType(tt *testing.T) {
 	var zeroValue string
-	r := &Repository{HTMLURL: &zeroValue}
-	r.GetHTMLURL()
-	r = &Repository{}
-	r.GetHTMLURL()
+	r := &RuleEvaluation{RuleType: &zeroValue}
+	r.GetRuleType()
+	r = &RuleEvaluation{}
+	r.GetRuleType()
 	r = nil
-	r.GetHTMLURL()
+	r.GetRuleType()
 }
 
-func TestRepository_GetID(tt *testing.T) {
+func TestRuleEvaluationSource_GetID(tt *testing.T) {
 	var zeroValue int64
-	r := &Repository{ID: &zeroValue}
+	r := &RuleEvaluationSource{ID: &zeroValue}
 	r.GetID()
-	r = &Repository{}
+	r = &RuleEvaluationSource{}
 	r.GetID()
 	r = nil
 	r.GetID()
 }
 
-func TestRepository_GetIssueCommentURL(tt *testing.T) {
+func TestRuleEvaluationSource_GetName(tt *testing.T) {
 	var zeroValue string
-	r := &Repository{IssueCommentURL: &zeroValue}
-	r.GetIssueCommentURL()
-	r = &Repository{}
-	r.GetIssueCommentURL()
+	r := &RuleEvaluationSource{Name: &zeroValue}
+	r.GetName()
+	r = &RuleEvaluationSource{}
+	r.GetName()
 	r = nil
-	r.GetIssueCommentURL()
+	r.GetName()
 }
 
-func TestRepository_GetIssueEventsURL(tt *testing.T) {
+func TestRuleEvaluationSource_GetType(tt *testing.T) {
 	var zeroValue string
-	r := &Repository{IssueEventsURL: &zeroValue}
-	r.GetIssueEventsURL()
-	r = &Repository{}
-	r.GetIssueEventsURL()
+	r := &RuleEvaluationSource{Type: &zeroValue}
+	r.GetType()
+	r = &RuleEvaluationSource{}
+	r.GetType()
 	r = nil
-	r.GetIssueEventsURL()
+	r.GetType()
 }
 
-func TestRepository_GetIssuesURL(tt *testing.T) {
+func TestRulePatternParameters_GetName(tt *testing.T) {
 	var zeroValue string
-	r := &Repository{IssuesURL: &zeroValue}
-	r.GetIssuesURL()
-	r = &Repository{}
-	r.GetIssuesURL()
+	r := &RulePatternParameters{Name: &zeroValue}
+	r.GetName()
+	r = &RulePatternParameters{}
+	r.GetName()
 	r = nil
-	r.GetIssuesURL()
+	r.GetName()
 }
 
-func TestRepository_GetIsTemplate(tt *testing.T) {
+func TestRulePatternParameters_GetNegate(tt *testing.T) {
 	var zeroValue bool
-	r := &Repository{IsTemplate: &zeroValue}
-	r.GetIsTemplate()
-	r = &Repository{}
-	r.GetIsTemplate()
+	r := &RulePatternParameters{Negate: &zeroValue}
+	r.GetNegate()
+	r = &RulePatternParameters{}
+	r.GetNegate()
 	r = nil
-	r.GetIsTemplate()
+	r.GetNegate()
 }
 
-func TestRepository_GetKeysURL(tt *testing.T) {
-	var zeroValue string
-	r := &Repository{KeysURL: &zeroValue}
-	r.GetKeysURL()
-	r = &Repository{}
-	r.GetKeysURL()
+func TestRuleset_GetConditions(tt *testing.T) {
+	r := &Ruleset{}
+	r.GetConditions()
 	r = nil
-	r.GetKeysURL()
+	r.GetConditions()
 }
 
-func TestRepository_GetLabelsURL(tt *testing.T) {
-	var zeroValue string
-	r := &Repository{LabelsURL: &zeroValue}
-	r.GetLabelsURL()
-	r = &Repository{}
-	r.GetLabelsURL()
+func TestRuleset_GetID(tt *testing.T) {
+	var zeroValue int64
+	r := &Ruleset{ID: &zeroValue}
+	r.GetID()
+	r = &Ruleset{}
+	r.GetID()
 	r = nil
-	r.GetLabelsURL()
+	r.GetID()
 }
 
-func TestRepository_GetLanguage(tt *testing.T) {
-	var zeroValue string
-	r := &Repository{Language: &zeroValue}
-	r.GetLanguage()
-	r = &Repository{}
-	r.GetLanguage()
+func TestRuleset_GetLinks(tt *testing.T) {
+	r := &Ruleset{}
+	r.GetLinks()
 	r = nil
-	r.GetLanguage()
+	r.GetLinks()
 }
 
-func TestRepository_GetLanguagesURL(tt *testing.T) {
+func TestRuleset_GetNodeID(tt *testing.T) {
 	var zeroValue string
-	r := &Repository{LanguagesURL: &zeroValue}
-	r.GetLanguagesURL()
-	r = &Repository{}
-	r.GetLanguagesURL()
-	r = nil
-	r.GetLanguagesURL()
-}
-
-func TestRepository_GetLicense(tt *testing.T) {
-	r := &Repository{}
-	r.GetLicense()
+	r := &Ruleset{NodeID: &zeroValue}
+	r.GetNodeID()
+	r = &Ruleset{}
+	r.GetNodeID()
 	r = nil
-	r.GetLicense()
+	r.GetNodeID()
 }
 
-func TestRepository_GetLicenseTemplate(tt *testing.T) {
+func TestRuleset_GetSource(tt *testing.T) {
 	var zeroValue string
-	r := &Repository{LicenseTemplate: &zeroValue}
-	r.GetLicenseTemplate()
-	r = &Repository{}
-	r.GetLicenseTemplate()
+	r := &Ruleset{Source: &zeroValue}
+	r.GetSource()
+	r = &Ruleset{}
+	r.GetSource()
 	r = nil
-	r.GetLicenseTemplate()
+	r.GetSource()
 }
 
-func TestRepository_GetMasterBranch(tt *testing.T) {
+func TestRuleset_GetSourceType(tt *testing.T) {
 	var zeroValue string
-	r := &Repository{MasterBranch: &zeroValue}
-	r.GetMasterBranch()
-	r = &Repository{}
-	r.GetMasterBranch()
+	r := &Ruleset{SourceType: &zeroValue}
+	r.GetSourceType()
+	r = &Ruleset{}
+	r.GetSourceType()
 	r = nil
-	r.GetMasterBranch()
+	r.GetSourceType()
 }
 
-func TestRepository_GetMergesURL(tt *testing.T) {
+func TestRuleset_GetTarget(tt *testing.T) {
 	var zeroValue string
-	r := &Repository{MergesURL: &zeroValue}
-	r.GetMergesURL()
-	r = &Repository{}
-	r.GetMergesURL()
+	r := &Ruleset{Target: &zeroValue}
+	r.GetTarget()
+	r = &Ruleset{}
+	r.GetTarget()
 	r = nil
-	r.GetMergesURL()
+	r.GetTarget()
 }
 
-func TestRepository_GetMilestonesURL(tt *testing.T) {
-	var zeroValue string
-	r := &Repository{MilestonesURL: &zeroValue}
-	r.GetMilestonesURL()
-	r = &Repository{}
-	r.GetMilestonesURL()
+func TestRulesetConditions_GetRefName(tt *testing.T) {
+	r := &RulesetConditions{}
+	r.GetRefName()
 	r = nil
-	r.GetMilestonesURL()
+	r.GetRefName()
 }
 
-func TestRepository_GetMirrorURL(tt *testing.T) {
-	var zeroValue string
-	r := &Repository{MirrorURL: &zeroValue}
-	r.GetMirrorURL()
-	r = &Repository{}
-	r.GetMirrorURL()
+func TestRulesetConditions_GetRepositoryID(tt *testing.T) {
+	r := &RulesetConditions{}
+	r.GetRepositoryID()
 	r = nil
-	r.GetMirrorURL()
+	r.GetRepositoryID()
 }
 
-func TestRepository_GetName(tt *testing.T) {
-	var zeroValue string
-	r := &Repository{Name: &zeroValue}
-	r.GetName()
-	r = &Repository{}
-	r.GetName()
+func TestRulesetConditions_GetRepositoryName(tt *testing.T) {
+	r := &RulesetConditions{}
+	r.GetRepositoryName()
 	r = nil
-	r.GetName()
+	r.GetRepositoryName()
 }
 
-func TestRepository_GetNetworkCount(tt *testing.T) {
-	var zeroValue int
-	r := &Repository{NetworkCount: &zeroValue}
-	r.GetNetworkCount()
-	r = &Repository{}
-	r.GetNetworkCount()
+func TestRulesetConditions_GetRepositoryProperty(tt *testing.T) {
+	r := &RulesetConditions{}
+	r.GetRepositoryProperty()
 	r = nil
-	r.GetNetworkCount()
+	r.GetRepositoryProperty()
 }
 
-func TestRepository_GetNodeID(tt *testing.T) {
+func TestRulesetLink_GetHRef(tt *testing.T) {
 	var zeroValue string
-	r := &Repository{NodeID: &zeroValue}
-	r.GetNodeID()
-	r = &Repository{}
-	r.GetNodeID()
+	r := &RulesetLink{HRef: &zeroValue}
+	r.GetHRef()
+	r = &RulesetLink{}
+	r.GetHRef()
 	r = nil
-	r.GetNodeID()
+	r.GetHRef()
 }
 
-func TestRepository_GetNotificationsURL(tt *testing.T) {
-	var zeroValue string
-	r := &Repository{NotificationsURL: &zeroValue}
-	r.GetNotificationsURL()
-	r = &Repository{}
-	r.GetNotificationsURL()
+func TestRulesetLinks_GetSelf(tt *testing.T) {
+	r := &RulesetLinks{}
+	r.GetSelf()
 	r = nil
-	r.GetNotificationsURL()
+	r.GetSelf()
 }
 
-func TestRepository_GetOpenIssuesCount(tt *testing.T) {
-	var zeroValue int
-	r := &Repository{OpenIssuesCount: &zeroValue}
-	r.GetOpenIssuesCount()
-	r = &Repository{}
-	r.GetOpenIssuesCount()
+func TestRulesetRepositoryNamesConditionParameters_GetProtected(tt *testing.T) {
+	var zeroValue bool
+	r := &RulesetRepositoryNamesConditionParameters{Protected: &zeroValue}
+	r.GetProtected()
+	r = &RulesetRepositoryNamesConditionParameters{}
+	r.GetProtected()
 	r = nil
-	r.GetOpenIssuesCount()
+	r.GetProtected()
 }
 
-func TestRepository_GetOrganization(tt *testing.T) {
-	r := &Repository{}
-	r.GetOrganization()
+func TestRulesetRepositoryPropertyTargetParameters_GetSource(tt *testing.T) {
+	var zeroValue string
+	r := &RulesetRepositoryPropertyTargetParameters{Source: &zeroValue}
+	r.GetSource()
+	r = &RulesetRepositoryPropertyTargetParameters{}
+	r.GetSource()
 	r = nil
-	r.GetOrganization()
+	r.GetSource()
 }
 
-func TestRepository_GetOwner(tt *testing.T) {
-	r := &Repository{}
-	r.GetOwner()
+func TestRuleSuite_GetActorID(tt *testing.T) {
+	var zeroValue int64
+	r := &RuleSuite{ActorID: &zeroValue}
+	r.GetActorID()
+	r = &RuleSuite{}
+	r.GetActorID()
 	r = nil
-	r.GetOwner()
+	r.GetActorID()
 }
 
-func TestRepository_GetParent(tt *testing.T) {
-	r := &Repository{}
-	r.GetParent()
+func TestRuleSuite_GetActorName(tt *testing.T) {
+	var zeroValue string
+	r := &RuleSuite{ActorName: &zeroValue}
+	r.GetActorName()
+	r = &RuleSuite{}
+	r.GetActorName()
 	r = nil
-	r.GetParent()
+	r.GetActorName()
 }
 
-func TestRepository_GetPermissions(tt *testing.T) {
-	var zeroValue map[string]bool
-	r := &Repository{Permissions: &zeroValue}
-	r.GetPermissions()
-	r = &Repository{}
-	r.GetPermissions()
+func TestRuleSuite_GetAfterSHA(tt *testing.T) {
+	var zeroValue string
+	r := &RuleSuite{AfterSHA: &zeroValue}
+	r.GetAfterSHA()
+	r = &RuleSuite{}
+	r.GetAfterSHA()
 	r = nil
-	r.GetPermissions()
+	r.GetAfterSHA()
 }
 
-func TestRepository_GetPrivate(tt *testing.T) {
-	var zeroValue bool
-	r := &Repository{Private: &zeroValue}
-	r.GetPrivate()
-	r = &Repository{}
-	r.GetPrivate()
+func TestRuleSuite_GetBeforeSHA(tt *testing.T) {
+	var zeroValue string
+	r := &RuleSuite{BeforeSHA: &zeroValue}
+	r.GetBeforeSHA()
+	r = &RuleSuite{}
+	r.GetBeforeSHA()
 	r = nil
-	r.GetPrivate()
+	r.GetBeforeSHA()
 }
 
-func TestRepository_GetPullsURL(tt *testing.T) {
+func TestRuleSuite_GetEvaluationResult(tt *testing.T) {
 	var zeroValue string
-	r := &Repository{PullsURL: &zeroValue}
-	r.GetPullsURL()
-	r = &Repository{}
-	r.GetPullsURL()
+	r := &RuleSuite{EvaluationResult: &zeroValue}
+	r.GetEvaluationResult()
+	r = &RuleSuite{}
+	r.GetEvaluationResult()
 	r = nil
-	r.GetPullsURL()
+	r.GetEvaluationResult()
 }
 
-func TestRepository_GetPushedAt(tt *testing.T) {
+func TestRuleSuite_GetID(tt *testing.T) {
+	var zeroValue int64
+	r := &RuleSuite{ID: &zeroValue}
+	r.GetID()
+	r = &RuleSuite{}
+	r.GetID()
+	r = nil
+	r.GetID()
+}
+
+func TestRuleSuite_GetPushedAt(tt *testing.T) {
 	var zeroValue Timestamp
-	r := &Repository{PushedAt: &zeroValue}
+	r := &RuleSuite{PushedAt: &zeroValue}
 	r.GetPushedAt()
-	r = &Repository{}
+	r = &RuleSuite{}
 	r.GetPushedAt()
 	r = nil
 	r.GetPushedAt()
 }
 
-func TestRepository_GetReleasesURL(tt *testing.T) {
+func TestRuleSuite_GetRef(tt *testing.T) {
 	var zeroValue string
-	r := &Repository{ReleasesURL: &zeroValue}
-	r.GetReleasesURL()
-	r = &Repository{}
-	r.GetReleasesURL()
+	r := &RuleSuite{Ref: &zeroValue}
+	r.GetRef()
+	r = &RuleSuite{}
+	r.GetRef()
 	r = nil
-	r.GetReleasesURL()
+	r.GetRef()
 }
 
-func TestRepository_GetSize(tt *testing.T) {
-	var zeroValue int
-	r := &Repository{Size: &zeroValue}
-	r.GetSize()
-	r = &Repository{}
-	r.GetSize()
+func TestRuleSuite_GetRepositoryID(tt *testing.T) {
+	var zeroValue int64
+	r := &RuleSuite{RepositoryID: &zeroValue}
+	r.GetRepositoryID()
+	r = &RuleSuite{}
+	r.GetRepositoryID()
+	r = nil
+	r.GetRepositoryID()
+}
+
+func TestRuleSuite_GetRepositoryName(tt *testing.T) {
+	var zeroValue string
+	r := &RuleSuite{RepositoryName: &zeroValue}
+	r.GetRepositoryName()
+	r = &RuleSuite{}
+	r.GetRepositoryName()
 	r = nil
-	r.GetSize()
+	r.GetRepositoryName()
 }
 
-func TestRepository_GetSource(tt *testing.T) {
-	r := &Repository{}
-	r.GetSource()
+func TestRuleSuite_GetResult(tt *testing.T) {
+	var zeroValue string
+	r := &RuleSuite{Result: &zeroValue}
+	r.GetResult()
+	r = &RuleSuite{}
+	r.GetResult()
 	r = nil
-	r.GetSource()
+	r.GetResult()
 }
 
-func TestRepository_GetSSHURL(tt *testing.T) {
-	var zeroValue string
-	r := &Repository{SSHURL: &zeroValue}
-	r.GetSSHURL()
-	r = &Repository{}
-	r.GetSSHURL()
+func TestRunner_GetBusy(tt *testing.T) {
+	var zeroValue bool
+	r := &Runner{Busy: &zeroValue}
+	r.GetBusy()
+	r = &Runner{}
+	r.GetBusy()
 	r = nil
-	r.GetSSHURL()
+	r.GetBusy()
 }
 
-func TestRepository_GetStargazersCount(tt *testing.T) {
-	var zeroValue int
-	r := &Repository{StargazersCount: &zeroValue}
-	r.GetStargazersCount()
-	r = &Repository{}
-	r.GetStargazersCount()
+func TestRunner_GetID(tt *testing.T) {
+	var zeroValue int64
+	r := &Runner{ID: &zeroValue}
+	r.GetID()
+	r = &Runner{}
+	r.GetID()
 	r = nil
-	r.GetStargazersCount()
+	r.GetID()
 }
 
-func TestRepository_GetStargazersURL(tt *testing.T) {
+func TestRunner_GetName(tt *testing.T) {
 	var zeroValue string
-	r := &Repository{StargazersURL: &zeroValue}
-	r.GetStargazersURL()
-	r = &Repository{}
-	r.GetStargazersURL()
+	r := &Runner{Name: &zeroValue}
+	r.GetName()
+	r = &Runner{}
+	r.GetName()
 	r = nil
-	r.GetStargazersURL()
+	r.GetName()
 }
 
-func TestRepository_GetStatusesURL(tt *testing.T) {
+func TestRunner_GetOS(tt *testing.T) {
 	var zeroValue string
-	r := &Repository{StatusesURL: &zeroValue}
-	r.GetStatusesURL()
-	r = &Repository{}
-	r.GetStatusesURL()
+	r := &Runner{OS: &zeroValue}
+	r.GetOS()
+	r = &Runner{}
+	r.GetOS()
 	r = nil
-	r.GetStatusesURL()
+	r.GetOS()
 }
 
-func TestRepository_GetSubscribersCount(tt *testing.T) {
-	var zeroValue int
-	r := &Repository{SubscribersCount: &zeroValue}
-	r.GetSubscribersCount()
-	r = &Repository{}
-	r.GetSubscribersCount()
+func TestRunner_GetStatus(tt *testing.T) {
+	var zeroValue string
+	r := &Runner{Status: &zeroValue}
+	r.GetStatus()
+	r = &Runner{}
+	r.GetStatus()
 	r = nil
-	r.GetSubscribersCount()
+	r.GetStatus()
 }
 
-func TestRepository_GetSubscribersURL(tt *testing.T) {
+func TestRunnerApplicationDownload_GetArchitecture(tt *testing.T) {
 	var zeroValue string
-	r := &Repository{SubscribersURL: &zeroValue}
-	r.GetSubscribersURL()
-	r = &Repository{}
-	r.GetSubscribersURL()
+	r := &RunnerApplicationDownload{Architecture: &zeroValue}
+	r.GetArchitecture()
+	r = &RunnerApplicationDownload{}
+	r.GetArchitecture()
 	r = nil
-	r.GetSubscribersURL()
+	r.GetArchitecture()
 }
 
-func TestRepository_GetSubscriptionURL(tt *testing.T) {
+func TestRunnerApplicationDownload_GetDownloadURL(tt *testing.T) {
 	var zeroValue string
-	r := &Repository{SubscriptionURL: &zeroValue}
-	r.GetSubscriptionURL()
-	r = &Repository{}
-	r.GetSubscriptionURL()
+	r := &RunnerApplicationDownload{DownloadURL: &zeroValue}
+	r.GetDownloadURL()
+	r = &RunnerApplicationDownload{}
+	r.GetDownloadURL()
 	r = nil
-	r.GetSubscriptionURL()
+	r.GetDownloadURL()
 }
 
-func TestRepository_GetSVNURL(tt *testing.T) {
+func TestRunnerApplicationDownload_GetFilename(tt *testing.T) {
 	var zeroValue string
-	r := &Repository{SVNURL: &zeroValue}
-	r.GetSVNURL()
-	r = &Repository{}
-	r.GetSVNURL()
+	r := &RunnerApplicationDownload{Filename: &zeroValue}
+	r.GetFilename()
+	r = &RunnerApplicationDownload{}
+	r.GetFilename()
 	r = nil
-	r.GetSVNURL()
+	r.GetFilename()
 }
 
-func TestRepository_GetTagsURL(tt *testing.T) {
+func TestRunnerApplicationDownload_GetOS(tt *testing.T) {
 	var zeroValue string
-	r := &Repository{TagsURL: &zeroValue}
-	r.GetTagsURL()
-	r = &Repository{}
-	r.GetTagsURL()
+	r := &RunnerApplicationDownload{OS: &zeroValue}
+	r.GetOS()
+	r = &RunnerApplicationDownload{}
+	r.GetOS()
 	r = nil
-	r.GetTagsURL()
+	r.GetOS()
 }
 
-func TestRepository_GetTeamID(tt *testing.T) {
+func TestRunnerLabels_GetID(tt *testing.T) {
 	var zeroValue int64
-	r := &Repository{TeamID: &zeroValue}
-	r.GetTeamID()
-	r = &Repository{}
-	r.GetTeamID()
+	r := &RunnerLabels{ID: &zeroValue}
+	r.GetID()
+	r = &RunnerLabels{}
+	r.GetID()
 	r = nil
-	r.GetTeamID()
+	r.GetID()
 }
 
-func TestRepository_GetTeamsURL(tt *testing.T) {
+func TestRunnerLabels_GetName(tt *testing.T) {
 	var zeroValue string
-	r := &Repository{TeamsURL: &zeroValue}
-	r.GetTeamsURL()
-	r = &Repository{}
-	r.GetTeamsURL()
+	r := &RunnerLabels{Name: &zeroValue}
+	r.GetName()
+	r = &RunnerLabels{}
+	r.GetName()
 	r = nil
-	r.GetTeamsURL()
+	r.GetName()
 }
 
-func TestRepository_GetTemplateRepository(tt *testing.T) {
-	r := &Repository{}
-	r.GetTemplateRepository()
+func TestRunnerLabels_GetType(tt *testing.T) {
+	var zeroValue string
+	r := &RunnerLabels{Type: &zeroValue}
+	r.GetType()
+	r = &RunnerLabels{}
+	r.GetType()
 	r = nil
-	r.GetTemplateRepository()
+	r.GetType()
 }
 
-func TestRepository_GetTreesURL(tt *testing.T) {
+func TestSARIFID_GetID(tt *testing.T) {
 	var zeroValue string
-	r := &Repository{TreesURL: &zeroValue}
-	r.GetTreesURL()
-	r = &Repository{}
-	r.GetTreesURL()
-	r = nil
-	r.GetTreesURL()
+	s := &SARIFID{ID: &zeroValue}
+	s.GetID()
+	s = &SARIFID{}
+	s.GetID()
+	s = nil
+	s.GetID()
 }
 
-func TestRepository_GetUpdatedAt(tt *testing.T) {
-	var zeroValue Timestamp
-	r := &Repository{UpdatedAt: &zeroValue}
-	r.GetUpdatedAt()
-	r = &Repository{}
-	r.GetUpdatedAt()
-	r = nil
-	r.GetUpdatedAt()
+func TestSARIFID_GetURL(tt *testing.T) {
+	var zeroValue string
+	s := &SARIFID{URL: &zeroValue}
+	s.GetURL()
+	s = &SARIFID{}
+	s.GetURL()
+	s = nil
+	s.GetURL()
 }
 
-func TestRepository_GetURL(tt *testing.T) {
+func TestSARIFUpload_GetAnalysesURL(tt *testing.T) {
 	var zeroValue string
-	r := &Repository{URL: &zeroValue}
-	r.GetURL()
-	r = &Repository{}
-	r.GetURL()
-	r = nil
-	r.GetURL()
+	s := &SARIFUpload{AnalysesURL: &zeroValue}
+	s.GetAnalysesURL()
+	s = &SARIFUpload{}
+	s.GetAnalysesURL()
+	s = nil
+	s.GetAnalysesURL()
 }
 
-func TestRepository_GetVisibility(tt *testing.T) {
+func TestSARIFUpload_GetProcessingStatus(tt *testing.T) {
 	var zeroValue string
-	r := &Repository{Visibility: &zeroValue}
-	r.GetVisibility()
-	r = &Repository{}
-	r.GetVisibility()
-	r = nil
-	r.GetVisibility()
+	s := &SARIFUpload{ProcessingStatus: &zeroValue}
+	s.GetProcessingStatus()
+	s = &SARIFUpload{}
+	s.GetProcessingStatus()
+	s = nil
+	s.GetProcessingStatus()
 }
 
-func TestRepository_GetWatchersCount(tt *testing.T) {
-	var zeroValue int
-	r := &Repository{WatchersCount: &zeroValue}
-	r.GetWatchersCount()
-	r = &Repository{}
-	r.GetWatchersCount()
-	r = nil
-	r.GetWatchersCount()
+func TestSARIFUploadOptions_GetStartedAt(tt *testing.T) {
+	var zeroValue Timestamp
+	s := &SARIFUploadOptions{StartedAt: &zeroValue}
+	s.GetStartedAt()
+	s = &SARIFUploadOptions{}
+	s.GetStartedAt()
+	s = nil
+	s.GetStartedAt()
 }
 
-func TestRepositoryComment_GetBody(tt *testing.T) {
+func TestSBOM_GetSBOM(tt *testing.T) {
+	s := &SBOM{}
+	s.GetSBOM()
+	s = nil
+	s.GetSBOM()
+}
+
+func TestSBOMInfo_GetCreationInfo(tt *testing.T) {
+	s := &SBOMInfo{}
+	s.GetCreationInfo()
+	s = nil
+	s.GetCreationInfo()
+}
+
+func TestSBOMInfo_GetDataLicense(tt *testing.T) {
 	var zeroValue string
-	r := &RepositoryComment{Body: &zeroValue}
-	r.GetBody()
-	r = &RepositoryComment{}
-	r.GetBody()
-	r = nil
-	r.GetBody()
+	s := &SBOMInfo{DataLicense: &zeroValue}
+	s.GetDataLicense()
+	s = &SBOMInfo{}
+	s.GetDataLicense()
+	s = nil
+	s.GetDataLicense()
 }
 
-func TestRepositoryComment_GetCommitID(tt *testing.T) {
+func TestSBOMInfo_GetDocumentNamespace(tt *testing.T) {
 	var zeroValue string
-	r := &RepositoryComment{CommitID: &zeroValue}
-	r.GetCommitID()
-	r = &RepositoryComment{}
-	r.GetCommitID()
-	r = nil
-	r.GetCommitID()
+	s := &SBOMInfo{DocumentNamespace: &zeroValue}
+	s.GetDocumentNamespace()
+	s = &SBOMInfo{}
+	s.GetDocumentNamespace()
+	s = nil
+	s.GetDocumentNamespace()
 }
 
-func TestRepositoryComment_GetCreatedAt(tt *testing.T) {
-	var zeroValue time.Time
-	r := &RepositoryComment{CreatedAt: &zeroValue}
-	r.GetCreatedAt()
-	r = &RepositoryComment{}
-	r.GetCreatedAt()
-	r = nil
-	r.GetCreatedAt()
+func TestSBOMInfo_GetName(tt *testing.T) {
+	var zeroValue string
+	s := &SBOMInfo{Name: &zeroValue}
+	s.GetName()
+	s = &SBOMInfo{}
+	s.GetName()
+	s = nil
+	s.GetName()
 }
 
-func TestRepositoryComment_GetHTMLURL(tt *testing.T) {
+func TestSBOMInfo_GetSPDXID(tt *testing.T) {
 	var zeroValue string
-	r := &RepositoryComment{HTMLURL: &zeroValue}
-	r.GetHTMLURL()
-	r = &RepositoryComment{}
-	r.GetHTMLURL()
-	r = nil
-	r.GetHTMLURL()
+	s := &SBOMInfo{SPDXID: &zeroValue}
+	s.GetSPDXID()
+	s = &SBOMInfo{}
+	s.GetSPDXID()
+	s = nil
+	s.GetSPDXID()
+}
+
+func TestSBOMInfo_GetSPDXVersion(tt *testing.T) {
+	var zeroValue string
+	s := &SBOMInfo{SPDXVersion: &zeroValue}
+	s.GetSPDXVersion()
+	s = &SBOMInfo{}
+	s.GetSPDXVersion()
+	s = nil
+	s.GetSPDXVersion()
+}
+
+func TestSBOMRelationship_GetRelatedSPDXElement(tt *testing.T) {
+	var zeroValue string
+	s := &SBOMRelationship{RelatedSPDXElement: &zeroValue}
+	s.GetRelatedSPDXElement()
+	s = &SBOMRelationship{}
+	s.GetRelatedSPDXElement()
+	s = nil
+	s.GetRelatedSPDXElement()
 }
 
-func TestRepositoryComment_GetID(tt *testing.T) {
-	var zeroValue int64
-	r := &RepositoryComment{ID: &zeroValue}
-	r.GetID()
-	r = &RepositoryComment{}
-	r.GetID()
-	r = nil
-	r.GetID()
+func TestSBOMRelationship_GetRelationshipType(tt *testing.T) {
+	var zeroValue string
+	s := &SBOMRelationship{RelationshipType: &zeroValue}
+	s.GetRelationshipType()
+	s = &SBOMRelationship{}
+	s.GetRelationshipType()
+	s = nil
+	s.GetRelationshipType()
 }
 
-func TestRepositoryComment_GetNodeID(tt *testing.T) {
+func TestSBOMRelationship_GetSPDXElementID(tt *testing.T) {
 	var zeroValue string
-	r := &RepositoryComment{NodeID: &zeroValue}
-	r.GetNodeID()
-	r = &RepositoryComment{}
-	r.GetNodeID()
-	r = nil
-	r.GetNodeID()
+	s := &SBOMRelationship{SPDXElementID: &zeroValue}
+	s.GetSPDXElementID()
+	s = &SBOMRelationship{}
+	s.GetSPDXElementID()
+	s = nil
+	s.GetSPDXElementID()
 }
 
-func TestRepositoryComment_GetPath(tt *testing.T) {
+func TestScanningAnalysis_GetAnalysisKey(tt *testing.T) {
 	var zeroValue string
-	r := &RepositoryComment{Path: &zeroValue}
-	r.GetPath()
-	r = &RepositoryComment{}
-	r.GetPath()
-	r = nil
-	r.GetPath()
+	s := &ScanningAnalysis{AnalysisKey: &zeroValue}
+	s.GetAnalysisKey()
+	s = &ScanningAnalysis{}
+	s.GetAnalysisKey()
+	s = nil
+	s.GetAnalysisKey()
 }
 
-func TestRepositoryComment_GetPosition(tt *testing.T) {
-	var zeroValue int
-	r := &RepositoryComment{Position: &zeroValue}
-	r.GetPosition()
-	r = &RepositoryComment{}
-	r.GetPosition()
-	r = nil
-	r.GetPosition()
+func TestScanningAnalysis_GetCategory(tt *testing.T) {
+	var zeroValue string
+	s := &ScanningAnalysis{Category: &zeroValue}
+	s.GetCategory()
+	s = &ScanningAnalysis{}
+	s.GetCategory()
+	s = nil
+	s.GetCategory()
 }
 
-func TestRepositoryComment_GetReactions(tt *testing.T) {
-	r := &RepositoryComment{}
-	r.GetReactions()
-	r = nil
-	r.GetReactions()
+func TestScanningAnalysis_GetCommitSHA(tt *testing.T) {
+	var zeroValue string
+	s := &ScanningAnalysis{CommitSHA: &zeroValue}
+	s.GetCommitSHA()
+	s = &ScanningAnalysis{}
+	s.GetCommitSHA()
+	s = nil
+	s.GetCommitSHA()
 }
 
-func TestRepositoryComment_GetUpdatedAt(tt *testing.T) {
-	var zeroValue time.Time
-	r := &RepositoryComment{UpdatedAt: &zeroValue}
-	r.GetUpdatedAt()
-	r = &RepositoryComment{}
-	r.GetUpdatedAt()
-	r = nil
-	r.GetUpdatedAt()
+func TestScanningAnalysis_GetCreatedAt(tt *testing.T) {
+	var zeroValue Timestamp
+	s := &ScanningAnalysis{CreatedAt: &zeroValue}
+	s.GetCreatedAt()
+	s = &ScanningAnalysis{}
+	s.GetCreatedAt()
+	s = nil
+	s.GetCreatedAt()
 }
 
-func TestRepositoryComment_GetURL(tt *testing.T) {
+func TestScanningAnalysis_GetDeletable(tt *testing.T) {
+	var zeroValue bool
+	s := &ScanningAnalysis{Deletable: &zeroValue}
+	s.GetDeletable()
+	s = &ScanningAnalysis{}
+	s.GetDeletable()
+	s = nil
+	s.GetDeletable()
+}
+
+func TestScanningAnalysis_GetEnvironment(tt *testing.T) {
 	var zeroValue string
-	r := &RepositoryComment{URL: &zeroValue}
-	r.GetURL()
-	r = &RepositoryComment{}
-	r.GetURL()
-	r = nil
-	r.GetURL()
+	s := &ScanningAnalysis{Environment: &zeroValue}
+	s.GetEnvironment()
+	s = &ScanningAnalysis{}
+	s.GetEnvironment()
+	s = nil
+	s.GetEnvironment()
 }
 
-func TestRepositoryComment_GetUser(tt *testing.T) {
-	r := &RepositoryComment{}
-	r.GetUser()
-	r = nil
-	r.GetUser()
+func TestScanningAnalysis_GetError(tt *testing.T) {
+	var zeroValue string
+	s := &ScanningAnalysis{Error: &zeroValue}
+	s.GetError()
+	s = &ScanningAnalysis{}
+	s.GetError()
+	s = nil
+	s.GetError()
 }
 
-func TestRepositoryCommit_GetAuthor(tt *testing.T) {
-	r := &RepositoryCommit{}
-	r.GetAuthor()
-	r = nil
-	r.GetAuthor()
+func TestScanningAnalysis_GetID(tt *testing.T) {
+	var zeroValue int64
+	s := &ScanningAnalysis{ID: &zeroValue}
+	s.GetID()
+	s = &ScanningAnalysis{}
+	s.GetID()
+	s = nil
+	s.GetID()
 }
 
-func TestRepositoryCommit_GetCommentsURL(tt *testing.T) {
+func TestScanningAnalysis_GetRef(tt *testing.T) {
 	var zeroValue string
-	r := &RepositoryCommit{CommentsURL: &zeroValue}
-	r.GetCommentsURL()
-	r = &RepositoryCommit{}
-	r.GetCommentsURL()
-	r = nil
-	r.GetCommentsURL()
+	s := &ScanningAnalysis{Ref: &zeroValue}
+	s.GetRef()
+	s = &ScanningAnalysis{}
+	s.GetRef()
+	s = nil
+	s.GetRef()
 }
 
-func TestRepositoryCommit_GetCommit(tt *testing.T) {
-	r := &RepositoryCommit{}
-	r.GetCommit()
-	r = nil
-	r.GetCommit()
+func TestScanningAnalysis_GetResultsCount(tt *testing.T) {
+	var zeroValue int
+	s := &ScanningAnalysis{ResultsCount: &zeroValue}
+	s.GetResultsCount()
+	s = &ScanningAnalysis{}
+	s.GetResultsCount()
+	s = nil
+	s.GetResultsCount()
 }
 
-func TestRepositoryCommit_GetCommitter(tt *testing.T) {
-	r := &RepositoryCommit{}
-	r.GetCommitter()
-	r = nil
-	r.GetCommitter()
+func TestScanningAnalysis_GetRulesCount(tt *testing.T) {
+	var zeroValue int
+	s := &ScanningAnalysis{RulesCount: &zeroValue}
+	s.GetRulesCount()
+	s = &ScanningAnalysis{}
+	s.GetRulesCount()
+	s = nil
+	s.GetRulesCount()
 }
 
-func TestRepositoryCommit_GetHTMLURL(tt *testing.T) {
+func TestScanningAnalysis_GetSARIFID(tt *testing.T) {
 	var zeroValue string
-	r := &RepositoryCommit{HTMLURL: &zeroValue}
-	r.GetHTMLURL()
-	r = &RepositoryCommit{}
-	r.GetHTMLURL()
-	r = nil
-	r.GetHTMLURL()
+	s := &ScanningAnalysis{SARIFID: &zeroValue}
+	s.GetSARIFID()
+	s = &ScanningAnalysis{}
+	s.GetSARIFID()
+	s = nil
+	s.GetSARIFID()
 }
 
-func TestRepositoryCommit_GetNodeID(tt *testing.T) {
+func TestScanningAnalysis_GetTool(tt *testing.T) {
+	s := &ScanningAnalysis{}
+	s.GetTool()
+	s = nil
+	s.GetTool()
+}
+
+func TestScanningAnalysis_GetURL(tt *testing.T) {
 	var zeroValue string
-	r := &RepositoryCommit{NodeID: &zeroValue}
-	r.GetNodeID()
-	r = &RepositoryCommit{}
-	r.GetNodeID()
-	r = nil
-	r.GetNodeID()
+	s := &ScanningAnalysis{URL: &zeroValue}
+	s.GetURL()
+	s = &ScanningAnalysis{}
+	s.GetURL()
+	s = nil
+	s.GetURL()
 }
 
-func TestRepositoryCommit_GetSHA(tt *testing.T) {
+func TestScanningAnalysis_GetWarning(tt *testing.T) {
 	var zeroValue string
-	r := &RepositoryCommit{SHA: &zeroValue}
-	r.GetSHA()
-	r = &RepositoryCommit{}
-	r.GetSHA()
-	r = nil
-	r.GetSHA()
+	s := &ScanningAnalysis{Warning: &zeroValue}
+	s.GetWarning()
+	s = &ScanningAnalysis{}
+	s.GetWarning()
+	s = nil
+	s.GetWarning()
 }
 
-func TestRepositoryCommit_GetStats(tt *testing.T) {
-	r := &RepositoryCommit{}
-	r.GetStats()
-	r = nil
-	r.GetStats()
+func TestSchemaDrift_GetResponse(tt *testing.T) {
+	s := &SchemaDrift{}
+	s.GetResponse()
+	s = nil
+	s.GetResponse()
 }
 
-func TestRepositoryCommit_GetURL(tt *testing.T) {
+func TestSCIMEnterpriseAttributeOperation_GetPath(tt *testing.T) {
 	var zeroValue string
-	r := &RepositoryCommit{URL: &zeroValue}
-	r.GetURL()
-	r = &RepositoryCommit{}
-	r.GetURL()
-	r = nil
-	r.GetURL()
+	s := &SCIMEnterpriseAttributeOperation{Path: &zeroValue}
+	s.GetPath()
+	s = &SCIMEnterpriseAttributeOperation{}
+	s.GetPath()
+	s = nil
+	s.GetPath()
 }
 
-func TestRepositoryContent_GetDownloadURL(tt *testing.T) {
+func TestSCIMEnterpriseDisplayReference_GetDisplay(tt *testing.T) {
 	var zeroValue string
-	r := &RepositoryContent{DownloadURL: &zeroValue}
-	r.GetDownloadURL()
-	r = &RepositoryContent{}
-	r.GetDownloadURL()
-	r = nil
-	r.GetDownloadURL()
+	s := &SCIMEnterpriseDisplayReference{Display: &zeroValue}
+	s.GetDisplay()
+	s = &SCIMEnterpriseDisplayReference{}
+	s.GetDisplay()
+	s = nil
+	s.GetDisplay()
 }
 
-func TestRepositoryContent_GetEncoding(tt *testing.T) {
+func TestSCIMEnterpriseDisplayReference_GetRef(tt *testing.T) {
 	var zeroValue string
-	r := &RepositoryContent{Encoding: &zeroValue}
-	r.GetEncoding()
-	r = &RepositoryContent{}
-	r.GetEncoding()
-	r = nil
-	r.GetEncoding()
+	s := &SCIMEnterpriseDisplayReference{Ref: &zeroValue}
+	s.GetRef()
+	s = &SCIMEnterpriseDisplayReference{}
+	s.GetRef()
+	s = nil
+	s.GetRef()
 }
 
-func TestRepositoryContent_GetGitURL(tt *testing.T) {
+func TestSCIMEnterpriseDisplayReference_GetValue(tt *testing.T) {
 	var zeroValue string
-	r := &RepositoryContent{GitURL: &zeroValue}
-	r.GetGitURL()
-	r = &RepositoryContent{}
-	r.GetGitURL()
-	r = nil
-	r.GetGitURL()
+	s := &SCIMEnterpriseDisplayReference{Value: &zeroValue}
+	s.GetValue()
+	s = &SCIMEnterpriseDisplayReference{}
+	s.GetValue()
+	s = nil
+	s.GetValue()
 }
 
-func TestRepositoryContent_GetHTMLURL(tt *testing.T) {
+func TestSCIMEnterpriseGroupAttributes_GetDisplayName(tt *testing.T) {
 	var zeroValue string
-	r := &RepositoryContent{HTMLURL: &zeroValue}
-	r.GetHTMLURL()
-	r = &RepositoryContent{}
-	r.GetHTMLURL()
-	r = nil
-	r.GetHTMLURL()
+	s := &SCIMEnterpriseGroupAttributes{DisplayName: &zeroValue}
+	s.GetDisplayName()
+	s = &SCIMEnterpriseGroupAttributes{}
+	s.GetDisplayName()
+	s = nil
+	s.GetDisplayName()
 }
 
-func TestRepositoryContent_GetName(tt *testing.T) {
+func TestSCIMEnterpriseGroupAttributes_GetExternalID(tt *testing.T) {
 	var zeroValue string
-	r := &RepositoryContent{Name: &zeroValue}
-	r.GetName()
-	r = &RepositoryContent{}
-	r.GetName()
-	r = nil
-	r.GetName()
+	s := &SCIMEnterpriseGroupAttributes{ExternalID: &zeroValue}
+	s.GetExternalID()
+	s = &SCIMEnterpriseGroupAttributes{}
+	s.GetExternalID()
+	s = nil
+	s.GetExternalID()
 }
 
-func TestRepositoryContent_GetPath(tt *testing.T) {
+func TestSCIMEnterpriseGroupAttributes_GetID(tt *testing.T) {
 	var zeroValue string
-	r := &RepositoryContent{Path: &zeroValue}
-	r.GetPath()
-	r = &RepositoryContent{}
-	r.GetPath()
-	r = nil
-	r.GetPath()
+	s := &SCIMEnterpriseGroupAttributes{ID: &zeroValue}
+	s.GetID()
+	s = &SCIMEnterpriseGroupAttributes{}
+	s.GetID()
+	s = nil
+	s.GetID()
 }
 
-func TestRepositoryContent_GetSHA(tt *testing.T) {
-	var zeroValue string
-	r := &RepositoryContent{SHA: &zeroValue}
-	r.GetSHA()
-	r = &RepositoryContent{}
-	r.GetSHA()
-	r = nil
-	r.GetSHA()
+func TestSCIMEnterpriseGroupAttributes_GetMeta(tt *testing.T) {
+	s := &SCIMEnterpriseGroupAttributes{}
+	s.GetMeta()
+	s = nil
+	s.GetMeta()
 }
 
-func TestRepositoryContent_GetSize(tt *testing.T) {
+func TestSCIMEnterpriseGroups_GetItemsPerPage(tt *testing.T) {
 	var zeroValue int
-	r := &RepositoryContent{Size: &zeroValue}
-	r.GetSize()
-	r = &RepositoryContent{}
-	r.GetSize()
-	r = nil
-	r.GetSize()
+	s := &SCIMEnterpriseGroups{ItemsPerPage: &zeroValue}
+	s.GetItemsPerPage()
+	s = &SCIMEnterpriseGroups{}
+	s.GetItemsPerPage()
+	s = nil
+	s.GetItemsPerPage()
 }
 
-func TestRepositoryContent_GetTarget(tt *testing.T) {
-	var zeroValue string
-	r := &RepositoryContent{Target: &zeroValue}
-	r.GetTarget()
-	r = &RepositoryContent{}
-	r.GetTarget()
-	r = nil
-	r.GetTarget()
+func TestSCIMEnterpriseGroups_GetStartIndex(tt *testing.T) {
+	var zeroValue int
+	s := &SCIMEnterpriseGroups{StartIndex: &zeroValue}
+	s.GetStartIndex()
+	s = &SCIMEnterpriseGroups{}
+	s.GetStartIndex()
+	s = nil
+	s.GetStartIndex()
 }
 
-func TestRepositoryContent_GetType(tt *testing.T) {
-	var zeroValue string
-	r := &RepositoryContent{Type: &zeroValue}
-	r.GetType()
-	r = &RepositoryContent{}
-	r.GetType()
-	r = nil
-	r.GetType()
+func TestSCIMEnterpriseGroups_GetTotalResults(tt *testing.T) {
+	var zeroValue int
+	s := &SCIMEnterpriseGroups{TotalResults: &zeroValue}
+	s.GetTotalResults()
+	s = &SCIMEnterpriseGroups{}
+	s.GetTotalResults()
+	s = nil
+	s.GetTotalResults()
 }
 
-func TestRepositoryContent_GetURL(tt *testing.T) {
+func TestSCIMEnterpriseUserAttributes_GetActive(tt *testing.T) {
+	var zeroValue bool
+	s := &SCIMEnterpriseUserAttributes{Active: &zeroValue}
+	s.GetActive()
+	s = &SCIMEnterpriseUserAttributes{}
+	s.GetActive()
+	s = nil
+	s.GetActive()
+}
+
+func TestSCIMEnterpriseUserAttributes_GetDisplayName(tt *testing.T) {
 	var zeroValue string
-	r := &RepositoryContent{URL: &zeroValue}
-	r.GetURL()
-	r = &RepositoryContent{}
-	r.GetURL()
-	r = nil
-	r.GetURL()
+	s := &SCIMEnterpriseUserAttributes{DisplayName: &zeroValue}
+	s.GetDisplayName()
+	s = &SCIMEnterpriseUserAttributes{}
+	s.GetDisplayName()
+	s = nil
+	s.GetDisplayName()
 }
 
-func TestRepositoryContentFileOptions_GetAuthor(tt *testing.T) {
-	r := &RepositoryContentFileOptions{}
-	r.GetAuthor()
-	r = nil
-	r.GetAuthor()
+func TestSCIMEnterpriseUserAttributes_GetExternalID(tt *testing.T) {
+	var zeroValue string
+	s := &SCIMEnterpriseUserAttributes{ExternalID: &zeroValue}
+	s.GetExternalID()
+	s = &SCIMEnterpriseUserAttributes{}
+	s.GetExternalID()
+	s = nil
+	s.GetExternalID()
 }
 
-func TestRepositoryContentFileOptions_GetBranch(tt *testing.T) {
+func TestSCIMEnterpriseUserAttributes_GetID(tt *testing.T) {
 	var zeroValue string
-	r := &RepositoryContentFileOptions{Branch: &zeroValue}
-	r.GetBranch()
-	r = &RepositoryContentFileOptions{}
-	r.GetBranch()
-	r = nil
-	r.GetBranch()
+	s := &SCIMEnterpriseUserAttributes{ID: &zeroValue}
+	s.GetID()
+	s = &SCIMEnterpriseUserAttributes{}
+	s.GetID()
+	s = nil
+	s.GetID()
 }
 
-func TestRepositoryContentFileOptions_GetCommitter(tt *testing.T) {
-	r := &RepositoryContentFileOptions{}
-	r.GetCommitter()
-	r = nil
-	r.GetCommitter()
+func TestSCIMEnterpriseUserAttributes_GetMeta(tt *testing.T) {
+	s := &SCIMEnterpriseUserAttributes{}
+	s.GetMeta()
+	s = nil
+	s.GetMeta()
 }
 
-func TestRepositoryContentFileOptions_GetMessage(tt *testing.T) {
-	var zeroValue string
-	r := &RepositoryContentFileOptions{Message: &zeroValue}
-	r.GetMessage()
-	r = &RepositoryContentFileOptions{}
-	r.GetMessage()
-	r = nil
-	r.GetMessage()
+func TestSCIMEnterpriseUserAttributes_GetName(tt *testing.T) {
+	s := &SCIMEnterpriseUserAttributes{}
+	s.GetName()
+	s = nil
+	s.GetName()
 }
 
-func TestRepositoryContentFileOptions_GetSHA(tt *testing.T) {
+func TestSCIMEnterpriseUserAttributes_GetUserName(tt *testing.T) {
 	var zeroValue string
-	r := &RepositoryContentFileOptions{SHA: &zeroValue}
-	r.GetSHA()
-	r = &RepositoryContentFileOptions{}
-	r.GetSHA()
-	r = nil
-	r.GetSHA()
+	s := &SCIMEnterpriseUserAttributes{UserName: &zeroValue}
+	s.GetUserName()
+	s = &SCIMEnterpriseUserAttributes{}
+	s.GetUserName()
+	s = nil
+	s.GetUserName()
 }
 
-func TestRepositoryContentResponse_GetContent(tt *testing.T) {
-	r := &RepositoryContentResponse{}
-	r.GetContent()
-	r = nil
-	r.GetContent()
+func TestSCIMEnterpriseUserEmail_GetPrimary(tt *testing.T) {
+	var zeroValue bool
+	s := &SCIMEnterpriseUserEmail{Primary: &zeroValue}
+	s.GetPrimary()
+	s = &SCIMEnterpriseUserEmail{}
+	s.GetPrimary()
+	s = nil
+	s.GetPrimary()
 }
 
-func TestRepositoryDispatchEvent_GetAction(tt *testing.T) {
+func TestSCIMEnterpriseUserEmail_GetType(tt *testing.T) {
 	var zeroValue string
-	r := &RepositoryDispatchEvent{Action: &zeroValue}
-	r.GetAction()
-	r = &RepositoryDispatchEvent{}
-	r.GetAction()
-	r = nil
-	r.GetAction()
+	s := &SCIMEnterpriseUserEmail{Type: &zeroValue}
+	s.GetType()
+	s = &SCIMEnterpriseUserEmail{}
+	s.GetType()
+	s = nil
+	s.GetType()
 }
 
-func TestRepositoryDispatchEvent_GetBranch(tt *testing.T) {
+func TestSCIMEnterpriseUserEmail_GetValue(tt *testing.T) {
 	var zeroValue string
-	r := &RepositoryDispatchEvent{Branch: &zeroValue}
-	r.GetBranch()
-	r = &RepositoryDispatchEvent{}
-	r.GetBranch()
-	r = nil
-	r.GetBranch()
+	s := &SCIMEnterpriseUserEmail{Value: &zeroValue}
+	s.GetValue()
+	s = &SCIMEnterpriseUserEmail{}
+	s.GetValue()
+	s = nil
+	s.GetValue()
 }
 
-func TestRepositoryDispatchEvent_GetInstallation(tt *testing.T) {
-	r := &RepositoryDispatchEvent{}
-	r.GetInstallation()
-	r = nil
-	r.GetInstallation()
+func TestSCIMEnterpriseUserName_GetFamilyName(tt *testing.T) {
+	var zeroValue string
+	s := &SCIMEnterpriseUserName{FamilyName: &zeroValue}
+	s.GetFamilyName()
+	s = &SCIMEnterpriseUserName{}
+	s.GetFamilyName()
+	s = nil
+	s.GetFamilyName()
 }
 
-func TestRepositoryDispatchEvent_GetOrg(tt *testing.T) {
-	r := &RepositoryDispatchEvent{}
-	r.GetOrg()
-	r = nil
-	r.GetOrg()
+func TestSCIMEnterpriseUserName_GetFormatted(tt *testing.T) {
+	var zeroValue string
+	s := &SCIMEnterpriseUserName{Formatted: &zeroValue}
+	s.GetFormatted()
+	s = &SCIMEnterpriseUserName{}
+	s.GetFormatted()
+	s = nil
+	s.GetFormatted()
 }
 
-func TestRepositoryDispatchEvent_GetRepo(tt *testing.T) {
-	r := &RepositoryDispatchEvent{}
-	r.GetRepo()
-	r = nil
-	r.GetRepo()
+func TestSCIMEnterpriseUserName_GetGivenName(tt *testing.T) {
+	var zeroValue string
+	s := &SCIMEnterpriseUserName{GivenName: &zeroValue}
+	s.GetGivenName()
+	s = &SCIMEnterpriseUserName{}
+	s.GetGivenName()
+	s = nil
+	s.GetGivenName()
 }
 
-func TestRepositoryDispatchEvent_GetSender(tt *testing.T) {
-	r := &RepositoryDispatchEvent{}
-	r.GetSender()
-	r = nil
-	r.GetSender()
+func TestSCIMEnterpriseUserName_GetMiddleName(tt *testing.T) {
+	var zeroValue string
+	s := &SCIMEnterpriseUserName{MiddleName: &zeroValue}
+	s.GetMiddleName()
+	s = &SCIMEnterpriseUserName{}
+	s.GetMiddleName()
+	s = nil
+	s.GetMiddleName()
 }
 
-func TestRepositoryEvent_GetAction(tt *testing.T) {
+func TestSCIMEnterpriseUserRole_GetDisplay(tt *testing.T) {
 	var zeroValue string
-	r := &RepositoryEvent{Action: &zeroValue}
-	r.GetAction()
-	r = &RepositoryEvent{}
-	r.GetAction()
-	r = nil
-	r.GetAction()
+	s := &SCIMEnterpriseUserRole{Display: &zeroValue}
+	s.GetDisplay()
+	s = &SCIMEnterpriseUserRole{}
+	s.GetDisplay()
+	s = nil
+	s.GetDisplay()
 }
 
-func TestRepositoryEvent_GetInstallation(tt *testing.T) {
-	r := &RepositoryEvent{}
-	r.GetInstallation()
-	r = nil
-	r.GetInstallation()
+func TestSCIMEnterpriseUserRole_GetPrimary(tt *testing.T) {
+	var zeroValue bool
+	s := &SCIMEnterpriseUserRole{Primary: &zeroValue}
+	s.GetPrimary()
+	s = &SCIMEnterpriseUserRole{}
+	s.GetPrimary()
+	s = nil
+	s.GetPrimary()
 }
 
-func TestRepositoryEvent_GetOrg(tt *testing.T) {
-	r := &RepositoryEvent{}
-	r.GetOrg()
-	r = nil
-	r.GetOrg()
+func TestSCIMEnterpriseUserRole_GetType(tt *testing.T) {
+	var zeroValue string
+	s := &SCIMEnterpriseUserRole{Type: &zeroValue}
+	s.GetType()
+	s = &SCIMEnterpriseUserRole{}
+	s.GetType()
+	s = nil
+	s.GetType()
 }
 
-func TestRepositoryEvent_GetRepo(tt *testing.T) {
-	r := &RepositoryEvent{}
-	r.GetRepo()
-	r = nil
-	r.GetRepo()
+func TestSCIMEnterpriseUserRole_GetValue(tt *testing.T) {
+	var zeroValue string
+	s := &SCIMEnterpriseUserRole{Value: &zeroValue}
+	s.GetValue()
+	s = &SCIMEnterpriseUserRole{}
+	s.GetValue()
+	s = nil
+	s.GetValue()
 }
 
-func TestRepositoryEvent_GetSender(tt *testing.T) {
-	r := &RepositoryEvent{}
-	r.GetSender()
-	r = nil
-	r.GetSender()
+func TestSCIMEnterpriseUsers_GetItemsPerPage(tt *testing.T) {
+	var zeroValue int
+	s := &SCIMEnterpriseUsers{ItemsPerPage: &zeroValue}
+	s.GetItemsPerPage()
+	s = &SCIMEnterpriseUsers{}
+	s.GetItemsPerPage()
+	s = nil
+	s.GetItemsPerPage()
 }
 
-func TestRepositoryInvitation_GetCreatedAt(tt *testing.T) {
-	var zeroValue Timestamp
-	r := &RepositoryInvitation{CreatedAt: &zeroValue}
-	r.GetCreatedAt()
-	r = &RepositoryInvitation{}
-	r.GetCreatedAt()
-	r = nil
-	r.GetCreatedAt()
+func TestSCIMEnterpriseUsers_GetStartIndex(tt *testing.T) {
+	var zeroValue int
+	s := &SCIMEnterpriseUsers{StartIndex: &zeroValue}
+	s.GetStartIndex()
+	s = &SCIMEnterpriseUsers{}
+	s.GetStartIndex()
+	s = nil
+	s.GetStartIndex()
 }
 
-func TestRepositoryInvitation_GetHTMLURL(tt *testing.T) {
-	var zeroValue string
-	r := &RepositoryInvitation{HTMLURL: &zeroValue}
-	r.GetHTMLURL()
-	r = &RepositoryInvitation{}
-	r.GetHTMLURL()
-	r = nil
-	r.GetHTMLURL()
+func TestSCIMEnterpriseUsers_GetTotalResults(tt *testing.T) {
+	var zeroValue int
+	s := &SCIMEnterpriseUsers{TotalResults: &zeroValue}
+	s.GetTotalResults()
+	s = &SCIMEnterpriseUsers{}
+	s.GetTotalResults()
+	s = nil
+	s.GetTotalResults()
 }
 
-func TestRepositoryInvitation_GetID(tt *testing.T) {
-	var zeroValue int64
-	r := &RepositoryInvitation{ID: &zeroValue}
-	r.GetID()
-	r = &RepositoryInvitation{}
-	r.GetID()
-	r = nil
-	r.GetID()
+func TestSCIMMeta_GetCreated(tt *testing.T) {
+	var zeroValue Timestamp
+	s := &SCIMMeta{Created: &zeroValue}
+	s.GetCreated()
+	s = &SCIMMeta{}
+	s.GetCreated()
+	s = nil
+	s.GetCreated()
 }
 
-func TestRepositoryInvitation_GetInvitee(tt *testing.T) {
-	r := &RepositoryInvitation{}
-	r.GetInvitee()
-	r = nil
-	r.GetInvitee()
+func TestSCIMMeta_GetLastModified(tt *testing.T) {
+	var zeroValue Timestamp
+	s := &SCIMMeta{LastModified: &zeroValue}
+	s.GetLastModified()
+	s = &SCIMMeta{}
+	s.GetLastModified()
+	s = nil
+	s.GetLastModified()
 }
 
-func TestRepositoryInvitation_GetInviter(tt *testing.T) {
-	r := &RepositoryInvitation{}
-	r.GetInviter()
-	r = nil
-	r.GetInviter()
+func TestSCIMMeta_GetLocation(tt *testing.T) {
+	var zeroValue string
+	s := &SCIMMeta{Location: &zeroValue}
+	s.GetLocation()
+	s = &SCIMMeta{}
+	s.GetLocation()
+	s = nil
+	s.GetLocation()
 }
 
-func TestRepositoryInvitation_GetPermissions(tt *testing.T) {
+func TestSCIMMeta_GetResourceType(tt *testing.T) {
 	var zeroValue string
-	r := &RepositoryInvitation{Permissions: &zeroValue}
-	r.GetPermissions()
-	r = &RepositoryInvitation{}
-	r.GetPermissions()
-	r = nil
-	r.GetPermissions()
+	s := &SCIMMeta{ResourceType: &zeroValue}
+	s.GetResourceType()
+	s = &SCIMMeta{}
+	s.GetResourceType()
+	s = nil
+	s.GetResourceType()
 }
 
-func TestRepositoryInvitation_GetRepo(tt *testing.T) {
-	r := &RepositoryInvitation{}
-	r.GetRepo()
-	r = nil
-	r.GetRepo()
+func TestSeatAssignments_GetSeatsCreated(tt *testing.T) {
+	var zeroValue int
+	s := &SeatAssignments{SeatsCreated: &zeroValue}
+	s.GetSeatsCreated()
+	s = &SeatAssignments{}
+	s.GetSeatsCreated()
+	s = nil
+	s.GetSeatsCreated()
 }
 
-func TestRepositoryInvitation_GetURL(tt *testing.T) {
-	var zeroValue string
-	r := &RepositoryInvitation{URL: &zeroValue}
-	r.GetURL()
-	r = &RepositoryInvitation{}
-	r.GetURL()
-	r = nil
-	r.GetURL()
+func TestSeatCancellations_GetSeatsCancelled(tt *testing.T) {
+	var zeroValue int
+	s := &SeatCancellations{SeatsCancelled: &zeroValue}
+	s.GetSeatsCancelled()
+	s = &SeatCancellations{}
+	s.GetSeatsCancelled()
+	s = nil
+	s.GetSeatsCancelled()
 }
 
-func TestRepositoryLicense_GetContent(tt *testing.T) {
-	var zeroValue string
-	r := &RepositoryLicense{Content: &zeroValue}
-	r.GetContent()
-	r = &RepositoryLicense{}
-	r.GetContent()
-	r = nil
-	r.GetContent()
+func TestSecretScanningAlert_GetCreatedAt(tt *testing.T) {
+	var zeroValue Timestamp
+	s := &SecretScanningAlert{CreatedAt: &zeroValue}
+	s.GetCreatedAt()
+	s = &SecretScanningAlert{}
+	s.GetCreatedAt()
+	s = nil
+	s.GetCreatedAt()
 }
 
-func TestRepositoryLicense_GetDownloadURL(tt *testing.T) {
+func TestSecretScanningAlert_GetHTMLURL(tt *testing.T) {
 	var zeroValue string
-	r := &RepositoryLicense{DownloadURL: &zeroValue}
-	r.GetDownloadURL()
-	r = &RepositoryLicense{}
-	r.GetDownloadURL()
-	r = nil
-	r.GetDownloadURL()
+	s := &SecretScanningAlert{HTMLURL: &zeroValue}
+	s.GetHTMLURL()
+	s = &SecretScanningAlert{}
+	s.GetHTMLURL()
+	s = nil
+	s.GetHTMLURL()
 }
 
-func TestRepositoryLicense_GetEncoding(tt *testing.T) {
-	var zeroValue string
-	r := &RepositoryLicense{Encoding: &zeroValue}
-	r.GetEncoding()
-	r = &RepositoryLicense{}
-	r.GetEncoding()
-	r = nil
-	r.GetEncoding()
+func TestSecretScanningAlert_GetIsBase64Encoded(tt *testing.T) {
+	var zeroValue bool
+	s := &SecretScanningAlert{IsBase64Encoded: &zeroValue}
+	s.GetIsBase64Encoded()
+	s = &SecretScanningAlert{}
+	s.GetIsBase64Encoded()
+	s = nil
+	s.GetIsBase64Encoded()
 }
 
-func TestRepositoryLicense_GetGitURL(tt *testing.T) {
+func TestSecretScanningAlert_GetLocationsURL(tt *testing.T) {
 	var zeroValue string
-	r := &RepositoryLicense{GitURL: &zeroValue}
-	r.GetGitURL()
-	r = &RepositoryLicense{}
-	r.GetGitURL()
-	r = nil
-	r.GetGitURL()
+	s := &SecretScanningAlert{LocationsURL: &zeroValue}
+	s.GetLocationsURL()
+	s = &SecretScanningAlert{}
+	s.GetLocationsURL()
+	s = nil
+	s.GetLocationsURL()
 }
 
-func TestRepositoryLicense_GetHTMLURL(tt *testing.T) {
-	var zeroValue string
-	r := &RepositoryLicense{HTMLURL: &zeroValue}
-	r.GetHTMLURL()
-	r = &RepositoryLicense{}
-	r.GetHTMLURL()
-	r = nil
-	r.GetHTMLURL()
+func TestSecretScanningAlert_GetMultiRepo(tt *testing.T) {
+	var zeroValue bool
+	s := &SecretScanningAlert{MultiRepo: &zeroValue}
+	s.GetMultiRepo()
+	s = &SecretScanningAlert{}
+	s.GetMultiRepo()
+	s = nil
+	s.GetMultiRepo()
 }
 
-func TestRepositoryLicense_GetLicense(tt *testing.T) {
-	r := &RepositoryLicense{}
-	r.GetLicense()
-	r = nil
-	r.GetLicense()
+func TestSecretScanningAlert_GetNumber(tt *testing.T) {
+	var zeroValue int
+	s := &SecretScanningAlert{Number: &zeroValue}
+	s.GetNumber()
+	s = &SecretScanningAlert{}
+	s.GetNumber()
+	s = nil
+	s.GetNumber()
 }
 
-func TestRepositoryLicense_GetName(tt *testing.T) {
-	var zeroValue string
-	r := &RepositoryLicense{Name: &zeroValue}
-	r.GetName()
-	r = &RepositoryLicense{}
-	r.GetName()
-	r = nil
-	r.GetName()
+func TestSecretScanningAlert_GetPubliclyLeaked(tt *testing.T) {
+	var zeroValue bool
+	s := &SecretScanningAlert{PubliclyLeaked: &zeroValue}
+	s.GetPubliclyLeaked()
+	s = &SecretScanningAlert{}
+	s.GetPubliclyLeaked()
+	s = nil
+	s.GetPubliclyLeaked()
 }
 
-func TestRepositoryLicense_GetPath(tt *testing.T) {
-	var zeroValue string
-	r := &RepositoryLicense{Path: &zeroValue}
-	r.GetPath()
-	r = &RepositoryLicense{}
-	r.GetPath()
-	r = nil
-	r.GetPath()
+func TestSecretScanningAlert_GetPushProtectionBypassed(tt *testing.T) {
+	var zeroValue bool
+	s := &SecretScanningAlert{PushProtectionBypassed: &zeroValue}
+	s.GetPushProtectionBypassed()
+	s = &SecretScanningAlert{}
+	s.GetPushProtectionBypassed()
+	s = nil
+	s.GetPushProtectionBypassed()
 }
 
-func TestRepositoryLicense_GetSHA(tt *testing.T) {
-	var zeroValue string
-	r := &RepositoryLicense{SHA: &zeroValue}
-	r.GetSHA()
-	r = &RepositoryLicense{}
-	r.GetSHA()
-	r = nil
-	r.GetSHA()
+func TestSecretScanningAlert_GetPushProtectionBypassedAt(tt *testing.T) {
+	var zeroValue Timestamp
+	s := &SecretScanningAlert{PushProtectionBypassedAt: &zeroValue}
+	s.GetPushProtectionBypassedAt()
+	s = &SecretScanningAlert{}
+	s.GetPushProtectionBypassedAt()
+	s = nil
+	s.GetPushProtectionBypassedAt()
 }
 
-func TestRepositoryLicense_GetSize(tt *testing.T) {
-	var zeroValue int
-	r := &RepositoryLicense{Size: &zeroValue}
-	r.GetSize()
-	r = &RepositoryLicense{}
-	r.GetSize()
-	r = nil
-	r.GetSize()
+func TestSecretScanningAlert_GetPushProtectionBypassedBy(tt *testing.T) {
+	s := &SecretScanningAlert{}
+	s.GetPushProtectionBypassedBy()
+	s = nil
+	s.GetPushProtectionBypassedBy()
 }
 
-func TestRepositoryLicense_GetType(tt *testing.T) {
-	var zeroValue string
-	r := &RepositoryLicense{Type: &zeroValue}
-	r.GetType()
-	r = &RepositoryLicense{}
-	r.GetType()
-	r = nil
-	r.GetType()
+func TestSecretScanningAlert_GetRepository(tt *testing.T) {
+	s := &SecretScanningAlert{}
+	s.GetRepository()
+	s = nil
+	s.GetRepository()
 }
 
-func TestRepositoryLicense_GetURL(tt *testing.T) {
+func TestSecretScanningAlert_GetResolution(tt *testing.T) {
 	var zeroValue string
-	r := &RepositoryLicense{URL: &zeroValue}
-	r.GetURL()
-	r = &RepositoryLicense{}
-	r.GetURL()
-	r = nil
-	r.GetURL()
+	s := &SecretScanningAlert{Resolution: &zeroValue}
+	s.GetResolution()
+	s = &SecretScanningAlert{}
+	s.GetResolution()
+	s = nil
+	s.GetResolution()
 }
 
-func TestRepositoryMergeRequest_GetBase(tt *testing.T) {
+func TestSecretScanningAlert_GetResolutionComment(tt *testing.T) {
 	var zeroValue string
-	r := &RepositoryMergeRequest{Base: &zeroValue}
-	r.GetBase()
-	r = &RepositoryMergeRequest{}
-	r.GetBase()
-	r = nil
-	r.GetBase()
+	s := &SecretScanningAlert{ResolutionComment: &zeroValue}
+	s.GetResolutionComment()
+	s = &SecretScanningAlert{}
+	s.GetResolutionComment()
+	s = nil
+	s.GetResolutionComment()
 }
 
-func TestRepositoryMergeRequest_GetCommitMessage(tt *testing.T) {
-	var zeroValue string
-	r := &RepositoryMergeRequest{CommitMessage: &zeroValue}
-	r.GetCommitMessage()
-	r = &RepositoryMergeRequest{}
-	r.GetCommitMessage()
-	r = nil
-	r.GetCommitMessage()
+func TestSecretScanningAlert_GetResolvedAt(tt *testing.T) {
+	var zeroValue Timestamp
+	s := &SecretScanningAlert{ResolvedAt: &zeroValue}
+	s.GetResolvedAt()
+	s = &SecretScanningAlert{}
+	s.GetResolvedAt()
+	s = nil
+	s.GetResolvedAt()
 }
 
-func TestRepositoryMergeRequest_GetHead(tt *testing.T) {
+func TestSecretScanningAlert_GetResolvedBy(tt *testing.T) {
+	s := &SecretScanningAlert{}
+	s.GetResolvedBy()
+	s = nil
+	s.GetResolvedBy()
+}
+
+func TestSecretScanningAlert_GetSecret(tt *testing.T) {
 	var zeroValue string
-	r := &RepositoryMergeRequest{Head: &zeroValue}
-	r.GetHead()
-	r = &RepositoryMergeRequest{}
-	r.GetHead()
-	r = nil
-	r.GetHead()
+	s := &SecretScanningAlert{Secret: &zeroValue}
+	s.GetSecret()
+	s = &SecretScanningAlert{}
+	s.GetSecret()
+	s = nil
+	s.GetSecret()
 }
 
-func TestRepositoryPermissionLevel_GetPermission(tt *testing.T) {
+func TestSecretScanningAlert_GetSecretType(tt *testing.T) {
 	var zeroValue string
-	r := &RepositoryPermissionLevel{Permission: &zeroValue}
-	r.GetPermission()
-	r = &RepositoryPermissionLevel{}
-	r.GetPermission()
-	r = nil
-	r.GetPermission()
+	s := &SecretScanningAlert{SecretType: &zeroValue}
+	s.GetSecretType()
+	s = &SecretScanningAlert{}
+	s.GetSecretType()
+	s = nil
+	s.GetSecretType()
 }
 
-func TestRepositoryPermissionLevel_GetUser(tt *testing.T) {
-	r := &RepositoryPermissionLevel{}
-	r.GetUser()
-	r = nil
-	r.GetUser()
+func TestSecretScanningAlert_GetSecretTypeDisplayName(tt *testing.T) {
+	var zeroValue string
+	s := &SecretScanningAlert{SecretTypeDisplayName: &zeroValue}
+	s.GetSecretTypeDisplayName()
+	s = &SecretScanningAlert{}
+	s.GetSecretTypeDisplayName()
+	s = nil
+	s.GetSecretTypeDisplayName()
 }
 
-func TestRepositoryRelease_GetAssetsURL(tt *testing.T) {
+func TestSecretScanningAlert_GetState(tt *testing.T) {
 	var zeroValue string
-	r := &RepositoryRelease{AssetsURL: &zeroValue}
-	r.GetAssetsURL()
-	r = &RepositoryRelease{}
-	r.GetAssetsURL()
-	r = nil
-	r.GetAssetsURL()
+	s := &SecretScanningAlert{State: &zeroValue}
+	s.GetState()
+	s = &SecretScanningAlert{}
+	s.GetState()
+	s = nil
+	s.GetState()
 }
 
-func TestRepositoryRelease_GetAuthor(tt *testing.T) {
-	r := &RepositoryRelease{}
-	r.GetAuthor()
-	r = nil
-	r.GetAuthor()
+func TestSecretScanningAlert_GetUpdatedAt(tt *testing.T) {
+	var zeroValue Timestamp
+	s := &SecretScanningAlert{UpdatedAt: &zeroValue}
+	s.GetUpdatedAt()
+	s = &SecretScanningAlert{}
+	s.GetUpdatedAt()
+	s = nil
+	s.GetUpdatedAt()
 }
 
-func TestRepositoryRelease_GetBody(tt *testing.T) {
+func TestSecretScanningAlert_GetURL(tt *testing.T) {
 	var zeroValue string
-	r := &RepositoryRelease{Body: &zeroValue}
-	r.GetBody()
-	r = &RepositoryRelease{}
-	r.GetBody()
-	r = nil
-	r.GetBody()
+	s := &SecretScanningAlert{URL: &zeroValue}
+	s.GetURL()
+	s = &SecretScanningAlert{}
+	s.GetURL()
+	s = nil
+	s.GetURL()
 }
 
-func TestRepositoryRelease_GetCreatedAt(tt *testing.T) {
-	var zeroValue Timestamp
-	r := &RepositoryRelease{CreatedAt: &zeroValue}
-	r.GetCreatedAt()
-	r = &RepositoryRelease{}
-	r.GetCreatedAt()
-	r = nil
-	r.GetCreatedAt()
+func TestSecretScanningAlert_GetValidity(tt *testing.T) {
+	var zeroValue string
+	s := &SecretScanningAlert{Validity: &zeroValue}
+	s.GetValidity()
+	s = &SecretScanningAlert{}
+	s.GetValidity()
+	s = nil
+	s.GetValidity()
 }
 
-func TestRepositoryRelease_GetDraft(tt *testing.T) {
-	var zeroValue bool
-	r := &RepositoryRelease{Draft: &zeroValue}
-	r.GetDraft()
-	r = &RepositoryRelease{}
-	r.GetDraft()
-	r = nil
-	r.GetDraft()
+func TestSecretScanningAlertLocation_GetDetails(tt *testing.T) {
+	s := &SecretScanningAlertLocation{}
+	s.GetDetails()
+	s = nil
+	s.GetDetails()
 }
 
-func TestRepositoryRelease_GetHTMLURL(tt *testing.T) {
+func TestSecretScanningAlertLocation_GetType(tt *testing.T) {
 	var zeroValue string
-	r := &RepositoryRelease{HTMLURL: &zeroValue}
-	r.GetHTMLURL()
-	r = &RepositoryRelease{}
-	r.GetHTMLURL()
-	r = nil
-	r.GetHTMLURL()
+	s := &SecretScanningAlertLocation{Type: &zeroValue}
+	s.GetType()
+	s = &SecretScanningAlertLocation{}
+	s.GetType()
+	s = nil
+	s.GetType()
 }
 
-func TestRepositoryRelease_GetID(tt *testing.T) {
-	var zeroValue int64
-	r := &RepositoryRelease{ID: &zeroValue}
-	r.GetID()
-	r = &RepositoryRelease{}
-	r.GetID()
-	r = nil
-	r.GetID()
+func TestSecretScanningAlertLocationDetails_GetBlobSHA(tt *testing.T) {
+	var zeroValue string
+	s := &SecretScanningAlertLocationDetails{BlobSHA: &zeroValue}
+	s.GetBlobSHA()
+	s = &SecretScanningAlertLocationDetails{}
+	s.GetBlobSHA()
+	s = nil
+	s.GetBlobSHA()
 }
 
-func TestRepositoryRelease_GetName(tt *testing.T) {
+func TestSecretScanningAlertLocationDetails_GetBlobURL(tt *testing.T) {
 	var zeroValue string
-	r := &RepositoryRelease{Name: &zeroValue}
-	r.GetName()
-	r = &RepositoryRelease{}
-	r.GetName()
-	r = nil
-	r.GetName()
+	s := &SecretScanningAlertLocationDetails{BlobURL: &zeroValue}
+	s.GetBlobURL()
+	s = &SecretScanningAlertLocationDetails{}
+	s.GetBlobURL()
+	s = nil
+	s.GetBlobURL()
 }
 
-func TestRepositoryRelease_GetNodeID(tt *testing.T) {
+func TestSecretScanningAlertLocationDetails_GetCommitSHA(tt *testing.T) {
 	var zeroValue string
-	r := &RepositoryRelease{NodeID: &zeroValue}
-	r.GetNodeID()
-	r = &RepositoryRelease{}
-	r.GetNodeID()
-	r = nil
-	r.GetNodeID()
+	s := &SecretScanningAlertLocationDetails{CommitSHA: &zeroValue}
+	s.GetCommitSHA()
+	s = &SecretScanningAlertLocationDetails{}
+	s.GetCommitSHA()
+	s = nil
+	s.GetCommitSHA()
 }
 
-func TestRepositoryRelease_GetPrerelease(tt *testing.T) {
-	var zeroValue bool
-	r := &RepositoryRelease{Prerelease: &zeroValue}
-	r.GetPrerelease()
-	r = &RepositoryRelease{}
-	r.GetPrerelease()
-	r = nil
-	r.GetPrerelease()
+func TestSecretScanningAlertLocationDetails_GetCommitURL(tt *testing.T) {
+	var zeroValue string
+	s := &SecretScanningAlertLocationDetails{CommitURL: &zeroValue}
+	s.GetCommitURL()
+	s = &SecretScanningAlertLocationDetails{}
+	s.GetCommitURL()
+	s = nil
+	s.GetCommitURL()
 }
 
-func TestRepositoryRelease_GetPublishedAt(tt *testing.T) {
-	var zeroValue Timestamp
-	r := &RepositoryRelease{PublishedAt: &zeroValue}
-	r.GetPublishedAt()
-	r = &RepositoryRelease{}
-	r.GetPublishedAt()
-	r = nil
-	r.GetPublishedAt()
+func TestSecretScanningAlertLocationDetails_GetDiscussionBodyURL(tt *testing.T) {
+	var zeroValue string
+	s := &SecretScanningAlertLocationDetails{DiscussionBodyURL: &zeroValue}
+	s.GetDiscussionBodyURL()
+	s = &SecretScanningAlertLocationDetails{}
+	s.GetDiscussionBodyURL()
+	s = nil
+	s.GetDiscussionBodyURL()
 }
 
-func TestRepositoryRelease_GetTagName(tt *testing.T) {
+func TestSecretScanningAlertLocationDetails_GetDiscussionCommentURL(tt *testing.T) {
 	var zeroValue string
-	r := &RepositoryRelease{TagName: &zeroValue}
-	r.GetTagName()
-	r = &RepositoryRelease{}
-	r.GetTagName()
-	r = nil
-	r.GetTagName()
+	s := &SecretScanningAlertLocationDetails{DiscussionCommentURL: &zeroValue}
+	s.GetDiscussionCommentURL()
+	s = &SecretScanningAlertLocationDetails{}
+	s.GetDiscussionCommentURL()
+	s = nil
+	s.GetDiscussionCommentURL()
 }
 
-func TestRepositoryRelease_GetTarballURL(tt *testing.T) {
+func TestSecretScanningAlertLocationDetails_GetDiscussionTitleURL(tt *testing.T) {
 	var zeroValue string
-	r := &RepositoryRelease{TarballURL: &zeroValue}
-	r.GetTarballURL()
-	r = &RepositoryRelease{}
-	r.GetTarballURL()
-	r = nil
-	r.GetTarballURL()
+	s := &SecretScanningAlertLocationDetails{DiscussionTitleURL: &zeroValue}
+	s.GetDiscussionTitleURL()
+	s = &SecretScanningAlertLocationDetails{}
+	s.GetDiscussionTitleURL()
+	s = nil
+	s.GetDiscussionTitleURL()
 }
 
-func TestRepositoryRelease_GetTargetCommitish(tt *testing.T) {
-	var zeroValue string
-	r := &RepositoryRelease{TargetCommitish: &zeroValue}
-	r.GetTargetCommitish()
-	r = &RepositoryRelease{}
-	r.GetTargetCommitish()
-	r = nil
-	r.GetTargetCommitish()
+func TestSecretScanningAlertLocationDetails_GetEndColumn(tt *testing.T) {
+	var zeroValue int
+	s := &SecretScanningAlertLocationDetails{EndColumn: &zeroValue}
+	s.GetEndColumn()
+	s = &SecretScanningAlertLocationDetails{}
+	s.GetEndColumn()
+	s = nil
+	s.GetEndColumn()
 }
 
-func TestRepositoryRelease_GetUploadURL(tt *testing.T) {
+func TestSecretScanningAlertLocationDetails_GetEndLine(tt *testing.T) {
+	var zeroValue int
+	s := &SecretScanningAlertLocationDetails{EndLine: &zeroValue}
+	s.GetEndLine()
+	s = &SecretScanningAlertLocationDetails{}
+	s.GetEndLine()
+	s = nil
+	s.GetEndLine()
+}
+
+func TestSecretScanningAlertLocationDetails_GetIssueBodyURL(tt *testing.T) {
 	var zeroValue string
-	r := &RepositoryRelease{UploadURL: &zeroValue}
-	r.GetUploadURL()
-	r = &RepositoryRelease{}
-	r.GetUploadURL()
-	r = nil
-	r.GetUploadURL()
+	s := &SecretScanningAlertLocationDetails{IssueBodyURL: &zeroValue}
+	s.GetIssueBodyURL()
+	s = &SecretScanningAlertLocationDetails{}
+	s.GetIssueBodyURL()
+	s = nil
+	s.GetIssueBodyURL()
 }
 
-func TestRepositoryRelease_GetURL(tt *testing.T) {
+func TestSecretScanningAlertLocationDetails_GetIssueCommentURL(tt *testing.T) {
 	var zeroValue string
-	r := &RepositoryRelease{URL: &zeroValue}
-	r.GetURL()
-	r = &RepositoryRelease{}
-	r.GetURL()
-	r = nil
-	r.GetURL()
+	s := &SecretScanningAlertLocationDetails{IssueCommentURL: &zeroValue}
+	s.GetIssueCommentURL()
+	s = &SecretScanningAlertLocationDetails{}
+	s.GetIssueCommentURL()
+	s = nil
+	s.GetIssueCommentURL()
 }
 
-func TestRepositoryRelease_GetZipballURL(tt *testing.T) {
+func TestSecretScanningAlertLocationDetails_GetIssueTitleURL(tt *testing.T) {
 	var zeroValue string
-	r := &RepositoryRelease{ZipballURL: &zeroValue}
-	r.GetZipballURL()
-	r = &RepositoryRelease{}
-	r.GetZipballURL()
-	r = nil
-	r.GetZipballURL()
+	s := &SecretScanningAlertLocationDetails{IssueTitleURL: &zeroValue}
+	s.GetIssueTitleURL()
+	s = &SecretScanningAlertLocationDetails{}
+	s.GetIssueTitleURL()
+	s = nil
+	s.GetIssueTitleURL()
 }
 
-func TestRepositoryTag_GetCommit(tt *testing.T) {
-	r := &RepositoryTag{}
-	r.GetCommit()
-	r = nil
-	r.GetCommit()
+func TestSecretScanningAlertLocationDetails_GetPageURL(tt *testing.T) {
+	var zeroValue string
+	s := &SecretScanningAlertLocationDetails{PageURL: &zeroValue}
+	s.GetPageURL()
+	s = &SecretScanningAlertLocationDetails{}
+	s.GetPageURL()
+	s = nil
+	s.GetPageURL()
 }
 
-func TestRepositoryTag_GetName(tt *testing.T) {
+func TestSecretScanningAlertLocationDetails_GetPath(tt *testing.T) {
 	var zeroValue string
-	r := &RepositoryTag{Name: &zeroValue}
-	r.GetName()
-	r = &RepositoryTag{}
-	r.GetName()
-	r = nil
-	r.GetName()
+	s := &SecretScanningAlertLocationDetails{Path: &zeroValue}
+	s.GetPath()
+	s = &SecretScanningAlertLocationDetails{}
+	s.GetPath()
+	s = nil
+	s.GetPath()
 }
 
-func TestRepositoryTag_GetTarballURL(tt *testing.T) {
+func TestSecretScanningAlertLocationDetails_GetPullRequestBodyURL(tt *testing.T) {
 	var zeroValue string
-	r := &RepositoryTag{TarballURL: &zeroValue}
-	r.GetTarballURL()
-	r = &RepositoryTag{}
-	r.GetTarballURL()
-	r = nil
-	r.GetTarballURL()
+	s := &SecretScanningAlertLocationDetails{PullRequestBodyURL: &zeroValue}
+	s.GetPullRequestBodyURL()
+	s = &SecretScanningAlertLocationDetails{}
+	s.GetPullRequestBodyURL()
+	s = nil
+	s.GetPullRequestBodyURL()
 }
 
-func TestRepositoryTag_GetZipballURL(tt *testing.T) {
+func TestSecretScanningAlertLocationDetails_GetPullRequestCommentURL(tt *testing.T) {
 	var zeroValue string
-	r := &RepositoryTag{ZipballURL: &zeroValue}
-	r.GetZipballURL()
-	r = &RepositoryTag{}
-	r.GetZipballURL()
-	r = nil
-	r.GetZipballURL()
+	s := &SecretScanningAlertLocationDetails{PullRequestCommentURL: &zeroValue}
+	s.GetPullRequestCommentURL()
+	s = &SecretScanningAlertLocationDetails{}
+	s.GetPullRequestCommentURL()
+	s = nil
+	s.GetPullRequestCommentURL()
 }
 
-func TestRepositoryVulnerabilityAlertEvent_GetAction(tt *testing.T) {
+func TestSecretScanningAlertLocationDetails_GetPullRequestReviewCommentURL(tt *testing.T) {
 	var zeroValue string
-	r := &RepositoryVulnerabilityAlertEvent{Action: &zeroValue}
-	r.GetAction()
-	r = &RepositoryVulnerabilityAlertEvent{}
-	r.GetAction()
-	r = nil
-	r.GetAction()
+	s := &SecretScanningAlertLocationDetails{PullRequestReviewCommentURL: &zeroValue}
+	s.GetPullRequestReviewCommentURL()
+	s = &SecretScanningAlertLocationDetails{}
+	s.GetPullRequestReviewCommentURL()
+	s = nil
+	s.GetPullRequestReviewCommentURL()
 }
 
-func TestRepositoryVulnerabilityAlertEvent_GetRepository(tt *testing.T) {
-	r := &RepositoryVulnerabilityAlertEvent{}
-	r.GetRepository()
-	r = nil
-	r.GetRepository()
+func TestSecretScanningAlertLocationDetails_GetPullRequestReviewURL(tt *testing.T) {
+	var zeroValue string
+	s := &SecretScanningAlertLocationDetails{PullRequestReviewURL: &zeroValue}
+	s.GetPullRequestReviewURL()
+	s = &SecretScanningAlertLocationDetails{}
+	s.GetPullRequestReviewURL()
+	s = nil
+	s.GetPullRequestReviewURL()
 }
 
-func TestRepoStats_GetForkRepos(tt *testing.T) {
-	var zeroValue int
-	r := &RepoStats{ForkRepos: &zeroValue}
-	r.GetForkRepos()
-	r = &RepoStats{}
-	r.GetForkRepos()
-	r = nil
-	r.GetForkRepos()
+func TestSecretScanningAlertLocationDetails_GetPullRequestTitleURL(tt *testing.T) {
+	var zeroValue string
+	s := &SecretScanningAlertLocationDetails{PullRequestTitleURL: &zeroValue}
+	s.GetPullRequestTitleURL()
+	s = &SecretScanningAlertLocationDetails{}
+	s.GetPullRequestTitleURL()
+	s = nil
+	s.GetPullRequestTitleURL()
 }
 
-func TestRepoStats_GetOrgRepos(tt *testing.T) {
+func TestSecretScanningAlertLocationDetails_GetStartColumn(tt *testing.T) {
 	var zeroValue int
-	r := &RepoStats{OrgRepos: &zeroValue}
-	r.GetOrgRepos()
-	r = &RepoStats{}
-	r.GetOrgRepos()
-	r = nil
-	r.GetOrgRepos()
+	s := &SecretScanningAlertLocationDetails{StartColumn: &zeroValue}
+	s.GetStartColumn()
+	s = &SecretScanningAlertLocationDetails{}
+	s.GetStartColumn()
+	s = nil
+	s.GetStartColumn()
 }
 
-func TestRepoStats_GetRootRepos(tt *testing.T) {
+func TestSecretScanningAlertLocationDetails_GetStartLine(tt *testing.T) {
 	var zeroValue int
-	r := &RepoStats{RootRepos: &zeroValue}
-	r.GetRootRepos()
-	r = &RepoStats{}
-	r.GetRootRepos()
-	r = nil
-	r.GetRootRepos()
+	s := &SecretScanningAlertLocationDetails{StartLine: &zeroValue}
+	s.GetStartLine()
+	s = &SecretScanningAlertLocationDetails{}
+	s.GetStartLine()
+	s = nil
+	s.GetStartLine()
 }
 
-func TestRepoStats_GetTotalPushes(tt *testing.T) {
-	var zeroValue int
-	r := &RepoStats{TotalPushes: &zeroValue}
-	r.GetTotalPushes()
-	r = &RepoStats{}
-	r.GetTotalPushes()
-	r = nil
-	r.GetTotalPushes()
+func TestSecretScanningAlertLocationEvent_GetAction(tt *testing.T) {
+	var zeroValue string
+	s := &SecretScanningAlertLocationEvent{Action: &zeroValue}
+	s.GetAction()
+	s = &SecretScanningAlertLocationEvent{}
+	s.GetAction()
+	s = nil
+	s.GetAction()
 }
 
-func TestRepoStats_GetTotalRepos(tt *testing.T) {
-	var zeroValue int
-	r := &RepoStats{TotalRepos: &zeroValue}
-	r.GetTotalRepos()
-	r = &RepoStats{}
-	r.GetTotalRepos()
-	r = nil
-	r.GetTotalRepos()
+func TestSecretScanningAlertLocationEvent_GetAlert(tt *testing.T) {
+	s := &SecretScanningAlertLocationEvent{}
+	s.GetAlert()
+	s = nil
+	s.GetAlert()
 }
 
-func TestRepoStats_GetTotalWikis(tt *testing.T) {
-	var zeroValue int
-	r := &RepoStats{TotalWikis: &zeroValue}
-	r.GetTotalWikis()
-	r = &RepoStats{}
-	r.GetTotalWikis()
-	r = nil
-	r.GetTotalWikis()
+func TestSecretScanningAlertLocationEvent_GetInstallation(tt *testing.T) {
+	s := &SecretScanningAlertLocationEvent{}
+	s.GetInstallation()
+	s = nil
+	s.GetInstallation()
 }
 
-func TestRepoStatus_GetContext(tt *testing.T) {
-	var zeroValue string
-	r := &RepoStatus{Context: &zeroValue}
-	r.GetContext()
-	r = &RepoStatus{}
-	r.GetContext()
-	r = nil
-	r.GetContext()
+func TestSecretScanningAlertLocationEvent_GetLocation(tt *testing.T) {
+	s := &SecretScanningAlertLocationEvent{}
+	s.GetLocation()
+	s = nil
+	s.GetLocation()
 }
 
-func TestRepoStatus_GetCreatedAt(tt *testing.T) {
-	var zeroValue time.Time
-	r := &RepoStatus{CreatedAt: &zeroValue}
-	r.GetCreatedAt()
-	r = &RepoStatus{}
-	r.GetCreatedAt()
-	r = nil
-	r.GetCreatedAt()
+func TestSecretScanningAlertLocationEvent_GetOrg(tt *testing.T) {
+	s := &SecretScanningAlertLocationEvent{}
+	s.GetOrg()
+	s = nil
+	s.GetOrg()
 }
 
-func TestRepoStatus_GetCreator(tt *testing.T) {
-	r := &RepoStatus{}
-	r.GetCreator()
-	r = nil
-	r.GetCreator()
+func TestSecretScanningAlertLocationEvent_GetRepo(tt *testing.T) {
+	s := &SecretScanningAlertLocationEvent{}
+	s.GetRepo()
+	s = nil
+	s.GetRepo()
 }
 
-func TestRepoStatus_GetDescription(tt *testing.T) {
+func TestSecretScanningAlertLocationEvent_GetSender(tt *testing.T) {
+	s := &SecretScanningAlertLocationEvent{}
+	s.GetSender()
+	s = nil
+	s.GetSender()
+}
+
+func TestSecretScanningBypassRequest_GetCreatedAt(tt *testing.T) {
+	var zeroValue Timestamp
+	s := &SecretScanningBypassRequest{CreatedAt: &zeroValue}
+	s.GetCreatedAt()
+	s = &SecretScanningBypassRequest{}
+	s.GetCreatedAt()
+	s = nil
+	s.GetCreatedAt()
+}
+
+func TestSecretScanningBypassRequest_GetExpiresAt(tt *testing.T) {
+	var zeroValue Timestamp
+	s := &SecretScanningBypassRequest{ExpiresAt: &zeroValue}
+	s.GetExpiresAt()
+	s = &SecretScanningBypassRequest{}
+	s.GetExpiresAt()
+	s = nil
+	s.GetExpiresAt()
+}
+
+func TestSecretScanningBypassRequest_GetHTMLURL(tt *testing.T) {
 	var zeroValue string
-	r := &RepoStatus{Description: &zeroValue}
-	r.GetDescription()
-	r = &RepoStatus{}
-	r.GetDescription()
-	r = nil
-	r.GetDescription()
+	s := &SecretScanningBypassRequest{HTMLURL: &zeroValue}
+	s.GetHTMLURL()
+	s = &SecretScanningBypassRequest{}
+	s.GetHTMLURL()
+	s = nil
+	s.GetHTMLURL()
 }
 
-func TestRepoStatus_GetID(tt *testing.T) {
+func TestSecretScanningBypassRequest_GetID(tt *testing.T) {
 	var zeroValue int64
-	r := &RepoStatus{ID: &zeroValue}
-	r.GetID()
-	r = &RepoStatus{}
-	r.GetID()
-	r = nil
-	r.GetID()
+	s := &SecretScanningBypassRequest{ID: &zeroValue}
+	s.GetID()
+	s = &SecretScanningBypassRequest{}
+	s.GetID()
+	s = nil
+	s.GetID()
 }
 
-func TestRepoStatus_GetNodeID(tt *testing.T) {
-	var zeroValue string
-	r := &RepoStatus{NodeID: &zeroValue}
-	r.GetNodeID()
-	r = &RepoStatus{}
-	r.GetNodeID()
-	r = nil
-	r.GetNodeID()
+func TestSecretScanningBypassRequest_GetNumber(tt *testing.T) {
+	var zeroValue int64
+	s := &SecretScanningBypassRequest{Number: &zeroValue}
+	s.GetNumber()
+	s = &SecretScanningBypassRequest{}
+	s.GetNumber()
+	s = nil
+	s.GetNumber()
 }
 
-func TestRepoStatus_GetState(tt *testing.T) {
-	var zeroValue string
-	r := &RepoStatus{State: &zeroValue}
-	r.GetState()
-	r = &RepoStatus{}
-	r.GetState()
-	r = nil
-	r.GetState()
+func TestSecretScanningBypassRequest_GetOrganization(tt *testing.T) {
+	s := &SecretScanningBypassRequest{}
+	s.GetOrganization()
+	s = nil
+	s.GetOrganization()
 }
 
-func TestRepoStatus_GetTargetURL(tt *testing.T) {
-	var zeroValue string
-	r := &RepoStatus{TargetURL: &zeroValue}
-	r.GetTargetURL()
-	r = &RepoStatus{}
-	r.GetTargetURL()
-	r = nil
-	r.GetTargetURL()
+func TestSecretScanningBypassRequest_GetRepository(tt *testing.T) {
+	s := &SecretScanningBypassRequest{}
+	s.GetRepository()
+	s = nil
+	s.GetRepository()
 }
 
-func TestRepoStatus_GetUpdatedAt(tt *testing.T) {
-	var zeroValue time.Time
-	r := &RepoStatus{UpdatedAt: &zeroValue}
-	r.GetUpdatedAt()
-	r = &RepoStatus{}
-	r.GetUpdatedAt()
-	r = nil
-	r.GetUpdatedAt()
+func TestSecretScanningBypassRequest_GetRequester(tt *testing.T) {
+	s := &SecretScanningBypassRequest{}
+	s.GetRequester()
+	s = nil
+	s.GetRequester()
 }
 
-func TestRepoStatus_GetURL(tt *testing.T) {
+func TestSecretScanningBypassRequest_GetRequesterComment(tt *testing.T) {
 	var zeroValue string
-	r := &RepoStatus{URL: &zeroValue}
-	r.GetURL()
-	r = &RepoStatus{}
-	r.GetURL()
-	r = nil
-	r.GetURL()
+	s := &SecretScanningBypassRequest{RequesterComment: &zeroValue}
+	s.GetRequesterComment()
+	s = &SecretScanningBypassRequest{}
+	s.GetRequesterComment()
+	s = nil
+	s.GetRequesterComment()
 }
 
-func TestRequiredStatusChecksRequest_GetStrict(tt *testing.T) {
-	var zeroValue bool
-	r := &RequiredStatusChecksRequest{Strict: &zeroValue}
-	r.GetStrict()
-	r = &RequiredStatusChecksRequest{}
-	r.GetStrict()
-	r = nil
-	r.GetStrict()
+func TestSecretScanningBypassRequest_GetStatus(tt *testing.T) {
+	var zeroValue string
+	s := &SecretScanningBypassRequest{Status: &zeroValue}
+	s.GetStatus()
+	s = &SecretScanningBypassRequest{}
+	s.GetStatus()
+	s = nil
+	s.GetStatus()
 }
 
-func TestReviewersRequest_GetNodeID(tt *testing.T) {
+func TestSecretScanningBypassRequest_GetURL(tt *testing.T) {
 	var zeroValue string
-	r := &ReviewersRequest{NodeID: &zeroValue}
-	r.GetNodeID()
-	r = &ReviewersRequest{}
-	r.GetNodeID()
-	r = nil
-	r.GetNodeID()
+	s := &SecretScanningBypassRequest{URL: &zeroValue}
+	s.GetURL()
+	s = &SecretScanningBypassRequest{}
+	s.GetURL()
+	s = nil
+	s.GetURL()
+}
+
+func TestSecurityAdvisory_GetAuthor(tt *testing.T) {
+	s := &SecurityAdvisory{}
+	s.GetAuthor()
+	s = nil
+	s.GetAuthor()
+}
+
+func TestSecurityAdvisory_GetClosedAt(tt *testing.T) {
+	var zeroValue Timestamp
+	s := &SecurityAdvisory{ClosedAt: &zeroValue}
+	s.GetClosedAt()
+	s = &SecurityAdvisory{}
+	s.GetClosedAt()
+	s = nil
+	s.GetClosedAt()
+}
+
+func TestSecurityAdvisory_GetCreatedAt(tt *testing.T) {
+	var zeroValue Timestamp
+	s := &SecurityAdvisory{CreatedAt: &zeroValue}
+	s.GetCreatedAt()
+	s = &SecurityAdvisory{}
+	s.GetCreatedAt()
+	s = nil
+	s.GetCreatedAt()
 }
 
-func TestRunner_GetBusy(tt *testing.T) {
-	var zeroValue bool
-	r := &Runner{Busy: &zeroValue}
-	r.GetBusy()
-	r = &Runner{}
-	r.GetBusy()
-	r = nil
-	r.GetBusy()
+func TestSecurityAdvisory_GetCVEID(tt *testing.T) {
+	var zeroValue string
+	s := &SecurityAdvisory{CVEID: &zeroValue}
+	s.GetCVEID()
+	s = &SecurityAdvisory{}
+	s.GetCVEID()
+	s = nil
+	s.GetCVEID()
 }
 
-func TestRunner_GetID(tt *testing.T) {
-	var zeroValue int64
-	r := &Runner{ID: &zeroValue}
-	r.GetID()
-	r = &Runner{}
-	r.GetID()
-	r = nil
-	r.GetID()
+func TestSecurityAdvisory_GetCVSS(tt *testing.T) {
+	s := &SecurityAdvisory{}
+	s.GetCVSS()
+	s = nil
+	s.GetCVSS()
 }
 
-func TestRunner_GetName(tt *testing.T) {
+func TestSecurityAdvisory_GetDescription(tt *testing.T) {
 	var zeroValue string
-	r := &Runner{Name: &zeroValue}
-	r.GetName()
-	r = &Runner{}
-	r.GetName()
-	r = nil
-	r.GetName()
+	s := &SecurityAdvisory{Description: &zeroValue}
+	s.GetDescription()
+	s = &SecurityAdvisory{}
+	s.GetDescription()
+	s = nil
+	s.GetDescription()
 }
 
-func TestRunner_GetOS(tt *testing.T) {
+func TestSecurityAdvisory_GetGHSAID(tt *testing.T) {
 	var zeroValue string
-	r := &Runner{OS: &zeroValue}
-	r.GetOS()
-	r = &Runner{}
-	r.GetOS()
-	r = nil
-	r.GetOS()
+	s := &SecurityAdvisory{GHSAID: &zeroValue}
+	s.GetGHSAID()
+	s = &SecurityAdvisory{}
+	s.GetGHSAID()
+	s = nil
+	s.GetGHSAID()
 }
 
-func TestRunner_GetStatus(tt *testing.T) {
+func TestSecurityAdvisory_GetHTMLURL(tt *testing.T) {
 	var zeroValue string
-	r := &Runner{Status: &zeroValue}
-	r.GetStatus()
-	r = &Runner{}
-	r.GetStatus()
-	r = nil
-	r.GetStatus()
+	s := &SecurityAdvisory{HTMLURL: &zeroValue}
+	s.GetHTMLURL()
+	s = &SecurityAdvisory{}
+	s.GetHTMLURL()
+	s = nil
+	s.GetHTMLURL()
 }
 
-func TestRunnerApplicationDownload_GetArchitecture(tt *testing.T) {
-	var zeroValue string
-	r := &RunnerApplicationDownload{Architecture: &zeroValue}
-	r.GetArchitecture()
-	r = &RunnerApplicationDownload{}
-	r.GetArchitecture()
-	r = nil
-	r.GetArchitecture()
+func TestSecurityAdvisory_GetPrivateFork(tt *testing.T) {
+	s := &SecurityAdvisory{}
+	s.GetPrivateFork()
+	s = nil
+	s.GetPrivateFork()
 }
 
-func TestRunnerApplicationDownload_GetDownloadURL(tt *testing.T) {
-	var zeroValue string
-	r := &RunnerApplicationDownload{DownloadURL: &zeroValue}
-	r.GetDownloadURL()
-	r = &RunnerApplicationDownload{}
-	r.GetDownloadURL()
-	r = nil
-	r.GetDownloadURL()
+func TestSecurityAdvisory_GetPublishedAt(tt *testing.T) {
+	var zeroValue Timestamp
+	s := &SecurityAdvisory{PublishedAt: &zeroValue}
+	s.GetPublishedAt()
+	s = &SecurityAdvisory{}
+	s.GetPublishedAt()
+	s = nil
+	s.GetPublishedAt()
 }
 
-func TestRunnerApplicationDownload_GetFilename(tt *testing.T) {
+func TestSecurityAdvisory_GetPublisher(tt *testing.T) {
+	s := &SecurityAdvisory{}
+	s.GetPublisher()
+	s = nil
+	s.GetPublisher()
+}
+
+func TestSecurityAdvisory_GetSeverity(tt *testing.T) {
 	var zeroValue string
-	r := &RunnerApplicationDownload{Filename: &zeroValue}
-	r.GetFilename()
-	r = &RunnerApplicationDownload{}
-	r.GetFilename()
-	r = nil
-	r.GetFilename()
+	s := &SecurityAdvisory{Severity: &zeroValue}
+	s.GetSeverity()
+	s = &SecurityAdvisory{}
+	s.GetSeverity()
+	s = nil
+	s.GetSeverity()
 }
 
-func TestRunnerApplicationDownload_GetOS(tt *testing.T) {
+func TestSecurityAdvisory_GetState(tt *testing.T) {
 	var zeroValue string
-	r := &RunnerApplicationDownload{OS: &zeroValue}
-	r.GetOS()
-	r = &RunnerApplicationDownload{}
-	r.GetOS()
-	r = nil
-	r.GetOS()
+	s := &SecurityAdvisory{State: &zeroValue}
+	s.GetState()
+	s = &SecurityAdvisory{}
+	s.GetState()
+	s = nil
+	s.GetState()
 }
 
-func TestRunnerLabels_GetID(tt *testing.T) {
-	var zeroValue int64
-	r := &RunnerLabels{ID: &zeroValue}
-	r.GetID()
-	r = &RunnerLabels{}
-	r.GetID()
-	r = nil
-	r.GetID()
+func TestSecurityAdvisory_GetSubmission(tt *testing.T) {
+	s := &SecurityAdvisory{}
+	s.GetSubmission()
+	s = nil
+	s.GetSubmission()
 }
 
-func TestRunnerLabels_GetName(tt *testing.T) {
+func TestSecurityAdvisory_GetSummary(tt *testing.T) {
 	var zeroValue string
-	r := &RunnerLabels{Name: &zeroValue}
-	r.GetName()
-	r = &RunnerLabels{}
-	r.GetName()
-	r = nil
-	r.GetName()
+	s := &SecurityAdvisory{Summary: &zeroValue}
+	s.GetSummary()
+	s = &SecurityAdvisory{}
+	s.GetSummary()
+	s = nil
+	s.GetSummary()
 }
 
-func TestRunnerLabels_GetType(tt *testing.T) {
+func TestSecurityAdvisory_GetUpdatedAt(tt *testing.T) {
+	var zeroValue Timestamp
+	s := &SecurityAdvisory{UpdatedAt: &zeroValue}
+	s.GetUpdatedAt()
+	s = &SecurityAdvisory{}
+	s.GetUpdatedAt()
+	s = nil
+	s.GetUpdatedAt()
+}
+
+func TestSecurityAdvisory_GetURL(tt *testing.T) {
 	var zeroValue string
-	r := &RunnerLabels{Type: &zeroValue}
-	r.GetType()
-	r = &RunnerLabels{}
-	r.GetType()
-	r = nil
-	r.GetType()
+	s := &SecurityAdvisory{URL: &zeroValue}
+	s.GetURL()
+	s = &SecurityAdvisory{}
+	s.GetURL()
+	s = nil
+	s.GetURL()
+}
+
+func TestSecurityAdvisory_GetWithdrawnAt(tt *testing.T) {
+	var zeroValue Timestamp
+	s := &SecurityAdvisory{WithdrawnAt: &zeroValue}
+	s.GetWithdrawnAt()
+	s = &SecurityAdvisory{}
+	s.GetWithdrawnAt()
+	s = nil
+	s.GetWithdrawnAt()
+}
+
+func TestSecurityAdvisorySubmission_GetAccepted(tt *testing.T) {
+	var zeroValue bool
+	s := &SecurityAdvisorySubmission{Accepted: &zeroValue}
+	s.GetAccepted()
+	s = &SecurityAdvisorySubmission{}
+	s.GetAccepted()
+	s = nil
+	s.GetAccepted()
 }
 
 func TestSelectedReposList_GetTotalCount(tt *testing.T) {
@@ -14891,6 +21580,36 @@ func TestSignatureVerification_GetVerified(tt *testing.T) {
 	s.GetVerified()
 }
 
+func TestSignatureVerification_GetVerifiedAt(tt *testing.T) {
+	var zeroValue Timestamp
+	s := &SignatureVerification{VerifiedAt: &zeroValue}
+	s.GetVerifiedAt()
+	s = &SignatureVerification{}
+	s.GetVerifiedAt()
+	s = nil
+	s.GetVerifiedAt()
+}
+
+func TestSocialAccount_GetProvider(tt *testing.T) {
+	var zeroValue string
+	s := &SocialAccount{Provider: &zeroValue}
+	s.GetProvider()
+	s = &SocialAccount{}
+	s.GetProvider()
+	s = nil
+	s.GetProvider()
+}
+
+func TestSocialAccount_GetURL(tt *testing.T) {
+	var zeroValue string
+	s := &SocialAccount{URL: &zeroValue}
+	s.GetURL()
+	s = &SocialAccount{}
+	s.GetURL()
+	s = nil
+	s.GetURL()
+}
+
 func TestSource_GetActor(tt *testing.T) {
 	s := &Source{}
 	s.GetActor()
@@ -15177,6 +21896,92 @@ func TestStatusEvent_GetUpdatedAt(tt *testing.T) {
 	s.GetUpdatedAt()
 }
 
+func TestSubIssuesEvent_GetAction(tt *testing.T) {
+	var zeroValue string
+	s := &SubIssuesEvent{Action: &zeroValue}
+	s.GetAction()
+	s = &SubIssuesEvent{}
+	s.GetAction()
+	s = nil
+	s.GetAction()
+}
+
+func TestSubIssuesEvent_GetInstallation(tt *testing.T) {
+	s := &SubIssuesEvent{}
+	s.GetInstallation()
+	s = nil
+	s.GetInstallation()
+}
+
+func TestSubIssuesEvent_GetOrg(tt *testing.T) {
+	s := &SubIssuesEvent{}
+	s.GetOrg()
+	s = nil
+	s.GetOrg()
+}
+
+func TestSubIssuesEvent_GetParentIssue(tt *testing.T) {
+	s := &SubIssuesEvent{}
+	s.GetParentIssue()
+	s = nil
+	s.GetParentIssue()
+}
+
+func TestSubIssuesEvent_GetParentIssueID(tt *testing.T) {
+	var zeroValue int64
+	s := &SubIssuesEvent{ParentIssueID: &zeroValue}
+	s.GetParentIssueID()
+	s = &SubIssuesEvent{}
+	s.GetParentIssueID()
+	s = nil
+	s.GetParentIssueID()
+}
+
+func TestSubIssuesEvent_GetParentIssueRepo(tt *testing.T) {
+	s := &SubIssuesEvent{}
+	s.GetParentIssueRepo()
+	s = nil
+	s.GetParentIssueRepo()
+}
+
+func TestSubIssuesEvent_GetRepo(tt *testing.T) {
+	s := &SubIssuesEvent{}
+	s.GetRepo()
+	s = nil
+	s.GetRepo()
+}
+
+func TestSubIssuesEvent_GetSender(tt *testing.T) {
+	s := &SubIssuesEvent{}
+	s.GetSender()
+	s = nil
+	s.GetSender()
+}
+
+func TestSubIssuesEvent_GetSubIssue(tt *testing.T) {
+	s := &SubIssuesEvent{}
+	s.GetSubIssue()
+	s = nil
+	s.GetSubIssue()
+}
+
+func TestSubIssuesEvent_GetSubIssueID(tt *testing.T) {
+	var zeroValue int64
+	s := &SubIssuesEvent{SubIssueID: &zeroValue}
+	s.GetSubIssueID()
+	s = &SubIssuesEvent{}
+	s.GetSubIssueID()
+	s = nil
+	s.GetSubIssueID()
+}
+
+func TestSubIssuesEvent_GetSubIssueRepo(tt *testing.T) {
+	s := &SubIssuesEvent{}
+	s.GetSubIssueRepo()
+	s = nil
+	s.GetSubIssueRepo()
+}
+
 func TestSubscription_GetCreatedAt(tt *testing.T) {
 	var zeroValue Timestamp
 	s := &Subscription{CreatedAt: &zeroValue}
@@ -15318,6 +22123,26 @@ func TestTag_GetVerification(tt *testing.T) {
 	t.GetVerification()
 }
 
+func TestTagProtection_GetID(tt *testing.T) {
+	var zeroValue int64
+	t := &TagProtection{ID: &zeroValue}
+	t.GetID()
+	t = &TagProtection{}
+	t.GetID()
+	t = nil
+	t.GetID()
+}
+
+func TestTagProtection_GetPattern(tt *testing.T) {
+	var zeroValue string
+	t := &TagProtection{Pattern: &zeroValue}
+	t.GetPattern()
+	t = &TagProtection{}
+	t.GetPattern()
+	t = nil
+	t.GetPattern()
+}
+
 func TestTaskStep_GetCompletedAt(tt *testing.T) {
 	var zeroValue Timestamp
 	t := &TaskStep{CompletedAt: &zeroValue}
@@ -16082,6 +22907,36 @@ func TestTimeline_GetURL(tt *testing.T) {
 	t.GetURL()
 }
 
+func TestTool_GetGUID(tt *testing.T) {
+	var zeroValue string
+	t := &Tool{GUID: &zeroValue}
+	t.GetGUID()
+	t = &Tool{}
+	t.GetGUID()
+	t = nil
+	t.GetGUID()
+}
+
+func TestTool_GetName(tt *testing.T) {
+	var zeroValue string
+	t := &Tool{Name: &zeroValue}
+	t.GetName()
+	t = &Tool{}
+	t.GetName()
+	t = nil
+	t.GetName()
+}
+
+func TestTool_GetVersion(tt *testing.T) {
+	var zeroValue string
+	t := &Tool{Version: &zeroValue}
+	t.GetVersion()
+	t = &Tool{}
+	t.GetVersion()
+	t = nil
+	t.GetVersion()
+}
+
 func TestTopicResult_GetCreatedAt(tt *testing.T) {
 	var zeroValue Timestamp
 	t := &TopicResult{CreatedAt: &zeroValue}
@@ -16142,6 +22997,16 @@ func TestTopicResult_GetFeatured(tt *testing.T) {
 	t.GetFeatured()
 }
 
+func TestTopicResult_GetLogoURL(tt *testing.T) {
+	var zeroValue string
+	t := &TopicResult{LogoURL: &zeroValue}
+	t.GetLogoURL()
+	t = &TopicResult{}
+	t.GetLogoURL()
+	t = nil
+	t.GetLogoURL()
+}
+
 func TestTopicResult_GetName(tt *testing.T) {
 	var zeroValue string
 	t := &TopicResult{Name: &zeroValue}
@@ -16152,6 +23017,26 @@ func TestTopicResult_GetName(tt *testing.T) {
 	t.GetName()
 }
 
+func TestTopicResult_GetReleased(tt *testing.T) {
+	var zeroValue string
+	t := &TopicResult{Released: &zeroValue}
+	t.GetReleased()
+	t = &TopicResult{}
+	t.GetReleased()
+	t = nil
+	t.GetReleased()
+}
+
+func TestTopicResult_GetRepositoryCount(tt *testing.T) {
+	var zeroValue int
+	t := &TopicResult{RepositoryCount: &zeroValue}
+	t.GetRepositoryCount()
+	t = &TopicResult{}
+	t.GetRepositoryCount()
+	t = nil
+	t.GetRepositoryCount()
+}
+
 func TestTopicResult_GetScore(tt *testing.T) {
 	t := &TopicResult{}
 	t.GetScore()
@@ -16486,6 +23371,36 @@ func TestUpdateCheckRunOptions_GetStatus(tt *testing.T) {
 	u.GetStatus()
 }
 
+func TestUpdateDefaultSetupConfigurationOptions_GetQuerySuite(tt *testing.T) {
+	var zeroValue string
+	u := &UpdateDefaultSetupConfigurationOptions{QuerySuite: &zeroValue}
+	u.GetQuerySuite()
+	u = &UpdateDefaultSetupConfigurationOptions{}
+	u.GetQuerySuite()
+	u = nil
+	u.GetQuerySuite()
+}
+
+func TestUpdateDefaultSetupConfigurationResponse_GetRunID(tt *testing.T) {
+	var zeroValue int64
+	u := &UpdateDefaultSetupConfigurationResponse{RunID: &zeroValue}
+	u.GetRunID()
+	u = &UpdateDefaultSetupConfigurationResponse{}
+	u.GetRunID()
+	u = nil
+	u.GetRunID()
+}
+
+func TestUpdateDefaultSetupConfigurationResponse_GetRunURL(tt *testing.T) {
+	var zeroValue string
+	u := &UpdateDefaultSetupConfigurationResponse{RunURL: &zeroValue}
+	u.GetRunURL()
+	u = &UpdateDefaultSetupConfigurationResponse{}
+	u.GetRunURL()
+	u = nil
+	u.GetRunURL()
+}
+
 func TestUser_GetAvatarURL(tt *testing.T) {
 	var zeroValue string
 	u := &User{AvatarURL: &zeroValue}
@@ -17411,6 +24326,26 @@ func TestUserSuspendOptions_GetReason(tt *testing.T) {
 	u.GetReason()
 }
 
+func TestVulnerabilityPackage_GetEcosystem(tt *testing.T) {
+	var zeroValue string
+	v := &VulnerabilityPackage{Ecosystem: &zeroValue}
+	v.GetEcosystem()
+	v = &VulnerabilityPackage{}
+	v.GetEcosystem()
+	v = nil
+	v.GetEcosystem()
+}
+
+func TestVulnerabilityPackage_GetName(tt *testing.T) {
+	var zeroValue string
+	v := &VulnerabilityPackage{Name: &zeroValue}
+	v.GetName()
+	v = &VulnerabilityPackage{}
+	v.GetName()
+	v = nil
+	v.GetName()
+}
+
 func TestWatchEvent_GetAction(tt *testing.T) {
 	var zeroValue string
 	w := &WatchEvent{Action: &zeroValue}
@@ -17526,6 +24461,20 @@ func TestWebHookCommit_GetTimestamp(tt *testing.T) {
 	w.GetTimestamp()
 }
 
+func TestWebHookDispatcher_GetInstallations(tt *testing.T) {
+	w := &WebHookDispatcher{}
+	w.GetInstallations()
+	w = nil
+	w.GetInstallations()
+}
+
+func TestWebHookDispatcher_GetOptions(tt *testing.T) {
+	w := &WebHookDispatcher{}
+	w.GetOptions()
+	w = nil
+	w.GetOptions()
+}
+
 func TestWebHookPayload_GetAction(tt *testing.T) {
 	var zeroValue string
 	w := &WebHookPayload{Action: &zeroValue}
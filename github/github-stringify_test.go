@@ -32,6 +32,29 @@ func TestAdminStats_String(t *testing.T) {
 	}
 }
 
+func TestAnnouncementBanner_String(t *testing.T) {
+	v := AnnouncementBanner{
+		Announcement:    String(""),
+		ExpiresAt:       &Timestamp{},
+		UserDismissible: Bool(false),
+	}
+	want := `github.AnnouncementBanner{Announcement:"", ExpiresAt:github.Timestamp{0001-01-01 00:00:00 +0000 UTC}, UserDismissible:false}`
+	if got := v.String(); got != want {
+		t.Errorf("AnnouncementBanner.String = %v, want %v", got, want)
+	}
+}
+
+func TestAtomFeed_String(t *testing.T) {
+	v := AtomFeed{
+		ID:    String(""),
+		Title: String(""),
+	}
+	want := `github.AtomFeed{ID:"", Title:""}`
+	if got := v.String(); got != want {
+		t.Errorf("AtomFeed.String = %v, want %v", got, want)
+	}
+}
+
 func TestAuthorization_String(t *testing.T) {
 	v := Authorization{
 		ID:             Int64(0),
@@ -45,9 +68,11 @@ func TestAuthorization_String(t *testing.T) {
 		UpdatedAt:      &Timestamp{},
 		CreatedAt:      &Timestamp{},
 		Fingerprint:    String(""),
+		ExpiresAt:      &Timestamp{},
 		User:           &User{},
+		Installation:   &Installation{},
 	}
-	want := `github.Authorization{ID:0, URL:"", Token:"", TokenLastEight:"", HashedToken:"", App:github.AuthorizationApp{}, Note:"", NoteURL:"", UpdatedAt:github.Timestamp{0001-01-01 00:00:00 +0000 UTC}, CreatedAt:github.Timestamp{0001-01-01 00:00:00 +0000 UTC}, Fingerprint:"", User:github.User{}}`
+	want := `github.Authorization{ID:0, URL:"", Token:"", TokenLastEight:"", HashedToken:"", App:github.AuthorizationApp{}, Note:"", NoteURL:"", UpdatedAt:github.Timestamp{0001-01-01 00:00:00 +0000 UTC}, CreatedAt:github.Timestamp{0001-01-01 00:00:00 +0000 UTC}, Fingerprint:"", ExpiresAt:github.Timestamp{0001-01-01 00:00:00 +0000 UTC}, User:github.User{}, Installation:github.Installation{}}`
 	if got := v.String(); got != want {
 		t.Errorf("Authorization.String = %v, want %v", got, want)
 	}
@@ -138,13 +163,19 @@ func TestCheckSuite_String(t *testing.T) {
 
 func TestCodeResult_String(t *testing.T) {
 	v := CodeResult{
-		Name:       String(""),
-		Path:       String(""),
-		SHA:        String(""),
-		HTMLURL:    String(""),
-		Repository: &Repository{},
+		Name:           String(""),
+		Path:           String(""),
+		SHA:            String(""),
+		URL:            String(""),
+		GitURL:         String(""),
+		HTMLURL:        String(""),
+		Repository:     &Repository{},
+		Score:          Float64(0.0),
+		FileSize:       Int(0),
+		Language:       String(""),
+		LastModifiedAt: &Timestamp{},
 	}
-	want := `github.CodeResult{Name:"", Path:"", SHA:"", HTMLURL:"", Repository:github.Repository{}}`
+	want := `github.CodeResult{Name:"", Path:"", SHA:"", URL:"", GitURL:"", HTMLURL:"", Repository:github.Repository{}, Score:0, FileSize:0, Language:"", LastModifiedAt:github.Timestamp{0001-01-01 00:00:00 +0000 UTC}}`
 	if got := v.String(); got != want {
 		t.Errorf("CodeResult.String = %v, want %v", got, want)
 	}
@@ -348,15 +379,18 @@ func TestEvent_String(t *testing.T) {
 func TestGPGKey_String(t *testing.T) {
 	v := GPGKey{
 		ID:                Int64(0),
+		Name:              String(""),
 		PrimaryKeyID:      Int64(0),
 		KeyID:             String(""),
+		RawKey:            String(""),
 		PublicKey:         String(""),
 		CanSign:           Bool(false),
 		CanEncryptComms:   Bool(false),
 		CanEncryptStorage: Bool(false),
 		CanCertify:        Bool(false),
+		Revoked:           Bool(false),
 	}
-	want := `github.GPGKey{ID:0, PrimaryKeyID:0, KeyID:"", PublicKey:"", CanSign:false, CanEncryptComms:false, CanEncryptStorage:false, CanCertify:false}`
+	want := `github.GPGKey{ID:0, Name:"", PrimaryKeyID:0, KeyID:"", RawKey:"", PublicKey:"", CanSign:false, CanEncryptComms:false, CanEncryptStorage:false, CanCertify:false, Revoked:false}`
 	if got := v.String(); got != want {
 		t.Errorf("GPGKey.String = %v, want %v", got, want)
 	}
@@ -510,15 +544,58 @@ func TestHook_String(t *testing.T) {
 	v := Hook{
 		URL:    String(""),
 		ID:     Int64(0),
-		Config: nil,
+		Config: &HookConfig{},
 		Active: Bool(false),
 	}
-	want := `github.Hook{URL:"", ID:0, Config:map[], Active:false}`
+	want := `github.Hook{URL:"", ID:0, Config:github.HookConfig{}, Active:false}`
 	if got := v.String(); got != want {
 		t.Errorf("Hook.String = %v, want %v", got, want)
 	}
 }
 
+func TestHookDelivery_String(t *testing.T) {
+	v := HookDelivery{
+		ID:             Int64(0),
+		GUID:           String(""),
+		DeliveredAt:    &Timestamp{},
+		Redelivery:     Bool(false),
+		Duration:       Float64(0.0),
+		Status:         String(""),
+		StatusCode:     Int(0),
+		Event:          String(""),
+		Action:         String(""),
+		InstallationID: Int64(0),
+		RepositoryID:   Int64(0),
+		Request:        &HookRequest{},
+		Response:       &HookResponse{},
+	}
+	want := `github.HookDelivery{ID:0, GUID:"", DeliveredAt:github.Timestamp{0001-01-01 00:00:00 +0000 UTC}, Redelivery:false, Duration:0, Status:"", StatusCode:0, Event:"", Action:"", InstallationID:0, RepositoryID:0, Request:github.HookRequest{Headers:map[]}, Response:github.HookResponse{Headers:map[]}}`
+	if got := v.String(); got != want {
+		t.Errorf("HookDelivery.String = %v, want %v", got, want)
+	}
+}
+
+func TestHookRequest_String(t *testing.T) {
+	v := HookRequest{
+		Headers: nil,
+	}
+	want := `github.HookRequest{Headers:map[]}`
+	if got := v.String(); got != want {
+		t.Errorf("HookRequest.String = %v, want %v", got, want)
+	}
+}
+
+func TestHookResponse_String(t *testing.T) {
+	v := HookResponse{
+		Headers: nil,
+		Payload: String(""),
+	}
+	want := `github.HookResponse{Headers:map[], Payload:""}`
+	if got := v.String(); got != want {
+		t.Errorf("HookResponse.String = %v, want %v", got, want)
+	}
+}
+
 func TestHookStats_String(t *testing.T) {
 	v := HookStats{
 		TotalHooks:    Int(0),
@@ -595,8 +672,10 @@ func TestInvitation_String(t *testing.T) {
 		Inviter:           &User{},
 		TeamCount:         Int(0),
 		InvitationTeamURL: String(""),
+		FailedAt:          &Timestamp{},
+		FailedReason:      String(""),
 	}
-	want := `github.Invitation{ID:0, NodeID:"", Login:"", Email:"", Role:"", Inviter:github.User{}, TeamCount:0, InvitationTeamURL:""}`
+	want := `github.Invitation{ID:0, NodeID:"", Login:"", Email:"", Role:"", Inviter:github.User{}, TeamCount:0, InvitationTeamURL:"", FailedAt:github.Timestamp{0001-01-01 00:00:00 +0000 UTC}, FailedReason:""}`
 	if got := v.String(); got != want {
 		t.Errorf("Invitation.String = %v, want %v", got, want)
 	}
@@ -679,6 +758,19 @@ func TestKey_String(t *testing.T) {
 	}
 }
 
+func TestLFSLock_String(t *testing.T) {
+	v := LFSLock{
+		ID:       String(""),
+		Path:     String(""),
+		LockedAt: &Timestamp{},
+		Owner:    &LFSLockOwner{},
+	}
+	want := `github.LFSLock{ID:"", Path:"", LockedAt:github.Timestamp{0001-01-01 00:00:00 +0000 UTC}, Owner:github.LFSLockOwner{}}`
+	if got := v.String(); got != want {
+		t.Errorf("LFSLock.String = %v, want %v", got, want)
+	}
+}
+
 func TestLabel_String(t *testing.T) {
 	v := Label{
 		ID:          Int64(0),
@@ -698,6 +790,7 @@ func TestLabel_String(t *testing.T) {
 func TestLabelResult_String(t *testing.T) {
 	v := LabelResult{
 		ID:          Int64(0),
+		NodeID:      String(""),
 		URL:         String(""),
 		Name:        String(""),
 		Color:       String(""),
@@ -705,7 +798,7 @@ func TestLabelResult_String(t *testing.T) {
 		Description: String(""),
 		Score:       Float64(0.0),
 	}
-	want := `github.LabelResult{ID:0, URL:"", Name:"", Color:"", Default:false, Description:"", Score:0}`
+	want := `github.LabelResult{ID:0, NodeID:"", URL:"", Name:"", Color:"", Default:false, Description:"", Score:0}`
 	if got := v.String(); got != want {
 		t.Errorf("LabelResult.String = %v, want %v", got, want)
 	}
@@ -909,8 +1002,12 @@ func TestPackage_String(t *testing.T) {
 		Owner:          &User{},
 		PackageVersion: &PackageVersion{},
 		Registry:       &PackageRegistry{},
+		URL:            String(""),
+		VersionCount:   Int64(0),
+		Visibility:     String(""),
+		Repository:     &Repository{},
 	}
-	want := `github.Package{ID:0, Name:"", PackageType:"", HTMLURL:"", CreatedAt:github.Timestamp{0001-01-01 00:00:00 +0000 UTC}, UpdatedAt:github.Timestamp{0001-01-01 00:00:00 +0000 UTC}, Owner:github.User{}, PackageVersion:github.PackageVersion{}, Registry:github.PackageRegistry{}}`
+	want := `github.Package{ID:0, Name:"", PackageType:"", HTMLURL:"", CreatedAt:github.Timestamp{0001-01-01 00:00:00 +0000 UTC}, UpdatedAt:github.Timestamp{0001-01-01 00:00:00 +0000 UTC}, Owner:github.User{}, PackageVersion:github.PackageVersion{}, Registry:github.PackageRegistry{}, URL:"", VersionCount:0, Visibility:"", Repository:github.Repository{}}`
 	if got := v.String(); got != want {
 		t.Errorf("Package.String = %v, want %v", got, want)
 	}
@@ -937,6 +1034,18 @@ func TestPackageFile_String(t *testing.T) {
 	}
 }
 
+func TestPackageMetadata_String(t *testing.T) {
+	v := PackageMetadata{
+		PackageType: String(""),
+		Container:   &PackageContainerMetadata{},
+		Docker:      &PackageDockerMetadata{},
+	}
+	want := `github.PackageMetadata{PackageType:"", Container:github.PackageContainerMetadata{}, Docker:github.PackageDockerMetadata{}}`
+	if got := v.String(); got != want {
+		t.Errorf("PackageMetadata.String = %v, want %v", got, want)
+	}
+}
+
 func TestPackageRegistry_String(t *testing.T) {
 	v := PackageRegistry{
 		AboutURL: String(""),
@@ -990,8 +1099,15 @@ func TestPackageVersion_String(t *testing.T) {
 		UpdatedAt:           &Timestamp{},
 		Author:              &User{},
 		InstallationCommand: String(""),
+		Name:                String(""),
+		URL:                 String(""),
+		PackageHTMLURL:      String(""),
+		License:             String(""),
+		Description:         String(""),
+		DeletedAt:           &Timestamp{},
+		Metadata:            &PackageMetadata{},
 	}
-	want := `github.PackageVersion{ID:0, Version:"", Summary:"", Body:"", BodyHTML:"", Release:github.PackageRelease{}, Manifest:"", HTMLURL:"", TagName:"", TargetCommitish:"", TargetOID:"", Draft:false, Prerelease:false, CreatedAt:github.Timestamp{0001-01-01 00:00:00 +0000 UTC}, UpdatedAt:github.Timestamp{0001-01-01 00:00:00 +0000 UTC}, Author:github.User{}, InstallationCommand:""}`
+	want := `github.PackageVersion{ID:0, Version:"", Summary:"", Body:"", BodyHTML:"", Release:github.PackageRelease{}, Manifest:"", HTMLURL:"", TagName:"", TargetCommitish:"", TargetOID:"", Draft:false, Prerelease:false, CreatedAt:github.Timestamp{0001-01-01 00:00:00 +0000 UTC}, UpdatedAt:github.Timestamp{0001-01-01 00:00:00 +0000 UTC}, Author:github.User{}, InstallationCommand:"", Name:"", URL:"", PackageHTMLURL:"", License:"", Description:"", DeletedAt:github.Timestamp{0001-01-01 00:00:00 +0000 UTC}, Metadata:github.PackageMetadata{}}`
 	if got := v.String(); got != want {
 		t.Errorf("PackageVersion.String = %v, want %v", got, want)
 	}
@@ -1098,8 +1214,9 @@ func TestPullRequest_String(t *testing.T) {
 		Head:                &PullRequestBranch{},
 		Base:                &PullRequestBranch{},
 		ActiveLockReason:    String(""),
+		AutoMerge:           &PullRequestAutoMerge{},
 	}
-	want := `github.PullRequest{ID:0, Number:0, State:"", Locked:false, Title:"", Body:"", User:github.User{}, Draft:false, Merged:false, Mergeable:false, MergeableState:"", MergedBy:github.User{}, MergeCommitSHA:"", Rebaseable:false, Comments:0, Commits:0, Additions:0, Deletions:0, ChangedFiles:0, URL:"", HTMLURL:"", IssueURL:"", StatusesURL:"", DiffURL:"", PatchURL:"", CommitsURL:"", CommentsURL:"", ReviewCommentsURL:"", ReviewCommentURL:"", ReviewComments:0, Assignee:github.User{}, Milestone:github.Milestone{}, MaintainerCanModify:false, AuthorAssociation:"", NodeID:"", Links:github.PRLinks{}, Head:github.PullRequestBranch{}, Base:github.PullRequestBranch{}, ActiveLockReason:""}`
+	want := `github.PullRequest{ID:0, Number:0, State:"", Locked:false, Title:"", Body:"", User:github.User{}, Draft:false, Merged:false, Mergeable:false, MergeableState:"", MergedBy:github.User{}, MergeCommitSHA:"", Rebaseable:false, Comments:0, Commits:0, Additions:0, Deletions:0, ChangedFiles:0, URL:"", HTMLURL:"", IssueURL:"", StatusesURL:"", DiffURL:"", PatchURL:"", CommitsURL:"", CommentsURL:"", ReviewCommentsURL:"", ReviewCommentURL:"", ReviewComments:0, Assignee:github.User{}, Milestone:github.Milestone{}, MaintainerCanModify:false, AuthorAssociation:"", NodeID:"", Links:github.PRLinks{}, Head:github.PullRequestBranch{}, Base:github.PullRequestBranch{}, ActiveLockReason:"", AutoMerge:github.PullRequestAutoMerge{}}`
 	if got := v.String(); got != want {
 		t.Errorf("PullRequest.String = %v, want %v", got, want)
 	}
@@ -1222,8 +1339,9 @@ func TestRate_String(t *testing.T) {
 		Limit:     0,
 		Remaining: 0,
 		Reset:     Timestamp{},
+		Resource:  "",
 	}
-	want := `github.Rate{Limit:0, Remaining:0, Reset:github.Timestamp{0001-01-01 00:00:00 +0000 UTC}}`
+	want := `github.Rate{Limit:0, Remaining:0, Reset:github.Timestamp{0001-01-01 00:00:00 +0000 UTC}, Resource:""}`
 	if got := v.String(); got != want {
 		t.Errorf("Rate.String = %v, want %v", got, want)
 	}
@@ -1498,25 +1616,27 @@ func TestRepositoryLicense_String(t *testing.T) {
 
 func TestRepositoryRelease_String(t *testing.T) {
 	v := RepositoryRelease{
-		TagName:         String(""),
-		TargetCommitish: String(""),
-		Name:            String(""),
-		Body:            String(""),
-		Draft:           Bool(false),
-		Prerelease:      Bool(false),
-		ID:              Int64(0),
-		CreatedAt:       &Timestamp{},
-		PublishedAt:     &Timestamp{},
-		URL:             String(""),
-		HTMLURL:         String(""),
-		AssetsURL:       String(""),
-		UploadURL:       String(""),
-		ZipballURL:      String(""),
-		TarballURL:      String(""),
-		Author:          &User{},
-		NodeID:          String(""),
-	}
-	want := `github.RepositoryRelease{TagName:"", TargetCommitish:"", Name:"", Body:"", Draft:false, Prerelease:false, ID:0, CreatedAt:github.Timestamp{0001-01-01 00:00:00 +0000 UTC}, PublishedAt:github.Timestamp{0001-01-01 00:00:00 +0000 UTC}, URL:"", HTMLURL:"", AssetsURL:"", UploadURL:"", ZipballURL:"", TarballURL:"", Author:github.User{}, NodeID:""}`
+		TagName:                String(""),
+		TargetCommitish:        String(""),
+		Name:                   String(""),
+		Body:                   String(""),
+		Draft:                  Bool(false),
+		Prerelease:             Bool(false),
+		DiscussionCategoryName: String(""),
+		ID:                     Int64(0),
+		CreatedAt:              &Timestamp{},
+		PublishedAt:            &Timestamp{},
+		URL:                    String(""),
+		HTMLURL:                String(""),
+		AssetsURL:              String(""),
+		UploadURL:              String(""),
+		ZipballURL:             String(""),
+		TarballURL:             String(""),
+		Author:                 &User{},
+		NodeID:                 String(""),
+		DiscussionURL:          String(""),
+	}
+	want := `github.RepositoryRelease{TagName:"", TargetCommitish:"", Name:"", Body:"", Draft:false, Prerelease:false, DiscussionCategoryName:"", ID:0, CreatedAt:github.Timestamp{0001-01-01 00:00:00 +0000 UTC}, PublishedAt:github.Timestamp{0001-01-01 00:00:00 +0000 UTC}, URL:"", HTMLURL:"", AssetsURL:"", UploadURL:"", ZipballURL:"", TarballURL:"", Author:github.User{}, NodeID:"", DiscussionURL:""}`
 	if got := v.String(); got != want {
 		t.Errorf("RepositoryRelease.String = %v, want %v", got, want)
 	}
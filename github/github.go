@@ -3,8 +3,10 @@
 // Use of this source code is governed by a BSD-style
 // license that can be found in the LICENSE file.
 
+//go:generate go run gen-values.go
 //go:generate go run gen-accessors.go
 //go:generate go run gen-stringify-test.go
+//go:generate go run gen-webhook-events.go
 
 package github
 
@@ -35,15 +37,23 @@ const (
 	headerRateLimit     = "X-RateLimit-Limit"
 	headerRateRemaining = "X-RateLimit-Remaining"
 	headerRateReset     = "X-RateLimit-Reset"
+	headerRateResource  = "X-RateLimit-Resource"
 	headerOTP           = "X-GitHub-OTP"
 
+	headerOAuthScopes         = "X-OAuth-Scopes"
+	headerAcceptedOAuthScopes = "X-Accepted-OAuth-Scopes"
+	headerSSO                 = "X-GitHub-SSO"
+	headerTokenExpiration     = "GitHub-Authentication-Token-Expiration"
+
 	mediaTypeV3                = "application/vnd.github.v3+json"
 	defaultMediaType           = "application/octet-stream"
 	mediaTypeV3SHA             = "application/vnd.github.v3.sha"
 	mediaTypeV3Diff            = "application/vnd.github.v3.diff"
 	mediaTypeV3Patch           = "application/vnd.github.v3.patch"
+	mediaTypeV3Raw             = "application/vnd.github.v3.raw"
 	mediaTypeOrgPermissionRepo = "application/vnd.github.v3.repository+json"
 	mediaTypeIssueImportAPI    = "application/vnd.github.golden-comet-preview+json"
+	mediaTypeSCIM              = "application/scim+json"
 
 	// Media Type values to access preview APIs
 
@@ -68,9 +78,6 @@ const (
 	// https://developer.github.com/changes/2016-09-14-projects-api/
 	mediaTypeProjectsPreview = "application/vnd.github.inertia-preview+json"
 
-	// https://developer.github.com/changes/2017-01-05-commit-search-api/
-	mediaTypeCommitSearchPreview = "application/vnd.github.cloak-preview+json"
-
 	// https://developer.github.com/changes/2017-02-28-user-blocking-apis-and-webhook/
 	mediaTypeBlockUsersPreview = "application/vnd.github.giant-sentry-fist-preview+json"
 
@@ -148,37 +155,53 @@ type Client struct {
 	// User agent used when communicating with the GitHub API.
 	UserAgent string
 
-	rateMu     sync.Mutex
-	rateLimits [categories]Rate // Rate limits for the client as determined by the most recent API calls.
+	rateLimitBehavior RateLimitBehavior // Set with WithRateLimitBehavior.
+	rawBodyRetention  bool              // Set with WithRawBodyRetention.
+
+	strictDecoding     bool               // Set with WithStrictDecoding.
+	schemaDriftHandler func(*SchemaDrift) // Set with WithSchemaDriftHandler.
+
+	middlewareMu sync.Mutex
+	middleware   []RequestMiddleware // Middleware added with Use, the outermost first.
+
+	rateMu          sync.Mutex
+	rateLimits      [categories]Rate             // Rate limits for the client as determined by the most recent API calls.
+	keyedRateLimits map[string]*[categories]Rate // Rate limits of the identities set with WithRateLimitKey.
 
 	common service // Reuse a single struct instead of allocating one for each service on the heap.
 
 	// Services used for talking to different parts of the GitHub API.
-	Actions        *ActionsService
-	Activity       *ActivityService
-	Admin          *AdminService
-	Apps           *AppsService
-	Authorizations *AuthorizationsService
-	Checks         *ChecksService
-	CodeScanning   *CodeScanningService
-	Enterprise     *EnterpriseService
-	Gists          *GistsService
-	Git            *GitService
-	Gitignores     *GitignoresService
-	Interactions   *InteractionsService
-	IssueImport    *IssueImportService
-	Issues         *IssuesService
-	Licenses       *LicensesService
-	Marketplace    *MarketplaceService
-	Migrations     *MigrationService
-	Organizations  *OrganizationsService
-	Projects       *ProjectsService
-	PullRequests   *PullRequestsService
-	Reactions      *ReactionsService
-	Repositories   *RepositoriesService
-	Search         *SearchService
-	Teams          *TeamsService
-	Users          *UsersService
+	Actions            *ActionsService
+	Activity           *ActivityService
+	Admin              *AdminService
+	Apps               *AppsService
+	Authorizations     *AuthorizationsService
+	Checks             *ChecksService
+	CodeScanning       *CodeScanningService
+	Copilot            *CopilotService
+	Credentials        *CredentialsService
+	Dependabot         *DependabotService
+	DependencyGraph    *DependencyGraphService
+	Enterprise         *EnterpriseService
+	Gists              *GistsService
+	Git                *GitService
+	Gitignores         *GitignoresService
+	Interactions       *InteractionsService
+	IssueImport        *IssueImportService
+	Issues             *IssuesService
+	Licenses           *LicensesService
+	Marketplace        *MarketplaceService
+	Migrations         *MigrationService
+	Organizations      *OrganizationsService
+	Projects           *ProjectsService
+	PullRequests       *PullRequestsService
+	Reactions          *ReactionsService
+	Repositories       *RepositoriesService
+	Search             *SearchService
+	SecurityAdvisories *SecurityAdvisoriesService
+	SecretScanning     *SecretScanningService
+	Teams              *TeamsService
+	Users              *UsersService
 }
 
 type service struct {
@@ -203,6 +226,11 @@ type ListCursorOptions struct {
 
 	// For paginated result sets, the number of results to include per page.
 	PerPage int `url:"per_page,omitempty"`
+
+	// For paginated result sets, the cursor of the page of results to
+	// retrieve, for the APIs that paginate with a "cursor" parameter
+	// (such as RepositoriesService.ListHookDeliveries).
+	Cursor string `url:"cursor,omitempty"`
 }
 
 // UploadOptions specifies the parameters to methods that support uploads.
@@ -253,7 +281,8 @@ func addOptions(s string, opts interface{}) (string, error) {
 // NewClient returns a new GitHub API client. If a nil httpClient is
 // provided, a new http.Client will be used. To use API methods which require
 // authentication, provide an http.Client that will perform the authentication
-// for you (such as that provided by the golang.org/x/oauth2 library).
+// for you (such as that provided by the golang.org/x/oauth2 library), or use
+// NewClientWithOptions with WithAuthToken.
 func NewClient(httpClient *http.Client) *Client {
 	if httpClient == nil {
 		httpClient = &http.Client{}
@@ -270,6 +299,10 @@ func NewClient(httpClient *http.Client) *Client {
 	c.Authorizations = (*AuthorizationsService)(&c.common)
 	c.Checks = (*ChecksService)(&c.common)
 	c.CodeScanning = (*CodeScanningService)(&c.common)
+	c.Copilot = (*CopilotService)(&c.common)
+	c.Credentials = (*CredentialsService)(&c.common)
+	c.Dependabot = (*DependabotService)(&c.common)
+	c.DependencyGraph = (*DependencyGraphService)(&c.common)
 	c.Enterprise = (*EnterpriseService)(&c.common)
 	c.Gists = (*GistsService)(&c.common)
 	c.Git = (*GitService)(&c.common)
@@ -286,6 +319,8 @@ func NewClient(httpClient *http.Client) *Client {
 	c.Reactions = (*ReactionsService)(&c.common)
 	c.Repositories = (*RepositoriesService)(&c.common)
 	c.Search = (*SearchService)(&c.common)
+	c.SecurityAdvisories = (*SecurityAdvisoriesService)(&c.common)
+	c.SecretScanning = (*SecretScanningService)(&c.common)
 	c.Teams = (*TeamsService)(&c.common)
 	c.Users = (*UsersService)(&c.common)
 	return c
@@ -305,31 +340,14 @@ func NewClient(httpClient *http.Client) *Client {
 // should be http(s)://[hostname]/api/v3/ or you will always receive the 406 status code.
 // The upload URL format should be http(s)://[hostname]/api/uploads/.
 func NewEnterpriseClient(baseURL, uploadURL string, httpClient *http.Client) (*Client, error) {
-	baseEndpoint, err := url.Parse(baseURL)
+	baseEndpoint, err := enterpriseURL(baseURL, "api/v3/")
 	if err != nil {
 		return nil, err
 	}
-	if !strings.HasSuffix(baseEndpoint.Path, "/") {
-		baseEndpoint.Path += "/"
-	}
-	if !strings.HasSuffix(baseEndpoint.Path, "/api/v3/") &&
-		!strings.HasPrefix(baseEndpoint.Host, "api.") &&
-		!strings.Contains(baseEndpoint.Host, ".api.") {
-		baseEndpoint.Path += "api/v3/"
-	}
-
-	uploadEndpoint, err := url.Parse(uploadURL)
+	uploadEndpoint, err := enterpriseURL(uploadURL, "api/uploads/")
 	if err != nil {
 		return nil, err
 	}
-	if !strings.HasSuffix(uploadEndpoint.Path, "/") {
-		uploadEndpoint.Path += "/"
-	}
-	if !strings.HasSuffix(uploadEndpoint.Path, "/api/uploads/") &&
-		!strings.HasPrefix(uploadEndpoint.Host, "api.") &&
-		!strings.Contains(uploadEndpoint.Host, ".api.") {
-		uploadEndpoint.Path += "api/uploads/"
-	}
 
 	c := NewClient(httpClient)
 	c.BaseURL = baseEndpoint
@@ -435,9 +453,67 @@ type Response struct {
 	// calling the endpoint again.
 	NextPageToken string
 
+	// For APIs that support before/after cursor pagination (such as
+	// RepositoriesService.ListActivities), the following fields will be
+	// populated to point to the next and previous pages.
+	//
+	// To use them, set the After or Before field of the endpoint's options
+	// to the corresponding value before calling the endpoint again.
+	Before string
+	After  string
+
+	// For APIs that support cursor pagination with a "cursor" parameter
+	// (such as RepositoriesService.ListHookDeliveries), the following field
+	// will be populated to point to the next page.
+	//
+	// To use it, set ListCursorOptions.Cursor to this value before calling
+	// the endpoint again.
+	Cursor string
+
 	// Explicitly specify the Rate type so Rate's String() receiver doesn't
 	// propagate to Response.
 	Rate Rate
+
+	// OAuthScopes are the scopes of the OAuth token used for the request,
+	// from the X-OAuth-Scopes header, and AcceptedOAuthScopes are the scopes
+	// accepted by the endpoint, from the X-Accepted-OAuth-Scopes header.
+	// They are nil if the headers are missing.
+	OAuthScopes         []Scope
+	AcceptedOAuthScopes []Scope
+
+	// SSO is the parsed X-GitHub-SSO header, which is set when the token
+	// must be authorized for SAML single sign-on to access some
+	// organization resources, or nil if the header is missing.
+	SSO *SSOStatus
+
+	// TokenExpiration is the expiration time of the token used for the
+	// request, from the GitHub-Authentication-Token-Expiration header, which
+	// is sent for the tokens that expire, such as the fine-grained personal
+	// access tokens. It is the zero Timestamp if the header is missing.
+	TokenExpiration Timestamp
+
+	// RawBody is the raw JSON body of the response decoded by Client.Do, if
+	// the client was created with WithRawBodyRetention or the request was
+	// made with the WithRawBody request option. It gives access to the
+	// fields of the response which are not modeled by the library yet,
+	// without a second request.
+	RawBody []byte
+}
+
+// SSOStatus is the parsed X-GitHub-SSO header of a response.
+//
+// GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/overview/other-authentication-methods#authenticating-for-saml-sso
+type SSOStatus struct {
+	// Required is true if the request requires the token to be authorized
+	// for SAML single sign-on, at URL.
+	Required bool
+	URL      string
+
+	// PartialResults is true if the results of the request omit the
+	// resources of the organizations with the IDs OrganizationIDs, for
+	// which the token is not authorized.
+	PartialResults  bool
+	OrganizationIDs []int64
 }
 
 // newResponse creates a new Response for the provided http.Response.
@@ -446,9 +522,73 @@ func newResponse(r *http.Response) *Response {
 	response := &Response{Response: r}
 	response.populatePageValues()
 	response.Rate = parseRate(r)
+	response.populateTokenValues()
 	return response
 }
 
+// populateTokenValues parses the headers describing the token used for the
+// request and populates the token values in the Response.
+func (r *Response) populateTokenValues() {
+	r.OAuthScopes = parseScopes(r.Header, headerOAuthScopes)
+	r.AcceptedOAuthScopes = parseScopes(r.Header, headerAcceptedOAuthScopes)
+	r.SSO = parseSSO(r.Header.Get(headerSSO))
+	if v := r.Header.Get(headerTokenExpiration); v != "" {
+		for _, layout := range []string{"2006-01-02 15:04:05 MST", "2006-01-02 15:04:05 -0700"} {
+			if t, err := time.Parse(layout, v); err == nil {
+				r.TokenExpiration = Timestamp{t}
+				break
+			}
+		}
+	}
+}
+
+// parseScopes parses the comma separated scopes of the header with the given
+// key. It returns nil if the header is missing, and an empty slice if the
+// header is empty, i.e. if there are no scopes.
+func parseScopes(h http.Header, key string) []Scope {
+	values, ok := h[http.CanonicalHeaderKey(key)]
+	if !ok {
+		return nil
+	}
+	scopes := []Scope{}
+	for _, value := range values {
+		for _, scope := range strings.Split(value, ",") {
+			if scope = strings.TrimSpace(scope); scope != "" {
+				scopes = append(scopes, Scope(scope))
+			}
+		}
+	}
+	return scopes
+}
+
+// parseSSO parses an X-GitHub-SSO header, such as
+// "required; url=https://github.com/orgs/o/sso?authorization_request=r" or
+// "partial-results; organizations=1,2".
+func parseSSO(header string) *SSOStatus {
+	if header == "" {
+		return nil
+	}
+	sso := new(SSOStatus)
+	for _, part := range strings.Split(header, ";") {
+		part = strings.TrimSpace(part)
+		switch {
+		case part == "required":
+			sso.Required = true
+		case part == "partial-results":
+			sso.PartialResults = true
+		case strings.HasPrefix(part, "url="):
+			sso.URL = strings.TrimPrefix(part, "url=")
+		case strings.HasPrefix(part, "organizations="):
+			for _, id := range strings.Split(strings.TrimPrefix(part, "organizations="), ",") {
+				if v, err := strconv.ParseInt(strings.TrimSpace(id), 10, 64); err == nil {
+					sso.OrganizationIDs = append(sso.OrganizationIDs, v)
+				}
+			}
+		}
+	}
+	return sso
+}
+
 // populatePageValues parses the HTTP Link response headers and populates the
 // various pagination link values in the Response.
 func (r *Response) populatePageValues() {
@@ -471,18 +611,32 @@ func (r *Response) populatePageValues() {
 			if err != nil {
 				continue
 			}
-			page := url.Query().Get("page")
-			if page == "" {
+			q := url.Query()
+			page := q.Get("page")
+			before, after, cursor := q.Get("before"), q.Get("after"), q.Get("cursor")
+			if page == "" && before == "" && after == "" && cursor == "" {
 				continue
 			}
 
 			for _, segment := range segments[1:] {
 				switch strings.TrimSpace(segment) {
 				case `rel="next"`:
+					if after != "" {
+						r.After = after
+					}
+					if cursor != "" {
+						r.Cursor = cursor
+					}
+					if page == "" {
+						continue
+					}
 					if r.NextPage, err = strconv.Atoi(page); err != nil {
 						r.NextPageToken = page
 					}
 				case `rel="prev"`:
+					if before != "" {
+						r.Before = before
+					}
 					r.PrevPage, _ = strconv.Atoi(page)
 				case `rel="first"`:
 					r.FirstPage, _ = strconv.Atoi(page)
@@ -509,6 +663,7 @@ func parseRate(r *http.Response) Rate {
 			rate.Reset = Timestamp{time.Unix(v, 0)}
 		}
 	}
+	rate.Resource = r.Header.Get(headerRateResource)
 	return rate
 }
 
@@ -516,27 +671,48 @@ func parseRate(r *http.Response) Rate {
 // or API Error occurs, the error will contain more information. Otherwise you
 // are supposed to read and close the response's Body. If rate limit is exceeded
 // and reset time is in the future, BareDo returns *RateLimitError immediately
-// without making a network API call.
+// without making a network API call, unless the client was created with
+// another RateLimitBehavior.
 //
 // The provided ctx must be non-nil, if it is nil an error is returned. If it is
-// canceled or times out, ctx.Err() will be returned.
+// canceled or times out, ctx.Err() will be returned. The request is customized
+// by the options set on ctx with WithRequestOptions, if any.
 func (c *Client) BareDo(ctx context.Context, req *http.Request) (*Response, error) {
 	if ctx == nil {
 		return nil, errors.New("context must be non-nil")
 	}
+	ctx, cancel, req := applyRequestOptions(ctx, req)
+	resp, err := c.bareDo(ctx, req)
+	if req2, ok := c.waitRateLimit(ctx, req, err); ok {
+		resp, err = c.bareDo(ctx, req2)
+	}
+	if cancel != nil {
+		if err != nil {
+			cancel()
+		} else {
+			resp.Body = &cancelOnClose{resp.Body, cancel}
+		}
+	}
+	return resp, err
+}
+
+// bareDo sends req once, as described by BareDo.
+func (c *Client) bareDo(ctx context.Context, req *http.Request) (*Response, error) {
 	req = withContext(ctx, req)
 
-	rateLimitCategory := category(req.URL.Path)
+	rateLimitCategory := category(req.Method, c.relativePath(req.URL))
 
 	// If we've hit rate limit, don't make further requests before Reset time.
-	if err := c.checkRateLimitBeforeDo(req, rateLimitCategory); err != nil {
-		return &Response{
-			Response: err.Response,
-			Rate:     err.Rate,
-		}, err
+	if c.rateLimitBehavior != RateLimitAlwaysSend {
+		if err := c.checkRateLimitBeforeDo(ctx, req, rateLimitCategory); err != nil {
+			return &Response{
+				Response: err.Response,
+				Rate:     err.Rate,
+			}, err
+		}
 	}
 
-	resp, err := c.client.Do(req)
+	resp, err := c.send(req)
 	if err != nil {
 		// If we got an error, and the context has been canceled,
 		// the context's error is probably more useful.
@@ -559,13 +735,21 @@ func (c *Client) BareDo(ctx context.Context, req *http.Request) (*Response, erro
 
 	response := newResponse(resp)
 
+	// The resource reported by GitHub, if any, is more accurate than the
+	// one guessed from the path.
+	if cat, ok := resourceCategory(response.Rate.Resource); ok {
+		rateLimitCategory = cat
+	}
 	c.rateMu.Lock()
-	c.rateLimits[rateLimitCategory] = response.Rate
+	c.rates(ctx)[rateLimitCategory] = response.Rate
 	c.rateMu.Unlock()
 
 	err = CheckResponse(resp)
 	if err != nil {
 		defer resp.Body.Close()
+		if rerr, ok := err.(*RateLimitError); ok && rerr.Rate.Resource == "" {
+			rerr.Rate.Resource = categoryResources[rateLimitCategory]
+		}
 		// Special case for AcceptedErrors. If an AcceptedError
 		// has been encountered, the response's payload will be
 		// added to the AcceptedError and returned.
@@ -585,13 +769,65 @@ func (c *Client) BareDo(ctx context.Context, req *http.Request) (*Response, erro
 	return response, err
 }
 
+// RequestHandler sends an HTTP request and returns its HTTP response, like
+// http.Client.Do.
+type RequestHandler func(req *http.Request) (*http.Response, error)
+
+// RequestMiddleware wraps the RequestHandler which sends the requests of a
+// Client. It returns a RequestHandler which can modify the request before
+// calling next, and observe or replace the response returned by next, or not
+// call next at all. As with http.RoundTripper, the request should be cloned
+// before it is modified.
+//
+// For example, a middleware which logs the requests:
+//
+//	client.Use(func(next github.RequestHandler) github.RequestHandler {
+//		return func(req *http.Request) (*http.Response, error) {
+//			start := time.Now()
+//			resp, err := next(req)
+//			log.Printf("%v %v: %v", req.Method, req.URL, time.Since(start))
+//			return resp, err
+//		}
+//	})
+type RequestMiddleware func(next RequestHandler) RequestHandler
+
+// Use adds middleware to the chain of middleware through which c sends its
+// requests, after the middleware already added. The first middleware added
+// sees the requests first, and the responses last. Middleware can be used
+// for authentication, logging, custom headers or fault injection, without
+// replacing the transport of the underlying http.Client.
+//
+// The requests are sent through the middleware after the rate limit check
+// of Do and BareDo, and their responses are then checked and decoded as
+// usual.
+func (c *Client) Use(middleware ...RequestMiddleware) {
+	c.middlewareMu.Lock()
+	defer c.middlewareMu.Unlock()
+	c.middleware = append(c.middleware, middleware...)
+}
+
+// send sends req with the underlying http.Client, through the middleware.
+func (c *Client) send(req *http.Request) (*http.Response, error) {
+	c.middlewareMu.Lock()
+	middleware := c.middleware
+	c.middlewareMu.Unlock()
+
+	handler := RequestHandler(c.client.Do)
+	for i := len(middleware) - 1; i >= 0; i-- {
+		handler = middleware[i](handler)
+	}
+	return handler(req)
+}
+
 // Do sends an API request and returns the API response. The API response is
 // JSON decoded and stored in the value pointed to by v, or returned as an
 // error if an API error has occurred. If v implements the io.Writer interface,
 // the raw response body will be written to v, without attempting to first
 // decode it. If v is nil, and no error hapens, the response is returned as is.
 // If rate limit is exceeded and reset time is in the future, Do returns
-// *RateLimitError immediately without making a network API call.
+// *RateLimitError immediately without making a network API call. The decoded
+// body is also kept in the RawBody field of the response if raw bodies are
+// retained, see WithRawBodyRetention.
 //
 // The provided ctx must be non-nil, if it is nil an error is returned. If it
 // is canceled or times out, ctx.Err() will be returned.
@@ -607,26 +843,117 @@ func (c *Client) Do(ctx context.Context, req *http.Request, v interface{}) (*Res
 	case io.Writer:
 		_, err = io.Copy(v, resp.Body)
 	default:
-		decErr := json.NewDecoder(resp.Body).Decode(v)
+		var body io.Reader = resp.Body
+		var raw []byte
+		if c.retainRawBody(ctx) || c.schemaDriftHandler != nil {
+			var readErr error
+			raw, readErr = ioutil.ReadAll(resp.Body)
+			if readErr != nil {
+				return resp, readErr
+			}
+			if c.retainRawBody(ctx) {
+				resp.RawBody = raw
+			}
+			body = bytes.NewReader(raw)
+		}
+		dec := json.NewDecoder(body)
+		if c.strictDecoding {
+			dec.DisallowUnknownFields()
+		}
+		decErr := dec.Decode(v)
 		if decErr == io.EOF {
 			decErr = nil // ignore EOF errors caused by empty response body
 		}
 		if decErr != nil {
 			err = decErr
+		} else if c.schemaDriftHandler != nil && len(raw) > 0 {
+			c.reportSchemaDrift(resp, v, raw)
 		}
 	}
 	return resp, err
 }
 
+// rateLimitKey is the type of the context key of the identity set with
+// WithRateLimitKey.
+type rateLimitKey struct{}
+
+// WithRateLimitKey returns a copy of ctx with which the rate limits of the
+// requests are tracked separately for the identity identified by key, rather
+// than with the rate limits of the client. It allows a single Client, whose
+// transport authenticates the requests with different credentials, such as
+// the installation tokens of a multi-tenant GitHub App, to track the rate
+// limits of each identity, so that the requests of an identity are not
+// rejected because of the exhausted rate limit of another one.
+//
+// See InstallationRateLimitKey for the keys of installations.
+func WithRateLimitKey(ctx context.Context, key string) context.Context {
+	return context.WithValue(ctx, rateLimitKey{}, key)
+}
+
+// InstallationRateLimitKey returns the rate limit key of the installation with
+// the given ID, to be used with WithRateLimitKey.
+func InstallationRateLimitKey(id int64) string {
+	return fmt.Sprintf("installation:%v", id)
+}
+
+// rates returns the rate limits of the identity of ctx. c.rateMu must be held.
+func (c *Client) rates(ctx context.Context) *[categories]Rate {
+	key, _ := ctx.Value(rateLimitKey{}).(string)
+	if key == "" {
+		return &c.rateLimits
+	}
+	limits, ok := c.keyedRateLimits[key]
+	if !ok {
+		if c.keyedRateLimits == nil {
+			c.keyedRateLimits = make(map[string]*[categories]Rate)
+		}
+		limits = new([categories]Rate)
+		c.keyedRateLimits[key] = limits
+	}
+	return limits
+}
+
+// KnownRate returns the rate limit of the resource, such as "core" or
+// "search", as determined by the most recent API calls with the identity
+// identified by key, as set with WithRateLimitKey, or by the most recent API
+// calls of the client without identity if key is empty. It makes no network
+// call, and returns the zero Rate if the rate limit is unknown.
+func (c *Client) KnownRate(key, resource string) Rate {
+	cat, ok := resourceCategory(resource)
+	if !ok {
+		return Rate{}
+	}
+	c.rateMu.Lock()
+	defer c.rateMu.Unlock()
+	if key == "" {
+		return c.rateLimits[cat]
+	}
+	if limits, ok := c.keyedRateLimits[key]; ok {
+		return limits[cat]
+	}
+	return Rate{}
+}
+
+// ForgetRateLimits forgets the rate limits tracked for the identity
+// identified by key, such as an installation which was deleted.
+func (c *Client) ForgetRateLimits(key string) {
+	c.rateMu.Lock()
+	defer c.rateMu.Unlock()
+	delete(c.keyedRateLimits, key)
+}
+
 // checkRateLimitBeforeDo does not make any network calls, but uses existing knowledge from
 // current client state in order to quickly check if *RateLimitError can be immediately returned
 // from Client.Do, and if so, returns it so that Client.Do can skip making a network API call unnecessarily.
 // Otherwise it returns nil, and Client.Do should proceed normally.
-func (c *Client) checkRateLimitBeforeDo(req *http.Request, rateLimitCategory rateLimitCategory) *RateLimitError {
+func (c *Client) checkRateLimitBeforeDo(ctx context.Context, req *http.Request, rateLimitCategory rateLimitCategory) *RateLimitError {
 	c.rateMu.Lock()
-	rate := c.rateLimits[rateLimitCategory]
+	rate := c.rates(ctx)[rateLimitCategory]
 	c.rateMu.Unlock()
 	if !rate.Reset.Time.IsZero() && rate.Remaining == 0 && time.Now().Before(rate.Reset.Time) {
+		if rate.Resource == "" {
+			rate.Resource = categoryResources[rateLimitCategory]
+		}
 		// Create a fake response.
 		resp := &http.Response{
 			Status:     http.StatusText(http.StatusForbidden),
@@ -645,6 +972,44 @@ func (c *Client) checkRateLimitBeforeDo(req *http.Request, rateLimitCategory rat
 	return nil
 }
 
+// waitForRateLimit waits until the rate limit reported by err is reset, or
+// until the delay of an abuse rate limit has passed, and returns true so that
+// the caller retries its request. It returns false and err if err is not a rate
+// limit error, or false and the context's error if ctx is done first.
+func waitForRateLimit(ctx context.Context, err error) (retry bool, werr error) {
+	switch e := err.(type) {
+	case *RateLimitError:
+		werr = sleepUntil(ctx, e.Rate.Reset.Time)
+	case *AbuseRateLimitError:
+		retryAfter := time.Minute
+		if e.RetryAfter != nil {
+			retryAfter = *e.RetryAfter
+		}
+		werr = sleepUntil(ctx, time.Now().Add(retryAfter))
+	default:
+		return false, err
+	}
+	return werr == nil, werr
+}
+
+// sleepUntil waits until t, or until ctx is done in which case it returns
+// the context's error.
+func sleepUntil(ctx context.Context, t time.Time) error {
+	d := time.Until(t)
+	if d <= 0 {
+		return ctx.Err()
+	}
+
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
 /*
 An ErrorResponse reports one or more errors caused by an API request.
 
@@ -680,8 +1045,29 @@ type TwoFactorAuthError ErrorResponse
 
 func (r *TwoFactorAuthError) Error() string { return (*ErrorResponse)(r).Error() }
 
+// SSORequiredError occurs when GitHub returns 403 Forbidden response because
+// the token must be authorized for the SAML single sign-on of an organization.
+// The request can be reattempted after the user authorizes the token at URL.
+type SSORequiredError struct {
+	Response *http.Response // HTTP response that caused this error
+	Message  string         `json:"message"` // error message
+
+	// URL is the URL where the user can authorize the token, and
+	// Organization is the login of the organization, if it could be
+	// determined from URL.
+	URL          string
+	Organization string
+}
+
+func (r *SSORequiredError) Error() string {
+	return fmt.Sprintf("%v %v: %d %v",
+		r.Response.Request.Method, sanitizeURL(r.Response.Request.URL),
+		r.Response.StatusCode, r.Message)
+}
+
 // RateLimitError occurs when GitHub returns 403 Forbidden response with a rate limit
-// remaining value of 0.
+// remaining value of 0. Rate.Resource is the exhausted rate limit, such as
+// "core" or "search".
 type RateLimitError struct {
 	Rate     Rate           // Rate specifies last known rate limit for the client
 	Response *http.Response // HTTP response that caused this error
@@ -811,6 +1197,19 @@ func CheckResponse(r *http.Response) error {
 	switch {
 	case r.StatusCode == http.StatusUnauthorized && strings.HasPrefix(r.Header.Get(headerOTP), "required"):
 		return (*TwoFactorAuthError)(errorResponse)
+	case r.StatusCode == http.StatusForbidden && strings.HasPrefix(r.Header.Get(headerSSO), "required"):
+		ssoRequiredError := &SSORequiredError{
+			Response: errorResponse.Response,
+			Message:  errorResponse.Message,
+			URL:      parseSSO(r.Header.Get(headerSSO)).URL,
+		}
+		// The URL is like https://github.com/orgs/{org}/sso?authorization_request=...
+		if u, err := url.Parse(ssoRequiredError.URL); err == nil {
+			if parts := strings.Split(strings.Trim(u.Path, "/"), "/"); len(parts) == 3 && parts[0] == "orgs" && parts[2] == "sso" {
+				ssoRequiredError.Organization = parts[1]
+			}
+		}
+		return ssoRequiredError
 	case r.StatusCode == http.StatusForbidden && r.Header.Get(headerRateRemaining) == "0":
 		return &RateLimitError{
 			Rate:     parseRate(r),
@@ -865,6 +1264,10 @@ type Rate struct {
 
 	// The time at which the current rate limit will reset.
 	Reset Timestamp `json:"reset"`
+
+	// The resource the rate limit applies to, such as "core" or "search",
+	// when known.
+	Resource string `json:"resource,omitempty"`
 }
 
 func (r Rate) String() string {
@@ -886,6 +1289,27 @@ type RateLimits struct {
 	//
 	// GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/search/#rate-limit
 	Search *Rate `json:"search"`
+
+	// The rate limit for GraphQL API requests.
+	//
+	// GitHub API docs: https://docs.github.com/en/free-pro-team@latest/graphql/overview/resource-limitations#rate-limit
+	GraphQL *Rate `json:"graphql"`
+
+	// The rate limit for the conversions of GitHub App manifests.
+	IntegrationManifest *Rate `json:"integration_manifest"`
+
+	// The rate limit for source import requests.
+	SourceImport *Rate `json:"source_import"`
+
+	// The rate limit for code scanning SARIF uploads.
+	CodeScanningUpload *Rate `json:"code_scanning_upload"`
+
+	// The rate limit for the creation of self-hosted runner registration
+	// tokens.
+	ActionsRunnerRegistration *Rate `json:"actions_runner_registration"`
+
+	// The rate limit for SCIM API requests.
+	SCIM *Rate `json:"scim"`
 }
 
 func (r RateLimits) String() string {
@@ -897,17 +1321,69 @@ type rateLimitCategory uint8
 const (
 	coreCategory rateLimitCategory = iota
 	searchCategory
+	graphqlCategory
+	integrationManifestCategory
+	sourceImportCategory
+	codeScanningUploadCategory
+	actionsRunnerRegistrationCategory
+	scimCategory
 
 	categories // An array of this length will be able to contain all rate limit categories.
 )
 
-// category returns the rate limit category of the endpoint, determined by Request.URL.Path.
-func category(path string) rateLimitCategory {
+// categoryResources are the names of the rate limit categories, as used by
+// the rate_limit endpoint and the X-RateLimit-Resource header.
+var categoryResources = [categories]string{
+	coreCategory:                      "core",
+	searchCategory:                    "search",
+	graphqlCategory:                   "graphql",
+	integrationManifestCategory:       "integration_manifest",
+	sourceImportCategory:              "source_import",
+	codeScanningUploadCategory:        "code_scanning_upload",
+	actionsRunnerRegistrationCategory: "actions_runner_registration",
+	scimCategory:                      "scim",
+}
+
+// resourceCategory returns the rate limit category of a resource name.
+func resourceCategory(resource string) (rateLimitCategory, bool) {
+	for cat, name := range categoryResources {
+		if resource != "" && name == resource {
+			return rateLimitCategory(cat), true
+		}
+	}
+	return coreCategory, false
+}
+
+// relativePath returns the path of u relative to the BaseURL of the client,
+// starting with "/", or the path of u if it is not under BaseURL.
+func (c *Client) relativePath(u *url.URL) string {
+	if c.BaseURL == nil {
+		return u.Path
+	}
+	return strings.TrimPrefix(u.Path, strings.TrimSuffix(c.BaseURL.Path, "/"))
+}
+
+// category returns the rate limit category of the endpoint, determined by
+// the method and the path of the request, relative to BaseURL.
+func category(method, path string) rateLimitCategory {
+	parts := strings.Split(strings.Trim(path, "/"), "/")
 	switch {
 	default:
 		return coreCategory
 	case strings.HasPrefix(path, "/search/"):
 		return searchCategory
+	case path == "/graphql" || strings.HasSuffix(path, "/api/graphql"):
+		return graphqlCategory
+	case strings.HasPrefix(path, "/app-manifests/") && strings.HasSuffix(path, "/conversions"):
+		return integrationManifestCategory
+	case len(parts) >= 4 && parts[0] == "repos" && parts[3] == "import":
+		return sourceImportCategory
+	case method == "POST" && len(parts) == 5 && parts[0] == "repos" && parts[3] == "code-scanning" && parts[4] == "sarifs":
+		return codeScanningUploadCategory
+	case method == "POST" && strings.HasSuffix(path, "/actions/runners/registration-token"):
+		return actionsRunnerRegistrationCategory
+	case strings.HasPrefix(path, "/scim/"):
+		return scimCategory
 	}
 }
 
@@ -927,12 +1403,22 @@ func (c *Client) RateLimits(ctx context.Context) (*RateLimits, *Response, error)
 	}
 
 	if response.Resources != nil {
-		c.rateMu.Lock()
-		if response.Resources.Core != nil {
-			c.rateLimits[coreCategory] = *response.Resources.Core
+		rates := [categories]*Rate{
+			coreCategory:                      response.Resources.Core,
+			searchCategory:                    response.Resources.Search,
+			graphqlCategory:                   response.Resources.GraphQL,
+			integrationManifestCategory:       response.Resources.IntegrationManifest,
+			sourceImportCategory:              response.Resources.SourceImport,
+			codeScanningUploadCategory:        response.Resources.CodeScanningUpload,
+			actionsRunnerRegistrationCategory: response.Resources.ActionsRunnerRegistration,
+			scimCategory:                      response.Resources.SCIM,
 		}
-		if response.Resources.Search != nil {
-			c.rateLimits[searchCategory] = *response.Resources.Search
+		c.rateMu.Lock()
+		limits := c.rates(ctx)
+		for cat, rate := range rates {
+			if rate != nil {
+				limits[cat] = *rate
+			}
 		}
 		c.rateMu.Unlock()
 	}
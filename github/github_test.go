@@ -197,7 +197,9 @@ func testNewRequestAndDoFailure(t *testing.T, methodName string, client *Client,
 	}
 
 	client.BaseURL.Path = "/api-v3/"
-	client.rateLimits[0].Reset.Time = time.Now().Add(10 * time.Minute)
+	for i := range client.rateLimits {
+		client.rateLimits[i].Reset.Time = time.Now().Add(10 * time.Minute)
+	}
 	resp, err = f()
 	if want := http.StatusForbidden; resp == nil || resp.Response.StatusCode != want {
 		if resp != nil {
@@ -438,7 +440,7 @@ func TestRateLimits_String(t *testing.T) {
 		Core:   &Rate{},
 		Search: &Rate{},
 	}
-	want := `github.RateLimits{Core:github.Rate{Limit:0, Remaining:0, Reset:github.Timestamp{0001-01-01 00:00:00 +0000 UTC}}, Search:github.Rate{Limit:0, Remaining:0, Reset:github.Timestamp{0001-01-01 00:00:00 +0000 UTC}}}`
+	want := `github.RateLimits{Core:github.Rate{Limit:0, Remaining:0, Reset:github.Timestamp{0001-01-01 00:00:00 +0000 UTC}, Resource:""}, Search:github.Rate{Limit:0, Remaining:0, Reset:github.Timestamp{0001-01-01 00:00:00 +0000 UTC}, Resource:""}}`
 	if got := v.String(); got != want {
 		t.Errorf("RateLimits.String = %v, want %v", got, want)
 	}
@@ -635,6 +637,50 @@ func TestResponse_cursorPagination(t *testing.T) {
 	}
 }
 
+func TestResponse_beforeAfterPagination(t *testing.T) {
+	r := http.Response{
+		Header: http.Header{
+			"Status": {"200 OK"},
+			"Link": {`<https://api.github.com/resource?per_page=2&before=Y3Vyc29yOnYyOpK0>; rel="prev",` +
+				` <https://api.github.com/resource?per_page=2&after=Y3Vyc29yOnYyOpK1>; rel="next"`},
+		},
+	}
+
+	response := newResponse(&r)
+	if got, want := response.Before, "Y3Vyc29yOnYyOpK0"; got != want {
+		t.Errorf("response.Before: %v, want %v", got, want)
+	}
+	if got, want := response.After, "Y3Vyc29yOnYyOpK1"; got != want {
+		t.Errorf("response.After: %v, want %v", got, want)
+	}
+	if got, want := response.NextPage, 0; got != want {
+		t.Errorf("response.NextPage: %v, want %v", got, want)
+	}
+	if got, want := response.NextPageToken, ""; got != want {
+		t.Errorf("response.NextPageToken: %v, want %v", got, want)
+	}
+}
+
+func TestResponse_cursorParameterPagination(t *testing.T) {
+	r := http.Response{
+		Header: http.Header{
+			"Status": {"200 OK"},
+			"Link":   {`<https://api.github.com/resource?per_page=2&cursor=v1_12345678>; rel="next"`},
+		},
+	}
+
+	response := newResponse(&r)
+	if got, want := response.Cursor, "v1_12345678"; got != want {
+		t.Errorf("response.Cursor: %v, want %v", got, want)
+	}
+	if got, want := response.NextPage, 0; got != want {
+		t.Errorf("response.NextPage: %v, want %v", got, want)
+	}
+	if got, want := response.NextPageToken, ""; got != want {
+		t.Errorf("response.NextPageToken: %v, want %v", got, want)
+	}
+}
+
 func TestResponse_populatePageValues_invalid(t *testing.T) {
 	r := http.Response{
 		Header: http.Header{
@@ -934,6 +980,155 @@ func TestDo_rateLimit_noNetworkCall(t *testing.T) {
 
 // Ensure *AbuseRateLimitError is returned when the response indicates that
 // the client has triggered an abuse detection mechanism.
+func TestDo_rateLimit_keyed(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	reset := time.Now().UTC().Add(time.Minute).Round(time.Second)
+	requests := map[string]int{}
+	mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		auth := r.Header.Get("X-Test-Identity")
+		requests[auth]++
+		w.Header().Set(headerRateLimit, "5000")
+		w.Header().Set(headerRateReset, fmt.Sprint(reset.Unix()))
+		if auth == "exhausted" {
+			w.Header().Set(headerRateRemaining, "0")
+			w.WriteHeader(http.StatusForbidden)
+			fmt.Fprint(w, `{"message":"API rate limit exceeded"}`)
+			return
+		}
+		w.Header().Set(headerRateRemaining, "4999")
+	})
+
+	ctx := context.Background()
+	do := func(ctx context.Context, identity string) error {
+		req, _ := client.NewRequest("GET", ".", nil)
+		req.Header.Set("X-Test-Identity", identity)
+		_, err := client.Do(ctx, req, nil)
+		return err
+	}
+
+	exhaustedCtx := WithRateLimitKey(ctx, InstallationRateLimitKey(1))
+	otherCtx := WithRateLimitKey(ctx, InstallationRateLimitKey(2))
+	if err := do(exhaustedCtx, "exhausted"); err == nil {
+		t.Fatal("Expected rate limit error for installation 1.")
+	}
+	if err := do(exhaustedCtx, "exhausted"); err == nil {
+		t.Fatal("Expected rate limit error for installation 1.")
+	}
+	if requests["exhausted"] != 1 {
+		t.Errorf("Made %v requests for installation 1, want 1", requests["exhausted"])
+	}
+	if err := do(otherCtx, "other"); err != nil {
+		t.Errorf("Request for installation 2 returned error: %v", err)
+	}
+	if err := do(ctx, "default"); err != nil {
+		t.Errorf("Request without rate limit key returned error: %v", err)
+	}
+
+	if got := client.KnownRate(InstallationRateLimitKey(1), "core"); got.Remaining != 0 || got.Limit != 5000 {
+		t.Errorf("KnownRate for installation 1 = %+v, want 0 remaining of 5000", got)
+	}
+	if got := client.KnownRate(InstallationRateLimitKey(2), "core"); got.Remaining != 4999 {
+		t.Errorf("KnownRate for installation 2 = %+v, want 4999 remaining", got)
+	}
+	if got := client.KnownRate("", "core"); got.Remaining != 4999 {
+		t.Errorf("KnownRate without key = %+v, want 4999 remaining", got)
+	}
+	if got := client.KnownRate(InstallationRateLimitKey(3), "core"); got != (Rate{}) {
+		t.Errorf("KnownRate for unknown key = %+v, want zero Rate", got)
+	}
+	if got := client.KnownRate("", "unknown"); got != (Rate{}) {
+		t.Errorf("KnownRate for unknown resource = %+v, want zero Rate", got)
+	}
+
+	client.ForgetRateLimits(InstallationRateLimitKey(1))
+	if got := client.KnownRate(InstallationRateLimitKey(1), "core"); got != (Rate{}) {
+		t.Errorf("KnownRate after ForgetRateLimits = %+v, want zero Rate", got)
+	}
+	do(exhaustedCtx, "exhausted")
+	if requests["exhausted"] != 2 {
+		t.Errorf("Made %v requests for installation 1 after ForgetRateLimits, want 2", requests["exhausted"])
+	}
+}
+
+func TestClient_Use(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		testHeader(t, r, "X-First", "1")
+		testHeader(t, r, "X-Second", "2")
+		fmt.Fprint(w, `{"A":"a"}`)
+	})
+
+	var calls []string
+	header := func(name, value string) RequestMiddleware {
+		return func(next RequestHandler) RequestHandler {
+			return func(req *http.Request) (*http.Response, error) {
+				calls = append(calls, "request "+name)
+				req = req.Clone(req.Context())
+				req.Header.Set(name, value)
+				resp, err := next(req)
+				calls = append(calls, fmt.Sprintf("response %v %v", name, resp.StatusCode))
+				return resp, err
+			}
+		}
+	}
+	client.Use(header("X-First", "1"))
+	client.Use(header("X-Second", "2"))
+
+	type foo struct {
+		A string
+	}
+	req, _ := client.NewRequest("GET", ".", nil)
+	body := new(foo)
+	ctx := context.Background()
+	if _, err := client.Do(ctx, req, body); err != nil {
+		t.Fatalf("Do returned error: %v", err)
+	}
+	if want := (&foo{"a"}); !reflect.DeepEqual(body, want) {
+		t.Errorf("Response body = %v, want %v", body, want)
+	}
+	want := []string{"request X-First", "request X-Second", "response X-Second 200", "response X-First 200"}
+	if !reflect.DeepEqual(calls, want) {
+		t.Errorf("Middleware calls = %v, want %v", calls, want)
+	}
+	if req.Header.Get("X-First") != "" {
+		t.Errorf("Middleware modified the original request")
+	}
+}
+
+func TestClient_Use_shortCircuit(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	madeNetworkCall := false
+	mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		madeNetworkCall = true
+	})
+
+	client.Use(func(next RequestHandler) RequestHandler {
+		return func(req *http.Request) (*http.Response, error) {
+			return &http.Response{
+				StatusCode: http.StatusInternalServerError,
+				Header:     http.Header{},
+				Body:       ioutil.NopCloser(strings.NewReader(`{"message":"injected"}`)),
+				Request:    req,
+			}, nil
+		}
+	})
+
+	req, _ := client.NewRequest("GET", ".", nil)
+	_, err := client.Do(context.Background(), req, nil)
+	if errResp, ok := err.(*ErrorResponse); !ok || errResp.Message != "injected" {
+		t.Errorf("Do returned error %v, want injected ErrorResponse", err)
+	}
+	if madeNetworkCall {
+		t.Errorf("Network call was made, even though the middleware returned a response.")
+	}
+}
+
 func TestDo_rateLimit_abuseRateLimitError(t *testing.T) {
 	client, mux, _, teardown := setup()
 	defer teardown()
@@ -1034,6 +1229,31 @@ func TestDo_rateLimit_abuseRateLimitError_retryAfter(t *testing.T) {
 	}
 }
 
+func TestWaitForRateLimit(t *testing.T) {
+	ctx := context.Background()
+	retryAfter := time.Millisecond
+	for _, err := range []error{
+		&RateLimitError{Rate: Rate{Reset: Timestamp{time.Now().Add(time.Millisecond)}}},
+		&AbuseRateLimitError{RetryAfter: &retryAfter},
+	} {
+		if retry, werr := waitForRateLimit(ctx, err); !retry || werr != nil {
+			t.Errorf("waitForRateLimit(%T) returned %v, %v, want true, nil", err, retry, werr)
+		}
+	}
+
+	err := errors.New("e")
+	if retry, werr := waitForRateLimit(ctx, err); retry || werr != err {
+		t.Errorf("waitForRateLimit returned %v, %v, want false, %v", retry, werr, err)
+	}
+
+	canceled, cancel := context.WithCancel(ctx)
+	cancel()
+	rateErr := &RateLimitError{Rate: Rate{Reset: Timestamp{time.Now().Add(time.Minute)}}}
+	if retry, werr := waitForRateLimit(canceled, rateErr); retry || werr != context.Canceled {
+		t.Errorf("waitForRateLimit with canceled context returned %v, %v, want false, %v", retry, werr, context.Canceled)
+	}
+}
+
 func TestDo_noContent(t *testing.T) {
 	client, mux, _, teardown := setup()
 	defer teardown()
@@ -1152,6 +1372,33 @@ func TestCheckResponse_AbuseRateLimit(t *testing.T) {
 	}
 }
 
+func TestCheckResponse_SSORequired(t *testing.T) {
+	res := &http.Response{
+		Request:    &http.Request{Method: "GET", URL: &url.URL{Path: "/orgs/o/repos"}},
+		StatusCode: http.StatusForbidden,
+		Header:     http.Header{},
+		Body:       ioutil.NopCloser(strings.NewReader(`{"message":"m"}`)),
+	}
+	res.Header.Set(headerSSO, "required; url=https://github.com/orgs/o/sso?authorization_request=r")
+	err, ok := CheckResponse(res).(*SSORequiredError)
+	if !ok {
+		t.Fatalf("CheckResponse returned %T, want *SSORequiredError", CheckResponse(res))
+	}
+
+	want := &SSORequiredError{
+		Response:     res,
+		Message:      "m",
+		URL:          "https://github.com/orgs/o/sso?authorization_request=r",
+		Organization: "o",
+	}
+	if !reflect.DeepEqual(err, want) {
+		t.Errorf("Error = %#v, want %#v", err, want)
+	}
+	if got, want := err.Error(), "GET /orgs/o/repos: 403 m"; got != want {
+		t.Errorf("Error() = %q, want %q", got, want)
+	}
+}
+
 // ensure that we properly handle API errors that do not contain a response body
 func TestCheckResponse_noBody(t *testing.T) {
 	res := &http.Response{
@@ -1296,6 +1543,124 @@ func TestRateLimits(t *testing.T) {
 	}
 }
 
+func TestRateLimits_allResources(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/rate_limit", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"resources":{
+			"core": {"limit":1,"remaining":1,"reset":1372700873},
+			"search": {"limit":2,"remaining":1,"reset":1372700873},
+			"graphql": {"limit":3,"remaining":1,"reset":1372700873},
+			"integration_manifest": {"limit":4,"remaining":1,"reset":1372700873},
+			"source_import": {"limit":5,"remaining":1,"reset":1372700873},
+			"code_scanning_upload": {"limit":6,"remaining":1,"reset":1372700873},
+			"actions_runner_registration": {"limit":7,"remaining":1,"reset":1372700873},
+			"scim": {"limit":8,"remaining":1,"reset":1372700873}
+		}}`)
+	})
+
+	ctx := context.Background()
+	rate, _, err := client.RateLimits(ctx)
+	if err != nil {
+		t.Errorf("RateLimits returned error: %v", err)
+	}
+
+	got := []int{
+		rate.Core.Limit, rate.Search.Limit, rate.GraphQL.Limit, rate.IntegrationManifest.Limit,
+		rate.SourceImport.Limit, rate.CodeScanningUpload.Limit, rate.ActionsRunnerRegistration.Limit, rate.SCIM.Limit,
+	}
+	if want := []int{1, 2, 3, 4, 5, 6, 7, 8}; !reflect.DeepEqual(got, want) {
+		t.Errorf("RateLimits returned limits %v, want %v", got, want)
+	}
+	for cat, r := range client.rateLimits {
+		if want := cat + 1; r.Limit != want {
+			t.Errorf("client.rateLimits[%v].Limit is %v, want %v", categoryResources[cat], r.Limit, want)
+		}
+	}
+}
+
+func TestCategory(t *testing.T) {
+	tests := []struct {
+		method, path string
+		want         rateLimitCategory
+	}{
+		{"GET", "/", coreCategory},
+		{"GET", "/repos/o/r", coreCategory},
+		{"GET", "/repos/o/graphql", coreCategory},
+		{"GET", "/search/issues", searchCategory},
+		{"POST", "/graphql", graphqlCategory},
+		{"POST", "/api/graphql", graphqlCategory},
+		{"POST", "/app-manifests/c/conversions", integrationManifestCategory},
+		{"PUT", "/repos/o/r/import", sourceImportCategory},
+		{"GET", "/repos/o/r/import/authors", sourceImportCategory},
+		{"POST", "/repos/o/r/code-scanning/sarifs", codeScanningUploadCategory},
+		{"GET", "/repos/o/r/code-scanning/sarifs/1", coreCategory},
+		{"POST", "/orgs/o/actions/runners/registration-token", actionsRunnerRegistrationCategory},
+		{"POST", "/repos/o/r/actions/runners/registration-token", actionsRunnerRegistrationCategory},
+		{"GET", "/scim/v2/organizations/o/Users", scimCategory},
+	}
+
+	for _, tt := range tests {
+		if got := category(tt.method, tt.path); got != tt.want {
+			t.Errorf("category(%v, %v) is %v, want %v", tt.method, tt.path, categoryResources[got], categoryResources[tt.want])
+		}
+	}
+}
+
+func TestDo_rateLimitResource(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	reset := time.Now().UTC().Add(time.Minute).Round(time.Second)
+	mux.HandleFunc("/search/code", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set(headerRateLimit, "30")
+		w.Header().Set(headerRateRemaining, "0")
+		w.Header().Set(headerRateReset, fmt.Sprint(reset.Unix()))
+		w.WriteHeader(http.StatusForbidden)
+		fmt.Fprint(w, `{"message": "API rate limit exceeded"}`)
+	})
+	mux.HandleFunc("/graphql", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set(headerRateLimit, "5000")
+		w.Header().Set(headerRateRemaining, "0")
+		w.Header().Set(headerRateReset, fmt.Sprint(reset.Unix()))
+		w.Header().Set(headerRateResource, "graphql")
+		w.WriteHeader(http.StatusForbidden)
+		fmt.Fprint(w, `{"message": "API rate limit exceeded"}`)
+	})
+	mux.HandleFunc("/repos/o/r", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{}`)
+	})
+
+	ctx := context.Background()
+	for _, tt := range []struct {
+		method, path string
+		want         string
+	}{
+		{"GET", "search/code", "search"},
+		{"GET", "search/code", "search"}, // From the known rate limit, without request.
+		{"POST", "graphql", "graphql"},
+		{"POST", "graphql", "graphql"},
+	} {
+		req, _ := client.NewRequest(tt.method, tt.path, nil)
+		_, err := client.Do(ctx, req, nil)
+		rerr, ok := err.(*RateLimitError)
+		if !ok {
+			t.Fatalf("Do(%v) returned error %#v, want *RateLimitError", tt.path, err)
+		}
+		if rerr.Rate.Resource != tt.want {
+			t.Errorf("Do(%v) returned error for resource %q, want %q", tt.path, rerr.Rate.Resource, tt.want)
+		}
+	}
+
+	// The core rate limit is not affected.
+	req, _ := client.NewRequest("GET", "repos/o/r", nil)
+	if _, err := client.Do(ctx, req, nil); err != nil {
+		t.Errorf("Do returned error: %v", err)
+	}
+}
+
 func TestRateLimits_coverage(t *testing.T) {
 	client, _, _, teardown := setup()
 	defer teardown()
@@ -1448,6 +1813,45 @@ func TestBasicAuthTransport_transport(t *testing.T) {
 	}
 }
 
+func TestResponse_tokenValues(t *testing.T) {
+	r := http.Response{Header: http.Header{}}
+	r.Header.Set(headerOAuthScopes, "repo, read:org")
+	r.Header.Set(headerAcceptedOAuthScopes, "")
+	r.Header.Set(headerSSO, "partial-results; organizations=21955855,20582480")
+	r.Header.Set(headerTokenExpiration, "2023-06-01 12:00:00 UTC")
+
+	response := newResponse(&r)
+	if want := []Scope{ScopeRepo, ScopeReadOrg}; !reflect.DeepEqual(response.OAuthScopes, want) {
+		t.Errorf("response.OAuthScopes: %v, want %v", response.OAuthScopes, want)
+	}
+	if want := []Scope{}; !reflect.DeepEqual(response.AcceptedOAuthScopes, want) {
+		t.Errorf("response.AcceptedOAuthScopes: %#v, want %#v", response.AcceptedOAuthScopes, want)
+	}
+	wantSSO := &SSOStatus{PartialResults: true, OrganizationIDs: []int64{21955855, 20582480}}
+	if !reflect.DeepEqual(response.SSO, wantSSO) {
+		t.Errorf("response.SSO: %+v, want %+v", response.SSO, wantSSO)
+	}
+	if want := time.Date(2023, time.June, 1, 12, 0, 0, 0, time.UTC); !response.TokenExpiration.Equal(Timestamp{want}) {
+		t.Errorf("response.TokenExpiration: %v, want %v", response.TokenExpiration, want)
+	}
+
+	r.Header.Set(headerSSO, "required; url=https://github.com/orgs/o/sso?authorization_request=r")
+	r.Header.Set(headerTokenExpiration, "2023-06-01 12:00:00 +0200")
+	response = newResponse(&r)
+	wantSSO = &SSOStatus{Required: true, URL: "https://github.com/orgs/o/sso?authorization_request=r"}
+	if !reflect.DeepEqual(response.SSO, wantSSO) {
+		t.Errorf("response.SSO: %+v, want %+v", response.SSO, wantSSO)
+	}
+	if want := time.Date(2023, time.June, 1, 10, 0, 0, 0, time.UTC); !response.TokenExpiration.Equal(Timestamp{want}) {
+		t.Errorf("response.TokenExpiration: %v, want %v", response.TokenExpiration, want)
+	}
+
+	response = newResponse(&http.Response{Header: http.Header{}})
+	if response.OAuthScopes != nil || response.AcceptedOAuthScopes != nil || response.SSO != nil || !response.TokenExpiration.IsZero() {
+		t.Errorf("newResponse without token headers returned %+v", response)
+	}
+}
+
 func TestFormatRateReset(t *testing.T) {
 	d := 120*time.Minute + 12*time.Second
 	got := formatRateReset(d)
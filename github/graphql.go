@@ -0,0 +1,120 @@
+// Copyright 2021 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+// Some features, such as resolving review threads or enabling auto-merge,
+// are only exposed by the GitHub GraphQL API. The methods backed by it use
+// the unexported helpers below, so they share the authentication, rate
+// limiting and error handling of the rest of the client.
+
+// graphQLRequest represents the body of a GitHub GraphQL API request.
+type graphQLRequest struct {
+	Query     string                 `json:"query"`
+	Variables map[string]interface{} `json:"variables,omitempty"`
+}
+
+// graphQLResponse represents the body of a GitHub GraphQL API response.
+type graphQLResponse struct {
+	Data   json.RawMessage `json:"data"`
+	Errors []*GraphQLError `json:"errors"`
+}
+
+// graphQLPageInfo represents the pagination information of a GraphQL connection.
+type graphQLPageInfo struct {
+	HasNextPage bool   `json:"hasNextPage"`
+	EndCursor   string `json:"endCursor"`
+}
+
+// graphQLActor represents the login of a GraphQL Actor, such as the author
+// of a comment.
+type graphQLActor struct {
+	Login *string `json:"login"`
+}
+
+// user returns the actor as a User, or nil if there is no actor.
+func (a *graphQLActor) user() *User {
+	if a == nil {
+		return nil
+	}
+	return &User{Login: a.Login}
+}
+
+// GraphQLError represents a single error returned by the GitHub GraphQL API.
+type GraphQLError struct {
+	Type    string `json:"type,omitempty"`
+	Message string `json:"message"`
+}
+
+func (e *GraphQLError) Error() string {
+	if e.Type == "" {
+		return e.Message
+	}
+	return fmt.Sprintf("%v: %v", e.Type, e.Message)
+}
+
+// GraphQLErrorResponse reports one or more errors returned by the GitHub
+// GraphQL API. Such errors are reported in the response body, with a
+// 200 OK status code.
+type GraphQLErrorResponse struct {
+	Response *http.Response // HTTP response that caused this error
+	Errors   []*GraphQLError
+}
+
+func (r *GraphQLErrorResponse) Error() string {
+	msgs := make([]string, 0, len(r.Errors))
+	for _, e := range r.Errors {
+		msgs = append(msgs, e.Error())
+	}
+	return fmt.Sprintf("%v %v: GraphQL errors: %v",
+		r.Response.Request.Method, sanitizeURL(r.Response.Request.URL),
+		strings.Join(msgs, "; "))
+}
+
+// graphQLURL returns the URL of the GraphQL API, relative to BaseURL.
+// GitHub Enterprise Server serves it at /api/graphql rather than under
+// the /api/v3/ prefix of the REST API.
+func (c *Client) graphQLURL() string {
+	if strings.HasSuffix(c.BaseURL.Path, "/api/v3/") {
+		return "../graphql"
+	}
+	return "graphql"
+}
+
+// graphQL sends the GraphQL query (or mutation) with the given variables
+// and stores the "data" of the response in the value pointed to by v.
+// Errors in the response body are returned as a *GraphQLErrorResponse.
+func (c *Client) graphQL(ctx context.Context, query string, variables map[string]interface{}, v interface{}) (*Response, error) {
+	req, err := c.NewRequest("POST", c.graphQLURL(), &graphQLRequest{Query: query, Variables: variables})
+	if err != nil {
+		return nil, err
+	}
+
+	body := new(graphQLResponse)
+	resp, err := c.Do(ctx, req, body)
+	if err != nil {
+		return resp, err
+	}
+
+	if len(body.Errors) > 0 {
+		return resp, &GraphQLErrorResponse{Response: resp.Response, Errors: body.Errors}
+	}
+
+	if v != nil && len(body.Data) > 0 {
+		if err := json.Unmarshal(body.Data, v); err != nil {
+			return resp, err
+		}
+	}
+
+	return resp, nil
+}
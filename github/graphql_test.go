@@ -0,0 +1,106 @@
+// Copyright 2021 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestClient_graphQL(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/graphql", func(w http.ResponseWriter, r *http.Request) {
+		v := new(graphQLRequest)
+		json.NewDecoder(r.Body).Decode(v)
+
+		testMethod(t, r, "POST")
+		want := &graphQLRequest{
+			Query:     "query($login: String!) { user(login: $login) { login } }",
+			Variables: map[string]interface{}{"login": "l"},
+		}
+		if !reflect.DeepEqual(v, want) {
+			t.Errorf("Request body = %+v, want %+v", v, want)
+		}
+
+		fmt.Fprint(w, `{"data":{"user":{"login":"l"}}}`)
+	})
+
+	var data struct {
+		User *graphQLActor `json:"user"`
+	}
+	ctx := context.Background()
+	_, err := client.graphQL(ctx, "query($login: String!) { user(login: $login) { login } }", map[string]interface{}{"login": "l"}, &data)
+	if err != nil {
+		t.Errorf("graphQL returned error: %v", err)
+	}
+
+	if want := (&graphQLActor{Login: String("l")}); !reflect.DeepEqual(data.User, want) {
+		t.Errorf("graphQL returned %+v, want %+v", data.User, want)
+	}
+
+	testNewRequestAndDoFailure(t, "graphQL", client, func() (*Response, error) {
+		return client.graphQL(ctx, "query { viewer { login } }", nil, nil)
+	})
+}
+
+func TestClient_graphQL_errors(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/graphql", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		fmt.Fprint(w, `{"data":null,"errors":[{"type":"NOT_FOUND","message":"m1"},{"message":"m2"}]}`)
+	})
+
+	ctx := context.Background()
+	resp, err := client.graphQL(ctx, "query { viewer { login } }", nil, nil)
+	if resp == nil || resp.StatusCode != http.StatusOK {
+		t.Errorf("graphQL returned response %#v, want status %v", resp, http.StatusOK)
+	}
+
+	gqlErr, ok := err.(*GraphQLErrorResponse)
+	if !ok {
+		t.Fatalf("graphQL returned error %#v, want *GraphQLErrorResponse", err)
+	}
+	want := []*GraphQLError{{Type: "NOT_FOUND", Message: "m1"}, {Message: "m2"}}
+	if !reflect.DeepEqual(gqlErr.Errors, want) {
+		t.Errorf("GraphQLErrorResponse.Errors = %+v, want %+v", gqlErr.Errors, want)
+	}
+	if got := gqlErr.Error(); !strings.HasSuffix(got, "GraphQL errors: NOT_FOUND: m1; m2") {
+		t.Errorf("GraphQLErrorResponse.Error() = %q, want suffix %q", got, "GraphQL errors: NOT_FOUND: m1; m2")
+	}
+}
+
+func TestClient_graphQLURL(t *testing.T) {
+	tests := []struct {
+		baseURL string
+		want    string
+	}{
+		{baseURL: defaultBaseURL, want: "https://api.github.com/graphql"},
+		{baseURL: "https://custom-url/api/v3/", want: "https://custom-url/api/graphql"},
+	}
+
+	for _, tt := range tests {
+		c := NewClient(nil)
+		c.BaseURL, _ = url.Parse(tt.baseURL)
+
+		u, err := c.BaseURL.Parse(c.graphQLURL())
+		if err != nil {
+			t.Fatalf("Parse returned unexpected error: %v", err)
+		}
+		if got := u.String(); got != tt.want {
+			t.Errorf("graphQLURL for %v resolved to %v, want %v", tt.baseURL, got, tt.want)
+		}
+	}
+}
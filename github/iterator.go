@@ -0,0 +1,65 @@
+// Copyright 2021 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+// pageIterator is the part shared by the iterators over the paginated lists,
+// such as PullRequestFilesIterator, which embed it and keep the items of the
+// current page. It fetches the pages as needed, and records the response of
+// the last page and the error which stopped the iteration.
+type pageIterator struct {
+	// fetch fetches the next page, and returns its number of items and
+	// whether it is the last page.
+	fetch func() (n int, last bool, resp *Response, err error)
+
+	left int // remaining items of the current page
+	resp *Response
+	err  error
+	done bool
+}
+
+// next advances the iterator to the next item, fetching the next page if
+// needed. It returns false when there are no more items or an error
+// occurred.
+func (it *pageIterator) next() bool {
+	for it.left == 0 {
+		if it.done || it.err != nil {
+			return false
+		}
+		n, last, resp, err := it.fetch()
+		it.resp = resp
+		if err != nil {
+			it.err = err
+			return false
+		}
+		it.left, it.done = n, last
+	}
+	it.left--
+	return true
+}
+
+// Err returns the error, if any, that stopped the iteration.
+func (it *pageIterator) Err() error {
+	return it.err
+}
+
+// Response returns the response of the last page fetched, or nil if no page
+// has been fetched yet.
+func (it *pageIterator) Response() *Response {
+	return it.resp
+}
+
+// listPages returns the fetch function of a pageIterator over a list
+// paginated by opts, which calls list and then moves opts to the next page.
+func listPages(opts *ListOptions, list func() (n int, resp *Response, err error)) func() (int, bool, *Response, error) {
+	return func() (int, bool, *Response, error) {
+		n, resp, err := list()
+		if err != nil {
+			return 0, false, resp, err
+		}
+		opts.Page = resp.NextPage
+		return n, resp.NextPage == 0 || n == 0, resp, nil
+	}
+}
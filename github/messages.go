@@ -18,9 +18,12 @@ import (
 	"errors"
 	"fmt"
 	"hash"
+	"io"
 	"io/ioutil"
 	"net/http"
 	"net/url"
+	"reflect"
+	"sort"
 	"strings"
 )
 
@@ -36,6 +39,9 @@ const (
 	eventTypeHeader = "X-Github-Event"
 	// deliveryIDHeader is the GitHub header key used to pass the unique ID for the webhook event.
 	deliveryIDHeader = "X-Github-Delivery"
+	// payloadFormParam is the name of the form parameter that the JSON payload
+	// will be in if a webhook has its content type set to application/x-www-form-urlencoded.
+	payloadFormParam = "payload"
 )
 
 var (
@@ -46,6 +52,8 @@ var (
 		"commit_comment":                 "CommitCommentEvent",
 		"content_reference":              "ContentReferenceEvent",
 		"create":                         "CreateEvent",
+		"custom_property":                "CustomPropertyEvent",
+		"custom_property_values":         "CustomPropertyValuesEvent",
 		"delete":                         "DeleteEvent",
 		"deploy_key":                     "DeployKeyEvent",
 		"deployment":                     "DeploymentEvent",
@@ -56,11 +64,13 @@ var (
 		"installation":                   "InstallationEvent",
 		"installation_repositories":      "InstallationRepositoriesEvent",
 		"issue_comment":                  "IssueCommentEvent",
+		"issue_dependencies":             "IssueDependenciesEvent",
 		"issues":                         "IssuesEvent",
 		"label":                          "LabelEvent",
 		"marketplace_purchase":           "MarketplacePurchaseEvent",
 		"member":                         "MemberEvent",
 		"membership":                     "MembershipEvent",
+		"merge_group":                    "MergeGroupEvent",
 		"meta":                           "MetaEvent",
 		"milestone":                      "MilestoneEvent",
 		"organization":                   "OrganizationEvent",
@@ -71,6 +81,7 @@ var (
 		"project":                        "ProjectEvent",
 		"project_card":                   "ProjectCardEvent",
 		"project_column":                 "ProjectColumnEvent",
+		"projects_v2_status_update":      "ProjectV2StatusUpdateEvent",
 		"public":                         "PublicEvent",
 		"pull_request_review":            "PullRequestReviewEvent",
 		"pull_request_review_comment":    "PullRequestReviewCommentEvent",
@@ -80,8 +91,10 @@ var (
 		"repository_dispatch":            "RepositoryDispatchEvent",
 		"repository_vulnerability_alert": "RepositoryVulnerabilityAlertEvent",
 		"release":                        "ReleaseEvent",
+		"secret_scanning_alert_location": "SecretScanningAlertLocationEvent",
 		"star":                           "StarEvent",
 		"status":                         "StatusEvent",
+		"sub_issues":                     "SubIssuesEvent",
 		"team":                           "TeamEvent",
 		"team_add":                       "TeamAddEvent",
 		"user":                           "UserEvent",
@@ -152,13 +165,36 @@ func messageMAC(signature string) ([]byte, func() hash.Hash, error) {
 //     }
 //
 func ValidatePayload(r *http.Request, secretToken []byte) (payload []byte, err error) {
+	payload, _, err = ValidatePayloadWithSecrets(r, secretToken)
+	return payload, err
+}
+
+// ValidatePayloadWithSecrets validates an incoming GitHub Webhook event
+// request like ValidatePayload, with any of several candidate secret tokens,
+// such as the old and the new tokens while the secret token of a webhook is
+// being rotated. It returns the (JSON) payload and the index in secretTokens
+// of the token whose signature matched.
+//
+// Empty secret tokens are ignored. If all the secret tokens are empty, the
+// signature is not validated and the returned index is -1.
+//
+// Example usage:
+//
+//     func (s *GitHubEventMonitor) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+//       payload, i, err := github.ValidatePayloadWithSecrets(r, s.newSecretKey, s.oldSecretKey)
+//       if err != nil { ... }
+//       if i == 1 { log.Print("webhook still uses the old secret key") }
+//       // Process payload...
+//     }
+//
+func ValidatePayloadWithSecrets(r *http.Request, secretTokens ...[]byte) (payload []byte, secretIndex int, err error) {
 	var body []byte // Raw body that GitHub uses to calculate the signature.
 
 	switch ct := r.Header.Get("Content-Type"); ct {
 	case "application/json":
 		var err error
 		if body, err = ioutil.ReadAll(r.Body); err != nil {
-			return nil, err
+			return nil, -1, err
 		}
 
 		// If the content type is application/json,
@@ -166,37 +202,44 @@ func ValidatePayload(r *http.Request, secretToken []byte) (payload []byte, err e
 		payload = body
 
 	case "application/x-www-form-urlencoded":
-		// payloadFormParam is the name of the form parameter that the JSON payload
-		// will be in if a webhook has its content type set to application/x-www-form-urlencoded.
-		const payloadFormParam = "payload"
-
 		var err error
 		if body, err = ioutil.ReadAll(r.Body); err != nil {
-			return nil, err
+			return nil, -1, err
 		}
 
 		// If the content type is application/x-www-form-urlencoded,
 		// the JSON payload will be under the "payload" form param.
 		form, err := url.ParseQuery(string(body))
 		if err != nil {
-			return nil, err
+			return nil, -1, err
 		}
 		payload = []byte(form.Get(payloadFormParam))
 
 	default:
-		return nil, fmt.Errorf("Webhook request has unsupported Content-Type %q", ct)
+		return nil, -1, fmt.Errorf("Webhook request has unsupported Content-Type %q", ct)
 	}
 
 	// Only validate the signature if a secret token exists. This is intended for
 	// local development only and all webhooks should ideally set up a secret token.
-	if len(secretToken) > 0 {
-		sig := r.Header.Get(signatureHeader)
-		if err := ValidateSignature(sig, body, secretToken); err != nil {
-			return nil, err
-		}
+	if !hasSecret(secretTokens) {
+		return payload, -1, nil
+	}
+
+	sig := r.Header.Get(signatureHeader)
+	if secretIndex, err = ValidateSignatureWithSecrets(sig, body, secretTokens...); err != nil {
+		return nil, -1, err
 	}
+	return payload, secretIndex, nil
+}
 
-	return payload, nil
+// hasSecret reports whether any of secretTokens is not empty.
+func hasSecret(secretTokens [][]byte) bool {
+	for _, secret := range secretTokens {
+		if len(secret) > 0 {
+			return true
+		}
+	}
+	return false
 }
 
 // ValidateSignature validates the signature for the given payload.
@@ -216,6 +259,23 @@ func ValidateSignature(signature string, payload, secretToken []byte) error {
 	return nil
 }
 
+// ValidateSignatureWithSecrets validates the signature for the given payload
+// like ValidateSignature, with any of several candidate secret tokens. It
+// returns the index in secretTokens of the token whose signature matched.
+// Empty secret tokens are ignored.
+func ValidateSignatureWithSecrets(signature string, payload []byte, secretTokens ...[]byte) (int, error) {
+	messageMAC, hashFunc, err := messageMAC(signature)
+	if err != nil {
+		return -1, err
+	}
+	for i, secret := range secretTokens {
+		if len(secret) > 0 && checkMAC(payload, messageMAC, secret, hashFunc) {
+			return i, nil
+		}
+	}
+	return -1, errors.New("payload signature check failed")
+}
+
 // WebHookType returns the event type of webhook request r.
 //
 // GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/repos/hooks/#webhook-headers
@@ -263,3 +323,311 @@ func ParseWebHook(messageType string, payload []byte) (interface{}, error) {
 	}
 	return event.ParsePayload()
 }
+
+// DefaultMaxWebHookBodySize is the default maximum size of the body of the
+// webhook requests parsed by ParseWebHookFromRequest. It is the maximum size
+// of the webhook payloads sent by GitHub, 25 MB.
+const DefaultMaxWebHookBodySize = 25 << 20
+
+// ErrWebHookBodyTooLarge is returned by ParseWebHookFromRequest when the body
+// of a webhook request is larger than its maximum size.
+var ErrWebHookBodyTooLarge = errors.New("webhook request body is too large")
+
+// ParseWebHookOptions specifies the optional parameters to the
+// ParseWebHookFromRequest function.
+type ParseWebHookOptions struct {
+	// SecretTokens are the candidate secret tokens of the webhook, as in
+	// ValidatePayloadWithSecrets. If they are all empty, the signature of
+	// the request is not validated.
+	SecretTokens [][]byte
+
+	// MaxBodySize is the maximum size in bytes of the request body.
+	// Default: DefaultMaxWebHookBodySize.
+	MaxBodySize int64
+}
+
+// ParseWebHookFromRequest validates and parses the payload of webhook
+// request r, like ValidatePayloadWithSecrets followed by ParseWebHook, but
+// without reading the whole body into memory first: for the
+// "application/json" content type, the payload is decoded while it is read,
+// and its signature computed along the way. This saves memory with large
+// payloads, such as push events with thousands of commits. Payloads of the
+// "application/x-www-form-urlencoded" content type are still read in full.
+//
+// The parsed event is only returned once the whole body has been read and
+// its signature validated. A body larger than opts.MaxBodySize results in
+// ErrWebHookBodyTooLarge.
+//
+// Example usage:
+//
+//     func (s *GitHubEventMonitor) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+//       event, err := github.ParseWebHookFromRequest(r, &github.ParseWebHookOptions{
+//         SecretTokens: [][]byte{s.webhookSecretKey},
+//       })
+//       if err != nil { ... }
+//       switch event := event.(type) {
+//       ...
+//       }
+//     }
+//
+func ParseWebHookFromRequest(r *http.Request, opts *ParseWebHookOptions) (interface{}, error) {
+	if opts == nil {
+		opts = &ParseWebHookOptions{}
+	}
+	messageType := WebHookType(r)
+	eventType, ok := eventTypeMapping[messageType]
+	if !ok {
+		return nil, fmt.Errorf("unknown X-Github-Event in message: %v", messageType)
+	}
+
+	maxBodySize := opts.MaxBodySize
+	if maxBodySize <= 0 {
+		maxBodySize = DefaultMaxWebHookBodySize
+	}
+	var body io.Reader = &maxBytesReader{r: r.Body, n: maxBodySize}
+
+	// Compute the signature of the body with each secret token as it is read.
+	var wantMAC []byte
+	var macs []hash.Hash
+	if hasSecret(opts.SecretTokens) {
+		var hashFunc func() hash.Hash
+		var err error
+		if wantMAC, hashFunc, err = messageMAC(r.Header.Get(signatureHeader)); err != nil {
+			return nil, err
+		}
+		var writers []io.Writer
+		for _, secret := range opts.SecretTokens {
+			if len(secret) == 0 {
+				continue
+			}
+			mac := hmac.New(hashFunc, secret)
+			macs = append(macs, mac)
+			writers = append(writers, mac)
+		}
+		body = io.TeeReader(body, io.MultiWriter(writers...))
+	}
+
+	var payload io.Reader
+	switch ct := r.Header.Get("Content-Type"); ct {
+	case "application/json":
+		payload = body
+
+	case "application/x-www-form-urlencoded":
+		// The JSON payload is in the "payload" form param, which can't be
+		// decoded while the body is read.
+		b, err := ioutil.ReadAll(body)
+		if err != nil {
+			return nil, err
+		}
+		form, err := url.ParseQuery(string(b))
+		if err != nil {
+			return nil, err
+		}
+		payload = strings.NewReader(form.Get(payloadFormParam))
+
+	default:
+		return nil, fmt.Errorf("Webhook request has unsupported Content-Type %q", ct)
+	}
+
+	event := newEventPayload(EventType(eventType))
+	dec := json.NewDecoder(payload)
+	decodeErr := dec.Decode(event)
+	if decodeErr == nil {
+		if _, err := dec.Token(); err == nil {
+			decodeErr = errors.New("unexpected data after webhook payload")
+		} else if err != io.EOF {
+			decodeErr = err
+		}
+	}
+
+	// Read the rest of the body, which is part of its signature.
+	if _, err := io.Copy(ioutil.Discard, body); err != nil {
+		return nil, err
+	}
+	if macs != nil && !anyMACEqual(macs, wantMAC) {
+		return nil, errors.New("payload signature check failed")
+	}
+	if decodeErr != nil {
+		return nil, decodeErr
+	}
+	return event, nil
+}
+
+// anyMACEqual reports whether the sum of any of macs is messageMAC.
+func anyMACEqual(macs []hash.Hash, messageMAC []byte) bool {
+	for _, mac := range macs {
+		if hmac.Equal(mac.Sum(nil), messageMAC) {
+			return true
+		}
+	}
+	return false
+}
+
+// maxBytesReader reads at most n bytes from r, and returns
+// ErrWebHookBodyTooLarge if r has more.
+type maxBytesReader struct {
+	r io.Reader
+	n int64
+}
+
+func (l *maxBytesReader) Read(p []byte) (int, error) {
+	if l.n < 0 {
+		return 0, ErrWebHookBodyTooLarge
+	}
+	// Read one more byte than allowed, to detect larger bodies.
+	if int64(len(p)) > l.n+1 {
+		p = p[:l.n+1]
+	}
+	n, err := l.r.Read(p)
+	if int64(n) > l.n {
+		n = int(l.n)
+		l.n = -1
+		return n, ErrWebHookBodyTooLarge
+	}
+	l.n -= int64(n)
+	return n, err
+}
+
+// UnknownFieldsError is returned by ParseWebHookStrict when a webhook
+// payload has fields that are not in the struct type of its event.
+type UnknownFieldsError struct {
+	MessageType string
+	// Fields are the sorted paths of the unknown fields, such as
+	// "issue.type", where "[]" stands for the elements of an array, such as
+	// "commits[].foo".
+	Fields []string
+}
+
+func (e *UnknownFieldsError) Error() string {
+	return fmt.Sprintf("%v payload has unknown fields: %v", e.MessageType, strings.Join(e.Fields, ", "))
+}
+
+// ParseWebHookStrict parses the event payload like ParseWebHook, and also
+// checks that all the fields of the payload are in the struct type of the
+// event. If they are not, the parsed event is returned along with an
+// *UnknownFieldsError that lists the unknown fields, which are the fields
+// added or renamed by GitHub and not yet supported by this library.
+//
+// Unlike a json.Decoder with DisallowUnknownFields, which stops at the first
+// unknown field, all the unknown fields are reported. The values of fields
+// whose type implements json.Unmarshaler, such as json.RawMessage, are not
+// checked.
+func ParseWebHookStrict(messageType string, payload []byte) (interface{}, error) {
+	event, err := ParseWebHook(messageType, payload)
+	if err != nil {
+		return event, err
+	}
+
+	var raw interface{}
+	if err := json.Unmarshal(payload, &raw); err != nil {
+		return event, err
+	}
+	unknown := map[string]reflect.Type{}
+	unknownFields(reflect.TypeOf(event), raw, "", unknown)
+	if len(unknown) == 0 {
+		return event, nil
+	}
+
+	fields := make([]string, 0, len(unknown))
+	for f := range unknown {
+		fields = append(fields, f)
+	}
+	sort.Strings(fields)
+	return event, &UnknownFieldsError{MessageType: messageType, Fields: fields}
+}
+
+var jsonUnmarshalerType = reflect.TypeOf((*json.Unmarshaler)(nil)).Elem()
+
+// unknownFields adds to unknown the paths of the fields of the JSON value v
+// that are not in the type t, along with the struct type which lacks them.
+// path is the path of v.
+func unknownFields(t reflect.Type, v interface{}, path string, unknown map[string]reflect.Type) {
+	for t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	if reflect.PtrTo(t).Implements(jsonUnmarshalerType) {
+		return
+	}
+
+	switch t.Kind() {
+	case reflect.Struct:
+		obj, ok := v.(map[string]interface{})
+		if !ok {
+			return
+		}
+		known := jsonFields(t)
+		for name, value := range obj {
+			fieldPath := name
+			if path != "" {
+				fieldPath = path + "." + name
+			}
+			ft, ok := known[strings.ToLower(name)]
+			if !ok {
+				unknown[fieldPath] = t
+				continue
+			}
+			unknownFields(ft, value, fieldPath, unknown)
+		}
+	case reflect.Slice, reflect.Array:
+		arr, ok := v.([]interface{})
+		if !ok {
+			return
+		}
+		for _, e := range arr {
+			unknownFields(t.Elem(), e, path+"[]", unknown)
+		}
+	case reflect.Map:
+		obj, ok := v.(map[string]interface{})
+		if !ok {
+			return
+		}
+		for key, e := range obj {
+			unknownFields(t.Elem(), e, path+"."+key, unknown)
+		}
+	}
+}
+
+// jsonFields returns the types of the fields of the struct type t, by
+// lower-cased JSON name, as encoding/json matches them case-insensitively.
+// As with encoding/json, the fields of embedded structs do not replace the
+// fields of the same name which are less deeply nested.
+func jsonFields(t reflect.Type) map[string]reflect.Type {
+	fields := map[string]reflect.Type{}
+	depths := map[string]int{}
+	addJSONFields(t, 0, fields, depths)
+	return fields
+}
+
+// addJSONFields adds the fields of the struct type t, nested at depth, to
+// fields, unless a field of the same name is less deeply nested.
+func addJSONFields(t reflect.Type, depth int, fields map[string]reflect.Type, depths map[string]int) {
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		if f.PkgPath != "" && !f.Anonymous {
+			continue
+		}
+		tag := f.Tag.Get("json")
+		if tag == "-" {
+			continue
+		}
+		name := strings.Split(tag, ",")[0]
+		if f.Anonymous && name == "" {
+			ft := f.Type
+			if ft.Kind() == reflect.Ptr {
+				ft = ft.Elem()
+			}
+			if ft.Kind() == reflect.Struct {
+				addJSONFields(ft, depth+1, fields, depths)
+				continue
+			}
+		}
+		if name == "" {
+			name = f.Name
+		}
+		name = strings.ToLower(name)
+		if d, ok := depths[name]; ok && d <= depth {
+			continue
+		}
+		fields[name], depths[name] = f.Type, depth
+	}
+}
@@ -0,0 +1,136 @@
+// Copyright 2021 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"net/http"
+	"sync"
+)
+
+// WebHookHandlerFunc handles a webhook event, as returned by ParseWebHook.
+// deliveryID is the GUID of the delivery of the event.
+type WebHookHandlerFunc func(ctx context.Context, deliveryID string, event interface{}) error
+
+// WebHookDispatcher is an http.Handler which validates and parses webhook
+// requests with ParseWebHookFromRequest, and dispatches the events to the
+// handlers registered for their event name.
+//
+// It responds with 204 No Content once the handlers of an event have
+// returned, even if the event has no handlers, such as ping events. It
+// responds with 400 Bad Request to invalid requests, with 413 Request Entity
+// Too Large to requests larger than Options.MaxBodySize, and with 500
+// Internal Server Error if a handler fails.
+//
+// Example usage:
+//
+//     d := &github.WebHookDispatcher{
+//       Options:       &github.ParseWebHookOptions{SecretTokens: [][]byte{secretKey}},
+//       Installations: &github.InstallationCache{},
+//     }
+//     d.HandleInstallation(func(ctx context.Context, deliveryID string, event *github.InstallationEvent) error {
+//       ...
+//     })
+//     http.Handle("/webhook", d)
+//
+type WebHookDispatcher struct {
+	// Options are used to validate and parse the webhook requests.
+	Options *ParseWebHookOptions
+
+	// Installations, if not nil, is updated with each event before the
+	// handlers are called. See InstallationCache.Update.
+	Installations *InstallationCache
+
+	mu       sync.RWMutex
+	handlers map[string][]WebHookHandlerFunc
+}
+
+// Handle registers h to handle the events of the given webhook event name,
+// such as "push". The handlers of an event are called in the order they
+// were registered.
+func (d *WebHookDispatcher) Handle(messageType string, h WebHookHandlerFunc) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if d.handlers == nil {
+		d.handlers = map[string][]WebHookHandlerFunc{}
+	}
+	d.handlers[messageType] = append(d.handlers[messageType], h)
+}
+
+// HandlePing registers h to handle the ping events, which GitHub sends
+// when a webhook is created.
+func (d *WebHookDispatcher) HandlePing(h func(ctx context.Context, deliveryID string, event *PingEvent) error) {
+	d.Handle("ping", func(ctx context.Context, deliveryID string, event interface{}) error {
+		return h(ctx, deliveryID, event.(*PingEvent))
+	})
+}
+
+// HandleInstallation registers h to handle the installation events, which
+// are triggered when a GitHub App is installed, uninstalled, suspended or
+// unsuspended, or when its new permissions are accepted.
+func (d *WebHookDispatcher) HandleInstallation(h func(ctx context.Context, deliveryID string, event *InstallationEvent) error) {
+	d.Handle("installation", func(ctx context.Context, deliveryID string, event interface{}) error {
+		return h(ctx, deliveryID, event.(*InstallationEvent))
+	})
+}
+
+// HandleInstallationRepositories registers h to handle the
+// installation_repositories events, which are triggered when repositories
+// are added to or removed from an installation of a GitHub App.
+func (d *WebHookDispatcher) HandleInstallationRepositories(h func(ctx context.Context, deliveryID string, event *InstallationRepositoriesEvent) error) {
+	d.Handle("installation_repositories", func(ctx context.Context, deliveryID string, event interface{}) error {
+		return h(ctx, deliveryID, event.(*InstallationRepositoriesEvent))
+	})
+}
+
+// HandleGitHubAppAuthorization registers h to handle the
+// github_app_authorization events, which are triggered when a user revokes
+// the authorization of a GitHub App. The user access tokens of the app for
+// the user, the Sender of the event, are no longer valid.
+func (d *WebHookDispatcher) HandleGitHubAppAuthorization(h func(ctx context.Context, deliveryID string, event *GitHubAppAuthorizationEvent) error) {
+	d.Handle("github_app_authorization", func(ctx context.Context, deliveryID string, event interface{}) error {
+		return h(ctx, deliveryID, event.(*GitHubAppAuthorizationEvent))
+	})
+}
+
+// Dispatch updates Installations with event, and calls the handlers of
+// event, a webhook event of the given event name as returned by
+// ParseWebHook, until one of them fails. It can be used to dispatch events
+// that are not received by ServeHTTP, such as redelivered or queued events.
+func (d *WebHookDispatcher) Dispatch(ctx context.Context, messageType, deliveryID string, event interface{}) error {
+	if d.Installations != nil {
+		d.Installations.Update(event)
+	}
+
+	d.mu.RLock()
+	handlers := d.handlers[messageType]
+	d.mu.RUnlock()
+	for _, h := range handlers {
+		if err := h(ctx, deliveryID, event); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ServeHTTP implements the http.Handler interface.
+func (d *WebHookDispatcher) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	event, err := ParseWebHookFromRequest(r, d.Options)
+	if err == ErrWebHookBodyTooLarge {
+		http.Error(w, err.Error(), http.StatusRequestEntityTooLarge)
+		return
+	}
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	if err := d.Dispatch(r.Context(), WebHookType(r), DeliveryID(r), event); err != nil {
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
@@ -0,0 +1,111 @@
+// Copyright 2021 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func newWebHookRequest(t *testing.T, messageType, body string) *http.Request {
+	t.Helper()
+	req, err := http.NewRequest("POST", "http://localhost/webhook", strings.NewReader(body))
+	if err != nil {
+		t.Fatalf("NewRequest: %v", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(eventTypeHeader, messageType)
+	req.Header.Set(deliveryIDHeader, "d")
+	return req
+}
+
+func TestWebHookDispatcher(t *testing.T) {
+	d := &WebHookDispatcher{Installations: &InstallationCache{}}
+
+	var got []string
+	d.HandlePing(func(ctx context.Context, deliveryID string, event *PingEvent) error {
+		got = append(got, "ping "+deliveryID+" "+event.GetZen())
+		return nil
+	})
+	d.HandleInstallation(func(ctx context.Context, deliveryID string, event *InstallationEvent) error {
+		// The cache is updated before the handlers are called.
+		repos, _ := d.Installations.Repositories(event.GetInstallation().GetID())
+		got = append(got, "installation "+event.GetAction()+" "+strings.Join(repos, ","))
+		return nil
+	})
+	d.HandleInstallationRepositories(func(ctx context.Context, deliveryID string, event *InstallationRepositoriesEvent) error {
+		got = append(got, "installation_repositories "+event.GetAction())
+		return nil
+	})
+	d.HandleGitHubAppAuthorization(func(ctx context.Context, deliveryID string, event *GitHubAppAuthorizationEvent) error {
+		got = append(got, "github_app_authorization "+event.GetSender().GetLogin())
+		return nil
+	})
+	d.Handle("ping", func(ctx context.Context, deliveryID string, event interface{}) error {
+		got = append(got, "ping again")
+		return nil
+	})
+
+	for _, tt := range []struct {
+		messageType string
+		body        string
+	}{
+		{"ping", `{"zen":"z"}`},
+		{"installation", `{"action":"created","installation":{"id":1},"repositories":[{"full_name":"o/r"}]}`},
+		{"installation_repositories", `{"action":"added","installation":{"id":1},"repositories_added":[{"full_name":"o/s"}]}`},
+		{"github_app_authorization", `{"action":"revoked","sender":{"login":"l"}}`},
+		{"push", `{}`},
+	} {
+		w := httptest.NewRecorder()
+		d.ServeHTTP(w, newWebHookRequest(t, tt.messageType, tt.body))
+		if w.Code != http.StatusNoContent {
+			t.Errorf("ServeHTTP(%v) responded %v, want %v", tt.messageType, w.Code, http.StatusNoContent)
+		}
+	}
+
+	want := []string{
+		"ping d z",
+		"ping again",
+		"installation created o/r",
+		"installation_repositories added",
+		"github_app_authorization l",
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("WebHookDispatcher handled %q, want %q", got, want)
+	}
+	if id, ok := d.Installations.Installation("o/s"); !ok || id != 1 {
+		t.Errorf("Installations.Installation(o/s) returned %v, %v, want 1, true", id, ok)
+	}
+}
+
+func TestWebHookDispatcher_errors(t *testing.T) {
+	d := &WebHookDispatcher{Options: &ParseWebHookOptions{MaxBodySize: 20}}
+	d.Handle("push", func(ctx context.Context, deliveryID string, event interface{}) error {
+		return errors.New("failed")
+	})
+
+	for _, tt := range []struct {
+		messageType string
+		body        string
+		want        int
+	}{
+		{"push", `{}`, http.StatusInternalServerError},
+		{"push", `{`, http.StatusBadRequest},
+		{"bogus", `{}`, http.StatusBadRequest},
+		{"push", `{"ref":"refs/heads/main"}`, http.StatusRequestEntityTooLarge},
+	} {
+		w := httptest.NewRecorder()
+		d.ServeHTTP(w, newWebHookRequest(t, tt.messageType, tt.body))
+		if w.Code != tt.want {
+			t.Errorf("ServeHTTP(%v, %v) responded %v, want %v", tt.messageType, tt.body, w.Code, tt.want)
+		}
+	}
+}
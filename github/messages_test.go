@@ -7,6 +7,9 @@ package github
 
 import (
 	"bytes"
+	"crypto/sha1"
+	"crypto/sha256"
+	"encoding/hex"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -186,6 +189,57 @@ func TestValidatePayload_NoSecretKey(t *testing.T) {
 	}
 }
 
+func TestValidatePayloadWithSecrets(t *testing.T) {
+	const body = `{"yo":true}`
+	const signature = "sha1=126f2c800419c60137ce748d7672e77b65cf16d6"
+	secretKey := []byte("0123456789abcdef")
+	otherKey := []byte("fedcba9876543210")
+
+	tests := []struct {
+		signature   string
+		secrets     [][]byte
+		wantIndex   int
+		wantErr     bool
+		wantPayload string
+	}{
+		{signature: signature, secrets: [][]byte{secretKey}, wantIndex: 0, wantPayload: body},
+		{signature: signature, secrets: [][]byte{otherKey, secretKey}, wantIndex: 1, wantPayload: body},
+		{signature: signature, secrets: [][]byte{nil, secretKey}, wantIndex: 1, wantPayload: body},
+		{signature: signature, secrets: [][]byte{otherKey}, wantIndex: -1, wantErr: true},
+		{signature: "", secrets: [][]byte{otherKey, secretKey}, wantIndex: -1, wantErr: true},
+		{signature: "", secrets: [][]byte{nil, {}}, wantIndex: -1, wantPayload: body},
+		{signature: "", wantIndex: -1, wantPayload: body},
+	}
+
+	for i, test := range tests {
+		req, err := http.NewRequest("POST", "http://localhost/event", strings.NewReader(body))
+		if err != nil {
+			t.Fatalf("NewRequest: %v", err)
+		}
+		req.Header.Set("Content-Type", "application/json")
+		if test.signature != "" {
+			req.Header.Set(signatureHeader, test.signature)
+		}
+
+		got, index, err := ValidatePayloadWithSecrets(req, test.secrets...)
+		if (err != nil) != test.wantErr {
+			t.Errorf("#%v: ValidatePayloadWithSecrets returned error %v, want error %v", i, err, test.wantErr)
+		}
+		if string(got) != test.wantPayload || index != test.wantIndex {
+			t.Errorf("#%v: ValidatePayloadWithSecrets = %q, %v, want %q, %v", i, got, index, test.wantPayload, test.wantIndex)
+		}
+	}
+}
+
+func TestValidateSignatureWithSecrets_emptySecret(t *testing.T) {
+	payload := []byte(`{"yo":true}`)
+	signature := "sha1=" + hex.EncodeToString(genMAC(payload, nil, sha1.New))
+
+	if _, err := ValidateSignatureWithSecrets(signature, payload, []byte{}, nil); err == nil {
+		t.Error("ValidateSignatureWithSecrets with empty secrets = nil, want err")
+	}
+}
+
 // badReader satisfies io.Reader but always returns an error.
 type badReader struct{}
 
@@ -241,6 +295,14 @@ func TestParseWebHook(t *testing.T) {
 			payload:     &CreateEvent{},
 			messageType: "create",
 		},
+		{
+			payload:     &CustomPropertyEvent{},
+			messageType: "custom_property",
+		},
+		{
+			payload:     &CustomPropertyValuesEvent{},
+			messageType: "custom_property_values",
+		},
 		{
 			payload:     &DeleteEvent{},
 			messageType: "delete",
@@ -282,6 +344,10 @@ func TestParseWebHook(t *testing.T) {
 			payload:     &IssueCommentEvent{},
 			messageType: "issue_comment",
 		},
+		{
+			payload:     &IssueDependenciesEvent{},
+			messageType: "issue_dependencies",
+		},
 		{
 			payload:     &IssuesEvent{},
 			messageType: "issues",
@@ -302,6 +368,10 @@ func TestParseWebHook(t *testing.T) {
 			payload:     &MembershipEvent{},
 			messageType: "membership",
 		},
+		{
+			payload:     &MergeGroupEvent{},
+			messageType: "merge_group",
+		},
 		{
 			payload:     &MetaEvent{},
 			messageType: "meta",
@@ -342,6 +412,10 @@ func TestParseWebHook(t *testing.T) {
 			payload:     &ProjectColumnEvent{},
 			messageType: "project_column",
 		},
+		{
+			payload:     &ProjectV2StatusUpdateEvent{},
+			messageType: "projects_v2_status_update",
+		},
 		{
 			payload:     &PublicEvent{},
 			messageType: "public",
@@ -374,6 +448,10 @@ func TestParseWebHook(t *testing.T) {
 			payload:     &RepositoryVulnerabilityAlertEvent{},
 			messageType: "repository_vulnerability_alert",
 		},
+		{
+			payload:     &SecretScanningAlertLocationEvent{},
+			messageType: "secret_scanning_alert_location",
+		},
 		{
 			payload:     &StarEvent{},
 			messageType: "star",
@@ -382,6 +460,10 @@ func TestParseWebHook(t *testing.T) {
 			payload:     &StatusEvent{},
 			messageType: "status",
 		},
+		{
+			payload:     &SubIssuesEvent{},
+			messageType: "sub_issues",
+		},
 		{
 			payload:     &TeamEvent{},
 			messageType: "team",
@@ -433,6 +515,189 @@ func TestParseWebHook_BadMessageType(t *testing.T) {
 	}
 }
 
+func TestParseWebHookStrict(t *testing.T) {
+	payload := []byte(`{
+		"action": "opened",
+		"Number": 1,
+		"unknown_top": true,
+		"issue": {
+			"number": 1,
+			"unknown_issue": "x",
+			"labels": [{"name": "a", "unknown_label": 1}, {"name": "b", "unknown_label": 2}],
+			"reactions": null
+		},
+		"repository": {"id": 1, "permissions": {"admin": true}}
+	}`)
+
+	got, err := ParseWebHookStrict("issues", payload)
+	permissions := map[string]bool{"admin": true}
+	want := &IssuesEvent{
+		Action: String("opened"),
+		Issue: &Issue{
+			Number: Int(1),
+			Labels: []*Label{{Name: String("a")}, {Name: String("b")}},
+		},
+		Repo: &Repository{ID: Int64(1), Permissions: &permissions},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("ParseWebHookStrict returned %+v, want %+v", got, want)
+	}
+
+	wantErr := &UnknownFieldsError{
+		MessageType: "issues",
+		Fields:      []string{"Number", "issue.labels[].unknown_label", "issue.unknown_issue", "unknown_top"},
+	}
+	if !reflect.DeepEqual(err, wantErr) {
+		t.Errorf("ParseWebHookStrict returned error %v, want %v", err, wantErr)
+	}
+
+	if _, err := ParseWebHookStrict("issues", []byte(`{"action": "opened", "issue": {"number": 1}}`)); err != nil {
+		t.Errorf("ParseWebHookStrict with known fields returned error: %v", err)
+	}
+	if _, err := ParseWebHookStrict("bogus message type", []byte("{}")); err == nil {
+		t.Errorf("ParseWebHookStrict with bad message type returned no error")
+	}
+}
+
+func TestJSONFields_embedded(t *testing.T) {
+	type inner struct {
+		Name  *string `json:"name"`
+		Inner *int    `json:"inner"`
+	}
+	type middle struct {
+		inner
+		ID *int64 `json:"id"`
+	}
+	type outer struct {
+		ID   *string `json:"id"`
+		Name *bool   `json:"name"`
+		*middle
+	}
+
+	got := jsonFields(reflect.TypeOf(outer{}))
+	want := map[string]reflect.Type{
+		"id":    reflect.TypeOf((*string)(nil)),
+		"name":  reflect.TypeOf((*bool)(nil)),
+		"inner": reflect.TypeOf((*int)(nil)),
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("jsonFields returned %v, want %v", got, want)
+	}
+}
+
+func TestParseWebHookFromRequest(t *testing.T) {
+	const body = `{"action":"opened","issue":{"number":1}}`
+	secretKey := []byte("0123456789abcdef")
+	signature := "sha256=" + hex.EncodeToString(genMAC([]byte(body), secretKey, sha256.New))
+	form := url.Values{"payload": {body}}.Encode()
+	formSignature := "sha1=" + hex.EncodeToString(genMAC([]byte(form), secretKey, sha1.New))
+
+	tests := []struct {
+		name        string
+		body        string
+		contentType string
+		event       string
+		signature   string
+		opts        *ParseWebHookOptions
+		wantErr     error
+	}{
+		{
+			name:        "rotated secrets",
+			body:        body,
+			contentType: "application/json",
+			event:       "issues",
+			signature:   signature,
+			opts:        &ParseWebHookOptions{SecretTokens: [][]byte{[]byte("new"), secretKey}},
+		},
+		{
+			name:        "form",
+			body:        form,
+			contentType: "application/x-www-form-urlencoded",
+			event:       "issues",
+			signature:   formSignature,
+			opts:        &ParseWebHookOptions{SecretTokens: [][]byte{secretKey}},
+		},
+		{
+			name:        "no secret",
+			body:        body + "\n",
+			contentType: "application/json",
+			event:       "issues",
+		},
+		{
+			name:        "max body size",
+			body:        body,
+			contentType: "application/json",
+			event:       "issues",
+			signature:   signature,
+			opts:        &ParseWebHookOptions{SecretTokens: [][]byte{secretKey}, MaxBodySize: int64(len(body))},
+		},
+		{
+			name:        "body too large",
+			body:        body + " ",
+			contentType: "application/json",
+			event:       "issues",
+			opts:        &ParseWebHookOptions{MaxBodySize: int64(len(body))},
+			wantErr:     ErrWebHookBodyTooLarge,
+		},
+		{
+			name:        "bad signature",
+			body:        body,
+			contentType: "application/json",
+			event:       "issues",
+			signature:   signature,
+			opts:        &ParseWebHookOptions{SecretTokens: [][]byte{[]byte("new")}},
+			wantErr:     errors.New("payload signature check failed"),
+		},
+		{
+			name:        "trailing data",
+			body:        body + "{}",
+			contentType: "application/json",
+			event:       "issues",
+			wantErr:     errors.New("unexpected data after webhook payload"),
+		},
+		{
+			name:        "unknown event",
+			body:        body,
+			contentType: "application/json",
+			event:       "bogus",
+			wantErr:     errors.New("unknown X-Github-Event in message: bogus"),
+		},
+		{
+			name:        "bad content type",
+			body:        body,
+			contentType: "text/plain",
+			event:       "issues",
+			wantErr:     errors.New(`Webhook request has unsupported Content-Type "text/plain"`),
+		},
+	}
+
+	want := &IssuesEvent{Action: String("opened"), Issue: &Issue{Number: Int(1)}}
+	for _, test := range tests {
+		req, err := http.NewRequest("POST", "http://localhost/event", strings.NewReader(test.body))
+		if err != nil {
+			t.Fatalf("NewRequest: %v", err)
+		}
+		req.Header.Set("Content-Type", test.contentType)
+		req.Header.Set(eventTypeHeader, test.event)
+		req.Header.Set(signatureHeader, test.signature)
+
+		got, err := ParseWebHookFromRequest(req, test.opts)
+		if !reflect.DeepEqual(err, test.wantErr) {
+			t.Errorf("%v: ParseWebHookFromRequest returned error %v, want %v", test.name, err, test.wantErr)
+		}
+		if err == nil && !reflect.DeepEqual(got, want) {
+			t.Errorf("%v: ParseWebHookFromRequest returned %+v, want %+v", test.name, got, want)
+		}
+	}
+}
+
+func TestUnknownFieldsError_Error(t *testing.T) {
+	err := &UnknownFieldsError{MessageType: "push", Fields: []string{"a", "b.c"}}
+	if got, want := err.Error(), "push payload has unknown fields: a, b.c"; got != want {
+		t.Errorf("UnknownFieldsError.Error = %q, want %q", got, want)
+	}
+}
+
 func TestDeliveryID(t *testing.T) {
 	id := "8970a780-244e-11e7-91ca-da3aabcb9793"
 	req, err := http.NewRequest("POST", "http://localhost", nil)
@@ -9,7 +9,11 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"io"
+	"io/ioutil"
 	"net/http"
+	"os"
+	"path/filepath"
 )
 
 // UserMigration represents a GitHub migration (archival).
@@ -48,6 +52,28 @@ type UserMigrationOptions struct {
 	// ExcludeAttachments indicates whether attachments should be excluded from
 	// the migration (to reduce migration archive file size).
 	ExcludeAttachments bool
+
+	// ExcludeReleases indicates whether releases should be excluded from the
+	// migration (to reduce migration archive file size).
+	ExcludeReleases bool
+
+	// ExcludeGitData indicates whether the repositories git data should be
+	// excluded from the migration.
+	ExcludeGitData bool
+
+	// ExcludeMetadata indicates whether metadata, such as issues and pull
+	// requests, should be excluded from the migration. If true, only the
+	// git data of the repositories is migrated.
+	ExcludeMetadata bool
+
+	// ExcludeOwnerProjects indicates whether projects owned by the user
+	// should be excluded from the migration.
+	ExcludeOwnerProjects bool
+
+	// Exclude is a list of the related items to exclude from the
+	// repositories. The only possible value is "repositories", which
+	// excludes the repositories metadata.
+	Exclude []string
 }
 
 // startUserMigration represents the body of a StartMigration request.
@@ -62,6 +88,12 @@ type startUserMigration struct {
 	// ExcludeAttachments indicates whether attachments should be excluded from
 	// the migration (to reduce migration archive file size).
 	ExcludeAttachments *bool `json:"exclude_attachments,omitempty"`
+
+	ExcludeReleases      *bool    `json:"exclude_releases,omitempty"`
+	ExcludeGitData       *bool    `json:"exclude_git_data,omitempty"`
+	ExcludeMetadata      *bool    `json:"exclude_metadata,omitempty"`
+	ExcludeOwnerProjects *bool    `json:"exclude_owner_projects,omitempty"`
+	Exclude              []string `json:"exclude,omitempty"`
 }
 
 // StartUserMigration starts the generation of a migration archive.
@@ -75,6 +107,21 @@ func (s *MigrationService) StartUserMigration(ctx context.Context, repos []strin
 	if opts != nil {
 		body.LockRepositories = Bool(opts.LockRepositories)
 		body.ExcludeAttachments = Bool(opts.ExcludeAttachments)
+		// The other options are only sent when set, as older GitHub
+		// Enterprise Server versions do not support them.
+		if opts.ExcludeReleases {
+			body.ExcludeReleases = Bool(true)
+		}
+		if opts.ExcludeGitData {
+			body.ExcludeGitData = Bool(true)
+		}
+		if opts.ExcludeMetadata {
+			body.ExcludeMetadata = Bool(true)
+		}
+		if opts.ExcludeOwnerProjects {
+			body.ExcludeOwnerProjects = Bool(true)
+		}
+		body.Exclude = opts.Exclude
 	}
 
 	req, err := s.client.NewRequest("POST", u, body)
@@ -171,10 +218,83 @@ func (s *MigrationService) UserMigrationArchiveURL(ctx context.Context, id int64
 	if err == nil {
 		return "", errors.New("expected redirect, none provided")
 	}
+	if resp == nil || resp.Header.Get("Location") == "" {
+		return "", err
+	}
 	loc = resp.Header.Get("Location")
+	// The Location header can be relative to the request URL.
+	if l, err := req.URL.Parse(loc); err == nil {
+		loc = l.String()
+	}
 	return loc, nil
 }
 
+// DownloadUserMigrationArchive downloads a specific migration archive, and
+// writes it to w as it is downloaded. id is the migration ID. It returns the
+// number of bytes written.
+//
+// The archive is downloaded from the URL returned by
+// UserMigrationArchiveURL, with followRedirectsClient. The GitHub
+// credentials must not be sent to that URL, so followRedirectsClient must
+// not be the http.Client used by the GitHub Client. If nil,
+// http.DefaultClient is used.
+//
+// GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/migrations/#download-a-user-migration-archive
+func (s *MigrationService) DownloadUserMigrationArchive(ctx context.Context, id int64, followRedirectsClient *http.Client, w io.Writer) (int64, error) {
+	url, err := s.UserMigrationArchiveURL(ctx, id)
+	if err != nil {
+		return 0, err
+	}
+
+	if followRedirectsClient == nil {
+		followRedirectsClient = http.DefaultClient
+	}
+	req, err := http.NewRequest("GET", url, nil)
+	if err != nil {
+		return 0, err
+	}
+	req = withContext(ctx, req)
+	resp, err := followRedirectsClient.Do(req)
+	if err != nil {
+		return 0, err
+	}
+	defer resp.Body.Close()
+	if err := CheckResponse(resp); err != nil {
+		return 0, err
+	}
+
+	return io.Copy(w, resp.Body)
+}
+
+// SaveUserMigrationArchive downloads a specific migration archive to the
+// file at path, as DownloadUserMigrationArchive does. The archive is first
+// written to a temporary file in the same directory, which is renamed to
+// path once the download is complete, so that path never holds a partial
+// archive.
+//
+// GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/migrations/#download-a-user-migration-archive
+func (s *MigrationService) SaveUserMigrationArchive(ctx context.Context, id int64, followRedirectsClient *http.Client, path string) (n int64, err error) {
+	f, err := ioutil.TempFile(filepath.Dir(path), filepath.Base(path)+".*.tmp")
+	if err != nil {
+		return 0, err
+	}
+	defer func() {
+		if err != nil {
+			f.Close()
+			os.Remove(f.Name())
+		}
+	}()
+
+	n, err = s.DownloadUserMigrationArchive(ctx, id, followRedirectsClient, f)
+	if err != nil {
+		return n, err
+	}
+	if err = f.Close(); err != nil {
+		return n, err
+	}
+	return n, os.Rename(f.Name(), path)
+}
+
 // DeleteUserMigration will delete a previous migration archive.
 // id is the migration ID.
 //
@@ -6,9 +6,13 @@
 package github
 
 import (
+	"bytes"
 	"context"
 	"fmt"
+	"io/ioutil"
 	"net/http"
+	"os"
+	"path/filepath"
 	"reflect"
 	"strings"
 	"testing"
@@ -147,6 +151,150 @@ func TestMigrationService_UserMigrationArchiveURL(t *testing.T) {
 	}
 }
 
+func TestMigrationService_StartUserMigration_excludeOptions(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/user/migrations", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testBody(t, r, `{"repositories":["r"],"lock_repositories":false,"exclude_attachments":true,"exclude_releases":true,"exclude_git_data":true,"exclude_metadata":true,"exclude_owner_projects":true,"exclude":["repositories"]}`+"\n")
+
+		w.WriteHeader(http.StatusCreated)
+		w.Write(userMigrationJSON)
+	})
+
+	opt := &UserMigrationOptions{
+		ExcludeAttachments:   true,
+		ExcludeReleases:      true,
+		ExcludeGitData:       true,
+		ExcludeMetadata:      true,
+		ExcludeOwnerProjects: true,
+		Exclude:              []string{"repositories"},
+	}
+
+	ctx := context.Background()
+	if _, _, err := client.Migrations.StartUserMigration(ctx, []string{"r"}, opt); err != nil {
+		t.Errorf("StartUserMigration returned error: %v", err)
+	}
+}
+
+func TestMigrationService_UserMigrationArchiveURL_noRedirect(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/user/migrations/1/archive", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		http.Error(w, `{"message":"Not Found"}`, http.StatusNotFound)
+	})
+
+	ctx := context.Background()
+	got, err := client.Migrations.UserMigrationArchiveURL(ctx, 1)
+	if err == nil {
+		t.Error("UserMigrationArchiveURL returned nil error, want error")
+	}
+	if got != "" {
+		t.Errorf("UserMigrationArchiveURL = %v, want empty", got)
+	}
+}
+
+func TestMigrationService_DownloadUserMigrationArchive(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/user/migrations/1/archive", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		http.Redirect(w, r, baseURLPath+"/archive.tar.gz", http.StatusFound)
+	})
+	mux.HandleFunc("/archive.tar.gz", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		if got := r.Header.Get("Authorization"); got != "" {
+			t.Errorf("Authorization header = %v, want empty", got)
+		}
+		fmt.Fprint(w, "archive data")
+	})
+
+	ctx := context.Background()
+	var buf bytes.Buffer
+	n, err := client.Migrations.DownloadUserMigrationArchive(ctx, 1, nil, &buf)
+	if err != nil {
+		t.Errorf("DownloadUserMigrationArchive returned error: %v", err)
+	}
+	if want := "archive data"; buf.String() != want {
+		t.Errorf("DownloadUserMigrationArchive wrote %q, want %q", buf.String(), want)
+	}
+	if n != int64(buf.Len()) {
+		t.Errorf("DownloadUserMigrationArchive returned %v bytes, want %v", n, buf.Len())
+	}
+}
+
+func TestMigrationService_DownloadUserMigrationArchive_error(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/user/migrations/1/archive", func(w http.ResponseWriter, r *http.Request) {
+		http.Redirect(w, r, baseURLPath+"/archive.tar.gz", http.StatusFound)
+	})
+	mux.HandleFunc("/archive.tar.gz", func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, `{"message":"Gone"}`, http.StatusGone)
+	})
+
+	ctx := context.Background()
+	var buf bytes.Buffer
+	if _, err := client.Migrations.DownloadUserMigrationArchive(ctx, 1, nil, &buf); err == nil {
+		t.Error("DownloadUserMigrationArchive returned nil error, want error")
+	}
+	if buf.Len() != 0 {
+		t.Errorf("DownloadUserMigrationArchive wrote %q, want nothing", buf.String())
+	}
+}
+
+func TestMigrationService_SaveUserMigrationArchive(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	status := http.StatusOK
+	mux.HandleFunc("/user/migrations/1/archive", func(w http.ResponseWriter, r *http.Request) {
+		http.Redirect(w, r, baseURLPath+"/archive.tar.gz", http.StatusFound)
+	})
+	mux.HandleFunc("/archive.tar.gz", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(status)
+		fmt.Fprint(w, "archive data")
+	})
+
+	dir, err := ioutil.TempDir("", "go-github-migration")
+	if err != nil {
+		t.Fatalf("ioutil.TempDir returned error: %v", err)
+	}
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "archive.tar.gz")
+
+	ctx := context.Background()
+	if _, err := client.Migrations.SaveUserMigrationArchive(ctx, 1, nil, path); err != nil {
+		t.Errorf("SaveUserMigrationArchive returned error: %v", err)
+	}
+	got, err := ioutil.ReadFile(path)
+	if err != nil {
+		t.Fatalf("ioutil.ReadFile returned error: %v", err)
+	}
+	if want := "archive data"; string(got) != want {
+		t.Errorf("SaveUserMigrationArchive saved %q, want %q", got, want)
+	}
+
+	// A failed download leaves neither a new nor a temporary file behind.
+	status = http.StatusNotFound
+	failed := filepath.Join(dir, "failed.tar.gz")
+	if _, err := client.Migrations.SaveUserMigrationArchive(ctx, 1, nil, failed); err == nil {
+		t.Error("SaveUserMigrationArchive returned nil error, want error")
+	}
+	files, err := ioutil.ReadDir(dir)
+	if err != nil {
+		t.Fatalf("ioutil.ReadDir returned error: %v", err)
+	}
+	if len(files) != 1 {
+		t.Errorf("SaveUserMigrationArchive left %v files, want 1", len(files))
+	}
+}
+
 func TestMigrationService_DeleteUserMigration(t *testing.T) {
 	client, mux, _, teardown := setup()
 	defer teardown()
@@ -170,6 +170,10 @@ type APIMeta struct {
 	// An array of IP addresses in CIDR format specifying the IP addresses
 	// Dependabot will originate from.
 	Dependabot []string `json:"dependabot,omitempty"`
+
+	// The version of GitHub Enterprise Server, such as "3.0.1". It is only
+	// returned by GitHub Enterprise Server installations.
+	InstalledVersion *string `json:"installed_version,omitempty"`
 }
 
 // APIMeta returns information about GitHub.com, the service. Or, if you access
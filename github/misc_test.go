@@ -187,6 +187,7 @@ func TestAPIMeta_marshal(t *testing.T) {
 		Importer:                         []string{"i"},
 		Actions:                          []string{"a"},
 		Dependabot:                       []string{"d"},
+		InstalledVersion:                 String("3.0.1"),
 	}
 	want := `{
 		"hooks":["h"],
@@ -195,7 +196,8 @@ func TestAPIMeta_marshal(t *testing.T) {
 		"pages":["p"],
 		"importer":["i"],
 		"actions":["a"],
-		"dependabot":["d"]
+		"dependabot":["d"],
+		"installed_version":"3.0.1"
 	}`
 
 	testJSONMarshal(t, a, want)
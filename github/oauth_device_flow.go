@@ -0,0 +1,207 @@
+// Copyright 2021 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+const (
+	deviceGrantType = "urn:ietf:params:oauth:grant-type:device_code"
+
+	// minDeviceFlowInterval is the minimum polling interval, which is the
+	// default interval of RFC 8628, used when GitHub sends a shorter one.
+	minDeviceFlowInterval = 5 * time.Second
+
+	// slowDownIncrement is the increment of the polling interval after a
+	// slow_down error, when GitHub does not send the new interval.
+	slowDownIncrement = 5 * time.Second
+)
+
+// DeviceFlow authenticates a user with the OAuth device authorization flow,
+// for applications without a browser or a web server, such as CLI tools:
+//
+//	flow := &github.DeviceFlow{ClientID: "your app's client ID", Scopes: []github.Scope{github.ScopeRepo}}
+//	client, _, err := flow.Authenticate(ctx, func(code *github.DeviceCode) error {
+//		fmt.Printf("Open %v and enter the code %v\n", code.VerificationURI, code.UserCode)
+//		return nil
+//	})
+//
+// The device flow must be enabled in the settings of the OAuth App or GitHub
+// App.
+//
+// GitHub docs: https://docs.github.com/en/free-pro-team@latest/developers/apps/authorizing-oauth-apps#device-flow
+type DeviceFlow struct {
+	// ClientID is the client ID of the OAuth App or GitHub App.
+	ClientID string
+
+	// Scopes are the scopes requested for OAuth Apps. They are ignored by
+	// GitHub Apps.
+	Scopes []Scope
+
+	// BaseURL is the URL of the GitHub web server. It defaults to
+	// https://github.com/. BaseURL should always be specified with a
+	// trailing slash.
+	BaseURL *url.URL
+
+	// HTTPClient is the HTTP client used for the requests of the flow, and
+	// whose transport is used by the returned Client. It defaults to
+	// http.DefaultClient.
+	HTTPClient *http.Client
+
+	// Wait waits for the duration d between two polling requests, or until
+	// ctx is done, in which case it returns the error of ctx. It defaults to
+	// sleeping for d.
+	Wait func(ctx context.Context, d time.Duration) error
+}
+
+// DeviceCode is the device and user verification codes returned by
+// DeviceFlow.RequestCode.
+type DeviceCode struct {
+	// DeviceCode identifies the device when polling for the token.
+	DeviceCode string `json:"device_code"`
+	// UserCode is the code to be entered by the user at VerificationURI.
+	UserCode        string `json:"user_code"`
+	VerificationURI string `json:"verification_uri"`
+	// ExpiresIn is the number of seconds before the codes expire.
+	ExpiresIn int `json:"expires_in"`
+	// Interval is the minimum number of seconds between polling requests.
+	Interval int `json:"interval"`
+}
+
+// RequestCode requests the device and user verification codes which start
+// the device flow.
+func (f *DeviceFlow) RequestCode(ctx context.Context) (*DeviceCode, error) {
+	form := url.Values{"client_id": {f.ClientID}}
+	if len(f.Scopes) > 0 {
+		scopes := make([]string, len(f.Scopes))
+		for i, s := range f.Scopes {
+			scopes[i] = string(s)
+		}
+		form.Set("scope", strings.Join(scopes, " "))
+	}
+
+	var result struct {
+		DeviceCode
+		OAuthError
+	}
+	if err := f.post(ctx, "login/device/code", form, &result); err != nil {
+		return nil, err
+	}
+	if result.Code != "" {
+		err := result.OAuthError
+		return nil, &err
+	}
+	code := result.DeviceCode
+	return &code, nil
+}
+
+// PollToken polls GitHub for the access token until the user enters the user
+// code, respecting the polling interval, which is at least 5 seconds, and
+// the slow_down errors. It returns an *OAuthError if the user denies the
+// authorization or the codes expire.
+func (f *DeviceFlow) PollToken(ctx context.Context, code *DeviceCode) (*OAuthToken, error) {
+	interval := deviceFlowInterval(time.Duration(code.Interval) * time.Second)
+	expiresIn := time.Duration(code.ExpiresIn) * time.Second
+	var waited time.Duration
+
+	form := url.Values{
+		"client_id":   {f.ClientID},
+		"device_code": {code.DeviceCode},
+		"grant_type":  {deviceGrantType},
+	}
+	for {
+		if expiresIn > 0 && waited+interval > expiresIn {
+			return nil, &OAuthError{Code: "expired_token", Description: "The device code has expired."}
+		}
+		if err := f.wait(ctx, interval); err != nil {
+			return nil, err
+		}
+		waited += interval
+
+		var result struct {
+			OAuthToken
+			OAuthError
+			Interval int `json:"interval"`
+		}
+		if err := f.post(ctx, "login/oauth/access_token", form, &result); err != nil {
+			return nil, err
+		}
+		switch result.Code {
+		case "":
+			token := result.OAuthToken
+			token.setExpiry(time.Now())
+			return &token, nil
+		case "authorization_pending":
+		case "slow_down":
+			if result.Interval > 0 {
+				interval = deviceFlowInterval(time.Duration(result.Interval) * time.Second)
+			} else {
+				interval += slowDownIncrement
+			}
+		default:
+			err := result.OAuthError
+			return nil, &err
+		}
+	}
+}
+
+// Authenticate runs the whole device flow. It requests the verification
+// codes, calls prompt so that the user can be asked to enter the user code at
+// the verification URI, and polls for the access token. It returns a Client
+// authenticated with the token, along with the token itself so that it can
+// be stored.
+//
+// The returned Client uses the public GitHub API. Use NewEnterpriseClient
+// with a TokenSourceTransport for GitHub Enterprise Server.
+func (f *DeviceFlow) Authenticate(ctx context.Context, prompt func(*DeviceCode) error) (*Client, *OAuthToken, error) {
+	code, err := f.RequestCode(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+	if err := prompt(code); err != nil {
+		return nil, nil, err
+	}
+	token, err := f.PollToken(ctx, code)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	tp := &TokenSourceTransport{Source: StaticTokenSource(token), Transport: f.httpClient().Transport}
+	return NewClient(tp.Client()), token, nil
+}
+
+// deviceFlowInterval returns the polling interval d, clamped to at least
+// minDeviceFlowInterval.
+func deviceFlowInterval(d time.Duration) time.Duration {
+	if d < minDeviceFlowInterval {
+		return minDeviceFlowInterval
+	}
+	return d
+}
+
+func (f *DeviceFlow) wait(ctx context.Context, d time.Duration) error {
+	if f.Wait != nil {
+		return f.Wait(ctx, d)
+	}
+	return sleepUntil(ctx, time.Now().Add(d))
+}
+
+func (f *DeviceFlow) httpClient() *http.Client {
+	if f.HTTPClient != nil {
+		return f.HTTPClient
+	}
+	return http.DefaultClient
+}
+
+// post posts form to the OAuth endpoint at path of the GitHub web server.
+func (f *DeviceFlow) post(ctx context.Context, path string, form url.Values, v interface{}) error {
+	return postOAuth(ctx, f.httpClient(), f.BaseURL, path, form, v)
+}
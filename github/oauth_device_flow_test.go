@@ -0,0 +1,198 @@
+// Copyright 2021 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"reflect"
+	"testing"
+	"time"
+)
+
+// setupDeviceFlow returns a DeviceFlow using a test server, which records
+// the polling intervals in waits instead of waiting.
+func setupDeviceFlow(t *testing.T) (flow *DeviceFlow, mux *http.ServeMux, waits *[]time.Duration, teardown func()) {
+	mux = http.NewServeMux()
+	server := httptest.NewServer(mux)
+	baseURL, _ := url.Parse(server.URL + "/")
+
+	waits = new([]time.Duration)
+	flow = &DeviceFlow{
+		ClientID: "cid",
+		Scopes:   []Scope{ScopeRepo, ScopeReadOrg},
+		BaseURL:  baseURL,
+		Wait: func(ctx context.Context, d time.Duration) error {
+			*waits = append(*waits, d)
+			return ctx.Err()
+		},
+	}
+	return flow, mux, waits, server.Close
+}
+
+func TestDeviceFlow_Authenticate(t *testing.T) {
+	flow, mux, waits, teardown := setupDeviceFlow(t)
+	defer teardown()
+
+	mux.HandleFunc("/login/device/code", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testHeader(t, r, "Accept", "application/json")
+		testFormValues(t, r, values{"client_id": "cid", "scope": "repo read:org"})
+		fmt.Fprint(w, `{"device_code":"dc","user_code":"UC","verification_uri":"https://github.com/login/device","expires_in":900,"interval":1}`)
+	})
+
+	var polls int
+	mux.HandleFunc("/login/oauth/access_token", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testFormValues(t, r, values{
+			"client_id":   "cid",
+			"device_code": "dc",
+			"grant_type":  "urn:ietf:params:oauth:grant-type:device_code",
+		})
+		polls++
+		switch polls {
+		case 1:
+			fmt.Fprint(w, `{"error":"authorization_pending"}`)
+		case 2:
+			fmt.Fprint(w, `{"error":"slow_down","interval":20}`)
+		default:
+			fmt.Fprint(w, `{"access_token":"t","token_type":"bearer","scope":"repo,read:org"}`)
+		}
+	})
+	mux.HandleFunc("/user", func(w http.ResponseWriter, r *http.Request) {
+		testHeader(t, r, "Authorization", "token t")
+		fmt.Fprint(w, `{"login":"l"}`)
+	})
+
+	ctx := context.Background()
+	var prompted *DeviceCode
+	client, token, err := flow.Authenticate(ctx, func(code *DeviceCode) error {
+		prompted = code
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("DeviceFlow.Authenticate returned error: %v", err)
+	}
+
+	wantCode := &DeviceCode{DeviceCode: "dc", UserCode: "UC", VerificationURI: "https://github.com/login/device", ExpiresIn: 900, Interval: 1}
+	if !reflect.DeepEqual(prompted, wantCode) {
+		t.Errorf("DeviceFlow.Authenticate prompted with %+v, want %+v", prompted, wantCode)
+	}
+	wantToken := &OAuthToken{AccessToken: "t", TokenType: "bearer", Scope: "repo,read:org"}
+	if !reflect.DeepEqual(token, wantToken) {
+		t.Errorf("DeviceFlow.Authenticate returned token %+v, want %+v", token, wantToken)
+	}
+	if polls != 3 {
+		t.Fatalf("DeviceFlow.Authenticate polled %v times, want 3", polls)
+	}
+	// The interval of 1s is raised to the minimum of 5s.
+	wantWaits := []time.Duration{5 * time.Second, 5 * time.Second, 20 * time.Second}
+	if !reflect.DeepEqual(*waits, wantWaits) {
+		t.Errorf("DeviceFlow.Authenticate waited %v, want %v", *waits, wantWaits)
+	}
+
+	client.BaseURL = flow.BaseURL
+	user, _, err := client.Users.Get(ctx, "")
+	if err != nil {
+		t.Fatalf("Users.Get returned error: %v", err)
+	}
+	if user.GetLogin() != "l" {
+		t.Errorf("Users.Get returned %+v, want login l", user)
+	}
+}
+
+func TestDeviceFlow_RequestCode_error(t *testing.T) {
+	flow, mux, _, teardown := setupDeviceFlow(t)
+	defer teardown()
+
+	mux.HandleFunc("/login/device/code", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"error":"device_flow_disabled","error_description":"Device Flow must be explicitly enabled for this App"}`)
+	})
+
+	_, err := flow.RequestCode(context.Background())
+	want := &OAuthError{Code: "device_flow_disabled", Description: "Device Flow must be explicitly enabled for this App"}
+	if !reflect.DeepEqual(err, want) {
+		t.Errorf("DeviceFlow.RequestCode returned error %#v, want %#v", err, want)
+	}
+	if got, want := err.Error(), "oauth: device_flow_disabled: Device Flow must be explicitly enabled for this App"; got != want {
+		t.Errorf("OAuthError.Error returned %q, want %q", got, want)
+	}
+}
+
+func TestDeviceFlow_PollToken_errors(t *testing.T) {
+	flow, mux, _, teardown := setupDeviceFlow(t)
+	defer teardown()
+
+	mux.HandleFunc("/login/oauth/access_token", func(w http.ResponseWriter, r *http.Request) {
+		switch r.FormValue("device_code") {
+		case "denied":
+			fmt.Fprint(w, `{"error":"access_denied"}`)
+		case "bad":
+			w.WriteHeader(http.StatusBadRequest)
+			fmt.Fprint(w, `{"error":"incorrect_client_credentials"}`)
+		case "server":
+			w.WriteHeader(http.StatusInternalServerError)
+		default:
+			fmt.Fprint(w, `{"error":"authorization_pending"}`)
+		}
+	})
+
+	ctx := context.Background()
+	for _, tt := range []struct {
+		code     *DeviceCode
+		wantCode string
+	}{
+		{&DeviceCode{DeviceCode: "denied"}, "access_denied"},
+		{&DeviceCode{DeviceCode: "bad"}, "incorrect_client_credentials"},
+		{&DeviceCode{DeviceCode: "pending", ExpiresIn: 10, Interval: 1}, "expired_token"},
+	} {
+		_, err := flow.PollToken(ctx, tt.code)
+		if oauthErr, ok := err.(*OAuthError); !ok || oauthErr.Code != tt.wantCode {
+			t.Errorf("DeviceFlow.PollToken(%v) returned error %v, want OAuth error %v", tt.code.DeviceCode, err, tt.wantCode)
+		}
+	}
+
+	if _, err := flow.PollToken(ctx, &DeviceCode{DeviceCode: "server"}); err == nil {
+		t.Errorf("DeviceFlow.PollToken returned no error for a server error")
+	}
+
+	canceled, cancel := context.WithCancel(ctx)
+	cancel()
+	if _, err := flow.PollToken(canceled, &DeviceCode{DeviceCode: "pending", Interval: 1}); err != context.Canceled {
+		t.Errorf("DeviceFlow.PollToken returned error %v, want %v", err, context.Canceled)
+	}
+
+	flow.BaseURL, _ = url.Parse("https://github.com")
+	if _, err := flow.RequestCode(ctx); err == nil {
+		t.Errorf("DeviceFlow.RequestCode returned no error for a BaseURL without trailing slash")
+	}
+}
+
+func TestDeviceFlow_PollToken_slowDownIncrement(t *testing.T) {
+	flow, mux, waits, teardown := setupDeviceFlow(t)
+	defer teardown()
+
+	var polls int
+	mux.HandleFunc("/login/oauth/access_token", func(w http.ResponseWriter, r *http.Request) {
+		polls++
+		if polls == 1 {
+			fmt.Fprint(w, `{"error":"slow_down"}`)
+			return
+		}
+		fmt.Fprint(w, `{"access_token":"t"}`)
+	})
+
+	if _, err := flow.PollToken(context.Background(), &DeviceCode{DeviceCode: "dc", Interval: 6}); err != nil {
+		t.Fatalf("DeviceFlow.PollToken returned error: %v", err)
+	}
+	wantWaits := []time.Duration{6 * time.Second, 11 * time.Second}
+	if !reflect.DeepEqual(*waits, wantWaits) {
+		t.Errorf("DeviceFlow.PollToken waited %v, want %v", *waits, wantWaits)
+	}
+}
@@ -0,0 +1,289 @@
+// Copyright 2021 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	defaultWebURL = "https://github.com/"
+
+	refreshGrantType = "refresh_token"
+
+	// tokenExpiryDelta is how early before their expiry the tokens are
+	// considered expired, to avoid using them while they expire.
+	tokenExpiryDelta = time.Minute
+)
+
+// OAuthToken is an OAuth access token returned by GitHub.
+type OAuthToken struct {
+	AccessToken string `json:"access_token"`
+	TokenType   string `json:"token_type"`
+	// Scope is the comma separated list of the scopes granted to the token.
+	Scope string `json:"scope"`
+
+	// ExpiresIn, RefreshToken and RefreshTokenExpiresIn are only set for
+	// the expiring user-to-server tokens of GitHub Apps. They are in
+	// seconds, from the time when the token was returned.
+	ExpiresIn             int    `json:"expires_in,omitempty"`
+	RefreshToken          string `json:"refresh_token,omitempty"`
+	RefreshTokenExpiresIn int    `json:"refresh_token_expires_in,omitempty"`
+
+	// Expiry and RefreshTokenExpiry are the expiry times of the token and of
+	// its refresh token, computed from ExpiresIn and RefreshTokenExpiresIn
+	// when the token is returned. They are nil if the tokens do not expire.
+	Expiry             *Timestamp `json:"expiry,omitempty"`
+	RefreshTokenExpiry *Timestamp `json:"refresh_token_expiry,omitempty"`
+}
+
+// setExpiry sets the expiry times of t, returned at now.
+func (t *OAuthToken) setExpiry(now time.Time) {
+	if t.ExpiresIn > 0 {
+		t.Expiry = &Timestamp{now.Add(time.Duration(t.ExpiresIn) * time.Second)}
+	}
+	if t.RefreshTokenExpiresIn > 0 {
+		t.RefreshTokenExpiry = &Timestamp{now.Add(time.Duration(t.RefreshTokenExpiresIn) * time.Second)}
+	}
+}
+
+// Valid reports whether t has an access token which does not expire within
+// the next minute.
+func (t *OAuthToken) Valid() bool {
+	if t == nil || t.AccessToken == "" {
+		return false
+	}
+	return t.Expiry == nil || time.Now().Add(tokenExpiryDelta).Before(t.Expiry.Time)
+}
+
+// OAuthError is an error returned by the GitHub OAuth endpoints, such as
+// "access_denied" or "expired_token".
+//
+// GitHub docs: https://docs.github.com/en/free-pro-team@latest/developers/apps/authorizing-oauth-apps#error-codes-for-the-device-flow
+type OAuthError struct {
+	Code        string `json:"error"`
+	Description string `json:"error_description"`
+	URI         string `json:"error_uri"`
+}
+
+func (e *OAuthError) Error() string {
+	if e.Description == "" {
+		return "oauth: " + e.Code
+	}
+	return fmt.Sprintf("oauth: %v: %v", e.Code, e.Description)
+}
+
+// TokenSource is the source of the OAuth tokens used by
+// TokenSourceTransport. Token returns a valid token, renewing it if
+// needed. It must be safe for concurrent use.
+type TokenSource interface {
+	Token(ctx context.Context) (*OAuthToken, error)
+}
+
+type staticTokenSource struct {
+	token *OAuthToken
+}
+
+// StaticTokenSource returns a TokenSource which always returns token.
+func StaticTokenSource(token *OAuthToken) TokenSource {
+	return staticTokenSource{token}
+}
+
+func (s staticTokenSource) Token(ctx context.Context) (*OAuthToken, error) {
+	return s.token, nil
+}
+
+// RefreshTokenSource is a TokenSource which renews an expiring
+// user-to-server token of a GitHub App with its refresh token, once it
+// expires.
+//
+// Each refresh token can only be used once, so the renewed tokens should be
+// stored with OnRefresh to be reused later.
+//
+// GitHub docs: https://docs.github.com/en/free-pro-team@latest/developers/apps/refreshing-user-to-server-access-tokens
+type RefreshTokenSource struct {
+	// ClientID and ClientSecret are the client ID and secret of the
+	// GitHub App.
+	ClientID     string
+	ClientSecret string
+
+	// BaseURL is the URL of the GitHub web server. It defaults to
+	// https://github.com/. BaseURL should always be specified with a
+	// trailing slash.
+	BaseURL *url.URL
+
+	// HTTPClient is the HTTP client used to refresh the tokens. It defaults
+	// to http.DefaultClient.
+	HTTPClient *http.Client
+
+	// OnRefresh, if not nil, is called with each renewed token.
+	OnRefresh func(*OAuthToken)
+
+	mu    sync.Mutex
+	token *OAuthToken
+}
+
+// NewRefreshTokenSource returns a RefreshTokenSource which returns token
+// until it expires, for the GitHub App with the given client ID and secret.
+func NewRefreshTokenSource(clientID, clientSecret string, token *OAuthToken) *RefreshTokenSource {
+	return &RefreshTokenSource{ClientID: clientID, ClientSecret: clientSecret, token: token}
+}
+
+// Token returns the current token, renewing it first if it has expired.
+func (s *RefreshTokenSource) Token(ctx context.Context) (*OAuthToken, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.token.Valid() {
+		return s.token, nil
+	}
+	return s.refresh(ctx)
+}
+
+// Refresh renews the current token, even if it has not expired.
+func (s *RefreshTokenSource) Refresh(ctx context.Context) (*OAuthToken, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.refresh(ctx)
+}
+
+func (s *RefreshTokenSource) refresh(ctx context.Context) (*OAuthToken, error) {
+	if s.token == nil || s.token.RefreshToken == "" {
+		return nil, errors.New("token expired and has no refresh token")
+	}
+	if s.token.RefreshTokenExpiry != nil && !time.Now().Before(s.token.RefreshTokenExpiry.Time) {
+		return nil, errors.New("refresh token expired")
+	}
+
+	form := url.Values{
+		"client_id":     {s.ClientID},
+		"client_secret": {s.ClientSecret},
+		"grant_type":    {refreshGrantType},
+		"refresh_token": {s.token.RefreshToken},
+	}
+	client := s.HTTPClient
+	if client == nil {
+		client = http.DefaultClient
+	}
+	var result struct {
+		OAuthToken
+		OAuthError
+	}
+	now := time.Now()
+	if err := postOAuth(ctx, client, s.BaseURL, "login/oauth/access_token", form, &result); err != nil {
+		return nil, err
+	}
+	if result.Code != "" {
+		err := result.OAuthError
+		return nil, &err
+	}
+
+	token := result.OAuthToken
+	token.setExpiry(now)
+	s.token = &token
+	if s.OnRefresh != nil {
+		s.OnRefresh(s.token)
+	}
+	return s.token, nil
+}
+
+// TokenSourceTransport is an http.RoundTripper that authenticates all requests
+// with the OAuth tokens returned by Source. Source may be a
+// StaticTokenSource, for a personal access token or a token returned by
+// DeviceFlow, or a RefreshTokenSource, so that the tokens are renewed
+// automatically.
+type TokenSourceTransport struct {
+	Source TokenSource
+
+	// Transport is the underlying HTTP transport to use when making requests.
+	// It will default to http.DefaultTransport if nil.
+	Transport http.RoundTripper
+}
+
+// RoundTrip implements the RoundTripper interface.
+func (t *TokenSourceTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if t.Source == nil {
+		return nil, errors.New("t.Source is nil")
+	}
+	token, err := t.Source.Token(req.Context())
+	if err != nil {
+		return nil, err
+	}
+
+	// To set the Authorization header, we must make a copy of the Request so
+	// that we don't modify the Request we were given. This is required by the
+	// specification of http.RoundTripper.
+	req2 := req.Clone(req.Context())
+	req2.Header.Set("Authorization", "token "+token.AccessToken)
+	return t.transport().RoundTrip(req2)
+}
+
+// Client returns an *http.Client that makes requests that are authenticated
+// with the OAuth tokens returned by Source.
+func (t *TokenSourceTransport) Client() *http.Client {
+	return &http.Client{Transport: t}
+}
+
+func (t *TokenSourceTransport) transport() http.RoundTripper {
+	if t.Transport != nil {
+		return t.Transport
+	}
+	return http.DefaultTransport
+}
+
+// postOAuth posts form to the OAuth endpoint at path of the GitHub web server
+// at baseURL, or https://github.com/ if nil, and decodes its JSON response into
+// v. An OAuth error without a successful status is returned as an *OAuthError.
+func postOAuth(ctx context.Context, client *http.Client, baseURL *url.URL, path string, form url.Values, v interface{}) error {
+	if baseURL == nil {
+		baseURL, _ = url.Parse(defaultWebURL)
+	}
+	if !strings.HasSuffix(baseURL.Path, "/") {
+		return fmt.Errorf("BaseURL must have a trailing slash, but %q does not", baseURL)
+	}
+	u, err := baseURL.Parse(path)
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequest("POST", u.String(), strings.NewReader(form.Encode()))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.Header.Set("Accept", "application/json")
+	req.Header.Set("User-Agent", userAgent)
+
+	resp, err := client.Do(req.WithContext(ctx))
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	data, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return err
+	}
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		oauthErr := new(OAuthError)
+		if json.Unmarshal(data, oauthErr) == nil && oauthErr.Code != "" {
+			return oauthErr
+		}
+		return fmt.Errorf("POST %v: %v", u, resp.Status)
+	}
+	if err := json.Unmarshal(data, v); err != nil {
+		return err
+	}
+	return nil
+}
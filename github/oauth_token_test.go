@@ -0,0 +1,210 @@
+// Copyright 2021 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+)
+
+func TestOAuthToken_Valid(t *testing.T) {
+	for _, tt := range []struct {
+		name  string
+		token *OAuthToken
+		want  bool
+	}{
+		{"nil", nil, false},
+		{"empty", &OAuthToken{}, false},
+		{"no expiry", &OAuthToken{AccessToken: "t"}, true},
+		{"expired", &OAuthToken{AccessToken: "t", Expiry: &Timestamp{time.Now().Add(-time.Hour)}}, false},
+		{"expiring", &OAuthToken{AccessToken: "t", Expiry: &Timestamp{time.Now().Add(10 * time.Second)}}, false},
+		{"valid", &OAuthToken{AccessToken: "t", Expiry: &Timestamp{time.Now().Add(time.Hour)}}, true},
+	} {
+		if got := tt.token.Valid(); got != tt.want {
+			t.Errorf("%v: Valid returned %v, want %v", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestOAuthToken_Marshal(t *testing.T) {
+	u := &OAuthToken{
+		AccessToken:           "t",
+		TokenType:             "bearer",
+		Scope:                 "",
+		ExpiresIn:             28800,
+		RefreshToken:          "r",
+		RefreshTokenExpiresIn: 15811200,
+		Expiry:                &Timestamp{referenceTime},
+		RefreshTokenExpiry:    &Timestamp{referenceTime},
+	}
+
+	want := `{
+		"access_token": "t",
+		"token_type": "bearer",
+		"scope": "",
+		"expires_in": 28800,
+		"refresh_token": "r",
+		"refresh_token_expires_in": 15811200,
+		"expiry": ` + referenceTimeStr + `,
+		"refresh_token_expiry": ` + referenceTimeStr + `
+	}`
+
+	testJSONMarshal(t, u, want)
+}
+
+func TestRefreshTokenSource(t *testing.T) {
+	mux := http.NewServeMux()
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	refreshes := 0
+	mux.HandleFunc("/login/oauth/access_token", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testHeader(t, r, "Accept", "application/json")
+		refreshes++
+		testFormValues(t, r, values{
+			"client_id":     "cid",
+			"client_secret": "cs",
+			"grant_type":    "refresh_token",
+			"refresh_token": fmt.Sprintf("r%v", refreshes-1),
+		})
+		fmt.Fprintf(w, `{"access_token":"t%v","token_type":"bearer","scope":"","expires_in":28800,"refresh_token":"r%v","refresh_token_expires_in":15811200}`, refreshes, refreshes)
+	})
+	mux.HandleFunc("/user", func(w http.ResponseWriter, r *http.Request) {
+		testHeader(t, r, "Authorization", "token t1")
+		fmt.Fprint(w, `{"login":"l"}`)
+	})
+
+	ctx := context.Background()
+	baseURL, _ := url.Parse(server.URL + "/")
+	expired := &OAuthToken{AccessToken: "t0", RefreshToken: "r0", Expiry: &Timestamp{time.Now().Add(-time.Minute)}}
+	ts := NewRefreshTokenSource("cid", "cs", expired)
+	ts.BaseURL = baseURL
+	var stored []*OAuthToken
+	ts.OnRefresh = func(token *OAuthToken) { stored = append(stored, token) }
+
+	client := NewClient((&TokenSourceTransport{Source: ts}).Client())
+	client.BaseURL = baseURL
+	for i := 0; i < 2; i++ {
+		user, _, err := client.Users.Get(ctx, "")
+		if err != nil {
+			t.Fatalf("Users.Get returned error: %v", err)
+		}
+		if user.GetLogin() != "l" {
+			t.Errorf("Users.Get returned %+v, want login l", user)
+		}
+	}
+	if refreshes != 1 || len(stored) != 1 {
+		t.Fatalf("RefreshTokenSource refreshed %v times and stored %v tokens, want 1 and 1", refreshes, len(stored))
+	}
+	token := stored[0]
+	if token.AccessToken != "t1" || token.RefreshToken != "r1" {
+		t.Errorf("RefreshTokenSource stored %+v, want access token t1 and refresh token r1", token)
+	}
+	if d := time.Until(token.GetExpiry().Time); d < 7*time.Hour || d > 8*time.Hour {
+		t.Errorf("RefreshTokenSource token expires in %v, want 8h", d)
+	}
+	if d := time.Until(token.GetRefreshTokenExpiry().Time); d < 182*24*time.Hour || d > 183*24*time.Hour {
+		t.Errorf("RefreshTokenSource refresh token expires in %v, want 183 days", d)
+	}
+
+	token, err := ts.Refresh(ctx)
+	if err != nil {
+		t.Fatalf("RefreshTokenSource.Refresh returned error: %v", err)
+	}
+	if token.AccessToken != "t2" || refreshes != 2 {
+		t.Errorf("RefreshTokenSource.Refresh returned %+v after %v refreshes, want access token t2 after 2", token, refreshes)
+	}
+}
+
+func TestRefreshTokenSource_errors(t *testing.T) {
+	mux := http.NewServeMux()
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	mux.HandleFunc("/login/oauth/access_token", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"error":"bad_refresh_token","error_description":"The refresh token passed is incorrect or expired."}`)
+	})
+
+	ctx := context.Background()
+	baseURL, _ := url.Parse(server.URL + "/")
+	expiry := &Timestamp{time.Now().Add(-time.Minute)}
+	for name, token := range map[string]*OAuthToken{
+		"nil token":             nil,
+		"no refresh token":      {AccessToken: "t", Expiry: expiry},
+		"expired refresh token": {AccessToken: "t", Expiry: expiry, RefreshToken: "r", RefreshTokenExpiry: expiry},
+	} {
+		ts := NewRefreshTokenSource("cid", "cs", token)
+		ts.BaseURL = baseURL
+		if _, err := ts.Token(ctx); err == nil {
+			t.Errorf("RefreshTokenSource.Token with %v returned no error", name)
+		}
+	}
+
+	ts := NewRefreshTokenSource("cid", "cs", &OAuthToken{AccessToken: "t", Expiry: expiry, RefreshToken: "r"})
+	ts.BaseURL = baseURL
+	_, err := ts.Token(ctx)
+	if oauthErr, ok := err.(*OAuthError); !ok || oauthErr.Code != "bad_refresh_token" {
+		t.Errorf("RefreshTokenSource.Token returned error %v, want OAuth error bad_refresh_token", err)
+	}
+
+	if _, err := (&TokenSourceTransport{}).RoundTrip(&http.Request{}); err == nil {
+		t.Errorf("TokenSourceTransport without Source returned no error")
+	}
+	tp := &TokenSourceTransport{Source: ts}
+	req, _ := http.NewRequest("GET", server.URL, nil)
+	if _, err := tp.RoundTrip(req); err == nil {
+		t.Errorf("TokenSourceTransport with failing Source returned no error")
+	}
+}
+
+func TestStaticTokenSource(t *testing.T) {
+	token := &OAuthToken{AccessToken: "t"}
+	got, err := StaticTokenSource(token).Token(context.Background())
+	if err != nil || got != token {
+		t.Errorf("StaticTokenSource.Token returned %+v, %v, want %+v", got, err, token)
+	}
+}
+
+func TestTokenSourceTransport_staticToken(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		testHeader(t, r, "Authorization", "token t")
+	})
+
+	tp := &TokenSourceTransport{Source: StaticTokenSource(&OAuthToken{AccessToken: "t"})}
+	tokenClient := NewClient(tp.Client())
+	tokenClient.BaseURL = client.BaseURL
+	req, _ := tokenClient.NewRequest("GET", ".", nil)
+	ctx := context.Background()
+	tokenClient.Do(ctx, req, nil)
+	if got := req.Header.Get("Authorization"); got != "" {
+		t.Errorf("TokenSourceTransport modified the request Authorization header to %q", got)
+	}
+}
+
+func TestTokenSourceTransport_transport(t *testing.T) {
+	// default transport
+	tp := &TokenSourceTransport{}
+	if tp.transport() != http.DefaultTransport {
+		t.Errorf("Expected http.DefaultTransport to be used.")
+	}
+
+	// custom transport
+	tp = &TokenSourceTransport{
+		Transport: &http.Transport{},
+	}
+	if tp.transport() == http.DefaultTransport {
+		t.Errorf("Expected custom transport to be used.")
+	}
+}
@@ -263,3 +263,33 @@ func (s *OrganizationsService) ListInstallations(ctx context.Context, org string
 
 	return result, resp, nil
 }
+
+// Security products that can be enabled or disabled for all the repositories
+// of an organization with OrganizationsService.EnableOrDisableSecurityProduct.
+const (
+	SecurityProductDependencyGraph               = "dependency_graph"
+	SecurityProductDependabotAlerts              = "dependabot_alerts"
+	SecurityProductDependabotSecurityUpdates     = "dependabot_security_updates"
+	SecurityProductAdvancedSecurity              = "advanced_security"
+	SecurityProductCodeScanningDefaultSetup      = "code_scanning_default_setup"
+	SecurityProductSecretScanning                = "secret_scanning"
+	SecurityProductSecretScanningPushProtection  = "secret_scanning_push_protection"
+	SecurityProductPrivateVulnerabilityReporting = "private_vulnerability_reporting"
+)
+
+// EnableOrDisableSecurityProduct enables or disables a security product,
+// such as SecurityProductPrivateVulnerabilityReporting, for all the
+// repositories of an organization. enablement is either "enable_all" or
+// "disable_all".
+//
+// GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/orgs/#enable-or-disable-a-security-feature-for-an-organization
+func (s *OrganizationsService) EnableOrDisableSecurityProduct(ctx context.Context, org, securityProduct, enablement string) (*Response, error) {
+	u := fmt.Sprintf("orgs/%v/%v/%v", org, securityProduct, enablement)
+
+	req, err := s.client.NewRequest("POST", u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(ctx, req, nil)
+}
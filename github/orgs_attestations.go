@@ -0,0 +1,21 @@
+// Copyright 2021 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"fmt"
+)
+
+// ListAttestations lists the attestations of the repositories of an
+// organization about the artifact with the given digest, in the
+// "algorithm:hex" form, such as "sha256:e3b0c4...".
+//
+// GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/orgs/#list-attestations
+func (s *OrganizationsService) ListAttestations(ctx context.Context, org, subjectDigest string, opts *ListOptions) (*AttestationsResponse, *Response, error) {
+	u := fmt.Sprintf("orgs/%v/attestations/%v", org, subjectDigest)
+	return listAttestations(ctx, s.client, u, opts)
+}
@@ -0,0 +1,57 @@
+// Copyright 2021 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+func TestOrganizationsService_ListAttestations(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/orgs/o/attestations/sha256:abc", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testFormValues(t, r, values{"page": "2"})
+		fmt.Fprint(w, `{"attestations":[{"repository_id":1,"bundle":{}},{"repository_id":2,"bundle":{}}]}`)
+	})
+
+	ctx := context.Background()
+	opts := &ListOptions{Page: 2}
+	attestations, _, err := client.Organizations.ListAttestations(ctx, "o", "sha256:abc", opts)
+	if err != nil {
+		t.Errorf("Organizations.ListAttestations returned error: %v", err)
+	}
+
+	want := &AttestationsResponse{
+		Attestations: []*Attestation{
+			{RepositoryID: Int64(1), Bundle: json.RawMessage(`{}`)},
+			{RepositoryID: Int64(2), Bundle: json.RawMessage(`{}`)},
+		},
+	}
+	if !reflect.DeepEqual(attestations, want) {
+		t.Errorf("Organizations.ListAttestations returned %+v, want %+v", attestations, want)
+	}
+
+	const methodName = "ListAttestations"
+	testBadOptions(t, methodName, func() (err error) {
+		_, _, err = client.Organizations.ListAttestations(ctx, "\n", "\n", opts)
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.Organizations.ListAttestations(ctx, "o", "sha256:abc", opts)
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
@@ -0,0 +1,371 @@
+// Copyright 2021 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strings"
+	"time"
+)
+
+// GetAuditLogOptions specifies the optional parameters to the
+// OrganizationsService.GetAuditLog and EnterpriseService.GetAuditLog methods.
+type GetAuditLogOptions struct {
+	// Phrase filters the events with the audit log search syntax, e.g.
+	// "action:repo.create created:>=2021-01-01".
+	Phrase string `url:"phrase,omitempty"`
+	// Include specifies the events to return. Possible values are: "web",
+	// "git" and "all". Default: "web".
+	Include string `url:"include,omitempty"`
+	// Order specifies the order of the events by their timestamp. Possible
+	// values are: "desc" and "asc". Default: "desc".
+	Order string `url:"order,omitempty"`
+
+	// Before and After are cursors for paginating through the results.
+	// Set them from Response.Before and Response.After respectively.
+	Before string `url:"before,omitempty"`
+	After  string `url:"after,omitempty"`
+
+	// For paginated result sets, the number of results to include per page.
+	PerPage int `url:"per_page,omitempty"`
+}
+
+// ActorLocation represents the location of the actor of an audit log event.
+type ActorLocation struct {
+	CountryCode *string `json:"country_code,omitempty"`
+}
+
+// AuditEntry represents an audit log event.
+type AuditEntry struct {
+	Action                   *string        `json:"action,omitempty"`
+	Actor                    *string        `json:"actor,omitempty"`
+	ActorID                  *int64         `json:"actor_id,omitempty"`
+	ActorLocation            *ActorLocation `json:"actor_location,omitempty"`
+	Business                 *string        `json:"business,omitempty"`
+	BusinessID               *int64         `json:"business_id,omitempty"`
+	DocumentID               *string        `json:"_document_id,omitempty"`
+	ExternalIdentityNameID   *string        `json:"external_identity_nameid,omitempty"`
+	ExternalIdentityUsername *string        `json:"external_identity_username,omitempty"`
+	HashedToken              *string        `json:"hashed_token,omitempty"`
+	Org                      *string        `json:"org,omitempty"`
+	OrgID                    *int64         `json:"org_id,omitempty"`
+	Repo                     *string        `json:"repo,omitempty"`
+	Team                     *string        `json:"team,omitempty"`
+	TokenID                  *int64         `json:"token_id,omitempty"`
+	TokenScopes              *string        `json:"token_scopes,omitempty"`
+	User                     *string        `json:"user,omitempty"`
+	UserID                   *int64         `json:"user_id,omitempty"`
+
+	// CreatedAt and Timestamp are encoded in milliseconds since the Unix
+	// epoch, as "created_at" and "@timestamp" respectively.
+	CreatedAt *Timestamp `json:"-"`
+	Timestamp *Timestamp `json:"-"`
+
+	// AdditionalFields holds the action-specific fields of the event, which
+	// can be decoded into a typed payload with Payload.
+	AdditionalFields map[string]interface{} `json:"-"`
+}
+
+// auditEntryAlias has the fields of an AuditEntry, without its methods.
+type auditEntryAlias AuditEntry
+
+// auditEntryTimes holds the timestamps of an AuditEntry as encoded by GitHub.
+type auditEntryTimes struct {
+	CreatedAt *int64 `json:"created_at,omitempty"`
+	Timestamp *int64 `json:"@timestamp,omitempty"`
+}
+
+// auditEntryFields are the JSON names of the fields of an AuditEntry that
+// are not AdditionalFields.
+var auditEntryFields = func() map[string]bool {
+	fields := map[string]bool{"created_at": true, "@timestamp": true}
+	t := reflect.TypeOf(AuditEntry{})
+	for i := 0; i < t.NumField(); i++ {
+		name := strings.Split(t.Field(i).Tag.Get("json"), ",")[0]
+		if name != "" && name != "-" {
+			fields[name] = true
+		}
+	}
+	return fields
+}()
+
+// millisToTimestamp converts a number of milliseconds since the Unix epoch
+// to a Timestamp.
+func millisToTimestamp(ms *int64) *Timestamp {
+	if ms == nil {
+		return nil
+	}
+	return &Timestamp{time.Unix(0, *ms*int64(time.Millisecond)).UTC()}
+}
+
+// timestampToMillis converts a Timestamp to a number of milliseconds since
+// the Unix epoch.
+func timestampToMillis(t *Timestamp) *int64 {
+	if t == nil {
+		return nil
+	}
+	ms := t.UnixNano() / int64(time.Millisecond)
+	return &ms
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+func (e *AuditEntry) UnmarshalJSON(data []byte) error {
+	var times auditEntryTimes
+	if err := json.Unmarshal(data, (*auditEntryAlias)(e)); err != nil {
+		return err
+	}
+	if err := json.Unmarshal(data, &times); err != nil {
+		return err
+	}
+	e.CreatedAt = millisToTimestamp(times.CreatedAt)
+	e.Timestamp = millisToTimestamp(times.Timestamp)
+
+	var fields map[string]interface{}
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return err
+	}
+	for name := range fields {
+		if auditEntryFields[name] {
+			delete(fields, name)
+		}
+	}
+	e.AdditionalFields = nil
+	if len(fields) > 0 {
+		e.AdditionalFields = fields
+	}
+	return nil
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+func (e *AuditEntry) MarshalJSON() ([]byte, error) {
+	fields := make(map[string]interface{}, len(e.AdditionalFields))
+	for name, value := range e.AdditionalFields {
+		fields[name] = value
+	}
+
+	times := auditEntryTimes{
+		CreatedAt: timestampToMillis(e.CreatedAt),
+		Timestamp: timestampToMillis(e.Timestamp),
+	}
+	for _, v := range []interface{}{(*auditEntryAlias)(e), times} {
+		b, err := json.Marshal(v)
+		if err != nil {
+			return nil, err
+		}
+		if err := json.Unmarshal(b, &fields); err != nil {
+			return nil, err
+		}
+	}
+
+	return json.Marshal(fields)
+}
+
+// Well-known audit log actions, for which AuditEntry.Payload returns a
+// typed payload.
+const (
+	AuditActionGitClone          = "git.clone"
+	AuditActionGitFetch          = "git.fetch"
+	AuditActionGitPush           = "git.push"
+	AuditActionRepoCreate        = "repo.create"
+	AuditActionRepoDestroy       = "repo.destroy"
+	AuditActionRepoAccess        = "repo.access"
+	AuditActionOrgAddMember      = "org.add_member"
+	AuditActionOrgRemoveMember   = "org.remove_member"
+	AuditActionOrgUpdateMember   = "org.update_member"
+	AuditActionTeamAddMember     = "team.add_member"
+	AuditActionTeamRemoveMember  = "team.remove_member"
+	AuditActionTeamAddRepository = "team.add_repository"
+)
+
+// AuditEntryGitPayload is the payload of the git.clone, git.fetch and
+// git.push audit log events, which are returned when
+// GetAuditLogOptions.Include is "git" or "all".
+type AuditEntryGitPayload struct {
+	Repository       *string `json:"repository,omitempty"`
+	RepositoryPublic *bool   `json:"repository_public,omitempty"`
+	// Possible values for TransportProtocolName are: "http" and "ssh".
+	TransportProtocolName *string `json:"transport_protocol_name,omitempty"`
+	TransportProtocol     *int    `json:"transport_protocol,omitempty"`
+}
+
+// AuditEntryRepoPayload is the payload of the repo.create, repo.destroy and
+// repo.access audit log events.
+type AuditEntryRepoPayload struct {
+	// Possible values for Visibility are: "public", "private" and "internal".
+	Visibility         *string `json:"visibility,omitempty"`
+	PreviousVisibility *string `json:"previous_visibility,omitempty"`
+	PublicRepo         *bool   `json:"public_repo,omitempty"`
+}
+
+// AuditEntryMemberPayload is the payload of the org and team membership
+// audit log events.
+type AuditEntryMemberPayload struct {
+	// Permission is the role of the member, e.g. "admin" or "read".
+	Permission    *string `json:"permission,omitempty"`
+	OldPermission *string `json:"old_permission,omitempty"`
+}
+
+// Payload returns the action-specific fields of a well-known audit log event
+// as a typed payload: an *AuditEntryGitPayload, an *AuditEntryRepoPayload or
+// an *AuditEntryMemberPayload, according to the Action of the event. It
+// returns nil if the action is not one of the AuditAction constants.
+func (e *AuditEntry) Payload() (interface{}, error) {
+	var payload interface{}
+	switch e.GetAction() {
+	case AuditActionGitClone, AuditActionGitFetch, AuditActionGitPush:
+		payload = &AuditEntryGitPayload{}
+	case AuditActionRepoCreate, AuditActionRepoDestroy, AuditActionRepoAccess:
+		payload = &AuditEntryRepoPayload{}
+	case AuditActionOrgAddMember, AuditActionOrgRemoveMember, AuditActionOrgUpdateMember,
+		AuditActionTeamAddMember, AuditActionTeamRemoveMember, AuditActionTeamAddRepository:
+		payload = &AuditEntryMemberPayload{}
+	default:
+		return nil, nil
+	}
+
+	b, err := json.Marshal(e.AdditionalFields)
+	if err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(b, payload); err != nil {
+		return nil, err
+	}
+	return payload, nil
+}
+
+// GetAuditLog gets the audit log events of an organization. If there are
+// more results, Response.After is set to the cursor to pass as
+// GetAuditLogOptions.After.
+//
+// GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/orgs/#get-the-audit-log-for-an-organization
+func (s *OrganizationsService) GetAuditLog(ctx context.Context, org string, opts *GetAuditLogOptions) ([]*AuditEntry, *Response, error) {
+	u := fmt.Sprintf("orgs/%v/audit-log", org)
+	u, err := addOptions(u, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var auditEntries []*AuditEntry
+	resp, err := s.client.Do(ctx, req, &auditEntries)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return auditEntries, resp, nil
+}
+
+// AuditLogStream iterates over audit log events, fetching the pages of the
+// audit log as needed. It follows the After cursors of the responses, or
+// their Before cursors if it was started with GetAuditLogOptions.Before and
+// without After.
+//
+// A stream can be resumed later, for example after a restart, by setting
+// the same cursor of GetAuditLogOptions to the value of Cursor.
+type AuditLogStream struct {
+	pageIterator
+
+	opts       GetAuditLogOptions
+	before     bool          // whether the stream follows the Before cursors
+	entries    []*AuditEntry // remaining entries of the current page
+	entry      *AuditEntry
+	pageCursor string // cursor of the current page
+}
+
+// AuditLogStream returns a stream of the audit log events of an
+// organization. opts may be used to filter the events, and to set the page
+// size and the cursor to start from. No request is made until the first
+// call to Next.
+//
+// GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/orgs/#get-the-audit-log-for-an-organization
+func (s *OrganizationsService) AuditLogStream(ctx context.Context, org string, opts *GetAuditLogOptions) *AuditLogStream {
+	return newAuditLogStream(opts, func(opts *GetAuditLogOptions) ([]*AuditEntry, *Response, error) {
+		return s.GetAuditLog(ctx, org, opts)
+	})
+}
+
+func newAuditLogStream(opts *GetAuditLogOptions, list func(opts *GetAuditLogOptions) ([]*AuditEntry, *Response, error)) *AuditLogStream {
+	stream := &AuditLogStream{}
+	if opts != nil {
+		stream.opts = *opts
+	}
+	stream.before = stream.opts.Before != "" && stream.opts.After == ""
+	stream.pageCursor = stream.cursor()
+	stream.fetch = func() (int, bool, *Response, error) {
+		stream.pageCursor = stream.cursor()
+		entries, resp, err := list(&stream.opts)
+		if err != nil {
+			return 0, false, resp, err
+		}
+		stream.entries = entries
+
+		next := resp.After
+		if stream.before {
+			next = resp.Before
+		}
+		if next != "" {
+			stream.setCursor(next)
+		}
+		return len(entries), next == "" || len(entries) == 0, resp, nil
+	}
+	return stream
+}
+
+// cursor returns the cursor of the next page.
+func (s *AuditLogStream) cursor() string {
+	if s.before {
+		return s.opts.Before
+	}
+	return s.opts.After
+}
+
+// setCursor sets the cursor of the next page.
+func (s *AuditLogStream) setCursor(cursor string) {
+	if s.before {
+		s.opts.Before = cursor
+	} else {
+		s.opts.After = cursor
+	}
+}
+
+// Next advances the stream to the next event, which is then available
+// through Entry. It returns false when there are no more events or an error
+// occurred, in which case it is returned by Err.
+func (s *AuditLogStream) Next() bool {
+	if !s.next() {
+		s.entry = nil
+		return false
+	}
+	s.entry, s.entries = s.entries[0], s.entries[1:]
+	return true
+}
+
+// Entry returns the current event, or nil if Next has not been called or
+// returned false.
+func (s *AuditLogStream) Entry() *AuditEntry {
+	return s.entry
+}
+
+// Cursor returns the cursor from which to resume the stream, by setting it
+// as GetAuditLogOptions.After, or as GetAuditLogOptions.Before if the stream
+// follows the Before cursors. Pages are the unit of resumption: while the
+// events of the current page have not all been returned by Next, Cursor
+// returns the cursor of the current page, so a resumed stream returns the
+// whole page again. Once the last page has been consumed, Cursor returns its
+// cursor, so that a resumed stream picks up the events added since.
+func (s *AuditLogStream) Cursor() string {
+	if len(s.entries) > 0 || s.done {
+		return s.pageCursor
+	}
+	return s.cursor()
+}
@@ -0,0 +1,306 @@
+// Copyright 2021 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestOrganizationsService_GetAuditLog(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/orgs/o/audit-log", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testFormValues(t, r, values{
+			"phrase":   "action:git.push",
+			"include":  "git",
+			"order":    "asc",
+			"after":    "c1",
+			"per_page": "2",
+		})
+		w.Header().Set("Link", `<https://api.github.com/orgs/o/audit-log?after=c2>; rel="next"`)
+		fmt.Fprint(w, `[
+		  {
+			"@timestamp": 1611689203000,
+			"_document_id": "d1",
+			"action": "git.push",
+			"actor": "octocat",
+			"actor_id": 1,
+			"actor_location": {"country_code": "US"},
+			"created_at": 1611689203000,
+			"org": "o",
+			"repo": "o/r",
+			"repository": "o/r",
+			"repository_public": false,
+			"transport_protocol": 1,
+			"transport_protocol_name": "http",
+			"user": "octocat"
+		  }
+		]`)
+	})
+
+	opts := &GetAuditLogOptions{
+		Phrase:  "action:git.push",
+		Include: "git",
+		Order:   "asc",
+		After:   "c1",
+		PerPage: 2,
+	}
+	ctx := context.Background()
+	auditEntries, resp, err := client.Organizations.GetAuditLog(ctx, "o", opts)
+	if err != nil {
+		t.Errorf("Organizations.GetAuditLog returned error: %v", err)
+	}
+
+	timestamp := &Timestamp{time.Date(2021, time.January, 26, 19, 26, 43, 0, time.UTC)}
+	want := []*AuditEntry{
+		{
+			Action:        String("git.push"),
+			Actor:         String("octocat"),
+			ActorID:       Int64(1),
+			ActorLocation: &ActorLocation{CountryCode: String("US")},
+			CreatedAt:     timestamp,
+			DocumentID:    String("d1"),
+			Org:           String("o"),
+			Repo:          String("o/r"),
+			Timestamp:     timestamp,
+			User:          String("octocat"),
+			AdditionalFields: map[string]interface{}{
+				"repository":              "o/r",
+				"repository_public":       false,
+				"transport_protocol":      float64(1),
+				"transport_protocol_name": "http",
+			},
+		},
+	}
+	if !reflect.DeepEqual(auditEntries, want) {
+		t.Errorf("Organizations.GetAuditLog returned %+v, want %+v", auditEntries, want)
+	}
+	if got, want := resp.After, "c2"; got != want {
+		t.Errorf("Organizations.GetAuditLog returned After %v, want %v", got, want)
+	}
+
+	const methodName = "GetAuditLog"
+	testBadOptions(t, methodName, func() (err error) {
+		_, _, err = client.Organizations.GetAuditLog(ctx, "\n", opts)
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.Organizations.GetAuditLog(ctx, "o", opts)
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
+
+func TestOrganizationsService_AuditLogStream(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/orgs/o/audit-log", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		switch r.FormValue("after") {
+		case "c1":
+			testFormValues(t, r, values{"include": "all", "after": "c1"})
+			w.Header().Set("Link", `<https://api.github.com/orgs/o/audit-log?after=c2>; rel="next"`)
+			fmt.Fprint(w, `[{"_document_id": "d1"}, {"_document_id": "d2"}]`)
+		case "c2":
+			testFormValues(t, r, values{"include": "all", "after": "c2"})
+			fmt.Fprint(w, `[{"_document_id": "d3"}]`)
+		default:
+			t.Errorf("Unexpected cursor %q", r.FormValue("after"))
+		}
+	})
+
+	ctx := context.Background()
+	stream := client.Organizations.AuditLogStream(ctx, "o", &GetAuditLogOptions{Include: "all", After: "c1"})
+	if got, want := stream.Cursor(), "c1"; got != want {
+		t.Errorf("AuditLogStream.Cursor returned %q before Next, want %q", got, want)
+	}
+
+	var ids, cursors []string
+	for stream.Next() {
+		ids = append(ids, stream.Entry().GetDocumentID())
+		cursors = append(cursors, stream.Cursor())
+	}
+	if err := stream.Err(); err != nil {
+		t.Errorf("AuditLogStream returned error: %v", err)
+	}
+
+	if want := []string{"d1", "d2", "d3"}; !reflect.DeepEqual(ids, want) {
+		t.Errorf("AuditLogStream returned %v, want %v", ids, want)
+	}
+	if want := []string{"c1", "c2", "c2"}; !reflect.DeepEqual(cursors, want) {
+		t.Errorf("AuditLogStream.Cursor returned %v, want %v", cursors, want)
+	}
+	if stream.Entry() != nil {
+		t.Errorf("AuditLogStream.Entry returned %+v after the last event, want nil", stream.Entry())
+	}
+	if stream.Response() == nil {
+		t.Error("AuditLogStream.Response returned nil, want the last response")
+	}
+}
+
+func TestOrganizationsService_AuditLogStream_before(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/orgs/o/audit-log", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		switch r.FormValue("before") {
+		case "c2":
+			testFormValues(t, r, values{"before": "c2"})
+			w.Header().Set("Link", `<https://api.github.com/orgs/o/audit-log?after=c3>; rel="next", <https://api.github.com/orgs/o/audit-log?before=c1>; rel="prev"`)
+			fmt.Fprint(w, `[{"_document_id": "d2"}]`)
+		case "c1":
+			testFormValues(t, r, values{"before": "c1"})
+			w.Header().Set("Link", `<https://api.github.com/orgs/o/audit-log?after=c2>; rel="next"`)
+			fmt.Fprint(w, `[{"_document_id": "d1"}]`)
+		default:
+			t.Errorf("Unexpected cursor %q", r.FormValue("before"))
+		}
+	})
+
+	ctx := context.Background()
+	stream := client.Organizations.AuditLogStream(ctx, "o", &GetAuditLogOptions{Before: "c2"})
+	var ids, cursors []string
+	for stream.Next() {
+		ids = append(ids, stream.Entry().GetDocumentID())
+		cursors = append(cursors, stream.Cursor())
+	}
+	if err := stream.Err(); err != nil {
+		t.Errorf("AuditLogStream returned error: %v", err)
+	}
+
+	if want := []string{"d2", "d1"}; !reflect.DeepEqual(ids, want) {
+		t.Errorf("AuditLogStream returned %v, want %v", ids, want)
+	}
+	if want := []string{"c1", "c1"}; !reflect.DeepEqual(cursors, want) {
+		t.Errorf("AuditLogStream.Cursor returned %v, want %v", cursors, want)
+	}
+}
+
+func TestOrganizationsService_AuditLogStream_error(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/orgs/o/audit-log", func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, "BadRequest", http.StatusBadRequest)
+	})
+
+	ctx := context.Background()
+	stream := client.Organizations.AuditLogStream(ctx, "o", nil)
+	if stream.Next() {
+		t.Error("AuditLogStream.Next returned true, want false")
+	}
+	if stream.Err() == nil {
+		t.Error("AuditLogStream.Err returned nil, want an error")
+	}
+	if stream.Next() {
+		t.Error("AuditLogStream.Next returned true after an error, want false")
+	}
+}
+
+func TestAuditEntry_Payload(t *testing.T) {
+	tests := []struct {
+		entry *AuditEntry
+		want  interface{}
+	}{
+		{
+			entry: &AuditEntry{
+				Action: String(AuditActionGitClone),
+				AdditionalFields: map[string]interface{}{
+					"repository":              "o/r",
+					"repository_public":       true,
+					"transport_protocol_name": "ssh",
+				},
+			},
+			want: &AuditEntryGitPayload{
+				Repository:            String("o/r"),
+				RepositoryPublic:      Bool(true),
+				TransportProtocolName: String("ssh"),
+			},
+		},
+		{
+			entry: &AuditEntry{
+				Action:           String(AuditActionRepoCreate),
+				AdditionalFields: map[string]interface{}{"visibility": "internal"},
+			},
+			want: &AuditEntryRepoPayload{Visibility: String("internal")},
+		},
+		{
+			entry: &AuditEntry{
+				Action:           String(AuditActionTeamAddMember),
+				AdditionalFields: map[string]interface{}{"permission": "admin"},
+			},
+			want: &AuditEntryMemberPayload{Permission: String("admin")},
+		},
+		{
+			entry: &AuditEntry{
+				Action:           String("workflows.completed_workflow_run"),
+				AdditionalFields: map[string]interface{}{"conclusion": "success"},
+			},
+			want: nil,
+		},
+	}
+
+	for _, tt := range tests {
+		got, err := tt.entry.Payload()
+		if err != nil {
+			t.Errorf("AuditEntry.Payload for %v returned error: %v", tt.entry.GetAction(), err)
+		}
+		if !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("AuditEntry.Payload for %v returned %+v, want %+v", tt.entry.GetAction(), got, tt.want)
+		}
+	}
+}
+
+func TestAuditEntry_Marshal(t *testing.T) {
+	testJSONMarshal(t, &AuditEntry{}, "{}")
+
+	timestamp := &Timestamp{time.Date(2021, time.January, 26, 19, 26, 43, 0, time.UTC)}
+	u := &AuditEntry{
+		Action:        String("repo.create"),
+		Actor:         String("a"),
+		ActorID:       Int64(1),
+		ActorLocation: &ActorLocation{CountryCode: String("US")},
+		CreatedAt:     timestamp,
+		DocumentID:    String("d"),
+		Org:           String("o"),
+		OrgID:         Int64(2),
+		Repo:          String("o/r"),
+		Timestamp:     timestamp,
+		AdditionalFields: map[string]interface{}{
+			"visibility": "private",
+		},
+	}
+
+	want := `{
+		"action": "repo.create",
+		"actor": "a",
+		"actor_id": 1,
+		"actor_location": {
+			"country_code": "US"
+		},
+		"created_at": 1611689203000,
+		"_document_id": "d",
+		"org": "o",
+		"org_id": 2,
+		"repo": "o/r",
+		"@timestamp": 1611689203000,
+		"visibility": "private"
+	}`
+
+	testJSONMarshal(t, u, want)
+}
@@ -0,0 +1,591 @@
+// Copyright 2021 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+func TestOrganizationsService_ListCustomRepoRoles(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/orgs/o/custom-repository-roles", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"total_count":1,"custom_roles":[{
+			"id": 1,
+			"name": "security-engineer",
+			"description": "d",
+			"base_role": "write",
+			"permissions": ["delete_alerts_code_scanning"],
+			"organization": {"login": "o"},
+			"created_at": `+referenceTimeStr+`
+		}]}`)
+	})
+
+	ctx := context.Background()
+	roles, _, err := client.Organizations.ListCustomRepoRoles(ctx, "o")
+	if err != nil {
+		t.Errorf("Organizations.ListCustomRepoRoles returned error: %v", err)
+	}
+
+	want := &OrganizationCustomRepoRoles{
+		TotalCount: Int(1),
+		CustomRepoRoles: []*CustomRepoRoles{{
+			ID:          Int64(1),
+			Name:        String("security-engineer"),
+			Description: String("d"),
+			BaseRole:    String("write"),
+			Permissions: []string{"delete_alerts_code_scanning"},
+			Org:         &Organization{Login: String("o")},
+			CreatedAt:   &Timestamp{referenceTime},
+		}},
+	}
+	if !reflect.DeepEqual(roles, want) {
+		t.Errorf("Organizations.ListCustomRepoRoles returned %+v, want %+v", roles, want)
+	}
+
+	const methodName = "ListCustomRepoRoles"
+	testBadOptions(t, methodName, func() (err error) {
+		_, _, err = client.Organizations.ListCustomRepoRoles(ctx, "\n")
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.Organizations.ListCustomRepoRoles(ctx, "o")
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
+
+func TestOrganizationsService_GetCustomRepoRole(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/orgs/o/custom-repository-roles/1", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"id":1,"name":"r"}`)
+	})
+
+	ctx := context.Background()
+	role, _, err := client.Organizations.GetCustomRepoRole(ctx, "o", 1)
+	if err != nil {
+		t.Errorf("Organizations.GetCustomRepoRole returned error: %v", err)
+	}
+
+	want := &CustomRepoRoles{ID: Int64(1), Name: String("r")}
+	if !reflect.DeepEqual(role, want) {
+		t.Errorf("Organizations.GetCustomRepoRole returned %+v, want %+v", role, want)
+	}
+
+	const methodName = "GetCustomRepoRole"
+	testBadOptions(t, methodName, func() (err error) {
+		_, _, err = client.Organizations.GetCustomRepoRole(ctx, "\n", -1)
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.Organizations.GetCustomRepoRole(ctx, "o", 1)
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
+
+func TestOrganizationsService_CreateCustomRepoRole(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/orgs/o/custom-repository-roles", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testBody(t, r, `{"name":"r","description":"d","base_role":"read","permissions":["add_label"]}`+"\n")
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprint(w, `{"id":1,"name":"r"}`)
+	})
+
+	opts := &CreateOrUpdateCustomRepoRoleOptions{
+		Name:        String("r"),
+		Description: String("d"),
+		BaseRole:    String("read"),
+		Permissions: []string{"add_label"},
+	}
+	ctx := context.Background()
+	role, _, err := client.Organizations.CreateCustomRepoRole(ctx, "o", opts)
+	if err != nil {
+		t.Errorf("Organizations.CreateCustomRepoRole returned error: %v", err)
+	}
+
+	want := &CustomRepoRoles{ID: Int64(1), Name: String("r")}
+	if !reflect.DeepEqual(role, want) {
+		t.Errorf("Organizations.CreateCustomRepoRole returned %+v, want %+v", role, want)
+	}
+
+	const methodName = "CreateCustomRepoRole"
+	testBadOptions(t, methodName, func() (err error) {
+		_, _, err = client.Organizations.CreateCustomRepoRole(ctx, "\n", opts)
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.Organizations.CreateCustomRepoRole(ctx, "o", opts)
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
+
+func TestOrganizationsService_UpdateCustomRepoRole(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/orgs/o/custom-repository-roles/1", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PATCH")
+		testBody(t, r, `{"description":"new"}`+"\n")
+		fmt.Fprint(w, `{"id":1,"description":"new"}`)
+	})
+
+	opts := &CreateOrUpdateCustomRepoRoleOptions{Description: String("new")}
+	ctx := context.Background()
+	role, _, err := client.Organizations.UpdateCustomRepoRole(ctx, "o", 1, opts)
+	if err != nil {
+		t.Errorf("Organizations.UpdateCustomRepoRole returned error: %v", err)
+	}
+
+	want := &CustomRepoRoles{ID: Int64(1), Description: String("new")}
+	if !reflect.DeepEqual(role, want) {
+		t.Errorf("Organizations.UpdateCustomRepoRole returned %+v, want %+v", role, want)
+	}
+
+	const methodName = "UpdateCustomRepoRole"
+	testBadOptions(t, methodName, func() (err error) {
+		_, _, err = client.Organizations.UpdateCustomRepoRole(ctx, "\n", -1, opts)
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.Organizations.UpdateCustomRepoRole(ctx, "o", 1, opts)
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
+
+func TestOrganizationsService_DeleteCustomRepoRole(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/orgs/o/custom-repository-roles/1", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	ctx := context.Background()
+	if _, err := client.Organizations.DeleteCustomRepoRole(ctx, "o", 1); err != nil {
+		t.Errorf("Organizations.DeleteCustomRepoRole returned error: %v", err)
+	}
+
+	const methodName = "DeleteCustomRepoRole"
+	testBadOptions(t, methodName, func() (err error) {
+		_, err = client.Organizations.DeleteCustomRepoRole(ctx, "\n", -1)
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		return client.Organizations.DeleteCustomRepoRole(ctx, "o", 1)
+	})
+}
+
+func TestOrganizationsService_ListRoles(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/orgs/o/organization-roles", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"total_count":1,"roles":[{
+			"id": 8030,
+			"name": "Custom Role Manager",
+			"description": "Permissions to manage custom roles within an org",
+			"permissions": ["write_organization_custom_repo_role"],
+			"base_role": "read",
+			"source": "Organization",
+			"organization": {"login": "o"}
+		}]}`)
+	})
+
+	ctx := context.Background()
+	roles, _, err := client.Organizations.ListRoles(ctx, "o")
+	if err != nil {
+		t.Errorf("Organizations.ListRoles returned error: %v", err)
+	}
+
+	want := &OrganizationCustomRoles{
+		TotalCount: Int(1),
+		CustomRoles: []*CustomOrgRoles{{
+			ID:          Int64(8030),
+			Name:        String("Custom Role Manager"),
+			Description: String("Permissions to manage custom roles within an org"),
+			Permissions: []string{"write_organization_custom_repo_role"},
+			BaseRole:    String("read"),
+			Source:      String("Organization"),
+			Org:         &Organization{Login: String("o")},
+		}},
+	}
+	if !reflect.DeepEqual(roles, want) {
+		t.Errorf("Organizations.ListRoles returned %+v, want %+v", roles, want)
+	}
+
+	const methodName = "ListRoles"
+	testBadOptions(t, methodName, func() (err error) {
+		_, _, err = client.Organizations.ListRoles(ctx, "\n")
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.Organizations.ListRoles(ctx, "o")
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
+
+func TestOrganizationsService_GetOrgRole(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/orgs/o/organization-roles/1", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"id":1,"name":"all_repo_read","source":"Predefined"}`)
+	})
+
+	ctx := context.Background()
+	role, _, err := client.Organizations.GetOrgRole(ctx, "o", 1)
+	if err != nil {
+		t.Errorf("Organizations.GetOrgRole returned error: %v", err)
+	}
+
+	want := &CustomOrgRoles{ID: Int64(1), Name: String("all_repo_read"), Source: String("Predefined")}
+	if !reflect.DeepEqual(role, want) {
+		t.Errorf("Organizations.GetOrgRole returned %+v, want %+v", role, want)
+	}
+
+	const methodName = "GetOrgRole"
+	testBadOptions(t, methodName, func() (err error) {
+		_, _, err = client.Organizations.GetOrgRole(ctx, "\n", -1)
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.Organizations.GetOrgRole(ctx, "o", 1)
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
+
+func TestOrganizationsService_CreateCustomOrgRole(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/orgs/o/organization-roles", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testBody(t, r, `{"name":"r","permissions":["read_audit_logs"]}`+"\n")
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprint(w, `{"id":1,"name":"r"}`)
+	})
+
+	opts := &CreateOrUpdateOrgRoleOptions{Name: String("r"), Permissions: []string{"read_audit_logs"}}
+	ctx := context.Background()
+	role, _, err := client.Organizations.CreateCustomOrgRole(ctx, "o", opts)
+	if err != nil {
+		t.Errorf("Organizations.CreateCustomOrgRole returned error: %v", err)
+	}
+
+	want := &CustomOrgRoles{ID: Int64(1), Name: String("r")}
+	if !reflect.DeepEqual(role, want) {
+		t.Errorf("Organizations.CreateCustomOrgRole returned %+v, want %+v", role, want)
+	}
+
+	const methodName = "CreateCustomOrgRole"
+	testBadOptions(t, methodName, func() (err error) {
+		_, _, err = client.Organizations.CreateCustomOrgRole(ctx, "\n", opts)
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.Organizations.CreateCustomOrgRole(ctx, "o", opts)
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
+
+func TestOrganizationsService_UpdateCustomOrgRole(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/orgs/o/organization-roles/1", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PATCH")
+		testBody(t, r, `{"base_role":"write"}`+"\n")
+		fmt.Fprint(w, `{"id":1,"base_role":"write"}`)
+	})
+
+	opts := &CreateOrUpdateOrgRoleOptions{BaseRole: String("write")}
+	ctx := context.Background()
+	role, _, err := client.Organizations.UpdateCustomOrgRole(ctx, "o", 1, opts)
+	if err != nil {
+		t.Errorf("Organizations.UpdateCustomOrgRole returned error: %v", err)
+	}
+
+	want := &CustomOrgRoles{ID: Int64(1), BaseRole: String("write")}
+	if !reflect.DeepEqual(role, want) {
+		t.Errorf("Organizations.UpdateCustomOrgRole returned %+v, want %+v", role, want)
+	}
+
+	const methodName = "UpdateCustomOrgRole"
+	testBadOptions(t, methodName, func() (err error) {
+		_, _, err = client.Organizations.UpdateCustomOrgRole(ctx, "\n", -1, opts)
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.Organizations.UpdateCustomOrgRole(ctx, "o", 1, opts)
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
+
+func TestOrganizationsService_DeleteCustomOrgRole(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/orgs/o/organization-roles/1", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	ctx := context.Background()
+	if _, err := client.Organizations.DeleteCustomOrgRole(ctx, "o", 1); err != nil {
+		t.Errorf("Organizations.DeleteCustomOrgRole returned error: %v", err)
+	}
+
+	const methodName = "DeleteCustomOrgRole"
+	testBadOptions(t, methodName, func() (err error) {
+		_, err = client.Organizations.DeleteCustomOrgRole(ctx, "\n", -1)
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		return client.Organizations.DeleteCustomOrgRole(ctx, "o", 1)
+	})
+}
+
+func TestOrganizationsService_AssignOrgRoleToTeam(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/orgs/o/organization-roles/teams/t/1", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PUT")
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	ctx := context.Background()
+	if _, err := client.Organizations.AssignOrgRoleToTeam(ctx, "o", "t", 1); err != nil {
+		t.Errorf("Organizations.AssignOrgRoleToTeam returned error: %v", err)
+	}
+
+	const methodName = "AssignOrgRoleToTeam"
+	testBadOptions(t, methodName, func() (err error) {
+		_, err = client.Organizations.AssignOrgRoleToTeam(ctx, "\n", "\n", -1)
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		return client.Organizations.AssignOrgRoleToTeam(ctx, "o", "t", 1)
+	})
+}
+
+func TestOrganizationsService_RemoveOrgRoleFromTeam(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/orgs/o/organization-roles/teams/t/1", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	ctx := context.Background()
+	if _, err := client.Organizations.RemoveOrgRoleFromTeam(ctx, "o", "t", 1); err != nil {
+		t.Errorf("Organizations.RemoveOrgRoleFromTeam returned error: %v", err)
+	}
+
+	const methodName = "RemoveOrgRoleFromTeam"
+	testBadOptions(t, methodName, func() (err error) {
+		_, err = client.Organizations.RemoveOrgRoleFromTeam(ctx, "\n", "\n", -1)
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		return client.Organizations.RemoveOrgRoleFromTeam(ctx, "o", "t", 1)
+	})
+}
+
+func TestOrganizationsService_AssignOrgRoleToUser(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/orgs/o/organization-roles/users/u/1", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PUT")
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	ctx := context.Background()
+	if _, err := client.Organizations.AssignOrgRoleToUser(ctx, "o", "u", 1); err != nil {
+		t.Errorf("Organizations.AssignOrgRoleToUser returned error: %v", err)
+	}
+
+	const methodName = "AssignOrgRoleToUser"
+	testBadOptions(t, methodName, func() (err error) {
+		_, err = client.Organizations.AssignOrgRoleToUser(ctx, "\n", "\n", -1)
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		return client.Organizations.AssignOrgRoleToUser(ctx, "o", "u", 1)
+	})
+}
+
+func TestOrganizationsService_RemoveOrgRoleFromUser(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/orgs/o/organization-roles/users/u/1", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	ctx := context.Background()
+	if _, err := client.Organizations.RemoveOrgRoleFromUser(ctx, "o", "u", 1); err != nil {
+		t.Errorf("Organizations.RemoveOrgRoleFromUser returned error: %v", err)
+	}
+
+	const methodName = "RemoveOrgRoleFromUser"
+	testBadOptions(t, methodName, func() (err error) {
+		_, err = client.Organizations.RemoveOrgRoleFromUser(ctx, "\n", "\n", -1)
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		return client.Organizations.RemoveOrgRoleFromUser(ctx, "o", "u", 1)
+	})
+}
+
+func TestOrganizationsService_ListTeamsAssignedToOrgRole(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/orgs/o/organization-roles/1/teams", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testFormValues(t, r, values{"page": "2"})
+		fmt.Fprint(w, `[{"id":1,"slug":"t"}]`)
+	})
+
+	opts := &ListOptions{Page: 2}
+	ctx := context.Background()
+	teams, _, err := client.Organizations.ListTeamsAssignedToOrgRole(ctx, "o", 1, opts)
+	if err != nil {
+		t.Errorf("Organizations.ListTeamsAssignedToOrgRole returned error: %v", err)
+	}
+
+	want := []*Team{{ID: Int64(1), Slug: String("t")}}
+	if !reflect.DeepEqual(teams, want) {
+		t.Errorf("Organizations.ListTeamsAssignedToOrgRole returned %+v, want %+v", teams, want)
+	}
+
+	const methodName = "ListTeamsAssignedToOrgRole"
+	testBadOptions(t, methodName, func() (err error) {
+		_, _, err = client.Organizations.ListTeamsAssignedToOrgRole(ctx, "\n", -1, opts)
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.Organizations.ListTeamsAssignedToOrgRole(ctx, "o", 1, opts)
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
+
+func TestOrganizationsService_ListUsersAssignedToOrgRole(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/orgs/o/organization-roles/1/users", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testFormValues(t, r, values{"per_page": "10"})
+		fmt.Fprint(w, `[{"id":1,"login":"u"}]`)
+	})
+
+	opts := &ListOptions{PerPage: 10}
+	ctx := context.Background()
+	users, _, err := client.Organizations.ListUsersAssignedToOrgRole(ctx, "o", 1, opts)
+	if err != nil {
+		t.Errorf("Organizations.ListUsersAssignedToOrgRole returned error: %v", err)
+	}
+
+	want := []*User{{ID: Int64(1), Login: String("u")}}
+	if !reflect.DeepEqual(users, want) {
+		t.Errorf("Organizations.ListUsersAssignedToOrgRole returned %+v, want %+v", users, want)
+	}
+
+	const methodName = "ListUsersAssignedToOrgRole"
+	testBadOptions(t, methodName, func() (err error) {
+		_, _, err = client.Organizations.ListUsersAssignedToOrgRole(ctx, "\n", -1, opts)
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.Organizations.ListUsersAssignedToOrgRole(ctx, "o", 1, opts)
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
+
+func TestCustomRepoRoles_Marshal(t *testing.T) {
+	testJSONMarshal(t, &CustomRepoRoles{}, "{}")
+
+	u := &CustomRepoRoles{
+		ID:          Int64(1),
+		Name:        String("n"),
+		BaseRole:    String("read"),
+		Permissions: []string{"p"},
+	}
+
+	want := `{
+		"id": 1,
+		"name": "n",
+		"base_role": "read",
+		"permissions": ["p"]
+	}`
+
+	testJSONMarshal(t, u, want)
+}
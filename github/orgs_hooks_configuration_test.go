@@ -0,0 +1,113 @@
+// Copyright 2021 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+func TestOrganizationsService_GetHookConfiguration(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/orgs/o/hooks/1/config", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"content_type": "json", "insecure_ssl": "0", "url": "https://example.com/webhook"}`)
+	})
+
+	ctx := context.Background()
+	config, _, err := client.Organizations.GetHookConfiguration(ctx, "o", 1)
+	if err != nil {
+		t.Errorf("Organizations.GetHookConfiguration returned error: %v", err)
+	}
+
+	want := &HookConfig{
+		ContentType: String(string(HookContentTypeJSON)),
+		InsecureSSL: String(string(HookVerifySSL)),
+		URL:         String("https://example.com/webhook"),
+	}
+	if !reflect.DeepEqual(config, want) {
+		t.Errorf("Organizations.GetHookConfiguration returned %+v, want %+v", config, want)
+	}
+
+	const methodName = "GetHookConfiguration"
+	testBadOptions(t, methodName, func() (err error) {
+		_, _, err = client.Organizations.GetHookConfiguration(ctx, "\n", -1)
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.Organizations.GetHookConfiguration(ctx, "o", 1)
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
+
+func TestOrganizationsService_EditHookConfiguration(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	input := &HookConfig{URL: String("u")}
+
+	mux.HandleFunc("/orgs/o/hooks/1/config", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PATCH")
+		testBody(t, r, `{"url":"u"}`+"\n")
+		fmt.Fprint(w, `{"url": "u", "insecure_ssl": 0}`)
+	})
+
+	ctx := context.Background()
+	config, _, err := client.Organizations.EditHookConfiguration(ctx, "o", 1, input)
+	if err != nil {
+		t.Errorf("Organizations.EditHookConfiguration returned error: %v", err)
+	}
+
+	want := &HookConfig{URL: String("u"), InsecureSSL: String("0")}
+	if !reflect.DeepEqual(config, want) {
+		t.Errorf("Organizations.EditHookConfiguration returned %+v, want %+v", config, want)
+	}
+
+	const methodName = "EditHookConfiguration"
+	testBadOptions(t, methodName, func() (err error) {
+		_, _, err = client.Organizations.EditHookConfiguration(ctx, "\n", -1, input)
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.Organizations.EditHookConfiguration(ctx, "o", 1, input)
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
+
+func TestOrganizationsService_RotateHook(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	var wantBody string
+	mux.HandleFunc("/orgs/o/hooks/1/config", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PATCH")
+		testBody(t, r, wantBody+"\n")
+		fmt.Fprint(w, `{}`)
+	})
+
+	ctx := context.Background()
+	wantBody = `{"secret":"s"}`
+	if _, _, err := client.Organizations.RotateHookSecret(ctx, "o", 1, "s"); err != nil {
+		t.Errorf("Organizations.RotateHookSecret returned error: %v", err)
+	}
+	wantBody = `{"url":"u"}`
+	if _, _, err := client.Organizations.RotateHookURL(ctx, "o", 1, "u"); err != nil {
+		t.Errorf("Organizations.RotateHookURL returned error: %v", err)
+	}
+}
@@ -0,0 +1,113 @@
+// Copyright 2021 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+func TestOrganizationsService_ListHookDeliveries(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/orgs/o/hooks/1/deliveries", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testFormValues(t, r, values{"cursor": "v1_12077215967"})
+		fmt.Fprint(w, `[{"id":1}, {"id":2}]`)
+	})
+
+	opts := &ListCursorOptions{Cursor: "v1_12077215967"}
+
+	ctx := context.Background()
+	deliveries, _, err := client.Organizations.ListHookDeliveries(ctx, "o", 1, opts)
+	if err != nil {
+		t.Errorf("Organizations.ListHookDeliveries returned error: %v", err)
+	}
+
+	want := []*HookDelivery{{ID: Int64(1)}, {ID: Int64(2)}}
+	if !reflect.DeepEqual(deliveries, want) {
+		t.Errorf("Organizations.ListHookDeliveries returned %+v, want %+v", deliveries, want)
+	}
+
+	const methodName = "ListHookDeliveries"
+	testBadOptions(t, methodName, func() (err error) {
+		_, _, err = client.Organizations.ListHookDeliveries(ctx, "\n", -1, opts)
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.Organizations.ListHookDeliveries(ctx, "o", 1, opts)
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
+
+func TestOrganizationsService_GetHookDelivery(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/orgs/o/hooks/1/deliveries/2", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"id":2,"guid":"g"}`)
+	})
+
+	ctx := context.Background()
+	delivery, _, err := client.Organizations.GetHookDelivery(ctx, "o", 1, 2)
+	if err != nil {
+		t.Errorf("Organizations.GetHookDelivery returned error: %v", err)
+	}
+
+	want := &HookDelivery{ID: Int64(2), GUID: String("g")}
+	if !reflect.DeepEqual(delivery, want) {
+		t.Errorf("Organizations.GetHookDelivery returned %+v, want %+v", delivery, want)
+	}
+
+	const methodName = "GetHookDelivery"
+	testBadOptions(t, methodName, func() (err error) {
+		_, _, err = client.Organizations.GetHookDelivery(ctx, "\n", -1, -1)
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.Organizations.GetHookDelivery(ctx, "o", 1, 2)
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
+
+func TestOrganizationsService_RedeliverHookDelivery(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/orgs/o/hooks/1/deliveries/2/attempts", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		w.WriteHeader(http.StatusAccepted)
+	})
+
+	ctx := context.Background()
+	_, err := client.Organizations.RedeliverHookDelivery(ctx, "o", 1, 2)
+	if err != nil {
+		t.Errorf("Organizations.RedeliverHookDelivery returned error: %v", err)
+	}
+
+	const methodName = "RedeliverHookDelivery"
+	testBadOptions(t, methodName, func() (err error) {
+		_, err = client.Organizations.RedeliverHookDelivery(ctx, "\n", -1, -1)
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		return client.Organizations.RedeliverHookDelivery(ctx, "o", 1, 2)
+	})
+}
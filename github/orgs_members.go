@@ -8,6 +8,7 @@ package github
 import (
 	"context"
 	"fmt"
+	"time"
 )
 
 // Membership represents the status of a user's membership in an organization or team.
@@ -362,3 +363,96 @@ func (s *OrganizationsService) ListOrgInvitationTeams(ctx context.Context, org,
 	}
 	return orgInvitationTeams, resp, nil
 }
+
+// CancelInvite cancels an organization invitation. In order to cancel
+// invitations in an organization, the authenticated user must be an
+// organization owner.
+//
+// GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/orgs/#cancel-an-organization-invitation
+func (s *OrganizationsService) CancelInvite(ctx context.Context, org string, invitationID int64) (*Response, error) {
+	u := fmt.Sprintf("orgs/%v/invitations/%v", org, invitationID)
+	req, err := s.client.NewRequest("DELETE", u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(ctx, req, nil)
+}
+
+// ListFailedOrgInvitations returns a list of failed invitations, which
+// have expired or could not be delivered. Their FailedAt and FailedReason
+// fields are set.
+//
+// GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/orgs/#list-failed-organization-invitations
+func (s *OrganizationsService) ListFailedOrgInvitations(ctx context.Context, org string, opts *ListOptions) ([]*Invitation, *Response, error) {
+	u := fmt.Sprintf("orgs/%v/failed_invitations", org)
+	u, err := addOptions(u, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var failedInvitations []*Invitation
+	resp, err := s.client.Do(ctx, req, &failedInvitations)
+	if err != nil {
+		return nil, resp, err
+	}
+	return failedInvitations, resp, nil
+}
+
+// CreateOrgInvitationsOptions specifies the optional parameters to the
+// OrganizationsService.CreateOrgInvitations method.
+type CreateOrgInvitationsOptions struct {
+	// Interval is the time to wait between two invitations, to avoid
+	// triggering GitHub's abuse rate limits. Default: 1s.
+	Interval time.Duration
+}
+
+// CreateOrgInvitations creates the given invitations one by one, waiting
+// between two invitations, as GitHub limits the rate at which invitations
+// can be created.
+//
+// CreateOrgInvitations retries the invitations that fail with a
+// *RateLimitError or an *AbuseRateLimitError, after waiting for the rate
+// limit to reset. It stops at the first other error, and returns the
+// invitations created so far, in the order of invites: the remaining
+// invitations are invites[len(invitations):]. It also returns the Response
+// of the last request made.
+//
+// GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/orgs/#create-an-organization-invitation
+func (s *OrganizationsService) CreateOrgInvitations(ctx context.Context, org string, invites []*CreateOrgInvitationOptions, opts *CreateOrgInvitationsOptions) ([]*Invitation, *Response, error) {
+	interval := time.Second
+	if opts != nil && opts.Interval > 0 {
+		interval = opts.Interval
+	}
+
+	var invitations []*Invitation
+	var resp *Response
+	for i, invite := range invites {
+		if i > 0 {
+			if err := sleepUntil(ctx, time.Now().Add(interval)); err != nil {
+				return invitations, resp, err
+			}
+		}
+
+		for {
+			var invitation *Invitation
+			var err error
+			invitation, resp, err = s.CreateOrgInvitation(ctx, org, invite)
+			if err == nil {
+				invitations = append(invitations, invitation)
+				break
+			}
+
+			if retry, err := waitForRateLimit(ctx, err); !retry {
+				return invitations, resp, err
+			}
+		}
+	}
+
+	return invitations, resp, nil
+}
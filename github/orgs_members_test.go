@@ -744,3 +744,172 @@ func TestOrganizationsService_ListOrgInvitationTeams(t *testing.T) {
 		return resp, err
 	})
 }
+
+func TestOrganizationsService_CancelInvite(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/orgs/o/invitations/1", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	ctx := context.Background()
+	_, err := client.Organizations.CancelInvite(ctx, "o", 1)
+	if err != nil {
+		t.Errorf("Organizations.CancelInvite returned error: %v", err)
+	}
+
+	const methodName = "CancelInvite"
+	testBadOptions(t, methodName, func() (err error) {
+		_, err = client.Organizations.CancelInvite(ctx, "\n", 1)
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		return client.Organizations.CancelInvite(ctx, "o", 1)
+	})
+}
+
+func TestOrganizationsService_ListFailedOrgInvitations(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/orgs/o/failed_invitations", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testFormValues(t, r, values{"page": "2", "per_page": "1"})
+		fmt.Fprint(w, `[{
+			"id": 1,
+			"login": "monalisa",
+			"email": "octocat@github.com",
+			"role": "direct_member",
+			"created_at": `+referenceTimeStr+`,
+			"inviter": {"login": "other_user"},
+			"team_count": 2,
+			"invitation_team_url": "https://api.github.com/organizations/2/invitations/1/teams",
+			"failed_at": `+referenceTimeStr+`,
+			"failed_reason": "the invitation has expired"
+		}]`)
+	})
+
+	opts := &ListOptions{Page: 2, PerPage: 1}
+	ctx := context.Background()
+	invitations, _, err := client.Organizations.ListFailedOrgInvitations(ctx, "o", opts)
+	if err != nil {
+		t.Errorf("Organizations.ListFailedOrgInvitations returned error: %v", err)
+	}
+
+	want := []*Invitation{{
+		ID:                Int64(1),
+		Login:             String("monalisa"),
+		Email:             String("octocat@github.com"),
+		Role:              String("direct_member"),
+		CreatedAt:         &referenceTime,
+		Inviter:           &User{Login: String("other_user")},
+		TeamCount:         Int(2),
+		InvitationTeamURL: String("https://api.github.com/organizations/2/invitations/1/teams"),
+		FailedAt:          &Timestamp{referenceTime},
+		FailedReason:      String("the invitation has expired"),
+	}}
+	if !reflect.DeepEqual(invitations, want) {
+		t.Errorf("Organizations.ListFailedOrgInvitations returned %+v, want %+v", invitations, want)
+	}
+
+	const methodName = "ListFailedOrgInvitations"
+	testBadOptions(t, methodName, func() (err error) {
+		_, _, err = client.Organizations.ListFailedOrgInvitations(ctx, "\n", opts)
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.Organizations.ListFailedOrgInvitations(ctx, "o", opts)
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
+
+func TestOrganizationsService_CreateOrgInvitations(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	abused := false
+	var emails []string
+	mux.HandleFunc("/orgs/o/invitations", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		v := new(CreateOrgInvitationOptions)
+		json.NewDecoder(r.Body).Decode(v)
+
+		// The second invitation hits the abuse rate limit and is retried.
+		if v.GetEmail() == "b@example.com" && !abused {
+			abused = true
+			w.Header().Set("Retry-After", "0")
+			w.WriteHeader(http.StatusForbidden)
+			fmt.Fprint(w, `{"message":"abuse","documentation_url":"https://docs.github.com/rest/overview/resources-in-the-rest-api#abuse-rate-limits"}`)
+			return
+		}
+
+		emails = append(emails, v.GetEmail())
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprintf(w, `{"email": %q}`, v.GetEmail())
+	})
+
+	invites := []*CreateOrgInvitationOptions{
+		{Email: String("a@example.com")},
+		{Email: String("b@example.com")},
+		{Email: String("c@example.com")},
+	}
+	opts := &CreateOrgInvitationsOptions{Interval: time.Millisecond}
+	ctx := context.Background()
+	invitations, _, err := client.Organizations.CreateOrgInvitations(ctx, "o", invites, opts)
+	if err != nil {
+		t.Errorf("Organizations.CreateOrgInvitations returned error: %v", err)
+	}
+
+	want := []*Invitation{
+		{Email: String("a@example.com")},
+		{Email: String("b@example.com")},
+		{Email: String("c@example.com")},
+	}
+	if !reflect.DeepEqual(invitations, want) {
+		t.Errorf("Organizations.CreateOrgInvitations returned %+v, want %+v", invitations, want)
+	}
+	if want := []string{"a@example.com", "b@example.com", "c@example.com"}; !reflect.DeepEqual(emails, want) {
+		t.Errorf("Organizations.CreateOrgInvitations invited %v, want %v", emails, want)
+	}
+}
+
+func TestOrganizationsService_CreateOrgInvitations_error(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/orgs/o/invitations", func(w http.ResponseWriter, r *http.Request) {
+		v := new(CreateOrgInvitationOptions)
+		json.NewDecoder(r.Body).Decode(v)
+		if v.GetEmail() == "b@example.com" {
+			w.WriteHeader(http.StatusUnprocessableEntity)
+			fmt.Fprint(w, `{"message":"Over invitation rate limit"}`)
+			return
+		}
+		fmt.Fprintf(w, `{"email": %q}`, v.GetEmail())
+	})
+
+	invites := []*CreateOrgInvitationOptions{
+		{Email: String("a@example.com")},
+		{Email: String("b@example.com")},
+		{Email: String("c@example.com")},
+	}
+	opts := &CreateOrgInvitationsOptions{Interval: time.Millisecond}
+	ctx := context.Background()
+	invitations, resp, err := client.Organizations.CreateOrgInvitations(ctx, "o", invites, opts)
+	if err == nil {
+		t.Errorf("Organizations.CreateOrgInvitations returned no error, want an error")
+	}
+	if resp == nil || resp.StatusCode != http.StatusUnprocessableEntity {
+		t.Errorf("Organizations.CreateOrgInvitations returned response %+v, want status %v", resp, http.StatusUnprocessableEntity)
+	}
+	if want := []*Invitation{{Email: String("a@example.com")}}; !reflect.DeepEqual(invitations, want) {
+		t.Errorf("Organizations.CreateOrgInvitations returned %+v, want %+v", invitations, want)
+	}
+}
@@ -0,0 +1,308 @@
+// Copyright 2021 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"time"
+)
+
+// ListPackages lists the packages of an organization. opts.PackageType is
+// required.
+//
+// GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/packages/#list-packages-for-an-organization
+func (s *OrganizationsService) ListPackages(ctx context.Context, org string, opts *PackageListOptions) ([]*Package, *Response, error) {
+	u := fmt.Sprintf("orgs/%v/packages", org)
+	return listPackages(ctx, s.client, u, opts)
+}
+
+// GetPackage gets a package of an organization by name.
+//
+// GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/packages/#get-a-package-for-an-organization
+func (s *OrganizationsService) GetPackage(ctx context.Context, org, packageType, packageName string) (*Package, *Response, error) {
+	u := fmt.Sprintf("orgs/%v/packages/%v/%v", org, packageType, url.PathEscape(packageName))
+	return getPackage(ctx, s.client, u)
+}
+
+// DeletePackage deletes a package of an organization.
+//
+// GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/packages/#delete-a-package-for-an-organization
+func (s *OrganizationsService) DeletePackage(ctx context.Context, org, packageType, packageName string) (*Response, error) {
+	u := fmt.Sprintf("orgs/%v/packages/%v/%v", org, packageType, url.PathEscape(packageName))
+	return sendPackageRequest(ctx, s.client, "DELETE", u)
+}
+
+// RestorePackage restores a package of an organization, which was deleted
+// less than 30 days ago.
+//
+// GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/packages/#restore-a-package-for-an-organization
+func (s *OrganizationsService) RestorePackage(ctx context.Context, org, packageType, packageName string) (*Response, error) {
+	u := fmt.Sprintf("orgs/%v/packages/%v/%v/restore", org, packageType, url.PathEscape(packageName))
+	return sendPackageRequest(ctx, s.client, "POST", u)
+}
+
+// PackageGetAllVersions lists the versions of a package of an organization.
+// opts.State can be used to list the deleted versions.
+//
+// GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/packages/#get-all-package-versions-for-a-package-owned-by-an-organization
+func (s *OrganizationsService) PackageGetAllVersions(ctx context.Context, org, packageType, packageName string, opts *PackageListOptions) ([]*PackageVersion, *Response, error) {
+	u := fmt.Sprintf("orgs/%v/packages/%v/%v/versions", org, packageType, url.PathEscape(packageName))
+	return listPackageVersions(ctx, s.client, u, opts)
+}
+
+// PackageGetVersion gets a version of a package of an organization.
+//
+// GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/packages/#get-a-package-version-for-an-organization
+func (s *OrganizationsService) PackageGetVersion(ctx context.Context, org, packageType, packageName string, packageVersionID int64) (*PackageVersion, *Response, error) {
+	u := fmt.Sprintf("orgs/%v/packages/%v/%v/versions/%v", org, packageType, url.PathEscape(packageName), packageVersionID)
+	return getPackageVersion(ctx, s.client, u)
+}
+
+// PackageDeleteVersion deletes a version of a package of an organization.
+//
+// GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/packages/#delete-package-version-for-an-organization
+func (s *OrganizationsService) PackageDeleteVersion(ctx context.Context, org, packageType, packageName string, packageVersionID int64) (*Response, error) {
+	u := fmt.Sprintf("orgs/%v/packages/%v/%v/versions/%v", org, packageType, url.PathEscape(packageName), packageVersionID)
+	return sendPackageRequest(ctx, s.client, "DELETE", u)
+}
+
+// PackageRestoreVersion restores a version of a package of an organization,
+// which was deleted less than 30 days ago.
+//
+// GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/packages/#restore-package-version-for-an-organization
+func (s *OrganizationsService) PackageRestoreVersion(ctx context.Context, org, packageType, packageName string, packageVersionID int64) (*Response, error) {
+	u := fmt.Sprintf("orgs/%v/packages/%v/%v/versions/%v/restore", org, packageType, url.PathEscape(packageName), packageVersionID)
+	return sendPackageRequest(ctx, s.client, "POST", u)
+}
+
+// listPackages lists the packages at u, which is the packages endpoint of
+// an organization or a user.
+func listPackages(ctx context.Context, client *Client, u string, opts *PackageListOptions) ([]*Package, *Response, error) {
+	u, err := addOptions(u, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var packages []*Package
+	resp, err := client.Do(ctx, req, &packages)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return packages, resp, nil
+}
+
+// getPackage gets the package at u.
+func getPackage(ctx context.Context, client *Client, u string) (*Package, *Response, error) {
+	req, err := client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	pack := new(Package)
+	resp, err := client.Do(ctx, req, pack)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return pack, resp, nil
+}
+
+// listPackageVersions lists the package versions at u.
+func listPackageVersions(ctx context.Context, client *Client, u string, opts *PackageListOptions) ([]*PackageVersion, *Response, error) {
+	u, err := addOptions(u, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var versions []*PackageVersion
+	resp, err := client.Do(ctx, req, &versions)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return versions, resp, nil
+}
+
+// getPackageVersion gets the package version at u.
+func getPackageVersion(ctx context.Context, client *Client, u string) (*PackageVersion, *Response, error) {
+	req, err := client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	version := new(PackageVersion)
+	resp, err := client.Do(ctx, req, version)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return version, resp, nil
+}
+
+// sendPackageRequest sends a request without body nor response content,
+// such as a deletion or restoration of a package or package version.
+func sendPackageRequest(ctx context.Context, client *Client, method, u string) (*Response, error) {
+	req, err := client.NewRequest(method, u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return client.Do(ctx, req, nil)
+}
+
+// containerPageSize is the page size used to go through all the versions
+// of a container package, which is the largest page size allowed by GitHub.
+const containerPageSize = 100
+
+// listAllContainerVersions lists all the active versions of the container
+// package packageName of org. It returns the Response of the last page.
+func (s *OrganizationsService) listAllContainerVersions(ctx context.Context, org, packageName string) ([]*PackageVersion, *Response, error) {
+	opts := &PackageListOptions{
+		State:       String("active"),
+		ListOptions: ListOptions{PerPage: containerPageSize},
+	}
+	var all []*PackageVersion
+	for {
+		versions, resp, err := s.PackageGetAllVersions(ctx, org, "container", packageName, opts)
+		if err != nil {
+			return nil, resp, err
+		}
+		all = append(all, versions...)
+		if resp.NextPage == 0 {
+			return all, resp, nil
+		}
+		opts.Page = resp.NextPage
+	}
+}
+
+// containerTags returns the tags of a version of a container package.
+func containerTags(v *PackageVersion) []string {
+	if v.Metadata == nil || v.Metadata.Container == nil {
+		return nil
+	}
+	return v.Metadata.Container.Tags
+}
+
+// ResolveContainerTags resolves tags of the container package packageName
+// of org to the IDs of the versions they point to. Tags which do not exist
+// are missing from the returned map. The Response of the last page of
+// versions is returned.
+func (s *OrganizationsService) ResolveContainerTags(ctx context.Context, org, packageName string, tags []string) (map[string]int64, *Response, error) {
+	versions, resp, err := s.listAllContainerVersions(ctx, org, packageName)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	wanted := make(map[string]bool, len(tags))
+	for _, tag := range tags {
+		wanted[tag] = true
+	}
+	ids := make(map[string]int64)
+	for _, v := range versions {
+		for _, tag := range containerTags(v) {
+			if wanted[tag] {
+				ids[tag] = v.GetID()
+			}
+		}
+	}
+	return ids, resp, nil
+}
+
+// ListUntaggedContainerVersions lists the versions of the container package
+// packageName of org which have no tags and were last updated before
+// cutoff, such as time.Now().AddDate(0, 0, -30) for the versions older than
+// 30 days. These are usually the versions to prune. The Response of the last
+// page of versions is returned.
+func (s *OrganizationsService) ListUntaggedContainerVersions(ctx context.Context, org, packageName string, cutoff time.Time) ([]*PackageVersion, *Response, error) {
+	versions, resp, err := s.listAllContainerVersions(ctx, org, packageName)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	var untagged []*PackageVersion
+	for _, v := range versions {
+		if len(containerTags(v)) > 0 {
+			continue
+		}
+		updatedAt := v.GetUpdatedAt()
+		if updatedAt.IsZero() {
+			updatedAt = v.GetCreatedAt()
+		}
+		if updatedAt.Before(cutoff) {
+			untagged = append(untagged, v)
+		}
+	}
+	return untagged, resp, nil
+}
+
+// DeletePackageVersionsOptions specifies the optional parameters to the
+// OrganizationsService.DeletePackageVersions method.
+type DeletePackageVersionsOptions struct {
+	// BatchSize is the number of versions deleted between pauses. Default
+	// is 10.
+	BatchSize int
+
+	// Interval is the pause between two batches, to avoid triggering the
+	// secondary rate limits of GitHub. Default is one second.
+	Interval time.Duration
+}
+
+// DeletePackageVersions deletes the versions with the given IDs of a package
+// of org, in batches. Rate limit errors are waited out. It returns the IDs
+// of the versions which were deleted, which are all of them unless an error
+// is returned, and the Response of the last request made.
+//
+// GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/packages/#delete-package-version-for-an-organization
+func (s *OrganizationsService) DeletePackageVersions(ctx context.Context, org, packageType, packageName string, versionIDs []int64, opts *DeletePackageVersionsOptions) ([]int64, *Response, error) {
+	batchSize := 10
+	interval := time.Second
+	if opts != nil {
+		if opts.BatchSize > 0 {
+			batchSize = opts.BatchSize
+		}
+		if opts.Interval > 0 {
+			interval = opts.Interval
+		}
+	}
+
+	var deleted []int64
+	var resp *Response
+	for i, id := range versionIDs {
+		if i > 0 && i%batchSize == 0 {
+			if err := sleepUntil(ctx, time.Now().Add(interval)); err != nil {
+				return deleted, resp, err
+			}
+		}
+
+		for {
+			var err error
+			resp, err = s.PackageDeleteVersion(ctx, org, packageType, packageName, id)
+			if err == nil {
+				deleted = append(deleted, id)
+				break
+			}
+
+			if retry, err := waitForRateLimit(ctx, err); !retry {
+				return deleted, resp, err
+			}
+		}
+	}
+
+	return deleted, resp, nil
+}
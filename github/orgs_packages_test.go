@@ -0,0 +1,469 @@
+// Copyright 2021 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestOrganizationsService_ListPackages(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/orgs/o/packages", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testFormValues(t, r, values{"package_type": "container", "visibility": "private", "per_page": "2", "page": "1"})
+		fmt.Fprint(w, `[{
+			"id": 197,
+			"name": "hello_docker",
+			"package_type": "container",
+			"version_count": 1,
+			"visibility": "private",
+			"url": "https://api.github.com/orgs/github/packages/container/hello_docker",
+			"created_at": `+referenceTimeStr+`,
+			"updated_at": `+referenceTimeStr+`,
+			"html_url": "https://github.com/orgs/github/packages/container/package/hello_docker"
+		}]`)
+	})
+
+	opts := &PackageListOptions{
+		PackageType: String("container"),
+		Visibility:  String("private"),
+		ListOptions: ListOptions{Page: 1, PerPage: 2},
+	}
+	ctx := context.Background()
+	packages, _, err := client.Organizations.ListPackages(ctx, "o", opts)
+	if err != nil {
+		t.Errorf("Organizations.ListPackages returned error: %v", err)
+	}
+
+	want := []*Package{{
+		ID:           Int64(197),
+		Name:         String("hello_docker"),
+		PackageType:  String("container"),
+		VersionCount: Int64(1),
+		Visibility:   String("private"),
+		URL:          String("https://api.github.com/orgs/github/packages/container/hello_docker"),
+		HTMLURL:      String("https://github.com/orgs/github/packages/container/package/hello_docker"),
+		CreatedAt:    &Timestamp{referenceTime},
+		UpdatedAt:    &Timestamp{referenceTime},
+	}}
+	if !reflect.DeepEqual(packages, want) {
+		t.Errorf("Organizations.ListPackages returned %+v, want %+v", packages, want)
+	}
+
+	const methodName = "ListPackages"
+	testBadOptions(t, methodName, func() (err error) {
+		_, _, err = client.Organizations.ListPackages(ctx, "\n", opts)
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.Organizations.ListPackages(ctx, "o", opts)
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
+
+func TestOrganizationsService_GetPackage(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	// The package name is escaped, as container names can contain slashes.
+	mux.HandleFunc("/orgs/o/packages/container/hello/hello_docker", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		if got, want := r.URL.EscapedPath(), "/orgs/o/packages/container/hello%2Fhello_docker"; got != want {
+			t.Errorf("Request path = %v, want %v", got, want)
+		}
+		fmt.Fprint(w, `{"id":197,"name":"hello/hello_docker","package_type":"container"}`)
+	})
+
+	ctx := context.Background()
+	pack, _, err := client.Organizations.GetPackage(ctx, "o", "container", "hello/hello_docker")
+	if err != nil {
+		t.Errorf("Organizations.GetPackage returned error: %v", err)
+	}
+
+	want := &Package{ID: Int64(197), Name: String("hello/hello_docker"), PackageType: String("container")}
+	if !reflect.DeepEqual(pack, want) {
+		t.Errorf("Organizations.GetPackage returned %+v, want %+v", pack, want)
+	}
+
+	const methodName = "GetPackage"
+	testBadOptions(t, methodName, func() (err error) {
+		_, _, err = client.Organizations.GetPackage(ctx, "\n", "", "")
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.Organizations.GetPackage(ctx, "o", "container", "hello/hello_docker")
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
+
+func TestOrganizationsService_DeletePackage(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/orgs/o/packages/container/hello_docker", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	ctx := context.Background()
+	if _, err := client.Organizations.DeletePackage(ctx, "o", "container", "hello_docker"); err != nil {
+		t.Errorf("Organizations.DeletePackage returned error: %v", err)
+	}
+
+	const methodName = "DeletePackage"
+	testBadOptions(t, methodName, func() (err error) {
+		_, err = client.Organizations.DeletePackage(ctx, "\n", "\n", "\n")
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		return client.Organizations.DeletePackage(ctx, "o", "container", "hello_docker")
+	})
+}
+
+func TestOrganizationsService_RestorePackage(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/orgs/o/packages/container/hello_docker/restore", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	ctx := context.Background()
+	if _, err := client.Organizations.RestorePackage(ctx, "o", "container", "hello_docker"); err != nil {
+		t.Errorf("Organizations.RestorePackage returned error: %v", err)
+	}
+
+	const methodName = "RestorePackage"
+	testBadOptions(t, methodName, func() (err error) {
+		_, err = client.Organizations.RestorePackage(ctx, "\n", "", "")
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		return client.Organizations.RestorePackage(ctx, "o", "container", "hello_docker")
+	})
+}
+
+func TestOrganizationsService_PackageGetAllVersions(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/orgs/o/packages/container/hello_docker/versions", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testFormValues(t, r, values{"state": "deleted", "per_page": "2", "page": "1"})
+		fmt.Fprint(w, `[{
+			"id": 45763,
+			"name": "sha256:08a44bab0bddaddd8837a8b381aebc2e4b933768b981685a9e088360af0d3dd9",
+			"url": "https://api.github.com/users/octocat/packages/container/hello_docker/versions/45763",
+			"package_html_url": "https://github.com/users/octocat/packages/container/package/hello_docker",
+			"created_at": `+referenceTimeStr+`,
+			"updated_at": `+referenceTimeStr+`,
+			"deleted_at": `+referenceTimeStr+`,
+			"html_url": "https://github.com/users/octocat/packages/container/hello_docker/45763",
+			"metadata": {
+				"package_type": "container",
+				"container": {
+					"tags": ["latest"]
+				}
+			}
+		}]`)
+	})
+
+	opts := &PackageListOptions{
+		State:       String("deleted"),
+		ListOptions: ListOptions{Page: 1, PerPage: 2},
+	}
+	ctx := context.Background()
+	versions, _, err := client.Organizations.PackageGetAllVersions(ctx, "o", "container", "hello_docker", opts)
+	if err != nil {
+		t.Errorf("Organizations.PackageGetAllVersions returned error: %v", err)
+	}
+
+	want := []*PackageVersion{{
+		ID:             Int64(45763),
+		Name:           String("sha256:08a44bab0bddaddd8837a8b381aebc2e4b933768b981685a9e088360af0d3dd9"),
+		URL:            String("https://api.github.com/users/octocat/packages/container/hello_docker/versions/45763"),
+		PackageHTMLURL: String("https://github.com/users/octocat/packages/container/package/hello_docker"),
+		CreatedAt:      &Timestamp{referenceTime},
+		UpdatedAt:      &Timestamp{referenceTime},
+		DeletedAt:      &Timestamp{referenceTime},
+		HTMLURL:        String("https://github.com/users/octocat/packages/container/hello_docker/45763"),
+		Metadata: &PackageMetadata{
+			PackageType: String("container"),
+			Container:   &PackageContainerMetadata{Tags: []string{"latest"}},
+		},
+	}}
+	if !reflect.DeepEqual(versions, want) {
+		t.Errorf("Organizations.PackageGetAllVersions returned %+v, want %+v", versions, want)
+	}
+
+	const methodName = "PackageGetAllVersions"
+	testBadOptions(t, methodName, func() (err error) {
+		_, _, err = client.Organizations.PackageGetAllVersions(ctx, "\n", "", "", opts)
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.Organizations.PackageGetAllVersions(ctx, "o", "container", "hello_docker", opts)
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
+
+func TestOrganizationsService_PackageGetVersion(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/orgs/o/packages/npm/hello/versions/45763", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"id":45763,"name":"1.0.0","license":"MIT","description":"d","metadata":{"package_type":"npm"}}`)
+	})
+
+	ctx := context.Background()
+	version, _, err := client.Organizations.PackageGetVersion(ctx, "o", "npm", "hello", 45763)
+	if err != nil {
+		t.Errorf("Organizations.PackageGetVersion returned error: %v", err)
+	}
+
+	want := &PackageVersion{
+		ID:          Int64(45763),
+		Name:        String("1.0.0"),
+		License:     String("MIT"),
+		Description: String("d"),
+		Metadata:    &PackageMetadata{PackageType: String("npm")},
+	}
+	if !reflect.DeepEqual(version, want) {
+		t.Errorf("Organizations.PackageGetVersion returned %+v, want %+v", version, want)
+	}
+
+	const methodName = "PackageGetVersion"
+	testBadOptions(t, methodName, func() (err error) {
+		_, _, err = client.Organizations.PackageGetVersion(ctx, "\n", "", "", 45763)
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.Organizations.PackageGetVersion(ctx, "o", "npm", "hello", 45763)
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
+
+func TestOrganizationsService_PackageDeleteVersion(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/orgs/o/packages/container/hello_docker/versions/45763", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	ctx := context.Background()
+	if _, err := client.Organizations.PackageDeleteVersion(ctx, "o", "container", "hello_docker", 45763); err != nil {
+		t.Errorf("Organizations.PackageDeleteVersion returned error: %v", err)
+	}
+
+	const methodName = "PackageDeleteVersion"
+	testBadOptions(t, methodName, func() (err error) {
+		_, err = client.Organizations.PackageDeleteVersion(ctx, "\n", "", "", 45763)
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		return client.Organizations.PackageDeleteVersion(ctx, "o", "container", "hello_docker", 45763)
+	})
+}
+
+func TestOrganizationsService_PackageRestoreVersion(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/orgs/o/packages/container/hello_docker/versions/45763/restore", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	ctx := context.Background()
+	if _, err := client.Organizations.PackageRestoreVersion(ctx, "o", "container", "hello_docker", 45763); err != nil {
+		t.Errorf("Organizations.PackageRestoreVersion returned error: %v", err)
+	}
+
+	const methodName = "PackageRestoreVersion"
+	testBadOptions(t, methodName, func() (err error) {
+		_, err = client.Organizations.PackageRestoreVersion(ctx, "\n", "", "", 45763)
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		return client.Organizations.PackageRestoreVersion(ctx, "o", "container", "hello_docker", 45763)
+	})
+}
+
+func TestPackageVersion_Marshal(t *testing.T) {
+	testJSONMarshal(t, &PackageVersion{}, "{}")
+
+	v := &PackageVersion{
+		ID:        Int64(1),
+		Name:      String("n"),
+		DeletedAt: &Timestamp{referenceTime},
+		Metadata: &PackageMetadata{
+			PackageType: String("docker"),
+			Docker:      &PackageDockerMetadata{Tags: []string{"latest"}},
+		},
+	}
+
+	want := `{
+		"id": 1,
+		"name": "n",
+		"deleted_at": ` + referenceTimeStr + `,
+		"metadata": {
+			"package_type": "docker",
+			"docker": {
+				"tag": ["latest"]
+			}
+		}
+	}`
+
+	testJSONMarshal(t, v, want)
+}
+
+func TestOrganizationsService_ContainerHelpers(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/orgs/o/packages/container/app/versions", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		switch r.FormValue("page") {
+		case "":
+			testFormValues(t, r, values{"state": "active", "per_page": "100"})
+			w.Header().Set("Link", `<https://api.github.com/orgs/o/packages/container/app/versions?page=2>; rel="next"`)
+			fmt.Fprint(w, `[
+				{"id":1,"updated_at":"2021-03-01T00:00:00Z","metadata":{"package_type":"container","container":{"tags":["latest","v2"]}}},
+				{"id":2,"updated_at":"2021-01-01T00:00:00Z","metadata":{"package_type":"container","container":{"tags":[]}}}
+			]`)
+		case "2":
+			testFormValues(t, r, values{"state": "active", "per_page": "100", "page": "2"})
+			fmt.Fprint(w, `[
+				{"id":3,"updated_at":"2021-02-20T00:00:00Z","metadata":{"package_type":"container","container":{"tags":[]}}},
+				{"id":4,"created_at":"2020-12-01T00:00:00Z","metadata":{"package_type":"container"}},
+				{"id":5,"updated_at":"2020-12-01T00:00:00Z","metadata":{"package_type":"container","container":{"tags":["v1"]}}}
+			]`)
+		}
+	})
+
+	ctx := context.Background()
+	ids, _, err := client.Organizations.ResolveContainerTags(ctx, "o", "app", []string{"latest", "v1", "missing"})
+	if err != nil {
+		t.Errorf("Organizations.ResolveContainerTags returned error: %v", err)
+	}
+	if want := map[string]int64{"latest": 1, "v1": 5}; !reflect.DeepEqual(ids, want) {
+		t.Errorf("Organizations.ResolveContainerTags returned %v, want %v", ids, want)
+	}
+
+	cutoff := time.Date(2021, time.February, 1, 0, 0, 0, 0, time.UTC)
+	untagged, _, err := client.Organizations.ListUntaggedContainerVersions(ctx, "o", "app", cutoff)
+	if err != nil {
+		t.Errorf("Organizations.ListUntaggedContainerVersions returned error: %v", err)
+	}
+	var got []int64
+	for _, v := range untagged {
+		got = append(got, v.GetID())
+	}
+	if want := []int64{2, 4}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Organizations.ListUntaggedContainerVersions returned versions %v, want %v", got, want)
+	}
+}
+
+func TestOrganizationsService_ContainerHelpers_error(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/orgs/o/packages/container/app/versions", func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, `{"message":"Not Found"}`, http.StatusNotFound)
+	})
+
+	ctx := context.Background()
+	_, resp, err := client.Organizations.ResolveContainerTags(ctx, "o", "app", []string{"latest"})
+	if err == nil {
+		t.Error("Organizations.ResolveContainerTags returned nil error")
+	}
+	if resp == nil || resp.StatusCode != http.StatusNotFound {
+		t.Errorf("Organizations.ResolveContainerTags returned response %v, want 404", resp)
+	}
+	_, resp, err = client.Organizations.ListUntaggedContainerVersions(ctx, "o", "app", time.Now())
+	if err == nil {
+		t.Error("Organizations.ListUntaggedContainerVersions returned nil error")
+	}
+	if resp == nil || resp.StatusCode != http.StatusNotFound {
+		t.Errorf("Organizations.ListUntaggedContainerVersions returned response %v, want 404", resp)
+	}
+}
+
+func TestOrganizationsService_DeletePackageVersions(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	var deleted []string
+	limited := false
+	mux.HandleFunc("/orgs/o/packages/container/app/versions/", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+		id := strings.TrimPrefix(r.URL.Path, "/orgs/o/packages/container/app/versions/")
+		if id == "2" && !limited {
+			limited = true
+			w.Header().Set("Retry-After", "0")
+			w.WriteHeader(http.StatusForbidden)
+			fmt.Fprint(w, `{"message":"You have triggered an abuse detection mechanism.","documentation_url":"https://docs.github.com/en/free-pro-team@latest/rest/overview/resources-in-the-rest-api#abuse-rate-limits"}`)
+			return
+		}
+		if id == "4" {
+			http.Error(w, `{"message":"Not Found"}`, http.StatusNotFound)
+			return
+		}
+		deleted = append(deleted, id)
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	opts := &DeletePackageVersionsOptions{BatchSize: 2, Interval: time.Millisecond}
+	ctx := context.Background()
+	got, resp, err := client.Organizations.DeletePackageVersions(ctx, "o", "container", "app", []int64{1, 2, 3, 4, 5}, opts)
+	if _, ok := err.(*ErrorResponse); !ok {
+		t.Errorf("Organizations.DeletePackageVersions returned error %v, want *ErrorResponse", err)
+	}
+	if resp == nil || resp.StatusCode != http.StatusNotFound {
+		t.Errorf("Organizations.DeletePackageVersions returned response %+v, want the 404 response", resp)
+	}
+	if want := []int64{1, 2, 3}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Organizations.DeletePackageVersions returned %v, want %v", got, want)
+	}
+	if want := []string{"1", "2", "3"}; !reflect.DeepEqual(deleted, want) {
+		t.Errorf("deleted versions = %v, want %v", deleted, want)
+	}
+	if !limited {
+		t.Error("rate limited request was not retried")
+	}
+}
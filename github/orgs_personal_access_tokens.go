@@ -0,0 +1,291 @@
+// Copyright 2021 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+)
+
+// Actions that can be taken on a fine-grained personal access token request
+// or grant.
+const (
+	PersonalAccessTokenActionApprove = "approve"
+	PersonalAccessTokenActionDeny    = "deny"
+	PersonalAccessTokenActionRevoke  = "revoke"
+)
+
+// PersonalAccessTokenPermissions represents the permissions of a
+// fine-grained personal access token, by permission type. Each map goes from
+// a permission name, e.g. "contents", to its access level, e.g. "read".
+type PersonalAccessTokenPermissions struct {
+	Org   map[string]string `json:"organization,omitempty"`
+	Repo  map[string]string `json:"repository,omitempty"`
+	Other map[string]string `json:"other,omitempty"`
+}
+
+// PersonalAccessTokenRequest represents a request of a fine-grained personal
+// access token to access the resources of an organization.
+type PersonalAccessTokenRequest struct {
+	// ID is the ID of the request.
+	ID    *int64 `json:"id,omitempty"`
+	Owner *User  `json:"owner,omitempty"`
+	// PermissionsAdded are the permissions requested that the token does not
+	// have yet, PermissionsUpgraded are the permissions whose access level is
+	// raised, and PermissionsResult are the permissions the token has if the
+	// request is approved.
+	PermissionsAdded    *PersonalAccessTokenPermissions `json:"permissions_added,omitempty"`
+	PermissionsUpgraded *PersonalAccessTokenPermissions `json:"permissions_upgraded,omitempty"`
+	PermissionsResult   *PersonalAccessTokenPermissions `json:"permissions_result,omitempty"`
+	// Possible values for RepositorySelection are: "none", "all" and "subset".
+	RepositorySelection *string       `json:"repository_selection,omitempty"`
+	RepositoryCount     *int64        `json:"repository_count,omitempty"`
+	Repositories        []*Repository `json:"repositories,omitempty"`
+	CreatedAt           *Timestamp    `json:"created_at,omitempty"`
+	TokenID             *int64        `json:"token_id,omitempty"`
+	TokenName           *string       `json:"token_name,omitempty"`
+	TokenExpired        *bool         `json:"token_expired,omitempty"`
+	TokenExpiresAt      *Timestamp    `json:"token_expires_at,omitempty"`
+	TokenLastUsedAt     *Timestamp    `json:"token_last_used_at,omitempty"`
+	Reason              *string       `json:"reason,omitempty"`
+}
+
+// PersonalAccessToken represents a fine-grained personal access token
+// granted access to the resources of an organization.
+type PersonalAccessToken struct {
+	// ID is the ID of the grant, to be used to revoke it.
+	ID          *int64                          `json:"id,omitempty"`
+	Owner       *User                           `json:"owner,omitempty"`
+	Permissions *PersonalAccessTokenPermissions `json:"permissions,omitempty"`
+	// Possible values for RepositorySelection are: "none", "all" and "subset".
+	RepositorySelection *string    `json:"repository_selection,omitempty"`
+	RepositoriesURL     *string    `json:"repositories_url,omitempty"`
+	AccessGrantedAt     *Timestamp `json:"access_granted_at,omitempty"`
+	TokenID             *int64     `json:"token_id,omitempty"`
+	TokenName           *string    `json:"token_name,omitempty"`
+	TokenExpired        *bool      `json:"token_expired,omitempty"`
+	TokenExpiresAt      *Timestamp `json:"token_expires_at,omitempty"`
+	TokenLastUsedAt     *Timestamp `json:"token_last_used_at,omitempty"`
+}
+
+// ListFineGrainedPATOptions specifies the optional parameters to the
+// OrganizationsService.ListFineGrainedPersonalAccessTokenRequests and
+// OrganizationsService.ListFineGrainedPersonalAccessTokens methods.
+type ListFineGrainedPATOptions struct {
+	// Sort specifies how to sort the results. The only possible value is
+	// "created_at".
+	Sort string `url:"sort,omitempty"`
+	// Direction in which to sort the results. Possible values are: "asc"
+	// and "desc". Default: "desc".
+	Direction string `url:"direction,omitempty"`
+	// Owner filters the results to the tokens of the given user logins.
+	Owner []string `url:"owner,omitempty,brackets"`
+	// Repository filters the results to the tokens that can access the
+	// given repository.
+	Repository string `url:"repository,omitempty"`
+	// Permission filters the results to the tokens with the given
+	// permission, e.g. "issues" or "issues:read".
+	Permission string `url:"permission,omitempty"`
+	// LastUsedBefore and LastUsedAfter filter the results to the tokens
+	// last used before or after the given ISO 8601 timestamps.
+	LastUsedBefore string `url:"last_used_before,omitempty"`
+	LastUsedAfter  string `url:"last_used_after,omitempty"`
+
+	ListOptions
+}
+
+// ReviewPersonalAccessTokenRequestOptions specifies the parameters to the
+// OrganizationsService.ReviewPersonalAccessTokenRequest method.
+type ReviewPersonalAccessTokenRequestOptions struct {
+	// Action is either "approve" or "deny".
+	Action string  `json:"action"`
+	Reason *string `json:"reason,omitempty"`
+}
+
+// ReviewPersonalAccessTokenRequestsOptions specifies the parameters to the
+// OrganizationsService.ReviewPersonalAccessTokenRequests method.
+type ReviewPersonalAccessTokenRequestsOptions struct {
+	// PATRequestIDs are the IDs of the requests to review. If empty, all the
+	// pending requests are reviewed.
+	PATRequestIDs []int64 `json:"pat_request_ids,omitempty"`
+	// Action is either "approve" or "deny".
+	Action string  `json:"action"`
+	Reason *string `json:"reason,omitempty"`
+}
+
+// revokePersonalAccessTokens represents the body of the requests revoking
+// fine-grained personal access token grants.
+type revokePersonalAccessTokens struct {
+	Action string  `json:"action"`
+	PATIDs []int64 `json:"pat_ids,omitempty"`
+}
+
+// ListFineGrainedPersonalAccessTokenRequests lists the requests of
+// fine-grained personal access tokens to access the resources of an
+// organization.
+//
+// GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/orgs/#list-requests-to-access-organization-resources-with-fine-grained-personal-access-tokens
+func (s *OrganizationsService) ListFineGrainedPersonalAccessTokenRequests(ctx context.Context, org string, opts *ListFineGrainedPATOptions) ([]*PersonalAccessTokenRequest, *Response, error) {
+	u := fmt.Sprintf("orgs/%v/personal-access-token-requests", org)
+	u, err := addOptions(u, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var requests []*PersonalAccessTokenRequest
+	resp, err := s.client.Do(ctx, req, &requests)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return requests, resp, nil
+}
+
+// ReviewPersonalAccessTokenRequest approves or denies a pending request of a
+// fine-grained personal access token to access the resources of an
+// organization.
+//
+// GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/orgs/#review-a-request-to-access-organization-resources-with-a-fine-grained-personal-access-token
+func (s *OrganizationsService) ReviewPersonalAccessTokenRequest(ctx context.Context, org string, requestID int64, opts ReviewPersonalAccessTokenRequestOptions) (*Response, error) {
+	u := fmt.Sprintf("orgs/%v/personal-access-token-requests/%v", org, requestID)
+
+	req, err := s.client.NewRequest("POST", u, &opts)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(ctx, req, nil)
+}
+
+// ReviewPersonalAccessTokenRequests approves or denies several pending
+// requests of fine-grained personal access tokens to access the resources of
+// an organization. The requests are reviewed asynchronously by GitHub.
+//
+// GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/orgs/#review-requests-to-access-organization-resources-with-fine-grained-personal-access-tokens
+func (s *OrganizationsService) ReviewPersonalAccessTokenRequests(ctx context.Context, org string, opts ReviewPersonalAccessTokenRequestsOptions) (*Response, error) {
+	u := fmt.Sprintf("orgs/%v/personal-access-token-requests", org)
+
+	req, err := s.client.NewRequest("POST", u, &opts)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.doAccepted(ctx, req)
+}
+
+// ListRepositoriesForPersonalAccessTokenRequest lists the repositories a
+// fine-grained personal access token request is requesting access to.
+//
+// GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/orgs/#list-repositories-requested-to-be-accessed-by-a-fine-grained-personal-access-token
+func (s *OrganizationsService) ListRepositoriesForPersonalAccessTokenRequest(ctx context.Context, org string, requestID int64, opts *ListOptions) ([]*Repository, *Response, error) {
+	u := fmt.Sprintf("orgs/%v/personal-access-token-requests/%v/repositories", org, requestID)
+	return s.listPersonalAccessTokenRepositories(ctx, u, opts)
+}
+
+// ListFineGrainedPersonalAccessTokens lists the fine-grained personal access
+// tokens granted access to the resources of an organization.
+//
+// GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/orgs/#list-fine-grained-personal-access-tokens-with-access-to-organization-resources
+func (s *OrganizationsService) ListFineGrainedPersonalAccessTokens(ctx context.Context, org string, opts *ListFineGrainedPATOptions) ([]*PersonalAccessToken, *Response, error) {
+	u := fmt.Sprintf("orgs/%v/personal-access-tokens", org)
+	u, err := addOptions(u, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var tokens []*PersonalAccessToken
+	resp, err := s.client.Do(ctx, req, &tokens)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return tokens, resp, nil
+}
+
+// RevokeFineGrainedPersonalAccessToken revokes the access of a fine-grained
+// personal access token to the resources of an organization. patID is the ID
+// of the grant, i.e. PersonalAccessToken.ID.
+//
+// GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/orgs/#update-the-access-a-fine-grained-personal-access-token-has-to-organization-resources
+func (s *OrganizationsService) RevokeFineGrainedPersonalAccessToken(ctx context.Context, org string, patID int64) (*Response, error) {
+	u := fmt.Sprintf("orgs/%v/personal-access-tokens/%v", org, patID)
+
+	body := &revokePersonalAccessTokens{Action: PersonalAccessTokenActionRevoke}
+	req, err := s.client.NewRequest("POST", u, body)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(ctx, req, nil)
+}
+
+// RevokeFineGrainedPersonalAccessTokens revokes the access of several
+// fine-grained personal access tokens to the resources of an organization.
+// The grants are revoked asynchronously by GitHub.
+//
+// GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/orgs/#update-the-access-to-organization-resources-via-fine-grained-personal-access-tokens
+func (s *OrganizationsService) RevokeFineGrainedPersonalAccessTokens(ctx context.Context, org string, patIDs []int64) (*Response, error) {
+	u := fmt.Sprintf("orgs/%v/personal-access-tokens", org)
+
+	body := &revokePersonalAccessTokens{Action: PersonalAccessTokenActionRevoke, PATIDs: patIDs}
+	req, err := s.client.NewRequest("POST", u, body)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.doAccepted(ctx, req)
+}
+
+// ListRepositoriesForFineGrainedPersonalAccessToken lists the repositories
+// of an organization a fine-grained personal access token has access to.
+// patID is the ID of the grant, i.e. PersonalAccessToken.ID.
+//
+// GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/orgs/#list-repositories-a-fine-grained-personal-access-token-has-access-to
+func (s *OrganizationsService) ListRepositoriesForFineGrainedPersonalAccessToken(ctx context.Context, org string, patID int64, opts *ListOptions) ([]*Repository, *Response, error) {
+	u := fmt.Sprintf("orgs/%v/personal-access-tokens/%v/repositories", org, patID)
+	return s.listPersonalAccessTokenRepositories(ctx, u, opts)
+}
+
+func (s *OrganizationsService) listPersonalAccessTokenRepositories(ctx context.Context, u string, opts *ListOptions) ([]*Repository, *Response, error) {
+	u, err := addOptions(u, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var repos []*Repository
+	resp, err := s.client.Do(ctx, req, &repos)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return repos, resp, nil
+}
+
+// doAccepted sends a request whose expected response is a 202 Accepted,
+// which is not reported as an error.
+func (s *OrganizationsService) doAccepted(ctx context.Context, req *http.Request) (*Response, error) {
+	resp, err := s.client.Do(ctx, req, nil)
+	if _, ok := err.(*AcceptedError); ok {
+		return resp, nil
+	}
+	return resp, err
+}
@@ -0,0 +1,413 @@
+// Copyright 2021 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+func TestOrganizationsService_ListFineGrainedPersonalAccessTokenRequests(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/orgs/o/personal-access-token-requests", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testFormValues(t, r, values{
+			"sort":            "created_at",
+			"direction":       "asc",
+			"owner[]":         "octocat",
+			"repository":      "r",
+			"permission":      "issues:read",
+			"last_used_after": "2021-01-01T00:00:00Z",
+			"page":            "2",
+		})
+		fmt.Fprint(w, `[
+		  {
+			"id": 25381,
+			"owner": {"login": "octocat", "id": 1},
+			"permissions_added": {"repository": {"issues": "read"}},
+			"permissions_upgraded": {"organization": {"members": "write"}},
+			"permissions_result": {"organization": {"members": "write"}, "repository": {"issues": "read", "metadata": "read"}},
+			"repository_selection": "subset",
+			"repository_count": 1,
+			"repositories": [{"id": 1296269, "full_name": "o/r"}],
+			"created_at": `+referenceTimeStr+`,
+			"token_id": 98716,
+			"token_name": "ci",
+			"token_expired": false,
+			"token_expires_at": `+referenceTimeStr+`,
+			"token_last_used_at": null,
+			"reason": "CI needs issues"
+		  }
+		]`)
+	})
+
+	opts := &ListFineGrainedPATOptions{
+		Sort:          "created_at",
+		Direction:     "asc",
+		Owner:         []string{"octocat"},
+		Repository:    "r",
+		Permission:    "issues:read",
+		LastUsedAfter: "2021-01-01T00:00:00Z",
+		ListOptions:   ListOptions{Page: 2},
+	}
+	ctx := context.Background()
+	requests, _, err := client.Organizations.ListFineGrainedPersonalAccessTokenRequests(ctx, "o", opts)
+	if err != nil {
+		t.Errorf("Organizations.ListFineGrainedPersonalAccessTokenRequests returned error: %v", err)
+	}
+
+	want := []*PersonalAccessTokenRequest{
+		{
+			ID:    Int64(25381),
+			Owner: &User{Login: String("octocat"), ID: Int64(1)},
+			PermissionsAdded: &PersonalAccessTokenPermissions{
+				Repo: map[string]string{"issues": "read"},
+			},
+			PermissionsUpgraded: &PersonalAccessTokenPermissions{
+				Org: map[string]string{"members": "write"},
+			},
+			PermissionsResult: &PersonalAccessTokenPermissions{
+				Org:  map[string]string{"members": "write"},
+				Repo: map[string]string{"issues": "read", "metadata": "read"},
+			},
+			RepositorySelection: String("subset"),
+			RepositoryCount:     Int64(1),
+			Repositories:        []*Repository{{ID: Int64(1296269), FullName: String("o/r")}},
+			CreatedAt:           &Timestamp{referenceTime},
+			TokenID:             Int64(98716),
+			TokenName:           String("ci"),
+			TokenExpired:        Bool(false),
+			TokenExpiresAt:      &Timestamp{referenceTime},
+			Reason:              String("CI needs issues"),
+		},
+	}
+	if !reflect.DeepEqual(requests, want) {
+		t.Errorf("Organizations.ListFineGrainedPersonalAccessTokenRequests returned %+v, want %+v", requests, want)
+	}
+
+	const methodName = "ListFineGrainedPersonalAccessTokenRequests"
+	testBadOptions(t, methodName, func() (err error) {
+		_, _, err = client.Organizations.ListFineGrainedPersonalAccessTokenRequests(ctx, "\n", opts)
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.Organizations.ListFineGrainedPersonalAccessTokenRequests(ctx, "o", opts)
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
+
+func TestOrganizationsService_ReviewPersonalAccessTokenRequest(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/orgs/o/personal-access-token-requests/1", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testBody(t, r, `{"action":"deny","reason":"too broad"}`+"\n")
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	opts := ReviewPersonalAccessTokenRequestOptions{
+		Action: PersonalAccessTokenActionDeny,
+		Reason: String("too broad"),
+	}
+	ctx := context.Background()
+	_, err := client.Organizations.ReviewPersonalAccessTokenRequest(ctx, "o", 1, opts)
+	if err != nil {
+		t.Errorf("Organizations.ReviewPersonalAccessTokenRequest returned error: %v", err)
+	}
+
+	const methodName = "ReviewPersonalAccessTokenRequest"
+	testBadOptions(t, methodName, func() (err error) {
+		_, err = client.Organizations.ReviewPersonalAccessTokenRequest(ctx, "\n", 1, opts)
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		return client.Organizations.ReviewPersonalAccessTokenRequest(ctx, "o", 1, opts)
+	})
+}
+
+func TestOrganizationsService_ReviewPersonalAccessTokenRequests(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/orgs/o/personal-access-token-requests", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testBody(t, r, `{"pat_request_ids":[1,2],"action":"approve"}`+"\n")
+		w.WriteHeader(http.StatusAccepted)
+		fmt.Fprint(w, `{}`)
+	})
+
+	opts := ReviewPersonalAccessTokenRequestsOptions{
+		PATRequestIDs: []int64{1, 2},
+		Action:        PersonalAccessTokenActionApprove,
+	}
+	ctx := context.Background()
+	resp, err := client.Organizations.ReviewPersonalAccessTokenRequests(ctx, "o", opts)
+	if err != nil {
+		t.Errorf("Organizations.ReviewPersonalAccessTokenRequests returned error: %v", err)
+	}
+	if resp.StatusCode != http.StatusAccepted {
+		t.Errorf("Organizations.ReviewPersonalAccessTokenRequests returned status %v, want %v", resp.StatusCode, http.StatusAccepted)
+	}
+
+	const methodName = "ReviewPersonalAccessTokenRequests"
+	testBadOptions(t, methodName, func() (err error) {
+		_, err = client.Organizations.ReviewPersonalAccessTokenRequests(ctx, "\n", opts)
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		return client.Organizations.ReviewPersonalAccessTokenRequests(ctx, "o", opts)
+	})
+}
+
+func TestOrganizationsService_ListRepositoriesForPersonalAccessTokenRequest(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/orgs/o/personal-access-token-requests/1/repositories", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testFormValues(t, r, values{"per_page": "10"})
+		fmt.Fprint(w, `[{"id": 1}]`)
+	})
+
+	opts := &ListOptions{PerPage: 10}
+	ctx := context.Background()
+	repos, _, err := client.Organizations.ListRepositoriesForPersonalAccessTokenRequest(ctx, "o", 1, opts)
+	if err != nil {
+		t.Errorf("Organizations.ListRepositoriesForPersonalAccessTokenRequest returned error: %v", err)
+	}
+
+	want := []*Repository{{ID: Int64(1)}}
+	if !reflect.DeepEqual(repos, want) {
+		t.Errorf("Organizations.ListRepositoriesForPersonalAccessTokenRequest returned %+v, want %+v", repos, want)
+	}
+
+	const methodName = "ListRepositoriesForPersonalAccessTokenRequest"
+	testBadOptions(t, methodName, func() (err error) {
+		_, _, err = client.Organizations.ListRepositoriesForPersonalAccessTokenRequest(ctx, "\n", 1, opts)
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.Organizations.ListRepositoriesForPersonalAccessTokenRequest(ctx, "o", 1, opts)
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
+
+func TestOrganizationsService_ListFineGrainedPersonalAccessTokens(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/orgs/o/personal-access-tokens", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testFormValues(t, r, values{"last_used_before": "2021-01-01T00:00:00Z"})
+		fmt.Fprint(w, `[
+		  {
+			"id": 25381,
+			"owner": {"login": "octocat"},
+			"repository_selection": "all",
+			"repositories_url": "https://api.github.com/organizations/652551/personal-access-tokens/25381/repositories",
+			"permissions": {"repository": {"contents": "write"}},
+			"access_granted_at": `+referenceTimeStr+`,
+			"token_id": 98716,
+			"token_name": "deploy",
+			"token_expired": true,
+			"token_last_used_at": `+referenceTimeStr+`
+		  }
+		]`)
+	})
+
+	opts := &ListFineGrainedPATOptions{LastUsedBefore: "2021-01-01T00:00:00Z"}
+	ctx := context.Background()
+	tokens, _, err := client.Organizations.ListFineGrainedPersonalAccessTokens(ctx, "o", opts)
+	if err != nil {
+		t.Errorf("Organizations.ListFineGrainedPersonalAccessTokens returned error: %v", err)
+	}
+
+	want := []*PersonalAccessToken{
+		{
+			ID:                  Int64(25381),
+			Owner:               &User{Login: String("octocat")},
+			RepositorySelection: String("all"),
+			RepositoriesURL:     String("https://api.github.com/organizations/652551/personal-access-tokens/25381/repositories"),
+			Permissions: &PersonalAccessTokenPermissions{
+				Repo: map[string]string{"contents": "write"},
+			},
+			AccessGrantedAt: &Timestamp{referenceTime},
+			TokenID:         Int64(98716),
+			TokenName:       String("deploy"),
+			TokenExpired:    Bool(true),
+			TokenLastUsedAt: &Timestamp{referenceTime},
+		},
+	}
+	if !reflect.DeepEqual(tokens, want) {
+		t.Errorf("Organizations.ListFineGrainedPersonalAccessTokens returned %+v, want %+v", tokens, want)
+	}
+
+	const methodName = "ListFineGrainedPersonalAccessTokens"
+	testBadOptions(t, methodName, func() (err error) {
+		_, _, err = client.Organizations.ListFineGrainedPersonalAccessTokens(ctx, "\n", opts)
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.Organizations.ListFineGrainedPersonalAccessTokens(ctx, "o", opts)
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
+
+func TestOrganizationsService_RevokeFineGrainedPersonalAccessToken(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/orgs/o/personal-access-tokens/1", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testBody(t, r, `{"action":"revoke"}`+"\n")
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	ctx := context.Background()
+	_, err := client.Organizations.RevokeFineGrainedPersonalAccessToken(ctx, "o", 1)
+	if err != nil {
+		t.Errorf("Organizations.RevokeFineGrainedPersonalAccessToken returned error: %v", err)
+	}
+
+	const methodName = "RevokeFineGrainedPersonalAccessToken"
+	testBadOptions(t, methodName, func() (err error) {
+		_, err = client.Organizations.RevokeFineGrainedPersonalAccessToken(ctx, "\n", 1)
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		return client.Organizations.RevokeFineGrainedPersonalAccessToken(ctx, "o", 1)
+	})
+}
+
+func TestOrganizationsService_RevokeFineGrainedPersonalAccessTokens(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/orgs/o/personal-access-tokens", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testBody(t, r, `{"action":"revoke","pat_ids":[1,2]}`+"\n")
+		w.WriteHeader(http.StatusAccepted)
+		fmt.Fprint(w, `{}`)
+	})
+
+	ctx := context.Background()
+	_, err := client.Organizations.RevokeFineGrainedPersonalAccessTokens(ctx, "o", []int64{1, 2})
+	if err != nil {
+		t.Errorf("Organizations.RevokeFineGrainedPersonalAccessTokens returned error: %v", err)
+	}
+
+	const methodName = "RevokeFineGrainedPersonalAccessTokens"
+	testBadOptions(t, methodName, func() (err error) {
+		_, err = client.Organizations.RevokeFineGrainedPersonalAccessTokens(ctx, "\n", []int64{1, 2})
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		return client.Organizations.RevokeFineGrainedPersonalAccessTokens(ctx, "o", []int64{1, 2})
+	})
+}
+
+func TestOrganizationsService_ListRepositoriesForFineGrainedPersonalAccessToken(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/orgs/o/personal-access-tokens/1/repositories", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testFormValues(t, r, values{"page": "2"})
+		fmt.Fprint(w, `[{"id": 1}]`)
+	})
+
+	opts := &ListOptions{Page: 2}
+	ctx := context.Background()
+	repos, _, err := client.Organizations.ListRepositoriesForFineGrainedPersonalAccessToken(ctx, "o", 1, opts)
+	if err != nil {
+		t.Errorf("Organizations.ListRepositoriesForFineGrainedPersonalAccessToken returned error: %v", err)
+	}
+
+	want := []*Repository{{ID: Int64(1)}}
+	if !reflect.DeepEqual(repos, want) {
+		t.Errorf("Organizations.ListRepositoriesForFineGrainedPersonalAccessToken returned %+v, want %+v", repos, want)
+	}
+
+	const methodName = "ListRepositoriesForFineGrainedPersonalAccessToken"
+	testBadOptions(t, methodName, func() (err error) {
+		_, _, err = client.Organizations.ListRepositoriesForFineGrainedPersonalAccessToken(ctx, "\n", 1, opts)
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.Organizations.ListRepositoriesForFineGrainedPersonalAccessToken(ctx, "o", 1, opts)
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
+
+func TestPersonalAccessToken_Marshal(t *testing.T) {
+	testJSONMarshal(t, &PersonalAccessToken{}, "{}")
+
+	u := &PersonalAccessToken{
+		ID:    Int64(1),
+		Owner: &User{Login: String("l")},
+		Permissions: &PersonalAccessTokenPermissions{
+			Org:   map[string]string{"members": "read"},
+			Repo:  map[string]string{"contents": "write"},
+			Other: map[string]string{"gists": "read"},
+		},
+		RepositorySelection: String("subset"),
+		RepositoriesURL:     String("u"),
+		AccessGrantedAt:     &Timestamp{referenceTime},
+		TokenID:             Int64(2),
+		TokenName:           String("n"),
+		TokenExpired:        Bool(false),
+		TokenExpiresAt:      &Timestamp{referenceTime},
+		TokenLastUsedAt:     &Timestamp{referenceTime},
+	}
+
+	want := `{
+		"id": 1,
+		"owner": {
+			"login": "l"
+		},
+		"permissions": {
+			"organization": {"members": "read"},
+			"repository": {"contents": "write"},
+			"other": {"gists": "read"}
+		},
+		"repository_selection": "subset",
+		"repositories_url": "u",
+		"access_granted_at": ` + referenceTimeStr + `,
+		"token_id": 2,
+		"token_name": "n",
+		"token_expired": false,
+		"token_expires_at": ` + referenceTimeStr + `,
+		"token_last_used_at": ` + referenceTimeStr + `
+	}`
+
+	testJSONMarshal(t, u, want)
+}
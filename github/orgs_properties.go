@@ -0,0 +1,203 @@
+// Copyright 2021 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"fmt"
+)
+
+// CustomProperty represents an organization custom property object.
+type CustomProperty struct {
+	// PropertyName is required for most endpoints except when calling CreateOrUpdateCustomProperty;
+	// where this is sent in the path and thus can be omitted.
+	PropertyName *string `json:"property_name,omitempty"`
+	// ValueType is the type of the value for the property. Can be one of: string, single_select.
+	ValueType *string `json:"value_type,omitempty"`
+	// Required indicates whether the property is required.
+	Required *bool `json:"required,omitempty"`
+	// DefaultValue is the default value of the property.
+	DefaultValue *string `json:"default_value,omitempty"`
+	// Description is a short description of the property.
+	Description *string `json:"description,omitempty"`
+	// AllowedValues is an ordered list of the allowed values of the property.
+	// The property can have up to 200 allowed values.
+	AllowedValues []string `json:"allowed_values,omitempty"`
+}
+
+// RepoCustomPropertyValue represents a repository custom property value.
+type RepoCustomPropertyValue struct {
+	RepositoryID       *int64                 `json:"repository_id,omitempty"`
+	RepositoryName     *string                `json:"repository_name,omitempty"`
+	RepositoryFullName *string                `json:"repository_full_name,omitempty"`
+	Properties         []*CustomPropertyValue `json:"properties,omitempty"`
+}
+
+// CustomPropertyValue represents a custom property value.
+//
+// A nil Value removes the property value from the repository.
+type CustomPropertyValue struct {
+	PropertyName string  `json:"property_name"`
+	Value        *string `json:"value"`
+}
+
+// ListCustomPropertyValuesOptions specifies the optional parameters to the
+// OrganizationsService.ListCustomPropertyValues method.
+type ListCustomPropertyValuesOptions struct {
+	// RepositoryQuery finds repositories based on a search query,
+	// e.g. "props.environment:production".
+	RepositoryQuery string `url:"repository_query,omitempty"`
+
+	ListOptions
+}
+
+// GetAllCustomProperties gets all custom properties that are defined for the specified organization.
+//
+// GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/orgs/#get-all-custom-properties-for-an-organization
+func (s *OrganizationsService) GetAllCustomProperties(ctx context.Context, org string) ([]*CustomProperty, *Response, error) {
+	u := fmt.Sprintf("orgs/%v/properties/schema", org)
+
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var customProperties []*CustomProperty
+	resp, err := s.client.Do(ctx, req, &customProperties)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return customProperties, resp, nil
+}
+
+// CreateOrUpdateCustomProperties creates new or updates existing custom properties that are defined for the specified organization.
+//
+// GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/orgs/#create-or-update-custom-properties-for-an-organization
+func (s *OrganizationsService) CreateOrUpdateCustomProperties(ctx context.Context, org string, properties []*CustomProperty) ([]*CustomProperty, *Response, error) {
+	u := fmt.Sprintf("orgs/%v/properties/schema", org)
+
+	params := struct {
+		Properties []*CustomProperty `json:"properties"`
+	}{
+		Properties: properties,
+	}
+
+	req, err := s.client.NewRequest("PATCH", u, params)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var customProperties []*CustomProperty
+	resp, err := s.client.Do(ctx, req, &customProperties)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return customProperties, resp, nil
+}
+
+// GetCustomProperty gets a custom property that is defined for the specified organization.
+//
+// GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/orgs/#get-a-custom-property-for-an-organization
+func (s *OrganizationsService) GetCustomProperty(ctx context.Context, org, name string) (*CustomProperty, *Response, error) {
+	u := fmt.Sprintf("orgs/%v/properties/schema/%v", org, name)
+
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	customProperty := new(CustomProperty)
+	resp, err := s.client.Do(ctx, req, customProperty)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return customProperty, resp, nil
+}
+
+// CreateOrUpdateCustomProperty creates a new or updates an existing custom property that is defined for the specified organization.
+//
+// GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/orgs/#create-or-update-a-custom-property-for-an-organization
+func (s *OrganizationsService) CreateOrUpdateCustomProperty(ctx context.Context, org, customPropertyName string, property *CustomProperty) (*CustomProperty, *Response, error) {
+	u := fmt.Sprintf("orgs/%v/properties/schema/%v", org, customPropertyName)
+
+	req, err := s.client.NewRequest("PUT", u, property)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	customProperty := new(CustomProperty)
+	resp, err := s.client.Do(ctx, req, customProperty)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return customProperty, resp, nil
+}
+
+// RemoveCustomProperty removes a custom property that is defined for the specified organization.
+//
+// GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/orgs/#remove-a-custom-property-for-an-organization
+func (s *OrganizationsService) RemoveCustomProperty(ctx context.Context, org, customPropertyName string) (*Response, error) {
+	u := fmt.Sprintf("orgs/%v/properties/schema/%v", org, customPropertyName)
+
+	req, err := s.client.NewRequest("DELETE", u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(ctx, req, nil)
+}
+
+// ListCustomPropertyValues lists all custom property values for repositories in the specified organization.
+// Use opts.RepositoryQuery to filter the repositories by their property values.
+//
+// GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/orgs/#list-custom-property-values-for-organization-repositories
+func (s *OrganizationsService) ListCustomPropertyValues(ctx context.Context, org string, opts *ListCustomPropertyValuesOptions) ([]*RepoCustomPropertyValue, *Response, error) {
+	u := fmt.Sprintf("orgs/%v/properties/values", org)
+	u, err := addOptions(u, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var repoCustomPropertyValues []*RepoCustomPropertyValue
+	resp, err := s.client.Do(ctx, req, &repoCustomPropertyValues)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return repoCustomPropertyValues, resp, nil
+}
+
+// CreateOrUpdateRepoCustomPropertyValues creates new or updates existing custom property values across multiple repositories for the specified organization.
+// A maximum of 30 repositories can be updated in a single request.
+//
+// GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/orgs/#create-or-update-custom-property-values-for-organization-repositories
+func (s *OrganizationsService) CreateOrUpdateRepoCustomPropertyValues(ctx context.Context, org string, repoNames []string, properties []*CustomPropertyValue) (*Response, error) {
+	u := fmt.Sprintf("orgs/%v/properties/values", org)
+
+	params := struct {
+		RepositoryNames []string               `json:"repository_names"`
+		Properties      []*CustomPropertyValue `json:"properties"`
+	}{
+		RepositoryNames: repoNames,
+		Properties:      properties,
+	}
+
+	req, err := s.client.NewRequest("PATCH", u, params)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(ctx, req, nil)
+}
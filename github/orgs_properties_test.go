@@ -0,0 +1,409 @@
+// Copyright 2021 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+func TestOrganizationsService_GetAllCustomProperties(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/orgs/o/properties/schema", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `[
+		{
+			"property_name": "name",
+			"value_type": "single_select",
+			"required": true,
+			"default_value": "production",
+			"description": "Prod or dev environment",
+			"allowed_values":[
+				"production",
+				"development"
+			]
+		},
+		{
+			"property_name": "service",
+			"value_type": "string"
+		}]`)
+	})
+
+	ctx := context.Background()
+	properties, _, err := client.Organizations.GetAllCustomProperties(ctx, "o")
+	if err != nil {
+		t.Errorf("Organizations.GetAllCustomProperties returned error: %v", err)
+	}
+
+	want := []*CustomProperty{
+		{
+			PropertyName:  String("name"),
+			ValueType:     String("single_select"),
+			Required:      Bool(true),
+			DefaultValue:  String("production"),
+			Description:   String("Prod or dev environment"),
+			AllowedValues: []string{"production", "development"},
+		},
+		{
+			PropertyName: String("service"),
+			ValueType:    String("string"),
+		},
+	}
+	if !reflect.DeepEqual(properties, want) {
+		t.Errorf("Organizations.GetAllCustomProperties returned %+v, want %+v", properties, want)
+	}
+
+	const methodName = "GetAllCustomProperties"
+	testBadOptions(t, methodName, func() (err error) {
+		_, _, err = client.Organizations.GetAllCustomProperties(ctx, "\n")
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.Organizations.GetAllCustomProperties(ctx, "o")
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
+
+func TestOrganizationsService_CreateOrUpdateCustomProperties(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/orgs/o/properties/schema", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PATCH")
+		testBody(t, r, `{"properties":[{"property_name":"name","value_type":"single_select","required":true},{"property_name":"service","value_type":"string"}]}`+"\n")
+		fmt.Fprint(w, `[
+		{
+			"property_name": "name",
+			"value_type": "single_select",
+			"required": true
+		},
+		{
+			"property_name": "service",
+			"value_type": "string"
+		}]`)
+	})
+
+	ctx := context.Background()
+	properties, _, err := client.Organizations.CreateOrUpdateCustomProperties(ctx, "o", []*CustomProperty{
+		{
+			PropertyName: String("name"),
+			ValueType:    String("single_select"),
+			Required:     Bool(true),
+		},
+		{
+			PropertyName: String("service"),
+			ValueType:    String("string"),
+		},
+	})
+	if err != nil {
+		t.Errorf("Organizations.CreateOrUpdateCustomProperties returned error: %v", err)
+	}
+
+	want := []*CustomProperty{
+		{
+			PropertyName: String("name"),
+			ValueType:    String("single_select"),
+			Required:     Bool(true),
+		},
+		{
+			PropertyName: String("service"),
+			ValueType:    String("string"),
+		},
+	}
+	if !reflect.DeepEqual(properties, want) {
+		t.Errorf("Organizations.CreateOrUpdateCustomProperties returned %+v, want %+v", properties, want)
+	}
+
+	const methodName = "CreateOrUpdateCustomProperties"
+	testBadOptions(t, methodName, func() (err error) {
+		_, _, err = client.Organizations.CreateOrUpdateCustomProperties(ctx, "\n", nil)
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.Organizations.CreateOrUpdateCustomProperties(ctx, "o", nil)
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
+
+func TestOrganizationsService_GetCustomProperty(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/orgs/o/properties/schema/name", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{
+			"property_name": "name",
+			"value_type": "single_select",
+			"required": true,
+			"default_value": "production",
+			"description": "Prod or dev environment",
+			"allowed_values":[
+				"production",
+				"development"
+			]
+		}`)
+	})
+
+	ctx := context.Background()
+	property, _, err := client.Organizations.GetCustomProperty(ctx, "o", "name")
+	if err != nil {
+		t.Errorf("Organizations.GetCustomProperty returned error: %v", err)
+	}
+
+	want := &CustomProperty{
+		PropertyName:  String("name"),
+		ValueType:     String("single_select"),
+		Required:      Bool(true),
+		DefaultValue:  String("production"),
+		Description:   String("Prod or dev environment"),
+		AllowedValues: []string{"production", "development"},
+	}
+	if !reflect.DeepEqual(property, want) {
+		t.Errorf("Organizations.GetCustomProperty returned %+v, want %+v", property, want)
+	}
+
+	const methodName = "GetCustomProperty"
+	testBadOptions(t, methodName, func() (err error) {
+		_, _, err = client.Organizations.GetCustomProperty(ctx, "\n", "\n")
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.Organizations.GetCustomProperty(ctx, "o", "name")
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
+
+func TestOrganizationsService_CreateOrUpdateCustomProperty(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/orgs/o/properties/schema/name", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PUT")
+		testBody(t, r, `{"value_type":"single_select","required":true,"default_value":"production","description":"Prod or dev environment","allowed_values":["production","development"]}`+"\n")
+		fmt.Fprint(w, `{
+			"property_name": "name",
+			"value_type": "single_select",
+			"required": true,
+			"default_value": "production",
+			"description": "Prod or dev environment",
+			"allowed_values":[
+				"production",
+				"development"
+			]
+		}`)
+	})
+
+	ctx := context.Background()
+	property, _, err := client.Organizations.CreateOrUpdateCustomProperty(ctx, "o", "name", &CustomProperty{
+		ValueType:     String("single_select"),
+		Required:      Bool(true),
+		DefaultValue:  String("production"),
+		Description:   String("Prod or dev environment"),
+		AllowedValues: []string{"production", "development"},
+	})
+	if err != nil {
+		t.Errorf("Organizations.CreateOrUpdateCustomProperty returned error: %v", err)
+	}
+
+	want := &CustomProperty{
+		PropertyName:  String("name"),
+		ValueType:     String("single_select"),
+		Required:      Bool(true),
+		DefaultValue:  String("production"),
+		Description:   String("Prod or dev environment"),
+		AllowedValues: []string{"production", "development"},
+	}
+	if !reflect.DeepEqual(property, want) {
+		t.Errorf("Organizations.CreateOrUpdateCustomProperty returned %+v, want %+v", property, want)
+	}
+
+	const methodName = "CreateOrUpdateCustomProperty"
+	testBadOptions(t, methodName, func() (err error) {
+		_, _, err = client.Organizations.CreateOrUpdateCustomProperty(ctx, "\n", "\n", nil)
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.Organizations.CreateOrUpdateCustomProperty(ctx, "o", "name", nil)
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
+
+func TestOrganizationsService_RemoveCustomProperty(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/orgs/o/properties/schema/name", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+	})
+
+	ctx := context.Background()
+	_, err := client.Organizations.RemoveCustomProperty(ctx, "o", "name")
+	if err != nil {
+		t.Errorf("Organizations.RemoveCustomProperty returned error: %v", err)
+	}
+
+	const methodName = "RemoveCustomProperty"
+	testBadOptions(t, methodName, func() (err error) {
+		_, err = client.Organizations.RemoveCustomProperty(ctx, "\n", "\n")
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		return client.Organizations.RemoveCustomProperty(ctx, "o", "name")
+	})
+}
+
+func TestOrganizationsService_ListCustomPropertyValues(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/orgs/o/properties/values", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testFormValues(t, r, values{
+			"repository_query": "props.environment:production",
+			"page":             "1",
+			"per_page":         "100",
+		})
+		fmt.Fprint(w, `[{
+		"repository_id": 1296269,
+		"repository_name": "Hello-World",
+		"repository_full_name": "octocat/Hello-World",
+		"properties": [
+		{
+			"property_name": "environment",
+			"value": "production"
+		},
+		{
+			"property_name": "service",
+			"value": null
+		}
+		]
+        }]`)
+	})
+
+	ctx := context.Background()
+	opts := &ListCustomPropertyValuesOptions{
+		RepositoryQuery: "props.environment:production",
+		ListOptions:     ListOptions{Page: 1, PerPage: 100},
+	}
+	repoPropertyValues, _, err := client.Organizations.ListCustomPropertyValues(ctx, "o", opts)
+	if err != nil {
+		t.Errorf("Organizations.ListCustomPropertyValues returned error: %v", err)
+	}
+
+	want := []*RepoCustomPropertyValue{
+		{
+			RepositoryID:       Int64(1296269),
+			RepositoryName:     String("Hello-World"),
+			RepositoryFullName: String("octocat/Hello-World"),
+			Properties: []*CustomPropertyValue{
+				{
+					PropertyName: "environment",
+					Value:        String("production"),
+				},
+				{
+					PropertyName: "service",
+				},
+			},
+		},
+	}
+	if !reflect.DeepEqual(repoPropertyValues, want) {
+		t.Errorf("Organizations.ListCustomPropertyValues returned %+v, want %+v", repoPropertyValues, want)
+	}
+
+	const methodName = "ListCustomPropertyValues"
+	testBadOptions(t, methodName, func() (err error) {
+		_, _, err = client.Organizations.ListCustomPropertyValues(ctx, "\n", opts)
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.Organizations.ListCustomPropertyValues(ctx, "o", nil)
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
+
+func TestOrganizationsService_CreateOrUpdateRepoCustomPropertyValues(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/orgs/o/properties/values", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PATCH")
+		testBody(t, r, `{"repository_names":["repo"],"properties":[{"property_name":"service","value":"string"},{"property_name":"team","value":null}]}`+"\n")
+	})
+
+	ctx := context.Background()
+	_, err := client.Organizations.CreateOrUpdateRepoCustomPropertyValues(ctx, "o", []string{"repo"}, []*CustomPropertyValue{
+		{
+			PropertyName: "service",
+			Value:        String("string"),
+		},
+		{
+			PropertyName: "team",
+		},
+	})
+	if err != nil {
+		t.Errorf("Organizations.CreateOrUpdateRepoCustomPropertyValues returned error: %v", err)
+	}
+
+	const methodName = "CreateOrUpdateRepoCustomPropertyValues"
+	testBadOptions(t, methodName, func() (err error) {
+		_, err = client.Organizations.CreateOrUpdateRepoCustomPropertyValues(ctx, "\n", nil, nil)
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		return client.Organizations.CreateOrUpdateRepoCustomPropertyValues(ctx, "o", nil, nil)
+	})
+}
+
+func TestCustomProperty_Marshal(t *testing.T) {
+	testJSONMarshal(t, &CustomProperty{}, "{}")
+
+	u := &CustomProperty{
+		PropertyName:  String("name"),
+		ValueType:     String("single_select"),
+		Required:      Bool(true),
+		DefaultValue:  String("production"),
+		Description:   String("Prod or dev environment"),
+		AllowedValues: []string{"production", "development"},
+	}
+
+	want := `{
+		"property_name": "name",
+		"value_type": "single_select",
+		"required": true,
+		"default_value": "production",
+		"description": "Prod or dev environment",
+		"allowed_values": ["production", "development"]
+	}`
+
+	testJSONMarshal(t, u, want)
+}
@@ -0,0 +1,212 @@
+// Copyright 2021 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"fmt"
+)
+
+// RuleSuite represents the evaluation of the rulesets of an organization
+// against a push.
+type RuleSuite struct {
+	ID             *int64     `json:"id,omitempty"`
+	ActorID        *int64     `json:"actor_id,omitempty"`
+	ActorName      *string    `json:"actor_name,omitempty"`
+	BeforeSHA      *string    `json:"before_sha,omitempty"`
+	AfterSHA       *string    `json:"after_sha,omitempty"`
+	Ref            *string    `json:"ref,omitempty"`
+	RepositoryID   *int64     `json:"repository_id,omitempty"`
+	RepositoryName *string    `json:"repository_name,omitempty"`
+	PushedAt       *Timestamp `json:"pushed_at,omitempty"`
+	// Possible values for Result are: pass, fail, bypass
+	Result *string `json:"result,omitempty"`
+	// EvaluationResult is the result of the rules in evaluate mode, and is
+	// only set by GetRuleSuite. Possible values are: pass, fail
+	EvaluationResult *string           `json:"evaluation_result,omitempty"`
+	RuleEvaluations  []*RuleEvaluation `json:"rule_evaluations,omitempty"`
+}
+
+// RuleEvaluationSource represents the ruleset or protected branch a rule
+// evaluated in a rule suite comes from.
+type RuleEvaluationSource struct {
+	// Possible values for Type are: ruleset, protected_branch
+	Type *string `json:"type,omitempty"`
+	ID   *int64  `json:"id,omitempty"`
+	Name *string `json:"name,omitempty"`
+}
+
+// RuleEvaluation represents the evaluation of a single rule in a rule suite.
+type RuleEvaluation struct {
+	RuleSource *RuleEvaluationSource `json:"rule_source,omitempty"`
+	// Possible values for Enforcement are: active, evaluate, deleted ruleset
+	Enforcement *string `json:"enforcement,omitempty"`
+	// Possible values for Result are: pass, fail
+	Result   *string `json:"result,omitempty"`
+	RuleType *string `json:"rule_type,omitempty"`
+	Details  *string `json:"details,omitempty"`
+}
+
+// ListRuleSuitesOptions specifies the optional parameters to the
+// OrganizationsService.ListRuleSuites method.
+type ListRuleSuitesOptions struct {
+	// Ref filters the rule suites to a ref, e.g. "refs/heads/main".
+	Ref string `url:"ref,omitempty"`
+	// RepositoryName filters the rule suites to a repository of the organization.
+	RepositoryName string `url:"repository_name,omitempty"`
+	// TimePeriod filters the rule suites by time period.
+	// Can be one of: hour, day, week, month. Default: day.
+	TimePeriod string `url:"time_period,omitempty"`
+	// ActorName filters the rule suites to those triggered by the given user login.
+	ActorName string `url:"actor_name,omitempty"`
+	// RuleSuiteResult filters the rule suites by result.
+	// Can be one of: pass, fail, bypass, all. Default: all.
+	RuleSuiteResult string `url:"rule_suite_result,omitempty"`
+
+	ListOptions
+}
+
+// GetAllOrganizationRulesets gets all the rulesets of the specified organization.
+//
+// GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/orgs/#get-all-organization-repository-rulesets
+func (s *OrganizationsService) GetAllOrganizationRulesets(ctx context.Context, org string) ([]*Ruleset, *Response, error) {
+	u := fmt.Sprintf("orgs/%v/rulesets", org)
+
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var rulesets []*Ruleset
+	resp, err := s.client.Do(ctx, req, &rulesets)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return rulesets, resp, nil
+}
+
+// CreateOrganizationRuleset creates a ruleset for the specified organization.
+// The repositories it applies to are selected with one of the repository
+// conditions of its Conditions.
+//
+// GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/orgs/#create-an-organization-repository-ruleset
+func (s *OrganizationsService) CreateOrganizationRuleset(ctx context.Context, org string, rs *Ruleset) (*Ruleset, *Response, error) {
+	u := fmt.Sprintf("orgs/%v/rulesets", org)
+
+	req, err := s.client.NewRequest("POST", u, rs)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	ruleset := new(Ruleset)
+	resp, err := s.client.Do(ctx, req, ruleset)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return ruleset, resp, nil
+}
+
+// GetOrganizationRuleset gets a ruleset of the specified organization.
+//
+// GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/orgs/#get-an-organization-repository-ruleset
+func (s *OrganizationsService) GetOrganizationRuleset(ctx context.Context, org string, rulesetID int64) (*Ruleset, *Response, error) {
+	u := fmt.Sprintf("orgs/%v/rulesets/%v", org, rulesetID)
+
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	ruleset := new(Ruleset)
+	resp, err := s.client.Do(ctx, req, ruleset)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return ruleset, resp, nil
+}
+
+// UpdateOrganizationRuleset updates a ruleset of the specified organization.
+//
+// GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/orgs/#update-an-organization-repository-ruleset
+func (s *OrganizationsService) UpdateOrganizationRuleset(ctx context.Context, org string, rulesetID int64, rs *Ruleset) (*Ruleset, *Response, error) {
+	u := fmt.Sprintf("orgs/%v/rulesets/%v", org, rulesetID)
+
+	req, err := s.client.NewRequest("PUT", u, rs)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	ruleset := new(Ruleset)
+	resp, err := s.client.Do(ctx, req, ruleset)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return ruleset, resp, nil
+}
+
+// DeleteOrganizationRuleset deletes a ruleset of the specified organization.
+//
+// GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/orgs/#delete-an-organization-repository-ruleset
+func (s *OrganizationsService) DeleteOrganizationRuleset(ctx context.Context, org string, rulesetID int64) (*Response, error) {
+	u := fmt.Sprintf("orgs/%v/rulesets/%v", org, rulesetID)
+
+	req, err := s.client.NewRequest("DELETE", u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(ctx, req, nil)
+}
+
+// ListRuleSuites lists the evaluations of the rulesets of the specified
+// organization against the pushes to its repositories.
+//
+// GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/orgs/#list-organization-rule-suites
+func (s *OrganizationsService) ListRuleSuites(ctx context.Context, org string, opts *ListRuleSuitesOptions) ([]*RuleSuite, *Response, error) {
+	u := fmt.Sprintf("orgs/%v/rulesets/rule-suites", org)
+	u, err := addOptions(u, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var ruleSuites []*RuleSuite
+	resp, err := s.client.Do(ctx, req, &ruleSuites)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return ruleSuites, resp, nil
+}
+
+// GetRuleSuite gets an evaluation of the rulesets of the specified
+// organization, with the results of the individual rules.
+//
+// GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/orgs/#get-an-organization-rule-suite
+func (s *OrganizationsService) GetRuleSuite(ctx context.Context, org string, ruleSuiteID int64) (*RuleSuite, *Response, error) {
+	u := fmt.Sprintf("orgs/%v/rulesets/rule-suites/%v", org, ruleSuiteID)
+
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	ruleSuite := new(RuleSuite)
+	resp, err := s.client.Do(ctx, req, ruleSuite)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return ruleSuite, resp, nil
+}
@@ -0,0 +1,428 @@
+// Copyright 2021 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+func TestOrganizationsService_GetAllOrganizationRulesets(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/orgs/o/rulesets", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `[{
+			"id": 26110,
+			"name": "test ruleset",
+			"target": "branch",
+			"source_type": "Organization",
+			"source": "o",
+			"enforcement": "active",
+			"bypass_mode": "none",
+			"node_id": "nid",
+			"_links": {
+			  "self": {
+				"href": "https://api.github.com/orgs/o/rulesets/26110"
+			  }
+			}
+		}]`)
+	})
+
+	ctx := context.Background()
+	rulesets, _, err := client.Organizations.GetAllOrganizationRulesets(ctx, "o")
+	if err != nil {
+		t.Errorf("Organizations.GetAllOrganizationRulesets returned error: %v", err)
+	}
+
+	want := []*Ruleset{{
+		ID:          Int64(26110),
+		Name:        "test ruleset",
+		Target:      String("branch"),
+		SourceType:  String("Organization"),
+		Source:      String("o"),
+		Enforcement: "active",
+		NodeID:      String("nid"),
+		Links: &RulesetLinks{
+			Self: &RulesetLink{HRef: String("https://api.github.com/orgs/o/rulesets/26110")},
+		},
+	}}
+	if !reflect.DeepEqual(rulesets, want) {
+		t.Errorf("Organizations.GetAllOrganizationRulesets returned %+v, want %+v", rulesets, want)
+	}
+
+	const methodName = "GetAllOrganizationRulesets"
+	testBadOptions(t, methodName, func() (err error) {
+		_, _, err = client.Organizations.GetAllOrganizationRulesets(ctx, "\n")
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.Organizations.GetAllOrganizationRulesets(ctx, "o")
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
+
+func TestOrganizationsService_CreateOrganizationRuleset_RepoNames(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/orgs/o/rulesets", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testBody(t, r, `{"name":"ruleset","target":"branch","enforcement":"active","bypass_actors":[{"actor_id":234,"actor_type":"Team","bypass_mode":"always"}],"conditions":{"ref_name":{"include":["~DEFAULT_BRANCH"],"exclude":[]},"repository_name":{"include":["important_repository"],"exclude":["unimportant_repository"],"protected":true}},"rules":[{"type":"deletion"}]}`+"\n")
+		fmt.Fprint(w, `{
+			"id": 21,
+			"name": "ruleset",
+			"target": "branch",
+			"source_type": "Organization",
+			"source": "o",
+			"enforcement": "active",
+			"conditions": {
+				"ref_name": {"include": ["~DEFAULT_BRANCH"], "exclude": []},
+				"repository_name": {"include": ["important_repository"], "exclude": ["unimportant_repository"], "protected": true}
+			},
+			"rules": [{"type": "deletion"}]
+		}`)
+	})
+
+	rs := &Ruleset{
+		Name:        "ruleset",
+		Target:      String("branch"),
+		Enforcement: "active",
+		BypassActors: []*BypassActor{
+			{ActorID: Int64(234), ActorType: String("Team"), BypassMode: String("always")},
+		},
+		Conditions: &RulesetConditions{
+			RefName: &RulesetRefConditionParameters{
+				Include: []string{"~DEFAULT_BRANCH"},
+				Exclude: []string{},
+			},
+			RepositoryName: &RulesetRepositoryNamesConditionParameters{
+				Include:   []string{"important_repository"},
+				Exclude:   []string{"unimportant_repository"},
+				Protected: Bool(true),
+			},
+		},
+		Rules: []*RepositoryRule{NewDeletionRule()},
+	}
+	ctx := context.Background()
+	ruleset, _, err := client.Organizations.CreateOrganizationRuleset(ctx, "o", rs)
+	if err != nil {
+		t.Errorf("Organizations.CreateOrganizationRuleset returned error: %v", err)
+	}
+
+	want := &Ruleset{
+		ID:          Int64(21),
+		Name:        "ruleset",
+		Target:      String("branch"),
+		SourceType:  String("Organization"),
+		Source:      String("o"),
+		Enforcement: "active",
+		Conditions:  rs.Conditions,
+		Rules:       []*RepositoryRule{NewDeletionRule()},
+	}
+	if !reflect.DeepEqual(ruleset, want) {
+		t.Errorf("Organizations.CreateOrganizationRuleset returned %+v, want %+v", ruleset, want)
+	}
+
+	const methodName = "CreateOrganizationRuleset"
+	testBadOptions(t, methodName, func() (err error) {
+		_, _, err = client.Organizations.CreateOrganizationRuleset(ctx, "\n", rs)
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.Organizations.CreateOrganizationRuleset(ctx, "o", rs)
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
+
+func TestOrganizationsService_CreateOrganizationRuleset_RepoProperty(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/orgs/o/rulesets", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testBody(t, r, `{"name":"ruleset","enforcement":"evaluate","conditions":{"ref_name":{"include":["~ALL"],"exclude":[]},"repository_property":{"include":[{"name":"environment","property_values":["production"],"source":"custom"}],"exclude":[]}}}`+"\n")
+		fmt.Fprint(w, `{"id": 21, "name": "ruleset", "enforcement": "evaluate"}`)
+	})
+
+	rs := &Ruleset{
+		Name:        "ruleset",
+		Enforcement: "evaluate",
+		Conditions: &RulesetConditions{
+			RefName: &RulesetRefConditionParameters{
+				Include: []string{"~ALL"},
+				Exclude: []string{},
+			},
+			RepositoryProperty: &RulesetRepositoryPropertyConditionParameters{
+				Include: []*RulesetRepositoryPropertyTargetParameters{
+					{Name: "environment", Values: []string{"production"}, Source: String("custom")},
+				},
+				Exclude: []*RulesetRepositoryPropertyTargetParameters{},
+			},
+		},
+	}
+	ctx := context.Background()
+	ruleset, _, err := client.Organizations.CreateOrganizationRuleset(ctx, "o", rs)
+	if err != nil {
+		t.Errorf("Organizations.CreateOrganizationRuleset returned error: %v", err)
+	}
+
+	want := &Ruleset{ID: Int64(21), Name: "ruleset", Enforcement: "evaluate"}
+	if !reflect.DeepEqual(ruleset, want) {
+		t.Errorf("Organizations.CreateOrganizationRuleset returned %+v, want %+v", ruleset, want)
+	}
+}
+
+func TestOrganizationsService_GetOrganizationRuleset(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/orgs/o/rulesets/26110", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{
+			"id": 26110,
+			"name": "test ruleset",
+			"enforcement": "active",
+			"conditions": {"repository_id": {"repository_ids": [1, 2]}}
+		}`)
+	})
+
+	ctx := context.Background()
+	ruleset, _, err := client.Organizations.GetOrganizationRuleset(ctx, "o", 26110)
+	if err != nil {
+		t.Errorf("Organizations.GetOrganizationRuleset returned error: %v", err)
+	}
+
+	want := &Ruleset{
+		ID:          Int64(26110),
+		Name:        "test ruleset",
+		Enforcement: "active",
+		Conditions: &RulesetConditions{
+			RepositoryID: &RulesetRepositoryIDsConditionParameters{RepositoryIDs: []int64{1, 2}},
+		},
+	}
+	if !reflect.DeepEqual(ruleset, want) {
+		t.Errorf("Organizations.GetOrganizationRuleset returned %+v, want %+v", ruleset, want)
+	}
+
+	const methodName = "GetOrganizationRuleset"
+	testBadOptions(t, methodName, func() (err error) {
+		_, _, err = client.Organizations.GetOrganizationRuleset(ctx, "\n", 26110)
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.Organizations.GetOrganizationRuleset(ctx, "o", 26110)
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
+
+func TestOrganizationsService_UpdateOrganizationRuleset(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/orgs/o/rulesets/26110", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PUT")
+		testBody(t, r, `{"name":"test ruleset","enforcement":"disabled"}`+"\n")
+		fmt.Fprint(w, `{"id": 26110, "name": "test ruleset", "enforcement": "disabled"}`)
+	})
+
+	rs := &Ruleset{Name: "test ruleset", Enforcement: "disabled"}
+	ctx := context.Background()
+	ruleset, _, err := client.Organizations.UpdateOrganizationRuleset(ctx, "o", 26110, rs)
+	if err != nil {
+		t.Errorf("Organizations.UpdateOrganizationRuleset returned error: %v", err)
+	}
+
+	want := &Ruleset{ID: Int64(26110), Name: "test ruleset", Enforcement: "disabled"}
+	if !reflect.DeepEqual(ruleset, want) {
+		t.Errorf("Organizations.UpdateOrganizationRuleset returned %+v, want %+v", ruleset, want)
+	}
+
+	const methodName = "UpdateOrganizationRuleset"
+	testBadOptions(t, methodName, func() (err error) {
+		_, _, err = client.Organizations.UpdateOrganizationRuleset(ctx, "\n", 26110, rs)
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.Organizations.UpdateOrganizationRuleset(ctx, "o", 26110, rs)
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
+
+func TestOrganizationsService_DeleteOrganizationRuleset(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/orgs/o/rulesets/26110", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+	})
+
+	ctx := context.Background()
+	_, err := client.Organizations.DeleteOrganizationRuleset(ctx, "o", 26110)
+	if err != nil {
+		t.Errorf("Organizations.DeleteOrganizationRuleset returned error: %v", err)
+	}
+
+	const methodName = "DeleteOrganizationRuleset"
+	testBadOptions(t, methodName, func() (err error) {
+		_, err = client.Organizations.DeleteOrganizationRuleset(ctx, "\n", 26110)
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		return client.Organizations.DeleteOrganizationRuleset(ctx, "o", 26110)
+	})
+}
+
+func TestOrganizationsService_ListRuleSuites(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/orgs/o/rulesets/rule-suites", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testFormValues(t, r, values{
+			"ref":               "refs/heads/main",
+			"repository_name":   "r",
+			"time_period":       "week",
+			"actor_name":        "octocat",
+			"rule_suite_result": "fail",
+			"per_page":          "1",
+		})
+		fmt.Fprint(w, `[{
+			"id": 21,
+			"actor_id": 12,
+			"actor_name": "octocat",
+			"before_sha": "9a6f1d",
+			"after_sha": "c4d7e5",
+			"ref": "refs/heads/main",
+			"repository_id": 404,
+			"repository_name": "r",
+			"pushed_at": `+referenceTimeStr+`,
+			"result": "fail"
+		}]`)
+	})
+
+	opts := &ListRuleSuitesOptions{
+		Ref:             "refs/heads/main",
+		RepositoryName:  "r",
+		TimePeriod:      "week",
+		ActorName:       "octocat",
+		RuleSuiteResult: "fail",
+		ListOptions:     ListOptions{PerPage: 1},
+	}
+	ctx := context.Background()
+	ruleSuites, _, err := client.Organizations.ListRuleSuites(ctx, "o", opts)
+	if err != nil {
+		t.Errorf("Organizations.ListRuleSuites returned error: %v", err)
+	}
+
+	want := []*RuleSuite{{
+		ID:             Int64(21),
+		ActorID:        Int64(12),
+		ActorName:      String("octocat"),
+		BeforeSHA:      String("9a6f1d"),
+		AfterSHA:       String("c4d7e5"),
+		Ref:            String("refs/heads/main"),
+		RepositoryID:   Int64(404),
+		RepositoryName: String("r"),
+		PushedAt:       &Timestamp{referenceTime},
+		Result:         String("fail"),
+	}}
+	if !reflect.DeepEqual(ruleSuites, want) {
+		t.Errorf("Organizations.ListRuleSuites returned %+v, want %+v", ruleSuites, want)
+	}
+
+	const methodName = "ListRuleSuites"
+	testBadOptions(t, methodName, func() (err error) {
+		_, _, err = client.Organizations.ListRuleSuites(ctx, "\n", opts)
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.Organizations.ListRuleSuites(ctx, "o", opts)
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
+
+func TestOrganizationsService_GetRuleSuite(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/orgs/o/rulesets/rule-suites/21", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{
+			"id": 21,
+			"result": "bypass",
+			"evaluation_result": "fail",
+			"rule_evaluations": [{
+				"rule_source": {"type": "ruleset", "id": 2, "name": "protect main"},
+				"enforcement": "active",
+				"result": "fail",
+				"rule_type": "pull_request",
+				"details": "Changes must be made through a pull request."
+			}]
+		}`)
+	})
+
+	ctx := context.Background()
+	ruleSuite, _, err := client.Organizations.GetRuleSuite(ctx, "o", 21)
+	if err != nil {
+		t.Errorf("Organizations.GetRuleSuite returned error: %v", err)
+	}
+
+	want := &RuleSuite{
+		ID:               Int64(21),
+		Result:           String("bypass"),
+		EvaluationResult: String("fail"),
+		RuleEvaluations: []*RuleEvaluation{{
+			RuleSource:  &RuleEvaluationSource{Type: String("ruleset"), ID: Int64(2), Name: String("protect main")},
+			Enforcement: String("active"),
+			Result:      String("fail"),
+			RuleType:    String("pull_request"),
+			Details:     String("Changes must be made through a pull request."),
+		}},
+	}
+	if !reflect.DeepEqual(ruleSuite, want) {
+		t.Errorf("Organizations.GetRuleSuite returned %+v, want %+v", ruleSuite, want)
+	}
+
+	const methodName = "GetRuleSuite"
+	testBadOptions(t, methodName, func() (err error) {
+		_, _, err = client.Organizations.GetRuleSuite(ctx, "\n", 21)
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.Organizations.GetRuleSuite(ctx, "o", 21)
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
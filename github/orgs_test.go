@@ -394,3 +394,28 @@ func TestOrganizationsService_ListInstallations_withListOptions(t *testing.T) {
 		return resp, err
 	})
 }
+
+func TestOrganizationsService_EnableOrDisableSecurityProduct(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/orgs/o/private_vulnerability_reporting/enable_all", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	ctx := context.Background()
+	if _, err := client.Organizations.EnableOrDisableSecurityProduct(ctx, "o", SecurityProductPrivateVulnerabilityReporting, "enable_all"); err != nil {
+		t.Errorf("Organizations.EnableOrDisableSecurityProduct returned error: %v", err)
+	}
+
+	const methodName = "EnableOrDisableSecurityProduct"
+	testBadOptions(t, methodName, func() (err error) {
+		_, err = client.Organizations.EnableOrDisableSecurityProduct(ctx, "\n", "\n", "\n")
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		return client.Organizations.EnableOrDisableSecurityProduct(ctx, "o", SecurityProductPrivateVulnerabilityReporting, "enable_all")
+	})
+}
@@ -0,0 +1,74 @@
+// Copyright 2021 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"sort"
+)
+
+// TopicUsage represents the use of a topic by the repositories of an
+// organization.
+type TopicUsage struct {
+	Name string
+	// Repositories are the names of the repositories with the topic,
+	// sorted by name.
+	Repositories []string
+}
+
+// ListTopicUsage lists the topics of the repositories of an organization,
+// with the repositories using each of them, sorted by decreasing number of
+// repositories, then by name. opts may be used to filter the repositories;
+// all their pages are fetched.
+//
+// GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/repos/#list-organization-repositories
+func (s *OrganizationsService) ListTopicUsage(ctx context.Context, org string, opts *RepositoryListByOrgOptions) ([]*TopicUsage, *Response, error) {
+	listOpts := &RepositoryListByOrgOptions{}
+	if opts != nil {
+		*listOpts = *opts
+	}
+	listOpts.Page = 0
+	if listOpts.PerPage == 0 {
+		listOpts.PerPage = 100
+	}
+
+	usage := make(map[string]*TopicUsage)
+	var resp *Response
+	for {
+		repos, r, err := s.client.Repositories.ListByOrg(ctx, org, listOpts)
+		resp = r
+		if err != nil {
+			return nil, resp, err
+		}
+		for _, repo := range repos {
+			for _, topic := range repo.Topics {
+				u, ok := usage[topic]
+				if !ok {
+					u = &TopicUsage{Name: topic}
+					usage[topic] = u
+				}
+				u.Repositories = append(u.Repositories, repo.GetName())
+			}
+		}
+		if resp.NextPage == 0 {
+			break
+		}
+		listOpts.Page = resp.NextPage
+	}
+
+	topics := make([]*TopicUsage, 0, len(usage))
+	for _, u := range usage {
+		sort.Strings(u.Repositories)
+		topics = append(topics, u)
+	}
+	sort.Slice(topics, func(i, j int) bool {
+		if len(topics[i].Repositories) != len(topics[j].Repositories) {
+			return len(topics[i].Repositories) > len(topics[j].Repositories)
+		}
+		return topics[i].Name < topics[j].Name
+	})
+	return topics, resp, nil
+}
@@ -0,0 +1,62 @@
+// Copyright 2021 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+func TestOrganizationsService_ListTopicUsage(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/orgs/o/repos", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		switch r.FormValue("page") {
+		case "":
+			testFormValues(t, r, values{"type": "sources", "per_page": "100"})
+			w.Header().Set("Link", `<https://api.github.com/orgs/o/repos?page=2>; rel="next"`)
+			fmt.Fprint(w, `[{"name":"c","topics":["go","cli"]},{"name":"a","topics":["go"]}]`)
+		case "2":
+			testFormValues(t, r, values{"type": "sources", "page": "2", "per_page": "100"})
+			fmt.Fprint(w, `[{"name":"b","topics":["go","api"]},{"name":"d"}]`)
+		}
+	})
+
+	ctx := context.Background()
+	opts := &RepositoryListByOrgOptions{Type: "sources", ListOptions: ListOptions{Page: 3}}
+	topics, _, err := client.Organizations.ListTopicUsage(ctx, "o", opts)
+	if err != nil {
+		t.Errorf("Organizations.ListTopicUsage returned error: %v", err)
+	}
+
+	want := []*TopicUsage{
+		{Name: "go", Repositories: []string{"a", "b", "c"}},
+		{Name: "api", Repositories: []string{"b"}},
+		{Name: "cli", Repositories: []string{"c"}},
+	}
+	if !reflect.DeepEqual(topics, want) {
+		t.Errorf("Organizations.ListTopicUsage returned %+v, want %+v", topics, want)
+	}
+
+	const methodName = "ListTopicUsage"
+	testBadOptions(t, methodName, func() (err error) {
+		_, _, err = client.Organizations.ListTopicUsage(ctx, "\n", opts)
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.Organizations.ListTopicUsage(ctx, "o", nil)
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
@@ -56,13 +56,58 @@ func (s *OrganizationsService) IsBlocked(ctx context.Context, org string, user s
 	return isBlocked, resp, err
 }
 
+// GetBlockStatus reports whether specified user is blocked from an
+// organization. Unlike IsBlocked, it distinguishes a failed check, for which
+// it returns BlockStatusUnknown and the error.
+//
+// GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/orgs/#check-if-a-user-is-blocked-by-an-organization
+func (s *OrganizationsService) GetBlockStatus(ctx context.Context, org string, user string) (BlockStatus, *Response, error) {
+	u := fmt.Sprintf("orgs/%v/blocks/%v", org, user)
+
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return BlockStatusUnknown, nil, err
+	}
+
+	// TODO: remove custom Accept header when this API fully launches.
+	req.Header.Set("Accept", mediaTypeBlockUsersPreview)
+
+	resp, err := s.client.Do(ctx, req, nil)
+	status, err := parseBlockStatus(err)
+	return status, resp, err
+}
+
+// BlockUserOptions specifies the optional parameters to the
+// OrganizationsService.BlockUserWithOptions method.
+type BlockUserOptions struct {
+	// Duration is the number of days the user is blocked for. If zero, the
+	// user is blocked until unblocked. Possible values are: 1, 3, 7 and 30.
+	Duration int `json:"duration,omitempty"`
+	// Reason is the reason for blocking the user, e.g. "spam" or
+	// "harassment", recorded for moderation purposes.
+	Reason string `json:"reason,omitempty"`
+}
+
 // BlockUser blocks specified user from an organization.
 //
 // GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/orgs/#block-a-user-from-an-organization
 func (s *OrganizationsService) BlockUser(ctx context.Context, org string, user string) (*Response, error) {
+	return s.BlockUserWithOptions(ctx, org, user, nil)
+}
+
+// BlockUserWithOptions blocks specified user from an organization, for the
+// duration and with the reason in opts. Blocks with a duration are only
+// supported for organizations, not for the authenticated user.
+//
+// GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/orgs/#block-a-user-from-an-organization
+func (s *OrganizationsService) BlockUserWithOptions(ctx context.Context, org string, user string, opts *BlockUserOptions) (*Response, error) {
 	u := fmt.Sprintf("orgs/%v/blocks/%v", org, user)
 
-	req, err := s.client.NewRequest("PUT", u, nil)
+	var body interface{}
+	if opts != nil {
+		body = opts
+	}
+	req, err := s.client.NewRequest("PUT", u, body)
 	if err != nil {
 		return nil, err
 	}
@@ -140,3 +140,83 @@ func TestOrganizationsService_UnblockUser(t *testing.T) {
 		return client.Organizations.UnblockUser(ctx, "o", "u")
 	})
 }
+
+func TestOrganizationsService_GetBlockStatus(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/orgs/o/blocks/blocked", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testHeader(t, r, "Accept", mediaTypeBlockUsersPreview)
+		w.WriteHeader(http.StatusNoContent)
+	})
+	mux.HandleFunc("/orgs/o/blocks/notblocked", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	})
+	mux.HandleFunc("/orgs/o/blocks/error", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	})
+
+	ctx := context.Background()
+	tests := []struct {
+		user    string
+		want    BlockStatus
+		wantErr bool
+	}{
+		{user: "blocked", want: BlockStatusBlocked},
+		{user: "notblocked", want: BlockStatusNotBlocked},
+		{user: "error", want: BlockStatusUnknown, wantErr: true},
+	}
+	for _, tt := range tests {
+		status, _, err := client.Organizations.GetBlockStatus(ctx, "o", tt.user)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("Organizations.GetBlockStatus(%q) returned error %v, want error: %v", tt.user, err, tt.wantErr)
+		}
+		if status != tt.want {
+			t.Errorf("Organizations.GetBlockStatus(%q) returned %v, want %v", tt.user, status, tt.want)
+		}
+	}
+
+	const methodName = "GetBlockStatus"
+	testBadOptions(t, methodName, func() (err error) {
+		_, _, err = client.Organizations.GetBlockStatus(ctx, "\n", "\n")
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.Organizations.GetBlockStatus(ctx, "o", "blocked")
+		if got != BlockStatusUnknown {
+			t.Errorf("testNewRequestAndDoFailure %v = %v, want %v", methodName, got, BlockStatusUnknown)
+		}
+		return resp, err
+	})
+}
+
+func TestOrganizationsService_BlockUserWithOptions(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/orgs/o/blocks/u", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PUT")
+		testHeader(t, r, "Accept", mediaTypeBlockUsersPreview)
+		testBody(t, r, `{"duration":7,"reason":"spam"}`+"\n")
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	opts := &BlockUserOptions{Duration: 7, Reason: "spam"}
+	ctx := context.Background()
+	_, err := client.Organizations.BlockUserWithOptions(ctx, "o", "u", opts)
+	if err != nil {
+		t.Errorf("Organizations.BlockUserWithOptions returned error: %v", err)
+	}
+
+	const methodName = "BlockUserWithOptions"
+	testBadOptions(t, methodName, func() (err error) {
+		_, err = client.Organizations.BlockUserWithOptions(ctx, "\n", "\n", opts)
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		return client.Organizations.BlockUserWithOptions(ctx, "o", "u", opts)
+	})
+}
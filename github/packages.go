@@ -16,6 +16,10 @@ type Package struct {
 	Owner          *User            `json:"owner,omitempty"`
 	PackageVersion *PackageVersion  `json:"package_version,omitempty"`
 	Registry       *PackageRegistry `json:"registry,omitempty"`
+	URL            *string          `json:"url,omitempty"`
+	VersionCount   *int64           `json:"version_count,omitempty"`
+	Visibility     *string          `json:"visibility,omitempty"`
+	Repository     *Repository      `json:"repository,omitempty"`
 }
 
 func (p Package) String() string {
@@ -42,12 +46,73 @@ type PackageVersion struct {
 	PackageFiles        []*PackageFile  `json:"package_files,omitempty"`
 	Author              *User           `json:"author,omitempty"`
 	InstallationCommand *string         `json:"installation_command,omitempty"`
+
+	// The following fields are populated by the package version endpoints
+	// of the Organizations and Users services.
+	Name           *string          `json:"name,omitempty"`
+	URL            *string          `json:"url,omitempty"`
+	PackageHTMLURL *string          `json:"package_html_url,omitempty"`
+	License        *string          `json:"license,omitempty"`
+	Description    *string          `json:"description,omitempty"`
+	DeletedAt      *Timestamp       `json:"deleted_at,omitempty"`
+	Metadata       *PackageMetadata `json:"metadata,omitempty"`
 }
 
 func (pv PackageVersion) String() string {
 	return Stringify(pv)
 }
 
+// PackageMetadata represents the ecosystem specific metadata of a package
+// version.
+type PackageMetadata struct {
+	PackageType *string                   `json:"package_type,omitempty"`
+	Container   *PackageContainerMetadata `json:"container,omitempty"`
+	Docker      *PackageDockerMetadata    `json:"docker,omitempty"`
+}
+
+func (r PackageMetadata) String() string {
+	return Stringify(r)
+}
+
+// PackageContainerMetadata represents the metadata of a version of a
+// container package.
+type PackageContainerMetadata struct {
+	Tags []string `json:"tags,omitempty"`
+}
+
+func (r PackageContainerMetadata) String() string {
+	return Stringify(r)
+}
+
+// PackageDockerMetadata represents the metadata of a version of a docker
+// package.
+type PackageDockerMetadata struct {
+	Tags []string `json:"tag,omitempty"`
+}
+
+func (r PackageDockerMetadata) String() string {
+	return Stringify(r)
+}
+
+// PackageListOptions represents the optional list options for packages and
+// package versions.
+type PackageListOptions struct {
+	// Visibility of packages, either "public", "internal" or "private".
+	// Only used when listing packages.
+	Visibility *string `url:"visibility,omitempty"`
+
+	// PackageType represents the type of the packages to list, which is
+	// required when listing packages. Possible values are "npm", "maven",
+	// "rubygems", "docker", "nuget" and "container".
+	PackageType *string `url:"package_type,omitempty"`
+
+	// State of the package versions, either "active" or "deleted". Only used
+	// when listing package versions.
+	State *string `url:"state,omitempty"`
+
+	ListOptions
+}
+
 // PackageRelease represents a GitHub package version release.
 type PackageRelease struct {
 	URL             *string    `json:"url,omitempty"`
@@ -0,0 +1,141 @@
+// Copyright 2021 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// Types of the lines of a DiffHunk.
+const (
+	DiffLineContext = " "
+	DiffLineAdded   = "+"
+	DiffLineDeleted = "-"
+)
+
+// DiffHunk represents a hunk of a unified diff, such as the Patch of a CommitFile.
+type DiffHunk struct {
+	OldStart int
+	OldLines int
+	NewStart int
+	NewLines int
+	// Section is the text following the hunk range in the hunk header,
+	// typically the enclosing function or section of the file.
+	Section string
+	Lines   []*DiffLine
+}
+
+// DiffLine represents a line of a DiffHunk.
+type DiffLine struct {
+	// Type is one of DiffLineContext, DiffLineAdded or DiffLineDeleted.
+	Type string
+	// Content is the line without its leading type marker.
+	Content string
+	// OldLine and NewLine are the line numbers of the line in the old and
+	// the new file respectively, or 0 if the line does not exist there.
+	OldLine int
+	NewLine int
+	// Position is the position of the line in the patch, counted from the
+	// first hunk header. It is the value expected by the position field of
+	// a pull request review comment.
+	Position int
+	// NoNewlineAtEOF reports whether the line is the last of its file and
+	// is not terminated by a newline.
+	NoNewlineAtEOF bool
+}
+
+var hunkHeaderRegexp = regexp.MustCompile(`^@@ -(\d+)(?:,(\d+))? \+(\d+)(?:,(\d+))? @@ ?(.*)$`)
+
+// ParsePatch parses a unified diff of a single file, such as the Patch of a
+// CommitFile, into hunks. Any file headers before the first hunk, such as
+// "--- a/file" and "+++ b/file", are ignored.
+func ParsePatch(patch string) ([]*DiffHunk, error) {
+	var hunks []*DiffHunk
+	var hunk *DiffHunk
+	var oldLine, newLine, position int
+
+	lines := strings.Split(patch, "\n")
+	// A trailing newline does not start a new line.
+	if len(lines) > 0 && lines[len(lines)-1] == "" {
+		lines = lines[:len(lines)-1]
+	}
+
+	for i, line := range lines {
+		if strings.HasPrefix(line, "@@") {
+			m := hunkHeaderRegexp.FindStringSubmatch(line)
+			if m == nil {
+				return nil, fmt.Errorf("line %v: invalid hunk header %q", i+1, line)
+			}
+			if hunk != nil {
+				position++
+			}
+			hunk = &DiffHunk{
+				OldStart: atoiDefault(m[1], 0),
+				OldLines: atoiDefault(m[2], 1),
+				NewStart: atoiDefault(m[3], 0),
+				NewLines: atoiDefault(m[4], 1),
+				Section:  m[5],
+			}
+			hunks = append(hunks, hunk)
+			oldLine, newLine = hunk.OldStart, hunk.NewStart
+			continue
+		}
+
+		if hunk == nil {
+			// File headers before the first hunk.
+			continue
+		}
+		position++
+
+		if strings.HasPrefix(line, `\`) {
+			// "\ No newline at end of file" applies to the preceding line.
+			if n := len(hunk.Lines); n > 0 {
+				hunk.Lines[n-1].NoNewlineAtEOF = true
+			}
+			continue
+		}
+
+		dl := &DiffLine{Position: position}
+		switch {
+		case line == "":
+			// Some tools strip the trailing space of empty context lines.
+			dl.Type = DiffLineContext
+		case strings.HasPrefix(line, DiffLineContext), strings.HasPrefix(line, DiffLineAdded), strings.HasPrefix(line, DiffLineDeleted):
+			dl.Type, dl.Content = line[:1], line[1:]
+		default:
+			return nil, fmt.Errorf("line %v: invalid diff line %q", i+1, line)
+		}
+
+		switch dl.Type {
+		case DiffLineContext:
+			dl.OldLine, dl.NewLine = oldLine, newLine
+			oldLine++
+			newLine++
+		case DiffLineAdded:
+			dl.NewLine = newLine
+			newLine++
+		case DiffLineDeleted:
+			dl.OldLine = oldLine
+			oldLine++
+		}
+		hunk.Lines = append(hunk.Lines, dl)
+	}
+
+	return hunks, nil
+}
+
+// atoiDefault returns s as an int, or def if s is empty. s must be a
+// sequence of digits.
+func atoiDefault(s string, def int) int {
+	if s == "" {
+		return def
+	}
+	n, _ := strconv.Atoi(s)
+	return n
+}
@@ -0,0 +1,77 @@
+// Copyright 2021 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestParsePatch(t *testing.T) {
+	patch := `--- a/f
++++ b/f
+@@ -1,3 +1,3 @@ func main() {
+ a
+-b
++c
+
+@@ -10 +10,2 @@
+ x
++y
+\ No newline at end of file
+`
+
+	got, err := ParsePatch(patch)
+	if err != nil {
+		t.Fatalf("ParsePatch returned error: %v", err)
+	}
+
+	want := []*DiffHunk{
+		{
+			OldStart: 1, OldLines: 3, NewStart: 1, NewLines: 3,
+			Section: "func main() {",
+			Lines: []*DiffLine{
+				{Type: DiffLineContext, Content: "a", OldLine: 1, NewLine: 1, Position: 1},
+				{Type: DiffLineDeleted, Content: "b", OldLine: 2, Position: 2},
+				{Type: DiffLineAdded, Content: "c", NewLine: 2, Position: 3},
+				{Type: DiffLineContext, Content: "", OldLine: 3, NewLine: 3, Position: 4},
+			},
+		},
+		{
+			OldStart: 10, OldLines: 1, NewStart: 10, NewLines: 2,
+			Lines: []*DiffLine{
+				{Type: DiffLineContext, Content: "x", OldLine: 10, NewLine: 10, Position: 6},
+				{Type: DiffLineAdded, Content: "y", NewLine: 11, Position: 7, NoNewlineAtEOF: true},
+			},
+		},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("ParsePatch returned %+v, want %+v", got, want)
+	}
+}
+
+func TestParsePatch_empty(t *testing.T) {
+	got, err := ParsePatch("")
+	if err != nil {
+		t.Fatalf("ParsePatch returned error: %v", err)
+	}
+	if got != nil {
+		t.Errorf("ParsePatch returned %+v, want nil", got)
+	}
+}
+
+func TestParsePatch_invalid(t *testing.T) {
+	tests := []string{
+		"@@ -a +1 @@\n",
+		"@@ -1 +1 @@\n*x\n",
+	}
+
+	for _, patch := range tests {
+		if _, err := ParsePatch(patch); err == nil {
+			t.Errorf("ParsePatch(%q) returned no error", patch)
+		}
+	}
+}
@@ -9,6 +9,8 @@ import (
 	"bytes"
 	"context"
 	"fmt"
+	"net/http"
+	"strings"
 	"time"
 )
 
@@ -74,6 +76,18 @@ type PullRequest struct {
 	// ActiveLockReason is populated only when LockReason is provided while locking the pull request.
 	// Possible values are: "off-topic", "too heated", "resolved", and "spam".
 	ActiveLockReason *string `json:"active_lock_reason,omitempty"`
+
+	// AutoMerge is populated when auto-merge is enabled for the pull request.
+	AutoMerge *PullRequestAutoMerge `json:"auto_merge,omitempty"`
+}
+
+// PullRequestAutoMerge represents the auto-merge settings of a pull request.
+type PullRequestAutoMerge struct {
+	EnabledBy *User `json:"enabled_by,omitempty"`
+	// Possible values for MergeMethod are: merge, squash, rebase.
+	MergeMethod   *string `json:"merge_method,omitempty"`
+	CommitTitle   *string `json:"commit_title,omitempty"`
+	CommitMessage *string `json:"commit_message,omitempty"`
 }
 
 func (p PullRequest) String() string {
@@ -423,20 +437,49 @@ type PullRequestMergeResult struct {
 	Message *string `json:"message,omitempty"`
 }
 
+// MergeMethod is a method to merge a pull request, as
+// PullRequestOptions.MergeMethod or AutoMergeOptions.MergeMethod.
+type MergeMethod string
+
+// Merge methods that can be used to merge a pull request.
+const (
+	MergeMethodMerge  MergeMethod = "merge"
+	MergeMethodSquash MergeMethod = "squash"
+	MergeMethodRebase MergeMethod = "rebase"
+)
+
 // PullRequestOptions lets you define how a pull request will be merged.
 type PullRequestOptions struct {
 	CommitTitle string // Title for the automatic commit message. (Optional.)
-	SHA         string // SHA that pull request head must match to allow merge. (Optional.)
 
-	// The merge method to use. Possible values include: "merge", "squash", and "rebase" with the default being merge. (Optional.)
-	MergeMethod string
+	// SHA that pull request head must match to allow merge. If the head
+	// has moved, Merge returns a *MergeHeadMovedError. (Optional.)
+	SHA string
+
+	// The merge method to use. Possible values are MergeMethodMerge,
+	// MergeMethodSquash and MergeMethodRebase, with the default being
+	// MergeMethodMerge. (Optional.)
+	MergeMethod MergeMethod
 }
 
+// MergeHeadMovedError occurs when merging a pull request whose head no
+// longer matches the SHA given in PullRequestOptions.
+type MergeHeadMovedError ErrorResponse
+
+func (r *MergeHeadMovedError) Error() string { return (*ErrorResponse)(r).Error() }
+
+// MergeQueueRequiredError occurs when merging a pull request whose base
+// branch requires changes to be made through a merge queue. Use
+// PullRequestsService.EnqueuePullRequest instead.
+type MergeQueueRequiredError ErrorResponse
+
+func (r *MergeQueueRequiredError) Error() string { return (*ErrorResponse)(r).Error() }
+
 type pullRequestMergeRequest struct {
-	CommitMessage string `json:"commit_message,omitempty"`
-	CommitTitle   string `json:"commit_title,omitempty"`
-	MergeMethod   string `json:"merge_method,omitempty"`
-	SHA           string `json:"sha,omitempty"`
+	CommitMessage string      `json:"commit_message,omitempty"`
+	CommitTitle   string      `json:"commit_title,omitempty"`
+	MergeMethod   MergeMethod `json:"merge_method,omitempty"`
+	SHA           string      `json:"sha,omitempty"`
 }
 
 // Merge a pull request.
@@ -460,8 +503,27 @@ func (s *PullRequestsService) Merge(ctx context.Context, owner string, repo stri
 	mergeResult := new(PullRequestMergeResult)
 	resp, err := s.client.Do(ctx, req, mergeResult)
 	if err != nil {
-		return nil, resp, err
+		return nil, resp, mergeError(err)
 	}
 
 	return mergeResult, resp, nil
 }
+
+// mergeError returns the typed error corresponding to err, an error
+// returned when merging a pull request, if there is one.
+func mergeError(err error) error {
+	errResp, ok := err.(*ErrorResponse)
+	if !ok || errResp.Response == nil {
+		return err
+	}
+
+	switch errResp.Response.StatusCode {
+	case http.StatusConflict:
+		return (*MergeHeadMovedError)(errResp)
+	case http.StatusMethodNotAllowed:
+		if strings.Contains(strings.ToLower(errResp.Message), "merge queue") {
+			return (*MergeQueueRequiredError)(errResp)
+		}
+	}
+	return err
+}
@@ -0,0 +1,153 @@
+// Copyright 2021 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"strings"
+)
+
+// AutoMergeOptions specifies the optional parameters to the
+// PullRequestsService.EnableAutoMerge method.
+type AutoMergeOptions struct {
+	// The merge method to use once all the requirements are met.
+	// Possible values are MergeMethodMerge, MergeMethodSquash and
+	// MergeMethodRebase. Default: the repository's default merge method.
+	MergeMethod MergeMethod
+
+	CommitTitle   string // Title of the merge commit. (Optional.)
+	CommitMessage string // Body of the merge commit. (Optional.)
+	SHA           string // SHA that pull request head must match to allow auto-merge. (Optional.)
+	AuthorEmail   string // Email address to associate with the merge commit. (Optional.)
+}
+
+// autoMergeRequestFields are the GraphQL fields of a PullRequestAutoMerge.
+const autoMergeRequestFields = `autoMergeRequest { enabledBy { login } mergeMethod commitHeadline commitBody }`
+
+// graphQLAutoMergeRequest is the GraphQL representation of a PullRequestAutoMerge.
+type graphQLAutoMergeRequest struct {
+	EnabledBy      *graphQLActor `json:"enabledBy"`
+	MergeMethod    *string       `json:"mergeMethod"`
+	CommitHeadline *string       `json:"commitHeadline"`
+	CommitBody     *string       `json:"commitBody"`
+}
+
+// autoMerge converts r to a PullRequestAutoMerge.
+func (r *graphQLAutoMergeRequest) autoMerge() *PullRequestAutoMerge {
+	if r == nil {
+		return nil
+	}
+	autoMerge := &PullRequestAutoMerge{
+		EnabledBy:     r.EnabledBy.user(),
+		CommitTitle:   r.CommitHeadline,
+		CommitMessage: r.CommitBody,
+	}
+	// The GraphQL API uses upper case merge methods, the REST API lower case.
+	if r.MergeMethod != nil {
+		autoMerge.MergeMethod = String(strings.ToLower(*r.MergeMethod))
+	}
+	return autoMerge
+}
+
+// pullRequestNodeID returns the GraphQL node ID of a pull request.
+func (s *PullRequestsService) pullRequestNodeID(ctx context.Context, owner, repo string, number int) (string, *Response, error) {
+	query := `query($owner: String!, $repo: String!, $number: Int!) {
+  repository(owner: $owner, name: $repo) {
+    pullRequest(number: $number) { id }
+  }
+}`
+	variables := map[string]interface{}{
+		"owner":  owner,
+		"repo":   repo,
+		"number": number,
+	}
+
+	var data struct {
+		Repository struct {
+			PullRequest struct {
+				ID string `json:"id"`
+			} `json:"pullRequest"`
+		} `json:"repository"`
+	}
+	resp, err := s.client.graphQL(ctx, query, variables, &data)
+	if err != nil {
+		return "", resp, err
+	}
+
+	return data.Repository.PullRequest.ID, resp, nil
+}
+
+// EnableAutoMerge enables auto-merge for a pull request: it will be merged
+// automatically once all the branch protection requirements are met.
+// Auto-merge is only exposed by the GitHub GraphQL API, so this method
+// makes two GraphQL requests; the returned Response is that of the last one.
+//
+// GitHub API docs: https://docs.github.com/en/graphql/reference/mutations#enablepullrequestautomerge
+func (s *PullRequestsService) EnableAutoMerge(ctx context.Context, owner, repo string, number int, opts *AutoMergeOptions) (*PullRequestAutoMerge, *Response, error) {
+	id, resp, err := s.pullRequestNodeID(ctx, owner, repo, number)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	query := `mutation($id: ID!, $mergeMethod: PullRequestMergeMethod, $commitHeadline: String, $commitBody: String, $expectedHeadOid: GitObjectID, $authorEmail: String) {
+  enablePullRequestAutoMerge(input: {pullRequestId: $id, mergeMethod: $mergeMethod, commitHeadline: $commitHeadline, commitBody: $commitBody, expectedHeadOid: $expectedHeadOid, authorEmail: $authorEmail}) {
+    pullRequest { ` + autoMergeRequestFields + ` }
+  }
+}`
+	variables := map[string]interface{}{"id": id}
+	if opts != nil {
+		if opts.MergeMethod != "" {
+			variables["mergeMethod"] = strings.ToUpper(string(opts.MergeMethod))
+		}
+		if opts.CommitTitle != "" {
+			variables["commitHeadline"] = opts.CommitTitle
+		}
+		if opts.CommitMessage != "" {
+			variables["commitBody"] = opts.CommitMessage
+		}
+		if opts.SHA != "" {
+			variables["expectedHeadOid"] = opts.SHA
+		}
+		if opts.AuthorEmail != "" {
+			variables["authorEmail"] = opts.AuthorEmail
+		}
+	}
+
+	var data struct {
+		EnablePullRequestAutoMerge struct {
+			PullRequest struct {
+				AutoMergeRequest *graphQLAutoMergeRequest `json:"autoMergeRequest"`
+			} `json:"pullRequest"`
+		} `json:"enablePullRequestAutoMerge"`
+	}
+	resp, err = s.client.graphQL(ctx, query, variables, &data)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return data.EnablePullRequestAutoMerge.PullRequest.AutoMergeRequest.autoMerge(), resp, nil
+}
+
+// DisableAutoMerge disables auto-merge for a pull request.
+// Auto-merge is only exposed by the GitHub GraphQL API, so this method
+// makes two GraphQL requests; the returned Response is that of the last one.
+//
+// GitHub API docs: https://docs.github.com/en/graphql/reference/mutations#disablepullrequestautomerge
+func (s *PullRequestsService) DisableAutoMerge(ctx context.Context, owner, repo string, number int) (*Response, error) {
+	id, resp, err := s.pullRequestNodeID(ctx, owner, repo, number)
+	if err != nil {
+		return resp, err
+	}
+
+	query := `mutation($id: ID!) {
+  disablePullRequestAutoMerge(input: {pullRequestId: $id}) {
+    pullRequest { id }
+  }
+}`
+	variables := map[string]interface{}{"id": id}
+
+	return s.client.graphQL(ctx, query, variables, nil)
+}
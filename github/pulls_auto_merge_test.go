@@ -0,0 +1,165 @@
+// Copyright 2021 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+// handlePullRequestNodeID handles the GraphQL query looking up the node ID
+// of pull request o/r#1, and passes any other request to next.
+func handlePullRequestNodeID(t *testing.T, next func(w http.ResponseWriter, v *graphQLRequest)) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		v := new(graphQLRequest)
+		json.NewDecoder(r.Body).Decode(v)
+		testMethod(t, r, "POST")
+
+		if strings.HasPrefix(v.Query, "query") {
+			want := map[string]interface{}{"owner": "o", "repo": "r", "number": float64(1)}
+			if !reflect.DeepEqual(v.Variables, want) {
+				t.Errorf("Request variables = %+v, want %+v", v.Variables, want)
+			}
+			fmt.Fprint(w, `{"data":{"repository":{"pullRequest":{"id":"PR1"}}}}`)
+			return
+		}
+		next(w, v)
+	}
+}
+
+func TestPullRequestsService_EnableAutoMerge(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/graphql", handlePullRequestNodeID(t, func(w http.ResponseWriter, v *graphQLRequest) {
+		if !strings.Contains(v.Query, "enablePullRequestAutoMerge(") {
+			t.Errorf("Request query = %q, want enablePullRequestAutoMerge mutation", v.Query)
+		}
+		want := map[string]interface{}{
+			"id":              "PR1",
+			"mergeMethod":     "SQUASH",
+			"commitHeadline":  "t",
+			"commitBody":      "m",
+			"expectedHeadOid": "s",
+			"authorEmail":     "e",
+		}
+		if !reflect.DeepEqual(v.Variables, want) {
+			t.Errorf("Request variables = %+v, want %+v", v.Variables, want)
+		}
+
+		fmt.Fprint(w, `{"data":{"enablePullRequestAutoMerge":{"pullRequest":{"autoMergeRequest":{
+			"enabledBy": {"login": "l"},
+			"mergeMethod": "SQUASH",
+			"commitHeadline": "t",
+			"commitBody": "m"
+		}}}}}`)
+	}))
+
+	opts := &AutoMergeOptions{
+		MergeMethod:   "squash",
+		CommitTitle:   "t",
+		CommitMessage: "m",
+		SHA:           "s",
+		AuthorEmail:   "e",
+	}
+	ctx := context.Background()
+	autoMerge, _, err := client.PullRequests.EnableAutoMerge(ctx, "o", "r", 1, opts)
+	if err != nil {
+		t.Errorf("PullRequests.EnableAutoMerge returned error: %v", err)
+	}
+
+	want := &PullRequestAutoMerge{
+		EnabledBy:     &User{Login: String("l")},
+		MergeMethod:   String("squash"),
+		CommitTitle:   String("t"),
+		CommitMessage: String("m"),
+	}
+	if !reflect.DeepEqual(autoMerge, want) {
+		t.Errorf("PullRequests.EnableAutoMerge returned %+v, want %+v", autoMerge, want)
+	}
+
+	const methodName = "EnableAutoMerge"
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.PullRequests.EnableAutoMerge(ctx, "o", "r", 1, opts)
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
+
+func TestPullRequestsService_EnableAutoMerge_defaults(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/graphql", handlePullRequestNodeID(t, func(w http.ResponseWriter, v *graphQLRequest) {
+		if want := map[string]interface{}{"id": "PR1"}; !reflect.DeepEqual(v.Variables, want) {
+			t.Errorf("Request variables = %+v, want %+v", v.Variables, want)
+		}
+		fmt.Fprint(w, `{"data":{"enablePullRequestAutoMerge":{"pullRequest":{"autoMergeRequest":{"mergeMethod":"MERGE"}}}}}`)
+	}))
+
+	ctx := context.Background()
+	autoMerge, _, err := client.PullRequests.EnableAutoMerge(ctx, "o", "r", 1, nil)
+	if err != nil {
+		t.Errorf("PullRequests.EnableAutoMerge returned error: %v", err)
+	}
+
+	want := &PullRequestAutoMerge{MergeMethod: String("merge")}
+	if !reflect.DeepEqual(autoMerge, want) {
+		t.Errorf("PullRequests.EnableAutoMerge returned %+v, want %+v", autoMerge, want)
+	}
+}
+
+func TestPullRequestsService_DisableAutoMerge(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/graphql", handlePullRequestNodeID(t, func(w http.ResponseWriter, v *graphQLRequest) {
+		if !strings.Contains(v.Query, "disablePullRequestAutoMerge(input: {pullRequestId: $id})") {
+			t.Errorf("Request query = %q, want disablePullRequestAutoMerge mutation", v.Query)
+		}
+		if want := map[string]interface{}{"id": "PR1"}; !reflect.DeepEqual(v.Variables, want) {
+			t.Errorf("Request variables = %+v, want %+v", v.Variables, want)
+		}
+		fmt.Fprint(w, `{"data":{"disablePullRequestAutoMerge":{"pullRequest":{"id":"PR1"}}}}`)
+	}))
+
+	ctx := context.Background()
+	if _, err := client.PullRequests.DisableAutoMerge(ctx, "o", "r", 1); err != nil {
+		t.Errorf("PullRequests.DisableAutoMerge returned error: %v", err)
+	}
+
+	const methodName = "DisableAutoMerge"
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		return client.PullRequests.DisableAutoMerge(ctx, "o", "r", 1)
+	})
+}
+
+func TestPullRequestAutoMerge_Marshal(t *testing.T) {
+	testJSONMarshal(t, &PullRequestAutoMerge{}, "{}")
+
+	u := &PullRequestAutoMerge{
+		EnabledBy:     &User{Login: String("l")},
+		MergeMethod:   String("squash"),
+		CommitTitle:   String("t"),
+		CommitMessage: String("m"),
+	}
+
+	want := `{
+		"enabled_by": {"login": "l"},
+		"merge_method": "squash",
+		"commit_title": "t",
+		"commit_message": "m"
+	}`
+
+	testJSONMarshal(t, u, want)
+}
@@ -169,6 +169,76 @@ func (s *PullRequestsService) CreateCommentInReplyTo(ctx context.Context, owner,
 	return c, resp, nil
 }
 
+// CreateCommentInReplyToComment creates a reply to a review comment of a pull
+// request. Replies to replies are not supported, so commentID must be the ID
+// of a top-level review comment.
+//
+// GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/pulls/#create-a-reply-for-a-review-comment
+func (s *PullRequestsService) CreateCommentInReplyToComment(ctx context.Context, owner, repo string, number int, commentID int64, body string) (*PullRequestComment, *Response, error) {
+	comment := &struct {
+		Body string `json:"body"`
+	}{
+		Body: body,
+	}
+	u := fmt.Sprintf("repos/%v/%v/pulls/%d/comments/%d/replies", owner, repo, number, commentID)
+	req, err := s.client.NewRequest("POST", u, comment)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	c := new(PullRequestComment)
+	resp, err := s.client.Do(ctx, req, c)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return c, resp, nil
+}
+
+// PullRequestCommentThread represents a review comment along with the
+// comments that reply to it, as returned by ThreadPullRequestComments.
+type PullRequestCommentThread struct {
+	Comment *PullRequestComment
+	Replies []*PullRequestCommentThread
+}
+
+// ThreadPullRequestComments groups review comments, such as those returned
+// by PullRequestsService.ListComments, into conversation trees using their
+// InReplyTo field. Comments that are not a reply, or that reply to a comment
+// missing from comments, are the roots of the returned threads. Threads and
+// replies are in the order in which they appear in comments.
+func ThreadPullRequestComments(comments []*PullRequestComment) []*PullRequestCommentThread {
+	threads := make(map[int64]*PullRequestCommentThread, len(comments))
+	for _, c := range comments {
+		if c == nil || c.ID == nil {
+			continue
+		}
+		threads[*c.ID] = &PullRequestCommentThread{Comment: c}
+	}
+
+	var roots []*PullRequestCommentThread
+	for _, c := range comments {
+		if c == nil {
+			continue
+		}
+		thread, ok := threads[c.GetID()]
+		if !ok || thread.Comment != c {
+			// Comments without an ID, or with a duplicate ID, cannot be
+			// replied to; they still start a thread of their own.
+			thread = &PullRequestCommentThread{Comment: c}
+		}
+
+		parentID := c.GetInReplyTo()
+		if parent, ok := threads[parentID]; ok && parentID != c.GetID() {
+			parent.Replies = append(parent.Replies, thread)
+			continue
+		}
+		roots = append(roots, thread)
+	}
+
+	return roots
+}
+
 // EditComment updates a pull request comment.
 // A non-nil comment.Body must be provided. Other comment fields should be left nil.
 //
@@ -359,6 +359,76 @@ func TestPullRequestsService_CreateCommentInReplyTo(t *testing.T) {
 	})
 }
 
+func TestPullRequestsService_CreateCommentInReplyToComment(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/pulls/1/comments/2/replies", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testBody(t, r, `{"body":"b"}`+"\n")
+		fmt.Fprint(w, `{"id":3,"in_reply_to_id":2}`)
+	})
+
+	ctx := context.Background()
+	comment, _, err := client.PullRequests.CreateCommentInReplyToComment(ctx, "o", "r", 1, 2, "b")
+	if err != nil {
+		t.Errorf("PullRequests.CreateCommentInReplyToComment returned error: %v", err)
+	}
+
+	want := &PullRequestComment{ID: Int64(3), InReplyTo: Int64(2)}
+	if !reflect.DeepEqual(comment, want) {
+		t.Errorf("PullRequests.CreateCommentInReplyToComment returned %+v, want %+v", comment, want)
+	}
+
+	const methodName = "CreateCommentInReplyToComment"
+	testBadOptions(t, methodName, func() (err error) {
+		_, _, err = client.PullRequests.CreateCommentInReplyToComment(ctx, "\n", "\n", -1, -2, "\n")
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.PullRequests.CreateCommentInReplyToComment(ctx, "o", "r", 1, 2, "b")
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
+
+func TestThreadPullRequestComments(t *testing.T) {
+	c1 := &PullRequestComment{ID: Int64(1)}
+	c2 := &PullRequestComment{ID: Int64(2)}
+	c3 := &PullRequestComment{ID: Int64(3), InReplyTo: Int64(1)}
+	c4 := &PullRequestComment{ID: Int64(4), InReplyTo: Int64(3)}
+	c5 := &PullRequestComment{ID: Int64(5), InReplyTo: Int64(1)}
+	orphan := &PullRequestComment{ID: Int64(6), InReplyTo: Int64(42)}
+	self := &PullRequestComment{ID: Int64(7), InReplyTo: Int64(7)}
+	noID := &PullRequestComment{Body: String("b")}
+
+	got := ThreadPullRequestComments([]*PullRequestComment{c4, c1, c2, c3, nil, c5, orphan, self, noID})
+
+	want := []*PullRequestCommentThread{
+		{
+			Comment: c1,
+			Replies: []*PullRequestCommentThread{
+				{Comment: c3, Replies: []*PullRequestCommentThread{{Comment: c4}}},
+				{Comment: c5},
+			},
+		},
+		{Comment: c2},
+		{Comment: orphan},
+		{Comment: self},
+		{Comment: noID},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("ThreadPullRequestComments returned %+v, want %+v", got, want)
+	}
+
+	if got := ThreadPullRequestComments(nil); got != nil {
+		t.Errorf("ThreadPullRequestComments(nil) returned %+v, want nil", got)
+	}
+}
+
 func TestPullRequestsService_EditComment(t *testing.T) {
 	client, mux, _, teardown := setup()
 	defer teardown()
@@ -0,0 +1,81 @@
+// Copyright 2021 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+)
+
+// DiffTooLargeError occurs when GitHub refuses to generate the diff or patch
+// of a pull request because it is too large, e.g. it changes more than
+// 20,000 lines or 300 files. The changed files, with their individual
+// patches, can still be listed with PullRequestsService.AllFiles.
+type DiffTooLargeError ErrorResponse
+
+func (r *DiffTooLargeError) Error() string { return (*ErrorResponse)(r).Error() }
+
+// GetDiff writes the diff of a pull request to w, as it is received.
+// If the diff is too large for GitHub to generate, a *DiffTooLargeError
+// is returned and nothing is written to w.
+//
+// GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/pulls/#get-a-pull-request
+func (s *PullRequestsService) GetDiff(ctx context.Context, owner, repo string, number int, w io.Writer) (*Response, error) {
+	return s.getRawTo(ctx, owner, repo, number, mediaTypeV3Diff, w)
+}
+
+// GetPatch writes the patch of a pull request, in the format generated by
+// git format-patch, to w as it is received. If the patch is too large for
+// GitHub to generate, a *DiffTooLargeError is returned and nothing is
+// written to w.
+//
+// GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/pulls/#get-a-pull-request
+func (s *PullRequestsService) GetPatch(ctx context.Context, owner, repo string, number int, w io.Writer) (*Response, error) {
+	return s.getRawTo(ctx, owner, repo, number, mediaTypeV3Patch, w)
+}
+
+// getRawTo writes a pull request in the given raw media type to w.
+func (s *PullRequestsService) getRawTo(ctx context.Context, owner, repo string, number int, mediaType string, w io.Writer) (*Response, error) {
+	if w == nil {
+		return nil, errors.New("w must be non-nil")
+	}
+
+	u := fmt.Sprintf("repos/%v/%v/pulls/%d", owner, repo, number)
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Accept", mediaType)
+
+	resp, err := s.client.Do(ctx, req, w)
+	if errResp, ok := err.(*ErrorResponse); ok && isDiffTooLarge(errResp) {
+		return resp, (*DiffTooLargeError)(errResp)
+	}
+
+	return resp, err
+}
+
+// isDiffTooLarge reports whether r is the error GitHub returns when a diff
+// is too large to be generated.
+func isDiffTooLarge(r *ErrorResponse) bool {
+	if r.Response == nil {
+		return false
+	}
+	if r.Response.StatusCode != http.StatusNotAcceptable && r.Response.StatusCode != http.StatusUnprocessableEntity {
+		return false
+	}
+	for _, e := range r.Errors {
+		if e.Code == "too_large" {
+			return true
+		}
+	}
+	msg := strings.ToLower(r.Message)
+	return strings.Contains(msg, "diff exceeded") || strings.Contains(msg, "too large")
+}
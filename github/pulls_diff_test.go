@@ -0,0 +1,122 @@
+// Copyright 2021 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+)
+
+func TestPullRequestsService_GetDiff(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	const rawStr = "diff --git a/f b/f\n"
+
+	mux.HandleFunc("/repos/o/r/pulls/1", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testHeader(t, r, "Accept", mediaTypeV3Diff)
+		fmt.Fprint(w, rawStr)
+	})
+
+	ctx := context.Background()
+	var buf bytes.Buffer
+	if _, err := client.PullRequests.GetDiff(ctx, "o", "r", 1, &buf); err != nil {
+		t.Fatalf("PullRequests.GetDiff returned error: %v", err)
+	}
+	if got := buf.String(); got != rawStr {
+		t.Errorf("PullRequests.GetDiff wrote %q, want %q", got, rawStr)
+	}
+
+	const methodName = "GetDiff"
+	testBadOptions(t, methodName, func() (err error) {
+		_, err = client.PullRequests.GetDiff(ctx, "\n", "\n", -1, &buf)
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		return client.PullRequests.GetDiff(ctx, "o", "r", 1, &buf)
+	})
+}
+
+func TestPullRequestsService_GetPatch(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	const rawStr = "From 1 Mon Sep 17 00:00:00 2001\n"
+
+	mux.HandleFunc("/repos/o/r/pulls/1", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testHeader(t, r, "Accept", mediaTypeV3Patch)
+		fmt.Fprint(w, rawStr)
+	})
+
+	ctx := context.Background()
+	var buf bytes.Buffer
+	if _, err := client.PullRequests.GetPatch(ctx, "o", "r", 1, &buf); err != nil {
+		t.Fatalf("PullRequests.GetPatch returned error: %v", err)
+	}
+	if got := buf.String(); got != rawStr {
+		t.Errorf("PullRequests.GetPatch wrote %q, want %q", got, rawStr)
+	}
+
+	const methodName = "GetPatch"
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		return client.PullRequests.GetPatch(ctx, "o", "r", 1, &buf)
+	})
+}
+
+func TestPullRequestsService_GetDiff_tooLarge(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/pulls/1", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotAcceptable)
+		fmt.Fprint(w, `{"message":"Sorry, the diff exceeded the maximum number of files (300).","errors":[{"resource":"PullRequest","field":"diff","code":"too_large"}]}`)
+	})
+
+	ctx := context.Background()
+	var buf bytes.Buffer
+	resp, err := client.PullRequests.GetDiff(ctx, "o", "r", 1, &buf)
+	if _, ok := err.(*DiffTooLargeError); !ok {
+		t.Errorf("PullRequests.GetDiff returned error %#v, want *DiffTooLargeError", err)
+	}
+	if resp == nil || resp.StatusCode != http.StatusNotAcceptable {
+		t.Errorf("PullRequests.GetDiff returned response %#v, want status %v", resp, http.StatusNotAcceptable)
+	}
+	if buf.Len() != 0 {
+		t.Errorf("PullRequests.GetDiff wrote %q, want nothing", buf.String())
+	}
+}
+
+func TestPullRequestsService_GetDiff_otherError(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/pulls/1", func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, `{"message":"Not Found"}`, http.StatusNotFound)
+	})
+
+	ctx := context.Background()
+	var buf bytes.Buffer
+	_, err := client.PullRequests.GetDiff(ctx, "o", "r", 1, &buf)
+	if _, ok := err.(*ErrorResponse); !ok {
+		t.Errorf("PullRequests.GetDiff returned error %#v, want *ErrorResponse", err)
+	}
+}
+
+func TestPullRequestsService_GetDiff_nilWriter(t *testing.T) {
+	client, _, _, teardown := setup()
+	defer teardown()
+
+	ctx := context.Background()
+	if _, err := client.PullRequests.GetDiff(ctx, "o", "r", 1, nil); err == nil {
+		t.Error("PullRequests.GetDiff with nil writer returned no error")
+	}
+}
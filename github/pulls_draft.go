@@ -0,0 +1,71 @@
+// Copyright 2021 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import "context"
+
+// Draft pull requests are created by setting NewPullRequest.Draft, but the
+// REST API cannot change the draft state of an existing pull request, so
+// the methods below use the GitHub GraphQL API.
+
+// MarkReadyForReview marks a draft pull request as ready for review.
+// This method makes two GraphQL requests; the returned Response is that
+// of the last one. Only the NodeID, Number and Draft fields of the returned
+// PullRequest are populated.
+//
+// GitHub API docs: https://docs.github.com/en/graphql/reference/mutations#markpullrequestreadyforreview
+func (s *PullRequestsService) MarkReadyForReview(ctx context.Context, owner, repo string, number int) (*PullRequest, *Response, error) {
+	return s.setDraft(ctx, "markPullRequestReadyForReview", owner, repo, number)
+}
+
+// ConvertToDraft converts a pull request to a draft.
+// This method makes two GraphQL requests; the returned Response is that
+// of the last one. Only the NodeID, Number and Draft fields of the returned
+// PullRequest are populated.
+//
+// GitHub API docs: https://docs.github.com/en/graphql/reference/mutations#convertpullrequesttodraft
+func (s *PullRequestsService) ConvertToDraft(ctx context.Context, owner, repo string, number int) (*PullRequest, *Response, error) {
+	return s.setDraft(ctx, "convertPullRequestToDraft", owner, repo, number)
+}
+
+// setDraft runs mutation, one of markPullRequestReadyForReview or
+// convertPullRequestToDraft, on a pull request.
+func (s *PullRequestsService) setDraft(ctx context.Context, mutation, owner, repo string, number int) (*PullRequest, *Response, error) {
+	id, resp, err := s.pullRequestNodeID(ctx, owner, repo, number)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	query := `mutation($id: ID!) {
+  ` + mutation + `(input: {pullRequestId: $id}) {
+    pullRequest { id number isDraft }
+  }
+}`
+	variables := map[string]interface{}{"id": id}
+
+	var data map[string]*struct {
+		PullRequest *struct {
+			ID      *string `json:"id"`
+			Number  *int    `json:"number"`
+			IsDraft *bool   `json:"isDraft"`
+		} `json:"pullRequest"`
+	}
+	resp, err = s.client.graphQL(ctx, query, variables, &data)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	payload := data[mutation]
+	if payload == nil || payload.PullRequest == nil {
+		return nil, resp, nil
+	}
+
+	return &PullRequest{
+		NodeID: payload.PullRequest.ID,
+		Number: payload.PullRequest.Number,
+		Draft:  payload.PullRequest.IsDraft,
+	}, resp, nil
+}
@@ -0,0 +1,82 @@
+// Copyright 2021 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestPullRequestsService_MarkReadyForReview(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/graphql", handlePullRequestNodeID(t, func(w http.ResponseWriter, v *graphQLRequest) {
+		if !strings.Contains(v.Query, "markPullRequestReadyForReview(input: {pullRequestId: $id})") {
+			t.Errorf("Request query = %q, want markPullRequestReadyForReview mutation", v.Query)
+		}
+		if want := map[string]interface{}{"id": "PR1"}; !reflect.DeepEqual(v.Variables, want) {
+			t.Errorf("Request variables = %+v, want %+v", v.Variables, want)
+		}
+		fmt.Fprint(w, `{"data":{"markPullRequestReadyForReview":{"pullRequest":{"id":"PR1","number":1,"isDraft":false}}}}`)
+	}))
+
+	ctx := context.Background()
+	pull, _, err := client.PullRequests.MarkReadyForReview(ctx, "o", "r", 1)
+	if err != nil {
+		t.Errorf("PullRequests.MarkReadyForReview returned error: %v", err)
+	}
+
+	want := &PullRequest{NodeID: String("PR1"), Number: Int(1), Draft: Bool(false)}
+	if !reflect.DeepEqual(pull, want) {
+		t.Errorf("PullRequests.MarkReadyForReview returned %+v, want %+v", pull, want)
+	}
+
+	const methodName = "MarkReadyForReview"
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.PullRequests.MarkReadyForReview(ctx, "o", "r", 1)
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
+
+func TestPullRequestsService_ConvertToDraft(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/graphql", handlePullRequestNodeID(t, func(w http.ResponseWriter, v *graphQLRequest) {
+		if !strings.Contains(v.Query, "convertPullRequestToDraft(input: {pullRequestId: $id})") {
+			t.Errorf("Request query = %q, want convertPullRequestToDraft mutation", v.Query)
+		}
+		fmt.Fprint(w, `{"data":{"convertPullRequestToDraft":{"pullRequest":{"id":"PR1","number":1,"isDraft":true}}}}`)
+	}))
+
+	ctx := context.Background()
+	pull, _, err := client.PullRequests.ConvertToDraft(ctx, "o", "r", 1)
+	if err != nil {
+		t.Errorf("PullRequests.ConvertToDraft returned error: %v", err)
+	}
+
+	want := &PullRequest{NodeID: String("PR1"), Number: Int(1), Draft: Bool(true)}
+	if !reflect.DeepEqual(pull, want) {
+		t.Errorf("PullRequests.ConvertToDraft returned %+v, want %+v", pull, want)
+	}
+
+	const methodName = "ConvertToDraft"
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.PullRequests.ConvertToDraft(ctx, "o", "r", 1)
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
@@ -0,0 +1,53 @@
+// Copyright 2021 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import "context"
+
+// PullRequestFilesIterator iterates over the files of a pull request,
+// fetching the pages of PullRequestsService.ListFiles as needed.
+type PullRequestFilesIterator struct {
+	pageIterator
+
+	files []*CommitFile // remaining files of the current page
+	file  *CommitFile
+}
+
+// AllFiles returns an iterator over all the files in a pull request.
+// opts may be used to set the page size and the page to start from.
+// No request is made until the first call to Next.
+//
+// GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/pulls/#list-pull-requests-files
+func (s *PullRequestsService) AllFiles(ctx context.Context, owner, repo string, number int, opts *ListOptions) *PullRequestFilesIterator {
+	var o ListOptions
+	if opts != nil {
+		o = *opts
+	}
+	it := &PullRequestFilesIterator{}
+	it.fetch = listPages(&o, func() (n int, resp *Response, err error) {
+		it.files, resp, err = s.ListFiles(ctx, owner, repo, number, &o)
+		return len(it.files), resp, err
+	})
+	return it
+}
+
+// Next advances the iterator to the next file, which is then available
+// through File. It returns false when there are no more files or an error
+// occurred, in which case it is returned by Err.
+func (it *PullRequestFilesIterator) Next() bool {
+	if !it.next() {
+		it.file = nil
+		return false
+	}
+	it.file, it.files = it.files[0], it.files[1:]
+	return true
+}
+
+// File returns the current file, or nil if Next has not been called or
+// returned false.
+func (it *PullRequestFilesIterator) File() *CommitFile {
+	return it.file
+}
@@ -0,0 +1,79 @@
+// Copyright 2021 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+func TestPullRequestsService_AllFiles(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/pulls/1/files", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		switch r.FormValue("page") {
+		case "":
+			testFormValues(t, r, values{"per_page": "2"})
+			w.Header().Set("Link", `<https://api.github.com/repos/o/r/pulls/1/files?page=2&per_page=2>; rel="next"`)
+			fmt.Fprint(w, `[{"filename":"a"},{"filename":"b"}]`)
+		case "2":
+			testFormValues(t, r, values{"page": "2", "per_page": "2"})
+			fmt.Fprint(w, `[{"filename":"c"}]`)
+		default:
+			t.Errorf("Unexpected page %q", r.FormValue("page"))
+		}
+	})
+
+	ctx := context.Background()
+	it := client.PullRequests.AllFiles(ctx, "o", "r", 1, &ListOptions{PerPage: 2})
+	if it.File() != nil || it.Response() != nil {
+		t.Errorf("PullRequests.AllFiles iterator has file or response before Next")
+	}
+
+	var got []string
+	for it.Next() {
+		got = append(got, it.File().GetFilename())
+	}
+	if err := it.Err(); err != nil {
+		t.Errorf("PullRequests.AllFiles returned error: %v", err)
+	}
+
+	if want := []string{"a", "b", "c"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("PullRequests.AllFiles returned %+v, want %+v", got, want)
+	}
+	if it.File() != nil {
+		t.Errorf("PullRequests.AllFiles iterator File = %+v after end, want nil", it.File())
+	}
+	if it.Next() {
+		t.Errorf("PullRequests.AllFiles iterator Next returned true after end")
+	}
+}
+
+func TestPullRequestsService_AllFiles_error(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/pulls/1/files", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	})
+
+	ctx := context.Background()
+	it := client.PullRequests.AllFiles(ctx, "o", "r", 1, nil)
+	if it.Next() {
+		t.Errorf("PullRequests.AllFiles iterator Next returned true, want false")
+	}
+	if it.Err() == nil {
+		t.Errorf("PullRequests.AllFiles iterator Err returned nil, want error")
+	}
+	if resp := it.Response(); resp == nil || resp.StatusCode != http.StatusNotFound {
+		t.Errorf("PullRequests.AllFiles iterator Response = %#v, want status %v", resp, http.StatusNotFound)
+	}
+}
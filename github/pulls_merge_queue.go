@@ -0,0 +1,272 @@
+// Copyright 2021 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"time"
+)
+
+// MergeQueueEntry represents a pull request in the merge queue of a branch.
+// Merge queues are only exposed by the GitHub GraphQL API, so ID is a
+// GraphQL node ID.
+type MergeQueueEntry struct {
+	ID *string `json:"id,omitempty"`
+	// Position is the position of the entry in the queue, starting at 1.
+	Position *int `json:"position,omitempty"`
+	// Possible values for State are: QUEUED, AWAITING_CHECKS, MERGEABLE, UNMERGEABLE, LOCKED.
+	State      *string    `json:"state,omitempty"`
+	EnqueuedAt *time.Time `json:"enqueued_at,omitempty"`
+	// EstimatedTimeToMerge is the estimated number of seconds until the
+	// entry is merged.
+	EstimatedTimeToMerge *int `json:"estimated_time_to_merge,omitempty"`
+	// Jump reports whether the entry was added to the front of the queue.
+	Jump *bool `json:"jump,omitempty"`
+	// Solo reports whether the entry is merged on its own, rather than in
+	// a group with other entries.
+	Solo     *bool   `json:"solo,omitempty"`
+	HeadSHA  *string `json:"head_sha,omitempty"`
+	BaseSHA  *string `json:"base_sha,omitempty"`
+	Enqueuer *User   `json:"enqueuer,omitempty"`
+	// Only the NodeID, Number and Title fields of PullRequest are populated.
+	PullRequest *PullRequest `json:"pull_request,omitempty"`
+}
+
+// ListMergeQueueEntriesOptions specifies the optional parameters to the
+// PullRequestsService.ListMergeQueueEntries method.
+type ListMergeQueueEntriesOptions struct {
+	// After is a cursor for paginating through the results.
+	// Set it from Response.After.
+	After string
+
+	// The number of entries to include per page (max 100). Default: 100.
+	PerPage int
+}
+
+// EnqueuePullRequestOptions specifies the optional parameters to the
+// PullRequestsService.EnqueuePullRequest method.
+type EnqueuePullRequestOptions struct {
+	SHA  string // SHA that pull request head must match to allow enqueuing. (Optional.)
+	Jump bool   // Add the pull request to the front of the queue. (Optional.)
+}
+
+// mergeQueueEntryFields are the GraphQL fields of a MergeQueueEntry.
+const mergeQueueEntryFields = `id position state enqueuedAt estimatedTimeToMerge jump solo headCommit { oid } baseCommit { oid } enqueuer { login } pullRequest { id number title }`
+
+// graphQLMergeQueueEntry is the GraphQL representation of a MergeQueueEntry.
+type graphQLMergeQueueEntry struct {
+	ID                   *string    `json:"id"`
+	Position             *int       `json:"position"`
+	State                *string    `json:"state"`
+	EnqueuedAt           *time.Time `json:"enqueuedAt"`
+	EstimatedTimeToMerge *int       `json:"estimatedTimeToMerge"`
+	Jump                 *bool      `json:"jump"`
+	Solo                 *bool      `json:"solo"`
+	HeadCommit           *struct {
+		OID *string `json:"oid"`
+	} `json:"headCommit"`
+	BaseCommit *struct {
+		OID *string `json:"oid"`
+	} `json:"baseCommit"`
+	Enqueuer    *graphQLActor `json:"enqueuer"`
+	PullRequest *struct {
+		ID     *string `json:"id"`
+		Number *int    `json:"number"`
+		Title  *string `json:"title"`
+	} `json:"pullRequest"`
+}
+
+// entry converts e to a MergeQueueEntry.
+func (e *graphQLMergeQueueEntry) entry() *MergeQueueEntry {
+	if e == nil {
+		return nil
+	}
+	entry := &MergeQueueEntry{
+		ID:                   e.ID,
+		Position:             e.Position,
+		State:                e.State,
+		EnqueuedAt:           e.EnqueuedAt,
+		EstimatedTimeToMerge: e.EstimatedTimeToMerge,
+		Jump:                 e.Jump,
+		Solo:                 e.Solo,
+		Enqueuer:             e.Enqueuer.user(),
+	}
+	if e.HeadCommit != nil {
+		entry.HeadSHA = e.HeadCommit.OID
+	}
+	if e.BaseCommit != nil {
+		entry.BaseSHA = e.BaseCommit.OID
+	}
+	if e.PullRequest != nil {
+		entry.PullRequest = &PullRequest{
+			NodeID: e.PullRequest.ID,
+			Number: e.PullRequest.Number,
+			Title:  e.PullRequest.Title,
+		}
+	}
+	return entry
+}
+
+// ListMergeQueueEntries lists the entries of the merge queue of a branch,
+// in queue order. If branch is empty, the queue of the default branch is
+// listed. If there are more results, Response.After is set to the cursor
+// to pass as ListMergeQueueEntriesOptions.After.
+//
+// GitHub API docs: https://docs.github.com/en/graphql/reference/objects#mergequeue
+func (s *PullRequestsService) ListMergeQueueEntries(ctx context.Context, owner, repo, branch string, opts *ListMergeQueueEntriesOptions) ([]*MergeQueueEntry, *Response, error) {
+	query := `query($owner: String!, $repo: String!, $branch: String, $first: Int!, $after: String) {
+  repository(owner: $owner, name: $repo) {
+    mergeQueue(branch: $branch) {
+      entries(first: $first, after: $after) {
+        pageInfo { hasNextPage endCursor }
+        nodes { ` + mergeQueueEntryFields + ` }
+      }
+    }
+  }
+}`
+	variables := map[string]interface{}{
+		"owner": owner,
+		"repo":  repo,
+		"first": 100,
+	}
+	if branch != "" {
+		variables["branch"] = branch
+	}
+	if opts != nil {
+		if opts.PerPage > 0 {
+			variables["first"] = opts.PerPage
+		}
+		if opts.After != "" {
+			variables["after"] = opts.After
+		}
+	}
+
+	var data struct {
+		Repository struct {
+			MergeQueue *struct {
+				Entries struct {
+					PageInfo graphQLPageInfo           `json:"pageInfo"`
+					Nodes    []*graphQLMergeQueueEntry `json:"nodes"`
+				} `json:"entries"`
+			} `json:"mergeQueue"`
+		} `json:"repository"`
+	}
+	resp, err := s.client.graphQL(ctx, query, variables, &data)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	// The branch has no merge queue.
+	if data.Repository.MergeQueue == nil {
+		return nil, resp, nil
+	}
+
+	entries := data.Repository.MergeQueue.Entries
+	if entries.PageInfo.HasNextPage {
+		resp.After = entries.PageInfo.EndCursor
+	}
+
+	queue := make([]*MergeQueueEntry, 0, len(entries.Nodes))
+	for _, e := range entries.Nodes {
+		queue = append(queue, e.entry())
+	}
+
+	return queue, resp, nil
+}
+
+// GetMergeQueueEntry gets the merge queue entry of a pull request, which
+// includes its position in the queue. It returns a nil entry if the pull
+// request is not in a merge queue.
+//
+// GitHub API docs: https://docs.github.com/en/graphql/reference/objects#mergequeueentry
+func (s *PullRequestsService) GetMergeQueueEntry(ctx context.Context, owner, repo string, number int) (*MergeQueueEntry, *Response, error) {
+	query := `query($owner: String!, $repo: String!, $number: Int!) {
+  repository(owner: $owner, name: $repo) {
+    pullRequest(number: $number) {
+      mergeQueueEntry { ` + mergeQueueEntryFields + ` }
+    }
+  }
+}`
+	variables := map[string]interface{}{
+		"owner":  owner,
+		"repo":   repo,
+		"number": number,
+	}
+
+	var data struct {
+		Repository struct {
+			PullRequest struct {
+				MergeQueueEntry *graphQLMergeQueueEntry `json:"mergeQueueEntry"`
+			} `json:"pullRequest"`
+		} `json:"repository"`
+	}
+	resp, err := s.client.graphQL(ctx, query, variables, &data)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return data.Repository.PullRequest.MergeQueueEntry.entry(), resp, nil
+}
+
+// EnqueuePullRequest adds a pull request to the merge queue of its base
+// branch. This method makes two GraphQL requests; the returned Response
+// is that of the last one.
+//
+// GitHub API docs: https://docs.github.com/en/graphql/reference/mutations#enqueuepullrequest
+func (s *PullRequestsService) EnqueuePullRequest(ctx context.Context, owner, repo string, number int, opts *EnqueuePullRequestOptions) (*MergeQueueEntry, *Response, error) {
+	id, resp, err := s.pullRequestNodeID(ctx, owner, repo, number)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	query := `mutation($id: ID!, $expectedHeadOid: GitObjectID, $jump: Boolean) {
+  enqueuePullRequest(input: {pullRequestId: $id, expectedHeadOid: $expectedHeadOid, jump: $jump}) {
+    mergeQueueEntry { ` + mergeQueueEntryFields + ` }
+  }
+}`
+	variables := map[string]interface{}{"id": id}
+	if opts != nil {
+		if opts.SHA != "" {
+			variables["expectedHeadOid"] = opts.SHA
+		}
+		if opts.Jump {
+			variables["jump"] = true
+		}
+	}
+
+	var data struct {
+		EnqueuePullRequest struct {
+			MergeQueueEntry *graphQLMergeQueueEntry `json:"mergeQueueEntry"`
+		} `json:"enqueuePullRequest"`
+	}
+	resp, err = s.client.graphQL(ctx, query, variables, &data)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return data.EnqueuePullRequest.MergeQueueEntry.entry(), resp, nil
+}
+
+// DequeuePullRequest removes a pull request from the merge queue.
+// This method makes two GraphQL requests; the returned Response is that
+// of the last one.
+//
+// GitHub API docs: https://docs.github.com/en/graphql/reference/mutations#dequeuepullrequest
+func (s *PullRequestsService) DequeuePullRequest(ctx context.Context, owner, repo string, number int) (*Response, error) {
+	id, resp, err := s.pullRequestNodeID(ctx, owner, repo, number)
+	if err != nil {
+		return resp, err
+	}
+
+	query := `mutation($id: ID!) {
+  dequeuePullRequest(input: {id: $id}) {
+    mergeQueueEntry { id }
+  }
+}`
+	variables := map[string]interface{}{"id": id}
+
+	return s.client.graphQL(ctx, query, variables, nil)
+}
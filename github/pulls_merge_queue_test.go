@@ -0,0 +1,240 @@
+// Copyright 2021 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+const mergeQueueEntryJSON = `{
+	"id": "MQE1",
+	"position": 2,
+	"state": "AWAITING_CHECKS",
+	"enqueuedAt": "2006-01-02T15:04:05Z",
+	"estimatedTimeToMerge": 600,
+	"jump": false,
+	"solo": false,
+	"headCommit": {"oid": "h"},
+	"baseCommit": {"oid": "b"},
+	"enqueuer": {"login": "l"},
+	"pullRequest": {"id": "PR1", "number": 1, "title": "t"}
+}`
+
+var wantMergeQueueEntry = &MergeQueueEntry{
+	ID:                   String("MQE1"),
+	Position:             Int(2),
+	State:                String("AWAITING_CHECKS"),
+	EnqueuedAt:           &referenceMergeQueueTime,
+	EstimatedTimeToMerge: Int(600),
+	Jump:                 Bool(false),
+	Solo:                 Bool(false),
+	HeadSHA:              String("h"),
+	BaseSHA:              String("b"),
+	Enqueuer:             &User{Login: String("l")},
+	PullRequest:          &PullRequest{NodeID: String("PR1"), Number: Int(1), Title: String("t")},
+}
+
+var referenceMergeQueueTime = time.Date(2006, time.January, 2, 15, 4, 5, 0, time.UTC)
+
+func TestPullRequestsService_ListMergeQueueEntries(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/graphql", func(w http.ResponseWriter, r *http.Request) {
+		v := new(graphQLRequest)
+		json.NewDecoder(r.Body).Decode(v)
+
+		testMethod(t, r, "POST")
+		if !strings.Contains(v.Query, "mergeQueue(branch: $branch)") {
+			t.Errorf("Request query = %q, want mergeQueue query", v.Query)
+		}
+		want := map[string]interface{}{
+			"owner":  "o",
+			"repo":   "r",
+			"branch": "main",
+			"first":  float64(1),
+			"after":  "c1",
+		}
+		if !reflect.DeepEqual(v.Variables, want) {
+			t.Errorf("Request variables = %+v, want %+v", v.Variables, want)
+		}
+
+		fmt.Fprint(w, `{"data":{"repository":{"mergeQueue":{"entries":{
+			"pageInfo": {"hasNextPage": true, "endCursor": "c2"},
+			"nodes": [`+mergeQueueEntryJSON+`]
+		}}}}}`)
+	})
+
+	opts := &ListMergeQueueEntriesOptions{After: "c1", PerPage: 1}
+	ctx := context.Background()
+	entries, resp, err := client.PullRequests.ListMergeQueueEntries(ctx, "o", "r", "main", opts)
+	if err != nil {
+		t.Errorf("PullRequests.ListMergeQueueEntries returned error: %v", err)
+	}
+
+	want := []*MergeQueueEntry{wantMergeQueueEntry}
+	if !reflect.DeepEqual(entries, want) {
+		t.Errorf("PullRequests.ListMergeQueueEntries returned %+v, want %+v", entries, want)
+	}
+	if got, want := resp.After, "c2"; got != want {
+		t.Errorf("PullRequests.ListMergeQueueEntries returned After %v, want %v", got, want)
+	}
+
+	const methodName = "ListMergeQueueEntries"
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.PullRequests.ListMergeQueueEntries(ctx, "o", "r", "main", opts)
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
+
+func TestPullRequestsService_ListMergeQueueEntries_noQueue(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/graphql", func(w http.ResponseWriter, r *http.Request) {
+		v := new(graphQLRequest)
+		json.NewDecoder(r.Body).Decode(v)
+
+		if _, ok := v.Variables["branch"]; ok {
+			t.Errorf("Request variables = %+v, want no branch", v.Variables)
+		}
+		fmt.Fprint(w, `{"data":{"repository":{"mergeQueue":null}}}`)
+	})
+
+	ctx := context.Background()
+	entries, _, err := client.PullRequests.ListMergeQueueEntries(ctx, "o", "r", "", nil)
+	if err != nil {
+		t.Errorf("PullRequests.ListMergeQueueEntries returned error: %v", err)
+	}
+	if entries != nil {
+		t.Errorf("PullRequests.ListMergeQueueEntries returned %+v, want nil", entries)
+	}
+}
+
+func TestPullRequestsService_GetMergeQueueEntry(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/graphql", func(w http.ResponseWriter, r *http.Request) {
+		v := new(graphQLRequest)
+		json.NewDecoder(r.Body).Decode(v)
+
+		testMethod(t, r, "POST")
+		want := map[string]interface{}{"owner": "o", "repo": "r", "number": float64(1)}
+		if !reflect.DeepEqual(v.Variables, want) {
+			t.Errorf("Request variables = %+v, want %+v", v.Variables, want)
+		}
+
+		fmt.Fprint(w, `{"data":{"repository":{"pullRequest":{"mergeQueueEntry":`+mergeQueueEntryJSON+`}}}}`)
+	})
+
+	ctx := context.Background()
+	entry, _, err := client.PullRequests.GetMergeQueueEntry(ctx, "o", "r", 1)
+	if err != nil {
+		t.Errorf("PullRequests.GetMergeQueueEntry returned error: %v", err)
+	}
+	if !reflect.DeepEqual(entry, wantMergeQueueEntry) {
+		t.Errorf("PullRequests.GetMergeQueueEntry returned %+v, want %+v", entry, wantMergeQueueEntry)
+	}
+
+	const methodName = "GetMergeQueueEntry"
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.PullRequests.GetMergeQueueEntry(ctx, "o", "r", 1)
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
+
+func TestPullRequestsService_GetMergeQueueEntry_notQueued(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/graphql", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"data":{"repository":{"pullRequest":{"mergeQueueEntry":null}}}}`)
+	})
+
+	ctx := context.Background()
+	entry, _, err := client.PullRequests.GetMergeQueueEntry(ctx, "o", "r", 1)
+	if err != nil {
+		t.Errorf("PullRequests.GetMergeQueueEntry returned error: %v", err)
+	}
+	if entry != nil {
+		t.Errorf("PullRequests.GetMergeQueueEntry returned %+v, want nil", entry)
+	}
+}
+
+func TestPullRequestsService_EnqueuePullRequest(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/graphql", handlePullRequestNodeID(t, func(w http.ResponseWriter, v *graphQLRequest) {
+		if !strings.Contains(v.Query, "enqueuePullRequest(") {
+			t.Errorf("Request query = %q, want enqueuePullRequest mutation", v.Query)
+		}
+		want := map[string]interface{}{"id": "PR1", "expectedHeadOid": "h", "jump": true}
+		if !reflect.DeepEqual(v.Variables, want) {
+			t.Errorf("Request variables = %+v, want %+v", v.Variables, want)
+		}
+
+		fmt.Fprint(w, `{"data":{"enqueuePullRequest":{"mergeQueueEntry":`+mergeQueueEntryJSON+`}}}`)
+	}))
+
+	opts := &EnqueuePullRequestOptions{SHA: "h", Jump: true}
+	ctx := context.Background()
+	entry, _, err := client.PullRequests.EnqueuePullRequest(ctx, "o", "r", 1, opts)
+	if err != nil {
+		t.Errorf("PullRequests.EnqueuePullRequest returned error: %v", err)
+	}
+	if !reflect.DeepEqual(entry, wantMergeQueueEntry) {
+		t.Errorf("PullRequests.EnqueuePullRequest returned %+v, want %+v", entry, wantMergeQueueEntry)
+	}
+
+	const methodName = "EnqueuePullRequest"
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.PullRequests.EnqueuePullRequest(ctx, "o", "r", 1, opts)
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
+
+func TestPullRequestsService_DequeuePullRequest(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/graphql", handlePullRequestNodeID(t, func(w http.ResponseWriter, v *graphQLRequest) {
+		if !strings.Contains(v.Query, "dequeuePullRequest(input: {id: $id})") {
+			t.Errorf("Request query = %q, want dequeuePullRequest mutation", v.Query)
+		}
+		if want := map[string]interface{}{"id": "PR1"}; !reflect.DeepEqual(v.Variables, want) {
+			t.Errorf("Request variables = %+v, want %+v", v.Variables, want)
+		}
+		fmt.Fprint(w, `{"data":{"dequeuePullRequest":{"mergeQueueEntry":{"id":"MQE1"}}}}`)
+	}))
+
+	ctx := context.Background()
+	if _, err := client.PullRequests.DequeuePullRequest(ctx, "o", "r", 1); err != nil {
+		t.Errorf("PullRequests.DequeuePullRequest returned error: %v", err)
+	}
+
+	const methodName = "DequeuePullRequest"
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		return client.PullRequests.DequeuePullRequest(ctx, "o", "r", 1)
+	})
+}
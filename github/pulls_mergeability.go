@@ -0,0 +1,104 @@
+// Copyright 2021 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"time"
+)
+
+// Mergeable states of a pull request, as reported by
+// PullRequest.MergeableState and PullRequestMergeability.State.
+const (
+	MergeableStateClean    = "clean"     // Mergeable, all checks passed.
+	MergeableStateUnstable = "unstable"  // Mergeable, but some non-required checks failed.
+	MergeableStateHasHooks = "has_hooks" // Mergeable, with passing pre-receive hooks.
+	MergeableStateBehind   = "behind"    // The head branch is behind the base branch.
+	MergeableStateBlocked  = "blocked"   // Merging is blocked, e.g. by a required review or check.
+	MergeableStateDirty    = "dirty"     // The merge commit cannot be cleanly created.
+	MergeableStateDraft    = "draft"     // The pull request is a draft.
+	MergeableStateUnknown  = "unknown"   // GitHub has not computed the state yet.
+)
+
+// PullRequestMergeability is the mergeability verdict of a pull request,
+// as returned by PullRequestsService.WaitForMergeability.
+type PullRequestMergeability struct {
+	// Mergeable reports whether the pull request can be merged without conflicts.
+	Mergeable bool
+	// State is the mergeable state of the pull request, one of the
+	// MergeableState constants.
+	State string
+	// PullRequest is the pull request the verdict was computed from.
+	PullRequest *PullRequest
+}
+
+// CanMerge reports whether the pull request can be merged right now.
+func (m *PullRequestMergeability) CanMerge() bool {
+	if !m.Mergeable {
+		return false
+	}
+	switch m.State {
+	case MergeableStateClean, MergeableStateUnstable, MergeableStateHasHooks:
+		return true
+	}
+	return false
+}
+
+// MergeabilityOptions specifies the optional parameters to the
+// PullRequestsService.WaitForMergeability method.
+type MergeabilityOptions struct {
+	// InitialInterval is the delay before polling again the first time
+	// the mergeability is not yet computed. It doubles after each poll,
+	// up to MaxInterval. Default: 1 second.
+	InitialInterval time.Duration
+
+	// MaxInterval is the maximum delay between two polls. Default: 16 seconds.
+	MaxInterval time.Duration
+}
+
+// WaitForMergeability gets a pull request until GitHub has computed whether
+// it can be merged, which happens in the background after the pull request
+// or its base branch changes. The delay between polls increases
+// exponentially; use ctx to bound the total wait.
+//
+// Closed pull requests are never computed, so their verdict is returned
+// immediately, with Mergeable false.
+//
+// GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/guides/getting-started-with-the-git-database-api#checking-mergeability-of-pull-requests
+func (s *PullRequestsService) WaitForMergeability(ctx context.Context, owner, repo string, number int, opts *MergeabilityOptions) (*PullRequestMergeability, *Response, error) {
+	interval, maxInterval := time.Second, 16*time.Second
+	if opts != nil {
+		if opts.InitialInterval > 0 {
+			interval = opts.InitialInterval
+		}
+		if opts.MaxInterval > 0 {
+			maxInterval = opts.MaxInterval
+		}
+	}
+
+	for {
+		pull, resp, err := s.Get(ctx, owner, repo, number)
+		if err != nil {
+			return nil, resp, err
+		}
+
+		if pull.GetState() == "closed" || (pull.Mergeable != nil && pull.GetMergeableState() != MergeableStateUnknown) {
+			return &PullRequestMergeability{
+				Mergeable:   pull.GetMergeable(),
+				State:       pull.GetMergeableState(),
+				PullRequest: pull,
+			}, resp, nil
+		}
+
+		if err := sleepUntil(ctx, time.Now().Add(interval)); err != nil {
+			return nil, resp, err
+		}
+
+		if interval *= 2; interval > maxInterval {
+			interval = maxInterval
+		}
+	}
+}
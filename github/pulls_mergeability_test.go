@@ -0,0 +1,136 @@
+// Copyright 2021 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestPullRequestsService_WaitForMergeability(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	calls := 0
+	mux.HandleFunc("/repos/o/r/pulls/1", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		calls++
+		switch calls {
+		case 1:
+			fmt.Fprint(w, `{"number":1,"state":"open","mergeable":null,"mergeable_state":"unknown"}`)
+		case 2:
+			fmt.Fprint(w, `{"number":1,"state":"open","mergeable":true,"mergeable_state":"unknown"}`)
+		default:
+			fmt.Fprint(w, `{"number":1,"state":"open","mergeable":true,"mergeable_state":"clean"}`)
+		}
+	})
+
+	ctx := context.Background()
+	opts := &MergeabilityOptions{InitialInterval: time.Millisecond, MaxInterval: 2 * time.Millisecond}
+	got, _, err := client.PullRequests.WaitForMergeability(ctx, "o", "r", 1, opts)
+	if err != nil {
+		t.Fatalf("PullRequests.WaitForMergeability returned error: %v", err)
+	}
+
+	want := &PullRequestMergeability{
+		Mergeable: true,
+		State:     MergeableStateClean,
+		PullRequest: &PullRequest{
+			Number:         Int(1),
+			State:          String("open"),
+			Mergeable:      Bool(true),
+			MergeableState: String("clean"),
+		},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("PullRequests.WaitForMergeability returned %+v, want %+v", got, want)
+	}
+	if calls != 3 {
+		t.Errorf("PullRequests.WaitForMergeability made %v requests, want 3", calls)
+	}
+	if !got.CanMerge() {
+		t.Errorf("PullRequestMergeability.CanMerge returned false, want true")
+	}
+
+	const methodName = "WaitForMergeability"
+	testBadOptions(t, methodName, func() (err error) {
+		_, _, err = client.PullRequests.WaitForMergeability(ctx, "\n", "\n", -1, opts)
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.PullRequests.WaitForMergeability(ctx, "o", "r", 1, opts)
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
+
+func TestPullRequestsService_WaitForMergeability_closed(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/pulls/1", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"number":1,"state":"closed","mergeable":null,"mergeable_state":"unknown"}`)
+	})
+
+	ctx := context.Background()
+	got, _, err := client.PullRequests.WaitForMergeability(ctx, "o", "r", 1, nil)
+	if err != nil {
+		t.Fatalf("PullRequests.WaitForMergeability returned error: %v", err)
+	}
+	if got.Mergeable || got.CanMerge() {
+		t.Errorf("PullRequests.WaitForMergeability returned %+v, want not mergeable", got)
+	}
+}
+
+func TestPullRequestsService_WaitForMergeability_contextCanceled(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/pulls/1", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"number":1,"state":"open","mergeable":null}`)
+	})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	opts := &MergeabilityOptions{InitialInterval: time.Millisecond, MaxInterval: 5 * time.Millisecond}
+	got, resp, err := client.PullRequests.WaitForMergeability(ctx, "o", "r", 1, opts)
+	if err != context.DeadlineExceeded {
+		t.Errorf("PullRequests.WaitForMergeability returned error %v, want %v", err, context.DeadlineExceeded)
+	}
+	if got != nil {
+		t.Errorf("PullRequests.WaitForMergeability returned %+v, want nil", got)
+	}
+	if resp == nil {
+		t.Errorf("PullRequests.WaitForMergeability returned nil response, want the last response")
+	}
+}
+
+func TestPullRequestMergeability_CanMerge(t *testing.T) {
+	tests := []struct {
+		m    *PullRequestMergeability
+		want bool
+	}{
+		{m: &PullRequestMergeability{Mergeable: true, State: MergeableStateClean}, want: true},
+		{m: &PullRequestMergeability{Mergeable: true, State: MergeableStateUnstable}, want: true},
+		{m: &PullRequestMergeability{Mergeable: true, State: MergeableStateHasHooks}, want: true},
+		{m: &PullRequestMergeability{Mergeable: true, State: MergeableStateBlocked}, want: false},
+		{m: &PullRequestMergeability{Mergeable: true, State: MergeableStateBehind}, want: false},
+		{m: &PullRequestMergeability{Mergeable: false, State: MergeableStateDirty}, want: false},
+	}
+
+	for _, tt := range tests {
+		if got := tt.m.CanMerge(); got != tt.want {
+			t.Errorf("%+v.CanMerge() = %v, want %v", tt.m, got, tt.want)
+		}
+	}
+}
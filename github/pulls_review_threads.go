@@ -0,0 +1,230 @@
+// Copyright 2021 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"time"
+)
+
+// PullRequestReviewThread represents a thread of review comments on a pull
+// request, also known as a conversation. Review threads are only exposed by
+// the GitHub GraphQL API, so ID is a GraphQL node ID.
+type PullRequestReviewThread struct {
+	ID                *string `json:"id,omitempty"`
+	IsResolved        *bool   `json:"is_resolved,omitempty"`
+	IsOutdated        *bool   `json:"is_outdated,omitempty"`
+	IsCollapsed       *bool   `json:"is_collapsed,omitempty"`
+	Path              *string `json:"path,omitempty"`
+	Line              *int    `json:"line,omitempty"`
+	OriginalLine      *int    `json:"original_line,omitempty"`
+	StartLine         *int    `json:"start_line,omitempty"`
+	OriginalStartLine *int    `json:"original_start_line,omitempty"`
+	// Possible values for DiffSide and StartDiffSide are: LEFT, RIGHT.
+	DiffSide      *string `json:"diff_side,omitempty"`
+	StartDiffSide *string `json:"start_diff_side,omitempty"`
+	ResolvedBy    *User   `json:"resolved_by,omitempty"`
+	// Comments holds the first 100 comments of the thread. Only the ID,
+	// NodeID, Body, Path, DiffHunk, User, CreatedAt, UpdatedAt and HTMLURL
+	// fields of the comments are populated.
+	Comments []*PullRequestComment `json:"comments,omitempty"`
+}
+
+// ListReviewThreadsOptions specifies the optional parameters to the
+// PullRequestsService.ListReviewThreads method.
+type ListReviewThreadsOptions struct {
+	// After is a cursor for paginating through the results.
+	// Set it from Response.After.
+	After string
+
+	// The number of threads to include per page (max 100). Default: 100.
+	PerPage int
+}
+
+// reviewThreadFields are the GraphQL fields of a PullRequestReviewThread.
+const reviewThreadFields = `
+id
+isResolved
+isOutdated
+isCollapsed
+path
+line
+originalLine
+startLine
+originalStartLine
+diffSide
+startDiffSide
+resolvedBy { login }
+comments(first: 100) {
+  nodes { databaseId id body path diffHunk author { login } createdAt updatedAt url }
+}`
+
+// graphQLReviewThread is the GraphQL representation of a PullRequestReviewThread.
+type graphQLReviewThread struct {
+	ID                *string       `json:"id"`
+	IsResolved        *bool         `json:"isResolved"`
+	IsOutdated        *bool         `json:"isOutdated"`
+	IsCollapsed       *bool         `json:"isCollapsed"`
+	Path              *string       `json:"path"`
+	Line              *int          `json:"line"`
+	OriginalLine      *int          `json:"originalLine"`
+	StartLine         *int          `json:"startLine"`
+	OriginalStartLine *int          `json:"originalStartLine"`
+	DiffSide          *string       `json:"diffSide"`
+	StartDiffSide     *string       `json:"startDiffSide"`
+	ResolvedBy        *graphQLActor `json:"resolvedBy"`
+	Comments          struct {
+		Nodes []*graphQLReviewThreadComment `json:"nodes"`
+	} `json:"comments"`
+}
+
+// graphQLReviewThreadComment is the GraphQL representation of a PullRequestComment.
+type graphQLReviewThreadComment struct {
+	DatabaseID *int64        `json:"databaseId"`
+	ID         *string       `json:"id"`
+	Body       *string       `json:"body"`
+	Path       *string       `json:"path"`
+	DiffHunk   *string       `json:"diffHunk"`
+	Author     *graphQLActor `json:"author"`
+	CreatedAt  *time.Time    `json:"createdAt"`
+	UpdatedAt  *time.Time    `json:"updatedAt"`
+	URL        *string       `json:"url"`
+}
+
+// thread converts t to a PullRequestReviewThread.
+func (t *graphQLReviewThread) thread() *PullRequestReviewThread {
+	thread := &PullRequestReviewThread{
+		ID:                t.ID,
+		IsResolved:        t.IsResolved,
+		IsOutdated:        t.IsOutdated,
+		IsCollapsed:       t.IsCollapsed,
+		Path:              t.Path,
+		Line:              t.Line,
+		OriginalLine:      t.OriginalLine,
+		StartLine:         t.StartLine,
+		OriginalStartLine: t.OriginalStartLine,
+		DiffSide:          t.DiffSide,
+		StartDiffSide:     t.StartDiffSide,
+		ResolvedBy:        t.ResolvedBy.user(),
+	}
+	for _, c := range t.Comments.Nodes {
+		thread.Comments = append(thread.Comments, &PullRequestComment{
+			ID:        c.DatabaseID,
+			NodeID:    c.ID,
+			Body:      c.Body,
+			Path:      c.Path,
+			DiffHunk:  c.DiffHunk,
+			User:      c.Author.user(),
+			CreatedAt: c.CreatedAt,
+			UpdatedAt: c.UpdatedAt,
+			HTMLURL:   c.URL,
+		})
+	}
+	return thread
+}
+
+// ListReviewThreads lists the review threads of a pull request, along with
+// their resolution state. If there are more results, Response.After is set
+// to the cursor to pass as ListReviewThreadsOptions.After.
+//
+// GitHub API docs: https://docs.github.com/en/graphql/reference/objects#pullrequestreviewthread
+func (s *PullRequestsService) ListReviewThreads(ctx context.Context, owner, repo string, number int, opts *ListReviewThreadsOptions) ([]*PullRequestReviewThread, *Response, error) {
+	query := `query($owner: String!, $repo: String!, $number: Int!, $first: Int!, $after: String) {
+  repository(owner: $owner, name: $repo) {
+    pullRequest(number: $number) {
+      reviewThreads(first: $first, after: $after) {
+        pageInfo { hasNextPage endCursor }
+        nodes {` + reviewThreadFields + `
+        }
+      }
+    }
+  }
+}`
+	variables := map[string]interface{}{
+		"owner":  owner,
+		"repo":   repo,
+		"number": number,
+		"first":  100,
+	}
+	if opts != nil {
+		if opts.PerPage > 0 {
+			variables["first"] = opts.PerPage
+		}
+		if opts.After != "" {
+			variables["after"] = opts.After
+		}
+	}
+
+	var data struct {
+		Repository struct {
+			PullRequest struct {
+				ReviewThreads struct {
+					PageInfo graphQLPageInfo        `json:"pageInfo"`
+					Nodes    []*graphQLReviewThread `json:"nodes"`
+				} `json:"reviewThreads"`
+			} `json:"pullRequest"`
+		} `json:"repository"`
+	}
+	resp, err := s.client.graphQL(ctx, query, variables, &data)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	reviewThreads := data.Repository.PullRequest.ReviewThreads
+	if reviewThreads.PageInfo.HasNextPage {
+		resp.After = reviewThreads.PageInfo.EndCursor
+	}
+
+	threads := make([]*PullRequestReviewThread, 0, len(reviewThreads.Nodes))
+	for _, t := range reviewThreads.Nodes {
+		threads = append(threads, t.thread())
+	}
+
+	return threads, resp, nil
+}
+
+// ResolveReviewThread marks a review thread as resolved. threadID is the
+// GraphQL node ID of the thread, as returned by ListReviewThreads.
+//
+// GitHub API docs: https://docs.github.com/en/graphql/reference/mutations#resolvereviewthread
+func (s *PullRequestsService) ResolveReviewThread(ctx context.Context, threadID string) (*PullRequestReviewThread, *Response, error) {
+	return s.setReviewThreadResolved(ctx, "resolveReviewThread", threadID)
+}
+
+// UnresolveReviewThread marks a review thread as unresolved. threadID is the
+// GraphQL node ID of the thread, as returned by ListReviewThreads.
+//
+// GitHub API docs: https://docs.github.com/en/graphql/reference/mutations#unresolvereviewthread
+func (s *PullRequestsService) UnresolveReviewThread(ctx context.Context, threadID string) (*PullRequestReviewThread, *Response, error) {
+	return s.setReviewThreadResolved(ctx, "unresolveReviewThread", threadID)
+}
+
+// setReviewThreadResolved runs mutation, one of resolveReviewThread or
+// unresolveReviewThread, on the review thread with the given node ID.
+func (s *PullRequestsService) setReviewThreadResolved(ctx context.Context, mutation, threadID string) (*PullRequestReviewThread, *Response, error) {
+	query := `mutation($threadId: ID!) {
+  ` + mutation + `(input: {threadId: $threadId}) {
+    thread {` + reviewThreadFields + `
+    }
+  }
+}`
+	variables := map[string]interface{}{"threadId": threadID}
+
+	var data map[string]*struct {
+		Thread *graphQLReviewThread `json:"thread"`
+	}
+	resp, err := s.client.graphQL(ctx, query, variables, &data)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	payload := data[mutation]
+	if payload == nil || payload.Thread == nil {
+		return nil, resp, nil
+	}
+
+	return payload.Thread.thread(), resp, nil
+}
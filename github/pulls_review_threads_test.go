@@ -0,0 +1,249 @@
+// Copyright 2021 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+const reviewThreadJSON = `{
+	"id": "T1",
+	"isResolved": true,
+	"isOutdated": false,
+	"isCollapsed": true,
+	"path": "main.go",
+	"line": 10,
+	"originalLine": 9,
+	"startLine": null,
+	"originalStartLine": null,
+	"diffSide": "RIGHT",
+	"startDiffSide": null,
+	"resolvedBy": {"login": "r"},
+	"comments": {
+		"nodes": [{
+			"databaseId": 1,
+			"id": "C1",
+			"body": "b",
+			"path": "main.go",
+			"diffHunk": "@@ -1 +1 @@",
+			"author": {"login": "a"},
+			"createdAt": "2006-01-02T15:04:05Z",
+			"updatedAt": "2006-01-02T15:04:05Z",
+			"url": "https://github.com/o/r/pull/1#discussion_r1"
+		}]
+	}
+}`
+
+var wantReviewThread = &PullRequestReviewThread{
+	ID:           String("T1"),
+	IsResolved:   Bool(true),
+	IsOutdated:   Bool(false),
+	IsCollapsed:  Bool(true),
+	Path:         String("main.go"),
+	Line:         Int(10),
+	OriginalLine: Int(9),
+	DiffSide:     String("RIGHT"),
+	ResolvedBy:   &User{Login: String("r")},
+	Comments: []*PullRequestComment{{
+		ID:        Int64(1),
+		NodeID:    String("C1"),
+		Body:      String("b"),
+		Path:      String("main.go"),
+		DiffHunk:  String("@@ -1 +1 @@"),
+		User:      &User{Login: String("a")},
+		CreatedAt: &referenceReviewThreadTime,
+		UpdatedAt: &referenceReviewThreadTime,
+		HTMLURL:   String("https://github.com/o/r/pull/1#discussion_r1"),
+	}},
+}
+
+var referenceReviewThreadTime = time.Date(2006, time.January, 2, 15, 4, 5, 0, time.UTC)
+
+func TestPullRequestsService_ListReviewThreads(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/graphql", func(w http.ResponseWriter, r *http.Request) {
+		v := new(graphQLRequest)
+		json.NewDecoder(r.Body).Decode(v)
+
+		testMethod(t, r, "POST")
+		if !strings.Contains(v.Query, "reviewThreads(first: $first, after: $after)") {
+			t.Errorf("Request query = %q, want reviewThreads query", v.Query)
+		}
+		wantVars := map[string]interface{}{
+			"owner":  "o",
+			"repo":   "r",
+			"number": float64(1),
+			"first":  float64(2),
+			"after":  "c1",
+		}
+		if !reflect.DeepEqual(v.Variables, wantVars) {
+			t.Errorf("Request variables = %+v, want %+v", v.Variables, wantVars)
+		}
+
+		fmt.Fprint(w, `{"data":{"repository":{"pullRequest":{"reviewThreads":{
+			"pageInfo": {"hasNextPage": true, "endCursor": "c2"},
+			"nodes": [`+reviewThreadJSON+`]
+		}}}}}`)
+	})
+
+	opts := &ListReviewThreadsOptions{After: "c1", PerPage: 2}
+	ctx := context.Background()
+	threads, resp, err := client.PullRequests.ListReviewThreads(ctx, "o", "r", 1, opts)
+	if err != nil {
+		t.Errorf("PullRequests.ListReviewThreads returned error: %v", err)
+	}
+
+	want := []*PullRequestReviewThread{wantReviewThread}
+	if !reflect.DeepEqual(threads, want) {
+		t.Errorf("PullRequests.ListReviewThreads returned %+v, want %+v", threads, want)
+	}
+	if got, want := resp.After, "c2"; got != want {
+		t.Errorf("PullRequests.ListReviewThreads returned After %v, want %v", got, want)
+	}
+
+	const methodName = "ListReviewThreads"
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.PullRequests.ListReviewThreads(ctx, "o", "r", 1, opts)
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
+
+func TestPullRequestsService_ListReviewThreads_lastPage(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/graphql", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"data":{"repository":{"pullRequest":{"reviewThreads":{
+			"pageInfo": {"hasNextPage": false, "endCursor": "c2"},
+			"nodes": []
+		}}}}}`)
+	})
+
+	ctx := context.Background()
+	threads, resp, err := client.PullRequests.ListReviewThreads(ctx, "o", "r", 1, nil)
+	if err != nil {
+		t.Errorf("PullRequests.ListReviewThreads returned error: %v", err)
+	}
+	if len(threads) != 0 {
+		t.Errorf("PullRequests.ListReviewThreads returned %+v, want none", threads)
+	}
+	if resp.After != "" {
+		t.Errorf("PullRequests.ListReviewThreads returned After %q, want empty", resp.After)
+	}
+}
+
+func TestPullRequestsService_ResolveReviewThread(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/graphql", func(w http.ResponseWriter, r *http.Request) {
+		v := new(graphQLRequest)
+		json.NewDecoder(r.Body).Decode(v)
+
+		testMethod(t, r, "POST")
+		if !strings.Contains(v.Query, "resolveReviewThread(input: {threadId: $threadId})") {
+			t.Errorf("Request query = %q, want resolveReviewThread mutation", v.Query)
+		}
+		if want := map[string]interface{}{"threadId": "T1"}; !reflect.DeepEqual(v.Variables, want) {
+			t.Errorf("Request variables = %+v, want %+v", v.Variables, want)
+		}
+
+		fmt.Fprint(w, `{"data":{"resolveReviewThread":{"thread":`+reviewThreadJSON+`}}}`)
+	})
+
+	ctx := context.Background()
+	thread, _, err := client.PullRequests.ResolveReviewThread(ctx, "T1")
+	if err != nil {
+		t.Errorf("PullRequests.ResolveReviewThread returned error: %v", err)
+	}
+	if !reflect.DeepEqual(thread, wantReviewThread) {
+		t.Errorf("PullRequests.ResolveReviewThread returned %+v, want %+v", thread, wantReviewThread)
+	}
+
+	const methodName = "ResolveReviewThread"
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.PullRequests.ResolveReviewThread(ctx, "T1")
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
+
+func TestPullRequestsService_UnresolveReviewThread(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/graphql", func(w http.ResponseWriter, r *http.Request) {
+		v := new(graphQLRequest)
+		json.NewDecoder(r.Body).Decode(v)
+
+		testMethod(t, r, "POST")
+		if !strings.Contains(v.Query, "unresolveReviewThread(input: {threadId: $threadId})") {
+			t.Errorf("Request query = %q, want unresolveReviewThread mutation", v.Query)
+		}
+
+		fmt.Fprint(w, `{"data":{"unresolveReviewThread":{"thread":{"id":"T1","isResolved":false}}}}`)
+	})
+
+	ctx := context.Background()
+	thread, _, err := client.PullRequests.UnresolveReviewThread(ctx, "T1")
+	if err != nil {
+		t.Errorf("PullRequests.UnresolveReviewThread returned error: %v", err)
+	}
+
+	want := &PullRequestReviewThread{ID: String("T1"), IsResolved: Bool(false)}
+	if !reflect.DeepEqual(thread, want) {
+		t.Errorf("PullRequests.UnresolveReviewThread returned %+v, want %+v", thread, want)
+	}
+
+	const methodName = "UnresolveReviewThread"
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.PullRequests.UnresolveReviewThread(ctx, "T1")
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
+
+func TestPullRequestReviewThread_Marshal(t *testing.T) {
+	testJSONMarshal(t, &PullRequestReviewThread{}, "{}")
+
+	u := &PullRequestReviewThread{
+		ID:         String("T1"),
+		IsResolved: Bool(true),
+		Path:       String("p"),
+		Line:       Int(1),
+		DiffSide:   String("LEFT"),
+		ResolvedBy: &User{Login: String("l")},
+		Comments:   []*PullRequestComment{{ID: Int64(1)}},
+	}
+
+	want := `{
+		"id": "T1",
+		"is_resolved": true,
+		"path": "p",
+		"line": 1,
+		"diff_side": "LEFT",
+		"resolved_by": {"login": "l"},
+		"comments": [{"id": 1}]
+	}`
+
+	testJSONMarshal(t, u, want)
+}
@@ -8,6 +8,7 @@ package github
 import (
 	"context"
 	"fmt"
+	"strings"
 )
 
 // ReviewersRequest specifies users and teams for a pull request review request.
@@ -78,3 +79,112 @@ func (s *PullRequestsService) RemoveReviewers(ctx context.Context, owner, repo s
 
 	return s.client.Do(ctx, req, nil)
 }
+
+// teamSlug returns the slug of team, which may be given as "org/slug".
+func teamSlug(team string) string {
+	if i := strings.LastIndex(team, "/"); i >= 0 {
+		return team[i+1:]
+	}
+	return team
+}
+
+// RequestTeamReviewers requests reviews from the provided teams only.
+// Teams may be given by slug, e.g. "justice-league", or qualified by their
+// organization, e.g. "octo-org/justice-league".
+//
+// GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/pulls/#request-reviewers-for-a-pull-request
+func (s *PullRequestsService) RequestTeamReviewers(ctx context.Context, owner, repo string, number int, teams []string) (*PullRequest, *Response, error) {
+	slugs := make([]string, 0, len(teams))
+	for _, team := range teams {
+		slugs = append(slugs, teamSlug(team))
+	}
+	return s.RequestReviewers(ctx, owner, repo, number, ReviewersRequest{TeamReviewers: slugs})
+}
+
+// ReviewersChange reports the outcome of PullRequestsService.EnsureReviewers.
+type ReviewersChange struct {
+	// Reviewers and TeamReviewers are the user logins and team slugs
+	// whose review was requested.
+	Reviewers     []string
+	TeamReviewers []string
+
+	// AlreadyRequestedReviewers and AlreadyRequestedTeamReviewers are the
+	// user logins and team slugs whose review had already been requested.
+	AlreadyRequestedReviewers     []string
+	AlreadyRequestedTeamReviewers []string
+
+	// PullRequest is the pull request returned when requesting reviews,
+	// or nil if all the reviews had already been requested.
+	PullRequest *PullRequest
+}
+
+// EnsureReviewers requests reviews from the provided users and teams,
+// skipping those whose review is already requested, so that it can safely
+// be called repeatedly. Teams may be given by slug or as "org/slug".
+// Logins and slugs are compared case-insensitively.
+//
+// Note that GitHub removes a reviewer from the requested reviewers once
+// they submit a review, so calling EnsureReviewers again re-requests it.
+//
+// GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/pulls/#request-reviewers-for-a-pull-request
+func (s *PullRequestsService) EnsureReviewers(ctx context.Context, owner, repo string, number int, reviewers ReviewersRequest) (*ReviewersChange, *Response, error) {
+	requestedUsers := make(map[string]bool)
+	requestedTeams := make(map[string]bool)
+	opts := &ListOptions{PerPage: 100}
+	var resp *Response
+	for {
+		current, listResp, err := s.ListReviewers(ctx, owner, repo, number, opts)
+		resp = listResp
+		if err != nil {
+			return nil, resp, err
+		}
+		for _, u := range current.Users {
+			requestedUsers[strings.ToLower(u.GetLogin())] = true
+		}
+		for _, t := range current.Teams {
+			requestedTeams[strings.ToLower(t.GetSlug())] = true
+		}
+		if resp.NextPage == 0 {
+			break
+		}
+		opts.Page = resp.NextPage
+	}
+
+	change := new(ReviewersChange)
+	for _, login := range reviewers.Reviewers {
+		key := strings.ToLower(login)
+		if requestedUsers[key] {
+			change.AlreadyRequestedReviewers = append(change.AlreadyRequestedReviewers, login)
+			continue
+		}
+		requestedUsers[key] = true
+		change.Reviewers = append(change.Reviewers, login)
+	}
+	for _, team := range reviewers.TeamReviewers {
+		slug := teamSlug(team)
+		key := strings.ToLower(slug)
+		if requestedTeams[key] {
+			change.AlreadyRequestedTeamReviewers = append(change.AlreadyRequestedTeamReviewers, slug)
+			continue
+		}
+		requestedTeams[key] = true
+		change.TeamReviewers = append(change.TeamReviewers, slug)
+	}
+
+	// Nothing to request; resp is that of listing the requested reviewers.
+	if len(change.Reviewers) == 0 && len(change.TeamReviewers) == 0 {
+		return change, resp, nil
+	}
+
+	pull, resp, err := s.RequestReviewers(ctx, owner, repo, number, ReviewersRequest{
+		NodeID:        reviewers.NodeID,
+		Reviewers:     change.Reviewers,
+		TeamReviewers: change.TeamReviewers,
+	})
+	if err != nil {
+		return nil, resp, err
+	}
+	change.PullRequest = pull
+
+	return change, resp, nil
+}
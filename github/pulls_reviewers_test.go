@@ -140,3 +140,116 @@ func TestListReviewers_withOptions(t *testing.T) {
 		return resp, err
 	})
 }
+
+func TestRequestTeamReviewers(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/pulls/1/requested_reviewers", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testBody(t, r, `{"team_reviewers":["justice-league","injustice-league"]}`+"\n")
+		fmt.Fprint(w, `{"number":1}`)
+	})
+
+	ctx := context.Background()
+	got, _, err := client.PullRequests.RequestTeamReviewers(ctx, "o", "r", 1, []string{"justice-league", "o/injustice-league"})
+	if err != nil {
+		t.Errorf("PullRequests.RequestTeamReviewers returned error: %v", err)
+	}
+	want := &PullRequest{Number: Int(1)}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("PullRequests.RequestTeamReviewers returned %+v, want %+v", got, want)
+	}
+
+	const methodName = "RequestTeamReviewers"
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.PullRequests.RequestTeamReviewers(ctx, "o", "r", 1, []string{"justice-league"})
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
+
+func TestEnsureReviewers(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/pulls/1/requested_reviewers", func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case "GET":
+			switch r.FormValue("page") {
+			case "":
+				testFormValues(t, r, values{"per_page": "100"})
+				w.Header().Set("Link", `<https://api.github.com/repos/o/r/pulls/1/requested_reviewers?page=2&per_page=100>; rel="next"`)
+				fmt.Fprint(w, `{"users":[{"login":"OctoCat"}],"teams":[]}`)
+			default:
+				testFormValues(t, r, values{"page": "2", "per_page": "100"})
+				fmt.Fprint(w, `{"users":[],"teams":[{"slug":"justice-league"}]}`)
+			}
+		case "POST":
+			testBody(t, r, `{"reviewers":["googlebot"],"team_reviewers":["injustice-league"]}`+"\n")
+			fmt.Fprint(w, `{"number":1}`)
+		default:
+			t.Errorf("Unexpected request method %v", r.Method)
+		}
+	})
+
+	ctx := context.Background()
+	reviewers := ReviewersRequest{
+		Reviewers:     []string{"octocat", "googlebot", "googlebot"},
+		TeamReviewers: []string{"o/justice-league", "injustice-league"},
+	}
+	got, _, err := client.PullRequests.EnsureReviewers(ctx, "o", "r", 1, reviewers)
+	if err != nil {
+		t.Errorf("PullRequests.EnsureReviewers returned error: %v", err)
+	}
+
+	want := &ReviewersChange{
+		Reviewers:                     []string{"googlebot"},
+		TeamReviewers:                 []string{"injustice-league"},
+		AlreadyRequestedReviewers:     []string{"octocat", "googlebot"},
+		AlreadyRequestedTeamReviewers: []string{"justice-league"},
+		PullRequest:                   &PullRequest{Number: Int(1)},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("PullRequests.EnsureReviewers returned %+v, want %+v", got, want)
+	}
+
+	const methodName = "EnsureReviewers"
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.PullRequests.EnsureReviewers(ctx, "o", "r", 1, reviewers)
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
+
+func TestEnsureReviewers_alreadyRequested(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/pulls/1/requested_reviewers", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"users":[{"login":"octocat"}],"teams":[{"slug":"justice-league"}]}`)
+	})
+
+	ctx := context.Background()
+	reviewers := ReviewersRequest{Reviewers: []string{"octocat"}, TeamReviewers: []string{"justice-league"}}
+	got, resp, err := client.PullRequests.EnsureReviewers(ctx, "o", "r", 1, reviewers)
+	if err != nil {
+		t.Errorf("PullRequests.EnsureReviewers returned error: %v", err)
+	}
+	if resp == nil {
+		t.Errorf("PullRequests.EnsureReviewers returned nil response")
+	}
+
+	want := &ReviewersChange{
+		AlreadyRequestedReviewers:     []string{"octocat"},
+		AlreadyRequestedTeamReviewers: []string{"justice-league"},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("PullRequests.EnsureReviewers returned %+v, want %+v", got, want)
+	}
+}
@@ -401,6 +401,30 @@ func TestPullRequestsService_Create(t *testing.T) {
 	})
 }
 
+func TestPullRequestsService_Create_draft(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	input := &NewPullRequest{Title: String("t"), Head: String("h"), Base: String("b"), Draft: Bool(true)}
+
+	mux.HandleFunc("/repos/o/r/pulls", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testBody(t, r, `{"title":"t","head":"h","base":"b","draft":true}`+"\n")
+		fmt.Fprint(w, `{"number":1,"draft":true}`)
+	})
+
+	ctx := context.Background()
+	pull, _, err := client.PullRequests.Create(ctx, "o", "r", input)
+	if err != nil {
+		t.Errorf("PullRequests.Create returned error: %v", err)
+	}
+
+	want := &PullRequest{Number: Int(1), Draft: Bool(true)}
+	if !reflect.DeepEqual(pull, want) {
+		t.Errorf("PullRequests.Create returned %+v, want %+v", pull, want)
+	}
+}
+
 func TestPullRequestsService_Create_invalidOwner(t *testing.T) {
 	client, _, _, teardown := setup()
 	defer teardown()
@@ -805,3 +829,57 @@ func TestPullRequestsService_Merge_options(t *testing.T) {
 		}
 	}
 }
+
+func TestPullRequestsService_Merge_headMoved(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/pulls/1/merge", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PUT")
+		testBody(t, r, `{"commit_message":"m","merge_method":"squash","sha":"s"}`+"\n")
+		w.WriteHeader(http.StatusConflict)
+		fmt.Fprint(w, `{"message":"Head branch was modified. Review and try the merge again."}`)
+	})
+
+	ctx := context.Background()
+	opts := &PullRequestOptions{SHA: "s", MergeMethod: MergeMethodSquash}
+	_, resp, err := client.PullRequests.Merge(ctx, "o", "r", 1, "m", opts)
+	if _, ok := err.(*MergeHeadMovedError); !ok {
+		t.Errorf("PullRequests.Merge returned error %#v, want *MergeHeadMovedError", err)
+	}
+	if resp == nil || resp.StatusCode != http.StatusConflict {
+		t.Errorf("PullRequests.Merge returned response %#v, want status %v", resp, http.StatusConflict)
+	}
+}
+
+func TestPullRequestsService_Merge_mergeQueueRequired(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/pulls/1/merge", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusMethodNotAllowed)
+		fmt.Fprint(w, `{"message":"Repository rule violations found\n\nChanges must be made through the merge queue\n\n"}`)
+	})
+
+	ctx := context.Background()
+	_, _, err := client.PullRequests.Merge(ctx, "o", "r", 1, "m", nil)
+	if _, ok := err.(*MergeQueueRequiredError); !ok {
+		t.Errorf("PullRequests.Merge returned error %#v, want *MergeQueueRequiredError", err)
+	}
+}
+
+func TestPullRequestsService_Merge_notMergeable(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/pulls/1/merge", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusMethodNotAllowed)
+		fmt.Fprint(w, `{"message":"Pull Request is not mergeable"}`)
+	})
+
+	ctx := context.Background()
+	_, _, err := client.PullRequests.Merge(ctx, "o", "r", 1, "m", nil)
+	if _, ok := err.(*ErrorResponse); !ok {
+		t.Errorf("PullRequests.Merge returned error %#v, want *ErrorResponse", err)
+	}
+}
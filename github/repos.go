@@ -635,6 +635,114 @@ func (s *RepositoriesService) DisableAutomatedSecurityFixes(ctx context.Context,
 	return s.client.Do(ctx, req, nil)
 }
 
+// RepositoryImmutableReleases represents the immutable releases settings of a repository.
+// When enabled, the tags and assets of published releases cannot be modified or deleted.
+type RepositoryImmutableReleases struct {
+	Enabled *bool `json:"enabled,omitempty"`
+	// EnforcedByOwner reports whether the setting is enforced by the
+	// repository owner's organization or enterprise policy.
+	EnforcedByOwner *bool `json:"enforced_by_owner,omitempty"`
+}
+
+// GetImmutableReleases gets the immutable releases settings of a repository.
+//
+// GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/repos/#check-if-immutable-releases-are-enabled-for-a-repository
+func (s *RepositoriesService) GetImmutableReleases(ctx context.Context, owner, repository string) (*RepositoryImmutableReleases, *Response, error) {
+	u := fmt.Sprintf("repos/%v/%v/immutable-releases", owner, repository)
+
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	immutableReleases := new(RepositoryImmutableReleases)
+	resp, err := s.client.Do(ctx, req, immutableReleases)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return immutableReleases, resp, nil
+}
+
+// EnableImmutableReleases enables immutable releases for a repository.
+//
+// GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/repos/#enable-immutable-releases
+func (s *RepositoriesService) EnableImmutableReleases(ctx context.Context, owner, repository string) (*Response, error) {
+	u := fmt.Sprintf("repos/%v/%v/immutable-releases", owner, repository)
+
+	req, err := s.client.NewRequest("PUT", u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(ctx, req, nil)
+}
+
+// DisableImmutableReleases disables immutable releases for a repository.
+//
+// GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/repos/#disable-immutable-releases
+func (s *RepositoriesService) DisableImmutableReleases(ctx context.Context, owner, repository string) (*Response, error) {
+	u := fmt.Sprintf("repos/%v/%v/immutable-releases", owner, repository)
+
+	req, err := s.client.NewRequest("DELETE", u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(ctx, req, nil)
+}
+
+// IsPrivateReportingEnabled checks if private vulnerability reporting is
+// enabled for a repository.
+//
+// GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/repos/#check-if-private-vulnerability-reporting-is-enabled-for-a-repository
+func (s *RepositoriesService) IsPrivateReportingEnabled(ctx context.Context, owner, repository string) (bool, *Response, error) {
+	u := fmt.Sprintf("repos/%v/%v/private-vulnerability-reporting", owner, repository)
+
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return false, nil, err
+	}
+
+	var privateReporting struct {
+		Enabled bool `json:"enabled"`
+	}
+	resp, err := s.client.Do(ctx, req, &privateReporting)
+	if err != nil {
+		return false, resp, err
+	}
+
+	return privateReporting.Enabled, resp, nil
+}
+
+// EnablePrivateReporting enables private vulnerability reporting for a repository.
+//
+// GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/repos/#enable-private-vulnerability-reporting-for-a-repository
+func (s *RepositoriesService) EnablePrivateReporting(ctx context.Context, owner, repository string) (*Response, error) {
+	u := fmt.Sprintf("repos/%v/%v/private-vulnerability-reporting", owner, repository)
+
+	req, err := s.client.NewRequest("PUT", u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(ctx, req, nil)
+}
+
+// DisablePrivateReporting disables private vulnerability reporting for a repository.
+//
+// GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/repos/#disable-private-vulnerability-reporting-for-a-repository
+func (s *RepositoriesService) DisablePrivateReporting(ctx context.Context, owner, repository string) (*Response, error) {
+	u := fmt.Sprintf("repos/%v/%v/private-vulnerability-reporting", owner, repository)
+
+	req, err := s.client.NewRequest("DELETE", u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(ctx, req, nil)
+}
+
 // ListContributors lists contributors for a repository.
 //
 // GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/repos/#list-repository-contributors
@@ -0,0 +1,94 @@
+// Copyright 2021 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"fmt"
+)
+
+// Activity types that can be used to filter
+// RepositoriesService.ListActivities results.
+const (
+	RepositoryActivityPush            = "push"
+	RepositoryActivityForcePush       = "force_push"
+	RepositoryActivityBranchCreation  = "branch_creation"
+	RepositoryActivityBranchDeletion  = "branch_deletion"
+	RepositoryActivityPRMerge         = "pr_merge"
+	RepositoryActivityMergeQueueMerge = "merge_queue_merge"
+)
+
+// RepositoryActivity represents an activity, such as a push or a pull request
+// merge, that changed a ref of a repository.
+type RepositoryActivity struct {
+	ID     *int64  `json:"id,omitempty"`
+	NodeID *string `json:"node_id,omitempty"`
+	// Before is the SHA of the commit the ref pointed to before the activity.
+	Before *string `json:"before,omitempty"`
+	// After is the SHA of the commit the ref points to after the activity.
+	After        *string    `json:"after,omitempty"`
+	Ref          *string    `json:"ref,omitempty"`
+	Timestamp    *Timestamp `json:"timestamp,omitempty"`
+	ActivityType *string    `json:"activity_type,omitempty"`
+	Actor        *User      `json:"actor,omitempty"`
+}
+
+// ListRepositoryActivityOptions specifies the optional parameters to the
+// RepositoriesService.ListActivities method.
+type ListRepositoryActivityOptions struct {
+	// Direction in which to sort the results. Can be one of: asc, desc.
+	// Default: desc.
+	Direction string `url:"direction,omitempty"`
+
+	// Ref filters the activities to a fully qualified ref, e.g. "refs/heads/main".
+	// If the ref is not fully qualified, it is assumed to be a branch.
+	Ref string `url:"ref,omitempty"`
+
+	// Actor filters the activities to those performed by the given user login.
+	Actor string `url:"actor,omitempty"`
+
+	// TimePeriod filters the activities by time period.
+	// Can be one of: day, week, month, quarter, year.
+	TimePeriod string `url:"time_period,omitempty"`
+
+	// ActivityType filters the activities by type.
+	// Can be one of the RepositoryActivity constants, e.g. RepositoryActivityForcePush.
+	ActivityType string `url:"activity_type,omitempty"`
+
+	// Before and After are cursors for paginating through the results.
+	// Set them from Response.Before and Response.After respectively.
+	Before string `url:"before,omitempty"`
+	After  string `url:"after,omitempty"`
+
+	// For paginated result sets, the number of results to include per page.
+	PerPage int `url:"per_page,omitempty"`
+}
+
+// ListActivities lists a detailed history of changes to a repository, such as
+// pushes, merges, force pushes, and branch changes, and associates these
+// changes with commits and users.
+//
+// GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/repos/#list-repository-activities
+func (s *RepositoriesService) ListActivities(ctx context.Context, owner, repo string, opts *ListRepositoryActivityOptions) ([]*RepositoryActivity, *Response, error) {
+	u := fmt.Sprintf("repos/%v/%v/activity", owner, repo)
+	u, err := addOptions(u, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var activities []*RepositoryActivity
+	resp, err := s.client.Do(ctx, req, &activities)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return activities, resp, nil
+}
@@ -0,0 +1,125 @@
+// Copyright 2021 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestRepositoriesService_ListActivities(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/activity", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testFormValues(t, r, values{
+			"ref":           "refs/heads/main",
+			"actor":         "octocat",
+			"time_period":   "week",
+			"activity_type": "force_push",
+			"after":         "c1",
+			"per_page":      "2",
+		})
+		w.Header().Set("Link", `<https://api.github.com/repos/o/r/activity?after=c2>; rel="next"`)
+		fmt.Fprint(w, `[
+		  {
+			"id": 1296269,
+			"node_id": "MDEwOlJlcG9zaXRvcnkxMjk2MjY5",
+			"before": "6dcb09b5b57875f334f61aebed695e2e4193db5e",
+			"after": "827efc6d56897b048c772eb4087f854f46256132",
+			"ref": "refs/heads/main",
+			"timestamp": "2011-01-26T19:06:43Z",
+			"activity_type": "force_push",
+			"actor": {
+			  "login": "octocat",
+			  "id": 1
+			}
+		  }
+		]`)
+	})
+
+	opts := &ListRepositoryActivityOptions{
+		Ref:          "refs/heads/main",
+		Actor:        "octocat",
+		TimePeriod:   "week",
+		ActivityType: RepositoryActivityForcePush,
+		After:        "c1",
+		PerPage:      2,
+	}
+	ctx := context.Background()
+	activities, resp, err := client.Repositories.ListActivities(ctx, "o", "r", opts)
+	if err != nil {
+		t.Errorf("Repositories.ListActivities returned error: %v", err)
+	}
+
+	want := []*RepositoryActivity{
+		{
+			ID:           Int64(1296269),
+			NodeID:       String("MDEwOlJlcG9zaXRvcnkxMjk2MjY5"),
+			Before:       String("6dcb09b5b57875f334f61aebed695e2e4193db5e"),
+			After:        String("827efc6d56897b048c772eb4087f854f46256132"),
+			Ref:          String("refs/heads/main"),
+			Timestamp:    &Timestamp{time.Date(2011, time.January, 26, 19, 6, 43, 0, time.UTC)},
+			ActivityType: String("force_push"),
+			Actor:        &User{Login: String("octocat"), ID: Int64(1)},
+		},
+	}
+	if !reflect.DeepEqual(activities, want) {
+		t.Errorf("Repositories.ListActivities returned %+v, want %+v", activities, want)
+	}
+	if got, want := resp.After, "c2"; got != want {
+		t.Errorf("Repositories.ListActivities returned After %v, want %v", got, want)
+	}
+
+	const methodName = "ListActivities"
+	testBadOptions(t, methodName, func() (err error) {
+		_, _, err = client.Repositories.ListActivities(ctx, "\n", "\n", opts)
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.Repositories.ListActivities(ctx, "o", "r", opts)
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
+
+func TestRepositoryActivity_Marshal(t *testing.T) {
+	testJSONMarshal(t, &RepositoryActivity{}, "{}")
+
+	r := &RepositoryActivity{
+		ID:           Int64(1),
+		NodeID:       String("n"),
+		Before:       String("b"),
+		After:        String("a"),
+		Ref:          String("refs/heads/main"),
+		Timestamp:    &Timestamp{referenceTime},
+		ActivityType: String("push"),
+		Actor:        &User{Login: String("l")},
+	}
+
+	want := `{
+		"id": 1,
+		"node_id": "n",
+		"before": "b",
+		"after": "a",
+		"ref": "refs/heads/main",
+		"timestamp": ` + referenceTimeStr + `,
+		"activity_type": "push",
+		"actor": {
+			"login": "l"
+		}
+	}`
+
+	testJSONMarshal(t, r, want)
+}
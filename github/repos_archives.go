@@ -0,0 +1,167 @@
+// Copyright 2021 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"archive/tar"
+	"archive/zip"
+	"compress/gzip"
+	"context"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+)
+
+// ArchiveEntry describes a file of a repository archive, as passed to
+// DownloadArchiveOptions.FileHandler.
+type ArchiveEntry struct {
+	// Path is the path of the file in the archive, which starts with the
+	// top-level directory GitHub adds, such as "octocat-hello-world-7fd1a60/".
+	Path string
+	Mode os.FileMode
+	Size int64
+}
+
+// DownloadArchiveOptions specifies the optional parameters to the
+// RepositoriesService.DownloadArchive method.
+type DownloadArchiveOptions struct {
+	// FileHandler, if set, is called with each regular file of the archive
+	// and a reader of its content, which is only valid until it returns. If
+	// it returns an error, the download stops and this error is returned.
+	//
+	// Tarballs are extracted as they are downloaded. Zipballs can only be
+	// read once complete, so they are first downloaded to a temporary file.
+	FileHandler func(entry *ArchiveEntry, r io.Reader) error
+}
+
+// DownloadArchive downloads a tarball or zipball archive of a repository at
+// ref, or at the default branch if ref is empty, and writes it to w as it is
+// received. w can be nil if only opts.FileHandler is needed. The redirect to
+// the archive is followed with the HTTP client of the Client, so that
+// authentication is kept for private repositories. It returns the size of
+// the archive.
+//
+// GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/repos/contents/#download-a-repository-archive
+func (s *RepositoriesService) DownloadArchive(ctx context.Context, owner, repo string, format ArchiveFormat, ref string, w io.Writer, opts *DownloadArchiveOptions) (int64, *Response, error) {
+	u := fmt.Sprintf("repos/%s/%s/%s", owner, repo, format)
+	if ref != "" {
+		u += "/" + refURLEscape(ref)
+	}
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return 0, nil, err
+	}
+
+	resp, err := s.client.BareDo(ctx, req)
+	if err != nil {
+		return 0, resp, err
+	}
+	defer resp.Body.Close()
+
+	if w == nil {
+		w = ioutil.Discard
+	}
+	counter := &countingWriter{w: w}
+	if opts == nil || opts.FileHandler == nil {
+		_, err = io.Copy(counter, resp.Body)
+		return counter.n, resp, err
+	}
+
+	switch format {
+	case Tarball:
+		err = extractTarball(io.TeeReader(resp.Body, counter), opts.FileHandler)
+	case Zipball:
+		err = extractZipball(io.TeeReader(resp.Body, counter), opts.FileHandler)
+	default:
+		err = fmt.Errorf("cannot extract archives of format %q", format)
+	}
+	return counter.n, resp, err
+}
+
+// countingWriter writes to w and counts the bytes written.
+type countingWriter struct {
+	w io.Writer
+	n int64
+}
+
+func (c *countingWriter) Write(p []byte) (int, error) {
+	n, err := c.w.Write(p)
+	c.n += int64(n)
+	return n, err
+}
+
+// extractTarball calls handler with each regular file of the gzipped tar
+// archive read from r, then reads r to its end.
+func extractTarball(r io.Reader, handler func(*ArchiveEntry, io.Reader) error) error {
+	gz, err := gzip.NewReader(r)
+	if err != nil {
+		return err
+	}
+	tr := tar.NewReader(gz)
+	for {
+		hdr, err := tr.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return err
+		}
+		mode := hdr.FileInfo().Mode()
+		if !mode.IsRegular() {
+			continue
+		}
+		entry := &ArchiveEntry{Path: hdr.Name, Mode: mode, Size: hdr.Size}
+		if err := handler(entry, tr); err != nil {
+			return err
+		}
+	}
+
+	// Read the end of the archive, so that it is fully written.
+	_, err = io.Copy(ioutil.Discard, r)
+	return err
+}
+
+// extractZipball calls handler with each regular file of the zip archive
+// read from r, which is first written to a temporary file.
+func extractZipball(r io.Reader, handler func(*ArchiveEntry, io.Reader) error) error {
+	tmp, err := ioutil.TempFile("", "go-github-archive-*.zip")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+	defer tmp.Close()
+
+	size, err := io.Copy(tmp, r)
+	if err != nil {
+		return err
+	}
+	zr, err := zip.NewReader(tmp, size)
+	if err != nil {
+		return err
+	}
+	for _, f := range zr.File {
+		if !f.Mode().IsRegular() {
+			continue
+		}
+		if err := extractZipFile(f, handler); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// extractZipFile calls handler with a file of a zip archive.
+func extractZipFile(f *zip.File, handler func(*ArchiveEntry, io.Reader) error) error {
+	rc, err := f.Open()
+	if err != nil {
+		return err
+	}
+	defer rc.Close()
+
+	entry := &ArchiveEntry{Path: f.Name, Mode: f.Mode(), Size: int64(f.UncompressedSize64)}
+	return handler(entry, rc)
+}
@@ -0,0 +1,134 @@
+// Copyright 2021 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"archive/tar"
+	"archive/zip"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"errors"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+// testArchiveFiles are the files of the test archives.
+var testArchiveFiles = map[string]string{
+	"o-r-s/README.md": "hello",
+	"o-r-s/a/b.txt":   "world",
+}
+
+func newTestTarball(t *testing.T) []byte {
+	t.Helper()
+	var buf bytes.Buffer
+	gz := gzip.NewWriter(&buf)
+	tw := tar.NewWriter(gz)
+	tw.WriteHeader(&tar.Header{Name: "o-r-s/", Typeflag: tar.TypeDir, Mode: 0755})
+	for _, name := range []string{"o-r-s/README.md", "o-r-s/a/b.txt"} {
+		content := testArchiveFiles[name]
+		if err := tw.WriteHeader(&tar.Header{Name: name, Typeflag: tar.TypeReg, Mode: 0644, Size: int64(len(content))}); err != nil {
+			t.Fatal(err)
+		}
+		tw.Write([]byte(content))
+	}
+	tw.Close()
+	gz.Close()
+	return buf.Bytes()
+}
+
+func newTestZipball(t *testing.T) []byte {
+	t.Helper()
+	var buf bytes.Buffer
+	zw := zip.NewWriter(&buf)
+	zw.Create("o-r-s/")
+	for _, name := range []string{"o-r-s/README.md", "o-r-s/a/b.txt"} {
+		f, err := zw.Create(name)
+		if err != nil {
+			t.Fatal(err)
+		}
+		f.Write([]byte(testArchiveFiles[name]))
+	}
+	zw.Close()
+	return buf.Bytes()
+}
+
+func TestRepositoriesService_DownloadArchive(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	archives := map[ArchiveFormat][]byte{Tarball: newTestTarball(t), Zipball: newTestZipball(t)}
+	mux.HandleFunc("/repos/o/r/tarball/feature/x", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		http.Redirect(w, r, baseURLPath+"/codeload/tarball", http.StatusFound)
+	})
+	mux.HandleFunc("/repos/o/r/zipball", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		http.Redirect(w, r, baseURLPath+"/codeload/zipball", http.StatusFound)
+	})
+	mux.HandleFunc("/codeload/tarball", func(w http.ResponseWriter, r *http.Request) {
+		w.Write(archives[Tarball])
+	})
+	mux.HandleFunc("/codeload/zipball", func(w http.ResponseWriter, r *http.Request) {
+		w.Write(archives[Zipball])
+	})
+
+	ctx := context.Background()
+	for _, tt := range []struct {
+		format ArchiveFormat
+		ref    string
+	}{{Tarball, "feature/x"}, {Zipball, ""}} {
+		var buf bytes.Buffer
+		n, _, err := client.Repositories.DownloadArchive(ctx, "o", "r", tt.format, tt.ref, &buf, nil)
+		if err != nil {
+			t.Errorf("Repositories.DownloadArchive(%v) returned error: %v", tt.format, err)
+		}
+		if want := archives[tt.format]; !bytes.Equal(buf.Bytes(), want) || n != int64(len(want)) {
+			t.Errorf("Repositories.DownloadArchive(%v) wrote %v bytes, want %v", tt.format, n, len(want))
+		}
+
+		buf.Reset()
+		files := make(map[string]string)
+		opts := &DownloadArchiveOptions{FileHandler: func(entry *ArchiveEntry, r io.Reader) error {
+			b, err := ioutil.ReadAll(r)
+			if int64(len(b)) != entry.Size || !entry.Mode.IsRegular() {
+				t.Errorf("FileHandler called with entry %+v for %v bytes", entry, len(b))
+			}
+			files[entry.Path] = string(b)
+			return err
+		}}
+		n, _, err = client.Repositories.DownloadArchive(ctx, "o", "r", tt.format, tt.ref, &buf, opts)
+		if err != nil {
+			t.Errorf("Repositories.DownloadArchive(%v) with FileHandler returned error: %v", tt.format, err)
+		}
+		if !reflect.DeepEqual(files, testArchiveFiles) {
+			t.Errorf("Repositories.DownloadArchive(%v) extracted %v, want %v", tt.format, files, testArchiveFiles)
+		}
+		if want := archives[tt.format]; !bytes.Equal(buf.Bytes(), want) || n != int64(len(want)) {
+			t.Errorf("Repositories.DownloadArchive(%v) with FileHandler wrote %v bytes, want %v", tt.format, n, len(want))
+		}
+
+		errStop := errors.New("stop")
+		opts.FileHandler = func(*ArchiveEntry, io.Reader) error { return errStop }
+		if _, _, err := client.Repositories.DownloadArchive(ctx, "o", "r", tt.format, tt.ref, nil, opts); err != errStop {
+			t.Errorf("Repositories.DownloadArchive(%v) returned error %v, want %v", tt.format, err, errStop)
+		}
+	}
+
+	const methodName = "DownloadArchive"
+	testBadOptions(t, methodName, func() (err error) {
+		_, _, err = client.Repositories.DownloadArchive(ctx, "\n", "\n", Tarball, "", nil, nil)
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		_, resp, err := client.Repositories.DownloadArchive(ctx, "o", "r", Zipball, "", nil, nil)
+		return resp, err
+	})
+}
@@ -0,0 +1,56 @@
+// Copyright 2021 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+func TestRepositoriesService_ListAttestations(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/attestations/sha256:abc", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testFormValues(t, r, values{"per_page": "2"})
+		fmt.Fprint(w, `{"attestations":[{"repository_id":1,"bundle":{"mediaType":"m"}}]}`)
+	})
+
+	ctx := context.Background()
+	opts := &ListOptions{PerPage: 2}
+	attestations, _, err := client.Repositories.ListAttestations(ctx, "o", "r", "sha256:abc", opts)
+	if err != nil {
+		t.Errorf("Repositories.ListAttestations returned error: %v", err)
+	}
+
+	want := &AttestationsResponse{
+		Attestations: []*Attestation{
+			{RepositoryID: Int64(1), Bundle: json.RawMessage(`{"mediaType":"m"}`)},
+		},
+	}
+	if !reflect.DeepEqual(attestations, want) {
+		t.Errorf("Repositories.ListAttestations returned %+v, want %+v", attestations, want)
+	}
+
+	const methodName = "ListAttestations"
+	testBadOptions(t, methodName, func() (err error) {
+		_, _, err = client.Repositories.ListAttestations(ctx, "\n", "\n", "\n", opts)
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.Repositories.ListAttestations(ctx, "o", "r", "sha256:abc", opts)
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
@@ -0,0 +1,196 @@
+// Copyright 2021 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+func TestRepositoriesService_ListAutolinks(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/autolinks", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testFormValues(t, r, values{"page": "2"})
+		fmt.Fprintf(w, `[{"id":1, "key_prefix": "TICKET-", "url_template": "https://example.com/TICKET?query=<num>"}, {"id":2, "key_prefix": "STORY-", "url_template": "https://example.com/STORY?query=<num>", "is_alphanumeric": false}]`)
+	})
+
+	opt := &ListOptions{
+		Page: 2,
+	}
+	ctx := context.Background()
+	autolinks, _, err := client.Repositories.ListAutolinks(ctx, "o", "r", opt)
+	if err != nil {
+		t.Errorf("Repositories.ListAutolinks returned error: %v", err)
+	}
+
+	want := []*Autolink{
+		{ID: Int64(1), KeyPrefix: String("TICKET-"), URLTemplate: String("https://example.com/TICKET?query=<num>")},
+		{ID: Int64(2), KeyPrefix: String("STORY-"), URLTemplate: String("https://example.com/STORY?query=<num>"), IsAlphanumeric: Bool(false)},
+	}
+
+	if !reflect.DeepEqual(autolinks, want) {
+		t.Errorf("Repositories.ListAutolinks returned %+v, want %+v", autolinks, want)
+	}
+
+	const methodName = "ListAutolinks"
+	testBadOptions(t, methodName, func() (err error) {
+		_, _, err = client.Repositories.ListAutolinks(ctx, "\n", "\n", opt)
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.Repositories.ListAutolinks(ctx, "o", "r", opt)
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
+
+func TestRepositoriesService_CreateAutolink(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	opt := &AutolinkOptions{
+		KeyPrefix:      String("TICKET-"),
+		URLTemplate:    String("https://example.com/TICKET?query=<num>"),
+		IsAlphanumeric: Bool(true),
+	}
+	mux.HandleFunc("/repos/o/r/autolinks", func(w http.ResponseWriter, r *http.Request) {
+		v := new(AutolinkOptions)
+		json.NewDecoder(r.Body).Decode(v)
+		testMethod(t, r, "POST")
+		if !reflect.DeepEqual(v, opt) {
+			t.Errorf("Request body = %+v, want %+v", v, opt)
+		}
+		w.WriteHeader(http.StatusCreated)
+		w.Write([]byte(`
+			{
+				"id": 1,
+				"key_prefix": "TICKET-",
+				"url_template": "https://example.com/TICKET?query=<num>",
+				"is_alphanumeric": true
+			}
+		`))
+	})
+	ctx := context.Background()
+	autolink, _, err := client.Repositories.CreateAutolink(ctx, "o", "r", opt)
+	if err != nil {
+		t.Errorf("Repositories.CreateAutolink returned error: %v", err)
+	}
+	want := &Autolink{
+		ID:             Int64(1),
+		KeyPrefix:      String("TICKET-"),
+		URLTemplate:    String("https://example.com/TICKET?query=<num>"),
+		IsAlphanumeric: Bool(true),
+	}
+
+	if !reflect.DeepEqual(autolink, want) {
+		t.Errorf("Repositories.CreateAutolink returned %+v, want %+v", autolink, want)
+	}
+
+	const methodName = "CreateAutolink"
+	testBadOptions(t, methodName, func() (err error) {
+		_, _, err = client.Repositories.CreateAutolink(ctx, "\n", "\n", opt)
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.Repositories.CreateAutolink(ctx, "o", "r", opt)
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
+
+func TestRepositoriesService_GetAutolink(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/autolinks/1", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprintf(w, `{"id":1, "key_prefix": "TICKET-", "url_template": "https://example.com/TICKET?query=<num>"}`)
+	})
+
+	ctx := context.Background()
+	autolink, _, err := client.Repositories.GetAutolink(ctx, "o", "r", 1)
+	if err != nil {
+		t.Errorf("Repositories.GetAutolink returned error: %v", err)
+	}
+
+	want := &Autolink{ID: Int64(1), KeyPrefix: String("TICKET-"), URLTemplate: String("https://example.com/TICKET?query=<num>")}
+	if !reflect.DeepEqual(autolink, want) {
+		t.Errorf("Repositories.GetAutolink returned %+v, want %+v", autolink, want)
+	}
+
+	const methodName = "GetAutolink"
+	testBadOptions(t, methodName, func() (err error) {
+		_, _, err = client.Repositories.GetAutolink(ctx, "\n", "\n", -1)
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.Repositories.GetAutolink(ctx, "o", "r", 1)
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
+
+func TestRepositoriesService_DeleteAutolink(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/autolinks/1", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	ctx := context.Background()
+	_, err := client.Repositories.DeleteAutolink(ctx, "o", "r", 1)
+	if err != nil {
+		t.Errorf("Repositories.DeleteAutolink returned error: %v", err)
+	}
+
+	const methodName = "DeleteAutolink"
+	testBadOptions(t, methodName, func() (err error) {
+		_, err = client.Repositories.DeleteAutolink(ctx, "\n", "\n", -1)
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		return client.Repositories.DeleteAutolink(ctx, "o", "r", 1)
+	})
+}
+
+func TestAutolink_Marshal(t *testing.T) {
+	testJSONMarshal(t, &Autolink{}, "{}")
+
+	r := &Autolink{
+		ID:             Int64(1),
+		KeyPrefix:      String("TICKET-"),
+		URLTemplate:    String("https://example.com/TICKET?query=<num>"),
+		IsAlphanumeric: Bool(true),
+	}
+
+	want := `{
+		"id": 1,
+		"key_prefix": "TICKET-",
+		"url_template": "https://example.com/TICKET?query=<num>",
+		"is_alphanumeric": true
+	}`
+
+	testJSONMarshal(t, r, want)
+}
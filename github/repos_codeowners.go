@@ -0,0 +1,248 @@
+// Copyright 2021 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"regexp"
+	"strings"
+)
+
+// codeownersPaths lists the locations GitHub looks for a CODEOWNERS file in,
+// in order of precedence.
+var codeownersPaths = []string{".github/CODEOWNERS", "CODEOWNERS", "docs/CODEOWNERS"}
+
+// CodeownersErrors represents a list of syntax errors detected in the CODEOWNERS file.
+type CodeownersErrors struct {
+	Errors []*CodeownersError `json:"errors"`
+}
+
+// CodeownersError represents a syntax error detected in the CODEOWNERS file.
+type CodeownersError struct {
+	Line       *int    `json:"line,omitempty"`
+	Column     *int    `json:"column,omitempty"`
+	Kind       *string `json:"kind,omitempty"`
+	Source     *string `json:"source,omitempty"`
+	Suggestion *string `json:"suggestion,omitempty"`
+	Message    *string `json:"message,omitempty"`
+	Path       *string `json:"path,omitempty"`
+}
+
+// GetCodeownersErrorsOptions specifies the optional parameters to the
+// RepositoriesService.GetCodeownersErrors method.
+type GetCodeownersErrorsOptions struct {
+	// A branch, tag or commit name used to determine which version of the CODEOWNERS file to use.
+	// Default: the repository's default branch (e.g. main).
+	Ref string `url:"ref,omitempty"`
+}
+
+// GetCodeownersErrors lists any syntax errors that are detected in the CODEOWNERS file.
+//
+// GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/repos/#list-codeowners-errors
+func (s *RepositoriesService) GetCodeownersErrors(ctx context.Context, owner, repo string, opts *GetCodeownersErrorsOptions) (*CodeownersErrors, *Response, error) {
+	u := fmt.Sprintf("repos/%v/%v/codeowners/errors", owner, repo)
+	u, err := addOptions(u, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	codeownersErrors := new(CodeownersErrors)
+	resp, err := s.client.Do(ctx, req, codeownersErrors)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return codeownersErrors, resp, nil
+}
+
+// Codeowners represents a parsed CODEOWNERS file.
+type Codeowners struct {
+	// Path is the location of the CODEOWNERS file in the repository.
+	Path string
+
+	// Rules are the rules of the file, in the order they appear.
+	Rules []*CodeownersRule
+}
+
+// CodeownersRule represents a single rule of a CODEOWNERS file.
+type CodeownersRule struct {
+	// Line is the 1-based line number of the rule in the file.
+	Line int
+
+	// Pattern is the gitignore-style pattern of the rule.
+	Pattern string
+
+	// Owners are the owners of the files matching Pattern, as written
+	// in the file: "@user", "@org/team-name" or an email address.
+	// A rule without owners means matching files have no owners.
+	Owners []string
+
+	re *regexp.Regexp
+}
+
+// ParseCodeowners parses the contents of a CODEOWNERS file.
+func ParseCodeowners(r io.Reader) (*Codeowners, error) {
+	c := new(Codeowners)
+	scanner := bufio.NewScanner(r)
+	line := 0
+	for scanner.Scan() {
+		line++
+		fields := strings.Fields(scanner.Text())
+		if len(fields) == 0 || strings.HasPrefix(fields[0], "#") {
+			continue
+		}
+
+		pattern := strings.Replace(fields[0], `\#`, "#", -1)
+		re, err := codeownersPatternRegexp(pattern)
+		if err != nil {
+			return nil, fmt.Errorf("line %v: invalid pattern %q: %v", line, pattern, err)
+		}
+
+		rule := &CodeownersRule{Line: line, Pattern: pattern, re: re}
+		for _, owner := range fields[1:] {
+			if strings.HasPrefix(owner, "#") {
+				break
+			}
+			rule.Owners = append(rule.Owners, owner)
+		}
+		c.Rules = append(c.Rules, rule)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	return c, nil
+}
+
+// Match reports whether the rule applies to the file at path, which is
+// relative to the root of the repository.
+func (r *CodeownersRule) Match(path string) bool {
+	if r.re == nil {
+		re, err := codeownersPatternRegexp(r.Pattern)
+		if err != nil {
+			return false
+		}
+		r.re = re
+	}
+	return r.re.MatchString(strings.TrimPrefix(path, "/"))
+}
+
+// Rule returns the rule that determines the owners of the file at path,
+// which is the last matching rule in the file. It returns nil if no rule
+// matches path.
+func (c *Codeowners) Rule(path string) *CodeownersRule {
+	for i := len(c.Rules) - 1; i >= 0; i-- {
+		if c.Rules[i].Match(path) {
+			return c.Rules[i]
+		}
+	}
+	return nil
+}
+
+// Owners returns the owners of the file at path, which is relative to the
+// root of the repository. It returns nil if the file has no owners.
+func (c *Codeowners) Owners(path string) []string {
+	if rule := c.Rule(path); rule != nil {
+		return rule.Owners
+	}
+	return nil
+}
+
+// codeownersPatternRegexp converts a CODEOWNERS pattern, which follows most
+// of the gitignore rules, into a regular expression matching file paths.
+func codeownersPatternRegexp(pattern string) (*regexp.Regexp, error) {
+	// A pattern containing a slash anywhere but at its end is
+	// relative to the root of the repository.
+	anchored := strings.Contains(strings.TrimSuffix(pattern, "/"), "/")
+	p := strings.TrimPrefix(pattern, "/")
+
+	// A pattern matching a directory matches everything inside it, except
+	// that "dir/*" only matches the files directly inside dir.
+	suffix := "(/.*)?$"
+	switch {
+	case strings.HasSuffix(p, "/"):
+		p = strings.TrimSuffix(p, "/")
+		suffix = "/.*$"
+	case strings.HasSuffix(p, "/*") || p == "*":
+		suffix = "$"
+	}
+
+	var b strings.Builder
+	b.WriteString("^")
+	if !anchored {
+		b.WriteString("(.*/)?")
+	}
+	for i := 0; i < len(p); i++ {
+		switch {
+		case strings.HasPrefix(p[i:], "**/"):
+			b.WriteString("(.*/)?")
+			i += 2
+		case strings.HasPrefix(p[i:], "**"):
+			b.WriteString(".*")
+			i++
+		case p[i] == '*':
+			b.WriteString("[^/]*")
+		case p[i] == '?':
+			b.WriteString("[^/]")
+		default:
+			b.WriteString(regexp.QuoteMeta(p[i : i+1]))
+		}
+	}
+	b.WriteString(suffix)
+
+	return regexp.Compile(b.String())
+}
+
+// GetCodeowners fetches and parses the CODEOWNERS file of a repository. As
+// GitHub does, it looks for the file in the .github/ directory, then in the
+// root of the repository, then in the docs/ directory, and uses the first one
+// it finds. If no CODEOWNERS file exists, the error returned for the last
+// location is returned.
+func (s *RepositoriesService) GetCodeowners(ctx context.Context, owner, repo string, opts *RepositoryContentGetOptions) (*Codeowners, *Response, error) {
+	var resp *Response
+	var err error
+	for _, path := range codeownersPaths {
+		var rc io.ReadCloser
+		rc, _, resp, err = s.DownloadContentsStream(ctx, owner, repo, path, opts)
+		if err != nil {
+			if e, ok := err.(*ErrorResponse); ok && e.Response.StatusCode == http.StatusNotFound {
+				continue
+			}
+			return nil, resp, err
+		}
+
+		codeowners, err := ParseCodeowners(rc)
+		rc.Close()
+		if err != nil {
+			return nil, resp, err
+		}
+		codeowners.Path = path
+		return codeowners, resp, nil
+	}
+
+	return nil, resp, err
+}
+
+// ResolveCodeowners returns the owners of the file at path, as determined by
+// the repository's CODEOWNERS file at the ref given in opts. See GetCodeowners
+// for how the CODEOWNERS file is located.
+func (s *RepositoriesService) ResolveCodeowners(ctx context.Context, owner, repo, path string, opts *RepositoryContentGetOptions) ([]string, *Response, error) {
+	codeowners, resp, err := s.GetCodeowners(ctx, owner, repo, opts)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return codeowners.Owners(path), resp, nil
+}
@@ -0,0 +1,202 @@
+// Copyright 2021 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestRepositoriesService_GetCodeownersErrors(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/codeowners/errors", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testFormValues(t, r, values{"ref": "mybranch"})
+		fmt.Fprint(w, `{
+		  "errors": [
+			{
+			  "line": 1,
+			  "column": 1,
+			  "kind": "Invalid pattern",
+			  "source": "***/*.rb @monalisa",
+			  "suggestion": "Did you mean **/*.rb?",
+			  "message": "Invalid pattern on line 3: Did you mean **/*.rb?\n\n  ***/*.rb @monalisa\n  ^",
+			  "path": ".github/CODEOWNERS"
+			}
+		  ]
+		}
+	`)
+	})
+
+	opts := &GetCodeownersErrorsOptions{Ref: "mybranch"}
+	ctx := context.Background()
+	codeownersErrors, _, err := client.Repositories.GetCodeownersErrors(ctx, "o", "r", opts)
+	if err != nil {
+		t.Errorf("Repositories.GetCodeownersErrors returned error: %v", err)
+	}
+
+	want := &CodeownersErrors{
+		Errors: []*CodeownersError{
+			{
+				Line:       Int(1),
+				Column:     Int(1),
+				Kind:       String("Invalid pattern"),
+				Source:     String("***/*.rb @monalisa"),
+				Suggestion: String("Did you mean **/*.rb?"),
+				Message:    String("Invalid pattern on line 3: Did you mean **/*.rb?\n\n  ***/*.rb @monalisa\n  ^"),
+				Path:       String(".github/CODEOWNERS"),
+			},
+		},
+	}
+	if !reflect.DeepEqual(codeownersErrors, want) {
+		t.Errorf("Repositories.GetCodeownersErrors returned %+v, want %+v", codeownersErrors, want)
+	}
+
+	const methodName = "GetCodeownersErrors"
+	testBadOptions(t, methodName, func() (err error) {
+		_, _, err = client.Repositories.GetCodeownersErrors(ctx, "\n", "\n", opts)
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.Repositories.GetCodeownersErrors(ctx, "o", "r", opts)
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
+
+func TestParseCodeowners(t *testing.T) {
+	const file = `# This is a comment.
+*       @global-owner1 @global-owner2
+
+*.js    @js-owner # This is an inline comment.
+*.go docs@example.com
+/build/logs/ @doctocat
+docs/*  docs@example.com
+apps/ @octocat
+/docs/ @doctocat
+/scripts/ @doctocat @octocat
+**/logs @octocat
+/apps/github
+\#notes @org/notes-team
+`
+
+	c, err := ParseCodeowners(strings.NewReader(file))
+	if err != nil {
+		t.Fatalf("ParseCodeowners returned error: %v", err)
+	}
+
+	if got, want := len(c.Rules), 11; got != want {
+		t.Fatalf("ParseCodeowners returned %v rules, want %v", got, want)
+	}
+	if got, want := c.Rules[1].Line, 4; got != want {
+		t.Errorf("Rules[1].Line = %v, want %v", got, want)
+	}
+	if got, want := c.Rules[1].Owners, []string{"@js-owner"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Rules[1].Owners = %v, want %v", got, want)
+	}
+
+	tests := []struct {
+		path string
+		want []string
+	}{
+		{"README.md", []string{"@global-owner1", "@global-owner2"}},
+		{"src/index.js", []string{"@js-owner"}},
+		{"main.go", []string{"docs@example.com"}},
+		{"build/logs/out.txt", []string{"@octocat"}},
+		{"build/logs/sub/out.txt", []string{"@octocat"}},
+		{"docs/getting-started.md", []string{"@doctocat"}},
+		{"docs/build-app/troubleshooting.md", []string{"@doctocat"}},
+		{"src/docs/getting-started.md", []string{"@global-owner1", "@global-owner2"}},
+		{"src/docs/build-app/troubleshooting.md", []string{"@global-owner1", "@global-owner2"}},
+		{"src/apps/main.c", []string{"@octocat"}},
+		{"scripts/build.sh", []string{"@doctocat", "@octocat"}},
+		{"deep/down/logs/x", []string{"@octocat"}},
+		{"apps/github/main.c", nil},
+		{"#notes", []string{"@org/notes-team"}},
+	}
+	for _, tt := range tests {
+		if got := c.Owners(tt.path); !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("Owners(%q) = %v, want %v", tt.path, got, tt.want)
+		}
+	}
+
+	if rule := (&Codeowners{}).Rule("a"); rule != nil {
+		t.Errorf("Rule returned %+v for empty file, want nil", rule)
+	}
+}
+
+func TestRepositoriesService_GetCodeowners(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/contents/.github/CODEOWNERS", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		http.Error(w, `{"message": "Not Found"}`, http.StatusNotFound)
+	})
+	mux.HandleFunc("/repos/o/r/contents/CODEOWNERS", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testFormValues(t, r, values{"ref": "main"})
+		// "* @a\n/docs/ @b\n"
+		fmt.Fprint(w, `{"type": "file", "encoding": "base64", "size": 16, "content": "KiBAYQovZG9jcy8gQGIK"}`)
+	})
+
+	ctx := context.Background()
+	opts := &RepositoryContentGetOptions{Ref: "main"}
+	codeowners, _, err := client.Repositories.GetCodeowners(ctx, "o", "r", opts)
+	if err != nil {
+		t.Fatalf("Repositories.GetCodeowners returned error: %v", err)
+	}
+	if got, want := codeowners.Path, "CODEOWNERS"; got != want {
+		t.Errorf("Repositories.GetCodeowners returned path %v, want %v", got, want)
+	}
+
+	owners, _, err := client.Repositories.ResolveCodeowners(ctx, "o", "r", "docs/index.md", opts)
+	if err != nil {
+		t.Fatalf("Repositories.ResolveCodeowners returned error: %v", err)
+	}
+	if want := []string{"@b"}; !reflect.DeepEqual(owners, want) {
+		t.Errorf("Repositories.ResolveCodeowners returned %v, want %v", owners, want)
+	}
+
+	const methodName = "GetCodeowners"
+	testBadOptions(t, methodName, func() (err error) {
+		_, _, err = client.Repositories.GetCodeowners(ctx, "\n", "\n", opts)
+		return err
+	})
+}
+
+func TestRepositoriesService_GetCodeowners_notFound(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	var requests int
+	mux.HandleFunc("/repos/o/r/contents/", func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		testMethod(t, r, "GET")
+		http.Error(w, `{"message": "Not Found"}`, http.StatusNotFound)
+	})
+
+	ctx := context.Background()
+	_, resp, err := client.Repositories.ResolveCodeowners(ctx, "o", "r", "a", nil)
+	if err == nil {
+		t.Fatal("Repositories.ResolveCodeowners returned nil error, want error")
+	}
+	if got, want := resp.StatusCode, http.StatusNotFound; got != want {
+		t.Errorf("Repositories.ResolveCodeowners returned status %v, want %v", got, want)
+	}
+	if got, want := requests, len(codeownersPaths); got != want {
+		t.Errorf("Repositories.ResolveCodeowners made %v requests, want %v", got, want)
+	}
+}
@@ -167,6 +167,39 @@ func (s *RepositoriesService) GetCommit(ctx context.Context, owner, repo, sha st
 	return commit, resp, nil
 }
 
+// UnverifiedCommitError is returned by RequireVerifiedCommit when the
+// commit signature is not verified.
+type UnverifiedCommitError struct {
+	SHA    string
+	Reason SignatureVerificationReason
+}
+
+func (e *UnverifiedCommitError) Error() string {
+	return fmt.Sprintf("commit %v is not verified: %v", e.SHA, e.Reason)
+}
+
+// RequireVerifiedCommit fetches the commit ref points to, such as the tip
+// of a branch, and returns an *UnverifiedCommitError if its signature is
+// not verified by GitHub. ref can be a SHA, a branch or a tag name.
+//
+// GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/repos/#get-a-commit
+func (s *RepositoriesService) RequireVerifiedCommit(ctx context.Context, owner, repo, ref string) (*RepositoryCommit, *Response, error) {
+	commit, resp, err := s.GetCommit(ctx, owner, repo, refURLEscape(ref))
+	if err != nil {
+		return nil, resp, err
+	}
+
+	verification := commit.GetCommit().GetVerification()
+	if !verification.GetVerified() {
+		reason := verification.VerificationReason()
+		if reason == "" {
+			reason = SignatureUnsigned
+		}
+		return commit, resp, &UnverifiedCommitError{SHA: commit.GetSHA(), Reason: reason}
+	}
+	return commit, resp, nil
+}
+
 // GetCommitRaw fetches the specified commit in raw (diff or patch) format.
 //
 // GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/repos/#get-a-commit
@@ -225,10 +258,23 @@ func (s *RepositoriesService) GetCommitSHA1(ctx context.Context, owner, repo, re
 //
 // GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/repos/#compare-two-commits
 func (s *RepositoriesService) CompareCommits(ctx context.Context, owner, repo string, base, head string) (*CommitsComparison, *Response, error) {
+	return s.CompareCommitsPage(ctx, owner, repo, base, head, nil)
+}
+
+// CompareCommitsPage compares a range of commits with each other, returning
+// the page of the commits selected by opts. CompareCommits only returns the
+// first 250 commits of the range.
+//
+// GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/repos/#compare-two-commits
+func (s *RepositoriesService) CompareCommitsPage(ctx context.Context, owner, repo string, base, head string, opts *ListOptions) (*CommitsComparison, *Response, error) {
 	escapedBase := url.QueryEscape(base)
 	escapedHead := url.QueryEscape(head)
 
 	u := fmt.Sprintf("repos/%v/%v/compare/%v...%v", owner, repo, escapedBase, escapedHead)
+	u, err := addOptions(u, opts)
+	if err != nil {
+		return nil, nil, err
+	}
 
 	req, err := s.client.NewRequest("GET", u, nil)
 	if err != nil {
@@ -244,6 +290,64 @@ func (s *RepositoriesService) CompareCommits(ctx context.Context, owner, repo st
 	return comp, resp, nil
 }
 
+// CompareAllCommitsOptions specifies the optional parameters to the
+// RepositoriesService.CompareAllCommits method.
+type CompareAllCommitsOptions struct {
+	// PerPage is the number of commits fetched per request. Default is 100.
+	PerPage int
+
+	// FileHandler, if set, is called with each file changed in the range
+	// as soon as the page listing it is received, and the files are not
+	// collected in the returned comparison. If it returns an error, the
+	// comparison stops and this error is returned.
+	FileHandler func(*CommitFile) error
+}
+
+// CompareAllCommits compares a range of commits with each other, as
+// CompareCommits does, but goes through all the pages of the comparison so
+// that the returned comparison has all the commits of the range, rather
+// than the first 250 only, along with the files listed by each page. The
+// returned Response is the one of the last page.
+//
+// GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/repos/#compare-two-commits
+func (s *RepositoriesService) CompareAllCommits(ctx context.Context, owner, repo, base, head string, opts *CompareAllCommitsOptions) (*CommitsComparison, *Response, error) {
+	if opts == nil {
+		opts = &CompareAllCommitsOptions{}
+	}
+	listOpts := &ListOptions{PerPage: opts.PerPage}
+	if listOpts.PerPage == 0 {
+		listOpts.PerPage = 100
+	}
+
+	var comp *CommitsComparison
+	for {
+		page, resp, err := s.CompareCommitsPage(ctx, owner, repo, base, head, listOpts)
+		if err != nil {
+			return nil, resp, err
+		}
+
+		files := page.Files
+		if comp == nil {
+			comp = page
+			comp.Files = nil
+		} else {
+			comp.Commits = append(comp.Commits, page.Commits...)
+		}
+		for _, f := range files {
+			if opts.FileHandler == nil {
+				comp.Files = append(comp.Files, f)
+			} else if err := opts.FileHandler(f); err != nil {
+				return nil, resp, err
+			}
+		}
+
+		if resp.NextPage == 0 {
+			return comp, resp, nil
+		}
+		listOpts.Page = resp.NextPage
+	}
+}
+
 // CompareCommitsRaw compares a range of commits with each other in raw (diff or patch) format.
 //
 // Both "base" and "head" must be branch names in "repo".
@@ -657,3 +657,155 @@ func TestRepositoriesService_ListBranchesHeadCommit(t *testing.T) {
 		return resp, err
 	})
 }
+
+func TestRepositoriesService_RequireVerifiedCommit(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/commits/main", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"sha":"s","commit":{"verification":{"verified":true,"reason":"valid","verified_at":`+referenceTimeStr+`}}}`)
+	})
+	mux.HandleFunc("/repos/o/r/commits/feature/x", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"sha":"t","commit":{"verification":{"verified":false,"reason":"unknown_key"}}}`)
+	})
+	mux.HandleFunc("/repos/o/r/commits/old", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"sha":"u","commit":{}}`)
+	})
+
+	ctx := context.Background()
+	commit, _, err := client.Repositories.RequireVerifiedCommit(ctx, "o", "r", "main")
+	if err != nil {
+		t.Errorf("Repositories.RequireVerifiedCommit returned error: %v", err)
+	}
+	if got := commit.GetCommit().GetVerification().GetVerifiedAt(); !got.Equal(Timestamp{referenceTime}) {
+		t.Errorf("Repositories.RequireVerifiedCommit returned commit verified at %v, want %v", got, referenceTime)
+	}
+
+	commit, _, err = client.Repositories.RequireVerifiedCommit(ctx, "o", "r", "feature/x")
+	want := &UnverifiedCommitError{SHA: "t", Reason: SignatureUnknownKey}
+	if !reflect.DeepEqual(err, want) {
+		t.Errorf("Repositories.RequireVerifiedCommit returned error %v, want %v", err, want)
+	}
+	if commit.GetSHA() != "t" {
+		t.Errorf("Repositories.RequireVerifiedCommit returned commit %v, want t", commit.GetSHA())
+	}
+
+	_, _, err = client.Repositories.RequireVerifiedCommit(ctx, "o", "r", "old")
+	if got, want := err.Error(), "commit u is not verified: unsigned"; got != want {
+		t.Errorf("Repositories.RequireVerifiedCommit returned error %q, want %q", got, want)
+	}
+
+	const methodName = "RequireVerifiedCommit"
+	testBadOptions(t, methodName, func() (err error) {
+		_, _, err = client.Repositories.RequireVerifiedCommit(ctx, "\n", "\n", "\n")
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.Repositories.RequireVerifiedCommit(ctx, "o", "r", "main")
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
+
+func TestRepositoriesService_CompareCommitsPage(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/compare/b...h", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testFormValues(t, r, values{"per_page": "2", "page": "3"})
+		fmt.Fprint(w, `{"total_commits":5,"commits":[{"sha":"s5"}]}`)
+	})
+
+	ctx := context.Background()
+	opts := &ListOptions{PerPage: 2, Page: 3}
+	got, _, err := client.Repositories.CompareCommitsPage(ctx, "o", "r", "b", "h", opts)
+	if err != nil {
+		t.Errorf("Repositories.CompareCommitsPage returned error: %v", err)
+	}
+	want := &CommitsComparison{TotalCommits: Int(5), Commits: []*RepositoryCommit{{SHA: String("s5")}}}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Repositories.CompareCommitsPage returned %+v, want %+v", got, want)
+	}
+
+	const methodName = "CompareCommitsPage"
+	testBadOptions(t, methodName, func() (err error) {
+		_, _, err = client.Repositories.CompareCommitsPage(ctx, "\n", "\n", "\n", "\n", opts)
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.Repositories.CompareCommitsPage(ctx, "o", "r", "b", "h", opts)
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
+
+func TestRepositoriesService_CompareAllCommits(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/compare/b...h", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		switch r.FormValue("page") {
+		case "":
+			testFormValues(t, r, values{"per_page": "100"})
+			w.Header().Set("Link", `<https://api.github.com/repos/o/r/compare/b...h?per_page=100&page=2>; rel="next"`)
+			fmt.Fprint(w, `{"status":"ahead","total_commits":3,"commits":[{"sha":"s1"},{"sha":"s2"}],"files":[{"filename":"a"},{"filename":"b"}]}`)
+		case "2":
+			testFormValues(t, r, values{"per_page": "100", "page": "2"})
+			fmt.Fprint(w, `{"status":"ahead","total_commits":3,"commits":[{"sha":"s3"}],"files":[{"filename":"c"}]}`)
+		}
+	})
+
+	ctx := context.Background()
+	got, _, err := client.Repositories.CompareAllCommits(ctx, "o", "r", "b", "h", nil)
+	if err != nil {
+		t.Errorf("Repositories.CompareAllCommits returned error: %v", err)
+	}
+	want := &CommitsComparison{
+		Status:       String("ahead"),
+		TotalCommits: Int(3),
+		Commits:      []*RepositoryCommit{{SHA: String("s1")}, {SHA: String("s2")}, {SHA: String("s3")}},
+		Files:        []*CommitFile{{Filename: String("a")}, {Filename: String("b")}, {Filename: String("c")}},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Repositories.CompareAllCommits returned %+v, want %+v", got, want)
+	}
+
+	var files []string
+	opts := &CompareAllCommitsOptions{FileHandler: func(f *CommitFile) error {
+		files = append(files, f.GetFilename())
+		return nil
+	}}
+	got, _, err = client.Repositories.CompareAllCommits(ctx, "o", "r", "b", "h", opts)
+	if err != nil {
+		t.Errorf("Repositories.CompareAllCommits returned error: %v", err)
+	}
+	if got.Files != nil || len(got.Commits) != 3 {
+		t.Errorf("Repositories.CompareAllCommits with FileHandler returned %+v", got)
+	}
+	if want := []string{"a", "b", "c"}; !reflect.DeepEqual(files, want) {
+		t.Errorf("FileHandler was called with %v, want %v", files, want)
+	}
+
+	opts.FileHandler = func(f *CommitFile) error {
+		return fmt.Errorf("stop at %v", f.GetFilename())
+	}
+	if _, _, err := client.Repositories.CompareAllCommits(ctx, "o", "r", "b", "h", opts); err == nil || err.Error() != "stop at a" {
+		t.Errorf("Repositories.CompareAllCommits returned error %v, want stop at a", err)
+	}
+
+	const methodName = "CompareAllCommits"
+	testBadOptions(t, methodName, func() (err error) {
+		_, _, err = client.Repositories.CompareAllCommits(ctx, "\n", "\n", "\n", "\n", nil)
+		return err
+	})
+}
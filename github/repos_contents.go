@@ -15,6 +15,7 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"io/ioutil"
 	"net/http"
 	"net/url"
 	"path"
@@ -144,6 +145,56 @@ func (s *RepositoriesService) DownloadContents(ctx context.Context, owner, repo,
 	return nil, resp, fmt.Errorf("No file named %s found in %s", filename, dir)
 }
 
+// DownloadContentsStream returns an io.ReadCloser that reads the contents of
+// the specified file, along with the file's metadata (including its size and
+// blob SHA). Unlike DownloadContents, it fetches the file directly rather than
+// listing its parent directory.
+//
+// Files up to 1 Mb are returned inline by the contents API and are decoded
+// without a further request. For larger files, the content is not included in
+// the JSON response, so DownloadContentsStream automatically falls back to
+// requesting the raw media type, which supports files up to 100 Mb.
+// It is the caller's responsibility to close the ReadCloser.
+//
+// GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/repos/#get-repository-content
+func (s *RepositoriesService) DownloadContentsStream(ctx context.Context, owner, repo, filepath string, opts *RepositoryContentGetOptions) (io.ReadCloser, *RepositoryContent, *Response, error) {
+	fileContent, _, resp, err := s.GetContents(ctx, owner, repo, filepath, opts)
+	if err != nil {
+		return nil, nil, resp, err
+	}
+	if fileContent == nil {
+		return nil, nil, resp, fmt.Errorf("%s is a directory, not a file", filepath)
+	}
+
+	// Files larger than 1 Mb are returned with empty content and an
+	// encoding of "none".
+	var content string
+	if fileContent.Content != nil {
+		content = *fileContent.Content
+	}
+	if fileContent.GetEncoding() == "base64" && (content != "" || fileContent.GetSize() == 0) {
+		return ioutil.NopCloser(base64.NewDecoder(base64.StdEncoding, strings.NewReader(content))), fileContent, resp, nil
+	}
+
+	escapedPath := (&url.URL{Path: strings.TrimSuffix(filepath, "/")}).String()
+	u := fmt.Sprintf("repos/%s/%s/contents/%s", owner, repo, escapedPath)
+	u, err = addOptions(u, opts)
+	if err != nil {
+		return nil, nil, resp, err
+	}
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, resp, err
+	}
+	req.Header.Set("Accept", mediaTypeV3Raw)
+
+	rawResp, err := s.client.BareDo(ctx, req)
+	if err != nil {
+		return nil, nil, rawResp, err
+	}
+	return rawResp.Body, fileContent, rawResp, nil
+}
+
 // GetContents can return either the metadata and content of a single file
 // (when path references a file) or the metadata of all the files and/or
 // subdirectories of a directory (when path references a directory). To make it
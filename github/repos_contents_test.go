@@ -247,6 +247,149 @@ func TestRepositoriesService_DownloadContents_NoFile(t *testing.T) {
 	}
 }
 
+func TestRepositoriesService_DownloadContentsStream_Inline(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+	mux.HandleFunc("/repos/o/r/contents/d/f", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testHeader(t, r, "Accept", mediaTypeV3)
+		fmt.Fprint(w, `{
+		  "type": "file",
+		  "encoding": "base64",
+		  "size": 3,
+		  "name": "f",
+		  "path": "d/f",
+		  "sha": "s",
+		  "content": "Zm9v\n"
+		}`)
+	})
+
+	ctx := context.Background()
+	r, content, _, err := client.Repositories.DownloadContentsStream(ctx, "o", "r", "d/f", nil)
+	if err != nil {
+		t.Fatalf("Repositories.DownloadContentsStream returned error: %v", err)
+	}
+	defer r.Close()
+
+	if got, want := content.GetSHA(), "s"; got != want {
+		t.Errorf("Repositories.DownloadContentsStream returned SHA %v, want %v", got, want)
+	}
+	if got, want := content.GetSize(), 3; got != want {
+		t.Errorf("Repositories.DownloadContentsStream returned size %v, want %v", got, want)
+	}
+
+	bytes, err := ioutil.ReadAll(r)
+	if err != nil {
+		t.Errorf("Error reading response body: %v", err)
+	}
+	if got, want := string(bytes), "foo"; got != want {
+		t.Errorf("Repositories.DownloadContentsStream returned %v, want %v", got, want)
+	}
+
+	const methodName = "DownloadContentsStream"
+	testBadOptions(t, methodName, func() (err error) {
+		_, _, _, err = client.Repositories.DownloadContentsStream(ctx, "\n", "\n", "\n", nil)
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, _, resp, err := client.Repositories.DownloadContentsStream(ctx, "o", "r", "d/f", nil)
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
+
+func TestRepositoriesService_DownloadContentsStream_RawFallback(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+	mux.HandleFunc("/repos/o/r/contents/d/f", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testFormValues(t, r, values{"ref": "main"})
+		if r.Header.Get("Accept") == mediaTypeV3Raw {
+			fmt.Fprint(w, "large file contents")
+			return
+		}
+		fmt.Fprint(w, `{
+		  "type": "file",
+		  "encoding": "none",
+		  "size": 2097152,
+		  "name": "f",
+		  "path": "d/f",
+		  "sha": "s",
+		  "content": ""
+		}`)
+	})
+
+	ctx := context.Background()
+	r, content, resp, err := client.Repositories.DownloadContentsStream(ctx, "o", "r", "d/f", &RepositoryContentGetOptions{Ref: "main"})
+	if err != nil {
+		t.Fatalf("Repositories.DownloadContentsStream returned error: %v", err)
+	}
+	defer r.Close()
+
+	if got, want := resp.Response.StatusCode, http.StatusOK; got != want {
+		t.Errorf("Repositories.DownloadContentsStream returned status code %v, want %v", got, want)
+	}
+	if got, want := content.GetSize(), 2097152; got != want {
+		t.Errorf("Repositories.DownloadContentsStream returned size %v, want %v", got, want)
+	}
+
+	bytes, err := ioutil.ReadAll(r)
+	if err != nil {
+		t.Errorf("Error reading response body: %v", err)
+	}
+	if got, want := string(bytes), "large file contents"; got != want {
+		t.Errorf("Repositories.DownloadContentsStream returned %v, want %v", got, want)
+	}
+}
+
+func TestRepositoriesService_DownloadContentsStream_RawFailedResponse(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+	mux.HandleFunc("/repos/o/r/contents/d/f", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		if r.Header.Get("Accept") == mediaTypeV3Raw {
+			w.WriteHeader(http.StatusForbidden)
+			fmt.Fprint(w, `{"message":"This API returns blobs up to 100 MB in size."}`)
+			return
+		}
+		fmt.Fprint(w, `{"type": "file", "encoding": "none", "size": 104857601, "name": "f"}`)
+	})
+
+	ctx := context.Background()
+	r, content, resp, err := client.Repositories.DownloadContentsStream(ctx, "o", "r", "d/f", nil)
+	if err == nil {
+		t.Error("Repositories.DownloadContentsStream did not return expected error")
+	}
+	if r != nil || content != nil {
+		t.Errorf("Repositories.DownloadContentsStream returned %v, %v, want nil", r, content)
+	}
+	if got, want := resp.Response.StatusCode, http.StatusForbidden; got != want {
+		t.Errorf("Repositories.DownloadContentsStream returned status code %v, want %v", got, want)
+	}
+}
+
+func TestRepositoriesService_DownloadContentsStream_Directory(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+	mux.HandleFunc("/repos/o/r/contents/d", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `[{"type": "file", "name": "f"}]`)
+	})
+
+	ctx := context.Background()
+	_, _, resp, err := client.Repositories.DownloadContentsStream(ctx, "o", "r", "d", nil)
+	if err == nil {
+		t.Errorf("Repositories.DownloadContentsStream did not return expected error")
+	}
+
+	if resp == nil {
+		t.Errorf("Repositories.DownloadContentsStream did not return expected response")
+	}
+}
+
 func TestRepositoriesService_GetContents_File(t *testing.T) {
 	client, mux, _, teardown := setup()
 	defer teardown()
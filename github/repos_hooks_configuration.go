@@ -0,0 +1,133 @@
+// Copyright 2021 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+)
+
+// HookContentType is the media type used to serialize the payloads of a
+// webhook, as in HookConfig.ContentType.
+type HookContentType string
+
+// Possible values for the ContentType of a HookConfig.
+const (
+	HookContentTypeJSON HookContentType = "json"
+	HookContentTypeForm HookContentType = "form"
+)
+
+// HookSSLVerification is whether the SSL certificate of the host of the URL
+// of a webhook is verified, as in HookConfig.InsecureSSL.
+type HookSSLVerification string
+
+// Possible values for the InsecureSSL of a HookConfig.
+const (
+	// HookVerifySSL verifies the SSL certificate of the host of the URL of
+	// the webhook when delivering payloads. It is the default.
+	HookVerifySSL HookSSLVerification = "0"
+	// HookInsecureSSL doesn't verify the SSL certificate. It is not
+	// recommended.
+	HookInsecureSSL HookSSLVerification = "1"
+)
+
+// HookConfig describes the configuration of a webhook.
+type HookConfig struct {
+	// ContentType is the media type used to serialize the payloads, either
+	// HookContentTypeJSON or HookContentTypeForm.
+	ContentType *string `json:"content_type,omitempty"`
+	// InsecureSSL is either HookVerifySSL or HookInsecureSSL.
+	InsecureSSL *string `json:"insecure_ssl,omitempty"`
+	URL         *string `json:"url,omitempty"`
+	// Secret is the secret token of the webhook, which GitHub returns
+	// obfuscated as "********" when it is set.
+	Secret *string `json:"secret,omitempty"`
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface. It accepts
+// InsecureSSL as a number too, as sent by some GitHub endpoints.
+func (c *HookConfig) UnmarshalJSON(data []byte) error {
+	type hookConfig HookConfig
+	aux := struct {
+		*hookConfig
+		InsecureSSL json.RawMessage `json:"insecure_ssl,omitempty"`
+	}{hookConfig: (*hookConfig)(c)}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+	if len(aux.InsecureSSL) == 0 || string(aux.InsecureSSL) == "null" {
+		return nil
+	}
+
+	var insecureSSL string
+	if err := json.Unmarshal(aux.InsecureSSL, &insecureSSL); err != nil {
+		var n json.Number
+		if err := json.Unmarshal(aux.InsecureSSL, &n); err != nil {
+			return fmt.Errorf("invalid insecure_ssl %s", aux.InsecureSSL)
+		}
+		insecureSSL = n.String()
+	}
+	c.InsecureSSL = &insecureSSL
+	return nil
+}
+
+// GetHookConfiguration returns the configuration for the specified repository webhook.
+//
+// GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/repos/#get-a-webhook-configuration-for-a-repository
+func (s *RepositoriesService) GetHookConfiguration(ctx context.Context, owner, repo string, id int64) (*HookConfig, *Response, error) {
+	u := fmt.Sprintf("repos/%v/%v/hooks/%v/config", owner, repo, id)
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	config := new(HookConfig)
+	resp, err := s.client.Do(ctx, req, config)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return config, resp, nil
+}
+
+// EditHookConfiguration updates the configuration for the specified repository webhook.
+// Only the fields of config that are set are updated.
+//
+// GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/repos/#update-a-webhook-configuration-for-a-repository
+func (s *RepositoriesService) EditHookConfiguration(ctx context.Context, owner, repo string, id int64, config *HookConfig) (*HookConfig, *Response, error) {
+	u := fmt.Sprintf("repos/%v/%v/hooks/%v/config", owner, repo, id)
+	req, err := s.client.NewRequest("PATCH", u, config)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	c := new(HookConfig)
+	resp, err := s.client.Do(ctx, req, c)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return c, resp, nil
+}
+
+// RotateHookSecret sets the secret token of the specified repository webhook
+// to secret, leaving the rest of its configuration unchanged.
+// Receivers can accept both the old and the new secret tokens during the
+// rotation with ValidatePayloadWithSecrets.
+//
+// GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/repos/#update-a-webhook-configuration-for-a-repository
+func (s *RepositoriesService) RotateHookSecret(ctx context.Context, owner, repo string, id int64, secret string) (*HookConfig, *Response, error) {
+	return s.EditHookConfiguration(ctx, owner, repo, id, &HookConfig{Secret: &secret})
+}
+
+// RotateHookURL sets the URL of the specified repository webhook to url,
+// leaving the rest of its configuration unchanged.
+//
+// GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/repos/#update-a-webhook-configuration-for-a-repository
+func (s *RepositoriesService) RotateHookURL(ctx context.Context, owner, repo string, id int64, url string) (*HookConfig, *Response, error) {
+	return s.EditHookConfiguration(ctx, owner, repo, id, &HookConfig{URL: &url})
+}
@@ -0,0 +1,142 @@
+// Copyright 2021 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+func TestRepositoriesService_GetHookConfiguration(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/hooks/1/config", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"content_type": "json", "insecure_ssl": "0", "secret": "********", "url": "https://example.com/webhook"}`)
+	})
+
+	ctx := context.Background()
+	config, _, err := client.Repositories.GetHookConfiguration(ctx, "o", "r", 1)
+	if err != nil {
+		t.Errorf("Repositories.GetHookConfiguration returned error: %v", err)
+	}
+
+	want := &HookConfig{
+		ContentType: String(string(HookContentTypeJSON)),
+		InsecureSSL: String(string(HookVerifySSL)),
+		Secret:      String("********"),
+		URL:         String("https://example.com/webhook"),
+	}
+	if !reflect.DeepEqual(config, want) {
+		t.Errorf("Repositories.GetHookConfiguration returned %+v, want %+v", config, want)
+	}
+
+	const methodName = "GetHookConfiguration"
+	testBadOptions(t, methodName, func() (err error) {
+		_, _, err = client.Repositories.GetHookConfiguration(ctx, "\n", "\n", -1)
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.Repositories.GetHookConfiguration(ctx, "o", "r", 1)
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
+
+func TestRepositoriesService_EditHookConfiguration(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	input := &HookConfig{ContentType: String(string(HookContentTypeForm)), InsecureSSL: String(string(HookInsecureSSL))}
+
+	mux.HandleFunc("/repos/o/r/hooks/1/config", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PATCH")
+		testBody(t, r, `{"content_type":"form","insecure_ssl":"1"}`+"\n")
+		fmt.Fprint(w, `{"content_type": "form", "insecure_ssl": "1", "url": "u"}`)
+	})
+
+	ctx := context.Background()
+	config, _, err := client.Repositories.EditHookConfiguration(ctx, "o", "r", 1, input)
+	if err != nil {
+		t.Errorf("Repositories.EditHookConfiguration returned error: %v", err)
+	}
+
+	want := &HookConfig{ContentType: String("form"), InsecureSSL: String("1"), URL: String("u")}
+	if !reflect.DeepEqual(config, want) {
+		t.Errorf("Repositories.EditHookConfiguration returned %+v, want %+v", config, want)
+	}
+
+	const methodName = "EditHookConfiguration"
+	testBadOptions(t, methodName, func() (err error) {
+		_, _, err = client.Repositories.EditHookConfiguration(ctx, "\n", "\n", -1, input)
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.Repositories.EditHookConfiguration(ctx, "o", "r", 1, input)
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
+
+func TestRepositoriesService_RotateHook(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	var wantBody string
+	mux.HandleFunc("/repos/o/r/hooks/1/config", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PATCH")
+		testBody(t, r, wantBody+"\n")
+		fmt.Fprint(w, `{"url": "u"}`)
+	})
+
+	ctx := context.Background()
+	wantBody = `{"secret":"s"}`
+	if _, _, err := client.Repositories.RotateHookSecret(ctx, "o", "r", 1, "s"); err != nil {
+		t.Errorf("Repositories.RotateHookSecret returned error: %v", err)
+	}
+	wantBody = `{"url":"u"}`
+	if _, _, err := client.Repositories.RotateHookURL(ctx, "o", "r", 1, "u"); err != nil {
+		t.Errorf("Repositories.RotateHookURL returned error: %v", err)
+	}
+}
+
+func TestHookConfig_UnmarshalJSON(t *testing.T) {
+	tests := []struct {
+		data string
+		want *HookConfig
+	}{
+		{`{}`, &HookConfig{}},
+		{`{"insecure_ssl": null, "url": "u"}`, &HookConfig{URL: String("u")}},
+		{`{"insecure_ssl": "1"}`, &HookConfig{InsecureSSL: String("1")}},
+		{`{"insecure_ssl": 1, "content_type": "json"}`, &HookConfig{InsecureSSL: String("1"), ContentType: String("json")}},
+	}
+	for _, tt := range tests {
+		got := new(HookConfig)
+		if err := json.Unmarshal([]byte(tt.data), got); err != nil {
+			t.Errorf("Unmarshal(%v) returned error: %v", tt.data, err)
+		}
+		if !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("Unmarshal(%v) = %+v, want %+v", tt.data, got, tt.want)
+		}
+	}
+
+	for _, data := range []string{`{"insecure_ssl": true}`, `{"url": 1}`} {
+		if err := json.Unmarshal([]byte(data), new(HookConfig)); err == nil {
+			t.Errorf("Unmarshal(%v) returned no error", data)
+		}
+	}
+}
@@ -0,0 +1,162 @@
+// Copyright 2021 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+)
+
+// HookDelivery represents a delivery of a webhook, as returned by the
+// ListHookDeliveries and GetHookDelivery methods of the RepositoriesService,
+// the OrganizationsService and the AppsService.
+type HookDelivery struct {
+	ID          *int64     `json:"id,omitempty"`
+	GUID        *string    `json:"guid,omitempty"`
+	DeliveredAt *Timestamp `json:"delivered_at,omitempty"`
+	Redelivery  *bool      `json:"redelivery,omitempty"`
+	// Duration is the time, in seconds, that the delivery took.
+	Duration *float64 `json:"duration,omitempty"`
+	// Status is a description of the result of the delivery, such as "OK",
+	// and StatusCode is the HTTP status code of the response to it, or 0 if
+	// no response was received.
+	Status         *string `json:"status,omitempty"`
+	StatusCode     *int    `json:"status_code,omitempty"`
+	Event          *string `json:"event,omitempty"`
+	Action         *string `json:"action,omitempty"`
+	InstallationID *int64  `json:"installation_id,omitempty"`
+	RepositoryID   *int64  `json:"repository_id,omitempty"`
+
+	// Request and Response are only populated by the Get...HookDelivery methods.
+	Request  *HookRequest  `json:"request,omitempty"`
+	Response *HookResponse `json:"response,omitempty"`
+}
+
+func (d HookDelivery) String() string {
+	return Stringify(d)
+}
+
+// Succeeded reports whether the delivery received a 2xx response.
+func (d *HookDelivery) Succeeded() bool {
+	code := d.GetStatusCode()
+	return code >= 200 && code <= 299
+}
+
+// ParseRequestPayload parses the payload of the request of the delivery.
+// For recognized event types, a value of the corresponding struct type
+// will be returned, as with ParseWebHook.
+func (d *HookDelivery) ParseRequestPayload() (interface{}, error) {
+	if d.Request == nil || d.Request.RawPayload == nil {
+		return nil, errors.New("hook delivery has no request payload")
+	}
+	return ParseWebHook(d.GetEvent(), *d.Request.RawPayload)
+}
+
+// HookRequest is a part of HookDelivery that contains
+// the HTTP headers and the JSON payload of the webhook request.
+type HookRequest struct {
+	Headers    map[string]string `json:"headers,omitempty"`
+	RawPayload *json.RawMessage  `json:"payload,omitempty"`
+}
+
+func (r HookRequest) String() string {
+	return Stringify(r)
+}
+
+// HookResponse is a part of HookDelivery that contains
+// the HTTP headers and the response body served by the webhook endpoint.
+type HookResponse struct {
+	Headers map[string]string `json:"headers,omitempty"`
+	Payload *string           `json:"payload,omitempty"`
+}
+
+func (r HookResponse) String() string {
+	return Stringify(r)
+}
+
+// ListHookDeliveries lists webhook deliveries for a webhook configured in a repository.
+// The deliveries are paginated with cursors: set opts.Cursor to Response.Cursor
+// to get the next page.
+//
+// GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/repos/#list-deliveries-for-a-repository-webhook
+func (s *RepositoriesService) ListHookDeliveries(ctx context.Context, owner, repo string, id int64, opts *ListCursorOptions) ([]*HookDelivery, *Response, error) {
+	u := fmt.Sprintf("repos/%v/%v/hooks/%v/deliveries", owner, repo, id)
+	return listHookDeliveries(ctx, s.client, u, opts)
+}
+
+// GetHookDelivery returns a delivery for a webhook configured in a repository,
+// including its request and response.
+//
+// GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/repos/#get-a-delivery-for-a-repository-webhook
+func (s *RepositoriesService) GetHookDelivery(ctx context.Context, owner, repo string, hookID, deliveryID int64) (*HookDelivery, *Response, error) {
+	u := fmt.Sprintf("repos/%v/%v/hooks/%v/deliveries/%v", owner, repo, hookID, deliveryID)
+	return getHookDelivery(ctx, s.client, u)
+}
+
+// RedeliverHookDelivery redelivers a delivery for a webhook configured in a repository.
+// The redelivery is asynchronous: it is listed by ListHookDeliveries, with
+// Redelivery set, once it has been attempted.
+//
+// GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/repos/#redeliver-a-delivery-for-a-repository-webhook
+func (s *RepositoriesService) RedeliverHookDelivery(ctx context.Context, owner, repo string, hookID, deliveryID int64) (*Response, error) {
+	u := fmt.Sprintf("repos/%v/%v/hooks/%v/deliveries/%v/attempts", owner, repo, hookID, deliveryID)
+	return redeliverHookDelivery(ctx, s.client, u)
+}
+
+// listHookDeliveries lists the webhook deliveries at the URL u.
+func listHookDeliveries(ctx context.Context, client *Client, u string, opts *ListCursorOptions) ([]*HookDelivery, *Response, error) {
+	u, err := addOptions(u, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var deliveries []*HookDelivery
+	resp, err := client.Do(ctx, req, &deliveries)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return deliveries, resp, nil
+}
+
+// getHookDelivery returns the webhook delivery at the URL u.
+func getHookDelivery(ctx context.Context, client *Client, u string) (*HookDelivery, *Response, error) {
+	req, err := client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	h := new(HookDelivery)
+	resp, err := client.Do(ctx, req, h)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return h, resp, nil
+}
+
+// redeliverHookDelivery requests a redelivery of the webhook delivery
+// whose attempts are at the URL u.
+func redeliverHookDelivery(ctx context.Context, client *Client, u string) (*Response, error) {
+	req, err := client.NewRequest("POST", u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := client.Do(ctx, req, nil)
+	// A 202 Accepted is the expected response to the request.
+	if _, ok := err.(*AcceptedError); ok {
+		return resp, nil
+	}
+	return resp, err
+}
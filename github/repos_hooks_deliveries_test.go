@@ -0,0 +1,173 @@
+// Copyright 2021 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+func TestRepositoriesService_ListHookDeliveries(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/hooks/1/deliveries", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testFormValues(t, r, values{"cursor": "v1_12077215967", "per_page": "2"})
+		w.Header().Set("Link", `<https://api.github.com/repos/o/r/hooks/1/deliveries?per_page=2&cursor=v1_12077215965>; rel="next"`)
+		fmt.Fprint(w, `[{"id":1,"status_code":200}, {"id":2,"status_code":502}]`)
+	})
+
+	opts := &ListCursorOptions{Cursor: "v1_12077215967", PerPage: 2}
+
+	ctx := context.Background()
+	deliveries, resp, err := client.Repositories.ListHookDeliveries(ctx, "o", "r", 1, opts)
+	if err != nil {
+		t.Errorf("Repositories.ListHookDeliveries returned error: %v", err)
+	}
+
+	want := []*HookDelivery{{ID: Int64(1), StatusCode: Int(200)}, {ID: Int64(2), StatusCode: Int(502)}}
+	if !reflect.DeepEqual(deliveries, want) {
+		t.Errorf("Repositories.ListHookDeliveries returned %+v, want %+v", deliveries, want)
+	}
+	if resp.Cursor != "v1_12077215965" {
+		t.Errorf("Repositories.ListHookDeliveries returned cursor %q, want %q", resp.Cursor, "v1_12077215965")
+	}
+
+	const methodName = "ListHookDeliveries"
+	testBadOptions(t, methodName, func() (err error) {
+		_, _, err = client.Repositories.ListHookDeliveries(ctx, "\n", "\n", -1, opts)
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.Repositories.ListHookDeliveries(ctx, "o", "r", 1, opts)
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
+
+func TestRepositoriesService_GetHookDelivery(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/hooks/1/deliveries/2", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{
+			"id": 2,
+			"event": "issues",
+			"action": "opened",
+			"request": {"headers": {"X-GitHub-Event": "issues"}, "payload": {"action": "opened"}},
+			"response": {"headers": {"Content-Type": "text/plain"}, "payload": "ok"}
+		}`)
+	})
+
+	ctx := context.Background()
+	delivery, _, err := client.Repositories.GetHookDelivery(ctx, "o", "r", 1, 2)
+	if err != nil {
+		t.Errorf("Repositories.GetHookDelivery returned error: %v", err)
+	}
+
+	payload := json.RawMessage(`{"action": "opened"}`)
+	want := &HookDelivery{
+		ID:     Int64(2),
+		Event:  String("issues"),
+		Action: String("opened"),
+		Request: &HookRequest{
+			Headers:    map[string]string{"X-GitHub-Event": "issues"},
+			RawPayload: &payload,
+		},
+		Response: &HookResponse{
+			Headers: map[string]string{"Content-Type": "text/plain"},
+			Payload: String("ok"),
+		},
+	}
+	if !reflect.DeepEqual(delivery, want) {
+		t.Errorf("Repositories.GetHookDelivery returned %+v, want %+v", delivery, want)
+	}
+
+	const methodName = "GetHookDelivery"
+	testBadOptions(t, methodName, func() (err error) {
+		_, _, err = client.Repositories.GetHookDelivery(ctx, "\n", "\n", -1, -1)
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.Repositories.GetHookDelivery(ctx, "o", "r", 1, 2)
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
+
+func TestRepositoriesService_RedeliverHookDelivery(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/hooks/1/deliveries/2/attempts", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		w.WriteHeader(http.StatusAccepted)
+		fmt.Fprint(w, `{}`)
+	})
+
+	ctx := context.Background()
+	_, err := client.Repositories.RedeliverHookDelivery(ctx, "o", "r", 1, 2)
+	if err != nil {
+		t.Errorf("Repositories.RedeliverHookDelivery returned error: %v", err)
+	}
+
+	const methodName = "RedeliverHookDelivery"
+	testBadOptions(t, methodName, func() (err error) {
+		_, err = client.Repositories.RedeliverHookDelivery(ctx, "\n", "\n", -1, -1)
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		return client.Repositories.RedeliverHookDelivery(ctx, "o", "r", 1, 2)
+	})
+}
+
+func TestHookDelivery_Succeeded(t *testing.T) {
+	for _, tt := range []struct {
+		code *int
+		want bool
+	}{
+		{nil, false},
+		{Int(200), true},
+		{Int(204), true},
+		{Int(302), false},
+		{Int(500), false},
+	} {
+		if got := (&HookDelivery{StatusCode: tt.code}).Succeeded(); got != tt.want {
+			t.Errorf("Succeeded with status code %v returned %v, want %v", tt.code, got, tt.want)
+		}
+	}
+}
+
+func TestHookDelivery_ParseRequestPayload(t *testing.T) {
+	payload := json.RawMessage(`{"action": "opened", "issue": {"number": 1}}`)
+	d := &HookDelivery{Event: String("issues"), Request: &HookRequest{RawPayload: &payload}}
+
+	got, err := d.ParseRequestPayload()
+	if err != nil {
+		t.Fatalf("ParseRequestPayload returned error: %v", err)
+	}
+	want := &IssuesEvent{Action: String("opened"), Issue: &Issue{Number: Int(1)}}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("ParseRequestPayload returned %+v, want %+v", got, want)
+	}
+
+	if _, err := (&HookDelivery{Event: String("issues")}).ParseRequestPayload(); err == nil {
+		t.Errorf("ParseRequestPayload without request returned no error")
+	}
+}
@@ -59,6 +59,37 @@ func TestRepositoriesService_CreateHook(t *testing.T) {
 	})
 }
 
+func TestRepositoriesService_CreateHook_config(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	input := &Hook{
+		Config: &HookConfig{
+			ContentType: String(string(HookContentTypeJSON)),
+			InsecureSSL: String(string(HookVerifySSL)),
+			Secret:      String("s"),
+			URL:         String("https://example.com/webhook"),
+		},
+		Events: []string{"push"},
+	}
+
+	mux.HandleFunc("/repos/o/r/hooks", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testBody(t, r, `{"name":"web","config":{"content_type":"json","insecure_ssl":"0","url":"https://example.com/webhook","secret":"s"},"events":["push"]}`+"\n")
+		fmt.Fprint(w, `{"id":1,"config":{"content_type":"json","insecure_ssl":"0","url":"https://example.com/webhook","secret":"********"}}`)
+	})
+
+	ctx := context.Background()
+	hook, _, err := client.Repositories.CreateHook(ctx, "o", "r", input)
+	if err != nil {
+		t.Errorf("Repositories.CreateHook returned error: %v", err)
+	}
+
+	if got, want := hook.GetConfig().GetSecret(), "********"; got != want {
+		t.Errorf("Repositories.CreateHook returned secret %q, want %q", got, want)
+	}
+}
+
 func TestRepositoriesService_ListHooks(t *testing.T) {
 	client, mux, _, teardown := setup()
 	defer teardown()
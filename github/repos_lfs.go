@@ -0,0 +1,215 @@
+// Copyright 2021 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strings"
+)
+
+const mediaTypeGitLFS = "application/vnd.git-lfs+json"
+
+// LFSLock represents a Git LFS file lock.
+type LFSLock struct {
+	ID       *string       `json:"id,omitempty"`
+	Path     *string       `json:"path,omitempty"`
+	LockedAt *Timestamp    `json:"locked_at,omitempty"`
+	Owner    *LFSLockOwner `json:"owner,omitempty"`
+}
+
+func (l LFSLock) String() string {
+	return Stringify(l)
+}
+
+// LFSLockOwner represents the owner of an LFSLock.
+type LFSLockOwner struct {
+	Name *string `json:"name,omitempty"`
+}
+
+// LFSLocks represents a page of Git LFS file locks.
+type LFSLocks struct {
+	Locks []*LFSLock `json:"locks,omitempty"`
+	// NextCursor is the cursor of the next page, if any.
+	NextCursor *string `json:"next_cursor,omitempty"`
+}
+
+// LFSLocksVerification represents a page of the Git LFS file locks of a
+// ref, split between the locks of the authenticated user and those of
+// other users.
+type LFSLocksVerification struct {
+	Ours   []*LFSLock `json:"ours,omitempty"`
+	Theirs []*LFSLock `json:"theirs,omitempty"`
+	// NextCursor is the cursor of the next page, if any.
+	NextCursor *string `json:"next_cursor,omitempty"`
+}
+
+// LFSLockListOptions specifies the optional parameters to the
+// RepositoriesService.ListLFSLocks method.
+type LFSLockListOptions struct {
+	// Path returns the lock of a single file.
+	Path string `url:"path,omitempty"`
+	// ID returns a single lock.
+	ID string `url:"id,omitempty"`
+	// Refspec returns the locks of a ref, such as "refs/heads/main".
+	Refspec string `url:"refspec,omitempty"`
+
+	// Cursor is the NextCursor of the previous page.
+	Cursor string `url:"cursor,omitempty"`
+	// Limit is the maximum number of locks returned.
+	Limit int `url:"limit,omitempty"`
+}
+
+// LFSLockVerifyOptions specifies the optional parameters to the
+// RepositoriesService.VerifyLFSLocks method.
+type LFSLockVerifyOptions struct {
+	// Ref restricts the locks to those of a ref, such as "refs/heads/main".
+	Ref string
+	// Cursor is the NextCursor of the previous page.
+	Cursor string
+	// Limit is the maximum number of locks returned.
+	Limit int
+}
+
+// lfsRef represents a ref in Git LFS lock requests.
+type lfsRef struct {
+	Name string `json:"name"`
+}
+
+// lfsLockRequest represents the body of the Git LFS lock requests.
+type lfsLockRequest struct {
+	Path   string  `json:"path,omitempty"`
+	Force  bool    `json:"force,omitempty"`
+	Cursor string  `json:"cursor,omitempty"`
+	Limit  int     `json:"limit,omitempty"`
+	Ref    *lfsRef `json:"ref,omitempty"`
+}
+
+func newLFSRef(ref string) *lfsRef {
+	if ref == "" {
+		return nil
+	}
+	return &lfsRef{Name: ref}
+}
+
+// lfsLockResponse represents the response of the Git LFS requests on a
+// single lock.
+type lfsLockResponse struct {
+	Lock *LFSLock `json:"lock"`
+}
+
+// lfsLocksURL returns the URL of the Git LFS locks API of a repository,
+// followed by path. This API is served by the Git server rather than by the
+// REST API: https://github.com/ for the default BaseURL, or the host of
+// GitHub Enterprise Server for a BaseURL ending with "/api/v3/". Other
+// BaseURLs are used as the Git server.
+func (s *RepositoriesService) lfsLocksURL(owner, repo, path string) string {
+	u := fmt.Sprintf("%v/%v.git/info/lfs/locks%v", owner, repo, path)
+
+	base := *s.client.BaseURL
+	switch {
+	case base.Host == "api.github.com":
+		base.Host = "github.com"
+		base.Path = "/"
+	case strings.HasSuffix(base.Path, "/api/v3/"):
+		base.Path = strings.TrimSuffix(base.Path, "api/v3/")
+	default:
+		return u
+	}
+	return base.String() + u
+}
+
+// sendLFSLockRequest sends a Git LFS lock request and decodes its response
+// into v.
+func (s *RepositoriesService) sendLFSLockRequest(ctx context.Context, method, u string, body, v interface{}) (*Response, error) {
+	req, err := s.client.NewRequest(method, u, body)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Accept", mediaTypeGitLFS)
+	if body != nil {
+		req.Header.Set("Content-Type", mediaTypeGitLFS)
+	}
+
+	return s.client.Do(ctx, req, v)
+}
+
+// CreateLFSLock locks the file at path in a repository, so that other
+// users cannot push changes to it. ref, such as "refs/heads/main", is the
+// ref the lock applies to, or the empty string for all refs.
+//
+// Git LFS API docs: https://github.com/git-lfs/git-lfs/blob/main/docs/api/locking.md#create-lock
+func (s *RepositoriesService) CreateLFSLock(ctx context.Context, owner, repo, path, ref string) (*LFSLock, *Response, error) {
+	u := s.lfsLocksURL(owner, repo, "")
+	body := &lfsLockRequest{Path: path, Ref: newLFSRef(ref)}
+
+	lock := new(lfsLockResponse)
+	resp, err := s.sendLFSLockRequest(ctx, "POST", u, body, lock)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return lock.Lock, resp, nil
+}
+
+// ListLFSLocks lists the Git LFS file locks of a repository.
+//
+// Git LFS API docs: https://github.com/git-lfs/git-lfs/blob/main/docs/api/locking.md#list-locks
+func (s *RepositoriesService) ListLFSLocks(ctx context.Context, owner, repo string, opts *LFSLockListOptions) (*LFSLocks, *Response, error) {
+	u, err := addOptions(s.lfsLocksURL(owner, repo, ""), opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	locks := new(LFSLocks)
+	resp, err := s.sendLFSLockRequest(ctx, "GET", u, nil, locks)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return locks, resp, nil
+}
+
+// VerifyLFSLocks lists the Git LFS file locks of a repository, split
+// between the locks of the authenticated user and those of other users, as
+// done before pushing to check that the pushed files are not locked by
+// others.
+//
+// Git LFS API docs: https://github.com/git-lfs/git-lfs/blob/main/docs/api/locking.md#list-locks-for-verification
+func (s *RepositoriesService) VerifyLFSLocks(ctx context.Context, owner, repo string, opts *LFSLockVerifyOptions) (*LFSLocksVerification, *Response, error) {
+	u := s.lfsLocksURL(owner, repo, "/verify")
+	body := &lfsLockRequest{}
+	if opts != nil {
+		body = &lfsLockRequest{Cursor: opts.Cursor, Limit: opts.Limit, Ref: newLFSRef(opts.Ref)}
+	}
+
+	locks := new(LFSLocksVerification)
+	resp, err := s.sendLFSLockRequest(ctx, "POST", u, body, locks)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return locks, resp, nil
+}
+
+// UnlockLFSLock deletes the Git LFS file lock with the given ID. force must
+// be set to delete the lock of another user, which requires admin
+// permissions. ref is the ref the lock applies to, or the empty string.
+//
+// Git LFS API docs: https://github.com/git-lfs/git-lfs/blob/main/docs/api/locking.md#delete-lock
+func (s *RepositoriesService) UnlockLFSLock(ctx context.Context, owner, repo, id string, force bool, ref string) (*LFSLock, *Response, error) {
+	u := s.lfsLocksURL(owner, repo, fmt.Sprintf("/%v/unlock", url.PathEscape(id)))
+	body := &lfsLockRequest{Force: force, Ref: newLFSRef(ref)}
+
+	lock := new(lfsLockResponse)
+	resp, err := s.sendLFSLockRequest(ctx, "POST", u, body, lock)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return lock.Lock, resp, nil
+}
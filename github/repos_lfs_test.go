@@ -0,0 +1,202 @@
+// Copyright 2021 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"reflect"
+	"testing"
+)
+
+func TestRepositoriesService_CreateLFSLock(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/o/r.git/info/lfs/locks", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testHeader(t, r, "Accept", mediaTypeGitLFS)
+		testHeader(t, r, "Content-Type", mediaTypeGitLFS)
+		testBody(t, r, `{"path":"a.bin","ref":{"name":"refs/heads/main"}}`+"\n")
+		fmt.Fprint(w, `{"lock":{"id":"1","path":"a.bin","locked_at":`+referenceTimeStr+`,"owner":{"name":"u"}}}`)
+	})
+
+	ctx := context.Background()
+	lock, _, err := client.Repositories.CreateLFSLock(ctx, "o", "r", "a.bin", "refs/heads/main")
+	if err != nil {
+		t.Errorf("Repositories.CreateLFSLock returned error: %v", err)
+	}
+	want := &LFSLock{
+		ID:       String("1"),
+		Path:     String("a.bin"),
+		LockedAt: &Timestamp{referenceTime},
+		Owner:    &LFSLockOwner{Name: String("u")},
+	}
+	if !reflect.DeepEqual(lock, want) {
+		t.Errorf("Repositories.CreateLFSLock returned %+v, want %+v", lock, want)
+	}
+
+	const methodName = "CreateLFSLock"
+	testBadOptions(t, methodName, func() (err error) {
+		_, _, err = client.Repositories.CreateLFSLock(ctx, "\n", "\n", "a.bin", "")
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.Repositories.CreateLFSLock(ctx, "o", "r", "a.bin", "")
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
+
+func TestRepositoriesService_CreateLFSLock_conflict(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/o/r.git/info/lfs/locks", func(w http.ResponseWriter, r *http.Request) {
+		testBody(t, r, `{"path":"a.bin"}`+"\n")
+		w.WriteHeader(http.StatusConflict)
+		fmt.Fprint(w, `{"lock":{"id":"1"},"message":"already created lock"}`)
+	})
+
+	_, resp, err := client.Repositories.CreateLFSLock(context.Background(), "o", "r", "a.bin", "")
+	if e, ok := err.(*ErrorResponse); !ok || e.Message != "already created lock" {
+		t.Errorf("Repositories.CreateLFSLock returned error %v, want conflict", err)
+	}
+	if resp == nil || resp.StatusCode != http.StatusConflict {
+		t.Errorf("Repositories.CreateLFSLock returned response %v, want %v status", resp, http.StatusConflict)
+	}
+}
+
+func TestRepositoriesService_ListLFSLocks(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/o/r.git/info/lfs/locks", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testHeader(t, r, "Accept", mediaTypeGitLFS)
+		testFormValues(t, r, values{"path": "a.bin", "cursor": "c", "limit": "10"})
+		fmt.Fprint(w, `{"locks":[{"id":"1"}],"next_cursor":"d"}`)
+	})
+
+	ctx := context.Background()
+	opts := &LFSLockListOptions{Path: "a.bin", Cursor: "c", Limit: 10}
+	locks, _, err := client.Repositories.ListLFSLocks(ctx, "o", "r", opts)
+	if err != nil {
+		t.Errorf("Repositories.ListLFSLocks returned error: %v", err)
+	}
+	want := &LFSLocks{Locks: []*LFSLock{{ID: String("1")}}, NextCursor: String("d")}
+	if !reflect.DeepEqual(locks, want) {
+		t.Errorf("Repositories.ListLFSLocks returned %+v, want %+v", locks, want)
+	}
+
+	const methodName = "ListLFSLocks"
+	testBadOptions(t, methodName, func() (err error) {
+		_, _, err = client.Repositories.ListLFSLocks(ctx, "\n", "\n", opts)
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.Repositories.ListLFSLocks(ctx, "o", "r", opts)
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
+
+func TestRepositoriesService_VerifyLFSLocks(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/o/r.git/info/lfs/locks/verify", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testBody(t, r, `{"cursor":"c","limit":5,"ref":{"name":"refs/heads/main"}}`+"\n")
+		fmt.Fprint(w, `{"ours":[{"id":"1"}],"theirs":[{"id":"2"}]}`)
+	})
+
+	ctx := context.Background()
+	opts := &LFSLockVerifyOptions{Ref: "refs/heads/main", Cursor: "c", Limit: 5}
+	locks, _, err := client.Repositories.VerifyLFSLocks(ctx, "o", "r", opts)
+	if err != nil {
+		t.Errorf("Repositories.VerifyLFSLocks returned error: %v", err)
+	}
+	want := &LFSLocksVerification{Ours: []*LFSLock{{ID: String("1")}}, Theirs: []*LFSLock{{ID: String("2")}}}
+	if !reflect.DeepEqual(locks, want) {
+		t.Errorf("Repositories.VerifyLFSLocks returned %+v, want %+v", locks, want)
+	}
+
+	const methodName = "VerifyLFSLocks"
+	testBadOptions(t, methodName, func() (err error) {
+		_, _, err = client.Repositories.VerifyLFSLocks(ctx, "\n", "\n", nil)
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.Repositories.VerifyLFSLocks(ctx, "o", "r", nil)
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
+
+func TestRepositoriesService_UnlockLFSLock(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/o/r.git/info/lfs/locks/1/unlock", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testBody(t, r, `{"force":true}`+"\n")
+		fmt.Fprint(w, `{"lock":{"id":"1","path":"a.bin"}}`)
+	})
+
+	ctx := context.Background()
+	lock, _, err := client.Repositories.UnlockLFSLock(ctx, "o", "r", "1", true, "")
+	if err != nil {
+		t.Errorf("Repositories.UnlockLFSLock returned error: %v", err)
+	}
+	want := &LFSLock{ID: String("1"), Path: String("a.bin")}
+	if !reflect.DeepEqual(lock, want) {
+		t.Errorf("Repositories.UnlockLFSLock returned %+v, want %+v", lock, want)
+	}
+
+	const methodName = "UnlockLFSLock"
+	testBadOptions(t, methodName, func() (err error) {
+		_, _, err = client.Repositories.UnlockLFSLock(ctx, "\n", "\n", "\n", false, "")
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.Repositories.UnlockLFSLock(ctx, "o", "r", "1", true, "")
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
+
+func TestRepositoriesService_lfsLocksURL(t *testing.T) {
+	tests := []struct {
+		baseURL string
+		want    string
+	}{
+		{"https://api.github.com/", "https://github.com/o/r.git/info/lfs/locks/verify"},
+		{"https://ghe.example.com/api/v3/", "https://ghe.example.com/o/r.git/info/lfs/locks/verify"},
+		{"https://git.example.com/", "o/r.git/info/lfs/locks/verify"},
+	}
+	for _, tt := range tests {
+		client := NewClient(nil)
+		client.BaseURL, _ = url.Parse(tt.baseURL)
+		if got := client.Repositories.lfsLocksURL("o", "r", "/verify"); got != tt.want {
+			t.Errorf("lfsLocksURL with base URL %v = %v, want %v", tt.baseURL, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,51 @@
+// Copyright 2021 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"fmt"
+)
+
+// GetAllCustomPropertyValues gets all custom property values that are set for a repository.
+//
+// GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/repos/#get-all-custom-property-values-for-a-repository
+func (s *RepositoriesService) GetAllCustomPropertyValues(ctx context.Context, owner, repo string) ([]*CustomPropertyValue, *Response, error) {
+	u := fmt.Sprintf("repos/%v/%v/properties/values", owner, repo)
+
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var customPropertyValues []*CustomPropertyValue
+	resp, err := s.client.Do(ctx, req, &customPropertyValues)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return customPropertyValues, resp, nil
+}
+
+// CreateOrUpdateCustomProperties creates new or updates existing custom property values for a repository.
+//
+// GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/repos/#create-or-update-custom-property-values-for-a-repository
+func (s *RepositoriesService) CreateOrUpdateCustomProperties(ctx context.Context, owner, repo string, customPropertyValues []*CustomPropertyValue) (*Response, error) {
+	u := fmt.Sprintf("repos/%v/%v/properties/values", owner, repo)
+
+	params := struct {
+		Properties []*CustomPropertyValue `json:"properties"`
+	}{
+		Properties: customPropertyValues,
+	}
+
+	req, err := s.client.NewRequest("PATCH", u, params)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(ctx, req, nil)
+}
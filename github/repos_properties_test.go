@@ -0,0 +1,107 @@
+// Copyright 2021 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+func TestRepositoriesService_GetAllCustomPropertyValues(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/properties/values", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `[
+		{
+			"property_name": "environment",
+			"value": "production"
+		},
+		{
+			"property_name": "service",
+			"value": "web"
+		},
+		{
+			"property_name": "team",
+			"value": null
+		}
+		]`)
+	})
+
+	ctx := context.Background()
+	customPropertyValues, _, err := client.Repositories.GetAllCustomPropertyValues(ctx, "o", "r")
+	if err != nil {
+		t.Errorf("Repositories.GetAllCustomPropertyValues returned error: %v", err)
+	}
+
+	want := []*CustomPropertyValue{
+		{
+			PropertyName: "environment",
+			Value:        String("production"),
+		},
+		{
+			PropertyName: "service",
+			Value:        String("web"),
+		},
+		{
+			PropertyName: "team",
+		},
+	}
+	if !reflect.DeepEqual(customPropertyValues, want) {
+		t.Errorf("Repositories.GetAllCustomPropertyValues returned %+v, want %+v", customPropertyValues, want)
+	}
+
+	const methodName = "GetAllCustomPropertyValues"
+	testBadOptions(t, methodName, func() (err error) {
+		_, _, err = client.Repositories.GetAllCustomPropertyValues(ctx, "\n", "\n")
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.Repositories.GetAllCustomPropertyValues(ctx, "o", "r")
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
+
+func TestRepositoriesService_CreateOrUpdateCustomProperties(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/usr/r/properties/values", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PATCH")
+		testBody(t, r, `{"properties":[{"property_name":"environment","value":"production"}]}`+"\n")
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	ctx := context.Background()
+	repoCustomProperty := []*CustomPropertyValue{
+		{
+			PropertyName: "environment",
+			Value:        String("production"),
+		},
+	}
+	_, err := client.Repositories.CreateOrUpdateCustomProperties(ctx, "usr", "r", repoCustomProperty)
+	if err != nil {
+		t.Errorf("Repositories.CreateOrUpdateCustomProperties returned error: %v", err)
+	}
+
+	const methodName = "CreateOrUpdateCustomProperties"
+	testBadOptions(t, methodName, func() (err error) {
+		_, err = client.Repositories.CreateOrUpdateCustomProperties(ctx, "\n", "\n", repoCustomProperty)
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		return client.Repositories.CreateOrUpdateCustomProperties(ctx, "usr", "r", repoCustomProperty)
+	})
+}
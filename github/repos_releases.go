@@ -6,15 +6,20 @@
 package github
 
 import (
+	"bytes"
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
 	"errors"
 	"fmt"
 	"io"
+	"io/ioutil"
 	"mime"
 	"net/http"
 	"os"
 	"path/filepath"
 	"strings"
+	"time"
 )
 
 // RepositoryRelease represents a GitHub release in a repository.
@@ -25,6 +30,10 @@ type RepositoryRelease struct {
 	Body            *string `json:"body,omitempty"`
 	Draft           *bool   `json:"draft,omitempty"`
 	Prerelease      *bool   `json:"prerelease,omitempty"`
+	// DiscussionCategoryName is the name of the discussion category in
+	// which a discussion announcing the release is created. It is only used
+	// when the release is published, and the category must exist.
+	DiscussionCategoryName *string `json:"discussion_category_name,omitempty"`
 
 	// The following fields are not used in CreateRelease or EditRelease:
 	ID          *int64          `json:"id,omitempty"`
@@ -39,6 +48,9 @@ type RepositoryRelease struct {
 	TarballURL  *string         `json:"tarball_url,omitempty"`
 	Author      *User           `json:"author,omitempty"`
 	NodeID      *string         `json:"node_id,omitempty"`
+	// DiscussionURL is the URL of the discussion announcing the release, if
+	// any.
+	DiscussionURL *string `json:"discussion_url,omitempty"`
 }
 
 func (r RepositoryRelease) String() string {
@@ -140,6 +152,8 @@ type repositoryReleaseRequest struct {
 	Body            *string `json:"body,omitempty"`
 	Draft           *bool   `json:"draft,omitempty"`
 	Prerelease      *bool   `json:"prerelease,omitempty"`
+
+	DiscussionCategoryName *string `json:"discussion_category_name,omitempty"`
 }
 
 // CreateRelease adds a new release for a repository.
@@ -158,6 +172,8 @@ func (s *RepositoriesService) CreateRelease(ctx context.Context, owner, repo str
 		Body:            release.Body,
 		Draft:           release.Draft,
 		Prerelease:      release.Prerelease,
+
+		DiscussionCategoryName: release.DiscussionCategoryName,
 	}
 
 	req, err := s.client.NewRequest("POST", u, releaseReq)
@@ -189,6 +205,8 @@ func (s *RepositoriesService) EditRelease(ctx context.Context, owner, repo strin
 		Body:            release.Body,
 		Draft:           release.Draft,
 		Prerelease:      release.Prerelease,
+
+		DiscussionCategoryName: release.DiscussionCategoryName,
 	}
 
 	req, err := s.client.NewRequest("PATCH", u, releaseReq)
@@ -217,6 +235,54 @@ func (s *RepositoriesService) DeleteRelease(ctx context.Context, owner, repo str
 	return s.client.Do(ctx, req, nil)
 }
 
+// GenerateNotesOptions represents the options to generate release notes.
+type GenerateNotesOptions struct {
+	// TagName is the tag of the release to generate notes for. It is
+	// required, and can be an existing tag or a new one.
+	TagName string `json:"tag_name"`
+
+	// PreviousTagName is the tag to use as the starting point of the notes.
+	// If nil, the previous release is used.
+	PreviousTagName *string `json:"previous_tag_name,omitempty"`
+
+	// TargetCommitish is the commitish the tag is created from, when
+	// TagName does not exist yet. If nil, the default branch is used.
+	TargetCommitish *string `json:"target_commitish,omitempty"`
+
+	// ConfigurationFilePath is the path of the file in the repository that
+	// configures how the notes are generated. If nil,
+	// ".github/release.yml" or ".github/release.yaml" is used if it exists.
+	ConfigurationFilePath *string `json:"configuration_file_path,omitempty"`
+}
+
+// RepositoryReleaseNotes represents generated release notes, which can be
+// used as the name and body of a release.
+type RepositoryReleaseNotes struct {
+	Name string `json:"name"`
+	Body string `json:"body"`
+}
+
+// GenerateReleaseNotes generates the name and body of the notes of a
+// release, without creating the release, so that they can be previewed or
+// edited before publishing.
+//
+// GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/repos/#generate-release-notes-content-for-a-release
+func (s *RepositoriesService) GenerateReleaseNotes(ctx context.Context, owner, repo string, opts *GenerateNotesOptions) (*RepositoryReleaseNotes, *Response, error) {
+	u := fmt.Sprintf("repos/%v/%v/releases/generate-notes", owner, repo)
+	req, err := s.client.NewRequest("POST", u, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	r := new(RepositoryReleaseNotes)
+	resp, err := s.client.Do(ctx, req, r)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return r, resp, nil
+}
+
 // ListReleaseAssets lists the release's assets.
 //
 // GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/repos/#list-release-assets
@@ -331,6 +397,143 @@ func (s *RepositoriesService) downloadReleaseAssetFromURL(ctx context.Context, f
 	return resp.Body, nil
 }
 
+// DownloadReleaseAssetOptions specifies the optional parameters to the
+// RepositoriesService.DownloadReleaseAssetStream method.
+type DownloadReleaseAssetOptions struct {
+	// FollowRedirectsClient is used to download the asset from the location
+	// GitHub redirects to, usually a storage service. GitHub credentials are
+	// not sent there. If nil, http.DefaultClient is used.
+	FollowRedirectsClient *http.Client
+
+	// Resume holds the part of the asset that was already downloaded, such
+	// as a partially written file opened for reading. Only the rest of the
+	// asset is then downloaded, with a Range request, and the content of
+	// Resume is included in the SHA256 verification.
+	Resume io.Reader
+
+	// SHA256, if not empty, is the expected hex-encoded SHA-256 checksum of
+	// the asset. The download fails with a *ReleaseAssetChecksumError if
+	// the checksum of the asset differs.
+	SHA256 string
+}
+
+// ReleaseAssetChecksumError is returned by DownloadReleaseAssetStream when
+// the checksum of the downloaded asset is not the expected one.
+type ReleaseAssetChecksumError struct {
+	Want string // expected hex-encoded SHA-256 checksum
+	Got  string // actual hex-encoded SHA-256 checksum
+}
+
+func (e *ReleaseAssetChecksumError) Error() string {
+	return fmt.Sprintf("release asset checksum mismatch: got sha256 %v, want %v", e.Got, e.Want)
+}
+
+// DownloadReleaseAssetStream downloads a release asset and writes it to w,
+// following the redirect to where the asset is stored. It returns the
+// number of bytes written to w, which excludes the content of opts.Resume.
+//
+// Downloads can be resumed by passing the already downloaded content as
+// opts.Resume, in which case w should append to it. If opts.SHA256 is set,
+// the checksum of the whole asset is verified once it is written, so w must
+// not be trusted until DownloadReleaseAssetStream returns a nil error.
+//
+// GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/repos/#get-a-release-asset
+func (s *RepositoriesService) DownloadReleaseAssetStream(ctx context.Context, owner, repo string, id int64, w io.Writer, opts *DownloadReleaseAssetOptions) (int64, error) {
+	if opts == nil {
+		opts = &DownloadReleaseAssetOptions{}
+	}
+
+	h := sha256.New()
+	var offset int64
+	if opts.Resume != nil {
+		n, err := io.Copy(h, opts.Resume)
+		if err != nil {
+			return 0, err
+		}
+		offset = n
+	}
+
+	u := fmt.Sprintf("repos/%s/%s/releases/assets/%d", owner, repo, id)
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return 0, err
+	}
+	req.Header.Set("Accept", defaultMediaType)
+	if offset > 0 {
+		req.Header.Set("Range", fmt.Sprintf("bytes=%d-", offset))
+	}
+
+	resp, err := s.doReleaseAssetRequest(ctx, req, opts.FollowRedirectsClient)
+	if err != nil {
+		return 0, err
+	}
+	defer resp.Body.Close()
+
+	var n int64
+	switch {
+	case resp.StatusCode == http.StatusRequestedRangeNotSatisfiable && offset > 0:
+		// The asset was already completely downloaded.
+	case resp.StatusCode == http.StatusPartialContent:
+		n, err = io.Copy(io.MultiWriter(w, h), resp.Body)
+	default:
+		if err := CheckResponse(resp); err != nil {
+			return 0, err
+		}
+		// The range was ignored, so skip the part already downloaded.
+		if _, err := io.CopyN(ioutil.Discard, resp.Body, offset); err != nil {
+			return 0, err
+		}
+		n, err = io.Copy(io.MultiWriter(w, h), resp.Body)
+	}
+	if err != nil {
+		return n, err
+	}
+
+	if opts.SHA256 != "" {
+		if got := hex.EncodeToString(h.Sum(nil)); !strings.EqualFold(got, opts.SHA256) {
+			return n, &ReleaseAssetChecksumError{Want: opts.SHA256, Got: got}
+		}
+	}
+	return n, nil
+}
+
+// doReleaseAssetRequest sends req, a request for a release asset, and
+// follows the redirect to where the asset is stored with
+// followRedirectsClient, with the same Accept and Range headers. Unlike
+// Client.Do, it does not check the status of the response.
+func (s *RepositoriesService) doReleaseAssetRequest(ctx context.Context, req *http.Request, followRedirectsClient *http.Client) (*http.Response, error) {
+	s.client.clientMu.Lock()
+	var loc string
+	saveRedirect := s.client.client.CheckRedirect
+	s.client.client.CheckRedirect = func(req *http.Request, via []*http.Request) error {
+		loc = req.URL.String()
+		return errors.New("disable redirect")
+	}
+	resp, err := s.client.client.Do(withContext(ctx, req))
+	s.client.client.CheckRedirect = saveRedirect
+	s.client.clientMu.Unlock()
+
+	if err == nil {
+		return resp, nil
+	}
+	if !strings.Contains(err.Error(), "disable redirect") {
+		return nil, err
+	}
+
+	if followRedirectsClient == nil {
+		followRedirectsClient = http.DefaultClient
+	}
+	redirected, err := http.NewRequest("GET", loc, nil)
+	if err != nil {
+		return nil, err
+	}
+	redirected.Header.Set("Accept", "*/*")
+	if r := req.Header.Get("Range"); r != "" {
+		redirected.Header.Set("Range", r)
+	}
+	return followRedirectsClient.Do(withContext(ctx, redirected))
+}
+
 // EditReleaseAsset edits a repository release asset.
 //
 // GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/repos/#update-a-release-asset
@@ -399,3 +602,196 @@ func (s *RepositoriesService) UploadReleaseAsset(ctx context.Context, owner, rep
 	}
 	return asset, resp, nil
 }
+
+// ReleaseAssetUploadOptions specifies the parameters to the
+// RepositoriesService.UploadReleaseAssetFromReader method.
+type ReleaseAssetUploadOptions struct {
+	// Name is the file name of the asset. It is required.
+	Name string
+
+	// Label is an optional short description of the asset, shown instead of
+	// its name on the release page.
+	Label string
+
+	// MediaType is the content type of the asset. If empty, it is detected
+	// from the extension of Name, or else from the content of the asset.
+	MediaType string
+
+	// Replace deletes an asset with the same name before the upload, instead
+	// of failing the upload.
+	Replace bool
+
+	// MaxRetries is the number of times a failed upload is retried, when it
+	// fails with a network error or a server error. Default is no retries.
+	MaxRetries int
+
+	// RetryDelay is the delay before the first retry, which is doubled for
+	// each of the following ones. Default is one second.
+	RetryDelay time.Duration
+
+	// Progress, if not nil, is called as the asset is uploaded, with the
+	// number of bytes sent so far in the current attempt and the size of
+	// the asset.
+	Progress func(sent, size int64)
+}
+
+// UploadReleaseAssetFromReader creates an asset by uploading the content read
+// from r to a release, as UploadReleaseAsset does, with retries and progress
+// reporting.
+//
+// GitHub only accepts an asset in a single request, so a failed upload is
+// retried from the start. If r is an io.ReadSeeker, it is rewound to its
+// current offset for each attempt; otherwise its content is first read into
+// memory. A failed upload can leave a partial asset in the "starter" state
+// behind, which is deleted before the upload is retried.
+//
+// GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/repos/#upload-a-release-asset
+func (s *RepositoriesService) UploadReleaseAssetFromReader(ctx context.Context, owner, repo string, id int64, r io.Reader, opts *ReleaseAssetUploadOptions) (*ReleaseAsset, *Response, error) {
+	if opts == nil || opts.Name == "" {
+		return nil, nil, errors.New("the asset name must be provided")
+	}
+
+	rs, ok := r.(io.ReadSeeker)
+	if !ok {
+		b, err := ioutil.ReadAll(r)
+		if err != nil {
+			return nil, nil, err
+		}
+		rs = bytes.NewReader(b)
+	}
+	start, err := rs.Seek(0, io.SeekCurrent)
+	if err != nil {
+		return nil, nil, err
+	}
+	end, err := rs.Seek(0, io.SeekEnd)
+	if err != nil {
+		return nil, nil, err
+	}
+	size := end - start
+
+	mediaType := opts.MediaType
+	if mediaType == "" {
+		mediaType = mime.TypeByExtension(filepath.Ext(opts.Name))
+	}
+	if mediaType == "" {
+		if _, err := rs.Seek(start, io.SeekStart); err != nil {
+			return nil, nil, err
+		}
+		head := make([]byte, 512)
+		n, err := io.ReadFull(rs, head)
+		if err != nil && err != io.ErrUnexpectedEOF && err != io.EOF {
+			return nil, nil, err
+		}
+		mediaType = http.DetectContentType(head[:n])
+	}
+
+	if opts.Replace {
+		if resp, err := s.deleteReleaseAssetNamed(ctx, owner, repo, id, opts.Name, ""); err != nil {
+			return nil, resp, err
+		}
+	}
+
+	u := fmt.Sprintf("repos/%s/%s/releases/%d/assets", owner, repo, id)
+	u, err = addOptions(u, &UploadOptions{Name: opts.Name, Label: opts.Label})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	delay := opts.RetryDelay
+	if delay <= 0 {
+		delay = time.Second
+	}
+	for attempt := 0; ; attempt++ {
+		if _, err := rs.Seek(start, io.SeekStart); err != nil {
+			return nil, nil, err
+		}
+		var body io.Reader = io.LimitReader(rs, size)
+		if opts.Progress != nil {
+			body = &progressReader{r: body, size: size, progress: opts.Progress}
+		}
+		if size == 0 {
+			body = http.NoBody
+		}
+
+		req, err := s.client.NewUploadRequest(u, body, size, mediaType)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		asset := new(ReleaseAsset)
+		resp, err := s.client.Do(ctx, req, asset)
+		if err == nil {
+			return asset, resp, nil
+		}
+		if attempt >= opts.MaxRetries || !isRetryableUploadError(ctx, err) {
+			return nil, resp, err
+		}
+
+		if err := sleepUntil(ctx, time.Now().Add(delay)); err != nil {
+			return nil, resp, err
+		}
+		delay *= 2
+		if resp, err := s.deleteReleaseAssetNamed(ctx, owner, repo, id, opts.Name, "starter"); err != nil {
+			return nil, resp, err
+		}
+	}
+}
+
+// deleteReleaseAssetNamed deletes the asset of a release with the given
+// name, if there is one. If state is not empty, the asset is only deleted if
+// it is in that state.
+func (s *RepositoriesService) deleteReleaseAssetNamed(ctx context.Context, owner, repo string, id int64, name, state string) (*Response, error) {
+	opts := &ListOptions{PerPage: 100}
+	for {
+		assets, resp, err := s.ListReleaseAssets(ctx, owner, repo, id, opts)
+		if err != nil {
+			return resp, err
+		}
+		for _, asset := range assets {
+			if asset.GetName() != name {
+				continue
+			}
+			if state != "" && asset.GetState() != state {
+				return resp, nil
+			}
+			return s.DeleteReleaseAsset(ctx, owner, repo, asset.GetID())
+		}
+		if resp.NextPage == 0 {
+			return resp, nil
+		}
+		opts.Page = resp.NextPage
+	}
+}
+
+// isRetryableUploadError reports whether an upload that failed with err may
+// succeed if retried: network errors and server errors are, unless ctx is
+// done.
+func isRetryableUploadError(ctx context.Context, err error) bool {
+	if ctx.Err() != nil {
+		return false
+	}
+	switch e := err.(type) {
+	case *ErrorResponse:
+		return e.Response != nil && e.Response.StatusCode >= 500
+	case *RateLimitError, *AbuseRateLimitError, *AcceptedError, *TwoFactorAuthError:
+		return false
+	}
+	return true
+}
+
+// progressReader reports the progress of reading r to progress.
+type progressReader struct {
+	r        io.Reader
+	sent     int64
+	size     int64
+	progress func(sent, size int64)
+}
+
+func (p *progressReader) Read(b []byte) (int, error) {
+	n, err := p.r.Read(b)
+	if n > 0 {
+		p.sent += int64(n)
+		p.progress(p.sent, p.size)
+	}
+	return n, err
+}
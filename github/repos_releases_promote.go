@@ -0,0 +1,168 @@
+// Copyright 2021 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+// ExpectedReleaseAsset describes an asset a release must have before it is
+// published by PromoteDraftRelease.
+type ExpectedReleaseAsset struct {
+	Name string
+	// Size is the expected size of the asset in bytes. If zero, any size is
+	// accepted.
+	Size int64
+}
+
+// PromoteReleaseOptions specifies the optional parameters to the
+// RepositoriesService.PromoteDraftRelease method.
+type PromoteReleaseOptions struct {
+	// ExpectedAssets are the assets the release must have. The release is
+	// not published if any of them is missing, still being uploaded, or
+	// has another size.
+	ExpectedAssets []*ExpectedReleaseAsset
+
+	// TargetSHA, if set, is the commit the tag of the release is created
+	// at. If the tag already exists as a lightweight tag, it is moved to
+	// this commit.
+	TargetSHA string
+}
+
+// ReleaseAssetsMismatchError is returned by PromoteDraftRelease when the
+// assets of the release are not the expected ones. The release is left
+// untouched.
+type ReleaseAssetsMismatchError struct {
+	// Missing are the names of the expected assets which are not uploaded.
+	Missing []string
+	// WrongSize are the names of the expected assets which have another
+	// size.
+	WrongSize []string
+}
+
+func (e *ReleaseAssetsMismatchError) Error() string {
+	var problems []string
+	if len(e.Missing) > 0 {
+		problems = append(problems, fmt.Sprintf("missing assets %v", strings.Join(e.Missing, ", ")))
+	}
+	if len(e.WrongSize) > 0 {
+		problems = append(problems, fmt.Sprintf("assets with unexpected size %v", strings.Join(e.WrongSize, ", ")))
+	}
+	return "release cannot be published: " + strings.Join(problems, "; ")
+}
+
+// PromoteDraftRelease publishes the draft release id, after checking that
+// it has all of opts.ExpectedAssets. If an asset is missing or has another
+// size, a *ReleaseAssetsMismatchError is returned and the release is left
+// as a draft. If opts.TargetSHA is set, the tag of the release is created
+// at, or moved to, this commit; an existing annotated tag is never moved,
+// and an error is returned instead if it points elsewhere.
+//
+// GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/repos/#update-a-release
+func (s *RepositoriesService) PromoteDraftRelease(ctx context.Context, owner, repo string, id int64, opts *PromoteReleaseOptions) (*RepositoryRelease, *Response, error) {
+	if opts == nil {
+		opts = &PromoteReleaseOptions{}
+	}
+
+	release, resp, err := s.GetRelease(ctx, owner, repo, id)
+	if err != nil {
+		return nil, resp, err
+	}
+	if !release.GetDraft() {
+		return nil, resp, fmt.Errorf("release %v is not a draft", id)
+	}
+
+	if len(opts.ExpectedAssets) > 0 {
+		resp, err = s.checkReleaseAssets(ctx, owner, repo, id, opts.ExpectedAssets)
+		if err != nil {
+			return nil, resp, err
+		}
+	}
+
+	edit := &RepositoryRelease{Draft: Bool(false)}
+	if opts.TargetSHA != "" {
+		resp, err = s.retargetReleaseTag(ctx, owner, repo, release.GetTagName(), opts.TargetSHA)
+		if err != nil {
+			return nil, resp, err
+		}
+		edit.TargetCommitish = String(opts.TargetSHA)
+	}
+
+	return s.EditRelease(ctx, owner, repo, id, edit)
+}
+
+// checkReleaseAssets returns a *ReleaseAssetsMismatchError if the release
+// id does not have all the expected assets.
+func (s *RepositoriesService) checkReleaseAssets(ctx context.Context, owner, repo string, id int64, expected []*ExpectedReleaseAsset) (*Response, error) {
+	uploaded := make(map[string]*ReleaseAsset)
+	opts := &ListOptions{PerPage: 100}
+	var resp *Response
+	for {
+		assets, r, err := s.ListReleaseAssets(ctx, owner, repo, id, opts)
+		resp = r
+		if err != nil {
+			return resp, err
+		}
+		for _, a := range assets {
+			// Assets whose upload failed or is in progress have the
+			// "starter" state.
+			if a.GetState() == "uploaded" {
+				uploaded[a.GetName()] = a
+			}
+		}
+		if resp.NextPage == 0 {
+			break
+		}
+		opts.Page = resp.NextPage
+	}
+
+	mismatch := new(ReleaseAssetsMismatchError)
+	for _, e := range expected {
+		a, ok := uploaded[e.Name]
+		switch {
+		case !ok:
+			mismatch.Missing = append(mismatch.Missing, e.Name)
+		case e.Size != 0 && int64(a.GetSize()) != e.Size:
+			mismatch.WrongSize = append(mismatch.WrongSize, e.Name)
+		}
+	}
+	if len(mismatch.Missing) > 0 || len(mismatch.WrongSize) > 0 {
+		return resp, mismatch
+	}
+	return resp, nil
+}
+
+// retargetReleaseTag moves the tag of a release to sha if the tag exists.
+// Tags which do not exist yet are created by GitHub when the release is
+// published.
+func (s *RepositoriesService) retargetReleaseTag(ctx context.Context, owner, repo, tag, sha string) (*Response, error) {
+	if tag == "" {
+		return nil, nil
+	}
+
+	ref, resp, err := s.client.Git.GetRef(ctx, owner, repo, "tags/"+tag)
+	if err != nil {
+		if e, ok := err.(*ErrorResponse); ok && e.Response.StatusCode == http.StatusNotFound {
+			return resp, nil
+		}
+		return resp, err
+	}
+
+	object := ref.GetObject()
+	if object.GetSHA() == sha {
+		return resp, nil
+	}
+	if object.GetType() != "commit" {
+		return resp, fmt.Errorf("tag %v is an annotated tag and cannot be moved to %v", tag, sha)
+	}
+
+	ref = &Reference{Ref: String("refs/tags/" + tag), Object: &GitObject{SHA: String(sha)}}
+	_, resp, err = s.client.Git.UpdateRef(ctx, owner, repo, ref, true)
+	return resp, err
+}
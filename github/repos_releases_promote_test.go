@@ -0,0 +1,141 @@
+// Copyright 2021 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+func TestRepositoriesService_PromoteDraftRelease(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/releases/1", func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case "GET":
+			fmt.Fprint(w, `{"id":1,"tag_name":"v1.0.0","draft":true}`)
+		case "PATCH":
+			v := new(repositoryReleaseRequest)
+			json.NewDecoder(r.Body).Decode(v)
+			want := &repositoryReleaseRequest{Draft: Bool(false), TargetCommitish: String("s")}
+			if !reflect.DeepEqual(v, want) {
+				t.Errorf("Request body = %+v, want %+v", v, want)
+			}
+			fmt.Fprint(w, `{"id":1,"tag_name":"v1.0.0","draft":false}`)
+		default:
+			t.Errorf("Request method: %v, want GET or PATCH", r.Method)
+		}
+	})
+	mux.HandleFunc("/repos/o/r/releases/1/assets", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		switch r.FormValue("page") {
+		case "":
+			w.Header().Set("Link", `<https://api.github.com/repos/o/r/releases/1/assets?page=2>; rel="next"`)
+			fmt.Fprint(w, `[{"name":"a.tar.gz","size":10,"state":"uploaded"}]`)
+		case "2":
+			fmt.Fprint(w, `[{"name":"a.zip","size":20,"state":"uploaded"}]`)
+		}
+	})
+	mux.HandleFunc("/repos/o/r/git/ref/tags/v1.0.0", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"ref":"refs/tags/v1.0.0","object":{"type":"commit","sha":"old"}}`)
+	})
+	moved := false
+	mux.HandleFunc("/repos/o/r/git/refs/tags/v1.0.0", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PATCH")
+		testBody(t, r, `{"sha":"s","force":true}`+"\n")
+		moved = true
+		fmt.Fprint(w, `{"ref":"refs/tags/v1.0.0","object":{"type":"commit","sha":"s"}}`)
+	})
+
+	opts := &PromoteReleaseOptions{
+		ExpectedAssets: []*ExpectedReleaseAsset{{Name: "a.tar.gz", Size: 10}, {Name: "a.zip"}},
+		TargetSHA:      "s",
+	}
+	ctx := context.Background()
+	release, _, err := client.Repositories.PromoteDraftRelease(ctx, "o", "r", 1, opts)
+	if err != nil {
+		t.Errorf("Repositories.PromoteDraftRelease returned error: %v", err)
+	}
+	want := &RepositoryRelease{ID: Int64(1), TagName: String("v1.0.0"), Draft: Bool(false)}
+	if !reflect.DeepEqual(release, want) {
+		t.Errorf("Repositories.PromoteDraftRelease returned %+v, want %+v", release, want)
+	}
+	if !moved {
+		t.Error("Repositories.PromoteDraftRelease did not move the tag")
+	}
+}
+
+func TestRepositoriesService_PromoteDraftRelease_mismatch(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/releases/1", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"id":1,"draft":true}`)
+	})
+	mux.HandleFunc("/repos/o/r/releases/1/assets", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `[
+			{"name":"a.tar.gz","size":9,"state":"uploaded"},
+			{"name":"a.zip","size":20,"state":"starter"}
+		]`)
+	})
+
+	opts := &PromoteReleaseOptions{
+		ExpectedAssets: []*ExpectedReleaseAsset{{Name: "a.tar.gz", Size: 10}, {Name: "a.zip"}, {Name: "a.txt"}},
+	}
+	ctx := context.Background()
+	_, _, err := client.Repositories.PromoteDraftRelease(ctx, "o", "r", 1, opts)
+	want := &ReleaseAssetsMismatchError{Missing: []string{"a.zip", "a.txt"}, WrongSize: []string{"a.tar.gz"}}
+	if !reflect.DeepEqual(err, want) {
+		t.Errorf("Repositories.PromoteDraftRelease returned error %#v, want %#v", err, want)
+	}
+	if got, want := err.Error(), "release cannot be published: missing assets a.zip, a.txt; assets with unexpected size a.tar.gz"; got != want {
+		t.Errorf("ReleaseAssetsMismatchError.Error() = %q, want %q", got, want)
+	}
+}
+
+func TestRepositoriesService_PromoteDraftRelease_tags(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/releases/1", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == "PATCH" {
+			fmt.Fprint(w, `{"id":1}`)
+			return
+		}
+		fmt.Fprint(w, `{"id":1,"tag_name":"new","draft":true}`)
+	})
+	mux.HandleFunc("/repos/o/r/releases/2", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"id":2,"tag_name":"annotated","draft":true}`)
+	})
+	mux.HandleFunc("/repos/o/r/releases/3", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"id":3,"draft":false}`)
+	})
+	mux.HandleFunc("/repos/o/r/git/ref/tags/new", func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, `{"message":"Not Found"}`, http.StatusNotFound)
+	})
+	mux.HandleFunc("/repos/o/r/git/ref/tags/annotated", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"ref":"refs/tags/annotated","object":{"type":"tag","sha":"t"}}`)
+	})
+
+	ctx := context.Background()
+	opts := &PromoteReleaseOptions{TargetSHA: "s"}
+	if _, _, err := client.Repositories.PromoteDraftRelease(ctx, "o", "r", 1, opts); err != nil {
+		t.Errorf("Repositories.PromoteDraftRelease with new tag returned error: %v", err)
+	}
+	if _, _, err := client.Repositories.PromoteDraftRelease(ctx, "o", "r", 2, opts); err == nil {
+		t.Error("Repositories.PromoteDraftRelease with annotated tag returned nil error")
+	}
+	if _, _, err := client.Repositories.PromoteDraftRelease(ctx, "o", "r", 3, nil); err == nil {
+		t.Error("Repositories.PromoteDraftRelease with published release returned nil error")
+	}
+}
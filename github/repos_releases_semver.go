@@ -0,0 +1,325 @@
+// Copyright 2021 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// ErrNoMatchingRelease is returned by GetLatestReleaseMatching when no
+// release matches the constraint.
+var ErrNoMatchingRelease = errors.New("no release matches the version constraint")
+
+// ReleaseMatchingOptions specifies the optional parameters to the
+// RepositoriesService.GetLatestReleaseMatching method.
+type ReleaseMatchingOptions struct {
+	// IncludePrereleases includes the releases marked as prereleases, and
+	// the releases whose tag has a prerelease version, such as
+	// "v2.0.0-rc.1". They are excluded by default.
+	IncludePrereleases bool
+}
+
+// GetLatestReleaseMatching returns the release with the highest version
+// that satisfies constraint, going through all the releases of a
+// repository. Release tags are parsed as semantic versions, with an
+// optional "v" prefix; releases whose tag is not a semantic version, and
+// draft releases, are ignored. If no release matches, ErrNoMatchingRelease
+// is returned.
+//
+// constraint is a list of comparisons separated by commas or spaces, which
+// must all be satisfied, and alternatives can be separated by "||", such as
+// ">= 1.2, < 2 || 3.x". The supported operators are =, !=, >, >=, <, <=,
+// ~ (same minor version, or same major version if only it is given) and ^
+// (same left-most non-zero version part). Versions can be partial, like
+// "1.2", or use "x" or "*" wildcards. An empty constraint matches any
+// version.
+//
+// GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/repos/#list-releases
+func (s *RepositoriesService) GetLatestReleaseMatching(ctx context.Context, owner, repo, constraint string, opts *ReleaseMatchingOptions) (*RepositoryRelease, *Response, error) {
+	c, err := parseSemverConstraint(constraint)
+	if err != nil {
+		return nil, nil, err
+	}
+	includePrereleases := opts != nil && opts.IncludePrereleases
+
+	var latest *RepositoryRelease
+	var latestVersion semver
+	listOpts := &ListOptions{PerPage: 100}
+	for {
+		releases, resp, err := s.ListReleases(ctx, owner, repo, listOpts)
+		if err != nil {
+			return nil, resp, err
+		}
+
+		for _, r := range releases {
+			if r.GetDraft() || (r.GetPrerelease() && !includePrereleases) {
+				continue
+			}
+			v, ok := parseSemver(r.GetTagName())
+			if !ok || (v.pre != "" && !includePrereleases) || !c.matches(v) {
+				continue
+			}
+			if latest == nil || v.compare(latestVersion) > 0 {
+				latest, latestVersion = r, v
+			}
+		}
+
+		if resp.NextPage == 0 {
+			if latest == nil {
+				return nil, resp, ErrNoMatchingRelease
+			}
+			return latest, resp, nil
+		}
+		listOpts.Page = resp.NextPage
+	}
+}
+
+// semver is a semantic version, as defined by https://semver.org. Build
+// metadata is ignored.
+type semver struct {
+	major, minor, patch int
+	pre                 string
+}
+
+// parseSemver parses a complete semantic version, with an optional "v"
+// prefix.
+func parseSemver(s string) (semver, bool) {
+	v, n, ok := parsePartialSemver(s)
+	if !ok || n != 3 {
+		return semver{}, false
+	}
+	return v, true
+}
+
+// parsePartialSemver parses a semantic version which may be partial, like
+// "1.2" or "1.x", and returns the number of version parts given.
+func parsePartialSemver(s string) (v semver, n int, ok bool) {
+	s = strings.TrimPrefix(strings.TrimPrefix(s, "v"), "V")
+	if i := strings.IndexByte(s, '+'); i >= 0 {
+		s = s[:i]
+	}
+	if i := strings.IndexByte(s, '-'); i >= 0 {
+		s, v.pre = s[:i], s[i+1:]
+		if v.pre == "" {
+			return semver{}, 0, false
+		}
+	}
+
+	parts := strings.Split(s, ".")
+	if len(parts) > 3 {
+		return semver{}, 0, false
+	}
+	nums := []*int{&v.major, &v.minor, &v.patch}
+	for i, p := range parts {
+		if p == "x" || p == "X" || p == "*" {
+			break
+		}
+		num, err := strconv.Atoi(p)
+		if err != nil || num < 0 || (len(p) > 1 && p[0] == '0') {
+			return semver{}, 0, false
+		}
+		*nums[i] = num
+		n++
+	}
+	if v.pre != "" && n != 3 {
+		return semver{}, 0, false
+	}
+	return v, n, true
+}
+
+// compare returns -1, 0 or 1 if v is lower than, equal to or greater
+// than w, following the precedence rules of semantic versioning.
+func (v semver) compare(w semver) int {
+	for _, d := range []int{v.major - w.major, v.minor - w.minor, v.patch - w.patch} {
+		if d < 0 {
+			return -1
+		}
+		if d > 0 {
+			return 1
+		}
+	}
+
+	switch {
+	case v.pre == w.pre:
+		return 0
+	case v.pre == "":
+		return 1
+	case w.pre == "":
+		return -1
+	}
+
+	vs, ws := strings.Split(v.pre, "."), strings.Split(w.pre, ".")
+	for i := 0; i < len(vs) && i < len(ws); i++ {
+		if c := comparePrereleaseIdentifiers(vs[i], ws[i]); c != 0 {
+			return c
+		}
+	}
+	switch {
+	case len(vs) < len(ws):
+		return -1
+	case len(vs) > len(ws):
+		return 1
+	}
+	return 0
+}
+
+// comparePrereleaseIdentifiers compares two dot-separated identifiers of
+// prerelease versions: numeric identifiers are compared numerically, and
+// have a lower precedence than alphanumeric ones.
+func comparePrereleaseIdentifiers(a, b string) int {
+	an, aErr := strconv.Atoi(a)
+	bn, bErr := strconv.Atoi(b)
+	switch {
+	case aErr == nil && bErr == nil:
+		switch {
+		case an < bn:
+			return -1
+		case an > bn:
+			return 1
+		}
+		return 0
+	case aErr == nil:
+		return -1
+	case bErr == nil:
+		return 1
+	}
+	return strings.Compare(a, b)
+}
+
+// semverRange is a range of versions, between an optional inclusive lower
+// bound and an optional exclusive upper bound. If exclude is set, the range
+// matches the versions outside of it instead.
+type semverRange struct {
+	lower, upper *semver
+	exclude      bool
+}
+
+func (r semverRange) matches(v semver) bool {
+	in := (r.lower == nil || v.compare(*r.lower) >= 0) && (r.upper == nil || v.compare(*r.upper) < 0)
+	return in != r.exclude
+}
+
+// semverConstraint is a list of alternatives, each of which is a list of
+// ranges that must all match.
+type semverConstraint [][]semverRange
+
+func (c semverConstraint) matches(v semver) bool {
+	for _, ranges := range c {
+		ok := true
+		for _, r := range ranges {
+			if !r.matches(v) {
+				ok = false
+				break
+			}
+		}
+		if ok {
+			return true
+		}
+	}
+	return false
+}
+
+// parseSemverConstraint parses a version constraint, as documented in
+// GetLatestReleaseMatching.
+func parseSemverConstraint(s string) (semverConstraint, error) {
+	var c semverConstraint
+	for _, alt := range strings.Split(s, "||") {
+		// Allow spaces between operators and versions, like ">= 1.2".
+		fields := strings.FieldsFunc(alt, func(r rune) bool { return r == ',' || r == ' ' || r == '\t' })
+		var comparisons []string
+		for i := 0; i < len(fields); i++ {
+			f := fields[i]
+			if strings.Trim(f, "=!<>~^") == "" && i+1 < len(fields) {
+				f += fields[i+1]
+				i++
+			}
+			comparisons = append(comparisons, f)
+		}
+
+		var ranges []semverRange
+		for _, comparison := range comparisons {
+			r, err := parseSemverComparison(comparison)
+			if err != nil {
+				return nil, err
+			}
+			ranges = append(ranges, r)
+		}
+		c = append(c, ranges)
+	}
+	return c, nil
+}
+
+// parseSemverComparison parses a single comparison, like ">=1.2" or "~1.2.3",
+// into the range of versions it matches.
+func parseSemverComparison(s string) (semverRange, error) {
+	var op string
+	for _, prefix := range []string{">=", "<=", "!=", ">", "<", "=", "~", "^"} {
+		if strings.HasPrefix(s, prefix) {
+			op, s = prefix, s[len(prefix):]
+			break
+		}
+	}
+
+	v, n, ok := parsePartialSemver(s)
+	if !ok {
+		return semverRange{}, fmt.Errorf("invalid version %q in constraint", s)
+	}
+	if n == 0 {
+		// A wildcard matches any version, whatever the operator.
+		return semverRange{}, nil
+	}
+
+	// next returns the lowest version above all those starting with the
+	// first i parts of v, which is the lowest prerelease of the next version.
+	next := func(i int) *semver {
+		switch i {
+		case 1:
+			return &semver{major: v.major + 1, pre: "0"}
+		case 2:
+			return &semver{major: v.major, minor: v.minor + 1, pre: "0"}
+		}
+		return &semver{major: v.major, minor: v.minor, patch: v.patch + 1, pre: "0"}
+	}
+	lower := &v
+	// upper is the upper bound of the versions matching a partial version,
+	// or of v itself if it is complete.
+	upper := next(n)
+	if n == 3 && v.pre != "" {
+		upper = &semver{major: v.major, minor: v.minor, patch: v.patch, pre: v.pre + ".0"}
+	}
+
+	switch op {
+	case "", "=":
+		return semverRange{lower: lower, upper: upper}, nil
+	case "!=":
+		return semverRange{lower: lower, upper: upper, exclude: true}, nil
+	case ">":
+		return semverRange{lower: upper}, nil
+	case ">=":
+		return semverRange{lower: lower}, nil
+	case "<":
+		return semverRange{upper: lower}, nil
+	case "<=":
+		return semverRange{upper: upper}, nil
+	case "~":
+		if n == 1 {
+			return semverRange{lower: lower, upper: next(1)}, nil
+		}
+		return semverRange{lower: lower, upper: next(2)}, nil
+	default: // "^"
+		switch {
+		case v.major > 0 || n == 1:
+			return semverRange{lower: lower, upper: next(1)}, nil
+		case v.minor > 0 || n == 2:
+			return semverRange{lower: lower, upper: next(2)}, nil
+		}
+		return semverRange{lower: lower, upper: next(3)}, nil
+	}
+}
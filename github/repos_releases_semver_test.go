@@ -0,0 +1,175 @@
+// Copyright 2021 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+)
+
+func TestRepositoriesService_GetLatestReleaseMatching(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/releases", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		switch r.FormValue("page") {
+		case "":
+			testFormValues(t, r, values{"per_page": "100"})
+			w.Header().Set("Link", `<https://api.github.com/repos/o/r/releases?page=2>; rel="next"`)
+			fmt.Fprint(w, `[
+				{"id":1,"tag_name":"v2.0.0-rc.1","prerelease":true},
+				{"id":2,"tag_name":"v1.10.0"},
+				{"id":3,"tag_name":"nightly"},
+				{"id":4,"tag_name":"v1.11.0","draft":true}
+			]`)
+		case "2":
+			testFormValues(t, r, values{"per_page": "100", "page": "2"})
+			fmt.Fprint(w, `[
+				{"id":5,"tag_name":"v1.9.3"},
+				{"id":6,"tag_name":"1.2.0"},
+				{"id":7,"tag_name":"v1.10.1-beta"}
+			]`)
+		}
+	})
+
+	tests := []struct {
+		constraint string
+		opts       *ReleaseMatchingOptions
+		wantID     int64
+	}{
+		{"", nil, 2},
+		{"^1.2", nil, 2},
+		{"~1.9", nil, 5},
+		{"< 1.9", nil, 6},
+		{">=1, <1.10 || 3.x", nil, 5},
+		{"*", &ReleaseMatchingOptions{IncludePrereleases: true}, 1},
+		{"1.10", &ReleaseMatchingOptions{IncludePrereleases: true}, 7},
+		{"!=1.10.0, ~1.10", &ReleaseMatchingOptions{IncludePrereleases: true}, 7},
+	}
+	ctx := context.Background()
+	for _, tt := range tests {
+		release, _, err := client.Repositories.GetLatestReleaseMatching(ctx, "o", "r", tt.constraint, tt.opts)
+		if err != nil {
+			t.Errorf("GetLatestReleaseMatching(%q) returned error: %v", tt.constraint, err)
+			continue
+		}
+		if got := release.GetID(); got != tt.wantID {
+			t.Errorf("GetLatestReleaseMatching(%q) returned release %v, want %v", tt.constraint, got, tt.wantID)
+		}
+	}
+
+	if _, _, err := client.Repositories.GetLatestReleaseMatching(ctx, "o", "r", ">=3", nil); err != ErrNoMatchingRelease {
+		t.Errorf("GetLatestReleaseMatching returned error %v, want %v", err, ErrNoMatchingRelease)
+	}
+	if _, _, err := client.Repositories.GetLatestReleaseMatching(ctx, "o", "r", ">=one", nil); err == nil {
+		t.Error("GetLatestReleaseMatching with invalid constraint returned nil error")
+	}
+
+	const methodName = "GetLatestReleaseMatching"
+	testBadOptions(t, methodName, func() (err error) {
+		_, _, err = client.Repositories.GetLatestReleaseMatching(ctx, "\n", "\n", "", nil)
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.Repositories.GetLatestReleaseMatching(ctx, "o", "r", "", nil)
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
+
+func TestSemver_compare(t *testing.T) {
+	// Versions in increasing order of precedence, from semver.org.
+	versions := []string{
+		"1.0.0-alpha", "1.0.0-alpha.1", "1.0.0-alpha.beta", "1.0.0-beta", "1.0.0-beta.2",
+		"1.0.0-beta.11", "1.0.0-rc.1", "1.0.0", "1.0.1", "1.2.0", "1.10.0", "2.0.0",
+	}
+	for i, a := range versions {
+		va, ok := parseSemver(a)
+		if !ok {
+			t.Fatalf("parseSemver(%q) failed", a)
+		}
+		for j, b := range versions {
+			vb, _ := parseSemver(b)
+			want := 0
+			switch {
+			case i < j:
+				want = -1
+			case i > j:
+				want = 1
+			}
+			if got := va.compare(vb); got != want {
+				t.Errorf("compare(%q, %q) = %v, want %v", a, b, got, want)
+			}
+		}
+	}
+
+	for _, s := range []string{"", "v", "1.2", "1.2.3.4", "01.2.3", "1.2.3-", "a.b.c", "1.2.x"} {
+		if _, ok := parseSemver(s); ok {
+			t.Errorf("parseSemver(%q) succeeded, want failure", s)
+		}
+	}
+	if v, ok := parseSemver("v1.2.3+build.5"); !ok || v != (semver{major: 1, minor: 2, patch: 3}) {
+		t.Errorf("parseSemver(%q) = %+v, %v", "v1.2.3+build.5", v, ok)
+	}
+}
+
+func TestSemverConstraint_matches(t *testing.T) {
+	tests := []struct {
+		constraint string
+		matching   []string
+		other      []string
+	}{
+		{"1.2.3", []string{"1.2.3"}, []string{"1.2.4", "1.2.3-rc.1", "1.2.4-rc.1"}},
+		{"=1.2", []string{"1.2.0", "1.2.9"}, []string{"1.3.0", "1.3.0-rc.1", "1.1.9"}},
+		{"1.x", []string{"1.0.0", "1.9.9"}, []string{"2.0.0", "0.9.0"}},
+		{"!=1.2", []string{"1.1.0", "1.3.0"}, []string{"1.2.5"}},
+		{">1.2", []string{"1.3.0"}, []string{"1.2.9"}},
+		{">1.2.3", []string{"1.2.4"}, []string{"1.2.3"}},
+		{">=1.2.3", []string{"1.2.3", "2.0.0"}, []string{"1.2.2"}},
+		{"<1.2", []string{"1.1.9"}, []string{"1.2.0"}},
+		{"<=1.2", []string{"1.2.9"}, []string{"1.3.0"}},
+		{"~1.2.3", []string{"1.2.3", "1.2.9"}, []string{"1.3.0", "1.2.2"}},
+		{"~1", []string{"1.0.0", "1.9.0"}, []string{"2.0.0"}},
+		{"^1.2.3", []string{"1.2.3", "1.9.0"}, []string{"2.0.0", "2.0.0-rc.1", "1.2.2"}},
+		{"^0.2.3", []string{"0.2.3", "0.2.9"}, []string{"0.3.0"}},
+		{"^0.0.3", []string{"0.0.3"}, []string{"0.0.4"}},
+		{"^0", []string{"0.0.1", "0.9.0"}, []string{"1.0.0"}},
+		{"1.0.0-rc.1", []string{"1.0.0-rc.1"}, []string{"1.0.0-rc.1.0", "1.0.0"}},
+		{">= 1.0 < 2 || >= 3", []string{"1.5.0", "3.1.0"}, []string{"2.5.0"}},
+		{"*", []string{"0.0.0", "9.9.9"}, nil},
+	}
+	for _, tt := range tests {
+		c, err := parseSemverConstraint(tt.constraint)
+		if err != nil {
+			t.Errorf("parseSemverConstraint(%q) returned error: %v", tt.constraint, err)
+			continue
+		}
+		for _, s := range tt.matching {
+			v, _ := parseSemver(s)
+			if !c.matches(v) {
+				t.Errorf("%q does not match %q", tt.constraint, s)
+			}
+		}
+		for _, s := range tt.other {
+			v, _ := parseSemver(s)
+			if c.matches(v) {
+				t.Errorf("%q matches %q", tt.constraint, s)
+			}
+		}
+	}
+
+	for _, s := range []string{">=", "~a", "1.2.3.4", "1.2-rc"} {
+		if _, err := parseSemverConstraint(s); err == nil {
+			t.Errorf("parseSemverConstraint(%q) returned nil error", s)
+		}
+	}
+}
@@ -8,14 +8,18 @@ package github
 import (
 	"bytes"
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
 	"encoding/json"
 	"fmt"
+	"io"
 	"io/ioutil"
 	"net/http"
 	"os"
 	"reflect"
 	"strings"
 	"testing"
+	"time"
 )
 
 func TestRepositoriesService_ListReleases(t *testing.T) {
@@ -164,20 +168,22 @@ func TestRepositoriesService_CreateRelease(t *testing.T) {
 	defer teardown()
 
 	input := &RepositoryRelease{
-		Name: String("v1.0"),
+		Name:                   String("v1.0"),
+		DiscussionCategoryName: String("General"),
 		// Fields to be removed:
-		ID:          Int64(2),
-		CreatedAt:   &Timestamp{referenceTime},
-		PublishedAt: &Timestamp{referenceTime},
-		URL:         String("http://url/"),
-		HTMLURL:     String("http://htmlurl/"),
-		AssetsURL:   String("http://assetsurl/"),
-		Assets:      []*ReleaseAsset{{ID: Int64(5)}},
-		UploadURL:   String("http://uploadurl/"),
-		ZipballURL:  String("http://zipballurl/"),
-		TarballURL:  String("http://tarballurl/"),
-		Author:      &User{Name: String("octocat")},
-		NodeID:      String("nodeid"),
+		ID:            Int64(2),
+		CreatedAt:     &Timestamp{referenceTime},
+		PublishedAt:   &Timestamp{referenceTime},
+		URL:           String("http://url/"),
+		HTMLURL:       String("http://htmlurl/"),
+		AssetsURL:     String("http://assetsurl/"),
+		Assets:        []*ReleaseAsset{{ID: Int64(5)}},
+		UploadURL:     String("http://uploadurl/"),
+		ZipballURL:    String("http://zipballurl/"),
+		TarballURL:    String("http://tarballurl/"),
+		Author:        &User{Name: String("octocat")},
+		NodeID:        String("nodeid"),
+		DiscussionURL: String("http://discussionurl/"),
 	}
 
 	mux.HandleFunc("/repos/o/r/releases", func(w http.ResponseWriter, r *http.Request) {
@@ -185,11 +191,11 @@ func TestRepositoriesService_CreateRelease(t *testing.T) {
 		json.NewDecoder(r.Body).Decode(v)
 
 		testMethod(t, r, "POST")
-		want := &repositoryReleaseRequest{Name: String("v1.0")}
+		want := &repositoryReleaseRequest{Name: String("v1.0"), DiscussionCategoryName: String("General")}
 		if !reflect.DeepEqual(v, want) {
 			t.Errorf("Request body = %+v, want %+v", v, want)
 		}
-		fmt.Fprint(w, `{"id":1}`)
+		fmt.Fprint(w, `{"id":1,"discussion_url":"https://github.com/o/r/discussions/3"}`)
 	})
 
 	ctx := context.Background()
@@ -198,7 +204,7 @@ func TestRepositoriesService_CreateRelease(t *testing.T) {
 		t.Errorf("Repositories.CreateRelease returned error: %v", err)
 	}
 
-	want := &RepositoryRelease{ID: Int64(1)}
+	want := &RepositoryRelease{ID: Int64(1), DiscussionURL: String("https://github.com/o/r/discussions/3")}
 	if !reflect.DeepEqual(release, want) {
 		t.Errorf("Repositories.CreateRelease returned %+v, want %+v", release, want)
 	}
@@ -223,20 +229,22 @@ func TestRepositoriesService_EditRelease(t *testing.T) {
 	defer teardown()
 
 	input := &RepositoryRelease{
-		Name: String("n"),
+		Name:                   String("n"),
+		DiscussionCategoryName: String("General"),
 		// Fields to be removed:
-		ID:          Int64(2),
-		CreatedAt:   &Timestamp{referenceTime},
-		PublishedAt: &Timestamp{referenceTime},
-		URL:         String("http://url/"),
-		HTMLURL:     String("http://htmlurl/"),
-		AssetsURL:   String("http://assetsurl/"),
-		Assets:      []*ReleaseAsset{{ID: Int64(5)}},
-		UploadURL:   String("http://uploadurl/"),
-		ZipballURL:  String("http://zipballurl/"),
-		TarballURL:  String("http://tarballurl/"),
-		Author:      &User{Name: String("octocat")},
-		NodeID:      String("nodeid"),
+		ID:            Int64(2),
+		CreatedAt:     &Timestamp{referenceTime},
+		PublishedAt:   &Timestamp{referenceTime},
+		URL:           String("http://url/"),
+		HTMLURL:       String("http://htmlurl/"),
+		AssetsURL:     String("http://assetsurl/"),
+		Assets:        []*ReleaseAsset{{ID: Int64(5)}},
+		UploadURL:     String("http://uploadurl/"),
+		ZipballURL:    String("http://zipballurl/"),
+		TarballURL:    String("http://tarballurl/"),
+		Author:        &User{Name: String("octocat")},
+		NodeID:        String("nodeid"),
+		DiscussionURL: String("http://discussionurl/"),
 	}
 
 	mux.HandleFunc("/repos/o/r/releases/1", func(w http.ResponseWriter, r *http.Request) {
@@ -244,7 +252,7 @@ func TestRepositoriesService_EditRelease(t *testing.T) {
 		json.NewDecoder(r.Body).Decode(v)
 
 		testMethod(t, r, "PATCH")
-		want := &repositoryReleaseRequest{Name: String("n")}
+		want := &repositoryReleaseRequest{Name: String("n"), DiscussionCategoryName: String("General")}
 		if !reflect.DeepEqual(v, want) {
 			t.Errorf("Request body = %+v, want %+v", v, want)
 		}
@@ -644,3 +652,327 @@ func TestRepositoriesService_UploadReleaseAsset(t *testing.T) {
 		})
 	}
 }
+
+func TestRepositoriesService_UploadReleaseAssetFromReader(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/releases/1/assets", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testHeader(t, r, "Content-Type", "text/plain; charset=utf-8")
+		testFormValues(t, r, values{"name": "n.txt", "label": "l"})
+		if r.ContentLength != 7 {
+			t.Errorf("ContentLength = %v, want 7", r.ContentLength)
+		}
+		b, _ := ioutil.ReadAll(r.Body)
+		if string(b) != "content" {
+			t.Errorf("Body = %q, want %q", b, "content")
+		}
+		fmt.Fprint(w, `{"id":1}`)
+	})
+
+	var sent int64
+	opts := &ReleaseAssetUploadOptions{
+		Name:  "n.txt",
+		Label: "l",
+		Progress: func(n, size int64) {
+			if size != 7 {
+				t.Errorf("Progress size = %v, want 7", size)
+			}
+			sent = n
+		},
+	}
+	ctx := context.Background()
+	// A plain io.Reader is read into memory.
+	asset, _, err := client.Repositories.UploadReleaseAssetFromReader(ctx, "o", "r", 1, ioutil.NopCloser(strings.NewReader("content")), opts)
+	if err != nil {
+		t.Errorf("Repositories.UploadReleaseAssetFromReader returned error: %v", err)
+	}
+	if want := (&ReleaseAsset{ID: Int64(1)}); !reflect.DeepEqual(asset, want) {
+		t.Errorf("Repositories.UploadReleaseAssetFromReader returned %+v, want %+v", asset, want)
+	}
+	if sent != 7 {
+		t.Errorf("Progress reported %v bytes sent, want 7", sent)
+	}
+
+	if _, _, err := client.Repositories.UploadReleaseAssetFromReader(ctx, "o", "r", 1, strings.NewReader(""), nil); err == nil {
+		t.Error("Repositories.UploadReleaseAssetFromReader without name returned nil error")
+	}
+}
+
+func TestRepositoriesService_UploadReleaseAssetFromReader_detectMediaType(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/releases/1/assets", func(w http.ResponseWriter, r *http.Request) {
+		testHeader(t, r, "Content-Type", "image/png")
+		b, _ := ioutil.ReadAll(r.Body)
+		if string(b) != "\x89PNG\r\n\x1a\n" {
+			t.Errorf("Body = %q", b)
+		}
+		fmt.Fprint(w, `{"id":1}`)
+	})
+
+	// The reader is uploaded from its current offset.
+	r := strings.NewReader("skipped\x89PNG\r\n\x1a\n")
+	r.Seek(7, io.SeekStart)
+	ctx := context.Background()
+	if _, _, err := client.Repositories.UploadReleaseAssetFromReader(ctx, "o", "r", 1, r, &ReleaseAssetUploadOptions{Name: "image"}); err != nil {
+		t.Errorf("Repositories.UploadReleaseAssetFromReader returned error: %v", err)
+	}
+}
+
+func TestRepositoriesService_UploadReleaseAssetFromReader_replaceAndRetry(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	var uploads, deleted []string
+	mux.HandleFunc("/repos/o/r/releases/1/assets", func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case "GET":
+			switch len(uploads) {
+			case 0:
+				fmt.Fprint(w, `[{"id":1,"name":"other","state":"uploaded"},{"id":2,"name":"n.txt","state":"uploaded"}]`)
+			default:
+				fmt.Fprint(w, `[{"id":3,"name":"n.txt","state":"starter"}]`)
+			}
+		case "POST":
+			b, _ := ioutil.ReadAll(r.Body)
+			uploads = append(uploads, string(b))
+			if len(uploads) == 1 {
+				http.Error(w, `{"message":"Bad Gateway"}`, http.StatusBadGateway)
+				return
+			}
+			fmt.Fprint(w, `{"id":4}`)
+		}
+	})
+	mux.HandleFunc("/repos/o/r/releases/assets/", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+		deleted = append(deleted, strings.TrimPrefix(r.URL.Path, "/repos/o/r/releases/assets/"))
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	opts := &ReleaseAssetUploadOptions{
+		Name:       "n.txt",
+		Replace:    true,
+		MaxRetries: 2,
+		RetryDelay: time.Millisecond,
+	}
+	ctx := context.Background()
+	asset, _, err := client.Repositories.UploadReleaseAssetFromReader(ctx, "o", "r", 1, strings.NewReader("content"), opts)
+	if err != nil {
+		t.Errorf("Repositories.UploadReleaseAssetFromReader returned error: %v", err)
+	}
+	if want := (&ReleaseAsset{ID: Int64(4)}); !reflect.DeepEqual(asset, want) {
+		t.Errorf("Repositories.UploadReleaseAssetFromReader returned %+v, want %+v", asset, want)
+	}
+	if want := []string{"content", "content"}; !reflect.DeepEqual(uploads, want) {
+		t.Errorf("uploads = %q, want %q", uploads, want)
+	}
+	if want := []string{"2", "3"}; !reflect.DeepEqual(deleted, want) {
+		t.Errorf("deleted assets = %v, want %v", deleted, want)
+	}
+}
+
+func TestRepositoriesService_UploadReleaseAssetFromReader_noRetryOnClientError(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	uploads := 0
+	mux.HandleFunc("/repos/o/r/releases/1/assets", func(w http.ResponseWriter, r *http.Request) {
+		uploads++
+		http.Error(w, `{"message":"Validation Failed"}`, http.StatusUnprocessableEntity)
+	})
+
+	opts := &ReleaseAssetUploadOptions{Name: "n.txt", MaxRetries: 3, RetryDelay: time.Millisecond}
+	ctx := context.Background()
+	if _, _, err := client.Repositories.UploadReleaseAssetFromReader(ctx, "o", "r", 1, strings.NewReader("content"), opts); err == nil {
+		t.Error("Repositories.UploadReleaseAssetFromReader returned nil error")
+	}
+	if uploads != 1 {
+		t.Errorf("Repositories.UploadReleaseAssetFromReader made %v uploads, want 1", uploads)
+	}
+}
+
+func TestRepositoriesService_DownloadReleaseAssetStream(t *testing.T) {
+	const content = "content"
+	sum := sha256.Sum256([]byte(content))
+	checksum := hex.EncodeToString(sum[:])
+
+	tests := []struct {
+		name        string
+		resume      string
+		ignoreRange bool
+		sha256      string
+		want        string
+		wantErr     bool
+	}{
+		{name: "full", sha256: checksum, want: content},
+		{name: "no checksum", want: content},
+		{name: "resumed", resume: "con", sha256: checksum, want: "tent"},
+		{name: "range ignored", resume: "con", ignoreRange: true, sha256: checksum, want: "tent"},
+		{name: "already complete", resume: content, sha256: checksum, want: ""},
+		{name: "checksum mismatch", resume: "CON", sha256: checksum, want: "tent", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client, mux, _, teardown := setup()
+			defer teardown()
+
+			mux.HandleFunc("/repos/o/r/releases/assets/1", func(w http.ResponseWriter, r *http.Request) {
+				testMethod(t, r, "GET")
+				testHeader(t, r, "Accept", defaultMediaType)
+				http.Redirect(w, r, baseURLPath+"/storage/asset", http.StatusFound)
+			})
+			mux.HandleFunc("/storage/asset", func(w http.ResponseWriter, r *http.Request) {
+				testMethod(t, r, "GET")
+				if got := r.Header.Get("Authorization"); got != "" {
+					t.Errorf("Authorization header = %v, want empty", got)
+				}
+				var offset int
+				if tt.resume != "" {
+					testHeader(t, r, "Range", fmt.Sprintf("bytes=%d-", len(tt.resume)))
+					offset = len(tt.resume)
+				}
+				switch {
+				case tt.ignoreRange || offset == 0:
+					fmt.Fprint(w, content)
+				case offset >= len(content):
+					w.WriteHeader(http.StatusRequestedRangeNotSatisfiable)
+				default:
+					w.WriteHeader(http.StatusPartialContent)
+					fmt.Fprint(w, content[offset:])
+				}
+			})
+
+			opts := &DownloadReleaseAssetOptions{SHA256: tt.sha256}
+			if tt.resume != "" {
+				opts.Resume = strings.NewReader(tt.resume)
+			}
+			var buf bytes.Buffer
+			ctx := context.Background()
+			n, err := client.Repositories.DownloadReleaseAssetStream(ctx, "o", "r", 1, &buf, opts)
+			if tt.wantErr {
+				if _, ok := err.(*ReleaseAssetChecksumError); !ok {
+					t.Errorf("DownloadReleaseAssetStream returned error %v, want *ReleaseAssetChecksumError", err)
+				}
+			} else if err != nil {
+				t.Errorf("DownloadReleaseAssetStream returned error: %v", err)
+			}
+			if buf.String() != tt.want {
+				t.Errorf("DownloadReleaseAssetStream wrote %q, want %q", buf.String(), tt.want)
+			}
+			if n != int64(len(tt.want)) {
+				t.Errorf("DownloadReleaseAssetStream returned %v bytes, want %v", n, len(tt.want))
+			}
+		})
+	}
+}
+
+func TestRepositoriesService_DownloadReleaseAssetStream_noRedirect(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/releases/assets/1", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testHeader(t, r, "Accept", defaultMediaType)
+		fmt.Fprint(w, "content")
+	})
+
+	var buf bytes.Buffer
+	ctx := context.Background()
+	if _, err := client.Repositories.DownloadReleaseAssetStream(ctx, "o", "r", 1, &buf, nil); err != nil {
+		t.Errorf("DownloadReleaseAssetStream returned error: %v", err)
+	}
+	if want := "content"; buf.String() != want {
+		t.Errorf("DownloadReleaseAssetStream wrote %q, want %q", buf.String(), want)
+	}
+}
+
+func TestRepositoriesService_DownloadReleaseAssetStream_error(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/releases/assets/1", func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, `{"message":"Not Found"}`, http.StatusNotFound)
+	})
+
+	var buf bytes.Buffer
+	ctx := context.Background()
+	_, err := client.Repositories.DownloadReleaseAssetStream(ctx, "o", "r", 1, &buf, nil)
+	if _, ok := err.(*ErrorResponse); !ok {
+		t.Errorf("DownloadReleaseAssetStream returned error %v, want *ErrorResponse", err)
+	}
+
+	const methodName = "DownloadReleaseAssetStream"
+	testBadOptions(t, methodName, func() (err error) {
+		_, err = client.Repositories.DownloadReleaseAssetStream(ctx, "\n", "\n", 1, &buf, nil)
+		return err
+	})
+}
+
+func TestRepositoriesService_GenerateReleaseNotes(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	opts := &GenerateNotesOptions{
+		TagName:               "v1.0.0",
+		PreviousTagName:       String("v0.9.0"),
+		TargetCommitish:       String("main"),
+		ConfigurationFilePath: String(".github/custom_release_config.yml"),
+	}
+
+	mux.HandleFunc("/repos/o/r/releases/generate-notes", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testBody(t, r, `{"tag_name":"v1.0.0","previous_tag_name":"v0.9.0","target_commitish":"main","configuration_file_path":".github/custom_release_config.yml"}`+"\n")
+		fmt.Fprint(w, `{"name":"Release v1.0.0 is now available!","body":"##Changes in Release v1.0.0 ... ##Contributors @monalisa"}`)
+	})
+
+	ctx := context.Background()
+	notes, _, err := client.Repositories.GenerateReleaseNotes(ctx, "o", "r", opts)
+	if err != nil {
+		t.Errorf("Repositories.GenerateReleaseNotes returned error: %v", err)
+	}
+
+	want := &RepositoryReleaseNotes{
+		Name: "Release v1.0.0 is now available!",
+		Body: "##Changes in Release v1.0.0 ... ##Contributors @monalisa",
+	}
+	if !reflect.DeepEqual(notes, want) {
+		t.Errorf("Repositories.GenerateReleaseNotes returned %+v, want %+v", notes, want)
+	}
+
+	const methodName = "GenerateReleaseNotes"
+	testBadOptions(t, methodName, func() (err error) {
+		_, _, err = client.Repositories.GenerateReleaseNotes(ctx, "\n", "\n", opts)
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.Repositories.GenerateReleaseNotes(ctx, "o", "r", opts)
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
+
+func TestGenerateNotesOptions_Marshal(t *testing.T) {
+	testJSONMarshal(t, &GenerateNotesOptions{}, `{"tag_name":""}`)
+
+	u := &GenerateNotesOptions{
+		TagName:               "v1",
+		PreviousTagName:       String("v0"),
+		TargetCommitish:       String("main"),
+		ConfigurationFilePath: String("c"),
+	}
+
+	want := `{
+		"tag_name": "v1",
+		"previous_tag_name": "v0",
+		"target_commitish": "main",
+		"configuration_file_path": "c"
+	}`
+
+	testJSONMarshal(t, u, want)
+}
@@ -0,0 +1,313 @@
+// Copyright 2021 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+)
+
+// BypassActor represents the bypass actors from a ruleset.
+type BypassActor struct {
+	ActorID *int64 `json:"actor_id,omitempty"`
+	// Possible values for ActorType are: RepositoryRole, Team, Integration, OrganizationAdmin
+	ActorType *string `json:"actor_type,omitempty"`
+	// Possible values for BypassMode are: always, pull_request
+	BypassMode *string `json:"bypass_mode,omitempty"`
+}
+
+// RulesetLink represents a single link object from GitHub ruleset request _links.
+type RulesetLink struct {
+	HRef *string `json:"href,omitempty"`
+}
+
+// RulesetLinks represents the "_links" object in a Ruleset.
+type RulesetLinks struct {
+	Self *RulesetLink `json:"self,omitempty"`
+}
+
+// RulesetRefConditionParameters represents the conditions object for ref_names.
+// Refs are fully qualified, e.g. "refs/tags/v*". The special values
+// "~DEFAULT_BRANCH" and "~ALL" can also be used.
+type RulesetRefConditionParameters struct {
+	Include []string `json:"include"`
+	Exclude []string `json:"exclude"`
+}
+
+// RulesetRepositoryNamesConditionParameters represents the conditions object
+// for repository_name, which targets the repositories of an organization by
+// name. Names can contain fnmatch patterns, and the special value "~ALL"
+// matches all repositories.
+type RulesetRepositoryNamesConditionParameters struct {
+	Include []string `json:"include"`
+	Exclude []string `json:"exclude"`
+	// If Protected is true, the targeted repositories cannot be renamed.
+	Protected *bool `json:"protected,omitempty"`
+}
+
+// RulesetRepositoryIDsConditionParameters represents the conditions object
+// for repository_id, which targets the repositories of an organization by ID.
+type RulesetRepositoryIDsConditionParameters struct {
+	RepositoryIDs []int64 `json:"repository_ids,omitempty"`
+}
+
+// RulesetRepositoryPropertyTargetParameters represents a custom property
+// that a repository must have, with one of the given values, to be targeted.
+type RulesetRepositoryPropertyTargetParameters struct {
+	Name   string   `json:"name"`
+	Values []string `json:"property_values"`
+	// Possible values for Source are: custom, system
+	Source *string `json:"source,omitempty"`
+}
+
+// RulesetRepositoryPropertyConditionParameters represents the conditions
+// object for repository_property, which targets the repositories of an
+// organization by their custom property values.
+type RulesetRepositoryPropertyConditionParameters struct {
+	Include []*RulesetRepositoryPropertyTargetParameters `json:"include"`
+	Exclude []*RulesetRepositoryPropertyTargetParameters `json:"exclude"`
+}
+
+// RulesetConditions represents the conditions object in a ruleset.
+// The repository conditions are only valid for organization rulesets, which
+// must set exactly one of RepositoryName, RepositoryID and
+// RepositoryProperty.
+type RulesetConditions struct {
+	RefName            *RulesetRefConditionParameters                `json:"ref_name,omitempty"`
+	RepositoryName     *RulesetRepositoryNamesConditionParameters    `json:"repository_name,omitempty"`
+	RepositoryID       *RulesetRepositoryIDsConditionParameters      `json:"repository_id,omitempty"`
+	RepositoryProperty *RulesetRepositoryPropertyConditionParameters `json:"repository_property,omitempty"`
+}
+
+// RulePatternParameters represents the rule pattern parameters.
+type RulePatternParameters struct {
+	Name *string `json:"name,omitempty"`
+	// If Negate is true, the rule will fail if the pattern matches.
+	Negate *bool `json:"negate,omitempty"`
+	// Possible values for Operator are: starts_with, ends_with, contains, regex
+	Operator string `json:"operator"`
+	Pattern  string `json:"pattern"`
+}
+
+// UpdateAllowsFetchAndMergeRuleParameters represents the update rule parameters.
+type UpdateAllowsFetchAndMergeRuleParameters struct {
+	UpdateAllowsFetchAndMerge bool `json:"update_allows_fetch_and_merge"`
+}
+
+// RepositoryRule represents a GitHub Rule. Use the New*Rule functions to
+// create rules with the correct type and parameters.
+type RepositoryRule struct {
+	Type       string           `json:"type"`
+	Parameters *json.RawMessage `json:"parameters,omitempty"`
+}
+
+// newRepositoryRule returns a rule of the given type. params, if non-nil,
+// is marshaled to JSON and used as the rule parameters.
+func newRepositoryRule(ruleType string, params interface{}) *RepositoryRule {
+	rule := &RepositoryRule{Type: ruleType}
+	if params != nil {
+		// The parameter types are plain structs, so marshaling cannot fail.
+		b, _ := json.Marshal(params)
+		raw := json.RawMessage(b)
+		rule.Parameters = &raw
+	}
+	return rule
+}
+
+// NewCreationRule creates a rule to only allow users with bypass permission to create matching refs.
+func NewCreationRule() *RepositoryRule {
+	return newRepositoryRule("creation", nil)
+}
+
+// NewUpdateRule creates a rule to only allow users with bypass permission to update matching refs.
+// params may be nil.
+func NewUpdateRule(params *UpdateAllowsFetchAndMergeRuleParameters) *RepositoryRule {
+	if params == nil {
+		return newRepositoryRule("update", nil)
+	}
+	return newRepositoryRule("update", params)
+}
+
+// NewDeletionRule creates a rule to only allow users with bypass permissions to delete matching refs.
+func NewDeletionRule() *RepositoryRule {
+	return newRepositoryRule("deletion", nil)
+}
+
+// NewRequiredLinearHistoryRule creates a rule to prevent merge commits from being pushed to matching branches.
+func NewRequiredLinearHistoryRule() *RepositoryRule {
+	return newRepositoryRule("required_linear_history", nil)
+}
+
+// NewRequiredSignaturesRule creates a rule to require commits pushed to matching refs to have verified signatures.
+func NewRequiredSignaturesRule() *RepositoryRule {
+	return newRepositoryRule("required_signatures", nil)
+}
+
+// NewNonFastForwardRule creates a rule as part to prevent users with push access from force pushing to matching refs.
+func NewNonFastForwardRule() *RepositoryRule {
+	return newRepositoryRule("non_fast_forward", nil)
+}
+
+// NewCommitMessagePatternRule creates a rule to restrict commit message patterns being pushed to matching refs.
+func NewCommitMessagePatternRule(params *RulePatternParameters) *RepositoryRule {
+	return newRepositoryRule("commit_message_pattern", params)
+}
+
+// NewBranchNamePatternRule creates a rule to restrict branch patterns from being merged into matching branches.
+func NewBranchNamePatternRule(params *RulePatternParameters) *RepositoryRule {
+	return newRepositoryRule("branch_name_pattern", params)
+}
+
+// NewTagNamePatternRule creates a rule to restrict tag patterns contained in non-target branches from being merged into matching branches.
+func NewTagNamePatternRule(params *RulePatternParameters) *RepositoryRule {
+	return newRepositoryRule("tag_name_pattern", params)
+}
+
+// Ruleset represents a GitHub ruleset object.
+type Ruleset struct {
+	ID   *int64 `json:"id,omitempty"`
+	Name string `json:"name"`
+	// Possible values for Target are: branch, tag
+	Target *string `json:"target,omitempty"`
+	// Possible values for SourceType are: Repository, Organization
+	SourceType *string `json:"source_type,omitempty"`
+	Source     *string `json:"source,omitempty"`
+	// Possible values for Enforcement are: disabled, active, evaluate
+	Enforcement  string             `json:"enforcement"`
+	BypassActors []*BypassActor     `json:"bypass_actors,omitempty"`
+	NodeID       *string            `json:"node_id,omitempty"`
+	Links        *RulesetLinks      `json:"_links,omitempty"`
+	Conditions   *RulesetConditions `json:"conditions,omitempty"`
+	Rules        []*RepositoryRule  `json:"rules,omitempty"`
+}
+
+// NewTagProtectionRuleset returns an active ruleset, named name, that locks
+// the tags matching any of patterns (e.g. "v*"): once created, matching tags
+// can be neither moved nor deleted, except by the ruleset's bypass actors.
+// To also restrict who can create matching tags, append NewCreationRule() to
+// the ruleset's Rules and add the release automation to its BypassActors.
+//
+// The returned ruleset can be passed to RepositoriesService.CreateRuleset.
+func NewTagProtectionRuleset(name string, patterns ...string) *Ruleset {
+	include := make([]string, 0, len(patterns))
+	for _, pattern := range patterns {
+		include = append(include, "refs/tags/"+pattern)
+	}
+
+	return &Ruleset{
+		Name:        name,
+		Target:      String("tag"),
+		Enforcement: "active",
+		Conditions: &RulesetConditions{
+			RefName: &RulesetRefConditionParameters{
+				Include: include,
+				Exclude: []string{},
+			},
+		},
+		Rules: []*RepositoryRule{
+			NewUpdateRule(nil),
+			NewDeletionRule(),
+			NewNonFastForwardRule(),
+		},
+	}
+}
+
+// GetAllRulesets gets all the rules that apply to the specified repository.
+// If includesParents is true, rulesets configured at the organization level that apply to the repository will be returned.
+//
+// GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/repos/#get-all-repository-rulesets
+func (s *RepositoriesService) GetAllRulesets(ctx context.Context, owner, repo string, includesParents bool) ([]*Ruleset, *Response, error) {
+	u := fmt.Sprintf("repos/%v/%v/rulesets?includes_parents=%v", owner, repo, includesParents)
+
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var ruleset []*Ruleset
+	resp, err := s.client.Do(ctx, req, &ruleset)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return ruleset, resp, nil
+}
+
+// CreateRuleset creates a ruleset for the specified repository.
+//
+// GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/repos/#create-a-repository-ruleset
+func (s *RepositoriesService) CreateRuleset(ctx context.Context, owner, repo string, rs *Ruleset) (*Ruleset, *Response, error) {
+	u := fmt.Sprintf("repos/%v/%v/rulesets", owner, repo)
+
+	req, err := s.client.NewRequest("POST", u, rs)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	ruleset := new(Ruleset)
+	resp, err := s.client.Do(ctx, req, ruleset)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return ruleset, resp, nil
+}
+
+// GetRuleset gets a ruleset for the specified repository.
+// If includesParents is true, rulesets configured at the organization level that apply to the repository will be returned.
+//
+// GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/repos/#get-a-repository-ruleset
+func (s *RepositoriesService) GetRuleset(ctx context.Context, owner, repo string, rulesetID int64, includesParents bool) (*Ruleset, *Response, error) {
+	u := fmt.Sprintf("repos/%v/%v/rulesets/%v?includes_parents=%v", owner, repo, rulesetID, includesParents)
+
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	ruleset := new(Ruleset)
+	resp, err := s.client.Do(ctx, req, ruleset)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return ruleset, resp, nil
+}
+
+// UpdateRuleset updates a ruleset for the specified repository.
+//
+// GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/repos/#update-a-repository-ruleset
+func (s *RepositoriesService) UpdateRuleset(ctx context.Context, owner, repo string, rulesetID int64, rs *Ruleset) (*Ruleset, *Response, error) {
+	u := fmt.Sprintf("repos/%v/%v/rulesets/%v", owner, repo, rulesetID)
+
+	req, err := s.client.NewRequest("PUT", u, rs)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	ruleset := new(Ruleset)
+	resp, err := s.client.Do(ctx, req, ruleset)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return ruleset, resp, nil
+}
+
+// DeleteRuleset deletes a ruleset for the specified repository.
+//
+// GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/repos/#delete-a-repository-ruleset
+func (s *RepositoriesService) DeleteRuleset(ctx context.Context, owner, repo string, rulesetID int64) (*Response, error) {
+	u := fmt.Sprintf("repos/%v/%v/rulesets/%v", owner, repo, rulesetID)
+
+	req, err := s.client.NewRequest("DELETE", u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(ctx, req, nil)
+}
@@ -0,0 +1,356 @@
+// Copyright 2021 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+func TestRepositoryRule_Marshal(t *testing.T) {
+	tests := map[string]struct {
+		rule *RepositoryRule
+		want string
+	}{
+		"creation": {
+			rule: NewCreationRule(),
+			want: `{"type":"creation"}`,
+		},
+		"update without params": {
+			rule: NewUpdateRule(nil),
+			want: `{"type":"update"}`,
+		},
+		"update": {
+			rule: NewUpdateRule(&UpdateAllowsFetchAndMergeRuleParameters{UpdateAllowsFetchAndMerge: true}),
+			want: `{"type":"update","parameters":{"update_allows_fetch_and_merge":true}}`,
+		},
+		"deletion": {
+			rule: NewDeletionRule(),
+			want: `{"type":"deletion"}`,
+		},
+		"required_linear_history": {
+			rule: NewRequiredLinearHistoryRule(),
+			want: `{"type":"required_linear_history"}`,
+		},
+		"required_signatures": {
+			rule: NewRequiredSignaturesRule(),
+			want: `{"type":"required_signatures"}`,
+		},
+		"non_fast_forward": {
+			rule: NewNonFastForwardRule(),
+			want: `{"type":"non_fast_forward"}`,
+		},
+		"commit_message_pattern": {
+			rule: NewCommitMessagePatternRule(&RulePatternParameters{Operator: "starts_with", Pattern: "JIRA-"}),
+			want: `{"type":"commit_message_pattern","parameters":{"operator":"starts_with","pattern":"JIRA-"}}`,
+		},
+		"branch_name_pattern": {
+			rule: NewBranchNamePatternRule(&RulePatternParameters{Name: String("n"), Negate: Bool(true), Operator: "regex", Pattern: "^x"}),
+			want: `{"type":"branch_name_pattern","parameters":{"name":"n","negate":true,"operator":"regex","pattern":"^x"}}`,
+		},
+		"tag_name_pattern": {
+			rule: NewTagNamePatternRule(&RulePatternParameters{Operator: "ends_with", Pattern: "-rc"}),
+			want: `{"type":"tag_name_pattern","parameters":{"operator":"ends_with","pattern":"-rc"}}`,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			testJSONMarshal(t, tc.rule, tc.want)
+		})
+	}
+}
+
+func TestNewTagProtectionRuleset(t *testing.T) {
+	rs := NewTagProtectionRuleset("release tags", "v*", "release-*")
+
+	want := `{
+		"name": "release tags",
+		"target": "tag",
+		"enforcement": "active",
+		"conditions": {
+			"ref_name": {
+				"include": ["refs/tags/v*", "refs/tags/release-*"],
+				"exclude": []
+			}
+		},
+		"rules": [
+			{"type": "update"},
+			{"type": "deletion"},
+			{"type": "non_fast_forward"}
+		]
+	}`
+
+	testJSONMarshal(t, rs, want)
+}
+
+func TestRepositoriesService_GetAllRulesets(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/rulesets", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testFormValues(t, r, values{"includes_parents": "true"})
+		fmt.Fprint(w, `[
+			{
+				"id": 42,
+				"name": "ruleset",
+				"target": "tag",
+				"source_type": "Repository",
+				"source": "o/r",
+				"enforcement": "active"
+			},
+			{
+				"id": 314,
+				"name": "Another ruleset",
+				"source_type": "Organization",
+				"source": "o",
+				"enforcement": "disabled"
+			}
+		]`)
+	})
+
+	ctx := context.Background()
+	ruleSets, _, err := client.Repositories.GetAllRulesets(ctx, "o", "r", true)
+	if err != nil {
+		t.Errorf("Repositories.GetAllRulesets returned error: %v", err)
+	}
+
+	want := []*Ruleset{
+		{
+			ID:          Int64(42),
+			Name:        "ruleset",
+			Target:      String("tag"),
+			SourceType:  String("Repository"),
+			Source:      String("o/r"),
+			Enforcement: "active",
+		},
+		{
+			ID:          Int64(314),
+			Name:        "Another ruleset",
+			SourceType:  String("Organization"),
+			Source:      String("o"),
+			Enforcement: "disabled",
+		},
+	}
+	if !reflect.DeepEqual(ruleSets, want) {
+		t.Errorf("Repositories.GetAllRulesets returned %+v, want %+v", ruleSets, want)
+	}
+
+	const methodName = "GetAllRulesets"
+	testBadOptions(t, methodName, func() (err error) {
+		_, _, err = client.Repositories.GetAllRulesets(ctx, "\n", "\n", true)
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.Repositories.GetAllRulesets(ctx, "o", "r", true)
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
+
+func TestRepositoriesService_CreateRuleset(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	input := NewTagProtectionRuleset("ruleset", "v*")
+
+	mux.HandleFunc("/repos/o/r/rulesets", func(w http.ResponseWriter, r *http.Request) {
+		v := new(Ruleset)
+		json.NewDecoder(r.Body).Decode(v)
+
+		testMethod(t, r, "POST")
+		if !reflect.DeepEqual(v, input) {
+			t.Errorf("Request body = %+v, want %+v", v, input)
+		}
+
+		fmt.Fprint(w, `{
+			"id": 42,
+			"name": "ruleset",
+			"target": "tag",
+			"source_type": "Repository",
+			"source": "o/r",
+			"enforcement": "active",
+			"rules": [{"type": "deletion"}]
+		}`)
+	})
+
+	ctx := context.Background()
+	ruleSet, _, err := client.Repositories.CreateRuleset(ctx, "o", "r", input)
+	if err != nil {
+		t.Errorf("Repositories.CreateRuleset returned error: %v", err)
+	}
+
+	want := &Ruleset{
+		ID:          Int64(42),
+		Name:        "ruleset",
+		Target:      String("tag"),
+		SourceType:  String("Repository"),
+		Source:      String("o/r"),
+		Enforcement: "active",
+		Rules:       []*RepositoryRule{NewDeletionRule()},
+	}
+	if !reflect.DeepEqual(ruleSet, want) {
+		t.Errorf("Repositories.CreateRuleset returned %+v, want %+v", ruleSet, want)
+	}
+
+	const methodName = "CreateRuleset"
+	testBadOptions(t, methodName, func() (err error) {
+		_, _, err = client.Repositories.CreateRuleset(ctx, "\n", "\n", input)
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.Repositories.CreateRuleset(ctx, "o", "r", input)
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
+
+func TestRepositoriesService_GetRuleset(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/rulesets/42", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testFormValues(t, r, values{"includes_parents": "false"})
+		fmt.Fprint(w, `{
+			"id": 42,
+			"name": "ruleset",
+			"source_type": "Repository",
+			"source": "o/r",
+			"enforcement": "active",
+			"bypass_actors": [{"actor_id": 1, "actor_type": "Integration", "bypass_mode": "always"}],
+			"_links": {"self": {"href": "https://api.github.com/repos/o/r/rulesets/42"}},
+			"conditions": {"ref_name": {"include": ["~DEFAULT_BRANCH"], "exclude": []}},
+			"rules": [
+				{
+					"type": "commit_message_pattern",
+					"parameters": {"operator": "starts_with", "pattern": "JIRA-"}
+				}
+			]
+		}`)
+	})
+
+	ctx := context.Background()
+	ruleSet, _, err := client.Repositories.GetRuleset(ctx, "o", "r", 42, false)
+	if err != nil {
+		t.Errorf("Repositories.GetRuleset returned error: %v", err)
+	}
+
+	params := json.RawMessage(`{"operator": "starts_with", "pattern": "JIRA-"}`)
+	want := &Ruleset{
+		ID:          Int64(42),
+		Name:        "ruleset",
+		SourceType:  String("Repository"),
+		Source:      String("o/r"),
+		Enforcement: "active",
+		BypassActors: []*BypassActor{
+			{ActorID: Int64(1), ActorType: String("Integration"), BypassMode: String("always")},
+		},
+		Links: &RulesetLinks{
+			Self: &RulesetLink{HRef: String("https://api.github.com/repos/o/r/rulesets/42")},
+		},
+		Conditions: &RulesetConditions{
+			RefName: &RulesetRefConditionParameters{
+				Include: []string{"~DEFAULT_BRANCH"},
+				Exclude: []string{},
+			},
+		},
+		Rules: []*RepositoryRule{
+			{Type: "commit_message_pattern", Parameters: &params},
+		},
+	}
+	if !reflect.DeepEqual(ruleSet, want) {
+		t.Errorf("Repositories.GetRuleset returned %+v, want %+v", ruleSet, want)
+	}
+
+	const methodName = "GetRuleset"
+	testBadOptions(t, methodName, func() (err error) {
+		_, _, err = client.Repositories.GetRuleset(ctx, "\n", "\n", 42, false)
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.Repositories.GetRuleset(ctx, "o", "r", 42, false)
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
+
+func TestRepositoriesService_UpdateRuleset(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	input := &Ruleset{Name: "ruleset", Enforcement: "evaluate"}
+
+	mux.HandleFunc("/repos/o/r/rulesets/42", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PUT")
+		testBody(t, r, `{"name":"ruleset","enforcement":"evaluate"}`+"\n")
+		fmt.Fprint(w, `{"id": 42, "name": "ruleset", "enforcement": "evaluate"}`)
+	})
+
+	ctx := context.Background()
+	ruleSet, _, err := client.Repositories.UpdateRuleset(ctx, "o", "r", 42, input)
+	if err != nil {
+		t.Errorf("Repositories.UpdateRuleset returned error: %v", err)
+	}
+
+	want := &Ruleset{ID: Int64(42), Name: "ruleset", Enforcement: "evaluate"}
+	if !reflect.DeepEqual(ruleSet, want) {
+		t.Errorf("Repositories.UpdateRuleset returned %+v, want %+v", ruleSet, want)
+	}
+
+	const methodName = "UpdateRuleset"
+	testBadOptions(t, methodName, func() (err error) {
+		_, _, err = client.Repositories.UpdateRuleset(ctx, "\n", "\n", 42, input)
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.Repositories.UpdateRuleset(ctx, "o", "r", 42, input)
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
+
+func TestRepositoriesService_DeleteRuleset(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/rulesets/42", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+	})
+
+	ctx := context.Background()
+	_, err := client.Repositories.DeleteRuleset(ctx, "o", "r", 42)
+	if err != nil {
+		t.Errorf("Repositories.DeleteRuleset returned error: %v", err)
+	}
+
+	const methodName = "DeleteRuleset"
+	testBadOptions(t, methodName, func() (err error) {
+		_, err = client.Repositories.DeleteRuleset(ctx, "\n", "\n", 42)
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		return client.Repositories.DeleteRuleset(ctx, "o", "r", 42)
+	})
+}
@@ -128,3 +128,114 @@ func (s *RepositoriesService) GetCombinedStatus(ctx context.Context, owner, repo
 
 	return status, resp, nil
 }
+
+// CombinedChecksStatus is the combined verdict of the commit statuses and of
+// the check runs of a reference, as returned by GetCombinedChecksStatus.
+type CombinedChecksStatus struct {
+	// State is the combined state: "failure" if any context failed,
+	// otherwise "pending" if any context is not complete or if there are no
+	// contexts, otherwise "success".
+	State string
+	SHA   string
+
+	Contexts []*ChecksStatusContext
+}
+
+// ChecksStatusContext is the normalized state of a commit status or of a
+// check run.
+type ChecksStatusContext struct {
+	// Name is the context of the commit status, or the name of the check
+	// run.
+	Name string
+	// State is "pending", "success" or "failure".
+	State string
+
+	// Status is the commit status this context comes from, if any.
+	Status *RepoStatus
+	// CheckRun is the check run this context comes from, if any.
+	CheckRun *CheckRun
+}
+
+// GetCombinedChecksStatus returns the combined verdict of the commit
+// statuses and of the latest check runs of a reference, which GitHub
+// reports through separate APIs but branch protection treats together.
+// ref can be a SHA, a branch name, or a tag name.
+//
+// Commit statuses in the "error" state and check runs which concluded with
+// anything but "success", "neutral" or "skipped" are failures; check runs
+// which are not completed are pending.
+//
+// GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/repos/#get-the-combined-status-for-a-specific-reference
+// GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/checks/#list-check-runs-for-a-git-reference
+func (s *RepositoriesService) GetCombinedChecksStatus(ctx context.Context, owner, repo, ref string) (*CombinedChecksStatus, *Response, error) {
+	combined := new(CombinedChecksStatus)
+
+	statusOpts := &ListOptions{PerPage: 100}
+	for {
+		status, resp, err := s.GetCombinedStatus(ctx, owner, repo, ref, statusOpts)
+		if err != nil {
+			return nil, resp, err
+		}
+		combined.SHA = status.GetSHA()
+		for _, st := range status.Statuses {
+			state := st.GetState()
+			if state == "error" {
+				state = "failure"
+			}
+			combined.Contexts = append(combined.Contexts, &ChecksStatusContext{Name: st.GetContext(), State: state, Status: st})
+		}
+		if resp.NextPage == 0 {
+			break
+		}
+		statusOpts.Page = resp.NextPage
+	}
+
+	checkOpts := &ListCheckRunsOptions{ListOptions: ListOptions{PerPage: 100}}
+	var resp *Response
+	for {
+		runs, r, err := s.client.Checks.ListCheckRunsForRef(ctx, owner, repo, ref, checkOpts)
+		resp = r
+		if err != nil {
+			return nil, resp, err
+		}
+		for _, run := range runs.CheckRuns {
+			if combined.SHA == "" {
+				combined.SHA = run.GetHeadSHA()
+			}
+			combined.Contexts = append(combined.Contexts, &ChecksStatusContext{Name: run.GetName(), State: checkRunState(run), CheckRun: run})
+		}
+		if resp.NextPage == 0 {
+			break
+		}
+		checkOpts.Page = resp.NextPage
+	}
+
+	combined.State = "success"
+	if len(combined.Contexts) == 0 {
+		combined.State = "pending"
+	}
+	for _, c := range combined.Contexts {
+		if c.State == "failure" {
+			combined.State = "failure"
+			break
+		}
+		if c.State == "pending" {
+			combined.State = "pending"
+		}
+	}
+
+	return combined, resp, nil
+}
+
+// checkRunState returns the state of a check run, as the state of a
+// commit status.
+func checkRunState(run *CheckRun) string {
+	if run.GetStatus() != "completed" {
+		return "pending"
+	}
+	switch run.GetConclusion() {
+	case "success", "neutral", "skipped":
+		return "success"
+	}
+	return "failure"
+}
@@ -148,3 +148,97 @@ func TestRepositoriesService_GetCombinedStatus(t *testing.T) {
 		return resp, err
 	})
 }
+
+func TestRepositoriesService_GetCombinedChecksStatus(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/commits/main/status", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		switch r.FormValue("page") {
+		case "":
+			testFormValues(t, r, values{"per_page": "100"})
+			w.Header().Set("Link", `<https://api.github.com/repos/o/r/commits/main/status?page=2>; rel="next"`)
+			fmt.Fprint(w, `{"state":"pending","sha":"s","statuses":[{"context":"ci/a","state":"success"}]}`)
+		case "2":
+			fmt.Fprint(w, `{"state":"pending","sha":"s","statuses":[{"context":"ci/b","state":"pending"}]}`)
+		}
+	})
+	mux.HandleFunc("/repos/o/r/commits/main/check-runs", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		switch r.FormValue("page") {
+		case "":
+			testFormValues(t, r, values{"per_page": "100"})
+			w.Header().Set("Link", `<https://api.github.com/repos/o/r/commits/main/check-runs?page=2>; rel="next"`)
+			fmt.Fprint(w, `{"total_count":2,"check_runs":[{"name":"lint","status":"completed","conclusion":"skipped"}]}`)
+		case "2":
+			fmt.Fprint(w, `{"total_count":2,"check_runs":[{"name":"test","status":"in_progress"}]}`)
+		}
+	})
+
+	ctx := context.Background()
+	got, _, err := client.Repositories.GetCombinedChecksStatus(ctx, "o", "r", "main")
+	if err != nil {
+		t.Fatalf("Repositories.GetCombinedChecksStatus returned error: %v", err)
+	}
+	want := &CombinedChecksStatus{
+		State: "pending",
+		SHA:   "s",
+		Contexts: []*ChecksStatusContext{
+			{Name: "ci/a", State: "success", Status: &RepoStatus{Context: String("ci/a"), State: String("success")}},
+			{Name: "ci/b", State: "pending", Status: &RepoStatus{Context: String("ci/b"), State: String("pending")}},
+			{Name: "lint", State: "success", CheckRun: &CheckRun{Name: String("lint"), Status: String("completed"), Conclusion: String("skipped")}},
+			{Name: "test", State: "pending", CheckRun: &CheckRun{Name: String("test"), Status: String("in_progress")}},
+		},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Repositories.GetCombinedChecksStatus returned %+v, want %+v", got, want)
+	}
+
+	const methodName = "GetCombinedChecksStatus"
+	testBadOptions(t, methodName, func() (err error) {
+		_, _, err = client.Repositories.GetCombinedChecksStatus(ctx, "\n", "\n", "\n")
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.Repositories.GetCombinedChecksStatus(ctx, "o", "r", "main")
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
+
+func TestRepositoriesService_GetCombinedChecksStatus_verdicts(t *testing.T) {
+	tests := []struct {
+		statuses  string
+		checkRuns string
+		want      string
+	}{
+		{`[]`, `[]`, "pending"},
+		{`[{"state":"success"}]`, `[{"status":"completed","conclusion":"neutral"}]`, "success"},
+		{`[{"state":"error"}]`, `[{"status":"completed","conclusion":"success"}]`, "failure"},
+		{`[{"state":"pending"}]`, `[{"status":"completed","conclusion":"timed_out"}]`, "failure"},
+		{`[]`, `[{"status":"queued"},{"status":"completed","conclusion":"action_required"}]`, "failure"},
+		{`[{"state":"success"}]`, `[{"status":"queued","head_sha":"h"}]`, "pending"},
+	}
+
+	for _, tt := range tests {
+		client, mux, _, teardown := setup()
+		mux.HandleFunc("/repos/o/r/commits/main/status", func(w http.ResponseWriter, r *http.Request) {
+			fmt.Fprintf(w, `{"statuses":%v}`, tt.statuses)
+		})
+		mux.HandleFunc("/repos/o/r/commits/main/check-runs", func(w http.ResponseWriter, r *http.Request) {
+			fmt.Fprintf(w, `{"check_runs":%v}`, tt.checkRuns)
+		})
+
+		got, _, err := client.Repositories.GetCombinedChecksStatus(context.Background(), "o", "r", "main")
+		if err != nil {
+			t.Errorf("Repositories.GetCombinedChecksStatus returned error: %v", err)
+		} else if got.State != tt.want {
+			t.Errorf("Repositories.GetCombinedChecksStatus(%v, %v) returned state %q, want %q", tt.statuses, tt.checkRuns, got.State, tt.want)
+		}
+		teardown()
+	}
+}
@@ -676,6 +676,174 @@ func TestRepositoriesService_DisableAutomatedSecurityFixes(t *testing.T) {
 	}
 }
 
+func TestRepositoriesService_GetImmutableReleases(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/immutable-releases", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"enabled":true,"enforced_by_owner":false}`)
+	})
+
+	ctx := context.Background()
+	got, _, err := client.Repositories.GetImmutableReleases(ctx, "o", "r")
+	if err != nil {
+		t.Errorf("Repositories.GetImmutableReleases returned error: %v", err)
+	}
+
+	want := &RepositoryImmutableReleases{Enabled: Bool(true), EnforcedByOwner: Bool(false)}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Repositories.GetImmutableReleases returned %+v, want %+v", got, want)
+	}
+
+	const methodName = "GetImmutableReleases"
+	testBadOptions(t, methodName, func() (err error) {
+		_, _, err = client.Repositories.GetImmutableReleases(ctx, "\n", "\n")
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.Repositories.GetImmutableReleases(ctx, "o", "r")
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
+
+func TestRepositoriesService_EnableImmutableReleases(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/immutable-releases", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PUT")
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	ctx := context.Background()
+	if _, err := client.Repositories.EnableImmutableReleases(ctx, "o", "r"); err != nil {
+		t.Errorf("Repositories.EnableImmutableReleases returned error: %v", err)
+	}
+
+	const methodName = "EnableImmutableReleases"
+	testBadOptions(t, methodName, func() (err error) {
+		_, err = client.Repositories.EnableImmutableReleases(ctx, "\n", "\n")
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		return client.Repositories.EnableImmutableReleases(ctx, "o", "r")
+	})
+}
+
+func TestRepositoriesService_DisableImmutableReleases(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/immutable-releases", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	ctx := context.Background()
+	if _, err := client.Repositories.DisableImmutableReleases(ctx, "o", "r"); err != nil {
+		t.Errorf("Repositories.DisableImmutableReleases returned error: %v", err)
+	}
+
+	const methodName = "DisableImmutableReleases"
+	testBadOptions(t, methodName, func() (err error) {
+		_, err = client.Repositories.DisableImmutableReleases(ctx, "\n", "\n")
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		return client.Repositories.DisableImmutableReleases(ctx, "o", "r")
+	})
+}
+
+func TestRepositoriesService_IsPrivateReportingEnabled(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/private-vulnerability-reporting", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"enabled":true}`)
+	})
+
+	ctx := context.Background()
+	enabled, _, err := client.Repositories.IsPrivateReportingEnabled(ctx, "o", "r")
+	if err != nil {
+		t.Errorf("Repositories.IsPrivateReportingEnabled returned error: %v", err)
+	}
+	if want := true; enabled != want {
+		t.Errorf("Repositories.IsPrivateReportingEnabled returned %+v, want %+v", enabled, want)
+	}
+
+	const methodName = "IsPrivateReportingEnabled"
+	testBadOptions(t, methodName, func() (err error) {
+		_, _, err = client.Repositories.IsPrivateReportingEnabled(ctx, "\n", "\n")
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.Repositories.IsPrivateReportingEnabled(ctx, "o", "r")
+		if got {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want false", methodName, got)
+		}
+		return resp, err
+	})
+}
+
+func TestRepositoriesService_EnablePrivateReporting(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/private-vulnerability-reporting", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PUT")
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	ctx := context.Background()
+	if _, err := client.Repositories.EnablePrivateReporting(ctx, "o", "r"); err != nil {
+		t.Errorf("Repositories.EnablePrivateReporting returned error: %v", err)
+	}
+
+	const methodName = "EnablePrivateReporting"
+	testBadOptions(t, methodName, func() (err error) {
+		_, err = client.Repositories.EnablePrivateReporting(ctx, "\n", "\n")
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		return client.Repositories.EnablePrivateReporting(ctx, "o", "r")
+	})
+}
+
+func TestRepositoriesService_DisablePrivateReporting(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/private-vulnerability-reporting", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	ctx := context.Background()
+	if _, err := client.Repositories.DisablePrivateReporting(ctx, "o", "r"); err != nil {
+		t.Errorf("Repositories.DisablePrivateReporting returned error: %v", err)
+	}
+
+	const methodName = "DisablePrivateReporting"
+	testBadOptions(t, methodName, func() (err error) {
+		_, err = client.Repositories.DisablePrivateReporting(ctx, "\n", "\n")
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		return client.Repositories.DisablePrivateReporting(ctx, "o", "r")
+	})
+}
+
 func TestRepositoriesService_ListContributors(t *testing.T) {
 	client, mux, _, teardown := setup()
 	defer teardown()
@@ -8,6 +8,7 @@ package github
 import (
 	"context"
 	"fmt"
+	"time"
 )
 
 // TrafficReferrer represent information about traffic from a referrer .
@@ -46,12 +47,91 @@ type TrafficClones struct {
 	Uniques *int           `json:"uniques,omitempty"`
 }
 
+// Traffic breakdown periods, used as TrafficBreakdownOptions.Per and by AggregateTraffic.
+const (
+	TrafficPerDay  = "day"
+	TrafficPerWeek = "week"
+)
+
 // TrafficBreakdownOptions specifies the parameters to methods that support breakdown per day or week.
 // Can be one of: day, week. Default: day.
 type TrafficBreakdownOptions struct {
 	Per string `url:"per,omitempty"`
 }
 
+// AggregateTraffic groups traffic data, such as TrafficViews.Views or
+// TrafficClones.Clones, into a contiguous series of day or week buckets,
+// sorted by time. per must be TrafficPerDay or TrafficPerWeek. Buckets are
+// in UTC and weeks start on Monday, as they do on GitHub; buckets without
+// any data are filled with zero counts.
+//
+// Unique counts are summed across the data points of a bucket, so when
+// daily data is aggregated into weeks the resulting Uniques is an upper
+// bound. Data points without a Timestamp are ignored.
+func AggregateTraffic(data []*TrafficData, per string) ([]*TrafficData, error) {
+	if per != TrafficPerDay && per != TrafficPerWeek {
+		return nil, fmt.Errorf("invalid traffic breakdown period %q", per)
+	}
+
+	type bucket struct{ count, uniques int }
+	buckets := make(map[time.Time]*bucket)
+	var first, last time.Time
+	for _, d := range data {
+		if d == nil || d.Timestamp == nil {
+			continue
+		}
+		start := trafficBucketStart(d.Timestamp.Time, per)
+		b, ok := buckets[start]
+		if !ok {
+			b = new(bucket)
+			buckets[start] = b
+		}
+		b.count += d.GetCount()
+		b.uniques += d.GetUniques()
+
+		if first.IsZero() || start.Before(first) {
+			first = start
+		}
+		if start.After(last) {
+			last = start
+		}
+	}
+	if len(buckets) == 0 {
+		return nil, nil
+	}
+
+	step := 1
+	if per == TrafficPerWeek {
+		step = 7
+	}
+
+	var series []*TrafficData
+	for t := first; !t.After(last); t = t.AddDate(0, 0, step) {
+		var count, uniques int
+		if b, ok := buckets[t]; ok {
+			count, uniques = b.count, b.uniques
+		}
+		series = append(series, &TrafficData{
+			Timestamp: &Timestamp{t},
+			Count:     Int(count),
+			Uniques:   Int(uniques),
+		})
+	}
+
+	return series, nil
+}
+
+// trafficBucketStart returns the start of the day or week, in UTC, that t falls in.
+func trafficBucketStart(t time.Time, per string) time.Time {
+	t = t.UTC()
+	day := time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+	if per == TrafficPerWeek {
+		// time.Weekday starts on Sunday; GitHub weeks start on Monday.
+		day = day.AddDate(0, 0, -((int(day.Weekday()) + 6) % 7))
+	}
+	return day
+}
+
 // ListTrafficReferrers list the top 10 referrers over the last 14 days.
 //
 // GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/repos/#get-top-referral-sources
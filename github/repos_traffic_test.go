@@ -199,3 +199,55 @@ func TestRepositoriesService_ListTrafficClones(t *testing.T) {
 		return resp, err
 	})
 }
+
+func TestAggregateTraffic(t *testing.T) {
+	day := func(d int) *Timestamp {
+		return &Timestamp{time.Date(2021, time.January, d, 0, 0, 0, 0, time.UTC)}
+	}
+	data := []*TrafficData{
+		{Timestamp: day(4), Count: Int(5), Uniques: Int(3)},
+		{Timestamp: day(1), Count: Int(2), Uniques: Int(1)},
+		{Timestamp: &Timestamp{time.Date(2021, time.January, 3, 16, 0, 0, 0, time.UTC)}, Count: Int(3), Uniques: Int(2)},
+		{Timestamp: day(12), Count: Int(1), Uniques: Int(1)},
+		{Count: Int(100)},
+	}
+
+	daily, err := AggregateTraffic(data, TrafficPerDay)
+	if err != nil {
+		t.Fatalf("AggregateTraffic returned error: %v", err)
+	}
+	if got, want := len(daily), 12; got != want {
+		t.Fatalf("AggregateTraffic returned %v daily buckets, want %v", got, want)
+	}
+	for i, want := range []*TrafficData{
+		{Timestamp: day(1), Count: Int(2), Uniques: Int(1)},
+		{Timestamp: day(2), Count: Int(0), Uniques: Int(0)},
+		{Timestamp: day(3), Count: Int(3), Uniques: Int(2)},
+		{Timestamp: day(4), Count: Int(5), Uniques: Int(3)},
+	} {
+		if !reflect.DeepEqual(daily[i], want) {
+			t.Errorf("AggregateTraffic daily[%v] = %+v, want %+v", i, daily[i], want)
+		}
+	}
+
+	weekly, err := AggregateTraffic(data, TrafficPerWeek)
+	if err != nil {
+		t.Fatalf("AggregateTraffic returned error: %v", err)
+	}
+	want := []*TrafficData{
+		{Timestamp: &Timestamp{time.Date(2020, time.December, 28, 0, 0, 0, 0, time.UTC)}, Count: Int(5), Uniques: Int(3)},
+		{Timestamp: day(4), Count: Int(5), Uniques: Int(3)},
+		{Timestamp: day(11), Count: Int(1), Uniques: Int(1)},
+	}
+	if !reflect.DeepEqual(weekly, want) {
+		t.Errorf("AggregateTraffic weekly = %+v, want %+v", weekly, want)
+	}
+
+	if got, err := AggregateTraffic(nil, TrafficPerDay); err != nil || got != nil {
+		t.Errorf("AggregateTraffic(nil) = %+v, %v, want nil, nil", got, err)
+	}
+
+	if _, err := AggregateTraffic(data, "month"); err == nil {
+		t.Error("AggregateTraffic with invalid period returned no error")
+	}
+}
@@ -0,0 +1,153 @@
+// Copyright 2021 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// RequestOption customizes the requests made with a context created by
+// WithRequestOptions.
+type RequestOption func(*requestOptions)
+
+type requestOptions struct {
+	header     http.Header
+	mediaTypes []string
+	timeout    time.Duration
+	rawBody    bool
+}
+
+// requestOptionsKey is the type of the context key of the options set with
+// WithRequestOptions.
+type requestOptionsKey struct{}
+
+// WithRequestOptions returns a copy of ctx with which the requests of the
+// service methods are customized by opts, in addition to the options already
+// set on ctx, if any. It allows to set custom media types, such as the
+// preview media types which enable the features of the API in preview,
+// extra headers or a timeout, without constructing raw requests:
+//
+//	ctx := github.WithRequestOptions(ctx, github.WithMediaType("application/vnd.github.nebula-preview+json"))
+//	repo, _, err := client.Repositories.Get(ctx, "o", "r")
+//
+// The options are applied by Client.BareDo, and so by Client.Do and all the
+// service methods, after the headers set by the methods themselves.
+func WithRequestOptions(ctx context.Context, opts ...RequestOption) context.Context {
+	o := &requestOptions{header: make(http.Header)}
+	if prev, ok := ctx.Value(requestOptionsKey{}).(*requestOptions); ok {
+		o.header = prev.header.Clone()
+		o.mediaTypes = append(o.mediaTypes, prev.mediaTypes...)
+		o.timeout = prev.timeout
+		o.rawBody = prev.rawBody
+	}
+	for _, opt := range opts {
+		opt(o)
+	}
+	return context.WithValue(ctx, requestOptionsKey{}, o)
+}
+
+// WithHeader sets the header key of the requests to value, replacing the
+// header set by the service method, if any.
+func WithHeader(key, value string) RequestOption {
+	return func(o *requestOptions) {
+		o.header.Set(key, value)
+	}
+}
+
+// WithMediaType adds mediaType to the Accept header of the requests, after
+// the media types set by the service method.
+func WithMediaType(mediaType string) RequestOption {
+	return func(o *requestOptions) {
+		o.mediaTypes = append(o.mediaTypes, mediaType)
+	}
+}
+
+// WithTimeout limits the time of each request to timeout, including the
+// reading of the response body, and the waiting of the rate limit reset if
+// any.
+func WithTimeout(timeout time.Duration) RequestOption {
+	return func(o *requestOptions) {
+		o.timeout = timeout
+	}
+}
+
+// WithRawBody keeps the raw JSON body of the responses decoded by Client.Do
+// in Response.RawBody.
+func WithRawBody() RequestOption {
+	return func(o *requestOptions) {
+		o.rawBody = true
+	}
+}
+
+// applyRequestOptions returns a copy of req customized by the options of ctx,
+// if any, along with the context of the request and, if the options set a
+// timeout, the function which cancels it, which must be called once the
+// response is read.
+func applyRequestOptions(ctx context.Context, req *http.Request) (context.Context, context.CancelFunc, *http.Request) {
+	o, ok := ctx.Value(requestOptionsKey{}).(*requestOptions)
+	if !ok {
+		return ctx, nil, req
+	}
+
+	var cancel context.CancelFunc
+	if o.timeout > 0 {
+		ctx, cancel = context.WithTimeout(ctx, o.timeout)
+	}
+	req = req.Clone(ctx)
+	for k, v := range o.header {
+		req.Header[k] = v
+	}
+	if len(o.mediaTypes) > 0 {
+		accept := req.Header.Values("Accept")
+		for _, mediaType := range o.mediaTypes {
+			if !containsMediaType(accept, mediaType) {
+				accept = append(accept, mediaType)
+			}
+		}
+		req.Header.Set("Accept", strings.Join(accept, ", "))
+	}
+	return ctx, cancel, req
+}
+
+// containsMediaType reports whether the Accept header values accept contain
+// mediaType.
+func containsMediaType(accept []string, mediaType string) bool {
+	for _, v := range accept {
+		for _, t := range strings.Split(v, ",") {
+			if strings.TrimSpace(t) == mediaType {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// cancelOnClose is a response body which cancels the context of its request
+// once closed.
+type cancelOnClose struct {
+	io.ReadCloser
+	cancel context.CancelFunc
+}
+
+func (b *cancelOnClose) Close() error {
+	err := b.ReadCloser.Close()
+	b.cancel()
+	return err
+}
+
+// retainRawBody reports whether the raw body of the response of a request
+// made with ctx is kept in Response.RawBody.
+func (c *Client) retainRawBody(ctx context.Context) bool {
+	if c.rawBodyRetention {
+		return true
+	}
+	o, ok := ctx.Value(requestOptionsKey{}).(*requestOptions)
+	return ok && o.rawBody
+}
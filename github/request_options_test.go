@@ -0,0 +1,95 @@
+// Copyright 2021 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+)
+
+func TestWithRequestOptions(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/issues/1/reactions", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testHeader(t, r, "Accept", mediaTypeReactionsPreview+", application/vnd.github.nebula-preview+json")
+		testHeader(t, r, "X-Custom", "v")
+		testHeader(t, r, "User-Agent", "custom-agent")
+		fmt.Fprint(w, `[{"id":1}]`)
+	})
+
+	ctx := WithRequestOptions(context.Background(), WithHeader("X-Custom", "v"))
+	ctx = WithRequestOptions(ctx,
+		WithHeader("User-Agent", "custom-agent"),
+		WithMediaType(mediaTypeReactionsPreview),
+		WithMediaType("application/vnd.github.nebula-preview+json"),
+	)
+	if _, _, err := client.Reactions.ListIssueReactions(ctx, "o", "r", 1, nil); err != nil {
+		t.Errorf("ListIssueReactions returned error: %v", err)
+	}
+}
+
+func TestWithRequestOptions_timeout(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/slow", func(w http.ResponseWriter, r *http.Request) {
+		select {
+		case <-r.Context().Done():
+		case <-time.After(time.Second):
+		}
+	})
+	mux.HandleFunc("/fast", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"id":1}`)
+	})
+
+	ctx := WithRequestOptions(context.Background(), WithTimeout(10*time.Millisecond))
+	req, _ := client.NewRequest("GET", "slow", nil)
+	if _, err := client.Do(ctx, req, nil); err != context.DeadlineExceeded {
+		t.Errorf("Do returned %v, want %v", err, context.DeadlineExceeded)
+	}
+
+	// The body of the response can still be read within the timeout.
+	ctx = WithRequestOptions(context.Background(), WithTimeout(time.Minute))
+	req, _ = client.NewRequest("GET", "fast", nil)
+	var v struct{ ID int }
+	if _, err := client.Do(ctx, req, &v); err != nil || v.ID != 1 {
+		t.Errorf("Do returned %+v, %v, want ID 1", v, err)
+	}
+}
+
+func TestWithRawBody(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"id":1,"unmodeled":"v"}`)
+	})
+
+	ctx := context.Background()
+	_, resp, err := client.Repositories.Get(ctx, "o", "r")
+	if err != nil {
+		t.Fatalf("Repositories.Get returned error: %v", err)
+	}
+	if resp.RawBody != nil {
+		t.Errorf("Response.RawBody is %s, want nil", resp.RawBody)
+	}
+
+	repo, resp, err := client.Repositories.Get(WithRequestOptions(ctx, WithRawBody()), "o", "r")
+	if err != nil {
+		t.Fatalf("Repositories.Get returned error: %v", err)
+	}
+	if repo.GetID() != 1 {
+		t.Errorf("Repositories.Get returned %+v, want ID 1", repo)
+	}
+	if got, want := string(resp.RawBody), `{"id":1,"unmodeled":"v"}`; got != want {
+		t.Errorf("Response.RawBody is %v, want %v", got, want)
+	}
+}
@@ -0,0 +1,75 @@
+// Copyright 2021 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"encoding/json"
+	"reflect"
+	"sort"
+	"strings"
+)
+
+// SchemaDrift reports the fields of the JSON body of a response which are
+// not in the type it was decoded into, which are the fields added or renamed
+// by GitHub and not yet supported by this library. It is passed to the
+// handler set with WithSchemaDriftHandler.
+type SchemaDrift struct {
+	// Response is the response whose body was decoded. Its body is already
+	// read and closed.
+	Response *Response
+
+	// Type is the type the body was decoded into, such as
+	// "*github.Repository".
+	Type string
+
+	// Fields are the sorted paths of the unknown fields, as in
+	// UnknownFieldsError, such as "owner.foo" or "[].labels[].foo".
+	Fields []string
+
+	// UnknownKeys are the sorted unknown keys of each struct type, such as
+	// {"github.User": {"foo"}}.
+	UnknownKeys map[string][]string
+}
+
+// findSchemaDrift returns the fields of the JSON data which are not in the
+// type of v, or nil if there are none. The values of fields whose type
+// implements json.Unmarshaler, such as Timestamp, are not checked.
+func findSchemaDrift(data []byte, v interface{}) *SchemaDrift {
+	var raw interface{}
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return nil
+	}
+	unknown := map[string]reflect.Type{}
+	unknownFields(reflect.TypeOf(v), raw, "", unknown)
+	if len(unknown) == 0 {
+		return nil
+	}
+
+	drift := &SchemaDrift{Type: reflect.TypeOf(v).String(), UnknownKeys: map[string][]string{}}
+	seen := map[string]bool{}
+	for path, t := range unknown {
+		drift.Fields = append(drift.Fields, path)
+		key := path[strings.LastIndex(path, ".")+1:]
+		if typeKey := t.String() + "." + key; !seen[typeKey] {
+			seen[typeKey] = true
+			drift.UnknownKeys[t.String()] = append(drift.UnknownKeys[t.String()], key)
+		}
+	}
+	sort.Strings(drift.Fields)
+	for _, keys := range drift.UnknownKeys {
+		sort.Strings(keys)
+	}
+	return drift
+}
+
+// reportSchemaDrift calls the schema drift handler of c with the unknown
+// fields of the raw body of resp, decoded into v, if any.
+func (c *Client) reportSchemaDrift(resp *Response, v interface{}, raw []byte) {
+	if drift := findSchemaDrift(raw, v); drift != nil {
+		drift.Response = resp
+		c.schemaDriftHandler(drift)
+	}
+}
@@ -0,0 +1,85 @@
+// Copyright 2021 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+func TestWithSchemaDriftHandler(t *testing.T) {
+	var drifts []*SchemaDrift
+	client, mux, teardown := setupWithOptions(t, WithSchemaDriftHandler(func(d *SchemaDrift) {
+		drifts = append(drifts, d)
+	}))
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/issues", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `[
+			{"number":1,"foo":1,"user":{"login":"l","bar":true},"labels":[{"name":"n","baz":{}}]},
+			{"number":2,"foo":2,"assignee":{"login":"l","bar":false},"created_at":"2006-01-02T15:04:05Z"}
+		]`)
+	})
+	mux.HandleFunc("/repos/o/r", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"id":1,"Full_Name":"o/r"}`)
+	})
+
+	ctx := context.Background()
+	issues, _, err := client.Issues.ListByRepo(ctx, "o", "r", nil)
+	if err != nil {
+		t.Fatalf("Issues.ListByRepo returned error: %v", err)
+	}
+	if len(issues) != 2 {
+		t.Errorf("Issues.ListByRepo returned %v issues, want 2", len(issues))
+	}
+	if len(drifts) != 1 {
+		t.Fatalf("Schema drift handler called %v times, want 1", len(drifts))
+	}
+	want := &SchemaDrift{
+		Response: drifts[0].Response,
+		Type:     "*[]*github.Issue",
+		Fields:   []string{"[].assignee.bar", "[].foo", "[].labels[].baz", "[].user.bar"},
+		UnknownKeys: map[string][]string{
+			"github.Issue": {"foo"},
+			"github.Label": {"baz"},
+			"github.User":  {"bar"},
+		},
+	}
+	if !reflect.DeepEqual(drifts[0], want) {
+		t.Errorf("Schema drift is %+v, want %+v", drifts[0], want)
+	}
+
+	// The field names are matched case-insensitively, as by encoding/json.
+	if _, _, err := client.Repositories.Get(ctx, "o", "r"); err != nil {
+		t.Fatalf("Repositories.Get returned error: %v", err)
+	}
+	if len(drifts) != 1 {
+		t.Errorf("Schema drift handler called for known fields: %+v", drifts[1])
+	}
+}
+
+func TestWithStrictDecoding(t *testing.T) {
+	client, mux, teardown := setupWithOptions(t, WithStrictDecoding())
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"id":1,"foo":"bar"}`)
+	})
+	mux.HandleFunc("/repos/o/known", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"id":1}`)
+	})
+
+	ctx := context.Background()
+	if _, _, err := client.Repositories.Get(ctx, "o", "r"); err == nil {
+		t.Error("Expected error for unknown field.")
+	}
+	if _, _, err := client.Repositories.Get(ctx, "o", "known"); err != nil {
+		t.Errorf("Repositories.Get returned error: %v", err)
+	}
+}
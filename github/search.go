@@ -8,7 +8,9 @@ package github
 import (
 	"context"
 	"fmt"
+	"reflect"
 	"strconv"
+	"strings"
 
 	qs "github.com/google/go-querystring/query"
 )
@@ -51,9 +53,20 @@ type SearchOptions struct {
 	// Whether to retrieve text match metadata with a query
 	TextMatch bool `url:"-"`
 
+	// IncompleteRetries is the number of times a search is sent again when
+	// its results are incomplete, because it timed out. The results of the
+	// last attempt are returned, with IncompleteResults still set if none
+	// of the attempts was complete.
+	IncompleteRetries int `url:"-"`
+
 	ListOptions
 }
 
+// searchResult is implemented by the results of the searches.
+type searchResult interface {
+	incomplete() bool
+}
+
 // Common search parameters.
 type searchParameters struct {
 	Query        string
@@ -67,6 +80,10 @@ type RepositoriesSearchResult struct {
 	Repositories      []*Repository `json:"items,omitempty"`
 }
 
+func (r *RepositoriesSearchResult) incomplete() bool {
+	return r.GetIncompleteResults()
+}
+
 // Repositories searches repositories via various criteria.
 //
 // GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/search/#search-repositories
@@ -83,22 +100,33 @@ type TopicsSearchResult struct {
 	Topics            []*TopicResult `json:"items,omitempty"`
 }
 
+func (r *TopicsSearchResult) incomplete() bool {
+	return r.GetIncompleteResults()
+}
+
+// TopicResult represents a single topics search result. Featured topics
+// are shown on github.com/topics, and curated topics have a description
+// written by GitHub.
 type TopicResult struct {
 	Name             *string    `json:"name,omitempty"`
 	DisplayName      *string    `json:"display_name,omitempty"`
 	ShortDescription *string    `json:"short_description,omitempty"`
 	Description      *string    `json:"description,omitempty"`
 	CreatedBy        *string    `json:"created_by,omitempty"`
+	Released         *string    `json:"released,omitempty"`
 	CreatedAt        *Timestamp `json:"created_at,omitempty"`
 	UpdatedAt        *string    `json:"updated_at,omitempty"`
 	Featured         *bool      `json:"featured,omitempty"`
 	Curated          *bool      `json:"curated,omitempty"`
 	Score            *float64   `json:"score,omitempty"`
+	RepositoryCount  *int       `json:"repository_count,omitempty"`
+	LogoURL          *string    `json:"logo_url,omitempty"`
 }
 
 // Topics finds topics via various criteria. Results are sorted by best match.
 // Please see https://help.github.com/en/articles/searching-topics for more
-// information about search qualifiers.
+// information about search qualifiers. The "is:featured" and "is:curated"
+// qualifiers restrict the results to the featured and curated topics.
 //
 // GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/search/#search-topics
 func (s *SearchService) Topics(ctx context.Context, query string, opts *SearchOptions) (*TopicsSearchResult, *Response, error) {
@@ -114,6 +142,10 @@ type CommitsSearchResult struct {
 	Commits           []*CommitResult `json:"items,omitempty"`
 }
 
+func (r *CommitsSearchResult) incomplete() bool {
+	return r.GetIncompleteResults()
+}
+
 // CommitResult represents a commit object as returned in commit search endpoint response.
 type CommitResult struct {
 	SHA         *string   `json:"sha,omitempty"`
@@ -129,6 +161,16 @@ type CommitResult struct {
 	Score      *float64    `json:"score,omitempty"`
 }
 
+// SearchCommitsSort is the sort order of the results of
+// SearchService.Commits, set as SearchOptions.Sort.
+type SearchCommitsSort string
+
+// These are the possible sort orders of SearchService.Commits.
+const (
+	SearchCommitsByAuthorDate    SearchCommitsSort = "author-date"
+	SearchCommitsByCommitterDate SearchCommitsSort = "committer-date"
+)
+
 // Commits searches commits via various criteria.
 //
 // GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/search/#search-commits
@@ -138,6 +180,67 @@ func (s *SearchService) Commits(ctx context.Context, query string, opts *SearchO
 	return result, resp, err
 }
 
+// Limits of the search queries, in characters and in number of AND, OR and
+// NOT operators.
+const (
+	searchQueryMaxLength    = 256
+	searchQueryMaxOperators = 5
+)
+
+// CommitsBySHA finds the commits with the given SHAs in the repositories of
+// an organization, such as to find where a built artifact comes from. SHAs
+// are searched in batches, each batch being a query combining "hash:"
+// qualifiers with OR. A commit found in several repositories, such as
+// forks, is returned once for each repository. SHAs which are not found are
+// not reported.
+//
+// GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/search/#search-commits
+func (s *SearchService) CommitsBySHA(ctx context.Context, org string, shas []string) ([]*CommitResult, *Response, error) {
+	var commits []*CommitResult
+	var resp *Response
+	for _, query := range commitsBySHAQueries(org, shas) {
+		opts := &SearchOptions{ListOptions: ListOptions{PerPage: 100}}
+		for {
+			result, r, err := s.Commits(ctx, query, opts)
+			resp = r
+			if err != nil {
+				return nil, resp, err
+			}
+			commits = append(commits, result.Commits...)
+			if resp.NextPage == 0 {
+				break
+			}
+			opts.Page = resp.NextPage
+		}
+	}
+	return commits, resp, nil
+}
+
+// commitsBySHAQueries returns the queries searching the commits of org with
+// the given SHAs, within the limits of the search queries.
+func commitsBySHAQueries(org string, shas []string) []string {
+	prefix := "org:" + org + " "
+	var queries []string
+	var terms []string
+	length := len(prefix)
+	for _, sha := range shas {
+		term := "hash:" + sha
+		if len(terms) > 0 && (len(terms) > searchQueryMaxOperators || length+len(" OR ")+len(term) > searchQueryMaxLength) {
+			queries = append(queries, prefix+strings.Join(terms, " OR "))
+			terms, length = nil, len(prefix)
+		}
+		if len(terms) > 0 {
+			length += len(" OR ")
+		}
+		terms = append(terms, term)
+		length += len(term)
+	}
+	if len(terms) > 0 {
+		queries = append(queries, prefix+strings.Join(terms, " OR "))
+	}
+	return queries
+}
+
 // IssuesSearchResult represents the result of an issues search.
 type IssuesSearchResult struct {
 	Total             *int     `json:"total_count,omitempty"`
@@ -145,6 +248,10 @@ type IssuesSearchResult struct {
 	Issues            []*Issue `json:"items,omitempty"`
 }
 
+func (r *IssuesSearchResult) incomplete() bool {
+	return r.GetIncompleteResults()
+}
+
 // Issues searches issues via various criteria.
 //
 // GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/search/#search-issues-and-pull-requests
@@ -161,6 +268,10 @@ type UsersSearchResult struct {
 	Users             []*User `json:"items,omitempty"`
 }
 
+func (r *UsersSearchResult) incomplete() bool {
+	return r.GetIncompleteResults()
+}
+
 // Users searches users via various criteria.
 //
 // GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/search/#search-users
@@ -196,14 +307,27 @@ type CodeSearchResult struct {
 	CodeResults       []*CodeResult `json:"items,omitempty"`
 }
 
+func (r *CodeSearchResult) incomplete() bool {
+	return r.GetIncompleteResults()
+}
+
 // CodeResult represents a single search result.
 type CodeResult struct {
 	Name        *string      `json:"name,omitempty"`
 	Path        *string      `json:"path,omitempty"`
 	SHA         *string      `json:"sha,omitempty"`
+	URL         *string      `json:"url,omitempty"`
+	GitURL      *string      `json:"git_url,omitempty"`
 	HTMLURL     *string      `json:"html_url,omitempty"`
 	Repository  *Repository  `json:"repository,omitempty"`
+	Score       *float64     `json:"score,omitempty"`
 	TextMatches []*TextMatch `json:"text_matches,omitempty"`
+
+	// The following fields are only returned by the new code search engine.
+	FileSize       *int       `json:"file_size,omitempty"`
+	Language       *string    `json:"language,omitempty"`
+	LastModifiedAt *Timestamp `json:"last_modified_at,omitempty"`
+	LineNumbers    []string   `json:"line_numbers,omitempty"`
 }
 
 func (c CodeResult) String() string {
@@ -212,6 +336,12 @@ func (c CodeResult) String() string {
 
 // Code searches code via various criteria.
 //
+// GitHub.com runs the new code search engine, which supports qualifiers such
+// as "symbol:", "content:" and "is:archived", regular expressions (see
+// CodePathRegexp), and returns the additional fields of CodeResult. GitHub
+// Enterprise Server still runs the legacy engine; use CodeSearchEngine to
+// find out which one a server runs.
+//
 // GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/search/#search-code
 func (s *SearchService) Code(ctx context.Context, query string, opts *SearchOptions) (*CodeSearchResult, *Response, error) {
 	result := new(CodeSearchResult)
@@ -219,6 +349,52 @@ func (s *SearchService) Code(ctx context.Context, query string, opts *SearchOpti
 	return result, resp, err
 }
 
+// CodeSearchEngineType is the code search engine run by a server.
+type CodeSearchEngineType string
+
+// These are the possible code search engines.
+const (
+	// CodeSearchLegacy is the engine of GitHub Enterprise Server, which does
+	// not support regular expressions nor the newer qualifiers.
+	CodeSearchLegacy CodeSearchEngineType = "legacy"
+	// CodeSearchNew is the engine of GitHub.com.
+	CodeSearchNew CodeSearchEngineType = "new"
+)
+
+// CodeSearchEngine returns the code search engine run by the server of the
+// client. No request is sent for GitHub.com; for other servers, the meta
+// endpoint is used to detect GitHub Enterprise Server installations.
+//
+// GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/meta#get-github-meta-information
+func (s *SearchService) CodeSearchEngine(ctx context.Context) (CodeSearchEngineType, *Response, error) {
+	if s.client.BaseURL.Host == "api.github.com" {
+		return CodeSearchNew, nil, nil
+	}
+
+	meta, resp, err := s.client.APIMeta(ctx)
+	if err != nil {
+		return "", resp, err
+	}
+	if meta.GetInstalledVersion() != "" {
+		return CodeSearchLegacy, resp, nil
+	}
+	return CodeSearchNew, resp, nil
+}
+
+// CodePathRegexp returns a "path:" qualifier matching the file paths with
+// the regular expression expr, such as `\.go$`. Slashes of expr are escaped.
+// Regular expressions are only supported by the new code search engine.
+func CodePathRegexp(expr string) string {
+	return "path:" + CodeRegexp(expr)
+}
+
+// CodeRegexp returns the regular expression expr as a value of the code
+// search queries, such as `/^src\/.*\.go$/` for `^src/.*\.go$`, with its
+// slashes escaped.
+func CodeRegexp(expr string) string {
+	return "/" + strings.ReplaceAll(expr, "/", `\/`) + "/"
+}
+
 // LabelsSearchResult represents the result of a code search.
 type LabelsSearchResult struct {
 	Total             *int           `json:"total_count,omitempty"`
@@ -226,15 +402,21 @@ type LabelsSearchResult struct {
 	Labels            []*LabelResult `json:"items,omitempty"`
 }
 
+func (r *LabelsSearchResult) incomplete() bool {
+	return r.GetIncompleteResults()
+}
+
 // LabelResult represents a single search result.
 type LabelResult struct {
-	ID          *int64   `json:"id,omitempty"`
-	URL         *string  `json:"url,omitempty"`
-	Name        *string  `json:"name,omitempty"`
-	Color       *string  `json:"color,omitempty"`
-	Default     *bool    `json:"default,omitempty"`
-	Description *string  `json:"description,omitempty"`
-	Score       *float64 `json:"score,omitempty"`
+	ID          *int64       `json:"id,omitempty"`
+	NodeID      *string      `json:"node_id,omitempty"`
+	URL         *string      `json:"url,omitempty"`
+	Name        *string      `json:"name,omitempty"`
+	Color       *string      `json:"color,omitempty"`
+	Default     *bool        `json:"default,omitempty"`
+	Description *string      `json:"description,omitempty"`
+	Score       *float64     `json:"score,omitempty"`
+	TextMatches []*TextMatch `json:"text_matches,omitempty"`
 }
 
 func (l LabelResult) String() string {
@@ -250,12 +432,26 @@ func (s *SearchService) Labels(ctx context.Context, repoID int64, query string,
 	return result, resp, err
 }
 
+// LabelsByRepo searches labels in the repository owner/repo, whose ID is
+// first fetched, via various criteria. Callers searching the same
+// repository repeatedly, such as for typeahead, should rather fetch the ID
+// once and use Labels.
+//
+// GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/search/#search-labels
+func (s *SearchService) LabelsByRepo(ctx context.Context, owner, repo, query string, opts *SearchOptions) (*LabelsSearchResult, *Response, error) {
+	r, resp, err := s.client.Repositories.Get(ctx, owner, repo)
+	if err != nil {
+		return nil, resp, err
+	}
+	return s.Labels(ctx, r.GetID(), query, opts)
+}
+
 // Helper function that executes search queries against different
 // GitHub search types (repositories, commits, code, issues, users, labels)
 //
 // If searchParameters.Query includes multiple condition, it MUST NOT include "+" as condition separator.
 // For example, querying with "language:c++" and "leveldb", then searchParameters.Query should be "language:c++ leveldb" but not "language:c+++leveldb".
-func (s *SearchService) search(ctx context.Context, searchType string, parameters *searchParameters, opts *SearchOptions, result interface{}) (*Response, error) {
+func (s *SearchService) search(ctx context.Context, searchType string, parameters *searchParameters, opts *SearchOptions, result searchResult) (*Response, error) {
 	params, err := qs.Values(opts)
 	if err != nil {
 		return nil, err
@@ -272,10 +468,6 @@ func (s *SearchService) search(ctx context.Context, searchType string, parameter
 	}
 
 	switch {
-	case searchType == "commits":
-		// Accept header for search commits preview endpoint
-		// TODO: remove custom Accept header when this API fully launches.
-		req.Header.Set("Accept", mediaTypeCommitSearchPreview)
 	case searchType == "topics":
 		// Accept header for search repositories based on topics preview endpoint
 		// TODO: remove custom Accept header when this API fully launches.
@@ -290,5 +482,16 @@ func (s *SearchService) search(ctx context.Context, searchType string, parameter
 		req.Header.Set("Accept", "application/vnd.github.v3.text-match+json")
 	}
 
-	return s.client.Do(ctx, req, result)
+	resp, err := s.client.Do(ctx, req, result)
+	if opts == nil {
+		return resp, err
+	}
+	for retries := 0; err == nil && result.incomplete() && retries < opts.IncompleteRetries; retries++ {
+		// Decode the new attempt into a zero result, not merged with the
+		// previous one.
+		v := reflect.ValueOf(result).Elem()
+		v.Set(reflect.Zero(v.Type()))
+		resp, err = s.client.Do(ctx, req, result)
+	}
+	return resp, err
 }
@@ -0,0 +1,186 @@
+// Copyright 2021 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"strings"
+	"time"
+)
+
+// SearchIssuesGraphQLOptions specifies the optional parameters to the
+// SearchService.IssuesGraphQL method.
+type SearchIssuesGraphQLOptions struct {
+	// Sort and Order are the same as in SearchOptions. They are sent as a
+	// "sort:" qualifier, as the GraphQL API has no such parameters.
+	Sort  string
+	Order string
+
+	// After is a cursor for paginating through the results.
+	// Set it from Response.After.
+	After string
+
+	// The number of results to include per page (max 100). Default: 100.
+	PerPage int
+}
+
+// searchIssueFields are the GraphQL fields of an Issue, shared by the
+// issues and the pull requests.
+const searchIssueFields = `
+databaseId
+id
+number
+title
+body
+state
+locked
+url
+createdAt
+updatedAt
+closedAt
+author { login }
+repository { nameWithOwner }
+labels(first: 100) { nodes { name color description } }
+comments { totalCount }`
+
+// graphQLSearchIssue is the GraphQL representation of an Issue, or of a
+// pull request.
+type graphQLSearchIssue struct {
+	TypeName   string        `json:"__typename"`
+	DatabaseID *int64        `json:"databaseId"`
+	ID         *string       `json:"id"`
+	Number     *int          `json:"number"`
+	Title      *string       `json:"title"`
+	Body       *string       `json:"body"`
+	State      *string       `json:"state"`
+	Locked     *bool         `json:"locked"`
+	URL        *string       `json:"url"`
+	CreatedAt  *time.Time    `json:"createdAt"`
+	UpdatedAt  *time.Time    `json:"updatedAt"`
+	ClosedAt   *time.Time    `json:"closedAt"`
+	Author     *graphQLActor `json:"author"`
+	Repository *struct {
+		NameWithOwner *string `json:"nameWithOwner"`
+	} `json:"repository"`
+	Labels struct {
+		Nodes []*struct {
+			Name        *string `json:"name"`
+			Color       *string `json:"color"`
+			Description *string `json:"description"`
+		} `json:"nodes"`
+	} `json:"labels"`
+	Comments struct {
+		TotalCount *int `json:"totalCount"`
+	} `json:"comments"`
+}
+
+// issue converts i to an Issue. The State of merged pull requests, which
+// is MERGED in the GraphQL API, is "closed" as in the REST API.
+func (i *graphQLSearchIssue) issue() *Issue {
+	issue := &Issue{
+		ID:        i.DatabaseID,
+		NodeID:    i.ID,
+		Number:    i.Number,
+		Title:     i.Title,
+		Body:      i.Body,
+		Locked:    i.Locked,
+		HTMLURL:   i.URL,
+		CreatedAt: i.CreatedAt,
+		UpdatedAt: i.UpdatedAt,
+		ClosedAt:  i.ClosedAt,
+		User:      i.Author.user(),
+		Comments:  i.Comments.TotalCount,
+	}
+	if i.State != nil {
+		state := strings.ToLower(*i.State)
+		if state == "merged" {
+			state = "closed"
+		}
+		issue.State = &state
+	}
+	if i.Repository != nil {
+		issue.Repository = &Repository{FullName: i.Repository.NameWithOwner}
+	}
+	for _, l := range i.Labels.Nodes {
+		issue.Labels = append(issue.Labels, &Label{Name: l.Name, Color: l.Color, Description: l.Description})
+	}
+	if i.TypeName == "PullRequest" {
+		issue.PullRequestLinks = &PullRequestLinks{HTMLURL: i.URL}
+	}
+	return issue
+}
+
+// IssuesGraphQL searches issues and pull requests via various criteria,
+// like Issues, but with the GitHub GraphQL API. Results are paginated with
+// cursors: if there are more results, Response.After is set to the cursor
+// to pass as opts.After.
+//
+// Only the ID, NodeID, Number, Title, Body, State, Locked, HTMLURL,
+// CreatedAt, UpdatedAt, ClosedAt, User, Repository.FullName, Labels and
+// Comments fields of the issues are populated, and PullRequestLinks is
+// non-nil for pull requests. IncompleteResults is never set.
+//
+// GitHub applies the same cap of SearchResultCap results to the GraphQL
+// searches. Use AllIssues with SearchIteratorOptions.PartitionByCreated
+// and UseGraphQL to iterate over more results.
+//
+// GitHub API docs: https://docs.github.com/en/graphql/reference/queries#search
+func (s *SearchService) IssuesGraphQL(ctx context.Context, query string, opts *SearchIssuesGraphQLOptions) (*IssuesSearchResult, *Response, error) {
+	gqlQuery := `query($query: String!, $first: Int!, $after: String) {
+  search(type: ISSUE, query: $query, first: $first, after: $after) {
+    issueCount
+    pageInfo { hasNextPage endCursor }
+    nodes {
+      __typename
+      ... on Issue {` + searchIssueFields + `
+      }
+      ... on PullRequest {` + searchIssueFields + `
+      }
+    }
+  }
+}`
+	variables := map[string]interface{}{
+		"query": query,
+		"first": 100,
+	}
+	if opts != nil {
+		if opts.Sort != "" {
+			sort := opts.Sort
+			if opts.Order != "" {
+				sort += "-" + opts.Order
+			}
+			variables["query"] = strings.TrimSpace(query + " sort:" + sort)
+		}
+		if opts.PerPage > 0 {
+			variables["first"] = opts.PerPage
+		}
+		if opts.After != "" {
+			variables["after"] = opts.After
+		}
+	}
+
+	var data struct {
+		Search struct {
+			IssueCount *int                  `json:"issueCount"`
+			PageInfo   graphQLPageInfo       `json:"pageInfo"`
+			Nodes      []*graphQLSearchIssue `json:"nodes"`
+		} `json:"search"`
+	}
+	resp, err := s.client.graphQL(ctx, gqlQuery, variables, &data)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	if data.Search.PageInfo.HasNextPage {
+		resp.After = data.Search.PageInfo.EndCursor
+	}
+
+	result := &IssuesSearchResult{Total: data.Search.IssueCount}
+	for _, i := range data.Search.Nodes {
+		result.Issues = append(result.Issues, i.issue())
+	}
+	return result, resp, nil
+}
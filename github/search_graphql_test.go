@@ -0,0 +1,184 @@
+// Copyright 2021 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+const searchIssueJSON = `{
+	"__typename": "Issue",
+	"databaseId": 1,
+	"id": "I1",
+	"number": 10,
+	"title": "t",
+	"body": "b",
+	"state": "OPEN",
+	"locked": false,
+	"url": "https://github.com/o/r/issues/10",
+	"createdAt": "2006-01-02T15:04:05Z",
+	"updatedAt": "2006-01-02T15:04:05Z",
+	"closedAt": null,
+	"author": {"login": "a"},
+	"repository": {"nameWithOwner": "o/r"},
+	"labels": {"nodes": [{"name": "bug", "color": "f00", "description": "d"}]},
+	"comments": {"totalCount": 3}
+}`
+
+const searchPullRequestJSON = `{
+	"__typename": "PullRequest",
+	"databaseId": 2,
+	"number": 11,
+	"state": "MERGED",
+	"url": "https://github.com/o/r/pull/11",
+	"author": null,
+	"labels": {"nodes": []},
+	"comments": {"totalCount": 0}
+}`
+
+func TestSearchService_IssuesGraphQL(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/graphql", func(w http.ResponseWriter, r *http.Request) {
+		v := new(graphQLRequest)
+		json.NewDecoder(r.Body).Decode(v)
+
+		testMethod(t, r, "POST")
+		if !strings.Contains(v.Query, "search(type: ISSUE, query: $query, first: $first, after: $after)") {
+			t.Errorf("Request query = %q, want search query", v.Query)
+		}
+		wantVars := map[string]interface{}{
+			"query": "repo:o/r sort:created-asc",
+			"first": float64(2),
+			"after": "c1",
+		}
+		if !reflect.DeepEqual(v.Variables, wantVars) {
+			t.Errorf("Request variables = %+v, want %+v", v.Variables, wantVars)
+		}
+
+		fmt.Fprint(w, `{"data":{"search":{
+			"issueCount": 5000,
+			"pageInfo": {"hasNextPage": true, "endCursor": "c2"},
+			"nodes": [`+searchIssueJSON+`,`+searchPullRequestJSON+`]
+		}}}`)
+	})
+
+	opts := &SearchIssuesGraphQLOptions{Sort: "created", Order: "asc", After: "c1", PerPage: 2}
+	ctx := context.Background()
+	result, resp, err := client.Search.IssuesGraphQL(ctx, "repo:o/r", opts)
+	if err != nil {
+		t.Errorf("Search.IssuesGraphQL returned error: %v", err)
+	}
+
+	created := time.Date(2006, time.January, 2, 15, 4, 5, 0, time.UTC)
+	want := &IssuesSearchResult{
+		Total: Int(5000),
+		Issues: []*Issue{
+			{
+				ID:         Int64(1),
+				NodeID:     String("I1"),
+				Number:     Int(10),
+				Title:      String("t"),
+				Body:       String("b"),
+				State:      String("open"),
+				Locked:     Bool(false),
+				HTMLURL:    String("https://github.com/o/r/issues/10"),
+				CreatedAt:  &created,
+				UpdatedAt:  &created,
+				User:       &User{Login: String("a")},
+				Repository: &Repository{FullName: String("o/r")},
+				Labels:     []*Label{{Name: String("bug"), Color: String("f00"), Description: String("d")}},
+				Comments:   Int(3),
+			},
+			{
+				ID:               Int64(2),
+				Number:           Int(11),
+				State:            String("closed"),
+				HTMLURL:          String("https://github.com/o/r/pull/11"),
+				Comments:         Int(0),
+				PullRequestLinks: &PullRequestLinks{HTMLURL: String("https://github.com/o/r/pull/11")},
+			},
+		},
+	}
+	if !reflect.DeepEqual(result, want) {
+		t.Errorf("Search.IssuesGraphQL returned %+v, want %+v", result, want)
+	}
+	if got, want := resp.After, "c2"; got != want {
+		t.Errorf("Search.IssuesGraphQL returned After %v, want %v", got, want)
+	}
+
+	const methodName = "IssuesGraphQL"
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.Search.IssuesGraphQL(ctx, "repo:o/r", opts)
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
+
+func TestSearchService_AllIssues_useGraphQL(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/graphql", func(w http.ResponseWriter, r *http.Request) {
+		v := new(graphQLRequest)
+		json.NewDecoder(r.Body).Decode(v)
+
+		testMethod(t, r, "POST")
+		if v.Variables["query"] != "is:open" || v.Variables["first"] != float64(100) {
+			t.Errorf("Request variables = %+v, want query and first", v.Variables)
+		}
+		switch v.Variables["after"] {
+		case nil:
+			fmt.Fprint(w, `{"data":{"search":{
+				"issueCount": 2,
+				"pageInfo": {"hasNextPage": true, "endCursor": "c1"},
+				"nodes": [`+searchIssueJSON+`]
+			}}}`)
+		case "c1":
+			fmt.Fprint(w, `{"data":{"search":{
+				"issueCount": 2,
+				"pageInfo": {"hasNextPage": false, "endCursor": "c2"},
+				"nodes": [`+searchPullRequestJSON+`]
+			}}}`)
+		default:
+			t.Errorf("Unexpected cursor %v", v.Variables["after"])
+		}
+	})
+
+	ctx := context.Background()
+	it := client.Search.AllIssues(ctx, "is:open", &SearchIteratorOptions{UseGraphQL: true})
+	var got []int
+	for it.Next() {
+		got = append(got, it.Issue().GetNumber())
+		if it.Issue().IsPullRequest() != (it.Issue().GetNumber() == 11) {
+			t.Errorf("Search.AllIssues returned issue %v with PullRequestLinks %v", it.Issue().GetNumber(), it.Issue().PullRequestLinks)
+		}
+	}
+	if err := it.Err(); err != nil {
+		t.Errorf("Search.AllIssues returned error: %v", err)
+	}
+	if want := []int{10, 11}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Search.AllIssues returned %v, want %v", got, want)
+	}
+	if it.Total() != 2 {
+		t.Errorf("Search.AllIssues iterator has Total %v, want 2", it.Total())
+	}
+
+	it2 := client.Search.AllCode(ctx, "q", &SearchIteratorOptions{UseGraphQL: true})
+	if it2.Next() || it2.Err() == nil {
+		t.Errorf("Search.AllCode with UseGraphQL returned no error")
+	}
+}
@@ -0,0 +1,488 @@
+// Copyright 2021 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"strings"
+	"time"
+)
+
+// SearchResultCap is the maximum number of results the search API returns
+// for a query, whatever the total count of the results. Further pages are
+// rejected.
+const SearchResultCap = 1000
+
+// SearchIteratorOptions specifies the optional parameters to the
+// SearchService iterators, such as AllIssues.
+type SearchIteratorOptions struct {
+	// SearchOptions.IncompleteRetries also applies to each page.
+	SearchOptions
+
+	// PartitionByCreated, only supported by AllIssues, splits the query
+	// into ranges of creation dates, each having at most SearchResultCap
+	// results, so that all the results can be iterated. The query must not
+	// have a "created:" qualifier. The results are then sorted by creation
+	// date, in ascending order, and Sort and Order are ignored.
+	PartitionByCreated bool
+
+	// CreatedSince and CreatedUntil bound the creation dates partitioned
+	// with PartitionByCreated. They default to the launch of GitHub and to
+	// the current time.
+	CreatedSince time.Time
+	CreatedUntil time.Time
+
+	// UseGraphQL, only supported by AllIssues, fetches the results with
+	// SearchService.IssuesGraphQL rather than with the REST API, so that
+	// they are paginated with cursors. IncompleteRetries and TextMatch are
+	// then ignored.
+	UseGraphQL bool
+}
+
+// searchEpoch is the default start of the creation dates partitioned by the
+// search iterators, before which nothing was created on GitHub.
+var searchEpoch = time.Date(2007, time.October, 1, 0, 0, 0, 0, time.UTC)
+
+// searchRawResult represents a page of search results of any type.
+type searchRawResult struct {
+	Total             int               `json:"total_count"`
+	IncompleteResults bool              `json:"incomplete_results"`
+	Items             []json.RawMessage `json:"items"`
+}
+
+func (r *searchRawResult) incomplete() bool {
+	return r.IncompleteResults
+}
+
+// searchTimeRange is a range of creation dates, including both ends.
+type searchTimeRange struct {
+	since, until time.Time
+}
+
+func (r searchTimeRange) qualifier() string {
+	const layout = "2006-01-02T15:04:05Z"
+	return "created:" + r.since.UTC().Format(layout) + ".." + r.until.UTC().Format(layout)
+}
+
+// searchIterator iterates over the raw results of a search, which are
+// decoded by the iterators embedding it. It provides their Err, Response,
+// Total, IncompleteResults and Capped methods.
+type searchIterator struct {
+	ctx        context.Context
+	s          *SearchService
+	searchType string
+	query      string
+	opts       SearchIteratorOptions
+
+	ranges  []searchTimeRange // ranges left to search, the next one last
+	current string            // query being paginated, empty between queries
+	after   string            // cursor of the next page of current, with UseGraphQL
+	fetched int               // results fetched for current
+
+	items      []json.RawMessage // remaining results of the current page
+	item       json.RawMessage
+	total      int
+	incomplete bool
+	capped     bool
+	resp       *Response
+	err        error
+	done       bool
+}
+
+func newSearchIterator(ctx context.Context, s *SearchService, searchType, query string, opts *SearchIteratorOptions) searchIterator {
+	it := searchIterator{ctx: ctx, s: s, searchType: searchType, query: query}
+	if opts != nil {
+		it.opts = *opts
+	}
+	if it.opts.PerPage == 0 {
+		it.opts.PerPage = 100
+	}
+
+	if it.opts.PartitionByCreated {
+		if strings.Contains(query, "created:") {
+			it.err = errors.New("search queries partitioned by creation date cannot have a created: qualifier")
+		}
+		r := searchTimeRange{since: it.opts.CreatedSince, until: it.opts.CreatedUntil}
+		if r.since.IsZero() {
+			r.since = searchEpoch
+		}
+		if r.until.IsZero() {
+			r.until = time.Now()
+		}
+		it.ranges = []searchTimeRange{{since: r.since.Truncate(time.Second), until: r.until.Truncate(time.Second)}}
+		it.opts.Sort = "created"
+		it.opts.Order = "asc"
+	}
+	return it
+}
+
+// next advances the iterator to the next raw result.
+func (it *searchIterator) next() bool {
+	for len(it.items) == 0 {
+		if it.done || it.err != nil {
+			it.item = nil
+			return false
+		}
+		it.fetchPage()
+	}
+
+	it.item, it.items = it.items[0], it.items[1:]
+	return true
+}
+
+// fetchPage fetches the next page of results, starting the next query if
+// needed.
+func (it *searchIterator) fetchPage() {
+	var r searchTimeRange
+	first := it.current == ""
+	if first {
+		if !it.opts.PartitionByCreated {
+			it.current = it.query
+		} else {
+			if len(it.ranges) == 0 {
+				it.done = true
+				return
+			}
+			r, it.ranges = it.ranges[len(it.ranges)-1], it.ranges[:len(it.ranges)-1]
+			it.current = strings.TrimSpace(it.query + " " + r.qualifier())
+		}
+		it.fetched = 0
+		it.opts.Page = 0
+		it.after = ""
+	}
+
+	result, resp, err := it.fetch()
+	it.resp = resp
+	if err != nil {
+		it.err = err
+		return
+	}
+	total := result.Total
+
+	if first && it.opts.PartitionByCreated && total > SearchResultCap && r.until.After(r.since) {
+		// Search the two halves of the range, the earliest first.
+		mid := r.since.Add(r.until.Sub(r.since) / 2).Truncate(time.Second)
+		it.ranges = append(it.ranges,
+			searchTimeRange{since: mid.Add(time.Second), until: r.until},
+			searchTimeRange{since: r.since, until: mid})
+		it.current = ""
+		return
+	}
+
+	if first {
+		it.total += total
+		if total > SearchResultCap {
+			it.capped = true
+		}
+	}
+	if result.IncompleteResults {
+		it.incomplete = true
+	}
+	it.items = result.Items
+	it.fetched += len(result.Items)
+
+	more := resp.NextPage != 0
+	if it.opts.UseGraphQL {
+		more = resp.After != ""
+	}
+	if !more || len(result.Items) == 0 || it.fetched >= SearchResultCap {
+		if it.opts.PartitionByCreated {
+			it.current = ""
+		} else {
+			it.done = true
+		}
+		return
+	}
+	it.opts.Page = resp.NextPage
+	it.after = resp.After
+}
+
+// fetch fetches the current page of the current query, with the REST API
+// or with the GraphQL API. The issues returned by the GraphQL API are
+// encoded back to JSON, so that all the results are handled alike.
+func (it *searchIterator) fetch() (*searchRawResult, *Response, error) {
+	result := new(searchRawResult)
+	if !it.opts.UseGraphQL {
+		resp, err := it.s.search(it.ctx, it.searchType, &searchParameters{Query: it.current}, &it.opts.SearchOptions, result)
+		return result, resp, err
+	}
+
+	opts := &SearchIssuesGraphQLOptions{
+		Sort:    it.opts.Sort,
+		Order:   it.opts.Order,
+		After:   it.after,
+		PerPage: it.opts.PerPage,
+	}
+	issues, resp, err := it.s.IssuesGraphQL(it.ctx, it.current, opts)
+	if err != nil {
+		return nil, resp, err
+	}
+	result.Total = issues.GetTotal()
+	for _, issue := range issues.Issues {
+		item, err := json.Marshal(issue)
+		if err != nil {
+			return nil, resp, err
+		}
+		result.Items = append(result.Items, item)
+	}
+	return result, resp, nil
+}
+
+// Err returns the error, if any, that stopped the iteration.
+func (it *searchIterator) Err() error {
+	return it.err
+}
+
+// Response returns the response of the last page fetched, or nil if no
+// page has been fetched yet.
+func (it *searchIterator) Response() *Response {
+	return it.resp
+}
+
+// Total returns the total count of the results of the query, as reported by
+// the pages fetched so far. When partitioning by creation date, it is the
+// sum of the counts of the ranges fetched so far.
+func (it *searchIterator) Total() int {
+	return it.total
+}
+
+// IncompleteResults reports whether any page fetched so far had incomplete
+// results, even after the retries of SearchOptions.IncompleteRetries.
+func (it *searchIterator) IncompleteResults() bool {
+	return it.incomplete
+}
+
+// Capped reports whether results were skipped because the query, or one of
+// its creation date ranges, had more than SearchResultCap results.
+func (it *searchIterator) Capped() bool {
+	return it.capped
+}
+
+// decode decodes the current raw result into v, stopping the iteration on
+// error.
+func (it *searchIterator) decode(v interface{}) bool {
+	if err := json.Unmarshal(it.item, v); err != nil {
+		it.err = err
+		it.items = nil
+		it.item = nil
+		return false
+	}
+	return true
+}
+
+// IssuesSearchIterator iterates over the results of an issues search,
+// fetching the pages of SearchService.Issues as needed. It is used like a
+// bufio.Scanner:
+//
+//	it := client.Search.AllIssues(ctx, "repo:o/r is:pr", nil)
+//	for it.Next() {
+//		issue := it.Issue()
+//		// ...
+//	}
+//	if err := it.Err(); err != nil {
+//		// ...
+//	}
+type IssuesSearchIterator struct {
+	searchIterator
+	issue *Issue
+}
+
+// AllIssues returns an iterator over all the results of an issues search,
+// up to SearchResultCap results unless opts.PartitionByCreated is set. No
+// request is made until the first call to Next.
+//
+// GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/search/#search-issues-and-pull-requests
+func (s *SearchService) AllIssues(ctx context.Context, query string, opts *SearchIteratorOptions) *IssuesSearchIterator {
+	return &IssuesSearchIterator{searchIterator: newSearchIterator(ctx, s, "issues", query, opts)}
+}
+
+// Next advances the iterator to the next issue, which is then available
+// through Issue. It returns false when there are no more issues or an error
+// occurred, in which case it is returned by Err.
+func (it *IssuesSearchIterator) Next() bool {
+	it.issue = nil
+	if !it.next() {
+		return false
+	}
+	issue := new(Issue)
+	if !it.decode(issue) {
+		return false
+	}
+	it.issue = issue
+	return true
+}
+
+// Issue returns the current issue, or nil if Next has not been called or
+// returned false.
+func (it *IssuesSearchIterator) Issue() *Issue {
+	return it.issue
+}
+
+// RepositoriesSearchIterator iterates over the results of a repositories
+// search, fetching the pages of SearchService.Repositories as needed. It is
+// used like IssuesSearchIterator.
+type RepositoriesSearchIterator struct {
+	searchIterator
+	repo *Repository
+}
+
+// AllRepositories returns an iterator over all the results of a
+// repositories search, up to SearchResultCap results. No request is made
+// until the first call to Next.
+//
+// GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/search/#search-repositories
+func (s *SearchService) AllRepositories(ctx context.Context, query string, opts *SearchIteratorOptions) *RepositoriesSearchIterator {
+	return &RepositoriesSearchIterator{searchIterator: newBasicSearchIterator(ctx, s, "repositories", query, opts)}
+}
+
+// Next advances the iterator to the next repository, which is then
+// available through Repository.
+func (it *RepositoriesSearchIterator) Next() bool {
+	it.repo = nil
+	if !it.next() {
+		return false
+	}
+	repo := new(Repository)
+	if !it.decode(repo) {
+		return false
+	}
+	it.repo = repo
+	return true
+}
+
+// Repository returns the current repository, or nil if Next has not been
+// called or returned false.
+func (it *RepositoriesSearchIterator) Repository() *Repository {
+	return it.repo
+}
+
+// CodeSearchIterator iterates over the results of a code search, fetching
+// the pages of SearchService.Code as needed. It is used like
+// IssuesSearchIterator.
+type CodeSearchIterator struct {
+	searchIterator
+	code *CodeResult
+}
+
+// AllCode returns an iterator over all the results of a code search, up to
+// SearchResultCap results. No request is made until the first call to Next.
+//
+// GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/search/#search-code
+func (s *SearchService) AllCode(ctx context.Context, query string, opts *SearchIteratorOptions) *CodeSearchIterator {
+	return &CodeSearchIterator{searchIterator: newBasicSearchIterator(ctx, s, "code", query, opts)}
+}
+
+// Next advances the iterator to the next code result, which is then
+// available through CodeResult.
+func (it *CodeSearchIterator) Next() bool {
+	it.code = nil
+	if !it.next() {
+		return false
+	}
+	code := new(CodeResult)
+	if !it.decode(code) {
+		return false
+	}
+	it.code = code
+	return true
+}
+
+// CodeResult returns the current code result, or nil if Next has not been
+// called or returned false.
+func (it *CodeSearchIterator) CodeResult() *CodeResult {
+	return it.code
+}
+
+// CommitsSearchIterator iterates over the results of a commits search,
+// fetching the pages of SearchService.Commits as needed. It is used like
+// IssuesSearchIterator.
+type CommitsSearchIterator struct {
+	searchIterator
+	commit *CommitResult
+}
+
+// AllCommits returns an iterator over all the results of a commits search,
+// up to SearchResultCap results. No request is made until the first call to
+// Next.
+//
+// GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/search/#search-commits
+func (s *SearchService) AllCommits(ctx context.Context, query string, opts *SearchIteratorOptions) *CommitsSearchIterator {
+	return &CommitsSearchIterator{searchIterator: newBasicSearchIterator(ctx, s, "commits", query, opts)}
+}
+
+// Next advances the iterator to the next commit, which is then available
+// through Commit.
+func (it *CommitsSearchIterator) Next() bool {
+	it.commit = nil
+	if !it.next() {
+		return false
+	}
+	commit := new(CommitResult)
+	if !it.decode(commit) {
+		return false
+	}
+	it.commit = commit
+	return true
+}
+
+// Commit returns the current commit, or nil if Next has not been called or
+// returned false.
+func (it *CommitsSearchIterator) Commit() *CommitResult {
+	return it.commit
+}
+
+// UsersSearchIterator iterates over the results of a users search,
+// fetching the pages of SearchService.Users as needed. It is used like
+// IssuesSearchIterator.
+type UsersSearchIterator struct {
+	searchIterator
+	user *User
+}
+
+// AllUsers returns an iterator over all the results of a users search, up
+// to SearchResultCap results. No request is made until the first call to
+// Next.
+//
+// GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/search/#search-users
+func (s *SearchService) AllUsers(ctx context.Context, query string, opts *SearchIteratorOptions) *UsersSearchIterator {
+	return &UsersSearchIterator{searchIterator: newBasicSearchIterator(ctx, s, "users", query, opts)}
+}
+
+// Next advances the iterator to the next user, which is then available
+// through User.
+func (it *UsersSearchIterator) Next() bool {
+	it.user = nil
+	if !it.next() {
+		return false
+	}
+	user := new(User)
+	if !it.decode(user) {
+		return false
+	}
+	it.user = user
+	return true
+}
+
+// User returns the current user, or nil if Next has not been called or
+// returned false.
+func (it *UsersSearchIterator) User() *User {
+	return it.user
+}
+
+// newBasicSearchIterator returns a search iterator for the search types
+// which do not support PartitionByCreated nor UseGraphQL.
+func newBasicSearchIterator(ctx context.Context, s *SearchService, searchType, query string, opts *SearchIteratorOptions) searchIterator {
+	it := newSearchIterator(ctx, s, searchType, query, opts)
+	if it.opts.PartitionByCreated {
+		it.err = errors.New("search results can only be partitioned by creation date for issues")
+	}
+	if it.opts.UseGraphQL {
+		it.err = errors.New("search results can only be fetched with GraphQL for issues")
+	}
+	return it
+}
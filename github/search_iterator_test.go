@@ -0,0 +1,230 @@
+// Copyright 2021 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"reflect"
+	"regexp"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestSearchService_AllIssues(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/search/issues", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		switch r.FormValue("page") {
+		case "":
+			testFormValues(t, r, values{"q": "is:pr", "per_page": "2"})
+			w.Header().Set("Link", `<https://api.github.com/search/issues?q=is%3Apr&page=2&per_page=2>; rel="next"`)
+			fmt.Fprint(w, `{"total_count": 3, "incomplete_results": false, "items": [{"number":1},{"number":2}]}`)
+		case "2":
+			testFormValues(t, r, values{"q": "is:pr", "page": "2", "per_page": "2"})
+			fmt.Fprint(w, `{"total_count": 3, "incomplete_results": false, "items": [{"number":3}]}`)
+		default:
+			t.Errorf("Unexpected page %q", r.FormValue("page"))
+		}
+	})
+
+	ctx := context.Background()
+	opts := &SearchIteratorOptions{SearchOptions: SearchOptions{ListOptions: ListOptions{PerPage: 2}}}
+	it := client.Search.AllIssues(ctx, "is:pr", opts)
+	if it.Issue() != nil || it.Response() != nil {
+		t.Errorf("Search.AllIssues iterator has issue or response before Next")
+	}
+
+	var got []int
+	for it.Next() {
+		got = append(got, it.Issue().GetNumber())
+	}
+	if err := it.Err(); err != nil {
+		t.Errorf("Search.AllIssues returned error: %v", err)
+	}
+
+	if want := []int{1, 2, 3}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Search.AllIssues returned %+v, want %+v", got, want)
+	}
+	if it.Total() != 3 || it.IncompleteResults() || it.Capped() {
+		t.Errorf("Search.AllIssues iterator has Total %v, IncompleteResults %v, Capped %v, want 3, false, false", it.Total(), it.IncompleteResults(), it.Capped())
+	}
+	if it.Issue() != nil || it.Next() {
+		t.Errorf("Search.AllIssues iterator continues after end")
+	}
+}
+
+func TestSearchService_AllIssues_incompleteResults(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	requests := 0
+	mux.HandleFunc("/search/issues", func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		incomplete := requests%2 == 1
+		fmt.Fprintf(w, `{"total_count": 1, "incomplete_results": %v, "items": [{"number":1}]}`, incomplete)
+	})
+
+	ctx := context.Background()
+	for _, tt := range []struct {
+		retries        int
+		wantRequests   int
+		wantIncomplete bool
+	}{
+		{0, 1, true},
+		{1, 2, false},
+	} {
+		requests = 0
+		it := client.Search.AllIssues(ctx, "q", &SearchIteratorOptions{SearchOptions: SearchOptions{IncompleteRetries: tt.retries}})
+		for it.Next() {
+		}
+		if err := it.Err(); err != nil {
+			t.Errorf("Search.AllIssues returned error: %v", err)
+		}
+		if requests != tt.wantRequests || it.IncompleteResults() != tt.wantIncomplete {
+			t.Errorf("Search.AllIssues with %v retries sent %v requests with IncompleteResults %v, want %v and %v", tt.retries, requests, it.IncompleteResults(), tt.wantRequests, tt.wantIncomplete)
+		}
+	}
+}
+
+func TestSearchService_AllIssues_capped(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	items := strings.TrimSuffix(strings.Repeat(`{"number":1},`, 100), ",")
+	requests := 0
+	mux.HandleFunc("/search/issues", func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		w.Header().Set("Link", fmt.Sprintf(`<https://api.github.com/search/issues?q=q&page=%v>; rel="next"`, requests+1))
+		fmt.Fprintf(w, `{"total_count": 1500, "incomplete_results": false, "items": [%v]}`, items)
+	})
+
+	ctx := context.Background()
+	it := client.Search.AllIssues(ctx, "q", nil)
+	n := 0
+	for it.Next() {
+		n++
+	}
+	if err := it.Err(); err != nil {
+		t.Errorf("Search.AllIssues returned error: %v", err)
+	}
+	if n != SearchResultCap || requests != 10 {
+		t.Errorf("Search.AllIssues returned %v issues in %v requests, want %v in 10", n, requests, SearchResultCap)
+	}
+	if !it.Capped() || it.Total() != 1500 {
+		t.Errorf("Search.AllIssues iterator has Capped %v and Total %v, want true and 1500", it.Capped(), it.Total())
+	}
+}
+
+func TestSearchService_AllIssues_partitionByCreated(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	since := time.Date(2020, time.January, 1, 0, 0, 0, 0, time.UTC)
+	createdRE := regexp.MustCompile(`^is:issue created:(\S+)\.\.(\S+)$`)
+	mux.HandleFunc("/search/issues", func(w http.ResponseWriter, r *http.Request) {
+		testFormValues(t, r, values{"q": r.FormValue("q"), "sort": "created", "order": "asc", "per_page": "100"})
+		m := createdRE.FindStringSubmatch(r.FormValue("q"))
+		if m == nil {
+			t.Fatalf("Unexpected query %q", r.FormValue("q"))
+		}
+		start, _ := time.Parse(time.RFC3339, m[1])
+		end, _ := time.Parse(time.RFC3339, m[2])
+
+		// Each second has 400 issues, of which the first of each range
+		// is returned.
+		total := 400 * int(end.Sub(start)/time.Second+1)
+		fmt.Fprintf(w, `{"total_count": %v, "incomplete_results": false, "items": [{"number":%v}]}`, total, int(start.Sub(since)/time.Second))
+	})
+
+	ctx := context.Background()
+	opts := &SearchIteratorOptions{
+		PartitionByCreated: true,
+		CreatedSince:       since,
+		CreatedUntil:       since.Add(10 * time.Second),
+	}
+	it := client.Search.AllIssues(ctx, "is:issue", opts)
+	var got []int
+	for it.Next() {
+		got = append(got, it.Issue().GetNumber())
+	}
+	if err := it.Err(); err != nil {
+		t.Errorf("Search.AllIssues returned error: %v", err)
+	}
+
+	if want := []int{0, 2, 3, 5, 6, 8, 9}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Search.AllIssues returned %+v, want %+v", got, want)
+	}
+	if it.Total() != 4400 || it.Capped() {
+		t.Errorf("Search.AllIssues iterator has Total %v and Capped %v, want 4400 and false", it.Total(), it.Capped())
+	}
+}
+
+func TestSearchService_AllIssues_errors(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/search/issues", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"total_count": 1, "items": [1]}`)
+	})
+	mux.HandleFunc("/search/code", func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, "Bad Request", http.StatusBadRequest)
+	})
+
+	ctx := context.Background()
+	opts := &SearchIteratorOptions{PartitionByCreated: true}
+	for name, it := range map[string]interface {
+		Next() bool
+		Err() error
+	}{
+		"AllIssues with created qualifier": client.Search.AllIssues(ctx, "created:>2020-01-01", opts),
+		"AllUsers partitioned":             client.Search.AllUsers(ctx, "q", opts),
+		"AllIssues with invalid issue":     client.Search.AllIssues(ctx, "q", nil),
+		"AllCode with request error":       client.Search.AllCode(ctx, "q", nil),
+	} {
+		if it.Next() {
+			t.Errorf("Search.%v Next returned true", name)
+		}
+		if it.Err() == nil {
+			t.Errorf("Search.%v returned no error", name)
+		}
+	}
+}
+
+func TestSearchService_allIterators(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	for _, searchType := range []string{"repositories", "code", "commits", "users"} {
+		mux.HandleFunc("/search/"+searchType, func(w http.ResponseWriter, r *http.Request) {
+			testMethod(t, r, "GET")
+			testFormValues(t, r, values{"q": "q", "per_page": "100"})
+			fmt.Fprint(w, `{"total_count": 1, "items": [{"name":"n","sha":"s","login":"l"}]}`)
+		})
+	}
+
+	ctx := context.Background()
+	repos := client.Search.AllRepositories(ctx, "q", nil)
+	if !repos.Next() || repos.Repository().GetName() != "n" || repos.Next() {
+		t.Errorf("Search.AllRepositories returned %+v, %v", repos.Repository(), repos.Err())
+	}
+	code := client.Search.AllCode(ctx, "q", nil)
+	if !code.Next() || code.CodeResult().GetName() != "n" || code.Next() {
+		t.Errorf("Search.AllCode returned %+v, %v", code.CodeResult(), code.Err())
+	}
+	commits := client.Search.AllCommits(ctx, "q", nil)
+	if !commits.Next() || commits.Commit().GetSHA() != "s" || commits.Next() {
+		t.Errorf("Search.AllCommits returned %+v, %v", commits.Commit(), commits.Err())
+	}
+	users := client.Search.AllUsers(ctx, "q", nil)
+	if !users.Next() || users.User().GetLogin() != "l" || users.Next() {
+		t.Errorf("Search.AllUsers returned %+v, %v", users.User(), users.Err())
+	}
+}
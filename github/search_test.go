@@ -9,7 +9,9 @@ import (
 	"context"
 	"fmt"
 	"net/http"
+	"net/url"
 	"reflect"
+	"strings"
 	"testing"
 )
 
@@ -137,6 +139,91 @@ func TestSearchService_Commits(t *testing.T) {
 	}
 }
 
+func TestSearchService_Commits_textMatch(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/search/commits", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testHeader(t, r, "Accept", "application/vnd.github.v3.text-match+json")
+		testFormValues(t, r, values{"q": "fix", "sort": string(SearchCommitsByCommitterDate)})
+		fmt.Fprint(w, `{"total_count": 1, "items": [{"sha":"s"}]}`)
+	})
+
+	opts := &SearchOptions{Sort: string(SearchCommitsByCommitterDate), TextMatch: true}
+	ctx := context.Background()
+	if _, _, err := client.Search.Commits(ctx, "fix", opts); err != nil {
+		t.Errorf("Search.Commits returned error: %v", err)
+	}
+}
+
+func TestSearchService_CommitsBySHA(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	var shas []string
+	for i := 0; i < 7; i++ {
+		shas = append(shas, fmt.Sprintf("%040d", i))
+	}
+
+	var queries []string
+	mux.HandleFunc("/search/commits", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		q := r.FormValue("q")
+		if len(q) > 256 {
+			t.Errorf("Search.CommitsBySHA sent query of %v characters, want at most 256", len(q))
+		}
+		if r.FormValue("page") == "2" {
+			fmt.Fprint(w, `{"total_count": 2, "items": [{"sha":"fork"}]}`)
+			return
+		}
+		queries = append(queries, q)
+		if len(queries) == 1 {
+			w.Header().Set("Link", `<https://api.github.com/search/commits?page=2>; rel="next"`)
+		}
+		fmt.Fprintf(w, `{"total_count": 2, "items": [{"sha":"%v"}]}`, len(queries))
+	})
+
+	ctx := context.Background()
+	commits, _, err := client.Search.CommitsBySHA(ctx, "o", shas)
+	if err != nil {
+		t.Errorf("Search.CommitsBySHA returned error: %v", err)
+	}
+
+	var got []string
+	for _, c := range commits {
+		got = append(got, c.GetSHA())
+	}
+	if want := []string{"1", "fork", "2"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Search.CommitsBySHA returned %v, want %v", got, want)
+	}
+
+	if len(queries) != 2 {
+		t.Fatalf("Search.CommitsBySHA sent %v queries, want 2", len(queries))
+	}
+	var searched []string
+	for _, q := range queries {
+		if !strings.HasPrefix(q, "org:o hash:") {
+			t.Errorf("Search.CommitsBySHA sent query %q, want org:o qualifier first", q)
+		}
+		for _, term := range strings.Split(strings.TrimPrefix(q, "org:o "), " OR ") {
+			searched = append(searched, strings.TrimPrefix(term, "hash:"))
+		}
+	}
+	if !reflect.DeepEqual(searched, shas) {
+		t.Errorf("Search.CommitsBySHA searched %v, want %v", searched, shas)
+	}
+
+	const methodName = "CommitsBySHA"
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.Search.CommitsBySHA(ctx, "o", shas)
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
+
 func TestSearchService_Commits_coverage(t *testing.T) {
 	client, _, _, teardown := setup()
 	defer teardown()
@@ -368,6 +455,105 @@ func TestSearchService_Code_coverage(t *testing.T) {
 	})
 }
 
+func TestSearchService_Code_newEngineSchema(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/search/code", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testFormValues(t, r, values{"q": `symbol:Client path:/^github\/.*\.go$/`})
+
+		fmt.Fprint(w, `{"total_count": 1, "incomplete_results": false, "items": [{
+			"name": "github.go",
+			"path": "github/github.go",
+			"score": 1.5,
+			"file_size": 42,
+			"language": "Go",
+			"last_modified_at": `+referenceTimeStr+`,
+			"line_numbers": ["10", "12"]
+		}]}`)
+	})
+
+	ctx := context.Background()
+	result, _, err := client.Search.Code(ctx, "symbol:Client "+CodePathRegexp(`^github/.*\.go$`), nil)
+	if err != nil {
+		t.Errorf("Search.Code returned error: %v", err)
+	}
+
+	want := &CodeSearchResult{
+		Total:             Int(1),
+		IncompleteResults: Bool(false),
+		CodeResults: []*CodeResult{{
+			Name:           String("github.go"),
+			Path:           String("github/github.go"),
+			Score:          Float64(1.5),
+			FileSize:       Int(42),
+			Language:       String("Go"),
+			LastModifiedAt: &Timestamp{referenceTime},
+			LineNumbers:    []string{"10", "12"},
+		}},
+	}
+	if !reflect.DeepEqual(result, want) {
+		t.Errorf("Search.Code returned %+v, want %+v", result, want)
+	}
+}
+
+func TestSearchService_CodeSearchEngine(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	installedVersion := ""
+	mux.HandleFunc("/meta", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprintf(w, `{"installed_version": %q}`, installedVersion)
+	})
+
+	ctx := context.Background()
+	for _, tt := range []struct {
+		installedVersion string
+		want             CodeSearchEngineType
+	}{
+		{"3.0.1", CodeSearchLegacy},
+		{"", CodeSearchNew},
+	} {
+		installedVersion = tt.installedVersion
+		got, _, err := client.Search.CodeSearchEngine(ctx)
+		if err != nil {
+			t.Errorf("Search.CodeSearchEngine returned error: %v", err)
+		}
+		if got != tt.want {
+			t.Errorf("Search.CodeSearchEngine with installed version %q returned %v, want %v", tt.installedVersion, got, tt.want)
+		}
+	}
+
+	const methodName = "CodeSearchEngine"
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.Search.CodeSearchEngine(ctx)
+		if got != "" {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want empty", methodName, got)
+		}
+		return resp, err
+	})
+
+	client.BaseURL, _ = url.Parse(defaultBaseURL)
+	got, resp, err := client.Search.CodeSearchEngine(ctx)
+	if err != nil || resp != nil || got != CodeSearchNew {
+		t.Errorf("Search.CodeSearchEngine for GitHub.com returned %v, %v, %v, want %v without request", got, resp, err, CodeSearchNew)
+	}
+}
+
+func TestCodePathRegexp(t *testing.T) {
+	if got, want := CodePathRegexp(`^src/.*\.go$`), `path:/^src\/.*\.go$/`; got != want {
+		t.Errorf("CodePathRegexp returned %q, want %q", got, want)
+	}
+}
+
+func TestCodeRegexp(t *testing.T) {
+	if got, want := CodeRegexp(`a/b`), `/a\/b/`; got != want {
+		t.Errorf("CodeRegexp returned %q, want %q", got, want)
+	}
+}
+
 func TestSearchService_CodeTextMatch(t *testing.T) {
 	client, mux, _, teardown := setup()
 	defer teardown()
@@ -480,3 +666,93 @@ func TestSearchService_Labels_coverage(t *testing.T) {
 		return err
 	})
 }
+
+func TestSearchService_LabelsByRepo(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"id":1234}`)
+	})
+	mux.HandleFunc("/search/labels", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testHeader(t, r, "Accept", "application/vnd.github.v3.text-match+json")
+		testFormValues(t, r, values{"repository_id": "1234", "q": "bu"})
+		fmt.Fprint(w, `{"total_count": 1, "incomplete_results": false, "items": [{"id":1,"node_id":"n","name":"bug","text_matches":[{"fragment":"bug"}]}]}`)
+	})
+
+	ctx := context.Background()
+	result, _, err := client.Search.LabelsByRepo(ctx, "o", "r", "bu", &SearchOptions{TextMatch: true})
+	if err != nil {
+		t.Errorf("Search.LabelsByRepo returned error: %v", err)
+	}
+
+	want := &LabelsSearchResult{
+		Total:             Int(1),
+		IncompleteResults: Bool(false),
+		Labels: []*LabelResult{{
+			ID:          Int64(1),
+			NodeID:      String("n"),
+			Name:        String("bug"),
+			TextMatches: []*TextMatch{{Fragment: String("bug")}},
+		}},
+	}
+	if !reflect.DeepEqual(result, want) {
+		t.Errorf("Search.LabelsByRepo returned %+v, want %+v", result, want)
+	}
+
+	const methodName = "LabelsByRepo"
+	testBadOptions(t, methodName, func() (err error) {
+		_, _, err = client.Search.LabelsByRepo(ctx, "\n", "\n", "bu", nil)
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.Search.LabelsByRepo(ctx, "o", "r", "bu", nil)
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
+
+func TestSearchService_incompleteRetries(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	requests := 0
+	mux.HandleFunc("/search/code", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testFormValues(t, r, values{"q": "blah"})
+		requests++
+		if requests < 3 {
+			fmt.Fprint(w, `{"total_count": 9, "incomplete_results": true, "items": [{"name":"partial"}]}`)
+			return
+		}
+		fmt.Fprint(w, `{"total_count": 2, "incomplete_results": false}`)
+	})
+
+	ctx := context.Background()
+	for _, tt := range []struct {
+		retries      int
+		wantRequests int
+		want         *CodeSearchResult
+	}{
+		{0, 1, &CodeSearchResult{Total: Int(9), IncompleteResults: Bool(true), CodeResults: []*CodeResult{{Name: String("partial")}}}},
+		{1, 2, &CodeSearchResult{Total: Int(9), IncompleteResults: Bool(true), CodeResults: []*CodeResult{{Name: String("partial")}}}},
+		{5, 3, &CodeSearchResult{Total: Int(2), IncompleteResults: Bool(false)}},
+	} {
+		requests = 0
+		result, _, err := client.Search.Code(ctx, "blah", &SearchOptions{IncompleteRetries: tt.retries})
+		if err != nil {
+			t.Errorf("Search.Code returned error: %v", err)
+		}
+		if requests != tt.wantRequests {
+			t.Errorf("Search.Code with %v retries sent %v requests, want %v", tt.retries, requests, tt.wantRequests)
+		}
+		if !reflect.DeepEqual(result, tt.want) {
+			t.Errorf("Search.Code with %v retries returned %+v, want %+v", tt.retries, result, tt.want)
+		}
+	}
+}
@@ -0,0 +1,149 @@
+// Copyright 2021 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"errors"
+	"html"
+	"sort"
+	"strings"
+)
+
+// ANSI escape sequences used by TextMatch.HighlightANSI.
+const (
+	ansiHighlight = "\x1b[1;33m"
+	ansiReset     = "\x1b[0m"
+)
+
+// matchRanges returns the ranges of the matches of tm, as character offsets
+// in a fragment of n characters, sorted and with overlapping ranges merged.
+// Invalid ranges are skipped.
+func (tm *TextMatch) matchRanges(n int) [][2]int {
+	var ranges [][2]int
+	for _, m := range tm.Matches {
+		if m == nil || len(m.Indices) != 2 {
+			continue
+		}
+		start, end := m.Indices[0], m.Indices[1]
+		if end > n {
+			end = n
+		}
+		if start < 0 || start >= end {
+			continue
+		}
+		ranges = append(ranges, [2]int{start, end})
+	}
+	sort.Slice(ranges, func(i, j int) bool { return ranges[i][0] < ranges[j][0] })
+
+	var merged [][2]int
+	for _, r := range ranges {
+		if last := len(merged) - 1; last >= 0 && r[0] <= merged[last][1] {
+			if r[1] > merged[last][1] {
+				merged[last][1] = r[1]
+			}
+			continue
+		}
+		merged = append(merged, r)
+	}
+	return merged
+}
+
+// Highlight returns the fragment of tm with each of its matches enclosed
+// between before and after. escape, if not nil, is applied to the text of
+// the fragment, but not to before and after. The indices of the matches
+// are offsets in characters.
+func (tm *TextMatch) Highlight(before, after string, escape func(string) string) string {
+	if escape == nil {
+		escape = func(s string) string { return s }
+	}
+
+	fragment := []rune(tm.GetFragment())
+	var b strings.Builder
+	pos := 0
+	for _, r := range tm.matchRanges(len(fragment)) {
+		b.WriteString(escape(string(fragment[pos:r[0]])))
+		b.WriteString(before)
+		b.WriteString(escape(string(fragment[r[0]:r[1]])))
+		b.WriteString(after)
+		pos = r[1]
+	}
+	b.WriteString(escape(string(fragment[pos:])))
+	return b.String()
+}
+
+// HighlightHTML returns the fragment of tm as HTML, with its matches
+// enclosed in <mark> elements.
+func (tm *TextMatch) HighlightHTML() string {
+	return tm.Highlight("<mark>", "</mark>", html.EscapeString)
+}
+
+// HighlightANSI returns the fragment of tm with its matches highlighted
+// with ANSI escape sequences, for terminals.
+func (tm *TextMatch) HighlightANSI() string {
+	return tm.Highlight(ansiHighlight, ansiReset, nil)
+}
+
+// TextMatchPosition is the position of a match of a TextMatch in the full
+// content of the matched object, such as a file.
+type TextMatchPosition struct {
+	Text string
+
+	// Offset and EndOffset are the byte offsets of the start and of the end
+	// of the match in the content. EndOffset is excluded.
+	Offset    int
+	EndOffset int
+
+	// Line and Column are the position of the start of the match, and
+	// EndLine and EndColumn the position just after its end. They start at
+	// 1, and columns are counted in characters.
+	Line      int
+	Column    int
+	EndLine   int
+	EndColumn int
+}
+
+// Locate returns the positions of the matches of tm in content, the full
+// content of the matched object, such as the content of a file found by
+// SearchService.Code. The fragment of tm is looked up in content, and its
+// first occurrence is used. Matches with invalid indices are skipped.
+func (tm *TextMatch) Locate(content string) ([]*TextMatchPosition, error) {
+	fragment := tm.GetFragment()
+	base := strings.Index(content, fragment)
+	if base < 0 {
+		return nil, errors.New("text match fragment not found in content")
+	}
+
+	runes := []rune(fragment)
+	var positions []*TextMatchPosition
+	for _, m := range tm.Matches {
+		if m == nil || len(m.Indices) != 2 {
+			continue
+		}
+		start, end := m.Indices[0], m.Indices[1]
+		if start < 0 || start > end || end > len(runes) {
+			continue
+		}
+
+		p := &TextMatchPosition{
+			Text:      string(runes[start:end]),
+			Offset:    base + len(string(runes[:start])),
+			EndOffset: base + len(string(runes[:end])),
+		}
+		p.Line, p.Column = lineColumn(content, p.Offset)
+		p.EndLine, p.EndColumn = lineColumn(content, p.EndOffset)
+		positions = append(positions, p)
+	}
+	return positions, nil
+}
+
+// lineColumn returns the line and the column, starting at 1, of the byte
+// offset in s.
+func lineColumn(s string, offset int) (line, column int) {
+	before := s[:offset]
+	line = strings.Count(before, "\n") + 1
+	column = len([]rune(before[strings.LastIndex(before, "\n")+1:])) + 1
+	return line, column
+}
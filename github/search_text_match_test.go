@@ -0,0 +1,77 @@
+// Copyright 2021 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestTextMatch_Highlight(t *testing.T) {
+	tm := &TextMatch{
+		Fragment: String("héllo <b> wörld & hello"),
+		Matches: []*Match{
+			{Text: String("hello"), Indices: []int{18, 23}},
+			{Text: String("héllo"), Indices: []int{0, 5}},
+			{Text: String("llo"), Indices: []int{2, 5}},
+			{Text: String("<b> w"), Indices: []int{6, 11}},
+			{Text: String("invalid"), Indices: []int{5, 1}},
+			{Text: String("invalid"), Indices: []int{1}},
+			nil,
+		},
+	}
+
+	if got, want := tm.Highlight("[", "]", nil), "[héllo] [<b> w]örld & [hello]"; got != want {
+		t.Errorf("TextMatch.Highlight returned %q, want %q", got, want)
+	}
+	if got, want := tm.HighlightHTML(), "<mark>héllo</mark> <mark>&lt;b&gt; w</mark>örld &amp; <mark>hello</mark>"; got != want {
+		t.Errorf("TextMatch.HighlightHTML returned %q, want %q", got, want)
+	}
+	if got, want := tm.HighlightANSI(), "\x1b[1;33mhéllo\x1b[0m \x1b[1;33m<b> w\x1b[0mörld & \x1b[1;33mhello\x1b[0m"; got != want {
+		t.Errorf("TextMatch.HighlightANSI returned %q, want %q", got, want)
+	}
+
+	if got, want := (&TextMatch{}).Highlight("[", "]", nil), ""; got != want {
+		t.Errorf("TextMatch.Highlight of empty text match returned %q, want %q", got, want)
+	}
+	tm = &TextMatch{Fragment: String("abc"), Matches: []*Match{{Indices: []int{1, 10}}}}
+	if got, want := tm.Highlight("[", "]", nil), "a[bc]"; got != want {
+		t.Errorf("TextMatch.Highlight with out of range indices returned %q, want %q", got, want)
+	}
+}
+
+func TestTextMatch_Locate(t *testing.T) {
+	content := "package main\n\nfunc main() {\n\tprintln(\"héllo\")\n\tprintln(\"wörld\")\n}\n"
+	tm := &TextMatch{
+		Fragment: String("\tprintln(\"héllo\")\n\tprintln(\"wörld\")"),
+		Matches: []*Match{
+			{Text: String("héllo"), Indices: []int{10, 15}},
+			{Text: String("wörld"), Indices: []int{28, 33}},
+			{Text: String("invalid"), Indices: []int{30, 100}},
+		},
+	}
+
+	got, err := tm.Locate(content)
+	if err != nil {
+		t.Fatalf("TextMatch.Locate returned error: %v", err)
+	}
+	want := []*TextMatchPosition{
+		{Text: "héllo", Offset: 38, EndOffset: 44, Line: 4, Column: 11, EndLine: 4, EndColumn: 16},
+		{Text: "wörld", Offset: 57, EndOffset: 63, Line: 5, Column: 11, EndLine: 5, EndColumn: 16},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("TextMatch.Locate returned %+v, want %+v", got, want)
+	}
+	for _, p := range got {
+		if content[p.Offset:p.EndOffset] != p.Text {
+			t.Errorf("TextMatch.Locate returned offsets of %q, want %q", content[p.Offset:p.EndOffset], p.Text)
+		}
+	}
+
+	if _, err := tm.Locate("other content"); err == nil {
+		t.Errorf("TextMatch.Locate returned no error for content without the fragment")
+	}
+}
@@ -0,0 +1,450 @@
+// Copyright 2021 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+/*
+Package searchquery builds the query strings of the GitHub search API, as
+used by the methods of github.SearchService.
+
+Values are quoted as needed, so that a label such as "good first issue" or a
+repository name is always passed as a single qualifier value:
+
+	q := searchquery.Query("crash").
+		Repo("o/r").
+		Label("good first issue").
+		State(searchquery.Open).
+		CreatedAfter(time.Date(2020, time.January, 1, 0, 0, 0, 0, time.UTC))
+	if err := q.Validate(searchquery.Issues); err != nil {
+		// ...
+	}
+	result, _, err := client.Search.Issues(ctx, q.String(), nil)
+
+GitHub search syntax: https://docs.github.com/en/free-pro-team@latest/github/searching-for-information-on-github/understanding-the-search-syntax
+*/
+package searchquery
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/go-github/v33/github"
+)
+
+// Domain is a type of search, such as issues or code.
+type Domain string
+
+// These are the search domains, named after the SearchService methods.
+const (
+	Repositories Domain = "repositories"
+	Topics       Domain = "topics"
+	Commits      Domain = "commits"
+	Issues       Domain = "issues"
+	Users        Domain = "users"
+	Code         Domain = "code"
+	Labels       Domain = "labels"
+)
+
+// qualifierDomains are the domains supporting each known qualifier.
+var qualifierDomains = map[string][]Domain{
+	"repo":           {Repositories, Commits, Issues, Code},
+	"org":            {Repositories, Commits, Issues, Code},
+	"user":           {Repositories, Commits, Issues, Code},
+	"in":             {Repositories, Issues, Users, Code},
+	"is":             {Repositories, Topics, Commits, Issues, Code},
+	"language":       {Repositories, Issues, Users, Code},
+	"created":        {Repositories, Topics, Issues, Users},
+	"updated":        {Issues},
+	"closed":         {Issues},
+	"merged":         {Issues},
+	"pushed":         {Repositories},
+	"stars":          {Repositories},
+	"forks":          {Repositories},
+	"size":           {Repositories, Code},
+	"topic":          {Repositories},
+	"topics":         {Repositories},
+	"license":        {Repositories},
+	"archived":       {Repositories, Issues},
+	"state":          {Issues},
+	"type":           {Issues, Users},
+	"label":          {Issues},
+	"milestone":      {Issues},
+	"author":         {Commits, Issues},
+	"assignee":       {Issues},
+	"mentions":       {Issues},
+	"commenter":      {Issues},
+	"involves":       {Issues},
+	"comments":       {Issues},
+	"no":             {Issues},
+	"head":           {Issues},
+	"base":           {Issues},
+	"review":         {Issues},
+	"reviewed-by":    {Issues},
+	"committer":      {Commits},
+	"author-name":    {Commits},
+	"committer-name": {Commits},
+	"author-email":   {Commits},
+	"author-date":    {Commits},
+	"committer-date": {Commits},
+	"hash":           {Commits},
+	"parent":         {Commits},
+	"tree":           {Commits},
+	"merge":          {Commits},
+	"followers":      {Users},
+	"repos":          {Users},
+	"location":       {Users},
+	"path":           {Code},
+	"filename":       {Code},
+	"extension":      {Code},
+	"symbol":         {Code},
+	"content":        {Code},
+	"repositories":   {Topics},
+}
+
+// State is the state of an issue or pull request.
+type State string
+
+// These are the possible states.
+const (
+	Open   State = "open"
+	Closed State = "closed"
+)
+
+// Range is a range of numbers, for the qualifiers such as "stars:".
+type Range string
+
+// AtLeast returns the range of the numbers greater than or equal to n.
+func AtLeast(n int) Range {
+	return Range(">=" + strconv.Itoa(n))
+}
+
+// AtMost returns the range of the numbers less than or equal to n.
+func AtMost(n int) Range {
+	return Range("<=" + strconv.Itoa(n))
+}
+
+// Between returns the range of the numbers between min and max, included.
+func Between(min, max int) Range {
+	return Range(strconv.Itoa(min) + ".." + strconv.Itoa(max))
+}
+
+// Exactly returns the range of the single number n.
+func Exactly(n int) Range {
+	return Range(strconv.Itoa(n))
+}
+
+// term is a keyword or a qualifier of a query.
+type term struct {
+	qualifier string // empty for keywords
+	value     string // already quoted
+	exclude   bool
+}
+
+func (t term) String() string {
+	s := t.value
+	if t.qualifier != "" {
+		s = t.qualifier + ":" + s
+	}
+	if t.exclude {
+		s = "-" + s
+	}
+	return s
+}
+
+// Builder builds a search query. Its methods add a keyword or a qualifier
+// to the query and return the Builder, so that calls can be chained.
+type Builder struct {
+	terms []term
+}
+
+// Query returns a new Builder of a query with the given keywords.
+func Query(keywords ...string) *Builder {
+	return new(Builder).Keywords(keywords...)
+}
+
+// String returns the query, to be passed to the SearchService methods.
+func (b *Builder) String() string {
+	terms := make([]string, len(b.terms))
+	for i, t := range b.terms {
+		terms[i] = t.String()
+	}
+	return strings.Join(terms, " ")
+}
+
+// Validate returns an error if the query has qualifiers which are not
+// supported by the domain. Unknown qualifiers are not reported, as GitHub
+// may support qualifiers this package does not know about.
+func (b *Builder) Validate(domain Domain) error {
+	for _, t := range b.terms {
+		domains, ok := qualifierDomains[t.qualifier]
+		if t.qualifier == "" || !ok {
+			continue
+		}
+		if !containsDomain(domains, domain) {
+			return fmt.Errorf("qualifier %q is not supported by %v searches", t.qualifier, domain)
+		}
+	}
+	return nil
+}
+
+func containsDomain(domains []Domain, domain Domain) bool {
+	for _, d := range domains {
+		if d == domain {
+			return true
+		}
+	}
+	return false
+}
+
+// Keywords adds keywords to the query. Each keyword is searched as a
+// whole, so that a keyword with spaces is searched as a phrase.
+func (b *Builder) Keywords(keywords ...string) *Builder {
+	for _, k := range keywords {
+		b.terms = append(b.terms, term{value: quote(k)})
+	}
+	return b
+}
+
+// Qualifier adds the qualifier name with value to the query, such as
+// "label:bug" for Qualifier("label", "bug"). It is used for the qualifiers
+// without a dedicated method.
+func (b *Builder) Qualifier(name, value string) *Builder {
+	return b.add(name, quote(value), false)
+}
+
+// Exclude adds the qualifier name with value to the query, so as to exclude
+// the results matching it, such as "-label:bug" for Exclude("label", "bug").
+func (b *Builder) Exclude(name, value string) *Builder {
+	return b.add(name, quote(value), true)
+}
+
+func (b *Builder) add(name, value string, exclude bool) *Builder {
+	b.terms = append(b.terms, term{qualifier: name, value: value, exclude: exclude})
+	return b
+}
+
+// Repo restricts the query to a repository, given as "owner/name".
+func (b *Builder) Repo(fullName string) *Builder {
+	return b.Qualifier("repo", fullName)
+}
+
+// Org restricts the query to the repositories of an organization.
+func (b *Builder) Org(org string) *Builder {
+	return b.Qualifier("org", org)
+}
+
+// User restricts the query to the repositories of a user.
+func (b *Builder) User(user string) *Builder {
+	return b.Qualifier("user", user)
+}
+
+// Is adds an "is:" qualifier, such as "pr", "merged" or "public".
+func (b *Builder) Is(value string) *Builder {
+	return b.Qualifier("is", value)
+}
+
+// In restricts the fields matching the keywords, such as "title" or "body".
+func (b *Builder) In(fields ...string) *Builder {
+	return b.Qualifier("in", strings.Join(fields, ","))
+}
+
+// Language restricts the query to a language.
+func (b *Builder) Language(language string) *Builder {
+	return b.Qualifier("language", language)
+}
+
+// State restricts the query to the issues and pull requests in a state.
+func (b *Builder) State(state State) *Builder {
+	return b.Qualifier("state", string(state))
+}
+
+// Label restricts the query to the issues and pull requests with a label.
+func (b *Builder) Label(label string) *Builder {
+	return b.Qualifier("label", label)
+}
+
+// Milestone restricts the query to the issues and pull requests of a
+// milestone.
+func (b *Builder) Milestone(milestone string) *Builder {
+	return b.Qualifier("milestone", milestone)
+}
+
+// Author restricts the query to the issues, pull requests or commits of an
+// author.
+func (b *Builder) Author(login string) *Builder {
+	return b.Qualifier("author", login)
+}
+
+// Assignee restricts the query to the issues and pull requests assigned to
+// a user.
+func (b *Builder) Assignee(login string) *Builder {
+	return b.Qualifier("assignee", login)
+}
+
+// Mentions restricts the query to the issues and pull requests mentioning
+// a user.
+func (b *Builder) Mentions(login string) *Builder {
+	return b.Qualifier("mentions", login)
+}
+
+// Involves restricts the query to the issues and pull requests involving a
+// user in any way.
+func (b *Builder) Involves(login string) *Builder {
+	return b.Qualifier("involves", login)
+}
+
+// Topic restricts the query to the repositories with a topic.
+func (b *Builder) Topic(topic string) *Builder {
+	return b.Qualifier("topic", topic)
+}
+
+// Stars restricts the query to the repositories with a number of stars.
+func (b *Builder) Stars(r Range) *Builder {
+	return b.add("stars", string(r), false)
+}
+
+// Forks restricts the query to the repositories with a number of forks.
+func (b *Builder) Forks(r Range) *Builder {
+	return b.add("forks", string(r), false)
+}
+
+// Comments restricts the query to the issues and pull requests with a
+// number of comments.
+func (b *Builder) Comments(r Range) *Builder {
+	return b.add("comments", string(r), false)
+}
+
+// RepositoryCount restricts the query to the topics used by a number of
+// repositories.
+func (b *Builder) RepositoryCount(r Range) *Builder {
+	return b.add("repositories", string(r), false)
+}
+
+// Followers restricts the query to the users with a number of followers.
+func (b *Builder) Followers(r Range) *Builder {
+	return b.add("followers", string(r), false)
+}
+
+// Path restricts the query to the files under a path.
+func (b *Builder) Path(path string) *Builder {
+	return b.Qualifier("path", path)
+}
+
+// PathRegexp restricts the query to the files whose path matches the
+// regular expression expr. It is only supported by the new code search
+// engine of GitHub.com.
+func (b *Builder) PathRegexp(expr string) *Builder {
+	return b.add("path", github.CodeRegexp(expr), false)
+}
+
+// Filename restricts the query to the files with a name.
+func (b *Builder) Filename(name string) *Builder {
+	return b.Qualifier("filename", name)
+}
+
+// Extension restricts the query to the files with an extension, without
+// the dot.
+func (b *Builder) Extension(ext string) *Builder {
+	return b.Qualifier("extension", ext)
+}
+
+// CreatedAfter restricts the query to the results created after t.
+func (b *Builder) CreatedAfter(t time.Time) *Builder {
+	return b.add("created", ">"+formatTime(t), false)
+}
+
+// CreatedBefore restricts the query to the results created before t.
+func (b *Builder) CreatedBefore(t time.Time) *Builder {
+	return b.add("created", "<"+formatTime(t), false)
+}
+
+// CreatedBetween restricts the query to the results created between since
+// and until, included.
+func (b *Builder) CreatedBetween(since, until time.Time) *Builder {
+	return b.add("created", formatTime(since)+".."+formatTime(until), false)
+}
+
+// UpdatedAfter restricts the query to the results updated after t.
+func (b *Builder) UpdatedAfter(t time.Time) *Builder {
+	return b.add("updated", ">"+formatTime(t), false)
+}
+
+// UpdatedBefore restricts the query to the results updated before t.
+func (b *Builder) UpdatedBefore(t time.Time) *Builder {
+	return b.add("updated", "<"+formatTime(t), false)
+}
+
+// ClosedAfter restricts the query to the issues and pull requests closed
+// after t.
+func (b *Builder) ClosedAfter(t time.Time) *Builder {
+	return b.add("closed", ">"+formatTime(t), false)
+}
+
+// MergedAfter restricts the query to the pull requests merged after t.
+func (b *Builder) MergedAfter(t time.Time) *Builder {
+	return b.add("merged", ">"+formatTime(t), false)
+}
+
+// PushedAfter restricts the query to the repositories pushed to after t.
+func (b *Builder) PushedAfter(t time.Time) *Builder {
+	return b.add("pushed", ">"+formatTime(t), false)
+}
+
+// AuthorDateAfter restricts the query to the commits authored after t.
+func (b *Builder) AuthorDateAfter(t time.Time) *Builder {
+	return b.add("author-date", ">"+formatTime(t), false)
+}
+
+// AuthorDateBefore restricts the query to the commits authored before t.
+func (b *Builder) AuthorDateBefore(t time.Time) *Builder {
+	return b.add("author-date", "<"+formatTime(t), false)
+}
+
+// AuthorDateBetween restricts the query to the commits authored between
+// since and until, included.
+func (b *Builder) AuthorDateBetween(since, until time.Time) *Builder {
+	return b.add("author-date", formatTime(since)+".."+formatTime(until), false)
+}
+
+// CommitterDateAfter restricts the query to the commits committed after t.
+func (b *Builder) CommitterDateAfter(t time.Time) *Builder {
+	return b.add("committer-date", ">"+formatTime(t), false)
+}
+
+// CommitterDateBefore restricts the query to the commits committed before
+// t.
+func (b *Builder) CommitterDateBefore(t time.Time) *Builder {
+	return b.add("committer-date", "<"+formatTime(t), false)
+}
+
+// CommitterDateBetween restricts the query to the commits committed between
+// since and until, included.
+func (b *Builder) CommitterDateBetween(since, until time.Time) *Builder {
+	return b.add("committer-date", formatTime(since)+".."+formatTime(until), false)
+}
+
+// Hash restricts the query to the commits with a SHA, or a prefix of it.
+func (b *Builder) Hash(sha string) *Builder {
+	return b.Qualifier("hash", sha)
+}
+
+// Committer restricts the query to the commits of a committer.
+func (b *Builder) Committer(login string) *Builder {
+	return b.Qualifier("committer", login)
+}
+
+// formatTime formats t for the date qualifiers, in UTC.
+func formatTime(t time.Time) string {
+	return t.UTC().Format("2006-01-02T15:04:05Z")
+}
+
+// quote quotes s if it contains characters which would split it, or change
+// its meaning, in a query.
+func quote(s string) string {
+	if s != "" && !strings.HasPrefix(s, "-") && !strings.ContainsAny(s, " \t\n\"():") {
+		return s
+	}
+	return `"` + strings.ReplaceAll(s, `"`, `\"`) + `"`
+}
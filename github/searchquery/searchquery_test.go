@@ -0,0 +1,86 @@
+// Copyright 2021 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package searchquery
+
+import (
+	"testing"
+	"time"
+)
+
+func TestBuilder_String(t *testing.T) {
+	since := time.Date(2020, time.January, 2, 3, 4, 5, 0, time.UTC)
+	until := since.Add(24 * time.Hour)
+
+	tests := []struct {
+		query *Builder
+		want  string
+	}{
+		{Query(), ""},
+		{Query("crash", "out of memory"), `crash "out of memory"`},
+		{Query(`say "hi"`, "-v", "a:b"), `"say \"hi\"" "-v" "a:b"`},
+		{
+			Query("crash").Repo("o/r").Label("good first issue").State(Open).CreatedAfter(since),
+			`crash repo:o/r label:"good first issue" state:open created:>2020-01-02T03:04:05Z`,
+		},
+		{
+			Query().Org("o").Is("pr").Author("a").Assignee("b").Mentions("c").Involves("d").Milestone("v1.0").Comments(AtLeast(10)),
+			`org:o is:pr author:a assignee:b mentions:c involves:d milestone:v1.0 comments:>=10`,
+		},
+		{
+			Query().Exclude("label", "wontfix").Qualifier("no", "assignee").UpdatedBefore(until).ClosedAfter(since).MergedAfter(since),
+			`-label:wontfix no:assignee updated:<2020-01-03T03:04:05Z closed:>2020-01-02T03:04:05Z merged:>2020-01-02T03:04:05Z`,
+		},
+		{
+			Query("cli").User("u").Topic("go").Language("C++").Stars(Between(10, 100)).Forks(AtMost(5)).PushedAfter(since).In("name", "description"),
+			`cli user:u topic:go language:C++ stars:10..100 forks:<=5 pushed:>2020-01-02T03:04:05Z in:name,description`,
+		},
+		{
+			Query("main").Path("cmd").PathRegexp(`^cmd/.*\.go$`).Filename("main.go").Extension("go"),
+			`main path:cmd path:/^cmd\/.*\.go$/ filename:main.go extension:go`,
+		},
+		{
+			Query("fix").AuthorDateAfter(since).CommitterDateAfter(since).CreatedBetween(since, until),
+			`fix author-date:>2020-01-02T03:04:05Z committer-date:>2020-01-02T03:04:05Z created:2020-01-02T03:04:05Z..2020-01-03T03:04:05Z`,
+		},
+		{Query().Followers(Exactly(3)).State(Closed), `followers:3 state:closed`},
+		{Query("ml").Is("featured").RepositoryCount(AtLeast(100)), `ml is:featured repositories:>=100`},
+		{
+			Query().Hash("abc").Committer("c").AuthorDateBefore(until).AuthorDateBetween(since, until).CommitterDateBefore(until).CommitterDateBetween(since, until),
+			`hash:abc committer:c author-date:<2020-01-03T03:04:05Z author-date:2020-01-02T03:04:05Z..2020-01-03T03:04:05Z committer-date:<2020-01-03T03:04:05Z committer-date:2020-01-02T03:04:05Z..2020-01-03T03:04:05Z`,
+		},
+		{Query().CreatedBefore(since.In(time.FixedZone("X", 3600))), `created:<2020-01-02T03:04:05Z`},
+	}
+
+	for _, tt := range tests {
+		if got := tt.query.String(); got != tt.want {
+			t.Errorf("Builder.String returned %q, want %q", got, tt.want)
+		}
+	}
+}
+
+func TestBuilder_Validate(t *testing.T) {
+	tests := []struct {
+		query   *Builder
+		domain  Domain
+		wantErr bool
+	}{
+		{Query("crash").Repo("o/r").Label("bug").State(Open), Issues, false},
+		{Query("crash").Repo("o/r").Label("bug"), Code, true},
+		{Query("main").Repo("o/r").PathRegexp(`\.go$`), Code, false},
+		{Query("main").Path("cmd"), Repositories, true},
+		{Query("go").Stars(AtLeast(1)).Qualifier("unknown", "x"), Repositories, false},
+		{Query("fix").Author("a").AuthorDateAfter(time.Now()), Commits, false},
+		{Query("fix").Exclude("label", "bug"), Users, true},
+		{Query("ml").Is("curated").RepositoryCount(AtLeast(1)), Topics, false},
+	}
+
+	for _, tt := range tests {
+		err := tt.query.Validate(tt.domain)
+		if gotErr := err != nil; gotErr != tt.wantErr {
+			t.Errorf("Builder(%q).Validate(%v) returned %v, want error %v", tt.query, tt.domain, err, tt.wantErr)
+		}
+	}
+}
@@ -0,0 +1,436 @@
+// Copyright 2021 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"fmt"
+)
+
+// SecretScanningService handles communication with the secret scanning related
+// methods of the GitHub API.
+//
+// GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/secret-scanning/
+type SecretScanningService service
+
+// Possible values for the Validity of a SecretScanningAlert.
+const (
+	SecretValidityActive   = "active"
+	SecretValidityInactive = "inactive"
+	SecretValidityUnknown  = "unknown"
+)
+
+// SecretScanningAlert represents a secret scanning alert.
+type SecretScanningAlert struct {
+	Number       *int       `json:"number,omitempty"`
+	CreatedAt    *Timestamp `json:"created_at,omitempty"`
+	UpdatedAt    *Timestamp `json:"updated_at,omitempty"`
+	URL          *string    `json:"url,omitempty"`
+	HTMLURL      *string    `json:"html_url,omitempty"`
+	LocationsURL *string    `json:"locations_url,omitempty"`
+	// Possible values for State are: "open" and "resolved".
+	State *string `json:"state,omitempty"`
+	// Possible values for Resolution are: "false_positive", "wont_fix",
+	// "revoked", "used_in_tests", "pattern_deleted" and "pattern_edited".
+	Resolution        *string    `json:"resolution,omitempty"`
+	ResolutionComment *string    `json:"resolution_comment,omitempty"`
+	ResolvedAt        *Timestamp `json:"resolved_at,omitempty"`
+	ResolvedBy        *User      `json:"resolved_by,omitempty"`
+	// SecretType is the type of the secret, which identifies the partner
+	// or custom pattern that detected it, e.g. "github_personal_access_token".
+	SecretType            *string     `json:"secret_type,omitempty"`
+	SecretTypeDisplayName *string     `json:"secret_type_display_name,omitempty"`
+	Secret                *string     `json:"secret,omitempty"`
+	Repository            *Repository `json:"repository,omitempty"`
+	// Validity is one of SecretValidityActive, SecretValidityInactive or
+	// SecretValidityUnknown, as reported by the partner of SecretType.
+	Validity *string `json:"validity,omitempty"`
+	// PubliclyLeaked reports whether the secret was also detected in a
+	// public repository.
+	PubliclyLeaked *bool `json:"publicly_leaked,omitempty"`
+	// MultiRepo reports whether the secret was also detected in other
+	// repositories of the organization or enterprise.
+	MultiRepo                *bool      `json:"multi_repo,omitempty"`
+	IsBase64Encoded          *bool      `json:"is_base64_encoded,omitempty"`
+	PushProtectionBypassed   *bool      `json:"push_protection_bypassed,omitempty"`
+	PushProtectionBypassedBy *User      `json:"push_protection_bypassed_by,omitempty"`
+	PushProtectionBypassedAt *Timestamp `json:"push_protection_bypassed_at,omitempty"`
+}
+
+// SecretScanningAlertListOptions specifies the optional parameters to the
+// SecretScanningService.ListAlertsForOrg and
+// SecretScanningService.ListAlertsForRepo methods. Filters that accept
+// several values take them as a comma-separated list.
+type SecretScanningAlertListOptions struct {
+	// Possible values for State are: "open" and "resolved".
+	State      string `url:"state,omitempty"`
+	SecretType string `url:"secret_type,omitempty"`
+	Resolution string `url:"resolution,omitempty"`
+	// Validity filters the alerts by the validity of their secret, e.g.
+	// SecretValidityActive.
+	Validity         string `url:"validity,omitempty"`
+	IsPubliclyLeaked bool   `url:"is_publicly_leaked,omitempty"`
+	IsMultiRepo      bool   `url:"is_multi_repo,omitempty"`
+
+	// Sort specifies how to sort the alerts. Possible values are: "created"
+	// and "updated". Default: "created".
+	Sort string `url:"sort,omitempty"`
+	// Direction in which to sort the alerts. Possible values are: "asc" and
+	// "desc". Default: "desc".
+	Direction string `url:"direction,omitempty"`
+
+	ListOptions
+}
+
+// Types of the locations of a SecretScanningAlert.
+const (
+	SecretScanningLocationCommit                   = "commit"
+	SecretScanningLocationWikiCommit               = "wiki_commit"
+	SecretScanningLocationIssueTitle               = "issue_title"
+	SecretScanningLocationIssueBody                = "issue_body"
+	SecretScanningLocationIssueComment             = "issue_comment"
+	SecretScanningLocationDiscussionTitle          = "discussion_title"
+	SecretScanningLocationDiscussionBody           = "discussion_body"
+	SecretScanningLocationDiscussionComment        = "discussion_comment"
+	SecretScanningLocationPullRequestTitle         = "pull_request_title"
+	SecretScanningLocationPullRequestBody          = "pull_request_body"
+	SecretScanningLocationPullRequestComment       = "pull_request_comment"
+	SecretScanningLocationPullRequestReview        = "pull_request_review"
+	SecretScanningLocationPullRequestReviewComment = "pull_request_review_comment"
+)
+
+// SecretScanningAlertLocationDetails represents the details of a location
+// of a SecretScanningAlert. Which fields are set depends on the Type of the
+// location:
+//
+//	commit, wiki_commit: Path, StartLine, EndLine, StartColumn, EndColumn,
+//	    BlobSHA, BlobURL, CommitSHA, CommitURL, and PageURL for wiki_commit.
+//	issue_title, issue_body, issue_comment: IssueTitleURL, IssueBodyURL
+//	    or IssueCommentURL.
+//	discussion_title, discussion_body, discussion_comment:
+//	    DiscussionTitleURL, DiscussionBodyURL or DiscussionCommentURL.
+//	pull_request_title, pull_request_body, pull_request_comment,
+//	pull_request_review, pull_request_review_comment: the PullRequest
+//	    URL field of the same name.
+type SecretScanningAlertLocationDetails struct {
+	Path        *string `json:"path,omitempty"`
+	StartLine   *int    `json:"start_line,omitempty"`
+	EndLine     *int    `json:"end_line,omitempty"`
+	StartColumn *int    `json:"start_column,omitempty"`
+	EndColumn   *int    `json:"end_column,omitempty"`
+	BlobSHA     *string `json:"blob_sha,omitempty"`
+	BlobURL     *string `json:"blob_url,omitempty"`
+	CommitSHA   *string `json:"commit_sha,omitempty"`
+	CommitURL   *string `json:"commit_url,omitempty"`
+	PageURL     *string `json:"page_url,omitempty"`
+
+	IssueTitleURL   *string `json:"issue_title_url,omitempty"`
+	IssueBodyURL    *string `json:"issue_body_url,omitempty"`
+	IssueCommentURL *string `json:"issue_comment_url,omitempty"`
+
+	DiscussionTitleURL   *string `json:"discussion_title_url,omitempty"`
+	DiscussionBodyURL    *string `json:"discussion_body_url,omitempty"`
+	DiscussionCommentURL *string `json:"discussion_comment_url,omitempty"`
+
+	PullRequestTitleURL         *string `json:"pull_request_title_url,omitempty"`
+	PullRequestBodyURL          *string `json:"pull_request_body_url,omitempty"`
+	PullRequestCommentURL       *string `json:"pull_request_comment_url,omitempty"`
+	PullRequestReviewURL        *string `json:"pull_request_review_url,omitempty"`
+	PullRequestReviewCommentURL *string `json:"pull_request_review_comment_url,omitempty"`
+}
+
+// SecretScanningAlertLocation represents a location of a SecretScanningAlert.
+type SecretScanningAlertLocation struct {
+	// Type is one of the SecretScanningLocation constants, e.g.
+	// SecretScanningLocationCommit.
+	Type    *string                             `json:"type,omitempty"`
+	Details *SecretScanningAlertLocationDetails `json:"details,omitempty"`
+}
+
+// Possible values for the Status of a BypassRequestReview.
+const (
+	BypassReviewApprove = "approve"
+	BypassReviewDeny    = "deny"
+)
+
+// BypassRequestActor represents the requester or reviewer of a push
+// protection bypass request.
+type BypassRequestActor struct {
+	ActorID   *int64  `json:"actor_id,omitempty"`
+	ActorName *string `json:"actor_name,omitempty"`
+}
+
+// BypassRequestLocation represents the location of a secret in a push
+// protection bypass request.
+type BypassRequestLocation struct {
+	Path      *string `json:"path,omitempty"`
+	StartLine *int    `json:"start_line,omitempty"`
+	EndLine   *int    `json:"end_line,omitempty"`
+	CommitSHA *string `json:"commit_sha,omitempty"`
+}
+
+// BypassRequestData represents a secret blocked by push protection for which
+// a bypass is requested.
+type BypassRequestData struct {
+	SecretType   *string                `json:"secret_type,omitempty"`
+	BypassReason *string                `json:"bypass_reason,omitempty"`
+	Location     *BypassRequestLocation `json:"location,omitempty"`
+}
+
+// BypassResponse represents a review of a push protection bypass request.
+type BypassResponse struct {
+	ID       *int64              `json:"id,omitempty"`
+	Reviewer *BypassRequestActor `json:"reviewer,omitempty"`
+	// Possible values for Status are: "approved", "denied" and "dismissed".
+	Status    *string    `json:"status,omitempty"`
+	CreatedAt *Timestamp `json:"created_at,omitempty"`
+}
+
+// SecretScanningBypassRequest represents a request to bypass secret scanning
+// push protection.
+type SecretScanningBypassRequest struct {
+	ID               *int64              `json:"id,omitempty"`
+	Number           *int64              `json:"number,omitempty"`
+	Repository       *Repository         `json:"repository,omitempty"`
+	Organization     *Organization       `json:"organization,omitempty"`
+	Requester        *BypassRequestActor `json:"requester,omitempty"`
+	RequesterComment *string             `json:"requester_comment,omitempty"`
+	// Possible values for Status are: "pending", "denied", "approved",
+	// "cancelled", "completed" and "expired".
+	Status    *string              `json:"status,omitempty"`
+	Data      []*BypassRequestData `json:"data,omitempty"`
+	Responses []*BypassResponse    `json:"responses,omitempty"`
+	ExpiresAt *Timestamp           `json:"expires_at,omitempty"`
+	CreatedAt *Timestamp           `json:"created_at,omitempty"`
+	URL       *string              `json:"url,omitempty"`
+	HTMLURL   *string              `json:"html_url,omitempty"`
+}
+
+// BypassRequestListOptions specifies the optional parameters to the
+// SecretScanningService.ListBypassRequestsForOrg and
+// SecretScanningService.ListBypassRequestsForRepo methods.
+type BypassRequestListOptions struct {
+	// Filters the bypass requests by the login of the reviewer or requester.
+	ReviewerName  string `url:"reviewer_name,omitempty"`
+	RequesterName string `url:"requester_name,omitempty"`
+
+	// Filters the bypass requests to those created within a time period.
+	// Possible values are: "hour", "day", "week" and "month". Default: "day".
+	TimePeriod string `url:"time_period,omitempty"`
+
+	// Filters the bypass requests by their status.
+	// Possible values are: "completed", "cancelled", "approved", "expired",
+	// "denied", "open" and "all". Default: "all".
+	RequestStatus string `url:"request_status,omitempty"`
+
+	ListOptions
+}
+
+// BypassRequestReview represents a review of a push protection bypass request.
+type BypassRequestReview struct {
+	// Status is one of BypassReviewApprove or BypassReviewDeny.
+	Status  string `json:"status"`
+	Message string `json:"message"`
+}
+
+// PushProtectionBypassRequest represents a request to bypass push protection
+// for a secret that was blocked.
+type PushProtectionBypassRequest struct {
+	// Possible values for Reason are: "false_positive", "used_in_tests" and "will_fix_later".
+	Reason string `json:"reason"`
+	// PlaceholderID is the ID of the blocked secret, as returned in the push
+	// protection error message.
+	PlaceholderID string `json:"placeholder_id"`
+}
+
+// PushProtectionBypass represents a bypass of push protection for a secret.
+type PushProtectionBypass struct {
+	Reason    *string    `json:"reason,omitempty"`
+	ExpireAt  *Timestamp `json:"expire_at,omitempty"`
+	TokenType *string    `json:"token_type,omitempty"`
+}
+
+// ListBypassRequestsForOrg lists the push protection bypass requests for the
+// repositories of an organization.
+//
+// GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/secret-scanning/#list-bypass-requests-for-secret-scanning-for-an-org
+func (s *SecretScanningService) ListBypassRequestsForOrg(ctx context.Context, org string, opts *BypassRequestListOptions) ([]*SecretScanningBypassRequest, *Response, error) {
+	u := fmt.Sprintf("orgs/%v/bypass-requests/secret-scanning", org)
+	return s.listBypassRequests(ctx, u, opts)
+}
+
+// ListBypassRequestsForRepo lists the push protection bypass requests for a
+// repository.
+//
+// GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/secret-scanning/#list-bypass-requests-for-secret-scanning-for-a-repository
+func (s *SecretScanningService) ListBypassRequestsForRepo(ctx context.Context, owner, repo string, opts *BypassRequestListOptions) ([]*SecretScanningBypassRequest, *Response, error) {
+	u := fmt.Sprintf("repos/%v/%v/bypass-requests/secret-scanning", owner, repo)
+	return s.listBypassRequests(ctx, u, opts)
+}
+
+func (s *SecretScanningService) listBypassRequests(ctx context.Context, u string, opts *BypassRequestListOptions) ([]*SecretScanningBypassRequest, *Response, error) {
+	u, err := addOptions(u, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var requests []*SecretScanningBypassRequest
+	resp, err := s.client.Do(ctx, req, &requests)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return requests, resp, nil
+}
+
+// GetBypassRequest gets a push protection bypass request of a repository.
+//
+// GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/secret-scanning/#get-a-bypass-request-for-secret-scanning
+func (s *SecretScanningService) GetBypassRequest(ctx context.Context, owner, repo string, number int64) (*SecretScanningBypassRequest, *Response, error) {
+	u := fmt.Sprintf("repos/%v/%v/bypass-requests/secret-scanning/%v", owner, repo, number)
+
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	request := new(SecretScanningBypassRequest)
+	resp, err := s.client.Do(ctx, req, request)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return request, resp, nil
+}
+
+// ReviewBypassRequest approves or denies a push protection bypass request
+// of a repository.
+//
+// GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/secret-scanning/#review-a-bypass-request-for-secret-scanning
+func (s *SecretScanningService) ReviewBypassRequest(ctx context.Context, owner, repo string, number int64, review *BypassRequestReview) (*BypassResponse, *Response, error) {
+	u := fmt.Sprintf("repos/%v/%v/bypass-responses/secret-scanning/%v", owner, repo, number)
+
+	req, err := s.client.NewRequest("PATCH", u, review)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	response := new(BypassResponse)
+	resp, err := s.client.Do(ctx, req, response)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return response, resp, nil
+}
+
+// CreatePushProtectionBypass creates a bypass for a secret that was blocked
+// by push protection, so that the push can be retried.
+//
+// GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/secret-scanning/#create-a-push-protection-bypass
+func (s *SecretScanningService) CreatePushProtectionBypass(ctx context.Context, owner, repo string, request *PushProtectionBypassRequest) (*PushProtectionBypass, *Response, error) {
+	u := fmt.Sprintf("repos/%v/%v/secret-scanning/push-protection-bypasses", owner, repo)
+
+	req, err := s.client.NewRequest("POST", u, request)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	bypass := new(PushProtectionBypass)
+	resp, err := s.client.Do(ctx, req, bypass)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return bypass, resp, nil
+}
+
+func (s *SecretScanningService) listAlerts(ctx context.Context, u string, opts *SecretScanningAlertListOptions) ([]*SecretScanningAlert, *Response, error) {
+	u, err := addOptions(u, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var alerts []*SecretScanningAlert
+	resp, err := s.client.Do(ctx, req, &alerts)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return alerts, resp, nil
+}
+
+// ListAlertsForOrg lists the secret scanning alerts of the repositories of
+// an organization.
+//
+// GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/secret-scanning/#list-secret-scanning-alerts-for-an-organization
+func (s *SecretScanningService) ListAlertsForOrg(ctx context.Context, org string, opts *SecretScanningAlertListOptions) ([]*SecretScanningAlert, *Response, error) {
+	u := fmt.Sprintf("orgs/%v/secret-scanning/alerts", org)
+	return s.listAlerts(ctx, u, opts)
+}
+
+// ListAlertsForRepo lists the secret scanning alerts of a repository.
+//
+// GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/secret-scanning/#list-secret-scanning-alerts-for-a-repository
+func (s *SecretScanningService) ListAlertsForRepo(ctx context.Context, owner, repo string, opts *SecretScanningAlertListOptions) ([]*SecretScanningAlert, *Response, error) {
+	u := fmt.Sprintf("repos/%v/%v/secret-scanning/alerts", owner, repo)
+	return s.listAlerts(ctx, u, opts)
+}
+
+// GetAlert gets a secret scanning alert of a repository.
+//
+// GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/secret-scanning/#get-a-secret-scanning-alert
+func (s *SecretScanningService) GetAlert(ctx context.Context, owner, repo string, number int64) (*SecretScanningAlert, *Response, error) {
+	u := fmt.Sprintf("repos/%v/%v/secret-scanning/alerts/%v", owner, repo, number)
+
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	alert := new(SecretScanningAlert)
+	resp, err := s.client.Do(ctx, req, alert)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return alert, resp, nil
+}
+
+// ListLocationsForAlert lists the locations, such as commits or issue
+// comments, where the secret of a secret scanning alert was detected.
+//
+// GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/secret-scanning/#list-locations-for-a-secret-scanning-alert
+func (s *SecretScanningService) ListLocationsForAlert(ctx context.Context, owner, repo string, number int64, opts *ListOptions) ([]*SecretScanningAlertLocation, *Response, error) {
+	u := fmt.Sprintf("repos/%v/%v/secret-scanning/alerts/%v/locations", owner, repo, number)
+	u, err := addOptions(u, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var locations []*SecretScanningAlertLocation
+	resp, err := s.client.Do(ctx, req, &locations)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return locations, resp, nil
+}
@@ -0,0 +1,517 @@
+// Copyright 2021 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestSecretScanningService_ListBypassRequestsForOrg(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/orgs/o/bypass-requests/secret-scanning", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testFormValues(t, r, values{"request_status": "open", "time_period": "week", "page": "2"})
+		fmt.Fprint(w, `[{"id":1,"number":2,"status":"pending"}]`)
+	})
+
+	opts := &BypassRequestListOptions{RequestStatus: "open", TimePeriod: "week", ListOptions: ListOptions{Page: 2}}
+	ctx := context.Background()
+	requests, _, err := client.SecretScanning.ListBypassRequestsForOrg(ctx, "o", opts)
+	if err != nil {
+		t.Errorf("SecretScanning.ListBypassRequestsForOrg returned error: %v", err)
+	}
+
+	want := []*SecretScanningBypassRequest{{ID: Int64(1), Number: Int64(2), Status: String("pending")}}
+	if !reflect.DeepEqual(requests, want) {
+		t.Errorf("SecretScanning.ListBypassRequestsForOrg returned %+v, want %+v", requests, want)
+	}
+
+	const methodName = "ListBypassRequestsForOrg"
+	testBadOptions(t, methodName, func() (err error) {
+		_, _, err = client.SecretScanning.ListBypassRequestsForOrg(ctx, "\n", opts)
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.SecretScanning.ListBypassRequestsForOrg(ctx, "o", opts)
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
+
+func TestSecretScanningService_ListBypassRequestsForRepo(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/bypass-requests/secret-scanning", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testFormValues(t, r, values{"requester_name": "u"})
+		fmt.Fprint(w, `[{"id":1}]`)
+	})
+
+	opts := &BypassRequestListOptions{RequesterName: "u"}
+	ctx := context.Background()
+	requests, _, err := client.SecretScanning.ListBypassRequestsForRepo(ctx, "o", "r", opts)
+	if err != nil {
+		t.Errorf("SecretScanning.ListBypassRequestsForRepo returned error: %v", err)
+	}
+
+	want := []*SecretScanningBypassRequest{{ID: Int64(1)}}
+	if !reflect.DeepEqual(requests, want) {
+		t.Errorf("SecretScanning.ListBypassRequestsForRepo returned %+v, want %+v", requests, want)
+	}
+
+	const methodName = "ListBypassRequestsForRepo"
+	testBadOptions(t, methodName, func() (err error) {
+		_, _, err = client.SecretScanning.ListBypassRequestsForRepo(ctx, "\n", "\n", opts)
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.SecretScanning.ListBypassRequestsForRepo(ctx, "o", "r", opts)
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
+
+func TestSecretScanningService_GetBypassRequest(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/bypass-requests/secret-scanning/2", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{
+			"id": 1,
+			"number": 2,
+			"requester": {"actor_id": 3, "actor_name": "u"},
+			"requester_comment": "test credentials",
+			"status": "approved",
+			"data": [{
+				"secret_type": "github_personal_access_token",
+				"bypass_reason": "used_in_tests",
+				"location": {"path": "a.go", "start_line": 1, "end_line": 1, "commit_sha": "s"}
+			}],
+			"responses": [{
+				"id": 4,
+				"reviewer": {"actor_id": 5, "actor_name": "r"},
+				"status": "approved",
+				"created_at": "2006-01-02T15:04:05Z"
+			}],
+			"expires_at": "2006-01-02T15:04:05Z"
+		}`)
+	})
+
+	ctx := context.Background()
+	request, _, err := client.SecretScanning.GetBypassRequest(ctx, "o", "r", 2)
+	if err != nil {
+		t.Errorf("SecretScanning.GetBypassRequest returned error: %v", err)
+	}
+
+	ts := &Timestamp{time.Date(2006, time.January, 2, 15, 4, 5, 0, time.UTC)}
+	want := &SecretScanningBypassRequest{
+		ID:               Int64(1),
+		Number:           Int64(2),
+		Requester:        &BypassRequestActor{ActorID: Int64(3), ActorName: String("u")},
+		RequesterComment: String("test credentials"),
+		Status:           String("approved"),
+		Data: []*BypassRequestData{{
+			SecretType:   String("github_personal_access_token"),
+			BypassReason: String("used_in_tests"),
+			Location:     &BypassRequestLocation{Path: String("a.go"), StartLine: Int(1), EndLine: Int(1), CommitSHA: String("s")},
+		}},
+		Responses: []*BypassResponse{{
+			ID:        Int64(4),
+			Reviewer:  &BypassRequestActor{ActorID: Int64(5), ActorName: String("r")},
+			Status:    String("approved"),
+			CreatedAt: ts,
+		}},
+		ExpiresAt: ts,
+	}
+	if !reflect.DeepEqual(request, want) {
+		t.Errorf("SecretScanning.GetBypassRequest returned %+v, want %+v", request, want)
+	}
+
+	const methodName = "GetBypassRequest"
+	testBadOptions(t, methodName, func() (err error) {
+		_, _, err = client.SecretScanning.GetBypassRequest(ctx, "\n", "\n", -1)
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.SecretScanning.GetBypassRequest(ctx, "o", "r", 2)
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
+
+func TestSecretScanningService_ReviewBypassRequest(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	input := &BypassRequestReview{Status: BypassReviewDeny, Message: "rotate it"}
+
+	mux.HandleFunc("/repos/o/r/bypass-responses/secret-scanning/2", func(w http.ResponseWriter, r *http.Request) {
+		v := new(BypassRequestReview)
+		json.NewDecoder(r.Body).Decode(v)
+
+		testMethod(t, r, "PATCH")
+		if !reflect.DeepEqual(v, input) {
+			t.Errorf("Request body = %+v, want %+v", v, input)
+		}
+
+		fmt.Fprint(w, `{"id":4,"status":"denied"}`)
+	})
+
+	ctx := context.Background()
+	response, _, err := client.SecretScanning.ReviewBypassRequest(ctx, "o", "r", 2, input)
+	if err != nil {
+		t.Errorf("SecretScanning.ReviewBypassRequest returned error: %v", err)
+	}
+
+	want := &BypassResponse{ID: Int64(4), Status: String("denied")}
+	if !reflect.DeepEqual(response, want) {
+		t.Errorf("SecretScanning.ReviewBypassRequest returned %+v, want %+v", response, want)
+	}
+
+	const methodName = "ReviewBypassRequest"
+	testBadOptions(t, methodName, func() (err error) {
+		_, _, err = client.SecretScanning.ReviewBypassRequest(ctx, "\n", "\n", -1, input)
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.SecretScanning.ReviewBypassRequest(ctx, "o", "r", 2, input)
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
+
+func TestSecretScanningService_CreatePushProtectionBypass(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	input := &PushProtectionBypassRequest{Reason: "false_positive", PlaceholderID: "p1"}
+
+	mux.HandleFunc("/repos/o/r/secret-scanning/push-protection-bypasses", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testBody(t, r, `{"reason":"false_positive","placeholder_id":"p1"}`+"\n")
+		fmt.Fprint(w, `{"reason":"false_positive","expire_at":"2006-01-02T15:04:05Z","token_type":"github_pat"}`)
+	})
+
+	ctx := context.Background()
+	bypass, _, err := client.SecretScanning.CreatePushProtectionBypass(ctx, "o", "r", input)
+	if err != nil {
+		t.Errorf("SecretScanning.CreatePushProtectionBypass returned error: %v", err)
+	}
+
+	want := &PushProtectionBypass{
+		Reason:    String("false_positive"),
+		ExpireAt:  &Timestamp{time.Date(2006, time.January, 2, 15, 4, 5, 0, time.UTC)},
+		TokenType: String("github_pat"),
+	}
+	if !reflect.DeepEqual(bypass, want) {
+		t.Errorf("SecretScanning.CreatePushProtectionBypass returned %+v, want %+v", bypass, want)
+	}
+
+	const methodName = "CreatePushProtectionBypass"
+	testBadOptions(t, methodName, func() (err error) {
+		_, _, err = client.SecretScanning.CreatePushProtectionBypass(ctx, "\n", "\n", input)
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.SecretScanning.CreatePushProtectionBypass(ctx, "o", "r", input)
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
+
+func TestSecretScanningService_ListAlertsForOrg(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/orgs/o/secret-scanning/alerts", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testFormValues(t, r, values{"state": "open", "validity": "active,unknown", "is_publicly_leaked": "true"})
+		fmt.Fprint(w, `[{"number":1,"validity":"active","publicly_leaked":true,"repository":{"name":"r"}}]`)
+	})
+
+	opts := &SecretScanningAlertListOptions{State: "open", Validity: "active,unknown", IsPubliclyLeaked: true}
+	ctx := context.Background()
+	alerts, _, err := client.SecretScanning.ListAlertsForOrg(ctx, "o", opts)
+	if err != nil {
+		t.Errorf("SecretScanning.ListAlertsForOrg returned error: %v", err)
+	}
+
+	want := []*SecretScanningAlert{{
+		Number:         Int(1),
+		Validity:       String(SecretValidityActive),
+		PubliclyLeaked: Bool(true),
+		Repository:     &Repository{Name: String("r")},
+	}}
+	if !reflect.DeepEqual(alerts, want) {
+		t.Errorf("SecretScanning.ListAlertsForOrg returned %+v, want %+v", alerts, want)
+	}
+
+	const methodName = "ListAlertsForOrg"
+	testBadOptions(t, methodName, func() (err error) {
+		_, _, err = client.SecretScanning.ListAlertsForOrg(ctx, "\n", opts)
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.SecretScanning.ListAlertsForOrg(ctx, "o", opts)
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
+
+func TestSecretScanningService_ListAlertsForRepo(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/secret-scanning/alerts", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testFormValues(t, r, values{"secret_type": "github_personal_access_token", "page": "2"})
+		fmt.Fprint(w, `[{"number":1}]`)
+	})
+
+	opts := &SecretScanningAlertListOptions{SecretType: "github_personal_access_token", ListOptions: ListOptions{Page: 2}}
+	ctx := context.Background()
+	alerts, _, err := client.SecretScanning.ListAlertsForRepo(ctx, "o", "r", opts)
+	if err != nil {
+		t.Errorf("SecretScanning.ListAlertsForRepo returned error: %v", err)
+	}
+
+	want := []*SecretScanningAlert{{Number: Int(1)}}
+	if !reflect.DeepEqual(alerts, want) {
+		t.Errorf("SecretScanning.ListAlertsForRepo returned %+v, want %+v", alerts, want)
+	}
+
+	const methodName = "ListAlertsForRepo"
+	testBadOptions(t, methodName, func() (err error) {
+		_, _, err = client.SecretScanning.ListAlertsForRepo(ctx, "\n", "\n", opts)
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.SecretScanning.ListAlertsForRepo(ctx, "o", "r", opts)
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
+
+func TestSecretScanningService_GetAlert(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/secret-scanning/alerts/1", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{
+			"number": 1,
+			"state": "resolved",
+			"resolution": "revoked",
+			"resolved_by": {"login": "u"},
+			"secret_type": "adafruit_io_key",
+			"secret_type_display_name": "Adafruit IO Key",
+			"secret": "s",
+			"validity": "inactive",
+			"multi_repo": false,
+			"is_base64_encoded": false,
+			"push_protection_bypassed": true,
+			"push_protection_bypassed_at": `+referenceTimeStr+`,
+			"locations_url": "https://api.github.com/repos/o/r/secret-scanning/alerts/1/locations"
+		}`)
+	})
+
+	ctx := context.Background()
+	alert, _, err := client.SecretScanning.GetAlert(ctx, "o", "r", 1)
+	if err != nil {
+		t.Errorf("SecretScanning.GetAlert returned error: %v", err)
+	}
+
+	want := &SecretScanningAlert{
+		Number:                   Int(1),
+		State:                    String("resolved"),
+		Resolution:               String("revoked"),
+		ResolvedBy:               &User{Login: String("u")},
+		SecretType:               String("adafruit_io_key"),
+		SecretTypeDisplayName:    String("Adafruit IO Key"),
+		Secret:                   String("s"),
+		Validity:                 String(SecretValidityInactive),
+		MultiRepo:                Bool(false),
+		IsBase64Encoded:          Bool(false),
+		PushProtectionBypassed:   Bool(true),
+		PushProtectionBypassedAt: &Timestamp{referenceTime},
+		LocationsURL:             String("https://api.github.com/repos/o/r/secret-scanning/alerts/1/locations"),
+	}
+	if !reflect.DeepEqual(alert, want) {
+		t.Errorf("SecretScanning.GetAlert returned %+v, want %+v", alert, want)
+	}
+
+	const methodName = "GetAlert"
+	testBadOptions(t, methodName, func() (err error) {
+		_, _, err = client.SecretScanning.GetAlert(ctx, "\n", "\n", -1)
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.SecretScanning.GetAlert(ctx, "o", "r", 1)
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
+
+func TestSecretScanningService_ListLocationsForAlert(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/secret-scanning/alerts/1/locations", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testFormValues(t, r, values{"page": "1", "per_page": "100"})
+		fmt.Fprint(w, `[{
+			"type": "commit",
+			"details": {
+				"path": "/example/secrets.txt",
+				"start_line": 1,
+				"end_line": 1,
+				"start_column": 1,
+				"end_column": 64,
+				"blob_sha": "af5626b4a114abcb82d63db7c8082c3c4756e51b",
+				"blob_url": "https://api.github.com/repos/o/r/git/blobs/af5626b4a114abcb82d63db7c8082c3c4756e51b",
+				"commit_sha": "f14d7debf9775f957cf4f1e8176da0786431f72b",
+				"commit_url": "https://api.github.com/repos/o/r/git/commits/f14d7debf9775f957cf4f1e8176da0786431f72b"
+			}
+		}, {
+			"type": "issue_comment",
+			"details": {"issue_comment_url": "https://api.github.com/repos/o/r/issues/comments/1"}
+		}, {
+			"type": "discussion_body",
+			"details": {"discussion_body_url": "https://github.com/o/r/discussions/1"}
+		}, {
+			"type": "pull_request_body",
+			"details": {"pull_request_body_url": "https://api.github.com/repos/o/r/pulls/2"}
+		}]`)
+	})
+
+	opts := &ListOptions{Page: 1, PerPage: 100}
+	ctx := context.Background()
+	locations, _, err := client.SecretScanning.ListLocationsForAlert(ctx, "o", "r", 1, opts)
+	if err != nil {
+		t.Errorf("SecretScanning.ListLocationsForAlert returned error: %v", err)
+	}
+
+	want := []*SecretScanningAlertLocation{{
+		Type: String(SecretScanningLocationCommit),
+		Details: &SecretScanningAlertLocationDetails{
+			Path:        String("/example/secrets.txt"),
+			StartLine:   Int(1),
+			EndLine:     Int(1),
+			StartColumn: Int(1),
+			EndColumn:   Int(64),
+			BlobSHA:     String("af5626b4a114abcb82d63db7c8082c3c4756e51b"),
+			BlobURL:     String("https://api.github.com/repos/o/r/git/blobs/af5626b4a114abcb82d63db7c8082c3c4756e51b"),
+			CommitSHA:   String("f14d7debf9775f957cf4f1e8176da0786431f72b"),
+			CommitURL:   String("https://api.github.com/repos/o/r/git/commits/f14d7debf9775f957cf4f1e8176da0786431f72b"),
+		},
+	}, {
+		Type:    String(SecretScanningLocationIssueComment),
+		Details: &SecretScanningAlertLocationDetails{IssueCommentURL: String("https://api.github.com/repos/o/r/issues/comments/1")},
+	}, {
+		Type:    String(SecretScanningLocationDiscussionBody),
+		Details: &SecretScanningAlertLocationDetails{DiscussionBodyURL: String("https://github.com/o/r/discussions/1")},
+	}, {
+		Type:    String(SecretScanningLocationPullRequestBody),
+		Details: &SecretScanningAlertLocationDetails{PullRequestBodyURL: String("https://api.github.com/repos/o/r/pulls/2")},
+	}}
+	if !reflect.DeepEqual(locations, want) {
+		t.Errorf("SecretScanning.ListLocationsForAlert returned %+v, want %+v", locations, want)
+	}
+
+	const methodName = "ListLocationsForAlert"
+	testBadOptions(t, methodName, func() (err error) {
+		_, _, err = client.SecretScanning.ListLocationsForAlert(ctx, "\n", "\n", -1, opts)
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.SecretScanning.ListLocationsForAlert(ctx, "o", "r", 1, opts)
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
+
+func TestSecretScanningAlert_Marshal(t *testing.T) {
+	testJSONMarshal(t, &SecretScanningAlert{}, "{}")
+
+	u := &SecretScanningAlert{
+		Number:         Int(1),
+		State:          String("open"),
+		SecretType:     String("t"),
+		Validity:       String("active"),
+		PubliclyLeaked: Bool(false),
+		CreatedAt:      &Timestamp{referenceTime},
+	}
+
+	want := `{
+		"number": 1,
+		"state": "open",
+		"secret_type": "t",
+		"validity": "active",
+		"publicly_leaked": false,
+		"created_at": ` + referenceTimeStr + `
+	}`
+
+	testJSONMarshal(t, u, want)
+}
+
+func TestSecretScanningBypassRequest_Marshal(t *testing.T) {
+	testJSONMarshal(t, &SecretScanningBypassRequest{}, "{}")
+
+	u := &SecretScanningBypassRequest{
+		ID:        Int64(1),
+		Number:    Int64(2),
+		Requester: &BypassRequestActor{ActorID: Int64(3), ActorName: String("u")},
+		Status:    String("pending"),
+		ExpiresAt: &Timestamp{referenceTime},
+	}
+
+	want := `{
+		"id": 1,
+		"number": 2,
+		"requester": {"actor_id": 3, "actor_name": "u"},
+		"status": "pending",
+		"expires_at": ` + referenceTimeStr + `
+	}`
+
+	testJSONMarshal(t, u, want)
+}
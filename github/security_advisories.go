@@ -0,0 +1,421 @@
+// Copyright 2021 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+)
+
+// SecurityAdvisoriesService handles communication with the security advisory
+// related methods of the GitHub API.
+//
+// GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/security-advisories/
+type SecurityAdvisoriesService service
+
+// RepoAdvisoryCredit represents a credit of a repository security advisory.
+type RepoAdvisoryCredit struct {
+	Login *string `json:"login,omitempty"`
+	// Possible values for Type are: "analyst", "finder", "reporter",
+	// "coordinator", "remediation_developer", "remediation_reviewer",
+	// "remediation_verifier", "tool", "sponsor" and "other".
+	Type *string `json:"type,omitempty"`
+}
+
+// RepoAdvisoryCreditDetailed represents a credit of a repository security
+// advisory, along with its acceptance state.
+type RepoAdvisoryCreditDetailed struct {
+	User *User   `json:"user,omitempty"`
+	Type *string `json:"type,omitempty"`
+	// Possible values for State are: "accepted", "declined" and "pending".
+	State *string `json:"state,omitempty"`
+}
+
+// SecurityAdvisorySubmission represents the submission of a privately
+// reported security advisory.
+type SecurityAdvisorySubmission struct {
+	// Accepted reports whether the maintainers accepted the report.
+	Accepted *bool `json:"accepted,omitempty"`
+}
+
+// SecurityAdvisory represents a repository security advisory.
+type SecurityAdvisory struct {
+	GHSAID      *string               `json:"ghsa_id,omitempty"`
+	CVEID       *string               `json:"cve_id,omitempty"`
+	URL         *string               `json:"url,omitempty"`
+	HTMLURL     *string               `json:"html_url,omitempty"`
+	Summary     *string               `json:"summary,omitempty"`
+	Description *string               `json:"description,omitempty"`
+	Severity    *string               `json:"severity,omitempty"`
+	Identifiers []*AdvisoryIdentifier `json:"identifiers,omitempty"`
+	Author      *User                 `json:"author,omitempty"`
+	Publisher   *User                 `json:"publisher,omitempty"`
+	// Possible values for State are: "published", "closed", "withdrawn",
+	// "draft" and "triage".
+	State              *string                       `json:"state,omitempty"`
+	CreatedAt          *Timestamp                    `json:"created_at,omitempty"`
+	UpdatedAt          *Timestamp                    `json:"updated_at,omitempty"`
+	PublishedAt        *Timestamp                    `json:"published_at,omitempty"`
+	ClosedAt           *Timestamp                    `json:"closed_at,omitempty"`
+	WithdrawnAt        *Timestamp                    `json:"withdrawn_at,omitempty"`
+	Submission         *SecurityAdvisorySubmission   `json:"submission,omitempty"`
+	Vulnerabilities    []*AdvisoryVulnerability      `json:"vulnerabilities,omitempty"`
+	CVSS               *AdvisoryCVSS                 `json:"cvss,omitempty"`
+	CWEs               []*AdvisoryCWE                `json:"cwes,omitempty"`
+	CWEIDs             []string                      `json:"cwe_ids,omitempty"`
+	Credits            []*RepoAdvisoryCredit         `json:"credits,omitempty"`
+	CreditsDetailed    []*RepoAdvisoryCreditDetailed `json:"credits_detailed,omitempty"`
+	CollaboratingUsers []*User                       `json:"collaborating_users,omitempty"`
+	CollaboratingTeams []*Team                       `json:"collaborating_teams,omitempty"`
+	PrivateFork        *Repository                   `json:"private_fork,omitempty"`
+}
+
+// RepositoryAdvisoryRequest represents a request to create or update a
+// repository security advisory. Only the set fields are updated.
+type RepositoryAdvisoryRequest struct {
+	Summary         *string                  `json:"summary,omitempty"`
+	Description     *string                  `json:"description,omitempty"`
+	CVEID           *string                  `json:"cve_id,omitempty"`
+	Vulnerabilities []*AdvisoryVulnerability `json:"vulnerabilities,omitempty"`
+	CWEIDs          []string                 `json:"cwe_ids,omitempty"`
+	// Credits replaces the credits of the advisory.
+	Credits []*RepoAdvisoryCredit `json:"credits,omitempty"`
+	// Possible values for Severity are: "critical", "high", "medium" and
+	// "low". Severity and CVSSVectorString cannot both be set.
+	Severity         *string `json:"severity,omitempty"`
+	CVSSVectorString *string `json:"cvss_vector_string,omitempty"`
+	// State can only be set when updating an advisory. Possible values
+	// are: "published", "closed" and "draft".
+	State *string `json:"state,omitempty"`
+	// CollaboratingUsers and CollaboratingTeams are the logins of the users
+	// and the slugs of the teams with access to the advisory.
+	CollaboratingUsers []string `json:"collaborating_users,omitempty"`
+	CollaboratingTeams []string `json:"collaborating_teams,omitempty"`
+}
+
+// ListRepositorySecurityAdvisoriesOptions specifies the optional parameters
+// to the SecurityAdvisoriesService.ListRepositorySecurityAdvisories and
+// SecurityAdvisoriesService.ListRepositorySecurityAdvisoriesForOrg methods.
+type ListRepositorySecurityAdvisoriesOptions struct {
+	// Sort specifies how to sort the advisories. Possible values are:
+	// "created", "updated" and "published". Default: "created".
+	Sort string `url:"sort,omitempty"`
+	// Direction in which to sort the advisories. Possible values are: "asc"
+	// and "desc". Default: "desc".
+	Direction string `url:"direction,omitempty"`
+	// State filters the advisories by state. Possible values are: "triage",
+	// "draft", "published" and "closed".
+	State string `url:"state,omitempty"`
+
+	// Before and After are cursors for paginating through the results.
+	// Set them from Response.Before and Response.After respectively.
+	Before string `url:"before,omitempty"`
+	After  string `url:"after,omitempty"`
+
+	// For paginated result sets, the number of results to include per page.
+	PerPage int `url:"per_page,omitempty"`
+}
+
+func (s *SecurityAdvisoriesService) listRepositorySecurityAdvisories(ctx context.Context, u string, opts *ListRepositorySecurityAdvisoriesOptions) ([]*SecurityAdvisory, *Response, error) {
+	u, err := addOptions(u, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var advisories []*SecurityAdvisory
+	resp, err := s.client.Do(ctx, req, &advisories)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return advisories, resp, nil
+}
+
+// ListRepositorySecurityAdvisories lists the security advisories of a repository.
+//
+// GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/security-advisories/#list-repository-security-advisories
+func (s *SecurityAdvisoriesService) ListRepositorySecurityAdvisories(ctx context.Context, owner, repo string, opts *ListRepositorySecurityAdvisoriesOptions) ([]*SecurityAdvisory, *Response, error) {
+	u := fmt.Sprintf("repos/%v/%v/security-advisories", owner, repo)
+	return s.listRepositorySecurityAdvisories(ctx, u, opts)
+}
+
+// ListRepositorySecurityAdvisoriesForOrg lists the security advisories of the
+// repositories of an organization.
+//
+// GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/security-advisories/#list-repository-security-advisories-for-an-organization
+func (s *SecurityAdvisoriesService) ListRepositorySecurityAdvisoriesForOrg(ctx context.Context, org string, opts *ListRepositorySecurityAdvisoriesOptions) ([]*SecurityAdvisory, *Response, error) {
+	u := fmt.Sprintf("orgs/%v/security-advisories", org)
+	return s.listRepositorySecurityAdvisories(ctx, u, opts)
+}
+
+// GetRepositorySecurityAdvisory gets a security advisory of a repository.
+//
+// GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/security-advisories/#get-a-repository-security-advisory
+func (s *SecurityAdvisoriesService) GetRepositorySecurityAdvisory(ctx context.Context, owner, repo, ghsaID string) (*SecurityAdvisory, *Response, error) {
+	u := fmt.Sprintf("repos/%v/%v/security-advisories/%v", owner, repo, ghsaID)
+
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	advisory := new(SecurityAdvisory)
+	resp, err := s.client.Do(ctx, req, advisory)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return advisory, resp, nil
+}
+
+// CreateRepositorySecurityAdvisory creates a draft security advisory for a
+// repository. Summary, Description and Vulnerabilities must be set.
+//
+// GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/security-advisories/#create-a-repository-security-advisory
+func (s *SecurityAdvisoriesService) CreateRepositorySecurityAdvisory(ctx context.Context, owner, repo string, advisory *RepositoryAdvisoryRequest) (*SecurityAdvisory, *Response, error) {
+	u := fmt.Sprintf("repos/%v/%v/security-advisories", owner, repo)
+
+	req, err := s.client.NewRequest("POST", u, advisory)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	a := new(SecurityAdvisory)
+	resp, err := s.client.Do(ctx, req, a)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return a, resp, nil
+}
+
+// UpdateRepositorySecurityAdvisory updates a security advisory of a
+// repository. It can be used to manage the credits of the advisory, which
+// are replaced by RepositoryAdvisoryRequest.Credits.
+//
+// GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/security-advisories/#update-a-repository-security-advisory
+func (s *SecurityAdvisoriesService) UpdateRepositorySecurityAdvisory(ctx context.Context, owner, repo, ghsaID string, advisory *RepositoryAdvisoryRequest) (*SecurityAdvisory, *Response, error) {
+	u := fmt.Sprintf("repos/%v/%v/security-advisories/%v", owner, repo, ghsaID)
+
+	req, err := s.client.NewRequest("PATCH", u, advisory)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	a := new(SecurityAdvisory)
+	resp, err := s.client.Do(ctx, req, a)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return a, resp, nil
+}
+
+// PublishRepositorySecurityAdvisory publishes a draft security advisory of a
+// repository.
+//
+// GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/security-advisories/#update-a-repository-security-advisory
+func (s *SecurityAdvisoriesService) PublishRepositorySecurityAdvisory(ctx context.Context, owner, repo, ghsaID string) (*SecurityAdvisory, *Response, error) {
+	return s.UpdateRepositorySecurityAdvisory(ctx, owner, repo, ghsaID, &RepositoryAdvisoryRequest{State: String("published")})
+}
+
+// RequestCVE requests a CVE ID for a security advisory of a repository.
+// GitHub processes the request asynchronously; the CVE ID is set on the
+// advisory once it is assigned.
+//
+// GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/security-advisories/#request-a-cve-for-a-repository-security-advisory
+func (s *SecurityAdvisoriesService) RequestCVE(ctx context.Context, owner, repo, ghsaID string) (*Response, error) {
+	u := fmt.Sprintf("repos/%v/%v/security-advisories/%v/cve", owner, repo, ghsaID)
+
+	req, err := s.client.NewRequest("POST", u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.client.Do(ctx, req, nil)
+	if err != nil {
+		// A 202 Accepted is the expected response to the request.
+		if _, ok := err.(*AcceptedError); ok {
+			return resp, nil
+		}
+		return resp, err
+	}
+
+	return resp, nil
+}
+
+// CreateTemporaryPrivateFork creates a temporary private fork of a
+// repository, in which to collaborate on a fix for a security advisory.
+//
+// This method might return an *AcceptedError and a status code of
+// 202. This is because this is the status that GitHub returns to signify that
+// it is now computing creating the fork in a background task. In this event,
+// the Repository value will be returned, which includes the details about the pending fork.
+//
+// GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/security-advisories/#create-a-temporary-private-fork
+func (s *SecurityAdvisoriesService) CreateTemporaryPrivateFork(ctx context.Context, owner, repo, ghsaID string) (*Repository, *Response, error) {
+	u := fmt.Sprintf("repos/%v/%v/security-advisories/%v/forks", owner, repo, ghsaID)
+
+	req, err := s.client.NewRequest("POST", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	fork := new(Repository)
+	resp, err := s.client.Do(ctx, req, fork)
+	if err != nil {
+		// Persist AcceptedError's metadata to the Repository object.
+		if aerr, ok := err.(*AcceptedError); ok {
+			if err := json.Unmarshal(aerr.Raw, fork); err != nil {
+				return fork, resp, err
+			}
+
+			return fork, resp, err
+		}
+		return nil, resp, err
+	}
+
+	return fork, resp, nil
+}
+
+// GlobalSecurityVulnerability represents a vulnerability of a global
+// security advisory.
+type GlobalSecurityVulnerability struct {
+	Package                *VulnerabilityPackage `json:"package,omitempty"`
+	VulnerableVersionRange *string               `json:"vulnerable_version_range,omitempty"`
+	FirstPatchedVersion    *string               `json:"first_patched_version,omitempty"`
+	VulnerableFunctions    []string              `json:"vulnerable_functions,omitempty"`
+}
+
+// Credit represents a user credited for a global security advisory.
+type Credit struct {
+	User *User   `json:"user,omitempty"`
+	Type *string `json:"type,omitempty"`
+}
+
+// GlobalSecurityAdvisory represents an advisory of the GitHub Advisory Database.
+type GlobalSecurityAdvisory struct {
+	GHSAID                *string `json:"ghsa_id,omitempty"`
+	CVEID                 *string `json:"cve_id,omitempty"`
+	URL                   *string `json:"url,omitempty"`
+	HTMLURL               *string `json:"html_url,omitempty"`
+	RepositoryAdvisoryURL *string `json:"repository_advisory_url,omitempty"`
+	Summary               *string `json:"summary,omitempty"`
+	Description           *string `json:"description,omitempty"`
+	// Possible values for Type are: "reviewed", "unreviewed" and "malware".
+	Type               *string                        `json:"type,omitempty"`
+	Severity           *string                        `json:"severity,omitempty"`
+	SourceCodeLocation *string                        `json:"source_code_location,omitempty"`
+	Identifiers        []*AdvisoryIdentifier          `json:"identifiers,omitempty"`
+	References         []string                       `json:"references,omitempty"`
+	PublishedAt        *Timestamp                     `json:"published_at,omitempty"`
+	UpdatedAt          *Timestamp                     `json:"updated_at,omitempty"`
+	GithubReviewedAt   *Timestamp                     `json:"github_reviewed_at,omitempty"`
+	NVDPublishedAt     *Timestamp                     `json:"nvd_published_at,omitempty"`
+	WithdrawnAt        *Timestamp                     `json:"withdrawn_at,omitempty"`
+	Vulnerabilities    []*GlobalSecurityVulnerability `json:"vulnerabilities,omitempty"`
+	CVSS               *AdvisoryCVSS                  `json:"cvss,omitempty"`
+	CWEs               []*AdvisoryCWE                 `json:"cwes,omitempty"`
+	EPSS               *AdvisoryEPSS                  `json:"epss,omitempty"`
+	Credits            []*Credit                      `json:"credits,omitempty"`
+}
+
+// ListGlobalSecurityAdvisoriesOptions specifies the optional parameters to
+// the SecurityAdvisoriesService.ListGlobalAdvisories method.
+type ListGlobalSecurityAdvisoriesOptions struct {
+	GHSAID string `url:"ghsa_id,omitempty"`
+	// Type filters the advisories by type. Possible values are: "reviewed",
+	// "malware" and "unreviewed". Default: "reviewed".
+	Type  string `url:"type,omitempty"`
+	CVEID string `url:"cve_id,omitempty"`
+	// Ecosystem filters the advisories by the ecosystem of the affected
+	// packages, e.g. "go" or "npm".
+	Ecosystem string `url:"ecosystem,omitempty"`
+	// Possible values for Severity are: "unknown", "low", "medium", "high"
+	// and "critical".
+	Severity string `url:"severity,omitempty"`
+	// CWEs filters the advisories to those with any of the given CWE IDs,
+	// e.g. "79" or "CWE-79".
+	CWEs []string `url:"cwes,comma,omitempty"`
+	// IsWithdrawn filters the advisories by whether they were withdrawn.
+	IsWithdrawn *bool `url:"is_withdrawn,omitempty"`
+	// Affects filters the advisories to those affecting a package, or a
+	// version of a package, e.g. "lodash" or "lodash@4.17.20".
+	Affects string `url:"affects,omitempty"`
+	// Published, Updated and Modified filter the advisories by date or date
+	// range, e.g. ">=2023-01-01" or "2023-01-01..2023-03-31".
+	Published string `url:"published,omitempty"`
+	Updated   string `url:"updated,omitempty"`
+	Modified  string `url:"modified,omitempty"`
+	// EPSSPercentage and EPSSPercentile filter the advisories by their EPSS
+	// score, e.g. ">=0.1".
+	EPSSPercentage string `url:"epss_percentage,omitempty"`
+	EPSSPercentile string `url:"epss_percentile,omitempty"`
+
+	// Sort specifies how to sort the advisories. Possible values are:
+	// "updated", "published" and "epss_percentage". Default: "published".
+	Sort string `url:"sort,omitempty"`
+	// Direction in which to sort the advisories. Possible values are: "asc"
+	// and "desc". Default: "desc".
+	Direction string `url:"direction,omitempty"`
+
+	// Before and After are cursors for paginating through the results.
+	// Set them from Response.Before and Response.After respectively.
+	Before string `url:"before,omitempty"`
+	After  string `url:"after,omitempty"`
+
+	// For paginated result sets, the number of results to include per page.
+	PerPage int `url:"per_page,omitempty"`
+}
+
+// ListGlobalAdvisories lists the advisories of the GitHub Advisory Database.
+//
+// GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/security-advisories/#list-global-security-advisories
+func (s *SecurityAdvisoriesService) ListGlobalAdvisories(ctx context.Context, opts *ListGlobalSecurityAdvisoriesOptions) ([]*GlobalSecurityAdvisory, *Response, error) {
+	u, err := addOptions("advisories", opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var advisories []*GlobalSecurityAdvisory
+	resp, err := s.client.Do(ctx, req, &advisories)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return advisories, resp, nil
+}
+
+// GetGlobalAdvisory gets an advisory of the GitHub Advisory Database.
+//
+// GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/security-advisories/#get-a-global-security-advisory
+func (s *SecurityAdvisoriesService) GetGlobalAdvisory(ctx context.Context, ghsaID string) (*GlobalSecurityAdvisory, *Response, error) {
+	u := fmt.Sprintf("advisories/%v", ghsaID)
+
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	advisory := new(GlobalSecurityAdvisory)
+	resp, err := s.client.Do(ctx, req, advisory)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return advisory, resp, nil
+}
@@ -0,0 +1,517 @@
+// Copyright 2021 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+func TestSecurityAdvisoriesService_ListRepositorySecurityAdvisories(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/security-advisories", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testFormValues(t, r, values{"state": "draft", "sort": "updated"})
+		fmt.Fprint(w, `[{"ghsa_id":"GHSA-1","state":"draft"}]`)
+	})
+
+	opts := &ListRepositorySecurityAdvisoriesOptions{State: "draft", Sort: "updated"}
+	ctx := context.Background()
+	advisories, _, err := client.SecurityAdvisories.ListRepositorySecurityAdvisories(ctx, "o", "r", opts)
+	if err != nil {
+		t.Errorf("SecurityAdvisories.ListRepositorySecurityAdvisories returned error: %v", err)
+	}
+
+	want := []*SecurityAdvisory{{GHSAID: String("GHSA-1"), State: String("draft")}}
+	if !reflect.DeepEqual(advisories, want) {
+		t.Errorf("SecurityAdvisories.ListRepositorySecurityAdvisories returned %+v, want %+v", advisories, want)
+	}
+
+	const methodName = "ListRepositorySecurityAdvisories"
+	testBadOptions(t, methodName, func() (err error) {
+		_, _, err = client.SecurityAdvisories.ListRepositorySecurityAdvisories(ctx, "\n", "\n", opts)
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.SecurityAdvisories.ListRepositorySecurityAdvisories(ctx, "o", "r", opts)
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
+
+func TestSecurityAdvisoriesService_ListRepositorySecurityAdvisoriesForOrg(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/orgs/o/security-advisories", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testFormValues(t, r, values{"after": "a1", "per_page": "10"})
+		fmt.Fprint(w, `[{"ghsa_id":"GHSA-1"}]`)
+	})
+
+	opts := &ListRepositorySecurityAdvisoriesOptions{After: "a1", PerPage: 10}
+	ctx := context.Background()
+	advisories, _, err := client.SecurityAdvisories.ListRepositorySecurityAdvisoriesForOrg(ctx, "o", opts)
+	if err != nil {
+		t.Errorf("SecurityAdvisories.ListRepositorySecurityAdvisoriesForOrg returned error: %v", err)
+	}
+
+	want := []*SecurityAdvisory{{GHSAID: String("GHSA-1")}}
+	if !reflect.DeepEqual(advisories, want) {
+		t.Errorf("SecurityAdvisories.ListRepositorySecurityAdvisoriesForOrg returned %+v, want %+v", advisories, want)
+	}
+
+	const methodName = "ListRepositorySecurityAdvisoriesForOrg"
+	testBadOptions(t, methodName, func() (err error) {
+		_, _, err = client.SecurityAdvisories.ListRepositorySecurityAdvisoriesForOrg(ctx, "\n", opts)
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.SecurityAdvisories.ListRepositorySecurityAdvisoriesForOrg(ctx, "o", opts)
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
+
+func TestSecurityAdvisoriesService_GetRepositorySecurityAdvisory(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/security-advisories/GHSA-1", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{
+			"ghsa_id": "GHSA-1",
+			"summary": "s",
+			"state": "published",
+			"vulnerabilities": [{
+				"package": {"ecosystem": "go", "name": "n"},
+				"vulnerable_version_range": "< 1.0.1",
+				"patched_versions": "1.0.1",
+				"vulnerable_functions": ["f"]
+			}],
+			"cwe_ids": ["CWE-79"],
+			"credits_detailed": [{"user": {"login": "u"}, "type": "finder", "state": "accepted"}],
+			"submission": {"accepted": true},
+			"private_fork": {"full_name": "o/r-ghsa-1"}
+		}`)
+	})
+
+	ctx := context.Background()
+	advisory, _, err := client.SecurityAdvisories.GetRepositorySecurityAdvisory(ctx, "o", "r", "GHSA-1")
+	if err != nil {
+		t.Errorf("SecurityAdvisories.GetRepositorySecurityAdvisory returned error: %v", err)
+	}
+
+	want := &SecurityAdvisory{
+		GHSAID:  String("GHSA-1"),
+		Summary: String("s"),
+		State:   String("published"),
+		Vulnerabilities: []*AdvisoryVulnerability{{
+			Package:                &VulnerabilityPackage{Ecosystem: String("go"), Name: String("n")},
+			VulnerableVersionRange: String("< 1.0.1"),
+			PatchedVersions:        String("1.0.1"),
+			VulnerableFunctions:    []string{"f"},
+		}},
+		CWEIDs:          []string{"CWE-79"},
+		CreditsDetailed: []*RepoAdvisoryCreditDetailed{{User: &User{Login: String("u")}, Type: String("finder"), State: String("accepted")}},
+		Submission:      &SecurityAdvisorySubmission{Accepted: Bool(true)},
+		PrivateFork:     &Repository{FullName: String("o/r-ghsa-1")},
+	}
+	if !reflect.DeepEqual(advisory, want) {
+		t.Errorf("SecurityAdvisories.GetRepositorySecurityAdvisory returned %+v, want %+v", advisory, want)
+	}
+
+	const methodName = "GetRepositorySecurityAdvisory"
+	testBadOptions(t, methodName, func() (err error) {
+		_, _, err = client.SecurityAdvisories.GetRepositorySecurityAdvisory(ctx, "\n", "\n", "\n")
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.SecurityAdvisories.GetRepositorySecurityAdvisory(ctx, "o", "r", "GHSA-1")
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
+
+func TestSecurityAdvisoriesService_CreateRepositorySecurityAdvisory(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	input := &RepositoryAdvisoryRequest{
+		Summary:     String("s"),
+		Description: String("d"),
+		Vulnerabilities: []*AdvisoryVulnerability{{
+			Package:                &VulnerabilityPackage{Ecosystem: String("go"), Name: String("n")},
+			VulnerableVersionRange: String("< 1.0.1"),
+		}},
+		Credits:  []*RepoAdvisoryCredit{{Login: String("u"), Type: String("reporter")}},
+		Severity: String("high"),
+	}
+
+	mux.HandleFunc("/repos/o/r/security-advisories", func(w http.ResponseWriter, r *http.Request) {
+		v := new(RepositoryAdvisoryRequest)
+		json.NewDecoder(r.Body).Decode(v)
+
+		testMethod(t, r, "POST")
+		if !reflect.DeepEqual(v, input) {
+			t.Errorf("Request body = %+v, want %+v", v, input)
+		}
+
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprint(w, `{"ghsa_id":"GHSA-1","state":"draft"}`)
+	})
+
+	ctx := context.Background()
+	advisory, _, err := client.SecurityAdvisories.CreateRepositorySecurityAdvisory(ctx, "o", "r", input)
+	if err != nil {
+		t.Errorf("SecurityAdvisories.CreateRepositorySecurityAdvisory returned error: %v", err)
+	}
+
+	want := &SecurityAdvisory{GHSAID: String("GHSA-1"), State: String("draft")}
+	if !reflect.DeepEqual(advisory, want) {
+		t.Errorf("SecurityAdvisories.CreateRepositorySecurityAdvisory returned %+v, want %+v", advisory, want)
+	}
+
+	const methodName = "CreateRepositorySecurityAdvisory"
+	testBadOptions(t, methodName, func() (err error) {
+		_, _, err = client.SecurityAdvisories.CreateRepositorySecurityAdvisory(ctx, "\n", "\n", input)
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.SecurityAdvisories.CreateRepositorySecurityAdvisory(ctx, "o", "r", input)
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
+
+func TestSecurityAdvisoriesService_UpdateRepositorySecurityAdvisory(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	input := &RepositoryAdvisoryRequest{
+		Credits:            []*RepoAdvisoryCredit{{Login: String("u"), Type: String("finder")}},
+		CollaboratingUsers: []string{"c"},
+	}
+
+	mux.HandleFunc("/repos/o/r/security-advisories/GHSA-1", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PATCH")
+		testBody(t, r, `{"credits":[{"login":"u","type":"finder"}],"collaborating_users":["c"]}`+"\n")
+		fmt.Fprint(w, `{"ghsa_id":"GHSA-1","credits":[{"login":"u","type":"finder"}]}`)
+	})
+
+	ctx := context.Background()
+	advisory, _, err := client.SecurityAdvisories.UpdateRepositorySecurityAdvisory(ctx, "o", "r", "GHSA-1", input)
+	if err != nil {
+		t.Errorf("SecurityAdvisories.UpdateRepositorySecurityAdvisory returned error: %v", err)
+	}
+
+	want := &SecurityAdvisory{GHSAID: String("GHSA-1"), Credits: []*RepoAdvisoryCredit{{Login: String("u"), Type: String("finder")}}}
+	if !reflect.DeepEqual(advisory, want) {
+		t.Errorf("SecurityAdvisories.UpdateRepositorySecurityAdvisory returned %+v, want %+v", advisory, want)
+	}
+
+	const methodName = "UpdateRepositorySecurityAdvisory"
+	testBadOptions(t, methodName, func() (err error) {
+		_, _, err = client.SecurityAdvisories.UpdateRepositorySecurityAdvisory(ctx, "\n", "\n", "\n", input)
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.SecurityAdvisories.UpdateRepositorySecurityAdvisory(ctx, "o", "r", "GHSA-1", input)
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
+
+func TestSecurityAdvisoriesService_PublishRepositorySecurityAdvisory(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/security-advisories/GHSA-1", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PATCH")
+		testBody(t, r, `{"state":"published"}`+"\n")
+		fmt.Fprint(w, `{"ghsa_id":"GHSA-1","state":"published"}`)
+	})
+
+	ctx := context.Background()
+	advisory, _, err := client.SecurityAdvisories.PublishRepositorySecurityAdvisory(ctx, "o", "r", "GHSA-1")
+	if err != nil {
+		t.Errorf("SecurityAdvisories.PublishRepositorySecurityAdvisory returned error: %v", err)
+	}
+
+	want := &SecurityAdvisory{GHSAID: String("GHSA-1"), State: String("published")}
+	if !reflect.DeepEqual(advisory, want) {
+		t.Errorf("SecurityAdvisories.PublishRepositorySecurityAdvisory returned %+v, want %+v", advisory, want)
+	}
+}
+
+func TestSecurityAdvisoriesService_RequestCVE(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/security-advisories/GHSA-1/cve", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		w.WriteHeader(http.StatusAccepted)
+		fmt.Fprint(w, `{}`)
+	})
+
+	ctx := context.Background()
+	resp, err := client.SecurityAdvisories.RequestCVE(ctx, "o", "r", "GHSA-1")
+	if err != nil {
+		t.Errorf("SecurityAdvisories.RequestCVE returned error: %v", err)
+	}
+	if resp.StatusCode != http.StatusAccepted {
+		t.Errorf("SecurityAdvisories.RequestCVE returned status %v, want %v", resp.StatusCode, http.StatusAccepted)
+	}
+
+	const methodName = "RequestCVE"
+	testBadOptions(t, methodName, func() (err error) {
+		_, err = client.SecurityAdvisories.RequestCVE(ctx, "\n", "\n", "\n")
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		return client.SecurityAdvisories.RequestCVE(ctx, "o", "r", "GHSA-1")
+	})
+}
+
+func TestSecurityAdvisoriesService_CreateTemporaryPrivateFork(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/security-advisories/GHSA-1/forks", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		fmt.Fprint(w, `{"id":1,"full_name":"o/r-ghsa-1","private":true}`)
+	})
+
+	ctx := context.Background()
+	fork, _, err := client.SecurityAdvisories.CreateTemporaryPrivateFork(ctx, "o", "r", "GHSA-1")
+	if err != nil {
+		t.Errorf("SecurityAdvisories.CreateTemporaryPrivateFork returned error: %v", err)
+	}
+
+	want := &Repository{ID: Int64(1), FullName: String("o/r-ghsa-1"), Private: Bool(true)}
+	if !reflect.DeepEqual(fork, want) {
+		t.Errorf("SecurityAdvisories.CreateTemporaryPrivateFork returned %+v, want %+v", fork, want)
+	}
+
+	const methodName = "CreateTemporaryPrivateFork"
+	testBadOptions(t, methodName, func() (err error) {
+		_, _, err = client.SecurityAdvisories.CreateTemporaryPrivateFork(ctx, "\n", "\n", "\n")
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.SecurityAdvisories.CreateTemporaryPrivateFork(ctx, "o", "r", "GHSA-1")
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
+
+func TestSecurityAdvisoriesService_CreateTemporaryPrivateFork_deferred(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/security-advisories/GHSA-1/forks", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		w.WriteHeader(http.StatusAccepted)
+		fmt.Fprint(w, `{"id":1,"full_name":"o/r-ghsa-1"}`)
+	})
+
+	ctx := context.Background()
+	fork, _, err := client.SecurityAdvisories.CreateTemporaryPrivateFork(ctx, "o", "r", "GHSA-1")
+	if _, ok := err.(*AcceptedError); !ok {
+		t.Errorf("SecurityAdvisories.CreateTemporaryPrivateFork returned error: %v (want AcceptedError)", err)
+	}
+
+	want := &Repository{ID: Int64(1), FullName: String("o/r-ghsa-1")}
+	if !reflect.DeepEqual(fork, want) {
+		t.Errorf("SecurityAdvisories.CreateTemporaryPrivateFork returned %+v, want %+v", fork, want)
+	}
+}
+
+func TestSecurityAdvisory_Marshal(t *testing.T) {
+	testJSONMarshal(t, &SecurityAdvisory{}, "{}")
+
+	u := &SecurityAdvisory{
+		GHSAID:    String("GHSA-1"),
+		CVEID:     String("CVE-1"),
+		Summary:   String("s"),
+		Severity:  String("low"),
+		State:     String("draft"),
+		Credits:   []*RepoAdvisoryCredit{{Login: String("u"), Type: String("finder")}},
+		CreatedAt: &Timestamp{referenceTime},
+	}
+
+	want := `{
+		"ghsa_id": "GHSA-1",
+		"cve_id": "CVE-1",
+		"summary": "s",
+		"severity": "low",
+		"state": "draft",
+		"credits": [{"login": "u", "type": "finder"}],
+		"created_at": ` + referenceTimeStr + `
+	}`
+
+	testJSONMarshal(t, u, want)
+}
+
+func TestSecurityAdvisoriesService_ListGlobalAdvisories(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/advisories", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testFormValues(t, r, values{
+			"ecosystem":       "go",
+			"severity":        "critical",
+			"cwes":            "79,284",
+			"is_withdrawn":    "false",
+			"epss_percentage": ">=0.1",
+			"per_page":        "5",
+		})
+		fmt.Fprint(w, `[{
+			"ghsa_id": "GHSA-1",
+			"cve_id": "CVE-1",
+			"type": "reviewed",
+			"severity": "critical",
+			"references": ["https://nvd.nist.gov/vuln/detail/CVE-1"],
+			"published_at": `+referenceTimeStr+`,
+			"vulnerabilities": [{
+				"package": {"ecosystem": "go", "name": "n"},
+				"vulnerable_version_range": "< 1.2.3",
+				"first_patched_version": "1.2.3",
+				"vulnerable_functions": []
+			}],
+			"cwes": [{"cwe_id": "CWE-79", "name": "XSS"}],
+			"epss": {"percentage": 0.2, "percentile": 0.9},
+			"credits": [{"user": {"login": "u"}, "type": "analyst"}]
+		}]`)
+	})
+
+	opts := &ListGlobalSecurityAdvisoriesOptions{
+		Ecosystem:      "go",
+		Severity:       "critical",
+		CWEs:           []string{"79", "284"},
+		IsWithdrawn:    Bool(false),
+		EPSSPercentage: ">=0.1",
+		PerPage:        5,
+	}
+	ctx := context.Background()
+	advisories, _, err := client.SecurityAdvisories.ListGlobalAdvisories(ctx, opts)
+	if err != nil {
+		t.Errorf("SecurityAdvisories.ListGlobalAdvisories returned error: %v", err)
+	}
+
+	want := []*GlobalSecurityAdvisory{{
+		GHSAID:      String("GHSA-1"),
+		CVEID:       String("CVE-1"),
+		Type:        String("reviewed"),
+		Severity:    String("critical"),
+		References:  []string{"https://nvd.nist.gov/vuln/detail/CVE-1"},
+		PublishedAt: &Timestamp{referenceTime},
+		Vulnerabilities: []*GlobalSecurityVulnerability{{
+			Package:                &VulnerabilityPackage{Ecosystem: String("go"), Name: String("n")},
+			VulnerableVersionRange: String("< 1.2.3"),
+			FirstPatchedVersion:    String("1.2.3"),
+			VulnerableFunctions:    []string{},
+		}},
+		CWEs:    []*AdvisoryCWE{{CWEID: String("CWE-79"), Name: String("XSS")}},
+		EPSS:    &AdvisoryEPSS{Percentage: Float64(0.2), Percentile: Float64(0.9)},
+		Credits: []*Credit{{User: &User{Login: String("u")}, Type: String("analyst")}},
+	}}
+	if !reflect.DeepEqual(advisories, want) {
+		t.Errorf("SecurityAdvisories.ListGlobalAdvisories returned %+v, want %+v", advisories, want)
+	}
+
+	const methodName = "ListGlobalAdvisories"
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.SecurityAdvisories.ListGlobalAdvisories(ctx, opts)
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
+
+func TestSecurityAdvisoriesService_GetGlobalAdvisory(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/advisories/GHSA-1", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"ghsa_id":"GHSA-1","type":"malware","withdrawn_at":`+referenceTimeStr+`}`)
+	})
+
+	ctx := context.Background()
+	advisory, _, err := client.SecurityAdvisories.GetGlobalAdvisory(ctx, "GHSA-1")
+	if err != nil {
+		t.Errorf("SecurityAdvisories.GetGlobalAdvisory returned error: %v", err)
+	}
+
+	want := &GlobalSecurityAdvisory{GHSAID: String("GHSA-1"), Type: String("malware"), WithdrawnAt: &Timestamp{referenceTime}}
+	if !reflect.DeepEqual(advisory, want) {
+		t.Errorf("SecurityAdvisories.GetGlobalAdvisory returned %+v, want %+v", advisory, want)
+	}
+
+	const methodName = "GetGlobalAdvisory"
+	testBadOptions(t, methodName, func() (err error) {
+		_, _, err = client.SecurityAdvisories.GetGlobalAdvisory(ctx, "\n")
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.SecurityAdvisories.GetGlobalAdvisory(ctx, "GHSA-1")
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
+
+func TestGlobalSecurityAdvisory_Marshal(t *testing.T) {
+	testJSONMarshal(t, &GlobalSecurityAdvisory{}, "{}")
+
+	u := &GlobalSecurityAdvisory{
+		GHSAID:     String("GHSA-1"),
+		Type:       String("reviewed"),
+		References: []string{"r"},
+		Vulnerabilities: []*GlobalSecurityVulnerability{{
+			FirstPatchedVersion: String("1.0.0"),
+		}},
+	}
+
+	want := `{
+		"ghsa_id": "GHSA-1",
+		"type": "reviewed",
+		"references": ["r"],
+		"vulnerabilities": [{"first_patched_version": "1.0.0"}]
+	}`
+
+	testJSONMarshal(t, u, want)
+}
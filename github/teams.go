@@ -67,6 +67,9 @@ type Invitation struct {
 	Inviter           *User      `json:"inviter,omitempty"`
 	TeamCount         *int       `json:"team_count,omitempty"`
 	InvitationTeamURL *string    `json:"invitation_team_url,omitempty"`
+	// FailedAt and FailedReason are only set for failed invitations.
+	FailedAt     *Timestamp `json:"failed_at,omitempty"`
+	FailedReason *string    `json:"failed_reason,omitempty"`
 }
 
 func (i Invitation) String() string {
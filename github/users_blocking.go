@@ -10,6 +10,45 @@ import (
 	"fmt"
 )
 
+// BlockStatus represents whether a user is blocked, as reported by
+// GetBlockStatus.
+type BlockStatus uint8
+
+const (
+	// BlockStatusUnknown is returned along with an error, when the block
+	// status could not be determined.
+	BlockStatusUnknown BlockStatus = iota
+	// BlockStatusBlocked means that the user is blocked.
+	BlockStatusBlocked
+	// BlockStatusNotBlocked means that the user is not blocked, or does not exist.
+	BlockStatusNotBlocked
+)
+
+func (b BlockStatus) String() string {
+	switch b {
+	case BlockStatusBlocked:
+		return "blocked"
+	case BlockStatusNotBlocked:
+		return "not blocked"
+	default:
+		return "unknown"
+	}
+}
+
+// parseBlockStatus parses the error of a request checking a block status,
+// as parseBoolResponse does.
+func parseBlockStatus(err error) (BlockStatus, error) {
+	blocked, err := parseBoolResponse(err)
+	switch {
+	case err != nil:
+		return BlockStatusUnknown, err
+	case blocked:
+		return BlockStatusBlocked, nil
+	default:
+		return BlockStatusNotBlocked, nil
+	}
+}
+
 // ListBlockedUsers lists all the blocked users by the authenticated user.
 //
 // GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/users/#list-users-blocked-by-the-authenticated-user
@@ -56,6 +95,27 @@ func (s *UsersService) IsBlocked(ctx context.Context, user string) (bool, *Respo
 	return isBlocked, resp, err
 }
 
+// GetBlockStatus reports whether specified user is blocked by the
+// authenticated user. Unlike IsBlocked, it distinguishes a failed check,
+// for which it returns BlockStatusUnknown and the error.
+//
+// GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/users/#check-if-a-user-is-blocked-by-the-authenticated-user
+func (s *UsersService) GetBlockStatus(ctx context.Context, user string) (BlockStatus, *Response, error) {
+	u := fmt.Sprintf("user/blocks/%v", user)
+
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return BlockStatusUnknown, nil, err
+	}
+
+	// TODO: remove custom Accept header when this API fully launches.
+	req.Header.Set("Accept", mediaTypeBlockUsersPreview)
+
+	resp, err := s.client.Do(ctx, req, nil)
+	status, err := parseBlockStatus(err)
+	return status, resp, err
+}
+
 // BlockUser blocks specified user for the authenticated user.
 //
 // GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/users/#block-a-user
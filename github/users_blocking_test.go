@@ -135,3 +135,67 @@ func TestUsersService_UnblockUser(t *testing.T) {
 		return client.Users.UnblockUser(ctx, "u")
 	})
 }
+
+func TestUsersService_GetBlockStatus(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/user/blocks/blocked", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testHeader(t, r, "Accept", mediaTypeBlockUsersPreview)
+		w.WriteHeader(http.StatusNoContent)
+	})
+	mux.HandleFunc("/user/blocks/notblocked", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	})
+	mux.HandleFunc("/user/blocks/error", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusForbidden)
+	})
+
+	ctx := context.Background()
+	tests := []struct {
+		user    string
+		want    BlockStatus
+		wantErr bool
+	}{
+		{user: "blocked", want: BlockStatusBlocked},
+		{user: "notblocked", want: BlockStatusNotBlocked},
+		{user: "error", want: BlockStatusUnknown, wantErr: true},
+	}
+	for _, tt := range tests {
+		status, _, err := client.Users.GetBlockStatus(ctx, tt.user)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("Users.GetBlockStatus(%q) returned error %v, want error: %v", tt.user, err, tt.wantErr)
+		}
+		if status != tt.want {
+			t.Errorf("Users.GetBlockStatus(%q) returned %v, want %v", tt.user, status, tt.want)
+		}
+	}
+
+	const methodName = "GetBlockStatus"
+	testBadOptions(t, methodName, func() (err error) {
+		_, _, err = client.Users.GetBlockStatus(ctx, "\n")
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.Users.GetBlockStatus(ctx, "blocked")
+		if got != BlockStatusUnknown {
+			t.Errorf("testNewRequestAndDoFailure %v = %v, want %v", methodName, got, BlockStatusUnknown)
+		}
+		return resp, err
+	})
+}
+
+func TestBlockStatus_String(t *testing.T) {
+	tests := map[BlockStatus]string{
+		BlockStatusUnknown:    "unknown",
+		BlockStatusBlocked:    "blocked",
+		BlockStatusNotBlocked: "not blocked",
+	}
+	for status, want := range tests {
+		if got := status.String(); got != want {
+			t.Errorf("BlockStatus(%d).String() = %q, want %q", status, got, want)
+		}
+	}
+}
@@ -8,6 +8,9 @@ package github
 import (
 	"context"
 	"fmt"
+	"net/http"
+	"strings"
+	"sync"
 )
 
 // ListFollowers lists the followers for a user. Passing the empty string will
@@ -120,3 +123,231 @@ func (s *UsersService) Unfollow(ctx context.Context, user string) (*Response, er
 
 	return s.client.Do(ctx, req, nil)
 }
+
+// followPageSize is the page size used by the follower iterators and by
+// FollowGraph, which is the largest page size allowed by GitHub.
+const followPageSize = 100
+
+// UsersIterator iterates over a list of users, such as the followers of a
+// user, fetching the pages as needed.
+type UsersIterator struct {
+	pageIterator
+
+	users []*User // remaining users of the current page
+	user  *User
+}
+
+// newUsersIterator returns an iterator over the users listed by list, with
+// the page size and the page to start from set in opts.
+func newUsersIterator(opts *ListOptions, list func(opts *ListOptions) ([]*User, *Response, error)) *UsersIterator {
+	var o ListOptions
+	if opts != nil {
+		o = *opts
+	}
+	if o.PerPage == 0 {
+		o.PerPage = followPageSize
+	}
+	it := &UsersIterator{}
+	it.fetch = listPages(&o, func() (n int, resp *Response, err error) {
+		it.users, resp, err = list(&o)
+		return len(it.users), resp, err
+	})
+	return it
+}
+
+// AllFollowers returns an iterator over the followers of a user. Passing the
+// empty string will iterate over the followers of the authenticated user.
+// opts may be used to set the page size, which defaults to 100, and the page
+// to start from. No request is made until the first call to Next.
+//
+// GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/users/#list-followers-of-the-authenticated-user
+// GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/users/#list-followers-of-a-user
+func (s *UsersService) AllFollowers(ctx context.Context, user string, opts *ListOptions) *UsersIterator {
+	return newUsersIterator(opts, func(opts *ListOptions) ([]*User, *Response, error) {
+		return s.ListFollowers(ctx, user, opts)
+	})
+}
+
+// AllFollowing returns an iterator over the people that a user is following.
+// Passing the empty string will iterate over the people the authenticated
+// user is following. opts is used as in AllFollowers.
+//
+// GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/users/#list-the-people-the-authenticated-user-follows
+// GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/users/#list-the-people-a-user-follows
+func (s *UsersService) AllFollowing(ctx context.Context, user string, opts *ListOptions) *UsersIterator {
+	return newUsersIterator(opts, func(opts *ListOptions) ([]*User, *Response, error) {
+		return s.ListFollowing(ctx, user, opts)
+	})
+}
+
+// Next advances the iterator to the next user, which is then available
+// through User. It returns false when there are no more users or an error
+// occurred, in which case it is returned by Err.
+func (it *UsersIterator) Next() bool {
+	if !it.next() {
+		it.user = nil
+		return false
+	}
+	it.user, it.users = it.users[0], it.users[1:]
+	return true
+}
+
+// User returns the current user, or nil if Next has not been called or
+// returned false.
+func (it *UsersIterator) User() *User {
+	return it.user
+}
+
+// FollowGraph answers questions about the follow graph of users, such as
+// who follows whom, for tools that repeatedly traverse it. It caches the
+// pages of followers and following lists it fetches, and revalidates them
+// with conditional requests, so that unchanged pages are answered with
+// "304 Not Modified" responses, which do not count against the rate limit.
+//
+// A FollowGraph is safe for concurrent use.
+type FollowGraph struct {
+	client *Client
+
+	mu    sync.Mutex
+	pages map[string]*followPage // keyed by request URL
+}
+
+// followPage is a cached page of users.
+type followPage struct {
+	etag     string
+	users    []*User
+	nextPage int
+}
+
+// NewFollowGraph returns a new FollowGraph with an empty cache.
+func (s *UsersService) NewFollowGraph() *FollowGraph {
+	return &FollowGraph{
+		client: s.client,
+		pages:  make(map[string]*followPage),
+	}
+}
+
+// Followers returns all the followers of user. Passing the empty string will
+// return the followers of the authenticated user.
+func (g *FollowGraph) Followers(ctx context.Context, user string) ([]*User, error) {
+	if user != "" {
+		return g.listAll(ctx, fmt.Sprintf("users/%v/followers", user))
+	}
+	return g.listAll(ctx, "user/followers")
+}
+
+// Following returns all the people that user is following. Passing the empty
+// string will return the people the authenticated user is following.
+func (g *FollowGraph) Following(ctx context.Context, user string) ([]*User, error) {
+	if user != "" {
+		return g.listAll(ctx, fmt.Sprintf("users/%v/following", user))
+	}
+	return g.listAll(ctx, "user/following")
+}
+
+// MutualFollowers returns the users that both follow user and are followed
+// by user, in the order of the followers of user. Passing the empty string
+// will return the mutual followers of the authenticated user. Logins are
+// compared case-insensitively.
+func (g *FollowGraph) MutualFollowers(ctx context.Context, user string) ([]*User, error) {
+	followers, err := g.Followers(ctx, user)
+	if err != nil {
+		return nil, err
+	}
+	following, err := g.Following(ctx, user)
+	if err != nil {
+		return nil, err
+	}
+
+	followed := make(map[string]bool, len(following))
+	for _, u := range following {
+		followed[strings.ToLower(u.GetLogin())] = true
+	}
+	var mutual []*User
+	for _, u := range followers {
+		if followed[strings.ToLower(u.GetLogin())] {
+			mutual = append(mutual, u)
+		}
+	}
+	return mutual, nil
+}
+
+// IsFollowing reports, for each of targets, whether user is following it.
+// Passing the empty string for user will check the authenticated user. It
+// makes as many requests as there are pages of people user is following,
+// rather than one request per target as UsersService.IsFollowing would.
+// Logins are compared case-insensitively.
+func (g *FollowGraph) IsFollowing(ctx context.Context, user string, targets []string) (map[string]bool, error) {
+	following, err := g.Following(ctx, user)
+	if err != nil {
+		return nil, err
+	}
+
+	followed := make(map[string]bool, len(following))
+	for _, u := range following {
+		followed[strings.ToLower(u.GetLogin())] = true
+	}
+	result := make(map[string]bool, len(targets))
+	for _, target := range targets {
+		result[target] = followed[strings.ToLower(target)]
+	}
+	return result, nil
+}
+
+// listAll fetches all the pages of users at path.
+func (g *FollowGraph) listAll(ctx context.Context, path string) ([]*User, error) {
+	var all []*User
+	opts := &ListOptions{PerPage: followPageSize}
+	for {
+		u, err := addOptions(path, opts)
+		if err != nil {
+			return nil, err
+		}
+		page, err := g.getPage(ctx, u)
+		if err != nil {
+			return nil, err
+		}
+		all = append(all, page.users...)
+		if page.nextPage == 0 {
+			return all, nil
+		}
+		opts.Page = page.nextPage
+	}
+}
+
+// getPage fetches the page of users at u, revalidating the cached page if
+// there is one.
+func (g *FollowGraph) getPage(ctx context.Context, u string) (*followPage, error) {
+	req, err := g.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	g.mu.Lock()
+	cached := g.pages[u]
+	g.mu.Unlock()
+	if cached != nil {
+		req.Header.Set("If-None-Match", cached.etag)
+	}
+
+	var users []*User
+	resp, err := g.client.Do(ctx, req, &users)
+	if err != nil {
+		if e, ok := err.(*ErrorResponse); ok && cached != nil && e.Response != nil && e.Response.StatusCode == http.StatusNotModified {
+			return cached, nil
+		}
+		return nil, err
+	}
+
+	page := &followPage{
+		etag:     resp.Header.Get("ETag"),
+		users:    users,
+		nextPage: resp.NextPage,
+	}
+	if page.etag != "" {
+		g.mu.Lock()
+		g.pages[u] = page
+		g.mu.Unlock()
+	}
+	return page, nil
+}
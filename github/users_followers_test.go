@@ -383,3 +383,135 @@ func TestUsersService_Unfollow_invalidUser(t *testing.T) {
 	_, err := client.Users.Unfollow(ctx, "%")
 	testURLParseError(t, err)
 }
+
+func TestUsersService_AllFollowers(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/users/u/followers", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		switch r.FormValue("page") {
+		case "":
+			testFormValues(t, r, values{"per_page": "100"})
+			w.Header().Set("Link", `<https://api.github.com/users/u/followers?page=2>; rel="next"`)
+			fmt.Fprint(w, `[{"id":1},{"id":2}]`)
+		case "2":
+			testFormValues(t, r, values{"per_page": "100", "page": "2"})
+			fmt.Fprint(w, `[{"id":3}]`)
+		default:
+			t.Errorf("unexpected page %q", r.FormValue("page"))
+		}
+	})
+
+	ctx := context.Background()
+	it := client.Users.AllFollowers(ctx, "u", nil)
+	var got []*User
+	for it.Next() {
+		got = append(got, it.User())
+	}
+	if err := it.Err(); err != nil {
+		t.Errorf("UsersIterator.Err returned %v", err)
+	}
+
+	want := []*User{{ID: Int64(1)}, {ID: Int64(2)}, {ID: Int64(3)}}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("UsersIterator returned %+v, want %+v", got, want)
+	}
+	if it.User() != nil {
+		t.Errorf("UsersIterator.User after end = %v, want nil", it.User())
+	}
+	if it.Response() == nil {
+		t.Error("UsersIterator.Response returned nil")
+	}
+}
+
+func TestUsersService_AllFollowing_error(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/user/following", func(w http.ResponseWriter, r *http.Request) {
+		testFormValues(t, r, values{"per_page": "10"})
+		http.Error(w, `{"message":"Requires authentication"}`, http.StatusUnauthorized)
+	})
+
+	ctx := context.Background()
+	it := client.Users.AllFollowing(ctx, "", &ListOptions{PerPage: 10})
+	if it.Next() {
+		t.Error("UsersIterator.Next returned true, want false")
+	}
+	if _, ok := it.Err().(*ErrorResponse); !ok {
+		t.Errorf("UsersIterator.Err returned %v, want *ErrorResponse", it.Err())
+	}
+}
+
+func TestFollowGraph(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	requests := map[string]int{}
+	serve := func(path, etag, body string) {
+		mux.HandleFunc(path, func(w http.ResponseWriter, r *http.Request) {
+			testMethod(t, r, "GET")
+			testFormValues(t, r, values{"per_page": "100"})
+			requests[path]++
+			if r.Header.Get("If-None-Match") == etag {
+				w.WriteHeader(http.StatusNotModified)
+				return
+			}
+			w.Header().Set("ETag", etag)
+			fmt.Fprint(w, body)
+		})
+	}
+	serve("/users/u/followers", `"f1"`, `[{"login":"a"},{"login":"b"},{"login":"c"}]`)
+	serve("/users/u/following", `"g1"`, `[{"login":"c"},{"login":"A"},{"login":"d"}]`)
+
+	ctx := context.Background()
+	g := client.Users.NewFollowGraph()
+	got, err := g.MutualFollowers(ctx, "u")
+	if err != nil {
+		t.Errorf("FollowGraph.MutualFollowers returned error: %v", err)
+	}
+	want := []*User{{Login: String("a")}, {Login: String("c")}}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("FollowGraph.MutualFollowers returned %+v, want %+v", got, want)
+	}
+
+	// The second traversal is answered from the cache after revalidation.
+	following, err := g.IsFollowing(ctx, "u", []string{"a", "b", "D"})
+	if err != nil {
+		t.Errorf("FollowGraph.IsFollowing returned error: %v", err)
+	}
+	wantFollowing := map[string]bool{"a": true, "b": false, "D": true}
+	if !reflect.DeepEqual(following, wantFollowing) {
+		t.Errorf("FollowGraph.IsFollowing returned %v, want %v", following, wantFollowing)
+	}
+	if got, want := requests["/users/u/following"], 2; got != want {
+		t.Errorf("FollowGraph made %v following requests, want %v", got, want)
+	}
+
+	followers, err := g.Followers(ctx, "u")
+	if err != nil {
+		t.Errorf("FollowGraph.Followers returned error: %v", err)
+	}
+	if len(followers) != 3 {
+		t.Errorf("FollowGraph.Followers returned %v users, want 3", len(followers))
+	}
+}
+
+func TestFollowGraph_error(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/user/followers", func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, `{"message":"Requires authentication"}`, http.StatusUnauthorized)
+	})
+
+	ctx := context.Background()
+	g := client.Users.NewFollowGraph()
+	if _, err := g.MutualFollowers(ctx, ""); err == nil {
+		t.Error("FollowGraph.MutualFollowers returned nil error, want error")
+	}
+	if _, err := g.Following(ctx, "\n"); err == nil {
+		t.Error("FollowGraph.Following returned nil error, want error")
+	}
+}
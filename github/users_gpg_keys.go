@@ -7,8 +7,13 @@ package github
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"strings"
 	"time"
+
+	"golang.org/x/crypto/openpgp/armor"
+	"golang.org/x/crypto/openpgp/packet"
 )
 
 // GPGKey represents a GitHub user's public GPG key used to verify GPG signed commits and tags.
@@ -16,8 +21,10 @@ import (
 // https://developer.github.com/changes/2016-04-04-git-signing-api-preview/
 type GPGKey struct {
 	ID                *int64      `json:"id,omitempty"`
+	Name              *string     `json:"name,omitempty"`
 	PrimaryKeyID      *int64      `json:"primary_key_id,omitempty"`
 	KeyID             *string     `json:"key_id,omitempty"`
+	RawKey            *string     `json:"raw_key,omitempty"`
 	PublicKey         *string     `json:"public_key,omitempty"`
 	Emails            []*GPGEmail `json:"emails,omitempty"`
 	Subkeys           []*GPGKey   `json:"subkeys,omitempty"`
@@ -25,6 +32,7 @@ type GPGKey struct {
 	CanEncryptComms   *bool       `json:"can_encrypt_comms,omitempty"`
 	CanEncryptStorage *bool       `json:"can_encrypt_storage,omitempty"`
 	CanCertify        *bool       `json:"can_certify,omitempty"`
+	Revoked           *bool       `json:"revoked,omitempty"`
 	CreatedAt         *time.Time  `json:"created_at,omitempty"`
 	ExpiresAt         *time.Time  `json:"expires_at,omitempty"`
 }
@@ -34,6 +42,12 @@ func (k GPGKey) String() string {
 	return Stringify(k)
 }
 
+// IsExpired reports whether the key has expired at t. Keys without an
+// expiration time never expire.
+func (k *GPGKey) IsExpired(t time.Time) bool {
+	return k.ExpiresAt != nil && !k.ExpiresAt.After(t)
+}
+
 // GPGEmail represents an email address associated to a GPG key.
 type GPGEmail struct {
 	Email    *string `json:"email,omitempty"`
@@ -97,9 +111,18 @@ func (s *UsersService) GetGPGKey(ctx context.Context, id int64) (*GPGKey, *Respo
 //
 // GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/users/#create-a-gpg-key
 func (s *UsersService) CreateGPGKey(ctx context.Context, armoredPublicKey string) (*GPGKey, *Response, error) {
+	return s.CreateNamedGPGKey(ctx, "", armoredPublicKey)
+}
+
+// CreateNamedGPGKey creates a GPG key with a descriptive name, as
+// CreateGPGKey does. The name is omitted if empty.
+//
+// GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/users/#create-a-gpg-key
+func (s *UsersService) CreateNamedGPGKey(ctx context.Context, name, armoredPublicKey string) (*GPGKey, *Response, error) {
 	gpgKey := &struct {
+		Name             string `json:"name,omitempty"`
 		ArmoredPublicKey string `json:"armored_public_key"`
-	}{ArmoredPublicKey: armoredPublicKey}
+	}{Name: name, ArmoredPublicKey: armoredPublicKey}
 	req, err := s.client.NewRequest("POST", "user/gpg_keys", gpgKey)
 	if err != nil {
 		return nil, nil, err
@@ -127,3 +150,60 @@ func (s *UsersService) DeleteGPGKey(ctx context.Context, id int64) (*Response, e
 
 	return s.client.Do(ctx, req, nil)
 }
+
+// MatchGPGKey finds the key with keyID among keys and their subkeys, for
+// instance to find which uploaded key made a signature. keyID may be a long
+// (16 hexadecimal digits) key ID or a fingerprint, with or without a "0x"
+// prefix, and is matched case-insensitively. It returns the primary key and
+// the matching key, which is either the primary key itself or one of its
+// subkeys, or nil, nil if no key matches.
+func MatchGPGKey(keys []*GPGKey, keyID string) (primary, key *GPGKey) {
+	id := strings.ToUpper(strings.TrimPrefix(strings.TrimPrefix(keyID, "0x"), "0X"))
+	if len(id) < 16 {
+		return nil, nil
+	}
+	// A fingerprint ends with the long key ID.
+	id = id[len(id)-16:]
+
+	for _, k := range keys {
+		if strings.EqualFold(k.GetKeyID(), id) {
+			return k, k
+		}
+		for _, sub := range k.Subkeys {
+			if strings.EqualFold(sub.GetKeyID(), id) {
+				return k, sub
+			}
+		}
+	}
+	return nil, nil
+}
+
+// SigningKeyID returns the long key ID, as 16 uppercase hexadecimal digits,
+// of the key that made the armored signature of v, so that it can be
+// matched with MatchGPGKey.
+func (v *SignatureVerification) SigningKeyID() (string, error) {
+	if v.GetSignature() == "" {
+		return "", errors.New("no signature")
+	}
+
+	block, err := armor.Decode(strings.NewReader(v.GetSignature()))
+	if err != nil {
+		return "", err
+	}
+	p, err := packet.Read(block.Body)
+	if err != nil {
+		return "", err
+	}
+
+	switch sig := p.(type) {
+	case *packet.Signature:
+		if sig.IssuerKeyId == nil {
+			return "", errors.New("signature has no issuer key ID")
+		}
+		return fmt.Sprintf("%016X", *sig.IssuerKeyId), nil
+	case *packet.SignatureV3:
+		return fmt.Sprintf("%016X", sig.IssuerKeyId), nil
+	default:
+		return "", fmt.Errorf("unexpected packet %T in signature", p)
+	}
+}
@@ -6,12 +6,17 @@
 package github
 
 import (
+	"bytes"
 	"context"
 	"encoding/json"
 	"fmt"
 	"net/http"
 	"reflect"
+	"strings"
 	"testing"
+	"time"
+
+	"golang.org/x/crypto/openpgp"
 )
 
 func TestUsersService_ListGPGKeys_authenticatedUser(t *testing.T) {
@@ -188,3 +193,126 @@ func TestUsersService_DeleteGPGKey(t *testing.T) {
 		return client.Users.DeleteGPGKey(ctx, 1)
 	})
 }
+
+func TestUsersService_CreateNamedGPGKey(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/user/gpg_keys", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testBody(t, r, `{"name":"laptop","armored_public_key":"k"}`+"\n")
+		fmt.Fprint(w, `{
+			"id": 1,
+			"name": "laptop",
+			"key_id": "3262EFF25BA0D270",
+			"raw_key": "k",
+			"emails": [{"email": "octocat@users.noreply.github.com", "verified": true}],
+			"subkeys": [{"id": 2, "primary_key_id": 1, "key_id": "4A595D4C72EE49C7", "can_sign": true, "expires_at": "2030-01-01T00:00:00Z"}],
+			"revoked": false
+		}`)
+	})
+
+	ctx := context.Background()
+	gpgKey, _, err := client.Users.CreateNamedGPGKey(ctx, "laptop", "k")
+	if err != nil {
+		t.Errorf("Users.CreateNamedGPGKey returned error: %v", err)
+	}
+
+	expiresAt := time.Date(2030, time.January, 1, 0, 0, 0, 0, time.UTC)
+	want := &GPGKey{
+		ID:     Int64(1),
+		Name:   String("laptop"),
+		KeyID:  String("3262EFF25BA0D270"),
+		RawKey: String("k"),
+		Emails: []*GPGEmail{{Email: String("octocat@users.noreply.github.com"), Verified: Bool(true)}},
+		Subkeys: []*GPGKey{
+			{ID: Int64(2), PrimaryKeyID: Int64(1), KeyID: String("4A595D4C72EE49C7"), CanSign: Bool(true), ExpiresAt: &expiresAt},
+		},
+		Revoked: Bool(false),
+	}
+	if !reflect.DeepEqual(gpgKey, want) {
+		t.Errorf("Users.CreateNamedGPGKey = %+v, want %+v", gpgKey, want)
+	}
+
+	const methodName = "CreateNamedGPGKey"
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.Users.CreateNamedGPGKey(ctx, "laptop", "k")
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
+
+func TestGPGKey_IsExpired(t *testing.T) {
+	now := time.Date(2021, time.January, 1, 0, 0, 0, 0, time.UTC)
+	past, future := now.Add(-time.Hour), now.Add(time.Hour)
+
+	if (&GPGKey{}).IsExpired(now) {
+		t.Error("GPGKey without expiration is expired")
+	}
+	if !(&GPGKey{ExpiresAt: &past}).IsExpired(now) {
+		t.Error("GPGKey which expired in the past is not expired")
+	}
+	if (&GPGKey{ExpiresAt: &future}).IsExpired(now) {
+		t.Error("GPGKey which expires in the future is expired")
+	}
+}
+
+func TestMatchGPGKey(t *testing.T) {
+	sub := &GPGKey{ID: Int64(2), KeyID: String("4A595D4C72EE49C7")}
+	primary := &GPGKey{ID: Int64(1), KeyID: String("3262EFF25BA0D270"), Subkeys: []*GPGKey{sub}}
+	other := &GPGKey{ID: Int64(3), KeyID: String("0123456789ABCDEF")}
+	keys := []*GPGKey{other, primary}
+
+	tests := []struct {
+		keyID       string
+		wantPrimary *GPGKey
+		wantKey     *GPGKey
+	}{
+		{"3262EFF25BA0D270", primary, primary},
+		{"0x3262eff25ba0d270", primary, primary},
+		{"4a595d4c72ee49c7", primary, sub},
+		{"AAAABBBBCCCCDDDDEEEEFFFF4A595D4C72EE49C7", primary, sub},
+		{"0123456789ABCDEF", other, other},
+		{"72EE49C7", nil, nil},
+		{"FFFFFFFFFFFFFFFF", nil, nil},
+	}
+	for _, tt := range tests {
+		gotPrimary, gotKey := MatchGPGKey(keys, tt.keyID)
+		if gotPrimary != tt.wantPrimary || gotKey != tt.wantKey {
+			t.Errorf("MatchGPGKey(%q) = %v, %v, want %v, %v", tt.keyID, gotPrimary, gotKey, tt.wantPrimary, tt.wantKey)
+		}
+	}
+}
+
+func TestSignatureVerification_SigningKeyID(t *testing.T) {
+	entity, err := openpgp.NewEntity("go-github", "test", "go-github@github.com", nil)
+	if err != nil {
+		t.Fatalf("openpgp.NewEntity returned error: %v", err)
+	}
+	var sig bytes.Buffer
+	if err := openpgp.ArmoredDetachSign(&sig, entity, strings.NewReader("payload"), nil); err != nil {
+		t.Fatalf("openpgp.ArmoredDetachSign returned error: %v", err)
+	}
+
+	v := &SignatureVerification{Signature: String(sig.String())}
+	got, err := v.SigningKeyID()
+	if err != nil {
+		t.Errorf("SigningKeyID returned error: %v", err)
+	}
+	if want := fmt.Sprintf("%016X", entity.PrimaryKey.KeyId); got != want {
+		t.Errorf("SigningKeyID = %v, want %v", got, want)
+	}
+
+	keys := []*GPGKey{{KeyID: String(got)}}
+	if primary, _ := MatchGPGKey(keys, got); primary != keys[0] {
+		t.Errorf("MatchGPGKey(%v) = %v, want %v", got, primary, keys[0])
+	}
+
+	for _, v := range []*SignatureVerification{{}, {Signature: String("not a signature")}} {
+		if _, err := v.SigningKeyID(); err == nil {
+			t.Errorf("SigningKeyID(%v) returned nil error", v)
+		}
+	}
+}
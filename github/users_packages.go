@@ -0,0 +1,106 @@
+// Copyright 2021 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+)
+
+// userPackagesURL returns the URL of the packages of user, or of the
+// authenticated user if user is empty, followed by path.
+func userPackagesURL(user, path string) string {
+	if user != "" {
+		return fmt.Sprintf("users/%v/packages%v", user, path)
+	}
+	return "user/packages" + path
+}
+
+// ListPackages lists the packages of a user. Passing the empty string for
+// "user" will list the packages of the authenticated user. opts.PackageType
+// is required.
+//
+// GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/packages/#list-packages-for-the-authenticated-user
+// GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/packages/#list-packages-for-a-user
+func (s *UsersService) ListPackages(ctx context.Context, user string, opts *PackageListOptions) ([]*Package, *Response, error) {
+	return listPackages(ctx, s.client, userPackagesURL(user, ""), opts)
+}
+
+// GetPackage gets a package of a user by name. Passing the empty string for
+// "user" will get a package of the authenticated user.
+//
+// GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/packages/#get-a-package-for-the-authenticated-user
+// GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/packages/#get-a-package-for-a-user
+func (s *UsersService) GetPackage(ctx context.Context, user, packageType, packageName string) (*Package, *Response, error) {
+	u := userPackagesURL(user, fmt.Sprintf("/%v/%v", packageType, url.PathEscape(packageName)))
+	return getPackage(ctx, s.client, u)
+}
+
+// DeletePackage deletes a package of a user. Passing the empty string for
+// "user" will delete a package of the authenticated user.
+//
+// GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/packages/#delete-a-package-for-the-authenticated-user
+// GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/packages/#delete-a-package-for-a-user
+func (s *UsersService) DeletePackage(ctx context.Context, user, packageType, packageName string) (*Response, error) {
+	u := userPackagesURL(user, fmt.Sprintf("/%v/%v", packageType, url.PathEscape(packageName)))
+	return sendPackageRequest(ctx, s.client, "DELETE", u)
+}
+
+// RestorePackage restores a package of a user, which was deleted less than
+// 30 days ago. Passing the empty string for "user" will restore a package
+// of the authenticated user.
+//
+// GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/packages/#restore-a-package-for-the-authenticated-user
+// GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/packages/#restore-a-package-for-a-user
+func (s *UsersService) RestorePackage(ctx context.Context, user, packageType, packageName string) (*Response, error) {
+	u := userPackagesURL(user, fmt.Sprintf("/%v/%v/restore", packageType, url.PathEscape(packageName)))
+	return sendPackageRequest(ctx, s.client, "POST", u)
+}
+
+// PackageGetAllVersions lists the versions of a package of a user. Passing
+// the empty string for "user" will list the versions of a package of the
+// authenticated user. opts.State can be used to list the deleted versions.
+//
+// GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/packages/#get-all-package-versions-for-a-package-owned-by-the-authenticated-user
+// GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/packages/#get-all-package-versions-for-a-package-owned-by-a-user
+func (s *UsersService) PackageGetAllVersions(ctx context.Context, user, packageType, packageName string, opts *PackageListOptions) ([]*PackageVersion, *Response, error) {
+	u := userPackagesURL(user, fmt.Sprintf("/%v/%v/versions", packageType, url.PathEscape(packageName)))
+	return listPackageVersions(ctx, s.client, u, opts)
+}
+
+// PackageGetVersion gets a version of a package of a user. Passing the
+// empty string for "user" will get a version of a package of the
+// authenticated user.
+//
+// GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/packages/#get-a-package-version-for-the-authenticated-user
+// GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/packages/#get-a-package-version-for-a-user
+func (s *UsersService) PackageGetVersion(ctx context.Context, user, packageType, packageName string, packageVersionID int64) (*PackageVersion, *Response, error) {
+	u := userPackagesURL(user, fmt.Sprintf("/%v/%v/versions/%v", packageType, url.PathEscape(packageName), packageVersionID))
+	return getPackageVersion(ctx, s.client, u)
+}
+
+// PackageDeleteVersion deletes a version of a package of a user. Passing
+// the empty string for "user" will delete a version of a package of the
+// authenticated user.
+//
+// GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/packages/#delete-a-package-version-for-the-authenticated-user
+// GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/packages/#delete-package-version-for-a-user
+func (s *UsersService) PackageDeleteVersion(ctx context.Context, user, packageType, packageName string, packageVersionID int64) (*Response, error) {
+	u := userPackagesURL(user, fmt.Sprintf("/%v/%v/versions/%v", packageType, url.PathEscape(packageName), packageVersionID))
+	return sendPackageRequest(ctx, s.client, "DELETE", u)
+}
+
+// PackageRestoreVersion restores a version of a package of a user, which
+// was deleted less than 30 days ago. Passing the empty string for "user"
+// will restore a version of a package of the authenticated user.
+//
+// GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/packages/#restore-a-package-version-for-the-authenticated-user
+// GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/packages/#restore-package-version-for-a-user
+func (s *UsersService) PackageRestoreVersion(ctx context.Context, user, packageType, packageName string, packageVersionID int64) (*Response, error) {
+	u := userPackagesURL(user, fmt.Sprintf("/%v/%v/versions/%v/restore", packageType, url.PathEscape(packageName), packageVersionID))
+	return sendPackageRequest(ctx, s.client, "POST", u)
+}
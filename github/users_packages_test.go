@@ -0,0 +1,185 @@
+// Copyright 2021 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+func TestUsersService_ListPackages(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	for _, path := range []string{"/user/packages", "/users/u/packages"} {
+		mux.HandleFunc(path, func(w http.ResponseWriter, r *http.Request) {
+			testMethod(t, r, "GET")
+			testFormValues(t, r, values{"package_type": "npm"})
+			fmt.Fprint(w, `[{"id":1,"name":"hello","package_type":"npm"}]`)
+		})
+	}
+
+	opts := &PackageListOptions{PackageType: String("npm")}
+	ctx := context.Background()
+	want := []*Package{{ID: Int64(1), Name: String("hello"), PackageType: String("npm")}}
+	for _, user := range []string{"", "u"} {
+		packages, _, err := client.Users.ListPackages(ctx, user, opts)
+		if err != nil {
+			t.Errorf("Users.ListPackages(%q) returned error: %v", user, err)
+		}
+		if !reflect.DeepEqual(packages, want) {
+			t.Errorf("Users.ListPackages(%q) returned %+v, want %+v", user, packages, want)
+		}
+	}
+
+	const methodName = "ListPackages"
+	testBadOptions(t, methodName, func() (err error) {
+		_, _, err = client.Users.ListPackages(ctx, "\n", opts)
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.Users.ListPackages(ctx, "u", opts)
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
+
+func TestUsersService_GetPackage(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/users/u/packages/npm/hello", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"id":1,"name":"hello"}`)
+	})
+
+	ctx := context.Background()
+	pack, _, err := client.Users.GetPackage(ctx, "u", "npm", "hello")
+	if err != nil {
+		t.Errorf("Users.GetPackage returned error: %v", err)
+	}
+	want := &Package{ID: Int64(1), Name: String("hello")}
+	if !reflect.DeepEqual(pack, want) {
+		t.Errorf("Users.GetPackage returned %+v, want %+v", pack, want)
+	}
+
+	const methodName = "GetPackage"
+	testBadOptions(t, methodName, func() (err error) {
+		_, _, err = client.Users.GetPackage(ctx, "\n", "", "")
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.Users.GetPackage(ctx, "u", "npm", "hello")
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
+
+func TestUsersService_DeleteAndRestorePackage(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/user/packages/npm/hello", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+		w.WriteHeader(http.StatusNoContent)
+	})
+	mux.HandleFunc("/user/packages/npm/hello/restore", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	ctx := context.Background()
+	if _, err := client.Users.DeletePackage(ctx, "", "npm", "hello"); err != nil {
+		t.Errorf("Users.DeletePackage returned error: %v", err)
+	}
+	if _, err := client.Users.RestorePackage(ctx, "", "npm", "hello"); err != nil {
+		t.Errorf("Users.RestorePackage returned error: %v", err)
+	}
+
+	testNewRequestAndDoFailure(t, "DeletePackage", client, func() (*Response, error) {
+		return client.Users.DeletePackage(ctx, "", "npm", "hello")
+	})
+	testNewRequestAndDoFailure(t, "RestorePackage", client, func() (*Response, error) {
+		return client.Users.RestorePackage(ctx, "", "npm", "hello")
+	})
+}
+
+func TestUsersService_PackageVersions(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/users/u/packages/container/hello_docker/versions", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testFormValues(t, r, values{"state": "active"})
+		fmt.Fprint(w, `[{"id":1,"metadata":{"package_type":"container","container":{"tags":["v1"]}}}]`)
+	})
+	mux.HandleFunc("/users/u/packages/container/hello_docker/versions/1", func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case "GET":
+			fmt.Fprint(w, `{"id":1}`)
+		case "DELETE":
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			t.Errorf("Request method: %v, want GET or DELETE", r.Method)
+		}
+	})
+	mux.HandleFunc("/users/u/packages/container/hello_docker/versions/1/restore", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	ctx := context.Background()
+	versions, _, err := client.Users.PackageGetAllVersions(ctx, "u", "container", "hello_docker", &PackageListOptions{State: String("active")})
+	if err != nil {
+		t.Errorf("Users.PackageGetAllVersions returned error: %v", err)
+	}
+	wantVersions := []*PackageVersion{{
+		ID: Int64(1),
+		Metadata: &PackageMetadata{
+			PackageType: String("container"),
+			Container:   &PackageContainerMetadata{Tags: []string{"v1"}},
+		},
+	}}
+	if !reflect.DeepEqual(versions, wantVersions) {
+		t.Errorf("Users.PackageGetAllVersions returned %+v, want %+v", versions, wantVersions)
+	}
+
+	version, _, err := client.Users.PackageGetVersion(ctx, "u", "container", "hello_docker", 1)
+	if err != nil {
+		t.Errorf("Users.PackageGetVersion returned error: %v", err)
+	}
+	if want := (&PackageVersion{ID: Int64(1)}); !reflect.DeepEqual(version, want) {
+		t.Errorf("Users.PackageGetVersion returned %+v, want %+v", version, want)
+	}
+
+	if _, err := client.Users.PackageDeleteVersion(ctx, "u", "container", "hello_docker", 1); err != nil {
+		t.Errorf("Users.PackageDeleteVersion returned error: %v", err)
+	}
+	if _, err := client.Users.PackageRestoreVersion(ctx, "u", "container", "hello_docker", 1); err != nil {
+		t.Errorf("Users.PackageRestoreVersion returned error: %v", err)
+	}
+
+	testBadOptions(t, "PackageGetAllVersions", func() (err error) {
+		_, _, err = client.Users.PackageGetAllVersions(ctx, "\n", "", "", nil)
+		return err
+	})
+	testNewRequestAndDoFailure(t, "PackageGetVersion", client, func() (*Response, error) {
+		got, resp, err := client.Users.PackageGetVersion(ctx, "u", "container", "hello_docker", 1)
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure PackageGetVersion = %#v, want nil", got)
+		}
+		return resp, err
+	})
+}
@@ -0,0 +1,89 @@
+// Copyright 2021 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"fmt"
+)
+
+// SocialAccount represents a social account, such as a Mastodon or
+// LinkedIn profile, linked to a GitHub user.
+type SocialAccount struct {
+	Provider *string `json:"provider,omitempty"`
+	URL      *string `json:"url,omitempty"`
+}
+
+// socialAccountsRequest represents the body of the requests adding or
+// deleting social accounts.
+type socialAccountsRequest struct {
+	AccountURLs []string `json:"account_urls"`
+}
+
+// ListSocialAccounts lists the social accounts of a user. Passing the empty
+// string will fetch the social accounts of the authenticated user.
+//
+// GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/users/#list-social-accounts-for-the-authenticated-user
+// GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/users/#list-social-accounts-for-a-user
+func (s *UsersService) ListSocialAccounts(ctx context.Context, user string, opts *ListOptions) ([]*SocialAccount, *Response, error) {
+	var u string
+	if user != "" {
+		u = fmt.Sprintf("users/%v/social_accounts", user)
+	} else {
+		u = "user/social_accounts"
+	}
+	u, err := addOptions(u, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var accounts []*SocialAccount
+	resp, err := s.client.Do(ctx, req, &accounts)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return accounts, resp, nil
+}
+
+// AddSocialAccounts adds social accounts, given by their URLs, to the
+// authenticated user. It returns the added social accounts.
+//
+// GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/users/#add-social-accounts-for-the-authenticated-user
+func (s *UsersService) AddSocialAccounts(ctx context.Context, accountURLs []string) ([]*SocialAccount, *Response, error) {
+	u := "user/social_accounts"
+	req, err := s.client.NewRequest("POST", u, &socialAccountsRequest{AccountURLs: accountURLs})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var accounts []*SocialAccount
+	resp, err := s.client.Do(ctx, req, &accounts)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return accounts, resp, nil
+}
+
+// DeleteSocialAccounts deletes social accounts, given by their URLs, from
+// the authenticated user.
+//
+// GitHub API docs: https://docs.github.com/en/free-pro-team@latest/rest/reference/users/#delete-social-accounts-for-the-authenticated-user
+func (s *UsersService) DeleteSocialAccounts(ctx context.Context, accountURLs []string) (*Response, error) {
+	u := "user/social_accounts"
+	req, err := s.client.NewRequest("DELETE", u, &socialAccountsRequest{AccountURLs: accountURLs})
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(ctx, req, nil)
+}
@@ -0,0 +1,126 @@
+// Copyright 2021 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+func TestUsersService_ListSocialAccounts_authenticatedUser(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/user/social_accounts", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testFormValues(t, r, values{"page": "2"})
+		fmt.Fprint(w, `[{"provider": "twitter", "url": "https://twitter.com/github"}]`)
+	})
+
+	opt := &ListOptions{Page: 2}
+	ctx := context.Background()
+	accounts, _, err := client.Users.ListSocialAccounts(ctx, "", opt)
+	if err != nil {
+		t.Errorf("Users.ListSocialAccounts returned error: %v", err)
+	}
+
+	want := []*SocialAccount{{Provider: String("twitter"), URL: String("https://twitter.com/github")}}
+	if !reflect.DeepEqual(accounts, want) {
+		t.Errorf("Users.ListSocialAccounts returned %+v, want %+v", accounts, want)
+	}
+
+	const methodName = "ListSocialAccounts"
+	testBadOptions(t, methodName, func() (err error) {
+		_, _, err = client.Users.ListSocialAccounts(ctx, "\n", opt)
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.Users.ListSocialAccounts(ctx, "", opt)
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
+
+func TestUsersService_ListSocialAccounts_specifiedUser(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/users/u/social_accounts", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `[{"provider": "linkedin", "url": "https://www.linkedin.com/company/github"}]`)
+	})
+
+	ctx := context.Background()
+	accounts, _, err := client.Users.ListSocialAccounts(ctx, "u", nil)
+	if err != nil {
+		t.Errorf("Users.ListSocialAccounts returned error: %v", err)
+	}
+
+	want := []*SocialAccount{{Provider: String("linkedin"), URL: String("https://www.linkedin.com/company/github")}}
+	if !reflect.DeepEqual(accounts, want) {
+		t.Errorf("Users.ListSocialAccounts returned %+v, want %+v", accounts, want)
+	}
+}
+
+func TestUsersService_AddSocialAccounts(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/user/social_accounts", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testBody(t, r, `{"account_urls":["https://twitter.com/github"]}`+"\n")
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprint(w, `[{"provider": "twitter", "url": "https://twitter.com/github"}]`)
+	})
+
+	ctx := context.Background()
+	accounts, _, err := client.Users.AddSocialAccounts(ctx, []string{"https://twitter.com/github"})
+	if err != nil {
+		t.Errorf("Users.AddSocialAccounts returned error: %v", err)
+	}
+
+	want := []*SocialAccount{{Provider: String("twitter"), URL: String("https://twitter.com/github")}}
+	if !reflect.DeepEqual(accounts, want) {
+		t.Errorf("Users.AddSocialAccounts returned %+v, want %+v", accounts, want)
+	}
+
+	const methodName = "AddSocialAccounts"
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.Users.AddSocialAccounts(ctx, []string{"https://twitter.com/github"})
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
+
+func TestUsersService_DeleteSocialAccounts(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/user/social_accounts", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+		testBody(t, r, `{"account_urls":["https://twitter.com/github"]}`+"\n")
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	ctx := context.Background()
+	_, err := client.Users.DeleteSocialAccounts(ctx, []string{"https://twitter.com/github"})
+	if err != nil {
+		t.Errorf("Users.DeleteSocialAccounts returned error: %v", err)
+	}
+
+	const methodName = "DeleteSocialAccounts"
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		return client.Users.DeleteSocialAccounts(ctx, []string{"https://twitter.com/github"})
+	})
+}
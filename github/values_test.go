@@ -0,0 +1,71 @@
+// Copyright 2021 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestIssue_ToValue(t *testing.T) {
+	links := &PullRequestLinks{URL: String("u")}
+	issue := &Issue{
+		ID:               Int64(1),
+		Title:            String("t"),
+		Locked:           Bool(true),
+		CreatedAt:        &referenceTime,
+		User:             &User{Login: String("l")},
+		Labels:           []*Label{{Name: String("a")}, nil},
+		Repository:       &Repository{FullName: String("o/r"), Parent: &Repository{FullName: String("p/r")}},
+		PullRequestLinks: links,
+	}
+
+	want := IssueValue{
+		ID:        1,
+		Title:     "t",
+		Locked:    true,
+		CreatedAt: referenceTime,
+		User:      UserValue{Login: "l"},
+		Labels:    []LabelValue{{Name: "a"}, {}},
+		Repository: RepositoryValue{
+			FullName: "o/r",
+			Parent:   &RepositoryValue{FullName: "p/r"},
+		},
+		PullRequestLinks: links,
+	}
+	if got := issue.ToValue(); !reflect.DeepEqual(got, want) {
+		t.Errorf("Issue.ToValue returned %+v, want %+v", got, want)
+	}
+}
+
+func TestIssue_ToValue_nil(t *testing.T) {
+	var issue *Issue
+	if got := issue.ToValue(); !reflect.DeepEqual(got, IssueValue{}) {
+		t.Errorf("Issue.ToValue returned %+v, want zero value", got)
+	}
+	if got := (&Issue{}).ToValue(); !reflect.DeepEqual(got, IssueValue{}) {
+		t.Errorf("Issue.ToValue returned %+v, want zero value", got)
+	}
+}
+
+func TestRepository_ToValue(t *testing.T) {
+	repo := &Repository{
+		Owner:       &User{Login: String("o")},
+		Permissions: &map[string]bool{"admin": true},
+		PushedAt:    &Timestamp{referenceTime},
+		License:     &License{Key: String("mit")},
+	}
+
+	want := RepositoryValue{
+		Owner:       UserValue{Login: "o"},
+		Permissions: map[string]bool{"admin": true},
+		PushedAt:    Timestamp{referenceTime},
+		License:     LicenseValue{Key: "mit"},
+	}
+	if got := repo.ToValue(); !reflect.DeepEqual(got, want) {
+		t.Errorf("Repository.ToValue returned %+v, want %+v", got, want)
+	}
+}